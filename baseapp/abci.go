@@ -18,6 +18,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/oplog"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -146,9 +147,10 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 	if app.cms.TracingEnabled() {
 		app.cms.SetTracingContext(sdk.TraceContext(
-			map[string]interface{}{"blockHeight": req.Header.Height},
+			map[string]interface{}{"blockHeight": req.Header.Height, "txIndex": oplog.NoTxIndex},
 		))
 	}
+	app.txIndex = 0
 
 	if err := app.validateHeight(req); err != nil {
 		panic(err)
@@ -191,7 +193,7 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 	if app.beginBlocker != nil {
 		res = app.beginBlocker(app.deliverState.ctx, req)
-		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
+		res.Events = app.indexEvents.MarkEventsToIndex(res.Events)
 	}
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
@@ -203,12 +205,14 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	defer telemetry.MeasureSince(time.Now(), "abci", "end_block")
 
 	if app.deliverState.ms.TracingEnabled() {
-		app.deliverState.ms = app.deliverState.ms.SetTracingContext(nil).(sdk.CacheMultiStore)
+		app.deliverState.ms = app.deliverState.ms.SetTracingContext(sdk.TraceContext(
+			map[string]interface{}{"txIndex": oplog.NoTxIndex},
+		)).(sdk.CacheMultiStore)
 	}
 
 	if app.endBlocker != nil {
 		res = app.endBlocker(app.deliverState.ctx, req)
-		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
+		res.Events = app.indexEvents.MarkEventsToIndex(res.Events)
 	}
 
 	if cp := app.GetConsensusParams(app.deliverState.ctx); cp != nil {
@@ -242,12 +246,14 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 
 	tx, err := app.txDecoder(req.Tx)
 	if err != nil {
+		app.checkLogger.Debug("rejected tx", "recheck", mode == runTxModeReCheck, "err", err)
 		return sdkerrors.ResponseCheckTx(err, 0, 0, app.trace)
 	}
 
 	ctx := app.getContextForTx(mode, req.Tx)
 	res, err := app.txHandler.CheckTx(ctx, tx, req)
 	if err != nil {
+		app.checkLogger.Debug("rejected tx", "recheck", mode == runTxModeReCheck, "err", err)
 		return sdkerrors.ResponseCheckTx(err, uint64(res.GasUsed), uint64(res.GasWanted), app.trace)
 	}
 
@@ -262,15 +268,24 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
 	defer telemetry.MeasureSince(time.Now(), "abci", "deliver_tx")
 
+	if app.deliverState.ms.TracingEnabled() {
+		app.deliverState.ms = app.deliverState.ms.SetTracingContext(sdk.TraceContext(
+			map[string]interface{}{"txIndex": app.txIndex},
+		)).(sdk.CacheMultiStore)
+	}
+	app.txIndex++
+
 	tx, err := app.txDecoder(req.Tx)
 	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, 0, 0, app.trace)
 	}
 
 	ctx := app.getContextForTx(runTxModeDeliver, req.Tx)
+	app.checkDeliverTxDeterminism(ctx, tx, req)
+
 	res, err := app.txHandler.DeliverTx(ctx, tx, req)
 	if err != nil {
-		return sdkerrors.ResponseDeliverTx(err, uint64(res.GasUsed), uint64(res.GasWanted), app.trace)
+		return sdkerrors.ResponseDeliverTxWithEvents(err, uint64(res.GasUsed), uint64(res.GasWanted), res.Events, app.trace)
 	}
 
 	return res
@@ -323,7 +338,14 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		app.halt()
 	}
 
-	if app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
+	switch {
+	case app.scheduledSnapshots[header.Height]:
+		// scheduled snapshots are taken before returning, as the node may halt
+		// at the next height, e.g. for an upgrade
+		delete(app.scheduledSnapshots, header.Height)
+		app.snapshot(header.Height)
+
+	case app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0:
 		go app.snapshot(header.Height)
 	}
 
@@ -385,6 +407,17 @@ func (app *BaseApp) snapshot(height int64) {
 	}
 }
 
+// ScheduleSnapshot requests a state sync snapshot to be taken once the given
+// height is committed, regardless of the snapshot interval. Unlike the interval
+// snapshots, it is taken synchronously, before Commit returns.
+func (app *BaseApp) ScheduleSnapshot(height int64) {
+	if app.scheduledSnapshots == nil {
+		app.scheduledSnapshots = make(map[int64]bool)
+	}
+
+	app.scheduledSnapshots[height] = true
+}
+
 // Query implements the ABCI interface. It delegates to CommitMultiStore if it
 // implements Queryable.
 func (app *BaseApp) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
@@ -64,8 +64,9 @@ type BaseApp struct { // nolint: maligned
 
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager    *snapshots.Manager
-	snapshotInterval   uint64 // block interval between state sync snapshots
-	snapshotKeepRecent uint32 // recent state sync snapshots to keep
+	snapshotInterval   uint64         // block interval between state sync snapshots
+	snapshotKeepRecent uint32         // recent state sync snapshots to keep
+	scheduledSnapshots map[int64]bool // heights of snapshots requested outside of the snapshot interval
 
 	// volatile states:
 	//
@@ -122,9 +123,21 @@ type BaseApp struct { // nolint: maligned
 	// trace set will return full stack traces for errors in ABCI Log field
 	trace bool
 
-	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
-	// which informs Tendermint what to index. If empty, all events will be indexed.
-	indexEvents map[string]struct{}
+	// txIndex is the index in the current block of the next delivered tx,
+	// which is set in the tracing context of the store operations.
+	txIndex int64
+
+	// indexEvents selects the events of BeginBlock and EndBlock which
+	// Tendermint indexes. By default, all events are indexed.
+	indexEvents sdk.EventIndexFilter
+
+	// simDeliverListener is called with the bytes of every tx delivered through
+	// SimDeliver, if set.
+	simDeliverListener func(txBytes []byte)
+
+	// checkLogger is the logger of the CheckTx contexts, which may be rate
+	// limited as the txs of the mempool are controlled by any peer.
+	checkLogger log.Logger
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -136,7 +149,7 @@ func NewBaseApp(
 	name string, logger log.Logger, db dbm.DB, txDecoder sdk.TxDecoder, options ...func(*BaseApp),
 ) *BaseApp {
 	app := &BaseApp{
-		logger:          logger,
+		logger:          logger.With("module", "baseapp"),
 		name:            name,
 		db:              db,
 		cms:             store.NewCommitMultiStore(db),
@@ -146,6 +159,7 @@ func NewBaseApp(
 		txDecoder:       txDecoder,
 		fauxMerkleMode:  false,
 	}
+	app.checkLogger = app.logger
 
 	for _, option := range options {
 		option(app)
@@ -274,6 +288,11 @@ func (app *BaseApp) LastCommitID() storetypes.CommitID {
 	return app.cms.LastCommitID()
 }
 
+// CommitMultiStore returns the root multi-store of the application.
+func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore {
+	return app.cms
+}
+
 // LastBlockHeight returns the last committed block height.
 func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
@@ -330,13 +349,17 @@ func (app *BaseApp) setTrace(trace bool) {
 }
 
 func (app *BaseApp) setIndexEvents(ie []string) {
-	app.indexEvents = make(map[string]struct{})
+	app.indexEvents.Allow = make(map[string]struct{})
 
 	for _, e := range ie {
-		app.indexEvents[e] = struct{}{}
+		app.indexEvents.Allow[e] = struct{}{}
 	}
 }
 
+func (app *BaseApp) setEventIndexFilter(filter sdk.EventIndexFilter) {
+	app.indexEvents = filter
+}
+
 // QueryRouter returns the QueryRouter of a BaseApp.
 func (app *BaseApp) QueryRouter() sdk.QueryRouter { return app.queryRouter }
 
@@ -354,7 +377,7 @@ func (app *BaseApp) setCheckState(header tmproto.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.checkState = &state{
 		ms:  ms,
-		ctx: sdk.NewContext(ms, header, true, app.logger).WithMinGasPrices(app.minGasPrices),
+		ctx: sdk.NewContext(ms, header, true, app.checkLogger).WithMinGasPrices(app.minGasPrices),
 	}
 }
 
@@ -1806,6 +1806,22 @@ func TestListSnapshots(t *testing.T) {
 	}}, resp)
 }
 
+func TestScheduleSnapshot(t *testing.T) {
+	app, teardown := setupBaseAppWithSnapshots(t, 2, 1)
+	defer teardown()
+
+	// height 3 is outside of the snapshot interval
+	app.ScheduleSnapshot(3)
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 3}})
+	app.EndBlock(abci.RequestEndBlock{Height: 3})
+	app.Commit()
+
+	// the snapshot is taken before Commit returns
+	resp := app.ListSnapshots(abci.RequestListSnapshots{})
+	require.Len(t, resp.Snapshots, 2)
+	require.EqualValues(t, 3, resp.Snapshots[0].Height)
+}
+
 func TestLoadSnapshotChunk(t *testing.T) {
 	app, teardown := setupBaseAppWithSnapshots(t, 2, 5)
 	defer teardown()
@@ -0,0 +1,183 @@
+package baseapp
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+	"sort"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/cosmos/cosmos-sdk/store/oplog"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// checkDeliverTxDeterminism detects nondeterministic execution of a tx, e.g.
+// by iterating over a Go map, when the app is built with the
+// nondeterminism_check build tag. The tx is replayed several times on
+// discarded branches of the deliver state, hashing the store reads of each
+// replay in order, its writes, its result and its events, and the function
+// panics if the hashes of the replays differ. As the iteration order of Go
+// maps is randomized, code whose store accesses or results depend on it is
+// likely to diverge across the replays.
+func (app *BaseApp) checkDeliverTxDeterminism(goCtx context.Context, tx sdk.Tx, req abci.RequestDeliverTx) {
+	if deliverTxReplays < 2 {
+		return
+	}
+
+	var first []byte
+	for i := 0; i < deliverTxReplays; i++ {
+		h := app.replayDeliverTx(goCtx, tx, req)
+		if i == 0 {
+			first = h
+			continue
+		}
+
+		if !bytes.Equal(first, h) {
+			err := fmt.Errorf(
+				"nondeterministic execution of tx %X: replay %d hashed %X but replay 0 hashed %X",
+				tmhash.Sum(req.Tx), i, h, first,
+			)
+			app.logger.Error(err.Error())
+			panic(err)
+		}
+	}
+}
+
+// replayDeliverTx delivers a tx on a discarded branch of the state of the given
+// context and returns the hash of its store accesses, result and events.
+func (app *BaseApp) replayDeliverTx(goCtx context.Context, tx sdk.Tx, req abci.RequestDeliverTx) []byte {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	h := sha256.New()
+	tracer := &replayTracer{h: h}
+
+	// the reads of the replay reach the traced stores the first time a key is
+	// read, and its writes when the traced branch is written to the discarded
+	// one
+	branch := ctx.MultiStore().CacheMultiStore()
+	traced := branch.SetTracer(tracer).(sdk.CacheMultiStore).CacheMultiStore()
+
+	ctx = ctx.WithMultiStore(traced).WithEventManager(sdk.NewEventManager())
+	if blockGasMeter := ctx.BlockGasMeter(); blockGasMeter != nil {
+		ctx = ctx.WithBlockGasMeter(copyGasMeter(blockGasMeter))
+	}
+
+	res, err := app.txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx, req)
+	tracer.writeStores(traced)
+
+	writeHashInt(h, res.GasWanted)
+	writeHashInt(h, res.GasUsed)
+	writeHashBytes(h, res.Data)
+	writeHashBytes(h, []byte(res.Log))
+	if err != nil {
+		writeHashBytes(h, []byte(err.Error()))
+	}
+	for _, event := range res.Events {
+		bz, err := event.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		writeHashBytes(h, bz)
+	}
+
+	return h.Sum(nil)
+}
+
+// replayTracer hashes the store operations traced during a replay along with
+// the name of their store.
+type replayTracer struct {
+	h hash.Hash
+	// writes holds the writes of each store while the traced branch is
+	// written, as the stores are written in the iteration order of a map
+	writes map[string][]oplog.Operation
+}
+
+func (t *replayTracer) Write(p []byte) (int, error) {
+	return t.h.Write(p)
+}
+
+// ForStore returns the trace writer of a store, see tracekv.WriterForStore.
+func (t *replayTracer) ForStore(name string) io.Writer {
+	return replayStoreTracer{replayTracer: t, store: name}
+}
+
+// writeStores writes the given traced branch to its parent and hashes the
+// written operations ordered by store name.
+func (t *replayTracer) writeStores(traced sdk.CacheMultiStore) {
+	t.writes = make(map[string][]oplog.Operation)
+	traced.Write()
+
+	names := make([]string, 0, len(t.writes))
+	for name := range t.writes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, op := range t.writes[name] {
+			t.hashOperation(name, op)
+		}
+	}
+}
+
+func (t *replayTracer) hashOperation(store string, op oplog.Operation) {
+	writeHashBytes(t.h, []byte(store))
+	t.h.Write([]byte{byte(op.Type)})
+	writeHashBytes(t.h, op.Key)
+	writeHashBytes(t.h, op.Value)
+}
+
+type replayStoreTracer struct {
+	*replayTracer
+	store string
+}
+
+var _ tracekv.OperationWriter = replayStoreTracer{}
+
+// WriteOperation implements tracekv.OperationWriter. The writes made while the
+// tx executes are skipped, as the branches of the traced branch made by the tx
+// also trace their writes, in the iteration order of a map.
+func (w replayStoreTracer) WriteOperation(op oplog.Operation) error {
+	isWrite := op.Type == oplog.OpWrite || op.Type == oplog.OpDelete
+	switch {
+	case w.writes != nil:
+		if isWrite {
+			w.writes[w.store] = append(w.writes[w.store], op)
+		}
+	case !isWrite:
+		w.hashOperation(w.store, op)
+	}
+
+	return nil
+}
+
+// copyGasMeter returns a new gas meter with the limit and consumed gas of the
+// given one.
+func copyGasMeter(meter sdk.GasMeter) sdk.GasMeter {
+	var cp sdk.GasMeter
+	if limit := meter.Limit(); limit > 0 {
+		cp = sdk.NewGasMeter(limit)
+	} else {
+		cp = sdk.NewInfiniteGasMeter()
+	}
+	cp.ConsumeGas(meter.GasConsumedToLimit(), "replay")
+
+	return cp
+}
+
+func writeHashInt(h hash.Hash, i int64) {
+	var bz [8]byte
+	binary.BigEndian.PutUint64(bz[:], uint64(i))
+	h.Write(bz[:])
+}
+
+func writeHashBytes(h hash.Hash, bz []byte) {
+	writeHashInt(h, int64(len(bz)))
+	h.Write(bz)
+}
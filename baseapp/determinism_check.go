@@ -0,0 +1,8 @@
+//go:build nondeterminism_check
+// +build nondeterminism_check
+
+package baseapp
+
+// deliverTxReplays is the number of times every delivered tx is replayed to
+// detect nondeterministic execution.
+const deliverTxReplays = 3
@@ -0,0 +1,8 @@
+//go:build !nondeterminism_check
+// +build !nondeterminism_check
+
+package baseapp
+
+// deliverTxReplays is zero without the nondeterminism_check build tag, which
+// disables the replays of delivered txs.
+const deliverTxReplays = 0
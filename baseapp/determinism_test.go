@@ -0,0 +1,68 @@
+//go:build nondeterminism_check
+// +build nondeterminism_check
+
+package baseapp_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// readKeysTxHandler reads keys of a store in the iteration order of a map when
+// ordered is false, and in a fixed order otherwise.
+type readKeysTxHandler struct {
+	tx.Handler
+	ordered bool
+}
+
+func (h readKeysTxHandler) DeliverTx(ctx context.Context, _ sdk.Tx, _ abci.RequestDeliverTx) (abci.ResponseDeliverTx, error) {
+	store := sdk.UnwrapSDKContext(ctx).KVStore(capKey2)
+
+	keys := make(map[int]bool, 1000)
+	for i := 0; i < 1000; i++ {
+		keys[i] = true
+	}
+
+	if h.ordered {
+		for i := 0; i < len(keys); i++ {
+			store.Get(sdk.Uint64ToBigEndian(uint64(i)))
+		}
+	} else {
+		for i := range keys {
+			store.Get(sdk.Uint64ToBigEndian(uint64(i)))
+		}
+	}
+	store.Set([]byte("counter"), []byte{1})
+
+	return abci.ResponseDeliverTx{}, nil
+}
+
+func TestDeliverTxDeterminismCheck(t *testing.T) {
+	for _, ordered := range []bool{true, false} {
+		app := setupBaseApp(t, func(app *baseapp.BaseApp) {
+			app.SetTxHandler(readKeysTxHandler{ordered: ordered})
+		})
+		app.InitChain(abci.RequestInitChain{})
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+		txBytes, err := aminoTxEncoder()(newTxCounter(0, 0))
+		require.NoError(t, err)
+
+		deliver := func() { app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}) }
+		if ordered {
+			require.NotPanics(t, deliver)
+		} else {
+			// the replays start iterating over the map at the same key with
+			// a negligible probability
+			require.Panics(t, deliver)
+		}
+	}
+}
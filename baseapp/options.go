@@ -1,15 +1,19 @@
 package baseapp
 
 import (
+	"crypto/cipher"
 	"fmt"
 	"io"
+	"time"
 
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdklog "github.com/cosmos/cosmos-sdk/types/log"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
@@ -58,12 +62,55 @@ func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
 }
 
+// SetEventIndexFilter provides a BaseApp option function that sets the filter
+// of the events to index, replacing the events set by SetIndexEvents.
+func SetEventIndexFilter(filter sdk.EventIndexFilter) func(*BaseApp) {
+	return func(app *BaseApp) { app.setEventIndexFilter(filter) }
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetStoreMetrics provides a BaseApp option function that enables per module
+// telemetry of the multistore KVStore operations.
+func SetStoreMetrics(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.cms.SetMetricsEnabled(enabled) }
+}
+
+// SetStoreEncryption provides a BaseApp option function that encrypts at rest
+// the stores of the given names with the given cipher. It is a no-op if no
+// store names are given.
+func SetStoreEncryption(aead cipher.AEAD, storeNames ...string) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if len(storeNames) == 0 {
+			return
+		}
+
+		rms, ok := app.cms.(*rootmulti.Store)
+		if !ok {
+			panic("store encryption requires a rootmulti store")
+		}
+		rms.SetStoreEncryption(aead, storeNames...)
+	}
+}
+
+// SetLogSampling provides a BaseApp option function that rate limits the logs
+// of CheckTx, including the ones of the tx handler middlewares, to at most
+// burst identical messages per period. It is a no-op if period or burst is
+// zero.
+func SetLogSampling(period time.Duration, burst int) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if period <= 0 || burst <= 0 {
+			return
+		}
+
+		app.checkLogger = sdklog.NewSamplingLogger(app.logger, period, burst)
+	}
+}
+
 // SetSnapshotInterval sets the snapshot interval.
 func SetSnapshotInterval(interval uint64) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotInterval(interval) }
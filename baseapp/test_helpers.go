@@ -54,7 +54,13 @@ func (app *BaseApp) SimDeliver(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo,
 		return sdk.GasInfo{}, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s", err)
 	}
 
+	if app.simDeliverListener != nil {
+		app.simDeliverListener(bz)
+	}
+
 	ctx := app.getContextForTx(runTxModeDeliver, bz)
+	app.checkDeliverTxDeterminism(ctx, tx, abci.RequestDeliverTx{Tx: bz})
+
 	res, err := app.txHandler.DeliverTx(ctx, tx, abci.RequestDeliverTx{Tx: bz})
 	gInfo := sdk.GasInfo{GasWanted: uint64(res.GasWanted), GasUsed: uint64(res.GasUsed)}
 	if err != nil {
@@ -64,6 +70,13 @@ func (app *BaseApp) SimDeliver(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo,
 	return gInfo, &sdk.Result{Data: res.Data, Log: res.Log, Events: res.Events}, nil
 }
 
+// SetSimDeliverListener sets a function called with the bytes of every tx
+// delivered through SimDeliver, e.g. to record the txs of a simulation. A nil
+// listener removes the current one.
+func (app *BaseApp) SetSimDeliverListener(listener func(txBytes []byte)) {
+	app.simDeliverListener = listener
+}
+
 // Context with current {check, deliver}State of the app used by tests.
 func (app *BaseApp) NewContext(isCheckTx bool, header tmproto.Header) sdk.Context {
 	if isCheckTx {
@@ -0,0 +1,77 @@
+package benchmarks_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/benchmarks"
+	"github.com/cosmos/cosmos-sdk/simapp"
+)
+
+var flagOutput = flag.String("BenchmarksOutput", "", "file the JSON results of the benchmarks are appended to")
+
+func simappConfig() benchmarks.Config {
+	encodingConfig := simapp.MakeTestEncodingConfig()
+
+	return benchmarks.Config{
+		NewApp: func() benchmarks.App {
+			return simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, simapp.DefaultNodeHome, 0, encodingConfig, simapp.EmptyAppOptions{})
+		},
+		ModuleBasics: simapp.ModuleBasics,
+		TxConfig:     encodingConfig.TxConfig,
+	}
+}
+
+// Run with:
+// go test -run=^$ -bench=BenchmarkStandardWorkloads ./benchmarks -BenchmarksOutput=results.json
+func BenchmarkStandardWorkloads(b *testing.B) {
+	cfg := simappConfig()
+	if *flagOutput != "" {
+		f, err := os.OpenFile(*flagOutput, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		require.NoError(b, err)
+		defer f.Close()
+		cfg.Output = f
+	}
+
+	for _, w := range benchmarks.StandardWorkloads() {
+		w := w
+		b.Run(w.Name, func(b *testing.B) {
+			benchmarks.Run(b, cfg, w)
+		})
+	}
+}
+
+func TestWorkloads(t *testing.T) {
+	for _, w := range []benchmarks.Workload{
+		benchmarks.BankSends(10),
+		benchmarks.Delegations(10),
+		benchmarks.GovTally(10),
+	} {
+		w := w
+		t.Run(w.Name, func(t *testing.T) {
+			var output bytes.Buffer
+			cfg := simappConfig()
+			cfg.Output = &output
+
+			var result benchmarks.Result
+			testing.Benchmark(func(b *testing.B) {
+				result = benchmarks.Run(b, cfg, w)
+			})
+			require.Positive(t, result.Blocks)
+			require.Equal(t, w.Name, result.Workload)
+
+			// the results are written as JSON lines
+			var written benchmarks.Result
+			lines := bytes.Split(bytes.TrimSpace(output.Bytes()), []byte("\n"))
+			require.NoError(t, json.Unmarshal(lines[len(lines)-1], &written))
+			require.Equal(t, result, written)
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package benchmarks
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Account is a genesis account of a workload, signing the txs of the workload.
+type Account struct {
+	PrivKey  cryptotypes.PrivKey
+	Address  sdk.AccAddress
+	Number   uint64
+	Sequence uint64
+}
+
+// Genesis is the genesis state of the app a workload runs against. It starts
+// from the default genesis state of the modules of the app, and the workloads
+// add their accounts, validators, delegations and proposals to the states of
+// the auth, bank, staking and gov modules. The keys of the accounts and the
+// validators are derived from their index, so that the workloads are
+// reproducible.
+type Genesis struct {
+	Time     time.Time
+	Accounts []*Account
+
+	Bank    *banktypes.GenesisState
+	Staking *stakingtypes.GenesisState
+	Gov     *govtypes.GenesisState
+
+	cdc      codec.JSONCodec
+	appState map[string]json.RawMessage
+	// indexes of the bank balances by address
+	balances map[string]int
+}
+
+// NewGenesis returns the default genesis state of the given modules.
+func NewGenesis(cdc codec.JSONCodec, moduleBasics module.BasicManager) *Genesis {
+	g := &Genesis{
+		Time:     time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		Bank:     &banktypes.GenesisState{},
+		Staking:  &stakingtypes.GenesisState{},
+		Gov:      &govtypes.GenesisState{},
+		cdc:      cdc,
+		appState: moduleBasics.DefaultGenesis(cdc),
+		balances: make(map[string]int),
+	}
+
+	cdc.MustUnmarshalJSON(g.appState[banktypes.ModuleName], g.Bank)
+	cdc.MustUnmarshalJSON(g.appState[stakingtypes.ModuleName], g.Staking)
+	cdc.MustUnmarshalJSON(g.appState[govtypes.ModuleName], g.Gov)
+	for i, balance := range g.Bank.Balances {
+		g.balances[balance.Address] = i
+	}
+
+	return g
+}
+
+// BondDenom returns the bond denom of the staking params.
+func (g *Genesis) BondDenom() string {
+	return g.Staking.Params.BondDenom
+}
+
+// AddAccounts adds n accounts holding the given coins.
+func (g *Genesis) AddAccounts(n int, coins sdk.Coins) []*Account {
+	accounts := make([]*Account, n)
+	for i := range accounts {
+		number := uint64(len(g.Accounts))
+		privKey := secp256k1.GenPrivKeyFromSecret([]byte(fmt.Sprintf("account-%d", number)))
+		acc := &Account{
+			PrivKey: privKey,
+			Address: sdk.AccAddress(privKey.PubKey().Address()),
+			Number:  number,
+		}
+
+		g.Accounts = append(g.Accounts, acc)
+		g.addBalance(acc.Address, coins)
+		accounts[i] = acc
+	}
+
+	return accounts
+}
+
+// AddValidator adds a bonded validator with the given tokens, self-delegated
+// by its operator.
+func (g *Genesis) AddValidator(tokens sdk.Int) (stakingtypes.Validator, error) {
+	privKey := ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("validator-%d", len(g.Staking.Validators))))
+	pkAny, err := codectypes.NewAnyWithValue(privKey.PubKey())
+	if err != nil {
+		return stakingtypes.Validator{}, err
+	}
+
+	operator := sdk.ValAddress(privKey.PubKey().Address())
+	validator := stakingtypes.Validator{
+		OperatorAddress:   operator.String(),
+		ConsensusPubkey:   pkAny,
+		Status:            stakingtypes.Bonded,
+		Tokens:            tokens,
+		DelegatorShares:   tokens.ToDec(),
+		UnbondingTime:     time.Unix(0, 0).UTC(),
+		Commission:        stakingtypes.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		MinSelfDelegation: sdk.ZeroInt(),
+	}
+
+	g.Staking.Validators = append(g.Staking.Validators, validator)
+	g.Staking.Delegations = append(g.Staking.Delegations, stakingtypes.NewDelegation(sdk.AccAddress(operator), operator, tokens.ToDec()))
+	g.addBalance(authtypes.NewModuleAddress(stakingtypes.BondedPoolName), sdk.NewCoins(sdk.NewCoin(g.BondDenom(), tokens)))
+
+	return validator, nil
+}
+
+// Delegate adds a delegation of the given tokens of the account to the
+// validator of the given index.
+func (g *Genesis) Delegate(acc *Account, validator int, tokens sdk.Int) {
+	val := &g.Staking.Validators[validator]
+	val.Tokens = val.Tokens.Add(tokens)
+	val.DelegatorShares = val.DelegatorShares.Add(tokens.ToDec())
+
+	g.Staking.Delegations = append(g.Staking.Delegations, stakingtypes.NewDelegation(acc.Address, val.GetOperator(), tokens.ToDec()))
+	g.addBalance(authtypes.NewModuleAddress(stakingtypes.BondedPoolName), sdk.NewCoins(sdk.NewCoin(g.BondDenom(), tokens)))
+}
+
+// AddVotingProposal adds a text proposal in voting period ending at the given
+// time, returning its ID.
+func (g *Genesis) AddVotingProposal(votingEndTime time.Time) (uint64, error) {
+	id := g.Gov.StartingProposalId
+	proposal, err := govtypes.NewProposal(govtypes.NewTextProposal("benchmark", "benchmark proposal"), id, g.Time, g.Time)
+	if err != nil {
+		return 0, err
+	}
+
+	proposal.Status = govtypes.StatusVotingPeriod
+	proposal.VotingStartTime = g.Time
+	proposal.VotingEndTime = votingEndTime
+
+	g.Gov.Proposals = append(g.Gov.Proposals, proposal)
+	g.Gov.StartingProposalId++
+
+	return id, nil
+}
+
+// Vote adds a vote of the account on the proposal of the given ID.
+func (g *Genesis) Vote(acc *Account, proposalID uint64, option govtypes.VoteOption) {
+	g.Gov.Votes = append(g.Gov.Votes, govtypes.NewVote(proposalID, acc.Address, govtypes.NewNonSplitVoteOption(option)))
+}
+
+// AppState returns the genesis state of the app, as given to InitChain.
+func (g *Genesis) AppState() ([]byte, error) {
+	genAccounts := make(authtypes.GenesisAccounts, len(g.Accounts))
+	for i, acc := range g.Accounts {
+		genAccounts[i] = authtypes.NewBaseAccount(acc.Address, acc.PrivKey.PubKey(), acc.Number, 0)
+	}
+
+	authGenesis := &authtypes.GenesisState{}
+	g.cdc.MustUnmarshalJSON(g.appState[authtypes.ModuleName], authGenesis)
+	authGenesis = authtypes.NewGenesisState(authGenesis.Params, genAccounts)
+
+	for name, state := range map[string]codec.ProtoMarshaler{
+		authtypes.ModuleName:    authGenesis,
+		banktypes.ModuleName:    g.Bank,
+		stakingtypes.ModuleName: g.Staking,
+		govtypes.ModuleName:     g.Gov,
+	} {
+		bz, err := g.cdc.MarshalJSON(state)
+		if err != nil {
+			return nil, err
+		}
+		g.appState[name] = bz
+	}
+
+	return json.Marshal(g.appState)
+}
+
+// addBalance adds the given coins to the balance of the address and to the
+// supply.
+func (g *Genesis) addBalance(addr sdk.AccAddress, coins sdk.Coins) {
+	g.Bank.Supply = g.Bank.Supply.Add(coins...)
+
+	if i, ok := g.balances[addr.String()]; ok {
+		g.Bank.Balances[i].Coins = g.Bank.Balances[i].Coins.Add(coins...)
+		return
+	}
+
+	g.balances[addr.String()] = len(g.Bank.Balances)
+	g.Bank.Balances = append(g.Bank.Balances, banktypes.Balance{Address: addr.String(), Coins: coins})
+}
@@ -0,0 +1,248 @@
+// Package benchmarks provides reproducible benchmark workloads, e.g. 10k bank
+// sends per block, run against any app through its ABCI interface and the
+// default genesis state of its modules, with results written in a
+// machine-readable format for regression tracking, e.g.
+//
+//	func BenchmarkWorkloads(b *testing.B) {
+//		cfg := benchmarks.Config{
+//			NewApp:       func() benchmarks.App { return newApp() },
+//			ModuleBasics: app.ModuleBasics,
+//			TxConfig:     encodingConfig.TxConfig,
+//			Output:       os.Stdout,
+//		}
+//		for _, w := range benchmarks.StandardWorkloads() {
+//			b.Run(w.Name, func(b *testing.B) { benchmarks.Run(b, cfg, w) })
+//		}
+//	}
+package benchmarks
+
+import (
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// App is the app the workloads run against.
+type App interface {
+	abci.Application
+
+	AppCodec() codec.Codec
+}
+
+// Config is the configuration of the runs of the workloads.
+type Config struct {
+	// NewApp returns a new app on an empty database.
+	NewApp func() App
+
+	// ModuleBasics are the modules of the app, providing the default genesis
+	// state the workloads add their state to.
+	ModuleBasics module.BasicManager
+
+	// TxConfig encodes and signs the txs of the workloads.
+	TxConfig client.TxConfig
+
+	// ChainID is the chain ID of the app, benchmarks by default.
+	ChainID string
+
+	// GasLimit is the gas limit of each tx, 1M by default.
+	GasLimit uint64
+
+	// Output receives a JSON Result per run, if not nil.
+	Output io.Writer
+}
+
+// Result is the result of the run of a workload.
+type Result struct {
+	Workload    string  `json:"workload"`
+	Blocks      int     `json:"blocks"`
+	Txs         int     `json:"txs"`
+	GasUsed     int64   `json:"gas_used"`
+	NsPerBlock  int64   `json:"ns_per_block"`
+	TxsPerSec   float64 `json:"txs_per_sec"`
+	GasPerBlock int64   `json:"gas_per_block"`
+}
+
+// consensusParams are the consensus params of the apps, without block gas
+// and size limits.
+var consensusParams = &abci.ConsensusParams{
+	Block: &abci.BlockParams{
+		MaxBytes: -1,
+		MaxGas:   -1,
+	},
+	Evidence: &tmproto.EvidenceParams{
+		MaxAgeNumBlocks: 302400,
+		MaxAgeDuration:  504 * time.Hour,
+		MaxBytes:        10000,
+	},
+	Validator: &tmproto.ValidatorParams{
+		PubKeyTypes: []string{tmtypes.ABCIPubKeyTypeEd25519},
+	},
+}
+
+// Run runs b.N measured blocks of the workload against the app of the config,
+// failing the benchmark if a tx of the workload fails. Only the BeginBlock,
+// DeliverTx, EndBlock and Commit calls of the measured blocks are timed. The
+// result is reported as custom benchmark metrics, and written to the output of
+// the config.
+func Run(b *testing.B, cfg Config, w Workload) Result {
+	b.Helper()
+
+	if cfg.ChainID == "" {
+		cfg.ChainID = "benchmarks"
+	}
+	if cfg.GasLimit == 0 {
+		cfg.GasLimit = 1000000
+	}
+
+	b.StopTimer()
+	app := cfg.NewApp()
+	g := NewGenesis(app.AppCodec(), cfg.ModuleBasics)
+	if err := w.Genesis(g); err != nil {
+		b.Fatalf("failed to generate the genesis state of %s: %v", w.Name, err)
+	}
+	appState, err := g.AppState()
+	if err != nil {
+		b.Fatalf("failed to marshal the genesis state of %s: %v", w.Name, err)
+	}
+
+	initChain := func(app App) {
+		for _, acc := range g.Accounts {
+			acc.Sequence = 0
+		}
+
+		app.InitChain(abci.RequestInitChain{
+			Time:            g.Time,
+			ChainId:         cfg.ChainID,
+			ConsensusParams: consensusParams,
+			AppStateBytes:   appState,
+		})
+		app.Commit()
+	}
+	initChain(app)
+
+	result := Result{Workload: w.Name}
+	var elapsed time.Duration
+	for n := 0; n < b.N; n++ {
+		height := int64(n) + 2
+		if w.Reinit {
+			if n > 0 {
+				app = cfg.NewApp()
+				initChain(app)
+			}
+			height = 2
+		}
+
+		txs, err := w.Block(g, n)
+		if err != nil {
+			b.Fatalf("failed to generate block %d of %s: %v", n, w.Name, err)
+		}
+		txsBytes := make([][]byte, len(txs))
+		for i, tx := range txs {
+			if txsBytes[i], err = signTx(cfg, tx); err != nil {
+				b.Fatalf("failed to sign tx of %s: %v", w.Name, err)
+			}
+			tx.Signer.Sequence++
+		}
+
+		header := tmproto.Header{
+			ChainID: cfg.ChainID,
+			Height:  height,
+			Time:    g.Time.Add(time.Duration(height) * 5 * time.Second),
+		}
+
+		start := time.Now()
+		b.StartTimer()
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		for _, bz := range txsBytes {
+			res := app.DeliverTx(abci.RequestDeliverTx{Tx: bz})
+			if !res.IsOK() {
+				b.Fatalf("tx of %s failed: %s", w.Name, res.Log)
+			}
+			result.GasUsed += res.GasUsed
+		}
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+		b.StopTimer()
+		elapsed += time.Since(start)
+
+		result.Blocks++
+		result.Txs += len(txs)
+	}
+
+	result.NsPerBlock = elapsed.Nanoseconds() / int64(result.Blocks)
+	result.GasPerBlock = result.GasUsed / int64(result.Blocks)
+	if elapsed > 0 {
+		result.TxsPerSec = float64(result.Txs) / elapsed.Seconds()
+	}
+
+	b.ReportMetric(result.TxsPerSec, "txs/s")
+	b.ReportMetric(float64(result.GasPerBlock), "gas/block")
+
+	if cfg.Output != nil {
+		if err := WriteResult(cfg.Output, result); err != nil {
+			b.Fatalf("failed to write the result of %s: %v", w.Name, err)
+		}
+	}
+
+	return result
+}
+
+// WriteResult writes the result as a line of JSON.
+func WriteResult(w io.Writer, result Result) error {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(append(bz, '\n'))
+	return err
+}
+
+// signTx returns the encoded tx signed by its signer.
+func signTx(cfg Config, tx Tx) ([]byte, error) {
+	builder := cfg.TxConfig.NewTxBuilder()
+	if err := builder.SetMsgs(tx.Msgs...); err != nil {
+		return nil, err
+	}
+	builder.SetGasLimit(cfg.GasLimit)
+
+	signMode := cfg.TxConfig.SignModeHandler().DefaultMode()
+	sig := signing.SignatureV2{
+		PubKey:   tx.Signer.PrivKey.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: tx.Signer.Sequence,
+	}
+	if err := builder.SetSignatures(sig); err != nil {
+		return nil, err
+	}
+
+	signerData := authsigning.SignerData{
+		Address:       tx.Signer.Address.String(),
+		ChainID:       cfg.ChainID,
+		AccountNumber: tx.Signer.Number,
+		Sequence:      tx.Signer.Sequence,
+	}
+	signBytes, err := cfg.TxConfig.SignModeHandler().GetSignBytes(signMode, signerData, builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	if sig.Data.(*signing.SingleSignatureData).Signature, err = tx.Signer.PrivKey.Sign(signBytes); err != nil {
+		return nil, err
+	}
+	if err := builder.SetSignatures(sig); err != nil {
+		return nil, err
+	}
+
+	return cfg.TxConfig.TxEncoder()(builder.GetTx())
+}
@@ -0,0 +1,150 @@
+package benchmarks
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Tx is a tx of a workload, signed by the runner with the key of its signer.
+type Tx struct {
+	Signer *Account
+	Msgs   []sdk.Msg
+}
+
+// Workload is a reproducible benchmark workload, run block by block against an
+// app.
+type Workload struct {
+	// Name identifies the workload in the results.
+	Name string
+
+	// Genesis adds the accounts and the state of the workload to the genesis
+	// state of the app.
+	Genesis func(g *Genesis) error
+
+	// Block returns the txs of the n-th measured block, counting from zero.
+	Block func(g *Genesis, n int) ([]Tx, error)
+
+	// Reinit runs each measured block on a new app initialized with the
+	// genesis state, e.g. for the workloads whose blocks consume their genesis
+	// state.
+	Reinit bool
+}
+
+// StandardWorkloads returns the standard workloads tracked for regressions:
+// 10k bank sends per block, 1k delegations per block and the gov tally of a
+// proposal with 100k votes.
+func StandardWorkloads() []Workload {
+	return []Workload{
+		BankSends(10000),
+		Delegations(1000),
+		GovTally(100000),
+	}
+}
+
+// workloadValidatorTokens are the tokens of the validator of the workloads.
+var workloadValidatorTokens = sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+
+// BankSends returns the workload of the given number of MsgSend per block,
+// each one sent by a different account.
+func BankSends(txsPerBlock int) Workload {
+	var senders []*Account
+
+	return Workload{
+		Name: fmt.Sprintf("bank_sends_%d", txsPerBlock),
+		Genesis: func(g *Genesis) error {
+			if _, err := g.AddValidator(workloadValidatorTokens); err != nil {
+				return err
+			}
+
+			senders = g.AddAccounts(txsPerBlock, sdk.NewCoins(sdk.NewInt64Coin(g.BondDenom(), 1000000000)))
+			return nil
+		},
+		Block: func(g *Genesis, _ int) ([]Tx, error) {
+			txs := make([]Tx, len(senders))
+			for i, sender := range senders {
+				to := senders[(i+1)%len(senders)]
+				txs[i] = Tx{
+					Signer: sender,
+					Msgs:   []sdk.Msg{banktypes.NewMsgSend(sender.Address, to.Address, sdk.NewCoins(sdk.NewInt64Coin(g.BondDenom(), 1)))},
+				}
+			}
+
+			return txs, nil
+		},
+	}
+}
+
+// Delegations returns the workload of the given number of MsgDelegate per
+// block, each one sent by a different account to the same validator.
+func Delegations(txsPerBlock int) Workload {
+	var (
+		delegators []*Account
+		validator  stakingtypes.Validator
+	)
+
+	return Workload{
+		Name: fmt.Sprintf("delegations_%d", txsPerBlock),
+		Genesis: func(g *Genesis) (err error) {
+			validator, err = g.AddValidator(workloadValidatorTokens)
+			if err != nil {
+				return err
+			}
+
+			delegators = g.AddAccounts(txsPerBlock, sdk.NewCoins(sdk.NewInt64Coin(g.BondDenom(), 1000000000)))
+			return nil
+		},
+		Block: func(g *Genesis, _ int) ([]Tx, error) {
+			txs := make([]Tx, len(delegators))
+			for i, delegator := range delegators {
+				txs[i] = Tx{
+					Signer: delegator,
+					Msgs:   []sdk.Msg{stakingtypes.NewMsgDelegate(delegator.Address, validator.GetOperator(), sdk.NewInt64Coin(g.BondDenom(), 1000))},
+				}
+			}
+
+			return txs, nil
+		},
+	}
+}
+
+// GovTally returns the workload of the tally of a proposal with the given
+// number of votes, cast by as many delegators, at the end of the first block.
+func GovTally(votes int) Workload {
+	options := []govtypes.VoteOption{
+		govtypes.OptionYes,
+		govtypes.OptionNo,
+		govtypes.OptionAbstain,
+		govtypes.OptionNoWithVeto,
+	}
+
+	return Workload{
+		Name: fmt.Sprintf("gov_tally_%d", votes),
+		Genesis: func(g *Genesis) error {
+			if _, err := g.AddValidator(workloadValidatorTokens); err != nil {
+				return err
+			}
+
+			proposalID, err := g.AddVotingProposal(g.Time.Add(time.Second))
+			if err != nil {
+				return err
+			}
+
+			voters := g.AddAccounts(votes, sdk.NewCoins(sdk.NewInt64Coin(g.BondDenom(), 1000000)))
+			for i, voter := range voters {
+				g.Delegate(voter, 0, sdk.NewInt(1000000))
+				g.Vote(voter, proposalID, options[i%len(options)])
+			}
+
+			return nil
+		},
+		Block: func(*Genesis, int) ([]Tx, error) {
+			return nil, nil
+		},
+		Reinit: true,
+	}
+}
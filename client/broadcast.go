@@ -20,7 +20,13 @@ import (
 // based on the context parameters. The result of the broadcast is parsed into
 // an intermediate structure which is logged if the context has a logger
 // defined.
+//
+// If the context has a genesis hash, the genesis of the node is verified first.
 func (ctx Context) BroadcastTx(txBytes []byte) (res *sdk.TxResponse, err error) {
+	if err := ctx.VerifyGenesisHash(); err != nil {
+		return nil, err
+	}
+
 	switch ctx.BroadcastMode {
 	case flags.BroadcastSync:
 		res, err = ctx.BroadcastTxSync(txBytes)
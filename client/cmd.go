@@ -1,6 +1,7 @@
 package client
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strings"
 
@@ -171,7 +172,38 @@ func readQueryCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context,
 		clientCtx = clientCtx.WithUseLedger(useLedger)
 	}
 
-	return ReadPersistentCommandFlags(clientCtx, flagSet)
+	clientCtx, err := ReadPersistentCommandFlags(clientCtx, flagSet)
+	if err != nil {
+		return clientCtx, err
+	}
+
+	if clientCtx.Verifier == nil || flagSet.Changed(flags.FlagTrustHash) {
+		trustHash, _ := flagSet.GetString(flags.FlagTrustHash)
+		if trustHash != "" {
+			hash, err := hex.DecodeString(trustHash)
+			if err != nil {
+				return clientCtx, fmt.Errorf("invalid trust hash: %w", err)
+			}
+
+			trustHeight, _ := flagSet.GetInt64(flags.FlagTrustHeight)
+			trustPeriod, _ := flagSet.GetDuration(flags.FlagTrustPeriod)
+			witnesses, _ := flagSet.GetStringSlice(flags.FlagWitnesses)
+
+			verifier, err := NewVerifier(clientCtx.HomeDir, clientCtx.ChainID, clientCtx.NodeURI, VerifierOptions{
+				TrustHeight: trustHeight,
+				TrustHash:   hash,
+				TrustPeriod: trustPeriod,
+				Witnesses:   witnesses,
+			})
+			if err != nil {
+				return clientCtx, err
+			}
+
+			clientCtx = clientCtx.WithVerifier(verifier)
+		}
+	}
+
+	return clientCtx, nil
 }
 
 // readTxCommandFlags returns an updated Context with fields set based on flags
@@ -190,6 +222,18 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		return clientCtx, err
 	}
 
+	if clientCtx.GenesisHash == nil || flagSet.Changed(flags.FlagGenesisHash) {
+		genesisHash, _ := flagSet.GetString(flags.FlagGenesisHash)
+		if genesisHash != "" {
+			hash, err := hex.DecodeString(genesisHash)
+			if err != nil {
+				return clientCtx, fmt.Errorf("invalid genesis hash: %w", err)
+			}
+
+			clientCtx = clientCtx.WithGenesisHash(hash)
+		}
+	}
+
 	if !clientCtx.GenerateOnly || flagSet.Changed(flags.FlagGenerateOnly) {
 		genOnly, _ := flagSet.GetBool(flags.FlagGenerateOnly)
 		clientCtx = clientCtx.WithGenerateOnly(genOnly)
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
@@ -58,6 +59,8 @@ func runConfigCmd(cmd *cobra.Command, args []string) error {
 			cmd.Println(conf.Node)
 		case flags.FlagBroadcastMode:
 			cmd.Println(conf.BroadcastMode)
+		case flags.FlagGenesisHash:
+			cmd.Println(conf.GenesisHash)
 		default:
 			err := errUnknownConfigKey(key)
 			return fmt.Errorf("couldn't get the value for the key: %v, error:  %v", key, err)
@@ -78,6 +81,11 @@ func runConfigCmd(cmd *cobra.Command, args []string) error {
 			conf.SetNode(value)
 		case flags.FlagBroadcastMode:
 			conf.SetBroadcastMode(value)
+		case flags.FlagGenesisHash:
+			if _, err := hex.DecodeString(value); err != nil {
+				return fmt.Errorf("invalid genesis hash: %v", err)
+			}
+			conf.SetGenesisHash(value)
 		default:
 			return errUnknownConfigKey(key)
 		}
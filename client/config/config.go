@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -15,6 +16,7 @@ const (
 	output         = "text"
 	node           = "tcp://localhost:26657"
 	broadcastMode  = "sync"
+	genesisHash    = ""
 )
 
 type ClientConfig struct {
@@ -23,11 +25,12 @@ type ClientConfig struct {
 	Output         string `mapstructure:"output" json:"output"`
 	Node           string `mapstructure:"node" json:"node"`
 	BroadcastMode  string `mapstructure:"broadcast-mode" json:"broadcast-mode"`
+	GenesisHash    string `mapstructure:"genesis-hash" json:"genesis-hash"`
 }
 
 // defaultClientConfig returns the reference to ClientConfig with default values.
 func defaultClientConfig() *ClientConfig {
-	return &ClientConfig{chainID, keyringBackend, output, node, broadcastMode}
+	return &ClientConfig{chainID, keyringBackend, output, node, broadcastMode, genesisHash}
 }
 
 func (c *ClientConfig) SetChainID(chainID string) {
@@ -50,6 +53,10 @@ func (c *ClientConfig) SetBroadcastMode(broadcastMode string) {
 	c.BroadcastMode = broadcastMode
 }
 
+func (c *ClientConfig) SetGenesisHash(genesisHash string) {
+	c.GenesisHash = genesisHash
+}
+
 // ReadFromClientConfig reads values from client.toml file and updates them in client Context
 func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 	configPath := filepath.Join(ctx.HomeDir, "config")
@@ -89,9 +96,18 @@ func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 		return ctx, fmt.Errorf("couldn't get client from nodeURI: %v", err)
 	}
 
+	genesisHash, err := hex.DecodeString(conf.GenesisHash)
+	if err != nil {
+		return ctx, fmt.Errorf("invalid genesis hash: %v", err)
+	}
+
 	ctx = ctx.WithNodeURI(conf.Node).
 		WithClient(client).
 		WithBroadcastMode(conf.BroadcastMode)
 
+	if len(genesisHash) != 0 {
+		ctx = ctx.WithGenesisHash(genesisHash)
+	}
+
 	return ctx, nil
 }
@@ -63,6 +63,26 @@ func TestConfigCmd(t *testing.T) {
 	require.Equal(t, string(out), testNode1+"\n")
 }
 
+func TestConfigCmdGenesisHash(t *testing.T) {
+	clientCtx, cleanup := initClientContext(t, "")
+	defer cleanup()
+
+	cmd := config.Cmd()
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{flags.FlagGenesisHash, "not hex"})
+	require.Error(t, err)
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{flags.FlagGenesisHash, "ABCD"})
+	require.NoError(t, err)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, []string{flags.FlagGenesisHash})
+	require.NoError(t, err)
+	require.Equal(t, "ABCD\n", out.String())
+
+	clientCtx, err = config.ReadFromClientConfig(clientCtx)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xAB, 0xCD}, clientCtx.GenesisHash)
+}
+
 func TestConfigCmdEnvFlag(t *testing.T) {
 	const (
 		defaultNode = "http://localhost:26657"
@@ -25,6 +25,9 @@ output = "{{ .Output }}"
 node = "{{ .Node }}"
 # Transaction broadcasting mode (sync|async|block)
 broadcast-mode = "{{ .BroadcastMode }}"
+# Hex encoded SHA-256 hash of the genesis of the network; if set, the genesis of the
+# node is verified before broadcasting transactions
+genesis-hash = "{{ .GenesisHash }}"
 `
 
 // writeConfigToFile parses defaultConfigTemplate, renders config using the template and writes it to
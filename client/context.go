@@ -2,6 +2,7 @@ package client
 
 import (
 	"bufio"
+	"encoding/json"
 	"io"
 	"os"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -48,11 +50,29 @@ type Context struct {
 	NodeURI           string
 	FeeGranter        sdk.AccAddress
 	Viper             *viper.Viper
+	Verifier          Verifier
+	GenesisHash       []byte
 
 	// TODO: Deprecated (remove).
 	LegacyAmino *codec.LegacyAmino
 }
 
+// WithVerifier returns a copy of the context with an updated light client
+// verifier. When set, store queries are requested with proofs which are
+// verified against the headers trusted by the verifier.
+func (ctx Context) WithVerifier(verifier Verifier) Context {
+	ctx.Verifier = verifier
+	return ctx
+}
+
+// WithGenesisHash returns a copy of the context with an updated genesis hash.
+// When set, the genesis of the node is verified to have this hash before
+// broadcasting transactions, see VerifyGenesisHash.
+func (ctx Context) WithGenesisHash(hash []byte) Context {
+	ctx.GenesisHash = hash
+	return ctx
+}
+
 // WithKeyring returns a copy of the context with an updated keyring.
 func (ctx Context) WithKeyring(k keyring.Keyring) Context {
 	ctx.Keyring = k
@@ -255,8 +275,9 @@ func (ctx Context) PrintBytes(o []byte) error {
 }
 
 // PrintProto outputs toPrint to the ctx.Output based on ctx.OutputFormat which is
-// either text or json. If text, toPrint will be YAML encoded. Otherwise, toPrint
-// will be JSON encoded using ctx.Codec. An error is returned upon failure.
+// either text, json or canonical-json. If text, toPrint will be YAML encoded.
+// Otherwise, toPrint will be JSON encoded using ctx.Codec, canonicalized for
+// canonical-json. An error is returned upon failure.
 func (ctx Context) PrintProto(toPrint proto.Message) error {
 	// always serialize JSON initially because proto json can't be directly YAML encoded
 	out, err := ctx.Codec.MarshalJSON(toPrint)
@@ -277,13 +298,26 @@ func (ctx Context) PrintObjectLegacy(toPrint interface{}) error {
 	return ctx.printOutput(out)
 }
 
+// PrintRaw is a variant of PrintProto printing an already JSON encoded value
+// based on ctx.OutputFormat.
+func (ctx Context) PrintRaw(toPrint json.RawMessage) error {
+	return ctx.printOutput(toPrint)
+}
+
 func (ctx Context) printOutput(out []byte) error {
 	var err error
-	if ctx.OutputFormat == "text" {
+	switch ctx.OutputFormat {
+	case "text":
 		out, err = yaml.JSONToYAML(out)
 		if err != nil {
 			return err
 		}
+
+	case flags.OutputFormatCanonicalJSON:
+		out, err = codec.CanonicalJSON(out)
+		if err != nil {
+			return err
+		}
 	}
 
 	writer := ctx.Output
@@ -67,6 +67,16 @@ func TestContext_PrintObject(t *testing.T) {
 x: "10"
 `, buf.String())
 
+	// canonical json
+	buf = &bytes.Buffer{}
+	ctx = ctx.WithOutput(buf)
+	ctx.OutputFormat = flags.OutputFormatCanonicalJSON
+	err = ctx.PrintProto(hasAnimal)
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"animal":{"@type":"/testdata.Dog","name":"Spot","size":"big"},"x":"10"}
+`, buf.String())
+
 	//
 	// amino
 	//
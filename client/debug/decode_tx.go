@@ -0,0 +1,279 @@
+package debug
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+const flagSignDoc = "sign-doc"
+
+// DecodedTx is the output of the decode-tx command, describing a tx or the
+// SignDoc of a tx. The chain ID and account number are only set for a SignDoc.
+type DecodedTx struct {
+	ChainID       string             `json:"chain_id,omitempty"`
+	AccountNumber string             `json:"account_number,omitempty"`
+	Messages      []json.RawMessage  `json:"messages"`
+	Memo          string             `json:"memo"`
+	TimeoutHeight string             `json:"timeout_height"`
+	Signers       []string           `json:"signers"`
+	Fee           json.RawMessage    `json:"fee"`
+	Tip           json.RawMessage    `json:"tip,omitempty"`
+	Signatures    []DecodedSignature `json:"signatures"`
+}
+
+// DecodedSignature describes the signature of a signer of a decoded tx. The
+// signature is empty for a SignDoc.
+type DecodedSignature struct {
+	Signer    string          `json:"signer"`
+	PubKey    json.RawMessage `json:"pub_key"`
+	ModeInfo  json.RawMessage `json:"mode_info"`
+	Sequence  string          `json:"sequence"`
+	Signature []byte          `json:"signature,omitempty"`
+}
+
+// protoTxProvider is implemented by the txs decoded by the protobuf tx config.
+type protoTxProvider interface {
+	GetProtoTx() *txtypes.Tx
+}
+
+// DecodeTxCmd decodes the raw bytes of a tx, or of the SignDoc of a tx, with
+// the codec of the app.
+func DecodeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-tx [base64|hex|file]",
+		Short: "Decode the raw bytes of a tx or SignDoc",
+		Long: fmt.Sprintf(`Decode the raw bytes of a tx, or of the SignDoc signed with SIGN_MODE_DIRECT,
+given in hex or base64, or as the path of a file holding the hex, base64 or raw
+bytes, and print its messages, signers, fees and signatures.
+
+The bytes are decoded as a tx, or else as a SignDoc, unless --sign-doc is set.
+As the SignDoc of an account number 0 is also decoded as a tx, --sign-doc must
+be set to decode it.
+
+Example:
+$ %s debug decode-tx CpIBCo8BChwvY29zbW9zLmJhbmsudjFiZXRhMS5Nc2dTZW5k...
+$ %s debug decode-tx signdoc.bin --sign-doc
+			`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.ReadPersistentCommandFlags(client.GetClientContextFromCmd(cmd), cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			bz, err := readTxBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			var decoded DecodedTx
+			if signDoc, _ := cmd.Flags().GetBool(flagSignDoc); signDoc {
+				decoded, err = decodeSignDoc(clientCtx, bz)
+			} else {
+				decoded, err = decodeTxOrSignDoc(clientCtx, bz)
+			}
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(decoded)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	cmd.Flags().Bool(flagSignDoc, false, "Decode the bytes as a SignDoc")
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json|canonical-json)")
+
+	return cmd
+}
+
+// readTxBytes returns the bytes of the given hex or base64 string, or of the
+// file at the given path, whose content is either hex, base64 or raw bytes.
+func readTxBytes(arg string) ([]byte, error) {
+	if _, err := os.Stat(arg); err == nil {
+		bz, err := os.ReadFile(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		if decoded, err := decodeString(strings.TrimSpace(string(bz))); err == nil {
+			return decoded, nil
+		}
+
+		return bz, nil
+	}
+
+	bz, err := decodeString(arg)
+	if err != nil {
+		return nil, fmt.Errorf("expected hex, base64 or the path of a file: %s", arg)
+	}
+
+	return bz, nil
+}
+
+func decodeString(s string) ([]byte, error) {
+	if s == "" {
+		return nil, errors.New("empty string")
+	}
+
+	if bz, err := hex.DecodeString(s); err == nil {
+		return bz, nil
+	}
+
+	return base64.StdEncoding.DecodeString(s)
+}
+
+// decodeTxOrSignDoc decodes the bytes of a tx, or else of a SignDoc.
+func decodeTxOrSignDoc(clientCtx client.Context, bz []byte) (DecodedTx, error) {
+	decoded, err := decodeTx(clientCtx, bz)
+	if err == nil {
+		return decoded, nil
+	}
+
+	decoded, signDocErr := decodeSignDoc(clientCtx, bz)
+	if signDocErr != nil {
+		return DecodedTx{}, fmt.Errorf("failed to decode the bytes as a tx: %s; or as a SignDoc: %s", err, signDocErr)
+	}
+
+	return decoded, nil
+}
+
+// decodeTx decodes the bytes of a tx.
+func decodeTx(clientCtx client.Context, bz []byte) (DecodedTx, error) {
+	tx, err := clientCtx.TxConfig.TxDecoder()(bz)
+	if err != nil {
+		return DecodedTx{}, err
+	}
+
+	return newDecodedTx(clientCtx, tx)
+}
+
+// decodeSignDoc decodes the bytes of a SignDoc, whose body and auth info are
+// decoded as those of a tx.
+func decodeSignDoc(clientCtx client.Context, bz []byte) (DecodedTx, error) {
+	var signDoc txtypes.SignDoc
+	if err := unknownproto.RejectUnknownFieldsStrict(bz, &signDoc, clientCtx.InterfaceRegistry); err != nil {
+		return DecodedTx{}, err
+	}
+	if err := clientCtx.Codec.Unmarshal(bz, &signDoc); err != nil {
+		return DecodedTx{}, err
+	}
+
+	txBytes, err := clientCtx.Codec.Marshal(&txtypes.TxRaw{
+		BodyBytes:     signDoc.BodyBytes,
+		AuthInfoBytes: signDoc.AuthInfoBytes,
+	})
+	if err != nil {
+		return DecodedTx{}, err
+	}
+
+	decoded, err := decodeTx(clientCtx, txBytes)
+	if err != nil {
+		return DecodedTx{}, err
+	}
+
+	decoded.ChainID = signDoc.ChainId
+	decoded.AccountNumber = strconv.FormatUint(signDoc.AccountNumber, 10)
+
+	return decoded, nil
+}
+
+// newDecodedTx describes the given decoded tx.
+func newDecodedTx(clientCtx client.Context, tx sdk.Tx) (DecodedTx, error) {
+	provider, ok := tx.(protoTxProvider)
+	if !ok {
+		return DecodedTx{}, fmt.Errorf("expected a protobuf tx, got %T", tx)
+	}
+	protoTx := provider.GetProtoTx()
+
+	decoded := DecodedTx{
+		Messages:      []json.RawMessage{},
+		Memo:          protoTx.Body.Memo,
+		TimeoutHeight: strconv.FormatUint(protoTx.Body.TimeoutHeight, 10),
+		Signers:       []string{},
+		Signatures:    []DecodedSignature{},
+	}
+
+	for _, msg := range protoTx.Body.Messages {
+		bz, err := clientCtx.Codec.MarshalJSON(msg)
+		if err != nil {
+			return DecodedTx{}, err
+		}
+		decoded.Messages = append(decoded.Messages, bz)
+	}
+
+	var signers []sdk.AccAddress
+	if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+		signers = sigTx.GetSigners()
+	}
+	for _, signer := range signers {
+		decoded.Signers = append(decoded.Signers, signer.String())
+	}
+
+	var err error
+	decoded.Fee = json.RawMessage("null")
+	if protoTx.AuthInfo.Fee != nil {
+		if decoded.Fee, err = clientCtx.Codec.MarshalJSON(protoTx.AuthInfo.Fee); err != nil {
+			return DecodedTx{}, err
+		}
+	}
+
+	if protoTx.AuthInfo.Tip != nil {
+		if decoded.Tip, err = clientCtx.Codec.MarshalJSON(protoTx.AuthInfo.Tip); err != nil {
+			return DecodedTx{}, err
+		}
+	}
+
+	for i, signerInfo := range protoTx.AuthInfo.SignerInfos {
+		sig := DecodedSignature{
+			PubKey:   json.RawMessage("null"),
+			ModeInfo: json.RawMessage("null"),
+			Sequence: strconv.FormatUint(signerInfo.Sequence, 10),
+		}
+
+		// the signer infos are ordered as the signers of the tx
+		if i < len(signers) {
+			sig.Signer = signers[i].String()
+		}
+
+		if signerInfo.PublicKey != nil {
+			if sig.PubKey, err = clientCtx.Codec.MarshalJSON(signerInfo.PublicKey); err != nil {
+				return DecodedTx{}, err
+			}
+		}
+
+		if signerInfo.ModeInfo != nil {
+			if sig.ModeInfo, err = clientCtx.Codec.MarshalJSON(signerInfo.ModeInfo); err != nil {
+				return DecodedTx{}, err
+			}
+		}
+
+		if i < len(protoTx.Signatures) {
+			sig.Signature = protoTx.Signatures[i]
+		}
+
+		decoded.Signatures = append(decoded.Signatures, sig)
+	}
+
+	return decoded, nil
+}
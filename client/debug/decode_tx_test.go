@@ -0,0 +1,114 @@
+package debug_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestDecodeTxCmd(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithTxConfig(encCfg.TxConfig)
+
+	privKey := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(200000)
+	txBuilder.SetMemo("memo")
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   privKey.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte("signature")},
+		Sequence: 7,
+	}))
+	txBytes, err := encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	var txRaw txtypes.TxRaw
+	require.NoError(t, encCfg.Codec.Unmarshal(txBytes, &txRaw))
+	signDocBytes, err := encCfg.Codec.Marshal(&txtypes.SignDoc{
+		BodyBytes:     txRaw.BodyBytes,
+		AuthInfoBytes: txRaw.AuthInfoBytes,
+		ChainId:       "test-chain",
+		AccountNumber: 3,
+	})
+	require.NoError(t, err)
+
+	rawFile := filepath.Join(t.TempDir(), "tx.bin")
+	require.NoError(t, os.WriteFile(rawFile, txBytes, 0o600))
+	hexFile := filepath.Join(t.TempDir(), "tx.txt")
+	require.NoError(t, os.WriteFile(hexFile, []byte(hex.EncodeToString(txBytes)+"\n"), 0o600))
+
+	testCases := []struct {
+		name       string
+		args       []string
+		expErr     bool
+		expSignDoc bool
+	}{
+		{"base64 tx", []string{base64.StdEncoding.EncodeToString(txBytes)}, false, false},
+		{"hex tx", []string{hex.EncodeToString(txBytes)}, false, false},
+		{"raw tx file", []string{rawFile}, false, false},
+		{"hex tx file", []string{hexFile}, false, false},
+		{"sign doc", []string{base64.StdEncoding.EncodeToString(signDocBytes)}, false, true},
+		{"explicit sign doc", []string{hex.EncodeToString(signDocBytes), "--sign-doc"}, false, true},
+		{"invalid bytes", []string{hex.EncodeToString([]byte("invalid"))}, true, false},
+		{"invalid encoding", []string{"invalid!"}, true, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			args := append(tc.args, fmt.Sprintf("--%s=json", tmcli.OutputFlag))
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, debug.DecodeTxCmd(), args)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var decoded debug.DecodedTx
+			require.NoError(t, json.Unmarshal(out.Bytes(), &decoded), out.String())
+			require.Len(t, decoded.Messages, 1)
+			require.Contains(t, string(decoded.Messages[0]), "/cosmos.bank.v1beta1.MsgSend")
+			require.Equal(t, "memo", decoded.Memo)
+			require.Equal(t, []string{addr.String()}, decoded.Signers)
+			require.JSONEq(t, `{"amount":[{"denom":"stake","amount":"10"}],"gas_limit":"200000","payer":"","granter":""}`, string(decoded.Fee))
+			require.Len(t, decoded.Signatures, 1)
+			require.Equal(t, addr.String(), decoded.Signatures[0].Signer)
+			require.Equal(t, "7", decoded.Signatures[0].Sequence)
+			require.JSONEq(t, `{"single":{"mode":"SIGN_MODE_DIRECT"}}`, string(decoded.Signatures[0].ModeInfo))
+
+			if tc.expSignDoc {
+				require.Equal(t, "test-chain", decoded.ChainID)
+				require.Equal(t, "3", decoded.AccountNumber)
+				require.Empty(t, decoded.Signatures[0].Signature)
+			} else {
+				require.Empty(t, decoded.ChainID)
+				require.Equal(t, []byte("signature"), decoded.Signatures[0].Signature)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(PubkeyCmd())
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
+	cmd.AddCommand(DecodeTxCmd())
 
 	return cmd
 }
@@ -129,3 +130,25 @@ $ %s debug raw-bytes [72 101 108 108 111 44 32 112 108 97 121 103 114 111 117 11
 		},
 	}
 }
+
+// TxMiddlewaresCmd prints the names of the middlewares of the app tx handler,
+// from outer to inner.
+func TxMiddlewaresCmd(names []string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tx-middlewares",
+		Short: "Print the effective middleware chain of the tx handler",
+		Long: fmt.Sprintf(`Print the names of the middlewares of the tx handler, from the outermost,
+which runs first, to the innermost.
+
+Example:
+$ %s debug tx-middlewares
+			`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			for i, name := range names {
+				cmd.Printf("%d. %s\n", i+1, name)
+			}
+			return nil
+		},
+	}
+}
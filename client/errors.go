@@ -0,0 +1,127 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Exit codes of the CLI, which let scripts tell apart the failures of a
+// command.
+const (
+	// ExitCodeFailure is the exit code of a command which failed before
+	// broadcasting a tx, e.g. on an invalid argument or a failed query.
+	ExitCodeFailure = 1
+	// ExitCodeBroadcastFailed is the exit code of a command which couldn't
+	// broadcast its tx, e.g. because the node is unreachable.
+	ExitCodeBroadcastFailed = 2
+	// ExitCodeTxFailed is the exit code of a command whose tx was broadcast
+	// but failed with a non-zero code, i.e. was rejected by the node or, in
+	// block mode, failed in the block.
+	ExitCodeTxFailed = 3
+)
+
+// BroadcastError is the error of a tx which couldn't be broadcast.
+type BroadcastError struct {
+	Err error
+}
+
+func (e BroadcastError) Error() string {
+	return fmt.Sprintf("failed to broadcast tx: %s", e.Err)
+}
+
+// Unwrap returns the error which prevented the broadcast.
+func (e BroadcastError) Unwrap() error {
+	return e.Err
+}
+
+// Cause returns the error which prevented the broadcast, which lets
+// sdkerrors.ABCIInfo find its ABCI code.
+func (e BroadcastError) Cause() error {
+	return e.Err
+}
+
+// ExitCode returns ExitCodeBroadcastFailed.
+func (BroadcastError) ExitCode() int {
+	return ExitCodeBroadcastFailed
+}
+
+// TxError is the error of a tx which was broadcast but failed with a non-zero
+// code.
+type TxError struct {
+	Response *sdk.TxResponse
+}
+
+func (e TxError) Error() string {
+	return fmt.Sprintf("tx %s failed with code %d in codespace %s: %s",
+		e.Response.TxHash, e.Response.Code, e.Response.Codespace, e.Response.RawLog)
+}
+
+// ABCICode returns the code of the tx response.
+func (e TxError) ABCICode() uint32 {
+	return e.Response.Code
+}
+
+// Codespace returns the codespace of the tx response.
+func (e TxError) Codespace() string {
+	return e.Response.Codespace
+}
+
+// ExitCode returns ExitCodeTxFailed.
+func (TxError) ExitCode() int {
+	return ExitCodeTxFailed
+}
+
+// CheckTxResponse returns a TxError if the tx of the given response failed.
+func CheckTxResponse(res *sdk.TxResponse) error {
+	if res == nil || res.Code == 0 {
+		return nil
+	}
+
+	return TxError{Response: res}
+}
+
+// ExitCode returns the exit code of a command which failed with the given
+// error, which is ExitCodeFailure unless the error, or an error it wraps, has
+// an ExitCode method.
+func ExitCode(err error) int {
+	var exitCoder interface{ ExitCode() int }
+	if errors.As(err, &exitCoder) {
+		return exitCoder.ExitCode()
+	}
+
+	return ExitCodeFailure
+}
+
+// ErrorResponse is the JSON output of a command which failed, when its output
+// format is JSON.
+type ErrorResponse struct {
+	Error ErrorDetails `json:"error"`
+}
+
+// ErrorDetails describes the error of a failed command. The codespace and
+// code are only set for the errors of the SDK and of the failed txs.
+type ErrorDetails struct {
+	Codespace string `json:"codespace,omitempty"`
+	Code      uint32 `json:"code,omitempty"`
+	Message   string `json:"message"`
+	ExitCode  int    `json:"exit_code"`
+}
+
+// NewErrorResponse returns the JSON output of a command which failed with the
+// given error.
+func NewErrorResponse(err error) ErrorResponse {
+	details := ErrorDetails{
+		Message:  err.Error(),
+		ExitCode: ExitCode(err),
+	}
+
+	if codespace, code, _ := sdkerrors.ABCIInfo(err, false); codespace != sdkerrors.UndefinedCodespace {
+		details.Codespace = codespace
+		details.Code = code
+	}
+
+	return ErrorResponse{Error: details}
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestCheckTxResponse(t *testing.T) {
+	require.NoError(t, CheckTxResponse(nil))
+	require.NoError(t, CheckTxResponse(&sdk.TxResponse{TxHash: "AB"}))
+
+	res := &sdk.TxResponse{TxHash: "AB", Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrInsufficientFee.ABCICode(), RawLog: "insufficient fees"}
+	err := CheckTxResponse(res)
+	require.Equal(t, TxError{Response: res}, err)
+	require.Equal(t, "tx AB failed with code 13 in codespace sdk: insufficient fees", err.Error())
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+	require.Equal(t, sdkerrors.RootCodespace, codespace)
+	require.Equal(t, sdkerrors.ErrInsufficientFee.ABCICode(), code)
+}
+
+func TestExitCode(t *testing.T) {
+	require.Equal(t, ExitCodeFailure, ExitCode(errors.New("invalid argument")))
+	require.Equal(t, ExitCodeBroadcastFailed, ExitCode(BroadcastError{Err: errors.New("connection refused")}))
+	require.Equal(t, ExitCodeTxFailed, ExitCode(TxError{Response: &sdk.TxResponse{Code: 5}}))
+	require.Equal(t, ExitCodeTxFailed, ExitCode(sdkerrors.Wrap(TxError{Response: &sdk.TxResponse{Code: 5}}, "wrapped")))
+}
+
+func TestNewErrorResponse(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		exp  ErrorDetails
+	}{
+		{
+			"error without code",
+			errors.New("invalid argument"),
+			ErrorDetails{Message: "invalid argument", ExitCode: ExitCodeFailure},
+		},
+		{
+			"sdk error",
+			sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "foo"),
+			ErrorDetails{Codespace: sdkerrors.RootCodespace, Code: 7, Message: "foo: invalid address", ExitCode: ExitCodeFailure},
+		},
+		{
+			"broadcast error",
+			BroadcastError{Err: sdkerrors.ErrTxTimeoutHeight},
+			ErrorDetails{Codespace: sdkerrors.RootCodespace, Code: 30, Message: "failed to broadcast tx: tx timeout height", ExitCode: ExitCodeBroadcastFailed},
+		},
+		{
+			"tx error",
+			TxError{Response: &sdk.TxResponse{TxHash: "AB", Codespace: "bank", Code: 5, RawLog: "no coins"}},
+			ErrorDetails{Codespace: "bank", Code: 5, Message: "tx AB failed with code 5 in codespace bank: no coins", ExitCode: ExitCodeTxFailed},
+		},
+		{
+			"query error",
+			sdkErrorToGRPCError(abci.ResponseQuery{Codespace: "gov", Code: 2, Log: "unknown proposal"}),
+			ErrorDetails{Codespace: "gov", Code: 2, Message: "rpc error: code = Unknown desc = unknown proposal", ExitCode: ExitCodeFailure},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, ErrorResponse{Error: tc.exp}, NewErrorResponse(tc.err))
+		})
+	}
+}
+
+func TestSdkErrorToGRPCError(t *testing.T) {
+	err := sdkErrorToGRPCError(abci.ResponseQuery{Codespace: sdkerrors.RootCodespace, Code: sdkerrors.ErrKeyNotFound.ABCICode(), Log: "not found"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Equal(t, status.Error(codes.NotFound, "not found").Error(), err.Error())
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+	require.Equal(t, sdkerrors.RootCodespace, codespace)
+	require.Equal(t, sdkerrors.ErrKeyNotFound.ABCICode(), code)
+}
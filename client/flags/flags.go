@@ -3,6 +3,7 @@ package flags
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
@@ -31,10 +32,17 @@ const (
 	// immediately.
 	BroadcastAsync = "async"
 
+	// OutputFormatCanonicalJSON is the value of the --output flag printing the
+	// canonical JSON encoding of the responses, whose keys are sorted, so that
+	// they can be hashed and signed independently of the SDK version.
+	OutputFormatCanonicalJSON = "canonical-json"
+
 	// SignModeDirect is the value of the --sign-mode flag for SIGN_MODE_DIRECT
 	SignModeDirect = "direct"
 	// SignModeLegacyAminoJSON is the value of the --sign-mode flag for SIGN_MODE_LEGACY_AMINO_JSON
 	SignModeLegacyAminoJSON = "amino-json"
+	// SignModeDirectAux is the value of the --sign-mode flag for SIGN_MODE_DIRECT_AUX
+	SignModeDirectAux = "direct-aux"
 )
 
 // List of CLI flags
@@ -52,6 +60,7 @@ const (
 	FlagSequence         = "sequence"
 	FlagNote             = "note"
 	FlagFees             = "fees"
+	FlagTip              = "tip"
 	FlagGas              = "gas"
 	FlagGasPrices        = "gas-prices"
 	FlagBroadcastMode    = "broadcast-mode"
@@ -72,6 +81,11 @@ const (
 	FlagKeyAlgorithm     = "algo"
 	FlagFeeAccount       = "fee-account"
 	FlagReverse          = "reverse"
+	FlagTrustHeight      = "trust-height"
+	FlagTrustHash        = "trust-hash"
+	FlagTrustPeriod      = "trust-period"
+	FlagWitnesses        = "witnesses"
+	FlagGenesisHash      = "genesis-hash"
 
 	// Tendermint logging flags
 	FlagLogLevel  = "log_level"
@@ -86,14 +100,18 @@ var LineBreak = &cobra.Command{Run: func(*cobra.Command, []string) {}}
 func AddQueryFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
 	cmd.Flags().Int64(FlagHeight, 0, "Use a specific height to query state at (this can error if the node is pruning state)")
-	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json|canonical-json)")
+	cmd.Flags().Int64(FlagTrustHeight, 0, "Height of a trusted header used to verify query proofs with a light client")
+	cmd.Flags().String(FlagTrustHash, "", "Hex-encoded hash of the trusted header; if set, query proofs are verified with a light client")
+	cmd.Flags().Duration(FlagTrustPeriod, 168*time.Hour, "Trusting period of the light client, should be significantly less than the unbonding period")
+	cmd.Flags().StringSlice(FlagWitnesses, nil, "RPC addresses of the light client witnesses; if omitted, the queried node is used")
 
 	cmd.MarkFlagRequired(FlagChainID)
 }
 
 // AddTxFlagsToCmd adds common flags to a module tx command.
 func AddTxFlagsToCmd(cmd *cobra.Command) {
-	cmd.Flags().StringP(tmcli.OutputFlag, "o", "json", "Output format (text|json)")
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "json", "Output format (text|json|canonical-json)")
 	cmd.Flags().String(FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
 	cmd.Flags().String(FlagFrom, "", "Name or address of private key with which to sign")
 	cmd.Flags().Uint64P(FlagAccountNumber, "a", 0, "The account number of the signing account (offline mode only)")
@@ -101,6 +119,7 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().String(FlagNote, "", "Note to add a description to the transaction (previously --memo)")
 	cmd.Flags().String(FlagFees, "", "Fees to pay along with transaction; eg: 10uatom")
 	cmd.Flags().String(FlagGasPrices, "", "Gas prices in decimal format to determine the transaction fee (e.g. 0.1uatom)")
+	cmd.Flags().String(FlagTip, "", "Tip paid by the signer to the fee payer of the transaction, which must be another signer; eg: 10uatom")
 	cmd.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 	cmd.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
 	cmd.Flags().Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
@@ -110,9 +129,10 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().Bool(FlagOffline, false, "Offline mode (does not allow any online functionality)")
 	cmd.Flags().BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
 	cmd.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
-	cmd.Flags().String(FlagSignMode, "", "Choose sign mode (direct|amino-json), this is an advanced feature")
+	cmd.Flags().String(FlagSignMode, "", "Choose sign mode (direct|direct-aux|amino-json), this is an advanced feature")
 	cmd.Flags().Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
 	cmd.Flags().String(FlagFeeAccount, "", "Fee account pays fees for the transaction instead of deducting from the signer")
+	cmd.Flags().String(FlagGenesisHash, "", "Hex-encoded hash of the genesis of the network; if set, the genesis of the node is verified before broadcasting")
 
 	// --gas can accept integers and "auto"
 	cmd.Flags().String(FlagGas, "", fmt.Sprintf("gas limit to set per-transaction; set to %q to calculate sufficient gas automatically (default %d)", GasFlagAuto, DefaultGasLimit))
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// verifiedGenesisHashes are the nodes whose genesis has been verified against
+// a genesis hash, by node URI and hex encoded genesis hash.
+var verifiedGenesisHashes sync.Map
+
+// GenesisHash returns the SHA-256 hash of the JSON encoding of the genesis
+// document, as served by the genesis endpoint of the Tendermint RPC.
+func GenesisHash(genDoc *tmtypes.GenesisDoc) ([]byte, error) {
+	bz, err := tmjson.Marshal(genDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
+// VerifyGenesisHash returns an error if the genesis of the node does not have
+// the genesis hash of the context, e.g. if the node belongs to another network
+// reusing the chain ID. It is a no-op if the context has no genesis hash, and
+// each node is only verified once per process.
+func (ctx Context) VerifyGenesisHash() error {
+	if len(ctx.GenesisHash) == 0 {
+		return nil
+	}
+
+	cacheKey := ctx.NodeURI + "/" + hex.EncodeToString(ctx.GenesisHash)
+	if _, ok := verifiedGenesisHashes.Load(cacheKey); ok {
+		return nil
+	}
+
+	node, err := ctx.GetNode()
+	if err != nil {
+		return err
+	}
+
+	res, err := node.Genesis(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to get the genesis of the node: %w", err)
+	}
+
+	hash, err := GenesisHash(res.Genesis)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(hash, ctx.GenesisHash) {
+		return fmt.Errorf(
+			"genesis hash of the node %X does not match the expected genesis hash %X, the node may belong to another network",
+			hash, ctx.GenesisHash,
+		)
+	}
+
+	verifiedGenesisHashes.Store(cacheKey, struct{}{})
+	return nil
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+type genesisClient struct {
+	mock.Client
+	genDoc *tmtypes.GenesisDoc
+	calls  *int
+}
+
+func (c genesisClient) Genesis(context.Context) (*ctypes.ResultGenesis, error) {
+	*c.calls++
+	return &ctypes.ResultGenesis{Genesis: c.genDoc}, nil
+}
+
+func (c genesisClient) BroadcastTxSync(context.Context, tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return &ctypes.ResultBroadcastTx{}, nil
+}
+
+func TestVerifyGenesisHash(t *testing.T) {
+	genDoc := &tmtypes.GenesisDoc{ChainID: "test-chain", AppState: []byte(`{"bank":{}}`)}
+	hash, err := GenesisHash(genDoc)
+	require.NoError(t, err)
+
+	otherHash, err := GenesisHash(&tmtypes.GenesisDoc{ChainID: "test-chain", AppState: []byte(`{"bank":{"a":1}}`)})
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherHash)
+
+	calls := 0
+	ctx := Context{
+		Client:        genesisClient{genDoc: genDoc, calls: &calls},
+		NodeURI:       "tcp://genesis-hash-test:26657",
+		BroadcastMode: flags.BroadcastSync,
+	}
+
+	// no genesis hash is pinned
+	_, err = ctx.BroadcastTx([]byte{1})
+	require.NoError(t, err)
+	require.Equal(t, 0, calls)
+
+	// the genesis of the node does not match
+	_, err = ctx.WithGenesisHash(otherHash).BroadcastTx([]byte{1})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not match the expected genesis hash")
+	require.Equal(t, 1, calls)
+
+	// the node is only verified once
+	for i := 0; i < 2; i++ {
+		_, err = ctx.WithGenesisHash(hash).BroadcastTx([]byte{1})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, calls)
+}
@@ -0,0 +1,68 @@
+package migratestore
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/db/badgerdb"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const FlagBatchSize = "batch-size"
+
+// Cmd migrates a module store of the application state into a store v2 flat
+// store backed by badger while the node is stopped.
+func Cmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-store [store-name] [target-dir]",
+		Short: "Migrate the committed IAVL state of a module store into a store v2 flat store",
+		Long: `Copy the IAVL store of the given module, committed at the given height of
+application.db, into a new store v2 flat store backed by a badger database in
+target-dir, committed at the same height. The keys are copied in batches, and the
+contents of both stores are verified to hash the same once copied.
+
+The node must be stopped while running this command. To migrate a store while the
+node keeps running, wrap it with the migration store of store/v2/migration.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			batchSize, _ := cmd.Flags().GetInt(FlagBatchSize)
+
+			db, err := sdk.NewLevelDB("application", filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			targetDB, err := badgerdb.NewDB(filepath.Clean(args[1]))
+			if err != nil {
+				return err
+			}
+			defer targetDB.Close()
+
+			result, err := server.MigrateStore(db, args[0], height, targetDB, batchSize, cmd.ErrOrStderr())
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("migrated %d keys of store %s at height %d\n", result.Keys, result.Store, result.Height)
+			cmd.Printf("iavl hash: %X\nsmt root: %X\ncontent hash: %X\n", result.IAVLHash, result.SMTRoot, result.ContentHash)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, 0, "Migrate the state at a particular height (0 means latest height)")
+	cmd.Flags().Int(FlagBatchSize, 10000, "Number of keys copied per batch")
+
+	return cmd
+}
@@ -91,6 +91,16 @@ func (ctx Context) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, error)
 		Prove:  req.Prove,
 	}
 
+	// queries made through a light client verifier must be provable, as the
+	// node itself isn't trusted
+	if ctx.Verifier != nil {
+		if !isQueryStoreWithProof(req.Path) {
+			return abci.ResponseQuery{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "query %s cannot be verified with a light client", req.Path)
+		}
+
+		opts.Prove = true
+	}
+
 	result, err := node.ABCIQueryWithOptions(context.Background(), req.Path, req.Data, opts)
 	if err != nil {
 		return abci.ResponseQuery{}, err
@@ -105,20 +115,60 @@ func (ctx Context) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, error)
 		return result.Response, nil
 	}
 
+	if ctx.Verifier != nil {
+		if err := ctx.verifyProof(req.Path, result.Response); err != nil {
+			return abci.ResponseQuery{}, err
+		}
+	}
+
 	return result.Response, nil
 }
 
 func sdkErrorToGRPCError(resp abci.ResponseQuery) error {
+	var code codes.Code
 	switch resp.Code {
 	case sdkerrors.ErrInvalidRequest.ABCICode():
-		return status.Error(codes.InvalidArgument, resp.Log)
+		code = codes.InvalidArgument
 	case sdkerrors.ErrUnauthorized.ABCICode():
-		return status.Error(codes.Unauthenticated, resp.Log)
+		code = codes.Unauthenticated
 	case sdkerrors.ErrKeyNotFound.ABCICode():
-		return status.Error(codes.NotFound, resp.Log)
+		code = codes.NotFound
 	default:
-		return status.Error(codes.Unknown, resp.Log)
+		code = codes.Unknown
 	}
+
+	return queryError{
+		status:    status.New(code, resp.Log),
+		codespace: resp.Codespace,
+		code:      resp.Code,
+	}
+}
+
+// queryError is the gRPC status error of a failed ABCI query, which keeps the
+// codespace and code of the query response.
+type queryError struct {
+	status    *status.Status
+	codespace string
+	code      uint32
+}
+
+func (e queryError) Error() string {
+	return e.status.Err().Error()
+}
+
+// GRPCStatus returns the gRPC status of the error.
+func (e queryError) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// ABCICode returns the code of the query response.
+func (e queryError) ABCICode() uint32 {
+	return e.code
+}
+
+// Codespace returns the codespace of the query response.
+func (e queryError) Codespace() string {
+	return e.codespace
 }
 
 // query performs a query to a Tendermint node with the provided store name
@@ -2,10 +2,10 @@ package rpc
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 
 	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -42,12 +42,12 @@ func BlockCommand() *cobra.Command {
 				return err
 			}
 
-			fmt.Println(string(output))
-			return nil
+			return clientCtx.PrintRaw(output)
 		},
 	}
 
 	cmd.Flags().StringP(flags.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
+	cmd.Flags().StringP(tmcli.OutputFlag, "o", "json", "Output format (text|json|canonical-json)")
 
 	return cmd
 }
@@ -0,0 +1,183 @@
+package snapshot
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Cmd returns the snapshots group command for managing the local state sync
+// snapshots of the node.
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state sync snapshots",
+		Long: `Manage the local state sync snapshots of the node. Snapshots can be exported to
+portable archives and loaded into the snapshot store of another node, in order to
+bootstrap it from a trusted archive.
+
+The node must be stopped while running these commands.`,
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ListCmd(),
+		ExportCmd(),
+		RestoreCmd(),
+		DeleteCmd(),
+	)
+
+	return cmd
+}
+
+// ListCmd returns the command listing local snapshots.
+func ListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			store, err := openSnapshotStore(cmd)
+			if err != nil {
+				return err
+			}
+
+			snapshots, err := store.List()
+			if err != nil {
+				return err
+			}
+
+			for _, s := range snapshots {
+				cmd.Printf("height: %d format: %d chunks: %d hash: %X\n", s.Height, s.Format, s.Chunks, s.Hash)
+			}
+
+			return nil
+		},
+	}
+}
+
+// ExportCmd returns the command writing a local snapshot to an archive file.
+func ExportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export [height] [format] [archive-file]",
+		Short: "Export a local snapshot to an archive file",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseHeightAndFormat(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			store, err := openSnapshotStore(cmd)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Create(args[2])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			if err := store.ExportArchive(height, format, file); err != nil {
+				return err
+			}
+
+			cmd.Printf("exported snapshot at height %d format %d to %s\n", height, format, args[2])
+			return file.Close()
+		},
+	}
+}
+
+// RestoreCmd returns the command loading a snapshot archive file into the local
+// snapshot store.
+func RestoreCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore [archive-file]",
+		Short: "Load a snapshot archive file into the local snapshot store",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := openSnapshotStore(cmd)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			snapshot, err := store.ImportArchive(file)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("restored snapshot at height %d format %d hash %X\n", snapshot.Height, snapshot.Format, snapshot.Hash)
+			return nil
+		},
+	}
+}
+
+// DeleteCmd returns the command deleting a local snapshot.
+func DeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete [height] [format]",
+		Short: "Delete a local snapshot",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseHeightAndFormat(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			store, err := openSnapshotStore(cmd)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := store.Get(height, format)
+			if err != nil {
+				return err
+			}
+			if snapshot == nil {
+				return fmt.Errorf("snapshot at height %d format %d not found", height, format)
+			}
+
+			return store.Delete(height, format)
+		},
+	}
+}
+
+func openSnapshotStore(cmd *cobra.Command) (*snapshots.Store, error) {
+	rootDir := server.GetServerContextFromCmd(cmd).Config.RootDir
+	snapshotDir := filepath.Join(rootDir, "data", "snapshots")
+	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots.NewStore(snapshotDB, snapshotDir)
+}
+
+func parseHeightAndFormat(heightStr, formatStr string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(heightStr, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid height %s: %w", heightStr, err)
+	}
+
+	format, err := strconv.ParseUint(formatStr, 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid format %s: %w", formatStr, err)
+	}
+
+	return height, uint32(format), nil
+}
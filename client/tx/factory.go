@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -29,6 +30,8 @@ type Factory struct {
 	chainID            string
 	memo               string
 	fees               sdk.Coins
+	tip                sdk.Coins
+	tipper             string
 	gasPrices          sdk.DecCoins
 	signMode           signing.SignMode
 	simulateAndExecute bool
@@ -44,6 +47,8 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		signMode = signing.SignMode_SIGN_MODE_DIRECT
 	case flags.SignModeLegacyAminoJSON:
 		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case flags.SignModeDirectAux:
+		signMode = signing.SignMode_SIGN_MODE_DIRECT_AUX
 	}
 
 	accNum, _ := flagSet.GetUint64(flags.FlagAccountNumber)
@@ -73,6 +78,9 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	feesStr, _ := flagSet.GetString(flags.FlagFees)
 	f = f.WithFees(feesStr)
 
+	tipStr, _ := flagSet.GetString(flags.FlagTip)
+	f = f.WithTip(tipStr, clientCtx.GetFromAddress().String())
+
 	gasPricesStr, _ := flagSet.GetString(flags.FlagGasPrices)
 	f = f.WithGasPrices(gasPricesStr)
 
@@ -87,6 +95,8 @@ func (f Factory) Keybase() keyring.Keyring                  { return f.keybase }
 func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
+func (f Factory) Tip() sdk.Coins                            { return f.tip }
+func (f Factory) Tipper() string                            { return f.tipper }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
@@ -130,6 +140,19 @@ func (f Factory) WithFees(fees string) Factory {
 	return f
 }
 
+// WithTip returns a copy of the Factory with an updated tip, paid by tipper to
+// the fee payer of the tx, which must be another signer of the tx.
+func (f Factory) WithTip(tip string, tipper string) Factory {
+	parsedTip, err := sdk.ParseCoinsNormalized(tip)
+	if err != nil {
+		panic(err)
+	}
+
+	f.tip = parsedTip
+	f.tipper = tipper
+	return f
+}
+
 // WithGasPrices returns a copy of the Factory with updated gas prices.
 func (f Factory) WithGasPrices(gasPrices string) Factory {
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
@@ -232,6 +255,15 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 	tx.SetGasLimit(f.gas)
 	tx.SetTimeoutHeight(f.TimeoutHeight())
 
+	if !f.tip.IsZero() {
+		// the tip is paid to the fee payer, which must thus be another signer
+		if f.tipper == tx.GetTx().FeePayer().String() {
+			return nil, fmt.Errorf("tipper %s cannot be the fee payer of the tx", f.tipper)
+		}
+
+		tx.SetTip(&txtypes.Tip{Amount: f.tip, Tipper: f.tipper})
+	}
+
 	return tx, nil
 }
 
@@ -49,7 +49,9 @@ func GenerateOrBroadcastTxWithFactory(clientCtx client.Context, txf Factory, msg
 
 // BroadcastTx attempts to generate, sign and broadcast a transaction with the
 // given set of messages. It will also simulate gas requirements if necessary.
-// It will return an error upon failure.
+// It will return an error upon failure, a client.BroadcastError if the
+// transaction couldn't be broadcast, or a client.TxError once the response is
+// printed if the transaction failed.
 func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 	txf, err := txf.Prepare(clientCtx)
 	if err != nil {
@@ -106,10 +108,14 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 	// broadcast to a Tendermint node
 	res, err := clientCtx.BroadcastTx(txBytes)
 	if err != nil {
+		return client.BroadcastError{Err: err}
+	}
+
+	if err := clientCtx.PrintProto(res); err != nil {
 		return err
 	}
 
-	return clientCtx.PrintProto(res)
+	return client.CheckTxResponse(res)
 }
 
 // CalculateGas simulates the execution of a transaction and returns the
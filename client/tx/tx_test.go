@@ -131,6 +131,24 @@ func TestBuildUnsignedTx(t *testing.T) {
 	require.Empty(t, sigs)
 }
 
+func TestBuildUnsignedTxTip(t *testing.T) {
+	txf := tx.Factory{}.
+		WithTxConfig(NewTestTxConfig()).
+		WithFees("50stake").
+		WithChainID("test-chain").
+		WithTip("10atom", sdk.AccAddress("tipper").String())
+
+	// the fee payer, i.e. the first signer, cannot tip itself
+	tipperMsg := banktypes.NewMsgSend(sdk.AccAddress("tipper"), sdk.AccAddress("to"), nil)
+	_, err := txf.BuildUnsignedTx(tipperMsg)
+	require.Error(t, err)
+
+	payerMsg := banktypes.NewMsgSend(sdk.AccAddress("payer"), sdk.AccAddress("to"), nil)
+	txb, err := txf.BuildUnsignedTx(payerMsg, tipperMsg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress("tipper").String(), txb.GetTx().(txtypes.TipTx).GetTip().Tipper)
+}
+
 func TestSign(t *testing.T) {
 	requireT := require.New(t)
 	path := hd.CreateHDPath(118, 0, 0).String()
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/light"
+	lightdb "github.com/tendermint/tendermint/light/store/db"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Verifier defines the light client functionality used to verify query
+// responses against trusted headers. It is implemented by *light.Client.
+type Verifier interface {
+	VerifyLightBlockAtHeight(ctx context.Context, height int64, now time.Time) (*tmtypes.LightBlock, error)
+}
+
+var _ Verifier = (*light.Client)(nil)
+
+// VerifierOptions defines the root of trust and the providers of a light
+// client verifier.
+type VerifierOptions struct {
+	// TrustHeight and TrustHash identify a header trusted by the user, which
+	// must have been obtained from a source other than the node being queried.
+	TrustHeight int64
+	TrustHash   []byte
+	// TrustPeriod should be significantly less than the unbonding period.
+	TrustPeriod time.Duration
+	// Witnesses are the RPC addresses used to cross-check the headers
+	// returned by the primary node. They default to the primary node itself.
+	Witnesses []string
+}
+
+// NewVerifier returns a light client verifier of the headers of the given
+// chain as returned by the node at nodeURI. Trusted headers are persisted under
+// the data directory of homeDir, so that the root of trust is only required the
+// first time a header is verified. The database of trusted headers is only
+// opened while verifying a header, so that concurrent commands don't contend
+// for its lock.
+func NewVerifier(homeDir, chainID, nodeURI string, opts VerifierOptions) (Verifier, error) {
+	if chainID == "" {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "chain ID is required to verify queries")
+	}
+
+	if len(opts.Witnesses) == 0 {
+		opts.Witnesses = []string{nodeURI}
+	}
+
+	return lightVerifier{
+		dbDir:   filepath.Join(homeDir, "data"),
+		chainID: chainID,
+		nodeURI: nodeURI,
+		opts:    opts,
+	}, nil
+}
+
+// lightVerifier is the Verifier returned by NewVerifier.
+type lightVerifier struct {
+	dbDir   string
+	chainID string
+	nodeURI string
+	opts    VerifierOptions
+}
+
+var _ Verifier = lightVerifier{}
+
+// VerifyLightBlockAtHeight implements Verifier. It opens the database of
+// trusted headers and closes it once the header is verified.
+func (v lightVerifier) VerifyLightBlockAtHeight(ctx context.Context, height int64, now time.Time) (*tmtypes.LightBlock, error) {
+	db, err := dbm.NewGoLevelDB("light-client", v.dbDir)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	lc, err := light.NewHTTPClient(
+		ctx,
+		v.chainID,
+		light.TrustOptions{
+			Period: v.opts.TrustPeriod,
+			Height: v.opts.TrustHeight,
+			Hash:   v.opts.TrustHash,
+		},
+		v.nodeURI,
+		v.opts.Witnesses,
+		lightdb.New(db, v.chainID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return lc.VerifyLightBlockAtHeight(ctx, height, now)
+}
+
+// verifyProof verifies the proof of a store query response against the app
+// hash of the header verified by the context's light client. The app hash of
+// the state at height H is committed in the header at height H+1.
+func (ctx Context) verifyProof(path string, resp abci.ResponseQuery) error {
+	if resp.ProofOps == nil || len(resp.ProofOps.Ops) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "query response has no proof")
+	}
+
+	block, err := ctx.Verifier.VerifyLightBlockAtHeight(context.Background(), resp.Height+1, time.Now())
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to verify header at height %d", resp.Height+1)
+	}
+
+	storeName, err := parseQueryStoreName(path)
+	if err != nil {
+		return err
+	}
+
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
+	kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
+
+	prt := rootmulti.DefaultProofRuntime()
+	if resp.Value == nil {
+		err = prt.VerifyAbsence(resp.ProofOps, block.AppHash, kp.String())
+	} else {
+		err = prt.VerifyValue(resp.ProofOps, block.AppHash, kp.String(), resp.Value)
+	}
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("failed to verify query proof: %s", err))
+	}
+
+	return nil
+}
+
+// parseQueryStoreName returns the store name of a store query path formatted
+// as /store/<storeName>/<subpath>.
+func parseQueryStoreName(path string) (string, error) {
+	if !strings.HasPrefix(path, "/") {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid query path %s", path)
+	}
+
+	paths := strings.SplitN(path[1:], "/", 3)
+	if len(paths) != 3 {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid query path %s", path)
+	}
+
+	return paths[1], nil
+}
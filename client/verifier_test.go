@@ -0,0 +1,118 @@
+package client_test
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// storeRPCClient is an RPC client answering ABCI queries from a multistore.
+type storeRPCClient struct {
+	rpcclient.Client
+	store *rootmulti.Store
+	// tamper modifies the query responses before they are returned
+	tamper func(*abci.ResponseQuery)
+}
+
+func (c storeRPCClient) ABCIQueryWithOptions(_ context.Context, path string, data tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	// the app routes /store queries to the multistore without the prefix
+	path = strings.TrimPrefix(path, "/store")
+	resp := c.store.Query(abci.RequestQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	if c.tamper != nil {
+		c.tamper(&resp)
+	}
+
+	return &ctypes.ResultABCIQuery{Response: resp}, nil
+}
+
+// appHashVerifier is a light client verifier trusting the given app hash at
+// every height.
+type appHashVerifier []byte
+
+func (v appHashVerifier) VerifyLightBlockAtHeight(_ context.Context, height int64, _ time.Time) (*tmtypes.LightBlock, error) {
+	return &tmtypes.LightBlock{
+		SignedHeader: &tmtypes.SignedHeader{
+			Header: &tmtypes.Header{Height: height, AppHash: tmbytes.HexBytes(v)},
+		},
+	}, nil
+}
+
+func TestQueryStoreWithVerifier(t *testing.T) {
+	key := storetypes.NewKVStoreKey("bank")
+	ms := rootmulti.NewStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ms.GetKVStore(key).Set([]byte("foo"), []byte("bar"))
+	cid := ms.Commit()
+
+	clientCtx := client.Context{}.
+		WithClient(storeRPCClient{store: ms}).
+		WithHeight(cid.Version).
+		WithVerifier(appHashVerifier(cid.Hash))
+
+	bz, height, err := clientCtx.QueryStore([]byte("foo"), "bank")
+	require.NoError(t, err)
+	require.Equal(t, []byte("bar"), bz)
+	require.Equal(t, cid.Version, height)
+
+	// absence of a key is proven as well
+	bz, _, err = clientCtx.QueryStore([]byte("baz"), "bank")
+	require.NoError(t, err)
+	require.Nil(t, bz)
+
+	// values that don't match the proof are rejected
+	_, _, err = clientCtx.WithClient(storeRPCClient{store: ms, tamper: func(resp *abci.ResponseQuery) {
+		resp.Value = []byte("forged")
+	}}).QueryStore([]byte("foo"), "bank")
+	require.Error(t, err)
+
+	// responses without proofs are rejected
+	_, _, err = clientCtx.WithClient(storeRPCClient{store: ms, tamper: func(resp *abci.ResponseQuery) {
+		resp.ProofOps = nil
+	}}).QueryStore([]byte("foo"), "bank")
+	require.Error(t, err)
+
+	// proofs against an untrusted app hash are rejected
+	_, _, err = clientCtx.WithVerifier(appHashVerifier([]byte("untrusted"))).QueryStore([]byte("foo"), "bank")
+	require.Error(t, err)
+
+	// queries which cannot be proven are rejected
+	_, _, err = clientCtx.Query("/cosmos.bank.v1beta1.Query/Balance")
+	require.Error(t, err)
+}
+
+func TestNewVerifierReleasesDB(t *testing.T) {
+	homeDir := t.TempDir()
+	_, err := client.NewVerifier(homeDir, "", "tcp://127.0.0.1:1", client.VerifierOptions{})
+	require.Error(t, err)
+
+	verifier, err := client.NewVerifier(homeDir, "test-chain", "tcp://127.0.0.1:1", client.VerifierOptions{
+		TrustHeight: 1,
+		TrustHash:   make([]byte, 32),
+		TrustPeriod: time.Hour,
+	})
+	require.NoError(t, err)
+
+	// the database of trusted headers isn't left open by a failed verification
+	_, err = verifier.VerifyLightBlockAtHeight(context.Background(), 2, time.Now())
+	require.Error(t, err)
+
+	db, err := dbm.NewGoLevelDB("light-client", filepath.Join(homeDir, "data"))
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+}
@@ -0,0 +1,121 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+)
+
+// ProtoMarshalCanonicalJSON returns the canonical JSON encoding of a message,
+// see CanonicalJSON.
+func ProtoMarshalCanonicalJSON(msg proto.Message, resolver jsonpb.AnyResolver) ([]byte, error) {
+	bz, err := ProtoMarshalJSON(msg, resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	return CanonicalJSON(bz)
+}
+
+// CanonicalJSON returns the canonical encoding of the given JSON, so that the
+// responses of the CLI and of the gRPC-gateway can be hashed and signed
+// independently of the SDK version which encoded them:
+//   - the keys of the objects are sorted, and there is no insignificant
+//     whitespace;
+//   - the strings are not HTML escaped;
+//   - the numbers are written without exponent, fractional trailing zeros or
+//     negative zero, e.g. 1e3 and 1000.0 are written 1000.
+//
+// The Int and Dec values are encoded as strings by their JSON marshalers, so
+// their formatting is kept as is.
+func CanonicalJSON(bz []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("invalid JSON: unexpected data after the top-level value")
+	}
+
+	v, err := canonicalizeNumbers(v)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	// the encoder terminates the value with a new line
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// canonicalizeNumbers rewrites the numbers of the decoded JSON value in their
+// canonical form. The keys of the maps are sorted by the JSON encoder.
+func canonicalizeNumbers(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			canonical, err := canonicalizeNumbers(value)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = canonical
+		}
+
+	case []interface{}:
+		for i, value := range v {
+			canonical, err := canonicalizeNumbers(value)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = canonical
+		}
+
+	case json.Number:
+		return canonicalNumber(v)
+	}
+
+	return v, nil
+}
+
+// canonicalNumber returns the decimal notation of the number, without
+// exponent, fractional trailing zeros or negative zero.
+func canonicalNumber(n json.Number) (json.Number, error) {
+	r, ok := new(big.Rat).SetString(n.String())
+	if !ok {
+		return "", fmt.Errorf("invalid JSON number %s", n)
+	}
+
+	if r.IsInt() {
+		return json.Number(r.Num().String()), nil
+	}
+
+	// a rational parsed from a decimal has a denominator of the form 2^a*5^b,
+	// whose decimal expansion has max(a, b) fractional digits
+	denom := new(big.Int).Set(r.Denom())
+	digits := int(denom.TrailingZeroBits())
+	denom.Rsh(denom, uint(digits))
+
+	five, fives, mod := big.NewInt(5), 0, new(big.Int)
+	for denom.Cmp(big.NewInt(1)) > 0 {
+		denom.QuoRem(denom, five, mod)
+		fives++
+	}
+	if fives > digits {
+		digits = fives
+	}
+
+	return json.Number(strings.TrimRight(r.FloatString(digits), "0")), nil
+}
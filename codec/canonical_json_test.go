@@ -0,0 +1,55 @@
+package codec_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expOut string
+		expErr bool
+	}{
+		{"sorted keys", `{"b": 1, "a": {"d": [2, 1], "c": null}}`, `{"a":{"c":null,"d":[2,1]},"b":1}`, false},
+		{"no html escaping", `{"memo": "<a&b>"}`, `{"memo":"<a&b>"}`, false},
+		{"numbers", `[1e3, 1000.0, -0, 1.50, 2.5e-3, -12E+1]`, `[1000,1000,0,1.5,0.0025,-120]`, false},
+		{"int and dec strings kept", `{"amount": "1000", "rate": "0.100000000000000000"}`, `{"amount":"1000","rate":"0.100000000000000000"}`, false},
+		{"invalid json", `{"a":`, "", true},
+		{"trailing data", `{} {}`, "", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := codec.CanonicalJSON([]byte(tc.input))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expOut, string(out))
+
+			// the canonical encoding is idempotent
+			again, err := codec.CanonicalJSON(out)
+			require.NoError(t, err)
+			require.Equal(t, out, again)
+		})
+	}
+}
+
+func TestProtoMarshalCanonicalJSON(t *testing.T) {
+	any, err := types.NewAnyWithValue(&testdata.Dog{Size_: "small", Name: "Spot"})
+	require.NoError(t, err)
+
+	bz, err := codec.ProtoMarshalCanonicalJSON(&testdata.HasAnimal{Animal: any, X: 10}, NewTestInterfaceRegistry())
+	require.NoError(t, err)
+	require.Equal(t, `{"animal":{"@type":"/testdata.Dog","name":"Spot","size":"small"},"x":"10"}`, string(bz))
+}
@@ -327,6 +327,13 @@ func (twt *errUnknownField) Error() string {
 
 var _ error = (*errUnknownField)(nil)
 
+// IsUnknownFieldError returns true if the given error of RejectUnknownFields
+// reports an unknown field, and not e.g. malformed bytes.
+func IsUnknownFieldError(err error) bool {
+	var unknownField *errUnknownField
+	return errors.As(err, &unknownField)
+}
+
 var (
 	protoFileToDesc   = make(map[string]*descriptor.FileDescriptorProto)
 	protoFileToDescMu sync.RWMutex
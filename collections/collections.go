@@ -0,0 +1,77 @@
+// Package collections provides typed abstractions over the key-value stores of
+// the modules: an Item stores a single value, a Map values under keys, a KeySet
+// a set of keys, a Sequence a monotonic counter, and an IndexedMap a Map with
+// secondary indexes. The keys and values are encoded by codecs, so that the
+// keepers do not build store keys by slicing bytes by hand.
+//
+// The collections of a module are declared with distinct prefixes, and are
+// gathered in a Schema, which checks that their prefixes do not overlap and
+// imports and exports their state from and to genesis.
+package collections
+
+import (
+	"encoding/json"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codespace is the codespace of the errors of the collections.
+const Codespace = "collections"
+
+var (
+	// ErrNotFound is returned when a key has no value.
+	ErrNotFound = sdkerrors.Register(Codespace, 2, "collection: not found")
+	// ErrEncoding is returned when a key or value cannot be encoded or decoded.
+	ErrEncoding = sdkerrors.Register(Codespace, 3, "collection: encoding error")
+	// ErrInvalidSchema is returned when the collections of a schema conflict.
+	ErrInvalidSchema = sdkerrors.Register(Codespace, 4, "collection: invalid schema")
+)
+
+// Collection is a collection of a Schema.
+type Collection interface {
+	// Name returns the name of the collection, under which its state is
+	// imported and exported in genesis.
+	Name() string
+	// Prefix returns the prefix of the keys of the collection in its store.
+	Prefix() []byte
+
+	// exportGenesis returns the state of the collection in JSON.
+	exportGenesis(ctx sdk.Context) (json.RawMessage, error)
+	// importGenesis imports the state of the collection from JSON.
+	importGenesis(ctx sdk.Context, bz json.RawMessage) error
+	// validateGenesis validates the state of the collection in JSON.
+	validateGenesis(bz json.RawMessage) error
+}
+
+// collection is the name, store key and prefix of a collection.
+type collection struct {
+	name     string
+	storeKey storetypes.StoreKey
+	prefix   []byte
+}
+
+func newCollection(storeKey storetypes.StoreKey, prefix []byte, name string) collection {
+	return collection{name: name, storeKey: storeKey, prefix: prefix}
+}
+
+// Name implements Collection.
+func (c collection) Name() string {
+	return c.name
+}
+
+// Prefix implements Collection.
+func (c collection) Prefix() []byte {
+	return c.prefix
+}
+
+// key returns the store key of the encoded key of an entry.
+func (c collection) key(bz []byte) []byte {
+	key := make([]byte, 0, len(c.prefix)+len(bz))
+	return append(append(key, c.prefix...), bz...)
+}
+
+func (c collection) store(ctx sdk.Context) sdk.KVStore {
+	return ctx.KVStore(c.storeKey)
+}
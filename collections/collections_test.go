@@ -0,0 +1,192 @@
+package collections_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/collections"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func newContext(t *testing.T) (sdk.Context, storetypes.StoreKey) {
+	key := storetypes.NewKVStoreKey("test")
+	return testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")), key
+}
+
+func TestMap(t *testing.T) {
+	ctx, key := newContext(t)
+	balances := collections.NewMap(key, []byte{1}, "balances",
+		collections.PairKeyCodec(collections.AccAddressKey, collections.StringKey), collections.Uint64Value)
+
+	alice, bob := sdk.AccAddress("alice"), sdk.AccAddress("bob")
+	require.NoError(t, balances.Set(ctx, collections.Join(alice, "atom"), uint64(1)))
+	require.NoError(t, balances.Set(ctx, collections.Join(alice, "btc"), uint64(2)))
+	require.NoError(t, balances.Set(ctx, collections.Join(bob, "atom"), uint64(3)))
+
+	value, err := balances.Get(ctx, collections.Join(alice, "btc"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), value)
+	_, err = balances.Get(ctx, collections.Join(bob, "btc"))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = balances.Get(ctx, "atom")
+	require.ErrorIs(t, err, collections.ErrEncoding)
+
+	// the entries of a prefix of the keys are iterated in order
+	var denoms []string
+	require.NoError(t, balances.Iterate(ctx, &collections.Range{Prefix: alice, Reverse: true}, func(key, value interface{}) (bool, error) {
+		denoms = append(denoms, key.(collections.Pair).K2.(string))
+		return false, nil
+	}))
+	require.Equal(t, []string{"btc", "atom"}, denoms)
+
+	var values []uint64
+	pageRes, err := balances.Paginate(ctx, &query.PageRequest{Limit: 2}, nil, func(_, value interface{}) error {
+		values = append(values, value.(uint64))
+		return nil
+	})
+	require.NoError(t, err)
+	require.NotNil(t, pageRes.NextKey)
+	// the addresses are length prefixed in pairs, so the shorter ones come first
+	require.Equal(t, []uint64{3, 1}, values)
+
+	require.NoError(t, balances.Remove(ctx, collections.Join(alice, "atom")))
+	has, err := balances.Has(ctx, collections.Join(alice, "atom"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// a range prefix requires pair keys
+	accounts := collections.NewMap(key, []byte{2}, "accounts", collections.AccAddressKey, collections.Uint64Value)
+	err = accounts.Iterate(ctx, &collections.Range{Prefix: alice}, func(_, _ interface{}) (bool, error) { return false, nil })
+	require.ErrorIs(t, err, collections.ErrEncoding)
+}
+
+func TestItemAndSequence(t *testing.T) {
+	ctx, key := newContext(t)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	item := collections.NewItem(key, []byte{1}, "dog", collections.ProtoValue(cdc, func() codec.ProtoMarshaler { return &testdata.Dog{} }))
+
+	_, err := item.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	require.ErrorIs(t, item.Set(ctx, &testdata.Cat{}), collections.ErrEncoding)
+	require.NoError(t, item.Set(ctx, &testdata.Dog{Name: "rex"}))
+	value, err := item.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &testdata.Dog{Name: "rex"}, value)
+
+	seq := collections.NewSequence(key, []byte{2}, "sequence")
+	for i := uint64(0); i < 3; i++ {
+		next, err := seq.Next(ctx)
+		require.NoError(t, err)
+		require.Equal(t, i, next)
+	}
+	current, err := seq.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), current)
+}
+
+func TestIndexedMap(t *testing.T) {
+	ctx, key := newContext(t)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	bySize := collections.NewMultiIndex(key, []byte{2}, "dogs_by_size", collections.StringKey, collections.StringKey,
+		func(_, value interface{}) (interface{}, error) { return value.(*testdata.Dog).Size_, nil })
+	byName := collections.NewUniqueIndex(key, []byte{3}, "dogs_by_name", collections.StringKey, collections.StringKey,
+		func(_, value interface{}) (interface{}, error) { return value.(*testdata.Dog).Name, nil })
+	dogs := collections.NewIndexedMap(
+		collections.NewMap(key, []byte{1}, "dogs", collections.StringKey, collections.ProtoValue(cdc, func() codec.ProtoMarshaler { return &testdata.Dog{} })),
+		bySize, byName,
+	)
+
+	require.NoError(t, dogs.Set(ctx, "1", &testdata.Dog{Name: "rex", Size_: "big"}))
+	require.NoError(t, dogs.Set(ctx, "2", &testdata.Dog{Name: "max", Size_: "big"}))
+	require.NoError(t, dogs.Set(ctx, "3", &testdata.Dog{Name: "fido", Size_: "small"}))
+
+	bigDogs := func() []interface{} {
+		var ids []interface{}
+		require.NoError(t, bySize.Iterate(ctx, "big", func(pk interface{}) (bool, error) {
+			ids = append(ids, pk)
+			return false, nil
+		}))
+		return ids
+	}
+	require.Equal(t, []interface{}{"1", "2"}, bigDogs())
+
+	// the indexes follow the updates and removals
+	require.NoError(t, dogs.Set(ctx, "1", &testdata.Dog{Name: "rex", Size_: "small"}))
+	require.NoError(t, dogs.Remove(ctx, "2"))
+	require.Empty(t, bigDogs())
+	pk, err := byName.Get(ctx, "rex")
+	require.NoError(t, err)
+	require.Equal(t, "1", pk)
+	_, err = byName.Get(ctx, "max")
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// the unique index keys cannot be shared
+	require.Error(t, dogs.Set(ctx, "4", &testdata.Dog{Name: "fido"}))
+}
+
+func TestSchemaGenesis(t *testing.T) {
+	ctx, key := newContext(t)
+	newSchema := func(key storetypes.StoreKey) (collections.Schema, collections.Map, collections.KeySet, collections.Sequence) {
+		balances := collections.NewMap(key, []byte{1}, "balances", collections.AccAddressKey, collections.Uint64Value)
+		frozen := collections.NewKeySet(key, []byte{2}, "frozen", collections.AccAddressKey)
+		seq := collections.NewSequence(key, []byte{3}, "sequence")
+		schema, err := collections.NewSchema(balances, frozen, seq)
+		require.NoError(t, err)
+
+		return schema, balances, frozen, seq
+	}
+
+	schema, balances, frozen, seq := newSchema(key)
+	addr := sdk.AccAddress("alice")
+	require.NoError(t, balances.Set(ctx, addr, uint64(10)))
+	require.NoError(t, frozen.Set(ctx, addr))
+	require.NoError(t, seq.Set(ctx, 7))
+
+	genesis, err := schema.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, schema.ValidateGenesis(genesis))
+	var states map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(genesis, &states))
+	require.JSONEq(t, `"7"`, string(states["sequence"]))
+
+	other, otherKey := newContext(t)
+	importSchema, importedBalances, importedFrozen, importedSeq := newSchema(otherKey)
+	require.NoError(t, importSchema.InitGenesis(other, genesis))
+	value, err := importedBalances.Get(other, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), value)
+	has, err := importedFrozen.Has(other, addr)
+	require.NoError(t, err)
+	require.True(t, has)
+	current, err := importedSeq.Peek(other)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), current)
+
+	require.Error(t, schema.ValidateGenesis(json.RawMessage(`{"unknown": []}`)))
+	require.Error(t, schema.ValidateGenesis(json.RawMessage(`{"balances": [{"key": "invalid", "value": "1"}]}`)))
+}
+
+func TestNewSchema(t *testing.T) {
+	_, key := newContext(t)
+	a := collections.NewKeySet(key, []byte{1}, "a", collections.StringKey)
+
+	_, err := collections.NewSchema(a, collections.NewKeySet(key, []byte{1, 2}, "b", collections.StringKey))
+	require.ErrorIs(t, err, collections.ErrInvalidSchema)
+	_, err = collections.NewSchema(a, collections.NewKeySet(key, []byte{2}, "a", collections.StringKey))
+	require.ErrorIs(t, err, collections.ErrInvalidSchema)
+
+	indexed := collections.NewIndexedMap(
+		collections.NewMap(key, []byte{2}, "c", collections.StringKey, collections.Uint64Value),
+		collections.NewMultiIndex(key, []byte{1, 3}, "c_index", collections.StringKey, collections.StringKey, nil),
+	)
+	_, err = collections.NewSchema(a, indexed)
+	require.ErrorIs(t, err, collections.ErrInvalidSchema)
+}
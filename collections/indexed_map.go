@@ -0,0 +1,240 @@
+package collections
+
+import (
+	"encoding/json"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Index is a secondary index of the values of an IndexedMap.
+type Index interface {
+	// Name returns the name of the index.
+	Name() string
+	// Prefix returns the prefix of the keys of the index in its store.
+	Prefix() []byte
+
+	// reference indexes the value of a primary key.
+	reference(ctx sdk.Context, pk, value interface{}) error
+	// unreference removes the value of a primary key from the index.
+	unreference(ctx sdk.Context, pk, value interface{}) error
+}
+
+// RefKeyFunc returns the reference key of the value of a primary key, under
+// which the primary key is indexed.
+type RefKeyFunc func(pk, value interface{}) (interface{}, error)
+
+// MultiIndex is an index of the primary keys of the values sharing a reference
+// key, e.g. the balances of a denom.
+type MultiIndex struct {
+	keys   KeySet
+	refKey RefKeyFunc
+}
+
+var _ Index = MultiIndex{}
+
+// NewMultiIndex returns the index stored under the prefix of the store, whose
+// reference keys are returned by refKey and encoded by refCodec, and whose
+// primary keys are encoded by pkCodec.
+func NewMultiIndex(storeKey storetypes.StoreKey, prefix []byte, name string, refCodec, pkCodec KeyCodec, refKey RefKeyFunc) MultiIndex {
+	return MultiIndex{
+		keys:   NewKeySet(storeKey, prefix, name, PairKeyCodec(refCodec, pkCodec)),
+		refKey: refKey,
+	}
+}
+
+// Name implements Index.
+func (i MultiIndex) Name() string {
+	return i.keys.Name()
+}
+
+// Prefix implements Index.
+func (i MultiIndex) Prefix() []byte {
+	return i.keys.Prefix()
+}
+
+func (i MultiIndex) reference(ctx sdk.Context, pk, value interface{}) error {
+	ref, err := i.refKey(pk, value)
+	if err != nil {
+		return err
+	}
+
+	return i.keys.Set(ctx, Join(ref, pk))
+}
+
+func (i MultiIndex) unreference(ctx sdk.Context, pk, value interface{}) error {
+	ref, err := i.refKey(pk, value)
+	if err != nil {
+		return err
+	}
+
+	return i.keys.Remove(ctx, Join(ref, pk))
+}
+
+// Has returns whether the value of the primary key has the reference key.
+func (i MultiIndex) Has(ctx sdk.Context, ref, pk interface{}) (bool, error) {
+	return i.keys.Has(ctx, Join(ref, pk))
+}
+
+// Iterate calls cb on the primary keys of the values with the reference key, in
+// their order, until cb returns true or an error.
+func (i MultiIndex) Iterate(ctx sdk.Context, ref interface{}, cb func(pk interface{}) (stop bool, err error)) error {
+	return i.keys.Iterate(ctx, &Range{Prefix: ref}, func(key interface{}) (bool, error) {
+		return cb(key.(Pair).K2)
+	})
+}
+
+// UniqueIndex is an index of the primary key of the value of a reference key,
+// which only one value can have.
+type UniqueIndex struct {
+	refs   Map
+	refKey RefKeyFunc
+}
+
+var _ Index = UniqueIndex{}
+
+// NewUniqueIndex returns the index stored under the prefix of the store, whose
+// reference keys are returned by refKey and encoded by refCodec, and whose
+// primary keys are encoded by pkCodec.
+func NewUniqueIndex(storeKey storetypes.StoreKey, prefix []byte, name string, refCodec, pkCodec KeyCodec, refKey RefKeyFunc) UniqueIndex {
+	return UniqueIndex{
+		refs:   NewMap(storeKey, prefix, name, refCodec, keyValue{pkCodec}),
+		refKey: refKey,
+	}
+}
+
+// Name implements Index.
+func (i UniqueIndex) Name() string {
+	return i.refs.Name()
+}
+
+// Prefix implements Index.
+func (i UniqueIndex) Prefix() []byte {
+	return i.refs.Prefix()
+}
+
+func (i UniqueIndex) reference(ctx sdk.Context, pk, value interface{}) error {
+	ref, err := i.refKey(pk, value)
+	if err != nil {
+		return err
+	}
+
+	has, err := i.refs.Has(ctx, ref)
+	if err != nil {
+		return err
+	}
+	if has {
+		return sdkerrors.Wrapf(sdkerrors.ErrConflict, "%s: reference key %s is already used", i.Name(), i.refs.keyCodec.Stringify(ref))
+	}
+
+	return i.refs.Set(ctx, ref, pk)
+}
+
+func (i UniqueIndex) unreference(ctx sdk.Context, pk, value interface{}) error {
+	ref, err := i.refKey(pk, value)
+	if err != nil {
+		return err
+	}
+
+	return i.refs.Remove(ctx, ref)
+}
+
+// Get returns the primary key of the value with the reference key, or
+// ErrNotFound if none.
+func (i UniqueIndex) Get(ctx sdk.Context, ref interface{}) (interface{}, error) {
+	return i.refs.Get(ctx, ref)
+}
+
+// keyValue encodes keys as the values of a map.
+type keyValue struct {
+	KeyCodec
+}
+
+func (c keyValue) Encode(value interface{}) ([]byte, error) {
+	return c.KeyCodec.Encode(value)
+}
+
+func (c keyValue) Decode(bz []byte) (interface{}, error) {
+	return c.KeyCodec.Decode(bz)
+}
+
+func (c keyValue) EncodeJSON(value interface{}) ([]byte, error) {
+	return c.KeyCodec.EncodeJSON(value)
+}
+
+func (c keyValue) DecodeJSON(bz []byte) (interface{}, error) {
+	return c.KeyCodec.DecodeJSON(bz)
+}
+
+// IndexedMap is a Map maintaining secondary indexes of its values. Its indexes
+// are not imported and exported in genesis, but rebuilt from its values.
+type IndexedMap struct {
+	Map
+	indexes []Index
+}
+
+var _ Collection = IndexedMap{}
+
+// NewIndexedMap returns the map maintaining the given indexes.
+func NewIndexedMap(m Map, indexes ...Index) IndexedMap {
+	return IndexedMap{Map: m, indexes: indexes}
+}
+
+// Indexes returns the indexes of the map.
+func (m IndexedMap) Indexes() []Index {
+	return m.indexes
+}
+
+// Set sets the value of a key and updates the indexes. If it returns an error,
+// e.g. as a unique index key is already used, the writes to the store must be
+// discarded like the writes of a failed transaction.
+func (m IndexedMap) Set(ctx sdk.Context, key, value interface{}) error {
+	if err := m.unreference(ctx, key); err != nil {
+		return err
+	}
+
+	for _, index := range m.indexes {
+		if err := index.reference(ctx, key, value); err != nil {
+			return err
+		}
+	}
+
+	return m.Map.Set(ctx, key, value)
+}
+
+// Remove removes the value of a key, if any, from the map and its indexes.
+func (m IndexedMap) Remove(ctx sdk.Context, key interface{}) error {
+	if err := m.unreference(ctx, key); err != nil {
+		return err
+	}
+
+	return m.Map.Remove(ctx, key)
+}
+
+// unreference removes the value of a key, if any, from the indexes.
+func (m IndexedMap) unreference(ctx sdk.Context, key interface{}) error {
+	has, err := m.Map.Has(ctx, key)
+	if err != nil || !has {
+		return err
+	}
+
+	old, err := m.Map.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	for _, index := range m.indexes {
+		if err := index.unreference(ctx, key, old); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m IndexedMap) importGenesis(ctx sdk.Context, bz json.RawMessage) error {
+	return m.decodeGenesis(bz, func(key, value interface{}) error {
+		return m.Set(ctx, key, value)
+	})
+}
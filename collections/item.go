@@ -0,0 +1,132 @@
+package collections
+
+import (
+	"encoding/json"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Item is a collection of a single value, e.g. the parameters of a module.
+type Item struct {
+	collection
+	valueCodec ValueCodec
+}
+
+var _ Collection = Item{}
+
+// NewItem returns the item stored under the prefix of the store, whose value is
+// encoded by the given codec.
+func NewItem(storeKey storetypes.StoreKey, prefix []byte, name string, valueCodec ValueCodec) Item {
+	return Item{
+		collection: newCollection(storeKey, prefix, name),
+		valueCodec: valueCodec,
+	}
+}
+
+// Get returns the value of the item, or ErrNotFound if it is not set.
+func (i Item) Get(ctx sdk.Context) (interface{}, error) {
+	bz := i.store(ctx).Get(i.prefix)
+	if bz == nil {
+		return nil, sdkerrors.Wrap(ErrNotFound, i.name)
+	}
+
+	return i.valueCodec.Decode(bz)
+}
+
+// Set sets the value of the item.
+func (i Item) Set(ctx sdk.Context, value interface{}) error {
+	bz, err := i.valueCodec.Encode(value)
+	if err != nil {
+		return err
+	}
+
+	i.store(ctx).Set(i.prefix, bz)
+	return nil
+}
+
+// Has returns whether the item is set.
+func (i Item) Has(ctx sdk.Context) bool {
+	return i.store(ctx).Has(i.prefix)
+}
+
+// Remove removes the value of the item.
+func (i Item) Remove(ctx sdk.Context) {
+	i.store(ctx).Delete(i.prefix)
+}
+
+func (i Item) exportGenesis(ctx sdk.Context) (json.RawMessage, error) {
+	if !i.Has(ctx) {
+		return json.RawMessage("null"), nil
+	}
+
+	value, err := i.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.valueCodec.EncodeJSON(value)
+}
+
+func (i Item) importGenesis(ctx sdk.Context, bz json.RawMessage) error {
+	if string(bz) == "null" {
+		return nil
+	}
+
+	value, err := i.valueCodec.DecodeJSON(bz)
+	if err != nil {
+		return err
+	}
+
+	return i.Set(ctx, value)
+}
+
+func (i Item) validateGenesis(bz json.RawMessage) error {
+	if string(bz) == "null" {
+		return nil
+	}
+
+	_, err := i.valueCodec.DecodeJSON(bz)
+	return err
+}
+
+// Sequence is a monotonic counter, e.g. to number the entities of a module. It
+// starts at zero.
+type Sequence struct {
+	Item
+}
+
+// NewSequence returns the sequence stored under the prefix of the store.
+func NewSequence(storeKey storetypes.StoreKey, prefix []byte, name string) Sequence {
+	return Sequence{Item: NewItem(storeKey, prefix, name, Uint64Value)}
+}
+
+// Peek returns the current value of the sequence.
+func (s Sequence) Peek(ctx sdk.Context) (uint64, error) {
+	if !s.Has(ctx) {
+		return 0, nil
+	}
+
+	value, err := s.Item.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return value.(uint64), nil
+}
+
+// Next returns the current value of the sequence and increments it.
+func (s Sequence) Next(ctx sdk.Context) (uint64, error) {
+	value, err := s.Peek(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return value, s.Item.Set(ctx, value+1)
+}
+
+// Set sets the current value of the sequence.
+func (s Sequence) Set(ctx sdk.Context, value uint64) error {
+	return s.Item.Set(ctx, value)
+}
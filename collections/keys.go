@@ -0,0 +1,457 @@
+package collections
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// KeyCodec encodes the keys of a collection. The encoded keys sort in the order
+// of the keys, so that the collections are iterated in that order.
+type KeyCodec interface {
+	// Encode encodes a key as the last part of a store key.
+	Encode(key interface{}) ([]byte, error)
+	// Decode decodes a key from the last part of a store key.
+	Decode(bz []byte) (interface{}, error)
+	// EncodeNonTerminal encodes a key followed by other keys in a store key,
+	// e.g. by prefixing it with its length.
+	EncodeNonTerminal(key interface{}) ([]byte, error)
+	// DecodeNonTerminal decodes a key followed by other keys in a store key,
+	// and returns the number of bytes read.
+	DecodeNonTerminal(bz []byte) (int, interface{}, error)
+	// EncodeJSON encodes a key in JSON, e.g. to export it to genesis.
+	EncodeJSON(key interface{}) ([]byte, error)
+	// DecodeJSON decodes a key from JSON.
+	DecodeJSON(bz []byte) (interface{}, error)
+	// Stringify returns the string of a key, e.g. for the errors.
+	Stringify(key interface{}) string
+}
+
+var (
+	// Uint64Key encodes uint64 keys in big endian.
+	Uint64Key KeyCodec = uint64Key{}
+	// StringKey encodes string keys, followed by a zero byte if non terminal.
+	StringKey KeyCodec = stringKey{}
+	// BytesKey encodes []byte keys, prefixed with their length if non terminal.
+	BytesKey KeyCodec = bytesKey{}
+	// AccAddressKey encodes sdk.AccAddress keys like BytesKey.
+	AccAddressKey KeyCodec = addressKey{
+		cast:    func(bz []byte) interface{} { return sdk.AccAddress(bz) },
+		parse:   func(s string) (interface{}, error) { return sdk.AccAddressFromBech32(s) },
+		address: func(key interface{}) ([]byte, bool) { addr, ok := key.(sdk.AccAddress); return addr, ok },
+	}
+	// ValAddressKey encodes sdk.ValAddress keys like BytesKey.
+	ValAddressKey KeyCodec = addressKey{
+		cast:    func(bz []byte) interface{} { return sdk.ValAddress(bz) },
+		parse:   func(s string) (interface{}, error) { return sdk.ValAddressFromBech32(s) },
+		address: func(key interface{}) ([]byte, bool) { addr, ok := key.(sdk.ValAddress); return addr, ok },
+	}
+)
+
+func keyTypeError(key interface{}, expected string) error {
+	return sdkerrors.Wrapf(ErrEncoding, "invalid key type %T, expected %s", key, expected)
+}
+
+type uint64Key struct{}
+
+func (uint64Key) Encode(key interface{}) ([]byte, error) {
+	k, ok := key.(uint64)
+	if !ok {
+		return nil, keyTypeError(key, "uint64")
+	}
+
+	return sdk.Uint64ToBigEndian(k), nil
+}
+
+func (c uint64Key) Decode(bz []byte) (interface{}, error) {
+	n, key, err := c.DecodeNonTerminal(bz)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(bz) {
+		return nil, sdkerrors.Wrapf(ErrEncoding, "invalid uint64 key length %d", len(bz))
+	}
+
+	return key, nil
+}
+
+func (c uint64Key) EncodeNonTerminal(key interface{}) ([]byte, error) {
+	return c.Encode(key)
+}
+
+func (uint64Key) DecodeNonTerminal(bz []byte) (int, interface{}, error) {
+	if len(bz) < 8 {
+		return 0, nil, sdkerrors.Wrapf(ErrEncoding, "invalid uint64 key length %d", len(bz))
+	}
+
+	return 8, binary.BigEndian.Uint64(bz), nil
+}
+
+func (uint64Key) EncodeJSON(key interface{}) ([]byte, error) {
+	k, ok := key.(uint64)
+	if !ok {
+		return nil, keyTypeError(key, "uint64")
+	}
+
+	// the integers are encoded as strings like the proto JSON encoding does
+	return json.Marshal(strconv.FormatUint(k, 10))
+}
+
+func (uint64Key) DecodeJSON(bz []byte) (interface{}, error) {
+	var s string
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return nil, sdkerrors.Wrap(ErrEncoding, err.Error())
+	}
+
+	k, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrEncoding, err.Error())
+	}
+
+	return k, nil
+}
+
+func (uint64Key) Stringify(key interface{}) string {
+	return fmt.Sprint(key)
+}
+
+type stringKey struct{}
+
+func (stringKey) Encode(key interface{}) ([]byte, error) {
+	k, ok := key.(string)
+	if !ok {
+		return nil, keyTypeError(key, "string")
+	}
+
+	return []byte(k), nil
+}
+
+func (stringKey) Decode(bz []byte) (interface{}, error) {
+	return string(bz), nil
+}
+
+func (stringKey) EncodeNonTerminal(key interface{}) ([]byte, error) {
+	k, ok := key.(string)
+	if !ok {
+		return nil, keyTypeError(key, "string")
+	}
+	for i := 0; i < len(k); i++ {
+		if k[i] == 0 {
+			return nil, sdkerrors.Wrapf(ErrEncoding, "non terminal string key %q contains a zero byte", k)
+		}
+	}
+
+	return append([]byte(k), 0), nil
+}
+
+func (stringKey) DecodeNonTerminal(bz []byte) (int, interface{}, error) {
+	for i, b := range bz {
+		if b == 0 {
+			return i + 1, string(bz[:i]), nil
+		}
+	}
+
+	return 0, nil, sdkerrors.Wrap(ErrEncoding, "non terminal string key is not terminated")
+}
+
+func (stringKey) EncodeJSON(key interface{}) ([]byte, error) {
+	k, ok := key.(string)
+	if !ok {
+		return nil, keyTypeError(key, "string")
+	}
+
+	return json.Marshal(k)
+}
+
+func (stringKey) DecodeJSON(bz []byte) (interface{}, error) {
+	var k string
+	if err := json.Unmarshal(bz, &k); err != nil {
+		return nil, sdkerrors.Wrap(ErrEncoding, err.Error())
+	}
+
+	return k, nil
+}
+
+func (stringKey) Stringify(key interface{}) string {
+	return fmt.Sprint(key)
+}
+
+// encodeLengthPrefixed prefixes bytes with their length.
+func encodeLengthPrefixed(bz []byte) ([]byte, error) {
+	if len(bz) > math.MaxUint8 {
+		return nil, sdkerrors.Wrapf(ErrEncoding, "non terminal key length %d exceeds %d", len(bz), math.MaxUint8)
+	}
+
+	return append([]byte{byte(len(bz))}, bz...), nil
+}
+
+// decodeLengthPrefixed decodes bytes prefixed with their length.
+func decodeLengthPrefixed(bz []byte) (int, []byte, error) {
+	if len(bz) == 0 || len(bz) < 1+int(bz[0]) {
+		return 0, nil, sdkerrors.Wrap(ErrEncoding, "invalid length prefixed key")
+	}
+
+	n := 1 + int(bz[0])
+	return n, append([]byte{}, bz[1:n]...), nil
+}
+
+type bytesKey struct{}
+
+func (bytesKey) Encode(key interface{}) ([]byte, error) {
+	k, ok := key.([]byte)
+	if !ok {
+		return nil, keyTypeError(key, "[]byte")
+	}
+
+	return k, nil
+}
+
+func (bytesKey) Decode(bz []byte) (interface{}, error) {
+	return append([]byte{}, bz...), nil
+}
+
+func (bytesKey) EncodeNonTerminal(key interface{}) ([]byte, error) {
+	k, ok := key.([]byte)
+	if !ok {
+		return nil, keyTypeError(key, "[]byte")
+	}
+
+	return encodeLengthPrefixed(k)
+}
+
+func (bytesKey) DecodeNonTerminal(bz []byte) (int, interface{}, error) {
+	return decodeLengthPrefixed(bz)
+}
+
+func (bytesKey) EncodeJSON(key interface{}) ([]byte, error) {
+	k, ok := key.([]byte)
+	if !ok {
+		return nil, keyTypeError(key, "[]byte")
+	}
+
+	return json.Marshal(k)
+}
+
+func (bytesKey) DecodeJSON(bz []byte) (interface{}, error) {
+	var k []byte
+	if err := json.Unmarshal(bz, &k); err != nil {
+		return nil, sdkerrors.Wrap(ErrEncoding, err.Error())
+	}
+
+	return k, nil
+}
+
+func (bytesKey) Stringify(key interface{}) string {
+	return fmt.Sprintf("%X", key)
+}
+
+// addressKey encodes the keys of an address type, in bech32 in JSON.
+type addressKey struct {
+	cast    func([]byte) interface{}
+	parse   func(string) (interface{}, error)
+	address func(interface{}) ([]byte, bool)
+}
+
+func (c addressKey) bytes(key interface{}) ([]byte, error) {
+	addr, ok := c.address(key)
+	if !ok {
+		return nil, keyTypeError(key, fmt.Sprintf("%T", c.cast(nil)))
+	}
+
+	return addr, nil
+}
+
+func (c addressKey) Encode(key interface{}) ([]byte, error) {
+	return c.bytes(key)
+}
+
+func (c addressKey) Decode(bz []byte) (interface{}, error) {
+	return c.cast(append([]byte{}, bz...)), nil
+}
+
+func (c addressKey) EncodeNonTerminal(key interface{}) ([]byte, error) {
+	addr, err := c.bytes(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeLengthPrefixed(addr)
+}
+
+func (c addressKey) DecodeNonTerminal(bz []byte) (int, interface{}, error) {
+	n, addr, err := decodeLengthPrefixed(bz)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return n, c.cast(addr), nil
+}
+
+func (c addressKey) EncodeJSON(key interface{}) ([]byte, error) {
+	if _, err := c.bytes(key); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(key.(fmt.Stringer).String())
+}
+
+func (c addressKey) DecodeJSON(bz []byte) (interface{}, error) {
+	var s string
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return nil, sdkerrors.Wrap(ErrEncoding, err.Error())
+	}
+
+	addr, err := c.parse(s)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrEncoding, err.Error())
+	}
+
+	return addr, nil
+}
+
+func (c addressKey) Stringify(key interface{}) string {
+	return fmt.Sprint(key)
+}
+
+// Pair is a key made of two keys, e.g. to key the entries of a Map by owner and
+// denom, so that the entries of an owner can be iterated over.
+type Pair struct {
+	K1 interface{}
+	K2 interface{}
+}
+
+// Join returns the pair of two keys.
+func Join(k1, k2 interface{}) Pair {
+	return Pair{K1: k1, K2: k2}
+}
+
+// PairKeyCodec returns the codec of the pairs of keys of the two codecs.
+func PairKeyCodec(k1, k2 KeyCodec) KeyCodec {
+	return pairKey{k1: k1, k2: k2}
+}
+
+type pairKey struct {
+	k1, k2 KeyCodec
+}
+
+func (c pairKey) pair(key interface{}) (Pair, error) {
+	pair, ok := key.(Pair)
+	if !ok {
+		return Pair{}, keyTypeError(key, "collections.Pair")
+	}
+
+	return pair, nil
+}
+
+func (c pairKey) encode(key interface{}, terminal bool) ([]byte, error) {
+	pair, err := c.pair(key)
+	if err != nil {
+		return nil, err
+	}
+
+	bz1, err := c.k1.EncodeNonTerminal(pair.K1)
+	if err != nil {
+		return nil, err
+	}
+
+	var bz2 []byte
+	if terminal {
+		bz2, err = c.k2.Encode(pair.K2)
+	} else {
+		bz2, err = c.k2.EncodeNonTerminal(pair.K2)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return append(bz1, bz2...), nil
+}
+
+func (c pairKey) Encode(key interface{}) ([]byte, error) {
+	return c.encode(key, true)
+}
+
+func (c pairKey) Decode(bz []byte) (interface{}, error) {
+	n, k1, err := c.k1.DecodeNonTerminal(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	k2, err := c.k2.Decode(bz[n:])
+	if err != nil {
+		return nil, err
+	}
+
+	return Join(k1, k2), nil
+}
+
+func (c pairKey) EncodeNonTerminal(key interface{}) ([]byte, error) {
+	return c.encode(key, false)
+}
+
+func (c pairKey) DecodeNonTerminal(bz []byte) (int, interface{}, error) {
+	n1, k1, err := c.k1.DecodeNonTerminal(bz)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	n2, k2, err := c.k2.DecodeNonTerminal(bz[n1:])
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return n1 + n2, Join(k1, k2), nil
+}
+
+func (c pairKey) EncodeJSON(key interface{}) ([]byte, error) {
+	pair, err := c.pair(key)
+	if err != nil {
+		return nil, err
+	}
+
+	bz1, err := c.k1.EncodeJSON(pair.K1)
+	if err != nil {
+		return nil, err
+	}
+	bz2, err := c.k2.EncodeJSON(pair.K2)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal([]json.RawMessage{bz1, bz2})
+}
+
+func (c pairKey) DecodeJSON(bz []byte) (interface{}, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, sdkerrors.Wrap(ErrEncoding, err.Error())
+	}
+	if len(raw) != 2 {
+		return nil, sdkerrors.Wrapf(ErrEncoding, "pair key has %d elements", len(raw))
+	}
+
+	k1, err := c.k1.DecodeJSON(raw[0])
+	if err != nil {
+		return nil, err
+	}
+	k2, err := c.k2.DecodeJSON(raw[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return Join(k1, k2), nil
+}
+
+func (c pairKey) Stringify(key interface{}) string {
+	pair, err := c.pair(key)
+	if err != nil {
+		return fmt.Sprint(key)
+	}
+
+	return fmt.Sprintf("(%s, %s)", c.k1.Stringify(pair.K1), c.k2.Stringify(pair.K2))
+}
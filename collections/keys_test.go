@@ -0,0 +1,69 @@
+package collections_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestKeyCodecs(t *testing.T) {
+	for name, tc := range map[string]struct {
+		codec collections.KeyCodec
+		key   interface{}
+	}{
+		"uint64":      {collections.Uint64Key, uint64(42)},
+		"string":      {collections.StringKey, "denom"},
+		"bytes":       {collections.BytesKey, []byte{1, 2, 3}},
+		"acc address": {collections.AccAddressKey, sdk.AccAddress{1, 2, 3}},
+		"val address": {collections.ValAddressKey, sdk.ValAddress{4, 5}},
+		"pair": {
+			collections.PairKeyCodec(collections.AccAddressKey, collections.StringKey),
+			collections.Join(sdk.AccAddress{1, 2}, "denom"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			bz, err := tc.codec.Encode(tc.key)
+			require.NoError(t, err)
+			key, err := tc.codec.Decode(bz)
+			require.NoError(t, err)
+			require.Equal(t, tc.key, key)
+
+			// the non terminal keys are delimited
+			bz, err = tc.codec.EncodeNonTerminal(tc.key)
+			require.NoError(t, err)
+			n, key, err := tc.codec.DecodeNonTerminal(append(bz, 0xff))
+			require.NoError(t, err)
+			require.Equal(t, len(bz), n)
+			require.Equal(t, tc.key, key)
+
+			bz, err = tc.codec.EncodeJSON(tc.key)
+			require.NoError(t, err)
+			key, err = tc.codec.DecodeJSON(bz)
+			require.NoError(t, err)
+			require.Equal(t, tc.key, key)
+
+			_, err = tc.codec.Encode(struct{}{})
+			require.ErrorIs(t, err, collections.ErrEncoding)
+		})
+	}
+}
+
+func TestUint64KeyOrder(t *testing.T) {
+	a, err := collections.Uint64Key.Encode(uint64(255))
+	require.NoError(t, err)
+	b, err := collections.Uint64Key.Encode(uint64(256))
+	require.NoError(t, err)
+	require.Equal(t, -1, bytes.Compare(a, b))
+}
+
+func TestStringKeyNonTerminal(t *testing.T) {
+	_, err := collections.StringKey.EncodeNonTerminal("a\x00b")
+	require.ErrorIs(t, err, collections.ErrEncoding)
+
+	_, _, err = collections.StringKey.DecodeNonTerminal([]byte("abc"))
+	require.ErrorIs(t, err, collections.ErrEncoding)
+}
@@ -0,0 +1,132 @@
+package collections
+
+import (
+	"encoding/json"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// noValue encodes the empty values of the entries of a KeySet.
+type noValue struct{}
+
+func (noValue) Encode(interface{}) ([]byte, error) { return []byte{}, nil }
+
+func (noValue) Decode(bz []byte) (interface{}, error) {
+	if len(bz) != 0 {
+		return nil, sdkerrors.Wrapf(ErrEncoding, "key set value of length %d", len(bz))
+	}
+
+	return nil, nil
+}
+
+func (noValue) EncodeJSON(interface{}) ([]byte, error) { return []byte("null"), nil }
+
+func (noValue) DecodeJSON([]byte) (interface{}, error) { return nil, nil }
+
+// KeySet is a collection of a set of keys.
+type KeySet struct {
+	m Map
+}
+
+var _ Collection = KeySet{}
+
+// NewKeySet returns the set of keys stored under the prefix of the store, which
+// are encoded by the given codec.
+func NewKeySet(storeKey storetypes.StoreKey, prefix []byte, name string, keyCodec KeyCodec) KeySet {
+	return KeySet{m: NewMap(storeKey, prefix, name, keyCodec, noValue{})}
+}
+
+// Name implements Collection.
+func (s KeySet) Name() string {
+	return s.m.Name()
+}
+
+// Prefix implements Collection.
+func (s KeySet) Prefix() []byte {
+	return s.m.Prefix()
+}
+
+// Set adds a key to the set.
+func (s KeySet) Set(ctx sdk.Context, key interface{}) error {
+	return s.m.Set(ctx, key, nil)
+}
+
+// Has returns whether a key is in the set.
+func (s KeySet) Has(ctx sdk.Context, key interface{}) (bool, error) {
+	return s.m.Has(ctx, key)
+}
+
+// Remove removes a key from the set.
+func (s KeySet) Remove(ctx sdk.Context, key interface{}) error {
+	return s.m.Remove(ctx, key)
+}
+
+// Iterate calls cb on the keys of the range, or of the whole set if nil, in
+// their order, until cb returns true or an error.
+func (s KeySet) Iterate(ctx sdk.Context, r *Range, cb func(key interface{}) (stop bool, err error)) error {
+	return s.m.Iterate(ctx, r, func(key, _ interface{}) (bool, error) {
+		return cb(key)
+	})
+}
+
+// Paginate calls cb on the keys of the page of the range, or of the whole set
+// if nil.
+func (s KeySet) Paginate(ctx sdk.Context, pageReq *query.PageRequest, r *Range, cb func(key interface{}) error) (*query.PageResponse, error) {
+	return s.m.Paginate(ctx, pageReq, r, func(key, _ interface{}) error {
+		return cb(key)
+	})
+}
+
+func (s KeySet) exportGenesis(ctx sdk.Context) (json.RawMessage, error) {
+	keys := []json.RawMessage{}
+	err := s.Iterate(ctx, nil, func(key interface{}) (bool, error) {
+		bz, err := s.m.keyCodec.EncodeJSON(key)
+		if err != nil {
+			return true, err
+		}
+
+		keys = append(keys, bz)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(keys)
+}
+
+// decodeGenesis calls cb on the keys of the set in genesis.
+func (s KeySet) decodeGenesis(bz json.RawMessage, cb func(key interface{}) error) error {
+	var keys []json.RawMessage
+	if err := json.Unmarshal(bz, &keys); err != nil {
+		return sdkerrors.Wrapf(ErrEncoding, "%s: %s", s.Name(), err)
+	}
+
+	for _, keyBz := range keys {
+		key, err := s.m.keyCodec.DecodeJSON(keyBz)
+		if err != nil {
+			return err
+		}
+		if err := cb(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s KeySet) importGenesis(ctx sdk.Context, bz json.RawMessage) error {
+	return s.decodeGenesis(bz, func(key interface{}) error {
+		return s.Set(ctx, key)
+	})
+}
+
+func (s KeySet) validateGenesis(bz json.RawMessage) error {
+	return s.decodeGenesis(bz, func(key interface{}) error {
+		_, err := s.m.keyCodec.Encode(key)
+		return err
+	})
+}
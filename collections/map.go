@@ -0,0 +1,255 @@
+package collections
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Range restricts the iteration over a collection.
+type Range struct {
+	// Prefix restricts the iteration to the keys whose first key is Prefix, if
+	// not nil. It requires the keys of the collection to be pairs.
+	Prefix interface{}
+	// Reverse iterates in descending order of the keys.
+	Reverse bool
+}
+
+// Map is a collection of values stored under keys.
+type Map struct {
+	collection
+	keyCodec   KeyCodec
+	valueCodec ValueCodec
+}
+
+var _ Collection = Map{}
+
+// NewMap returns the map stored under the prefix of the store, whose keys and
+// values are encoded by the given codecs.
+func NewMap(storeKey storetypes.StoreKey, prefix []byte, name string, keyCodec KeyCodec, valueCodec ValueCodec) Map {
+	return Map{
+		collection: newCollection(storeKey, prefix, name),
+		keyCodec:   keyCodec,
+		valueCodec: valueCodec,
+	}
+}
+
+// KeyCodec returns the codec of the keys of the map.
+func (m Map) KeyCodec() KeyCodec {
+	return m.keyCodec
+}
+
+// ValueCodec returns the codec of the values of the map.
+func (m Map) ValueCodec() ValueCodec {
+	return m.valueCodec
+}
+
+func (m Map) storeKey(key interface{}) ([]byte, error) {
+	bz, err := m.keyCodec.Encode(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.key(bz), nil
+}
+
+// Set sets the value of a key.
+func (m Map) Set(ctx sdk.Context, key, value interface{}) error {
+	storeKey, err := m.storeKey(key)
+	if err != nil {
+		return err
+	}
+
+	bz, err := m.valueCodec.Encode(value)
+	if err != nil {
+		return err
+	}
+
+	m.store(ctx).Set(storeKey, bz)
+	return nil
+}
+
+// Get returns the value of a key, or ErrNotFound if the key has no value.
+func (m Map) Get(ctx sdk.Context, key interface{}) (interface{}, error) {
+	storeKey, err := m.storeKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	bz := m.store(ctx).Get(storeKey)
+	if bz == nil {
+		return nil, sdkerrors.Wrapf(ErrNotFound, "%s: key %s", m.name, m.keyCodec.Stringify(key))
+	}
+
+	return m.valueCodec.Decode(bz)
+}
+
+// Has returns whether a key has a value.
+func (m Map) Has(ctx sdk.Context, key interface{}) (bool, error) {
+	storeKey, err := m.storeKey(key)
+	if err != nil {
+		return false, err
+	}
+
+	return m.store(ctx).Has(storeKey), nil
+}
+
+// Remove removes the value of a key, if any.
+func (m Map) Remove(ctx sdk.Context, key interface{}) error {
+	storeKey, err := m.storeKey(key)
+	if err != nil {
+		return err
+	}
+
+	m.store(ctx).Delete(storeKey)
+	return nil
+}
+
+// rangePrefix returns the prefix of the encoded keys of a range.
+func (m Map) rangePrefix(r *Range) ([]byte, error) {
+	if r == nil || r.Prefix == nil {
+		return nil, nil
+	}
+
+	pairCodec, ok := m.keyCodec.(pairKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrEncoding, "%s: range prefix requires pair keys", m.name)
+	}
+
+	return pairCodec.k1.EncodeNonTerminal(r.Prefix)
+}
+
+// Iterate calls cb on the keys and values of the range, or of the whole map if
+// nil, in the order of their keys, until cb returns true or an error.
+func (m Map) Iterate(ctx sdk.Context, r *Range, cb func(key, value interface{}) (stop bool, err error)) error {
+	rangePrefix, err := m.rangePrefix(r)
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(m.store(ctx), m.key(rangePrefix))
+	var iter sdk.Iterator
+	if r != nil && r.Reverse {
+		iter = store.ReverseIterator(nil, nil)
+	} else {
+		iter = store.Iterator(nil, nil)
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, err := m.keyCodec.Decode(append(append([]byte{}, rangePrefix...), iter.Key()...))
+		if err != nil {
+			return err
+		}
+		value, err := m.valueCodec.Decode(iter.Value())
+		if err != nil {
+			return err
+		}
+
+		stop, err := cb(key, value)
+		if err != nil || stop {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Paginate calls cb on the keys and values of the page of the range, or of the
+// whole map if nil. The order of the page is set by the page request, so the
+// range must not be reversed.
+func (m Map) Paginate(ctx sdk.Context, pageReq *query.PageRequest, r *Range, cb func(key, value interface{}) error) (*query.PageResponse, error) {
+	if r != nil && r.Reverse {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s: the order of a page is set by its request", m.name)
+	}
+
+	rangePrefix, err := m.rangePrefix(r)
+	if err != nil {
+		return nil, err
+	}
+
+	store := prefix.NewStore(m.store(ctx), m.key(rangePrefix))
+	return query.Paginate(store, pageReq, func(keyBz, valueBz []byte) error {
+		key, err := m.keyCodec.Decode(append(append([]byte{}, rangePrefix...), keyBz...))
+		if err != nil {
+			return err
+		}
+		value, err := m.valueCodec.Decode(valueBz)
+		if err != nil {
+			return err
+		}
+
+		return cb(key, value)
+	})
+}
+
+// genesisEntry is an entry of a map in genesis.
+type genesisEntry struct {
+	Key   json.RawMessage `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+func (m Map) exportGenesis(ctx sdk.Context) (json.RawMessage, error) {
+	entries := []genesisEntry{}
+	err := m.Iterate(ctx, nil, func(key, value interface{}) (bool, error) {
+		keyBz, err := m.keyCodec.EncodeJSON(key)
+		if err != nil {
+			return true, err
+		}
+		valueBz, err := m.valueCodec.EncodeJSON(value)
+		if err != nil {
+			return true, err
+		}
+
+		entries = append(entries, genesisEntry{Key: keyBz, Value: valueBz})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(entries)
+}
+
+// decodeGenesis calls cb on the keys and values of the map in genesis.
+func (m Map) decodeGenesis(bz json.RawMessage, cb func(key, value interface{}) error) error {
+	var entries []genesisEntry
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return sdkerrors.Wrapf(ErrEncoding, "%s: %s", m.name, err)
+	}
+
+	for _, entry := range entries {
+		key, err := m.keyCodec.DecodeJSON(entry.Key)
+		if err != nil {
+			return err
+		}
+		value, err := m.valueCodec.DecodeJSON(entry.Value)
+		if err != nil {
+			return err
+		}
+
+		if err := cb(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m Map) importGenesis(ctx sdk.Context, bz json.RawMessage) error {
+	return m.decodeGenesis(bz, func(key, value interface{}) error {
+		return m.Set(ctx, key, value)
+	})
+}
+
+func (m Map) validateGenesis(bz json.RawMessage) error {
+	return m.decodeGenesis(bz, func(key, value interface{}) error {
+		// the keys must be encodable in the store
+		_, err := m.keyCodec.Encode(key)
+		return err
+	})
+}
@@ -0,0 +1,123 @@
+package collections
+
+import (
+	"bytes"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Schema is the set of the collections of a module, which imports and exports
+// their state from and to genesis, as a JSON object of the states of the
+// collections by name.
+type Schema struct {
+	collections []Collection
+}
+
+// NewSchema returns the schema of the collections. It returns ErrInvalidSchema
+// if two collections or indexes have the same name or overlapping prefixes.
+func NewSchema(collections ...Collection) (Schema, error) {
+	type entry struct {
+		name   string
+		prefix []byte
+	}
+
+	var entries []entry
+	for _, c := range collections {
+		entries = append(entries, entry{c.Name(), c.Prefix()})
+		if m, ok := c.(IndexedMap); ok {
+			for _, index := range m.Indexes() {
+				entries = append(entries, entry{index.Name(), index.Prefix()})
+			}
+		}
+	}
+
+	for i, a := range entries {
+		if len(a.prefix) == 0 {
+			return Schema{}, sdkerrors.Wrapf(ErrInvalidSchema, "collection %s has an empty prefix", a.name)
+		}
+
+		for _, b := range entries[:i] {
+			if a.name == b.name {
+				return Schema{}, sdkerrors.Wrapf(ErrInvalidSchema, "duplicate collection name %s", a.name)
+			}
+			if bytes.HasPrefix(a.prefix, b.prefix) || bytes.HasPrefix(b.prefix, a.prefix) {
+				return Schema{}, sdkerrors.Wrapf(ErrInvalidSchema, "prefixes of collections %s and %s overlap", b.name, a.name)
+			}
+		}
+	}
+
+	return Schema{collections: collections}, nil
+}
+
+// ExportGenesis returns the state of the collections.
+func (s Schema) ExportGenesis(ctx sdk.Context) (json.RawMessage, error) {
+	states := make(map[string]json.RawMessage, len(s.collections))
+	for _, c := range s.collections {
+		state, err := c.exportGenesis(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		states[c.Name()] = state
+	}
+
+	return json.Marshal(states)
+}
+
+// decodeGenesis returns the states of the collections, which are all optional.
+func (s Schema) decodeGenesis(bz json.RawMessage) (map[string]json.RawMessage, error) {
+	var states map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &states); err != nil {
+		return nil, sdkerrors.Wrap(ErrEncoding, err.Error())
+	}
+
+	for name := range states {
+		var found bool
+		for _, c := range s.collections {
+			found = found || c.Name() == name
+		}
+		if !found {
+			return nil, sdkerrors.Wrapf(ErrEncoding, "unknown collection %s", name)
+		}
+	}
+
+	return states, nil
+}
+
+// ValidateGenesis validates the state of the collections.
+func (s Schema) ValidateGenesis(bz json.RawMessage) error {
+	states, err := s.decodeGenesis(bz)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range s.collections {
+		if state, ok := states[c.Name()]; ok {
+			if err := c.validateGenesis(state); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// InitGenesis imports the state of the collections.
+func (s Schema) InitGenesis(ctx sdk.Context, bz json.RawMessage) error {
+	states, err := s.decodeGenesis(bz)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range s.collections {
+		if state, ok := states[c.Name()]; ok {
+			if err := c.importGenesis(ctx, state); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package collections
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValueCodec encodes the values of a collection.
+type ValueCodec interface {
+	// Encode encodes a value.
+	Encode(value interface{}) ([]byte, error)
+	// Decode decodes a value.
+	Decode(bz []byte) (interface{}, error)
+	// EncodeJSON encodes a value in JSON, e.g. to export it to genesis.
+	EncodeJSON(value interface{}) ([]byte, error)
+	// DecodeJSON decodes a value from JSON.
+	DecodeJSON(bz []byte) (interface{}, error)
+}
+
+func valueTypeError(value interface{}, expected string) error {
+	return sdkerrors.Wrapf(ErrEncoding, "invalid value type %T, expected %s", value, expected)
+}
+
+// Uint64Value encodes uint64 values in big endian.
+var Uint64Value ValueCodec = uint64Value{}
+
+type uint64Value struct{}
+
+func (uint64Value) Encode(value interface{}) ([]byte, error) {
+	v, ok := value.(uint64)
+	if !ok {
+		return nil, valueTypeError(value, "uint64")
+	}
+
+	return sdk.Uint64ToBigEndian(v), nil
+}
+
+func (uint64Value) Decode(bz []byte) (interface{}, error) {
+	if len(bz) != 8 {
+		return nil, sdkerrors.Wrapf(ErrEncoding, "invalid uint64 value length %d", len(bz))
+	}
+
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+func (uint64Value) EncodeJSON(value interface{}) ([]byte, error) {
+	v, ok := value.(uint64)
+	if !ok {
+		return nil, valueTypeError(value, "uint64")
+	}
+
+	return json.Marshal(strconv.FormatUint(v, 10))
+}
+
+func (uint64Value) DecodeJSON(bz []byte) (interface{}, error) {
+	return Uint64Key.DecodeJSON(bz)
+}
+
+// jsonCodec returns the JSON codec of a binary codec, if it is one.
+func jsonCodec(cdc codec.BinaryCodec) (codec.JSONCodec, error) {
+	jsonCdc, ok := cdc.(codec.JSONCodec)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrEncoding, "codec %T does not encode JSON", cdc)
+	}
+
+	return jsonCdc, nil
+}
+
+// ProtoValue returns the codec of the values of a proto message type, whose
+// new instances are returned by newValue. The values are encoded in JSON if
+// the codec is also a JSON codec.
+func ProtoValue(cdc codec.BinaryCodec, newValue func() codec.ProtoMarshaler) ValueCodec {
+	return protoValue{cdc: cdc, newValue: newValue}
+}
+
+type protoValue struct {
+	cdc      codec.BinaryCodec
+	newValue func() codec.ProtoMarshaler
+}
+
+func (c protoValue) message(value interface{}) (codec.ProtoMarshaler, error) {
+	msg, ok := value.(codec.ProtoMarshaler)
+	if !ok || reflect.TypeOf(msg) != reflect.TypeOf(c.newValue()) {
+		return nil, valueTypeError(value, fmt.Sprintf("%T", c.newValue()))
+	}
+
+	return msg, nil
+}
+
+func (c protoValue) Encode(value interface{}) ([]byte, error) {
+	msg, err := c.message(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.cdc.Marshal(msg)
+}
+
+func (c protoValue) Decode(bz []byte) (interface{}, error) {
+	msg := c.newValue()
+	if err := c.cdc.Unmarshal(bz, msg); err != nil {
+		return nil, sdkerrors.Wrap(ErrEncoding, err.Error())
+	}
+
+	return msg, nil
+}
+
+func (c protoValue) EncodeJSON(value interface{}) ([]byte, error) {
+	msg, err := c.message(value)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonCdc, err := jsonCodec(c.cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonCdc.MarshalJSON(msg)
+}
+
+func (c protoValue) DecodeJSON(bz []byte) (interface{}, error) {
+	jsonCdc, err := jsonCodec(c.cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := c.newValue()
+	if err := jsonCdc.UnmarshalJSON(bz, msg); err != nil {
+		return nil, sdkerrors.Wrap(ErrEncoding, err.Error())
+	}
+
+	return msg, nil
+}
+
+// InterfaceValue returns the codec of the values of an interface type packed
+// into Any, e.g. the accounts. newPtr returns a new pointer to the interface
+// type, e.g. new(types.AccountI). The values are encoded in JSON if the codec
+// is also a JSON codec.
+func InterfaceValue(cdc codec.BinaryCodec, newPtr func() interface{}) ValueCodec {
+	return interfaceValue{cdc: cdc, newPtr: newPtr}
+}
+
+type interfaceValue struct {
+	cdc    codec.BinaryCodec
+	newPtr func() interface{}
+}
+
+func (c interfaceValue) message(value interface{}) (proto.Message, error) {
+	iface := reflect.TypeOf(c.newPtr()).Elem()
+	msg, ok := value.(proto.Message)
+	if !ok || !reflect.TypeOf(value).Implements(iface) {
+		return nil, valueTypeError(value, iface.String())
+	}
+
+	return msg, nil
+}
+
+func (c interfaceValue) Encode(value interface{}) ([]byte, error) {
+	msg, err := c.message(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.cdc.MarshalInterface(msg)
+}
+
+func (c interfaceValue) Decode(bz []byte) (interface{}, error) {
+	ptr := c.newPtr()
+	if err := c.cdc.UnmarshalInterface(bz, ptr); err != nil {
+		return nil, sdkerrors.Wrap(ErrEncoding, err.Error())
+	}
+
+	return reflect.ValueOf(ptr).Elem().Interface(), nil
+}
+
+func (c interfaceValue) EncodeJSON(value interface{}) ([]byte, error) {
+	msg, err := c.message(value)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonCdc, err := jsonCodec(c.cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonCdc.MarshalInterfaceJSON(msg)
+}
+
+func (c interfaceValue) DecodeJSON(bz []byte) (interface{}, error) {
+	jsonCdc, err := jsonCodec(c.cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	ptr := c.newPtr()
+	if err := jsonCdc.UnmarshalInterfaceJSON(bz, ptr); err != nil {
+		return nil, sdkerrors.Wrap(ErrEncoding, err.Error())
+	}
+
+	return reflect.ValueOf(ptr).Elem().Interface(), nil
+}
@@ -25,6 +25,11 @@ const (
 	EnvSkipBackup           = "UNSAFE_SKIP_BACKUP"
 	EnvInterval             = "DAEMON_POLL_INTERVAL"
 	EnvPreupgradeMaxRetries = "DAEMON_PREUPGRADE_MAX_RETRIES"
+	EnvDownloadTimeout      = "DAEMON_DOWNLOAD_TIMEOUT"
+	EnvDownloadRetries      = "DAEMON_DOWNLOAD_RETRIES"
+	EnvDownloadProxy        = "DAEMON_DOWNLOAD_PROXY"
+	EnvTrustedKeys          = "DAEMON_UPGRADE_TRUSTED_KEYS"
+	EnvSignatureThreshold   = "DAEMON_UPGRADE_SIGNATURE_THRESHOLD"
 )
 
 const (
@@ -48,6 +53,17 @@ type Config struct {
 	UnsafeSkipBackup      bool
 	PreupgradeMaxRetries  int
 
+	// download of the upgrade binaries, the zero values default to
+	// DefaultDownloadConfig
+	DownloadTimeout time.Duration
+	DownloadRetries int
+	DownloadProxy   string
+
+	// keys trusted to sign the binaries of the plan infos, of which at least
+	// SignatureThreshold must sign them if set
+	TrustedKeys        []TrustedKey
+	SignatureThreshold int
+
 	// currently running upgrade
 	currentUpgrade UpgradeInfo
 }
@@ -167,6 +183,35 @@ func GetConfigFromEnv() (*Config, error) {
 		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvPreupgradeMaxRetries, err))
 	}
 
+	if timeout := os.Getenv(EnvDownloadTimeout); timeout != "" {
+		if cfg.DownloadTimeout, err = time.ParseDuration(timeout); err != nil || cfg.DownloadTimeout <= 0 {
+			errs = append(errs, fmt.Errorf("invalid %s: could not parse \"%s\" into a positive duration", EnvDownloadTimeout, timeout))
+		}
+	}
+	if retries := os.Getenv(EnvDownloadRetries); retries != "" {
+		if cfg.DownloadRetries, err = strconv.Atoi(retries); err != nil || cfg.DownloadRetries < 1 {
+			errs = append(errs, fmt.Errorf("invalid %s: could not parse \"%s\" into a positive int", EnvDownloadRetries, retries))
+		}
+	}
+	cfg.DownloadProxy = os.Getenv(EnvDownloadProxy)
+	if keys := os.Getenv(EnvTrustedKeys); keys != "" {
+		for _, key := range strings.Split(keys, ",") {
+			pubKey, err := ParseTrustedKey(strings.TrimSpace(key))
+			if err != nil {
+				errs = append(errs, fmt.Errorf("invalid %s: %w", EnvTrustedKeys, err))
+				continue
+			}
+			cfg.TrustedKeys = append(cfg.TrustedKeys, pubKey)
+		}
+
+		cfg.SignatureThreshold = 1
+		if threshold := os.Getenv(EnvSignatureThreshold); threshold != "" {
+			if cfg.SignatureThreshold, err = strconv.Atoi(threshold); err != nil || cfg.SignatureThreshold < 1 || cfg.SignatureThreshold > len(cfg.TrustedKeys) {
+				errs = append(errs, fmt.Errorf("invalid %s: must be between 1 and the number of trusted keys, got \"%s\"", EnvSignatureThreshold, threshold))
+			}
+		}
+	}
+
 	errs = append(errs, cfg.validate()...)
 
 	if len(errs) > 0 {
@@ -175,6 +220,22 @@ func GetConfigFromEnv() (*Config, error) {
 	return cfg, nil
 }
 
+// DownloadConfig returns the config of the downloads of the upgrade binaries.
+func (cfg *Config) DownloadConfig() DownloadConfig {
+	downloadCfg := DefaultDownloadConfig()
+	if cfg.DownloadTimeout > 0 {
+		downloadCfg.Timeout = cfg.DownloadTimeout
+	}
+	if cfg.DownloadRetries > 0 {
+		downloadCfg.Retries = cfg.DownloadRetries
+	}
+	downloadCfg.ProxyURL = cfg.DownloadProxy
+	downloadCfg.TrustedKeys = cfg.TrustedKeys
+	downloadCfg.SignatureThreshold = cfg.SignatureThreshold
+
+	return downloadCfg
+}
+
 // LogConfigOrError logs either the config details or the error.
 func LogConfigOrError(logger zerolog.Logger, cfg *Config, cerr error) {
 	switch {
@@ -309,6 +370,11 @@ func (cfg Config) DetailString() string {
 		{EnvInterval, fmt.Sprintf("%s", cfg.PollInterval)},
 		{EnvSkipBackup, fmt.Sprintf("%t", cfg.UnsafeSkipBackup)},
 		{EnvPreupgradeMaxRetries, fmt.Sprintf("%d", cfg.PreupgradeMaxRetries)},
+		{EnvDownloadTimeout, fmt.Sprintf("%s", cfg.DownloadConfig().Timeout)},
+		{EnvDownloadRetries, fmt.Sprintf("%d", cfg.DownloadConfig().Retries)},
+		{EnvDownloadProxy, cfg.DownloadProxy},
+		{EnvTrustedKeys, fmt.Sprintf("%d keys", len(cfg.TrustedKeys))},
+		{EnvSignatureThreshold, fmt.Sprintf("%d", cfg.SignatureThreshold)},
 	}
 	derivedEntries := []struct{ name, value string }{
 		{"Root Dir", cfg.Root()},
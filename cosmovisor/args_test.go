@@ -2,6 +2,7 @@ package cosmovisor
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"os"
@@ -9,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -513,6 +515,56 @@ func (s *argsTestSuite) TestGetConfigFromEnv() {
 	}
 }
 
+func (s *argsTestSuite) TestGetConfigFromEnvTrustedKeys() {
+	initialEnv := s.clearEnv()
+	defer s.setEnv(nil, initialEnv)
+
+	absPath, err := filepath.Abs(filepath.Join("testdata", "validate"))
+	s.Require().NoError(err)
+	s.setEnv(s.T(), &cosmovisorEnv{Home: absPath, Name: "testname"})
+
+	genKey := func() []byte {
+		priv, err := btcec.NewPrivateKey(btcec.S256())
+		s.Require().NoError(err)
+		return priv.PubKey().SerializeCompressed()
+	}
+	key1, key2 := genKey(), genKey()
+	keys := fmt.Sprintf("secp256k1:%s, secp256k1:%s",
+		base64.StdEncoding.EncodeToString(key1), base64.StdEncoding.EncodeToString(key2))
+
+	cases := map[string]struct {
+		keys, threshold string
+		expThreshold    int
+		expErr          bool
+	}{
+		"default threshold": {keys: keys, expThreshold: 1},
+		"threshold":         {keys: keys, threshold: "2", expThreshold: 2},
+		"threshold too big": {keys: keys, threshold: "3", expErr: true},
+		"invalid key":       {keys: "secp256k1:bad", expErr: true},
+	}
+
+	for name, tc := range cases {
+		s.Run(name, func() {
+			s.Require().NoError(os.Setenv(EnvTrustedKeys, tc.keys))
+			defer os.Unsetenv(EnvTrustedKeys)
+			if tc.threshold != "" {
+				s.Require().NoError(os.Setenv(EnvSignatureThreshold, tc.threshold))
+				defer os.Unsetenv(EnvSignatureThreshold)
+			}
+
+			cfg, err := GetConfigFromEnv()
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(cfg.TrustedKeys, 2)
+			s.Require().Equal(TrustedKey{Type: KeyTypeSecp256k1, Key: key1}, cfg.TrustedKeys[0])
+			s.Require().Equal(tc.expThreshold, cfg.DownloadConfig().SignatureThreshold)
+		})
+	}
+}
+
 func (s *argsTestSuite) TestLogConfigOrError() {
 	cfg := &Config{
 		Home:                  "/no/place/like/it",
@@ -0,0 +1,274 @@
+package cosmovisor
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// planInfoAnyPlatform is the PlanInfo binaries key of a binary which runs on
+// any platform.
+const planInfoAnyPlatform = "any"
+
+// key types of the plan info signatures, as named by the SDK
+const (
+	KeyTypeSecp256k1 = "secp256k1"
+	KeyTypeEd25519   = "ed25519"
+)
+
+const secp256k1PubKeySize = 33
+
+// used to reject malleable secp256k1 signatures, as the SDK does
+var secp256k1HalfN = new(big.Int).Rsh(btcec.S256().N, 1)
+
+// PlanInfo is the content of the upgrade plan info field used to download the
+// upgraded binary. It has the same encoding as the x/upgrade PlanInfo.
+type PlanInfo struct {
+	// Binaries maps an os/arch platform, e.g. linux/amd64, or "any" to the
+	// download URL of the binary, which includes its checksum.
+	Binaries map[string]string `json:"binaries"`
+
+	// Mirrors maps a platform of Binaries to alternative download URLs of the
+	// same binary, tried in order when the download from Binaries fails.
+	Mirrors map[string][]string `json:"mirrors,omitempty"`
+
+	// Signature holds detached signatures over the canonical encoding of
+	// Binaries, see BinariesSignBytes.
+	Signature []PlanInfoSignature `json:"signature,omitempty"`
+}
+
+// PlanInfoSignature is a signature of the binaries of a PlanInfo.
+type PlanInfoSignature struct {
+	// PubKeyType is the type of PubKey, secp256k1 or ed25519.
+	PubKeyType string `json:"pub_key_type"`
+	PubKey     []byte `json:"pub_key"`
+	Signature  []byte `json:"signature"`
+}
+
+// TrustedKey is a public key trusted to sign the binaries of the plan infos.
+type TrustedKey struct {
+	Type string
+	Key  []byte
+}
+
+// NewTrustedKey checks the length of a public key of the given type.
+func NewTrustedKey(keyType string, key []byte) (TrustedKey, error) {
+	switch keyType {
+	case KeyTypeSecp256k1:
+		if len(key) != secp256k1PubKeySize {
+			return TrustedKey{}, fmt.Errorf("invalid secp256k1 public key length %d", len(key))
+		}
+	case KeyTypeEd25519:
+		if len(key) != ed25519.PublicKeySize {
+			return TrustedKey{}, fmt.Errorf("invalid ed25519 public key length %d", len(key))
+		}
+	default:
+		return TrustedKey{}, fmt.Errorf("unsupported public key type %q", keyType)
+	}
+
+	return TrustedKey{Type: keyType, Key: key}, nil
+}
+
+// ParseTrustedKey parses a trusted key given as <type>:<base64 key>, e.g.
+// secp256k1:A0b1...
+func ParseTrustedKey(key string) (TrustedKey, error) {
+	parts := strings.SplitN(key, ":", 2)
+	if len(parts) != 2 {
+		return TrustedKey{}, fmt.Errorf("invalid key %q, expected <type>:<base64 key>", key)
+	}
+
+	bz, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return TrustedKey{}, fmt.Errorf("invalid key %q: %w", key, err)
+	}
+
+	return NewTrustedKey(parts[0], bz)
+}
+
+// Equals returns whether both keys are the same.
+func (k TrustedKey) Equals(other TrustedKey) bool {
+	return k.Type == other.Type && bytes.Equal(k.Key, other.Key)
+}
+
+// VerifySignature verifies a signature of msg made the same way as by the keys
+// of the SDK: ed25519 over msg, or secp256k1 of the form R || S in lower-S form
+// over the sha256 of msg.
+func (k TrustedKey) VerifySignature(msg, sig []byte) bool {
+	switch k.Type {
+	case KeyTypeEd25519:
+		return len(k.Key) == ed25519.PublicKeySize && ed25519.Verify(k.Key, msg, sig)
+
+	case KeyTypeSecp256k1:
+		if len(sig) != 64 {
+			return false
+		}
+		pub, err := btcec.ParsePubKey(k.Key, btcec.S256())
+		if err != nil {
+			return false
+		}
+		signature := &btcec.Signature{
+			R: new(big.Int).SetBytes(sig[:32]),
+			S: new(big.Int).SetBytes(sig[32:]),
+		}
+		if signature.S.Cmp(secp256k1HalfN) > 0 {
+			return false
+		}
+		hash := sha256.Sum256(msg)
+		return signature.Verify(hash[:], pub)
+
+	default:
+		return false
+	}
+}
+
+// URLs returns the download URL and the mirrors of the binary of the given
+// platform, falling back to the binary of any platform.
+func (pi PlanInfo) URLs(platform string) []string {
+	binaryURL, ok := pi.Binaries[platform]
+	if !ok {
+		platform = planInfoAnyPlatform
+		if binaryURL, ok = pi.Binaries[platform]; !ok {
+			return nil
+		}
+	}
+
+	return append([]string{binaryURL}, pi.Mirrors[platform]...)
+}
+
+// ValidateBasic checks the platforms and URLs of the binaries.
+func (pi PlanInfo) ValidateBasic() error {
+	if len(pi.Binaries) == 0 {
+		return errors.New("plan info must contain at least one binary")
+	}
+
+	for platform, binaryURL := range pi.Binaries {
+		if platform != planInfoAnyPlatform && len(strings.Split(platform, "/")) != 2 {
+			return fmt.Errorf("invalid platform %q, expected os/arch or %s", platform, planInfoAnyPlatform)
+		}
+		if _, err := url.Parse(binaryURL); err != nil {
+			return fmt.Errorf("invalid url of %s binary: %w", platform, err)
+		}
+	}
+
+	for platform, mirrors := range pi.Mirrors {
+		if _, ok := pi.Binaries[platform]; !ok {
+			return fmt.Errorf("mirrors of %s have no binary", platform)
+		}
+		for _, mirror := range mirrors {
+			if _, err := url.Parse(mirror); err != nil {
+				return fmt.Errorf("invalid mirror url of %s binary: %w", platform, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// BinariesSignBytes returns the canonical encoding of the binaries signed by
+// the plan info signatures: their JSON encoding, ordered by platform and
+// without whitespace.
+func (pi PlanInfo) BinariesSignBytes() []byte {
+	// encoding/json sorts map keys
+	bz, err := json.Marshal(pi.Binaries)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// ValidateFull performs ValidateBasic and checks that every binary and mirror
+// URL includes a checksum, and that mirrors have the checksum of their binary.
+// If trustedKeys is not empty, the binaries must also be signed by at least
+// threshold distinct trusted keys.
+func (pi PlanInfo) ValidateFull(trustedKeys []TrustedKey, threshold int) error {
+	if err := pi.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for platform, binaryURL := range pi.Binaries {
+		checksum, err := urlChecksum(binaryURL)
+		if err != nil {
+			return fmt.Errorf("binary of %s: %w", platform, err)
+		}
+		for _, mirror := range pi.Mirrors[platform] {
+			mirrorChecksum, err := urlChecksum(mirror)
+			if err != nil {
+				return fmt.Errorf("mirror of %s: %w", platform, err)
+			}
+			if mirrorChecksum != checksum {
+				return fmt.Errorf("mirror %s of %s has checksum %s, expected %s", mirror, platform, mirrorChecksum, checksum)
+			}
+		}
+	}
+
+	if len(trustedKeys) == 0 {
+		return nil
+	}
+	if threshold < 1 || threshold > len(trustedKeys) {
+		return fmt.Errorf("invalid signature threshold %d of %d trusted keys", threshold, len(trustedKeys))
+	}
+
+	signBytes := pi.BinariesSignBytes()
+	signers := make(map[string]bool)
+	for _, sig := range pi.Signature {
+		pubKey, err := NewTrustedKey(sig.PubKeyType, sig.PubKey)
+		if err != nil {
+			return err
+		}
+		if !isTrustedKey(pubKey, trustedKeys) || !pubKey.VerifySignature(signBytes, sig.Signature) {
+			continue
+		}
+		signers[pubKey.Type+":"+string(pubKey.Key)] = true
+	}
+
+	if len(signers) < threshold {
+		return fmt.Errorf("plan info has %d valid signatures of trusted keys, expected at least %d", len(signers), threshold)
+	}
+
+	return nil
+}
+
+func isTrustedKey(pubKey TrustedKey, trustedKeys []TrustedKey) bool {
+	for _, trusted := range trustedKeys {
+		if pubKey.Equals(trusted) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// urlChecksum returns the checksum query parameter of a binary URL.
+func urlChecksum(binaryURL string) (string, error) {
+	u, err := url.Parse(binaryURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid url %s: %w", binaryURL, err)
+	}
+
+	checksum := u.Query().Get("checksum")
+	if checksum == "" {
+		return "", fmt.Errorf("url %s has no checksum", binaryURL)
+	}
+
+	return checksum, nil
+}
+
+// String returns the JSON encoding of the plan info.
+func (pi PlanInfo) String() string {
+	bz, err := json.Marshal(pi)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bz)
+}
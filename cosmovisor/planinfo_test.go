@@ -0,0 +1,71 @@
+package cosmovisor
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanInfoValidateFull(t *testing.T) {
+	info := PlanInfo{Binaries: map[string]string{"linux/amd64": "https://example.com/simd?checksum=sha256:abcd"}}
+
+	secpPriv, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	secpKey := TrustedKey{Type: KeyTypeSecp256k1, Key: secpPriv.PubKey().SerializeCompressed()}
+
+	edPub, edPriv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	edKey := TrustedKey{Type: KeyTypeEd25519, Key: edPub}
+
+	trustedKeys := []TrustedKey{secpKey, edKey}
+	require.NoError(t, info.ValidateFull(nil, 0))
+	require.Error(t, info.ValidateFull(trustedKeys, 1))
+
+	// secp256k1 signatures are R || S over the sha256 of the sign bytes, as
+	// made by the keys of the SDK
+	hash := sha256.Sum256(info.BinariesSignBytes())
+	secpSig, err := secpPriv.Sign(hash[:])
+	require.NoError(t, err)
+	sig := make([]byte, 64)
+	secpSig.R.FillBytes(sig[:32])
+	secpSig.S.FillBytes(sig[32:])
+	info.Signature = append(info.Signature, PlanInfoSignature{PubKeyType: KeyTypeSecp256k1, PubKey: secpKey.Key, Signature: sig})
+	require.NoError(t, info.ValidateFull(trustedKeys, 1))
+	require.Error(t, info.ValidateFull(trustedKeys, 2))
+
+	// the same key doesn't count twice
+	info.Signature = append(info.Signature, info.Signature[0])
+	require.Error(t, info.ValidateFull(trustedKeys, 2))
+
+	info.Signature = append(info.Signature, PlanInfoSignature{
+		PubKeyType: KeyTypeEd25519,
+		PubKey:     edPub,
+		Signature:  ed25519.Sign(edPriv, info.BinariesSignBytes()),
+	})
+	require.NoError(t, info.ValidateFull(trustedKeys, 2))
+
+	// the signatures no longer match altered binaries
+	info.Binaries["linux/amd64"] = "https://example.com/evil?checksum=sha256:abcd"
+	require.Error(t, info.ValidateFull(trustedKeys, 1))
+
+	// binaries must have checksums
+	require.Error(t, PlanInfo{Binaries: map[string]string{"linux/amd64": "https://example.com/simd"}}.ValidateFull(nil, 0))
+}
+
+func TestParseTrustedKey(t *testing.T) {
+	edPub, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	key, err := ParseTrustedKey("ed25519:" + base64.StdEncoding.EncodeToString(edPub))
+	require.NoError(t, err)
+	require.Equal(t, TrustedKey{Type: KeyTypeEd25519, Key: edPub}, key)
+
+	for _, invalid := range []string{"ed25519", "ed25519:bad!", "ed25519:AAAA", "sr25519:" + base64.StdEncoding.EncodeToString(edPub)} {
+		_, err := ParseTrustedKey(invalid)
+		require.Error(t, err, invalid)
+	}
+}
@@ -1,6 +1,7 @@
 package cosmovisor
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -29,8 +30,9 @@ func DoUpgrade(cfg *Config, info UpgradeInfo) error {
 		return fmt.Errorf("binary not present, downloading disabled: %w", err)
 	}
 
-	// if the dir is there already, don't download either
-	if _, err := os.Stat(cfg.UpgradeDir(info.Name)); !os.IsNotExist(err) {
+	// if the dir is there already, don't download either, unless it only holds
+	// an interrupted download to resume
+	if _, err := os.Stat(cfg.UpgradeDir(info.Name)); !os.IsNotExist(err) && !hasPartialDownload(cfg, info) {
 		return errors.New("upgrade dir already exists, won't overwrite")
 	}
 
@@ -49,21 +51,61 @@ func DoUpgrade(cfg *Config, info UpgradeInfo) error {
 	return cfg.SetCurrentUpgrade(info)
 }
 
-// DownloadBinary will grab the binary and place it in the proper directory
+// DownloadBinary will grab the binary and place it in the proper directory.
+// A binary served over http(s) or from a file is downloaded with
+// DownloadUpgrade, which falls back to its mirrors, resumes interrupted
+// downloads and applies the download timeout, retries and proxy of the config.
+// Archives and the other sources supported by go-getter are unpacked with
+// go-getter. If the config has trusted keys, the binaries of the plan info must
+// be signed by them.
 func DownloadBinary(cfg *Config, info UpgradeInfo) error {
-	url, err := GetDownloadURL(info)
+	planInfo, err := GetPlanInfo(info)
 	if err != nil {
 		return err
 	}
 
+	urls := planInfo.URLs(OSArch())
+	if len(urls) == 0 {
+		return fmt.Errorf("cannot find binary for os/arch: neither %s, nor any", OSArch())
+	}
+
+	// the plan info is verified against the trusted keys, if any, before
+	// downloading anything
+	if len(cfg.TrustedKeys) > 0 {
+		if err := planInfo.ValidateFull(cfg.TrustedKeys, cfg.SignatureThreshold); err != nil {
+			return fmt.Errorf("plan info verification failed: %w", err)
+		}
+	}
+
+	binPath := cfg.UpgradeBin(info.Name)
+	if isPlainBinaryURL(urls[0]) {
+		if err := os.MkdirAll(filepath.Dir(binPath), 0o755); err != nil {
+			return err
+		}
+		return DownloadUpgrade(context.Background(), planInfo, binPath, cfg.DownloadConfig())
+	}
+
+	for _, u := range urls {
+		if err = getBinary(cfg, info, u); err == nil {
+			// if it is successful, let's ensure the binary is executable
+			return MarkExecutable(binPath)
+		}
+		Logger.Error().Err(err).Str("url", u).Msg("failed to download binary")
+	}
+
+	return err
+}
+
+// getBinary downloads the binary, or the archive holding it, with go-getter.
+func getBinary(cfg *Config, info UpgradeInfo, u string) error {
 	// download into the bin dir (works for one file)
 	binPath := cfg.UpgradeBin(info.Name)
-	err = getter.GetFile(binPath, url)
+	err := getter.GetFile(binPath, u)
 
 	// if this fails, let's see if it is a zipped directory
 	if err != nil {
 		dirPath := cfg.UpgradeDir(info.Name)
-		err = getter.Get(dirPath, url)
+		err = getter.Get(dirPath, u)
 		if err != nil {
 			return err
 		}
@@ -77,8 +119,45 @@ func DownloadBinary(cfg *Config, info UpgradeInfo) error {
 		}
 	}
 
-	// if it is successful, let's ensure the binary is executable
-	return MarkExecutable(binPath)
+	return nil
+}
+
+// isPlainBinaryURL returns whether u is an http, https or file URL of a binary
+// rather than of an archive.
+func isPlainBinaryURL(u string) bool {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return false
+	}
+
+	switch parsed.Scheme {
+	case "http", "https", "file":
+	default:
+		return false
+	}
+
+	if parsed.Query().Get("archive") != "" {
+		return false
+	}
+	for ext := range getter.Decompressors {
+		if strings.HasSuffix(parsed.Path, "."+ext) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasPartialDownload returns whether the directory of an upgrade only holds an
+// interrupted download of its binary.
+func hasPartialDownload(cfg *Config, info UpgradeInfo) bool {
+	binPath := cfg.UpgradeBin(info.Name)
+	if _, err := os.Stat(binPath); !os.IsNotExist(err) {
+		return false
+	}
+
+	_, err := os.Stat(PartialPath(binPath))
+	return err == nil
 }
 
 // MarkExecutable will try to set the executable bits if not already set
@@ -98,50 +177,60 @@ func MarkExecutable(path string) error {
 }
 
 // UpgradeConfig is expected format for the info field to allow auto-download
+//
+// Deprecated: the info field is parsed into PlanInfo, which also holds the
+// mirrors of the binaries.
 type UpgradeConfig struct {
 	Binaries map[string]string `json:"binaries"`
 }
 
-// GetDownloadURL will check if there is an arch-dependent binary specified in Info
-func GetDownloadURL(info UpgradeInfo) (string, error) {
+// GetPlanInfo returns the plan info of the upgrade, which is either given
+// inline or by a link to a file holding it.
+func GetPlanInfo(info UpgradeInfo) (PlanInfo, error) {
 	doc := strings.TrimSpace(info.Info)
 	// if this is a url, then we download that and try to get a new doc with the real info
 	if _, err := url.Parse(doc); err == nil {
 		tmpDir, err := os.MkdirTemp("", "upgrade-manager-reference")
 		if err != nil {
-			return "", fmt.Errorf("create tempdir for reference file: %w", err)
+			return PlanInfo{}, fmt.Errorf("create tempdir for reference file: %w", err)
 		}
 		defer os.RemoveAll(tmpDir)
 
 		refPath := filepath.Join(tmpDir, "ref")
 		if err := getter.GetFile(refPath, doc); err != nil {
-			return "", fmt.Errorf("downloading reference link %s: %w", doc, err)
+			return PlanInfo{}, fmt.Errorf("downloading reference link %s: %w", doc, err)
 		}
 
 		refBytes, err := os.ReadFile(refPath)
 		if err != nil {
-			return "", fmt.Errorf("reading downloaded reference: %w", err)
+			return PlanInfo{}, fmt.Errorf("reading downloaded reference: %w", err)
 		}
 		// if download worked properly, then we use this new file as the binary map to parse
 		doc = string(refBytes)
 	}
 
 	// check if it is the upgrade config
-	var config UpgradeConfig
+	var planInfo PlanInfo
+	if err := json.Unmarshal([]byte(doc), &planInfo); err != nil {
+		return PlanInfo{}, errors.New("upgrade info doesn't contain binary map")
+	}
 
-	if err := json.Unmarshal([]byte(doc), &config); err == nil {
-		url, ok := config.Binaries[OSArch()]
-		if !ok {
-			url, ok = config.Binaries["any"]
-		}
-		if !ok {
-			return "", fmt.Errorf("cannot find binary for os/arch: neither %s, nor any", OSArch())
-		}
+	return planInfo, nil
+}
+
+// GetDownloadURL will check if there is an arch-dependent binary specified in Info
+func GetDownloadURL(info UpgradeInfo) (string, error) {
+	planInfo, err := GetPlanInfo(info)
+	if err != nil {
+		return "", err
+	}
 
-		return url, nil
+	urls := planInfo.URLs(OSArch())
+	if len(urls) == 0 {
+		return "", fmt.Errorf("cannot find binary for os/arch: neither %s, nor any", OSArch())
 	}
 
-	return "", errors.New("upgrade info doesn't contain binary map")
+	return urls[0], nil
 }
 
 func OSArch() string {
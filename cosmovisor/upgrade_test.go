@@ -3,11 +3,17 @@
 package cosmovisor_test
 
 import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -268,6 +274,107 @@ func (s *upgradeTestSuite) TestDownloadBinary() {
 	}
 }
 
+func (s *upgradeTestSuite) TestDoUpgradeDownloadMirrorsAndResume() {
+	binary := []byte("#!/bin/sh\necho upgraded\n" + strings.Repeat("#", 4096) + "\n")
+	checksum := fmt.Sprintf("checksum=sha256:%x", sha256.Sum256(binary))
+
+	var ranges []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/autod" {
+			http.NotFound(w, r)
+			return
+		}
+		ranges = append(ranges, r.Header.Get("Range"))
+		http.ServeContent(w, r, "autod", time.Time{}, bytes.NewReader(binary))
+	}))
+	defer srv.Close()
+
+	newConfig := func() *cosmovisor.Config {
+		return &cosmovisor.Config{
+			Home:                  copyTestData(s.T(), "download"),
+			Name:                  "autod",
+			AllowDownloadBinaries: true,
+			DownloadRetries:       1,
+		}
+	}
+	checkUpgraded := func(cfg *cosmovisor.Config, upgrade string) {
+		bz, err := os.ReadFile(cfg.UpgradeBin(upgrade))
+		s.Require().NoError(err)
+		s.Require().Equal(binary, bz)
+
+		current, err := cfg.CurrentBin()
+		s.Require().NoError(err)
+		s.Require().Equal(cfg.UpgradeBin(upgrade), current)
+	}
+
+	s.Run("mirror fallback", func() {
+		cfg := newConfig()
+		info := cosmovisor.UpgradeInfo{
+			Name: "amazonas",
+			Info: fmt.Sprintf(`{"binaries":{"%s":"%s/missing?%s"},"mirrors":{"%s":["%s/autod?%s"]}}`,
+				cosmovisor.OSArch(), srv.URL, checksum, cosmovisor.OSArch(), srv.URL, checksum),
+		}
+
+		s.Require().NoError(cosmovisor.DoUpgrade(cfg, info))
+		checkUpgraded(cfg, info.Name)
+	})
+
+	s.Run("resume", func() {
+		ranges = nil
+		cfg := newConfig()
+		info := cosmovisor.UpgradeInfo{
+			Name: "amazonas",
+			Info: fmt.Sprintf(`{"binaries":{"%s":"%s/autod?%s"}}`, cosmovisor.OSArch(), srv.URL, checksum),
+		}
+
+		// an interrupted download is left in the upgrade dir
+		binPath := cfg.UpgradeBin(info.Name)
+		s.Require().NoError(os.MkdirAll(filepath.Dir(binPath), 0o755))
+		s.Require().NoError(os.WriteFile(cosmovisor.PartialPath(binPath), binary[:100], 0o644))
+
+		s.Require().NoError(cosmovisor.DoUpgrade(cfg, info))
+		checkUpgraded(cfg, info.Name)
+		s.Require().Equal([]string{"bytes=100-"}, ranges)
+		s.Require().NoFileExists(cosmovisor.PartialPath(binPath))
+	})
+}
+
+func (s *upgradeTestSuite) TestDownloadBinaryTrustedKeys() {
+	binary := []byte("#!/bin/sh\necho upgraded\n")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "autod", time.Time{}, bytes.NewReader(binary))
+	}))
+	defer srv.Close()
+
+	pubKey, trustedKey, err := ed25519.GenerateKey(nil)
+	s.Require().NoError(err)
+	planInfo := cosmovisor.PlanInfo{Binaries: map[string]string{
+		cosmovisor.OSArch(): fmt.Sprintf("%s/autod?checksum=sha256:%x", srv.URL, sha256.Sum256(binary)),
+	}}
+
+	cfg := &cosmovisor.Config{
+		Home:                  copyTestData(s.T(), "download"),
+		Name:                  "autod",
+		AllowDownloadBinaries: true,
+		TrustedKeys:           []cosmovisor.TrustedKey{{Type: cosmovisor.KeyTypeEd25519, Key: pubKey}},
+		SignatureThreshold:    1,
+	}
+
+	// the unsigned binaries are not downloaded
+	err = cosmovisor.DownloadBinary(cfg, cosmovisor.UpgradeInfo{Name: "amazonas", Info: planInfo.String()})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "plan info verification failed")
+	s.Require().NoFileExists(cfg.UpgradeBin("amazonas"))
+
+	planInfo.Signature = append(planInfo.Signature, cosmovisor.PlanInfoSignature{
+		PubKeyType: cosmovisor.KeyTypeEd25519,
+		PubKey:     pubKey,
+		Signature:  ed25519.Sign(trustedKey, planInfo.BinariesSignBytes()),
+	})
+	s.Require().NoError(cosmovisor.DownloadBinary(cfg, cosmovisor.UpgradeInfo{Name: "amazonas", Info: planInfo.String()}))
+	s.Require().NoError(cosmovisor.EnsureBinary(cfg.UpgradeBin("amazonas")))
+}
+
 // copyTestData will make a tempdir and then
 // "cp -r" a subdirectory under testdata there
 // returns the directory (which can now be used as Config.Home) and modified safely
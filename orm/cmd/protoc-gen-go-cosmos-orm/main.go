@@ -0,0 +1,13 @@
+// protoc-gen-go-cosmos-orm generates the typed table accessors of the proto
+// messages annotated with orm directives, see the orm package.
+package main
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/cosmos/cosmos-sdk/orm/internal/codegen"
+)
+
+func main() {
+	protogen.Options{}.Run(codegen.Generate)
+}
@@ -0,0 +1,23 @@
+package orm
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Codespace is the codespace of the errors of the tables.
+const Codespace = "orm"
+
+var (
+	// ErrNotFound is returned when no entity has the requested key.
+	ErrNotFound = sdkerrors.Register(Codespace, 2, "entity not found")
+	// ErrAlreadyExists is returned when inserting an entity whose primary key is
+	// already used.
+	ErrAlreadyExists = sdkerrors.Register(Codespace, 3, "entity already exists")
+	// ErrUniqueConstraint is returned when writing an entity whose unique index
+	// key is already used by another entity.
+	ErrUniqueConstraint = sdkerrors.Register(Codespace, 4, "unique constraint violation")
+	// ErrInvalidKey is returned when the fields of a key cannot be encoded.
+	ErrInvalidKey = sdkerrors.Register(Codespace, 5, "invalid key")
+	// ErrNotUnique is returned when getting an entity by a non-unique index.
+	ErrNotUnique = sdkerrors.Register(Codespace, 6, "index is not unique")
+)
@@ -0,0 +1,91 @@
+package orm
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Index is a secondary index of the entities of a table. Its entries map the
+// index key of an entity, followed by its primary key unless the index is
+// unique, to its primary key.
+type Index struct {
+	table  *Table
+	prefix byte
+	unique bool
+	fields KeyFunc
+}
+
+// Unique returns whether the index is unique.
+func (i *Index) Unique() bool {
+	return i.unique
+}
+
+// entryKey returns the key of the index entry of an entity.
+func (i *Index) entryKey(entity Entity, pk []byte) ([]byte, error) {
+	key, err := EncodeKey(i.fields(entity)...)
+	if err != nil {
+		return nil, err
+	}
+
+	key = append([]byte{i.prefix}, key...)
+	if !i.unique {
+		key = append(key, pk...)
+	}
+
+	return key, nil
+}
+
+// Get reads the entity of the key of a unique index into dest. It returns
+// ErrNotFound if no entity has the key.
+func (i *Index) Get(store sdk.KVStore, dest Entity, fields ...interface{}) error {
+	if !i.unique {
+		return sdkerrors.Wrapf(ErrNotUnique, "index %d", i.prefix)
+	}
+
+	key, err := EncodeKey(fields...)
+	if err != nil {
+		return err
+	}
+
+	pk := store.Get(append([]byte{i.prefix}, key...))
+	if pk == nil {
+		return sdkerrors.Wrapf(ErrNotFound, "index %d key %X", i.prefix, key)
+	}
+
+	return i.table.get(store, pk, dest)
+}
+
+// Has returns whether an entity has the key of a unique index.
+func (i *Index) Has(store sdk.KVStore, fields ...interface{}) (bool, error) {
+	if !i.unique {
+		return false, sdkerrors.Wrapf(ErrNotUnique, "index %d", i.prefix)
+	}
+
+	key, err := EncodeKey(fields...)
+	if err != nil {
+		return false, err
+	}
+
+	return store.Has(append([]byte{i.prefix}, key...)), nil
+}
+
+// List calls onEntity on the entities of the page, in the order of their index
+// keys, whose leading fields are the given prefix fields, if any.
+func (i *Index) List(store sdk.KVStore, pageReq *query.PageRequest, onEntity func(Entity) error, prefixFields ...interface{}) (*query.PageResponse, error) {
+	keyPrefix, err := EncodeKey(prefixFields...)
+	if err != nil {
+		return nil, err
+	}
+
+	indexStore := prefix.NewStore(store, append([]byte{i.prefix}, keyPrefix...))
+	return query.Paginate(indexStore, pageReq, func(_, pk []byte) error {
+		entity := i.table.newEntity()
+		if err := i.table.get(store, pk, entity); err != nil {
+			return err
+		}
+
+		return onEntity(entity)
+	})
+}
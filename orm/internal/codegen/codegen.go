@@ -0,0 +1,419 @@
+// Package codegen generates the typed table accessors of the proto messages
+// annotated with orm directives in their leading comments:
+//
+//	// orm:table prefix=<byte> primary_key=<field>[,<field>...]
+//	// orm:index prefix=<byte> fields=<field>[,<field>...]
+//	// orm:unique prefix=<byte> fields=<field>[,<field>...]
+package codegen
+
+import (
+	"fmt"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	codecPackage = protogen.GoImportPath("github.com/cosmos/cosmos-sdk/codec")
+	ormPackage   = protogen.GoImportPath("github.com/cosmos/cosmos-sdk/orm")
+	queryPackage = protogen.GoImportPath("github.com/cosmos/cosmos-sdk/types/query")
+	sdkPackage   = protogen.GoImportPath("github.com/cosmos/cosmos-sdk/types")
+
+	directivePrefix = "orm:"
+)
+
+// gogoRenamed are the field names renamed by gogoproto with a trailing
+// underscore as they conflict with the methods of the messages.
+var gogoRenamed = map[string]bool{
+	"Size":       true,
+	"String":     true,
+	"Reset":      true,
+	"Descriptor": true,
+	"Marshal":    true,
+	"Unmarshal":  true,
+	"MarshalTo":  true,
+	"Equal":      true,
+}
+
+// reservedParams are the parameter names of the generated methods, which the
+// parameters of the key fields must not shadow.
+var reservedParams = map[string]bool{
+	"t":        true,
+	"store":    true,
+	"pageReq":  true,
+	"pageRes":  true,
+	"entity":   true,
+	"entities": true,
+	"err":      true,
+}
+
+type table struct {
+	message    *protogen.Message
+	prefix     byte
+	primaryKey []*protogen.Field
+	indexes    []index
+}
+
+type index struct {
+	prefix byte
+	unique bool
+	fields []*protogen.Field
+}
+
+// Generate generates the table accessors of the annotated messages of the files
+// to generate, in a <file>.cosmos_orm.go file next to their Go types.
+func Generate(plugin *protogen.Plugin) error {
+	for _, file := range plugin.Files {
+		if !file.Generate {
+			continue
+		}
+
+		var tables []table
+		for _, message := range file.Messages {
+			t, ok, err := parseTable(message)
+			if err != nil {
+				return fmt.Errorf("%s: %w", message.Desc.FullName(), err)
+			}
+			if ok {
+				tables = append(tables, t)
+			}
+		}
+		if len(tables) == 0 {
+			continue
+		}
+
+		g := plugin.NewGeneratedFile(file.GeneratedFilenamePrefix+".cosmos_orm.go", file.GoImportPath)
+		g.P("// Code generated by protoc-gen-go-cosmos-orm. DO NOT EDIT.")
+		g.P()
+		g.P("package ", file.GoPackageName)
+		for _, t := range tables {
+			generateTable(g, t)
+		}
+	}
+
+	return nil
+}
+
+// parseTable parses the orm directives of the leading comments of a message.
+func parseTable(message *protogen.Message) (table, bool, error) {
+	t := table{message: message}
+	var isTable bool
+	for _, line := range strings.Split(string(message.Comments.Leading), "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, directivePrefix) {
+			continue
+		}
+
+		words := strings.Fields(strings.TrimPrefix(line, directivePrefix))
+		if len(words) == 0 {
+			return t, false, fmt.Errorf("empty orm directive")
+		}
+
+		args := make(map[string]string)
+		for _, word := range words[1:] {
+			kv := strings.SplitN(word, "=", 2)
+			if len(kv) != 2 {
+				return t, false, fmt.Errorf("invalid orm directive argument %q", word)
+			}
+			args[kv[0]] = kv[1]
+		}
+
+		prefix, err := strconv.ParseUint(args["prefix"], 10, 8)
+		if err != nil {
+			return t, false, fmt.Errorf("invalid prefix %q of orm directive %q", args["prefix"], words[0])
+		}
+
+		switch words[0] {
+		case "table":
+			if isTable {
+				return t, false, fmt.Errorf("duplicate orm table directive")
+			}
+			isTable = true
+			t.prefix = byte(prefix)
+			if t.primaryKey, err = keyFields(message, args["primary_key"]); err != nil {
+				return t, false, err
+			}
+		case "index", "unique":
+			fields, err := keyFields(message, args["fields"])
+			if err != nil {
+				return t, false, err
+			}
+			t.indexes = append(t.indexes, index{prefix: byte(prefix), unique: words[0] == "unique", fields: fields})
+		default:
+			return t, false, fmt.Errorf("unknown orm directive %q", words[0])
+		}
+	}
+
+	if !isTable && len(t.indexes) > 0 {
+		return t, false, fmt.Errorf("orm index directive without table directive")
+	}
+
+	return t, isTable, nil
+}
+
+// keyFields returns the fields of a comma separated list of field names.
+func keyFields(message *protogen.Message, names string) ([]*protogen.Field, error) {
+	if names == "" {
+		return nil, fmt.Errorf("missing key fields")
+	}
+
+	var fields []*protogen.Field
+	for _, name := range strings.Split(names, ",") {
+		var field *protogen.Field
+		for _, f := range message.Fields {
+			if string(f.Desc.Name()) == name {
+				field = f
+			}
+		}
+		if field == nil {
+			return nil, fmt.Errorf("unknown key field %q", name)
+		}
+		if field.Desc.IsList() || field.Desc.IsMap() {
+			return nil, fmt.Errorf("key field %q is repeated", name)
+		}
+
+		switch field.Desc.Kind() {
+		case protoreflect.Uint64Kind, protoreflect.Uint32Kind, protoreflect.Int64Kind, protoreflect.Int32Kind,
+			protoreflect.BoolKind, protoreflect.StringKind, protoreflect.BytesKind, protoreflect.EnumKind:
+		default:
+			return nil, fmt.Errorf("key field %q has unsupported kind %s", name, field.Desc.Kind())
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// goName returns the name of the field of the gogoproto Go type.
+func goName(field *protogen.Field) string {
+	if gogoRenamed[field.GoName] {
+		return field.GoName + "_"
+	}
+
+	return field.GoName
+}
+
+// paramName returns the name of the parameter of a key field.
+func paramName(field *protogen.Field) string {
+	name := strings.ToLower(field.GoName[:1]) + field.GoName[1:]
+	if token.Lookup(name).IsKeyword() || reservedParams[name] {
+		name += "_"
+	}
+
+	return name
+}
+
+// goType returns the Go type of a key field.
+func goType(g *protogen.GeneratedFile, field *protogen.Field) string {
+	switch field.Desc.Kind() {
+	case protoreflect.Uint64Kind:
+		return "uint64"
+	case protoreflect.Uint32Kind:
+		return "uint32"
+	case protoreflect.Int64Kind:
+		return "int64"
+	case protoreflect.Int32Kind:
+		return "int32"
+	case protoreflect.BoolKind:
+		return "bool"
+	case protoreflect.StringKind:
+		return "string"
+	case protoreflect.BytesKind:
+		return "[]byte"
+	default:
+		return g.QualifiedGoIdent(field.Enum.GoIdent)
+	}
+}
+
+// params returns the parameters and arguments of key fields.
+func params(g *protogen.GeneratedFile, fields []*protogen.Field) (string, string) {
+	var params, args []string
+	for _, field := range fields {
+		params = append(params, paramName(field)+" "+goType(g, field))
+		args = append(args, paramName(field))
+	}
+
+	return strings.Join(params, ", "), strings.Join(args, ", ")
+}
+
+// indexName returns the name of an index, e.g. ByOwnerDenom.
+func indexName(fields []*protogen.Field) string {
+	name := "By"
+	for _, field := range fields {
+		name += field.GoName
+	}
+
+	return name
+}
+
+// generateKeyFunc generates a KeyFunc returning the fields of an entity,
+// followed by the given suffix.
+func generateKeyFunc(g *protogen.GeneratedFile, msgType string, fields []*protogen.Field, suffix string) {
+	g.P("func(entity ", g.QualifiedGoIdent(ormPackage.Ident("Entity")), ") []interface{} {")
+	g.P("m := entity.(*", msgType, ")")
+	var values []string
+	for _, field := range fields {
+		values = append(values, "m."+goName(field))
+	}
+	g.P("return []interface{}{", strings.Join(values, ", "), "}")
+	g.P("}", suffix)
+}
+
+func generateTable(g *protogen.GeneratedFile, t table) {
+	msgType := g.QualifiedGoIdent(t.message.GoIdent)
+	tableType := t.message.GoIdent.GoName + "Table"
+	kvStore := g.QualifiedGoIdent(sdkPackage.Ident("KVStore"))
+	pageRequest := g.QualifiedGoIdent(queryPackage.Ident("PageRequest"))
+	pageResponse := g.QualifiedGoIdent(queryPackage.Ident("PageResponse"))
+	ormEntity := g.QualifiedGoIdent(ormPackage.Ident("Entity"))
+	pkParams, pkArgs := params(g, t.primaryKey)
+
+	var pkNames []string
+	for _, field := range t.primaryKey {
+		pkNames = append(pkNames, string(field.Desc.Name()))
+	}
+
+	g.P()
+	g.P("// ", tableType, " is the table of the ", msgType, " entities, whose primary key is")
+	g.P("// (", strings.Join(pkNames, ", "), ").")
+	g.P("type ", tableType, " struct {")
+	g.P("table *", g.QualifiedGoIdent(ormPackage.Ident("Table")))
+	for _, idx := range t.indexes {
+		g.P(lowerFirst(indexName(idx.fields)), " *", g.QualifiedGoIdent(ormPackage.Ident("Index")))
+	}
+	g.P("}")
+	g.P()
+
+	g.P("// New", tableType, " returns the table of the ", msgType, " entities.")
+	g.P("func New", tableType, "(cdc ", g.QualifiedGoIdent(codecPackage.Ident("BinaryCodec")), ") ", tableType, " {")
+	g.P("table := ", g.QualifiedGoIdent(ormPackage.Ident("NewTable")), "(", t.prefix, ", cdc, func() ", ormEntity, " { return &", msgType, "{} }, ")
+	generateKeyFunc(g, msgType, t.primaryKey, ")")
+	g.P()
+	g.P("return ", tableType, "{")
+	g.P("table: table,")
+	for _, idx := range t.indexes {
+		g.P(lowerFirst(indexName(idx.fields)), ": table.AddIndex(", idx.prefix, ", ", idx.unique, ", ")
+		generateKeyFunc(g, msgType, idx.fields, "),")
+	}
+	g.P("}")
+	g.P("}")
+	g.P()
+
+	g.P("// Insert inserts a ", msgType, ".")
+	g.P("func (t ", tableType, ") Insert(store ", kvStore, ", entity *", msgType, ") error {")
+	g.P("return t.table.Insert(store, entity)")
+	g.P("}")
+	g.P()
+	g.P("// Update updates an existing ", msgType, ".")
+	g.P("func (t ", tableType, ") Update(store ", kvStore, ", entity *", msgType, ") error {")
+	g.P("return t.table.Update(store, entity)")
+	g.P("}")
+	g.P()
+	g.P("// Save inserts or updates a ", msgType, ".")
+	g.P("func (t ", tableType, ") Save(store ", kvStore, ", entity *", msgType, ") error {")
+	g.P("return t.table.Save(store, entity)")
+	g.P("}")
+	g.P()
+	g.P("// Delete deletes the ", msgType, " of the primary key.")
+	g.P("func (t ", tableType, ") Delete(store ", kvStore, ", ", pkParams, ") error {")
+	g.P("return t.table.Delete(store, ", pkArgs, ")")
+	g.P("}")
+	g.P()
+	g.P("// Has returns whether a ", msgType, " has the primary key.")
+	g.P("func (t ", tableType, ") Has(store ", kvStore, ", ", pkParams, ") (bool, error) {")
+	g.P("return t.table.Has(store, ", pkArgs, ")")
+	g.P("}")
+	g.P()
+	g.P("// Get returns the ", msgType, " of the primary key.")
+	g.P("func (t ", tableType, ") Get(store ", kvStore, ", ", pkParams, ") (*", msgType, ", error) {")
+	g.P("entity := &", msgType, "{}")
+	g.P("if err := t.table.Get(store, entity, ", pkArgs, "); err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P()
+	g.P("return entity, nil")
+	g.P("}")
+	g.P()
+	g.P("// List returns the ", msgType, " entities of the page, in the order of their primary")
+	g.P("// keys.")
+	g.P("func (t ", tableType, ") List(store ", kvStore, ", pageReq *", pageRequest, ") ([]*", msgType, ", *", pageResponse, ", error) {")
+	generateList(g, msgType, ormEntity, "t.table.List(store, pageReq, ", ")")
+	g.P("}")
+
+	for _, idx := range t.indexes {
+		name := indexName(idx.fields)
+		field := lowerFirst(name)
+		idxParams, idxArgs := params(g, idx.fields)
+
+		g.P()
+		if idx.unique {
+			g.P("// Get", name, " returns the ", msgType, " of the unique index key.")
+			g.P("func (t ", tableType, ") Get", name, "(store ", kvStore, ", ", idxParams, ") (*", msgType, ", error) {")
+			g.P("entity := &", msgType, "{}")
+			g.P("if err := t.", field, ".Get(store, entity, ", idxArgs, "); err != nil {")
+			g.P("return nil, err")
+			g.P("}")
+			g.P()
+			g.P("return entity, nil")
+			g.P("}")
+			g.P()
+			g.P("// Has", name, " returns whether a ", msgType, " has the unique index key.")
+			g.P("func (t ", tableType, ") Has", name, "(store ", kvStore, ", ", idxParams, ") (bool, error) {")
+			g.P("return t.", field, ".Has(store, ", idxArgs, ")")
+			g.P("}")
+			continue
+		}
+
+		g.P("// List", name, " returns the ", msgType, " entities of the page with the index key.")
+		g.P("func (t ", tableType, ") List", name, "(store ", kvStore, ", ", idxParams, ", pageReq *", pageRequest, ") ([]*", msgType, ", *", pageResponse, ", error) {")
+		generateList(g, msgType, ormEntity, "t."+field+".List(store, pageReq, ", ", "+idxArgs+")")
+		g.P("}")
+	}
+
+	g.P()
+	g.P("// Export returns all the ", msgType, " entities, e.g. to export them to genesis.")
+	g.P("func (t ", tableType, ") Export(store ", kvStore, ") ([]*", msgType, ", error) {")
+	g.P("var entities []*", msgType)
+	g.P("err := t.table.Export(store, func(entity ", ormEntity, ") error {")
+	g.P("entities = append(entities, entity.(*", msgType, "))")
+	g.P("return nil")
+	g.P("})")
+	g.P()
+	g.P("return entities, err")
+	g.P("}")
+	g.P()
+	g.P("// Import inserts the ", msgType, " entities, e.g. imported from genesis.")
+	g.P("func (t ", tableType, ") Import(store ", kvStore, ", entities []*", msgType, ") error {")
+	g.P("for _, entity := range entities {")
+	g.P("if err := t.table.Insert(store, entity); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("}")
+	g.P()
+	g.P("return nil")
+	g.P("}")
+}
+
+// generateList generates the body of a method listing the entities of a page
+// with the given call, whose callback argument is inserted between its prefix
+// and suffix.
+func generateList(g *protogen.GeneratedFile, msgType, ormEntity, callPrefix, callSuffix string) {
+	g.P("var entities []*", msgType)
+	g.P("pageRes, err := ", callPrefix, "func(entity ", ormEntity, ") error {")
+	g.P("entities = append(entities, entity.(*", msgType, "))")
+	g.P("return nil")
+	g.P("}", callSuffix)
+	g.P("if err != nil {")
+	g.P("return nil, nil, err")
+	g.P("}")
+	g.P()
+	g.P("return entities, pageRes, nil")
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,127 @@
+package codegen_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/cosmos/cosmos-sdk/orm/internal/codegen"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func field(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(name),
+		Number:   proto.Int32(number),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     typ.Enum(),
+	}
+}
+
+func comment(message int32, comment string) *descriptorpb.SourceCodeInfo_Location {
+	return &descriptorpb.SourceCodeInfo_Location{
+		Path:            []int32{4, message},
+		Span:            []int32{0, 0, 0},
+		LeadingComments: proto.String(comment),
+	}
+}
+
+// newRequest returns the request to generate the accessors of the messages of
+// testutil/testdata annotated with the given comments.
+func newRequest(dogComment, catComment string) *pluginpb.CodeGeneratorRequest {
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("testdata.proto"),
+		Package: proto.String("testdata"),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{
+			GoPackage: proto.String("github.com/cosmos/cosmos-sdk/testutil/testdata"),
+		},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Dog"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("size", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+					field("name", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				},
+			},
+			{
+				Name: proto.String("Cat"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("moniker", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+					field("lives", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32),
+				},
+			},
+		},
+		SourceCodeInfo: &descriptorpb.SourceCodeInfo{
+			Location: []*descriptorpb.SourceCodeInfo_Location{
+				comment(0, dogComment),
+				comment(1, catComment),
+			},
+		},
+	}
+
+	return &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"testdata.proto"},
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{file},
+	}
+}
+
+func generate(t *testing.T, req *pluginpb.CodeGeneratorRequest) (*pluginpb.CodeGeneratorResponse, error) {
+	plugin, err := protogen.Options{}.New(req)
+	require.NoError(t, err)
+
+	if err := codegen.Generate(plugin); err != nil {
+		return nil, err
+	}
+
+	return plugin.Response(), nil
+}
+
+func TestGenerate(t *testing.T) {
+	res, err := generate(t, newRequest(
+		" Dog is a dog.\n\n orm:table prefix=1 primary_key=name\n orm:index prefix=2 fields=size\n",
+		" orm:table prefix=3 primary_key=moniker\n orm:unique prefix=4 fields=lives,moniker\n",
+	))
+	require.NoError(t, err)
+	require.Nil(t, res.Error, res.GetError())
+	require.Len(t, res.File, 1)
+	require.Equal(t, "github.com/cosmos/cosmos-sdk/testutil/testdata/testdata.cosmos_orm.go", res.File[0].GetName())
+
+	golden := filepath.Join("testdata", "testdata.cosmos_orm.go.golden")
+	if *update {
+		require.NoError(t, os.WriteFile(golden, []byte(res.File[0].GetContent()), 0o600))
+	}
+	expected, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), res.File[0].GetContent())
+}
+
+func TestGenerateErrors(t *testing.T) {
+	for name, dogComment := range map[string]string{
+		"unknown directive":   " orm:view prefix=1\n",
+		"invalid prefix":      " orm:table prefix=256 primary_key=name\n",
+		"unknown field":       " orm:table prefix=1 primary_key=breed\n",
+		"missing primary key": " orm:table prefix=1\n",
+		"index without table": " orm:index prefix=2 fields=size\n",
+		"duplicate table":     " orm:table prefix=1 primary_key=name\n orm:table prefix=2 primary_key=size\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := generate(t, newRequest(dogComment, ""))
+			require.Error(t, err)
+		})
+	}
+
+	// the messages without directives are skipped
+	res, err := generate(t, newRequest(" Dog is a dog.\n", ""))
+	require.NoError(t, err)
+	require.Empty(t, res.File)
+}
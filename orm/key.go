@@ -0,0 +1,64 @@
+package orm
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxKeyFieldLen is the maximum length of the string and bytes fields of a key.
+const MaxKeyFieldLen = math.MaxUint8
+
+// EncodeKey encodes the values of the fields of a key. The integers are encoded
+// in big endian with their sign bit flipped, so that their keys sort in their
+// order, and the strings and bytes are prefixed with their length, so that the
+// keys sharing their leading fields share a prefix, and sort by length first. The supported types are
+// uint64, uint32, int64, int32, bool, string and []byte, and the types defined
+// on them such as enums and addresses.
+func EncodeKey(values ...interface{}) ([]byte, error) {
+	var key []byte
+	for _, value := range values {
+		v := reflect.ValueOf(value)
+		switch v.Kind() {
+		case reflect.Uint64, reflect.Uint32:
+			key = appendUint(key, v.Uint(), v.Type().Bits())
+		case reflect.Int64, reflect.Int32:
+			bits := v.Type().Bits()
+			key = appendUint(key, uint64(v.Int())^(1<<(bits-1)), bits)
+		case reflect.Bool:
+			if v.Bool() {
+				key = append(key, 1)
+			} else {
+				key = append(key, 0)
+			}
+		case reflect.String, reflect.Slice:
+			if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+				return nil, sdkerrors.Wrapf(ErrInvalidKey, "unsupported key field type %T", value)
+			}
+			if v.Len() > MaxKeyFieldLen {
+				return nil, sdkerrors.Wrapf(ErrInvalidKey, "field of length %d exceeds the maximum length %d", v.Len(), MaxKeyFieldLen)
+			}
+
+			var bz []byte
+			if v.Kind() == reflect.String {
+				bz = []byte(v.String())
+			} else {
+				bz = v.Bytes()
+			}
+			key = append(append(key, byte(len(bz))), bz...)
+		default:
+			return nil, sdkerrors.Wrapf(ErrInvalidKey, "unsupported key field type %T", value)
+		}
+	}
+
+	return key, nil
+}
+
+// appendUint appends an unsigned integer of the given bits in big endian.
+func appendUint(key []byte, v uint64, bits int) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, v)
+	return append(key, bz[8-bits/8:]...)
+}
@@ -0,0 +1,45 @@
+package orm_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEncodeKey(t *testing.T) {
+	key, err := orm.EncodeKey(uint64(1), "ab", []byte{3}, true)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 1, 2, 'a', 'b', 1, 3, 1}, key)
+
+	// the types defined on the supported types are supported
+	key, err = orm.EncodeKey(sdk.AccAddress{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, []byte{2, 1, 2}, key)
+
+	// the keys of the integers sort in their order
+	for _, values := range [][2]interface{}{
+		{int64(-2), int64(-1)},
+		{int64(-1), int64(0)},
+		{int64(0), int64(1)},
+		{int32(-1), int32(1)},
+		{uint32(1), uint32(256)},
+	} {
+		a, err := orm.EncodeKey(values[0])
+		require.NoError(t, err)
+		b, err := orm.EncodeKey(values[1])
+		require.NoError(t, err)
+		require.Equal(t, -1, bytes.Compare(a, b), values)
+	}
+
+	_, err = orm.EncodeKey(strings.Repeat("a", orm.MaxKeyFieldLen+1))
+	require.ErrorIs(t, err, orm.ErrInvalidKey)
+	_, err = orm.EncodeKey(1.5)
+	require.ErrorIs(t, err, orm.ErrInvalidKey)
+	_, err = orm.EncodeKey([]string{"a"})
+	require.ErrorIs(t, err, orm.ErrInvalidKey)
+}
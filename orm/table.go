@@ -0,0 +1,270 @@
+// Package orm stores the entities of a module in tables, indexed by their
+// primary key and by secondary indexes, so that the keepers do not prefix the
+// keys and iterate over the stores by hand.
+//
+// The tables are usually not built directly but by the accessors generated by
+// protoc-gen-go-cosmos-orm from the table definitions of the proto messages:
+//
+//	// Balance is the balance of a denom of an account.
+//	//
+//	// orm:table prefix=1 primary_key=address,denom
+//	// orm:index prefix=2 fields=denom
+//	message Balance {
+//	  bytes  address = 1;
+//	  string denom   = 2;
+//	  uint64 amount  = 3;
+//	}
+//
+// An entity is stored under the prefix of its table followed by its primary
+// key, and each index maps the index key of an entity to its primary key.
+package orm
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// Entity is an entity stored in a table.
+type Entity = codec.ProtoMarshaler
+
+// KeyFunc returns the values of the fields of a key of an entity, whose types
+// must be supported by EncodeKey.
+type KeyFunc func(Entity) []interface{}
+
+// Table stores entities under their primary key and maintains their indexes.
+type Table struct {
+	prefix     byte
+	cdc        codec.BinaryCodec
+	newEntity  func() Entity
+	primaryKey KeyFunc
+	indexes    []*Index
+}
+
+// NewTable returns the table of the entities created by newEntity, stored under
+// the given prefix and the primary key returned by primaryKey.
+func NewTable(prefix byte, cdc codec.BinaryCodec, newEntity func() Entity, primaryKey KeyFunc) *Table {
+	return &Table{
+		prefix:     prefix,
+		cdc:        cdc,
+		newEntity:  newEntity,
+		primaryKey: primaryKey,
+	}
+}
+
+// AddIndex adds an index of the entities under the given prefix, keyed by the
+// fields returned by fields. Only one entity can have a given key of a unique
+// index. The indexes must be added before the table is used, and it panics if
+// the prefix is already used by the table or another index.
+func (t *Table) AddIndex(prefix byte, unique bool, fields KeyFunc) *Index {
+	if prefix == t.prefix {
+		panic(fmt.Errorf("index prefix %d is the prefix of its table", prefix))
+	}
+	for _, index := range t.indexes {
+		if index.prefix == prefix {
+			panic(fmt.Errorf("index prefix %d is already used", prefix))
+		}
+	}
+
+	index := &Index{table: t, prefix: prefix, unique: unique, fields: fields}
+	t.indexes = append(t.indexes, index)
+
+	return index
+}
+
+func (t *Table) entityKey(primaryKey []byte) []byte {
+	return append([]byte{t.prefix}, primaryKey...)
+}
+
+// Insert inserts an entity. It returns ErrAlreadyExists if its primary key is
+// already used and ErrUniqueConstraint if one of its unique index keys is.
+func (t *Table) Insert(store sdk.KVStore, entity Entity) error {
+	pk, err := EncodeKey(t.primaryKey(entity)...)
+	if err != nil {
+		return err
+	}
+	if store.Has(t.entityKey(pk)) {
+		return sdkerrors.Wrapf(ErrAlreadyExists, "primary key %X", pk)
+	}
+
+	return t.write(store, pk, nil, entity)
+}
+
+// Update updates an existing entity. It returns ErrNotFound if no entity has its
+// primary key and ErrUniqueConstraint if one of its unique index keys is used
+// by another entity.
+func (t *Table) Update(store sdk.KVStore, entity Entity) error {
+	pk, err := EncodeKey(t.primaryKey(entity)...)
+	if err != nil {
+		return err
+	}
+
+	old := t.newEntity()
+	if err := t.get(store, pk, old); err != nil {
+		return err
+	}
+
+	return t.write(store, pk, old, entity)
+}
+
+// Save inserts an entity or updates it if its primary key is already used.
+func (t *Table) Save(store sdk.KVStore, entity Entity) error {
+	pk, err := EncodeKey(t.primaryKey(entity)...)
+	if err != nil {
+		return err
+	}
+
+	var old Entity
+	if bz := store.Get(t.entityKey(pk)); bz != nil {
+		old = t.newEntity()
+		if err := t.cdc.Unmarshal(bz, old); err != nil {
+			return err
+		}
+	}
+
+	return t.write(store, pk, old, entity)
+}
+
+// write writes the entity of the primary key, replacing the index entries of
+// the old entity, if not nil, with its own.
+func (t *Table) write(store sdk.KVStore, pk []byte, old, entity Entity) error {
+	oldKeys := make([][]byte, len(t.indexes))
+	newKeys := make([][]byte, len(t.indexes))
+	for i, index := range t.indexes {
+		var err error
+		if old != nil {
+			if oldKeys[i], err = index.entryKey(old, pk); err != nil {
+				return err
+			}
+		}
+		if newKeys[i], err = index.entryKey(entity, pk); err != nil {
+			return err
+		}
+
+		if index.unique && string(oldKeys[i]) != string(newKeys[i]) && store.Has(newKeys[i]) {
+			return sdkerrors.Wrapf(ErrUniqueConstraint, "index %d key %X", index.prefix, newKeys[i][1:])
+		}
+	}
+
+	bz, err := t.cdc.Marshal(entity)
+	if err != nil {
+		return err
+	}
+	store.Set(t.entityKey(pk), bz)
+
+	for i := range t.indexes {
+		if oldKeys[i] != nil {
+			store.Delete(oldKeys[i])
+		}
+		store.Set(newKeys[i], pk)
+	}
+
+	return nil
+}
+
+// Delete deletes the entity of the primary key and its index entries. It
+// returns ErrNotFound if no entity has the primary key.
+func (t *Table) Delete(store sdk.KVStore, primaryKey ...interface{}) error {
+	pk, err := EncodeKey(primaryKey...)
+	if err != nil {
+		return err
+	}
+
+	entity := t.newEntity()
+	if err := t.get(store, pk, entity); err != nil {
+		return err
+	}
+
+	for _, index := range t.indexes {
+		key, err := index.entryKey(entity, pk)
+		if err != nil {
+			return err
+		}
+		store.Delete(key)
+	}
+	store.Delete(t.entityKey(pk))
+
+	return nil
+}
+
+// Has returns whether an entity has the primary key.
+func (t *Table) Has(store sdk.KVStore, primaryKey ...interface{}) (bool, error) {
+	pk, err := EncodeKey(primaryKey...)
+	if err != nil {
+		return false, err
+	}
+
+	return store.Has(t.entityKey(pk)), nil
+}
+
+// Get reads the entity of the primary key into dest. It returns ErrNotFound if
+// no entity has the primary key.
+func (t *Table) Get(store sdk.KVStore, dest Entity, primaryKey ...interface{}) error {
+	pk, err := EncodeKey(primaryKey...)
+	if err != nil {
+		return err
+	}
+
+	return t.get(store, pk, dest)
+}
+
+func (t *Table) get(store sdk.KVStore, pk []byte, dest Entity) error {
+	bz := store.Get(t.entityKey(pk))
+	if bz == nil {
+		return sdkerrors.Wrapf(ErrNotFound, "primary key %X", pk)
+	}
+
+	return t.cdc.Unmarshal(bz, dest)
+}
+
+// List calls onEntity on the entities of the page, in the order of their
+// primary keys, whose leading fields are the given prefix fields, if any.
+func (t *Table) List(store sdk.KVStore, pageReq *query.PageRequest, onEntity func(Entity) error, prefixFields ...interface{}) (*query.PageResponse, error) {
+	keyPrefix, err := EncodeKey(prefixFields...)
+	if err != nil {
+		return nil, err
+	}
+
+	entityStore := prefix.NewStore(store, t.entityKey(keyPrefix))
+	return query.Paginate(entityStore, pageReq, func(_, value []byte) error {
+		entity := t.newEntity()
+		if err := t.cdc.Unmarshal(value, entity); err != nil {
+			return err
+		}
+
+		return onEntity(entity)
+	})
+}
+
+// Export calls onEntity on all the entities, e.g. to export them to genesis.
+func (t *Table) Export(store sdk.KVStore, onEntity func(Entity) error) error {
+	iter := sdk.KVStorePrefixIterator(store, []byte{t.prefix})
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		entity := t.newEntity()
+		if err := t.cdc.Unmarshal(iter.Value(), entity); err != nil {
+			return err
+		}
+		if err := onEntity(entity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Import inserts the entities, e.g. imported from genesis.
+func (t *Table) Import(store sdk.KVStore, entities []Entity) error {
+	for _, entity := range entities {
+		if err := t.Insert(store, entity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package orm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/orm"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// newDogTable returns the table of the dogs keyed by name, indexed by size, and
+// uniquely indexed by size and name for testing purposes.
+func newDogTable() (*orm.Table, *orm.Index, *orm.Index) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	table := orm.NewTable(1, cdc, func() orm.Entity { return &testdata.Dog{} }, func(entity orm.Entity) []interface{} {
+		return []interface{}{entity.(*testdata.Dog).Name}
+	})
+	bySize := table.AddIndex(2, false, func(entity orm.Entity) []interface{} {
+		return []interface{}{entity.(*testdata.Dog).Size_}
+	})
+	bySizeName := table.AddIndex(3, true, func(entity orm.Entity) []interface{} {
+		dog := entity.(*testdata.Dog)
+		return []interface{}{dog.Size_, dog.Name}
+	})
+
+	return table, bySize, bySizeName
+}
+
+func listDogs(t *testing.T, list func(func(orm.Entity) error) (*query.PageResponse, error)) []string {
+	var names []string
+	_, err := list(func(entity orm.Entity) error {
+		names = append(names, entity.(*testdata.Dog).Name)
+		return nil
+	})
+	require.NoError(t, err)
+
+	return names
+}
+
+func TestTable(t *testing.T) {
+	table, bySize, bySizeName := newDogTable()
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	require.NoError(t, table.Insert(store, &testdata.Dog{Name: "rex", Size_: "big"}))
+	require.NoError(t, table.Insert(store, &testdata.Dog{Name: "fido", Size_: "small"}))
+	require.NoError(t, table.Save(store, &testdata.Dog{Name: "max", Size_: "big"}))
+	require.ErrorIs(t, table.Insert(store, &testdata.Dog{Name: "rex"}), orm.ErrAlreadyExists)
+
+	var dog testdata.Dog
+	require.NoError(t, table.Get(store, &dog, "rex"))
+	require.Equal(t, "big", dog.Size_)
+	require.ErrorIs(t, table.Get(store, &dog, "rover"), orm.ErrNotFound)
+	has, err := table.Has(store, "fido")
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// the strings of the keys sort by length first
+	require.Equal(t, []string{"max", "rex", "fido"}, listDogs(t, func(onEntity func(orm.Entity) error) (*query.PageResponse, error) {
+		return table.List(store, nil, onEntity)
+	}))
+	require.Equal(t, []string{"max", "rex"}, listDogs(t, func(onEntity func(orm.Entity) error) (*query.PageResponse, error) {
+		return bySize.List(store, nil, onEntity, "big")
+	}))
+
+	// the index entries follow the updates
+	require.NoError(t, table.Update(store, &testdata.Dog{Name: "rex", Size_: "small"}))
+	require.ErrorIs(t, table.Update(store, &testdata.Dog{Name: "rover"}), orm.ErrNotFound)
+	require.Equal(t, []string{"max"}, listDogs(t, func(onEntity func(orm.Entity) error) (*query.PageResponse, error) {
+		return bySize.List(store, nil, onEntity, "big")
+	}))
+	require.Equal(t, []string{"rex", "fido"}, listDogs(t, func(onEntity func(orm.Entity) error) (*query.PageResponse, error) {
+		return bySize.List(store, nil, onEntity, "small")
+	}))
+
+	require.NoError(t, bySizeName.Get(store, &dog, "small", "rex"))
+	require.Equal(t, "rex", dog.Name)
+	require.ErrorIs(t, bySizeName.Get(store, &dog, "big", "rex"), orm.ErrNotFound)
+	require.ErrorIs(t, bySize.Get(store, &dog, "big"), orm.ErrNotUnique)
+
+	require.NoError(t, table.Delete(store, "rex"))
+	require.ErrorIs(t, table.Delete(store, "rex"), orm.ErrNotFound)
+	has, err = bySizeName.Has(store, "small", "rex")
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Equal(t, []string{"fido"}, listDogs(t, func(onEntity func(orm.Entity) error) (*query.PageResponse, error) {
+		return bySize.List(store, nil, onEntity, "small")
+	}))
+}
+
+func TestTableUniqueConstraint(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	table := orm.NewTable(1, cdc, func() orm.Entity { return &testdata.Cat{} }, func(entity orm.Entity) []interface{} {
+		return []interface{}{entity.(*testdata.Cat).Moniker}
+	})
+	byLives := table.AddIndex(2, true, func(entity orm.Entity) []interface{} {
+		return []interface{}{entity.(*testdata.Cat).Lives}
+	})
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	require.NoError(t, table.Insert(store, &testdata.Cat{Moniker: "tom", Lives: 9}))
+	require.ErrorIs(t, table.Insert(store, &testdata.Cat{Moniker: "felix", Lives: 9}), orm.ErrUniqueConstraint)
+	has, err := table.Has(store, "felix")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// an entity can keep its own unique key
+	require.NoError(t, table.Save(store, &testdata.Cat{Moniker: "tom", Lives: 9}))
+	require.NoError(t, table.Insert(store, &testdata.Cat{Moniker: "felix", Lives: 7}))
+	require.ErrorIs(t, table.Update(store, &testdata.Cat{Moniker: "felix", Lives: 9}), orm.ErrUniqueConstraint)
+
+	var cat testdata.Cat
+	require.NoError(t, byLives.Get(store, &cat, int32(7)))
+	require.Equal(t, "felix", cat.Moniker)
+
+	require.Panics(t, func() {
+		table.AddIndex(2, false, func(entity orm.Entity) []interface{} { return nil })
+	})
+}
+
+func TestTablePagination(t *testing.T) {
+	table, bySize, _ := newDogTable()
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		require.NoError(t, table.Insert(store, &testdata.Dog{Name: name, Size_: "big"}))
+	}
+
+	var names []string
+	onEntity := func(entity orm.Entity) error {
+		names = append(names, entity.(*testdata.Dog).Name)
+		return nil
+	}
+
+	res, err := bySize.List(store, &query.PageRequest{Limit: 2, CountTotal: true}, onEntity, "big")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, names)
+	require.Equal(t, uint64(5), res.Total)
+
+	res, err = bySize.List(store, &query.PageRequest{Key: res.NextKey, Limit: 2}, onEntity, "big")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c", "d"}, names)
+	require.NotNil(t, res.NextKey)
+
+	names = nil
+	_, err = table.List(store, &query.PageRequest{Limit: 2, Reverse: true}, onEntity)
+	require.NoError(t, err)
+	require.Equal(t, []string{"e", "d"}, names)
+
+	var exported []string
+	require.NoError(t, table.Export(store, func(entity orm.Entity) error {
+		exported = append(exported, entity.(*testdata.Dog).Name)
+		return nil
+	}))
+	require.Equal(t, []string{"a", "b", "c", "d", "e"}, exported)
+
+	imported, _, _ := newDogTable()
+	other := dbadapter.Store{DB: dbm.NewMemDB()}
+	require.NoError(t, imported.Import(other, []orm.Entity{&testdata.Dog{Name: "a"}, &testdata.Dog{Name: "b"}}))
+	require.ErrorIs(t, imported.Import(other, []orm.Entity{&testdata.Dog{Name: "a"}}), orm.ErrAlreadyExists)
+}
@@ -13,6 +13,9 @@ protoc_gen_gocosmos() {
 
 protoc_gen_gocosmos
 
+# the ORM table accessors are generated from the orm directives of the messages
+go install ./orm/cmd/protoc-gen-go-cosmos-orm
+
 proto_dirs=$(find ./proto -path -prune -o -name '*.proto' -print0 | xargs -0 -n1 dirname | sort | uniq)
 for dir in $proto_dirs; do
   buf protoc \
@@ -21,6 +24,7 @@ for dir in $proto_dirs; do
     --gocosmos_out=plugins=grpc,\
 Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:. \
     --grpc-gateway_out=logtostderr=true,allow_colon_final_segments=true:. \
+    --go-cosmos-orm_out=. \
   $(find "${dir}" -maxdepth 1 -name '*.proto')
 
 done
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// RegisterRESTAliases registers the given REST route aliases, which forward
+// GET requests to the gRPC gateway. It must be called before the server is
+// started, so that the aliases take precedence over the gRPC gateway routes.
+func (s *Server) RegisterRESTAliases(aliases []module.RESTAlias) {
+	for _, alias := range aliases {
+		s.Router.HandleFunc(alias.Path, s.restAliasHandler(alias)).Methods(http.MethodGet)
+	}
+}
+
+func (s *Server) restAliasHandler(alias module.RESTAlias) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		target := alias.Target
+		for name, value := range mux.Vars(r) {
+			target = strings.ReplaceAll(target, "{"+name+"}", value)
+		}
+
+		req := r.Clone(r.Context())
+		req.URL.Path = target
+		req.URL.RawPath = ""
+		req.RequestURI = ""
+
+		if alias.TextField == "" {
+			s.GRPCGatewayRouter.ServeHTTP(w, req)
+			return
+		}
+
+		rec := httptest.NewRecorder()
+		s.GRPCGatewayRouter.ServeHTTP(rec, req)
+
+		// errors are returned as is
+		if rec.Code != http.StatusOK {
+			for k, v := range rec.Header() {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(rec.Code)
+			_, _ = w.Write(rec.Body.Bytes())
+			return
+		}
+
+		value, err := jsonScalarField(rec.Body.Bytes(), alias.TextField)
+		if err != nil {
+			writeErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte(value))
+	}
+}
+
+// jsonScalarField returns the value of the scalar field at the dot-separated
+// path of the given JSON object.
+func jsonScalarField(bz []byte, path string) (string, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return "", err
+	}
+
+	for _, name := range strings.Split(path, ".") {
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("field %s of response is not an object", name)
+		}
+
+		if value, ok = obj[name]; !ok {
+			return "", fmt.Errorf("response has no field %s", path)
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return fmt.Sprint(v), nil
+	default:
+		return "", fmt.Errorf("field %s of response is not a scalar", path)
+	}
+}
@@ -0,0 +1,71 @@
+package api_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type supplyQueryClient struct {
+	banktypes.QueryClient
+}
+
+func (supplyQueryClient) SupplyOf(_ context.Context, req *banktypes.QuerySupplyOfRequest, _ ...grpc.CallOption) (*banktypes.QuerySupplyOfResponse, error) {
+	if req.Denom != "stake" {
+		return nil, status.Error(codes.NotFound, "unknown denom")
+	}
+
+	return &banktypes.QuerySupplyOfResponse{Amount: sdk.NewInt64Coin("stake", 1000)}, nil
+}
+
+func TestRESTAliases(t *testing.T) {
+	srv := api.New(client.Context{}, log.NewNopLogger())
+	require.NoError(t, banktypes.RegisterQueryHandlerClient(context.Background(), srv.GRPCGatewayRouter, supplyQueryClient{}))
+
+	srv.RegisterRESTAliases([]module.RESTAlias{
+		{Path: "/supply/{denom}", Target: "/cosmos/bank/v1beta1/supply/{denom}", TextField: "amount.amount"},
+		{Path: "/supply-json/{denom}", Target: "/cosmos/bank/v1beta1/supply/{denom}"},
+		{Path: "/supply-coin/{denom}", Target: "/cosmos/bank/v1beta1/supply/{denom}", TextField: "amount"},
+	})
+
+	get := func(path string) (int, string, string) {
+		rec := httptest.NewRecorder()
+		srv.Router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		bz, err := ioutil.ReadAll(rec.Body)
+		require.NoError(t, err)
+		return rec.Code, rec.Header().Get("Content-Type"), string(bz)
+	}
+
+	code, contentType, body := get("/supply/stake")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "text/plain; charset=utf-8", contentType)
+	require.Equal(t, "1000", body)
+
+	code, contentType, body = get("/supply-json/stake")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "application/json", contentType)
+	require.Contains(t, body, `"amount": "1000"`)
+
+	// errors of the gRPC gateway are forwarded
+	code, _, body = get("/supply/foo")
+	require.Equal(t, http.StatusNotFound, code)
+	require.Contains(t, body, "unknown denom")
+
+	// only scalar fields can be returned as plain text
+	code, _, _ = get("/supply-coin/stake")
+	require.Equal(t, http.StatusInternalServerError, code)
+}
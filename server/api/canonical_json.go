@@ -0,0 +1,51 @@
+package api
+
+import (
+	"io"
+
+	"github.com/gogo/gateway"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// CanonicalJSONMIME is the media type to accept for the gRPC-gateway to
+// respond with the canonical JSON encoding of the responses, see
+// codec.CanonicalJSON.
+const CanonicalJSONMIME = "application/vnd.cosmos.canonical+json"
+
+// canonicalJSONMarshaler is the gRPC-gateway marshaler of CanonicalJSONMIME.
+// It only differs from the default marshaler on its outputs.
+type canonicalJSONMarshaler struct {
+	*gateway.JSONPb
+}
+
+var _ runtime.Marshaler = canonicalJSONMarshaler{}
+
+// ContentType implements runtime.Marshaler.
+func (canonicalJSONMarshaler) ContentType() string {
+	return "application/json"
+}
+
+// Marshal implements runtime.Marshaler.
+func (m canonicalJSONMarshaler) Marshal(v interface{}) ([]byte, error) {
+	bz, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.CanonicalJSON(bz)
+}
+
+// NewEncoder implements runtime.Marshaler.
+func (m canonicalJSONMarshaler) NewEncoder(w io.Writer) runtime.Encoder {
+	return runtime.EncoderFunc(func(v interface{}) error {
+		bz, err := m.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(bz)
+		return err
+	})
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogo/gateway"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func TestCanonicalJSONMarshaler(t *testing.T) {
+	registry := types.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+
+	m := canonicalJSONMarshaler{JSONPb: &gateway.JSONPb{
+		EmitDefaults: true,
+		Indent:       "  ",
+		OrigName:     true,
+		AnyResolver:  registry,
+	}}
+	expected := `{"name":"Spot","size":"big"}`
+
+	bz, err := m.Marshal(&testdata.Dog{Name: "Spot", Size_: "big"})
+	require.NoError(t, err)
+	require.Equal(t, expected, string(bz))
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, m.NewEncoder(buf).Encode(&testdata.Dog{Name: "Spot", Size_: "big"}))
+	require.Equal(t, expected, buf.String())
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HealthStatus defines the status of the node reported by the /healthz and
+// /readyz endpoints.
+type HealthStatus struct {
+	// Live is true if the node and the stores of the application respond.
+	Live bool `json:"live"`
+
+	// Ready is true if the node is live, caught up, and its latest block is
+	// recent enough, i.e. if it can serve up to date queries.
+	Ready bool `json:"ready"`
+
+	CatchingUp        bool      `json:"catching_up"`
+	LatestBlockHeight int64     `json:"latest_block_height"`
+	LatestBlockTime   time.Time `json:"latest_block_time"`
+	LatestBlockAge    string    `json:"latest_block_age"`
+	AppHeight         int64     `json:"app_height"`
+
+	// Errors are the reasons for which the node isn't live or ready.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// healthStatus returns the status of the node. The node is ready if its
+// latest block is at most maxBlockAge old, unless zero.
+func (s *Server) healthStatus(r *http.Request, maxBlockAge time.Duration) HealthStatus {
+	var status HealthStatus
+
+	node, err := s.ClientCtx.GetNode()
+	if err != nil {
+		status.Errors = append(status.Errors, err.Error())
+		return status
+	}
+
+	nodeStatus, err := node.Status(r.Context())
+	if err != nil {
+		status.Errors = append(status.Errors, fmt.Sprintf("failed to query node status: %s", err))
+		return status
+	}
+
+	// the ABCI info query is served by the application from its committed
+	// stores
+	info, err := node.ABCIInfo(r.Context())
+	if err != nil {
+		status.Errors = append(status.Errors, fmt.Sprintf("failed to query application info: %s", err))
+		return status
+	}
+
+	status.CatchingUp = nodeStatus.SyncInfo.CatchingUp
+	status.LatestBlockHeight = nodeStatus.SyncInfo.LatestBlockHeight
+	status.LatestBlockTime = nodeStatus.SyncInfo.LatestBlockTime
+	status.AppHeight = info.Response.LastBlockHeight
+	age := time.Since(status.LatestBlockTime)
+	status.LatestBlockAge = age.Round(time.Millisecond).String()
+
+	// the application commits a block after Tendermint saves it, so its
+	// height may lag one block behind
+	status.Live = true
+	if status.AppHeight < status.LatestBlockHeight-1 {
+		status.Live = false
+		status.Errors = append(status.Errors, fmt.Sprintf("application height %d is behind block height %d", status.AppHeight, status.LatestBlockHeight))
+	}
+
+	status.Ready = status.Live
+	if status.CatchingUp {
+		status.Ready = false
+		status.Errors = append(status.Errors, "node is catching up")
+	}
+	if maxBlockAge > 0 && age > maxBlockAge {
+		status.Ready = false
+		status.Errors = append(status.Errors, fmt.Sprintf("latest block is older than %s", maxBlockAge))
+	}
+
+	return status
+}
+
+// registerHealthRoutes registers the /healthz liveness and /readyz readiness
+// endpoints, responding 200 if the node is live, respectively ready, and 503
+// otherwise.
+func (s *Server) registerHealthRoutes(maxBlockAge time.Duration) {
+	handler := func(ready bool) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			status := s.healthStatus(r, maxBlockAge)
+
+			code := http.StatusOK
+			if (ready && !status.Ready) || (!ready && !status.Live) {
+				code = http.StatusServiceUnavailable
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(code)
+			_ = json.NewEncoder(w).Encode(status)
+		}
+	}
+
+	s.Router.HandleFunc("/healthz", handler(false)).Methods("GET")
+	s.Router.HandleFunc("/readyz", handler(true)).Methods("GET")
+}
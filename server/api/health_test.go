@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+type healthClient struct {
+	mock.Client
+	syncInfo  ctypes.SyncInfo
+	appHeight int64
+	err       error
+}
+
+func (c healthClient) Status(context.Context) (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{SyncInfo: c.syncInfo}, c.err
+}
+
+func (c healthClient) ABCIInfo(context.Context) (*ctypes.ResultABCIInfo, error) {
+	return &ctypes.ResultABCIInfo{Response: abci.ResponseInfo{LastBlockHeight: c.appHeight}}, nil
+}
+
+func TestHealthRoutes(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name    string
+		client  healthClient
+		live    bool
+		ready   bool
+		errsLen int
+	}{
+		{
+			"ready",
+			healthClient{syncInfo: ctypes.SyncInfo{LatestBlockHeight: 10, LatestBlockTime: now}, appHeight: 10},
+			true, true, 0,
+		},
+		{
+			"application one block behind",
+			healthClient{syncInfo: ctypes.SyncInfo{LatestBlockHeight: 10, LatestBlockTime: now}, appHeight: 9},
+			true, true, 0,
+		},
+		{
+			"catching up",
+			healthClient{syncInfo: ctypes.SyncInfo{LatestBlockHeight: 10, LatestBlockTime: now, CatchingUp: true}, appHeight: 10},
+			true, false, 1,
+		},
+		{
+			"stale block",
+			healthClient{syncInfo: ctypes.SyncInfo{LatestBlockHeight: 10, LatestBlockTime: now.Add(-time.Hour)}, appHeight: 10},
+			true, false, 1,
+		},
+		{
+			"application behind",
+			healthClient{syncInfo: ctypes.SyncInfo{LatestBlockHeight: 10, LatestBlockTime: now}, appHeight: 5},
+			false, false, 1,
+		},
+		{
+			"node unavailable",
+			healthClient{err: errors.New("unavailable")},
+			false, false, 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			s := &Server{Router: mux.NewRouter(), ClientCtx: client.Context{}.WithClient(tc.client)}
+			s.registerHealthRoutes(time.Minute)
+
+			for path, ok := range map[string]bool{"/healthz": tc.live, "/readyz": tc.ready} {
+				rec := httptest.NewRecorder()
+				s.Router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+				expectedCode := http.StatusOK
+				if !ok {
+					expectedCode = http.StatusServiceUnavailable
+				}
+				require.Equal(t, expectedCode, rec.Code, path)
+
+				var status HealthStatus
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+				require.Equal(t, tc.live, status.Live)
+				require.Equal(t, tc.ready, status.Ready)
+				require.Len(t, status.Errors, tc.errsLen)
+			}
+		})
+	}
+}
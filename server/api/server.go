@@ -1,10 +1,12 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gogo/gateway"
@@ -33,6 +35,12 @@ type Server struct {
 	logger   log.Logger
 	metrics  *telemetry.Metrics
 	listener net.Listener
+
+	// mtx guards closing, so that no request is added to inFlight once the
+	// server is shutting down.
+	mtx      sync.Mutex
+	closing  bool
+	inFlight sync.WaitGroup
 }
 
 // CustomGRPCHeaderMatcher for mapping request headers to
@@ -67,6 +75,15 @@ func New(clientCtx client.Context, logger log.Logger) *Server {
 			// Custom marshaler option is required for gogo proto
 			runtime.WithMarshalerOption(runtime.MIMEWildcard, marshalerOption),
 
+			// Canonical JSON responses for the requests accepting them
+			runtime.WithMarshalerOption(CanonicalJSONMIME, canonicalJSONMarshaler{
+				JSONPb: &gateway.JSONPb{
+					EmitDefaults: true,
+					OrigName:     true,
+					AnyResolver:  clientCtx.InterfaceRegistry,
+				},
+			}),
+
 			// This is necessary to get error details properly
 			// marshalled in unary requests.
 			runtime.WithProtoErrorHandler(runtime.DefaultHTTPProtoErrorHandler),
@@ -104,10 +121,11 @@ func (s *Server) Start(cfg config.Config) error {
 		return err
 	}
 
+	s.registerHealthRoutes(time.Duration(cfg.API.ReadyMaxBlockAge) * time.Second)
 	s.registerGRPCGatewayRoutes()
 
 	s.listener = listener
-	var h http.Handler = s.Router
+	var h http.Handler = s.trackInFlight(s.Router)
 
 	if cfg.API.EnableUnsafeCORS {
 		allowAllCORS := handlers.CORS(handlers.AllowedHeaders([]string{"Content-Type"}))
@@ -115,7 +133,7 @@ func (s *Server) Start(cfg config.Config) error {
 	}
 
 	s.logger.Info("starting API server...")
-	return tmrpcserver.Serve(s.listener, s.Router, s.logger, tmCfg)
+	return tmrpcserver.Serve(s.listener, h, s.logger, tmCfg)
 }
 
 // Close closes the API server.
@@ -123,6 +141,59 @@ func (s *Server) Close() error {
 	return s.listener.Close()
 }
 
+// CloseMetrics flushes and stops the telemetry sinks of the API server, if
+// enabled. It must be called once the node is stopped, so that the metrics of
+// the last blocks are flushed.
+func (s *Server) CloseMetrics() {
+	if s.metrics != nil {
+		s.metrics.Close()
+	}
+}
+
+// Shutdown stops the API server from accepting new requests and waits for the
+// in-flight requests to complete, or for the context to be done. The requests
+// received on the open connections while shutting down are rejected.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mtx.Lock()
+	s.closing = true
+	s.mtx.Unlock()
+
+	if err := s.Close(); err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.inFlight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// trackInFlight wraps the handler to track the in-flight requests drained by
+// Shutdown.
+func (s *Server) trackInFlight(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mtx.Lock()
+		if s.closing {
+			s.mtx.Unlock()
+			writeErrorResponse(w, http.StatusServiceUnavailable, "server is shutting down")
+			return
+		}
+		s.inFlight.Add(1)
+		s.mtx.Unlock()
+
+		defer s.inFlight.Done()
+		h.ServeHTTP(w, r)
+	})
+}
+
 func (s *Server) registerGRPCGatewayRoutes() {
 	s.Router.PathPrefix("/").Handler(s.GRPCGatewayRouter)
 }
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerShutdown(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &Server{Router: mux.NewRouter(), listener: listener}
+	started, release := make(chan struct{}), make(chan struct{})
+	s.Router.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	})
+	go http.Serve(listener, s.trackInFlight(s.Router)) //nolint:errcheck
+
+	url := "http://" + listener.Addr().String() + "/slow"
+	resCh := make(chan *http.Response)
+	go func() {
+		res, err := http.Get(url) //nolint:gosec
+		require.NoError(t, err)
+		resCh <- res
+	}()
+	<-started
+
+	// the in-flight request isn't aborted
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, s.Shutdown(ctx), context.DeadlineExceeded)
+
+	// new requests are rejected
+	_, err = http.Get(url) //nolint:gosec
+	require.Error(t, err)
+
+	// and the shutdown completes once the in-flight request completes
+	close(release)
+	res := <-resCh
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.NoError(t, res.Body.Close())
+
+	s.mtx.Lock()
+	require.True(t, s.closing)
+	s.mtx.Unlock()
+	s.inFlight.Wait()
+}
@@ -0,0 +1,326 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/cosmos/iavl"
+	"github.com/spf13/cobra"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	iavlstore "github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+const FlagIAVL = "iavl"
+
+// AppHashDump holds the app hash committed at a height along with the commit
+// IDs of the stores it is computed from, to localize the stores causing an app
+// hash mismatch between nodes.
+type AppHashDump struct {
+	Height  int64            `json:"height"`
+	AppHash tmbytes.HexBytes `json:"app_hash"`
+	Stores  []StoreHash      `json:"stores"`
+}
+
+// StoreHash holds the hash of a store committed at a height. With the IAVL
+// option of the dump, IAVLHash is the root hash of the IAVL tree of the store
+// at that height, recomputed from its nodes, which differs from the committed
+// hash if the tree of the node is corrupted.
+type StoreHash struct {
+	Name       string           `json:"name"`
+	CommitHash tmbytes.HexBytes `json:"commit_hash"`
+	IAVLHash   tmbytes.HexBytes `json:"iavl_hash,omitempty"`
+}
+
+// StoreHashDiff describes a store whose hashes differ between two dumps. The
+// hashes of a store missing from a dump are empty.
+type StoreHashDiff struct {
+	Name string    `json:"name"`
+	A    StoreHash `json:"a"`
+	B    StoreHash `json:"b"`
+}
+
+// AppHashDumpCmd dumps the app hash and store hashes committed at a height.
+func AppHashDumpCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apphash-dump",
+		Short: "Dump the app hash and the hashes of the stores committed at a height",
+		Long: `Dump, as JSON, the app hash committed at a height along with the commit IDs of the
+module stores it is computed from. With --iavl, the root hash of the IAVL tree of each
+store is also recomputed from its nodes.
+
+After a node halts on an app hash mismatch, stop it and dump its latest height, then
+dump the same height on a node agreeing with the chain and compare both dumps with the
+apphash-compare command to find the stores which diverged.
+
+The node must be stopped while running this command.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			withIAVL, _ := cmd.Flags().GetBool(FlagIAVL)
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			dump, err := GetAppHashDump(db, height, withIAVL)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(dump, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, 0, "Dump the hashes at a particular height (0 means latest height)")
+	cmd.Flags().Bool(FlagIAVL, false, "Recompute the root hash of the IAVL tree of each store")
+
+	return cmd
+}
+
+// AppHashCompareCmd compares two dumps of the apphash-dump command.
+func AppHashCompareCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "apphash-compare [dump-a] [dump-b]",
+		Short: "Compare two app hash dumps and list the stores whose hashes differ",
+		Long: `Compare two JSON dumps of the apphash-dump command, e.g. taken on two nodes disagreeing
+on the app hash of a height, and list the stores whose hashes differ. The command fails
+if any store differs.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			a, err := readAppHashDump(args[0])
+			if err != nil {
+				return err
+			}
+			b, err := readAppHashDump(args[1])
+			if err != nil {
+				return err
+			}
+
+			if a.Height != b.Height {
+				return fmt.Errorf("the dumps are of different heights: %d and %d", a.Height, b.Height)
+			}
+
+			diffs := CompareAppHashDumps(a, b)
+			if len(diffs) == 0 {
+				cmd.Printf("the app hashes of height %d match: %s\n", a.Height, a.AppHash)
+				return nil
+			}
+
+			bz, err := json.MarshalIndent(diffs, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+
+			return fmt.Errorf("%d stores differ at height %d", len(diffs), a.Height)
+		},
+	}
+}
+
+// GetAppHashDump returns the app hash and the store hashes committed at the
+// given height of the application database. A height of zero selects the
+// latest committed height. With withIAVL, the root hash of the IAVL tree of
+// each store is recomputed from its nodes.
+func GetAppHashDump(db dbm.DB, height int64, withIAVL bool) (AppHashDump, error) {
+	if height == 0 {
+		height = rootmulti.GetLatestVersion(db)
+	}
+
+	cInfo, err := rootmulti.GetCommitInfo(db, height)
+	if err != nil {
+		return AppHashDump{}, err
+	}
+
+	dump := AppHashDump{
+		Height:  height,
+		AppHash: cInfo.Hash(),
+		Stores:  make([]StoreHash, 0, len(cInfo.StoreInfos)),
+	}
+	for _, info := range cInfo.StoreInfos {
+		dump.Stores = append(dump.Stores, StoreHash{Name: info.Name, CommitHash: info.CommitId.Hash})
+	}
+	sort.Slice(dump.Stores, func(i, j int) bool { return dump.Stores[i].Name < dump.Stores[j].Name })
+
+	if !withIAVL {
+		return dump, nil
+	}
+
+	ms, keys, err := loadCommittedStores(db, height)
+	if err != nil {
+		return AppHashDump{}, err
+	}
+
+	iavlHashes := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		store, ok := ms.GetCommitKVStore(key).(*iavlstore.Store)
+		if !ok {
+			return AppHashDump{}, fmt.Errorf("store %s is not an IAVL store", key.Name())
+		}
+		if iavlHashes[key.Name()], err = iavlRootHash(store, height); err != nil {
+			return AppHashDump{}, fmt.Errorf("failed to recompute the IAVL hash of store %s: %w", key.Name(), err)
+		}
+	}
+	for i := range dump.Stores {
+		dump.Stores[i].IAVLHash = iavlHashes[dump.Stores[i].Name]
+	}
+
+	return dump, nil
+}
+
+// iavlRootHash recomputes the root hash of the IAVL tree of a store at the
+// given version from its nodes, exported in post-order, in the same way as the
+// IAVL tree hashes its nodes. The hash of an empty tree is nil.
+func iavlRootHash(store *iavlstore.Store, version int64) ([]byte, error) {
+	exporter, err := store.Export(version)
+	if err != nil {
+		return nil, err
+	}
+	defer exporter.Close()
+
+	type subtree struct {
+		hash []byte
+		size int64
+	}
+
+	var stack []subtree
+	for {
+		node, err := exporter.Next()
+		if errors.Is(err, iavl.ExportDone) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var buf bytes.Buffer
+		if node.Height == 0 {
+			valueHash := sha256.Sum256(node.Value)
+			writeIAVLVarint(&buf, 0)
+			writeIAVLVarint(&buf, 1)
+			writeIAVLVarint(&buf, node.Version)
+			writeIAVLBytes(&buf, node.Key)
+			writeIAVLBytes(&buf, valueHash[:])
+			stack = append(stack, subtree{hash: sha256Sum(buf.Bytes()), size: 1})
+			continue
+		}
+
+		if len(stack) < 2 {
+			return nil, fmt.Errorf("inner node of height %d has no children", node.Height)
+		}
+		left, right := stack[len(stack)-2], stack[len(stack)-1]
+		stack = stack[:len(stack)-2]
+
+		size := left.size + right.size
+		writeIAVLVarint(&buf, int64(node.Height))
+		writeIAVLVarint(&buf, size)
+		writeIAVLVarint(&buf, node.Version)
+		writeIAVLBytes(&buf, left.hash)
+		writeIAVLBytes(&buf, right.hash)
+		stack = append(stack, subtree{hash: sha256Sum(buf.Bytes()), size: size})
+	}
+
+	switch len(stack) {
+	case 0:
+		return nil, nil
+	case 1:
+		return stack[0].hash, nil
+	default:
+		return nil, fmt.Errorf("the tree has %d roots", len(stack))
+	}
+}
+
+func writeIAVLVarint(buf *bytes.Buffer, i int64) {
+	var bz [binary.MaxVarintLen64]byte
+	buf.Write(bz[:binary.PutVarint(bz[:], i)])
+}
+
+func writeIAVLBytes(buf *bytes.Buffer, bz []byte) {
+	var size [binary.MaxVarintLen64]byte
+	buf.Write(size[:binary.PutUvarint(size[:], uint64(len(bz)))])
+	buf.Write(bz)
+}
+
+func sha256Sum(bz []byte) []byte {
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+// CompareAppHashDumps returns the stores whose committed or IAVL hashes differ
+// between the given dumps, including the stores missing from one of them,
+// sorted by name. The IAVL hashes are only compared if both dumps have them.
+func CompareAppHashDumps(a, b AppHashDump) []StoreHashDiff {
+	storesA := make(map[string]StoreHash, len(a.Stores))
+	for _, s := range a.Stores {
+		storesA[s.Name] = s
+	}
+	storesB := make(map[string]StoreHash, len(b.Stores))
+	for _, s := range b.Stores {
+		storesB[s.Name] = s
+	}
+
+	names := make([]string, 0, len(storesA)+len(storesB))
+	for name := range storesA {
+		names = append(names, name)
+	}
+	for name := range storesB {
+		if _, ok := storesA[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var diffs []StoreHashDiff
+	for _, name := range names {
+		sa, okA := storesA[name]
+		sb, okB := storesB[name]
+
+		differ := !okA || !okB || !bytes.Equal(sa.CommitHash, sb.CommitHash)
+		if len(sa.IAVLHash) > 0 && len(sb.IAVLHash) > 0 && !bytes.Equal(sa.IAVLHash, sb.IAVLHash) {
+			differ = true
+		}
+		if differ {
+			diffs = append(diffs, StoreHashDiff{Name: name, A: sa, B: sb})
+		}
+	}
+
+	return diffs
+}
+
+func readAppHashDump(path string) (AppHashDump, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return AppHashDump{}, err
+	}
+
+	var dump AppHashDump
+	if err := json.Unmarshal(bz, &dump); err != nil {
+		return AppHashDump{}, fmt.Errorf("failed to parse the app hash dump %s: %w", path, err)
+	}
+
+	return dump, nil
+}
@@ -0,0 +1,83 @@
+package server_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func commitAppHashStores(t *testing.T, bankValue string) dbm.DB {
+	db := dbm.NewMemDB()
+	ms := rootmulti.NewStore(db)
+	bankKey := storetypes.NewKVStoreKey("bank")
+	authKey := storetypes.NewKVStoreKey("acc")
+	ms.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(authKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ms.GetKVStore(bankKey).Set([]byte{0x01}, []byte(bankValue))
+	ms.GetKVStore(authKey).Set([]byte{0x01}, []byte("account"))
+	ms.Commit()
+
+	// the nodes of the trees are of several versions and heights
+	for i := byte(2); i < 10; i++ {
+		ms.GetKVStore(authKey).Set([]byte{i}, []byte{i})
+		ms.Commit()
+	}
+
+	return db
+}
+
+func TestAppHashDumps(t *testing.T) {
+	dbA := commitAppHashStores(t, "balance")
+	dbB := commitAppHashStores(t, "diverged")
+
+	dumpA, err := server.GetAppHashDump(dbA, 0, true)
+	require.NoError(t, err)
+	require.Equal(t, int64(9), dumpA.Height)
+	require.Len(t, dumpA.Stores, 2)
+	require.Equal(t, "acc", dumpA.Stores[0].Name)
+	require.Equal(t, "bank", dumpA.Stores[1].Name)
+	for _, s := range dumpA.Stores {
+		require.NotEmpty(t, s.CommitHash)
+		require.Equal(t, s.CommitHash, s.IAVLHash)
+	}
+
+	// the trees are recomputed at the dumped height
+	dumpOld, err := server.GetAppHashDump(dbA, 5, true)
+	require.NoError(t, err)
+	for _, s := range dumpOld.Stores {
+		require.Equal(t, s.CommitHash, s.IAVLHash)
+	}
+	require.NotEqual(t, dumpA.Stores[0].IAVLHash, dumpOld.Stores[0].IAVLHash)
+
+	cInfo, err := rootmulti.GetCommitInfo(dbA, 9)
+	require.NoError(t, err)
+	require.Equal(t, cInfo.Hash(), []byte(dumpA.AppHash))
+
+	dumpB, err := server.GetAppHashDump(dbB, 9, false)
+	require.NoError(t, err)
+	require.Empty(t, dumpB.Stores[0].IAVLHash)
+	require.NotEqual(t, dumpA.AppHash, dumpB.AppHash)
+
+	require.Empty(t, server.CompareAppHashDumps(dumpA, dumpA))
+
+	diffs := server.CompareAppHashDumps(dumpA, dumpB)
+	require.Len(t, diffs, 1)
+	require.Equal(t, "bank", diffs[0].Name)
+
+	// a store missing from a dump differs
+	dumpB.Stores = dumpB.Stores[1:]
+	diffs = server.CompareAppHashDumps(dumpA, dumpB)
+	require.Len(t, diffs, 2)
+	require.Equal(t, "acc", diffs[0].Name)
+	require.Empty(t, diffs[0].B.CommitHash)
+
+	_, err = server.GetAppHashDump(dbA, 10, false)
+	require.Error(t, err)
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
@@ -16,7 +17,10 @@ import (
 // Execute executes the root command of an application. It handles creating a
 // server context object with the appropriate server and client objects injected
 // into the underlying stdlib Context. It also handles adding core CLI flags,
-// specifically the logging flags. It returns an error upon execution failure.
+// specifically the logging flags. It returns an error upon execution failure,
+// whose exit code is given by client.ExitCode. If the output format of the
+// failed command is JSON, the error is also written to its output as a
+// client.ErrorResponse.
 func Execute(rootCmd *cobra.Command, defaultHome string) error {
 	// Create and set a client.Context on the command's Context. During the pre-run
 	// of the root command, a default initialized client.Context is provided to
@@ -25,13 +29,47 @@ func Execute(rootCmd *cobra.Command, defaultHome string) error {
 	// getting and setting the client.Context. Ideally, we utilize
 	// https://github.com/spf13/cobra/pull/1118.
 	srvCtx := server.NewDefaultContext()
+	clientCtx := &client.Context{}
 	ctx := context.Background()
-	ctx = context.WithValue(ctx, client.ClientContextKey, &client.Context{})
+	ctx = context.WithValue(ctx, client.ClientContextKey, clientCtx)
 	ctx = context.WithValue(ctx, server.ServerContextKey, srvCtx)
 
-	rootCmd.PersistentFlags().String(flags.FlagLogLevel, zerolog.InfoLevel.String(), "The logging level (trace|debug|info|warn|error|fatal|panic)")
+	rootCmd.PersistentFlags().String(flags.FlagLogLevel, zerolog.InfoLevel.String(), "The logging level (trace|debug|info|warn|error|fatal|panic), or comma separated levels per module, e.g. x/bank:debug,baseapp:info,*:error")
 	rootCmd.PersistentFlags().String(flags.FlagLogFormat, tmcfg.LogFormatPlain, "The logging format (json|plain)")
 
 	executor := tmcli.PrepareBaseCmd(rootCmd, "", defaultHome)
-	return executor.ExecuteContext(ctx)
+	cmd, err := executor.ExecuteContextC(ctx)
+	if err != nil {
+		if printErr := printErrorResponse(cmd, *clientCtx, err); printErr != nil {
+			cmd.PrintErrln("Error:", printErr.Error())
+		}
+	}
+
+	return err
+}
+
+// printErrorResponse writes the error of the failed command to its output as a
+// client.ErrorResponse if its output format is JSON. The output format is
+// resolved as in client.ReadPersistentCommandFlags, from the output flag of
+// the command or else from the client context, e.g. the client config.
+func printErrorResponse(cmd *cobra.Command, clientCtx client.Context, err error) error {
+	outputFlag := cmd.Flags().Lookup(tmcli.OutputFlag)
+	if outputFlag == nil {
+		return nil
+	}
+
+	if clientCtx.OutputFormat == "" || outputFlag.Changed {
+		clientCtx = clientCtx.WithOutputFormat(outputFlag.Value.String())
+	}
+
+	if clientCtx.OutputFormat != "json" && clientCtx.OutputFormat != flags.OutputFormatCanonicalJSON {
+		return nil
+	}
+
+	out, jsonErr := json.Marshal(client.NewErrorResponse(err))
+	if jsonErr != nil {
+		return jsonErr
+	}
+
+	return clientCtx.WithOutput(cmd.OutOrStdout()).PrintRaw(out)
 }
@@ -3,7 +3,9 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/spf13/cast"
 	"github.com/spf13/viper"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -20,6 +22,10 @@ const (
 
 	// DefaultGRPCWebAddress defines the default address to bind the gRPC-web server to.
 	DefaultGRPCWebAddress = "0.0.0.0:9091"
+
+	// DefaultShutdownTimeout defines the default duration for which the
+	// in-flight requests are drained when the node is stopped.
+	DefaultShutdownTimeout = 30 * time.Second
 )
 
 // BaseConfig defines the server's basic configuration
@@ -69,6 +75,52 @@ type BaseConfig struct {
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
+
+	// GasTraceRetention defines the number of delivered txs of which the gas
+	// consumed per message and per store is retained in memory, and served by
+	// the gas trace query service. If zero, gas tracing is disabled.
+	GasTraceRetention uint64 `mapstructure:"gas-trace-retention"`
+
+	// GasBreakdown appends the gas consumed per message and per store access
+	// category to the events of the tx results.
+	GasBreakdown bool `mapstructure:"gas-breakdown"`
+
+	// GasPriceBlocks defines the number of blocks of which the gas prices paid
+	// by the txs are tracked to suggest gas prices. If zero, the gas prices
+	// aren't tracked.
+	GasPriceBlocks uint64 `mapstructure:"gas-price-blocks"`
+
+	// RawStoreQueries enables the raw store query service, serving the raw
+	// key/value pairs of the stores for debugging.
+	RawStoreQueries bool `mapstructure:"raw-store-queries"`
+
+	// ModuleGenesisQueries enables the module genesis query service, exporting
+	// the state of a single module at a height.
+	ModuleGenesisQueries bool `mapstructure:"module-genesis-queries"`
+
+	// ShutdownTimeout defines the maximum duration for which the in-flight
+	// gRPC and API requests are drained when the node is stopped, before they
+	// are aborted.
+	ShutdownTimeout time.Duration `mapstructure:"shutdown-timeout"`
+
+	// LogLevels defines comma separated log levels per module, in the form
+	// {module}:{level}, overridden by the levels set by the log_level flag.
+	LogLevels string `mapstructure:"log-levels"`
+
+	// LogSamplingPeriod and LogSamplingBurst rate limit the logs of CheckTx,
+	// including the ones of the tx handler middlewares, to at most
+	// LogSamplingBurst identical messages per LogSamplingPeriod. The logs
+	// aren't rate limited if either is zero.
+	LogSamplingPeriod time.Duration `mapstructure:"log-sampling-period"`
+	LogSamplingBurst  uint          `mapstructure:"log-sampling-burst"`
+}
+
+// EventIndexRules defines the allow and deny lists of the events of a module,
+// i.e. of the events whose module attribute is the module name, which are
+// indexed by Tendermint, in the form {eventType} or {eventType}.{attributeKey}.
+type EventIndexRules struct {
+	Allow []string `mapstructure:"allow"`
+	Deny  []string `mapstructure:"deny"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -97,6 +149,11 @@ type APIConfig struct {
 	// RPCMaxBodyBytes defines the Tendermint maximum response body (in bytes)
 	RPCMaxBodyBytes uint `mapstructure:"rpc-max-body-bytes"`
 
+	// ReadyMaxBlockAge defines the maximum age (in seconds) of the latest block
+	// for the node to be reported ready by the /readyz endpoint. 0 disables the
+	// check.
+	ReadyMaxBlockAge uint `mapstructure:"ready-max-block-age"`
+
 	// TODO: TLS/Proxy configuration.
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/issues/6420
@@ -132,6 +189,18 @@ type GRPCConfig struct {
 
 	// Address defines the API server to listen on
 	Address string `mapstructure:"address"`
+
+	// TLSCertFile is the path of the PEM encoded certificate served by the
+	// gRPC and gRPC-web servers. TLS is disabled if empty.
+	TLSCertFile string `mapstructure:"tls-cert-file"`
+
+	// TLSKeyFile is the path of the PEM encoded private key of the certificate.
+	TLSKeyFile string `mapstructure:"tls-key-file"`
+
+	// ClientCAFile is the path of the PEM encoded certificates of the
+	// authorities of the client certificates. If set, the clients must
+	// authenticate with a certificate signed by one of them (mTLS).
+	ClientCAFile string `mapstructure:"client-ca-file"`
 }
 
 // GRPCWebConfig defines configuration for the gRPC-web server.
@@ -157,17 +226,71 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// UpgradeConfig defines the actions taken by the node before a scheduled
+// upgrade is applied.
+type UpgradeConfig struct {
+	// SnapshotBeforeUpgrade takes a state sync snapshot of the last height
+	// before a scheduled upgrade.
+	SnapshotBeforeUpgrade bool `mapstructure:"snapshot-before-upgrade"`
+
+	// ExportBeforeUpgrade exports the application state of the last height
+	// before a scheduled upgrade to the data directory.
+	ExportBeforeUpgrade bool `mapstructure:"export-before-upgrade"`
+}
+
+// InvariantsConfig defines the invariant checks run by a live node without
+// halting it.
+type InvariantsConfig struct {
+	// AsyncCheckPeriod sets the block interval at which all registered
+	// invariants are checked asynchronously against the last committed state.
+	// 0 disables the checks.
+	AsyncCheckPeriod uint64 `mapstructure:"async-check-period"`
+}
+
+// StoreEncryptionConfig defines the stores encrypted at rest by the node.
+type StoreEncryptionConfig struct {
+	// KeyFile is the path of the file holding the hex encoded 32 bytes
+	// encryption key, relative to the node home if not absolute.
+	KeyFile string `mapstructure:"key-file"`
+
+	// Stores are the names of the stores encrypted at rest.
+	Stores []string `mapstructure:"stores"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
 
 	// Telemetry defines the application telemetry configuration
-	Telemetry telemetry.Config `mapstructure:"telemetry"`
-	API       APIConfig        `mapstructure:"api"`
-	GRPC      GRPCConfig       `mapstructure:"grpc"`
-	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
-	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
-	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Telemetry  telemetry.Config `mapstructure:"telemetry"`
+	API        APIConfig        `mapstructure:"api"`
+	GRPC       GRPCConfig       `mapstructure:"grpc"`
+	Rosetta    RosettaConfig    `mapstructure:"rosetta"`
+	GRPCWeb    GRPCWebConfig    `mapstructure:"grpc-web"`
+	StateSync  StateSyncConfig  `mapstructure:"state-sync"`
+	Upgrade    UpgradeConfig    `mapstructure:"upgrade"`
+	Invariants InvariantsConfig `mapstructure:"invariants"`
+
+	// StoreEncryption defines the stores encrypted at rest
+	StoreEncryption StoreEncryptionConfig `mapstructure:"store-encryption"`
+
+	// EventIndexing defines the events indexed by Tendermint per module, in
+	// addition to the IndexEvents of the base configuration.
+	EventIndexing map[string]EventIndexRules `mapstructure:"event-indexing"`
+}
+
+// EventIndexFilter returns the filter of the events indexed by Tendermint,
+// allowing IndexEvents for all the events and applying the allow and deny lists
+// of each module to the events of that module only.
+func (c Config) EventIndexFilter() sdk.EventIndexFilter {
+	filter := sdk.NewEventIndexFilter(c.IndexEvents, nil)
+	filter.Modules = make(map[string]sdk.EventIndexFilter, len(c.EventIndexing))
+
+	for module, rules := range c.EventIndexing {
+		filter.Modules[module] = sdk.NewEventIndexFilter(rules.Allow, rules.Deny)
+	}
+
+	return filter
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -209,6 +332,9 @@ func DefaultConfig() *Config {
 			PruningInterval:   "0",
 			MinRetainBlocks:   0,
 			IndexEvents:       make([]string, 0),
+			ShutdownTimeout:   DefaultShutdownTimeout,
+			LogSamplingPeriod: time.Second,
+			LogSamplingBurst:  10,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -221,6 +347,7 @@ func DefaultConfig() *Config {
 			MaxOpenConnections: 1000,
 			RPCReadTimeout:     10,
 			RPCMaxBodyBytes:    1000000,
+			ReadyMaxBlockAge:   60,
 		},
 		GRPC: GRPCConfig{
 			Enable:  true,
@@ -242,6 +369,14 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		Upgrade: UpgradeConfig{
+			SnapshotBeforeUpgrade: false,
+			ExportBeforeUpgrade:   false,
+		},
+		EventIndexing: make(map[string]EventIndexRules),
+		Invariants: InvariantsConfig{
+			AsyncCheckPeriod: 0,
+		},
 	}
 }
 
@@ -258,16 +393,25 @@ func GetConfig(v *viper.Viper) Config {
 
 	return Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      v.GetString("minimum-gas-prices"),
-			InterBlockCache:   v.GetBool("inter-block-cache"),
-			Pruning:           v.GetString("pruning"),
-			PruningKeepRecent: v.GetString("pruning-keep-recent"),
-			PruningKeepEvery:  v.GetString("pruning-keep-every"),
-			PruningInterval:   v.GetString("pruning-interval"),
-			HaltHeight:        v.GetUint64("halt-height"),
-			HaltTime:          v.GetUint64("halt-time"),
-			IndexEvents:       v.GetStringSlice("index-events"),
-			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
+			MinGasPrices:         v.GetString("minimum-gas-prices"),
+			InterBlockCache:      v.GetBool("inter-block-cache"),
+			Pruning:              v.GetString("pruning"),
+			PruningKeepRecent:    v.GetString("pruning-keep-recent"),
+			PruningKeepEvery:     v.GetString("pruning-keep-every"),
+			PruningInterval:      v.GetString("pruning-interval"),
+			HaltHeight:           v.GetUint64("halt-height"),
+			HaltTime:             v.GetUint64("halt-time"),
+			IndexEvents:          v.GetStringSlice("index-events"),
+			MinRetainBlocks:      v.GetUint64("min-retain-blocks"),
+			GasTraceRetention:    v.GetUint64("gas-trace-retention"),
+			GasBreakdown:         v.GetBool("gas-breakdown"),
+			GasPriceBlocks:       v.GetUint64("gas-price-blocks"),
+			RawStoreQueries:      v.GetBool("raw-store-queries"),
+			ModuleGenesisQueries: v.GetBool("module-genesis-queries"),
+			ShutdownTimeout:      v.GetDuration("shutdown-timeout"),
+			LogLevels:            v.GetString("log-levels"),
+			LogSamplingPeriod:    v.GetDuration("log-sampling-period"),
+			LogSamplingBurst:     v.GetUint("log-sampling-burst"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -275,6 +419,7 @@ func GetConfig(v *viper.Viper) Config {
 			EnableHostname:          v.GetBool("telemetry.enable-hostname"),
 			EnableHostnameLabel:     v.GetBool("telemetry.enable-hostname-label"),
 			EnableServiceLabel:      v.GetBool("telemetry.enable-service-label"),
+			EnableStoreMetrics:      v.GetBool("telemetry.enable-store-metrics"),
 			PrometheusRetentionTime: v.GetInt64("telemetry.prometheus-retention-time"),
 			GlobalLabels:            globalLabels,
 		},
@@ -287,6 +432,7 @@ func GetConfig(v *viper.Viper) Config {
 			RPCWriteTimeout:    v.GetUint("api.rpc-write-timeout"),
 			RPCMaxBodyBytes:    v.GetUint("api.rpc-max-body-bytes"),
 			EnableUnsafeCORS:   v.GetBool("api.enabled-unsafe-cors"),
+			ReadyMaxBlockAge:   v.GetUint("api.ready-max-block-age"),
 		},
 		Rosetta: RosettaConfig{
 			Enable:     v.GetBool("rosetta.enable"),
@@ -297,8 +443,11 @@ func GetConfig(v *viper.Viper) Config {
 			Offline:    v.GetBool("rosetta.offline"),
 		},
 		GRPC: GRPCConfig{
-			Enable:  v.GetBool("grpc.enable"),
-			Address: v.GetString("grpc.address"),
+			Enable:       v.GetBool("grpc.enable"),
+			Address:      v.GetString("grpc.address"),
+			TLSCertFile:  v.GetString("grpc.tls-cert-file"),
+			TLSKeyFile:   v.GetString("grpc.tls-key-file"),
+			ClientCAFile: v.GetString("grpc.client-ca-file"),
 		},
 		GRPCWeb: GRPCWebConfig{
 			Enable:           v.GetBool("grpc-web.enable"),
@@ -309,9 +458,36 @@ func GetConfig(v *viper.Viper) Config {
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 		},
+		Upgrade: UpgradeConfig{
+			SnapshotBeforeUpgrade: v.GetBool("upgrade.snapshot-before-upgrade"),
+			ExportBeforeUpgrade:   v.GetBool("upgrade.export-before-upgrade"),
+		},
+		Invariants: InvariantsConfig{
+			AsyncCheckPeriod: v.GetUint64("invariants.async-check-period"),
+		},
+		StoreEncryption: StoreEncryptionConfig{
+			KeyFile: v.GetString("store-encryption.key-file"),
+			Stores:  v.GetStringSlice("store-encryption.stores"),
+		},
+		EventIndexing: GetEventIndexing(v.Get("event-indexing")),
 	}
 }
 
+// GetEventIndexing returns the per module event indexing rules from the raw
+// value of the event-indexing app configuration.
+func GetEventIndexing(raw interface{}) map[string]EventIndexRules {
+	eventIndexing := make(map[string]EventIndexRules)
+	for module, rawRules := range cast.ToStringMap(raw) {
+		rules := cast.ToStringMap(rawRules)
+		eventIndexing[module] = EventIndexRules{
+			Allow: cast.ToStringSlice(rules["allow"]),
+			Deny:  cast.ToStringSlice(rules["deny"]),
+		}
+	}
+
+	return eventIndexing
+}
+
 // ValidateBasic returns an error if min-gas-prices field is empty in BaseConfig. Otherwise, it returns nil.
 func (c Config) ValidateBasic() error {
 	if c.BaseConfig.MinGasPrices == "" {
@@ -58,6 +58,39 @@ func TestIndexEventsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestEventIndexingWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.IndexEvents = []string{"message.sender"}
+	conf.EventIndexing = map[string]EventIndexRules{
+		"bank":    {Allow: []string{"transfer"}, Deny: []string{"coin_spent", "coin_received"}},
+		"staking": {Deny: []string{"delegate.amount"}},
+	}
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err, "parsing config")
+	require.Equal(t, []string{"transfer"}, cfg.EventIndexing["bank"].Allow)
+	require.Equal(t, []string{"coin_spent", "coin_received"}, cfg.EventIndexing["bank"].Deny)
+	require.Equal(t, conf.EventIndexing, GetConfig(vpr).EventIndexing)
+
+	// the rules of a module only apply to the events of that module
+	filter := cfg.EventIndexFilter()
+	require.True(t, filter.IsModuleEventIndexed("bank", "message", "sender"))
+	require.True(t, filter.IsModuleEventIndexed("bank", "transfer", "amount"))
+	require.False(t, filter.IsModuleEventIndexed("bank", "message", "action"))
+	require.False(t, filter.IsModuleEventIndexed("bank", "coin_spent", "amount"))
+	require.True(t, filter.IsModuleEventIndexed("staking", "message", "sender"))
+	require.False(t, filter.IsModuleEventIndexed("staking", "transfer", "amount"))
+	require.False(t, filter.IsModuleEventIndexed("staking", "delegate", "amount"))
+	require.True(t, filter.IsIndexed("message", "sender"))
+	require.False(t, filter.IsIndexed("transfer", "amount"))
+}
+
 func TestGlobalLabelsEventsMarshalling(t *testing.T) {
 	expectedIn := `global-labels = [
   ["labelname1", "labelvalue1"],
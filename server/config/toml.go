@@ -24,9 +24,12 @@ minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: all saved states will be deleted, storing only the current state; pruning at 10 block intervals
 # custom: allow pruning options to be manually specified through 'pruning-keep-recent', 'pruning-keep-every', and 'pruning-interval'
+# snapshots: all saved states will be deleted except the last 'pruning-keep-recent' states and the states of the
+# 'state-sync.snapshot-interval' heights; pruning at 'pruning-interval' (10 by default) block intervals
 pruning = "{{ .BaseConfig.Pruning }}"
 
-# These are applied if and only if the pruning strategy is custom.
+# These are applied if and only if the pruning strategy is custom, except
+# pruning-keep-recent and pruning-interval which also apply to the snapshots one.
 pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-keep-every = "{{ .BaseConfig.PruningKeepEvery }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
@@ -70,6 +73,78 @@ inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 # ["message.sender", "message.recipient"]
 index-events = [{{ range .BaseConfig.IndexEvents }}{{ printf "%q, " . }}{{end}}]
 
+# GasTraceRetention defines the number of delivered txs of which the gas
+# consumed per message and per store is retained in memory, and served by the
+# cosmos.base.gastrace.v1beta1.Query service for debugging. Gas tracing is
+# disabled if zero, which is recommended for validators.
+gas-trace-retention = {{ .BaseConfig.GasTraceRetention }}
+
+# GasBreakdown appends a gas_breakdown event per message of the delivered and
+# simulated txs to their results, with the gas consumed by the message and per
+# store access category. The events aren't part of the consensus state, so it
+# can be enabled on some nodes only.
+gas-breakdown = {{ .BaseConfig.GasBreakdown }}
+
+# GasPriceBlocks defines the number of blocks of which the gas prices paid by
+# the txs are tracked in memory, to suggest gas prices to wallets through the
+# cosmos.base.gasprice.v1beta1.Query service. The suggestions are never below
+# the minimum gas prices of the node. Gas price tracking is disabled if zero.
+gas-price-blocks = {{ .BaseConfig.GasPriceBlocks }}
+
+# RawStoreQueries enables the cosmos.base.rawstore.v1beta1.Query service,
+# serving the raw key/value pairs of the stores for debugging. It exposes the
+# whole state of the node and should only be enabled on nodes with a private
+# gRPC endpoint.
+raw-store-queries = {{ .BaseConfig.RawStoreQueries }}
+
+# ModuleGenesisQueries enables the cosmos.base.modulegenesis.v1beta1.Query
+# service, exporting the genesis state of a single module at a height, e.g. to
+# fork the state of a module into a devnet. Exporting a module may be expensive,
+# so it should only be enabled on nodes with a private gRPC endpoint.
+module-genesis-queries = {{ .BaseConfig.ModuleGenesisQueries }}
+
+# ShutdownTimeout defines the maximum duration for which the in-flight gRPC and
+# API requests are drained when the node receives SIGINT or SIGTERM, before
+# they are aborted and the stores are closed.
+shutdown-timeout = "{{ .BaseConfig.ShutdownTimeout }}"
+
+# LogLevels defines comma separated log levels per module, in the form
+# {module}:{level}, where the module is the "module" field of the logs, e.g.
+# "x/bank:debug,baseapp:info". The default level of the other modules is set by
+# the log_level flag or option of config.toml, which may also set levels per
+# module overriding the ones set here.
+log-levels = "{{ .BaseConfig.LogLevels }}"
+
+# LogSamplingPeriod and LogSamplingBurst rate limit the logs of CheckTx, e.g.
+# the rejections of the txs of the mempool, to at most log-sampling-burst
+# identical messages per log-sampling-period, so that spam cannot flood the
+# logs. The number of dropped messages is reported by the next logged one. The
+# logs aren't rate limited if either is zero.
+log-sampling-period = "{{ .BaseConfig.LogSamplingPeriod }}"
+log-sampling-burst = {{ .BaseConfig.LogSamplingBurst }}
+
+###############################################################################
+###                      Event Indexing Configuration                       ###
+###############################################################################
+
+# The event-indexing tables define, per module, the events which are allowed
+# and denied to be indexed by Tendermint, in the form {eventType} or
+# {eventType}.{attributeKey}. The rules of a module only apply to the events
+# whose "module" attribute is the module name, e.g. the message events of its
+# Msgs. The events allowed for a module are indexed in addition to
+# index-events: if either list is non-empty, only the allowed events of the
+# module are indexed. The denied events of a module are never indexed.
+#
+# Example:
+# [event-indexing.bank]
+# allow = ["message"]
+# deny = ["message.sender"]
+{{ range $module, $rules := .EventIndexing }}
+[event-indexing.{{ $module }}]
+allow = [{{ range $rules.Allow }}{{ printf "%q, " . }}{{end}}]
+deny = [{{ range $rules.Deny }}{{ printf "%q, " . }}{{end}}]
+{{ end }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -93,6 +168,10 @@ enable-hostname-label = {{ .Telemetry.EnableHostnameLabel }}
 # Enable adding service to labels.
 enable-service-label = {{ .Telemetry.EnableServiceLabel }}
 
+# EnableStoreMetrics enables counters and latency measures of the KVStore
+# operations, labeled by module. It adds overhead to every store access.
+enable-store-metrics = {{ .Telemetry.EnableStoreMetrics }}
+
 # PrometheusRetentionTime, when positive, enables a Prometheus metrics sink.
 prometheus-retention-time = {{ .Telemetry.PrometheusRetentionTime }}
 
@@ -135,6 +214,10 @@ rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
 enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
 
+# ReadyMaxBlockAge defines the maximum age (in seconds) of the latest block for
+# the node to be reported ready by the /readyz endpoint. 0 disables the check.
+ready-max-block-age = {{ .API.ReadyMaxBlockAge }}
+
 ###############################################################################
 ###                           Rosetta Configuration                         ###
 ###############################################################################
@@ -171,6 +254,19 @@ enable = {{ .GRPC.Enable }}
 # Address defines the gRPC server address to bind to.
 address = "{{ .GRPC.Address }}"
 
+# TLSCertFile is the path of the PEM encoded certificate served by the gRPC and
+# gRPC-web servers. TLS is disabled if empty.
+# NOTE: Rosetta in online mode connects to the gRPC server without TLS.
+tls-cert-file = "{{ .GRPC.TLSCertFile }}"
+
+# TLSKeyFile is the path of the PEM encoded private key of the certificate.
+tls-key-file = "{{ .GRPC.TLSKeyFile }}"
+
+# ClientCAFile is the path of the PEM encoded certificates of the authorities
+# of the client certificates. If set, the clients must authenticate with a
+# certificate signed by one of them (mTLS). Requires TLS to be enabled.
+client-ca-file = "{{ .GRPC.ClientCAFile }}"
+
 ###############################################################################
 ###                        gRPC Web Configuration                           ###
 ###############################################################################
@@ -201,6 +297,52 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                         Upgrade Configuration                           ###
+###############################################################################
+
+# Actions taken by the node before a scheduled upgrade is applied, allowing it to be
+# restored to the last height before the upgrade if the upgrade fails.
+[upgrade]
+
+# snapshot-before-upgrade takes a local state sync snapshot of the last height before
+# a scheduled upgrade.
+snapshot-before-upgrade = {{ .Upgrade.SnapshotBeforeUpgrade }}
+
+# export-before-upgrade exports the application state of the last height before a
+# scheduled upgrade to data/upgrade-export-<height>.json, when the node halts for it.
+export-before-upgrade = {{ .Upgrade.ExportBeforeUpgrade }}
+
+###############################################################################
+###                        Invariants Configuration                         ###
+###############################################################################
+
+[invariants]
+
+# async-check-period specifies the block interval at which all registered invariants
+# are checked in the background against the last committed state (0 to disable).
+# Unlike inv-check-period, a broken invariant does not halt the node: it is logged
+# as an error and counted by the crisis_invariant_broken telemetry metric.
+async-check-period = {{ .Invariants.AsyncCheckPeriod }}
+
+###############################################################################
+###                     Store Encryption Configuration                      ###
+###############################################################################
+
+# The values of the listed stores are encrypted at rest in application.db with a
+# node-local key. The encryption does not change the state commitments, so each
+# node can decide whether to encrypt its stores. A store must be encrypted since
+# its creation, e.g. when the node is initialized or restored from a state sync
+# snapshot, as its unencrypted values cannot be read once encrypted.
+[store-encryption]
+
+# key-file is the path of the file holding the hex encoded 32 bytes AES-256 key,
+# relative to the node home if not absolute.
+key-file = "{{ .StoreEncryption.KeyFile }}"
+
+# stores are the names of the stores encrypted at rest, e.g. ["authz", "feegrant"].
+stores = [{{ range .StoreEncryption.Stores }}{{ printf "%q, " . }}{{end}}]
 `
 
 var configTemplate *template.Template
@@ -1,10 +1,13 @@
 package server
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/oplog"
 )
 
 func Test_openDB(t *testing.T) {
@@ -17,12 +20,22 @@ func Test_openTraceWriter(t *testing.T) {
 	t.Parallel()
 
 	fname := filepath.Join(t.TempDir(), "logfile")
-	w, err := openTraceWriter(fname)
+	w, err := openTraceWriter(fname, TraceStoreFormatOpLog)
+	require.NoError(t, err)
+	require.IsType(t, &oplog.Writer{}, w)
+	require.NoError(t, closeTraceWriter(w))
+
+	w, err = openTraceWriter(fname, TraceStoreFormatJSON)
 	require.NoError(t, err)
-	require.NotNil(t, w)
+	require.IsType(t, &os.File{}, w)
+	require.NoError(t, closeTraceWriter(w))
+
+	_, err = openTraceWriter(fname, "xml")
+	require.Error(t, err)
 
 	// test no-op
-	w, err = openTraceWriter("")
+	w, err = openTraceWriter("", TraceStoreFormatOpLog)
 	require.NoError(t, err)
 	require.Nil(t, w)
+	require.NoError(t, closeTraceWriter(w))
 }
@@ -58,10 +58,12 @@ func ExportCmd(appExporter types.AppExporter, defaultNodeHome string) *cobra.Com
 			}
 
 			traceWriterFile, _ := cmd.Flags().GetString(flagTraceStore)
-			traceWriter, err := openTraceWriter(traceWriterFile)
+			traceStoreFormat, _ := cmd.Flags().GetString(flagTraceStoreFormat)
+			traceWriter, err := openTraceWriter(traceWriterFile, traceStoreFormat)
 			if err != nil {
 				return err
 			}
+			defer closeTraceWriter(traceWriter)
 
 			height, _ := cmd.Flags().GetInt64(FlagHeight)
 			forZeroHeight, _ := cmd.Flags().GetBool(FlagForZeroHeight)
@@ -0,0 +1,965 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/gasprice/v1beta1/query.proto
+
+package gasprice
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QuerySuggestGasPricesRequest is the request type for the
+// Query/SuggestGasPrices RPC method.
+type QuerySuggestGasPricesRequest struct {
+	// percentiles are the percentiles of the gas prices to return, between 1 and
+	// 100. Defaults to 25, 50 and 75 if empty.
+	Percentiles []uint32 `protobuf:"varint,1,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`
+}
+
+func (m *QuerySuggestGasPricesRequest) Reset()         { *m = QuerySuggestGasPricesRequest{} }
+func (m *QuerySuggestGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySuggestGasPricesRequest) ProtoMessage()    {}
+func (*QuerySuggestGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68398c13e5e5bb54, []int{0}
+}
+func (m *QuerySuggestGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuggestGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuggestGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySuggestGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuggestGasPricesRequest.Merge(m, src)
+}
+func (m *QuerySuggestGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuggestGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuggestGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySuggestGasPricesRequest proto.InternalMessageInfo
+
+func (m *QuerySuggestGasPricesRequest) GetPercentiles() []uint32 {
+	if m != nil {
+		return m.Percentiles
+	}
+	return nil
+}
+
+// QuerySuggestGasPricesResponse is the response type for the
+// Query/SuggestGasPrices RPC method.
+type QuerySuggestGasPricesResponse struct {
+	// gas_prices are the suggested gas prices, one per requested percentile, in
+	// the requested order.
+	GasPrices []SuggestedGasPrices `protobuf:"bytes,1,rep,name=gas_prices,json=gasPrices,proto3" json:"gas_prices"`
+	// blocks is the number of blocks the gas prices are sampled from.
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// txs is the number of txs the gas prices are sampled from.
+	Txs uint64 `protobuf:"varint,3,opt,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *QuerySuggestGasPricesResponse) Reset()         { *m = QuerySuggestGasPricesResponse{} }
+func (m *QuerySuggestGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySuggestGasPricesResponse) ProtoMessage()    {}
+func (*QuerySuggestGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68398c13e5e5bb54, []int{1}
+}
+func (m *QuerySuggestGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuggestGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuggestGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySuggestGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuggestGasPricesResponse.Merge(m, src)
+}
+func (m *QuerySuggestGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuggestGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuggestGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySuggestGasPricesResponse proto.InternalMessageInfo
+
+func (m *QuerySuggestGasPricesResponse) GetGasPrices() []SuggestedGasPrices {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
+func (m *QuerySuggestGasPricesResponse) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *QuerySuggestGasPricesResponse) GetTxs() uint64 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+// SuggestedGasPrices are the gas prices of a percentile, one per fee denom.
+// The gas prices are never below the minimum gas prices of the node.
+type SuggestedGasPrices struct {
+	Percentile uint32                                      `protobuf:"varint,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	Prices     github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=prices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"prices"`
+}
+
+func (m *SuggestedGasPrices) Reset()         { *m = SuggestedGasPrices{} }
+func (m *SuggestedGasPrices) String() string { return proto.CompactTextString(m) }
+func (*SuggestedGasPrices) ProtoMessage()    {}
+func (*SuggestedGasPrices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68398c13e5e5bb54, []int{2}
+}
+func (m *SuggestedGasPrices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuggestedGasPrices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuggestedGasPrices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuggestedGasPrices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuggestedGasPrices.Merge(m, src)
+}
+func (m *SuggestedGasPrices) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuggestedGasPrices) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuggestedGasPrices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuggestedGasPrices proto.InternalMessageInfo
+
+func (m *SuggestedGasPrices) GetPercentile() uint32 {
+	if m != nil {
+		return m.Percentile
+	}
+	return 0
+}
+
+func (m *SuggestedGasPrices) GetPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QuerySuggestGasPricesRequest)(nil), "cosmos.base.gasprice.v1beta1.QuerySuggestGasPricesRequest")
+	proto.RegisterType((*QuerySuggestGasPricesResponse)(nil), "cosmos.base.gasprice.v1beta1.QuerySuggestGasPricesResponse")
+	proto.RegisterType((*SuggestedGasPrices)(nil), "cosmos.base.gasprice.v1beta1.SuggestedGasPrices")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/gasprice/v1beta1/query.proto", fileDescriptor_68398c13e5e5bb54)
+}
+
+var fileDescriptor_68398c13e5e5bb54 = []byte{
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xcf, 0x6b, 0xd4, 0x40,
+	0x14, 0xce, 0xec, 0xd6, 0x05, 0xa7, 0x14, 0xca, 0x20, 0x12, 0x96, 0x38, 0x0d, 0x01, 0x31, 0x20,
+	0xcd, 0xb8, 0xed, 0x4d, 0x2f, 0xb2, 0x0a, 0x1e, 0xbc, 0x68, 0xc4, 0x8b, 0x17, 0x99, 0x4c, 0x1f,
+	0xe3, 0xd0, 0x6d, 0x26, 0xcd, 0x9b, 0x14, 0x7b, 0xf5, 0x2f, 0x10, 0xfc, 0x03, 0xf4, 0xec, 0x9f,
+	0xe0, 0xd9, 0x43, 0x8f, 0x05, 0x2f, 0x9e, 0x54, 0x76, 0xfd, 0x43, 0x24, 0xbf, 0xea, 0xaa, 0x98,
+	0x43, 0x4f, 0x99, 0xbc, 0xf9, 0xde, 0xf7, 0x7d, 0xef, 0x9b, 0x47, 0x63, 0x65, 0xf1, 0xc8, 0xa2,
+	0xc8, 0x24, 0x82, 0xd0, 0x12, 0x8b, 0xd2, 0x28, 0x10, 0x27, 0xb3, 0x0c, 0x9c, 0x9c, 0x89, 0xe3,
+	0x0a, 0xca, 0xd3, 0xa4, 0x28, 0xad, 0xb3, 0x2c, 0x68, 0x91, 0x49, 0x8d, 0x4c, 0x7a, 0x64, 0xd2,
+	0x21, 0xa7, 0xd7, 0xb4, 0xd5, 0xb6, 0x01, 0x8a, 0xfa, 0xd4, 0xf6, 0x4c, 0x03, 0x6d, 0xad, 0x5e,
+	0x80, 0x90, 0x85, 0x11, 0x32, 0xcf, 0xad, 0x93, 0xce, 0xd8, 0x1c, 0xbb, 0x5b, 0xbe, 0xae, 0xdd,
+	0x4b, 0x2a, 0x6b, 0xf2, 0xf6, 0x3e, 0xba, 0x4f, 0x83, 0xa7, 0xb5, 0x81, 0x67, 0x95, 0xd6, 0x80,
+	0xee, 0x91, 0xc4, 0x27, 0xb5, 0x26, 0xa6, 0x70, 0x5c, 0x01, 0x3a, 0x16, 0xd2, 0xcd, 0x02, 0x4a,
+	0x05, 0xb9, 0x33, 0x0b, 0x40, 0x9f, 0x84, 0xe3, 0x78, 0x2b, 0x5d, 0x2f, 0x45, 0x1f, 0x08, 0xbd,
+	0xf1, 0x1f, 0x0a, 0x2c, 0x6c, 0x8e, 0xc0, 0x9e, 0x53, 0xaa, 0x25, 0xbe, 0x6c, 0x86, 0x69, 0x29,
+	0x36, 0xf7, 0xee, 0x24, 0x43, 0xa3, 0x26, 0x1d, 0x17, 0x1c, 0x5c, 0xb0, 0xcd, 0x37, 0xce, 0xbe,
+	0xed, 0x78, 0xe9, 0x55, 0xdd, 0x17, 0xd8, 0x75, 0x3a, 0xc9, 0x16, 0x56, 0x1d, 0xa2, 0x3f, 0x0a,
+	0x49, 0xbc, 0x91, 0x76, 0x7f, 0x6c, 0x9b, 0x8e, 0xdd, 0x6b, 0xf4, 0xc7, 0x4d, 0xb1, 0x3e, 0x46,
+	0xef, 0x09, 0x65, 0xff, 0x32, 0x32, 0x4e, 0xe9, 0xef, 0x41, 0x7c, 0x12, 0x92, 0x78, 0x2b, 0x5d,
+	0xab, 0x30, 0x43, 0x27, 0x9d, 0xe7, 0x51, 0xe3, 0x39, 0xf8, 0xc3, 0x73, 0x6f, 0xf5, 0x21, 0xa8,
+	0x07, 0xd6, 0xe4, 0xf3, 0xfd, 0xda, 0xdf, 0xc7, 0xef, 0x3b, 0xb7, 0xb5, 0x71, 0xaf, 0xaa, 0x2c,
+	0x51, 0xf6, 0x48, 0x74, 0xe1, 0xb7, 0x9f, 0x5d, 0x3c, 0x38, 0x14, 0xee, 0xb4, 0x00, 0xec, 0x7b,
+	0x30, 0xed, 0x04, 0xf6, 0x3e, 0x13, 0x7a, 0xa5, 0x09, 0x91, 0x7d, 0x22, 0x74, 0xfb, 0xef, 0x24,
+	0xd9, 0xdd, 0xe1, 0xb4, 0x86, 0x5e, 0x70, 0x7a, 0xef, 0x52, 0xbd, 0xed, 0xd3, 0x45, 0xbb, 0x6f,
+	0xbe, 0xfc, 0x7c, 0x37, 0xba, 0xc5, 0x6e, 0x8a, 0xc1, 0x1d, 0xc6, 0xb6, 0x7f, 0xfe, 0xf8, 0x6c,
+	0xc9, 0xc9, 0xf9, 0x92, 0x93, 0x1f, 0x4b, 0x4e, 0xde, 0xae, 0xb8, 0x77, 0xbe, 0xe2, 0xde, 0xd7,
+	0x15, 0xf7, 0x5e, 0xcc, 0x06, 0x53, 0x41, 0x28, 0x4f, 0xa0, 0x14, 0xba, 0x2c, 0xd4, 0x05, 0x79,
+	0x36, 0x69, 0x36, 0x74, 0xff, 0xd7, 0x00, 0x8c, 0xce, 0x08, 0xb4, 0x3f, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// SuggestGasPrices returns percentiles of the gas prices paid by the txs of
+	// the last blocks, per fee denom. The suggestions are only served by nodes
+	// which track gas prices.
+	SuggestGasPrices(ctx context.Context, in *QuerySuggestGasPricesRequest, opts ...grpc.CallOption) (*QuerySuggestGasPricesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) SuggestGasPrices(ctx context.Context, in *QuerySuggestGasPricesRequest, opts ...grpc.CallOption) (*QuerySuggestGasPricesResponse, error) {
+	out := new(QuerySuggestGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.gasprice.v1beta1.Query/SuggestGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// SuggestGasPrices returns percentiles of the gas prices paid by the txs of
+	// the last blocks, per fee denom. The suggestions are only served by nodes
+	// which track gas prices.
+	SuggestGasPrices(context.Context, *QuerySuggestGasPricesRequest) (*QuerySuggestGasPricesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) SuggestGasPrices(ctx context.Context, req *QuerySuggestGasPricesRequest) (*QuerySuggestGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestGasPrices not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_SuggestGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySuggestGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SuggestGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.gasprice.v1beta1.Query/SuggestGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuggestGasPrices(ctx, req.(*QuerySuggestGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.gasprice.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SuggestGasPrices",
+			Handler:    _Query_SuggestGasPrices_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/gasprice/v1beta1/query.proto",
+}
+
+func (m *QuerySuggestGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuggestGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuggestGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Percentiles) > 0 {
+		dAtA2 := make([]byte, len(m.Percentiles)*10)
+		var j1 int
+		for _, num := range m.Percentiles {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintQuery(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySuggestGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuggestGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuggestGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Txs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Txs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuggestedGasPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuggestedGasPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuggestedGasPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Percentile != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Percentile))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySuggestGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Percentiles) > 0 {
+		l = 0
+		for _, e := range m.Percentiles {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QuerySuggestGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	if m.Txs != 0 {
+		n += 1 + sovQuery(uint64(m.Txs))
+	}
+	return n
+}
+
+func (m *SuggestedGasPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Percentile != 0 {
+		n += 1 + sovQuery(uint64(m.Percentile))
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySuggestGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySuggestGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySuggestGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Percentiles = append(m.Percentiles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Percentiles) == 0 {
+					m.Percentiles = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Percentiles = append(m.Percentiles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentiles", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySuggestGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySuggestGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySuggestGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, SuggestedGasPrices{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			m.Txs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Txs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuggestedGasPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuggestedGasPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuggestedGasPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentile", wireType)
+			}
+			m.Percentile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percentile |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, types.DecCoin{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,175 @@
+package gasprice
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultPercentiles are the percentiles of the gas prices suggested if none
+// are requested.
+var DefaultPercentiles = []uint32{25, 50, 75}
+
+// Tracker tracks the gas prices paid by the txs of the last blocks executed by
+// the node, to suggest gas prices to clients. Only the blocks with txs are
+// tracked, so the suggestions of an idle chain are based on the last blocks
+// with txs.
+type Tracker struct {
+	mu           sync.RWMutex
+	blocks       int
+	minGasPrices sdk.DecCoins
+	// samples are the gas prices paid by the txs of the tracked blocks, from
+	// oldest to newest
+	samples []blockGasPrices
+}
+
+type blockGasPrices struct {
+	height int64
+	prices []sdk.DecCoins
+}
+
+// NewTracker returns a Tracker tracking the gas prices of the txs of the given
+// number of blocks. The suggested gas prices are never below the given minimum
+// gas prices, which should be the ones of the node, so that the txs paying the
+// suggested gas prices are accepted by its mempool.
+func NewTracker(blocks int, minGasPrices sdk.DecCoins) *Tracker {
+	if blocks <= 0 {
+		panic("the number of blocks of the gas price tracker must be positive")
+	}
+
+	return &Tracker{
+		blocks:       blocks,
+		minGasPrices: minGasPrices,
+	}
+}
+
+// Record records the gas prices paid by a tx executed at the given height.
+func (t *Tracker) Record(height int64, gasPrices sdk.DecCoins) {
+	if gasPrices.IsZero() {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	n := len(t.samples)
+	switch {
+	case n > 0 && t.samples[n-1].height == height:
+		t.samples[n-1].prices = append(t.samples[n-1].prices, gasPrices)
+		return
+	case n > 0 && t.samples[n-1].height > height:
+		// the node executes the chain again from a lower height, e.g. after a
+		// rollback
+		t.samples = nil
+	}
+
+	t.samples = append(t.samples, blockGasPrices{height: height, prices: []sdk.DecCoins{gasPrices}})
+
+	// the blocks below the tracked ones are pruned
+	i := 0
+	for i < len(t.samples) && t.samples[i].height <= height-int64(t.blocks) {
+		i++
+	}
+	t.samples = t.samples[i:]
+}
+
+// Suggest returns the given percentiles of the gas prices of the tracked txs,
+// per fee denom, along with the number of blocks and txs sampled. The denoms of
+// the minimum gas prices are always suggested.
+func (t *Tracker) Suggest(percentiles []uint32) (suggestions []SuggestedGasPrices, blocks, txs uint64) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	byDenom := make(map[string][]sdk.Dec)
+	for _, block := range t.samples {
+		for _, prices := range block.prices {
+			for _, price := range prices {
+				byDenom[price.Denom] = append(byDenom[price.Denom], price.Amount)
+			}
+		}
+		txs += uint64(len(block.prices))
+	}
+	for _, price := range t.minGasPrices {
+		if _, ok := byDenom[price.Denom]; !ok {
+			byDenom[price.Denom] = nil
+		}
+	}
+
+	denoms := make([]string, 0, len(byDenom))
+	for denom, prices := range byDenom {
+		sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	suggestions = make([]SuggestedGasPrices, len(percentiles))
+	for i, p := range percentiles {
+		suggestions[i].Percentile = p
+		for _, denom := range denoms {
+			price := t.minGasPrices.AmountOf(denom)
+			if prices := byDenom[denom]; len(prices) > 0 {
+				price = sdk.MaxDec(price, percentile(prices, p))
+			}
+			suggestions[i].Prices = append(suggestions[i].Prices, sdk.NewDecCoinFromDec(denom, price))
+		}
+	}
+
+	return suggestions, uint64(len(t.samples)), txs
+}
+
+// percentile returns the given percentile of the given sorted prices, with the
+// nearest-rank method.
+func percentile(sorted []sdk.Dec, p uint32) sdk.Dec {
+	rank := (int(p)*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
+
+type queryServer struct {
+	tracker *Tracker
+}
+
+var _ QueryServer = queryServer{}
+
+// SuggestGasPrices implements the Query/SuggestGasPrices gRPC method.
+func (q queryServer) SuggestGasPrices(_ context.Context, req *QuerySuggestGasPricesRequest) (*QuerySuggestGasPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	percentiles := req.Percentiles
+	if len(percentiles) == 0 {
+		percentiles = DefaultPercentiles
+	}
+	for _, p := range percentiles {
+		if p < 1 || p > 100 {
+			return nil, status.Errorf(codes.InvalidArgument, "percentile %d must be between 1 and 100", p)
+		}
+	}
+
+	suggestions, blocks, txs := q.tracker.Suggest(percentiles)
+
+	return &QuerySuggestGasPricesResponse{GasPrices: suggestions, Blocks: blocks, Txs: txs}, nil
+}
+
+// RegisterGasPriceService registers the gas price query service serving the
+// suggestions of the given tracker.
+func RegisterGasPriceService(qrt gogogrpc.Server, tracker *Tracker) {
+	RegisterQueryServer(qrt, queryServer{tracker: tracker})
+}
+
+// RegisterGRPCGatewayRoutes mounts the gas price service's gRPC-gateway routes
+// on the given mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+}
@@ -0,0 +1,67 @@
+package gasprice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTrackerSuggest(t *testing.T) {
+	tracker := NewTracker(2, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(15, 2))))
+
+	suggestions, blocks, txs := tracker.Suggest(DefaultPercentiles)
+	require.Zero(t, blocks)
+	require.Zero(t, txs)
+	require.Len(t, suggestions, 3)
+	for _, s := range suggestions {
+		require.Equal(t, "0.150000000000000000stake", s.Prices.String())
+	}
+
+	for i := int64(1); i <= 4; i++ {
+		tracker.Record(1, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(i, 1))))
+	}
+	tracker.Record(2, sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDec(1))))
+	// zero gas prices aren't tracked
+	tracker.Record(2, sdk.NewDecCoins())
+
+	suggestions, blocks, txs = tracker.Suggest([]uint32{25, 50, 100})
+	require.Equal(t, uint64(2), blocks)
+	require.Equal(t, uint64(5), txs)
+	// the 25th percentile of stake is floored by the minimum gas price
+	require.Equal(t, "1.000000000000000000atom,0.150000000000000000stake", suggestions[0].Prices.String())
+	require.Equal(t, "1.000000000000000000atom,0.200000000000000000stake", suggestions[1].Prices.String())
+	require.Equal(t, "1.000000000000000000atom,0.400000000000000000stake", suggestions[2].Prices.String())
+
+	// the first block is pruned
+	tracker.Record(3, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDec(2))))
+	suggestions, blocks, txs = tracker.Suggest([]uint32{50})
+	require.Equal(t, uint64(2), blocks)
+	require.Equal(t, uint64(2), txs)
+	require.Equal(t, "1.000000000000000000atom,2.000000000000000000stake", suggestions[0].Prices.String())
+
+	// executing a lower height resets the tracker
+	tracker.Record(1, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDec(3))))
+	_, blocks, txs = tracker.Suggest([]uint32{50})
+	require.Equal(t, uint64(1), blocks)
+	require.Equal(t, uint64(1), txs)
+}
+
+func TestQuerySuggestGasPrices(t *testing.T) {
+	q := queryServer{tracker: NewTracker(1, nil)}
+	q.tracker.Record(1, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDec(1))))
+
+	res, err := q.SuggestGasPrices(context.Background(), &QuerySuggestGasPricesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.GasPrices, len(DefaultPercentiles))
+	require.Equal(t, uint64(1), res.Txs)
+
+	_, err = q.SuggestGasPrices(context.Background(), &QuerySuggestGasPricesRequest{Percentiles: []uint32{0}})
+	require.Error(t, err)
+	_, err = q.SuggestGasPrices(context.Background(), &QuerySuggestGasPricesRequest{Percentiles: []uint32{101}})
+	require.Error(t, err)
+	_, err = q.SuggestGasPrices(context.Background(), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,62 @@
+package gastrace
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type queryServer struct {
+	store *sdk.GasTraceStore
+}
+
+var _ QueryServer = queryServer{}
+
+// TxGasTrace implements the Query/TxGasTrace gRPC method.
+func (q queryServer) TxGasTrace(_ context.Context, req *QueryTxGasTraceRequest) (*QueryTxGasTraceResponse, error) {
+	if req == nil || req.Hash == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty tx hash")
+	}
+
+	trace := q.store.Get(req.Hash)
+	if trace == nil {
+		return nil, status.Errorf(codes.NotFound, "no gas trace retained for tx %s", req.Hash)
+	}
+
+	return &QueryTxGasTraceResponse{GasTrace: newGasTrace(trace)}, nil
+}
+
+// newGasTrace returns the GasTrace of the given trace.
+func newGasTrace(trace *sdk.TxGasTrace) *GasTrace {
+	res := &GasTrace{
+		Hash:      trace.Hash,
+		Height:    trace.Height,
+		GasWanted: trace.GasWanted,
+		GasUsed:   trace.GasUsed,
+	}
+	for _, m := range trace.Msgs {
+		res.Messages = append(res.Messages, &MsgGasUsage{TypeUrl: m.TypeURL, GasUsed: m.GasUsed})
+	}
+	for _, st := range trace.Stores {
+		res.Stores = append(res.Stores, &StoreGasUsage{Store: st.Store, GasUsed: st.GasUsed})
+	}
+
+	return res
+}
+
+// RegisterGasTraceService registers the gas trace query service serving the
+// traces of the given store.
+func RegisterGasTraceService(qrt gogogrpc.Server, store *sdk.GasTraceStore) {
+	RegisterQueryServer(qrt, queryServer{store: store})
+}
+
+// RegisterGRPCGatewayRoutes mounts the gas trace service's gRPC-gateway routes
+// on the given mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+}
@@ -12,8 +12,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/types"
 )
 
-// StartGRPCWeb starts a gRPC-Web server on the given address.
+// StartGRPCWeb starts a gRPC-Web server on the given address, serving TLS
+// with the configuration of the gRPC server if configured.
 func StartGRPCWeb(grpcSrv *grpc.Server, config config.Config) (*http.Server, error) {
+	tlsConfig, err := NewTLSConfig(config.GRPC)
+	if err != nil {
+		return nil, err
+	}
+
 	var options []grpcweb.Option
 	if config.GRPCWeb.EnableUnsafeCORS {
 		options = append(options,
@@ -25,13 +31,21 @@ func StartGRPCWeb(grpcSrv *grpc.Server, config config.Config) (*http.Server, err
 
 	wrappedServer := grpcweb.WrapServer(grpcSrv, options...)
 	grpcWebSrv := &http.Server{
-		Addr:    config.GRPCWeb.Address,
-		Handler: wrappedServer,
+		Addr:      config.GRPCWeb.Address,
+		Handler:   wrappedServer,
+		TLSConfig: tlsConfig,
 	}
 
 	errCh := make(chan error)
 	go func() {
-		if err := grpcWebSrv.ListenAndServe(); err != nil {
+		var err error
+		if tlsConfig != nil {
+			// the certificate is already loaded in the TLS config
+			err = grpcWebSrv.ListenAndServeTLS("", "")
+		} else {
+			err = grpcWebSrv.ListenAndServe()
+		}
+		if err != nil {
 			errCh <- fmt.Errorf("[grpc] failed to serve: %w", err)
 		}
 	}()
@@ -0,0 +1,630 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/modulegenesis/v1beta1/query.proto
+
+package modulegenesis
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryModuleGenesisRequest is the request type for the Query/ModuleGenesis
+// RPC method.
+type QueryModuleGenesisRequest struct {
+	// module_name is the name of the module to export.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+}
+
+func (m *QueryModuleGenesisRequest) Reset()         { *m = QueryModuleGenesisRequest{} }
+func (m *QueryModuleGenesisRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleGenesisRequest) ProtoMessage()    {}
+func (*QueryModuleGenesisRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9a15abfa86ddd57, []int{0}
+}
+func (m *QueryModuleGenesisRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleGenesisRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleGenesisRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleGenesisRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleGenesisRequest.Merge(m, src)
+}
+func (m *QueryModuleGenesisRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleGenesisRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleGenesisRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleGenesisRequest proto.InternalMessageInfo
+
+func (m *QueryModuleGenesisRequest) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// QueryModuleGenesisResponse is the response type for the Query/ModuleGenesis
+// RPC method.
+type QueryModuleGenesisResponse struct {
+	// height is the height at which the module was exported.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// genesis is the JSON-encoded genesis state of the module.
+	Genesis []byte `protobuf:"bytes,2,opt,name=genesis,proto3" json:"genesis,omitempty"`
+}
+
+func (m *QueryModuleGenesisResponse) Reset()         { *m = QueryModuleGenesisResponse{} }
+func (m *QueryModuleGenesisResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleGenesisResponse) ProtoMessage()    {}
+func (*QueryModuleGenesisResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9a15abfa86ddd57, []int{1}
+}
+func (m *QueryModuleGenesisResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleGenesisResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleGenesisResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleGenesisResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleGenesisResponse.Merge(m, src)
+}
+func (m *QueryModuleGenesisResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleGenesisResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleGenesisResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleGenesisResponse proto.InternalMessageInfo
+
+func (m *QueryModuleGenesisResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryModuleGenesisResponse) GetGenesis() []byte {
+	if m != nil {
+		return m.Genesis
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryModuleGenesisRequest)(nil), "cosmos.base.modulegenesis.v1beta1.QueryModuleGenesisRequest")
+	proto.RegisterType((*QueryModuleGenesisResponse)(nil), "cosmos.base.modulegenesis.v1beta1.QueryModuleGenesisResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/modulegenesis/v1beta1/query.proto", fileDescriptor_e9a15abfa86ddd57)
+}
+
+var fileDescriptor_e9a15abfa86ddd57 = []byte{
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xc1, 0x4a, 0x33, 0x31,
+	0x10, 0xc7, 0x9b, 0x7e, 0x7c, 0x15, 0xa3, 0x5e, 0x72, 0x90, 0x5a, 0x24, 0xd6, 0x9e, 0x7a, 0x69,
+	0x42, 0x15, 0x44, 0xa4, 0x82, 0x78, 0xf1, 0x64, 0xd1, 0x3d, 0x7a, 0x91, 0x6c, 0x3b, 0xa4, 0x8b,
+	0xdd, 0x64, 0xbb, 0x93, 0x2d, 0x88, 0x78, 0xf1, 0x09, 0x04, 0x5f, 0xca, 0x93, 0x14, 0xbc, 0xf4,
+	0x28, 0xad, 0x0f, 0x22, 0xdd, 0xb4, 0x60, 0x41, 0x29, 0x78, 0x0a, 0x33, 0xfc, 0x7f, 0xbf, 0x64,
+	0x32, 0xb4, 0xd1, 0xb1, 0x18, 0x5b, 0x94, 0xa1, 0x42, 0x90, 0xb1, 0xed, 0x66, 0x7d, 0xd0, 0x60,
+	0x00, 0x23, 0x94, 0xc3, 0x66, 0x08, 0x4e, 0x35, 0xe5, 0x20, 0x83, 0xf4, 0x5e, 0x24, 0xa9, 0x75,
+	0x96, 0xed, 0xfb, 0xb8, 0x98, 0xc5, 0xc5, 0x52, 0x5c, 0xcc, 0xe3, 0x95, 0x5d, 0x6d, 0xad, 0xee,
+	0x83, 0x54, 0x49, 0x24, 0x95, 0x31, 0xd6, 0x29, 0x17, 0x59, 0x83, 0x5e, 0x50, 0x6b, 0xd1, 0x9d,
+	0xeb, 0x99, 0xef, 0x32, 0x67, 0x2f, 0x3c, 0x1b, 0xc0, 0x20, 0x03, 0x74, 0x6c, 0x8f, 0x6e, 0x78,
+	0xe7, 0xad, 0x51, 0x31, 0x94, 0x49, 0x95, 0xd4, 0xd7, 0x03, 0xea, 0x5b, 0x6d, 0x15, 0x43, 0xad,
+	0x4d, 0x2b, 0x3f, 0xd1, 0x98, 0x58, 0x83, 0xc0, 0xb6, 0x69, 0xa9, 0x07, 0x91, 0xee, 0xb9, 0x9c,
+	0xfc, 0x17, 0xcc, 0x2b, 0x56, 0xa6, 0x6b, 0xf3, 0x47, 0x96, 0x8b, 0x55, 0x52, 0xdf, 0x0c, 0x16,
+	0xe5, 0xc1, 0x98, 0xd0, 0xff, 0xb9, 0x90, 0xbd, 0x11, 0xba, 0xb5, 0x64, 0x65, 0x2d, 0xb1, 0x72,
+	0x56, 0xf1, 0xeb, 0x28, 0x95, 0xd3, 0x3f, 0xd2, 0x7e, 0x94, 0xda, 0xd9, 0xd3, 0xfb, 0xe7, 0x4b,
+	0xf1, 0x84, 0x1d, 0xcb, 0xd5, 0xfb, 0xf1, 0x5d, 0x94, 0x0f, 0xdf, 0xfe, 0xee, 0xf1, 0xfc, 0xea,
+	0x75, 0xc2, 0xc9, 0x68, 0xc2, 0xc9, 0xc7, 0x84, 0x93, 0xe7, 0x29, 0x2f, 0x8c, 0xa6, 0xbc, 0x30,
+	0x9e, 0xf2, 0xc2, 0xcd, 0x91, 0x8e, 0x5c, 0x2f, 0x0b, 0x45, 0xc7, 0xc6, 0x0b, 0xbb, 0x3f, 0x1a,
+	0xd8, 0xbd, 0x93, 0x08, 0xe9, 0x10, 0x52, 0xa9, 0xd3, 0xa4, 0xb3, 0x7c, 0x5f, 0x58, 0xca, 0x37,
+	0x78, 0xf8, 0x35, 0x00, 0x4e, 0x1a, 0x30, 0x4a, 0x33, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ModuleGenesis returns the genesis state of a module exported at the height
+	// of the query.
+	ModuleGenesis(ctx context.Context, in *QueryModuleGenesisRequest, opts ...grpc.CallOption) (*QueryModuleGenesisResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ModuleGenesis(ctx context.Context, in *QueryModuleGenesisRequest, opts ...grpc.CallOption) (*QueryModuleGenesisResponse, error) {
+	out := new(QueryModuleGenesisResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.modulegenesis.v1beta1.Query/ModuleGenesis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ModuleGenesis returns the genesis state of a module exported at the height
+	// of the query.
+	ModuleGenesis(context.Context, *QueryModuleGenesisRequest) (*QueryModuleGenesisResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ModuleGenesis(ctx context.Context, req *QueryModuleGenesisRequest) (*QueryModuleGenesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleGenesis not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ModuleGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleGenesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.modulegenesis.v1beta1.Query/ModuleGenesis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleGenesis(ctx, req.(*QueryModuleGenesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.modulegenesis.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ModuleGenesis",
+			Handler:    _Query_ModuleGenesis_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/modulegenesis/v1beta1/query.proto",
+}
+
+func (m *QueryModuleGenesisRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleGenesisRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleGenesisRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleGenesisResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleGenesisResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleGenesisResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Genesis) > 0 {
+		i -= len(m.Genesis)
+		copy(dAtA[i:], m.Genesis)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Genesis)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryModuleGenesisRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleGenesisResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Genesis)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryModuleGenesisRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleGenesisRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleGenesisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleGenesisResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleGenesisResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleGenesisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Genesis = append(m.Genesis[:0], dAtA[iNdEx:postIndex]...)
+			if m.Genesis == nil {
+				m.Genesis = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,62 @@
+package modulegenesis
+
+import (
+	"context"
+	"encoding/json"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Exporter exports the genesis state of a module, e.g. the
+// ExportModuleGenesis method of the module manager.
+type Exporter func(ctx sdk.Context, moduleName string) (json.RawMessage, error)
+
+type queryServer struct {
+	export Exporter
+}
+
+var _ QueryServer = queryServer{}
+
+// ModuleGenesis implements the Query/ModuleGenesis gRPC method.
+func (q queryServer) ModuleGenesis(c context.Context, req *QueryModuleGenesisRequest) (res *QueryModuleGenesisResponse, err error) {
+	if req == nil || req.ModuleName == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty module name")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// a module may panic on a state it can't export
+	defer func() {
+		if r := recover(); r != nil {
+			res, err = nil, status.Errorf(codes.Internal, "failed to export %s: %v", req.ModuleName, r)
+		}
+	}()
+
+	genesis, err := q.export(ctx, req.ModuleName)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &QueryModuleGenesisResponse{Height: ctx.BlockHeight(), Genesis: genesis}, nil
+}
+
+// RegisterModuleGenesisService registers the module genesis query service
+// exporting the modules with the given exporter.
+func RegisterModuleGenesisService(qrt gogogrpc.Server, export Exporter) {
+	if export == nil {
+		panic("nil module genesis exporter")
+	}
+
+	RegisterQueryServer(qrt, queryServer{export: export})
+}
+
+// RegisterGRPCGatewayRoutes mounts the module genesis service's gRPC-gateway
+// routes on the given mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+}
@@ -0,0 +1,44 @@
+package modulegenesis_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server/grpc/modulegenesis"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestModuleGenesis(t *testing.T) {
+	ctx := testutil.DefaultContext(sdk.NewKVStoreKey("test"), sdk.NewTransientStoreKey("transient_test")).
+		WithBlockHeader(tmproto.Header{Height: 10})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, codectypes.NewInterfaceRegistry())
+	modulegenesis.RegisterModuleGenesisService(queryHelper, func(_ sdk.Context, moduleName string) (json.RawMessage, error) {
+		switch moduleName {
+		case "module1":
+			return json.RawMessage(`{"key":"value"}`), nil
+		case "panic":
+			panic("can't export")
+		default:
+			return nil, fmt.Errorf("unknown module %s", moduleName)
+		}
+	})
+	queryClient := modulegenesis.NewQueryClient(queryHelper)
+
+	res, err := queryClient.ModuleGenesis(sdk.WrapSDKContext(ctx), &modulegenesis.QueryModuleGenesisRequest{ModuleName: "module1"})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), res.Height)
+	require.Equal(t, []byte(`{"key":"value"}`), res.Genesis)
+
+	for _, name := range []string{"", "unknown", "panic"} {
+		_, err := queryClient.ModuleGenesis(sdk.WrapSDKContext(ctx), &modulegenesis.QueryModuleGenesisRequest{ModuleName: name})
+		require.Error(t, err, name)
+	}
+}
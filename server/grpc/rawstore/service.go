@@ -0,0 +1,101 @@
+package rawstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultLimit is the number of pairs returned when no limit is requested.
+	DefaultLimit = 100
+	// MaxLimit is the maximum number of pairs returned by a query.
+	MaxLimit = 1000
+)
+
+type queryServer struct {
+	keys map[string]storetypes.StoreKey
+}
+
+var _ QueryServer = queryServer{}
+
+// RawStoreRange implements the Query/RawStoreRange gRPC method.
+func (q queryServer) RawStoreRange(c context.Context, req *QueryRawStoreRangeRequest) (*QueryRawStoreRangeResponse, error) {
+	if req == nil || req.StoreKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty store key")
+	}
+
+	key, ok := q.keys[req.StoreKey]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown store key %s", req.StoreKey)
+	}
+
+	prefix, err := hex.DecodeString(req.Prefix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid prefix: %s", err)
+	}
+
+	start, err := hex.DecodeString(req.StartKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start key: %s", err)
+	}
+	if len(start) == 0 {
+		start = prefix
+	} else if !bytes.HasPrefix(start, prefix) {
+		return nil, status.Error(codes.InvalidArgument, "start key doesn't have the prefix")
+	}
+	// cache stores reject empty start keys, a nil one ranges from the first key
+	if len(start) == 0 {
+		start = nil
+	}
+
+	limit := int(req.Limit)
+	switch {
+	case limit == 0:
+		limit = DefaultLimit
+	case limit > MaxLimit:
+		limit = MaxLimit
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.MultiStore().GetKVStore(key)
+
+	iterator := store.Iterator(start, storetypes.PrefixEndBytes(prefix))
+	defer iterator.Close()
+
+	res := &QueryRawStoreRangeResponse{}
+	for ; iterator.Valid(); iterator.Next() {
+		if len(res.Pairs) == limit {
+			res.NextKey = hex.EncodeToString(iterator.Key())
+			break
+		}
+
+		res.Pairs = append(res.Pairs, &RawKVPair{
+			Key:   hex.EncodeToString(iterator.Key()),
+			Value: hex.EncodeToString(iterator.Value()),
+		})
+	}
+
+	return res, nil
+}
+
+// RegisterRawStoreService registers the raw store query service serving the
+// stores of the given keys, by name. The service exposes the whole state of
+// the node and is meant for debugging only.
+func RegisterRawStoreService(qrt gogogrpc.Server, keys map[string]storetypes.StoreKey) {
+	RegisterQueryServer(qrt, queryServer{keys: keys})
+}
+
+// RegisterGRPCGatewayRoutes mounts the raw store service's gRPC-gateway routes
+// on the given mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+}
@@ -0,0 +1,70 @@
+package rawstore_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server/grpc/rawstore"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRawStoreRange(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+	// ABCI queries run on cached stores
+	ctx = ctx.WithMultiStore(ctx.MultiStore().CacheMultiStore())
+
+	store := ctx.KVStore(key)
+	store.Set([]byte{0x00, 0x01}, []byte("other"))
+	for i := byte(0); i < 5; i++ {
+		store.Set([]byte{0x01, i}, []byte{i, i})
+	}
+	store.Set([]byte{0x02}, []byte("other"))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, codectypes.NewInterfaceRegistry())
+	rawstore.RegisterRawStoreService(queryHelper, map[string]storetypes.StoreKey{"test": key})
+	queryClient := rawstore.NewQueryClient(queryHelper)
+
+	res, err := queryClient.RawStoreRange(sdk.WrapSDKContext(ctx), &rawstore.QueryRawStoreRangeRequest{
+		StoreKey: "test",
+		Prefix:   "01",
+		Limit:    3,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*rawstore.RawKVPair{
+		{Key: "0100", Value: "0000"},
+		{Key: "0101", Value: "0101"},
+		{Key: "0102", Value: "0202"},
+	}, res.Pairs)
+	require.Equal(t, "0103", res.NextKey)
+
+	res, err = queryClient.RawStoreRange(sdk.WrapSDKContext(ctx), &rawstore.QueryRawStoreRangeRequest{
+		StoreKey: "test",
+		Prefix:   "01",
+		StartKey: res.NextKey,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Pairs, 2)
+	require.Equal(t, "0104", res.Pairs[1].Key)
+	require.Empty(t, res.NextKey)
+
+	res, err = queryClient.RawStoreRange(sdk.WrapSDKContext(ctx), &rawstore.QueryRawStoreRangeRequest{StoreKey: "test"})
+	require.NoError(t, err)
+	require.Len(t, res.Pairs, 7)
+
+	for _, req := range []*rawstore.QueryRawStoreRangeRequest{
+		{},
+		{StoreKey: "unknown"},
+		{StoreKey: "test", Prefix: "zz"},
+		{StoreKey: "test", Prefix: "01", StartKey: "02"},
+	} {
+		_, err := queryClient.RawStoreRange(sdk.WrapSDKContext(ctx), req)
+		require.Error(t, err, fmt.Sprintf("%+v", req))
+	}
+}
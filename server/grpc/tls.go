@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+// NewTLSConfig returns the TLS configuration of the gRPC and gRPC-web servers
+// from the given gRPC config, or nil if TLS is disabled. The clients must
+// authenticate with a certificate signed by the configured client CAs, if any.
+func NewTLSConfig(cfg config.GRPCConfig) (*tls.Config, error) {
+	if cfg.TLSCertFile == "" && cfg.TLSKeyFile == "" {
+		if cfg.ClientCAFile != "" {
+			return nil, errors.New("grpc client CA file requires TLS to be enabled")
+		}
+
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load grpc TLS certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if cfg.ClientCAFile != "" {
+		bz, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read grpc client CA file: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bz) {
+			return nil, fmt.Errorf("no certificate found in grpc client CA file %s", cfg.ClientCAFile)
+		}
+
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
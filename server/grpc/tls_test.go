@@ -0,0 +1,141 @@
+package grpc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+)
+
+// newCert returns a certificate and its key signed by the given parent, or
+// self-signed if nil.
+func newCert(t *testing.T, parent *tls.Certificate, isCA bool) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	signer, signerKey := template, interface{}(key)
+	if parent != nil {
+		signer, signerKey = parent.Leaf, parent.PrivateKey
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+// writeCert writes the PEM encoded certificate and key into the given
+// directory, returning their paths.
+func writeCert(t *testing.T, dir, name string, cert tls.Certificate) (string, string) {
+	keyDER, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	require.NoError(t, err)
+
+	certFile, keyFile := filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return certFile, keyFile
+}
+
+// serveTLS serves an empty gRPC server with the given TLS configuration,
+// returning its address.
+func serveTLS(t *testing.T, tlsConfig *tls.Config) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	go srv.Serve(listener) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+
+	return listener.Addr().String()
+}
+
+// invoke calls an unknown method of the server, returning the status code of
+// the call, Unimplemented if the TLS handshake succeeded.
+func invoke(t *testing.T, address string, tlsConfig *tls.Config) codes.Code {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	err = conn.Invoke(ctx, "/test.Service/Method", &emptyMessage{}, &emptyMessage{})
+	return status.Code(err)
+}
+
+type emptyMessage struct{}
+
+func (*emptyMessage) Reset()         {}
+func (*emptyMessage) String() string { return "" }
+func (*emptyMessage) ProtoMessage()  {}
+
+func TestNewTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	ca := newCert(t, nil, true)
+	caFile, _ := writeCert(t, dir, "ca", ca)
+	certFile, keyFile := writeCert(t, dir, "server", newCert(t, &ca, false))
+	client := newCert(t, &ca, false)
+	other := newCert(t, nil, false)
+
+	tlsConfig, err := servergrpc.NewTLSConfig(config.GRPCConfig{})
+	require.NoError(t, err)
+	require.Nil(t, tlsConfig)
+
+	_, err = servergrpc.NewTLSConfig(config.GRPCConfig{ClientCAFile: caFile})
+	require.Error(t, err)
+	_, err = servergrpc.NewTLSConfig(config.GRPCConfig{TLSCertFile: certFile})
+	require.Error(t, err)
+	_, err = servergrpc.NewTLSConfig(config.GRPCConfig{TLSCertFile: certFile, TLSKeyFile: keyFile, ClientCAFile: keyFile})
+	require.Error(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.Leaf)
+
+	// TLS
+	tlsConfig, err = servergrpc.NewTLSConfig(config.GRPCConfig{TLSCertFile: certFile, TLSKeyFile: keyFile})
+	require.NoError(t, err)
+	address := serveTLS(t, tlsConfig)
+	require.Equal(t, codes.Unimplemented, invoke(t, address, &tls.Config{RootCAs: roots, ServerName: "localhost"}))
+
+	// mTLS
+	tlsConfig, err = servergrpc.NewTLSConfig(config.GRPCConfig{TLSCertFile: certFile, TLSKeyFile: keyFile, ClientCAFile: caFile})
+	require.NoError(t, err)
+	address = serveTLS(t, tlsConfig)
+	require.Equal(t, codes.Unimplemented, invoke(t, address, &tls.Config{RootCAs: roots, ServerName: "localhost", Certificates: []tls.Certificate{client}}))
+	require.Equal(t, codes.Unavailable, invoke(t, address, &tls.Config{RootCAs: roots, ServerName: "localhost"}))
+	require.Equal(t, codes.Unavailable, invoke(t, address, &tls.Config{RootCAs: roots, ServerName: "localhost", Certificates: []tls.Certificate{other}}))
+}
@@ -0,0 +1,301 @@
+package server
+
+// DONTCOVER
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/node"
+	pvm "github.com/tendermint/tendermint/privval"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+	dbm "github.com/tendermint/tm-db"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// KeyInPlaceFork is the app option holding the *InPlaceFork to apply by an app
+// started by the in-place-fork command.
+const KeyInPlaceFork = "in-place-fork"
+
+const flagForkVotingPeriod = "voting-period"
+
+// InPlaceFork defines how the in-place-fork command forks a live chain into a
+// single-node devnet. The command rewrites the Tendermint state and passes the
+// fork to the app in the KeyInPlaceFork app option. The app must apply it to
+// its state in the BeginBlock of the first block it executes, by:
+//
+// - creating a validator operated by OperatorAddress with the ValidatorPubKey
+// consensus key, bonding tokens worth ValidatorPower minted to the operator,
+// - jailing all the other validators, so that they are removed from the
+// validator set by the EndBlock of the block,
+// - setting the governance voting period to VotingPeriod.
+type InPlaceFork struct {
+	ChainID         string
+	OperatorAddress sdk.AccAddress
+	ValidatorPubKey cryptotypes.PubKey
+	ValidatorPower  int64
+	VotingPeriod    time.Duration
+}
+
+// InPlaceForkCmd returns a command forking the chain of the node in place into
+// a single-node devnet and starting the node, e.g. to rehearse upgrades with
+// mainnet state. It accepts all the flags of the start command.
+func InPlaceForkCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := StartCmd(appCreator, defaultNodeHome)
+	cmd.Use = "in-place-fork [new-chain-id] [operator-address]"
+	cmd.Short = "Fork the chain of the node in place into a single-node devnet and start the node"
+	cmd.Long = `Fork the chain of the node in place into a single-node devnet and start the node,
+e.g. to rehearse an upgrade with mainnet state. The node must be stopped after committing a
+block, and its home directory is modified in place, so it should be a copy.
+
+The chain is renamed to the given chain-id, and the consensus key of the node joins the
+validator set with over two thirds of the voting power, signing the last block again. In
+its first block, the app creates the validator of the key operated by the given account,
+with tokens minted to the account, jails all the other validators and shortens the
+governance voting period, so that a single node keeps producing blocks and can pass
+proposals. The node can then be restarted with the start command.
+
+Example:
+	simd testnet in-place-fork fork-1 cosmos1... --home ./mainnet-copy
+`
+	cmd.Args = cobra.ExactArgs(2)
+
+	start := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		serverCtx := GetServerContextFromCmd(cmd)
+
+		operator, err := sdk.AccAddressFromBech32(args[1])
+		if err != nil {
+			return err
+		}
+
+		votingPeriod, err := cmd.Flags().GetDuration(flagForkVotingPeriod)
+		if err != nil {
+			return err
+		}
+
+		fork, err := forkTendermintState(serverCtx.Config, args[0])
+		if err != nil {
+			return err
+		}
+		fork.OperatorAddress = operator
+		fork.VotingPeriod = votingPeriod
+
+		// the devnet must not dial the peers of the live chain, and must not wait
+		// to sync blocks from peers as the validators of the chain are still in
+		// the validator set of the first block
+		serverCtx.Config.P2P.Seeds = ""
+		serverCtx.Config.P2P.PersistentPeers = ""
+		serverCtx.Config.P2P.PexReactor = false
+		serverCtx.Config.FastSyncMode = false
+
+		serverCtx.Viper.Set(KeyInPlaceFork, fork)
+		serverCtx.Logger.Info("forked chain in place", "chain-id", fork.ChainID, "operator", operator)
+
+		return start(cmd, args)
+	}
+
+	cmd.Flags().Duration(flagForkVotingPeriod, time.Minute, "The governance voting period of the devnet")
+
+	return cmd
+}
+
+// forkTendermintState rewrites the Tendermint state of the node so that its
+// consensus key joins the validator set of the given chain with over two
+// thirds of the voting power, and signs the last block again.
+func forkTendermintState(cfg *tmcfg.Config, chainID string) (*InPlaceFork, error) {
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	defer stateDB.Close()
+
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	defer blockStoreDB.Close()
+
+	stateStore := sm.NewStore(stateDB)
+	state, err := stateStore.Load()
+	if err != nil {
+		return nil, err
+	}
+	if state.IsEmpty() {
+		return nil, fmt.Errorf("no chain state in %s", cfg.DBDir())
+	}
+	if chainID == state.ChainID {
+		return nil, errors.New("the chain-id of the fork must differ from the one of the chain")
+	}
+
+	height := state.LastBlockHeight
+	blockStore := store.NewBlockStore(blockStoreDB)
+	if blockStore.Height() != height {
+		return nil, fmt.Errorf("the block store is at height %d but the state is at height %d, start the node once to sync them", blockStore.Height(), height)
+	}
+
+	seenCommit := blockStore.LoadSeenCommit(height)
+	if seenCommit == nil {
+		return nil, fmt.Errorf("no commit of block %d", height)
+	}
+
+	pv := pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
+	pubKey, err := pv.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	if state.Validators.HasAddress(pubKey.Address()) {
+		return nil, errors.New("the consensus key of the node is a validator of the chain, fork a node with another key")
+	}
+
+	// the validators of the chain are kept, and removed from the set by the app
+	// in the first block, so that their stake is unbonded as usual
+	power := 3 * state.Validators.TotalVotingPower()
+	validators := tmtypes.NewValidatorSet(append(state.Validators.Copy().Validators, tmtypes.NewValidator(pubKey, power)))
+
+	// the sign state of the key is reset, as it signs for the new chain
+	pv.Reset()
+
+	commit, err := signCommit(pv, chainID, validators, seenCommit, state.LastBlockTime)
+	if err != nil {
+		return nil, err
+	}
+	if err := blockStore.SaveSeenCommit(height, commit); err != nil {
+		return nil, err
+	}
+
+	state.ChainID = chainID
+	state.LastValidators = validators
+	state.Validators = validators.Copy()
+	state.NextValidators = validators.CopyIncrementProposerPriority(1)
+	state.LastHeightValidatorsChanged = height + 1
+	if err := stateStore.Save(state); err != nil {
+		return nil, err
+	}
+
+	// the validators of the last and next blocks are loaded by height, e.g. to
+	// verify evidence
+	for _, h := range []int64{height, height + 1} {
+		if err := saveValidatorsInfo(stateDB, h, validators); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := forkGenesisDoc(cfg, stateDB, chainID); err != nil {
+		return nil, err
+	}
+
+	// the consensus messages of the next block of the chain are discarded
+	if err := os.RemoveAll(filepath.Dir(cfg.Consensus.WalFile())); err != nil {
+		return nil, err
+	}
+
+	sdkPubKey, err := cryptocodec.FromTmPubKeyInterface(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InPlaceFork{
+		ChainID:         chainID,
+		ValidatorPubKey: sdkPubKey,
+		ValidatorPower:  power,
+	}, nil
+}
+
+// signCommit returns a commit of the block of the given commit signed only by
+// the given key, which holds over two thirds of the voting power of the given
+// validators.
+func signCommit(
+	pv *pvm.FilePV, chainID string, validators *tmtypes.ValidatorSet, seenCommit *tmtypes.Commit, lastBlockTime time.Time,
+) (*tmtypes.Commit, error) {
+	pubKey, err := pv.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// the time of the next block is the time of this signature
+	timestamp := tmtime.Now()
+	if !timestamp.After(lastBlockTime) {
+		timestamp = lastBlockTime.Add(time.Millisecond)
+	}
+
+	idx, _ := validators.GetByAddress(pubKey.Address())
+	vote := &tmtypes.Vote{
+		Type:             tmproto.PrecommitType,
+		Height:           seenCommit.Height,
+		Round:            seenCommit.Round,
+		BlockID:          seenCommit.BlockID,
+		Timestamp:        timestamp,
+		ValidatorAddress: pubKey.Address(),
+		ValidatorIndex:   idx,
+	}
+	v := vote.ToProto()
+	if err := pv.SignVote(chainID, v); err != nil {
+		return nil, err
+	}
+
+	sigs := make([]tmtypes.CommitSig, validators.Size())
+	for i, val := range validators.Validators {
+		if !bytes.Equal(val.Address, pubKey.Address()) {
+			sigs[i] = tmtypes.NewCommitSigAbsent()
+			continue
+		}
+
+		sigs[i] = tmtypes.CommitSig{
+			BlockIDFlag:      tmtypes.BlockIDFlagCommit,
+			ValidatorAddress: val.Address,
+			Timestamp:        timestamp,
+			Signature:        v.Signature,
+		}
+	}
+
+	return tmtypes.NewCommit(seenCommit.Height, seenCommit.Round, seenCommit.BlockID, sigs), nil
+}
+
+// saveValidatorsInfo saves the full validator set of the given height in the
+// Tendermint state database, the same way as the Tendermint state store.
+func saveValidatorsInfo(stateDB dbm.DB, height int64, validators *tmtypes.ValidatorSet) error {
+	pv, err := validators.ToProto()
+	if err != nil {
+		return err
+	}
+
+	bz, err := (&tmstate.ValidatorsInfo{ValidatorSet: pv, LastHeightChanged: height}).Marshal()
+	if err != nil {
+		return err
+	}
+
+	return stateDB.Set([]byte(fmt.Sprintf("validatorsKey:%v", height)), bz)
+}
+
+// forkGenesisDoc renames the chain of the genesis file, and deletes the copy of
+// the genesis doc in the Tendermint state database so that the node loads the
+// renamed one.
+func forkGenesisDoc(cfg *tmcfg.Config, stateDB dbm.DB, chainID string) error {
+	genDoc, err := tmtypes.GenesisDocFromFile(cfg.GenesisFile())
+	if err != nil {
+		return err
+	}
+
+	genDoc.ChainID = chainID
+	if err := genDoc.SaveAs(cfg.GenesisFile()); err != nil {
+		return err
+	}
+
+	return stateDB.Delete([]byte("genesisDoc"))
+}
@@ -1,16 +1,94 @@
 package server
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/rs/zerolog"
 	tmlog "github.com/tendermint/tendermint/libs/log"
+
+	sdklog "github.com/cosmos/cosmos-sdk/types/log"
 )
 
-var _ tmlog.Logger = (*ZeroLogWrapper)(nil)
+var (
+	_ tmlog.Logger       = (*ZeroLogWrapper)(nil)
+	_ sdklog.LevelLogger = (*ZeroLogWrapper)(nil)
+)
+
+// LogLevels defines the log levels of the modules, keyed by the "module"
+// context of their loggers, e.g. x/bank or baseapp.
+type LogLevels struct {
+	// Default is the level of the modules without a level.
+	Default zerolog.Level
+	Modules map[string]zerolog.Level
+}
+
+// ParseLogLevels parses comma separated log levels, either of a module in the
+// form {module}:{level}, or the default level in the form {level} or *:{level},
+// e.g. x/bank:debug,baseapp:info,*:error. The later levels of a module
+// override the earlier ones, and the default level is info if not set.
+func ParseLogLevels(s string) (LogLevels, error) {
+	levels := LogLevels{Default: zerolog.InfoLevel, Modules: make(map[string]zerolog.Level)}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		module, levelStr := "*", entry
+		if i := strings.LastIndex(entry, ":"); i >= 0 {
+			module, levelStr = strings.TrimSpace(entry[:i]), strings.TrimSpace(entry[i+1:])
+		}
+
+		level, err := zerolog.ParseLevel(levelStr)
+		if err != nil {
+			return LogLevels{}, err
+		}
+		if module == "" || levelStr == "" {
+			return LogLevels{}, fmt.Errorf("invalid log level %q, expected {module}:{level}", entry)
+		}
+
+		if module == "*" {
+			levels.Default = level
+		} else {
+			levels.Modules[module] = level
+		}
+	}
+
+	return levels, nil
+}
+
+// Level returns the log level of the given module.
+func (l LogLevels) Level(module string) zerolog.Level {
+	if level, ok := l.Modules[module]; ok {
+		return level
+	}
+
+	return l.Default
+}
 
 // ZeroLogWrapper provides a wrapper around a zerolog.Logger instance. It implements
 // Tendermint's Logger interface.
 type ZeroLogWrapper struct {
 	zerolog.Logger
+
+	// levels are the per-module log levels, nil if the level of the logger
+	// doesn't depend on its module. If set, the logger is built from the root
+	// logger and its context, so that its module can be overridden.
+	levels *LogLevels
+	root   zerolog.Logger
+	fields map[string]interface{}
+	module string
+}
+
+// NewZeroLogWrapper returns a wrapper around the given logger, logging with
+// the level of the module set in its context by With.
+func NewZeroLogWrapper(logger zerolog.Logger, levels LogLevels) ZeroLogWrapper {
+	return ZeroLogWrapper{
+		Logger: logger.Level(levels.Default),
+		levels: &levels,
+		root:   logger,
+	}
 }
 
 // Info implements Tendermint's Logger interface and logs with level INFO. A set
@@ -34,11 +112,54 @@ func (z ZeroLogWrapper) Debug(msg string, keyVals ...interface{}) {
 	z.Logger.Debug().Fields(getLogFields(keyVals...)).Msg(msg)
 }
 
+// Enabled implements the LevelLogger interface and returns whether the
+// messages of the given level are logged, given the level of the module of the
+// logger.
+func (z ZeroLogWrapper) Enabled(level string) bool {
+	lvl, err := zerolog.ParseLevel(level)
+	if err != nil {
+		return true
+	}
+
+	return lvl >= z.GetLevel() && lvl >= zerolog.GlobalLevel()
+}
+
 // With returns a new wrapped logger with additional context provided by a set
 // of key/value tuples. The number of tuples must be even and the key of the
-// tuple must be a string.
+// tuple must be a string. With per-module log levels, the "module" key sets
+// the module of the logger, overriding the previous one, and its level.
 func (z ZeroLogWrapper) With(keyVals ...interface{}) tmlog.Logger {
-	return ZeroLogWrapper{z.Logger.With().Fields(getLogFields(keyVals...)).Logger()}
+	if z.levels == nil {
+		return ZeroLogWrapper{Logger: z.Logger.With().Fields(getLogFields(keyVals...)).Logger()}
+	}
+
+	fields := make(map[string]interface{}, len(z.fields)+len(keyVals)/2)
+	for k, v := range z.fields {
+		fields[k] = v
+	}
+
+	module := z.module
+	for k, v := range getLogFields(keyVals...) {
+		if k == "module" {
+			module = fmt.Sprint(v)
+			continue
+		}
+
+		fields[k] = v
+	}
+
+	ctx := z.root.With().Fields(fields)
+	if module != "" {
+		ctx = ctx.Str("module", module)
+	}
+
+	return ZeroLogWrapper{
+		Logger: ctx.Logger().Level(z.levels.Level(module)),
+		levels: z.levels,
+		root:   z.root,
+		fields: fields,
+		module: module,
+	}
 }
 
 func getLogFields(keyVals ...interface{}) map[string]interface{} {
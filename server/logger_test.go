@@ -0,0 +1,52 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogLevels(t *testing.T) {
+	levels, err := ParseLogLevels("")
+	require.NoError(t, err)
+	require.Equal(t, LogLevels{Default: zerolog.InfoLevel, Modules: map[string]zerolog.Level{}}, levels)
+
+	levels, err = ParseLogLevels("x/bank:debug, baseapp:info,*:error,x/bank:warn,debug")
+	require.NoError(t, err)
+	require.Equal(t, zerolog.DebugLevel, levels.Default)
+	require.Equal(t, zerolog.WarnLevel, levels.Level("x/bank"))
+	require.Equal(t, zerolog.InfoLevel, levels.Level("baseapp"))
+	require.Equal(t, zerolog.DebugLevel, levels.Level("x/staking"))
+
+	for _, s := range []string{"verbose", "x/bank:verbose", ":debug", "x/bank:"} {
+		_, err = ParseLogLevels(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestZeroLogWrapperModuleLevels(t *testing.T) {
+	levels, err := ParseLogLevels("x/bank:debug,baseapp:error,info")
+	require.NoError(t, err)
+
+	buf := new(bytes.Buffer)
+	logger := NewZeroLogWrapper(zerolog.New(buf), levels)
+
+	logger.Debug("root debug")
+	logger.Info("root info")
+	app := logger.With("module", "baseapp", "height", 1)
+	app.Info("app info")
+	app.Error("app error")
+	bank := app.With("module", "x/bank")
+	bank.Debug("bank debug")
+
+	require.False(t, logger.Enabled("debug"))
+	require.False(t, app.(ZeroLogWrapper).Enabled("info"))
+	require.True(t, bank.(ZeroLogWrapper).Enabled("debug"))
+
+	require.Equal(t, `{"level":"info","message":"root info"}
+{"level":"error","height":1,"module":"baseapp","message":"app error"}
+{"level":"debug","height":1,"module":"x/bank","message":"bank debug"}
+`, buf.String())
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"fmt"
+	"io"
+
+	dbm "github.com/tendermint/tm-db"
+
+	dbm2 "github.com/cosmos/cosmos-sdk/db"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2/flat"
+	"github.com/cosmos/cosmos-sdk/store/v2/migration"
+)
+
+// StoreMigration is the result of the migration of a module store.
+type StoreMigration struct {
+	Store       string `json:"store"`
+	Height      int64  `json:"height"`
+	Keys        uint64 `json:"keys"`
+	IAVLHash    []byte `json:"iavl_hash"`
+	SMTRoot     []byte `json:"smt_root"`
+	ContentHash []byte `json:"content_hash"`
+}
+
+// MigrateStore copies the IAVL store of the given name, committed at the given
+// height of the application database or at the latest height if zero, into a
+// new flat store of the target database, committed at the same height, and
+// verifies that the contents of both stores hash the same. The progress is
+// written to the given writer after each batch of batchSize keys.
+func MigrateStore(db dbm.DB, storeName string, height int64, targetDB dbm2.DBConnection, batchSize int, progress io.Writer) (StoreMigration, error) {
+	if batchSize <= 0 {
+		return StoreMigration{}, fmt.Errorf("batch size must be positive: %d", batchSize)
+	}
+
+	ms, keys, err := loadCommittedStores(db, height)
+	if err != nil {
+		return StoreMigration{}, err
+	}
+
+	var key storetypes.StoreKey
+	for _, k := range keys {
+		if k.Name() == storeName {
+			key = k
+		}
+	}
+	if key == nil {
+		return StoreMigration{}, fmt.Errorf("store %s not found", storeName)
+	}
+
+	source := ms.GetCommitKVStore(key)
+	commitID := source.LastCommitID()
+
+	target, err := flat.NewStore(targetDB, flat.StoreConfig{
+		Pruning:        storetypes.PruneNothing,
+		InitialVersion: uint64(commitID.Version),
+	})
+	if err != nil {
+		return StoreMigration{}, err
+	}
+	defer target.Close()
+
+	if target.LastCommitID().Version != 0 {
+		return StoreMigration{}, fmt.Errorf("target database is not empty")
+	}
+
+	store := migration.NewStore(source, target, migration.Progress{})
+	var migrated uint64
+	for !store.Progress().Done {
+		migrated += uint64(store.MigrateBatch(batchSize))
+		fmt.Fprintf(progress, "copied %d keys of store %s\n", migrated, storeName)
+	}
+
+	targetID := target.Commit()
+	if targetID.Version != commitID.Version {
+		return StoreMigration{}, fmt.Errorf("target store committed at height %d instead of %d", targetID.Version, commitID.Version)
+	}
+
+	if err := store.Verify(); err != nil {
+		return StoreMigration{}, err
+	}
+
+	return StoreMigration{
+		Store:       storeName,
+		Height:      commitID.Version,
+		Keys:        migrated,
+		IAVLHash:    commitID.Hash,
+		SMTRoot:     targetID.Hash,
+		ContentHash: migration.Hash(target),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package server_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/store/v2/flat"
+)
+
+func TestMigrateStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := rootmulti.NewStore(db)
+	key := storetypes.NewKVStoreKey("bank")
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := byte(0); i < 5; i++ {
+		ms.GetKVStore(key).Set([]byte{i}, []byte{i})
+		ms.Commit()
+	}
+	iavlID := ms.GetCommitKVStore(key).LastCommitID()
+
+	targetDB := memdb.NewDB()
+	var progress bytes.Buffer
+	result, err := server.MigrateStore(db, "bank", 0, targetDB, 2, &progress)
+	require.NoError(t, err)
+	require.Equal(t, "copied 2 keys of store bank\ncopied 4 keys of store bank\ncopied 5 keys of store bank\n", progress.String())
+	require.Equal(t, uint64(5), result.Keys)
+	require.Equal(t, int64(5), result.Height)
+	require.Equal(t, iavlID.Hash, result.IAVLHash)
+
+	target, err := flat.NewStore(targetDB, flat.DefaultStoreConfig)
+	require.NoError(t, err)
+	require.Equal(t, storetypes.CommitID{Version: 5, Hash: result.SMTRoot}, target.LastCommitID())
+	require.Equal(t, []byte{3}, target.Get([]byte{3}))
+	require.NoError(t, target.Close())
+
+	// the target database must be empty
+	_, err = server.MigrateStore(db, "bank", 0, targetDB, 2, &progress)
+	require.Error(t, err)
+
+	_, err = server.MigrateStore(db, "staking", 0, memdb.NewDB(), 2, &progress)
+	require.Error(t, err)
+}
@@ -111,6 +111,10 @@ func (ms multiStore) GetStoreType() storetypes.StoreType {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetMetricsEnabled(_ bool) {
+	panic("not implemented")
+}
+
 func (ms multiStore) SetInterBlockCache(_ sdk.MultiStorePersistentCache) {
 	panic("not implemented")
 }
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+)
+
+// PruneCmd deletes old versions of the application state while the node is
+// stopped.
+func PruneCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune [keep-recent]",
+		Short: "Delete all but the most recent versions of the application state",
+		Long: `Delete all versions of the application state stored in application.db except
+the given number of most recent versions, along with the latest version.
+
+The node must be stopped while running this command. To keep the heights of local
+state sync snapshots available, keep-recent cannot be lower than the configured
+state-sync.snapshot-interval.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			keepRecent, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid keep-recent %s: %w", args[0], err)
+			}
+
+			snapshotInterval := cast.ToUint64(serverCtx.Viper.Get(FlagStateSyncSnapshotInterval))
+			if snapshotInterval > 0 && keepRecent < snapshotInterval {
+				return fmt.Errorf(
+					"keep-recent %d must not be lower than the state sync snapshot interval %d",
+					keepRecent, snapshotInterval,
+				)
+			}
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			return PruneVersions(db, keepRecent, cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// PruneVersions deletes from every store of the application database all the
+// versions older than the latest keepRecent versions, writing the progress to
+// the given writer. The latest version is always kept.
+func PruneVersions(db dbm.DB, keepRecent uint64, progress io.Writer) error {
+	ms, keys, err := loadCommittedStores(db, 0)
+	if err != nil {
+		return err
+	}
+
+	latest := ms.LastCommitID().Version
+	pruneTo := latest - int64(keepRecent)
+	if keepRecent == 0 {
+		// the latest version cannot be deleted
+		pruneTo = latest - 1
+	}
+
+	if pruneTo < 1 {
+		fmt.Fprintf(progress, "nothing to prune at height %d\n", latest)
+		return nil
+	}
+
+	for i, key := range keys {
+		store, ok := ms.GetCommitKVStore(key).(*iavl.Store)
+		if !ok {
+			continue
+		}
+
+		var versions []int64
+		for v := int64(1); v <= pruneTo; v++ {
+			if store.VersionExists(v) {
+				versions = append(versions, v)
+			}
+		}
+
+		fmt.Fprintf(progress, "[%d/%d] pruning %d versions of store %s\n", i+1, len(keys), len(versions), key.Name())
+		if err := store.DeleteVersions(versions...); err != nil {
+			return fmt.Errorf("failed to prune store %s: %w", key.Name(), err)
+		}
+	}
+
+	fmt.Fprintf(progress, "pruned all versions up to height %d\n", pruneTo)
+	return nil
+}
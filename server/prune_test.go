@@ -0,0 +1,45 @@
+package server_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestPruneVersions(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := rootmulti.NewStore(db)
+	key := storetypes.NewKVStoreKey("bank")
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := byte(0); i < 10; i++ {
+		ms.GetKVStore(key).Set([]byte{i}, []byte{i})
+		ms.Commit()
+	}
+
+	var progress bytes.Buffer
+	require.NoError(t, server.PruneVersions(db, 3, &progress))
+	require.Contains(t, progress.String(), "pruning 7 versions of store bank")
+
+	ms = rootmulti.NewStore(db)
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	store := ms.GetCommitKVStore(key).(*iavl.Store)
+	for v := int64(1); v <= 10; v++ {
+		require.Equal(t, v > 7, store.VersionExists(v), "version %d", v)
+	}
+
+	// pruning again has nothing left to delete
+	progress.Reset()
+	require.NoError(t, server.PruneVersions(db, 3, &progress))
+	require.Contains(t, progress.String(), "pruning 0 versions of store bank")
+}
@@ -13,7 +13,9 @@ import (
 
 // GetPruningOptionsFromFlags parses command flags and returns the correct
 // PruningOptions. If a pruning strategy is provided, that will be parsed and
-// returned, otherwise, it is assumed custom pruning options are provided.
+// returned, otherwise, it is assumed custom pruning options are provided. The
+// snapshots strategy keeps the heights of the state sync snapshots, so it
+// requires a non-zero state sync snapshot interval.
 func GetPruningOptionsFromFlags(appOpts types.AppOptions) (storetypes.PruningOptions, error) {
 	strategy := strings.ToLower(cast.ToString(appOpts.Get(FlagPruning)))
 
@@ -34,6 +36,26 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (storetypes.PruningOpt
 
 		return opts, nil
 
+	case storetypes.PruningOptionSnapshots:
+		snapshotInterval := cast.ToUint64(appOpts.Get(FlagStateSyncSnapshotInterval))
+		if snapshotInterval == 0 {
+			return store.PruningOptions{}, fmt.Errorf(
+				"pruning strategy %s requires a non-zero %s", strategy, FlagStateSyncSnapshotInterval,
+			)
+		}
+
+		opts := storetypes.NewSnapshotPruningOptions(
+			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
+			snapshotInterval,
+			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
+		)
+
+		if err := opts.Validate(); err != nil {
+			return opts, fmt.Errorf("invalid snapshots pruning options: %w", err)
+		}
+
+		return opts, nil
+
 	default:
 		return store.PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
 	}
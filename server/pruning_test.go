@@ -51,6 +51,33 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 			},
 			expectedOptions: types.PruneDefault,
 		},
+		{
+			name: "snapshots pruning options",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, types.PruningOptionSnapshots)
+				v.Set(FlagPruningKeepRecent, 100)
+				v.Set(FlagStateSyncSnapshotInterval, 1000)
+
+				return v
+			},
+			expectedOptions: types.PruningOptions{
+				KeepRecent: 100,
+				KeepEvery:  1000,
+				Interval:   10,
+			},
+		},
+		{
+			name: "snapshots pruning options without snapshots",
+			initParams: func() *viper.Viper {
+				v := viper.New()
+				v.Set(FlagPruning, types.PruningOptionSnapshots)
+				v.Set(FlagPruningKeepRecent, 100)
+
+				return v
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
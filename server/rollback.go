@@ -0,0 +1,167 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmstoreproto "github.com/tendermint/tendermint/proto/tendermint/store"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+const (
+	FlagHard      = "hard"
+	FlagNumBlocks = "num-blocks"
+)
+
+// RollbackCmd reverts the application and Tendermint state by one or more
+// heights.
+func RollbackCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rollback the application and Tendermint state by one height",
+		Long: `A state rollback is performed to recover from an incorrect application state
+transition, when Tendermint has persisted an incorrect app hash and is thus unable to
+make progress. Rollback overwrites the state at height n with the state at height n - 1,
+for both the application multistore and Tendermint.
+
+By default no blocks are removed, so upon restarting the node the transactions in
+block n are re-executed against the application. With --hard, the rolled back blocks
+are also removed from the block store and fetched again from peers, which allows
+rolling back more than one height with --num-blocks.
+
+The node must be stopped while running this command.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			hard, _ := cmd.Flags().GetBool(FlagHard)
+			numBlocks, _ := cmd.Flags().GetUint64(FlagNumBlocks)
+			if numBlocks == 0 {
+				return fmt.Errorf("number of blocks to rollback must be positive")
+			}
+			if numBlocks > 1 && !hard {
+				return fmt.Errorf("rolling back more than one height requires --%s", FlagHard)
+			}
+
+			height, hash, err := rollbackTendermintState(config, numBlocks, hard)
+			if err != nil {
+				return fmt.Errorf("failed to rollback tendermint state: %w", err)
+			}
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			ms, _, err := loadCommittedStores(db, 0)
+			if err != nil {
+				return err
+			}
+
+			if err := ms.RollbackToVersion(height); err != nil {
+				return fmt.Errorf("failed to rollback to version: %w", err)
+			}
+
+			cmd.Printf("Rolled back state to height %d and hash %X\n", height, hash)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Bool(FlagHard, false, "Remove the rolled back blocks from the block store")
+	cmd.Flags().Uint64(FlagNumBlocks, 1, "Number of heights to rollback (requires --hard if greater than 1)")
+
+	return cmd
+}
+
+// rollbackTendermintState overwrites the Tendermint state at the latest height
+// n with the state at height n - numBlocks, removing the rolled back blocks if
+// hard is set. It returns the height and the app hash of the resulting state.
+func rollbackTendermintState(config *tmcfg.Config, numBlocks uint64, hard bool) (int64, []byte, error) {
+	dbType := dbm.BackendType(config.DBBackend)
+
+	blockStoreDB, err := dbm.NewDB("blockstore", dbType, config.DBDir())
+	if err != nil {
+		return -1, nil, err
+	}
+	defer blockStoreDB.Close()
+
+	stateDB, err := dbm.NewDB("state", dbType, config.DBDir())
+	if err != nil {
+		return -1, nil, err
+	}
+	defer stateDB.Close()
+
+	stateStore := sm.NewStore(stateDB)
+
+	var (
+		height int64
+		hash   []byte
+	)
+	for i := uint64(0); i < numBlocks; i++ {
+		blockStore := tmstore.NewBlockStore(blockStoreDB)
+		height, hash, err = sm.Rollback(blockStore, stateStore)
+		if err != nil {
+			return -1, nil, err
+		}
+
+		if hard {
+			if err := removeLatestBlock(blockStoreDB, blockStore); err != nil {
+				return -1, nil, err
+			}
+		}
+	}
+
+	return height, hash, nil
+}
+
+// removeLatestBlock deletes the latest block from the Tendermint block store.
+// The keys mirror the layout of the Tendermint block store.
+func removeLatestBlock(db dbm.DB, blockStore *tmstore.BlockStore) error {
+	height := blockStore.Height()
+	meta := blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return fmt.Errorf("block at height %d not found", height)
+	}
+
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	keys := [][]byte{
+		[]byte(fmt.Sprintf("H:%v", height)),
+		[]byte(fmt.Sprintf("BH:%x", meta.BlockID.Hash)),
+		// the commit of the previous block is part of the removed block
+		[]byte(fmt.Sprintf("C:%v", height-1)),
+		[]byte(fmt.Sprintf("SC:%v", height)),
+	}
+	for i := 0; i < int(meta.BlockID.PartSetHeader.Total); i++ {
+		keys = append(keys, []byte(fmt.Sprintf("P:%v:%v", height, i)))
+	}
+
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	tmstore.SaveBlockStoreState(&tmstoreproto.BlockStoreState{
+		Base:   blockStore.Base(),
+		Height: height - 1,
+	}, db)
+
+	return nil
+}
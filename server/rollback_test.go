@@ -0,0 +1,50 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	tmstore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestRemoveLatestBlock(t *testing.T) {
+	db := dbm.NewMemDB()
+	saveBlock := func(height int64) {
+		blockStore := tmstore.NewBlockStore(db)
+		lastCommit := &tmtypes.Commit{Height: height - 1}
+		block := tmtypes.MakeBlock(height, []tmtypes.Tx{tmtypes.Tx("tx")}, lastCommit, nil)
+		block.Time = time.Unix(height, 0).UTC()
+		block.ProposerAddress = make([]byte, crypto.AddressSize)
+		partSet := block.MakePartSet(tmtypes.BlockPartSizeBytes)
+		seenCommit := &tmtypes.Commit{Height: height, BlockID: tmtypes.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}}
+		blockStore.SaveBlock(block, partSet, seenCommit)
+	}
+
+	for h := int64(1); h <= 3; h++ {
+		saveBlock(h)
+	}
+
+	blockStore := tmstore.NewBlockStore(db)
+	hash := blockStore.LoadBlockMeta(3).BlockID.Hash
+	require.NoError(t, removeLatestBlock(db, blockStore))
+
+	blockStore = tmstore.NewBlockStore(db)
+	require.Equal(t, int64(1), blockStore.Base())
+	require.Equal(t, int64(2), blockStore.Height())
+	require.Nil(t, blockStore.LoadBlockMeta(3))
+	require.Nil(t, blockStore.LoadBlockByHash(hash))
+	require.Nil(t, blockStore.LoadBlockCommit(2))
+	require.Nil(t, blockStore.LoadSeenCommit(3))
+	require.NotNil(t, blockStore.LoadBlockMeta(2))
+	has, err := db.Has([]byte("SC:2"))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// the removed height can be saved again
+	require.NotPanics(t, func() { saveBlock(3) })
+	require.Equal(t, int64(3), tmstore.NewBlockStore(db).Height())
+}
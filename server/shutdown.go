@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/server/api"
+)
+
+// drainServers stops the API, gRPC-web and gRPC servers, if started, from
+// accepting new requests and waits for their in-flight requests to complete
+// until the context is done, after which the remaining requests are aborted.
+func drainServers(ctx context.Context, apiSrv *api.Server, grpcWebSrv *http.Server, grpcSrv *grpc.Server) error {
+	var err error
+	if apiSrv != nil {
+		if e := apiSrv.Shutdown(ctx); e != nil && err == nil {
+			err = e
+		}
+	}
+
+	// the gRPC-web requests are served by the gRPC server, so the gRPC-web
+	// server is drained first
+	if grpcWebSrv != nil {
+		if e := grpcWebSrv.Shutdown(ctx); e != nil && err == nil {
+			err = e
+		}
+	}
+
+	if grpcSrv != nil {
+		stopGRPCServer(ctx, grpcSrv)
+		if e := ctx.Err(); e != nil && err == nil {
+			err = e
+		}
+	}
+
+	return err
+}
+
+// stopGRPCServer gracefully stops the gRPC server, waiting for its in-flight
+// requests to complete until the context is done.
+func stopGRPCServer(ctx context.Context, grpcSrv *grpc.Server) {
+	done := make(chan struct{})
+	go func() {
+		grpcSrv.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		grpcSrv.Stop()
+		<-done
+	}
+}
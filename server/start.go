@@ -3,6 +3,7 @@ package server
 // DONTCOVER
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -41,6 +42,7 @@ const (
 	flagAddress            = "address"
 	flagTransport          = "transport"
 	flagTraceStore         = "trace-store"
+	flagTraceStoreFormat   = "trace-store-format"
 	flagCPUProfile         = "cpu-profile"
 	FlagMinGasPrices       = "minimum-gas-prices"
 	FlagHaltHeight         = "halt-height"
@@ -50,12 +52,22 @@ const (
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
 
-	FlagPruning           = "pruning"
-	FlagPruningKeepRecent = "pruning-keep-recent"
-	FlagPruningKeepEvery  = "pruning-keep-every"
-	FlagPruningInterval   = "pruning-interval"
-	FlagIndexEvents       = "index-events"
-	FlagMinRetainBlocks   = "min-retain-blocks"
+	FlagPruning              = "pruning"
+	FlagPruningKeepRecent    = "pruning-keep-recent"
+	FlagPruningKeepEvery     = "pruning-keep-every"
+	FlagPruningInterval      = "pruning-interval"
+	FlagIndexEvents          = "index-events"
+	FlagEventIndexing        = "event-indexing"
+	FlagGasTraceRetention    = "gas-trace-retention"
+	FlagGasBreakdown         = "gas-breakdown"
+	FlagGasPriceBlocks       = "gas-price-blocks"
+	FlagRawStoreQueries      = "raw-store-queries"
+	FlagModuleGenesisQueries = "module-genesis-queries"
+	FlagMinRetainBlocks      = "min-retain-blocks"
+	FlagShutdownTimeout      = "shutdown-timeout"
+	FlagLogLevels            = "log-levels"
+	FlagLogSamplingPeriod    = "log-sampling-period"
+	FlagLogSamplingBurst     = "log-sampling-burst"
 )
 
 // GRPC-related flags.
@@ -66,6 +78,37 @@ const (
 	flagGRPCWebAddress = "grpc-web.address"
 )
 
+// Formats of the KVStore tracing output file.
+const (
+	// TraceStoreFormatOpLog is the compressed operation log read with the
+	// store/oplog package.
+	TraceStoreFormatOpLog = "oplog"
+	// TraceStoreFormatJSON is the legacy line-delimited JSON format.
+	TraceStoreFormatJSON = "json"
+)
+
+// Telemetry-related flags.
+const (
+	FlagTelemetryStoreMetrics = "telemetry.enable-store-metrics"
+)
+
+// Upgrade-related flags.
+const (
+	FlagUpgradeSnapshotBeforeUpgrade = "upgrade.snapshot-before-upgrade"
+	FlagUpgradeExportBeforeUpgrade   = "upgrade.export-before-upgrade"
+)
+
+// Invariants-related flags.
+const (
+	FlagInvariantsAsyncCheckPeriod = "invariants.async-check-period"
+)
+
+// Store encryption-related flags.
+const (
+	FlagStoreEncryptionKeyFile = "store-encryption.key-file"
+	FlagStoreEncryptionStores  = "store-encryption.stores"
+)
+
 // State sync-related flags.
 const (
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -90,6 +133,8 @@ default: the last 100 states are kept in addition to every 500th state; pruning
 nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 everything: all saved states will be deleted, storing only the current state; pruning at 10 block intervals
 custom: allow pruning options to be manually specified through 'pruning-keep-recent', 'pruning-keep-every', and 'pruning-interval'
+snapshots: all saved states will be deleted except the last 'pruning-keep-recent' states and the states of the
+'state-sync.snapshot-interval' heights; pruning at 'pruning-interval' (10 by default) block intervals
 
 Node halting configurations exist in the form of two flags: '--halt-height' and '--halt-time'. During
 the ABCI Commit phase, the node will check if the current block height is greater than or equal to
@@ -142,6 +187,7 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().String(flagAddress, "tcp://0.0.0.0:26658", "Listen address")
 	cmd.Flags().String(flagTransport, "socket", "Transport protocol: socket, grpc")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
+	cmd.Flags().String(flagTraceStoreFormat, TraceStoreFormatOpLog, fmt.Sprintf("Format of the KVStore tracing output file (%s|%s)", TraceStoreFormatOpLog, TraceStoreFormatJSON))
 	cmd.Flags().String(FlagMinGasPrices, "", "Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)")
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
@@ -149,12 +195,16 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
-	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
-	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
+	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom|snapshots)")
+	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom' or 'snapshots')")
 	cmd.Flags().Uint64(FlagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')")
-	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom' or 'snapshots')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
+	cmd.Flags().Duration(FlagShutdownTimeout, config.DefaultShutdownTimeout, "Maximum duration for which the in-flight gRPC and API requests are drained on shutdown")
+	cmd.Flags().Bool(FlagGasBreakdown, false, "Append the gas consumed per message and per store access category to the events of the tx results")
+	cmd.Flags().Uint64(FlagGasPriceBlocks, 0, "Number of blocks of which the paid gas prices are tracked to suggest gas prices (0 disables the tracking)")
+	cmd.Flags().Bool(FlagRawStoreQueries, false, "Enable the raw store query service serving the raw key/value pairs of the stores for debugging")
 
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(flagGRPCAddress, config.DefaultGRPCAddress, "the gRPC server address to listen on")
@@ -181,7 +231,7 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 	}
 
 	traceWriterFile := ctx.Viper.GetString(flagTraceStore)
-	traceWriter, err := openTraceWriter(traceWriterFile)
+	traceWriter, err := openTraceWriter(traceWriterFile, ctx.Viper.GetString(flagTraceStoreFormat))
 	if err != nil {
 		return err
 	}
@@ -204,6 +254,14 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		if err = svr.Stop(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		if err := closeTraceWriter(traceWriter); err != nil {
+			ctx.Logger.Error("failed to close the trace writer", "err", err)
+		}
+
+		if err := db.Close(); err != nil {
+			ctx.Logger.Error("failed to close the application database", "err", err)
+		}
 	}()
 
 	// Wait for SIGINT or SIGTERM signal
@@ -240,7 +298,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		return err
 	}
 
-	traceWriter, err := openTraceWriter(traceWriterFile)
+	traceWriter, err := openTraceWriter(traceWriterFile, ctx.Viper.GetString(flagTraceStoreFormat))
 	if err != nil {
 		return err
 	}
@@ -321,7 +379,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		grpcWebSrv *http.Server
 	)
 	if config.GRPC.Enable {
-		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC.Address)
+		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC)
 		if err != nil {
 			return err
 		}
@@ -371,23 +429,36 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	}
 
 	defer func() {
+		// The servers are drained before the node is stopped, so that the
+		// in-flight queries complete against open stores, and the telemetry
+		// sinks and the stores are closed last, once no block can be
+		// committed anymore.
+		ctx.Logger.Info("draining in-flight requests...", "timeout", config.ShutdownTimeout)
+		drainCtx, cancel := context.WithTimeout(context.Background(), config.ShutdownTimeout)
+		if err := drainServers(drainCtx, apiSrv, grpcWebSrv, grpcSrv); err != nil {
+			ctx.Logger.Error("failed to drain in-flight requests", "err", err)
+		}
+		cancel()
+
 		if tmNode.IsRunning() {
 			_ = tmNode.Stop()
+			tmNode.Wait()
+		}
+
+		if apiSrv != nil {
+			apiSrv.CloseMetrics()
 		}
 
 		if cpuProfileCleanup != nil {
 			cpuProfileCleanup()
 		}
 
-		if apiSrv != nil {
-			_ = apiSrv.Close()
+		if err := closeTraceWriter(traceWriter); err != nil {
+			ctx.Logger.Error("failed to close the trace writer", "err", err)
 		}
 
-		if grpcSrv != nil {
-			grpcSrv.Stop()
-			if grpcWebSrv != nil {
-				grpcWebSrv.Close()
-			}
+		if err := db.Close(); err != nil {
+			ctx.Logger.Error("failed to close the application database", "err", err)
 		}
 
 		ctx.Logger.Info("exiting...")
@@ -0,0 +1,213 @@
+package server
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	FlagPrefixBytes = "prefix-bytes"
+	FlagFormat      = "format"
+)
+
+// StoreSize holds the number of keys and their byte sizes for a module store,
+// or for a key prefix within a module store.
+type StoreSize struct {
+	Store      string `json:"store"`
+	Prefix     string `json:"prefix,omitempty"`
+	Keys       uint64 `json:"keys"`
+	KeyBytes   uint64 `json:"key_bytes"`
+	ValueBytes uint64 `json:"value_bytes"`
+}
+
+// StateSizeCmd reports the size of the committed application state.
+func StateSizeCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-size",
+		Short: "Report the number of keys and byte sizes of the committed state of each module",
+		Long: `Walk the committed multistore and report, for each module store, the number of
+keys along with the total size of keys and values. With --prefix-bytes, sizes are
+further broken down by the hex encoded leading bytes of the keys.
+
+The node must be stopped while running this command.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			prefixLen, _ := cmd.Flags().GetInt(FlagPrefixBytes)
+			format, _ := cmd.Flags().GetString(FlagFormat)
+			if format != "json" && format != "csv" {
+				return fmt.Errorf("unsupported format %s, expected json or csv", format)
+			}
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			sizes, err := GetStateSizes(db, height, prefixLen)
+			if err != nil {
+				return err
+			}
+
+			if format == "csv" {
+				return writeStateSizesCSV(cmd.OutOrStdout(), sizes)
+			}
+
+			bz, err := json.MarshalIndent(sizes, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, 0, "Report the state at a particular height (0 means latest height)")
+	cmd.Flags().Int(FlagPrefixBytes, 0, "Break down each store by the given number of leading key bytes")
+	cmd.Flags().String(FlagFormat, "json", "Output format (json|csv)")
+
+	return cmd
+}
+
+// GetStateSizes walks every store committed at the given height of the
+// application database and returns their sizes, sorted by store name and
+// prefix. A height of zero selects the latest committed height. A positive
+// prefixLen breaks each store down by the first prefixLen bytes of its keys.
+func GetStateSizes(db dbm.DB, height int64, prefixLen int) ([]StoreSize, error) {
+	if prefixLen < 0 {
+		return nil, fmt.Errorf("prefix length cannot be negative: %d", prefixLen)
+	}
+
+	ms, keys, err := loadCommittedStores(db, height)
+	if err != nil {
+		return nil, err
+	}
+
+	var sizes []StoreSize
+	for _, key := range keys {
+		sizes = append(sizes, storeSizes(key.Name(), ms.GetKVStore(key), prefixLen)...)
+	}
+
+	sort.Slice(sizes, func(i, j int) bool {
+		if sizes[i].Store != sizes[j].Store {
+			return sizes[i].Store < sizes[j].Store
+		}
+		return sizes[i].Prefix < sizes[j].Prefix
+	})
+
+	return sizes, nil
+}
+
+// loadCommittedStores loads the multistore of the application database at the
+// given height, or at the latest height if zero, mounting every store listed in
+// the commit info of that height.
+func loadCommittedStores(db dbm.DB, height int64) (*rootmulti.Store, []storetypes.StoreKey, error) {
+	if height == 0 {
+		height = rootmulti.GetLatestVersion(db)
+	}
+
+	cInfo, err := rootmulti.GetCommitInfo(db, height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ms := rootmulti.NewStore(db)
+	keys := make([]storetypes.StoreKey, 0, len(cInfo.StoreInfos))
+	for _, info := range cInfo.StoreInfos {
+		key := storetypes.NewKVStoreKey(info.Name)
+		ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+		keys = append(keys, key)
+	}
+
+	if err := ms.LoadVersion(height); err != nil {
+		return nil, nil, err
+	}
+
+	return ms, keys, nil
+}
+
+func storeSizes(name string, store storetypes.KVStore, prefixLen int) []StoreSize {
+	total := StoreSize{Store: name}
+	byPrefix := make(map[string]*StoreSize)
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, value := iter.Key(), iter.Value()
+		total.Keys++
+		total.KeyBytes += uint64(len(key))
+		total.ValueBytes += uint64(len(value))
+
+		if prefixLen == 0 {
+			continue
+		}
+
+		prefix := key
+		if len(prefix) > prefixLen {
+			prefix = prefix[:prefixLen]
+		}
+
+		p := hex.EncodeToString(prefix)
+		size, ok := byPrefix[p]
+		if !ok {
+			size = &StoreSize{Store: name, Prefix: p}
+			byPrefix[p] = size
+		}
+
+		size.Keys++
+		size.KeyBytes += uint64(len(key))
+		size.ValueBytes += uint64(len(value))
+	}
+
+	sizes := []StoreSize{total}
+	for _, size := range byPrefix {
+		sizes = append(sizes, *size)
+	}
+
+	return sizes
+}
+
+func writeStateSizesCSV(w io.Writer, sizes []StoreSize) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"store", "prefix", "keys", "key_bytes", "value_bytes"}); err != nil {
+		return err
+	}
+
+	for _, size := range sizes {
+		record := []string{
+			size.Store,
+			size.Prefix,
+			strconv.FormatUint(size.Keys, 10),
+			strconv.FormatUint(size.KeyBytes, 10),
+			strconv.FormatUint(size.ValueBytes, 10),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,51 @@
+package server_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestGetStateSizes(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := rootmulti.NewStore(db)
+	bankKey := storetypes.NewKVStoreKey("bank")
+	authKey := storetypes.NewKVStoreKey("acc")
+	ms.MountStoreWithDB(bankKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(authKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ms.GetKVStore(bankKey).Set([]byte{0x01, 0xaa}, []byte("value"))
+	ms.GetKVStore(bankKey).Set([]byte{0x01, 0xbb}, []byte("value"))
+	ms.GetKVStore(bankKey).Set([]byte{0x02}, []byte("v"))
+	ms.GetKVStore(authKey).Set([]byte{0x01}, []byte("account"))
+	ms.Commit()
+
+	// a later version removes a key from the bank store
+	ms.GetKVStore(bankKey).Delete([]byte{0x02})
+	ms.Commit()
+
+	sizes, err := server.GetStateSizes(db, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, []server.StoreSize{
+		{Store: "acc", Keys: 1, KeyBytes: 1, ValueBytes: 7},
+		{Store: "bank", Keys: 3, KeyBytes: 5, ValueBytes: 11},
+	}, sizes)
+
+	sizes, err = server.GetStateSizes(db, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, []server.StoreSize{
+		{Store: "acc", Keys: 1, KeyBytes: 1, ValueBytes: 7},
+		{Store: "acc", Prefix: "01", Keys: 1, KeyBytes: 1, ValueBytes: 7},
+		{Store: "bank", Keys: 2, KeyBytes: 4, ValueBytes: 10},
+		{Store: "bank", Prefix: "01", Keys: 2, KeyBytes: 4, ValueBytes: 10},
+	}, sizes)
+
+	_, err = server.GetStateSizes(db, 0, -1)
+	require.Error(t, err)
+}
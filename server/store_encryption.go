@@ -0,0 +1,51 @@
+package server
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/encryption"
+)
+
+// GetStoreEncryptionFromFlags returns the cipher and the names of the stores
+// encrypted at rest, parsed from the store-encryption app options. The cipher
+// is nil if no store is encrypted.
+func GetStoreEncryptionFromFlags(appOpts types.AppOptions) (cipher.AEAD, []string, error) {
+	stores := cast.ToStringSlice(appOpts.Get(FlagStoreEncryptionStores))
+	if len(stores) == 0 {
+		return nil, nil, nil
+	}
+
+	keyFile := cast.ToString(appOpts.Get(FlagStoreEncryptionKeyFile))
+	if keyFile == "" {
+		return nil, nil, fmt.Errorf("%s is required to encrypt the stores %v", FlagStoreEncryptionKeyFile, stores)
+	}
+	if !filepath.IsAbs(keyFile) {
+		keyFile = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), keyFile)
+	}
+
+	bz, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read the store encryption key: %w", err)
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(bz)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid store encryption key: %w", err)
+	}
+
+	aead, err := encryption.NewAEAD(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return aead, stores, nil
+}
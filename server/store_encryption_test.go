@@ -0,0 +1,57 @@
+package server
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+func TestGetStoreEncryptionFromFlags(t *testing.T) {
+	home := t.TempDir()
+	key := hex.EncodeToString([]byte(strings.Repeat("k", 32)))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "store_key.txt"), []byte(key+"\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "short_key.txt"), []byte("abcd"), 0o600))
+
+	tests := []struct {
+		name    string
+		keyFile string
+		stores  []string
+		enabled bool
+		wantErr bool
+	}{
+		{"no encrypted stores", "", nil, false, false},
+		{"relative key file", "store_key.txt", []string{"authz"}, true, false},
+		{"absolute key file", filepath.Join(home, "store_key.txt"), []string{"authz"}, true, false},
+		{"missing key file", "", []string{"authz"}, false, true},
+		{"invalid key", "short_key.txt", []string{"authz"}, false, true},
+		{"unknown key file", "unknown.txt", []string{"authz"}, false, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			v := viper.New()
+			v.Set(flags.FlagHome, home)
+			v.Set(FlagStoreEncryptionKeyFile, tt.keyFile)
+			v.Set(FlagStoreEncryptionStores, tt.stores)
+
+			aead, stores, err := GetStoreEncryptionFromFlags(v)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.enabled, aead != nil)
+			require.Equal(t, tt.stores, stores)
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -26,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/oplog"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 )
@@ -52,11 +54,16 @@ func (e ErrorCode) Error() string {
 	return strconv.Itoa(e.Code)
 }
 
+// ExitCode returns the exit code, see client.ExitCode.
+func (e ErrorCode) ExitCode() int {
+	return e.Code
+}
+
 func NewDefaultContext() *Context {
 	return NewContext(
 		viper.New(),
 		tmcfg.DefaultConfig(),
-		ZeroLogWrapper{log.Logger},
+		ZeroLogWrapper{Logger: log.Logger},
 	)
 }
 
@@ -144,13 +151,15 @@ func InterceptConfigsPreRunHandler(cmd *cobra.Command, customAppConfigTemplate s
 		logWriter = os.Stderr
 	}
 
-	logLvlStr := serverCtx.Viper.GetString(flags.FlagLogLevel)
-	logLvl, err := zerolog.ParseLevel(logLvlStr)
+	// the log levels of the modules set by the log_level flag override the
+	// ones of app.toml
+	logLvlStr := serverCtx.Viper.GetString(FlagLogLevels) + "," + serverCtx.Viper.GetString(flags.FlagLogLevel)
+	logLevels, err := ParseLogLevels(logLvlStr)
 	if err != nil {
 		return fmt.Errorf("failed to parse log level (%s): %w", logLvlStr, err)
 	}
 
-	serverCtx.Logger = ZeroLogWrapper{zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger()}
+	serverCtx.Logger = NewZeroLogWrapper(zerolog.New(logWriter).With().Timestamp().Logger(), logLevels)
 
 	return SetCmdServerContext(cmd, serverCtx)
 }
@@ -258,6 +267,17 @@ func interceptConfigs(rootViper *viper.Viper, customAppTemplate string, customCo
 	return conf, nil
 }
 
+// GetEventIndexFilter returns the filter of the events indexed by Tendermint
+// from the index-events and event-indexing app options.
+func GetEventIndexFilter(appOpts types.AppOptions) sdk.EventIndexFilter {
+	cfg := config.Config{
+		BaseConfig:    config.BaseConfig{IndexEvents: cast.ToStringSlice(appOpts.Get(FlagIndexEvents))},
+		EventIndexing: config.GetEventIndexing(appOpts.Get(FlagEventIndexing)),
+	}
+
+	return cfg.EventIndexFilter()
+}
+
 // add server commands
 func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator types.AppCreator, appExport types.AppExporter, addStartFlags types.ModuleInitFlags) {
 	tendermintCmd := &cobra.Command{
@@ -279,6 +299,8 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		UnsafeResetAllCmd(),
 		tendermintCmd,
 		ExportCmd(appExport, defaultNodeHome),
+		PruneCmd(defaultNodeHome),
+		RollbackCmd(defaultNodeHome),
 		version.NewVersionCommand(),
 	)
 }
@@ -376,13 +398,39 @@ func openDB(rootDir string) (dbm.DB, error) {
 	return sdk.NewLevelDB("application", dataDir)
 }
 
-func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
+// openTraceWriter opens the store trace writer appending to the given file in
+// the given format, which defaults to TraceStoreFormatOpLog.
+func openTraceWriter(traceWriterFile, format string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
 	}
-	return os.OpenFile(
+
+	f, err := os.OpenFile(
 		traceWriterFile,
 		os.O_WRONLY|os.O_APPEND|os.O_CREATE,
 		0666,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case TraceStoreFormatOpLog, "":
+		return oplog.NewWriter(f), nil
+	case TraceStoreFormatJSON:
+		return f, nil
+	default:
+		f.Close()
+		return nil, fmt.Errorf("unknown trace store format %q", format)
+	}
+}
+
+// closeTraceWriter closes the store trace writer if any, flushing the
+// operations which are still buffered.
+func closeTraceWriter(w io.Writer) error {
+	if c, ok := w.(io.Closer); ok {
+		return c.Close()
+	}
+
+	return nil
 }
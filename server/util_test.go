@@ -197,6 +197,37 @@ func TestInterceptConfigsPreRunHandlerReadsFlags(t *testing.T) {
 	}
 }
 
+func TestInterceptConfigsPreRunHandlerReadsAppFlags(t *testing.T) {
+	tempDir := t.TempDir()
+	cmd := server.StartCmd(nil, "/foobar")
+
+	if err := cmd.Flags().Set(flags.FlagHome, tempDir); err != nil {
+		t.Fatalf("Could not set home flag [%T] %v", err, err)
+	}
+	if err := cmd.Flags().Set(server.FlagRawStoreQueries, "true"); err != nil {
+		t.Fatalf("Could not set raw store queries flag [%T] %v", err, err)
+	}
+	if err := cmd.Flags().Set(server.FlagGasPriceBlocks, "20"); err != nil {
+		t.Fatalf("Could not set gas price blocks flag [%T] %v", err, err)
+	}
+
+	cmd.PreRunE = preRunETestImpl
+
+	serverCtx := &server.Context{}
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+
+	if err := cmd.ExecuteContext(ctx); err != cancelledInPreRun {
+		t.Fatalf("function failed with [%T] %v", err, err)
+	}
+
+	if !serverCtx.Viper.GetBool(server.FlagRawStoreQueries) {
+		t.Error("Raw store queries were not enabled from command flags")
+	}
+	if serverCtx.Viper.GetUint64(server.FlagGasPriceBlocks) != 20 {
+		t.Error("Gas price blocks were not set from command flags")
+	}
+}
+
 func TestInterceptConfigsPreRunHandlerReadsEnvVars(t *testing.T) {
 	const testAddr = "tcp://127.1.2.3:12345"
 	tempDir := t.TempDir()
@@ -2,6 +2,7 @@ package simapp
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
@@ -23,6 +24,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/grpc/gasprice"
+	"github.com/cosmos/cosmos-sdk/server/grpc/gastrace"
+	"github.com/cosmos/cosmos-sdk/server/grpc/modulegenesis"
+	"github.com/cosmos/cosmos-sdk/server/grpc/rawstore"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -42,7 +47,11 @@ import (
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/x/accountlimits"
+	accountlimitskeeper "github.com/cosmos/cosmos-sdk/x/accountlimits/keeper"
+	accountlimitsmodule "github.com/cosmos/cosmos-sdk/x/accountlimits/module"
 	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
@@ -73,7 +82,16 @@ import (
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+	protocolpoolclient "github.com/cosmos/cosmos-sdk/x/protocolpool/client"
+	protocolpoolkeeper "github.com/cosmos/cosmos-sdk/x/protocolpool/keeper"
+	protocolpoolmodule "github.com/cosmos/cosmos-sdk/x/protocolpool/module"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+	schedulerclient "github.com/cosmos/cosmos-sdk/x/scheduler/client"
+	schedulerkeeper "github.com/cosmos/cosmos-sdk/x/scheduler/keeper"
+	schedulermodule "github.com/cosmos/cosmos-sdk/x/scheduler/module"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
+	slashingclient "github.com/cosmos/cosmos-sdk/x/slashing/client"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -107,6 +125,8 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			slashingclient.ProposalHandler, schedulerclient.ProposalHandler,
+			protocolpoolclient.CreateContinuousFundProposalHandler, protocolpoolclient.CancelContinuousFundProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -116,6 +136,9 @@ var (
 		evidence.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
+		accountlimitsmodule.AppModuleBasic{},
+		schedulermodule.AppModuleBasic{},
+		protocolpoolmodule.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -125,7 +148,10 @@ var (
 		minttypes.ModuleName:           {authtypes.Minter},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		stakingtypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		govtypes.ModuleName:            {authtypes.Burner},
+		scheduler.ModuleName:           nil,
+		protocolpool.ModuleName:        nil,
 	}
 )
 
@@ -144,6 +170,15 @@ type SimApp struct {
 	interfaceRegistry types.InterfaceRegistry
 	msgSvcRouter      *authmiddleware.MsgServiceRouter
 	legacyRouter      sdk.Router
+	gasTraces         *sdk.GasTraceStore
+	gasBreakdown      bool
+	gasPrices         *gasprice.Tracker
+	rawStoreQueries   bool
+
+	moduleGenesisQueries bool
+
+	// inPlaceFork is the in-place fork to apply in the next BeginBlock, if any
+	inPlaceFork *server.InPlaceFork
 
 	invCheckPeriod uint
 
@@ -168,6 +203,10 @@ type SimApp struct {
 	EvidenceKeeper   evidencekeeper.Keeper
 	FeeGrantKeeper   feegrantkeeper.Keeper
 
+	AccountLimitsKeeper accountlimitskeeper.Keeper
+	SchedulerKeeper     schedulerkeeper.Keeper
+	ProtocolPoolKeeper  protocolpoolkeeper.Keeper
+
 	// the module manager
 	mm *module.Manager
 
@@ -208,12 +247,13 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
-		authzkeeper.StoreKey,
+		authzkeeper.StoreKey, accountlimits.StoreKey, scheduler.StoreKey,
+		protocolpool.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	// NOTE: The testingkey is just mounted for testing purposes. Actual applications should
 	// not include this key.
-	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, "testingkey")
+	memKeys := sdk.NewMemoryStoreKeys("testingkey")
 
 	app := &SimApp{
 		BaseApp:           bApp,
@@ -233,7 +273,7 @@ func NewSimApp(
 	// set the BaseApp's parameter store
 	bApp.SetParamStore(app.ParamsKeeper.Subspace(baseapp.Paramspace).WithKeyTable(paramstypes.ConsensusParamsKeyTable()))
 
-	app.CapabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey], memKeys[capabilitytypes.MemStoreKey])
+	app.CapabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey])
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
 	app.CapabilityKeeper.Seal()
@@ -241,9 +281,11 @@ func NewSimApp(
 	// add keepers
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
 		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
@@ -254,32 +296,81 @@ func NewSimApp(
 	)
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		&stakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(),
+		&stakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(), authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec, keys[slashingtypes.StoreKey], &stakingKeeper, app.GetSubspace(slashingtypes.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	app.CrisisKeeper = crisiskeeper.NewKeeper(
 		app.GetSubspace(crisistypes.ModuleName), invCheckPeriod, app.BankKeeper, authtypes.FeeCollectorName,
 	)
-
-	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
+	if period := cast.ToUint(appOpts.Get(server.FlagInvariantsAsyncCheckPeriod)); period != 0 {
+		app.CrisisKeeper.SetAsyncInvariantCheck(period, app.CommitMultiStore().(*rootmulti.Store).IsolatedCacheMultiStoreWithVersion)
+	}
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.GetSubspace(feegrant.ModuleName), app.AccountKeeper)
+	app.AccountLimitsKeeper = accountlimitskeeper.NewKeeper(
+		appCodec, keys[accountlimits.StoreKey], app.GetSubspace(accountlimits.ModuleName), app.AccountKeeper,
+	)
+	// enforce the account limits on every send of the bank keeper
+	app.BankKeeper.AppendSendRestriction(app.AccountLimitsKeeper.SendRestrictionFn)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp)
+	if cast.ToBool(appOpts.Get(server.FlagUpgradeSnapshotBeforeUpgrade)) {
+		app.UpgradeKeeper.SetPreUpgradeSnapshotScheduler(app.BaseApp)
+	}
+	if cast.ToBool(appOpts.Get(server.FlagUpgradeExportBeforeUpgrade)) {
+		app.UpgradeKeeper.SetPreUpgradeExporter(func(ctx sdk.Context) (json.RawMessage, error) {
+			return json.MarshalIndent(app.mm.ExportGenesis(ctx, app.appCodec), "", "  ")
+		})
+	}
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper = *stakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
+		stakingtypes.NewOrderedStakingHooks(
+			[]string{distrtypes.ModuleName, slashingtypes.ModuleName},
+			map[string]stakingtypes.StakingHooks{
+				distrtypes.ModuleName:    app.DistrKeeper.Hooks(),
+				slashingtypes.ModuleName: app.SlashingKeeper.Hooks(),
+			},
+		),
 	)
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.msgSvcRouter)
 
+	// the scheduler module executes the scheduled Msgs of the following types
+	// on behalf of their owners
+	app.SchedulerKeeper = schedulerkeeper.NewKeeper(
+		appCodec, keys[scheduler.StoreKey], app.GetSubspace(scheduler.ModuleName), app.AccountKeeper, app.BankKeeper,
+		authmiddleware.NewModuleMsgRouter(app.msgSvcRouter, scheduler.ModuleName, []string{
+			sdk.MsgTypeURL(&banktypes.MsgSend{}),
+			sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}),
+			sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+			sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+			sdk.MsgTypeURL(&govtypes.MsgVote{}),
+			sdk.MsgTypeURL(&authtypes.MsgUpdateParams{}),
+			sdk.MsgTypeURL(&protocolpool.MsgCreateContinuousFund{}),
+			sdk.MsgTypeURL(&protocolpool.MsgCancelContinuousFund{}),
+			sdk.MsgTypeURL(&paramproposal.MsgUpdateSubspaceParams{}),
+		}),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.ProtocolPoolKeeper = protocolpoolkeeper.NewKeeper(
+		appCodec, keys[protocolpool.StoreKey], app.GetSubspace(protocolpool.ModuleName), app.AccountKeeper, app.BankKeeper,
+		authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
+		AddRoute(banktypes.RouterKey, bank.NewSetSendEnabledProposalHandler(app.BankKeeper)).
+		AddRoute(slashingtypes.RouterKey, slashing.NewTombstoneAppealProposalHandler(app.SlashingKeeper)).
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(scheduler.RouterKey, schedulerkeeper.NewScheduleMsgProposalHandler(app.SchedulerKeeper)).
+		AddRoute(protocolpool.RouterKey, protocolpoolkeeper.NewContinuousFundProposalHandler(app.ProtocolPoolKeeper))
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
@@ -304,6 +395,10 @@ func NewSimApp(
 	// we prefer to be more strict in what arguments the modules expect.
 	var skipGenesisInvariants = cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))
 
+	// the modules only reading accounts depend on the read-only view of the
+	// account keeper, whose stores panic if written
+	readOnlyAccountKeeper := authkeeper.NewReadOnlyAccountKeeper(app.AccountKeeper)
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
@@ -319,25 +414,29 @@ func NewSimApp(
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
-		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
+		slashing.NewAppModule(appCodec, app.SlashingKeeper, readOnlyAccountKeeper, app.BankKeeper, app.StakingKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		upgrade.NewAppModule(app.UpgradeKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		params.NewAppModule(app.ParamsKeeper),
-		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, readOnlyAccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		accountlimitsmodule.NewAppModule(appCodec, app.AccountLimitsKeeper),
+		schedulermodule.NewAppModule(appCodec, app.SchedulerKeeper),
+		protocolpoolmodule.NewAppModule(appCodec, app.ProtocolPoolKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
+	// The protocolpool module takes its share of the collected fees and minted
+	// provisions between mint.BeginBlocker and distr.BeginBlocker.
 	// NOTE: staking module is required if HistoricalEntries param > 0
-	// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
 	app.mm.SetOrderBeginBlockers(
-		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
-		evidencetypes.ModuleName, stakingtypes.ModuleName,
+		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName, protocolpool.ModuleName, distrtypes.ModuleName,
+		slashingtypes.ModuleName, evidencetypes.ModuleName, stakingtypes.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName)
+	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, scheduler.ModuleName, stakingtypes.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -348,14 +447,23 @@ func NewSimApp(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
-		feegrant.ModuleName,
+		feegrant.ModuleName, accountlimits.ModuleName, scheduler.ModuleName, protocolpool.ModuleName,
 	)
 
+	app.UpgradeKeeper.SetBinaryModuleVersions(app.mm.GetVersionMap())
+
+	app.BankKeeper.RegisterModuleBalanceExpectations(stakingkeeper.ModuleBalanceExpectations(app.StakingKeeper)...)
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.legacyRouter, app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.appCodec, app.msgSvcRouter, app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
 
+	if app.moduleGenesisQueries = cast.ToBool(appOpts.Get(server.FlagModuleGenesisQueries)); app.moduleGenesisQueries {
+		modulegenesis.RegisterModuleGenesisService(app.GRPCQueryRouter(), func(ctx sdk.Context, moduleName string) (json.RawMessage, error) {
+			return app.mm.ExportModuleGenesis(ctx, app.appCodec, moduleName)
+		})
+	}
+
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
 
@@ -372,10 +480,13 @@ func NewSimApp(
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
+		slashing.NewAppModule(appCodec, app.SlashingKeeper, readOnlyAccountKeeper, app.BankKeeper, app.StakingKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
-		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, readOnlyAccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		accountlimitsmodule.NewAppModule(appCodec, app.AccountLimitsKeeper),
+		schedulermodule.NewAppModule(appCodec, app.SchedulerKeeper),
+		protocolpoolmodule.NewAppModule(appCodec, app.ProtocolPoolKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -389,7 +500,30 @@ func NewSimApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
-	app.setTxHandler(encodingConfig.TxConfig, cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents)))
+	app.inPlaceFork, _ = appOpts.Get(server.KeyInPlaceFork).(*server.InPlaceFork)
+	if retention := cast.ToInt(appOpts.Get(server.FlagGasTraceRetention)); retention > 0 {
+		app.gasTraces = sdk.NewGasTraceStore(retention)
+		gastrace.RegisterGasTraceService(app.GRPCQueryRouter(), app.gasTraces)
+	}
+	if app.rawStoreQueries = cast.ToBool(appOpts.Get(server.FlagRawStoreQueries)); app.rawStoreQueries {
+		storeKeys := make(map[string]storetypes.StoreKey, len(keys))
+		for name, key := range keys {
+			storeKeys[name] = key
+		}
+		rawstore.RegisterRawStoreService(app.GRPCQueryRouter(), storeKeys)
+	}
+
+	app.gasBreakdown = cast.ToBool(appOpts.Get(server.FlagGasBreakdown))
+	if blocks := cast.ToInt(appOpts.Get(server.FlagGasPriceBlocks)); blocks > 0 {
+		minGasPrices, err := sdk.ParseDecCoins(cast.ToString(appOpts.Get(server.FlagMinGasPrices)))
+		if err != nil {
+			panic(fmt.Errorf("invalid minimum gas prices: %w", err))
+		}
+		app.gasPrices = gasprice.NewTracker(blocks, minGasPrices)
+		gasprice.RegisterGasPriceService(app.GRPCQueryRouter(), app.gasPrices)
+	}
+
+	app.setTxHandler(encodingConfig.TxConfig, server.GetEventIndexFilter(appOpts))
 
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
@@ -400,34 +534,81 @@ func NewSimApp(
 	return app
 }
 
-func (app *SimApp) setTxHandler(txConfig client.TxConfig, indexEventsStr []string) {
-	indexEvents := map[string]struct{}{}
-	for _, e := range indexEventsStr {
-		indexEvents[e] = struct{}{}
+func (app *SimApp) setTxHandler(txConfig client.TxConfig, indexEvents sdk.EventIndexFilter) {
+	options := authmiddleware.TxHandlerOptions{
+		Debug:             app.Trace(),
+		IndexEvents:       indexEvents.Allow,
+		ModuleIndexEvents: indexEvents.Modules,
+		LegacyRouter:      app.legacyRouter,
+		MsgServiceRouter:  app.msgSvcRouter,
+		AccountKeeper:     app.AccountKeeper,
+		BankKeeper:        app.BankKeeper,
+		FeegrantKeeper:    app.FeeGrantKeeper,
+		SignModeHandler:   txConfig.SignModeHandler(),
+		SigGasConsumer:    authmiddleware.DefaultSigVerificationGasConsumer,
 	}
-	txHandler, err := authmiddleware.NewDefaultTxHandler(authmiddleware.TxHandlerOptions{
-		Debug:            app.Trace(),
-		IndexEvents:      indexEvents,
-		LegacyRouter:     app.legacyRouter,
-		MsgServiceRouter: app.msgSvcRouter,
-		AccountKeeper:    app.AccountKeeper,
-		BankKeeper:       app.BankKeeper,
-		FeegrantKeeper:   app.FeeGrantKeeper,
-		SignModeHandler:  txConfig.SignModeHandler(),
-		SigGasConsumer:   authmiddleware.DefaultSigVerificationGasConsumer,
-	})
-	if err != nil {
+	if err := options.Validate(); err != nil {
 		panic(err)
 	}
+	options.PostHandler = authmiddleware.NewDefaultPostHandler(options)
+
+	chain := TxMiddlewareChain(options)
+	if app.gasTraces != nil {
+		var err error
+		chain, err = chain.InsertAfter(authmiddleware.RecoveryMiddlewareName, authmiddleware.NamedMiddleware{
+			Name:       authmiddleware.GasTraceMiddlewareName,
+			Middleware: authmiddleware.GasTraceMiddleware(app.gasTraces),
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+	if app.gasPrices != nil {
+		var err error
+		chain, err = chain.InsertAfter(authmiddleware.RecoveryMiddlewareName, authmiddleware.NamedMiddleware{
+			Name:       authmiddleware.GasPriceTrackerMiddlewareName,
+			Middleware: authmiddleware.GasPriceTrackerMiddleware(app.gasPrices),
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+	if app.gasBreakdown {
+		var err error
+		chain, err = chain.InsertBefore(authmiddleware.RecoveryMiddlewareName, authmiddleware.NamedMiddleware{
+			Name:       authmiddleware.GasBreakdownMiddlewareName,
+			Middleware: authmiddleware.GasBreakdownMiddleware,
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
 
+	txHandler := chain.Compose(
+		authmiddleware.NewRunMsgsTxHandlerWithPostHandler(options.MsgServiceRouter, options.LegacyRouter, options.PostHandler),
+	)
 	app.SetTxHandler(txHandler)
 }
 
+// TxMiddlewareChain returns the middleware chain of the SimApp tx handler.
+// Apps can insert, replace or remove middlewares of the default chain by name
+// here.
+func TxMiddlewareChain(options authmiddleware.TxHandlerOptions) authmiddleware.MiddlewareChain {
+	return authmiddleware.NewDefaultMiddlewareChain(options)
+}
+
 // Name returns the name of the App
 func (app *SimApp) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker application updates every begin block
 func (app *SimApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	if app.inPlaceFork != nil {
+		if err := app.applyInPlaceFork(ctx, app.inPlaceFork); err != nil {
+			panic(fmt.Errorf("failed to apply the in-place fork: %w", err))
+		}
+		app.inPlaceFork = nil
+	}
+
 	return app.mm.BeginBlock(ctx, req)
 }
 
@@ -516,6 +697,21 @@ func (app *SimApp) SimulationManager() *module.SimulationManager {
 	return app.sm
 }
 
+// ModuleManager returns the module manager of the app.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *SimApp) ModuleManager() *module.Manager {
+	return app.mm
+}
+
+// Configurator returns the configurator of the modules of the app, e.g. to run
+// their migrations in an upgrade handler.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *SimApp) Configurator() module.Configurator {
+	return app.configurator
+}
+
 // RegisterAPIRoutes registers all application module routes with the provided
 // API server.
 func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
@@ -528,6 +724,20 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	// Register legacy and grpc-gateway routes for all modules.
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	apiSvr.RegisterRESTAliases(ModuleBasics.RESTAliases())
+
+	if app.gasTraces != nil {
+		gastrace.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	}
+	if app.gasPrices != nil {
+		gasprice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	}
+	if app.rawStoreQueries {
+		rawstore.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	}
+	if app.moduleGenesisQueries {
+		modulegenesis.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	}
 
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
@@ -537,7 +747,7 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *SimApp) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry, app.AccountKeeper)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -567,7 +777,7 @@ func GetMaccPerms() map[string][]string {
 
 // initParamsKeeper init params keeper and its subspaces
 func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey storetypes.StoreKey) paramskeeper.Keeper {
-	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
+	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	paramsKeeper.Subspace(authtypes.ModuleName)
 	paramsKeeper.Subspace(banktypes.ModuleName)
@@ -577,6 +787,21 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
+	paramsKeeper.Subspace(feegrant.ModuleName)
+	paramsKeeper.Subspace(accountlimits.ModuleName)
+	paramsKeeper.Subspace(scheduler.ModuleName)
+	paramsKeeper.Subspace(protocolpool.ModuleName)
+
+	// the modules still storing their parameters in x/params can be updated by
+	// MsgUpdateSubspaceParams
+	paramsKeeper.RegisterSubspaceValidator(stakingtypes.ModuleName, paramstypes.SubspaceValidator{
+		ParamSet: func() paramstypes.ParamSet { return &stakingtypes.Params{} },
+		Validate: func(_ sdk.Context, ps paramstypes.ParamSet) error { return ps.(*stakingtypes.Params).Validate() },
+	})
+	paramsKeeper.RegisterSubspaceValidator(minttypes.ModuleName, paramstypes.SubspaceValidator{
+		ParamSet: func() paramstypes.ParamSet { return &minttypes.Params{} },
+		Validate: func(_ sdk.Context, ps paramstypes.ParamSet) error { return ps.(*minttypes.Params).Validate() },
+	})
 
 	return paramsKeeper
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	accountlimitsmodule "github.com/cosmos/cosmos-sdk/x/accountlimits/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
@@ -31,6 +32,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	protocolpoolmodule "github.com/cosmos/cosmos-sdk/x/protocolpool/module"
+	schedulermodule "github.com/cosmos/cosmos-sdk/x/scheduler/module"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
@@ -127,8 +130,13 @@ func TestRunMigrations(t *testing.T) {
 			false, "", true, "no migration found for module bank from version 2 to version 3: not found", 0,
 		},
 		{
-			"can register 2->3 migration handler for x/bank, can run migration",
+			"can register 2->3 migration handler for x/bank, cannot run migration",
 			"bank", 2,
+			false, "", true, "no migration found for module bank from version 3 to version 4: not found", 0,
+		},
+		{
+			"can register 3->4 migration handler for x/bank, can run migration",
+			"bank", 3,
 			false, "", false, "", 1,
 		},
 		{
@@ -170,22 +178,25 @@ func TestRunMigrations(t *testing.T) {
 			_, err = app.mm.RunMigrations(
 				app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()}), app.configurator,
 				module.VersionMap{
-					"bank":         1,
-					"auth":         auth.AppModule{}.ConsensusVersion(),
-					"authz":        authzmodule.AppModule{}.ConsensusVersion(),
-					"staking":      staking.AppModule{}.ConsensusVersion(),
-					"mint":         mint.AppModule{}.ConsensusVersion(),
-					"distribution": distribution.AppModule{}.ConsensusVersion(),
-					"slashing":     slashing.AppModule{}.ConsensusVersion(),
-					"gov":          gov.AppModule{}.ConsensusVersion(),
-					"params":       params.AppModule{}.ConsensusVersion(),
-					"upgrade":      upgrade.AppModule{}.ConsensusVersion(),
-					"vesting":      vesting.AppModule{}.ConsensusVersion(),
-					"feegrant":     feegrantmodule.AppModule{}.ConsensusVersion(),
-					"evidence":     evidence.AppModule{}.ConsensusVersion(),
-					"crisis":       crisis.AppModule{}.ConsensusVersion(),
-					"genutil":      genutil.AppModule{}.ConsensusVersion(),
-					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"bank":          1,
+					"auth":          auth.AppModule{}.ConsensusVersion(),
+					"authz":         authzmodule.AppModule{}.ConsensusVersion(),
+					"staking":       staking.AppModule{}.ConsensusVersion(),
+					"mint":          mint.AppModule{}.ConsensusVersion(),
+					"distribution":  distribution.AppModule{}.ConsensusVersion(),
+					"slashing":      slashing.AppModule{}.ConsensusVersion(),
+					"gov":           gov.AppModule{}.ConsensusVersion(),
+					"params":        params.AppModule{}.ConsensusVersion(),
+					"upgrade":       upgrade.AppModule{}.ConsensusVersion(),
+					"vesting":       vesting.AppModule{}.ConsensusVersion(),
+					"feegrant":      feegrantmodule.AppModule{}.ConsensusVersion(),
+					"evidence":      evidence.AppModule{}.ConsensusVersion(),
+					"crisis":        crisis.AppModule{}.ConsensusVersion(),
+					"genutil":       genutil.AppModule{}.ConsensusVersion(),
+					"capability":    capability.AppModule{}.ConsensusVersion(),
+					"accountlimits": accountlimitsmodule.AppModule{}.ConsensusVersion(),
+					"scheduler":     schedulermodule.AppModule{}.ConsensusVersion(),
+					"protocolpool":  protocolpoolmodule.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -222,22 +233,25 @@ func TestInitGenesisOnMigration(t *testing.T) {
 	// the VersionMap to simulate upgrading with a new module.
 	_, err := app.mm.RunMigrations(ctx, app.configurator,
 		module.VersionMap{
-			"bank":         bank.AppModule{}.ConsensusVersion(),
-			"auth":         auth.AppModule{}.ConsensusVersion(),
-			"authz":        authzmodule.AppModule{}.ConsensusVersion(),
-			"staking":      staking.AppModule{}.ConsensusVersion(),
-			"mint":         mint.AppModule{}.ConsensusVersion(),
-			"distribution": distribution.AppModule{}.ConsensusVersion(),
-			"slashing":     slashing.AppModule{}.ConsensusVersion(),
-			"gov":          gov.AppModule{}.ConsensusVersion(),
-			"params":       params.AppModule{}.ConsensusVersion(),
-			"upgrade":      upgrade.AppModule{}.ConsensusVersion(),
-			"vesting":      vesting.AppModule{}.ConsensusVersion(),
-			"feegrant":     feegrantmodule.AppModule{}.ConsensusVersion(),
-			"evidence":     evidence.AppModule{}.ConsensusVersion(),
-			"crisis":       crisis.AppModule{}.ConsensusVersion(),
-			"genutil":      genutil.AppModule{}.ConsensusVersion(),
-			"capability":   capability.AppModule{}.ConsensusVersion(),
+			"bank":          bank.AppModule{}.ConsensusVersion(),
+			"auth":          auth.AppModule{}.ConsensusVersion(),
+			"authz":         authzmodule.AppModule{}.ConsensusVersion(),
+			"staking":       staking.AppModule{}.ConsensusVersion(),
+			"mint":          mint.AppModule{}.ConsensusVersion(),
+			"distribution":  distribution.AppModule{}.ConsensusVersion(),
+			"slashing":      slashing.AppModule{}.ConsensusVersion(),
+			"gov":           gov.AppModule{}.ConsensusVersion(),
+			"params":        params.AppModule{}.ConsensusVersion(),
+			"upgrade":       upgrade.AppModule{}.ConsensusVersion(),
+			"vesting":       vesting.AppModule{}.ConsensusVersion(),
+			"feegrant":      feegrantmodule.AppModule{}.ConsensusVersion(),
+			"evidence":      evidence.AppModule{}.ConsensusVersion(),
+			"crisis":        crisis.AppModule{}.ConsensusVersion(),
+			"genutil":       genutil.AppModule{}.ConsensusVersion(),
+			"capability":    capability.AppModule{}.ConsensusVersion(),
+			"accountlimits": accountlimitsmodule.AppModule{}.ConsensusVersion(),
+			"scheduler":     schedulermodule.AppModule{}.ConsensusVersion(),
+			"protocolpool":  protocolpoolmodule.AppModule{}.ConsensusVersion(),
 		},
 	)
 	require.NoError(t, err)
@@ -2,6 +2,7 @@ package simapp
 
 import (
 	"flag"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 )
@@ -14,6 +15,8 @@ var (
 	FlagExportParamsHeightValue int
 	FlagExportStatePathValue    string
 	FlagExportStatsPathValue    string
+	FlagExportScenarioPathValue string
+	FlagScenarioFileValue       string
 	FlagSeedValue               int64
 	FlagInitialBlockHeightValue int
 	FlagNumBlocksValue          int
@@ -22,6 +25,7 @@ var (
 	FlagCommitValue             bool
 	FlagOnOperationValue        bool // TODO: Remove in favor of binary search for invariant violation
 	FlagAllInvariantsValue      bool
+	FlagClockSkewValue          time.Duration
 
 	FlagEnabledValue     bool
 	FlagVerboseValue     bool
@@ -38,6 +42,8 @@ func GetSimulatorFlags() {
 	flag.IntVar(&FlagExportParamsHeightValue, "ExportParamsHeight", 0, "height to which export the randomly generated params")
 	flag.StringVar(&FlagExportStatePathValue, "ExportStatePath", "", "custom file path to save the exported app state JSON")
 	flag.StringVar(&FlagExportStatsPathValue, "ExportStatsPath", "", "custom file path to save the exported simulation statistics JSON")
+	flag.StringVar(&FlagExportScenarioPathValue, "ExportScenarioPath", "", "custom file path to save the blocks and txs of the simulation as a scenario JSON")
+	flag.StringVar(&FlagScenarioFileValue, "Scenario", "", "scenario file recorded with ExportScenarioPath to replay instead of simulating from a seed")
 	flag.Int64Var(&FlagSeedValue, "Seed", 42, "simulation random seed")
 	flag.IntVar(&FlagInitialBlockHeightValue, "InitialBlockHeight", 1, "initial block to start the simulation")
 	flag.IntVar(&FlagNumBlocksValue, "NumBlocks", 500, "number of new blocks to simulate from the initial block height")
@@ -46,6 +52,7 @@ func GetSimulatorFlags() {
 	flag.BoolVar(&FlagCommitValue, "Commit", false, "have the simulation commit")
 	flag.BoolVar(&FlagOnOperationValue, "SimulateEveryOperation", false, "run slow invariants every operation")
 	flag.BoolVar(&FlagAllInvariantsValue, "PrintAllInvariants", false, "print all invariants if a broken invariant is found")
+	flag.DurationVar(&FlagClockSkewValue, "ClockSkew", 0, "maximum duration the block times jump forward by at random blocks; disabled if zero")
 
 	// simulation flags
 	flag.BoolVar(&FlagEnabledValue, "Enabled", false, "enable the simulation")
@@ -63,6 +70,7 @@ func NewConfigFromFlags() simulation.Config {
 		ExportParamsHeight: FlagExportParamsHeightValue,
 		ExportStatePath:    FlagExportStatePathValue,
 		ExportStatsPath:    FlagExportStatsPathValue,
+		ExportScenarioPath: FlagExportScenarioPathValue,
 		Seed:               FlagSeedValue,
 		InitialBlockHeight: FlagInitialBlockHeightValue,
 		NumBlocks:          FlagNumBlocksValue,
@@ -71,5 +79,6 @@ func NewConfigFromFlags() simulation.Config {
 		Commit:             FlagCommitValue,
 		OnOperation:        FlagOnOperationValue,
 		AllInvariants:      FlagAllInvariantsValue,
+		ClockSkew:          FlagClockSkewValue,
 	}
 }
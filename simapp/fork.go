@@ -0,0 +1,79 @@
+package simapp
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// applyInPlaceFork applies an in-place fork to the state of the app: the
+// validator of the fork is created with tokens minted to its operator, all the
+// other validators are jailed, so that the EndBlock of the block removes them
+// from the validator set, and the governance voting period is shortened.
+func (app *SimApp) applyInPlaceFork(ctx sdk.Context, fork *server.InPlaceFork) error {
+	valAddr := sdk.ValAddress(fork.OperatorAddress)
+	if _, found := app.StakingKeeper.GetValidator(ctx, valAddr); found {
+		return fmt.Errorf("%s is already a validator", valAddr)
+	}
+
+	validator, err := stakingtypes.NewValidator(valAddr, fork.ValidatorPubKey, stakingtypes.NewDescription("in-place-fork", "", "", "", ""))
+	if err != nil {
+		return err
+	}
+	validator.Commission = stakingtypes.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+	validator.MinSelfDelegation = sdk.OneInt()
+
+	// the validators of the chain are jailed before the one of the fork is
+	// created
+	for _, val := range app.StakingKeeper.GetAllValidators(ctx) {
+		if val.IsJailed() {
+			continue
+		}
+
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		app.StakingKeeper.Jail(ctx, consAddr)
+	}
+
+	app.StakingKeeper.SetValidator(ctx, validator)
+	if err := app.StakingKeeper.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+	app.StakingKeeper.SetNewValidatorByPowerIndex(ctx, validator)
+	if err := app.StakingKeeper.AfterValidatorCreated(ctx, valAddr); err != nil {
+		return err
+	}
+
+	// the validator signs the blocks from this one on, before being bonded by
+	// the EndBlock
+	consAddr := sdk.ConsAddress(fork.ValidatorPubKey.Address())
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(
+		consAddr, ctx.BlockHeight(), 0, time.Unix(0, 0), false, 0,
+	))
+
+	// the operator keeps as many tokens as it bonds, e.g. to pay deposits
+	tokens := sdk.TokensFromConsensusPower(fork.ValidatorPower, app.StakingKeeper.PowerReduction(ctx))
+	coins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), tokens.MulRaw(2)))
+	if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, fork.OperatorAddress, coins); err != nil {
+		return err
+	}
+	if _, err := app.StakingKeeper.Delegate(ctx, fork.OperatorAddress, tokens, stakingtypes.Unbonded, validator, true); err != nil {
+		return err
+	}
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.VotingPeriod = fork.VotingPeriod
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package simapp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestApplyInPlaceFork(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+
+	validators := app.StakingKeeper.GetAllValidators(ctx)
+	require.NotEmpty(t, validators)
+
+	fork := &server.InPlaceFork{
+		ChainID:         "fork-1",
+		OperatorAddress: sdk.AccAddress("operator____________"),
+		ValidatorPubKey: ed25519.GenPrivKey().PubKey(),
+		ValidatorPower:  100,
+		VotingPeriod:    10 * time.Second,
+	}
+	require.NoError(t, app.applyInPlaceFork(ctx, fork))
+
+	for _, val := range validators {
+		val, found := app.StakingKeeper.GetValidator(ctx, val.GetOperator())
+		require.True(t, found)
+		require.True(t, val.IsJailed())
+	}
+
+	valAddr := sdk.ValAddress(fork.OperatorAddress)
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, fork.ValidatorPower), validator.Tokens)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, fork.OperatorAddress, valAddr)
+	require.True(t, found)
+	require.Equal(t, validator.Tokens, app.BankKeeper.GetBalance(ctx, fork.OperatorAddress, app.StakingKeeper.BondDenom(ctx)).Amount)
+	require.Equal(t, fork.VotingPeriod, app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+
+	// the validator of the fork replaces the ones of the chain in the EndBlock
+	updates := app.StakingKeeper.BlockValidatorUpdates(ctx)
+	require.Len(t, updates, len(validators)+1)
+
+	// the fork can't be applied twice
+	require.Error(t, app.applyInPlaceFork(ctx, fork))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"runtime/debug"
 	"strings"
 	"testing"
@@ -178,7 +179,7 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[stakingtypes.StoreKey], newApp.keys[stakingtypes.StoreKey],
 			[][]byte{
 				stakingtypes.UnbondingQueueKey, stakingtypes.RedelegationQueueKey, stakingtypes.ValidatorQueueKey,
-				stakingtypes.HistoricalInfoKey,
+				stakingtypes.HistoricalInfoKey, stakingtypes.HistoricalInfoByTimeKey,
 			}}, // ordering may change but it doesn't matter
 		{app.keys[slashingtypes.StoreKey], newApp.keys[slashingtypes.StoreKey], [][]byte{}},
 		{app.keys[minttypes.StoreKey], newApp.keys[minttypes.StoreKey], [][]byte{}},
@@ -347,3 +348,57 @@ func TestAppStateDeterminism(t *testing.T) {
 		}
 	}
 }
+
+func TestAppReplayScenario(t *testing.T) {
+	if !FlagEnabledValue {
+		t.Skip("skipping application simulation")
+	}
+
+	config := NewConfigFromFlags()
+	config.ChainID = helpers.SimAppChainID
+	config.Commit = true
+
+	newApp := func() *SimApp {
+		logger := log.NewNopLogger()
+		if FlagVerboseValue {
+			logger = log.TestingLogger()
+		}
+
+		return NewSimApp(logger, dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, FlagPeriodValue, MakeTestEncodingConfig(), EmptyAppOptions{})
+	}
+
+	scenarioPath := FlagScenarioFileValue
+	var expectedCommitID storetypes.CommitID
+
+	// record a scenario from the seed if none was given
+	if scenarioPath == "" {
+		scenarioPath = filepath.Join(t.TempDir(), "scenario.json")
+		config.ExportScenarioPath = scenarioPath
+
+		app := newApp()
+		_, _, err := simulation.SimulateFromSeed(
+			t,
+			os.Stdout,
+			app.BaseApp,
+			AppStateFn(app.AppCodec(), app.SimulationManager()),
+			simtypes.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
+			SimulationOperations(app, app.AppCodec(), config),
+			app.ModuleAccountAddrs(),
+			config,
+			app.AppCodec(),
+		)
+		require.NoError(t, err)
+
+		expectedCommitID = app.LastCommitID()
+	}
+
+	scenario, err := simulation.LoadScenario(scenarioPath)
+	require.NoError(t, err)
+
+	app := newApp()
+	simulation.ReplayScenario(os.Stdout, app.BaseApp, scenario, true)
+
+	if expectedCommitID.Version != 0 {
+		require.Equal(t, expectedCommitID, app.LastCommitID(), "replayed scenario diverged from the simulation")
+	}
+}
@@ -1,11 +1,15 @@
 package cmd_test
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/simapp/simd/cmd"
@@ -22,3 +26,44 @@ func TestInitCmd(t *testing.T) {
 
 	require.NoError(t, svrcmd.Execute(rootCmd, simapp.DefaultNodeHome))
 }
+
+func TestExecuteErrorOutput(t *testing.T) {
+	testCases := []struct {
+		name      string
+		output    string
+		expOutput string
+	}{
+		{
+			"text output",
+			"text",
+			"",
+		},
+		{
+			"json output",
+			"json",
+			`{"error":{"message":"decoding bech32 failed: invalid bech32 string length 3","exit_code":1}}`,
+		},
+		{
+			"canonical json output",
+			"canonical-json",
+			`{"error":{"exit_code":1,"message":"decoding bech32 failed: invalid bech32 string length 3"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			rootCmd, _ := cmd.NewRootCmd()
+			rootCmd.SilenceUsage = true
+			out := new(bytes.Buffer)
+			rootCmd.SetOut(out)
+			rootCmd.SetErr(io.Discard)
+			rootCmd.SetArgs([]string{"query", "bank", "balances", "foo", fmt.Sprintf("--output=%s", tc.output)})
+
+			err := svrcmd.Execute(rootCmd, simapp.DefaultNodeHome)
+			require.Error(t, err)
+			require.Equal(t, client.ExitCodeFailure, client.ExitCode(err))
+			require.Equal(t, tc.expOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package snapshots
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// archiveMetadataName is the name of the archive entry holding the snapshot
+// metadata. It is followed by one entry per chunk, named by the chunk index.
+const archiveMetadataName = "snapshot"
+
+// ExportArchive writes the snapshot of the given height and format as a tar
+// archive, which can be loaded into the snapshot store of another node with
+// ImportArchive.
+func (s *Store) ExportArchive(height uint64, format uint32, w io.Writer) error {
+	snapshot, chunks, err := s.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot for height %v format %v", height, format)
+	}
+	defer DrainChunks(chunks)
+
+	bz, err := proto.Marshal(snapshot)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to encode snapshot metadata")
+	}
+
+	tw := tar.NewWriter(w)
+	if err := writeArchiveEntry(tw, archiveMetadataName, bytes.NewReader(bz), int64(len(bz))); err != nil {
+		return err
+	}
+
+	index := uint32(0)
+	for chunk := range chunks {
+		// the chunk size must be known before writing the tar header
+		body, err := io.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to read snapshot chunk %v", index)
+		}
+
+		name := strconv.FormatUint(uint64(index), 10)
+		if err := writeArchiveEntry(tw, name, bytes.NewReader(body), int64(len(body))); err != nil {
+			return err
+		}
+		index++
+	}
+
+	return tw.Close()
+}
+
+// ImportArchive saves the snapshot contained in a tar archive written by
+// ExportArchive into the store. The chunks are checked against the hashes of
+// the archived metadata, and the snapshot is discarded if they do not match.
+func (s *Store) ImportArchive(r io.Reader) (*types.Snapshot, error) {
+	tr := tar.NewReader(r)
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to read snapshot archive")
+	}
+	if hdr.Name != archiveMetadataName {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected archive entry %q, expected %q", hdr.Name, archiveMetadataName)
+	}
+
+	bz, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to read snapshot metadata")
+	}
+
+	expected := &types.Snapshot{}
+	if err := proto.Unmarshal(bz, expected); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to decode snapshot metadata")
+	}
+
+	chunks := make(chan io.ReadCloser)
+	go func() {
+		defer close(chunks)
+		for i := uint32(0); i < expected.Chunks; i++ {
+			pr, pw := io.Pipe()
+			chunks <- pr
+
+			hdr, err := tr.Next()
+			if err == nil && hdr.Name != strconv.FormatUint(uint64(i), 10) {
+				err = sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unexpected archive entry %q for chunk %v", hdr.Name, i)
+			}
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+
+			_, err = io.Copy(pw, tr)
+			pw.CloseWithError(err)
+		}
+	}()
+
+	snapshot, err := s.Save(expected.Height, expected.Format, chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	if !proto.Equal(snapshot, expected) {
+		if err := s.Delete(snapshot.Height, snapshot.Format); err != nil {
+			return nil, err
+		}
+		return nil, sdkerrors.Wrapf(types.ErrChunkHashMismatch, "archived snapshot for height %v format %v", expected.Height, expected.Format)
+	}
+
+	return snapshot, nil
+}
+
+func writeArchiveEntry(tw *tar.Writer, name string, r io.Reader, size int64) error {
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: size}); err != nil {
+		return sdkerrors.Wrapf(err, "failed to write archive header %q", name)
+	}
+
+	if _, err := io.Copy(tw, r); err != nil {
+		return sdkerrors.Wrapf(err, "failed to write archive entry %q", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package snapshots_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+func TestStore_ExportImportArchive(t *testing.T) {
+	store := setupStore(t)
+
+	var archive bytes.Buffer
+	require.NoError(t, store.ExportArchive(2, 2, &archive))
+
+	// exporting a missing snapshot fails
+	require.Error(t, store.ExportArchive(9, 1, io.Discard))
+
+	target, err := snapshots.NewStore(db.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+
+	snapshot, err := target.ImportArchive(bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+
+	expected, err := store.Get(2, 2)
+	require.NoError(t, err)
+	require.Equal(t, expected, snapshot)
+
+	_, chunks, err := target.Load(2, 2)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{{2, 2, 0}, {2, 2, 1}, {2, 2, 2}}, readChunks(chunks))
+
+	// importing the same snapshot twice fails
+	_, err = target.ImportArchive(bytes.NewReader(archive.Bytes()))
+	require.Error(t, err)
+}
+
+func TestStore_ImportArchive_Tampered(t *testing.T) {
+	store := setupStore(t)
+
+	var archive bytes.Buffer
+	require.NoError(t, store.ExportArchive(1, 1, &archive))
+
+	// rewrite the archive with a modified last chunk
+	var tampered bytes.Buffer
+	tr := tar.NewReader(&archive)
+	tw := tar.NewWriter(&tampered)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		if hdr.Name == "1" {
+			body = []byte{9, 9, 9}
+		}
+
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: hdr.Name, Mode: 0644, Size: int64(len(body))}))
+		_, err = tw.Write(body)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+
+	target, err := snapshots.NewStore(db.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+
+	_, err = target.ImportArchive(&tampered)
+	require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+
+	snapshot, err := target.Get(1, 1)
+	require.NoError(t, err)
+	require.Nil(t, snapshot)
+}
@@ -0,0 +1,201 @@
+// Package encryption encrypts at rest the values written to a database, e.g.
+// the IAVL nodes of the stores of privacy-sensitive modules. The encryption
+// sits below the stores, so their commitments are computed on the plaintext
+// values and do not depend on whether a node encrypts its stores or not.
+//
+// The keys are kept in plaintext so that the database can still be iterated,
+// and the values are encrypted with AES-256-GCM, authenticating their key, so
+// that an encrypted value cannot be moved to another key.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+// KeySize is the size in bytes of the encryption keys.
+const KeySize = 32
+
+// NewAEAD returns the AES-256-GCM cipher of the given key.
+func NewAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("invalid encryption key size %d, expected %d", len(key), KeySize)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// DB is a database encrypting the values of an underlying database.
+type DB struct {
+	db   dbm.DB
+	aead cipher.AEAD
+}
+
+var _ dbm.DB = (*DB)(nil)
+
+// NewDB returns a database encrypting the values of the given one with the
+// given cipher.
+func NewDB(db dbm.DB, aead cipher.AEAD) *DB {
+	return &DB{db: db, aead: aead}
+}
+
+// encrypt returns the nonce and the ciphertext of the value of the key.
+func (db *DB) encrypt(key, value []byte) ([]byte, error) {
+	nonce := make([]byte, db.aead.NonceSize(), db.aead.NonceSize()+len(value)+db.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return db.aead.Seal(nonce, nonce, value, key), nil
+}
+
+// decrypt returns the value of the key from its nonce and ciphertext.
+func (db *DB) decrypt(key, bz []byte) ([]byte, error) {
+	if bz == nil {
+		return nil, nil
+	}
+	if len(bz) < db.aead.NonceSize() {
+		return nil, errors.New("encrypted value is too short")
+	}
+
+	nonce, ciphertext := bz[:db.aead.NonceSize()], bz[db.aead.NonceSize():]
+	value, err := db.aead.Open(nil, nonce, ciphertext, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt value of key %X: %w", key, err)
+	}
+
+	// the values of the keys set to empty values must not be read as missing
+	if value == nil {
+		value = []byte{}
+	}
+
+	return value, nil
+}
+
+// Get implements DB.
+func (db *DB) Get(key []byte) ([]byte, error) {
+	bz, err := db.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.decrypt(key, bz)
+}
+
+// Has implements DB.
+func (db *DB) Has(key []byte) (bool, error) {
+	return db.db.Has(key)
+}
+
+// Set implements DB.
+func (db *DB) Set(key, value []byte) error {
+	bz, err := db.encrypt(key, value)
+	if err != nil {
+		return err
+	}
+
+	return db.db.Set(key, bz)
+}
+
+// SetSync implements DB.
+func (db *DB) SetSync(key, value []byte) error {
+	bz, err := db.encrypt(key, value)
+	if err != nil {
+		return err
+	}
+
+	return db.db.SetSync(key, bz)
+}
+
+// Delete implements DB.
+func (db *DB) Delete(key []byte) error {
+	return db.db.Delete(key)
+}
+
+// DeleteSync implements DB.
+func (db *DB) DeleteSync(key []byte) error {
+	return db.db.DeleteSync(key)
+}
+
+// Iterator implements DB.
+func (db *DB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	iter, err := db.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return &iterator{Iterator: iter, db: db}, nil
+}
+
+// ReverseIterator implements DB.
+func (db *DB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	iter, err := db.db.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return &iterator{Iterator: iter, db: db}, nil
+}
+
+// Close implements DB.
+func (db *DB) Close() error {
+	return db.db.Close()
+}
+
+// NewBatch implements DB.
+func (db *DB) NewBatch() dbm.Batch {
+	return &batch{Batch: db.db.NewBatch(), db: db}
+}
+
+// Print implements DB.
+func (db *DB) Print() error {
+	return db.db.Print()
+}
+
+// Stats implements DB.
+func (db *DB) Stats() map[string]string {
+	return db.db.Stats()
+}
+
+// batch encrypts the values of the writes of an underlying batch.
+type batch struct {
+	dbm.Batch
+	db *DB
+}
+
+// Set implements Batch.
+func (b *batch) Set(key, value []byte) error {
+	bz, err := b.db.encrypt(key, value)
+	if err != nil {
+		return err
+	}
+
+	return b.Batch.Set(key, bz)
+}
+
+// iterator decrypts the values of an underlying iterator.
+type iterator struct {
+	dbm.Iterator
+	db *DB
+}
+
+// Value implements Iterator. It panics if the value cannot be decrypted, as
+// the iterators cannot return errors.
+func (it *iterator) Value() []byte {
+	value, err := it.db.decrypt(it.Key(), it.Iterator.Value())
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
@@ -0,0 +1,95 @@
+package encryption_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/encryption"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newEncryptedDB(t *testing.T, b byte) *encryption.DB {
+	aead, err := encryption.NewAEAD(bytes.Repeat([]byte{b}, encryption.KeySize))
+	require.NoError(t, err)
+
+	return encryption.NewDB(dbm.NewMemDB(), aead)
+}
+
+func TestNewAEAD(t *testing.T) {
+	_, err := encryption.NewAEAD(make([]byte, 16))
+	require.Error(t, err)
+
+	_, err = encryption.NewAEAD(make([]byte, encryption.KeySize))
+	require.NoError(t, err)
+}
+
+func TestDB(t *testing.T) {
+	raw := dbm.NewMemDB()
+	aead, err := encryption.NewAEAD(bytes.Repeat([]byte{1}, encryption.KeySize))
+	require.NoError(t, err)
+	db := encryption.NewDB(raw, aead)
+
+	require.NoError(t, db.Set([]byte("a"), []byte("secret")))
+	require.NoError(t, db.SetSync([]byte("b"), []byte{}))
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set([]byte("c"), []byte("batched")))
+	require.NoError(t, batch.Write())
+	require.NoError(t, batch.Close())
+
+	value, err := db.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret"), value)
+	value, err = db.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{}, value)
+	value, err = db.Get([]byte("missing"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	// the values are encrypted in the underlying database
+	for _, key := range []string{"a", "c"} {
+		bz, err := raw.Get([]byte(key))
+		require.NoError(t, err)
+		require.NotContains(t, string(bz), "secret")
+		require.NotContains(t, string(bz), "batched")
+	}
+
+	iter, err := db.ReverseIterator(nil, nil)
+	require.NoError(t, err)
+	var values []string
+	for ; iter.Valid(); iter.Next() {
+		values = append(values, string(iter.Value()))
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, []string{"batched", "", "secret"}, values)
+
+	// an encrypted value cannot be moved to another key
+	bz, err := raw.Get([]byte("a"))
+	require.NoError(t, err)
+	require.NoError(t, raw.Set([]byte("d"), bz))
+	_, err = db.Get([]byte("d"))
+	require.Error(t, err)
+
+	// nor be decrypted with another key
+	other, err := encryption.NewAEAD(bytes.Repeat([]byte{2}, encryption.KeySize))
+	require.NoError(t, err)
+	_, err = encryption.NewDB(raw, other).Get([]byte("a"))
+	require.Error(t, err)
+}
+
+func TestIAVLStoreCommitments(t *testing.T) {
+	plain, err := iavl.LoadStore(dbm.NewMemDB(), types.CommitID{}, false)
+	require.NoError(t, err)
+	encrypted, err := iavl.LoadStore(newEncryptedDB(t, 1), types.CommitID{}, false)
+	require.NoError(t, err)
+
+	for _, store := range []types.CommitKVStore{plain, encrypted} {
+		store.Set([]byte("key"), []byte("value"))
+	}
+
+	require.Equal(t, plain.Commit(), encrypted.Commit())
+}
@@ -198,6 +198,18 @@ func (st *Store) DeleteVersions(versions ...int64) error {
 	return st.tree.DeleteVersions(versions...)
 }
 
+// LoadVersionForOverwriting loads the given version of the tree and deletes
+// all the later versions, so that the next commit overwrites them. It returns
+// the loaded version.
+func (st *Store) LoadVersionForOverwriting(targetVersion int64) (int64, error) {
+	tree, ok := st.tree.(*iavl.MutableTree)
+	if !ok {
+		return 0, fmt.Errorf("cannot overwrite versions of an immutable IAVL tree")
+	}
+
+	return tree.LoadVersionForOverwriting(targetVersion)
+}
+
 // Implements types.KVStore.
 func (st *Store) Iterator(start, end []byte) types.Iterator {
 	var iTree *iavl.ImmutableTree
@@ -0,0 +1,59 @@
+// Package oplog implements a structured and compressed log of the operations
+// on traced KVStores, which replaces the line-delimited JSON store traces.
+//
+// An operation log is a gzip stream of length-prefixed binary records, each
+// encoding the block height, the index of the tx in the block, the name of the
+// store and the type, key and value of an operation. Logs are written with a
+// Writer, passed as the trace writer of a MultiStore, and replayed with a
+// Reader.
+package oplog
+
+import "fmt"
+
+// OpType is the type of a traced KVStore operation.
+type OpType uint8
+
+const (
+	OpRead OpType = iota + 1
+	OpWrite
+	OpDelete
+	OpIterKey
+	OpIterValue
+)
+
+// String implements the Stringer interface, using the operation names of the
+// JSON store traces.
+func (t OpType) String() string {
+	switch t {
+	case OpRead:
+		return "read"
+	case OpWrite:
+		return "write"
+	case OpDelete:
+		return "delete"
+	case OpIterKey:
+		return "iterKey"
+	case OpIterValue:
+		return "iterValue"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
+// NoTxIndex is the tx index of the operations executed outside of a tx, e.g.
+// in BeginBlock and EndBlock.
+const NoTxIndex = -1
+
+// Operation is a traced KVStore operation.
+type Operation struct {
+	// Height is the height of the block during which the operation was
+	// executed, or 0 if unknown.
+	Height int64
+	// TxIndex is the index of the tx in its block, or NoTxIndex.
+	TxIndex int64
+	// StoreKey is the name of the store, or empty if unknown.
+	StoreKey string
+	Type     OpType
+	Key      []byte
+	Value    []byte
+}
@@ -0,0 +1,102 @@
+package oplog_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/oplog"
+)
+
+func readAll(t *testing.T, r io.Reader) []oplog.Operation {
+	reader, err := oplog.NewReader(r)
+	require.NoError(t, err)
+
+	var ops []oplog.Operation
+	require.NoError(t, reader.ForEach(func(op oplog.Operation) error {
+		ops = append(ops, op)
+		return nil
+	}))
+
+	return ops
+}
+
+func TestWriteRead(t *testing.T) {
+	ops := []oplog.Operation{
+		{Height: 1, TxIndex: oplog.NoTxIndex, StoreKey: "bank", Type: oplog.OpWrite, Key: []byte("key1"), Value: []byte("value1")},
+		{Height: 1, TxIndex: 0, StoreKey: "acc", Type: oplog.OpRead, Key: []byte("key2")},
+		{Height: 2, TxIndex: 3, StoreKey: "staking", Type: oplog.OpDelete, Key: []byte("key3")},
+		{Height: 2, TxIndex: 3, StoreKey: "", Type: oplog.OpIterKey},
+	}
+
+	var buf bytes.Buffer
+	w := oplog.NewWriter(&buf)
+	for _, op := range ops {
+		require.NoError(t, w.WriteOperation(op))
+	}
+	require.NoError(t, w.Close())
+
+	require.Equal(t, ops, readAll(t, &buf))
+}
+
+func TestWriterForStore(t *testing.T) {
+	var buf bytes.Buffer
+	w := oplog.NewWriter(&buf)
+
+	bank := w.ForStore("bank").(oplog.StoreWriter)
+	require.NoError(t, bank.WriteOperation(oplog.Operation{Height: 1, Type: oplog.OpWrite, Key: []byte("a"), Value: []byte("b")}))
+	acc := bank.ForStore("acc").(oplog.StoreWriter)
+	require.NoError(t, acc.WriteOperation(oplog.Operation{Height: 1, Type: oplog.OpRead, Key: []byte("c"), Value: []byte{}}))
+
+	// raw writes are rejected
+	_, err := bank.Write([]byte("raw"))
+	require.Error(t, err)
+	require.NoError(t, w.Close())
+
+	ops := readAll(t, &buf)
+	require.Len(t, ops, 2)
+	require.Equal(t, "bank", ops[0].StoreKey)
+	require.Equal(t, "acc", ops[1].StoreKey)
+}
+
+func TestReadFlushedBlocks(t *testing.T) {
+	var buf bytes.Buffer
+	w := oplog.NewWriter(&buf)
+
+	require.NoError(t, w.WriteOperation(oplog.Operation{Height: 1, Type: oplog.OpWrite, Key: []byte("a"), Value: []byte("b")}))
+	// the operations of a block are flushed when the next block starts
+	require.NoError(t, w.WriteOperation(oplog.Operation{Height: 2, Type: oplog.OpWrite, Key: []byte("c"), Value: []byte("d")}))
+
+	reader, err := oplog.NewReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	op, err := reader.Next()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), op.Height)
+
+	// the log is still being written
+	_, err = reader.Next()
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestReadTruncated(t *testing.T) {
+	var buf bytes.Buffer
+	w := oplog.NewWriter(&buf)
+	require.NoError(t, w.WriteOperation(oplog.Operation{Height: 1, Type: oplog.OpWrite, Key: []byte("key"), Value: bytes.Repeat([]byte("v"), 1024)}))
+	require.NoError(t, w.Close())
+
+	bz := buf.Bytes()
+	reader, err := oplog.NewReader(bytes.NewReader(bz[:len(bz)/2]))
+	require.NoError(t, err)
+
+	_, err = reader.Next()
+	require.Error(t, err)
+}
+
+func TestOpTypeString(t *testing.T) {
+	require.Equal(t, "read", oplog.OpRead.String())
+	require.Equal(t, "iterValue", oplog.OpIterValue.String())
+	require.Equal(t, "unknown(0)", oplog.OpType(0).String())
+}
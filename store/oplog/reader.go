@@ -0,0 +1,152 @@
+package oplog
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// maxRecordSize bounds the size of the records read from a log, to fail fast
+// on corrupted logs.
+const maxRecordSize = 1 << 30
+
+// Reader reads the operations of an operation log in the order they were
+// written.
+type Reader struct {
+	r *bufio.Reader
+}
+
+// NewReader returns a Reader of the compressed operation log read from r.
+func NewReader(r io.Reader) (*Reader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{r: bufio.NewReader(gz)}, nil
+}
+
+// Next returns the next operation of the log, or io.EOF at the end of the log.
+// An io.ErrUnexpectedEOF error is returned if the log is truncated, e.g. if it
+// is read while being written.
+func (r *Reader) Next() (Operation, error) {
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return Operation{}, err
+	}
+	if size > maxRecordSize {
+		return Operation{}, fmt.Errorf("oplog: record of %d bytes is too large", size)
+	}
+
+	record := make([]byte, size)
+	if _, err := io.ReadFull(r.r, record); err != nil {
+		return Operation{}, unexpectedEOF(err)
+	}
+
+	return decodeOperation(record)
+}
+
+// ForEach calls fn with each remaining operation of the log, until the end of
+// the log or until fn returns an error.
+func (r *Reader) ForEach(fn func(Operation) error) error {
+	for {
+		op, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := fn(op); err != nil {
+			return err
+		}
+	}
+}
+
+func decodeOperation(record []byte) (op Operation, err error) {
+	d := decoder{buf: record}
+
+	op.Height = d.varint()
+	op.TxIndex = d.varint()
+	op.StoreKey = string(d.bytes())
+	op.Type = OpType(d.byte())
+	op.Key = d.bytes()
+	op.Value = d.bytes()
+
+	if d.err != nil {
+		return Operation{}, d.err
+	}
+	if len(d.buf) != 0 {
+		return Operation{}, errors.New("oplog: trailing bytes in record")
+	}
+
+	return op, nil
+}
+
+type decoder struct {
+	buf []byte
+	err error
+}
+
+var errInvalidRecord = errors.New("oplog: invalid record")
+
+func (d *decoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.err = errInvalidRecord
+		return 0
+	}
+
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *decoder) byte() byte {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.buf) == 0 {
+		d.err = errInvalidRecord
+		return 0
+	}
+
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+	return b
+}
+
+func (d *decoder) bytes() []byte {
+	if d.err != nil {
+		return nil
+	}
+
+	size, n := binary.Uvarint(d.buf)
+	if n <= 0 || uint64(len(d.buf)-n) < size {
+		d.err = errInvalidRecord
+		return nil
+	}
+
+	bz := d.buf[n : n+int(size)]
+	d.buf = d.buf[n+int(size):]
+	if size == 0 {
+		return nil
+	}
+
+	return bz
+}
+
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
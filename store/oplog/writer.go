@@ -0,0 +1,132 @@
+package oplog
+
+import (
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+)
+
+// Writer writes an operation log. It implements io.Writer so that it can be
+// set as the trace writer of a MultiStore, but only accepts operations written
+// with WriteOperation. It is safe for concurrent use.
+type Writer struct {
+	mu     sync.Mutex
+	out    io.Writer
+	gz     *gzip.Writer
+	height int64
+	buf    []byte
+}
+
+var _ io.WriteCloser = (*Writer)(nil)
+
+// NewWriter returns a Writer writing a compressed operation log to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{out: w, gz: gzip.NewWriter(w)}
+}
+
+// Write implements io.Writer. Raw writes are rejected, as an operation log
+// only contains operations.
+func (w *Writer) Write(_ []byte) (int, error) {
+	return 0, errors.New("oplog: raw writes are not supported, use WriteOperation")
+}
+
+// WriteOperation appends an operation to the log. The log is flushed to the
+// underlying writer when the height of the operations changes, so that the
+// operations of all the committed blocks can be read back.
+func (w *Writer) WriteOperation(op Operation) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if op.Height != w.height {
+		if err := w.gz.Flush(); err != nil {
+			return err
+		}
+		w.height = op.Height
+	}
+
+	buf := w.buf[:0]
+	buf = appendVarint(buf, op.Height)
+	buf = appendVarint(buf, op.TxIndex)
+	buf = appendBytes(buf, []byte(op.StoreKey))
+	buf = append(buf, byte(op.Type))
+	buf = appendBytes(buf, op.Key)
+	buf = appendBytes(buf, op.Value)
+	w.buf = buf
+
+	var size [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(size[:], uint64(len(buf)))
+	if _, err := w.gz.Write(size[:n]); err != nil {
+		return err
+	}
+
+	_, err := w.gz.Write(buf)
+	return err
+}
+
+// Flush flushes the operations written so far to the underlying writer.
+func (w *Writer) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.gz.Flush()
+}
+
+// Close terminates the log and closes the underlying writer if it is an
+// io.Closer.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err := w.gz.Close(); err != nil {
+		return err
+	}
+
+	if c, ok := w.out.(io.Closer); ok {
+		return c.Close()
+	}
+
+	return nil
+}
+
+// ForStore returns a writer of the operations of the store of the given name,
+// which sets the StoreKey of the operations written to the log.
+func (w *Writer) ForStore(storeKey string) io.Writer {
+	return StoreWriter{w: w, storeKey: storeKey}
+}
+
+// StoreWriter writes the operations of a single store to an operation log.
+type StoreWriter struct {
+	w        *Writer
+	storeKey string
+}
+
+// Write implements io.Writer, rejecting raw writes.
+func (sw StoreWriter) Write(p []byte) (int, error) {
+	return sw.w.Write(p)
+}
+
+// WriteOperation appends an operation of the store to the log.
+func (sw StoreWriter) WriteOperation(op Operation) error {
+	op.StoreKey = sw.storeKey
+	return sw.w.WriteOperation(op)
+}
+
+// ForStore returns a writer of the operations of the store of the given name.
+func (sw StoreWriter) ForStore(storeKey string) io.Writer {
+	return sw.w.ForStore(storeKey)
+}
+
+func appendVarint(buf []byte, v int64) []byte {
+	var bz [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(bz[:], v)
+	return append(buf, bz[:n]...)
+}
+
+func appendBytes(buf, bz []byte) []byte {
+	var size [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(size[:], uint64(len(bz)))
+	buf = append(buf, size[:n]...)
+	return append(buf, bz...)
+}
@@ -0,0 +1,310 @@
+// readonlygen generates the read-only view of a keeper interface: an interface
+// of its read-only methods, and a wrapper of the keeper implementing it, which
+// calls the keeper with read-only stores, so that a module depending on the
+// view provably does not write to the stores of the keeper.
+//
+//	readonlygen -source keeper.go -interface AccountKeeperI -name ReadOnlyAccountKeeper \
+//		-methods GetAccount,HasAccount -output readonly.go
+//
+// The view is generated in the package of the source file.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// config is the configuration of the generation of a read-only view.
+type config struct {
+	source  string
+	iface   string
+	name    string
+	methods []string
+}
+
+func main() {
+	var cfg config
+	var methods, output string
+	flag.StringVar(&cfg.source, "source", "", "Go source file declaring the keeper interface")
+	flag.StringVar(&cfg.iface, "interface", "", "name of the keeper interface")
+	flag.StringVar(&cfg.name, "name", "", "name of the read-only view interface")
+	flag.StringVar(&methods, "methods", "", "comma separated read-only methods of the keeper interface")
+	flag.StringVar(&output, "output", "", "output file")
+	flag.Parse()
+
+	cfg.methods = strings.Split(methods, ",")
+	if err := run(cfg, output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(cfg config, output string) error {
+	src, err := os.ReadFile(cfg.source)
+	if err != nil {
+		return err
+	}
+
+	bz, err := generate(cfg, src)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(output, bz, 0o644)
+}
+
+// generate returns the source of the read-only view of the keeper interface
+// declared in src.
+func generate(cfg config, src []byte) ([]byte, error) {
+	if cfg.iface == "" || cfg.name == "" || len(cfg.methods) == 0 || cfg.methods[0] == "" {
+		return nil, fmt.Errorf("the interface, name and methods are required")
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, cfg.source, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	iface, err := findInterface(file, cfg.iface)
+	if err != nil {
+		return nil, err
+	}
+
+	methods := make(map[string]*ast.Field)
+	for _, field := range iface.Methods.List {
+		for _, name := range field.Names {
+			methods[name.Name] = field
+		}
+	}
+
+	g := &generator{fset: fset, packages: make(map[string]bool)}
+	var decls, impls bytes.Buffer
+	for _, name := range cfg.methods {
+		field, ok := methods[name]
+		if !ok {
+			return nil, fmt.Errorf("interface %s has no method %s", cfg.iface, name)
+		}
+
+		if err := g.method(&decls, &impls, cfg, name, field); err != nil {
+			return nil, err
+		}
+	}
+
+	imports, err := g.imports(file)
+	if err != nil {
+		return nil, err
+	}
+
+	impl := strings.ToLower(cfg.name[:1]) + cfg.name[1:]
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by readonlygen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", file.Name.Name)
+	fmt.Fprintf(&buf, "import (\n%s)\n\n", imports)
+	fmt.Fprintf(&buf, "// %s is the read-only view of %s.\n", cfg.name, cfg.iface)
+	fmt.Fprintf(&buf, "type %s interface {\n%s}\n\n", cfg.name, decls.String())
+	fmt.Fprintf(&buf, "type %s struct {\n\tkeeper %s\n}\n\n", impl, cfg.iface)
+	fmt.Fprintf(&buf, "var _ %s = %s{}\n\n", cfg.name, impl)
+	fmt.Fprintf(&buf, "// New%s returns the read-only view of the keeper, whose methods are\n", cfg.name)
+	fmt.Fprintf(&buf, "// called with read-only stores, panicking with ErrReadOnlyWrite if written.\n")
+	fmt.Fprintf(&buf, "func New%s(keeper %s) %s {\n\treturn %s{keeper: keeper}\n}\n", cfg.name, cfg.iface, cfg.name, impl)
+	buf.Write(bytes.ReplaceAll(impls.Bytes(), []byte("$IMPL"), []byte(impl)))
+
+	return format.Source(buf.Bytes())
+}
+
+// findInterface returns the interface type of the given name declared in the
+// file.
+func findInterface(file *ast.File, name string) (*ast.InterfaceType, error) {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if ts.Name.Name != name {
+				continue
+			}
+
+			iface, ok := ts.Type.(*ast.InterfaceType)
+			if !ok {
+				return nil, fmt.Errorf("%s is not an interface", name)
+			}
+
+			return iface, nil
+		}
+	}
+
+	return nil, fmt.Errorf("interface %s not found", name)
+}
+
+type generator struct {
+	fset     *token.FileSet
+	packages map[string]bool
+}
+
+// expr returns the source of an expression, recording the packages it uses.
+func (g *generator) expr(expr ast.Expr) (string, error) {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok {
+				g.packages[x.Name] = true
+			}
+		}
+
+		return true
+	})
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, g.fset, expr); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// isContext returns whether an expression is the sdk.Context type.
+func isContext(expr ast.Expr) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Context" {
+		return false
+	}
+
+	x, ok := sel.X.(*ast.Ident)
+	return ok && x.Name == "sdk"
+}
+
+// method writes the declaration of a method in the view interface to decls and
+// its implementation calling the keeper with read-only stores to impls.
+func (g *generator) method(decls, impls *bytes.Buffer, cfg config, name string, field *ast.Field) error {
+	fn := field.Type.(*ast.FuncType)
+
+	var params, args []string
+	var i int
+	for _, param := range fn.Params.List {
+		typ, err := g.expr(param.Type)
+		if err != nil {
+			return err
+		}
+
+		n := len(param.Names)
+		if n == 0 {
+			n = 1
+		}
+		for j := 0; j < n; j++ {
+			arg := "arg" + strconv.Itoa(i)
+			if len(param.Names) > 0 && param.Names[j].Name != "_" {
+				arg = param.Names[j].Name
+			}
+			if isContext(param.Type) && len(param.Names) == 0 {
+				arg = "ctx"
+				if i > 0 {
+					arg += strconv.Itoa(i)
+				}
+			}
+			call := arg
+			if isContext(param.Type) {
+				call = arg + ".WithReadOnlyStores()"
+			}
+			if _, ok := param.Type.(*ast.Ellipsis); ok {
+				call += "..."
+			}
+
+			params = append(params, arg+" "+typ)
+			args = append(args, call)
+			i++
+		}
+	}
+
+	var results string
+	if fn.Results != nil {
+		var types []string
+		for _, result := range fn.Results.List {
+			typ, err := g.expr(result.Type)
+			if err != nil {
+				return err
+			}
+
+			n := len(result.Names)
+			if n == 0 {
+				n = 1
+			}
+			for j := 0; j < n; j++ {
+				types = append(types, typ)
+			}
+		}
+
+		results = strings.Join(types, ", ")
+		if len(types) > 1 {
+			results = "(" + results + ")"
+		}
+	}
+
+	if field.Doc != nil {
+		for _, comment := range field.Doc.List {
+			fmt.Fprintf(decls, "\t%s\n", comment.Text)
+		}
+	}
+	fmt.Fprintf(decls, "\t%s(%s) %s\n", name, strings.Join(params, ", "), results)
+
+	ret := "return "
+	if results == "" {
+		ret = ""
+	}
+	fmt.Fprintf(impls, "\n// %s implements %s.\n", name, cfg.name)
+	fmt.Fprintf(impls, "func (k $IMPL) %s(%s) %s {\n\t%sk.keeper.%s(%s)\n}\n", name, strings.Join(params, ", "), results, ret, name, strings.Join(args, ", "))
+
+	return nil
+}
+
+// imports returns the import specs of the file of the packages used by the
+// generated methods.
+func (g *generator) imports(file *ast.File) (string, error) {
+	var specs []string
+	found := make(map[string]bool)
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return "", err
+		}
+
+		// the packages are assumed to be named after the last element of
+		// their path unless imported with a name
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if !g.packages[name] {
+			continue
+		}
+
+		found[name] = true
+		if spec.Name != nil {
+			specs = append(specs, fmt.Sprintf("\t%s %s\n", spec.Name.Name, spec.Path.Value))
+		} else {
+			specs = append(specs, fmt.Sprintf("\t%s\n", spec.Path.Value))
+		}
+	}
+
+	for name := range g.packages {
+		if !found[name] {
+			return "", fmt.Errorf("import of package %s not found", name)
+		}
+	}
+
+	sort.Strings(specs)
+	return strings.Join(specs, ""), nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerateAuth checks that the committed read-only view of the auth keeper
+// is up to date with its interface.
+func TestGenerateAuth(t *testing.T) {
+	src, err := os.ReadFile("../../../../x/auth/keeper/keeper.go")
+	require.NoError(t, err)
+
+	bz, err := generate(config{
+		source:  "keeper.go",
+		iface:   "AccountKeeperI",
+		name:    "ReadOnlyAccountKeeper",
+		methods: []string{"HasAccount", "GetAccount", "IterateAccounts", "GetPubKey", "GetSequence"},
+	}, src)
+	require.NoError(t, err)
+
+	expected, err := os.ReadFile("../../../../x/auth/keeper/readonly.go")
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(bz), "run go generate ./x/auth/keeper")
+}
+
+func TestGenerate(t *testing.T) {
+	src := []byte(`package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type Keeper interface {
+	// GetBalance returns the balance.
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	HasBalances(sdk.Context, ...types.Balance) bool
+	SetBalance(sdk.Context, types.Balance)
+}
+`)
+
+	cfg := config{
+		source:  "keeper.go",
+		iface:   "Keeper",
+		name:    "ReadOnlyKeeper",
+		methods: []string{"GetBalance", "HasBalances"},
+	}
+	bz, err := generate(cfg, src)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "\t// GetBalance returns the balance.\n\tGetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin\n")
+	require.Contains(t, string(bz), "return k.keeper.GetBalance(ctx.WithReadOnlyStores(), addr, denom)")
+	require.Contains(t, string(bz), "return k.keeper.HasBalances(ctx.WithReadOnlyStores(), arg1...)")
+	require.Contains(t, string(bz), "\t\"github.com/cosmos/cosmos-sdk/x/bank/types\"\n")
+	require.NotContains(t, string(bz), "SetBalance")
+
+	cfg.methods = []string{"GetBalances"}
+	_, err = generate(cfg, src)
+	require.EqualError(t, err, "interface Keeper has no method GetBalances")
+
+	cfg.iface = "Other"
+	_, err = generate(cfg, src)
+	require.EqualError(t, err, "interface Other not found")
+}
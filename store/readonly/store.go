@@ -0,0 +1,146 @@
+// Package readonly wraps stores to reject their writes, so that a module given
+// read-only access to the state, e.g. through a read-only keeper, provably does
+// not write to it. As the stores cannot return errors, the writes panic with
+// ErrReadOnlyWrite, which Recover turns back into an error.
+package readonly
+
+import (
+	"errors"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Store is a KVStore whose writes panic with ErrReadOnlyWrite.
+type Store struct {
+	types.KVStore
+}
+
+var _ types.KVStore = Store{}
+
+// NewStore returns the read-only view of the store.
+func NewStore(store types.KVStore) Store {
+	if ro, ok := store.(Store); ok {
+		return ro
+	}
+
+	return Store{KVStore: store}
+}
+
+// Set implements KVStore. It panics with ErrReadOnlyWrite.
+func (s Store) Set(key, _ []byte) {
+	panic(sdkerrors.Wrapf(types.ErrReadOnlyWrite, "set key %X", key))
+}
+
+// Delete implements KVStore. It panics with ErrReadOnlyWrite.
+func (s Store) Delete(key []byte) {
+	panic(sdkerrors.Wrapf(types.ErrReadOnlyWrite, "delete key %X", key))
+}
+
+// CacheWrap implements KVStore. The branch panics when written to the store.
+func (s Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements KVStore.
+func (s Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// CacheWrapWithListeners implements KVStore.
+func (s Store) CacheWrapWithListeners(storeKey types.StoreKey, listeners []types.WriteListener) types.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(s, storeKey, listeners))
+}
+
+// MultiStore is a MultiStore whose KVStores are read-only.
+type MultiStore struct {
+	types.MultiStore
+}
+
+var _ types.MultiStore = MultiStore{}
+
+// NewMultiStore returns the read-only view of the multi store.
+func NewMultiStore(ms types.MultiStore) MultiStore {
+	if ro, ok := ms.(MultiStore); ok {
+		return ro
+	}
+
+	return MultiStore{MultiStore: ms}
+}
+
+// GetKVStore implements MultiStore.
+func (ms MultiStore) GetKVStore(key types.StoreKey) types.KVStore {
+	return NewStore(ms.MultiStore.GetKVStore(key))
+}
+
+// GetStore implements MultiStore.
+func (ms MultiStore) GetStore(key types.StoreKey) types.Store {
+	store := ms.MultiStore.GetStore(key)
+	if kv, ok := store.(types.KVStore); ok {
+		return NewStore(kv)
+	}
+
+	return store
+}
+
+// CacheMultiStore implements MultiStore. The KVStores of the branch are
+// read-only too.
+func (ms MultiStore) CacheMultiStore() types.CacheMultiStore {
+	return cacheMultiStore{MultiStore: NewMultiStore(ms.MultiStore.CacheMultiStore())}
+}
+
+// CacheMultiStoreWithVersion implements MultiStore.
+func (ms MultiStore) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	cms, err := ms.MultiStore.CacheMultiStoreWithVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return cacheMultiStore{MultiStore: NewMultiStore(cms)}, nil
+}
+
+// SetTracer implements MultiStore.
+func (ms MultiStore) SetTracer(w io.Writer) types.MultiStore {
+	return NewMultiStore(ms.MultiStore.SetTracer(w))
+}
+
+// SetTracingContext implements MultiStore.
+func (ms MultiStore) SetTracingContext(tc types.TraceContext) types.MultiStore {
+	return NewMultiStore(ms.MultiStore.SetTracingContext(tc))
+}
+
+// cacheMultiStore is the read-only branch of a multi store, which has nothing to
+// write.
+type cacheMultiStore struct {
+	MultiStore
+}
+
+var _ types.CacheMultiStore = cacheMultiStore{}
+
+// Write implements CacheMultiStore.
+func (cacheMultiStore) Write() {}
+
+// Recover calls fn and returns the error of its panic if it wrote to a
+// read-only store, and re-panics on the other panics.
+func Recover(fn func()) (err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		if rerr, ok := r.(error); ok && errors.Is(rerr, types.ErrReadOnlyWrite) {
+			err = rerr
+			return
+		}
+
+		panic(r)
+	}()
+
+	fn()
+	return nil
+}
@@ -0,0 +1,72 @@
+package readonly_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/readonly"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestStore(t *testing.T) {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent.Set([]byte("key"), []byte("value"))
+
+	store := readonly.NewStore(parent)
+	require.Equal(t, store, readonly.NewStore(store))
+	require.Equal(t, []byte("value"), store.Get([]byte("key")))
+	require.True(t, store.Has([]byte("key")))
+
+	iter := store.Iterator(nil, nil)
+	require.True(t, iter.Valid())
+	require.Equal(t, []byte("key"), iter.Key())
+	require.NoError(t, iter.Close())
+
+	err := readonly.Recover(func() { store.Set([]byte("key"), []byte("other")) })
+	require.True(t, errors.Is(err, types.ErrReadOnlyWrite))
+	err = readonly.Recover(func() { store.Delete([]byte("key")) })
+	require.True(t, errors.Is(err, types.ErrReadOnlyWrite))
+	require.Equal(t, []byte("value"), parent.Get([]byte("key")))
+
+	// the branches can be written to but not written back
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set([]byte("key"), []byte("other"))
+	require.Equal(t, []byte("other"), cache.Get([]byte("key")))
+	err = readonly.Recover(cache.Write)
+	require.True(t, errors.Is(err, types.ErrReadOnlyWrite))
+	require.Equal(t, []byte("value"), parent.Get([]byte("key")))
+}
+
+func TestRecover(t *testing.T) {
+	require.NoError(t, readonly.Recover(func() {}))
+	require.PanicsWithValue(t, "other", func() {
+		_ = readonly.Recover(func() { panic("other") })
+	})
+}
+
+func TestMultiStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	key := types.NewKVStoreKey("store")
+	parent := rootmulti.NewStore(db)
+	parent.MountStoreWithDB(key, types.StoreTypeIAVL, db)
+	require.NoError(t, parent.LoadLatestVersion())
+	parent.GetKVStore(key).Set([]byte("key"), []byte("value"))
+
+	ms := readonly.NewMultiStore(parent)
+	require.Equal(t, []byte("value"), ms.GetKVStore(key).Get([]byte("key")))
+	err := readonly.Recover(func() { ms.GetKVStore(key).Set([]byte("key"), []byte("other")) })
+	require.True(t, errors.Is(err, types.ErrReadOnlyWrite))
+
+	// the KVStores of the branches are read-only too
+	cms := ms.CacheMultiStore()
+	require.Equal(t, []byte("value"), cms.GetKVStore(key).Get([]byte("key")))
+	err = readonly.Recover(func() { cms.GetKVStore(key).Delete([]byte("key")) })
+	require.True(t, errors.Is(err, types.ErrReadOnlyWrite))
+	cms.Write()
+	require.Equal(t, []byte("value"), parent.GetKVStore(key).Get([]byte("key")))
+}
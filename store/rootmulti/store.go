@@ -3,6 +3,7 @@ package rootmulti
 import (
 	"bufio"
 	"compress/zlib"
+	"crypto/cipher"
 	"encoding/binary"
 	"fmt"
 	"io"
@@ -21,9 +22,11 @@ import (
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/encryption"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/mem"
+	"github.com/cosmos/cosmos-sdk/store/telemetrykv"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -61,7 +64,12 @@ type Store struct {
 
 	interBlockCache types.MultiStorePersistentCache
 
+	// encryption ciphers of the stores encrypted at rest, by store name
+	encryption map[string]cipher.AEAD
+
 	listeners map[types.StoreKey][]types.WriteListener
+
+	metricsEnabled bool
 }
 
 var (
@@ -83,6 +91,7 @@ func NewStore(db dbm.DB) *Store {
 		pruneHeights: make([]int64, 0),
 		listeners:    make(map[types.StoreKey][]types.WriteListener),
 		removalMap:   make(map[types.StoreKey]bool),
+		encryption:   make(map[string]cipher.AEAD),
 	}
 }
 
@@ -150,7 +159,7 @@ func (rs *Store) GetCommitKVStore(key types.StoreKey) types.CommitKVStore {
 
 // LoadLatestVersionAndUpgrade implements CommitMultiStore
 func (rs *Store) LoadLatestVersionAndUpgrade(upgrades *types.StoreUpgrades) error {
-	ver := getLatestVersion(rs.db)
+	ver := GetLatestVersion(rs.db)
 	return rs.loadVersion(ver, upgrades)
 }
 
@@ -161,7 +170,7 @@ func (rs *Store) LoadVersionAndUpgrade(ver int64, upgrades *types.StoreUpgrades)
 
 // LoadLatestVersion implements CommitMultiStore.
 func (rs *Store) LoadLatestVersion() error {
-	ver := getLatestVersion(rs.db)
+	ver := GetLatestVersion(rs.db)
 	return rs.loadVersion(ver, nil)
 }
 
@@ -178,7 +187,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	// load old data if we are not version 0
 	if ver != 0 {
 		var err error
-		cInfo, err = getCommitInfo(rs.db, ver)
+		cInfo, err = GetCommitInfo(rs.db, ver)
 		if err != nil {
 			return err
 		}
@@ -311,6 +320,22 @@ func (rs *Store) SetInterBlockCache(c types.MultiStorePersistentCache) {
 	rs.interBlockCache = c
 }
 
+// SetStoreEncryption encrypts at rest the values of the IAVL and DB stores of
+// the given names with the given cipher, see the encryption package. It must
+// be called before the stores are loaded, and the stores must have been
+// encrypted since their creation, as their unencrypted values cannot be read.
+func (rs *Store) SetStoreEncryption(aead cipher.AEAD, storeNames ...string) {
+	for _, name := range storeNames {
+		rs.encryption[name] = aead
+	}
+}
+
+// SetMetricsEnabled implements CommitMultiStore. When enabled, operations on
+// the underlying KVStores are counted and measured per store key.
+func (rs *Store) SetMetricsEnabled(enabled bool) {
+	rs.metricsEnabled = enabled
+}
+
 // SetTracer sets the tracer for the MultiStore that the underlying
 // stores will utilize to trace operations. A MultiStore is returned.
 func (rs *Store) SetTracer(w io.Writer) types.MultiStore {
@@ -360,7 +385,7 @@ func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
 func (rs *Store) LastCommitID() types.CommitID {
 	if rs.lastCommitInfo == nil {
 		return types.CommitID{
-			Version: getLatestVersion(rs.db),
+			Version: GetLatestVersion(rs.db),
 		}
 	}
 
@@ -425,6 +450,30 @@ func (rs *Store) Commit() types.CommitID {
 	}
 }
 
+// RollbackToVersion deletes all the versions of the IAVL stores later than the
+// target version, and makes the target the latest version of the multistore.
+func (rs *Store) RollbackToVersion(target int64) error {
+	if target <= 0 {
+		return fmt.Errorf("invalid rollback height target: %d", target)
+	}
+
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeIAVL {
+			// If the store is wrapped with an inter-block cache, we must first unwrap
+			// it to get the underlying IAVL store.
+			store = rs.GetCommitKVStore(key)
+
+			if _, err := store.(*iavl.Store).LoadVersionForOverwriting(target); err != nil {
+				return fmt.Errorf("failed to rollback store %s: %w", key.Name(), err)
+			}
+		}
+	}
+
+	flushMetadata(rs.db, target, rs.buildCommitInfo(target), rs.pruneHeights)
+
+	return rs.LoadLatestVersion()
+}
+
 // pruneStores will batch delete a list of heights from each mounted sub-store.
 // Afterwards, pruneHeights is reset.
 func (rs *Store) pruneStores() {
@@ -469,7 +518,7 @@ func (rs *Store) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListene
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
-		stores[k] = v
+		stores[k] = rs.withMetrics(k, v)
 	}
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext, rs.listeners)
 }
@@ -494,16 +543,47 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 				return nil, err
 			}
 
-			cachedStores[key] = iavlStore
+			cachedStores[key] = rs.withMetrics(key, iavlStore)
 
 		default:
-			cachedStores[key] = store
+			cachedStores[key] = rs.withMetrics(key, store)
 		}
 	}
 
 	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.traceContext, rs.listeners), nil
 }
 
+// IsolatedCacheMultiStoreWithVersion returns a branch of the IAVL stores at the
+// given version sharing no mutable state with the root store, so that it can be
+// read concurrently with block execution once returned, e.g. by background
+// checks. It has no tracing, listeners nor metrics, and the stores which aren't
+// versioned, e.g. the transient and memory stores, are replaced by empty ones.
+func (rs *Store) IsolatedCacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
+	for key, store := range rs.stores {
+		switch store.GetStoreType() {
+		case types.StoreTypeIAVL:
+			iavlStore, err := rs.GetCommitKVStore(key).(*iavl.Store).GetImmutable(version)
+			if err != nil {
+				return nil, err
+			}
+
+			cachedStores[key] = iavlStore
+
+		case types.StoreTypeTransient:
+			cachedStores[key] = transient.NewStore()
+
+		case types.StoreTypeMemory:
+			cachedStores[key] = mem.NewStore()
+
+		default:
+			cachedStores[key] = dbadapter.Store{DB: dbm.NewMemDB()}
+		}
+	}
+
+	return cachemulti.NewStore(dbm.NewMemDB(), cachedStores, rs.keysByName, nil, nil, nil), nil
+}
+
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
 // not exist, it will panic. If the Store is wrapped in an inter-block cache, it
 // will be unwrapped prior to being returned.
@@ -530,10 +610,10 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if s == nil {
 		panic(fmt.Sprintf("store does not exist for key: %s", key.Name()))
 	}
-	store := s.(types.KVStore)
+	store := rs.withMetrics(key, s.(types.KVStore))
 
 	if rs.TracingEnabled() {
-		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
+		store = tracekv.NewStore(store, tracekv.WriterForStore(rs.traceWriter, key.Name()), rs.traceContext)
 	}
 	if rs.ListeningEnabled(key) {
 		store = listenkv.NewStore(store, key, rs.listeners[key])
@@ -542,6 +622,16 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	return store
 }
 
+// withMetrics wraps the given store in a telemetry KVStore if metrics are
+// enabled, otherwise the store is returned as-is.
+func (rs *Store) withMetrics(key types.StoreKey, store types.KVStore) types.KVStore {
+	if !rs.metricsEnabled {
+		return store
+	}
+
+	return telemetrykv.NewStore(store, key)
+}
+
 // getStoreByName performs a lookup of a StoreKey given a store name typically
 // provided in a path. The StoreKey is then used to perform a lookup and return
 // a Store. If the Store is wrapped in an inter-block cache, it will be unwrapped
@@ -596,7 +686,7 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 	if res.Height == rs.lastCommitInfo.Version {
 		commitInfo = rs.lastCommitInfo
 	} else {
-		commitInfo, err = getCommitInfo(rs.db, res.Height)
+		commitInfo, err = GetCommitInfo(rs.db, res.Height)
 		if err != nil {
 			return sdkerrors.QueryResult(err, false)
 		}
@@ -886,6 +976,10 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		db = dbm.NewPrefixDB(rs.db, []byte(prefix))
 	}
 
+	if aead, ok := rs.encryption[key.Name()]; ok {
+		db = encryption.NewDB(db, aead)
+	}
+
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
@@ -960,7 +1054,8 @@ type storeParams struct {
 	initialVersion uint64
 }
 
-func getLatestVersion(db dbm.DB) int64 {
+// GetLatestVersion returns the latest version committed to the given database.
+func GetLatestVersion(db dbm.DB) int64 {
 	bz, err := db.Get([]byte(latestVersionKey))
 	if err != nil {
 		panic(err)
@@ -1002,8 +1097,8 @@ func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore
 	}
 }
 
-// Gets commitInfo from disk.
-func getCommitInfo(db dbm.DB, ver int64) (*types.CommitInfo, error) {
+// GetCommitInfo gets commitInfo from disk.
+func GetCommitInfo(db dbm.DB, ver int64) (*types.CommitInfo, error) {
 	cInfoKey := fmt.Sprintf(commitInfoKeyFmt, ver)
 
 	bz, err := db.Get([]byte(cInfoKey))
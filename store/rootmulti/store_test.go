@@ -20,9 +20,11 @@ import (
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/encryption"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	sdkmaps "github.com/cosmos/cosmos-sdk/store/internal/maps"
 	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/telemetrykv"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -212,7 +214,7 @@ func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	expectedCommitID := getExpectedCommitID(store, 1)
 	checkStore(t, store, expectedCommitID, commitID)
 
-	ci, err := getCommitInfo(db, 1)
+	ci, err := GetCommitInfo(db, 1)
 	require.NoError(t, err)
 	require.Equal(t, int64(1), ci.Version)
 	require.Equal(t, 3, len(ci.StoreInfos))
@@ -296,7 +298,7 @@ func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	require.Equal(t, v4, rl4.Get(k4))
 
 	// check commitInfo in storage
-	ci, err = getCommitInfo(db, 2)
+	ci, err = GetCommitInfo(db, 2)
 	require.NoError(t, err)
 	require.Equal(t, int64(2), ci.Version)
 	require.Equal(t, 3, len(ci.StoreInfos), ci.StoreInfos)
@@ -356,7 +358,7 @@ func TestMultiStoreRestart(t *testing.T) {
 
 		multi.Commit()
 
-		cinfo, err := getCommitInfo(multi.db, int64(i))
+		cinfo, err := GetCommitInfo(multi.db, int64(i))
 		require.NoError(t, err)
 		require.Equal(t, int64(i), cinfo.Version)
 	}
@@ -371,7 +373,7 @@ func TestMultiStoreRestart(t *testing.T) {
 
 	multi.Commit()
 
-	flushedCinfo, err := getCommitInfo(multi.db, 3)
+	flushedCinfo, err := GetCommitInfo(multi.db, 3)
 	require.Nil(t, err)
 	require.NotEqual(t, initCid, flushedCinfo, "CID is different after flush to disk")
 
@@ -381,7 +383,7 @@ func TestMultiStoreRestart(t *testing.T) {
 
 	multi.Commit()
 
-	postFlushCinfo, err := getCommitInfo(multi.db, 4)
+	postFlushCinfo, err := GetCommitInfo(multi.db, 4)
 	require.NoError(t, err)
 	require.Equal(t, int64(4), postFlushCinfo.Version, "Commit changed after in-memory commit")
 
@@ -789,6 +791,51 @@ func TestGetListenWrappedKVStore(t *testing.T) {
 	require.Equal(t, []byte{}, kvPairDelete3Bytes)
 }
 
+func TestRollbackToVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	var commitIDs []types.CommitID
+	for i := byte(1); i <= 5; i++ {
+		ms.GetKVStore(testStoreKey1).Set([]byte("key"), []byte{i})
+		commitIDs = append(commitIDs, ms.Commit())
+	}
+
+	require.Error(t, ms.RollbackToVersion(0))
+	require.NoError(t, ms.RollbackToVersion(3))
+	require.Equal(t, commitIDs[2], ms.LastCommitID())
+	require.Equal(t, int64(3), GetLatestVersion(db))
+	require.Equal(t, []byte{3}, ms.GetKVStore(testStoreKey1).Get([]byte("key")))
+
+	// the rolled back versions are overwritten by new commits
+	ms = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, commitIDs[2], ms.LastCommitID())
+	ms.GetKVStore(testStoreKey1).Set([]byte("key"), []byte{9})
+	commitID := ms.Commit()
+	require.Equal(t, int64(4), commitID.Version)
+	require.NotEqual(t, commitIDs[3].Hash, commitID.Hash)
+}
+
+func TestGetMetricsWrappedKVStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	require.IsType(t, &iavl.Store{}, ms.GetKVStore(testStoreKey1))
+
+	ms.SetMetricsEnabled(true)
+	store := ms.GetKVStore(testStoreKey1)
+	require.IsType(t, &telemetrykv.Store{}, store)
+
+	// writes on a branch reach the underlying store on Write
+	cms := ms.CacheMultiStore()
+	cms.GetKVStore(testStoreKey1).Set(testKey1, testValue1)
+	cms.Write()
+	require.Equal(t, testValue1, store.Get(testKey1))
+}
+
 func TestCacheWraps(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -876,6 +923,47 @@ func benchmarkMultistoreSnapshotRestore(b *testing.B, stores uint8, storeKeys ui
 	}
 }
 
+func TestStoreEncryption(t *testing.T) {
+	aead, err := encryption.NewAEAD(bytes.Repeat([]byte{1}, encryption.KeySize))
+	require.NoError(t, err)
+
+	plainDB, encryptedDB := dbm.NewMemDB(), dbm.NewMemDB()
+	plain := newMultiStoreWithMounts(plainDB, types.PruneNothing)
+	encrypted := newMultiStoreWithMounts(encryptedDB, types.PruneNothing)
+	encrypted.SetStoreEncryption(aead, testStoreKey1.Name())
+
+	for _, store := range []*Store{plain, encrypted} {
+		require.NoError(t, store.LoadLatestVersion())
+		store.getStoreByName("store1").(types.KVStore).Set([]byte("key"), []byte("secret value"))
+		store.getStoreByName("store2").(types.KVStore).Set([]byte("key"), []byte("public value"))
+	}
+
+	// the commitments do not depend on the encryption
+	require.Equal(t, plain.Commit(), encrypted.Commit())
+
+	contains := func(db dbm.DB, value string) bool {
+		iter, err := db.Iterator(nil, nil)
+		require.NoError(t, err)
+		defer iter.Close()
+
+		for ; iter.Valid(); iter.Next() {
+			if bytes.Contains(iter.Value(), []byte(value)) {
+				return true
+			}
+		}
+		return false
+	}
+	require.True(t, contains(plainDB, "secret value"))
+	require.False(t, contains(encryptedDB, "secret value"))
+	require.True(t, contains(encryptedDB, "public value"))
+
+	// the encrypted store is read back with the same cipher
+	reloaded := newMultiStoreWithMounts(encryptedDB, types.PruneNothing)
+	reloaded.SetStoreEncryption(aead, testStoreKey1.Name())
+	require.NoError(t, reloaded.LoadLatestVersion())
+	require.Equal(t, []byte("secret value"), reloaded.getStoreByName("store1").(types.KVStore).Get([]byte("key")))
+}
+
 //-----------------------------------------------------------------------
 // utils
 
@@ -0,0 +1,126 @@
+package telemetrykv
+
+import (
+	"io"
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Metric keys emitted by the Store. Every metric is labeled with the module,
+// i.e. the name of the store key.
+const (
+	MetricKeyStore    = "store"
+	MetricKeyRead     = "read"
+	MetricKeyWrite    = "write"
+	MetricKeyDelete   = "delete"
+	MetricKeyIterate  = "iterate"
+	MetricKeyBytes    = "bytes"
+	MetricKeyDuration = "duration"
+)
+
+var _ types.KVStore = &Store{}
+
+// Store implements the KVStore interface with telemetry enabled. Each core
+// KVStore operation increments a counter and measures its latency, labeled by
+// the module owning the store.
+type Store struct {
+	parent types.KVStore
+	module string
+	labels []metrics.Label
+}
+
+// NewStore returns a reference to a new telemetry KVStore given a parent
+// KVStore implementation and the key of the store.
+func NewStore(parent types.KVStore, storeKey types.StoreKey) *Store {
+	return &Store{
+		parent: parent,
+		module: storeKey.Name(),
+		labels: []metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNameModule, storeKey.Name())},
+	}
+}
+
+// Get implements the KVStore interface. It counts and measures a read
+// operation and delegates a Get call to the parent KVStore.
+func (s *Store) Get(key []byte) []byte {
+	defer s.measureSince(time.Now(), MetricKeyRead)
+	value := s.parent.Get(key)
+	s.incrCounter(float32(len(value)), MetricKeyRead, MetricKeyBytes)
+
+	return value
+}
+
+// Has implements the KVStore interface. It counts and measures a read
+// operation and delegates a Has call to the parent KVStore.
+func (s *Store) Has(key []byte) bool {
+	defer s.measureSince(time.Now(), MetricKeyRead)
+	return s.parent.Has(key)
+}
+
+// Set implements the KVStore interface. It counts and measures a write
+// operation and delegates the Set call to the parent KVStore.
+func (s *Store) Set(key []byte, value []byte) {
+	defer s.measureSince(time.Now(), MetricKeyWrite)
+	types.AssertValidKey(key)
+	s.parent.Set(key, value)
+	s.incrCounter(float32(len(key)+len(value)), MetricKeyWrite, MetricKeyBytes)
+}
+
+// Delete implements the KVStore interface. It counts and measures a delete
+// operation and delegates the Delete call to the parent KVStore.
+func (s *Store) Delete(key []byte) {
+	defer s.measureSince(time.Now(), MetricKeyDelete)
+	s.parent.Delete(key)
+}
+
+// Iterator implements the KVStore interface. It counts an iterate operation
+// and delegates the Iterator call to the parent KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	s.incrCounter(1, MetricKeyIterate)
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface. It counts an iterate
+// operation and delegates the ReverseIterator call to the parent KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	s.incrCounter(1, MetricKeyIterate)
+	return s.parent.ReverseIterator(start, end)
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. The branch reads from and flushes
+// its writes to the telemetry store, so that only operations reaching the
+// underlying store are measured.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// CacheWrapWithListeners implements the KVStore interface.
+func (s *Store) CacheWrapWithListeners(storeKey types.StoreKey, listeners []types.WriteListener) types.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(s, storeKey, listeners))
+}
+
+func (s *Store) incrCounter(val float32, keys ...string) {
+	telemetry.IncrCounterWithLabels(append([]string{MetricKeyStore}, keys...), val, s.labels)
+}
+
+func (s *Store) measureSince(start time.Time, op string) {
+	s.incrCounter(1, op)
+	telemetry.ModuleMeasureSince(s.module, start, MetricKeyStore, op, MetricKeyDuration)
+}
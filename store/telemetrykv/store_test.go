@@ -0,0 +1,76 @@
+package telemetrykv_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/telemetrykv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var testStoreKey = types.NewKVStoreKey("bank")
+
+func newTelemetryKVStore(t *testing.T) (*telemetrykv.Store, *metrics.InmemSink) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	return telemetrykv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, testStoreKey), sink
+}
+
+func counterValue(sink *metrics.InmemSink, name string) float64 {
+	for _, interval := range sink.Data() {
+		for key, counter := range interval.Counters {
+			if strings.HasPrefix(key, name) && strings.Contains(key, "module=bank") {
+				return counter.Sum
+			}
+		}
+	}
+
+	return 0
+}
+
+func TestTelemetryKVStoreOperations(t *testing.T) {
+	store, sink := newTelemetryKVStore(t)
+
+	store.Set([]byte("key1"), []byte("value1"))
+	store.Set([]byte("key2"), []byte("value2"))
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+	require.True(t, store.Has([]byte("key2")))
+	store.Delete([]byte("key2"))
+
+	iter := store.Iterator(nil, nil)
+	require.True(t, iter.Valid())
+	require.NoError(t, iter.Close())
+
+	require.Equal(t, 2.0, counterValue(sink, "test.store.write;"))
+	require.Equal(t, 20.0, counterValue(sink, "test.store.write.bytes;"))
+	require.Equal(t, 2.0, counterValue(sink, "test.store.read;"))
+	require.Equal(t, 1.0, counterValue(sink, "test.store.delete;"))
+	require.Equal(t, 1.0, counterValue(sink, "test.store.iterate;"))
+}
+
+func TestTelemetryKVStoreCacheWrap(t *testing.T) {
+	store, sink := newTelemetryKVStore(t)
+
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set([]byte("key1"), []byte("value1"))
+	require.Equal(t, []byte("value1"), cache.Get([]byte("key1")))
+
+	// operations served by the cache do not reach the underlying store
+	require.Zero(t, counterValue(sink, "test.store.write;"))
+	require.Zero(t, counterValue(sink, "test.store.read;"))
+
+	cache.Write()
+	require.Equal(t, 1.0, counterValue(sink, "test.store.write;"))
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io"
 
+	"github.com/cosmos/cosmos-sdk/store/oplog"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -178,9 +179,17 @@ func (tkv *Store) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListen
 	panic("cannot CacheWrapWithListeners a TraceKVStore")
 }
 
-// writeOperation writes a KVStore operation to the underlying io.Writer as
-// JSON-encoded data where the key/value pair is base64 encoded.
+// writeOperation writes a KVStore operation to the underlying io.Writer. It is
+// written as a structured operation if the writer is an OperationWriter, and
+// as JSON-encoded data where the key/value pair is base64 encoded otherwise.
 func writeOperation(w io.Writer, op operation, tc types.TraceContext, key, value []byte) {
+	if ow, ok := w.(OperationWriter); ok {
+		if err := ow.WriteOperation(newLogOperation(op, tc, key, value)); err != nil {
+			panic(errors.Wrap(err, "failed to write trace operation"))
+		}
+		return
+	}
+
 	traceOp := traceOperation{
 		Operation: op,
 		Key:       base64.StdEncoding.EncodeToString(key),
@@ -202,3 +211,54 @@ func writeOperation(w io.Writer, op operation, tc types.TraceContext, key, value
 
 	io.WriteString(w, "\n")
 }
+
+// OperationWriter is implemented by the trace writers writing structured
+// operations, such as oplog.Writer, instead of line-delimited JSON.
+type OperationWriter interface {
+	WriteOperation(op oplog.Operation) error
+}
+
+// WriterForStore returns the trace writer of the store of the given name. If
+// the writer can attribute operations to stores, such as oplog.Writer, a
+// writer of the operations of that store is returned.
+func WriterForStore(w io.Writer, storeKey string) io.Writer {
+	if sw, ok := w.(interface{ ForStore(string) io.Writer }); ok {
+		return sw.ForStore(storeKey)
+	}
+
+	return w
+}
+
+var opTypes = map[operation]oplog.OpType{
+	readOp:      oplog.OpRead,
+	writeOp:     oplog.OpWrite,
+	deleteOp:    oplog.OpDelete,
+	iterKeyOp:   oplog.OpIterKey,
+	iterValueOp: oplog.OpIterValue,
+}
+
+// newLogOperation returns the operation log entry of a traced operation. The
+// block height and tx index are read from the blockHeight and txIndex keys of
+// the trace context.
+func newLogOperation(op operation, tc types.TraceContext, key, value []byte) oplog.Operation {
+	return oplog.Operation{
+		Height:  traceContextInt(tc, "blockHeight", 0),
+		TxIndex: traceContextInt(tc, "txIndex", oplog.NoTxIndex),
+		Type:    opTypes[op],
+		Key:     key,
+		Value:   value,
+	}
+}
+
+func traceContextInt(tc types.TraceContext, key string, defaultValue int64) int64 {
+	switch v := tc[key].(type) {
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case uint64:
+		return int64(v)
+	default:
+		return defaultValue
+	}
+}
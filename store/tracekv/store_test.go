@@ -11,6 +11,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/oplog"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -296,3 +297,29 @@ func TestTraceKVStoreCacheWrapWithListeners(t *testing.T) {
 	store := newEmptyTraceKVStore(nil)
 	require.Panics(t, func() { store.CacheWrapWithListeners(nil, nil) })
 }
+
+func TestTraceKVStoreOpLog(t *testing.T) {
+	var buf bytes.Buffer
+	w := oplog.NewWriter(&buf)
+
+	store := newTraceKVStore(tracekv.WriterForStore(w, "bank"))
+	store.Get(kvPairs[0].Key)
+	store.Delete(kvPairs[1].Key)
+	require.NoError(t, w.Close())
+
+	reader, err := oplog.NewReader(&buf)
+	require.NoError(t, err)
+
+	var ops []oplog.Operation
+	require.NoError(t, reader.ForEach(func(op oplog.Operation) error {
+		ops = append(ops, op)
+		return nil
+	}))
+
+	require.Len(t, ops, len(kvPairs)+2)
+	require.Equal(t, oplog.Operation{
+		Height: 64, TxIndex: oplog.NoTxIndex, StoreKey: "bank", Type: oplog.OpRead, Key: kvPairs[0].Key, Value: kvPairs[0].Value,
+	}, ops[len(kvPairs)])
+	require.Equal(t, oplog.OpDelete, ops[len(kvPairs)+1].Type)
+	require.Equal(t, kvPairs[1].Key, ops[len(kvPairs)+1].Key)
+}
@@ -8,4 +8,8 @@ const StoreCodespace = "store"
 
 var (
 	ErrInvalidProof = sdkerrors.Register(StoreCodespace, 2, "invalid proof")
+
+	// ErrReadOnlyWrite is the error of the panics of the writes to read-only
+	// stores.
+	ErrReadOnlyWrite = sdkerrors.Register(StoreCodespace, 3, "write to read-only store")
 )
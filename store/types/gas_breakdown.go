@@ -0,0 +1,140 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Categories of the gas consumed through a ChildGasMeter.
+const (
+	GasCategoryRead    = "read"
+	GasCategoryWrite   = "write"
+	GasCategoryIterate = "iterate"
+	GasCategoryOther   = "other"
+)
+
+// GasDescriptorCategory returns the category of the gas consumed with the
+// given descriptor: the store accesses are split in reads, writes and
+// iterations, and any other consumption, e.g. for signature verification, is
+// in the other category.
+func GasDescriptorCategory(descriptor string) string {
+	switch descriptor {
+	case GasReadCostFlatDesc, GasReadPerByteDesc, GasHasDesc:
+		return GasCategoryRead
+	case GasWriteCostFlatDesc, GasWritePerByteDesc, GasDeleteDesc:
+		return GasCategoryWrite
+	case GasIterNextCostFlatDesc, GasValuePerByteDesc:
+		return GasCategoryIterate
+	default:
+		return GasCategoryOther
+	}
+}
+
+// ChildGasMeter is a gas meter consuming gas on a parent meter, which records
+// the gas consumed through it by category. Child meters can be attached to it,
+// e.g. one per message of a tx, to break the gas consumed down further. The
+// limit and gas consumed reported by a ChildGasMeter are the ones of its
+// parent, so that it can replace the parent meter in a Context.
+//
+// A ChildGasMeter is not safe for concurrent use.
+type ChildGasMeter struct {
+	GasMeter
+
+	name       string
+	consumed   Gas
+	categories map[string]Gas
+	children   []*ChildGasMeter
+}
+
+var _ GasMeter = &ChildGasMeter{}
+
+// NewChildGasMeter returns a new ChildGasMeter of the given name consuming gas
+// on the given parent meter.
+func NewChildGasMeter(parent GasMeter, name string) *ChildGasMeter {
+	return &ChildGasMeter{
+		GasMeter:   parent,
+		name:       name,
+		categories: make(map[string]Gas),
+	}
+}
+
+// Child returns a new meter of the given name consuming gas through this one.
+func (g *ChildGasMeter) Child(name string) *ChildGasMeter {
+	child := NewChildGasMeter(g, name)
+	g.children = append(g.children, child)
+
+	return child
+}
+
+// ConsumeGas consumes gas on the parent meter, and records it in the category
+// of the descriptor. The gas is recorded even if the parent meter runs out of
+// gas, so that the breakdown shows what exhausted it.
+func (g *ChildGasMeter) ConsumeGas(amount Gas, descriptor string) {
+	category := GasDescriptorCategory(descriptor)
+	if consumed, overflow := addUint64Overflow(g.consumed, amount); !overflow {
+		g.consumed = consumed
+		g.categories[category] += amount
+	}
+
+	g.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// RefundGas refunds gas on the parent meter, and deducts it from the category
+// of the descriptor.
+func (g *ChildGasMeter) RefundGas(amount Gas, descriptor string) {
+	g.GasMeter.RefundGas(amount, descriptor)
+
+	category := GasDescriptorCategory(descriptor)
+	g.consumed -= minGas(g.consumed, amount)
+	g.categories[category] -= minGas(g.categories[category], amount)
+}
+
+// String returns the name of the meter and the gas consumed through it.
+func (g *ChildGasMeter) String() string {
+	return fmt.Sprintf("ChildGasMeter:\n  name: %s\n  consumed: %d", g.name, g.consumed)
+}
+
+// Breakdown returns the gas consumed through the meter and its children.
+func (g *ChildGasMeter) Breakdown() GasBreakdown {
+	breakdown := GasBreakdown{
+		Name:       g.name,
+		GasUsed:    g.consumed,
+		Categories: make([]GasCategoryUsage, 0, len(g.categories)),
+	}
+
+	for category, gas := range g.categories {
+		breakdown.Categories = append(breakdown.Categories, GasCategoryUsage{Category: category, GasUsed: gas})
+	}
+	sort.Slice(breakdown.Categories, func(i, j int) bool {
+		return breakdown.Categories[i].Category < breakdown.Categories[j].Category
+	})
+
+	for _, child := range g.children {
+		breakdown.Children = append(breakdown.Children, child.Breakdown())
+	}
+
+	return breakdown
+}
+
+// GasBreakdown is the gas consumed through a ChildGasMeter, by category, along
+// with the breakdowns of its children, in the order they were attached. The gas
+// consumed through the children is included in the gas of their parent.
+type GasBreakdown struct {
+	Name       string
+	GasUsed    Gas
+	Categories []GasCategoryUsage
+	Children   []GasBreakdown
+}
+
+// GasCategoryUsage is the gas consumed in a category.
+type GasCategoryUsage struct {
+	Category string
+	GasUsed  Gas
+}
+
+func minGas(a, b Gas) Gas {
+	if a < b {
+		return a
+	}
+	return b
+}
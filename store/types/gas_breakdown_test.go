@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChildGasMeter(t *testing.T) {
+	t.Parallel()
+	parent := NewGasMeter(10000)
+	meter := NewChildGasMeter(parent, "tx")
+	meter.ConsumeGas(100, "txSize")
+
+	msg := meter.Child("msg_0")
+	msg.ConsumeGas(1000, GasReadCostFlatDesc)
+	msg.ConsumeGas(2000, GasWriteCostFlatDesc)
+	msg.ConsumeGas(30, GasIterNextCostFlatDesc)
+	msg.RefundGas(500, GasWriteCostFlatDesc)
+
+	// the child meters report the limit and gas consumed of the root meter
+	require.Equal(t, Gas(2630), parent.GasConsumed())
+	require.Equal(t, parent.GasConsumed(), msg.GasConsumed())
+	require.Equal(t, Gas(10000), msg.Limit())
+
+	require.Equal(t, GasBreakdown{
+		Name:    "tx",
+		GasUsed: 2630,
+		Categories: []GasCategoryUsage{
+			{Category: GasCategoryIterate, GasUsed: 30},
+			{Category: GasCategoryOther, GasUsed: 100},
+			{Category: GasCategoryRead, GasUsed: 1000},
+			{Category: GasCategoryWrite, GasUsed: 1500},
+		},
+		Children: []GasBreakdown{{
+			Name:    "msg_0",
+			GasUsed: 2530,
+			Categories: []GasCategoryUsage{
+				{Category: GasCategoryIterate, GasUsed: 30},
+				{Category: GasCategoryRead, GasUsed: 1000},
+				{Category: GasCategoryWrite, GasUsed: 1500},
+			},
+		}},
+	}, meter.Breakdown())
+
+	// the gas exhausting the root meter is recorded
+	require.Panics(t, func() { msg.ConsumeGas(10000, GasWriteCostFlatDesc) })
+	require.Equal(t, Gas(12530), msg.Breakdown().GasUsed)
+}
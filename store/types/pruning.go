@@ -8,6 +8,7 @@ const (
 	PruningOptionEverything = "everything"
 	PruningOptionNothing    = "nothing"
 	PruningOptionCustom     = "custom"
+	PruningOptionSnapshots  = "snapshots"
 )
 
 var (
@@ -48,6 +49,19 @@ func NewPruningOptions(keepRecent, keepEvery, interval uint64) PruningOptions {
 	}
 }
 
+// NewSnapshotPruningOptions returns the pruning strategy of the nodes serving
+// state sync snapshots, where all heights are pruned except the keepRecent
+// last ones and the heights of the snapshots taken every snapshotInterval
+// heights. The to-be pruned heights are pruned at every interval height, or at
+// every 10th height if interval is zero.
+func NewSnapshotPruningOptions(keepRecent, snapshotInterval, interval uint64) PruningOptions {
+	if interval == 0 {
+		interval = PruneEverything.Interval
+	}
+
+	return NewPruningOptions(keepRecent, snapshotInterval, interval)
+}
+
 func (po PruningOptions) Validate() error {
 	if po.KeepEvery == 0 && po.Interval == 0 {
 		return fmt.Errorf("invalid 'Interval' when pruning everything: %d", po.Interval)
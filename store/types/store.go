@@ -178,6 +178,9 @@ type CommitMultiStore interface {
 	// StoreKeys to CommitKVStores.
 	SetInterBlockCache(MultiStorePersistentCache)
 
+	// SetMetricsEnabled enables per store key telemetry of KVStore operations.
+	SetMetricsEnabled(enabled bool)
+
 	// SetInitialVersion sets the initial version of the IAVL tree. It is used when
 	// starting a new chain at an arbitrary height.
 	SetInitialVersion(version int64) error
@@ -0,0 +1,201 @@
+// Package migration migrates the state of a store into a store of another
+// engine, e.g. from an IAVL store into a store v2 flat store, either offline
+// or progressively across blocks while the chain keeps running.
+//
+// During a progressive migration, the module store is wrapped by a Store
+// which copies a batch of keys from the source into the target store at each
+// block, e.g. in EndBlock, and which serves the reads of the migrated keys
+// from the target store. The writes are applied to both stores, so the source
+// store stays complete until the migration is done, and its progress must be
+// persisted by the app to resume it after a restart.
+package migration
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = (*Store)(nil)
+
+// Progress is the progress of the migration of a store.
+type Progress struct {
+	// Next is the next key of the source store to migrate, nil if no key has
+	// been migrated yet.
+	Next []byte
+
+	// Done is true once all the keys of the source store are migrated.
+	Done bool
+}
+
+// Migrated returns whether the given key is in the range of the keys already
+// migrated.
+func (p Progress) Migrated(key []byte) bool {
+	return p.Done || (p.Next != nil && bytes.Compare(key, p.Next) < 0)
+}
+
+// Store is a KVStore migrating the state of a source store into a target
+// store, see the package documentation.
+type Store struct {
+	source   types.KVStore
+	target   types.KVStore
+	progress Progress
+}
+
+// NewStore returns a store migrating the source store into the target one,
+// resuming the migration from the given progress.
+func NewStore(source, target types.KVStore, progress Progress) *Store {
+	return &Store{
+		source:   source,
+		target:   target,
+		progress: progress,
+	}
+}
+
+// Progress returns the progress of the migration.
+func (s *Store) Progress() Progress {
+	return s.progress
+}
+
+// MigrateBatch copies at most n keys of the source store into the target
+// store, returning the number of migrated keys.
+func (s *Store) MigrateBatch(n int) int {
+	if s.progress.Done {
+		return 0
+	}
+
+	iter := s.source.Iterator(s.progress.Next, nil)
+	defer iter.Close()
+
+	// the keys are collected first, as no writes may happen while iterating
+	var keys, values [][]byte
+	for ; iter.Valid() && len(keys) < n; iter.Next() {
+		keys = append(keys, iter.Key())
+		values = append(values, iter.Value())
+	}
+
+	if iter.Valid() {
+		s.progress.Next = append([]byte(nil), iter.Key()...)
+	} else {
+		s.progress = Progress{Done: true}
+	}
+
+	for i, key := range keys {
+		s.target.Set(key, values[i])
+	}
+
+	return len(keys)
+}
+
+// Verify returns an error if the contents of the source and the target stores
+// differ. It must only be called once the migration is done.
+func (s *Store) Verify() error {
+	if !s.progress.Done {
+		return fmt.Errorf("migration is not done, next key to migrate is %X", s.progress.Next)
+	}
+
+	sourceHash, targetHash := Hash(s.source), Hash(s.target)
+	if !bytes.Equal(sourceHash, targetHash) {
+		return fmt.Errorf("target store hash %X does not match source store hash %X", targetHash, sourceHash)
+	}
+
+	return nil
+}
+
+// Hash returns the hash of the contents of the store, independent of its
+// engine, so that the contents of the stores of different engines can be
+// compared.
+func Hash(store types.KVStore) []byte {
+	h := sha256.New()
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var size [binary.MaxVarintLen64]byte
+	for ; iter.Valid(); iter.Next() {
+		for _, bz := range [][]byte{iter.Key(), iter.Value()} {
+			n := binary.PutUvarint(size[:], uint64(len(bz)))
+			h.Write(size[:n])
+			h.Write(bz)
+		}
+	}
+
+	return h.Sum(nil)
+}
+
+// store returns the store serving the reads of the given key.
+func (s *Store) store(key []byte) types.KVStore {
+	if s.progress.Migrated(key) {
+		return s.target
+	}
+
+	return s.source
+}
+
+// Get implements KVStore.
+func (s *Store) Get(key []byte) []byte {
+	return s.store(key).Get(key)
+}
+
+// Has implements KVStore.
+func (s *Store) Has(key []byte) bool {
+	return s.store(key).Has(key)
+}
+
+// Set implements KVStore.
+func (s *Store) Set(key, value []byte) {
+	s.source.Set(key, value)
+	s.target.Set(key, value)
+}
+
+// Delete implements KVStore.
+func (s *Store) Delete(key []byte) {
+	s.source.Delete(key)
+	s.target.Delete(key)
+}
+
+// Iterator implements KVStore. The iterations are served by the source store
+// until the migration is done.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	if s.progress.Done {
+		return s.target.Iterator(start, end)
+	}
+
+	return s.source.Iterator(start, end)
+}
+
+// ReverseIterator implements KVStore. The iterations are served by the source
+// store until the migration is done.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	if s.progress.Done {
+		return s.target.ReverseIterator(start, end)
+	}
+
+	return s.source.ReverseIterator(start, end)
+}
+
+// GetStoreType implements Store.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.source.GetStoreType()
+}
+
+// CacheWrap implements CacheWrapper.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// CacheWrapWithListeners implements CacheWrapper.
+func (s *Store) CacheWrapWithListeners(storeKey types.StoreKey, listeners []types.WriteListener) types.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(s, storeKey, listeners))
+}
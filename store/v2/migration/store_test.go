@@ -0,0 +1,69 @@
+package migration_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/v2/flat"
+	"github.com/cosmos/cosmos-sdk/store/v2/migration"
+)
+
+func TestStoreMigration(t *testing.T) {
+	source := dbadapter.Store{DB: tmdb.NewMemDB()}
+	for i := 0; i < 10; i++ {
+		source.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+
+	target, err := flat.NewStore(memdb.NewDB(), flat.DefaultStoreConfig)
+	require.NoError(t, err)
+
+	store := migration.NewStore(source, target, migration.Progress{})
+	require.Error(t, store.Verify())
+
+	require.Equal(t, 4, store.MigrateBatch(4))
+	require.Equal(t, migration.Progress{Next: []byte("key4")}, store.Progress())
+	require.Equal(t, []byte("value3"), target.Get([]byte("key3")))
+	require.Nil(t, target.Get([]byte("key4")))
+
+	// reads of the migrated keys are served by the target store
+	target.Set([]byte("key0"), []byte("target"))
+	require.Equal(t, []byte("target"), store.Get([]byte("key0")))
+	store.Set([]byte("key0"), []byte("value0"))
+
+	// writes are applied to both stores
+	store.Set([]byte("key5"), []byte("updated"))
+	store.Delete([]byte("key6"))
+	store.Delete([]byte("key1"))
+	require.Equal(t, []byte("updated"), source.Get([]byte("key5")))
+	require.False(t, source.Has([]byte("key6")))
+	require.False(t, target.Has([]byte("key1")))
+
+	// the migration resumes from its progress
+	store = migration.NewStore(source, target, store.Progress())
+	require.Equal(t, 4, store.MigrateBatch(4))
+	require.False(t, store.Progress().Done)
+	require.Equal(t, 1, store.MigrateBatch(4))
+	require.True(t, store.Progress().Done)
+	require.Equal(t, 0, store.MigrateBatch(4))
+
+	require.NoError(t, store.Verify())
+	require.Equal(t, []byte("updated"), store.Get([]byte("key5")))
+
+	target.Set([]byte("key9"), []byte("corrupted"))
+	require.Error(t, store.Verify())
+}
+
+func TestHash(t *testing.T) {
+	a := dbadapter.Store{DB: tmdb.NewMemDB()}
+	b := dbadapter.Store{DB: tmdb.NewMemDB()}
+	require.Equal(t, migration.Hash(a), migration.Hash(b))
+
+	a.Set([]byte("ab"), []byte("c"))
+	b.Set([]byte("a"), []byte("bc"))
+	require.NotEqual(t, migration.Hash(a), migration.Hash(b))
+}
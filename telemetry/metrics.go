@@ -42,6 +42,10 @@ type Config struct {
 	// Enable adding service to labels
 	EnableServiceLabel bool `mapstructure:"enable-service-label"`
 
+	// EnableStoreMetrics enables counters and latency measures of the KVStore
+	// operations, labeled by module.
+	EnableStoreMetrics bool `mapstructure:"enable-store-metrics"`
+
 	// PrometheusRetentionTime, when positive, enables a Prometheus metrics sink.
 	// It defines the retention duration in seconds.
 	PrometheusRetentionTime int64 `mapstructure:"prometheus-retention-time"`
@@ -61,6 +65,8 @@ type Config struct {
 // dump of formatted recent metrics will be sent to STDERR.
 type Metrics struct {
 	memSink           *metrics.InmemSink
+	memSignal         *metrics.InmemSignal
+	sinks             metrics.FanoutSink
 	prometheusEnabled bool
 }
 
@@ -90,9 +96,9 @@ func New(cfg Config) (*Metrics, error) {
 	metricsConf.EnableHostnameLabel = cfg.EnableHostnameLabel
 
 	memSink := metrics.NewInmemSink(10*time.Second, time.Minute)
-	metrics.DefaultInmemSignal(memSink)
+	memSignal := metrics.DefaultInmemSignal(memSink)
 
-	m := &Metrics{memSink: memSink}
+	m := &Metrics{memSink: memSink, memSignal: memSignal}
 	fanout := metrics.FanoutSink{memSink}
 
 	if cfg.PrometheusRetentionTime > 0 {
@@ -113,9 +119,23 @@ func New(cfg Config) (*Metrics, error) {
 		return nil, err
 	}
 
+	m.sinks = fanout
 	return m, nil
 }
 
+// Close flushes the metrics buffered by the sinks, e.g. the ones of a statsd
+// sink, and stops the sinks and the dump of the metrics on SIGUSR1. It is
+// called on shutdown, once no metrics are emitted anymore.
+func (m *Metrics) Close() {
+	m.memSignal.Stop()
+
+	for _, sink := range m.sinks {
+		if s, ok := sink.(interface{ Shutdown() }); ok {
+			s.Shutdown()
+		}
+	}
+}
+
 // Gather collects all registered metrics and returns a GatherResponse where the
 // metrics are encoded depending on the type. Metrics are either encoded via
 // Prometheus or JSON if in-memory.
@@ -74,3 +74,24 @@ func emitMetrics() {
 		}
 	}
 }
+
+// shutdownSink is a sink recording whether it was shut down.
+type shutdownSink struct {
+	metrics.BlackholeSink
+	shutdown bool
+}
+
+func (s *shutdownSink) Shutdown() { s.shutdown = true }
+
+func TestMetrics_Close(t *testing.T) {
+	memSink := metrics.NewInmemSink(10*time.Second, time.Minute)
+	sink := &shutdownSink{}
+	m := &Metrics{
+		memSink:   memSink,
+		memSignal: metrics.DefaultInmemSignal(memSink),
+		sinks:     metrics.FanoutSink{memSink, sink},
+	}
+
+	m.Close()
+	require.True(t, sink.shutdown)
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"errors"
 
 	"github.com/spf13/cobra"
 
@@ -10,6 +11,8 @@ import (
 )
 
 // ExecTestCLICmd builds the client context, mocks the output and executes the command.
+// The client.TxError of a tx which failed isn't returned, as its response, and
+// code, is written to the output.
 func ExecTestCLICmd(clientCtx client.Context, cmd *cobra.Command, extraArgs []string) (testutil.BufferWriter, error) {
 	cmd.SetArgs(extraArgs)
 
@@ -19,7 +22,7 @@ func ExecTestCLICmd(clientCtx client.Context, cmd *cobra.Command, extraArgs []st
 	ctx := context.Background()
 	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
 
-	if err := cmd.ExecuteContext(ctx); err != nil {
+	if err := cmd.ExecuteContext(ctx); err != nil && !errors.As(err, &client.TxError{}) {
 		return out, err
 	}
 
@@ -0,0 +1,188 @@
+//go:build go1.18
+// +build go1.18
+
+// Package fuzz provides native Go fuzzing harnesses for the tx decoding, the
+// sign bytes generation and the tx handler middlewares, so that apps can fuzz
+// their custom decoders and middlewares against malformed inputs, e.g.
+//
+//	func FuzzTxHandler(f *testing.F) {
+//		fuzz.AddCorpusDir(f, "testdata/txs")
+//		fuzz.TxHandler(f, txConfig.TxDecoder(), func(t *testing.T) (sdk.Context, tx.Handler) {
+//			app := setupApp(t)
+//			return app.NewContext(false, tmproto.Header{}), app.TxHandler()
+//		})
+//	}
+//
+// The harnesses fail on panics only: malformed inputs are expected to be
+// rejected with an error.
+package fuzz
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// ChainID is the chain ID of the sign bytes generated by SignBytes.
+const ChainID = "fuzz-chain"
+
+// AddTxs adds the given txs, encoded with the given encoder, to the seed
+// corpus of the fuzz test.
+func AddTxs(f *testing.F, encoder sdk.TxEncoder, txs ...sdk.Tx) {
+	f.Helper()
+
+	for _, tx := range txs {
+		bz, err := encoder(tx)
+		if err != nil {
+			f.Fatalf("failed to encode seed tx: %v", err)
+		}
+
+		f.Add(bz)
+	}
+}
+
+// AddCorpusDir adds the content of each file of the given directory to the
+// seed corpus of the fuzz test, e.g. the encoded txs collected from a chain
+// or the crashers of a previous fuzzing run. A missing directory is skipped.
+func AddCorpusDir(f *testing.F, dir string) {
+	f.Helper()
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		f.Fatalf("failed to read corpus directory %s: %v", dir, err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		bz, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			f.Fatalf("failed to read corpus file %s: %v", entry.Name(), err)
+		}
+
+		f.Add(bz)
+	}
+}
+
+// TxDecoder fuzzes the given tx decoder. Decoding the inputs and reading the
+// decoded txs must not panic. The signers of the txs are only read once their
+// ValidateBasic succeeds, as the Msgs of the SDK panic on invalid signers.
+func TxDecoder(f *testing.F, decoder sdk.TxDecoder) {
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		decode(t, decoder, bz)
+	})
+}
+
+// SignBytes fuzzes the sign bytes generation of the given sign mode handler,
+// for all its sign modes, with the txs decoded by the given decoder. Getting
+// the sign bytes of the txs passing ValidateBasic must not panic.
+func SignBytes(f *testing.F, decoder sdk.TxDecoder, handler signing.SignModeHandler) {
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		tx, ok := decode(t, decoder, bz)
+		if !ok {
+			return
+		}
+
+		sigTx, ok := tx.(signing.Tx)
+		if !ok {
+			return
+		}
+
+		for i, signer := range sigTx.GetSigners() {
+			data := signing.SignerData{
+				Address:       signer.String(),
+				ChainID:       ChainID,
+				AccountNumber: uint64(i),
+				SignerIndex:   i,
+			}
+
+			for _, mode := range handler.Modes() {
+				_, _ = handler.GetSignBytes(mode, data, tx)
+			}
+		}
+	})
+}
+
+// TxHandler fuzzes the tx handler returned by the given setup function, e.g.
+// the middlewares of an app, with the txs decoded by the given decoder. Each
+// tx is checked and delivered on a branch of the state of the context returned
+// by the setup function, and neither must panic, nor be rejected with ErrPanic
+// by a recovery middleware. The setup function is called once per fuzzing
+// process, with the test of its first input, so it must not register cleanups
+// on the test.
+func TxHandler(f *testing.F, decoder sdk.TxDecoder, setup func(t *testing.T) (sdk.Context, txtypes.Handler)) {
+	var (
+		once    sync.Once
+		ctx     sdk.Context
+		handler txtypes.Handler
+	)
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		once.Do(func() { ctx, handler = setup(t) })
+
+		tx, err := decoder(bz)
+		if err != nil {
+			return
+		}
+
+		checkCtx, _ := ctx.WithIsCheckTx(true).CacheContext()
+		_, err = handler.CheckTx(sdk.WrapSDKContext(checkCtx), tx, abci.RequestCheckTx{Tx: bz, Type: abci.CheckTxType_New})
+		requireNoPanic(t, "CheckTx", err)
+
+		deliverCtx, _ := ctx.WithIsCheckTx(false).CacheContext()
+		_, err = handler.DeliverTx(sdk.WrapSDKContext(deliverCtx), tx, abci.RequestDeliverTx{Tx: bz})
+		requireNoPanic(t, "DeliverTx", err)
+	})
+}
+
+// decode decodes the given bytes and reads the decoded tx, returning whether
+// the tx passes ValidateBasic.
+func decode(t *testing.T, decoder sdk.TxDecoder, bz []byte) (sdk.Tx, bool) {
+	tx, err := decoder(bz)
+	if err != nil {
+		return nil, false
+	}
+	if tx == nil {
+		t.Fatal("decoder returned a nil tx without error")
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if msg == nil {
+			t.Fatal("decoded tx has a nil msg")
+		}
+	}
+
+	if err := tx.ValidateBasic(); err != nil {
+		return nil, false
+	}
+	for _, msg := range tx.GetMsgs() {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, false
+		}
+		_ = msg.GetSigners()
+	}
+
+	return tx, true
+}
+
+// requireNoPanic fails the test if the given error is a panic recovered by a
+// recovery middleware.
+func requireNoPanic(t *testing.T, method string, err error) {
+	codespace, code, _ := sdkerrors.ABCIInfo(err, true)
+	if codespace == sdkerrors.ErrPanic.Codespace() && code == sdkerrors.ErrPanic.ABCICode() {
+		t.Fatalf("%s panicked: %v", method, err)
+	}
+}
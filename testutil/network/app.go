@@ -0,0 +1,109 @@
+package network
+
+import (
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// blockClock shifts the block times seen by the apps of the validators of a
+// network, so that tests can jump ahead in time without waiting for it. The
+// shifts are recorded by height, so that all the validators see the same block
+// times whether or not they lag behind.
+type blockClock struct {
+	mu sync.Mutex
+	// height is the highest height begun by any of the validators
+	height int64
+	// shifts are the total time shifts by height they apply from, in
+	// increasing order
+	shifts []timeShift
+}
+
+type timeShift struct {
+	height int64
+	offset time.Duration
+}
+
+// offset returns the time shift of the block of the given height.
+func (c *blockClock) offset(height int64) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if height > c.height {
+		c.height = height
+	}
+
+	var offset time.Duration
+	for _, s := range c.shifts {
+		if s.height > height {
+			break
+		}
+		offset = s.offset
+	}
+
+	return offset
+}
+
+// jump shifts the times of the blocks not begun by any validator yet by the
+// given duration, and returns the first height shifted.
+func (c *blockClock) jump(d time.Duration) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var offset time.Duration
+	if len(c.shifts) > 0 {
+		offset = c.shifts[len(c.shifts)-1].offset
+	}
+
+	height := c.height + 1
+	c.shifts = append(c.shifts, timeShift{height: height, offset: offset + d})
+
+	return height
+}
+
+// networkApp wraps the app of a validator to shift its block times and, for
+// SimApp, to register the handler of an upgrade at the height of its plan, as
+// if the validator had switched to the upgraded binary.
+type networkApp struct {
+	servertypes.Application
+
+	clock           *blockClock
+	simApp          *simapp.SimApp
+	upgradeHandlers map[string]upgradetypes.UpgradeHandler
+}
+
+var _ abci.Application = networkApp{}
+
+// BeginBlock implements the ABCI interface.
+func (app networkApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	req.Header.Time = req.Header.Time.Add(app.clock.offset(req.Header.Height))
+
+	if app.simApp != nil && len(app.upgradeHandlers) > 0 {
+		app.switchUpgradeHandler(req.Header)
+	}
+
+	return app.Application.BeginBlock(req)
+}
+
+// switchUpgradeHandler registers the handler of the upgrade plan to execute at
+// the block of the given header, if any. The handler can't be registered
+// earlier, as the upgrade module halts the chain if the handler of a pending
+// plan is known before its height.
+func (app networkApp) switchUpgradeHandler(header tmproto.Header) {
+	ctx := app.simApp.NewContext(true, header)
+
+	plan, found := app.simApp.UpgradeKeeper.GetUpgradePlan(ctx)
+	if !found || !plan.ShouldExecute(ctx) {
+		return
+	}
+
+	if handler, ok := app.upgradeHandlers[plan.Name]; ok {
+		app.simApp.UpgradeKeeper.SetUpgradeHandler(plan.Name, handler)
+	}
+}
@@ -19,6 +19,14 @@ exposed. Due to this exact same limitation, only a single test network can exist
 at a time. A caller must be certain it calls Cleanup after it no longer needs
 the network.
 
+Each validator can be given its own app options through the ValidatorAppOptions
+of the config. Blocks are produced continuously; WaitForBlocks waits for a given
+number of them, and JumpTime shifts the block time seen by the apps forward so
+that time-dependent logic, e.g. governance voting periods, completes without
+waiting. Upgrade upgrades the network through a software upgrade proposal, with
+the handlers of the upgrades given in the UpgradeHandlers of the config, and
+can be called again with the next plan to chain upgrades.
+
 A typical testing flow might look like the following:
 
 	type IntegrationTestSuite struct {
@@ -45,6 +45,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 // package-wide network lock to only allow one test network at a time
@@ -57,10 +58,15 @@ type AppConstructor = func(val Validator) servertypes.Application
 // NewAppConstructor returns a new simapp AppConstructor
 func NewAppConstructor(encodingCfg params.EncodingConfig) AppConstructor {
 	return func(val Validator) servertypes.Application {
+		var appOpts servertypes.AppOptions = simapp.EmptyAppOptions{}
+		if val.AppOptions != nil {
+			appOpts = val.AppOptions
+		}
+
 		return simapp.NewSimApp(
 			val.Ctx.Logger, dbm.NewMemDB(), nil, true, make(map[int64]bool), val.Ctx.Config.RootDir, 0,
 			encodingCfg,
-			simapp.EmptyAppOptions{},
+			appOpts,
 			baseapp.SetPruning(storetypes.NewPruningOptionsFromString(val.AppConfig.Pruning)),
 			baseapp.SetMinGasPrices(val.AppConfig.MinGasPrices),
 		)
@@ -95,6 +101,16 @@ type Config struct {
 	APIAddress       string                     // REST API listen address (including port)
 	GRPCAddress      string                     // GRPC server listen address (including port)
 	PrintMnemonic    bool                       // print the mnemonic of first validator as log output for testing
+
+	// ValidatorAppOptions are the app options of each validator, by index. The
+	// validators without app options use empty ones.
+	ValidatorAppOptions []servertypes.AppOptions
+
+	// UpgradeHandlers are the handlers of the upgrades the network can go
+	// through, by name. The SimApp of each validator registers the handler of
+	// an upgrade at the height of its plan, as if switching to the upgraded
+	// binary. Other apps don't support them.
+	UpgradeHandlers map[string]upgradetypes.UpgradeHandler
 }
 
 // DefaultConfig returns a sane default configuration suitable for nearly all
@@ -143,6 +159,8 @@ type (
 		Validators []*Validator
 
 		Config Config
+
+		clock *blockClock
 	}
 
 	// Validator defines an in-process Tendermint validator node. Through this object,
@@ -162,6 +180,7 @@ type (
 		Address    sdk.AccAddress
 		ValAddress sdk.ValAddress
 		RPCClient  tmclient.Client
+		AppOptions servertypes.AppOptions
 
 		tmNode  *node.Node
 		api     *api.Server
@@ -198,6 +217,10 @@ func NewCLILogger(cmd *cobra.Command) CLILogger {
 
 // New creates a new Network for integration tests or in-process testnets run via the CLI
 func New(l Logger, baseDir string, cfg Config) (*Network, error) {
+	if len(cfg.ValidatorAppOptions) > cfg.NumValidators {
+		return nil, fmt.Errorf("got app options for %d validators, but the network has %d", len(cfg.ValidatorAppOptions), cfg.NumValidators)
+	}
+
 	// only one caller/test can create and use a network at a time
 	l.Log("acquiring test network lock")
 	lock.Lock()
@@ -207,6 +230,7 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		BaseDir:    baseDir,
 		Validators: make([]*Validator, cfg.NumValidators),
 		Config:     cfg,
+		clock:      &blockClock{},
 	}
 
 	l.Logf("preparing test network with chain-id \"%s\"\n", cfg.ChainID)
@@ -448,6 +472,11 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			WithTxConfig(cfg.TxConfig).
 			WithAccountRetriever(cfg.AccountRetriever)
 
+		var appOpts servertypes.AppOptions
+		if i < len(cfg.ValidatorAppOptions) {
+			appOpts = cfg.ValidatorAppOptions[i]
+		}
+
 		network.Validators[i] = &Validator{
 			AppConfig:  appCfg,
 			ClientCtx:  clientCtx,
@@ -461,6 +490,7 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			APIAddress: apiAddr,
 			Address:    addr,
 			ValAddress: sdk.ValAddress(addr),
+			AppOptions: appOpts,
 		}
 	}
 
@@ -475,7 +505,7 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 
 	l.Log("starting test network...")
 	for _, v := range network.Validators {
-		err := startInProcess(cfg, v)
+		err := startInProcess(cfg, v, network.clock)
 		if err != nil {
 			return nil, err
 		}
@@ -542,6 +572,29 @@ func (n *Network) WaitForHeightWithTimeout(h int64, t time.Duration) (int64, err
 	}
 }
 
+// WaitForBlocks waits for the given number of blocks to be committed after the
+// latest one, returning the latest height queried.
+func (n *Network) WaitForBlocks(blocks int64) (int64, error) {
+	lastBlock, err := n.LatestHeight()
+	if err != nil {
+		return 0, err
+	}
+
+	return n.WaitForHeightWithTimeout(lastBlock+blocks, time.Duration(blocks)*10*time.Second)
+}
+
+// JumpTime shifts the block time seen by the apps of the validators forward by
+// the given duration from the next block on, and waits for that block to be
+// committed, returning its height. The times of the Tendermint blocks, as
+// returned by the RPC, aren't shifted.
+func (n *Network) JumpTime(d time.Duration) (int64, error) {
+	if d < 0 {
+		return 0, errors.New("cannot jump back in time")
+	}
+
+	return n.WaitForHeight(n.clock.jump(d))
+}
+
 // WaitForNextBlock waits for the next block to be committed, returning an error
 // upon failure.
 func (n *Network) WaitForNextBlock() error {
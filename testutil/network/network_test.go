@@ -3,12 +3,20 @@
 package network_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/grpc/rawstore"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 type IntegrationTestSuite struct {
@@ -20,8 +28,22 @@ type IntegrationTestSuite struct {
 func (s *IntegrationTestSuite) SetupSuite() {
 	s.T().Log("setting up integration test suite")
 
+	appOpts := viper.New()
+	appOpts.Set(server.FlagRawStoreQueries, true)
+
+	noopUpgrade := func(_ sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return fromVM, nil
+	}
+
+	cfg := network.DefaultConfig()
+	cfg.ValidatorAppOptions = []servertypes.AppOptions{appOpts}
+	cfg.UpgradeHandlers = map[string]upgradetypes.UpgradeHandler{
+		"v2": noopUpgrade,
+		"v3": noopUpgrade,
+	}
+
 	var err error
-	s.network, err = network.New(s.T(), s.T().TempDir(), network.DefaultConfig())
+	s.network, err = network.New(s.T(), s.T().TempDir(), cfg)
 	s.Require().NoError(err)
 
 	_, err = s.network.WaitForHeight(1)
@@ -38,6 +60,36 @@ func (s *IntegrationTestSuite) TestNetwork_Liveness() {
 	s.Require().NoError(err, "expected to reach 10 blocks; got %d", h)
 }
 
+func (s *IntegrationTestSuite) TestNetwork_WaitForBlocks() {
+	start, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+
+	h, err := s.network.WaitForBlocks(3)
+	s.Require().NoError(err)
+	s.Require().GreaterOrEqual(h, start+3)
+}
+
+func (s *IntegrationTestSuite) TestNetwork_ValidatorAppOptions() {
+	// the raw store queries are only enabled by the app options of the first
+	// validator
+	res, err := rawstore.NewQueryClient(s.network.Validators[0].ClientCtx).RawStoreRange(
+		context.Background(), &rawstore.QueryRawStoreRangeRequest{StoreKey: "bank", Limit: 1},
+	)
+	s.Require().NoError(err)
+	s.Require().Len(res.Pairs, 1)
+}
+
+func (s *IntegrationTestSuite) TestNetwork_ChainedUpgrades() {
+	for _, name := range []string{"v2", "v3"} {
+		h, err := s.network.LatestHeight()
+		s.Require().NoError(err)
+
+		// the proposal needs a block per validator and a couple more to pass
+		plan := upgradetypes.Plan{Name: name, Height: h + int64(len(s.network.Validators)) + 6}
+		s.Require().NoError(s.network.Upgrade(plan))
+	}
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -0,0 +1,172 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// upgradeTxGas is the gas limit of the txs submitting and voting on upgrade
+// proposals.
+const upgradeTxGas = 1000000
+
+// Upgrade upgrades the network with the given plan through a software upgrade
+// proposal: the first validator submits it with the minimum deposit, all the
+// validators vote yes on it and the time is jumped past its voting period. It
+// then waits for the height of the plan and returns an error unless the upgrade
+// was applied there.
+//
+// The handler of the upgrade must be in the UpgradeHandlers of the config, and
+// the height of the plan must leave room for the proposal to pass, which takes
+// a block per validator and a couple more. Upgrades are chained by calling
+// Upgrade again with the next plan.
+func (n *Network) Upgrade(plan upgradetypes.Plan) error {
+	if len(n.Validators) == 0 {
+		return fmt.Errorf("no validators available")
+	}
+	if _, ok := n.Config.UpgradeHandlers[plan.Name]; !ok {
+		return fmt.Errorf("no handler for upgrade %s", plan.Name)
+	}
+
+	val := n.Validators[0]
+	govClient := govtypes.NewQueryClient(val.ClientCtx)
+
+	depositParams, err := govClient.Params(context.Background(), &govtypes.QueryParamsRequest{ParamsType: govtypes.ParamDeposit})
+	if err != nil {
+		return err
+	}
+	votingParams, err := govClient.Params(context.Background(), &govtypes.QueryParamsRequest{ParamsType: govtypes.ParamVoting})
+	if err != nil {
+		return err
+	}
+
+	content := upgradetypes.NewSoftwareUpgradeProposal(plan.Name, fmt.Sprintf("upgrade %s at height %d", plan.Name, plan.Height), plan)
+	msg, err := govtypes.NewMsgSubmitProposal(content, depositParams.DepositParams.MinDeposit, val.Address)
+	if err != nil {
+		return err
+	}
+
+	res, err := n.broadcastTx(val, msg)
+	if err != nil {
+		return err
+	}
+
+	proposalID, err := proposalIDFromLogs(res.Logs)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range n.Validators {
+		if _, err := n.broadcastTx(v, govtypes.NewMsgVote(v.Address, proposalID, govtypes.OptionYes)); err != nil {
+			return err
+		}
+	}
+
+	if _, err := n.JumpTime(votingParams.VotingParams.VotingPeriod); err != nil {
+		return err
+	}
+
+	proposal, err := govClient.Proposal(context.Background(), &govtypes.QueryProposalRequest{ProposalId: proposalID})
+	if err != nil {
+		return err
+	}
+	if proposal.Proposal.Status != govtypes.StatusPassed {
+		return fmt.Errorf("upgrade proposal %d didn't pass: %s", proposalID, proposal.Proposal.Status)
+	}
+
+	latestHeight, err := n.LatestHeight()
+	if err != nil {
+		return err
+	}
+	if _, err := n.WaitForHeightWithTimeout(plan.Height, time.Duration(plan.Height-latestHeight+1)*10*time.Second); err != nil {
+		return err
+	}
+
+	applied, err := upgradetypes.NewQueryClient(val.ClientCtx).AppliedPlan(
+		context.Background(), &upgradetypes.QueryAppliedPlanRequest{Name: plan.Name},
+	)
+	if err != nil {
+		return err
+	}
+	if applied.Height != plan.Height {
+		return fmt.Errorf("upgrade %s wasn't applied at height %d", plan.Name, plan.Height)
+	}
+
+	return nil
+}
+
+// broadcastTx signs the given messages with the key of the given validator and
+// broadcasts them through the RPC of the first validator, waiting for them to
+// be committed. It returns an error if the tx fails.
+func (n *Network) broadcastTx(val *Validator, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	clientCtx := val.ClientCtx.
+		WithClient(n.Validators[0].RPCClient).
+		WithFromAddress(val.Address).
+		WithFromName(val.Moniker).
+		WithBroadcastMode(flags.BroadcastBlock)
+
+	txf := tx.Factory{}.
+		WithChainID(n.Config.ChainID).
+		WithKeybase(val.ClientCtx.Keyring).
+		WithTxConfig(n.Config.TxConfig).
+		WithAccountRetriever(n.Config.AccountRetriever).
+		WithGas(upgradeTxGas).
+		WithGasPrices(n.Config.MinGasPrices)
+
+	txf, err := txf.Prepare(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Sign(txf, val.Moniker, txBuilder, true); err != nil {
+		return nil, err
+	}
+
+	txBz, err := n.Config.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := clientCtx.BroadcastTx(txBz)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.CheckTxResponse(res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// proposalIDFromLogs returns the id of the proposal submitted by a tx from its
+// logs.
+func proposalIDFromLogs(logs sdk.ABCIMessageLogs) (uint64, error) {
+	for _, log := range logs {
+		for _, event := range log.Events {
+			if event.Type != govtypes.EventTypeSubmitProposal {
+				continue
+			}
+
+			for _, attr := range event.Attributes {
+				if attr.Key == govtypes.AttributeKeyProposalID {
+					return strconv.ParseUint(attr.Value, 10, 64)
+				}
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("no proposal id in the tx logs")
+}
@@ -0,0 +1,243 @@
+// Package rehearsal rehearses the upgrades of a chain in process, from its
+// exported genesis or the data of one of its nodes, e.g. a state-synced node,
+// so that the upgrade handlers and the migrations of the modules are exercised
+// on the actual state of the chain before the upgrade is proposed:
+//
+//	app := simapp.NewSimApp(logger, dbm.NewMemDB(), nil, true, map[int64]bool{}, home, 0, encCfg, simapp.EmptyAppOptions{})
+//	r := rehearsal.NewSimAppRehearsal(app)
+//	if err := r.InitFromGenesisFile("exported-genesis.json"); err != nil {
+//		return err
+//	}
+//	if err := r.Upgrade("v2", nil); err != nil {
+//		return err
+//	}
+//
+// The upgrade is applied by the upgrade module at the height following the
+// state, as on the chain, after which the versions of the modules and the
+// invariants are checked.
+package rehearsal
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// App is the app of the upgraded binary whose upgrade is rehearsed, such as a
+// BaseApp based app.
+type App interface {
+	abci.Application
+
+	LastBlockHeight() int64
+	NewContext(isCheckTx bool, header tmproto.Header) sdk.Context
+	NewUncachedContext(isCheckTx bool, header tmproto.Header) sdk.Context
+}
+
+// Rehearsal rehearses the upgrades of an app.
+type Rehearsal struct {
+	App           App
+	UpgradeKeeper upgradekeeper.Keeper
+	CrisisKeeper  crisiskeeper.Keeper
+	ModuleManager *module.Manager
+	Configurator  module.Configurator
+
+	// BlockTime is the time of the upgrade blocks. It defaults to the genesis
+	// time of the chain, or to the current time if the app is loaded from the
+	// data of a node.
+	BlockTime time.Time
+	// ChainID is the chain ID of the upgrade blocks. It defaults to the chain
+	// ID of the genesis.
+	ChainID string
+
+	// initialized is set if the app is initialized from a genesis, whose
+	// state is not committed until the first block.
+	initialized   bool
+	initialHeight int64
+}
+
+// NewSimAppRehearsal returns the rehearsal of the upgrades of a SimApp.
+func NewSimAppRehearsal(app *simapp.SimApp) *Rehearsal {
+	return &Rehearsal{
+		App:           app,
+		UpgradeKeeper: app.UpgradeKeeper,
+		CrisisKeeper:  app.CrisisKeeper,
+		ModuleManager: app.ModuleManager(),
+		Configurator:  app.Configurator(),
+	}
+}
+
+// OpenDB opens the application database in the home directory of a node, to
+// create the app rehearsing its upgrades. As the rehearsal commits the upgrade
+// blocks to the database, the home directory should be a copy of the home of
+// the node.
+func OpenDB(home string) (dbm.DB, error) {
+	return sdk.NewLevelDB("application", filepath.Join(home, "data"))
+}
+
+// InitFromGenesisFile initializes the app from the genesis file, e.g. exported
+// from a chain. The app must not have been loaded from an existing state.
+func (r *Rehearsal) InitFromGenesisFile(path string) error {
+	genDoc, err := tmtypes.GenesisDocFromFile(path)
+	if err != nil {
+		return err
+	}
+
+	return r.InitFromGenesis(genDoc)
+}
+
+// InitFromGenesis initializes the app from the genesis. The app must not have
+// been loaded from an existing state.
+func (r *Rehearsal) InitFromGenesis(genDoc *tmtypes.GenesisDoc) error {
+	if r.App.LastBlockHeight() != 0 {
+		return fmt.Errorf("app already loaded at height %d", r.App.LastBlockHeight())
+	}
+
+	validators := make([]abci.ValidatorUpdate, len(genDoc.Validators))
+	for i, val := range genDoc.Validators {
+		validators[i] = tmtypes.TM2PB.ValidatorUpdate(tmtypes.NewValidator(val.PubKey, val.Power))
+	}
+
+	err := recoverPanic(func() {
+		r.App.InitChain(abci.RequestInitChain{
+			Time:            genDoc.GenesisTime,
+			ChainId:         genDoc.ChainID,
+			ConsensusParams: tmtypes.TM2PB.ConsensusParams(genDoc.ConsensusParams),
+			Validators:      validators,
+			AppStateBytes:   genDoc.AppState,
+			InitialHeight:   genDoc.InitialHeight,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("init chain: %w", err)
+	}
+
+	r.initialized = true
+	r.initialHeight = genDoc.InitialHeight
+	if r.ChainID == "" {
+		r.ChainID = genDoc.ChainID
+	}
+	if r.BlockTime.IsZero() {
+		r.BlockTime = genDoc.GenesisTime
+	}
+
+	return nil
+}
+
+// Upgrade applies the upgrade of the given name at the next height: it
+// registers its handler, schedules its plan and runs the block of its height.
+// The handler defaults to running the migrations of all the modules. It then
+// checks that the upgrade was applied, that all the modules are at their
+// consensus version and that the invariants hold, returning an error if not.
+// The upgrades are chained by calling Upgrade again.
+func (r *Rehearsal) Upgrade(name string, handler upgradetypes.UpgradeHandler) error {
+	if handler == nil {
+		handler = func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return r.ModuleManager.RunMigrations(ctx, r.Configurator, fromVM)
+		}
+	}
+	r.UpgradeKeeper.SetUpgradeHandler(name, handler)
+
+	height := r.App.LastBlockHeight() + 1
+	if r.initialized && r.App.LastBlockHeight() == 0 && r.initialHeight > 1 {
+		height = r.initialHeight
+	}
+	if r.BlockTime.IsZero() {
+		r.BlockTime = time.Now().UTC()
+	}
+	header := tmproto.Header{ChainID: r.ChainID, Height: height, Time: r.BlockTime}
+
+	// the genesis state is only committed by the first block
+	scheduleCtx := r.App.NewUncachedContext(false, tmproto.Header{ChainID: r.ChainID, Height: height - 1, Time: r.BlockTime})
+	if r.initialized && r.App.LastBlockHeight() == 0 {
+		scheduleCtx = r.App.NewContext(false, tmproto.Header{ChainID: r.ChainID, Height: height - 1, Time: r.BlockTime})
+	}
+	if err := r.UpgradeKeeper.ScheduleUpgrade(scheduleCtx, upgradetypes.Plan{Name: name, Height: height}); err != nil {
+		return fmt.Errorf("schedule upgrade %s: %w", name, err)
+	}
+
+	err := recoverPanic(func() {
+		r.App.BeginBlock(abci.RequestBeginBlock{Header: header})
+		r.App.EndBlock(abci.RequestEndBlock{Height: height})
+		r.App.Commit()
+	})
+	if err != nil {
+		return fmt.Errorf("upgrade %s at height %d: %w", name, height, err)
+	}
+
+	ctx := r.App.NewContext(true, header)
+	if done := r.UpgradeKeeper.GetDoneHeight(ctx, name); done != height {
+		return fmt.Errorf("upgrade %s wasn't applied at height %d", name, height)
+	}
+
+	if err := r.checkVersions(ctx); err != nil {
+		return err
+	}
+
+	return r.CheckInvariants(ctx)
+}
+
+// checkVersions returns an error if the versions of the modules stored by the
+// upgrade are not their consensus versions.
+func (r *Rehearsal) checkVersions(ctx sdk.Context) error {
+	stored := r.UpgradeKeeper.GetModuleVersionMap(ctx)
+
+	var outdated []string
+	for name, version := range r.ModuleManager.GetVersionMap() {
+		if stored[name] != version {
+			outdated = append(outdated, fmt.Sprintf("%s at version %d instead of %d", name, stored[name], version))
+		}
+	}
+	if len(outdated) > 0 {
+		sort.Strings(outdated)
+		return fmt.Errorf("modules not migrated: %s", strings.Join(outdated, ", "))
+	}
+
+	return nil
+}
+
+// CheckInvariants checks all the invariants registered in the crisis keeper,
+// returning an error listing the broken ones.
+func (r *Rehearsal) CheckInvariants(ctx sdk.Context) error {
+	var broken []string
+	for _, route := range r.CrisisKeeper.Routes() {
+		if msg, stop := route.Invar(ctx); stop {
+			broken = append(broken, fmt.Sprintf("%s: %s", route.FullRoute(), msg))
+		}
+	}
+	if len(broken) > 0 {
+		return fmt.Errorf("broken invariants:\n%s", strings.Join(broken, "\n"))
+	}
+
+	return nil
+}
+
+// recoverPanic calls fn and returns its panic as an error, as the app panics on
+// the failures of the blocks, such as the errors of the upgrade handlers.
+func recoverPanic(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if rerr, ok := r.(error); ok {
+				err = rerr
+			} else {
+				err = fmt.Errorf("%v", r)
+			}
+		}
+	}()
+
+	fn()
+	return nil
+}
@@ -0,0 +1,132 @@
+package rehearsal_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/rehearsal"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// exportGenesis exports the genesis of a chain at height 2 to a file.
+func exportGenesis(t *testing.T) string {
+	app := simapp.Setup(t, false)
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 2, ChainID: "rehearsal", Time: time.Now().UTC()}})
+	app.EndBlock(abci.RequestEndBlock{Height: 2})
+	app.Commit()
+
+	exported, err := app.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+
+	genDoc := &tmtypes.GenesisDoc{
+		GenesisTime:     time.Now().UTC(),
+		ChainID:         "rehearsal",
+		InitialHeight:   exported.Height,
+		ConsensusParams: tmtypes.DefaultConsensusParams(),
+		Validators:      exported.Validators,
+		AppState:        exported.AppState,
+	}
+	path := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, genDoc.SaveAs(path))
+
+	return path
+}
+
+func newRehearsal(t *testing.T, genesis string) (*rehearsal.Rehearsal, *simapp.SimApp) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, t.TempDir(), 0, encCfg, simapp.EmptyAppOptions{})
+	r := rehearsal.NewSimAppRehearsal(app)
+	require.NoError(t, r.InitFromGenesisFile(genesis))
+
+	return r, app
+}
+
+func TestUpgrade(t *testing.T) {
+	genesis := exportGenesis(t)
+	r, app := newRehearsal(t, genesis)
+
+	var applied []int64
+	require.NoError(t, r.Upgrade("v2", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		applied = append(applied, plan.Height)
+		return app.ModuleManager().RunMigrations(ctx, app.Configurator(), fromVM)
+	}))
+	require.Equal(t, []int64{3}, applied)
+	require.Equal(t, int64(3), app.LastBlockHeight())
+
+	// the upgrades are chained, by default running the migrations
+	require.NoError(t, r.Upgrade("v3", nil))
+	require.Equal(t, int64(4), app.LastBlockHeight())
+}
+
+func TestUpgradeFailures(t *testing.T) {
+	genesis := exportGenesis(t)
+
+	r, _ := newRehearsal(t, genesis)
+	err := r.Upgrade("v2", func(sdk.Context, upgradetypes.Plan, module.VersionMap) (module.VersionMap, error) {
+		return nil, errors.New("migration failed")
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "migration failed")
+
+	r, _ = newRehearsal(t, genesis)
+	err = r.Upgrade("v2", func(_ sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		fromVM[banktypes.ModuleName]--
+		return fromVM, nil
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "modules not migrated: bank at version")
+
+	r, app := newRehearsal(t, genesis)
+	err = r.Upgrade("v2", func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// minting without updating the supply breaks the bank invariants
+		addr := sdk.AccAddress("rehearsal-----------")
+		store := ctx.KVStore(app.GetKey(banktypes.StoreKey))
+		amount, err := sdk.NewInt(1000).Marshal()
+		if err != nil {
+			return nil, err
+		}
+		store.Set(append(banktypes.CreateAccountBalancesPrefix(addr), []byte(sdk.DefaultBondDenom)...), amount)
+		return fromVM, nil
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "broken invariants")
+}
+
+func TestUpgradeFromHome(t *testing.T) {
+	home := t.TempDir()
+	encCfg := simapp.MakeTestEncodingConfig()
+
+	// the node of the chain is stopped after its first upgrade, and its data
+	// reopened to rehearse the next one
+	db, err := rehearsal.OpenDB(home)
+	require.NoError(t, err)
+	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, 0, encCfg, simapp.EmptyAppOptions{})
+	r := rehearsal.NewSimAppRehearsal(app)
+	require.NoError(t, r.InitFromGenesisFile(exportGenesis(t)))
+	require.NoError(t, r.Upgrade("v2", nil))
+	require.NoError(t, db.Close())
+
+	db, err = rehearsal.OpenDB(home)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+	app = simapp.NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, 0, encCfg, simapp.EmptyAppOptions{})
+	require.Equal(t, int64(3), app.LastBlockHeight())
+
+	r = rehearsal.NewSimAppRehearsal(app)
+	r.ChainID = "rehearsal"
+	require.NoError(t, r.Upgrade("v3", nil))
+	require.Equal(t, int64(4), app.LastBlockHeight())
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/tendermint/tendermint/crypto"
+
 	"github.com/cosmos/cosmos-sdk/internal/conv"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -59,10 +61,22 @@ func Compose(typ string, subAddresses []Addressable) ([]byte, error) {
 }
 
 // Module is a specialized version of a composed address for modules. Each module account
-// is constructed from a module name and module account key.
-func Module(moduleName string, key []byte) []byte {
+// is constructed from a module name and a sequence of derivation keys. Without derivation
+// keys, the legacy module account address is returned. Otherwise, the first key derives a
+// sub-account of the module, and each following key derives a sub-account of the previous
+// address with Derive.
+func Module(moduleName string, derivationKeys ...[]byte) []byte {
+	if len(derivationKeys) == 0 {
+		return crypto.AddressHash([]byte(moduleName))
+	}
+
 	mKey := append([]byte(moduleName), 0)
-	return Hash("module", append(mKey, key...))
+	addr := Hash("module", append(mKey, derivationKeys[0]...))
+	for _, k := range derivationKeys[1:] {
+		addr = Derive(addr, k)
+	}
+
+	return addr
 }
 
 // Derive derives a new address from the main `address` and a derivation `key`.
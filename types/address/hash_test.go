@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto"
 )
 
 func TestAddressSuite(t *testing.T) {
@@ -73,6 +74,17 @@ func (suite *AddressSuite) TestModule() {
 	addr3 := Module(modName, []byte{1, 2, 3})
 	assert.NotEqual(addr, addr3, "changing key must change address")
 	assert.NotEqual(addr2, addr3, "changing key must change address")
+
+	// no derivation keys gives the legacy module account address
+	legacy := Module(modName)
+	assert.Equal(crypto.AddressHash([]byte(modName)).Bytes(), legacy)
+
+	// each additional key derives a sub-account of the previous address
+	addr4 := Module(modName, key, []byte{3})
+	assert.Len(addr4, Len, "must have address length")
+	assert.Equal(Derive(addr, []byte{3}), addr4)
+	assert.NotEqual(addr3, addr4, "splitting the keys must change address")
+	assert.NotEqual(addr4, Module(modName, []byte{3}, key), "changing key order must change address")
 }
 
 func (suite *AddressSuite) TestDerive() {
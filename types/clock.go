@@ -0,0 +1,72 @@
+package types
+
+import (
+	"time"
+)
+
+// BlockClock is the time source of the state transitions: the time of the
+// block being processed, agreed upon by the validators. Unlike the local time
+// of the node returned by time.Now, it is the same on every node replaying the
+// block, so the modules must use it instead of time.Now to stay deterministic.
+type BlockClock struct {
+	now time.Time
+}
+
+// NewBlockClock returns the clock of a block of the given time.
+func NewBlockClock(blockTime time.Time) BlockClock {
+	return BlockClock{now: blockTime}
+}
+
+// Now returns the time of the block.
+func (c BlockClock) Now() time.Time {
+	return c.now
+}
+
+// Since returns the duration elapsed from t to the time of the block.
+func (c BlockClock) Since(t time.Time) time.Duration {
+	return c.now.Sub(t)
+}
+
+// Until returns the duration from the time of the block to t.
+func (c BlockClock) Until(t time.Time) time.Duration {
+	return t.Sub(c.now)
+}
+
+// After returns the time at the given duration after the time of the block,
+// e.g. the expiration of an entry created in the block.
+func (c BlockClock) After(d time.Duration) time.Time {
+	return c.now.Add(d)
+}
+
+// HasPassed returns whether the time of the block is at or past t, e.g. whether
+// an entry expiring at t has expired.
+func (c BlockClock) HasPassed(t time.Time) bool {
+	return !c.now.Before(t)
+}
+
+// HasExpired returns whether an optional expiration has passed. A nil
+// expiration never expires.
+func (c BlockClock) HasExpired(expiration *time.Time) bool {
+	return expiration != nil && c.HasPassed(*expiration)
+}
+
+// PeriodsSince returns the number of complete periods elapsed from start to the
+// time of the block, or 0 if the block is before start. It panics if the period
+// is not positive.
+func (c BlockClock) PeriodsSince(start time.Time, period time.Duration) int64 {
+	if period <= 0 {
+		panic("period must be positive")
+	}
+	if c.now.Before(start) {
+		return 0
+	}
+
+	return int64(c.now.Sub(start) / period)
+}
+
+// PeriodStart returns the start of the period containing the time of the block,
+// where the periods have the given duration from start. The block must not be
+// before start.
+func (c BlockClock) PeriodStart(start time.Time, period time.Duration) time.Time {
+	return start.Add(time.Duration(c.PeriodsSince(start, period)) * period)
+}
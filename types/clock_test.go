@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBlockClock(t *testing.T) {
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Time: now})
+	clock := ctx.BlockClock()
+
+	require.Equal(t, now, clock.Now())
+	require.Equal(t, time.Hour, clock.Since(now.Add(-time.Hour)))
+	require.Equal(t, time.Hour, clock.Until(now.Add(time.Hour)))
+	require.Equal(t, now.Add(time.Minute), clock.After(time.Minute))
+
+	require.True(t, clock.HasPassed(now))
+	require.True(t, clock.HasPassed(now.Add(-time.Second)))
+	require.False(t, clock.HasPassed(now.Add(time.Second)))
+
+	require.False(t, clock.HasExpired(nil))
+	expiration := now.Add(time.Second)
+	require.False(t, clock.HasExpired(&expiration))
+	expiration = now
+	require.True(t, clock.HasExpired(&expiration))
+}
+
+func TestBlockClockPeriods(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	clock := sdk.NewBlockClock(start.Add(10*day + time.Hour))
+	require.Equal(t, int64(10), clock.PeriodsSince(start, day))
+	require.Equal(t, int64(1), clock.PeriodsSince(start, 7*day))
+	require.Equal(t, start.Add(7*day), clock.PeriodStart(start, 7*day))
+
+	require.Equal(t, int64(0), sdk.NewBlockClock(start.Add(-day)).PeriodsSince(start, day))
+	require.Equal(t, int64(0), sdk.NewBlockClock(start).PeriodsSince(start, day))
+	require.Panics(t, func() { clock.PeriodsSince(start, 0) })
+}
@@ -3,9 +3,12 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"regexp"
 	"sort"
 	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 //-----------------------------------------------------------------------------
@@ -100,6 +103,21 @@ func (coin Coin) Add(coinB Coin) Coin {
 	return Coin{coin.Denom, coin.Amount.Add(coinB.Amount)}
 }
 
+// SafeAdd adds amounts of two coins with same denom. It returns an error if
+// the coins differ in denom or if the sum overflows.
+func (coin Coin) SafeAdd(coinB Coin) (Coin, error) {
+	if coin.Denom != coinB.Denom {
+		return Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin denominations; %s, %s", coin.Denom, coinB.Denom)
+	}
+
+	amount, err := coin.Amount.SafeAdd(coinB.Amount)
+	if err != nil {
+		return Coin{}, err
+	}
+
+	return Coin{coin.Denom, amount}, nil
+}
+
 // AddAmount adds an amount to the Coin.
 func (coin Coin) AddAmount(amount Int) Coin {
 	return Coin{coin.Denom, coin.Amount.Add(amount)}
@@ -120,6 +138,27 @@ func (coin Coin) Sub(coinB Coin) Coin {
 	return res
 }
 
+// SafeSub subtracts amounts of two coins with same denom. It returns an error
+// if the coins differ in denom, if the difference overflows or if it is
+// negative.
+func (coin Coin) SafeSub(coinB Coin) (Coin, error) {
+	if coin.Denom != coinB.Denom {
+		return Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin denominations; %s, %s", coin.Denom, coinB.Denom)
+	}
+
+	amount, err := coin.Amount.SafeSub(coinB.Amount)
+	if err != nil {
+		return Coin{}, err
+	}
+
+	res := Coin{coin.Denom, amount}
+	if res.IsNegative() {
+		return Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", coin, coinB)
+	}
+
+	return res, nil
+}
+
 // SubAmount subtracts an amount from the Coin.
 func (coin Coin) SubAmount(amount Int) Coin {
 	res := Coin{coin.Denom, coin.Amount.Sub(amount)}
@@ -281,6 +320,17 @@ func (coins Coins) IsValid() bool {
 // amount. In otherwords, IsValid will always return true.
 // The function panics if `coins` or  `coinsB` are not sorted (ascending).
 func (coins Coins) Add(coinsB ...Coin) Coins {
+	sum, err := coins.safeAdd(coinsB)
+	if err != nil {
+		panic(err)
+	}
+
+	return sum
+}
+
+// SafeAdd performs the same arithmetic as Add but returns an error instead of
+// panicking if `coins` or `coinsB` are not sorted or if an amount overflows.
+func (coins Coins) SafeAdd(coinsB ...Coin) (Coins, error) {
 	return coins.safeAdd(coinsB)
 }
 
@@ -289,15 +339,16 @@ func (coins Coins) Add(coinsB ...Coin) Coins {
 // other set is returned. Otherwise, the coins are compared in order of their
 // denomination and addition only occurs when the denominations match, otherwise
 // the coin is simply added to the sum assuming it's not zero.
-// The function panics if `coins` or  `coinsB` are not sorted (ascending).
-func (coins Coins) safeAdd(coinsB Coins) Coins {
+// The function returns an error if `coins` or  `coinsB` are not sorted
+// (ascending), or if an amount overflows.
+func (coins Coins) safeAdd(coinsB Coins) (Coins, error) {
 	// probably the best way will be to make Coins and interface and hide the structure
 	// definition (type alias)
 	if !coins.isSorted() {
-		panic("Coins (self) must be sorted")
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Coins (self) must be sorted")
 	}
 	if !coinsB.isSorted() {
-		panic("Wrong argument: coins must be sorted")
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Wrong argument: coins must be sorted")
 	}
 
 	sum := ([]Coin)(nil)
@@ -308,14 +359,14 @@ func (coins Coins) safeAdd(coinsB Coins) Coins {
 		if indexA == lenA {
 			if indexB == lenB {
 				// return nil coins if both sets are empty
-				return sum
+				return sum, nil
 			}
 
 			// return set B (excluding zero coins) if set A is empty
-			return append(sum, removeZeroCoins(coinsB[indexB:])...)
+			return append(sum, removeZeroCoins(coinsB[indexB:])...), nil
 		} else if indexB == lenB {
 			// return set A (excluding zero coins) if set B is empty
-			return append(sum, removeZeroCoins(coins[indexA:])...)
+			return append(sum, removeZeroCoins(coins[indexA:])...), nil
 		}
 
 		coinA, coinB := coins[indexA], coinsB[indexB]
@@ -329,7 +380,10 @@ func (coins Coins) safeAdd(coinsB Coins) Coins {
 			indexA++
 
 		case 0: // coin A denom == coin B denom
-			res := coinA.Add(coinB)
+			res, err := coinA.SafeAdd(coinB)
+			if err != nil {
+				return nil, err
+			}
 			if !res.IsZero() {
 				sum = append(sum, res)
 			}
@@ -374,20 +428,52 @@ func (coins Coins) DenomsSubsetOf(coinsB Coins) bool {
 // CONTRACT: Sub will never return Coins where one Coin has a non-positive
 // amount. In otherwords, IsValid will always return true.
 func (coins Coins) Sub(coinsB Coins) Coins {
-	diff, hasNeg := coins.SafeSub(coinsB)
-	if hasNeg {
-		panic("negative coin amount")
+	diff, err := coins.SafeSub(coinsB)
+	if err != nil {
+		panic(err)
 	}
 
 	return diff
 }
 
-// SafeSub performs the same arithmetic as Sub but returns a boolean if any
-// negative coin amount was returned.
-// The function panics if `coins` or  `coinsB` are not sorted (ascending).
-func (coins Coins) SafeSub(coinsB Coins) (Coins, bool) {
-	diff := coins.safeAdd(coinsB.negative())
-	return diff, diff.IsAnyNegative()
+// SafeSub performs the same arithmetic as Sub but returns an error instead of
+// panicking if any coin amount of the difference is negative, if `coins` or
+// `coinsB` are not sorted (ascending), or if an amount overflows. The error
+// wraps ErrInsufficientFunds in the first case.
+func (coins Coins) SafeSub(coinsB Coins) (Coins, error) {
+	diff, err := coins.safeAdd(coinsB.negative())
+	if err != nil {
+		return nil, err
+	}
+	if diff.IsAnyNegative() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", coins, coinsB)
+	}
+
+	return diff, nil
+}
+
+// SafeMulDec multiplies the coins by a decimal, truncating the amounts, and
+// removes the coins whose amount truncates to zero. It returns an error if
+// the decimal is negative or if an amount overflows.
+func (coins Coins) SafeMulDec(d Dec) (Coins, error) {
+	if d.IsNegative() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot multiply coins by negative decimal %s", d)
+	}
+
+	res := make(Coins, 0, len(coins))
+	for _, coin := range coins {
+		amount := new(big.Int).Mul(coin.Amount.BigInt(), d.BigInt())
+		amount.Quo(amount, precisionReuse)
+		if amount.BitLen() > maxBitLen {
+			return nil, overflowError("mul_dec")
+		}
+
+		if amount.Sign() != 0 {
+			res = append(res, Coin{coin.Denom, NewIntFromBigInt(amount)})
+		}
+	}
+
+	return res, nil
 }
 
 // IsAllGT returns true if for every denom in coinsB,
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -480,6 +481,55 @@ func (s *coinTestSuite) TestSubCoins() {
 	}
 }
 
+func (s *coinTestSuite) TestSafeAddCoins() {
+	res, err := sdk.Coins{s.ca1}.SafeAdd(s.ca1, s.cm2)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.Coins{s.ca2, s.cm2}, res)
+
+	_, err = sdk.Coins{s.cm1, s.ca1}.SafeAdd(s.ca1)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	maxCoin := sdk.NewCoin(testDenom1, sdk.NewIntWithDecimal(6, 76))
+	_, err = sdk.Coins{maxCoin}.SafeAdd(maxCoin)
+	s.Require().ErrorIs(err, sdkerrors.ErrIntOverflow)
+	s.Require().Panics(func() { sdk.Coins{maxCoin}.Add(maxCoin) })
+}
+
+func (s *coinTestSuite) TestSafeSubCoins() {
+	res, err := sdk.Coins{s.ca1, s.cm1}.SafeSub(sdk.Coins{s.ca1})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.Coins{s.cm1}, res)
+
+	_, err = sdk.Coins{s.ca1, s.cm1}.SafeSub(sdk.Coins{s.ca2})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	_, err = sdk.Coins{s.ca2}.SafeSub(sdk.Coins{s.cm2, s.ca1})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	coin, err := s.ca2.SafeSub(s.ca1)
+	s.Require().NoError(err)
+	s.Require().Equal(s.ca1, coin)
+	_, err = s.ca1.SafeSub(s.ca2)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	_, err = s.ca1.SafeSub(s.cm1)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+}
+
+func (s *coinTestSuite) TestSafeMulDecCoins() {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(testDenom1, 10), sdk.NewInt64Coin(testDenom2, 1))
+
+	res, err := coins.SafeMulDec(sdk.NewDecWithPrec(25, 2))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(testDenom1, 2)), res)
+
+	_, err = coins.SafeMulDec(sdk.NewDec(-1))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	maxCoins := sdk.NewCoins(sdk.NewCoin(testDenom1, sdk.NewIntWithDecimal(6, 76)))
+	_, err = maxCoins.SafeMulDec(sdk.NewDec(2))
+	s.Require().ErrorIs(err, sdkerrors.ErrIntOverflow)
+}
+
 func (s *coinTestSuite) TestCoins_Validate() {
 	testCases := []struct {
 		name    string
@@ -11,6 +11,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/store/gaskv"
+	"github.com/cosmos/cosmos-sdk/store/readonly"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -38,6 +39,7 @@ type Context struct {
 	minGasPrice   DecCoins
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
+	gasTracer     *GasTracer
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -58,6 +60,7 @@ func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
 func (c Context) EventManager() *EventManager { return c.eventManager }
+func (c Context) GasTracer() *GasTracer       { return c.gasTracer }
 
 // clone the header before returning
 func (c Context) BlockHeader() tmproto.Header {
@@ -105,6 +108,20 @@ func (c Context) WithMultiStore(ms MultiStore) Context {
 	return c
 }
 
+// BlockClock returns the clock of the block, the time source the state
+// transitions must use instead of time.Now.
+func (c Context) BlockClock() BlockClock {
+	return NewBlockClock(c.header.Time)
+}
+
+// WithReadOnlyStores returns a Context whose stores panic with
+// ErrReadOnlyWrite when written, e.g. to give a module read-only access to the
+// stores of another one.
+func (c Context) WithReadOnlyStores() Context {
+	c.ms = readonly.NewMultiStore(c.ms)
+	return c
+}
+
 // WithBlockHeader returns a Context with an updated tendermint block header in UTC time.
 func (c Context) WithBlockHeader(header tmproto.Header) Context {
 	// https://github.com/gogo/protobuf/issues/519
@@ -214,6 +231,13 @@ func (c Context) WithEventManager(em *EventManager) Context {
 	return c
 }
 
+// WithGasTracer returns a Context with an updated gas tracer, which records
+// the gas consumed per store. A nil tracer disables gas tracing.
+func (c Context) WithGasTracer(tracer *GasTracer) Context {
+	c.gasTracer = tracer
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
@@ -244,12 +268,22 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), storetypes.KVGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.storeGasMeter(key), storetypes.KVGasConfig())
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key storetypes.StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), storetypes.TransientGasConfig())
+	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.storeGasMeter(key), storetypes.TransientGasConfig())
+}
+
+// storeGasMeter returns the gas meter of the stores accessed through the
+// Context, which records the gas consumed per store if gas tracing is enabled.
+func (c Context) storeGasMeter(key storetypes.StoreKey) GasMeter {
+	if c.gasTracer == nil {
+		return c.GasMeter()
+	}
+
+	return c.gasTracer.StoreGasMeter(key.Name(), c.GasMeter())
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
@@ -65,6 +65,14 @@ func ResponseDeliverTx(err error, gw, gu uint64, debug bool) abci.ResponseDelive
 	}
 }
 
+// ResponseDeliverTxWithEvents returns an ABCI ResponseDeliverTx object with
+// fields filled in from the given error, gas values and events.
+func ResponseDeliverTxWithEvents(err error, gw, gu uint64, events []abci.Event, debug bool) abci.ResponseDeliverTx {
+	resp := ResponseDeliverTx(err, gw, gu, debug)
+	resp.Events = events
+	return resp
+}
+
 // QueryResult returns a ResponseQuery from an error. It will try to parse ABCI
 // info from the error.
 func QueryResult(err error, debug bool) abci.ResponseQuery {
@@ -153,6 +153,9 @@ var (
 
 	// ErrInvalidDecString defines an error for an invalid decimal string
 	ErrInvalidDecString = Register(mathCodespace, 41, "invalid decimal string")
+
+	// ErrIntOverflow defines an error for an integer overflow
+	ErrIntOverflow = Register(mathCodespace, 42, "integer overflow")
 )
 
 // Register returns an error instance that should be used as the base for
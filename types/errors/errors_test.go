@@ -260,3 +260,21 @@ func ExampleWrapf() {
 	// 90 is smaller than 100: insufficient funds
 	// 90 is smaller than 100: insufficient funds
 }
+
+func (s *errorsTestSuite) TestWrapPath() {
+	s.Require().Nil(WrapPath(nil, "bank"))
+
+	err := WrapPath(Wrap(ErrInvalidCoins, "-1stake"), "coins")
+	err = WrapPath(err, "balances", 3)
+	err = WrapPath(err, "bank")
+	s.Require().EqualError(err, "bank.balances[3].coins: -1stake: invalid coins")
+	s.Require().True(stdlib.Is(err, ErrInvalidCoins))
+	s.Require().Equal(ErrInvalidCoins, errors.Cause(err))
+
+	var pathErr *PathError
+	s.Require().True(stdlib.As(err, &pathErr))
+	s.Require().Equal("bank.balances[3].coins", pathErr.Path())
+
+	s.Require().EqualError(WrapPath(ErrInvalidCoins, 0, 1), "[0][1]: invalid coins")
+	s.Require().Panics(func() { _ = WrapPath(ErrInvalidCoins, 1.5) })
+}
@@ -0,0 +1,71 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PathError is an error of the element at a path of a JSON document, such as
+// the genesis file, e.g. bank.balances[3].coins. The path is made of the names
+// of the fields and the indexes of the arrays leading to the element.
+type PathError struct {
+	path []interface{}
+	err  error
+}
+
+// WrapPath returns the error of the element at the path, whose elements are
+// field names (strings) and array indexes (ints). The paths of the errors
+// wrapped by the nested elements are prepended with the path of their parent,
+// so that the elements only need to know their position in their parent. It
+// returns nil if err is nil.
+func WrapPath(err error, path ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+
+	for _, elem := range path {
+		switch elem.(type) {
+		case string, int:
+		default:
+			panic(fmt.Errorf("invalid path element %v of type %T", elem, elem))
+		}
+	}
+
+	if pathErr, ok := err.(*PathError); ok {
+		return &PathError{path: append(append([]interface{}{}, path...), pathErr.path...), err: pathErr.err}
+	}
+
+	return &PathError{path: path, err: err}
+}
+
+// Path returns the path of the element, e.g. bank.balances[3].coins.
+func (e *PathError) Path() string {
+	var b strings.Builder
+	for _, elem := range e.path {
+		switch elem := elem.(type) {
+		case string:
+			if b.Len() > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(elem)
+		case int:
+			fmt.Fprintf(&b, "[%d]", elem)
+		}
+	}
+
+	return b.String()
+}
+
+func (e *PathError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path(), e.err.Error())
+}
+
+// Cause returns the error of the element.
+func (e *PathError) Cause() error {
+	return e.err
+}
+
+// Unwrap implements the built-in errors.Unwrap
+func (e *PathError) Unwrap() error {
+	return e.err
+}
@@ -87,11 +87,19 @@ func TypedEventToEvent(tev proto.Message) (Event, error) {
 		return Event{}, err
 	}
 
+	// the attributes are sorted by key, as the iteration order of the map is
+	// random
+	keys := make([]string, 0, len(attrMap))
+	for k := range attrMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
 	attrs := make([]abci.EventAttribute, 0, len(attrMap))
-	for k, v := range attrMap {
+	for _, k := range keys {
 		attrs = append(attrs, abci.EventAttribute{
 			Key:   []byte(k),
-			Value: v,
+			Value: attrMap[k],
 		})
 	}
 
@@ -308,7 +316,83 @@ func StringifyEvents(events []abci.Event) StringEvents {
 // MarkEventsToIndex returns the set of ABCI events, where each event's attribute
 // has it's index value marked based on the provided set of events to index.
 func MarkEventsToIndex(events []abci.Event, indexSet map[string]struct{}) []abci.Event {
-	indexAll := len(indexSet) == 0
+	return EventIndexFilter{Allow: indexSet}.MarkEventsToIndex(events)
+}
+
+// EventIndexFilter selects the event attributes which Tendermint indexes.
+// Entries are in the form {eventType}, matching all the attributes of the
+// events of that type, or {eventType}.{attributeKey}.
+type EventIndexFilter struct {
+	// Allow is the set of entries to index. If empty, all events are indexed.
+	Allow map[string]struct{}
+	// Deny is the set of entries which are never indexed, even if allowed.
+	Deny map[string]struct{}
+	// Modules are the filters of the events of each module, i.e. of the events
+	// whose module attribute is the module name. The entries allowed by the
+	// filter of a module are indexed in addition to Allow, and the entries it
+	// denies are only denied for the events of that module.
+	Modules map[string]EventIndexFilter
+}
+
+// NewEventIndexFilter returns an EventIndexFilter from the given allow and
+// deny lists.
+func NewEventIndexFilter(allow, deny []string) EventIndexFilter {
+	f := EventIndexFilter{
+		Allow: make(map[string]struct{}, len(allow)),
+		Deny:  make(map[string]struct{}, len(deny)),
+	}
+
+	for _, e := range allow {
+		f.Allow[e] = struct{}{}
+	}
+	for _, e := range deny {
+		f.Deny[e] = struct{}{}
+	}
+
+	return f
+}
+
+// IsIndexed returns true if the attribute of the given key of the events of the
+// given type must be indexed, regardless of their module.
+func (f EventIndexFilter) IsIndexed(eventType, attrKey string) bool {
+	return f.IsModuleEventIndexed("", eventType, attrKey)
+}
+
+// IsModuleEventIndexed returns true if the attribute of the given key of the
+// events of the given type and module must be indexed. The filter of the
+// module, if any, applies in addition to Allow and Deny.
+func (f EventIndexFilter) IsModuleEventIndexed(module, eventType, attrKey string) bool {
+	attr := fmt.Sprintf("%s.%s", eventType, attrKey)
+	moduleFilter := f.Modules[module]
+
+	for _, deny := range []map[string]struct{}{f.Deny, moduleFilter.Deny} {
+		if _, ok := deny[eventType]; ok {
+			return false
+		}
+		if _, ok := deny[attr]; ok {
+			return false
+		}
+	}
+
+	if len(f.Allow) == 0 && len(moduleFilter.Allow) == 0 {
+		return true
+	}
+
+	for _, allow := range []map[string]struct{}{f.Allow, moduleFilter.Allow} {
+		if _, ok := allow[eventType]; ok {
+			return true
+		}
+		if _, ok := allow[attr]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarkEventsToIndex returns the set of ABCI events, where each event's attribute
+// has it's index value marked based on the filter.
+func (f EventIndexFilter) MarkEventsToIndex(events []abci.Event) []abci.Event {
 	updatedEvents := make([]abci.Event, len(events))
 
 	for i, e := range events {
@@ -317,12 +401,12 @@ func MarkEventsToIndex(events []abci.Event, indexSet map[string]struct{}) []abci
 			Attributes: make([]abci.EventAttribute, len(e.Attributes)),
 		}
 
+		module := eventModule(e)
 		for j, attr := range e.Attributes {
-			_, index := indexSet[fmt.Sprintf("%s.%s", e.Type, attr.Key)]
 			updatedAttr := abci.EventAttribute{
 				Key:   attr.Key,
 				Value: attr.Value,
-				Index: index || indexAll,
+				Index: f.IsModuleEventIndexed(module, e.Type, string(attr.Key)),
 			}
 
 			updatedEvent.Attributes[j] = updatedAttr
@@ -333,3 +417,15 @@ func MarkEventsToIndex(events []abci.Event, indexSet map[string]struct{}) []abci
 
 	return updatedEvents
 }
+
+// eventModule returns the value of the module attribute of the given event, or
+// an empty string if it has none.
+func eventModule(e abci.Event) string {
+	for _, attr := range e.Attributes {
+		if string(attr.Key) == AttributeKeyModule {
+			return string(attr.Value)
+		}
+	}
+
+	return ""
+}
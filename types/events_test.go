@@ -3,6 +3,7 @@ package types_test
 import (
 	"encoding/json"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -219,3 +220,81 @@ func (s *eventsTestSuite) TestMarkEventsToIndex() {
 		})
 	}
 }
+
+func (s *eventsTestSuite) TestEventIndexFilter() {
+	testCases := map[string]struct {
+		allow, deny []string
+		expected    map[string]bool
+	}{
+		"no lists": {
+			expected: map[string]bool{"message.sender": true, "transfer.amount": true},
+		},
+		"allow event type": {
+			allow:    []string{"transfer"},
+			expected: map[string]bool{"message.sender": false, "transfer.amount": true, "transfer.recipient": true},
+		},
+		"allow attribute": {
+			allow:    []string{"transfer.recipient"},
+			expected: map[string]bool{"message.sender": false, "transfer.amount": false, "transfer.recipient": true},
+		},
+		"deny event type": {
+			deny:     []string{"coin_spent"},
+			expected: map[string]bool{"message.sender": true, "coin_spent.amount": false, "coin_spent.spender": false},
+		},
+		"deny takes precedence": {
+			allow:    []string{"transfer"},
+			deny:     []string{"transfer.amount"},
+			expected: map[string]bool{"message.sender": false, "transfer.amount": false, "transfer.recipient": true},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		s.T().Run(name, func(_ *testing.T) {
+			filter := sdk.NewEventIndexFilter(tc.allow, tc.deny)
+			for attr, indexed := range tc.expected {
+				parts := strings.SplitN(attr, ".", 2)
+				s.Require().Equal(indexed, filter.IsIndexed(parts[0], parts[1]), attr)
+			}
+		})
+	}
+}
+
+func (s *eventsTestSuite) TestEventIndexFilterModules() {
+	events := []abci.Event{
+		{
+			Type: "message",
+			Attributes: []abci.EventAttribute{
+				{Key: []byte("module"), Value: []byte("bank")},
+				{Key: []byte("sender"), Value: []byte("foo")},
+			},
+		},
+		{
+			Type: "message",
+			Attributes: []abci.EventAttribute{
+				{Key: []byte("module"), Value: []byte("staking")},
+				{Key: []byte("sender"), Value: []byte("bar")},
+			},
+		},
+		{
+			Type:       "transfer",
+			Attributes: []abci.EventAttribute{{Key: []byte("amount"), Value: []byte("5")}},
+		},
+	}
+
+	// the deny rule of bank doesn't apply to the message events of staking,
+	// nor does the allow rule of staking apply to the events of other modules
+	filter := sdk.EventIndexFilter{
+		Modules: map[string]sdk.EventIndexFilter{
+			"bank":    sdk.NewEventIndexFilter(nil, []string{"message"}),
+			"staking": sdk.NewEventIndexFilter([]string{"message.sender"}, nil),
+		},
+	}
+
+	marked := filter.MarkEventsToIndex(events)
+	s.Require().False(marked[0].Attributes[0].Index)
+	s.Require().False(marked[0].Attributes[1].Index)
+	s.Require().False(marked[1].Attributes[0].Index)
+	s.Require().True(marked[1].Attributes[1].Index)
+	s.Require().True(marked[2].Attributes[0].Index)
+}
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"encoding/hex"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// GasTracer records the gas consumed during the execution of a tx, per message
+// and per store. It is set on the Context of a tx with WithGasTracer, and is
+// not safe for concurrent use.
+type GasTracer struct {
+	msgs   []MsgGasUsage
+	stores map[string]Gas
+}
+
+// MsgGasUsage is the gas consumed by the execution of a message.
+type MsgGasUsage struct {
+	TypeURL string
+	GasUsed Gas
+}
+
+// StoreGasUsage is the gas consumed by the reads and writes of a store.
+type StoreGasUsage struct {
+	Store   string
+	GasUsed Gas
+}
+
+// NewGasTracer returns a new empty GasTracer.
+func NewGasTracer() *GasTracer {
+	return &GasTracer{stores: make(map[string]Gas)}
+}
+
+// AddMsg records the gas consumed by the execution of a message.
+func (t *GasTracer) AddMsg(typeURL string, gasUsed Gas) {
+	t.msgs = append(t.msgs, MsgGasUsage{TypeURL: typeURL, GasUsed: gasUsed})
+}
+
+// Msgs returns the gas consumed by each message, in execution order.
+func (t *GasTracer) Msgs() []MsgGasUsage {
+	return t.msgs
+}
+
+// Stores returns the gas consumed by each store, sorted by store name.
+func (t *GasTracer) Stores() []StoreGasUsage {
+	stores := make([]StoreGasUsage, 0, len(t.stores))
+	for store, gas := range t.stores {
+		stores = append(stores, StoreGasUsage{Store: store, GasUsed: gas})
+	}
+
+	sort.Slice(stores, func(i, j int) bool { return stores[i].Store < stores[j].Store })
+
+	return stores
+}
+
+// StoreGasMeter returns a gas meter consuming gas on the given meter, which
+// records the gas consumed on behalf of the given store.
+func (t *GasTracer) StoreGasMeter(store string, meter GasMeter) GasMeter {
+	return storeGasMeter{GasMeter: meter, tracer: t, store: store}
+}
+
+type storeGasMeter struct {
+	GasMeter
+	tracer *GasTracer
+	store  string
+}
+
+func (m storeGasMeter) ConsumeGas(amount Gas, descriptor string) {
+	m.GasMeter.ConsumeGas(amount, descriptor)
+	m.tracer.stores[m.store] += amount
+}
+
+// TxGasTrace is the gas consumed by a delivered tx, per message and per store.
+type TxGasTrace struct {
+	// Hash is the upper case hex-encoded hash of the tx.
+	Hash      string
+	Height    int64
+	GasWanted Gas
+	GasUsed   Gas
+	Msgs      []MsgGasUsage
+	Stores    []StoreGasUsage
+}
+
+// GasTraceStore retains the gas traces of the last delivered txs in memory. It
+// is safe for concurrent use.
+type GasTraceStore struct {
+	mu        sync.RWMutex
+	retention int
+	traces    map[string]*TxGasTrace
+	// hashes are the hashes of the retained traces, from oldest to newest
+	hashes []string
+}
+
+// NewGasTraceStore returns a GasTraceStore retaining the gas traces of the
+// given number of txs.
+func NewGasTraceStore(retention int) *GasTraceStore {
+	if retention <= 0 {
+		panic("gas trace retention must be positive")
+	}
+
+	return &GasTraceStore{
+		retention: retention,
+		traces:    make(map[string]*TxGasTrace, retention),
+	}
+}
+
+// Save saves the gas trace recorded by the given tracer for the tx of the given
+// hash, pruning the oldest trace if the retention is exceeded.
+func (s *GasTraceStore) Save(hash []byte, height int64, gasWanted, gasUsed Gas, tracer *GasTracer) {
+	trace := &TxGasTrace{
+		Hash:      strings.ToUpper(hex.EncodeToString(hash)),
+		Height:    height,
+		GasWanted: gasWanted,
+		GasUsed:   gasUsed,
+		Msgs:      tracer.Msgs(),
+		Stores:    tracer.Stores(),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.traces[trace.Hash]; !ok {
+		s.hashes = append(s.hashes, trace.Hash)
+	}
+	s.traces[trace.Hash] = trace
+
+	if len(s.hashes) > s.retention {
+		delete(s.traces, s.hashes[0])
+		s.hashes = s.hashes[1:]
+	}
+}
+
+// Get returns the gas trace of the tx of the given hex-encoded hash, or nil if
+// it isn't retained.
+func (s *GasTraceStore) Get(hash string) *TxGasTrace {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.traces[strings.ToUpper(hash)]
+}
@@ -41,6 +41,37 @@ func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
 	}
 }
 
+// PostHandler runs after the messages of a transaction were handled
+// successfully, in the same state branch: its state changes are committed with
+// the ones of the messages, and an error reverts both. It is used for tx-level
+// logic depending on the execution of the messages, e.g. fee refunds or tips.
+// If newCtx.IsZero(), ctx is used instead.
+type PostHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, err error)
+
+// PostDecorator wraps the next PostHandler to perform custom pre- and post-processing.
+type PostDecorator interface {
+	PostHandle(ctx Context, tx Tx, simulate bool, next PostHandler) (newCtx Context, err error)
+}
+
+// ChainPostDecorators chains PostDecorators together with each PostDecorator
+// wrapping over the decorators further along chain and returns a single
+// PostHandler. As for ChainAnteDecorators, the first element is the outermost
+// decorator. Returns nil when no PostDecorator are supplied.
+func ChainPostDecorators(chain ...PostDecorator) PostHandler {
+	if len(chain) == 0 {
+		return nil
+	}
+
+	return func(ctx Context, tx Tx, simulate bool) (Context, error) {
+		next := ChainPostDecorators(chain[1:]...)
+		if next == nil {
+			next = func(ctx Context, _ Tx, _ bool) (Context, error) { return ctx, nil }
+		}
+
+		return chain[0].PostHandle(ctx, tx, simulate, next)
+	}
+}
+
 // Terminator AnteDecorator will get added to the chain to simplify decorator code
 // Don't need to check if next == nil further up the chain
 //                        ______
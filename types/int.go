@@ -4,13 +4,24 @@ import (
 	"encoding"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"testing"
 
-	"math/big"
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const maxBitLen = 256
 
+// overflowError returns an ErrIntOverflow for the given operation, and counts
+// the overflow in the int_overflow telemetry counter.
+func overflowError(op string) error {
+	telemetry.IncrCounterWithLabels([]string{"int_overflow"}, 1, []metrics.Label{telemetry.NewLabel("op", op)})
+	return sdkerrors.Wrapf(sdkerrors.ErrIntOverflow, "%s overflows %d bits", op, maxBitLen)
+}
+
 func newIntegerFromString(s string) (*big.Int, bool) {
 	return new(big.Int).SetString(s, 0)
 }
@@ -274,6 +285,40 @@ func (i Int) Mul(i2 Int) (res Int) {
 	return
 }
 
+// SafeAdd adds Int from another and returns an error on overflow.
+func (i Int) SafeAdd(i2 Int) (Int, error) {
+	res := Int{add(i.i, i2.i)}
+	if res.i.BitLen() > maxBitLen {
+		return Int{}, overflowError("add")
+	}
+
+	return res, nil
+}
+
+// SafeSub subtracts Int from another and returns an error on overflow.
+func (i Int) SafeSub(i2 Int) (Int, error) {
+	res := Int{sub(i.i, i2.i)}
+	if res.i.BitLen() > maxBitLen {
+		return Int{}, overflowError("sub")
+	}
+
+	return res, nil
+}
+
+// SafeMul multiples two Ints and returns an error on overflow.
+func (i Int) SafeMul(i2 Int) (Int, error) {
+	if i.i.BitLen()+i2.i.BitLen()-1 > maxBitLen {
+		return Int{}, overflowError("mul")
+	}
+
+	res := Int{mul(i.i, i2.i)}
+	if res.i.BitLen() > maxBitLen {
+		return Int{}, overflowError("mul")
+	}
+
+	return res, nil
+}
+
 // MulRaw multipies Int and int64
 func (i Int) MulRaw(i2 int64) Int {
 	return i.Mul(NewInt(i2))
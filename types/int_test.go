@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 type intTestSuite struct {
@@ -104,6 +105,29 @@ func (s *intTestSuite) TestIntPanic() {
 // Since we are using *big.Int as underlying value
 // and (U/)Int is immutable value(see TestImmutability(U/)Int)
 // it is safe to use randomness in the tests
+func (s *intTestSuite) TestSafeArithInt() {
+	i1 := sdk.NewIntWithDecimal(5, 76)
+	i2 := sdk.NewIntWithDecimal(6, 76)
+
+	sum, err := i1.SafeAdd(i1)
+	s.Require().NoError(err)
+	s.Require().Equal(i1.Add(i1), sum)
+	_, err = i2.SafeAdd(i2)
+	s.Require().ErrorIs(err, sdkerrors.ErrIntOverflow)
+
+	diff, err := i1.SafeSub(i2)
+	s.Require().NoError(err)
+	s.Require().Equal(i1.Sub(i2), diff)
+	_, err = i2.Neg().SafeSub(i2)
+	s.Require().ErrorIs(err, sdkerrors.ErrIntOverflow)
+
+	prod, err := sdk.NewInt(2).SafeMul(i1)
+	s.Require().NoError(err)
+	s.Require().Equal(i1.MulRaw(2), prod)
+	_, err = i1.SafeMul(i1)
+	s.Require().ErrorIs(err, sdkerrors.ErrIntOverflow)
+}
+
 func (s *intTestSuite) TestIdentInt() {
 	for d := 0; d < 1000; d++ {
 		n := rand.Int63()
@@ -0,0 +1,166 @@
+// Package log provides loggers wrapping the Tendermint loggers used by the SDK.
+package log
+
+import (
+	"sync"
+	"time"
+
+	tmlog "github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// maxSamples is the number of distinct messages above which the samples of
+// the past periods are pruned.
+const maxSamples = 1000
+
+var _ tmlog.Logger = SamplingLogger{}
+
+// LevelLogger is a logger filtering its messages by level. The SamplingLogger
+// only samples the messages its LevelLogger logs, so that the filtered ones,
+// e.g. the debug messages of an info logger, don't count against the burst.
+type LevelLogger interface {
+	tmlog.Logger
+
+	// Enabled returns whether the messages of the given level, i.e. debug,
+	// info or error, are logged.
+	Enabled(level string) bool
+}
+
+// SamplingLogger is a logger rate limiting the identical messages, i.e. of the
+// same level and message regardless of their key/value pairs, so that hot
+// paths such as the rejections of the txs of the mempool cannot flood the
+// logs, e.g. during a spam attack.
+//
+// At most burst identical messages are logged per period, the next ones are
+// dropped and counted, and the first message logged in a following period
+// reports the number of the dropped ones in its "dropped" key. The loggers
+// returned by With share the samples of their parent.
+type SamplingLogger struct {
+	logger  tmlog.Logger
+	sampler *sampler
+}
+
+// NewSamplingLogger returns a logger logging at most burst identical messages
+// per period to the given logger.
+func NewSamplingLogger(logger tmlog.Logger, period time.Duration, burst int) SamplingLogger {
+	return SamplingLogger{
+		logger: logger,
+		sampler: &sampler{
+			period:  period,
+			burst:   burst,
+			samples: make(map[sampleKey]*sample),
+			now:     time.Now,
+		},
+	}
+}
+
+// Debug implements Logger.
+func (l SamplingLogger) Debug(msg string, keyVals ...interface{}) {
+	if !l.enabled("debug") {
+		return
+	}
+	if keyVals, ok := l.sampler.sample("debug", msg, keyVals); ok {
+		l.logger.Debug(msg, keyVals...)
+	}
+}
+
+// Info implements Logger.
+func (l SamplingLogger) Info(msg string, keyVals ...interface{}) {
+	if !l.enabled("info") {
+		return
+	}
+	if keyVals, ok := l.sampler.sample("info", msg, keyVals); ok {
+		l.logger.Info(msg, keyVals...)
+	}
+}
+
+// Error implements Logger.
+func (l SamplingLogger) Error(msg string, keyVals ...interface{}) {
+	if !l.enabled("error") {
+		return
+	}
+	if keyVals, ok := l.sampler.sample("error", msg, keyVals); ok {
+		l.logger.Error(msg, keyVals...)
+	}
+}
+
+// With implements Logger.
+func (l SamplingLogger) With(keyVals ...interface{}) tmlog.Logger {
+	return SamplingLogger{logger: l.logger.With(keyVals...), sampler: l.sampler}
+}
+
+// enabled returns whether the given level is logged by the wrapped logger,
+// which is assumed if it isn't a LevelLogger.
+func (l SamplingLogger) enabled(level string) bool {
+	logger, ok := l.logger.(LevelLogger)
+	return !ok || logger.Enabled(level)
+}
+
+type sampleKey struct {
+	level string
+	msg   string
+}
+
+type sample struct {
+	start   time.Time
+	logged  int
+	dropped uint64
+}
+
+// sampler counts the identical messages logged per period.
+type sampler struct {
+	mtx     sync.Mutex
+	period  time.Duration
+	burst   int
+	samples map[sampleKey]*sample
+	now     func() time.Time
+}
+
+// sample returns whether the message must be logged, and its key/value pairs
+// with the number of the identical messages dropped since it was last logged,
+// if any.
+func (s *sampler) sample(level, msg string, keyVals []interface{}) ([]interface{}, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	now := s.now()
+	key := sampleKey{level: level, msg: msg}
+	smp, ok := s.samples[key]
+	if !ok {
+		if len(s.samples) >= maxSamples {
+			s.prune(now)
+		}
+
+		smp = &sample{start: now}
+		s.samples[key] = smp
+	}
+
+	if now.Sub(smp.start) >= s.period {
+		smp.start = now
+		smp.logged = 0
+	}
+
+	if smp.logged >= s.burst {
+		smp.dropped++
+		telemetry.IncrCounter(1, "log", "dropped")
+		return nil, false
+	}
+
+	smp.logged++
+	if smp.dropped > 0 {
+		keyVals = append(keyVals[:len(keyVals):len(keyVals)], "dropped", smp.dropped)
+		smp.dropped = 0
+	}
+
+	return keyVals, true
+}
+
+// prune deletes the samples of the past periods without dropped messages.
+func (s *sampler) prune(now time.Time) {
+	for key, smp := range s.samples {
+		if now.Sub(smp.start) >= s.period && smp.dropped == 0 {
+			delete(s.samples, key)
+		}
+	}
+}
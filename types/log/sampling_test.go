@@ -0,0 +1,97 @@
+package log
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+// recordLogger records the logged messages.
+type recordLogger struct {
+	logs *[]string
+}
+
+func (l recordLogger) Debug(msg string, keyVals ...interface{}) { l.log("debug", msg, keyVals) }
+func (l recordLogger) Info(msg string, keyVals ...interface{})  { l.log("info", msg, keyVals) }
+func (l recordLogger) Error(msg string, keyVals ...interface{}) { l.log("error", msg, keyVals) }
+func (l recordLogger) With(...interface{}) tmlog.Logger         { return l }
+
+func (l recordLogger) log(level, msg string, keyVals []interface{}) {
+	*l.logs = append(*l.logs, fmt.Sprint(level, " ", msg, keyVals))
+}
+
+func TestSamplingLogger(t *testing.T) {
+	var logs []string
+	now := time.Unix(0, 0)
+	logger := NewSamplingLogger(recordLogger{&logs}, time.Second, 2)
+	logger.sampler.now = func() time.Time { return now }
+
+	for i := 0; i < 5; i++ {
+		logger.Info("rejected tx", "i", i)
+	}
+	// the samples are per level and message, and shared with the child loggers
+	logger.Error("rejected tx", "i", 0)
+	logger.With("module", "x/bank").Info("rejected tx", "i", 5)
+	logger.Info("other", "i", 0)
+
+	require.Equal(t, []string{
+		"info rejected tx[i 0]",
+		"info rejected tx[i 1]",
+		"error rejected tx[i 0]",
+		"info other[i 0]",
+	}, logs)
+
+	// the dropped messages are reported in the next period
+	now = now.Add(time.Second)
+	logs = nil
+	logger.Info("rejected tx", "i", 6)
+	logger.Info("rejected tx", "i", 7)
+	require.Equal(t, []string{
+		"info rejected tx[i 6 dropped 4]",
+		"info rejected tx[i 7]",
+	}, logs)
+}
+
+func TestSamplerPrune(t *testing.T) {
+	var logs []string
+	now := time.Unix(0, 0)
+	logger := NewSamplingLogger(recordLogger{&logs}, time.Second, 1)
+	logger.sampler.now = func() time.Time { return now }
+
+	logger.Info("dropped")
+	logger.Info("dropped")
+	for i := 1; i < maxSamples; i++ {
+		logger.Info(fmt.Sprint(i))
+	}
+	require.Len(t, logger.sampler.samples, maxSamples)
+
+	// the samples of the past periods are pruned, unless they dropped messages
+	now = now.Add(time.Second)
+	logger.Info("new")
+	require.Len(t, logger.sampler.samples, 2)
+}
+
+// infoLogger is a recordLogger filtering the debug messages.
+type infoLogger struct {
+	recordLogger
+}
+
+func (l infoLogger) Enabled(level string) bool { return level != "debug" }
+
+func TestSamplingLoggerFiltersBeforeSampling(t *testing.T) {
+	var logs []string
+	logger := NewSamplingLogger(infoLogger{recordLogger{&logs}}, time.Second, 1)
+
+	// the filtered messages are neither logged nor sampled
+	logger.Debug("rejected tx", "i", 0)
+	logger.Debug("rejected tx", "i", 1)
+	require.Empty(t, logger.sampler.samples)
+
+	logger.Info("rejected tx", "i", 0)
+	logger.Info("rejected tx", "i", 1)
+	require.Equal(t, []string{"info rejected tx[i 0]"}, logs)
+	require.Len(t, logger.sampler.samples, 1)
+}
@@ -31,7 +31,9 @@ package module
 import (
 	"encoding/json"
 	"fmt"
+	goruntime "runtime"
 	"sort"
+	"sync"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -97,11 +99,56 @@ func (bm BasicManager) DefaultGenesis(cdc codec.JSONCodec) map[string]json.RawMe
 	return genesis
 }
 
-// ValidateGenesis performs genesis state validation for all modules
+// ValidateGenesis performs genesis state validation for all modules, validating
+// one module per CPU concurrently. See ValidateGenesisWithWorkers.
 func (bm BasicManager) ValidateGenesis(cdc codec.JSONCodec, txEncCfg client.TxEncodingConfig, genesis map[string]json.RawMessage) error {
-	for _, b := range bm {
-		if err := b.ValidateGenesis(cdc, txEncCfg, genesis[b.Name()]); err != nil {
-			return err
+	return bm.ValidateGenesisWithWorkers(cdc, txEncCfg, genesis, goruntime.NumCPU())
+}
+
+// ValidateGenesisWithWorkers performs genesis state validation for all modules,
+// validating up to the given number of modules concurrently. The errors are
+// wrapped in a PathError of the module name, e.g. bank.balances[3].coins if the
+// module wraps its errors with the path of the invalid element, and the error
+// of the first module in alphabetical order is returned, so that the result
+// does not depend on the scheduling of the workers.
+func (bm BasicManager) ValidateGenesisWithWorkers(cdc codec.JSONCodec, txEncCfg client.TxEncodingConfig, genesis map[string]json.RawMessage, workers int) error {
+	if workers < 1 {
+		workers = 1
+	}
+
+	names := make([]string, 0, len(bm))
+	for name := range bm {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	errs := make([]error, len(names))
+	panics := make([]interface{}, len(names))
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for i, name := range names {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, name string) {
+			defer func() {
+				// the panics are raised again by the caller, which may
+				// recover from them
+				panics[i] = recover()
+				<-sem
+				wg.Done()
+			}()
+
+			errs[i] = bm[name].ValidateGenesis(cdc, txEncCfg, genesis[name])
+		}(i, name)
+	}
+	wg.Wait()
+
+	for i, name := range names {
+		if panics[i] != nil {
+			panic(panics[i])
+		}
+		if errs[i] != nil {
+			return sdkerrors.WrapPath(errs[i], name)
 		}
 	}
 
@@ -122,6 +169,43 @@ func (bm BasicManager) RegisterGRPCGatewayRoutes(clientCtx client.Context, rtr *
 	}
 }
 
+// RESTAlias defines an additional REST route served by forwarding requests to
+// a gRPC gateway route.
+type RESTAlias struct {
+	// Path is the route of the alias, which may contain path variables, e.g.
+	// "/bank/supply/{denom}".
+	Path string
+	// Target is the gRPC gateway route requests are forwarded to, in which the
+	// path variables of Path are substituted, e.g.
+	// "/cosmos/bank/v1beta1/supply/{denom}".
+	Target string
+	// TextField is the dot-separated path of a scalar field of the JSON
+	// response, e.g. "amount.amount". If set, the alias responds with the
+	// value of this field as text/plain instead of the JSON response, which is
+	// easier to consume for simple monitoring tools.
+	TextField string
+}
+
+// HasRESTAliases is the interface for modules registering REST route aliases
+// of their gRPC gateway routes.
+type HasRESTAliases interface {
+	RESTAliases() []RESTAlias
+}
+
+// RESTAliases returns the REST route aliases of all modules, sorted by path.
+func (bm BasicManager) RESTAliases() []RESTAlias {
+	var aliases []RESTAlias
+	for _, b := range bm {
+		if m, ok := b.(HasRESTAliases); ok {
+			aliases = append(aliases, m.RESTAliases()...)
+		}
+	}
+
+	sort.Slice(aliases, func(i, j int) bool { return aliases[i].Path < aliases[j].Path })
+
+	return aliases
+}
+
 // AddTxCommands adds all tx commands to the rootTxCmd.
 //
 // TODO: Remove clientCtx argument.
@@ -341,6 +425,17 @@ func (m *Manager) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) map[string
 	return genesisData
 }
 
+// ExportModuleGenesis returns the genesis state of a single module, exported
+// from the given context.
+func (m *Manager) ExportModuleGenesis(ctx sdk.Context, cdc codec.JSONCodec, moduleName string) (json.RawMessage, error) {
+	module, ok := m.Modules[moduleName]
+	if !ok {
+		return nil, fmt.Errorf("unknown module %s", moduleName)
+	}
+
+	return module.ExportGenesis(ctx, cdc), nil
+}
+
 // MigrationHandler is the migration function that each module registers.
 type MigrationHandler func(sdk.Context) error
 
@@ -3,6 +3,7 @@ package module_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/gorilla/mux"
@@ -18,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
@@ -56,7 +58,9 @@ func TestBasicManager(t *testing.T) {
 	var data map[string]string
 	require.Equal(t, map[string]string(nil), data)
 
-	require.True(t, errors.Is(errFoo, mm.ValidateGenesis(cdc, nil, wantDefaultGenesis)))
+	err := mm.ValidateGenesis(cdc, nil, wantDefaultGenesis)
+	require.True(t, errors.Is(err, errFoo))
+	require.EqualError(t, err, "mockAppModuleBasic1: dummy")
 
 	mm.RegisterRESTRoutes(client.Context{}, &mux.Router{})
 
@@ -69,6 +73,36 @@ func TestBasicManager(t *testing.T) {
 	require.Nil(t, module.NewBasicManager().ValidateGenesis(cdc, nil, wantDefaultGenesis))
 }
 
+func TestBasicManagerValidateGenesisWithWorkers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	genesis := make(map[string]json.RawMessage)
+	var modules []module.AppModuleBasic
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("module%d", i)
+		genesis[name] = json.RawMessage(fmt.Sprintf(`{"id":%d}`, i))
+
+		var err error
+		if i%3 == 2 {
+			err = sdkerrors.WrapPath(errFoo, "items", i)
+		}
+		mockModule := mocks.NewMockAppModuleBasic(mockCtrl)
+		mockModule.EXPECT().Name().AnyTimes().Return(name)
+		mockModule.EXPECT().ValidateGenesis(gomock.Eq(cdc), gomock.Eq(nil), gomock.Eq(genesis[name])).Times(2).Return(err)
+		modules = append(modules, mockModule)
+	}
+	mm := module.NewBasicManager(modules...)
+
+	// the error of the first invalid module is returned whatever the workers
+	for _, workers := range []int{1, 4} {
+		err := mm.ValidateGenesisWithWorkers(cdc, nil, genesis, workers)
+		require.True(t, errors.Is(err, errFoo))
+		require.EqualError(t, err, "module2.items[2]: dummy")
+	}
+}
+
 func TestGenesisOnlyAppModule(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -243,6 +277,30 @@ func TestManager_ExportGenesis(t *testing.T) {
 	require.Equal(t, want, mm.ExportGenesis(ctx, cdc))
 }
 
+func TestManager_ExportModuleGenesis(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+
+	ctx := sdk.Context{}
+	interfaceRegistry := types.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	mockAppModule2.EXPECT().ExportGenesis(gomock.Eq(ctx), gomock.Eq(cdc)).Times(1).Return(json.RawMessage(`{"key2": "value2"}`))
+
+	genesis, err := mm.ExportModuleGenesis(ctx, cdc, "module2")
+	require.NoError(t, err)
+	require.Equal(t, json.RawMessage(`{"key2": "value2"}`), genesis)
+
+	_, err = mm.ExportModuleGenesis(ctx, cdc, "module3")
+	require.Error(t, err)
+}
+
 func TestManager_BeginBlock(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -2,12 +2,14 @@ package module
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"math/rand"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
@@ -35,6 +37,8 @@ type AppModuleSimulation interface {
 type SimulationManager struct {
 	Modules       []AppModuleSimulation    // array of app modules; we use an array for deterministic simulation tests
 	StoreDecoders sdk.StoreDecoderRegistry // functions to decode the key-value pairs from each module's store
+
+	registered map[string]bool // names of the modules added with RegisterModule
 }
 
 // NewSimulationManager creates a new SimulationManager object
@@ -44,9 +48,29 @@ func NewSimulationManager(modules ...AppModuleSimulation) *SimulationManager {
 	return &SimulationManager{
 		Modules:       modules,
 		StoreDecoders: make(sdk.StoreDecoderRegistry),
+		registered:    make(map[string]bool),
 	}
 }
 
+// RegisterModule adds the simulation functionality of a module to an existing
+// SimulationManager, so that app-level simulations include modules which are
+// not known to the application constructor, e.g. third-party modules, without
+// forking it. Modules are simulated in the order they are registered, after
+// the modules passed to NewSimulationManager. It panics if a module with the
+// same name was already registered.
+func (sm *SimulationManager) RegisterModule(name string, module AppModuleSimulation) {
+	if sm.registered == nil {
+		sm.registered = make(map[string]bool)
+	}
+	if sm.registered[name] {
+		panic(fmt.Sprintf("simulation module %s already registered", name))
+	}
+
+	sm.registered[name] = true
+	sm.Modules = append(sm.Modules, module)
+	module.RegisterStoreDecoder(sm.StoreDecoders)
+}
+
 // GetProposalContents returns each module's proposal content generator function
 // with their default operation weight and key.
 func (sm *SimulationManager) GetProposalContents(simState SimulationState) []simulation.WeightedProposalContent {
@@ -110,3 +134,61 @@ type SimulationState struct {
 	ParamChanges []simulation.ParamChange             // simulated parameter changes from modules
 	Contents     []simulation.WeightedProposalContent // proposal content generator functions with their default weight and app sim key
 }
+
+// SimulationModule implements AppModuleSimulation from a set of functions,
+// generalizing the RandomGenesisAccountsFn pattern of the auth module. It allows
+// a module to provide only part of the simulation functionality, e.g. its
+// weighted operations, to SimulationManager.RegisterModule. Nil functions are
+// skipped.
+type SimulationModule struct {
+	GenesisFn            func(simState *SimulationState)
+	ProposalContentsFn   func(simState SimulationState) []simulation.WeightedProposalContent
+	RandomizedParamsFn   func(r *rand.Rand) []simulation.ParamChange
+	WeightedOperationsFn func(simState SimulationState) []simulation.WeightedOperation
+
+	StoreKey     string                        // store key name used to register StoreDecoder
+	StoreDecoder func(kvA, kvB kv.Pair) string // decoder of the key-value pairs of the module store
+}
+
+var _ AppModuleSimulation = SimulationModule{}
+
+// GenerateGenesisState implements AppModuleSimulation.
+func (m SimulationModule) GenerateGenesisState(simState *SimulationState) {
+	if m.GenesisFn != nil {
+		m.GenesisFn(simState)
+	}
+}
+
+// ProposalContents implements AppModuleSimulation.
+func (m SimulationModule) ProposalContents(simState SimulationState) []simulation.WeightedProposalContent {
+	if m.ProposalContentsFn == nil {
+		return nil
+	}
+
+	return m.ProposalContentsFn(simState)
+}
+
+// RandomizedParams implements AppModuleSimulation.
+func (m SimulationModule) RandomizedParams(r *rand.Rand) []simulation.ParamChange {
+	if m.RandomizedParamsFn == nil {
+		return nil
+	}
+
+	return m.RandomizedParamsFn(r)
+}
+
+// RegisterStoreDecoder implements AppModuleSimulation.
+func (m SimulationModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	if m.StoreDecoder != nil {
+		sdr[m.StoreKey] = m.StoreDecoder
+	}
+}
+
+// WeightedOperations implements AppModuleSimulation.
+func (m SimulationModule) WeightedOperations(simState SimulationState) []simulation.WeightedOperation {
+	if m.WeightedOperationsFn == nil {
+		return nil
+	}
+
+	return m.WeightedOperationsFn(simState)
+}
@@ -0,0 +1,54 @@
+package module_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+func TestSimulationManagerRegisterModule(t *testing.T) {
+	sm := module.NewSimulationManager()
+	sm.RegisterStoreDecoders()
+
+	opMsg := simtypes.NewOperationMsgBasic("custom", "noop", "", true, nil)
+	var genesisCalled bool
+	sm.RegisterModule("custom", module.SimulationModule{
+		GenesisFn: func(simState *module.SimulationState) {
+			genesisCalled = true
+		},
+		WeightedOperationsFn: func(simState module.SimulationState) []simtypes.WeightedOperation {
+			return []simtypes.WeightedOperation{
+				simulation.NewWeightedOperation(100, func(
+					r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+				) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+					return opMsg, nil, nil
+				}),
+			}
+		},
+		StoreKey: "custom",
+		StoreDecoder: func(kvA, kvB kv.Pair) string {
+			return "custom"
+		},
+	})
+
+	require.Len(t, sm.Modules, 1)
+	require.Contains(t, sm.StoreDecoders, "custom")
+
+	sm.GenerateGenesisStates(&module.SimulationState{})
+	require.True(t, genesisCalled)
+	require.Len(t, sm.WeightedOperations(module.SimulationState{}), 1)
+	require.Empty(t, sm.GetProposalContents(module.SimulationState{}))
+	require.Empty(t, sm.GenerateParamChanges(1))
+
+	require.Panics(t, func() {
+		sm.RegisterModule("custom", module.SimulationModule{})
+	})
+}
@@ -0,0 +1,465 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var _ CustomProtobufType = (*PrecDec)(nil)
+
+// MaxPrecDecPrecision is the maximum number of decimal places of a PrecDec.
+const MaxPrecDecPrecision = 36
+
+var (
+	precDecMultipliers []*big.Int
+	precDecMaxBitLens  []int
+)
+
+func init() {
+	precDecMultipliers = make([]*big.Int, MaxPrecDecPrecision+1)
+	precDecMaxBitLens = make([]int, MaxPrecDecPrecision+1)
+	for i := 0; i <= MaxPrecDecPrecision; i++ {
+		precDecMultipliers[i] = new(big.Int).Exp(tenInt, big.NewInt(int64(i)), nil)
+		precDecMaxBitLens[i] = maxBitLen + precDecMultipliers[i].BitLen()
+	}
+}
+
+// PrecDec is a decimal with a configurable number of decimal places, up to
+// MaxPrecDecPrecision. Unlike Dec, whose precision is fixed to Precision
+// decimal places, a PrecDec keeps the precision it is created with, so that
+// long-running accumulations (e.g. distribution rewards) can use more digits
+// than Dec. Operations on decimals of different precisions return a decimal of
+// the highest of the two precisions.
+//
+// The string representation of a PrecDec has exactly its number of decimal
+// places, and parsing it with ParsePrecDec returns the same decimal, with the
+// same precision.
+//
+// NOTE: never use new(PrecDec) or else we will panic unmarshalling into the
+// nil embedded big.Int
+type PrecDec struct {
+	i    *big.Int
+	prec int64
+}
+
+func checkPrecDecPrecision(prec int64) {
+	if prec < 0 || prec > MaxPrecDecPrecision {
+		panic(fmt.Sprintf("invalid precision; min: 0, max: %d, got: %d", MaxPrecDecPrecision, prec))
+	}
+}
+
+// newPrecDec returns a PrecDec, panicking if it is out of range.
+func newPrecDec(i *big.Int, prec int64) PrecDec {
+	if i.BitLen() > precDecMaxBitLens[prec] {
+		panic("PrecDec overflow")
+	}
+
+	return PrecDec{i, prec}
+}
+
+// ZeroPrecDec returns a zero PrecDec with prec decimal places.
+func ZeroPrecDec(prec int64) PrecDec {
+	checkPrecDecPrecision(prec)
+	return PrecDec{new(big.Int), prec}
+}
+
+// OnePrecDec returns a PrecDec equal to one with prec decimal places.
+func OnePrecDec(prec int64) PrecDec {
+	checkPrecDecPrecision(prec)
+	return PrecDec{new(big.Int).Set(precDecMultipliers[prec]), prec}
+}
+
+// NewPrecDecFromInt returns a PrecDec with prec decimal places equal to the
+// integer i.
+func NewPrecDecFromInt(i Int, prec int64) PrecDec {
+	checkPrecDecPrecision(prec)
+	return newPrecDec(new(big.Int).Mul(i.BigInt(), precDecMultipliers[prec]), prec)
+}
+
+// NewPrecDecFromStr creates a PrecDec with prec decimal places from a decimal
+// string of the form accepted by NewDecFromStr. An error is returned if the
+// string has more than prec decimal places, so that no digit is silently
+// dropped.
+func NewPrecDecFromStr(str string, prec int64) (PrecDec, error) {
+	if prec < 0 || prec > MaxPrecDecPrecision {
+		return PrecDec{}, fmt.Errorf("invalid precision; min: 0, max: %d, got: %d", MaxPrecDecPrecision, prec)
+	}
+	if len(str) == 0 {
+		return PrecDec{}, ErrEmptyDecimalStr
+	}
+
+	neg := false
+	if str[0] == '-' {
+		neg = true
+		str = str[1:]
+	}
+
+	if len(str) == 0 {
+		return PrecDec{}, ErrEmptyDecimalStr
+	}
+
+	strs := strings.Split(str, ".")
+	lenDecs := 0
+	combinedStr := strs[0]
+
+	if len(strs) == 2 { // has a decimal place
+		lenDecs = len(strs[1])
+		if lenDecs == 0 || len(combinedStr) == 0 {
+			return PrecDec{}, ErrInvalidDecimalLength
+		}
+		combinedStr += strs[1]
+	} else if len(strs) > 2 {
+		return PrecDec{}, ErrInvalidDecimalStr
+	}
+
+	if int64(lenDecs) > prec {
+		return PrecDec{}, fmt.Errorf("invalid precision; max: %d, got: %d", prec, lenDecs)
+	}
+
+	combinedStr += strings.Repeat("0", int(prec)-lenDecs)
+
+	combined, ok := new(big.Int).SetString(combinedStr, 10) // base 10
+	if !ok {
+		return PrecDec{}, fmt.Errorf("failed to set decimal string: %s", combinedStr)
+	}
+	if combined.BitLen() > precDecMaxBitLens[prec] {
+		return PrecDec{}, fmt.Errorf("decimal out of range; bitLen: got %d, max %d", combined.BitLen(), precDecMaxBitLens[prec])
+	}
+	if neg {
+		combined.Neg(combined)
+	}
+
+	return PrecDec{combined, prec}, nil
+}
+
+// ParsePrecDec creates a PrecDec from a decimal string, with as many decimal
+// places as the string has. It is the inverse of PrecDec.String.
+func ParsePrecDec(str string) (PrecDec, error) {
+	prec := 0
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		prec = len(str) - i - 1
+	}
+
+	return NewPrecDecFromStr(str, int64(prec))
+}
+
+// MustNewPrecDecFromStr creates a PrecDec from a decimal string, panicking on
+// error.
+func MustNewPrecDecFromStr(str string, prec int64) PrecDec {
+	d, err := NewPrecDecFromStr(str, prec)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// NewPrecDecFromDec converts a Dec to a PrecDec with prec decimal places. An
+// error is returned if prec is lower than Precision and the conversion would
+// drop non-zero digits.
+func NewPrecDecFromDec(d Dec, prec int64) (PrecDec, error) {
+	return PrecDec{d.BigInt(), Precision}.Rescale(prec)
+}
+
+// ToDec converts the PrecDec to a Dec. An error is returned if the PrecDec
+// has more than Precision decimal places and the conversion would drop
+// non-zero digits.
+func (d PrecDec) ToDec() (Dec, error) {
+	res, err := d.Rescale(Precision)
+	if err != nil {
+		return Dec{}, err
+	}
+
+	return Dec{res.i}, nil
+}
+
+// ToDecTruncate converts the PrecDec to a Dec, truncating the decimal places
+// beyond Precision.
+func (d PrecDec) ToDecTruncate() Dec {
+	return Dec{d.RescaleTruncate(Precision).i}
+}
+
+// Rescale returns the decimal with prec decimal places. An error is returned
+// if the decimal has non-zero digits beyond prec decimal places, or if it
+// overflows at prec decimal places.
+func (d PrecDec) Rescale(prec int64) (PrecDec, error) {
+	if prec < 0 || prec > MaxPrecDecPrecision {
+		return PrecDec{}, fmt.Errorf("invalid precision; min: 0, max: %d, got: %d", MaxPrecDecPrecision, prec)
+	}
+
+	if prec >= d.prec {
+		i := new(big.Int).Mul(d.i, precDecMultipliers[prec-d.prec])
+		if i.BitLen() > precDecMaxBitLens[prec] {
+			return PrecDec{}, fmt.Errorf("cannot rescale %s to %d decimal places: PrecDec overflow", d, prec)
+		}
+
+		return PrecDec{i, prec}, nil
+	}
+
+	quo, rem := new(big.Int).QuoRem(d.i, precDecMultipliers[d.prec-prec], new(big.Int))
+	if rem.Sign() != 0 {
+		return PrecDec{}, fmt.Errorf("cannot rescale %s to %d decimal places without losing precision", d, prec)
+	}
+
+	return PrecDec{quo, prec}, nil
+}
+
+// RescaleTruncate returns the decimal with prec decimal places, truncating
+// the digits beyond prec decimal places.
+func (d PrecDec) RescaleTruncate(prec int64) PrecDec {
+	checkPrecDecPrecision(prec)
+	if prec >= d.prec {
+		return newPrecDec(new(big.Int).Mul(d.i, precDecMultipliers[prec-d.prec]), prec)
+	}
+
+	return PrecDec{new(big.Int).Quo(d.i, precDecMultipliers[d.prec-prec]), prec}
+}
+
+// align returns the integers of d and d2 at the highest of their precisions.
+func (d PrecDec) align(d2 PrecDec) (*big.Int, *big.Int, int64) {
+	switch {
+	case d.prec > d2.prec:
+		return d.i, new(big.Int).Mul(d2.i, precDecMultipliers[d.prec-d2.prec]), d.prec
+	case d.prec < d2.prec:
+		return new(big.Int).Mul(d.i, precDecMultipliers[d2.prec-d.prec]), d2.i, d2.prec
+	default:
+		return d.i, d2.i, d.prec
+	}
+}
+
+func (d PrecDec) cmp(d2 PrecDec) int {
+	i, i2, _ := d.align(d2)
+	return i.Cmp(i2)
+}
+
+func (d PrecDec) Precision() int64      { return d.prec }     // number of decimal places
+func (d PrecDec) IsNil() bool           { return d.i == nil } // is decimal nil
+func (d PrecDec) IsZero() bool          { return d.i.Sign() == 0 }
+func (d PrecDec) IsNegative() bool      { return d.i.Sign() == -1 }
+func (d PrecDec) IsPositive() bool      { return d.i.Sign() == 1 }
+func (d PrecDec) Equal(d2 PrecDec) bool { return d.cmp(d2) == 0 }
+func (d PrecDec) GT(d2 PrecDec) bool    { return d.cmp(d2) > 0 }
+func (d PrecDec) GTE(d2 PrecDec) bool   { return d.cmp(d2) >= 0 }
+func (d PrecDec) LT(d2 PrecDec) bool    { return d.cmp(d2) < 0 }
+func (d PrecDec) LTE(d2 PrecDec) bool   { return d.cmp(d2) <= 0 }
+func (d PrecDec) Neg() PrecDec          { return PrecDec{new(big.Int).Neg(d.i), d.prec} }
+func (d PrecDec) Abs() PrecDec          { return PrecDec{new(big.Int).Abs(d.i), d.prec} }
+func (d PrecDec) BigInt() *big.Int      { return new(big.Int).Set(d.i) }
+
+// IsInteger returns true if the decimal has no fractional part.
+func (d PrecDec) IsInteger() bool {
+	return new(big.Int).Rem(d.i, precDecMultipliers[d.prec]).Sign() == 0
+}
+
+// TruncateInt truncates the decimal to an integer.
+func (d PrecDec) TruncateInt() Int {
+	return NewIntFromBigInt(new(big.Int).Quo(d.i, precDecMultipliers[d.prec]))
+}
+
+// RoundInt rounds the decimal half to even to an integer.
+func (d PrecDec) RoundInt() Int {
+	return NewIntFromBigInt(quoRoundHalfEven(d.i, precDecMultipliers[d.prec]))
+}
+
+// MulInt multiplies the decimal by an integer.
+func (d PrecDec) MulInt(i Int) PrecDec {
+	return newPrecDec(new(big.Int).Mul(d.i, i.BigInt()), d.prec)
+}
+
+// QuoInt divides the decimal by an integer, truncating the result.
+func (d PrecDec) QuoInt(i Int) PrecDec {
+	return PrecDec{new(big.Int).Quo(d.i, i.BigInt()), d.prec}
+}
+
+// Add adds two decimals.
+func (d PrecDec) Add(d2 PrecDec) PrecDec {
+	i, i2, prec := d.align(d2)
+	return newPrecDec(new(big.Int).Add(i, i2), prec)
+}
+
+// Sub subtracts d2 from d.
+func (d PrecDec) Sub(d2 PrecDec) PrecDec {
+	i, i2, prec := d.align(d2)
+	return newPrecDec(new(big.Int).Sub(i, i2), prec)
+}
+
+func (d PrecDec) maxPrec(d2 PrecDec) int64 {
+	return maxInt64(d.prec, d2.prec)
+}
+
+// Mul multiplies two decimals, rounding the result half to even.
+func (d PrecDec) Mul(d2 PrecDec) PrecDec {
+	prec := d.maxPrec(d2)
+	mul := new(big.Int).Mul(d.i, d2.i)
+	return newPrecDec(quoRoundHalfEven(mul, precDecMultipliers[d.prec+d2.prec-prec]), prec)
+}
+
+// MulTruncate multiplies two decimals, truncating the result.
+func (d PrecDec) MulTruncate(d2 PrecDec) PrecDec {
+	prec := d.maxPrec(d2)
+	mul := new(big.Int).Mul(d.i, d2.i)
+	return newPrecDec(mul.Quo(mul, precDecMultipliers[d.prec+d2.prec-prec]), prec)
+}
+
+// Quo divides two decimals, rounding the result half to even. It panics on
+// division by zero.
+func (d PrecDec) Quo(d2 PrecDec) PrecDec {
+	return newPrecDec(quoRoundHalfEven(d.scaledDividend(d2), d2.i), d.maxPrec(d2))
+}
+
+// QuoTruncate divides two decimals, truncating the result. It panics on
+// division by zero.
+func (d PrecDec) QuoTruncate(d2 PrecDec) PrecDec {
+	dividend := d.scaledDividend(d2)
+	return newPrecDec(dividend.Quo(dividend, d2.i), d.maxPrec(d2))
+}
+
+// scaledDividend returns the integer of d scaled such that dividing it by the
+// integer of d2 gives the integer of the quotient at the highest precision.
+func (d PrecDec) scaledDividend(d2 PrecDec) *big.Int {
+	if d2.IsZero() {
+		panic("division by zero")
+	}
+
+	// the scale goes up to twice MaxPrecDecPrecision, beyond the multipliers
+	scale := d.maxPrec(d2) + d2.prec - d.prec
+	if scale <= MaxPrecDecPrecision {
+		return new(big.Int).Mul(d.i, precDecMultipliers[scale])
+	}
+
+	return new(big.Int).Mul(d.i, new(big.Int).Exp(tenInt, big.NewInt(scale), nil))
+}
+
+// quoRoundHalfEven returns x / y rounded half to even, i.e. with bankers
+// rounding as done by Dec.
+func quoRoundHalfEven(x, y *big.Int) *big.Int {
+	quo, rem := new(big.Int).QuoRem(x, y, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	// compare the remainder to half of the divisor
+	cmp := new(big.Int).Abs(new(big.Int).Lsh(rem, 1)).Cmp(new(big.Int).Abs(y))
+	if cmp < 0 || (cmp == 0 && quo.Bit(0) == 0) {
+		return quo
+	}
+
+	// round away from zero
+	if (x.Sign() < 0) != (y.Sign() < 0) {
+		return quo.Sub(quo, oneInt)
+	}
+
+	return quo.Add(quo, oneInt)
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+// String returns the decimal with exactly its number of decimal places, e.g.
+// "-1.500" for a decimal of precision 3.
+func (d PrecDec) String() string {
+	if d.i == nil {
+		return d.i.String()
+	}
+
+	abs := new(big.Int).Abs(d.i).String()
+	if d.prec > 0 {
+		if len(abs) <= int(d.prec) {
+			abs = strings.Repeat("0", int(d.prec)-len(abs)+1) + abs
+		}
+		abs = abs[:len(abs)-int(d.prec)] + "." + abs[len(abs)-int(d.prec):]
+	}
+
+	if d.i.Sign() < 0 {
+		return "-" + abs
+	}
+
+	return abs
+}
+
+// Format implements fmt.Formatter.
+func (d PrecDec) Format(s fmt.State, verb rune) {
+	_, err := s.Write([]byte(d.String()))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// MarshalJSON marshals the decimal as a JSON string.
+func (d PrecDec) MarshalJSON() ([]byte, error) {
+	if d.i == nil {
+		return nilJSON, nil
+	}
+
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON defines custom decoding scheme
+func (d *PrecDec) UnmarshalJSON(bz []byte) error {
+	var text string
+	if err := json.Unmarshal(bz, &text); err != nil {
+		return err
+	}
+
+	newDec, err := ParsePrecDec(text)
+	if err != nil {
+		return err
+	}
+
+	*d = newDec
+	return nil
+}
+
+// MarshalYAML returns the YAML representation.
+func (d PrecDec) MarshalYAML() (interface{}, error) {
+	return d.String(), nil
+}
+
+// Marshal implements the gogo proto custom type interface.
+func (d PrecDec) Marshal() ([]byte, error) {
+	if d.i == nil {
+		d = ZeroPrecDec(0)
+	}
+
+	return []byte(d.String()), nil
+}
+
+// MarshalTo implements the gogo proto custom type interface.
+func (d *PrecDec) MarshalTo(data []byte) (n int, err error) {
+	bz, err := d.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	copy(data, bz)
+	return len(bz), nil
+}
+
+// Unmarshal implements the gogo proto custom type interface.
+func (d *PrecDec) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		*d = ZeroPrecDec(0)
+		return nil
+	}
+
+	newDec, err := ParsePrecDec(string(data))
+	if err != nil {
+		return err
+	}
+
+	*d = newDec
+	return nil
+}
+
+// Size implements the gogo proto custom type interface.
+func (d *PrecDec) Size() int {
+	bz, _ := d.Marshal()
+	return len(bz)
+}
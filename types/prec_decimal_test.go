@@ -0,0 +1,148 @@
+package types_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type precDecimalTestSuite struct {
+	suite.Suite
+}
+
+func TestPrecDecimalTestSuite(t *testing.T) {
+	suite.Run(t, new(precDecimalTestSuite))
+}
+
+func (s *precDecimalTestSuite) TestNewPrecDecFromStr() {
+	tests := []struct {
+		str       string
+		prec      int64
+		expString string
+		expErr    bool
+	}{
+		{"", 18, "", true},
+		{"-", 18, "", true},
+		{"0.", 18, "", true},
+		{".1", 18, "", true},
+		{"1.2.3", 18, "", true},
+		{"foo", 18, "", true},
+		{"1.5", 37, "", true},
+		{"1.234", 2, "", true},
+		{"0", 0, "0", false},
+		{"1.5", 3, "1.500", false},
+		{"-0.05", 2, "-0.05", false},
+		{"123.456", 34, "123.4560000000000000000000000000000000", false},
+		{"0.000000000000000000000000000000000001", 36, "0.000000000000000000000000000000000001", false},
+	}
+
+	for _, tc := range tests {
+		d, err := sdk.NewPrecDecFromStr(tc.str, tc.prec)
+		if tc.expErr {
+			s.Require().Error(err, tc.str)
+			continue
+		}
+
+		s.Require().NoError(err, tc.str)
+		s.Require().Equal(tc.expString, d.String())
+		s.Require().Equal(tc.prec, d.Precision())
+
+		// the string representation round-trips exactly
+		parsed, err := sdk.ParsePrecDec(d.String())
+		s.Require().NoError(err)
+		s.Require().Equal(d, parsed)
+	}
+}
+
+func (s *precDecimalTestSuite) TestArithmetic() {
+	a := sdk.MustNewPrecDecFromStr("1", 34)
+	b := sdk.MustNewPrecDecFromStr("3", 34)
+
+	third := a.Quo(b)
+	s.Require().Equal("0.3333333333333333333333333333333333", third.String())
+	s.Require().Equal("0.6666666666666666666666666666666666", third.Add(third).String())
+	s.Require().Equal("0.6666666666666666666666666666666667", a.Sub(third).String())
+	s.Require().Equal("0.9999999999999999999999999999999999", third.Mul(b).String())
+	s.Require().Equal("0.6666666666666666666666666666666666", sdk.MustNewPrecDecFromStr("2", 34).QuoTruncate(b).String())
+
+	// operations on different precisions use the highest precision
+	c := sdk.MustNewPrecDecFromStr("0.5", 1)
+	s.Require().Equal(int64(34), c.Add(third).Precision())
+	s.Require().Equal("0.1666666666666666666666666666666666", c.MulTruncate(third).String())
+	s.Require().True(c.GT(third))
+	s.Require().True(c.Equal(sdk.MustNewPrecDecFromStr("0.50", 2)))
+
+	// rounding is half to even
+	half, sevenTenths := sdk.MustNewPrecDecFromStr("0.5", 1), sdk.MustNewPrecDecFromStr("0.7", 1)
+	s.Require().Equal("0.2", half.Mul(half).String())
+	s.Require().Equal("0.4", half.Mul(sevenTenths).String())
+	s.Require().Equal("-0.4", half.Neg().Mul(sevenTenths).String())
+	s.Require().Equal(sdk.NewInt(2), sdk.MustNewPrecDecFromStr("2.5", 1).RoundInt())
+	s.Require().Equal(sdk.NewInt(2), sdk.MustNewPrecDecFromStr("2.9", 1).TruncateInt())
+
+	// the dividend is scaled beyond MaxPrecDecPrecision for mixed precisions
+	e := sdk.MustNewPrecDecFromStr("1", 0)
+	f := sdk.MustNewPrecDecFromStr("3", sdk.MaxPrecDecPrecision)
+	s.Require().Equal("0.333333333333333333333333333333333333", e.Quo(f).String())
+	s.Require().Equal("0.333333333333333333333333333333333333", e.QuoTruncate(f).String())
+	s.Require().Equal("3.000000000000000000000000000000000000", f.Quo(e).String())
+	s.Require().Equal("0.000000000000000000000000000000000001", sdk.MustNewPrecDecFromStr("0.000000000000000000000000000000000001", 36).QuoTruncate(e).String())
+
+	s.Require().Panics(func() { a.Quo(sdk.ZeroPrecDec(34)) })
+	s.Require().Panics(func() { sdk.ZeroPrecDec(sdk.MaxPrecDecPrecision + 1) })
+}
+
+func (s *precDecimalTestSuite) TestDecConversion() {
+	d := sdk.MustNewDecFromStr("1.123456789012345678")
+
+	p, err := sdk.NewPrecDecFromDec(d, 34)
+	s.Require().NoError(err)
+	s.Require().Equal("1.1234567890123456780000000000000000", p.String())
+
+	back, err := p.ToDec()
+	s.Require().NoError(err)
+	s.Require().Equal(d, back)
+
+	_, err = sdk.NewPrecDecFromDec(d, 6)
+	s.Require().Error(err)
+
+	third := sdk.OnePrecDec(34).Quo(sdk.MustNewPrecDecFromStr("3", 0))
+	_, err = third.ToDec()
+	s.Require().Error(err)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.333333333333333333"), third.ToDecTruncate())
+
+	rescaled, err := sdk.MustNewPrecDecFromStr("1.5000", 4).Rescale(1)
+	s.Require().NoError(err)
+	s.Require().Equal("1.5", rescaled.String())
+	s.Require().Equal("1.2", sdk.MustNewPrecDecFromStr("1.29", 2).RescaleTruncate(1).String())
+
+	// rescaling to a higher precision may overflow
+	maxInt := sdk.NewPrecDecFromInt(sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))), 0)
+	_, err = maxInt.Add(maxInt).Rescale(sdk.MaxPrecDecPrecision)
+	s.Require().Error(err)
+	s.Require().Panics(func() { maxInt.Add(maxInt).RescaleTruncate(sdk.MaxPrecDecPrecision) })
+}
+
+func (s *precDecimalTestSuite) TestEncoding() {
+	d := sdk.MustNewPrecDecFromStr("-12.3450", 4)
+
+	bz, err := json.Marshal(d)
+	s.Require().NoError(err)
+	s.Require().Equal(`"-12.3450"`, string(bz))
+
+	var fromJSON sdk.PrecDec
+	s.Require().NoError(json.Unmarshal(bz, &fromJSON))
+	s.Require().Equal(d, fromJSON)
+
+	bz, err = d.Marshal()
+	s.Require().NoError(err)
+	s.Require().Equal(len(bz), d.Size())
+
+	var fromProto sdk.PrecDec
+	s.Require().NoError(fromProto.Unmarshal(bz))
+	s.Require().Equal(d, fromProto)
+}
@@ -0,0 +1,55 @@
+package query
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Counter is the number of entries of a collection, persisted in a store under
+// a key and maintained by the keeper as it adds and removes entries, so that
+// the paginated queries of the collection can return its total with WithTotal
+// without iterating over all its entries.
+type Counter struct {
+	store types.KVStore
+	key   []byte
+}
+
+// NewCounter returns the counter persisted in the store under the key.
+func NewCounter(store types.KVStore, key []byte) Counter {
+	return Counter{store: store, key: key}
+}
+
+// Get returns the count, which is zero if it was never set.
+func (c Counter) Get() uint64 {
+	bz := c.store.Get(c.key)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// Set sets the count.
+func (c Counter) Set(count uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	c.store.Set(c.key, bz)
+}
+
+// Increment increments the count by one.
+func (c Counter) Increment() {
+	c.Set(c.Get() + 1)
+}
+
+// Decrement decrements the count by one. It panics if the count is zero, as
+// the keeper then removed an entry it did not count.
+func (c Counter) Decrement() {
+	count := c.Get()
+	if count == 0 {
+		panic(fmt.Errorf("counter %X cannot be decremented below zero", c.key))
+	}
+
+	c.Set(count - 1)
+}
@@ -34,6 +34,9 @@ func FilteredPaginate(
 	if offset > 0 && key != nil {
 		return nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
 	}
+	if err := checkOffset(offset); err != nil {
+		return nil, err
+	}
 
 	if limit == 0 {
 		limit = DefaultLimit
@@ -0,0 +1,172 @@
+package query
+
+import (
+	"fmt"
+
+	db "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// MaxOffset is the maximum offset of the requests paginated with an offset.
+// Offset pagination is deprecated: the results preceding the offset are
+// skipped one by one, so larger offsets are rejected and clients must
+// paginate with the next key of the previous page instead.
+var MaxOffset uint64 = 10000
+
+// PaginateOption configures the pagination of the results of a store.
+type PaginateOption func(*paginateOptions)
+
+type paginateOptions struct {
+	total    uint64
+	hasTotal bool
+}
+
+// WithTotal sets the total number of results of the store, e.g. read from a
+// Counter maintained by the keeper. The total is then returned to the requests
+// counting it whether they paginate with a key or an offset, without iterating
+// over all the results of the store.
+func WithTotal(total uint64) PaginateOption {
+	return func(opts *paginateOptions) {
+		opts.total = total
+		opts.hasTotal = true
+	}
+}
+
+// checkOffset returns an error if the offset of the request exceeds MaxOffset.
+func checkOffset(offset uint64) error {
+	if offset > MaxOffset {
+		return status.Errorf(codes.InvalidArgument, "offset %d exceeds the maximum offset %d, paginate with the next key instead", offset, MaxOffset)
+	}
+
+	return nil
+}
+
+// PageIterator iterates over the results of a store in the page of a
+// PageRequest, for the keepers whose queries cannot paginate with a callback.
+// The results are consumed by calling Next, and once the iteration is done,
+// PageResponse returns the response of the page, whose next key is the first
+// result that was not consumed.
+//
+//	it, err := query.NewPageIterator(store, req.Pagination)
+//	if err != nil {
+//		return nil, err
+//	}
+//	defer it.Close()
+//
+//	for ; it.Valid(); it.Next() {
+//		...
+//	}
+//
+//	pageRes, err := it.PageResponse()
+type PageIterator struct {
+	iterator db.Iterator
+	opts     paginateOptions
+
+	limit      uint64
+	countTotal bool
+	withKey    bool
+
+	skipped uint64
+	count   uint64
+}
+
+// NewPageIterator returns an iterator over the results of the store in the page
+// of the request, which defaults to the first DefaultLimit results if nil.
+func NewPageIterator(store types.KVStore, pageRequest *PageRequest, opts ...PaginateOption) (*PageIterator, error) {
+	if pageRequest == nil {
+		pageRequest = &PageRequest{}
+	}
+
+	if pageRequest.Offset > 0 && pageRequest.Key != nil {
+		return nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	if err := checkOffset(pageRequest.Offset); err != nil {
+		return nil, err
+	}
+
+	it := &PageIterator{
+		limit:      pageRequest.Limit,
+		countTotal: pageRequest.CountTotal,
+		withKey:    len(pageRequest.Key) != 0,
+	}
+	for _, opt := range opts {
+		opt(&it.opts)
+	}
+
+	if it.limit == 0 {
+		it.limit = DefaultLimit
+
+		// count total results when the limit is zero/not supplied
+		it.countTotal = true
+	}
+
+	it.iterator = getIterator(store, pageRequest.Key, pageRequest.Reverse)
+	for ; it.skipped < pageRequest.Offset && it.iterator.Valid(); it.iterator.Next() {
+		it.skipped++
+	}
+
+	return it, nil
+}
+
+// Valid returns whether the iterator is positioned on a result of the page.
+func (it *PageIterator) Valid() bool {
+	return it.count < it.limit && it.iterator.Valid()
+}
+
+// Next consumes the current result and moves to the next one.
+func (it *PageIterator) Next() {
+	it.count++
+	it.iterator.Next()
+}
+
+// Key returns the key of the current result.
+func (it *PageIterator) Key() []byte {
+	return it.iterator.Key()
+}
+
+// Value returns the value of the current result.
+func (it *PageIterator) Value() []byte {
+	return it.iterator.Value()
+}
+
+// Error returns the error of the underlying iterator of the store while it is
+// valid.
+func (it *PageIterator) Error() error {
+	return it.iterator.Error()
+}
+
+// Close closes the iterator.
+func (it *PageIterator) Close() error {
+	return it.iterator.Close()
+}
+
+// PageResponse returns the response of the page once the iteration is done.
+// Its next key is the key of the first result that wasn't consumed, if any.
+// If the request counts the total, the total is the one set with WithTotal or
+// else counted by iterating over the remaining results of the store, which is
+// only possible when paginating with an offset.
+func (it *PageIterator) PageResponse() (*PageResponse, error) {
+	res := &PageResponse{}
+	if it.iterator.Valid() {
+		res.NextKey = it.iterator.Key()
+	}
+
+	switch {
+	case !it.countTotal:
+	case it.opts.hasTotal:
+		res.Total = it.opts.total
+	case !it.withKey:
+		res.Total = it.skipped + it.count
+		for ; it.iterator.Valid(); it.iterator.Next() {
+			if err := it.iterator.Error(); err != nil {
+				return nil, err
+			}
+			res.Total++
+		}
+	}
+
+	return res, nil
+}
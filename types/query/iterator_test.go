@@ -0,0 +1,123 @@
+package query_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func newPaginatedStore(n int) dbadapter.Store {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := 0; i < n; i++ {
+		store.Set([]byte(fmt.Sprintf("key%02d", i)), []byte(fmt.Sprintf("value%02d", i)))
+	}
+
+	return store
+}
+
+func TestPageIterator(t *testing.T) {
+	store := newPaginatedStore(10)
+
+	it, err := query.NewPageIterator(store, &query.PageRequest{Limit: 4, CountTotal: true})
+	require.NoError(t, err)
+	var keys []string
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	res, err := it.PageResponse()
+	require.NoError(t, err)
+	require.NoError(t, it.Close())
+	require.Equal(t, []string{"key00", "key01", "key02", "key03"}, keys)
+	require.Equal(t, &query.PageResponse{NextKey: []byte("key04"), Total: 10}, res)
+
+	// the next key is the first result that wasn't consumed
+	it, err = query.NewPageIterator(store, &query.PageRequest{Key: res.NextKey, Limit: 4})
+	require.NoError(t, err)
+	require.True(t, it.Valid())
+	require.Equal(t, []byte("value04"), it.Value())
+	it.Next()
+	res, err = it.PageResponse()
+	require.NoError(t, err)
+	require.NoError(t, it.Close())
+	require.Equal(t, &query.PageResponse{NextKey: []byte("key05")}, res)
+
+	// the total set by the keeper is returned when paginating with a key
+	it, err = query.NewPageIterator(store, &query.PageRequest{Key: []byte("key08"), CountTotal: true}, query.WithTotal(10))
+	require.NoError(t, err)
+	for ; it.Valid(); it.Next() {
+	}
+	res, err = it.PageResponse()
+	require.NoError(t, err)
+	require.NoError(t, it.Close())
+	require.Equal(t, &query.PageResponse{Total: 10}, res)
+
+	// the total of an offset past the end counts all the results
+	it, err = query.NewPageIterator(store, &query.PageRequest{Offset: 20, Limit: 4, CountTotal: true})
+	require.NoError(t, err)
+	require.False(t, it.Valid())
+	res, err = it.PageResponse()
+	require.NoError(t, err)
+	require.NoError(t, it.Close())
+	require.Equal(t, &query.PageResponse{Total: 10}, res)
+}
+
+// errStore is a store whose iterators fail.
+type errStore struct {
+	dbadapter.Store
+}
+
+func (s errStore) Iterator(start, end []byte) storetypes.Iterator {
+	return errIterator{s.Store.Iterator(start, end)}
+}
+
+type errIterator struct {
+	storetypes.Iterator
+}
+
+func (errIterator) Error() error { return errors.New("iterator failure") }
+
+func TestPaginateIteratorError(t *testing.T) {
+	store := errStore{newPaginatedStore(10)}
+
+	_, err := query.Paginate(store, &query.PageRequest{Limit: 4}, func(key, value []byte) error {
+		return nil
+	})
+	require.EqualError(t, err, "iterator failure")
+}
+
+func TestPaginateMaxOffset(t *testing.T) {
+	store := newPaginatedStore(1)
+	onResult := func(key, value []byte) error { return nil }
+
+	_, err := query.Paginate(store, &query.PageRequest{Offset: query.MaxOffset}, onResult)
+	require.NoError(t, err)
+
+	_, err = query.Paginate(store, &query.PageRequest{Offset: query.MaxOffset + 1}, onResult)
+	require.Error(t, err)
+
+	_, err = query.FilteredPaginate(store, &query.PageRequest{Offset: query.MaxOffset + 1}, func(key, value []byte, accumulate bool) (bool, error) {
+		return true, nil
+	})
+	require.Error(t, err)
+}
+
+func TestCounter(t *testing.T) {
+	counter := query.NewCounter(newPaginatedStore(0), []byte("count"))
+	require.Equal(t, uint64(0), counter.Get())
+	require.Panics(t, counter.Decrement)
+
+	counter.Increment()
+	counter.Increment()
+	counter.Decrement()
+	require.Equal(t, uint64(1), counter.Get())
+
+	counter.Set(42)
+	require.Equal(t, uint64(42), counter.Get())
+}
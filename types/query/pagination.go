@@ -1,7 +1,6 @@
 package query
 
 import (
-	"fmt"
 	"math"
 
 	db "github.com/tendermint/tm-db"
@@ -49,100 +48,29 @@ func ParsePagination(pageReq *PageRequest) (page, limit int, err error) {
 
 // Paginate does pagination of all the results in the PrefixStore based on the
 // provided PageRequest. onResult should be used to do actual unmarshaling.
+// The total of the results can be set with WithTotal to avoid counting them.
 func Paginate(
 	prefixStore types.KVStore,
 	pageRequest *PageRequest,
 	onResult func(key []byte, value []byte) error,
+	opts ...PaginateOption,
 ) (*PageResponse, error) {
-
-	// if the PageRequest is nil, use default PageRequest
-	if pageRequest == nil {
-		pageRequest = &PageRequest{}
-	}
-
-	offset := pageRequest.Offset
-	key := pageRequest.Key
-	limit := pageRequest.Limit
-	countTotal := pageRequest.CountTotal
-	reverse := pageRequest.Reverse
-
-	if offset > 0 && key != nil {
-		return nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
-	}
-
-	if limit == 0 {
-		limit = DefaultLimit
-
-		// count total results when the limit is zero/not supplied
-		countTotal = true
+	iterator, err := NewPageIterator(prefixStore, pageRequest, opts...)
+	if err != nil {
+		return nil, err
 	}
-
-	if len(key) != 0 {
-		iterator := getIterator(prefixStore, key, reverse)
-		defer iterator.Close()
-
-		var count uint64
-		var nextKey []byte
-
-		for ; iterator.Valid(); iterator.Next() {
-
-			if count == limit {
-				nextKey = iterator.Key()
-				break
-			}
-			if iterator.Error() != nil {
-				return nil, iterator.Error()
-			}
-			err := onResult(iterator.Key(), iterator.Value())
-			if err != nil {
-				return nil, err
-			}
-
-			count++
-		}
-
-		return &PageResponse{
-			NextKey: nextKey,
-		}, nil
-	}
-
-	iterator := getIterator(prefixStore, nil, reverse)
 	defer iterator.Close()
 
-	end := offset + limit
-
-	var count uint64
-	var nextKey []byte
-
 	for ; iterator.Valid(); iterator.Next() {
-		count++
-
-		if count <= offset {
-			continue
+		if err := iterator.Error(); err != nil {
+			return nil, err
 		}
-		if count <= end {
-			err := onResult(iterator.Key(), iterator.Value())
-			if err != nil {
-				return nil, err
-			}
-		} else if count == end+1 {
-			nextKey = iterator.Key()
-
-			if !countTotal {
-				break
-			}
+		if err := onResult(iterator.Key(), iterator.Value()); err != nil {
+			return nil, err
 		}
-		if iterator.Error() != nil {
-			return nil, iterator.Error()
-		}
-	}
-
-	res := &PageResponse{NextKey: nextKey}
-	if countTotal {
-		res.Total = count
 	}
 
-	return res, nil
+	return iterator.PageResponse()
 }
 
 func getIterator(prefixStore types.KVStore, start []byte, reverse bool) db.Iterator {
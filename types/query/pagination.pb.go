@@ -31,20 +31,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 //  }
 type PageRequest struct {
 	// key is a value returned in PageResponse.next_key to begin
-	// querying the next page most efficiently. Only one of offset or key
-	// should be set.
+	// querying the next page. It is an opaque cursor that clients must not
+	// construct or interpret. Only one of offset or key should be set.
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	// offset is a numeric offset that can be used when key is unavailable.
-	// It is less efficient than using key. Only one of offset or key should
-	// be set.
+	// Deprecated: the results preceding the offset are skipped one by one,
+	// so nodes reject offsets above their maximum offset. Paginate with key
+	// instead. Only one of offset or key should be set.
 	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	// limit is the total number of results to be returned in the result page.
 	// If left empty it will default to a value to be set by each app.
 	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	// count_total is set to true  to indicate that the result set should include
 	// a count of the total number of items available for pagination in UIs.
-	// count_total is only respected when offset is used. It is ignored when key
-	// is set.
+	// When key is set, count_total is only respected by the queries whose
+	// total is maintained by the module.
 	CountTotal bool `protobuf:"varint,4,opt,name=count_total,json=countTotal,proto3" json:"count_total,omitempty"`
 	// reverse is set to true if results are to be returned in the descending order.
 	Reverse bool `protobuf:"varint,5,opt,name=reverse,proto3" json:"reverse,omitempty"`
@@ -126,8 +127,8 @@ func (m *PageRequest) GetReverse() bool {
 //          PageResponse page = 2;
 //  }
 type PageResponse struct {
-	// next_key is the key to be passed to PageRequest.key to
-	// query the next page most efficiently
+	// next_key is the opaque cursor to be passed to PageRequest.key to
+	// query the next page. It is empty on the last page.
 	NextKey []byte `protobuf:"bytes,1,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
 	// total is total number of results available if PageRequest.count_total
 	// was set, its value is undefined otherwise
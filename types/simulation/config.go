@@ -1,5 +1,7 @@
 package simulation
 
+import "time"
+
 // Config contains the necessary configuration flags for the simulator
 type Config struct {
 	GenesisFile string // custom simulation genesis file; cannot be used with params file
@@ -9,6 +11,7 @@ type Config struct {
 	ExportParamsHeight int    // height to which export the randomly generated params
 	ExportStatePath    string // custom file path to save the exported app state JSON
 	ExportStatsPath    string // custom file path to save the exported simulation statistics JSON
+	ExportScenarioPath string // custom file path to save the blocks and txs of the simulation as a scenario JSON
 
 	Seed               int64  // simulation random seed
 	InitialBlockHeight int    // initial block to start the simulation
@@ -21,4 +24,10 @@ type Config struct {
 
 	OnOperation   bool // run slow invariants every operation
 	AllInvariants bool // print all failed invariants if a broken invariant is found
+
+	// ClockSkew is the maximum duration the block times jump forward by at
+	// random blocks, on top of the regular block intervals, as if the chain
+	// halted, so that the modules are exercised with gaps between blocks
+	// and block times far from the local time. It is disabled if zero.
+	ClockSkew time.Duration
 }
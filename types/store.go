@@ -167,3 +167,13 @@ type (
 func NewInfiniteGasMeter() GasMeter {
 	return types.NewInfiniteGasMeter()
 }
+
+type (
+	ChildGasMeter    = types.ChildGasMeter
+	GasBreakdown     = types.GasBreakdown
+	GasCategoryUsage = types.GasCategoryUsage
+)
+
+func NewChildGasMeter(parent GasMeter, name string) *ChildGasMeter {
+	return types.NewChildGasMeter(parent, name)
+}
@@ -0,0 +1,6 @@
+package tx
+
+// TxExtensionOptionI defines the interface of the tx extension options. The
+// types of the extension options supported by an app must be registered as its
+// implementations, so that the txs holding them can be decoded.
+type TxExtensionOptionI interface{}
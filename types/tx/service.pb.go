@@ -551,6 +551,121 @@ func (m *GetTxResponse) GetTxResponse() *types.TxResponse {
 	return nil
 }
 
+// PendingSequencesRequest is the request type for the Service.PendingSequences
+// RPC method.
+type PendingSequencesRequest struct {
+	// address is the account address to query, encoded as a bech32 string.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *PendingSequencesRequest) Reset()         { *m = PendingSequencesRequest{} }
+func (m *PendingSequencesRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingSequencesRequest) ProtoMessage()    {}
+func (*PendingSequencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{8}
+}
+func (m *PendingSequencesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSequencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSequencesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSequencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSequencesRequest.Merge(m, src)
+}
+func (m *PendingSequencesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSequencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSequencesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSequencesRequest proto.InternalMessageInfo
+
+func (m *PendingSequencesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// PendingSequencesResponse is the response type for the
+// Service.PendingSequences RPC method.
+type PendingSequencesResponse struct {
+	// sequence is the next sequence expected on-chain for the account.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// pending_sequences are the sequences of the account's txs found in the
+	// node's mempool, in ascending order.
+	PendingSequences []uint64 `protobuf:"varint,2,rep,packed,name=pending_sequences,json=pendingSequences,proto3" json:"pending_sequences,omitempty"`
+	// missing_sequences are the sequences between the on-chain sequence and the
+	// highest pending sequence which have no tx in the mempool. Pending txs with
+	// a sequence above a missing one cannot be included until the gap is filled.
+	MissingSequences []uint64 `protobuf:"varint,3,rep,packed,name=missing_sequences,json=missingSequences,proto3" json:"missing_sequences,omitempty"`
+}
+
+func (m *PendingSequencesResponse) Reset()         { *m = PendingSequencesResponse{} }
+func (m *PendingSequencesResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingSequencesResponse) ProtoMessage()    {}
+func (*PendingSequencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{9}
+}
+func (m *PendingSequencesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingSequencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingSequencesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingSequencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingSequencesResponse.Merge(m, src)
+}
+func (m *PendingSequencesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingSequencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingSequencesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingSequencesResponse proto.InternalMessageInfo
+
+func (m *PendingSequencesResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PendingSequencesResponse) GetPendingSequences() []uint64 {
+	if m != nil {
+		return m.PendingSequences
+	}
+	return nil
+}
+
+func (m *PendingSequencesResponse) GetMissingSequences() []uint64 {
+	if m != nil {
+		return m.MissingSequences
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
 	golang_proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
@@ -572,6 +687,10 @@ func init() {
 	golang_proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
 	golang_proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
+	proto.RegisterType((*PendingSequencesRequest)(nil), "cosmos.tx.v1beta1.PendingSequencesRequest")
+	golang_proto.RegisterType((*PendingSequencesRequest)(nil), "cosmos.tx.v1beta1.PendingSequencesRequest")
+	proto.RegisterType((*PendingSequencesResponse)(nil), "cosmos.tx.v1beta1.PendingSequencesResponse")
+	golang_proto.RegisterType((*PendingSequencesResponse)(nil), "cosmos.tx.v1beta1.PendingSequencesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
@@ -580,59 +699,66 @@ func init() {
 }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x5e, 0x3b, 0x65, 0x93, 0xbe, 0x64, 0x4b, 0x3a, 0xbb, 0x14, 0x93, 0x82, 0x37, 0x75, 0xc9,
-	0x36, 0x44, 0xc2, 0x56, 0x03, 0x48, 0x08, 0x71, 0x89, 0x93, 0x74, 0x59, 0x41, 0x9b, 0x6a, 0xb2,
-	0x08, 0x15, 0x21, 0x45, 0x4e, 0x32, 0xf5, 0x5a, 0x6c, 0x3c, 0x59, 0xcf, 0x64, 0xe5, 0xa8, 0xad,
-	0x90, 0x38, 0x72, 0x42, 0xe2, 0x67, 0xf0, 0x27, 0x38, 0x72, 0x5c, 0x89, 0x0b, 0x47, 0xb4, 0xe1,
-	0x47, 0x70, 0x44, 0x1e, 0x4f, 0x12, 0x27, 0xeb, 0x74, 0x11, 0xa7, 0xbc, 0xc9, 0x7c, 0xef, 0x7b,
-	0xdf, 0xfb, 0xe6, 0xcd, 0x18, 0xf6, 0x07, 0x94, 0x8d, 0x28, 0xb3, 0x78, 0x68, 0x9d, 0x3f, 0xec,
-	0x13, 0xee, 0x3c, 0xb4, 0x18, 0x09, 0xce, 0xbd, 0x01, 0x31, 0xc7, 0x01, 0xe5, 0x14, 0xdd, 0x8e,
-	0x01, 0x26, 0x0f, 0x4d, 0x09, 0x28, 0xbd, 0xeb, 0x52, 0xea, 0x9e, 0x12, 0xcb, 0x19, 0x7b, 0x96,
-	0xe3, 0xfb, 0x94, 0x3b, 0xdc, 0xa3, 0x3e, 0x8b, 0x13, 0x4a, 0xf7, 0x25, 0x63, 0xdf, 0x61, 0xc4,
-	0x72, 0xfa, 0x03, 0x6f, 0x41, 0x1c, 0x2d, 0x24, 0xa8, 0x74, 0xb5, 0x2c, 0x0f, 0xe5, 0xde, 0x9e,
-	0x4b, 0x5d, 0x2a, 0x42, 0x2b, 0x8a, 0xe4, 0xbf, 0xb5, 0x24, 0xed, 0xd9, 0x84, 0x04, 0xd3, 0x45,
-	0xe6, 0xd8, 0x71, 0x3d, 0x5f, 0x68, 0x88, 0xb1, 0xc6, 0xaf, 0x0a, 0xa0, 0x43, 0xc2, 0x8f, 0x43,
-	0xd6, 0x3e, 0x27, 0x3e, 0xc7, 0xe4, 0x6c, 0x42, 0x18, 0x47, 0x77, 0x60, 0x9b, 0x44, 0x6b, 0xa6,
-	0x29, 0xe5, 0x4c, 0xf5, 0x26, 0x96, 0x2b, 0xf4, 0x08, 0x60, 0x49, 0xa1, 0xa9, 0x65, 0xa5, 0x9a,
-	0xaf, 0x1f, 0x98, 0xb2, 0xef, 0xa8, 0x9e, 0x29, 0xea, 0xcd, 0xfb, 0x37, 0x9f, 0x3a, 0x2e, 0x91,
-	0x9c, 0x38, 0x91, 0x89, 0x3e, 0x81, 0x1c, 0x0d, 0x86, 0x24, 0xe8, 0xf5, 0xa7, 0x5a, 0xa6, 0xac,
-	0x54, 0x6f, 0xd5, 0x4b, 0xe6, 0x15, 0xf7, 0xcc, 0x4e, 0x04, 0xb1, 0xa7, 0x38, 0x4b, 0xe3, 0xc0,
-	0xb8, 0x50, 0x60, 0x77, 0x45, 0x2d, 0x1b, 0x53, 0x9f, 0x11, 0xf4, 0x00, 0x32, 0x3c, 0x8c, 0xb5,
-	0xe6, 0xeb, 0x6f, 0xa5, 0x30, 0x1d, 0x87, 0x38, 0x42, 0xa0, 0x43, 0x28, 0xf0, 0xb0, 0x17, 0xc8,
-	0x3c, 0xa6, 0xa9, 0x22, 0xe3, 0xfd, 0x95, 0x0e, 0x84, 0xf7, 0x89, 0x44, 0x09, 0xc6, 0x79, 0xbe,
-	0x88, 0x23, 0xa2, 0xa4, 0x11, 0x19, 0x61, 0xc4, 0x83, 0x6b, 0x8d, 0x90, 0x4c, 0x89, 0x54, 0x83,
-	0x00, 0xb2, 0x03, 0xea, 0x0c, 0x07, 0x0e, 0xe3, 0x51, 0xb1, 0xd8, 0xff, 0x77, 0x20, 0xc7, 0xc3,
-	0x5e, 0x7f, 0xca, 0x49, 0xd4, 0x95, 0x52, 0x2d, 0xe0, 0x2c, 0x0f, 0xed, 0x68, 0x89, 0x3e, 0x86,
-	0x1b, 0x23, 0x3a, 0x24, 0xc2, 0xfc, 0x5b, 0xf5, 0x72, 0x4a, 0xb3, 0x0b, 0xbe, 0xc7, 0x74, 0x48,
-	0xb0, 0x40, 0x1b, 0xdf, 0xc1, 0xee, 0x4a, 0x19, 0x69, 0x5c, 0x1b, 0xf2, 0x09, 0x3f, 0x44, 0xa9,
-	0xff, 0x6a, 0x07, 0x2c, 0xed, 0x30, 0xbe, 0x81, 0x37, 0xbb, 0xde, 0x68, 0x72, 0xea, 0xf0, 0xf9,
-	0x69, 0xa3, 0x0f, 0x40, 0xe5, 0xa1, 0x24, 0x4c, 0x3f, 0x11, 0x5b, 0xd5, 0x14, 0xac, 0xf2, 0x70,
-	0xa5, 0x59, 0x75, 0xa5, 0x59, 0xe3, 0x27, 0x05, 0x8a, 0x4b, 0x66, 0x29, 0xfa, 0x73, 0xc8, 0xb9,
-	0x0e, 0xeb, 0x79, 0xfe, 0x73, 0x2a, 0x0b, 0xdc, 0xdb, 0xac, 0xf8, 0xd0, 0x61, 0x47, 0xfe, 0x73,
-	0x8a, 0xb3, 0x6e, 0x1c, 0xa0, 0x4f, 0x61, 0x3b, 0x20, 0x6c, 0x72, 0xca, 0xe5, 0xf8, 0x96, 0x37,
-	0xe7, 0x62, 0x81, 0xc3, 0x12, 0x6f, 0x18, 0x50, 0x10, 0xc3, 0x37, 0x6f, 0x11, 0xc1, 0x8d, 0x13,
-	0x87, 0x9d, 0x08, 0x0d, 0x37, 0xb1, 0x88, 0x8d, 0x57, 0xb0, 0x23, 0x31, 0x52, 0x6c, 0xe5, 0x5a,
-	0x1f, 0x84, 0x07, 0x6b, 0x07, 0xa1, 0xfe, 0xbf, 0x83, 0xa8, 0x7d, 0x01, 0x59, 0x79, 0x69, 0x90,
-	0x06, 0x7b, 0x1d, 0xdc, 0x6a, 0xe3, 0x9e, 0xfd, 0xac, 0xf7, 0xf5, 0x93, 0xee, 0xd3, 0x76, 0xf3,
-	0xe8, 0xd1, 0x51, 0xbb, 0x55, 0xdc, 0x42, 0x45, 0x28, 0x2c, 0x76, 0x1a, 0xdd, 0x66, 0x51, 0x41,
-	0xb7, 0x61, 0x67, 0xf1, 0x4f, 0xab, 0xdd, 0x6d, 0x16, 0xd5, 0xda, 0x4b, 0xd8, 0x59, 0x99, 0x23,
-	0xa4, 0x43, 0xc9, 0xc6, 0x9d, 0x46, 0xab, 0xd9, 0xe8, 0x1e, 0xf7, 0x1e, 0x77, 0x5a, 0xed, 0x35,
-	0x56, 0x0d, 0xf6, 0xd6, 0xf6, 0xed, 0xaf, 0x3a, 0xcd, 0x2f, 0x8b, 0x0a, 0x7a, 0x1b, 0x76, 0xd7,
-	0x76, 0xba, 0xcf, 0x9e, 0x34, 0x8b, 0x6a, 0x4a, 0x4a, 0x43, 0xec, 0x64, 0xea, 0xff, 0x64, 0x20,
-	0xdb, 0x8d, 0x1f, 0x57, 0xf4, 0x02, 0x72, 0xf3, 0x11, 0x40, 0x46, 0x8a, 0x83, 0x6b, 0x93, 0x57,
-	0xba, 0xff, 0x5a, 0x8c, 0x9c, 0xd8, 0x83, 0x1f, 0xff, 0xf8, 0xfb, 0x17, 0xb5, 0x6c, 0xdc, 0xb5,
-	0x52, 0x5e, 0x75, 0x09, 0xfe, 0x4c, 0xa9, 0xa1, 0x33, 0x78, 0x43, 0x9c, 0x27, 0xda, 0x4f, 0x61,
-	0x4d, 0x4e, 0x43, 0xa9, 0xbc, 0x19, 0x20, 0x6b, 0x56, 0x44, 0xcd, 0x7d, 0xf4, 0x9e, 0x95, 0xf6,
-	0xa4, 0x33, 0xeb, 0x45, 0x34, 0x41, 0xaf, 0xd0, 0x0f, 0x90, 0x4f, 0x5c, 0x55, 0x54, 0x79, 0xdd,
-	0x0d, 0x5f, 0x96, 0x3f, 0xb8, 0x0e, 0x26, 0x45, 0xdc, 0x13, 0x22, 0xee, 0x1a, 0x77, 0xd2, 0x45,
-	0x44, 0x3d, 0xbf, 0x84, 0x7c, 0xe2, 0x91, 0x4d, 0x15, 0x70, 0xf5, 0x93, 0x91, 0x2a, 0x20, 0xe5,
-	0xad, 0x36, 0x74, 0x21, 0x40, 0x43, 0x1b, 0x04, 0xd8, 0xcd, 0xdf, 0x2f, 0x75, 0xe5, 0xe2, 0x52,
-	0x57, 0xfe, 0xba, 0xd4, 0x95, 0x9f, 0x67, 0xfa, 0xd6, 0x6f, 0x33, 0x5d, 0xb9, 0x98, 0xe9, 0x5b,
-	0x7f, 0xce, 0xf4, 0xad, 0x6f, 0x2b, 0xae, 0xc7, 0x4f, 0x26, 0x7d, 0x73, 0x40, 0x47, 0xf3, 0xfc,
-	0xf8, 0xe7, 0x43, 0x36, 0xfc, 0xde, 0xe2, 0xd3, 0x31, 0x89, 0x08, 0xfb, 0xdb, 0xe2, 0xeb, 0xf6,
-	0xd1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x86, 0xcd, 0x5c, 0xb4, 0x07, 0x00, 0x00,
+	// 938 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xae, 0xd3, 0xd8, 0x7d, 0x4e, 0x8a, 0x33, 0x09, 0xed, 0xb2, 0x85, 0x8d, 0xbb, 0x25,
+	0x69, 0x70, 0xc5, 0x2e, 0x75, 0x01, 0x21, 0xc4, 0x25, 0xfe, 0xd1, 0x10, 0x41, 0xeb, 0x68, 0x1c,
+	0x84, 0x8a, 0x90, 0xac, 0xb5, 0x3d, 0xdd, 0xac, 0x88, 0x77, 0x9c, 0x9d, 0x71, 0xb4, 0x56, 0x1a,
+	0x21, 0x71, 0xe4, 0x54, 0x89, 0x3b, 0x17, 0x8e, 0xfc, 0x13, 0x1c, 0x39, 0x46, 0xe2, 0xc2, 0x11,
+	0x25, 0xfc, 0x21, 0x68, 0x67, 0xc7, 0xce, 0xda, 0x5e, 0x37, 0x15, 0x27, 0xcf, 0x8f, 0xef, 0x7d,
+	0xef, 0x7b, 0xdf, 0xcc, 0xdb, 0x31, 0x6c, 0x74, 0x28, 0xeb, 0x51, 0x66, 0xf3, 0xd0, 0x3e, 0x79,
+	0xd4, 0x26, 0xdc, 0x79, 0x64, 0x33, 0x12, 0x9c, 0x78, 0x1d, 0x62, 0xf5, 0x03, 0xca, 0x29, 0x5a,
+	0x8d, 0x01, 0x16, 0x0f, 0x2d, 0x09, 0xd0, 0xdf, 0x75, 0x29, 0x75, 0x8f, 0x88, 0xed, 0xf4, 0x3d,
+	0xdb, 0xf1, 0x7d, 0xca, 0x1d, 0xee, 0x51, 0x9f, 0xc5, 0x01, 0xfa, 0x7d, 0xc9, 0xd8, 0x76, 0x18,
+	0xb1, 0x9d, 0x76, 0xc7, 0x1b, 0x13, 0x47, 0x13, 0x09, 0xd2, 0x67, 0xd3, 0xf2, 0x50, 0xee, 0xad,
+	0xbb, 0xd4, 0xa5, 0x62, 0x68, 0x47, 0x23, 0xb9, 0x5a, 0x4a, 0xd2, 0x1e, 0x0f, 0x48, 0x30, 0x1c,
+	0x47, 0xf6, 0x1d, 0xd7, 0xf3, 0x85, 0x86, 0x18, 0x6b, 0xfe, 0xae, 0x00, 0xda, 0x25, 0xfc, 0x20,
+	0x64, 0xf5, 0x13, 0xe2, 0x73, 0x4c, 0x8e, 0x07, 0x84, 0x71, 0x74, 0x1b, 0x96, 0x48, 0x34, 0x67,
+	0x9a, 0x52, 0xcc, 0x6c, 0xdf, 0xc4, 0x72, 0x86, 0x9e, 0x00, 0x5c, 0x51, 0x68, 0x6a, 0x51, 0xd9,
+	0xce, 0x97, 0xb7, 0x2c, 0x59, 0x77, 0x94, 0xcf, 0x12, 0xf9, 0x46, 0xf5, 0x5b, 0xfb, 0x8e, 0x4b,
+	0x24, 0x27, 0x4e, 0x44, 0xa2, 0x4f, 0x20, 0x47, 0x83, 0x2e, 0x09, 0x5a, 0xed, 0xa1, 0x96, 0x29,
+	0x2a, 0xdb, 0xb7, 0xca, 0xba, 0x35, 0xe3, 0x9e, 0xd5, 0x88, 0x20, 0x95, 0x21, 0xce, 0xd2, 0x78,
+	0x60, 0x9e, 0x2b, 0xb0, 0x36, 0xa1, 0x96, 0xf5, 0xa9, 0xcf, 0x08, 0x7a, 0x00, 0x19, 0x1e, 0xc6,
+	0x5a, 0xf3, 0xe5, 0xb7, 0x53, 0x98, 0x0e, 0x42, 0x1c, 0x21, 0xd0, 0x2e, 0x2c, 0xf3, 0xb0, 0x15,
+	0xc8, 0x38, 0xa6, 0xa9, 0x22, 0xe2, 0xfd, 0x89, 0x0a, 0x84, 0xf7, 0x89, 0x40, 0x09, 0xc6, 0x79,
+	0x3e, 0x1e, 0x47, 0x44, 0x49, 0x23, 0x32, 0xc2, 0x88, 0x07, 0xd7, 0x1a, 0x21, 0x99, 0x12, 0xa1,
+	0x26, 0x01, 0x54, 0x09, 0xa8, 0xd3, 0xed, 0x38, 0x8c, 0x1f, 0x84, 0xd2, 0x2b, 0xf4, 0x0e, 0xe4,
+	0x78, 0xd8, 0x6a, 0x0f, 0x39, 0x89, 0xaa, 0x52, 0xb6, 0x97, 0x71, 0x96, 0x87, 0x95, 0x68, 0x8a,
+	0x3e, 0x86, 0xc5, 0x1e, 0xed, 0x12, 0x61, 0xfe, 0xad, 0x72, 0x31, 0xa5, 0xd8, 0x31, 0xdf, 0x53,
+	0xda, 0x25, 0x58, 0xa0, 0xcd, 0xef, 0x61, 0x6d, 0x22, 0x8d, 0x34, 0xae, 0x0e, 0xf9, 0x84, 0x1f,
+	0x22, 0xd5, 0x9b, 0xda, 0x01, 0x57, 0x76, 0x98, 0xdf, 0xc2, 0x5b, 0x4d, 0xaf, 0x37, 0x38, 0x72,
+	0xf8, 0xe8, 0xb4, 0xd1, 0x07, 0xa0, 0xf2, 0x50, 0x12, 0xa6, 0x9f, 0x48, 0x45, 0xd5, 0x14, 0xac,
+	0xf2, 0x70, 0xa2, 0x58, 0x75, 0xa2, 0x58, 0xf3, 0x67, 0x05, 0x0a, 0x57, 0xcc, 0x52, 0xf4, 0x17,
+	0x90, 0x73, 0x1d, 0xd6, 0xf2, 0xfc, 0x17, 0x54, 0x26, 0xb8, 0x37, 0x5f, 0xf1, 0xae, 0xc3, 0xf6,
+	0xfc, 0x17, 0x14, 0x67, 0xdd, 0x78, 0x80, 0x3e, 0x83, 0xa5, 0x80, 0xb0, 0xc1, 0x11, 0x97, 0xd7,
+	0xb7, 0x38, 0x3f, 0x16, 0x0b, 0x1c, 0x96, 0x78, 0xd3, 0x84, 0x65, 0x71, 0xf9, 0x46, 0x25, 0x22,
+	0x58, 0x3c, 0x74, 0xd8, 0xa1, 0xd0, 0x70, 0x13, 0x8b, 0xb1, 0x79, 0x06, 0x2b, 0x12, 0x23, 0xc5,
+	0x6e, 0x5e, 0xeb, 0x83, 0xf0, 0x60, 0xea, 0x20, 0xd4, 0xff, 0x79, 0x10, 0x8f, 0xe1, 0xce, 0x3e,
+	0xf1, 0xbb, 0x9e, 0xef, 0x36, 0x23, 0x91, 0x7e, 0x87, 0xb0, 0x91, 0x5a, 0x0d, 0xb2, 0x4e, 0xb7,
+	0x1b, 0x10, 0xc6, 0xa4, 0xe0, 0xd1, 0xd4, 0x7c, 0xa5, 0x80, 0x36, 0x1b, 0x25, 0xf5, 0xeb, 0x90,
+	0x63, 0x72, 0x51, 0xc4, 0x2d, 0xe2, 0xf1, 0x1c, 0x3d, 0x84, 0xd5, 0x7e, 0x1c, 0xd7, 0x1a, 0xad,
+	0xc5, 0x2d, 0xb5, 0x88, 0x0b, 0xfd, 0x29, 0xc2, 0x08, 0xdc, 0xf3, 0x18, 0x9b, 0x04, 0x67, 0x62,
+	0xb0, 0xdc, 0x18, 0x83, 0x4b, 0x5f, 0x42, 0x56, 0x36, 0x3f, 0xd2, 0x60, 0xbd, 0x81, 0x6b, 0x75,
+	0xdc, 0xaa, 0x3c, 0x6f, 0x7d, 0xf3, 0xac, 0xb9, 0x5f, 0xaf, 0xee, 0x3d, 0xd9, 0xab, 0xd7, 0x0a,
+	0x0b, 0xa8, 0x00, 0xcb, 0xe3, 0x9d, 0x9d, 0x66, 0xb5, 0xa0, 0xa0, 0x55, 0x58, 0x19, 0xaf, 0xd4,
+	0xea, 0xcd, 0x6a, 0x41, 0x2d, 0xbd, 0x84, 0x95, 0x89, 0x7e, 0x40, 0x06, 0xe8, 0x15, 0xdc, 0xd8,
+	0xa9, 0x55, 0x77, 0x9a, 0x07, 0xad, 0xa7, 0x8d, 0x5a, 0x7d, 0x8a, 0x55, 0x83, 0xf5, 0xa9, 0xfd,
+	0xca, 0xd7, 0x8d, 0xea, 0x57, 0x05, 0x05, 0xdd, 0x81, 0xb5, 0xa9, 0x9d, 0xe6, 0xf3, 0x67, 0xd5,
+	0x82, 0x9a, 0x12, 0xb2, 0x23, 0x76, 0x32, 0xe5, 0x5f, 0x6f, 0x40, 0xb6, 0x19, 0x3f, 0x12, 0xe8,
+	0x14, 0x72, 0xa3, 0xab, 0x8c, 0xcc, 0x94, 0x9b, 0x30, 0xd5, 0x41, 0xfa, 0xfd, 0xd7, 0x62, 0xe4,
+	0x81, 0x6f, 0xfd, 0xf4, 0xd7, 0xbf, 0xbf, 0xa8, 0x45, 0xf3, 0xae, 0x9d, 0xf2, 0x3a, 0x49, 0xf0,
+	0xe7, 0x4a, 0x09, 0x1d, 0xc3, 0x0d, 0x71, 0x2f, 0xd1, 0x46, 0x0a, 0x6b, 0xf2, 0x56, 0xeb, 0xc5,
+	0xf9, 0x00, 0x99, 0x73, 0x53, 0xe4, 0xdc, 0x40, 0xef, 0xd9, 0x69, 0x4f, 0x13, 0xb3, 0x4f, 0xa3,
+	0x4e, 0x38, 0x43, 0x3f, 0x42, 0x3e, 0xf1, 0xc9, 0x41, 0x9b, 0xaf, 0xfb, 0x52, 0x5d, 0xa5, 0xdf,
+	0xba, 0x0e, 0x26, 0x45, 0xdc, 0x13, 0x22, 0xee, 0x9a, 0xb7, 0xd3, 0x45, 0x44, 0x35, 0xbf, 0x84,
+	0x7c, 0xe2, 0xb1, 0x48, 0x15, 0x30, 0xfb, 0xf4, 0xe9, 0x5b, 0xd7, 0xc1, 0xa4, 0x00, 0x43, 0x08,
+	0xd0, 0xd0, 0x1c, 0x01, 0xe8, 0x37, 0x05, 0x0a, 0xd3, 0x5d, 0x85, 0x4a, 0x29, 0xe4, 0x73, 0x1a,
+	0x56, 0x7f, 0xf8, 0x46, 0x58, 0xa9, 0xe6, 0x53, 0xa1, 0xe6, 0x23, 0x64, 0xa5, 0xa8, 0x99, 0xe9,
+	0x51, 0xfb, 0x54, 0xb6, 0xfe, 0x59, 0xa5, 0xfa, 0xe7, 0x85, 0xa1, 0x9c, 0x5f, 0x18, 0xca, 0x3f,
+	0x17, 0x86, 0xf2, 0xea, 0xd2, 0x58, 0xf8, 0xe3, 0xd2, 0x50, 0xce, 0x2f, 0x8d, 0x85, 0xbf, 0x2f,
+	0x8d, 0x85, 0xef, 0x36, 0x5d, 0x8f, 0x1f, 0x0e, 0xda, 0x56, 0x87, 0xf6, 0x46, 0xbc, 0xf1, 0xcf,
+	0x87, 0xac, 0xfb, 0x83, 0xcd, 0x87, 0x7d, 0x12, 0x25, 0x6a, 0x2f, 0x89, 0xff, 0x12, 0x8f, 0xff,
+	0x1b, 0x00, 0x65, 0xc8, 0x83, 0x58, 0x22, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -655,6 +781,10 @@ type ServiceClient interface {
 	BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error)
 	// GetTxsEvent fetches txs by event.
 	GetTxsEvent(ctx context.Context, in *GetTxsEventRequest, opts ...grpc.CallOption) (*GetTxsEventResponse, error)
+	// PendingSequences returns the on-chain sequence of an account along with
+	// the sequences of its txs in the node's mempool, so that clients can detect
+	// stuck or nonce-gapped txs.
+	PendingSequences(ctx context.Context, in *PendingSequencesRequest, opts ...grpc.CallOption) (*PendingSequencesResponse, error)
 }
 
 type serviceClient struct {
@@ -701,6 +831,15 @@ func (c *serviceClient) GetTxsEvent(ctx context.Context, in *GetTxsEventRequest,
 	return out, nil
 }
 
+func (c *serviceClient) PendingSequences(ctx context.Context, in *PendingSequencesRequest, opts ...grpc.CallOption) (*PendingSequencesResponse, error) {
+	out := new(PendingSequencesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/PendingSequences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
@@ -711,6 +850,10 @@ type ServiceServer interface {
 	BroadcastTx(context.Context, *BroadcastTxRequest) (*BroadcastTxResponse, error)
 	// GetTxsEvent fetches txs by event.
 	GetTxsEvent(context.Context, *GetTxsEventRequest) (*GetTxsEventResponse, error)
+	// PendingSequences returns the on-chain sequence of an account along with
+	// the sequences of its txs in the node's mempool, so that clients can detect
+	// stuck or nonce-gapped txs.
+	PendingSequences(context.Context, *PendingSequencesRequest) (*PendingSequencesResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -729,6 +872,9 @@ func (*UnimplementedServiceServer) BroadcastTx(ctx context.Context, req *Broadca
 func (*UnimplementedServiceServer) GetTxsEvent(ctx context.Context, req *GetTxsEventRequest) (*GetTxsEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTxsEvent not implemented")
 }
+func (*UnimplementedServiceServer) PendingSequences(ctx context.Context, req *PendingSequencesRequest) (*PendingSequencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingSequences not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -806,6 +952,24 @@ func _Service_GetTxsEvent_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_PendingSequences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingSequencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).PendingSequences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/PendingSequences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).PendingSequences(ctx, req.(*PendingSequencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.tx.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -826,6 +990,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxsEvent",
 			Handler:    _Service_GetTxsEvent_Handler,
 		},
+		{
+			MethodName: "PendingSequences",
+			Handler:    _Service_PendingSequences_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
@@ -1179,6 +1347,100 @@ func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingSequencesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSequencesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSequencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingSequencesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingSequencesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingSequencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissingSequences) > 0 {
+		dAtA10 := make([]byte, len(m.MissingSequences)*10)
+		var j9 int
+		for _, num := range m.MissingSequences {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintService(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PendingSequences) > 0 {
+		dAtA12 := make([]byte, len(m.PendingSequences)*10)
+		var j11 int
+		for _, num := range m.PendingSequences {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintService(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintService(dAtA []byte, offset int, v uint64) int {
 	offset -= sovService(v)
 	base := offset
@@ -1330,6 +1592,45 @@ func (m *GetTxResponse) Size() (n int) {
 	return n
 }
 
+func (m *PendingSequencesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingSequencesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovService(uint64(m.Sequence))
+	}
+	if len(m.PendingSequences) > 0 {
+		l = 0
+		for _, e := range m.PendingSequences {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	if len(m.MissingSequences) > 0 {
+		l = 0
+		for _, e := range m.MissingSequences {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2262,6 +2563,309 @@ func (m *GetTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingSequencesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSequencesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSequencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingSequencesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingSequencesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingSequencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PendingSequences = append(m.PendingSequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PendingSequences) == 0 {
+					m.PendingSequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PendingSequences = append(m.PendingSequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSequences", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissingSequences = append(m.MissingSequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissingSequences) == 0 {
+					m.MissingSequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissingSequences = append(m.MissingSequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingSequences", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,6 +16,14 @@ const MaxGasWanted = uint64((1 << 63) - 1)
 var _, _, _, _ codectypes.UnpackInterfacesMessage = &Tx{}, &TxBody{}, &AuthInfo{}, &SignerInfo{}
 var _ sdk.Tx = &Tx{}
 
+// TipTx defines a tx which can include a tip, paid by the tipper to the fee
+// payer of the tx, e.g. for the fee payer to pay the fees of the tipper in
+// another denom.
+type TipTx interface {
+	sdk.FeeTx
+	GetTip() *Tip
+}
+
 // GetMsgs implements the GetMsgs method on sdk.Tx.
 func (t *Tx) GetMsgs() []sdk.Msg {
 	if t == nil || t.Body == nil {
@@ -79,6 +87,16 @@ func (t *Tx) ValidateBasic() error {
 		}
 	}
 
+	if tip := authInfo.Tip; tip != nil {
+		if err := tip.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if !t.isSigner(tip.Tipper) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "tipper %s must be a signer of the tx", tip.Tipper)
+		}
+	}
+
 	sigs := t.Signatures
 
 	if len(sigs) == 0 {
@@ -125,6 +143,29 @@ func (t *Tx) GetSigners() []sdk.AccAddress {
 	return signers
 }
 
+func (t *Tx) isSigner(addr string) bool {
+	for _, signer := range t.GetSigners() {
+		if signer.String() == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateBasic performs stateless validation of a tip.
+func (t *Tip) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(t.Tipper); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tipper address (%s)", err)
+	}
+
+	if amount := sdk.Coins(t.Amount); amount.Empty() || !amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid tip amount %s", amount)
+	}
+
+	return nil
+}
+
 func (t *Tx) GetGas() uint64 {
 	return t.AuthInfo.Fee.GasLimit
 }
@@ -196,4 +237,6 @@ func (m *SignerInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterInterface("cosmos.tx.v1beta1.Tx", (*sdk.Tx)(nil))
 	registry.RegisterImplementations((*sdk.Tx)(nil), &Tx{})
+
+	registry.RegisterInterface("cosmos.tx.v1beta1.TxExtensionOptionI", (*TxExtensionOptionI)(nil))
 }
@@ -0,0 +1,943 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/accountlimits/v1beta1/accountlimits.proto
+
+package accountlimits
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountLimit is a spend limit an account imposed on itself.
+type AccountLimit struct {
+	// address is the address of the limited account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// daily_spend_limit is the maximum amount of each denom the account can send
+	// per day. The denoms it doesn't contain aren't limited.
+	DailySpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=daily_spend_limit,json=dailySpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"daily_spend_limit"`
+	// allowed_destinations are the only addresses the account can send coins to.
+	// An empty list allows any destination.
+	AllowedDestinations []string `protobuf:"bytes,3,rep,name=allowed_destinations,json=allowedDestinations,proto3" json:"allowed_destinations,omitempty"`
+	// disable_time is the time from which the limit is removed, if a disable was
+	// requested.
+	DisableTime *time.Time `protobuf:"bytes,4,opt,name=disable_time,json=disableTime,proto3,stdtime" json:"disable_time,omitempty"`
+}
+
+func (m *AccountLimit) Reset()         { *m = AccountLimit{} }
+func (m *AccountLimit) String() string { return proto.CompactTextString(m) }
+func (*AccountLimit) ProtoMessage()    {}
+func (*AccountLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b21b7caa3869eff, []int{0}
+}
+func (m *AccountLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountLimit.Merge(m, src)
+}
+func (m *AccountLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountLimit proto.InternalMessageInfo
+
+// DailySpend is the amount sent by a limited account during a day.
+type DailySpend struct {
+	// address is the address of the limited account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// day is the number of days since the unix epoch, in block time.
+	Day int64 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	// spent is the amount sent during the day.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *DailySpend) Reset()         { *m = DailySpend{} }
+func (m *DailySpend) String() string { return proto.CompactTextString(m) }
+func (*DailySpend) ProtoMessage()    {}
+func (*DailySpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b21b7caa3869eff, []int{1}
+}
+func (m *DailySpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DailySpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DailySpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DailySpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DailySpend.Merge(m, src)
+}
+func (m *DailySpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *DailySpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_DailySpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DailySpend proto.InternalMessageInfo
+
+func (m *DailySpend) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DailySpend) GetDay() int64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *DailySpend) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+// Params defines the parameters of the accountlimits module.
+type Params struct {
+	// disable_timelock is the delay between the request to disable a limit and
+	// its removal.
+	DisableTimelock time.Duration `protobuf:"bytes,1,opt,name=disable_timelock,json=disableTimelock,proto3,stdduration" json:"disable_timelock"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b21b7caa3869eff, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDisableTimelock() time.Duration {
+	if m != nil {
+		return m.DisableTimelock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AccountLimit)(nil), "cosmos.accountlimits.v1beta1.AccountLimit")
+	proto.RegisterType((*DailySpend)(nil), "cosmos.accountlimits.v1beta1.DailySpend")
+	proto.RegisterType((*Params)(nil), "cosmos.accountlimits.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/accountlimits/v1beta1/accountlimits.proto", fileDescriptor_7b21b7caa3869eff)
+}
+
+var fileDescriptor_7b21b7caa3869eff = []byte{
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0x87, 0x7d, 0x71, 0x28, 0x70, 0xa9, 0xd4, 0x62, 0x32, 0xb8, 0x11, 0xb2, 0xad, 0x4c, 0x1e,
+	0xa8, 0xdd, 0x86, 0xad, 0x5b, 0xdd, 0x88, 0x05, 0x84, 0x50, 0xca, 0xc4, 0x80, 0x75, 0xf6, 0x1d,
+	0xe6, 0x54, 0xdb, 0x17, 0xf9, 0x2e, 0x94, 0x7c, 0x03, 0xc6, 0x8e, 0x1d, 0x3b, 0x33, 0xc3, 0x77,
+	0xe8, 0x58, 0xc1, 0xc2, 0x44, 0x51, 0xf2, 0x45, 0xd0, 0xfd, 0x71, 0x95, 0x74, 0xe8, 0x80, 0x3a,
+	0xf9, 0xee, 0xde, 0xfb, 0xbd, 0xef, 0xe3, 0x47, 0x36, 0xdc, 0xcb, 0x19, 0xaf, 0x18, 0x8f, 0x51,
+	0x9e, 0xb3, 0x59, 0x2d, 0x4a, 0x5a, 0x51, 0xc1, 0xe3, 0xcf, 0xfb, 0x19, 0x11, 0x68, 0x7f, 0xfd,
+	0x34, 0x9a, 0x36, 0x4c, 0x30, 0xe7, 0x99, 0x4e, 0x44, 0xeb, 0x35, 0x93, 0x18, 0xf4, 0x0b, 0x56,
+	0x30, 0x75, 0x31, 0x96, 0x2b, 0x9d, 0x19, 0x78, 0x05, 0x63, 0x45, 0x49, 0x62, 0xb5, 0xcb, 0x66,
+	0x1f, 0x63, 0x3c, 0x6b, 0x90, 0xa0, 0xac, 0x36, 0x75, 0xff, 0x76, 0x5d, 0xd0, 0x8a, 0x70, 0x81,
+	0xaa, 0x69, 0xdb, 0xc0, 0x60, 0x66, 0x88, 0x93, 0x1b, 0xba, 0x9c, 0xd1, 0xb6, 0xc1, 0x8e, 0xae,
+	0xa7, 0x7a, 0xb2, 0x21, 0x54, 0x9b, 0xe1, 0xaf, 0x0e, 0xdc, 0x3c, 0xd4, 0xac, 0xaf, 0x25, 0xab,
+	0x33, 0x82, 0x0f, 0x11, 0xc6, 0x0d, 0xe1, 0xdc, 0x05, 0x01, 0x08, 0x1f, 0x27, 0xee, 0xcf, 0xef,
+	0xbb, 0x7d, 0x93, 0x39, 0xd4, 0x95, 0x63, 0xd1, 0xd0, 0xba, 0x98, 0xb4, 0x17, 0x9d, 0x53, 0xf8,
+	0x04, 0x23, 0x5a, 0xce, 0x53, 0x3e, 0x25, 0x35, 0x4e, 0xd5, 0x4b, 0xbb, 0x9d, 0xc0, 0x0e, 0x7b,
+	0xa3, 0x9d, 0xc8, 0x44, 0x25, 0x5b, 0xeb, 0x21, 0x3a, 0x62, 0xb4, 0x4e, 0xf6, 0x2e, 0xff, 0xf8,
+	0xd6, 0xb7, 0x6b, 0x3f, 0x2c, 0xa8, 0xf8, 0x34, 0xcb, 0xa2, 0x9c, 0x55, 0x86, 0xcd, 0x3c, 0x76,
+	0x39, 0x3e, 0x89, 0xc5, 0x7c, 0x4a, 0xb8, 0x0a, 0xf0, 0xc9, 0x96, 0x9a, 0x72, 0x2c, 0x87, 0x68,
+	0xd8, 0x57, 0xb0, 0x8f, 0xca, 0x92, 0x9d, 0x12, 0x9c, 0x62, 0xc2, 0x05, 0xad, 0x95, 0x36, 0xee,
+	0xda, 0x81, 0x7d, 0x27, 0xf9, 0x53, 0x93, 0x1a, 0xaf, 0x84, 0x9c, 0x23, 0xb8, 0x89, 0x29, 0x47,
+	0x59, 0x49, 0x52, 0x29, 0xd8, 0xed, 0x06, 0x20, 0xec, 0x8d, 0x06, 0x91, 0xb6, 0x1f, 0xb5, 0xf6,
+	0xa3, 0x77, 0xad, 0xfd, 0xa4, 0x7b, 0x76, 0xed, 0x83, 0x49, 0xcf, 0xa4, 0xe4, 0xf9, 0x41, 0xf7,
+	0xeb, 0x85, 0x6f, 0x0d, 0x7f, 0x00, 0x08, 0xc7, 0x37, 0xac, 0xff, 0xe5, 0x74, 0x1b, 0xda, 0x18,
+	0xcd, 0xdd, 0x4e, 0x00, 0x42, 0x7b, 0x22, 0x97, 0x0e, 0x82, 0x0f, 0xa4, 0x5f, 0xe1, 0xda, 0xf7,
+	0x6f, 0x56, 0x77, 0x1e, 0x7e, 0x80, 0x1b, 0x6f, 0x51, 0x83, 0x2a, 0xee, 0xbc, 0x81, 0xdb, 0xab,
+	0x32, 0x4a, 0x96, 0x9f, 0x28, 0x76, 0x39, 0xf7, 0xb6, 0x90, 0xb1, 0xf9, 0x5c, 0x93, 0x47, 0x72,
+	0xee, 0xb9, 0x74, 0xb2, 0xb5, 0xe2, 0x44, 0x66, 0x0f, 0xba, 0xe7, 0x17, 0xbe, 0x95, 0xbc, 0xbc,
+	0x5c, 0x78, 0xe0, 0x6a, 0xe1, 0x81, 0xbf, 0x0b, 0x0f, 0x9c, 0x2d, 0x3d, 0xeb, 0x6a, 0xe9, 0x59,
+	0xbf, 0x97, 0x9e, 0xf5, 0xfe, 0xf9, 0x9d, 0xa8, 0x5f, 0xd6, 0xff, 0xb5, 0x6c, 0x43, 0xcd, 0x7e,
+	0xf1, 0x6f, 0x00, 0x87, 0xe5, 0x34, 0xe7, 0xa0, 0x03, 0x00, 0x00,
+}
+
+func (m *AccountLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisableTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DisableTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DisableTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAccountlimits(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AllowedDestinations) > 0 {
+		for iNdEx := len(m.AllowedDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDestinations[iNdEx])
+			copy(dAtA[i:], m.AllowedDestinations[iNdEx])
+			i = encodeVarintAccountlimits(dAtA, i, uint64(len(m.AllowedDestinations[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DailySpendLimit) > 0 {
+		for iNdEx := len(m.DailySpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailySpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccountlimits(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAccountlimits(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DailySpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DailySpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DailySpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccountlimits(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Day != 0 {
+		i = encodeVarintAccountlimits(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAccountlimits(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DisableTimelock, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DisableTimelock):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAccountlimits(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccountlimits(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccountlimits(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAccountlimits(uint64(l))
+	}
+	if len(m.DailySpendLimit) > 0 {
+		for _, e := range m.DailySpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAccountlimits(uint64(l))
+		}
+	}
+	if len(m.AllowedDestinations) > 0 {
+		for _, s := range m.AllowedDestinations {
+			l = len(s)
+			n += 1 + l + sovAccountlimits(uint64(l))
+		}
+	}
+	if m.DisableTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.DisableTime)
+		n += 1 + l + sovAccountlimits(uint64(l))
+	}
+	return n
+}
+
+func (m *DailySpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAccountlimits(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovAccountlimits(uint64(m.Day))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovAccountlimits(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DisableTimelock)
+	n += 1 + l + sovAccountlimits(uint64(l))
+	return n
+}
+
+func sovAccountlimits(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccountlimits(x uint64) (n int) {
+	return sovAccountlimits(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccountLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountlimits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountlimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailySpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountlimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailySpendLimit = append(m.DailySpendLimit, types.Coin{})
+			if err := m.DailySpendLimit[len(m.DailySpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDestinations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountlimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDestinations = append(m.AllowedDestinations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountlimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DisableTime == nil {
+				m.DisableTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.DisableTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountlimits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DailySpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountlimits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DailySpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DailySpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountlimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountlimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountlimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountlimits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountlimits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableTimelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountlimits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DisableTimelock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountlimits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccountlimits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccountlimits(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccountlimits
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountlimits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountlimits
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccountlimits
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccountlimits
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccountlimits
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccountlimits        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccountlimits          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccountlimits = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/accountlimits"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        accountlimits.ModuleName,
+		Short:                      "Querying commands for the accountlimits module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryAccountLimit(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryParams returns the command to query the accountlimits params.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current accountlimits parameters",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := accountlimits.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &accountlimits.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAccountLimit returns the command to query the limit of an account.
+func GetCmdQueryAccountLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the spend limit of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the spend limit of an account and the amount it sent today.
+
+Example:
+$ %s query %s limit cosmos1..
+`, version.AppName, accountlimits.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := accountlimits.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountLimit(cmd.Context(), &accountlimits.QueryAccountLimitRequest{Address: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
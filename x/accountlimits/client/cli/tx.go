@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/accountlimits"
+)
+
+// flags for the accountlimits module
+const (
+	FlagDailySpendLimit     = "daily-spend-limit"
+	FlagAllowedDestinations = "allowed-destinations"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        accountlimits.ModuleName,
+		Short:                      "Account limits transactions subcommands",
+		Long:                       "Set the spend limit of an account and request or cancel its removal",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCmdSetAccountLimit(),
+		NewCmdRequestDisableAccountLimit(),
+		NewCmdCancelDisableAccountLimit(),
+	)
+
+	return txCmd
+}
+
+// NewCmdSetAccountLimit returns a CLI command handler for creating a
+// MsgSetAccountLimit transaction.
+func NewCmdSetAccountLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-limit",
+		Short: "Set the spend limit of the sender's account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the spend limit of the sender's account. A limit caps the amount
+of each denom sent per day and/or restricts the addresses coins can be sent to.
+An existing limit can only be made stricter.
+
+Example:
+$ %s tx %s set-limit --daily-spend-limit=1000stake --allowed-destinations=cosmos1..,cosmos1.. --from=mykey
+`, version.AppName, accountlimits.ModuleName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limitStr, err := cmd.Flags().GetString(FlagDailySpendLimit)
+			if err != nil {
+				return err
+			}
+
+			dailySpendLimit, err := sdk.ParseCoinsNormalized(limitStr)
+			if err != nil {
+				return err
+			}
+
+			allowedDestinations, err := cmd.Flags().GetStringSlice(FlagAllowedDestinations)
+			if err != nil {
+				return err
+			}
+
+			msg := accountlimits.NewMsgSetAccountLimit(clientCtx.GetFromAddress(), dailySpendLimit, allowedDestinations)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDailySpendLimit, "", "The maximum amount of each denom sent per day")
+	cmd.Flags().StringSlice(FlagAllowedDestinations, []string{}, "The only addresses coins can be sent to, comma separated")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRequestDisableAccountLimit returns a CLI command handler for creating a
+// MsgRequestDisableAccountLimit transaction.
+func NewCmdRequestDisableAccountLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-disable",
+		Short: "Request the removal of the spend limit of the sender's account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Request the removal of the spend limit of the sender's account. The
+limit is removed once the disable timelock has elapsed.
+
+Example:
+$ %s tx %s request-disable --from=mykey
+`, version.AppName, accountlimits.ModuleName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := accountlimits.NewMsgRequestDisableAccountLimit(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCancelDisableAccountLimit returns a CLI command handler for creating a
+// MsgCancelDisableAccountLimit transaction.
+func NewCmdCancelDisableAccountLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-disable",
+		Short: "Cancel the pending removal of the spend limit of the sender's account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the pending removal of the spend limit of the sender's account.
+
+Example:
+$ %s tx %s cancel-disable --from=mykey
+`, version.AppName, accountlimits.ModuleName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := accountlimits.NewMsgCancelDisableAccountLimit(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,13 @@
+/*
+Package accountlimits lets accounts impose spend limits on themselves, to
+limit the damage done by a compromised key.
+
+An account sets its limit with MsgSetAccountLimit. A limit caps the amount of
+each denom the account can send per day and can restrict the addresses it
+sends coins to. The limits are enforced by a bank send restriction, so that
+they apply to every module sending coins on behalf of the account. Once set, a
+limit can only be made stricter. Removing it takes a MsgRequestDisableAccountLimit
+followed by a timelock, during which the request can be cancelled with
+MsgCancelDisableAccountLimit.
+*/
+package accountlimits
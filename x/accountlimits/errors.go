@@ -0,0 +1,16 @@
+package accountlimits
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/accountlimits module sentinel errors
+var (
+	ErrLimitNotStricter        = sdkerrors.Register(ModuleName, 2, "account limit can only be made stricter")
+	ErrNoAccountLimit          = sdkerrors.Register(ModuleName, 3, "account has no limit")
+	ErrDailySpendLimitExceeded = sdkerrors.Register(ModuleName, 4, "daily spend limit exceeded")
+	ErrDestinationNotAllowed   = sdkerrors.Register(ModuleName, 5, "destination not allowed by account limit")
+	ErrDisableNotRequested     = sdkerrors.Register(ModuleName, 6, "account limit disable not requested")
+	ErrDisableAlreadyRequested = sdkerrors.Register(ModuleName, 7, "account limit disable already requested")
+	ErrInvalidAccountLimit     = sdkerrors.Register(ModuleName, 8, "invalid account limit")
+)
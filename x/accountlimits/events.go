@@ -0,0 +1,13 @@
+package accountlimits
+
+// accountlimits module event types
+const (
+	EventTypeSetAccountLimit            = "set_account_limit"
+	EventTypeRequestDisableAccountLimit = "request_disable_account_limit"
+	EventTypeCancelDisableAccountLimit  = "cancel_disable_account_limit"
+
+	AttributeKeyAddress     = "address"
+	AttributeKeyDisableTime = "disable_time"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,51 @@
+package accountlimits
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, limits []AccountLimit, dailySpends []DailySpend) *GenesisState {
+	return &GenesisState{
+		Params:      params,
+		Limits:      limits,
+		DailySpends: dailySpends,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the accountlimits
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, nil)
+}
+
+// ValidateGenesis validates the accountlimits genesis state.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	limited := make(map[string]bool, len(data.Limits))
+	for _, limit := range data.Limits {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+		if limited[limit.Address] {
+			return fmt.Errorf("duplicate account limit for address %s", limit.Address)
+		}
+		limited[limit.Address] = true
+	}
+
+	spent := make(map[string]bool, len(data.DailySpends))
+	for _, s := range data.DailySpends {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if spent[s.Address] {
+			return fmt.Errorf("duplicate daily spend for address %s", s.Address)
+		}
+		spent[s.Address] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/accountlimits"
+)
+
+// GetAccountLimit returns the limit of an account, including a disabled limit
+// not yet removed.
+func (k Keeper) GetAccountLimit(ctx sdk.Context, addr sdk.AccAddress) (accountlimits.AccountLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(accountlimits.AccountLimitKey(addr))
+	if bz == nil {
+		return accountlimits.AccountLimit{}, false
+	}
+
+	var limit accountlimits.AccountLimit
+	k.cdc.MustUnmarshal(bz, &limit)
+
+	return limit, true
+}
+
+// GetActiveAccountLimit returns the limit of an account if it isn't disabled.
+// A disabled limit is removed along with the daily spend of the account.
+func (k Keeper) GetActiveAccountLimit(ctx sdk.Context, addr sdk.AccAddress) (accountlimits.AccountLimit, bool) {
+	limit, found := k.GetAccountLimit(ctx, addr)
+	if !found {
+		return accountlimits.AccountLimit{}, false
+	}
+
+	if limit.IsDisabled(ctx.BlockTime()) {
+		k.DeleteAccountLimit(ctx, addr)
+		return accountlimits.AccountLimit{}, false
+	}
+
+	return limit, true
+}
+
+// SetAccountLimit sets the limit of an account.
+func (k Keeper) SetAccountLimit(ctx sdk.Context, limit accountlimits.AccountLimit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(accountlimits.AccountLimitKey(limit.GetAddress()), k.cdc.MustMarshal(&limit))
+}
+
+// DeleteAccountLimit removes the limit and the daily spend of an account.
+func (k Keeper) DeleteAccountLimit(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(accountlimits.AccountLimitKey(addr))
+	store.Delete(accountlimits.DailySpendKey(addr))
+}
+
+// IterateAccountLimits iterates over the account limits.
+// If the cb returns true, the iterator will close and stop.
+func (k Keeper) IterateAccountLimits(ctx sdk.Context, cb func(accountlimits.AccountLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, accountlimits.AccountLimitKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var limit accountlimits.AccountLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &limit)
+
+		if cb(limit) {
+			break
+		}
+	}
+}
+
+// GetDailySpend returns the last daily spend of an account.
+func (k Keeper) GetDailySpend(ctx sdk.Context, addr sdk.AccAddress) (accountlimits.DailySpend, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(accountlimits.DailySpendKey(addr))
+	if bz == nil {
+		return accountlimits.DailySpend{}, false
+	}
+
+	var spend accountlimits.DailySpend
+	k.cdc.MustUnmarshal(bz, &spend)
+
+	return spend, true
+}
+
+// GetSpentToday returns the amount an account sent during the current day.
+func (k Keeper) GetSpentToday(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	spend, found := k.GetDailySpend(ctx, addr)
+	if !found || spend.Day != accountlimits.DayOf(ctx.BlockTime()) {
+		return sdk.NewCoins()
+	}
+
+	return spend.Spent
+}
+
+// SetDailySpend sets the daily spend of an account, replacing the spend of a
+// previous day.
+func (k Keeper) SetDailySpend(ctx sdk.Context, spend accountlimits.DailySpend) {
+	addr, err := sdk.AccAddressFromBech32(spend.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(accountlimits.DailySpendKey(addr), k.cdc.MustMarshal(&spend))
+}
+
+// IterateDailySpends iterates over the daily spends of the limited accounts.
+// If the cb returns true, the iterator will close and stop.
+func (k Keeper) IterateDailySpends(ctx sdk.Context, cb func(accountlimits.DailySpend) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, accountlimits.DailySpendKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var spend accountlimits.DailySpend
+		k.cdc.MustUnmarshal(iterator.Value(), &spend)
+
+		if cb(spend) {
+			break
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/accountlimits"
+)
+
+// InitGenesis initializes the accountlimits module's state from a given
+// genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *accountlimits.GenesisState) {
+	k.SetParams(ctx, data.Params)
+
+	for _, limit := range data.Limits {
+		k.SetAccountLimit(ctx, limit)
+	}
+	for _, spend := range data.DailySpends {
+		k.SetDailySpend(ctx, spend)
+	}
+}
+
+// ExportGenesis returns the accountlimits module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *accountlimits.GenesisState {
+	var limits []accountlimits.AccountLimit
+	k.IterateAccountLimits(ctx, func(limit accountlimits.AccountLimit) bool {
+		limits = append(limits, limit)
+		return false
+	})
+
+	var dailySpends []accountlimits.DailySpend
+	k.IterateDailySpends(ctx, func(spend accountlimits.DailySpend) bool {
+		dailySpends = append(dailySpends, spend)
+		return false
+	})
+
+	return accountlimits.NewGenesisState(k.GetParams(ctx), limits, dailySpends)
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/accountlimits"
+)
+
+var _ accountlimits.QueryServer = Keeper{}
+
+// Params returns the accountlimits params.
+func (k Keeper) Params(c context.Context, req *accountlimits.QueryParamsRequest) (*accountlimits.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &accountlimits.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// AccountLimit returns the limit of an account and the amount it sent today.
+func (k Keeper) AccountLimit(c context.Context, req *accountlimits.QueryAccountLimitRequest) (*accountlimits.QueryAccountLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	limit, found := k.GetActiveAccountLimit(ctx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no limit for account %s", req.Address)
+	}
+
+	return &accountlimits.QueryAccountLimitResponse{
+		Limit:      &limit,
+		SpentToday: k.GetSpentToday(ctx, addr),
+	}, nil
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/accountlimits"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper manages the spend limits of the accounts and enforces them through a
+// bank send restriction.
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	paramSpace paramtypes.Subspace
+	authKeeper accountlimits.AccountKeeper
+}
+
+// NewKeeper creates an accountlimits Keeper
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, ak accountlimits.AccountKeeper) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(accountlimits.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
+		authKeeper: ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", accountlimits.ModuleName))
+}
+
+// GetParams returns the accountlimits params.
+func (k Keeper) GetParams(ctx sdk.Context) (params accountlimits.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the accountlimits params.
+func (k Keeper) SetParams(ctx sdk.Context, params accountlimits.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// SendRestrictionFn is the bank send restriction enforcing the account
+// limits. The fees paid to the fee collector aren't limited, so that a limited
+// account can always transact.
+func (k Keeper) SendRestrictionFn(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	limit, found := k.GetActiveAccountLimit(ctx, fromAddr)
+	if !found {
+		return nil
+	}
+
+	if toAddr != nil && toAddr.Equals(k.authKeeper.GetModuleAddress(authtypes.FeeCollectorName)) {
+		return nil
+	}
+
+	if !limit.IsDestinationAllowed(toAddr) {
+		if toAddr == nil {
+			return sdkerrors.Wrapf(accountlimits.ErrDestinationNotAllowed, "unknown destination of %s", fromAddr)
+		}
+		return sdkerrors.Wrapf(accountlimits.ErrDestinationNotAllowed, "%s can't send coins to %s", fromAddr, toAddr)
+	}
+
+	if len(limit.DailySpendLimit) == 0 {
+		return nil
+	}
+
+	spent := k.GetSpentToday(ctx, fromAddr).Add(amt...)
+	if limit.ExceedsDailySpendLimit(spent) {
+		return sdkerrors.Wrapf(accountlimits.ErrDailySpendLimitExceeded, "%s would spend %s today, limit is %s", fromAddr, spent, limit.DailySpendLimit)
+	}
+
+	k.SetDailySpend(ctx, accountlimits.NewDailySpend(fromAddr, accountlimits.DayOf(ctx.BlockTime()), spent))
+
+	return nil
+}
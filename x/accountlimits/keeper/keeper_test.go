@@ -0,0 +1,221 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/accountlimits"
+	"github.com/cosmos/cosmos-sdk/x/accountlimits/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app     *simapp.SimApp
+	ctx     sdk.Context
+	addrs   []sdk.AccAddress
+	keeper  keeper.Keeper
+	msgSrvr accountlimits.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1_000_000_000, 0).UTC()})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+	suite.keeper = app.AccountLimitsKeeper
+	suite.msgSrvr = keeper.NewMsgServerImpl(suite.keeper)
+}
+
+func (suite *KeeperTestSuite) setLimit(addr sdk.AccAddress, dailySpendLimit sdk.Coins, allowedDestinations ...sdk.AccAddress) error {
+	var dests []string
+	for _, dest := range allowedDestinations {
+		dests = append(dests, dest.String())
+	}
+
+	_, err := suite.msgSrvr.SetAccountLimit(sdk.WrapSDKContext(suite.ctx), accountlimits.NewMsgSetAccountLimit(addr, dailySpendLimit, dests))
+	return err
+}
+
+func stake(amt int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amt))
+}
+
+func (suite *KeeperTestSuite) TestDailySpendLimit() {
+	owner, recipient := suite.addrs[0], suite.addrs[1]
+	suite.Require().NoError(suite.setLimit(owner, stake(100)))
+
+	bank := suite.app.BankKeeper
+	suite.Require().NoError(bank.SendCoins(suite.ctx, owner, recipient, stake(60)))
+	suite.Require().ErrorIs(bank.SendCoins(suite.ctx, owner, recipient, stake(50)), accountlimits.ErrDailySpendLimitExceeded)
+	suite.Require().NoError(bank.SendCoins(suite.ctx, owner, recipient, stake(40)))
+	suite.Require().Equal(stake(100), suite.keeper.GetSpentToday(suite.ctx, owner))
+
+	// the denoms without a limit aren't limited
+	other := sdk.NewCoins(sdk.NewInt64Coin("other", 1000))
+	suite.Require().NoError(banktestutil.FundAccount(bank, suite.ctx, owner, other))
+	suite.Require().NoError(bank.SendCoins(suite.ctx, owner, recipient, other))
+
+	// the fees are never limited
+	suite.Require().NoError(bank.SendCoinsFromAccountToModule(suite.ctx, owner, authtypes.FeeCollectorName, stake(10)))
+
+	// a multi-send is limited by the amount of its inputs
+	inputs := []banktypes.Input{{Address: owner.String(), Coins: stake(1)}}
+	outputs := []banktypes.Output{{Address: recipient.String(), Coins: stake(1)}}
+	suite.Require().ErrorIs(bank.InputOutputCoins(suite.ctx, inputs, outputs), accountlimits.ErrDailySpendLimitExceeded)
+
+	// the limit is reset the next day
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(24 * time.Hour))
+	suite.Require().True(suite.keeper.GetSpentToday(suite.ctx, owner).IsZero())
+	suite.Require().NoError(bank.SendCoins(suite.ctx, owner, recipient, stake(100)))
+
+	// other accounts aren't limited
+	suite.Require().NoError(bank.SendCoins(suite.ctx, recipient, owner, stake(1000)))
+}
+
+func (suite *KeeperTestSuite) TestAllowedDestinations() {
+	owner, allowed, other := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	suite.Require().NoError(suite.setLimit(owner, nil, allowed))
+
+	bank := suite.app.BankKeeper
+	suite.Require().NoError(bank.SendCoins(suite.ctx, owner, allowed, stake(1000)))
+	suite.Require().ErrorIs(bank.SendCoins(suite.ctx, owner, other, stake(1)), accountlimits.ErrDestinationNotAllowed)
+
+	// the destinations of a multi-send with several inputs aren't known
+	inputs := []banktypes.Input{
+		{Address: owner.String(), Coins: stake(1)},
+		{Address: other.String(), Coins: stake(1)},
+	}
+	outputs := []banktypes.Output{{Address: allowed.String(), Coins: stake(2)}}
+	suite.Require().ErrorIs(bank.InputOutputCoins(suite.ctx, inputs, outputs), accountlimits.ErrDestinationNotAllowed)
+}
+
+func (suite *KeeperTestSuite) TestSetAccountLimitStricter() {
+	owner, dest1, dest2 := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	suite.Require().NoError(suite.setLimit(owner, stake(100), dest1, dest2))
+
+	testCases := []struct {
+		name   string
+		limit  sdk.Coins
+		dests  []sdk.AccAddress
+		expErr bool
+	}{
+		{"raise limit", stake(101), []sdk.AccAddress{dest1}, true},
+		{"remove limit", nil, []sdk.AccAddress{dest1}, true},
+		{"remove destinations", stake(100), nil, true},
+		{"add destination", stake(100), []sdk.AccAddress{dest1, dest2, suite.addrs[3]}, true},
+		{"lower limit", stake(50), []sdk.AccAddress{dest1, dest2}, false},
+		{"narrow destinations", stake(50), []sdk.AccAddress{dest2}, false},
+		{"limit other denom", stake(50).Add(sdk.NewInt64Coin("other", 1)), []sdk.AccAddress{dest2}, false},
+	}
+
+	for _, tc := range testCases {
+		err := suite.setLimit(owner, tc.limit, tc.dests...)
+		if tc.expErr {
+			suite.Require().ErrorIs(err, accountlimits.ErrLimitNotStricter, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+
+	limit, found := suite.keeper.GetAccountLimit(suite.ctx, owner)
+	suite.Require().True(found)
+	suite.Require().Equal(stake(50).Add(sdk.NewInt64Coin("other", 1)), limit.DailySpendLimit)
+	suite.Require().Equal([]string{dest2.String()}, limit.AllowedDestinations)
+}
+
+func (suite *KeeperTestSuite) TestDisableAccountLimit() {
+	owner, recipient := suite.addrs[0], suite.addrs[1]
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.msgSrvr.RequestDisableAccountLimit(goCtx, accountlimits.NewMsgRequestDisableAccountLimit(owner))
+	suite.Require().ErrorIs(err, accountlimits.ErrNoAccountLimit)
+
+	suite.Require().NoError(suite.setLimit(owner, stake(100)))
+
+	_, err = suite.msgSrvr.CancelDisableAccountLimit(goCtx, accountlimits.NewMsgCancelDisableAccountLimit(owner))
+	suite.Require().ErrorIs(err, accountlimits.ErrDisableNotRequested)
+
+	_, err = suite.msgSrvr.RequestDisableAccountLimit(goCtx, accountlimits.NewMsgRequestDisableAccountLimit(owner))
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.RequestDisableAccountLimit(goCtx, accountlimits.NewMsgRequestDisableAccountLimit(owner))
+	suite.Require().ErrorIs(err, accountlimits.ErrDisableAlreadyRequested)
+
+	// a stricter limit keeps the pending disable
+	suite.Require().NoError(suite.setLimit(owner, stake(90)))
+	limit, found := suite.keeper.GetAccountLimit(suite.ctx, owner)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(accountlimits.DefaultDisableTimelock), *limit.DisableTime)
+
+	// the limit still applies during the timelock
+	suite.ctx = suite.ctx.WithBlockTime(limit.DisableTime.Add(-time.Second))
+	suite.Require().ErrorIs(suite.app.BankKeeper.SendCoins(suite.ctx, owner, recipient, stake(1000)), accountlimits.ErrDailySpendLimitExceeded)
+
+	// the limit is removed once the timelock has elapsed
+	suite.ctx = suite.ctx.WithBlockTime(*limit.DisableTime)
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, owner, recipient, stake(1000)))
+	_, found = suite.keeper.GetAccountLimit(suite.ctx, owner)
+	suite.Require().False(found)
+
+	// a cancelled disable keeps the limit
+	suite.Require().NoError(suite.setLimit(owner, stake(100)))
+	goCtx = sdk.WrapSDKContext(suite.ctx)
+	_, err = suite.msgSrvr.RequestDisableAccountLimit(goCtx, accountlimits.NewMsgRequestDisableAccountLimit(owner))
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.CancelDisableAccountLimit(goCtx, accountlimits.NewMsgCancelDisableAccountLimit(owner))
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(accountlimits.DefaultDisableTimelock))
+	_, found = suite.keeper.GetActiveAccountLimit(suite.ctx, owner)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestQueryAccountLimit() {
+	owner, recipient := suite.addrs[0], suite.addrs[1]
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.keeper.AccountLimit(goCtx, &accountlimits.QueryAccountLimitRequest{Address: owner.String()})
+	suite.Require().Error(err)
+
+	suite.Require().NoError(suite.setLimit(owner, stake(100)))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, owner, recipient, stake(30)))
+
+	res, err := suite.keeper.AccountLimit(goCtx, &accountlimits.QueryAccountLimitRequest{Address: owner.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(stake(100), res.Limit.DailySpendLimit)
+	suite.Require().Equal(stake(30), res.SpentToday)
+
+	params, err := suite.keeper.Params(goCtx, &accountlimits.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(accountlimits.DefaultParams(), params.Params)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	owner, recipient := suite.addrs[0], suite.addrs[1]
+	suite.Require().NoError(suite.setLimit(owner, stake(100), recipient))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, owner, recipient, stake(30)))
+
+	genesis := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(accountlimits.ValidateGenesis(*genesis))
+	suite.Require().Len(genesis.Limits, 1)
+	suite.Require().Len(genesis.DailySpends, 1)
+
+	suite.SetupTest()
+	suite.keeper.InitGenesis(suite.ctx, genesis)
+	suite.Require().Equal(genesis, suite.keeper.ExportGenesis(suite.ctx))
+	suite.Require().Equal(stake(30), suite.keeper.GetSpentToday(suite.ctx, owner))
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/accountlimits"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the accountlimits MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(k Keeper) accountlimits.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ accountlimits.MsgServer = msgServer{}
+
+// SetAccountLimit sets the limit of the signer's account. An existing limit
+// can only be made stricter, and keeps its pending disable, if any.
+func (k msgServer) SetAccountLimit(goCtx context.Context, msg *accountlimits.MsgSetAccountLimit) (*accountlimits.MsgSetAccountLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := accountlimits.NewAccountLimit(addr, msg.DailySpendLimit, msg.AllowedDestinations)
+	if existing, found := k.GetActiveAccountLimit(ctx, addr); found {
+		if err := existing.ValidateStricter(limit); err != nil {
+			return nil, err
+		}
+		limit.DisableTime = existing.DisableTime
+	}
+
+	k.Keeper.SetAccountLimit(ctx, limit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			accountlimits.EventTypeSetAccountLimit,
+			sdk.NewAttribute(accountlimits.AttributeKeyAddress, msg.Address),
+		),
+	)
+
+	return &accountlimits.MsgSetAccountLimitResponse{}, nil
+}
+
+// RequestDisableAccountLimit schedules the removal of the limit of the
+// signer's account once the disable timelock has elapsed.
+func (k msgServer) RequestDisableAccountLimit(goCtx context.Context, msg *accountlimits.MsgRequestDisableAccountLimit) (*accountlimits.MsgRequestDisableAccountLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, found := k.GetActiveAccountLimit(ctx, addr)
+	if !found {
+		return nil, accountlimits.ErrNoAccountLimit
+	}
+	if limit.DisableTime != nil {
+		return nil, accountlimits.ErrDisableAlreadyRequested
+	}
+
+	disableTime := ctx.BlockTime().Add(k.GetParams(ctx).DisableTimelock)
+	limit.DisableTime = &disableTime
+	k.Keeper.SetAccountLimit(ctx, limit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			accountlimits.EventTypeRequestDisableAccountLimit,
+			sdk.NewAttribute(accountlimits.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(accountlimits.AttributeKeyDisableTime, disableTime.Format(time.RFC3339)),
+		),
+	)
+
+	return &accountlimits.MsgRequestDisableAccountLimitResponse{}, nil
+}
+
+// CancelDisableAccountLimit cancels the pending removal of the limit of the
+// signer's account.
+func (k msgServer) CancelDisableAccountLimit(goCtx context.Context, msg *accountlimits.MsgCancelDisableAccountLimit) (*accountlimits.MsgCancelDisableAccountLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, found := k.GetActiveAccountLimit(ctx, addr)
+	if !found {
+		return nil, accountlimits.ErrNoAccountLimit
+	}
+	if limit.DisableTime == nil {
+		return nil, accountlimits.ErrDisableNotRequested
+	}
+
+	limit.DisableTime = nil
+	k.Keeper.SetAccountLimit(ctx, limit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			accountlimits.EventTypeCancelDisableAccountLimit,
+			sdk.NewAttribute(accountlimits.AttributeKeyAddress, msg.Address),
+		),
+	)
+
+	return &accountlimits.MsgCancelDisableAccountLimitResponse{}, nil
+}
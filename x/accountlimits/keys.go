@@ -0,0 +1,39 @@
+package accountlimits
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "accountlimits"
+
+	// StoreKey is the store key string for accountlimits. It differs from the
+	// module name, which is prefixed by the auth store key.
+	StoreKey = "limits"
+
+	// RouterKey is the message route for accountlimits
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for accountlimits
+	QuerierRoute = ModuleName
+)
+
+var (
+	// AccountLimitKeyPrefix is the prefix of the account limits
+	AccountLimitKeyPrefix = []byte{0x01}
+	// DailySpendKeyPrefix is the prefix of the daily spends of the limited
+	// accounts
+	DailySpendKeyPrefix = []byte{0x02}
+)
+
+// AccountLimitKey returns the key of the limit of an account.
+func AccountLimitKey(addr sdk.AccAddress) []byte {
+	return append(AccountLimitKeyPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// DailySpendKey returns the key of the daily spend of an account.
+func DailySpendKey(addr sdk.AccAddress) []byte {
+	return append(DailySpendKeyPrefix, address.MustLengthPrefix(addr)...)
+}
@@ -0,0 +1,159 @@
+package accountlimits
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// secondsPerDay is the length of the days of the daily spend limits.
+const secondsPerDay = 24 * 60 * 60
+
+// NewAccountLimit creates a new AccountLimit instance.
+//nolint:interfacer
+func NewAccountLimit(addr sdk.AccAddress, dailySpendLimit sdk.Coins, allowedDestinations []string) AccountLimit {
+	return AccountLimit{
+		Address:             addr.String(),
+		DailySpendLimit:     dailySpendLimit,
+		AllowedDestinations: allowedDestinations,
+	}
+}
+
+// GetAddress returns the address of the limited account.
+func (l AccountLimit) GetAddress() sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(l.Address)
+	return addr
+}
+
+// IsDisabled returns true if a disable of the limit was requested and its
+// timelock has elapsed at the given time.
+func (l AccountLimit) IsDisabled(now time.Time) bool {
+	return l.DisableTime != nil && !now.Before(*l.DisableTime)
+}
+
+// IsDestinationAllowed returns true if the account can send coins to the given
+// address. A nil address, i.e. an unknown destination, is only allowed if the
+// limit has no allowed destinations.
+func (l AccountLimit) IsDestinationAllowed(addr sdk.AccAddress) bool {
+	if len(l.AllowedDestinations) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+
+	for _, dest := range l.AllowedDestinations {
+		if dest == addr.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ExceedsDailySpendLimit returns true if the given daily spend exceeds the
+// limit for any of its limited denoms.
+func (l AccountLimit) ExceedsDailySpendLimit(spent sdk.Coins) bool {
+	for _, limit := range l.DailySpendLimit {
+		if spent.AmountOf(limit.Denom).GT(limit.Amount) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateStricter returns an error if the given limit isn't at least as
+// strict as l. Every limited denom must stay limited to at most the same
+// amount, and the allowed destinations can only be narrowed.
+func (l AccountLimit) ValidateStricter(updated AccountLimit) error {
+	for _, limit := range l.DailySpendLimit {
+		newLimit := updated.DailySpendLimit.AmountOf(limit.Denom)
+		if !newLimit.IsPositive() || newLimit.GT(limit.Amount) {
+			return sdkerrors.Wrapf(ErrLimitNotStricter, "daily spend limit of %s can't be raised or removed", limit.Denom)
+		}
+	}
+
+	if len(l.AllowedDestinations) == 0 {
+		return nil
+	}
+	if len(updated.AllowedDestinations) == 0 {
+		return sdkerrors.Wrap(ErrLimitNotStricter, "allowed destinations can't be removed")
+	}
+	for _, dest := range updated.AllowedDestinations {
+		addr, err := sdk.AccAddressFromBech32(dest)
+		if err != nil {
+			return err
+		}
+		if !l.IsDestinationAllowed(addr) {
+			return sdkerrors.Wrapf(ErrLimitNotStricter, "destination %s can't be allowed", dest)
+		}
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the account limit.
+func (l AccountLimit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(l.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return validateLimit(l.DailySpendLimit, l.AllowedDestinations)
+}
+
+// NewDailySpend creates a new DailySpend instance.
+//nolint:interfacer
+func NewDailySpend(addr sdk.AccAddress, day int64, spent sdk.Coins) DailySpend {
+	return DailySpend{
+		Address: addr.String(),
+		Day:     day,
+		Spent:   spent,
+	}
+}
+
+// Validate performs basic validation of the daily spend.
+func (s DailySpend) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+	if s.Day < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("negative day: %d", s.Day)
+	}
+	if !s.Spent.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(s.Spent.String())
+	}
+
+	return nil
+}
+
+// DayOf returns the day of the daily spend limits containing the given time,
+// i.e. the number of days since the unix epoch.
+func DayOf(t time.Time) int64 {
+	return t.Unix() / secondsPerDay
+}
+
+// validateLimit validates the restrictions of an account limit, which can't be
+// empty.
+func validateLimit(dailySpendLimit sdk.Coins, allowedDestinations []string) error {
+	if len(dailySpendLimit) == 0 && len(allowedDestinations) == 0 {
+		return sdkerrors.Wrap(ErrInvalidAccountLimit, "limit must have a daily spend limit or allowed destinations")
+	}
+	if !dailySpendLimit.IsValid() && len(dailySpendLimit) != 0 {
+		return sdkerrors.Wrapf(ErrInvalidAccountLimit, "invalid daily spend limit: %s", dailySpendLimit)
+	}
+
+	seen := make(map[string]bool, len(allowedDestinations))
+	for _, dest := range allowedDestinations {
+		if _, err := sdk.AccAddressFromBech32(dest); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid allowed destination: %s", err)
+		}
+		if seen[dest] {
+			return sdkerrors.Wrapf(ErrInvalidAccountLimit, "duplicate allowed destination: %s", dest)
+		}
+		seen[dest] = true
+	}
+
+	return nil
+}
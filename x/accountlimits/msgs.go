@@ -0,0 +1,125 @@
+package accountlimits
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_, _, _ sdk.Msg            = &MsgSetAccountLimit{}, &MsgRequestDisableAccountLimit{}, &MsgCancelDisableAccountLimit{}
+	_, _, _ legacytx.LegacyMsg = &MsgSetAccountLimit{}, &MsgRequestDisableAccountLimit{}, &MsgCancelDisableAccountLimit{} // For amino support.
+)
+
+// NewMsgSetAccountLimit creates a new MsgSetAccountLimit.
+//nolint:interfacer
+func NewMsgSetAccountLimit(addr sdk.AccAddress, dailySpendLimit sdk.Coins, allowedDestinations []string) *MsgSetAccountLimit {
+	return &MsgSetAccountLimit{
+		Address:             addr.String(),
+		DailySpendLimit:     dailySpendLimit,
+		AllowedDestinations: allowedDestinations,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetAccountLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return validateLimit(msg.DailySpendLimit, msg.AllowedDestinations)
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetAccountLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgSetAccountLimit) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgSetAccountLimit) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgSetAccountLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgRequestDisableAccountLimit creates a new MsgRequestDisableAccountLimit.
+//nolint:interfacer
+func NewMsgRequestDisableAccountLimit(addr sdk.AccAddress) *MsgRequestDisableAccountLimit {
+	return &MsgRequestDisableAccountLimit{Address: addr.String()}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRequestDisableAccountLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRequestDisableAccountLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRequestDisableAccountLimit) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRequestDisableAccountLimit) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRequestDisableAccountLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgCancelDisableAccountLimit creates a new MsgCancelDisableAccountLimit.
+//nolint:interfacer
+func NewMsgCancelDisableAccountLimit(addr sdk.AccAddress) *MsgCancelDisableAccountLimit {
+	return &MsgCancelDisableAccountLimit{Address: addr.String()}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelDisableAccountLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCancelDisableAccountLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgCancelDisableAccountLimit) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgCancelDisableAccountLimit) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgCancelDisableAccountLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
@@ -0,0 +1,76 @@
+package accountlimits_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/accountlimits"
+)
+
+func TestMsgSetAccountLimit(t *testing.T) {
+	addr := sdk.AccAddress("addr1_______________")
+	dest := sdk.AccAddress("addr2_______________")
+	limit := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+
+	cases := map[string]struct {
+		msg   *accountlimits.MsgSetAccountLimit
+		valid bool
+	}{
+		"spend limit":          {accountlimits.NewMsgSetAccountLimit(addr, limit, nil), true},
+		"allowed destinations": {accountlimits.NewMsgSetAccountLimit(addr, nil, []string{dest.String()}), true},
+		"both":                 {accountlimits.NewMsgSetAccountLimit(addr, limit, []string{dest.String()}), true},
+		"empty":                {accountlimits.NewMsgSetAccountLimit(addr, nil, nil), false},
+		"invalid address":      {&accountlimits.MsgSetAccountLimit{Address: "foo", DailySpendLimit: limit}, false},
+		"invalid destination":  {accountlimits.NewMsgSetAccountLimit(addr, limit, []string{"foo"}), false},
+		"duplicate destination": {
+			accountlimits.NewMsgSetAccountLimit(addr, nil, []string{dest.String(), dest.String()}),
+			false,
+		},
+		"invalid spend limit": {
+			accountlimits.NewMsgSetAccountLimit(addr, sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}}, nil),
+			false,
+		},
+	}
+
+	for name, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, name)
+			require.Equal(t, []sdk.AccAddress{addr}, tc.msg.GetSigners(), name)
+		} else {
+			require.Error(t, err, name)
+		}
+	}
+}
+
+func TestAccountLimitValidateStricter(t *testing.T) {
+	addr := sdk.AccAddress("addr1_______________")
+	dest1 := sdk.AccAddress("addr2_______________").String()
+	dest2 := sdk.AccAddress("addr3_______________").String()
+	atom := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amt)) }
+
+	unlimitedDests := accountlimits.NewAccountLimit(addr, atom(100), nil)
+	require.NoError(t, unlimitedDests.ValidateStricter(accountlimits.NewAccountLimit(addr, atom(100), []string{dest1})))
+	require.NoError(t, unlimitedDests.ValidateStricter(accountlimits.NewAccountLimit(addr, atom(100).Add(sdk.NewInt64Coin("eth", 1)), nil)))
+	require.ErrorIs(t, unlimitedDests.ValidateStricter(accountlimits.NewAccountLimit(addr, nil, []string{dest1})), accountlimits.ErrLimitNotStricter)
+
+	unlimitedSpend := accountlimits.NewAccountLimit(addr, nil, []string{dest1, dest2})
+	require.NoError(t, unlimitedSpend.ValidateStricter(accountlimits.NewAccountLimit(addr, atom(1), []string{dest2})))
+	require.ErrorIs(t, unlimitedSpend.ValidateStricter(accountlimits.NewAccountLimit(addr, atom(1), nil)), accountlimits.ErrLimitNotStricter)
+}
+
+func TestAccountLimitIsDestinationAllowed(t *testing.T) {
+	addr := sdk.AccAddress("addr1_______________")
+	dest := sdk.AccAddress("addr2_______________")
+
+	limit := accountlimits.NewAccountLimit(addr, nil, []string{dest.String()})
+	require.True(t, limit.IsDestinationAllowed(dest))
+	require.False(t, limit.IsDestinationAllowed(addr))
+	require.False(t, limit.IsDestinationAllowed(nil))
+
+	limit = accountlimits.NewAccountLimit(addr, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), nil)
+	require.True(t, limit.IsDestinationAllowed(addr))
+	require.True(t, limit.IsDestinationAllowed(nil))
+}
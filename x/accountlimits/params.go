@@ -0,0 +1,67 @@
+package accountlimits
+
+import (
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultDisableTimelock is the default delay between the request to disable
+// an account limit and its removal.
+const DefaultDisableTimelock = 72 * time.Hour
+
+// KeyDisableTimelock is the parameter store key of the disable timelock.
+var KeyDisableTimelock = []byte("DisableTimelock")
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table for the accountlimits module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(disableTimelock time.Duration) Params {
+	return Params{
+		DisableTimelock: disableTimelock,
+	}
+}
+
+// DefaultParams returns the default accountlimits params.
+func DefaultParams() Params {
+	return NewParams(DefaultDisableTimelock)
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDisableTimelock, &p.DisableTimelock, validateDisableTimelock),
+	}
+}
+
+// Validate performs basic validation of the accountlimits params.
+func (p Params) Validate() error {
+	return validateDisableTimelock(p.DisableTimelock)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateDisableTimelock(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("disable timelock must not be negative: %s", v)
+	}
+
+	return nil
+}
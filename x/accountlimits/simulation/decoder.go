@@ -0,0 +1,31 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/accountlimits"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding accountlimits type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], accountlimits.AccountLimitKeyPrefix):
+			var limitA, limitB accountlimits.AccountLimit
+			cdc.MustUnmarshal(kvA.Value, &limitA)
+			cdc.MustUnmarshal(kvB.Value, &limitB)
+			return fmt.Sprintf("%v\n%v", limitA, limitB)
+		case bytes.Equal(kvA.Key[:1], accountlimits.DailySpendKeyPrefix):
+			var spendA, spendB accountlimits.DailySpend
+			cdc.MustUnmarshal(kvA.Value, &spendA)
+			cdc.MustUnmarshal(kvB.Value, &spendB)
+			return fmt.Sprintf("%v\n%v", spendA, spendB)
+		default:
+			panic(fmt.Sprintf("invalid accountlimits key %X", kvA.Key))
+		}
+	}
+}
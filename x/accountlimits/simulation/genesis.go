@@ -0,0 +1,39 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/accountlimits"
+)
+
+// Simulation parameter constants
+const disableTimelock = "disable_timelock"
+
+// GenDisableTimelock randomized DisableTimelock
+func GenDisableTimelock(r *rand.Rand) time.Duration {
+	return time.Duration(r.Intn(7*24)+1) * time.Hour
+}
+
+// RandomizedGenState generates a random GenesisState for accountlimits. No
+// account is limited, so that the operations of the other modules aren't
+// restricted.
+func RandomizedGenState(simState *module.SimulationState) {
+	var timelock time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, disableTimelock, &timelock, simState.Rand,
+		func(r *rand.Rand) { timelock = GenDisableTimelock(r) },
+	)
+
+	genesis := accountlimits.NewGenesisState(accountlimits.NewParams(timelock), nil, nil)
+
+	bz, err := json.MarshalIndent(&genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated accountlimits parameters:\n%s\n", bz)
+	simState.GenState[accountlimits.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
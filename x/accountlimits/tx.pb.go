@@ -0,0 +1,1338 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/accountlimits/v1beta1/tx.proto
+
+package accountlimits
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetAccountLimit sets the spend limit of an account.
+type MsgSetAccountLimit struct {
+	// address is the address of the account to limit.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// daily_spend_limit is the maximum amount of each denom the account can send
+	// per day.
+	DailySpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=daily_spend_limit,json=dailySpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"daily_spend_limit"`
+	// allowed_destinations are the only addresses the account can send coins to.
+	AllowedDestinations []string `protobuf:"bytes,3,rep,name=allowed_destinations,json=allowedDestinations,proto3" json:"allowed_destinations,omitempty"`
+}
+
+func (m *MsgSetAccountLimit) Reset()         { *m = MsgSetAccountLimit{} }
+func (m *MsgSetAccountLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAccountLimit) ProtoMessage()    {}
+func (*MsgSetAccountLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2315c4050c8e48fe, []int{0}
+}
+func (m *MsgSetAccountLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAccountLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAccountLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAccountLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAccountLimit.Merge(m, src)
+}
+func (m *MsgSetAccountLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAccountLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAccountLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAccountLimit proto.InternalMessageInfo
+
+func (m *MsgSetAccountLimit) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgSetAccountLimit) GetDailySpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DailySpendLimit
+	}
+	return nil
+}
+
+func (m *MsgSetAccountLimit) GetAllowedDestinations() []string {
+	if m != nil {
+		return m.AllowedDestinations
+	}
+	return nil
+}
+
+// MsgSetAccountLimitResponse defines the Msg/SetAccountLimit response type.
+type MsgSetAccountLimitResponse struct {
+}
+
+func (m *MsgSetAccountLimitResponse) Reset()         { *m = MsgSetAccountLimitResponse{} }
+func (m *MsgSetAccountLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAccountLimitResponse) ProtoMessage()    {}
+func (*MsgSetAccountLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2315c4050c8e48fe, []int{1}
+}
+func (m *MsgSetAccountLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAccountLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAccountLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAccountLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAccountLimitResponse.Merge(m, src)
+}
+func (m *MsgSetAccountLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAccountLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAccountLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAccountLimitResponse proto.InternalMessageInfo
+
+// MsgRequestDisableAccountLimit requests the removal of the spend limit of an
+// account.
+type MsgRequestDisableAccountLimit struct {
+	// address is the address of the limited account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgRequestDisableAccountLimit) Reset()         { *m = MsgRequestDisableAccountLimit{} }
+func (m *MsgRequestDisableAccountLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestDisableAccountLimit) ProtoMessage()    {}
+func (*MsgRequestDisableAccountLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2315c4050c8e48fe, []int{2}
+}
+func (m *MsgRequestDisableAccountLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestDisableAccountLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestDisableAccountLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestDisableAccountLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestDisableAccountLimit.Merge(m, src)
+}
+func (m *MsgRequestDisableAccountLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestDisableAccountLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestDisableAccountLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestDisableAccountLimit proto.InternalMessageInfo
+
+func (m *MsgRequestDisableAccountLimit) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgRequestDisableAccountLimitResponse defines the
+// Msg/RequestDisableAccountLimit response type.
+type MsgRequestDisableAccountLimitResponse struct {
+}
+
+func (m *MsgRequestDisableAccountLimitResponse) Reset()         { *m = MsgRequestDisableAccountLimitResponse{} }
+func (m *MsgRequestDisableAccountLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestDisableAccountLimitResponse) ProtoMessage()    {}
+func (*MsgRequestDisableAccountLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2315c4050c8e48fe, []int{3}
+}
+func (m *MsgRequestDisableAccountLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestDisableAccountLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestDisableAccountLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestDisableAccountLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestDisableAccountLimitResponse.Merge(m, src)
+}
+func (m *MsgRequestDisableAccountLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestDisableAccountLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestDisableAccountLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestDisableAccountLimitResponse proto.InternalMessageInfo
+
+// MsgCancelDisableAccountLimit cancels a pending removal of the spend limit of
+// an account.
+type MsgCancelDisableAccountLimit struct {
+	// address is the address of the limited account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgCancelDisableAccountLimit) Reset()         { *m = MsgCancelDisableAccountLimit{} }
+func (m *MsgCancelDisableAccountLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDisableAccountLimit) ProtoMessage()    {}
+func (*MsgCancelDisableAccountLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2315c4050c8e48fe, []int{4}
+}
+func (m *MsgCancelDisableAccountLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDisableAccountLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDisableAccountLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDisableAccountLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDisableAccountLimit.Merge(m, src)
+}
+func (m *MsgCancelDisableAccountLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDisableAccountLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDisableAccountLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDisableAccountLimit proto.InternalMessageInfo
+
+func (m *MsgCancelDisableAccountLimit) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgCancelDisableAccountLimitResponse defines the
+// Msg/CancelDisableAccountLimit response type.
+type MsgCancelDisableAccountLimitResponse struct {
+}
+
+func (m *MsgCancelDisableAccountLimitResponse) Reset()         { *m = MsgCancelDisableAccountLimitResponse{} }
+func (m *MsgCancelDisableAccountLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDisableAccountLimitResponse) ProtoMessage()    {}
+func (*MsgCancelDisableAccountLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2315c4050c8e48fe, []int{5}
+}
+func (m *MsgCancelDisableAccountLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDisableAccountLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDisableAccountLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDisableAccountLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDisableAccountLimitResponse.Merge(m, src)
+}
+func (m *MsgCancelDisableAccountLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDisableAccountLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDisableAccountLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDisableAccountLimitResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetAccountLimit)(nil), "cosmos.accountlimits.v1beta1.MsgSetAccountLimit")
+	proto.RegisterType((*MsgSetAccountLimitResponse)(nil), "cosmos.accountlimits.v1beta1.MsgSetAccountLimitResponse")
+	proto.RegisterType((*MsgRequestDisableAccountLimit)(nil), "cosmos.accountlimits.v1beta1.MsgRequestDisableAccountLimit")
+	proto.RegisterType((*MsgRequestDisableAccountLimitResponse)(nil), "cosmos.accountlimits.v1beta1.MsgRequestDisableAccountLimitResponse")
+	proto.RegisterType((*MsgCancelDisableAccountLimit)(nil), "cosmos.accountlimits.v1beta1.MsgCancelDisableAccountLimit")
+	proto.RegisterType((*MsgCancelDisableAccountLimitResponse)(nil), "cosmos.accountlimits.v1beta1.MsgCancelDisableAccountLimitResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/accountlimits/v1beta1/tx.proto", fileDescriptor_2315c4050c8e48fe)
+}
+
+var fileDescriptor_2315c4050c8e48fe = []byte{
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xb1, 0x6e, 0xd3, 0x40,
+	0x1c, 0xc6, 0xe3, 0x5a, 0x02, 0x71, 0x0c, 0x15, 0x26, 0x43, 0x62, 0x05, 0x37, 0xb2, 0x28, 0x64,
+	0xa0, 0xe7, 0x36, 0x2c, 0x08, 0xa6, 0x3a, 0x15, 0x0b, 0x78, 0xb1, 0x37, 0x16, 0xeb, 0x6c, 0x9f,
+	0x8e, 0x13, 0xce, 0x9d, 0xc9, 0xff, 0x42, 0xdb, 0x81, 0x57, 0x40, 0xec, 0x48, 0x3c, 0x00, 0x33,
+	0x6f, 0xc0, 0xd2, 0xb1, 0x62, 0x62, 0x02, 0x94, 0xbc, 0x08, 0xb2, 0x7d, 0x8e, 0x28, 0x55, 0x5c,
+	0x41, 0x98, 0x6c, 0xeb, 0xbe, 0xdf, 0xf7, 0x7d, 0xfe, 0xfb, 0x7c, 0x68, 0x37, 0x95, 0x30, 0x95,
+	0xe0, 0x91, 0x34, 0x95, 0x73, 0xa1, 0x72, 0x3e, 0xe5, 0x0a, 0xbc, 0x37, 0x07, 0x09, 0x55, 0xe4,
+	0xc0, 0x53, 0x27, 0xb8, 0x98, 0x49, 0x25, 0xad, 0x41, 0x2d, 0xc3, 0x17, 0x64, 0x58, 0xcb, 0xec,
+	0x2e, 0x93, 0x4c, 0x56, 0x42, 0xaf, 0xbc, 0xab, 0x19, 0xdb, 0xd1, 0xd6, 0x09, 0x01, 0xba, 0x72,
+	0x4c, 0x25, 0x17, 0x7a, 0xbd, 0x5f, 0xaf, 0xc7, 0x35, 0xa8, 0x03, 0xaa, 0x07, 0xf7, 0xdd, 0x16,
+	0xb2, 0x02, 0x60, 0x11, 0x55, 0x87, 0x75, 0xe0, 0xf3, 0x32, 0xd0, 0x1a, 0xa3, 0xeb, 0x24, 0xcb,
+	0x66, 0x14, 0xa0, 0x67, 0x0c, 0x8d, 0xd1, 0x0d, 0xbf, 0xf7, 0xf5, 0xf3, 0x5e, 0x57, 0x93, 0x87,
+	0xf5, 0x4a, 0xa4, 0x66, 0x5c, 0xb0, 0xb0, 0x11, 0x5a, 0xc7, 0xe8, 0x56, 0x46, 0x78, 0x7e, 0x1a,
+	0x43, 0x41, 0x45, 0x16, 0x57, 0xcd, 0x7b, 0x5b, 0x43, 0x73, 0x74, 0x73, 0xdc, 0xc7, 0x1a, 0x2d,
+	0x1b, 0x36, 0x2f, 0x83, 0x27, 0x92, 0x0b, 0x7f, 0xff, 0xec, 0xfb, 0x4e, 0xe7, 0xd3, 0x8f, 0x9d,
+	0x11, 0xe3, 0xea, 0xe5, 0x3c, 0xc1, 0xa9, 0x9c, 0xea, 0x86, 0xfa, 0xb2, 0x07, 0xd9, 0x2b, 0x4f,
+	0x9d, 0x16, 0x14, 0x2a, 0x00, 0xc2, 0xed, 0x2a, 0x25, 0x2a, 0x43, 0xea, 0xb2, 0xcf, 0x50, 0x97,
+	0xe4, 0xb9, 0x3c, 0xa6, 0x59, 0x9c, 0x51, 0x50, 0x5c, 0x10, 0xc5, 0xa5, 0x80, 0x9e, 0x39, 0x34,
+	0x5b, 0x9b, 0xdf, 0xd6, 0xd4, 0xd1, 0x6f, 0x90, 0x3b, 0x40, 0xf6, 0xe5, 0x79, 0x84, 0x14, 0x0a,
+	0x29, 0x80, 0xba, 0x11, 0xba, 0x13, 0x00, 0x0b, 0xe9, 0xeb, 0x39, 0x05, 0x75, 0xc4, 0x81, 0x24,
+	0x39, 0xdd, 0x74, 0x70, 0xee, 0x7d, 0xb4, 0xdb, 0x6a, 0xba, 0x4a, 0x0f, 0xd1, 0x20, 0x00, 0x36,
+	0x21, 0x22, 0xa5, 0xf9, 0xff, 0x0a, 0xbf, 0x87, 0xee, 0xb6, 0x79, 0x36, 0xd9, 0xe3, 0x2f, 0x26,
+	0x32, 0x03, 0x60, 0xd6, 0x5b, 0xb4, 0xfd, 0xe7, 0x66, 0xd9, 0xc7, 0x6d, 0x7b, 0x16, 0x5f, 0x1e,
+	0xa7, 0xfd, 0xe8, 0x6f, 0x89, 0xa6, 0x86, 0xf5, 0xd1, 0x40, 0x76, 0xcb, 0xf8, 0x9f, 0x5c, 0x69,
+	0xbc, 0x1e, 0xb6, 0x27, 0x1b, 0xc0, 0xab, 0x82, 0x1f, 0x0c, 0xd4, 0x5f, 0xff, 0x85, 0x1e, 0x5f,
+	0x19, 0xb1, 0x96, 0xb5, 0xfd, 0x7f, 0x67, 0x9b, 0x76, 0xfe, 0xd3, 0xb3, 0x85, 0x63, 0x9c, 0x2f,
+	0x1c, 0xe3, 0xe7, 0xc2, 0x31, 0xde, 0x2f, 0x9d, 0xce, 0xf9, 0xd2, 0xe9, 0x7c, 0x5b, 0x3a, 0x9d,
+	0x17, 0x0f, 0x5a, 0xff, 0xbf, 0x93, 0x8b, 0xc7, 0x56, 0x72, 0xad, 0x3a, 0x3d, 0x1e, 0xfe, 0x1a,
+	0x00, 0x38, 0x05, 0x30, 0xb9, 0xd5, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetAccountLimit sets the spend limit of the signer's account. An existing
+	// limit can only be made stricter.
+	SetAccountLimit(ctx context.Context, in *MsgSetAccountLimit, opts ...grpc.CallOption) (*MsgSetAccountLimitResponse, error)
+	// RequestDisableAccountLimit requests the removal of the spend limit of the
+	// signer's account, which happens once the disable timelock has elapsed.
+	RequestDisableAccountLimit(ctx context.Context, in *MsgRequestDisableAccountLimit, opts ...grpc.CallOption) (*MsgRequestDisableAccountLimitResponse, error)
+	// CancelDisableAccountLimit cancels a pending removal of the spend limit of
+	// the signer's account.
+	CancelDisableAccountLimit(ctx context.Context, in *MsgCancelDisableAccountLimit, opts ...grpc.CallOption) (*MsgCancelDisableAccountLimitResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetAccountLimit(ctx context.Context, in *MsgSetAccountLimit, opts ...grpc.CallOption) (*MsgSetAccountLimitResponse, error) {
+	out := new(MsgSetAccountLimitResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accountlimits.v1beta1.Msg/SetAccountLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestDisableAccountLimit(ctx context.Context, in *MsgRequestDisableAccountLimit, opts ...grpc.CallOption) (*MsgRequestDisableAccountLimitResponse, error) {
+	out := new(MsgRequestDisableAccountLimitResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accountlimits.v1beta1.Msg/RequestDisableAccountLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelDisableAccountLimit(ctx context.Context, in *MsgCancelDisableAccountLimit, opts ...grpc.CallOption) (*MsgCancelDisableAccountLimitResponse, error) {
+	out := new(MsgCancelDisableAccountLimitResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.accountlimits.v1beta1.Msg/CancelDisableAccountLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetAccountLimit sets the spend limit of the signer's account. An existing
+	// limit can only be made stricter.
+	SetAccountLimit(context.Context, *MsgSetAccountLimit) (*MsgSetAccountLimitResponse, error)
+	// RequestDisableAccountLimit requests the removal of the spend limit of the
+	// signer's account, which happens once the disable timelock has elapsed.
+	RequestDisableAccountLimit(context.Context, *MsgRequestDisableAccountLimit) (*MsgRequestDisableAccountLimitResponse, error)
+	// CancelDisableAccountLimit cancels a pending removal of the spend limit of
+	// the signer's account.
+	CancelDisableAccountLimit(context.Context, *MsgCancelDisableAccountLimit) (*MsgCancelDisableAccountLimitResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetAccountLimit(ctx context.Context, req *MsgSetAccountLimit) (*MsgSetAccountLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountLimit not implemented")
+}
+func (*UnimplementedMsgServer) RequestDisableAccountLimit(ctx context.Context, req *MsgRequestDisableAccountLimit) (*MsgRequestDisableAccountLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDisableAccountLimit not implemented")
+}
+func (*UnimplementedMsgServer) CancelDisableAccountLimit(ctx context.Context, req *MsgCancelDisableAccountLimit) (*MsgCancelDisableAccountLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDisableAccountLimit not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetAccountLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAccountLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAccountLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accountlimits.v1beta1.Msg/SetAccountLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAccountLimit(ctx, req.(*MsgSetAccountLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestDisableAccountLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestDisableAccountLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestDisableAccountLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accountlimits.v1beta1.Msg/RequestDisableAccountLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestDisableAccountLimit(ctx, req.(*MsgRequestDisableAccountLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDisableAccountLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDisableAccountLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDisableAccountLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.accountlimits.v1beta1.Msg/CancelDisableAccountLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDisableAccountLimit(ctx, req.(*MsgCancelDisableAccountLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.accountlimits.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetAccountLimit",
+			Handler:    _Msg_SetAccountLimit_Handler,
+		},
+		{
+			MethodName: "RequestDisableAccountLimit",
+			Handler:    _Msg_RequestDisableAccountLimit_Handler,
+		},
+		{
+			MethodName: "CancelDisableAccountLimit",
+			Handler:    _Msg_CancelDisableAccountLimit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/accountlimits/v1beta1/tx.proto",
+}
+
+func (m *MsgSetAccountLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccountLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedDestinations) > 0 {
+		for iNdEx := len(m.AllowedDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDestinations[iNdEx])
+			copy(dAtA[i:], m.AllowedDestinations[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedDestinations[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DailySpendLimit) > 0 {
+		for iNdEx := len(m.DailySpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailySpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAccountLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAccountLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAccountLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestDisableAccountLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestDisableAccountLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestDisableAccountLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestDisableAccountLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestDisableAccountLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestDisableAccountLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDisableAccountLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDisableAccountLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDisableAccountLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDisableAccountLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDisableAccountLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDisableAccountLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetAccountLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DailySpendLimit) > 0 {
+		for _, e := range m.DailySpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AllowedDestinations) > 0 {
+		for _, s := range m.AllowedDestinations {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAccountLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestDisableAccountLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestDisableAccountLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelDisableAccountLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelDisableAccountLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetAccountLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAccountLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAccountLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailySpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailySpendLimit = append(m.DailySpendLimit, types.Coin{})
+			if err := m.DailySpendLimit[len(m.DailySpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDestinations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDestinations = append(m.AllowedDestinations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAccountLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAccountLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAccountLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestDisableAccountLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestDisableAccountLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestDisableAccountLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestDisableAccountLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestDisableAccountLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestDisableAccountLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDisableAccountLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDisableAccountLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDisableAccountLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDisableAccountLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDisableAccountLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDisableAccountLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -46,10 +46,14 @@ $ <appd> tx broadcast ./mytxn.json
 
 			res, err := clientCtx.BroadcastTx(txBytes)
 			if err != nil {
+				return client.BroadcastError{Err: err}
+			}
+
+			if err := clientCtx.PrintProto(res); err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return client.CheckTxResponse(res)
 		},
 	}
 
@@ -44,6 +44,8 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountsCmd(),
 		QueryParamsCmd(),
 		QueryModuleAccountsCmd(),
+		QueryModuleAddressDerivationCmd(),
+		QueryPubKeyRotationsCmd(),
 	)
 
 	return cmd
@@ -97,6 +99,17 @@ func GetAccountCmd() *cobra.Command {
 				return err
 			}
 
+			// accounts are read from the store when a light client verifier is
+			// set, as gRPC query responses cannot be proven
+			if clientCtx.Verifier != nil {
+				acc, err := queryVerifiedAccount(clientCtx, key)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(acc)
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.Account(cmd.Context(), &types.QueryAccountRequest{Address: key.String()})
 			if err != nil {
@@ -112,6 +125,27 @@ func GetAccountCmd() *cobra.Command {
 	return cmd
 }
 
+// queryVerifiedAccount queries the account of the given address directly from
+// the auth store, so that the response is verified against the proof returned
+// by the node.
+func queryVerifiedAccount(clientCtx client.Context, addr sdk.AccAddress) (types.AccountI, error) {
+	bz, _, err := clientCtx.QueryStore(types.AddressStoreKey(addr), types.StoreKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if bz == nil {
+		return nil, errors.Wrapf(errors.ErrUnknownAddress, "account %s not found", addr)
+	}
+
+	var acc types.AccountI
+	if err := clientCtx.Codec.UnmarshalInterface(bz, &acc); err != nil {
+		return nil, err
+	}
+
+	return acc, nil
+}
+
 // GetAccountsCmd returns a query command that will display a list of accounts
 func GetAccountsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -171,6 +205,79 @@ func QueryModuleAccountsCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryModuleAddressDerivationCmd returns the command handler for resolving a
+// registered module address to its module name and derivation keys.
+func QueryModuleAddressDerivationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-address-derivation [address]",
+		Short: "Query the module name and derivation keys of a registered module address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ModuleAddressDerivation(cmd.Context(), &types.QueryModuleAddressDerivationRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Derivation)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryPubKeyRotationsCmd returns the command handler for querying the public
+// key rotations of an account.
+func QueryPubKeyRotationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pubkey-rotations [address]",
+		Short: "Query the public key rotations of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PubKeyRotations(cmd.Context(), &types.QueryPubKeyRotationsRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pubkey rotations")
+
+	return cmd
+}
+
 // QueryTxsByEventsCmd returns a command to search through transactions by events.
 func QueryTxsByEventsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+const flagFeeBump = "fee-bump"
+
+// GetReplaceCommand returns the tx replace command.
+func GetReplaceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replace [txhash]",
+		Short: "Replace a pending transaction with a copy paying higher fees",
+		Long: strings.TrimSpace(`Fetch the still pending transaction of the given hash from the mempool of
+the node, multiply its fees by --fee-bump, re-sign it with the same sequence with
+the key of the --from flag, which must be its only signer, and broadcast it.
+
+The replacement has the same sequence as the original transaction, so it is only
+accepted by the nodes whose mempool no longer holds the original one, e.g. after it
+was evicted, and only one of the two transactions can be committed.
+
+Example:
+$ <appd> tx replace 0A1B2C... --fee-bump 1.2 --from mykey
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.Offline || clientCtx.GenerateOnly {
+				return errors.New("cannot replace a tx in offline or generate only mode")
+			}
+
+			hash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid tx hash: %w", err)
+			}
+
+			feeBumpStr, _ := cmd.Flags().GetString(flagFeeBump)
+			feeBump, err := sdk.NewDecFromStr(feeBumpStr)
+			if err != nil {
+				return fmt.Errorf("invalid fee bump: %w", err)
+			}
+
+			pendingTx, err := authclient.GetPendingTx(clientCtx, hash)
+			if err != nil {
+				return err
+			}
+
+			sigTx, ok := pendingTx.(authsigning.SigVerifiableTx)
+			if !ok {
+				return fmt.Errorf("tx %X cannot be re-signed", hash)
+			}
+
+			signers := sigTx.GetSigners()
+			if len(signers) != 1 || !signers[0].Equals(clientCtx.GetFromAddress()) {
+				return fmt.Errorf("tx %X must be signed by %s only", hash, clientCtx.GetFromAddress())
+			}
+
+			sigs, err := sigTx.GetSignaturesV2()
+			if err != nil {
+				return err
+			}
+			if len(sigs) != 1 {
+				return fmt.Errorf("tx %X must have a single signature", hash)
+			}
+
+			txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(pendingTx)
+			if err != nil {
+				return err
+			}
+
+			fees, err := authclient.BumpFees(sigTx.(sdk.FeeTx).GetFee(), feeBump)
+			if err != nil {
+				return err
+			}
+			txBuilder.SetFeeAmount(fees)
+
+			accNum, _, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).
+				WithAccountNumber(accNum).
+				WithSequence(sigs[0].Sequence)
+			if err := tx.Sign(txf, clientCtx.GetFromName(), txBuilder, true); err != nil {
+				return err
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return client.BroadcastError{Err: err}
+			}
+
+			if err := clientCtx.PrintProto(res); err != nil {
+				return err
+			}
+
+			return client.CheckTxResponse(res)
+		},
+	}
+
+	cmd.Flags().String(flagFeeBump, "1.2", "Factor the fees of the replaced transaction are multiplied by, greater than 1")
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Auth transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewChangePubKeyCmd(),
+	)
+
+	return cmd
+}
+
+// NewChangePubKeyCmd returns a CLI command handler for creating a
+// MsgChangePubKey transaction.
+func NewChangePubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-pubkey [pubkey]",
+		Short: "Rotate the public key of an account",
+		Long: strings.TrimSpace(`Rotate the public key of the account of the '--from' flag to the given
+public key. The account keeps its address, account number and sequence, and its
+following transactions must be signed with the new key.
+
+Example:
+$ <appd> tx auth change-pubkey '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A8oWyJkohwy8XZ0Df92jFMBTtTPMvYJplYIrlEHTKPYk"}' --from mykey
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgChangePubKey(clientCtx.GetFromAddress(), pk)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPendingTx returns the tx of the given hash from the mempool of the node.
+// An error is returned if the tx is already committed, or if it is not among
+// the unconfirmed txs returned by the node, which are limited to 100 txs.
+func GetPendingTx(clientCtx client.Context, hash []byte) (sdk.Tx, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	if res, err := node.Tx(context.Background(), hash, false); err == nil {
+		return nil, fmt.Errorf("tx %X is already committed at height %d", hash, res.Height)
+	}
+
+	limit := 100
+	res, err := node.UnconfirmedTxs(context.Background(), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bz := range res.Txs {
+		if bytes.Equal(bz.Hash(), hash) {
+			return clientCtx.TxConfig.TxDecoder()(bz)
+		}
+	}
+
+	return nil, fmt.Errorf("tx %X not found in the mempool of the node", hash)
+}
+
+// BumpFees returns the fees multiplied by the given bump, rounded up, so that
+// each non-zero fee coin is increased.
+func BumpFees(fees sdk.Coins, bump sdk.Dec) (sdk.Coins, error) {
+	if !bump.GT(sdk.OneDec()) {
+		return nil, fmt.Errorf("fee bump must be greater than 1: %s", bump)
+	}
+
+	bumped := make(sdk.Coins, len(fees))
+	for i, fee := range fees {
+		bumped[i] = sdk.NewCoin(fee.Denom, fee.Amount.ToDec().Mul(bump).Ceil().TruncateInt())
+	}
+
+	return bumped, nil
+}
@@ -0,0 +1,78 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+type mempoolClient struct {
+	mock.Client
+	committed map[string]int64
+	pending   tmtypes.Txs
+}
+
+func (c mempoolClient) Tx(_ context.Context, hash []byte, _ bool) (*ctypes.ResultTx, error) {
+	height, ok := c.committed[string(hash)]
+	if !ok {
+		return nil, errors.New("tx not found")
+	}
+
+	return &ctypes.ResultTx{Hash: hash, Height: height}, nil
+}
+
+func (c mempoolClient) UnconfirmedTxs(context.Context, *int) (*ctypes.ResultUnconfirmedTxs, error) {
+	return &ctypes.ResultUnconfirmedTxs{Txs: c.pending}, nil
+}
+
+func TestGetPendingTx(t *testing.T) {
+	txCfg := simapp.MakeTestEncodingConfig().TxConfig
+
+	encode := func(memo string) tmtypes.Tx {
+		txBuilder := txCfg.NewTxBuilder()
+		txBuilder.SetMemo(memo)
+		bz, err := txCfg.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+	committed, pending := encode("committed"), encode("pending")
+
+	clientCtx := client.Context{}.
+		WithTxConfig(txCfg).
+		WithClient(mempoolClient{
+			committed: map[string]int64{string(committed.Hash()): 10},
+			pending:   tmtypes.Txs{encode("other"), pending},
+		})
+
+	tx, err := authclient.GetPendingTx(clientCtx, pending.Hash())
+	require.NoError(t, err)
+	require.Equal(t, "pending", tx.(sdk.TxWithMemo).GetMemo())
+
+	_, err = authclient.GetPendingTx(clientCtx, committed.Hash())
+	require.EqualError(t, err, fmt.Sprintf("tx %X is already committed at height 10", committed.Hash()))
+
+	_, err = authclient.GetPendingTx(clientCtx, encode("unknown").Hash())
+	require.Error(t, err)
+}
+
+func TestBumpFees(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("stake", 1))
+
+	bumped, err := authclient.BumpFees(fees, sdk.MustNewDecFromStr("1.2"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 180), sdk.NewInt64Coin("stake", 2)), bumped)
+
+	_, err = authclient.BumpFees(fees, sdk.OneDec())
+	require.Error(t, err)
+}
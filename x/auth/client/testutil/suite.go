@@ -1331,6 +1331,53 @@ func (s *IntegrationTestSuite) TestQueryParamsCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestChangePubKeyCmd() {
+	val := s.network.Validators[0]
+	_, pub, _ := testdata.KeyTestPubAddr()
+
+	pkJSON, err := val.ClientCtx.Codec.MarshalInterfaceJSON(pub)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"invalid pubkey",
+			[]string{"invalid", fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address), fmt.Sprintf("--%s=true", flags.FlagGenerateOnly)},
+			true,
+		},
+		{
+			"valid pubkey",
+			[]string{string(pkJSON), fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address), fmt.Sprintf("--%s=true", flags.FlagGenerateOnly)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, authcli.NewChangePubKeyCmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			txJSON, err := val.ClientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+			s.Require().NoError(err)
+
+			msgs := txJSON.GetMsgs()
+			s.Require().Len(msgs, 1)
+			msg, ok := msgs[0].(*authtypes.MsgChangePubKey)
+			s.Require().True(ok)
+			s.Require().Equal(val.Address.String(), msg.Address)
+			s.Require().True(pub.Equals(msg.GetPubKey()))
+		})
+	}
+}
+
 // TestTxWithoutPublicKey makes sure sending a proto tx message without the
 // public key doesn't cause any error in the RPC layer (broadcast).
 // See https://github.com/cosmos/cosmos-sdk/issues/7585 for more details.
@@ -25,6 +25,14 @@ func InitGenesis(ctx sdk.Context, ak keeper.AccountKeeper, data types.GenesisSta
 	}
 
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
+
+	for _, derivation := range data.ModuleAddressDerivations {
+		ak.SetModuleAddressDerivation(ctx, derivation)
+	}
+
+	for _, rotation := range data.PubKeyRotations {
+		ak.AppendPubKeyRotation(ctx, rotation)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
@@ -38,5 +46,15 @@ func ExportGenesis(ctx sdk.Context, ak keeper.AccountKeeper) *types.GenesisState
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genState := types.NewGenesisState(params, genAccounts)
+	ak.IterateModuleAddressDerivations(ctx, func(derivation types.ModuleAddressDerivation) bool {
+		genState.ModuleAddressDerivations = append(genState.ModuleAddressDerivations, derivation)
+		return false
+	})
+	ak.IteratePubKeyRotations(ctx, func(rotation types.PubKeyRotation) bool {
+		genState.PubKeyRotations = append(genState.PubKeyRotations, rotation)
+		return false
+	})
+
+	return genState
 }
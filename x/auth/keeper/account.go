@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -27,19 +30,25 @@ func (ak AccountKeeper) NewAccount(ctx sdk.Context, acc types.AccountI) types.Ac
 
 // HasAccount implements AccountKeeperI.
 func (ak AccountKeeper) HasAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
-	store := ctx.KVStore(ak.key)
-	return store.Has(types.AddressStoreKey(addr))
+	has, err := ak.accounts.Has(ctx, addr)
+	if err != nil {
+		panic(err)
+	}
+
+	return has
 }
 
 // GetAccount implements AccountKeeperI.
 func (ak AccountKeeper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI {
-	store := ctx.KVStore(ak.key)
-	bz := store.Get(types.AddressStoreKey(addr))
-	if bz == nil {
+	acc, err := ak.accounts.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
 		return nil
 	}
+	if err != nil {
+		panic(err)
+	}
 
-	return ak.decodeAccount(bz)
+	return acc.(types.AccountI)
 }
 
 // GetAllAccounts returns all accounts in the accountKeeper.
@@ -54,37 +63,26 @@ func (ak AccountKeeper) GetAllAccounts(ctx sdk.Context) (accounts []types.Accoun
 
 // SetAccount implements AccountKeeperI.
 func (ak AccountKeeper) SetAccount(ctx sdk.Context, acc types.AccountI) {
-	addr := acc.GetAddress()
-	store := ctx.KVStore(ak.key)
-
-	bz, err := ak.MarshalAccount(acc)
-	if err != nil {
+	if err := ak.accounts.Set(ctx, acc.GetAddress(), acc); err != nil {
 		panic(err)
 	}
-
-	store.Set(types.AddressStoreKey(addr), bz)
 }
 
 // RemoveAccount removes an account for the account mapper store.
 // NOTE: this will cause supply invariant violation if called
 func (ak AccountKeeper) RemoveAccount(ctx sdk.Context, acc types.AccountI) {
-	addr := acc.GetAddress()
-	store := ctx.KVStore(ak.key)
-	store.Delete(types.AddressStoreKey(addr))
+	if err := ak.accounts.Remove(ctx, acc.GetAddress()); err != nil {
+		panic(err)
+	}
 }
 
 // IterateAccounts iterates over all the stored accounts and performs a callback function.
 // Stops iteration when callback returns true.
 func (ak AccountKeeper) IterateAccounts(ctx sdk.Context, cb func(account types.AccountI) (stop bool)) {
-	store := ctx.KVStore(ak.key)
-	iterator := sdk.KVStorePrefixIterator(store, types.AddressStoreKeyPrefix)
-
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		account := ak.decodeAccount(iterator.Value())
-
-		if cb(account) {
-			break
-		}
+	err := ak.accounts.Iterate(ctx, nil, func(_, acc interface{}) (bool, error) {
+		return cb(acc.(types.AccountI)), nil
+	})
+	if err != nil {
+		panic(err)
 	}
 }
@@ -24,13 +24,10 @@ func (ak AccountKeeper) Accounts(c context.Context, req *types.QueryAccountsRequ
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	store := ctx.KVStore(ak.key)
-	accountsStore := prefix.NewStore(store, types.AddressStoreKeyPrefix)
 
 	var accounts []*codectypes.Any
-	pageRes, err := query.Paginate(accountsStore, req.Pagination, func(key, value []byte) error {
-		account := ak.decodeAccount(value)
-		any, err := codectypes.NewAnyWithValue(account)
+	pageRes, err := ak.accounts.Paginate(ctx, req.Pagination, nil, func(_, account interface{}) error {
+		any, err := codectypes.NewAnyWithValue(account.(types.AccountI))
 		if err != nil {
 			return err
 		}
@@ -153,3 +150,62 @@ func (ak AccountKeeper) AddressStringToBytes(ctx context.Context, req *types.Add
 
 	return &types.AddressStringToBytesResponse{AddressBytes: bz}, nil
 }
+
+// ModuleAddressDerivation resolves a registered module address to its derivation
+func (ak AccountKeeper) ModuleAddressDerivation(c context.Context, req *types.QueryModuleAddressDerivationRequest) (*types.QueryModuleAddressDerivationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "Address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	derivation, found := ak.GetModuleAddressDerivation(ctx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no module address derivation registered for %s", req.Address)
+	}
+
+	return &types.QueryModuleAddressDerivationResponse{Derivation: derivation}, nil
+}
+
+// PubKeyRotations returns the public key rotations of an account
+func (ak AccountKeeper) PubKeyRotations(c context.Context, req *types.QueryPubKeyRotationsRequest) (*types.QueryPubKeyRotationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "Address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(ak.key), types.PubKeyRotationsPrefixKey(addr))
+
+	var rotations []types.PubKeyRotation
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var rotation types.PubKeyRotation
+		if err := ak.cdc.Unmarshal(value, &rotation); err != nil {
+			return err
+		}
+
+		rotations = append(rotations, rotation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+
+	return &types.QueryPubKeyRotationsResponse{Rotations: rotations, Pagination: pageRes}, nil
+}
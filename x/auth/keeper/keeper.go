@@ -8,6 +8,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/collections"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -18,6 +19,8 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+//go:generate go run ../../../store/readonly/cmd/readonlygen -source keeper.go -interface AccountKeeperI -name ReadOnlyAccountKeeper -methods HasAccount,GetAccount,IterateAccounts,GetPubKey,GetSequence -output readonly.go
+
 // AccountKeeperI is the interface contract that x/auth's keeper implements.
 type AccountKeeperI interface {
 	// Return a new account with the next account number and the specified address. Does not save the new account to the store.
@@ -59,9 +62,16 @@ type AccountKeeper struct {
 	paramSubspace paramtypes.Subspace
 	permAddrs     map[string]types.PermissionsForAddress
 
+	// accounts stores the accounts by address
+	accounts collections.Map
+
 	// The prototypical AccountI constructor.
 	proto      func() types.AccountI
 	addressCdc address.Codec
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -74,7 +84,7 @@ var _ AccountKeeperI = &AccountKeeper{}
 // may use auth.Keeper to access the accounts permissions map.
 func NewAccountKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramstore paramtypes.Subspace, proto func() types.AccountI,
-	maccPerms map[string][]string, bech32Prefix string,
+	maccPerms map[string][]string, bech32Prefix string, authority string,
 ) AccountKeeper {
 
 	// set KeyTable if it has not already been set
@@ -88,6 +98,10 @@ func NewAccountKeeper(
 	}
 
 	bech32Codec := newBech32Codec(bech32Prefix)
+	accounts := collections.NewMap(
+		key, types.AddressStoreKeyPrefix, "accounts", collections.AccAddressKey,
+		collections.InterfaceValue(cdc, func() interface{} { return new(types.AccountI) }),
+	)
 
 	return AccountKeeper{
 		key:           key,
@@ -95,10 +109,17 @@ func NewAccountKeeper(
 		cdc:           cdc,
 		paramSubspace: paramstore,
 		permAddrs:     permAddrs,
+		accounts:      accounts,
 		addressCdc:    bech32Codec,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the address allowed to execute MsgUpdateParams.
+func (ak AccountKeeper) GetAuthority() string {
+	return ak.authority
+}
+
 // Logger returns a module-specific logger.
 func (ak AccountKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -221,15 +242,6 @@ func (ak AccountKeeper) SetModuleAccount(ctx sdk.Context, macc types.ModuleAccou
 	ak.SetAccount(ctx, macc)
 }
 
-func (ak AccountKeeper) decodeAccount(bz []byte) types.AccountI {
-	acc, err := ak.UnmarshalAccount(bz)
-	if err != nil {
-		panic(err)
-	}
-
-	return acc
-}
-
 // MarshalAccount protobuf serializes an Account interface
 func (ak AccountKeeper) MarshalAccount(accountI types.AccountI) ([]byte, error) { // nolint:interfacer
 	return ak.cdc.MarshalInterface(accountI)
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -8,9 +9,14 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/readonly"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
 )
 
 const (
@@ -132,7 +138,7 @@ func TestSupply_ValidatePermissions(t *testing.T) {
 	cdc := simapp.MakeTestEncodingConfig().Codec
 	keeper := keeper.NewAccountKeeper(
 		cdc, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		types.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		types.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix, types.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	err := keeper.ValidatePermissions(multiPermAcc)
@@ -146,3 +152,72 @@ func TestSupply_ValidatePermissions(t *testing.T) {
 	err = app.AccountKeeper.ValidatePermissions(otherAcc)
 	require.Error(t, err)
 }
+
+func (suite *KeeperTestSuite) TestMsgUpdateParams() {
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	ak := suite.app.AccountKeeper
+	msgServer := keeper.NewMsgServerImpl(ak)
+
+	params := ak.GetParams(ctx)
+	params.MaxMemoCharacters = 1024
+	params.SigVerifyCostSecp256k1 = 2000
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(types.NewModuleAddress("foo").String(), params))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	invalid := params
+	invalid.TxSigLimit = 0
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(ak.GetAuthority(), invalid))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(ak.GetAuthority(), params))
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, ak.GetParams(ctx))
+
+	events := ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal("cosmos.auth.v1beta1.EventUpdateParams", events[0].Type)
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateParamsProposal() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	ak := suite.app.AccountKeeper
+
+	params := ak.GetParams(ctx)
+	params.MaxMemoCharacters = 1024
+
+	// governance schedules the Msg, which is executed by the scheduler
+	content, err := scheduler.NewScheduleMsgProposal("title", "description", types.NewMsgUpdateParams(ak.GetAuthority(), params), 12, nil, 1, 0, 0)
+	suite.Require().NoError(err)
+	suite.Require().NoError(content.ValidateBasic())
+
+	handler := suite.app.GovKeeper.Router().GetRoute(content.ProposalRoute())
+	suite.Require().NoError(handler(ctx, content))
+	suite.Require().NotEqual(params, ak.GetParams(ctx))
+
+	ctx = ctx.WithBlockHeight(12)
+	suite.app.SchedulerKeeper.ExecuteDueSchedules(ctx)
+	suite.Require().Equal(params, ak.GetParams(ctx))
+}
+
+func TestReadOnlyAccountKeeper(t *testing.T) {
+	app, ctx := createTestApp(t, true)
+	addr := sdk.AccAddress([]byte("some---------address"))
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetSequence(3))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	ak := keeper.NewReadOnlyAccountKeeper(app.AccountKeeper)
+	require.True(t, ak.HasAccount(ctx, addr))
+	require.Equal(t, acc, ak.GetAccount(ctx, addr))
+	seq, err := ak.GetSequence(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), seq)
+
+	// the keeper panics if written with the stores of the read-only keeper
+	err = readonly.Recover(func() {
+		app.AccountKeeper.SetAccount(ctx.WithReadOnlyStores(), acc)
+	})
+	require.True(t, errors.Is(err, storetypes.ErrReadOnlyWrite))
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RegisterModuleAddress derives the address of a module sub-account from the
+// given module name and derivation keys, and registers its derivation so that
+// the address can be resolved back to its module. Registering the same
+// derivation again is a no-op, while deriving an address already registered
+// with another derivation or used by a module account fails.
+func (ak AccountKeeper) RegisterModuleAddress(ctx sdk.Context, moduleName string, derivationKeys ...[]byte) (sdk.AccAddress, error) {
+	derivation := types.NewModuleAddressDerivation(moduleName, derivationKeys...)
+	if err := derivation.Validate(); err != nil {
+		return nil, err
+	}
+
+	addr := derivation.GetAddress()
+	if existing, found := ak.GetModuleAddressDerivation(ctx, addr); found {
+		if existing.HasPath(moduleName, derivationKeys) {
+			return addr, nil
+		}
+
+		return nil, sdkerrors.Wrapf(types.ErrModuleAddressCollision, "%s is already derived from module %s", addr, existing.ModuleName)
+	}
+
+	if acc := ak.GetAccount(ctx, addr); acc != nil {
+		if macc, ok := acc.(types.ModuleAccountI); ok {
+			return nil, sdkerrors.Wrapf(types.ErrModuleAddressCollision, "%s is the address of module account %s", addr, macc.GetName())
+		}
+	}
+
+	ak.SetModuleAddressDerivation(ctx, derivation)
+
+	return addr, nil
+}
+
+// GetModuleAddressDerivation returns the derivation of a registered module
+// address.
+func (ak AccountKeeper) GetModuleAddressDerivation(ctx sdk.Context, addr sdk.AccAddress) (types.ModuleAddressDerivation, bool) {
+	store := ctx.KVStore(ak.key)
+	bz := store.Get(types.ModuleAddressDerivationKey(addr))
+	if bz == nil {
+		return types.ModuleAddressDerivation{}, false
+	}
+
+	var derivation types.ModuleAddressDerivation
+	ak.cdc.MustUnmarshal(bz, &derivation)
+
+	return derivation, true
+}
+
+// SetModuleAddressDerivation sets the derivation of a module address in the
+// registry.
+func (ak AccountKeeper) SetModuleAddressDerivation(ctx sdk.Context, derivation types.ModuleAddressDerivation) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.ModuleAddressDerivationKey(derivation.GetAddress()), ak.cdc.MustMarshal(&derivation))
+}
+
+// IterateModuleAddressDerivations iterates over the registered module address
+// derivations. If the cb returns true, the iterator will close and stop.
+func (ak AccountKeeper) IterateModuleAddressDerivations(ctx sdk.Context, cb func(types.ModuleAddressDerivation) (stop bool)) {
+	store := ctx.KVStore(ak.key)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ModuleAddressDerivationKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var derivation types.ModuleAddressDerivation
+		ak.cdc.MustUnmarshal(iterator.Value(), &derivation)
+
+		if cb(derivation) {
+			break
+		}
+	}
+}
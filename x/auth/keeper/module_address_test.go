@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterModuleAddress() {
+	app, ctx := suite.app, suite.ctx
+
+	addr, err := app.AccountKeeper.RegisterModuleAddress(ctx, "escrow", []byte("channel-0"), []byte{1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.AccAddress(address.Module("escrow", []byte("channel-0"), []byte{1})), addr)
+
+	derivation, found := app.AccountKeeper.GetModuleAddressDerivation(ctx, addr)
+	suite.Require().True(found)
+	suite.Require().Equal("escrow", derivation.ModuleName)
+	suite.Require().Equal([][]byte{[]byte("channel-0"), {1}}, derivation.DerivationKeys)
+
+	// registering the same derivation is a no-op
+	addr2, err := app.AccountKeeper.RegisterModuleAddress(ctx, "escrow", []byte("channel-0"), []byte{1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr, addr2)
+
+	// a derivation without keys is the module account address
+	_, err = app.AccountKeeper.RegisterModuleAddress(ctx, "escrow")
+	suite.Require().ErrorIs(err, types.ErrInvalidDerivation)
+
+	// a derivation resolving to an address registered with another path fails
+	other := types.NewModuleAddressDerivation("other", []byte{2})
+	other.Address = addr.String()
+	app.AccountKeeper.SetModuleAddressDerivation(ctx, other)
+	_, err = app.AccountKeeper.RegisterModuleAddress(ctx, "escrow", []byte("channel-0"), []byte{1})
+	suite.Require().ErrorIs(err, types.ErrModuleAddressCollision)
+
+	// a derivation resolving to a module account fails
+	maccAddr := sdk.AccAddress(address.Module("escrow", []byte{3}))
+	macc := types.NewModuleAccount(types.NewBaseAccountWithAddress(maccAddr), "escrow-macc")
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, macc))
+	_, err = app.AccountKeeper.RegisterModuleAddress(ctx, "escrow", []byte{3})
+	suite.Require().ErrorIs(err, types.ErrModuleAddressCollision)
+
+	var derivations []types.ModuleAddressDerivation
+	app.AccountKeeper.IterateModuleAddressDerivations(ctx, func(d types.ModuleAddressDerivation) bool {
+		derivations = append(derivations, d)
+		return false
+	})
+	suite.Require().Len(derivations, 1)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryModuleAddressDerivation() {
+	addr, err := suite.app.AccountKeeper.RegisterModuleAddress(suite.ctx, "escrow", []byte{1})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.ModuleAddressDerivation(sdk.WrapSDKContext(suite.ctx), &types.QueryModuleAddressDerivationRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewModuleAddressDerivation("escrow", []byte{1}), res.Derivation)
+
+	_, err = suite.queryClient.ModuleAddressDerivation(sdk.WrapSDKContext(suite.ctx), &types.QueryModuleAddressDerivationRequest{Address: sdk.AccAddress(address.Module("escrow", []byte{2})).String()})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.ModuleAddressDerivation(sdk.WrapSDKContext(suite.ctx), &types.QueryModuleAddressDerivationRequest{})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type msgServer struct {
+	AccountKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the auth MsgServer interface
+// for the provided AccountKeeper.
+func NewMsgServerImpl(ak AccountKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: ak}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// ChangePubKey implements the Msg/ChangePubKey method.
+func (ms msgServer) ChangePubKey(goCtx context.Context, msg *types.MsgChangePubKey) (*types.MsgChangePubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey := msg.GetPubKey()
+	if pubKey == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "empty public key")
+	}
+
+	if err := ms.AccountKeeper.ChangePubKey(ctx, addr, pubKey); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address),
+		),
+	)
+
+	return &types.MsgChangePubKeyResponse{}, nil
+}
+
+// UpdateParams implements the Msg/UpdateParams method. Only the authority of
+// the keeper can update the parameters.
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", ms.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid params: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	oldParams := ms.GetParams(ctx)
+	ms.SetParams(ctx, msg.Params)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUpdateParams{
+		Authority: msg.Authority,
+		OldParams: oldParams,
+		NewParams: msg.Params,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
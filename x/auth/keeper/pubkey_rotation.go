@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"fmt"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ChangePubKey rotates the public key of an account. The account keeps its
+// address, account number and sequence, and the rotation is recorded in its
+// public key history. The rotation consumes the PubKeyChangeCost gas and fails
+// if the last rotation of the account is more recent than the
+// PubKeyChangeCooldown.
+func (ak AccountKeeper) ChangePubKey(ctx sdk.Context, addr sdk.AccAddress, pubKey cryptotypes.PubKey) error {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+	if _, ok := acc.(types.ModuleAccountI); ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot change the public key of module account %s", addr)
+	}
+
+	oldPubKey := acc.GetPubKey()
+	if oldPubKey != nil && oldPubKey.Equals(pubKey) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "new public key is the current public key of the account")
+	}
+
+	params := ak.GetParams(ctx)
+	if last, found := ak.GetLastPubKeyRotation(ctx, addr); found {
+		if next := last.Time.Add(params.PubKeyChangeCooldown); ctx.BlockTime().Before(next) {
+			return sdkerrors.Wrapf(types.ErrPubKeyChangeCooldown, "next rotation allowed at %s", next)
+		}
+	}
+
+	ctx.GasMeter().ConsumeGas(params.PubKeyChangeCost, "pub key change")
+
+	if err := acc.SetPubKey(pubKey); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	ak.SetAccount(ctx, acc)
+
+	rotation, err := types.NewPubKeyRotation(addr, oldPubKey, pubKey, acc.GetSequence(), ctx.BlockHeight(), ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+	ak.AppendPubKeyRotation(ctx, rotation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangePubKey,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyPubKey, fmt.Sprintf("%X", pubKey.Bytes())),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", acc.GetSequence())),
+		),
+	)
+
+	return nil
+}
+
+// AppendPubKeyRotation appends a rotation to the public key history of an
+// account.
+func (ak AccountKeeper) AppendPubKeyRotation(ctx sdk.Context, rotation types.PubKeyRotation) {
+	addr, err := sdk.AccAddressFromBech32(rotation.Address)
+	if err != nil {
+		panic(fmt.Sprintf("invalid pub key rotation address %s: %s", rotation.Address, err))
+	}
+
+	store := ctx.KVStore(ak.key)
+	prefix := types.PubKeyRotationsPrefixKey(addr)
+
+	// the rotations are indexed sequentially per account, so the next index
+	// follows the one of the last rotation
+	index := uint64(0)
+	iter := sdk.KVStoreReversePrefixIterator(store, prefix)
+	if iter.Valid() {
+		index = sdk.BigEndianToUint64(iter.Key()[len(prefix):]) + 1
+	}
+	iter.Close()
+
+	store.Set(types.PubKeyRotationKey(addr, index), ak.cdc.MustMarshal(&rotation))
+}
+
+// GetLastPubKeyRotation returns the last public key rotation of an account.
+func (ak AccountKeeper) GetLastPubKeyRotation(ctx sdk.Context, addr sdk.AccAddress) (types.PubKeyRotation, bool) {
+	store := ctx.KVStore(ak.key)
+	iter := sdk.KVStoreReversePrefixIterator(store, types.PubKeyRotationsPrefixKey(addr))
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return types.PubKeyRotation{}, false
+	}
+
+	var rotation types.PubKeyRotation
+	ak.cdc.MustUnmarshal(iter.Value(), &rotation)
+
+	return rotation, true
+}
+
+// GetPubKeyRotations returns the public key rotations of an account, from
+// oldest to newest.
+func (ak AccountKeeper) GetPubKeyRotations(ctx sdk.Context, addr sdk.AccAddress) []types.PubKeyRotation {
+	store := ctx.KVStore(ak.key)
+	iter := sdk.KVStorePrefixIterator(store, types.PubKeyRotationsPrefixKey(addr))
+	defer iter.Close()
+
+	rotations := []types.PubKeyRotation{}
+	for ; iter.Valid(); iter.Next() {
+		var rotation types.PubKeyRotation
+		ak.cdc.MustUnmarshal(iter.Value(), &rotation)
+		rotations = append(rotations, rotation)
+	}
+
+	return rotations
+}
+
+// IteratePubKeyRotations iterates over the public key rotations of all the
+// accounts. If the cb returns true, the iterator will close and stop.
+func (ak AccountKeeper) IteratePubKeyRotations(ctx sdk.Context, cb func(types.PubKeyRotation) (stop bool)) {
+	store := ctx.KVStore(ak.key)
+	iter := sdk.KVStorePrefixIterator(store, types.PubKeyRotationKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var rotation types.PubKeyRotation
+		ak.cdc.MustUnmarshal(iter.Value(), &rotation)
+
+		if cb(rotation) {
+			break
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestChangePubKey() {
+	app, ctx := suite.app, suite.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	_, pub1, addr := testdata.KeyTestPubAddr()
+	_, pub2, _ := testdata.KeyTestPubAddr()
+	_, pub3, _ := testdata.KeyTestPubAddr()
+
+	// unknown account
+	suite.Require().ErrorIs(app.AccountKeeper.ChangePubKey(ctx, addr, pub2), sdkerrors.ErrUnknownAddress)
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	suite.Require().NoError(acc.SetPubKey(pub1))
+	suite.Require().NoError(acc.SetSequence(5))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	// the current pubkey
+	suite.Require().ErrorIs(app.AccountKeeper.ChangePubKey(ctx, addr, pub1), sdkerrors.ErrInvalidPubKey)
+
+	params := app.AccountKeeper.GetParams(ctx)
+	gasBefore := ctx.GasMeter().GasConsumed()
+	suite.Require().NoError(app.AccountKeeper.ChangePubKey(ctx, addr, pub2))
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, params.PubKeyChangeCost)
+
+	// the account keeps its number and sequence
+	rotated := app.AccountKeeper.GetAccount(ctx, addr)
+	suite.Require().True(pub2.Equals(rotated.GetPubKey()))
+	suite.Require().Equal(acc.GetAccountNumber(), rotated.GetAccountNumber())
+	suite.Require().Equal(uint64(5), rotated.GetSequence())
+
+	rotations := app.AccountKeeper.GetPubKeyRotations(ctx, addr)
+	suite.Require().Len(rotations, 1)
+	suite.Require().True(pub1.Equals(rotations[0].GetOldPubKey()))
+	suite.Require().True(pub2.Equals(rotations[0].GetNewPubKey()))
+	suite.Require().Equal(uint64(5), rotations[0].Sequence)
+	suite.Require().Equal(int64(10), rotations[0].Height)
+
+	// the cooldown must elapse before the next rotation
+	suite.Require().ErrorIs(app.AccountKeeper.ChangePubKey(ctx, addr, pub3), types.ErrPubKeyChangeCooldown)
+
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(ctx.BlockTime().Add(params.PubKeyChangeCooldown))
+	suite.Require().NoError(app.AccountKeeper.ChangePubKey(ctx, addr, pub3))
+
+	rotations = app.AccountKeeper.GetPubKeyRotations(ctx, addr)
+	suite.Require().Len(rotations, 2)
+	suite.Require().True(pub2.Equals(rotations[1].GetOldPubKey()))
+	suite.Require().True(pub3.Equals(rotations[1].GetNewPubKey()))
+
+	last, found := app.AccountKeeper.GetLastPubKeyRotation(ctx, addr)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(20), last.Height)
+
+	// module accounts can't be rotated
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	suite.Require().ErrorIs(app.AccountKeeper.ChangePubKey(ctx, feeCollector.GetAddress(), pub1), sdkerrors.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestMsgChangePubKey() {
+	app, ctx := suite.app, suite.ctx
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, pub, _ := testdata.KeyTestPubAddr()
+
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+
+	msg, err := types.NewMsgChangePubKey(addr, pub)
+	suite.Require().NoError(err)
+
+	msgServer := keeper.NewMsgServerImpl(app.AccountKeeper)
+	_, err = msgServer.ChangePubKey(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+
+	pk, err := app.AccountKeeper.GetPubKey(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().True(pub.Equals(pk))
+
+	res, err := suite.queryClient.PubKeyRotations(sdk.WrapSDKContext(ctx), &types.QueryPubKeyRotationsRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Rotations, 1)
+	suite.Require().Nil(res.Rotations[0].GetOldPubKey())
+	suite.Require().True(pub.Equals(res.Rotations[0].GetNewPubKey()))
+
+	_, err = suite.queryClient.PubKeyRotations(sdk.WrapSDKContext(ctx), &types.QueryPubKeyRotationsRequest{})
+	suite.Require().Error(err)
+}
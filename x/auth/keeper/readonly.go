@@ -0,0 +1,60 @@
+// Code generated by readonlygen. DO NOT EDIT.
+
+package keeper
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ReadOnlyAccountKeeper is the read-only view of AccountKeeperI.
+type ReadOnlyAccountKeeper interface {
+	// Check if an account exists in the store.
+	HasAccount(ctx sdk.Context, arg1 sdk.AccAddress) bool
+	// Retrieve an account from the store.
+	GetAccount(ctx sdk.Context, arg1 sdk.AccAddress) types.AccountI
+	// Iterate over all accounts, calling the provided function. Stop iteration when it returns true.
+	IterateAccounts(ctx sdk.Context, arg1 func(types.AccountI) bool)
+	// Fetch the public key of an account at a specified address
+	GetPubKey(ctx sdk.Context, arg1 sdk.AccAddress) (cryptotypes.PubKey, error)
+	// Fetch the sequence of an account at a specified address.
+	GetSequence(ctx sdk.Context, arg1 sdk.AccAddress) (uint64, error)
+}
+
+type readOnlyAccountKeeper struct {
+	keeper AccountKeeperI
+}
+
+var _ ReadOnlyAccountKeeper = readOnlyAccountKeeper{}
+
+// NewReadOnlyAccountKeeper returns the read-only view of the keeper, whose methods are
+// called with read-only stores, panicking with ErrReadOnlyWrite if written.
+func NewReadOnlyAccountKeeper(keeper AccountKeeperI) ReadOnlyAccountKeeper {
+	return readOnlyAccountKeeper{keeper: keeper}
+}
+
+// HasAccount implements ReadOnlyAccountKeeper.
+func (k readOnlyAccountKeeper) HasAccount(ctx sdk.Context, arg1 sdk.AccAddress) bool {
+	return k.keeper.HasAccount(ctx.WithReadOnlyStores(), arg1)
+}
+
+// GetAccount implements ReadOnlyAccountKeeper.
+func (k readOnlyAccountKeeper) GetAccount(ctx sdk.Context, arg1 sdk.AccAddress) types.AccountI {
+	return k.keeper.GetAccount(ctx.WithReadOnlyStores(), arg1)
+}
+
+// IterateAccounts implements ReadOnlyAccountKeeper.
+func (k readOnlyAccountKeeper) IterateAccounts(ctx sdk.Context, arg1 func(types.AccountI) bool) {
+	k.keeper.IterateAccounts(ctx.WithReadOnlyStores(), arg1)
+}
+
+// GetPubKey implements ReadOnlyAccountKeeper.
+func (k readOnlyAccountKeeper) GetPubKey(ctx sdk.Context, arg1 sdk.AccAddress) (cryptotypes.PubKey, error) {
+	return k.keeper.GetPubKey(ctx.WithReadOnlyStores(), arg1)
+}
+
+// GetSequence implements ReadOnlyAccountKeeper.
+func (k readOnlyAccountKeeper) GetSequence(ctx sdk.Context, arg1 sdk.AccAddress) (uint64, error) {
+	return k.keeper.GetSequence(ctx.WithReadOnlyStores(), arg1)
+}
@@ -0,0 +1,184 @@
+package middleware
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// Names of the middlewares of the default middleware chain.
+const (
+	GasMiddlewareName                    = "gas"
+	RecoveryMiddlewareName               = "recovery"
+	IndexEventsMiddlewareName            = "index-events"
+	RejectExtensionOptionsMiddlewareName = "reject-extension-options"
+	MempoolFeeMiddlewareName             = "mempool-fee"
+	ValidateBasicMiddlewareName          = "validate-basic"
+	TxTimeoutHeightMiddlewareName        = "tx-timeout-height"
+	ValidateMemoMiddlewareName           = "validate-memo"
+	ConsumeTxSizeGasMiddlewareName       = "consume-tx-size-gas"
+	DeductFeeMiddlewareName              = "deduct-fee"
+	SetPubKeyMiddlewareName              = "set-pubkey"
+	ValidateSigCountMiddlewareName       = "validate-sig-count"
+	SigGasConsumeMiddlewareName          = "sig-gas-consume"
+	SigVerificationMiddlewareName        = "sig-verification"
+	IncrementSequenceMiddlewareName      = "increment-sequence"
+)
+
+// NamedMiddleware is a tx.Middleware identified by a name in a MiddlewareChain.
+type NamedMiddleware struct {
+	Name       string
+	Middleware tx.Middleware
+}
+
+// MiddlewareChain is a list of named middlewares, ordered from outer to inner
+// as in ComposeMiddlewares. Apps can start from NewDefaultMiddlewareChain and
+// insert, replace or remove middlewares by name, instead of copying the whole
+// list of middlewares. The methods of MiddlewareChain do not modify the chain
+// they are called on.
+type MiddlewareChain []NamedMiddleware
+
+// NewDefaultMiddlewareChain returns the middleware chain of NewDefaultTxHandler.
+func NewDefaultMiddlewareChain(options TxHandlerOptions) MiddlewareChain {
+	var sigGasConsumer = options.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = DefaultSigVerificationGasConsumer
+	}
+
+	var extensionOptions tx.Middleware = RejectExtensionOptionsMiddleware
+	if options.ExtensionOptions != nil {
+		extensionOptions = ExtensionOptionsMiddleware(options.ExtensionOptions)
+	}
+
+	var mempoolFee tx.Middleware = MempoolFeeMiddleware
+	if options.FeePriceSource != nil {
+		mempoolFee = MempoolFeeWithPriceSourceMiddleware(options.AccountKeeper, options.FeePriceSource)
+	}
+
+	return MiddlewareChain{
+		// Set a new GasMeter on sdk.Context.
+		//
+		// Make sure the Gas middleware is outside of all other middlewares
+		// that reads the GasMeter. In our case, the Recovery middleware reads
+		// the GasMeter to populate GasInfo.
+		{GasMiddlewareName, GasTxMiddleware},
+		// Recover from panics. Panics outside of this middleware won't be
+		// caught, be careful!
+		{RecoveryMiddlewareName, RecoveryTxMiddleware},
+		// Choose which events to index in Tendermint. Make sure no events are
+		// emitted outside of this middleware.
+		{IndexEventsMiddlewareName, NewEventIndexFilterTxMiddleware(sdk.EventIndexFilter{Allow: options.IndexEvents, Modules: options.ModuleIndexEvents})},
+		// Reject all extension options which can optionally be included in the
+		// tx, except the ones with a registered handler.
+		{RejectExtensionOptionsMiddlewareName, extensionOptions},
+		{MempoolFeeMiddlewareName, mempoolFee},
+		{ValidateBasicMiddlewareName, ValidateBasicMiddleware},
+		{TxTimeoutHeightMiddlewareName, TxTimeoutHeightMiddleware},
+		{ValidateMemoMiddlewareName, ValidateMemoMiddleware(options.AccountKeeper)},
+		{ConsumeTxSizeGasMiddlewareName, ConsumeTxSizeGasMiddleware(options.AccountKeeper)},
+		{DeductFeeMiddlewareName, DeductFeeMiddleware(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper)},
+		{SetPubKeyMiddlewareName, SetPubKeyMiddleware(options.AccountKeeper)},
+		{ValidateSigCountMiddlewareName, ValidateSigCountMiddleware(options.AccountKeeper)},
+		{SigGasConsumeMiddlewareName, SigGasConsumeMiddleware(options.AccountKeeper, sigGasConsumer)},
+		{SigVerificationMiddlewareName, SigVerificationMiddleware(options.AccountKeeper, options.SignModeHandler)},
+		{IncrementSequenceMiddlewareName, IncrementSequenceMiddleware(options.AccountKeeper)},
+	}
+}
+
+// Names returns the names of the middlewares of the chain, from outer to inner.
+func (c MiddlewareChain) Names() []string {
+	names := make([]string, len(c))
+	for i, m := range c {
+		names[i] = m.Name
+	}
+
+	return names
+}
+
+// Compose composes the middlewares of the chain on top of txHandler.
+func (c MiddlewareChain) Compose(txHandler tx.Handler) tx.Handler {
+	middlewares := make([]tx.Middleware, len(c))
+	for i, m := range c {
+		middlewares[i] = m.Middleware
+	}
+
+	return ComposeMiddlewares(txHandler, middlewares...)
+}
+
+// Append returns the chain with the given middlewares added as the innermost
+// middlewares.
+func (c MiddlewareChain) Append(middlewares ...NamedMiddleware) (MiddlewareChain, error) {
+	return c.insert(len(c), middlewares)
+}
+
+// InsertBefore returns the chain with the given middlewares inserted just
+// outside of the middleware of the given name.
+func (c MiddlewareChain) InsertBefore(name string, middlewares ...NamedMiddleware) (MiddlewareChain, error) {
+	i, err := c.index(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.insert(i, middlewares)
+}
+
+// InsertAfter returns the chain with the given middlewares inserted just
+// inside of the middleware of the given name.
+func (c MiddlewareChain) InsertAfter(name string, middlewares ...NamedMiddleware) (MiddlewareChain, error) {
+	i, err := c.index(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.insert(i+1, middlewares)
+}
+
+// Replace returns the chain with the middleware of the given name replaced.
+func (c MiddlewareChain) Replace(name string, middleware tx.Middleware) (MiddlewareChain, error) {
+	i, err := c.index(name)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := append(MiddlewareChain{}, c...)
+	chain[i].Middleware = middleware
+
+	return chain, nil
+}
+
+// Remove returns the chain without the middleware of the given name.
+func (c MiddlewareChain) Remove(name string) (MiddlewareChain, error) {
+	i, err := c.index(name)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := append(MiddlewareChain{}, c[:i]...)
+	return append(chain, c[i+1:]...), nil
+}
+
+func (c MiddlewareChain) index(name string) (int, error) {
+	for i, m := range c {
+		if m.Name == name {
+			return i, nil
+		}
+	}
+
+	return -1, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "middleware %s", name)
+}
+
+func (c MiddlewareChain) insert(i int, middlewares []NamedMiddleware) (MiddlewareChain, error) {
+	for _, m := range middlewares {
+		if m.Name == "" || m.Middleware == nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "middleware must have a name and a function")
+		}
+		if _, err := c.index(m.Name); err == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "middleware %s already in chain", m.Name)
+		}
+	}
+
+	chain := make(MiddlewareChain, 0, len(c)+len(middlewares))
+	chain = append(chain, c[:i]...)
+	chain = append(chain, middlewares...)
+	return append(chain, c[i:]...), nil
+}
@@ -0,0 +1,85 @@
+package middleware_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+)
+
+// recordingMiddleware returns a middleware appending its name to calls on
+// DeliverTx.
+func recordingMiddleware(name string, calls *[]string) middleware.NamedMiddleware {
+	return middleware.NamedMiddleware{
+		Name: name,
+		Middleware: func(next tx.Handler) tx.Handler {
+			return recordingHandler{next: next, name: name, calls: calls}
+		},
+	}
+}
+
+type recordingHandler struct {
+	tx.Handler
+	next  tx.Handler
+	name  string
+	calls *[]string
+}
+
+func (h recordingHandler) DeliverTx(ctx context.Context, sdkTx sdk.Tx, req abci.RequestDeliverTx) (abci.ResponseDeliverTx, error) {
+	*h.calls = append(*h.calls, h.name)
+	if h.next == nil {
+		return abci.ResponseDeliverTx{}, nil
+	}
+	return h.next.DeliverTx(ctx, sdkTx, req)
+}
+
+func TestDefaultMiddlewareChainNames(t *testing.T) {
+	chain := middleware.NewDefaultMiddlewareChain(middleware.TxHandlerOptions{})
+	names := chain.Names()
+	require.Len(t, names, 15)
+	require.Equal(t, middleware.GasMiddlewareName, names[0])
+	require.Equal(t, middleware.IncrementSequenceMiddlewareName, names[len(names)-1])
+}
+
+func TestMiddlewareChain(t *testing.T) {
+	var calls []string
+	chain := middleware.MiddlewareChain{
+		recordingMiddleware("a", &calls),
+		recordingMiddleware("b", &calls),
+	}
+
+	chain, err := chain.InsertBefore("b", recordingMiddleware("c", &calls))
+	require.NoError(t, err)
+	chain, err = chain.InsertAfter("b", recordingMiddleware("d", &calls))
+	require.NoError(t, err)
+	chain, err = chain.Append(recordingMiddleware("e", &calls))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "c", "b", "d", "e"}, chain.Names())
+
+	removed, err := chain.Remove("c")
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "d", "e"}, removed.Names())
+	require.Equal(t, []string{"a", "c", "b", "d", "e"}, chain.Names())
+
+	replaced, err := removed.Replace("b", recordingMiddleware("f", &calls).Middleware)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "d", "e"}, replaced.Names())
+
+	_, err = replaced.Compose(recordingHandler{name: "handler", calls: &calls}).DeliverTx(context.Background(), nil, abci.RequestDeliverTx{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "f", "d", "e", "handler"}, calls)
+
+	_, err = chain.Remove("unknown")
+	require.Error(t, err)
+	_, err = chain.InsertAfter("unknown", recordingMiddleware("g", &calls))
+	require.Error(t, err)
+	_, err = chain.Append(recordingMiddleware("a", &calls))
+	require.Error(t, err)
+	_, err = chain.Append(middleware.NamedMiddleware{Name: "g"})
+	require.Error(t, err)
+}
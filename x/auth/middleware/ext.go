@@ -2,7 +2,10 @@ package middleware
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 
+	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -69,3 +72,126 @@ func (txh rejectExtensionOptionsTxHandler) SimulateTx(ctx context.Context, sdkTx
 
 	return txh.next.SimulateTx(ctx, sdkTx, req)
 }
+
+// ExtensionOptionHandler handles an extension option of a tx, decoded from the
+// tx body. It returns an error to reject the tx.
+type ExtensionOptionHandler func(ctx sdk.Context, tx sdk.Tx, option proto.Message) error
+
+type extensionOption struct {
+	msgType reflect.Type
+	handler ExtensionOptionHandler
+}
+
+// ExtensionOptionRegistry holds the handlers of the tx extension options
+// supported by an app, e.g. chain-specific signatures or oracle attestations,
+// by type URL. The types of the options must also be registered in the
+// InterfaceRegistry of the app as TxExtensionOptionI implementations, to be
+// decoded in the txs.
+type ExtensionOptionRegistry struct {
+	options map[string]extensionOption
+}
+
+// NewExtensionOptionRegistry returns an empty ExtensionOptionRegistry.
+func NewExtensionOptionRegistry() *ExtensionOptionRegistry {
+	return &ExtensionOptionRegistry{options: make(map[string]extensionOption)}
+}
+
+// Register registers the handler of the extension options of the type of the
+// given message. It panics if a handler is already registered for the type.
+func (r *ExtensionOptionRegistry) Register(option proto.Message, handler ExtensionOptionHandler) {
+	typeURL := "/" + proto.MessageName(option)
+	if _, ok := r.options[typeURL]; ok {
+		panic(fmt.Errorf("extension option %s already registered", typeURL))
+	}
+
+	r.options[typeURL] = extensionOption{msgType: reflect.TypeOf(option).Elem(), handler: handler}
+}
+
+// handle runs the handlers of the extension options of the given tx. The
+// extension options without handler are rejected, while the non-critical ones
+// without handler are ignored.
+func (r *ExtensionOptionRegistry) handle(ctx sdk.Context, sdkTx sdk.Tx) error {
+	hasExtOptsTx, ok := sdkTx.(HasExtensionOptionsTx)
+	if !ok {
+		return nil
+	}
+
+	for _, any := range hasExtOptsTx.GetExtensionOptions() {
+		if _, ok := r.options[any.TypeUrl]; !ok {
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownExtensionOptions, any.TypeUrl)
+		}
+		if err := r.handleOption(ctx, sdkTx, any); err != nil {
+			return err
+		}
+	}
+
+	for _, any := range hasExtOptsTx.GetNonCriticalExtensionOptions() {
+		if _, ok := r.options[any.TypeUrl]; !ok {
+			continue
+		}
+		if err := r.handleOption(ctx, sdkTx, any); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *ExtensionOptionRegistry) handleOption(ctx sdk.Context, sdkTx sdk.Tx, any *codectypes.Any) error {
+	opt := r.options[any.TypeUrl]
+
+	option := reflect.New(opt.msgType).Interface().(proto.Message)
+	if err := proto.Unmarshal(any.Value, option); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "extension option %s: %s", any.TypeUrl, err)
+	}
+
+	return opt.handler(ctx, sdkTx, option)
+}
+
+type extensionOptionsTxHandler struct {
+	registry *ExtensionOptionRegistry
+	next     tx.Handler
+}
+
+// ExtensionOptionsMiddleware returns a middleware running the handlers of the
+// given registry on the extension options of the txs, which replaces
+// RejectExtensionOptionsMiddleware in the default middleware chain if the
+// ExtensionOptions of the TxHandlerOptions are set. As the other extension
+// options, the critical ones without handler are rejected.
+//
+// In the default middleware chain, the handlers run before the signatures of
+// the tx are verified and its fees are deducted.
+func ExtensionOptionsMiddleware(registry *ExtensionOptionRegistry) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		return extensionOptionsTxHandler{registry: registry, next: txh}
+	}
+}
+
+var _ tx.Handler = extensionOptionsTxHandler{}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (txh extensionOptionsTxHandler) CheckTx(ctx context.Context, tx sdk.Tx, req abci.RequestCheckTx) (abci.ResponseCheckTx, error) {
+	if err := txh.registry.handle(sdk.UnwrapSDKContext(ctx), tx); err != nil {
+		return abci.ResponseCheckTx{}, err
+	}
+
+	return txh.next.CheckTx(ctx, tx, req)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (txh extensionOptionsTxHandler) DeliverTx(ctx context.Context, tx sdk.Tx, req abci.RequestDeliverTx) (abci.ResponseDeliverTx, error) {
+	if err := txh.registry.handle(sdk.UnwrapSDKContext(ctx), tx); err != nil {
+		return abci.ResponseDeliverTx{}, err
+	}
+
+	return txh.next.DeliverTx(ctx, tx, req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx method.
+func (txh extensionOptionsTxHandler) SimulateTx(ctx context.Context, sdkTx sdk.Tx, req tx.RequestSimulateTx) (tx.ResponseSimulateTx, error) {
+	if err := txh.registry.handle(sdk.UnwrapSDKContext(ctx), sdkTx); err != nil {
+		return tx.ResponseSimulateTx{}, err
+	}
+
+	return txh.next.SimulateTx(ctx, sdkTx, req)
+}
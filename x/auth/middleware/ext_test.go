@@ -1,12 +1,15 @@
 package middleware_test
 
 import (
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
-	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func (s *MWTestSuite) TestRejectExtensionOptionsMiddleware() {
@@ -34,3 +37,51 @@ func (s *MWTestSuite) TestRejectExtensionOptionsMiddleware() {
 	_, err = txHandler.CheckTx(sdk.WrapSDKContext(ctx), theTx, abci.RequestCheckTx{})
 	s.Require().EqualError(err, "unknown extension options")
 }
+
+func (s *MWTestSuite) TestExtensionOptionsMiddleware() {
+	ctx := s.SetupTest(true) // setup
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	extOptsTxBldr, ok := txBuilder.(tx.ExtensionOptionsTxBuilder)
+	s.Require().True(ok)
+
+	var handled []string
+	registry := middleware.NewExtensionOptionRegistry()
+	registry.Register(&testdata.Dog{}, func(_ sdk.Context, _ sdk.Tx, option proto.Message) error {
+		dog := option.(*testdata.Dog)
+		if dog.Name == "" {
+			return sdkerrors.ErrInvalidRequest
+		}
+		handled = append(handled, dog.Name)
+		return nil
+	})
+	s.Require().Panics(func() { registry.Register(&testdata.Dog{}, nil) })
+
+	txHandler := middleware.ComposeMiddlewares(noopTxHandler{}, middleware.ExtensionOptionsMiddleware(registry))
+
+	dog, err := types.NewAnyWithValue(&testdata.Dog{Name: "spot"})
+	s.Require().NoError(err)
+	nonCriticalDog, err := types.NewAnyWithValue(&testdata.Dog{Name: "rex"})
+	s.Require().NoError(err)
+	cat, err := types.NewAnyWithValue(&testdata.Cat{Moniker: "kitty"})
+	s.Require().NoError(err)
+	invalidDog, err := types.NewAnyWithValue(&testdata.Dog{})
+	s.Require().NoError(err)
+
+	// the registered options are handled, and the unregistered non-critical
+	// ones are ignored
+	extOptsTxBldr.SetExtensionOptions(dog)
+	extOptsTxBldr.SetNonCriticalExtensionOptions(nonCriticalDog, cat)
+	_, err = txHandler.CheckTx(sdk.WrapSDKContext(ctx), txBuilder.GetTx(), abci.RequestCheckTx{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"spot", "rex"}, handled)
+
+	// the tx is rejected if a handler fails
+	extOptsTxBldr.SetExtensionOptions(invalidDog)
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), txBuilder.GetTx(), abci.RequestDeliverTx{})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the unregistered options are rejected
+	extOptsTxBldr.SetExtensionOptions(dog, cat)
+	_, err = txHandler.CheckTx(sdk.WrapSDKContext(ctx), txBuilder.GetTx(), abci.RequestCheckTx{})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnknownExtensionOptions)
+}
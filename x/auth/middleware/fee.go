@@ -15,7 +15,17 @@ import (
 var _ tx.Handler = mempoolFeeTxHandler{}
 
 type mempoolFeeTxHandler struct {
-	next tx.Handler
+	accountKeeper AccountKeeper
+	priceSource   FeePriceSource
+	next          tx.Handler
+}
+
+// FeePriceSource values fees paid in non-native denoms, e.g. IBC vouchers, in
+// the native denoms of the minimum gas prices, for instance from the prices of
+// an oracle. It is provided by the app.
+type FeePriceSource interface {
+	// ConvertFee returns the value of the given fee in a native denom.
+	ConvertFee(ctx sdk.Context, fee sdk.Coin) (sdk.DecCoin, error)
 }
 
 // MempoolFeeMiddleware will check if the transaction's fee is at least as large
@@ -30,6 +40,20 @@ func MempoolFeeMiddleware(txh tx.Handler) tx.Handler {
 	}
 }
 
+// MempoolFeeWithPriceSourceMiddleware is MempoolFeeMiddleware also accepting
+// fees in the non-native denoms enabled by the PricedFeeDenoms param of x/auth,
+// valued in native denoms by the given price source when the fee is checked
+// against the local validator's minimum gasFee.
+func MempoolFeeWithPriceSourceMiddleware(ak AccountKeeper, priceSource FeePriceSource) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		return mempoolFeeTxHandler{
+			accountKeeper: ak,
+			priceSource:   priceSource,
+			next:          txh,
+		}
+	}
+}
+
 // CheckTx implements tx.Handler.CheckTx.
 func (txh mempoolFeeTxHandler) CheckTx(ctx context.Context, tx sdk.Tx, req abci.RequestCheckTx) (abci.ResponseCheckTx, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -58,13 +82,54 @@ func (txh mempoolFeeTxHandler) CheckTx(ctx context.Context, tx sdk.Tx, req abci.
 		}
 
 		if !feeCoins.IsAnyGTE(requiredFees) {
-			return abci.ResponseCheckTx{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			if txh.priceSource == nil {
+				return abci.ResponseCheckTx{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			}
+
+			// Only value the fees in the priced denoms when the fees in native
+			// denoms are not enough, so that the txs paying native fees never
+			// depend on the price source.
+			convertedFees, err := txh.convertFees(sdkCtx, feeCoins)
+			if err != nil {
+				return abci.ResponseCheckTx{}, err
+			}
+
+			if !convertedFees.IsAnyGTE(requiredFees) {
+				return abci.ResponseCheckTx{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s worth %s required: %s", feeCoins, convertedFees, requiredFees)
+			}
 		}
 	}
 
 	return txh.next.CheckTx(ctx, tx, req)
 }
 
+// convertFees returns the fees with the coins of the priced fee denoms
+// converted into native denoms by the price source.
+func (txh mempoolFeeTxHandler) convertFees(ctx sdk.Context, fees sdk.Coins) (sdk.Coins, error) {
+	params := txh.accountKeeper.GetParams(ctx)
+
+	converted := sdk.NewDecCoins()
+	for _, fee := range fees {
+		if !params.IsPricedFeeDenom(fee.Denom) {
+			converted = converted.Add(sdk.NewDecCoinFromCoin(fee))
+			continue
+		}
+
+		value, err := txh.priceSource.ConvertFee(ctx, fee)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "cannot convert fee %s: %s", fee, err)
+		}
+		if err := value.Validate(); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid value %s of fee %s: %s", value, fee, err)
+		}
+
+		converted = converted.Add(value)
+	}
+
+	truncated, _ := converted.TruncateDecimal()
+	return truncated, nil
+}
+
 // DeliverTx implements tx.Handler.DeliverTx.
 func (txh mempoolFeeTxHandler) DeliverTx(ctx context.Context, tx sdk.Tx, req abci.RequestDeliverTx) (abci.ResponseDeliverTx, error) {
 	return txh.next.DeliverTx(ctx, tx, req)
@@ -1,9 +1,12 @@
 package middleware_test
 
 import (
+	"errors"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -51,6 +54,57 @@ func (s *MWTestSuite) TestEnsureMempoolFees() {
 	s.Require().Nil(err, "Middleware should not have errored on fee higher than local gasPrice")
 }
 
+type feePriceSourceFn func(ctx sdk.Context, fee sdk.Coin) (sdk.DecCoin, error)
+
+func (f feePriceSourceFn) ConvertFee(ctx sdk.Context, fee sdk.Coin) (sdk.DecCoin, error) {
+	return f(ctx, fee)
+}
+
+func (s *MWTestSuite) TestEnsureMempoolFeesWithPriceSource() {
+	ctx := s.SetupTest(true) // setup
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+
+	// a voucher is worth 5atom
+	voucherDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	priceSource := feePriceSourceFn(func(_ sdk.Context, fee sdk.Coin) (sdk.DecCoin, error) {
+		if fee.Denom != voucherDenom {
+			return sdk.DecCoin{}, errors.New("no price")
+		}
+		return sdk.NewDecCoin("atom", fee.Amount.MulRaw(5)), nil
+	})
+	txHandler := middleware.ComposeMiddlewares(noopTxHandler{}, middleware.MempoolFeeWithPriceSourceMiddleware(s.app.AccountKeeper, priceSource))
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 100)))
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+	s.Require().NoError(err)
+
+	// 200000 gas at 0.002atom requires 400atom
+	ctx = ctx.WithMinGasPrices(sdk.DecCoins{sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(2, 3))})
+
+	// the voucher denom is not enabled
+	_, err = txHandler.CheckTx(sdk.WrapSDKContext(ctx), tx, abci.RequestCheckTx{})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	params := s.app.AccountKeeper.GetParams(ctx)
+	params.PricedFeeDenoms = []string{voucherDenom}
+	s.app.AccountKeeper.SetParams(ctx, params)
+
+	// 100 vouchers are worth 500atom
+	_, err = txHandler.CheckTx(sdk.WrapSDKContext(ctx), tx, abci.RequestCheckTx{})
+	s.Require().NoError(err)
+
+	// 200000 gas at 0.003atom requires 600atom
+	ctx = ctx.WithMinGasPrices(sdk.DecCoins{sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(3, 3))})
+	_, err = txHandler.CheckTx(sdk.WrapSDKContext(ctx), tx, abci.RequestCheckTx{})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	s.Require().Contains(err.Error(), "worth 500atom")
+}
+
 func (s *MWTestSuite) TestDeductFees() {
 	ctx := s.SetupTest(false) // setup
 	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
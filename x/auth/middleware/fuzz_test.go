@@ -0,0 +1,53 @@
+//go:build go1.18
+// +build go1.18
+
+package middleware_test
+
+import (
+	"testing"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/fuzz"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func FuzzTxHandler(f *testing.F) {
+	encodingConfig := simapp.MakeTestEncodingConfig()
+
+	_, _, from := testdata.KeyTestPubAddr()
+	_, _, to := testdata.KeyTestPubAddr()
+	builder := encodingConfig.TxConfig.NewTxBuilder()
+	if err := builder.SetMsgs(banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))); err != nil {
+		f.Fatal(err)
+	}
+	builder.SetFeeAmount(testdata.NewTestFeeAmount())
+	builder.SetGasLimit(testdata.NewTestGasLimit())
+	fuzz.AddTxs(f, encodingConfig.TxConfig.TxEncoder(), builder.GetTx())
+
+	fuzz.TxHandler(f, encodingConfig.TxConfig.TxDecoder(), func(t *testing.T) (sdk.Context, txtypes.Handler) {
+		app, ctx := createTestApp(t, false)
+		msr := middleware.NewMsgServiceRouter(encodingConfig.InterfaceRegistry)
+		banktypes.RegisterMsgServer(msr, bankkeeper.NewMsgServerImpl(app.BankKeeper))
+		txHandler, err := middleware.NewDefaultTxHandler(middleware.TxHandlerOptions{
+			MsgServiceRouter: msr,
+			LegacyRouter:     middleware.NewLegacyRouter(),
+			AccountKeeper:    app.AccountKeeper,
+			BankKeeper:       app.BankKeeper,
+			FeegrantKeeper:   app.FeeGrantKeeper,
+			SignModeHandler:  encodingConfig.TxConfig.SignModeHandler(),
+			SigGasConsumer:   middleware.DefaultSigVerificationGasConsumer,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return ctx.WithBlockHeader(tmproto.Header{Height: 1}), txHandler
+	})
+}
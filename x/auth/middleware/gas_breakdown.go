@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"context"
+	"strconv"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// GasBreakdownMiddlewareName is the name of the middleware returned by
+// GasBreakdownMiddleware, which isn't part of the default middleware chain.
+const GasBreakdownMiddlewareName = "gas-breakdown"
+
+const (
+	// EventTypeGasBreakdown is the type of the events of GasBreakdownMiddleware.
+	EventTypeGasBreakdown = "gas_breakdown"
+
+	AttributeKeyGasMeter = "meter"
+	AttributeKeyGasUsed  = "gas_used"
+)
+
+type gasBreakdownTxHandler struct {
+	next tx.Handler
+}
+
+// GasBreakdownMiddleware is a middleware breaking the gas consumed by the
+// delivered and simulated txs down per message and per store access category.
+// The gas meter of the tx is replaced by a ChildGasMeter, on which the RunMsgs
+// handler attaches a child meter per message, and the breakdown is appended to
+// the events of the tx result, with a gas_breakdown event per meter. It must
+// be inside of the Gas middleware, so that the gas meter of the tx is set, and
+// outside of the Recovery middleware, so that the breakdown of the txs running
+// out of gas is appended to their failed result too.
+//
+// The events aren't part of the consensus state, so the middleware can be
+// enabled on some nodes only, e.g. the ones serving clients estimating gas.
+func GasBreakdownMiddleware(txh tx.Handler) tx.Handler {
+	return gasBreakdownTxHandler{next: txh}
+}
+
+var _ tx.Handler = gasBreakdownTxHandler{}
+
+// CheckTx implements tx.Handler.CheckTx method.
+func (txh gasBreakdownTxHandler) CheckTx(ctx context.Context, tx sdk.Tx, req abci.RequestCheckTx) (abci.ResponseCheckTx, error) {
+	return txh.next.CheckTx(ctx, tx, req)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx method.
+func (txh gasBreakdownTxHandler) DeliverTx(ctx context.Context, tx sdk.Tx, req abci.RequestDeliverTx) (abci.ResponseDeliverTx, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	meter := sdk.NewChildGasMeter(sdkCtx.GasMeter(), "tx")
+
+	res, err := txh.next.DeliverTx(sdk.WrapSDKContext(sdkCtx.WithGasMeter(meter)), tx, req)
+	res.Events = append(res.Events, GasBreakdownEvents(meter.Breakdown())...)
+
+	return res, err
+}
+
+// SimulateTx implements tx.Handler.SimulateTx method.
+func (txh gasBreakdownTxHandler) SimulateTx(ctx context.Context, sdkTx sdk.Tx, req tx.RequestSimulateTx) (tx.ResponseSimulateTx, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	meter := sdk.NewChildGasMeter(sdkCtx.GasMeter(), "tx")
+
+	res, err := txh.next.SimulateTx(sdk.WrapSDKContext(sdkCtx.WithGasMeter(meter)), sdkTx, req)
+	if err != nil {
+		return res, err
+	}
+
+	if res.Result != nil {
+		res.Result.Events = append(res.Result.Events, GasBreakdownEvents(meter.Breakdown())...)
+	}
+
+	return res, nil
+}
+
+// GasBreakdownEvents returns a gas_breakdown event per meter of the given
+// breakdown, with the path of the meter, e.g. tx/msg_0, the gas consumed
+// through it and an attribute per category.
+func GasBreakdownEvents(breakdown sdk.GasBreakdown) []abci.Event {
+	var events []abci.Event
+	appendGasBreakdownEvents(&events, "", breakdown)
+
+	return events
+}
+
+func appendGasBreakdownEvents(events *[]abci.Event, parentPath string, breakdown sdk.GasBreakdown) {
+	path := breakdown.Name
+	if parentPath != "" {
+		path = parentPath + "/" + path
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(AttributeKeyGasMeter, path),
+		sdk.NewAttribute(AttributeKeyGasUsed, strconv.FormatUint(breakdown.GasUsed, 10)),
+	}
+	for _, c := range breakdown.Categories {
+		attrs = append(attrs, sdk.NewAttribute(c.Category, strconv.FormatUint(c.GasUsed, 10)))
+	}
+
+	*events = append(*events, abci.Event(sdk.NewEvent(EventTypeGasBreakdown, attrs...)))
+
+	for _, child := range breakdown.Children {
+		appendGasBreakdownEvents(events, path, child)
+	}
+}
@@ -0,0 +1,130 @@
+package middleware_test
+
+import (
+	"context"
+
+	"github.com/tendermint/tendermint/abci/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// dogStoreMsgServer saves the names of the created dogs in a store.
+type dogStoreMsgServer struct {
+	key storetypes.StoreKey
+}
+
+func (m dogStoreMsgServer) CreateDog(ctx context.Context, msg *testdata.MsgCreateDog) (*testdata.MsgCreateDogResponse, error) {
+	sdk.UnwrapSDKContext(ctx).KVStore(m.key).Set([]byte(msg.Dog.Name), []byte{1})
+	return &testdata.MsgCreateDogResponse{Name: msg.Dog.Name}, nil
+}
+
+func (s *MWTestSuite) TestGasBreakdown() {
+	ctx := s.SetupTest(false) // setup
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(100000))
+
+	storeKey := s.app.GetKey(authtypes.StoreKey)
+	msr := middleware.NewMsgServiceRouter(s.clientCtx.InterfaceRegistry)
+	testdata.RegisterMsgServer(msr, dogStoreMsgServer{key: storeKey})
+	postHandler := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		ctx.KVStore(storeKey).Get([]byte("post"))
+		return ctx, nil
+	}
+
+	txHandler := middleware.ComposeMiddlewares(
+		middleware.NewRunMsgsTxHandlerWithPostHandler(msr, nil, postHandler),
+		middleware.GasBreakdownMiddleware,
+	)
+
+	priv, _, _ := testdata.KeyTestPubAddr()
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(
+		&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}},
+		&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Rex"}},
+	))
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{0}
+	tx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+	s.Require().NoError(err)
+	txBytes, err := s.clientCtx.TxConfig.TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	res, err := txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx, types.RequestDeliverTx{Tx: txBytes})
+	s.Require().NoError(err)
+
+	var breakdowns []map[string]string
+	for _, event := range res.Events {
+		if event.Type != middleware.EventTypeGasBreakdown {
+			continue
+		}
+
+		attrs := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		breakdowns = append(breakdowns, attrs)
+	}
+
+	gasConfig := storetypes.KVGasConfig()
+	spotWrite := gasConfig.WriteCostFlat + gasConfig.WriteCostPerByte*uint64(len("Spot")+1)
+	rexWrite := gasConfig.WriteCostFlat + gasConfig.WriteCostPerByte*uint64(len("Rex")+1)
+	postRead := gasConfig.ReadCostFlat + gasConfig.ReadCostPerByte*uint64(len("post"))
+
+	s.Require().Len(breakdowns, 3)
+	s.Require().Equal(map[string]string{
+		middleware.AttributeKeyGasMeter: "tx",
+		middleware.AttributeKeyGasUsed:  sdk.NewIntFromUint64(ctx.GasMeter().GasConsumed()).String(),
+		storetypes.GasCategoryRead:      sdk.NewIntFromUint64(postRead).String(),
+		storetypes.GasCategoryWrite:     sdk.NewIntFromUint64(spotWrite + rexWrite).String(),
+	}, breakdowns[0])
+	s.Require().Equal(map[string]string{
+		middleware.AttributeKeyGasMeter: "tx/msg_0",
+		middleware.AttributeKeyGasUsed:  sdk.NewIntFromUint64(spotWrite).String(),
+		storetypes.GasCategoryWrite:     sdk.NewIntFromUint64(spotWrite).String(),
+	}, breakdowns[1])
+	s.Require().Equal(map[string]string{
+		middleware.AttributeKeyGasMeter: "tx/msg_1",
+		middleware.AttributeKeyGasUsed:  sdk.NewIntFromUint64(rexWrite).String(),
+		storetypes.GasCategoryWrite:     sdk.NewIntFromUint64(rexWrite).String(),
+	}, breakdowns[2])
+}
+
+func (s *MWTestSuite) TestGasBreakdownOutOfGas() {
+	ctx := s.SetupTest(false) // setup
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(1000))
+
+	storeKey := s.app.GetKey(authtypes.StoreKey)
+	msr := middleware.NewMsgServiceRouter(s.clientCtx.InterfaceRegistry)
+	testdata.RegisterMsgServer(msr, dogStoreMsgServer{key: storeKey})
+
+	// the breakdown is outside of the Recovery middleware, which returns the
+	// out of gas panic as an error
+	txHandler := middleware.ComposeMiddlewares(
+		middleware.NewRunMsgsTxHandler(msr, nil),
+		middleware.GasBreakdownMiddleware,
+		middleware.RecoveryTxMiddleware,
+	)
+
+	priv, _, _ := testdata.KeyTestPubAddr()
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}))
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{0}
+	tx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+	s.Require().NoError(err)
+	txBytes, err := s.clientCtx.TxConfig.TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	res, err := txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx, types.RequestDeliverTx{Tx: txBytes})
+	s.Require().ErrorIs(err, sdkerrors.ErrOutOfGas)
+
+	var meters []string
+	for _, event := range res.Events {
+		s.Require().Equal(middleware.EventTypeGasBreakdown, event.Type)
+		meters = append(meters, string(event.Attributes[0].Value))
+	}
+	s.Require().Equal([]string{"tx", "tx/msg_0"}, meters)
+}
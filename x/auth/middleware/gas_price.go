@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// GasPriceTrackerMiddlewareName is the name of the middleware returned by
+// GasPriceTrackerMiddleware, which isn't part of the default middleware chain.
+const GasPriceTrackerMiddlewareName = "gas-price-tracker"
+
+// GasPriceRecorder records the gas prices paid by the delivered txs. It is
+// implemented by the Tracker of the gas price query service.
+type GasPriceRecorder interface {
+	Record(height int64, gasPrices sdk.DecCoins)
+}
+
+type gasPriceTrackerTxHandler struct {
+	tracker GasPriceRecorder
+	next    tx.Handler
+}
+
+// GasPriceTrackerMiddleware returns a middleware recording the gas prices paid
+// by the successfully delivered txs into the given tracker, to suggest gas
+// prices to clients.
+func GasPriceTrackerMiddleware(tracker GasPriceRecorder) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		return gasPriceTrackerTxHandler{tracker: tracker, next: txh}
+	}
+}
+
+var _ tx.Handler = gasPriceTrackerTxHandler{}
+
+// CheckTx implements tx.Handler.CheckTx method.
+func (txh gasPriceTrackerTxHandler) CheckTx(ctx context.Context, tx sdk.Tx, req abci.RequestCheckTx) (abci.ResponseCheckTx, error) {
+	return txh.next.CheckTx(ctx, tx, req)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx method.
+func (txh gasPriceTrackerTxHandler) DeliverTx(ctx context.Context, tx sdk.Tx, req abci.RequestDeliverTx) (abci.ResponseDeliverTx, error) {
+	res, err := txh.next.DeliverTx(ctx, tx, req)
+	if err != nil {
+		return res, err
+	}
+
+	if feeTx, ok := tx.(sdk.FeeTx); ok && feeTx.GetGas() > 0 {
+		gasPrices := sdk.NewDecCoinsFromCoins(feeTx.GetFee()...).QuoDec(sdk.NewDecFromInt(sdk.NewIntFromUint64(feeTx.GetGas())))
+		txh.tracker.Record(sdk.UnwrapSDKContext(ctx).BlockHeight(), gasPrices)
+	}
+
+	return res, nil
+}
+
+// SimulateTx implements tx.Handler.SimulateTx method.
+func (txh gasPriceTrackerTxHandler) SimulateTx(ctx context.Context, tx sdk.Tx, req tx.RequestSimulateTx) (tx.ResponseSimulateTx, error) {
+	return txh.next.SimulateTx(ctx, tx, req)
+}
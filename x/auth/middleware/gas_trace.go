@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"context"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// GasTraceMiddlewareName is the name of the middleware returned by
+// GasTraceMiddleware, which isn't part of the default middleware chain.
+const GasTraceMiddlewareName = "gas-trace"
+
+type gasTraceTxHandler struct {
+	store *sdk.GasTraceStore
+	next  tx.Handler
+}
+
+// GasTraceMiddleware returns a middleware saving the gas consumed per message
+// and per store by the delivered txs into the given store. It must be inside of
+// the Gas and Recovery middlewares, so that the gas meter of the tx is set and
+// the traces of the txs running out of gas are saved.
+func GasTraceMiddleware(store *sdk.GasTraceStore) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		return gasTraceTxHandler{store: store, next: txh}
+	}
+}
+
+var _ tx.Handler = gasTraceTxHandler{}
+
+// CheckTx implements tx.Handler.CheckTx method.
+func (txh gasTraceTxHandler) CheckTx(ctx context.Context, tx sdk.Tx, req abci.RequestCheckTx) (abci.ResponseCheckTx, error) {
+	return txh.next.CheckTx(ctx, tx, req)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx method.
+func (txh gasTraceTxHandler) DeliverTx(ctx context.Context, tx sdk.Tx, req abci.RequestDeliverTx) (abci.ResponseDeliverTx, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	tracer := sdk.NewGasTracer()
+
+	defer func() {
+		gasMeter := sdkCtx.GasMeter()
+		txh.store.Save(tmtypes.Tx(req.Tx).Hash(), sdkCtx.BlockHeight(), gasMeter.Limit(), gasMeter.GasConsumed(), tracer)
+	}()
+
+	return txh.next.DeliverTx(sdk.WrapSDKContext(sdkCtx.WithGasTracer(tracer)), tx, req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx method.
+func (txh gasTraceTxHandler) SimulateTx(ctx context.Context, tx sdk.Tx, req tx.RequestSimulateTx) (tx.ResponseSimulateTx, error) {
+	return txh.next.SimulateTx(ctx, tx, req)
+}
@@ -0,0 +1,65 @@
+package middleware_test
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (s *MWTestSuite) TestGasTrace() {
+	ctx := s.SetupTest(false) // setup
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(100000))
+
+	msr := middleware.NewMsgServiceRouter(s.clientCtx.InterfaceRegistry)
+	testdata.RegisterMsgServer(msr, testdata.MsgServerImpl{})
+	storeKey := s.app.GetKey(authtypes.StoreKey)
+	postHandler := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		ctx.KVStore(storeKey).Set([]byte("post"), []byte{1})
+		return ctx, nil
+	}
+
+	store := sdk.NewGasTraceStore(1)
+	txHandler := middleware.ComposeMiddlewares(
+		middleware.NewRunMsgsTxHandlerWithPostHandler(msr, nil, postHandler),
+		middleware.GasTraceMiddleware(store),
+	)
+
+	priv, _, _ := testdata.KeyTestPubAddr()
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(
+		&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}},
+		&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Rex"}},
+	))
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{0}
+	tx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+	s.Require().NoError(err)
+	txBytes, err := s.clientCtx.TxConfig.TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx, types.RequestDeliverTx{Tx: txBytes})
+	s.Require().NoError(err)
+
+	hash := fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash())
+	trace := store.Get(hash)
+	s.Require().NotNil(trace)
+	s.Require().Equal(ctx.BlockHeight(), trace.Height)
+	s.Require().Equal(uint64(100000), trace.GasWanted)
+	s.Require().Equal(ctx.GasMeter().GasConsumed(), trace.GasUsed)
+	s.Require().Len(trace.Msgs, 2)
+	s.Require().Equal(sdk.MsgTypeURL(&testdata.MsgCreateDog{}), trace.Msgs[0].TypeURL)
+	s.Require().Len(trace.Stores, 1)
+	s.Require().Equal(storeKey.Name(), trace.Stores[0].Store)
+	s.Require().Greater(trace.Stores[0].GasUsed, uint64(0))
+
+	// only the trace of the last tx is retained
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx, types.RequestDeliverTx{Tx: append(txBytes, 0)})
+	s.Require().NoError(err)
+	s.Require().Nil(store.Get(hash))
+}
@@ -10,18 +10,23 @@ import (
 )
 
 type indexEventsTxHandler struct {
-	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
-	// which informs Tendermint what to index. If empty, all events will be indexed.
-	indexEvents map[string]struct{}
+	// indexEvents selects the events which Tendermint indexes.
+	indexEvents sdk.EventIndexFilter
 	inner       tx.Handler
 }
 
 // NewIndexEventsTxMiddleware defines a middleware to optionally only index a
 // subset of the emitted events inside the Tendermint events indexer.
 func NewIndexEventsTxMiddleware(indexEvents map[string]struct{}) tx.Middleware {
+	return NewEventIndexFilterTxMiddleware(sdk.EventIndexFilter{Allow: indexEvents})
+}
+
+// NewEventIndexFilterTxMiddleware defines a middleware marking the emitted
+// events to index inside the Tendermint events indexer with the given filter.
+func NewEventIndexFilterTxMiddleware(filter sdk.EventIndexFilter) tx.Middleware {
 	return func(txHandler tx.Handler) tx.Handler {
 		return indexEventsTxHandler{
-			indexEvents: indexEvents,
+			indexEvents: filter,
 			inner:       txHandler,
 		}
 	}
@@ -36,7 +41,7 @@ func (txh indexEventsTxHandler) CheckTx(ctx context.Context, tx sdk.Tx, req abci
 		return res, err
 	}
 
-	res.Events = sdk.MarkEventsToIndex(res.Events, txh.indexEvents)
+	res.Events = txh.indexEvents.MarkEventsToIndex(res.Events)
 	return res, nil
 }
 
@@ -47,7 +52,7 @@ func (txh indexEventsTxHandler) DeliverTx(ctx context.Context, tx sdk.Tx, req ab
 		return res, err
 	}
 
-	res.Events = sdk.MarkEventsToIndex(res.Events, txh.indexEvents)
+	res.Events = txh.indexEvents.MarkEventsToIndex(res.Events)
 	return res, nil
 }
 
@@ -58,6 +63,6 @@ func (txh indexEventsTxHandler) SimulateTx(ctx context.Context, tx sdk.Tx, req t
 		return res, err
 	}
 
-	res.Result.Events = sdk.MarkEventsToIndex(res.Result.Events, txh.indexEvents)
+	res.Result.Events = txh.indexEvents.MarkEventsToIndex(res.Result.Events)
 	return res, nil
 }
@@ -35,6 +35,10 @@ type TxHandlerOptions struct {
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	IndexEvents map[string]struct{}
+	// ModuleIndexEvents defines the filters of the events of each module, i.e.
+	// of the events whose module attribute is the module name, which apply in
+	// addition to IndexEvents.
+	ModuleIndexEvents map[string]sdk.EventIndexFilter
 
 	LegacyRouter     sdk.Router
 	MsgServiceRouter *MsgServiceRouter
@@ -44,55 +48,60 @@ type TxHandlerOptions struct {
 	FeegrantKeeper  FeegrantKeeper
 	SignModeHandler authsigning.SignModeHandler
 	SigGasConsumer  func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
+
+	// FeePriceSource values the fees paid in the non-native denoms enabled by
+	// the PricedFeeDenoms param of x/auth when checking them against the
+	// minimum gas prices. If nil, fees are only accepted in the denoms of the
+	// minimum gas prices.
+	FeePriceSource FeePriceSource
+
+	// ExtensionOptions holds the handlers of the extension options of the
+	// txs. If nil, all the extension options are rejected.
+	ExtensionOptions *ExtensionOptionRegistry
+
+	// PostHandler runs after the messages of a tx succeeded, before their
+	// state changes are committed. NewDefaultTxHandler uses
+	// NewDefaultPostHandler if nil.
+	PostHandler sdk.PostHandler
 }
 
-// NewDefaultTxHandler defines a TxHandler middleware stacks that should work
-// for most applications.
-func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
+// Validate checks that the options contain the dependencies of the default
+// middlewares.
+func (options TxHandlerOptions) Validate() error {
 	if options.AccountKeeper == nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for compose middlewares")
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for compose middlewares")
 	}
 
 	if options.BankKeeper == nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for compose middlewares")
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for compose middlewares")
 	}
 
 	if options.SignModeHandler == nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for compose middlewares")
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for compose middlewares")
 	}
 
-	var sigGasConsumer = options.SigGasConsumer
-	if sigGasConsumer == nil {
-		sigGasConsumer = DefaultSigVerificationGasConsumer
+	return nil
+}
+
+// NewDefaultTxHandler defines a TxHandler middleware stacks that should work
+// for most applications. Use NewDefaultMiddlewareChain to customize it.
+func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	postHandler := options.PostHandler
+	if postHandler == nil {
+		postHandler = NewDefaultPostHandler(options)
 	}
 
-	return ComposeMiddlewares(
-		NewRunMsgsTxHandler(options.MsgServiceRouter, options.LegacyRouter),
-		// Set a new GasMeter on sdk.Context.
-		//
-		// Make sure the Gas middleware is outside of all other middlewares
-		// that reads the GasMeter. In our case, the Recovery middleware reads
-		// the GasMeter to populate GasInfo.
-		GasTxMiddleware,
-		// Recover from panics. Panics outside of this middleware won't be
-		// caught, be careful!
-		RecoveryTxMiddleware,
-		// Choose which events to index in Tendermint. Make sure no events are
-		// emitted outside of this middleware.
-		NewIndexEventsTxMiddleware(options.IndexEvents),
-		// Reject all extension options which can optionally be included in the
-		// tx.
-		RejectExtensionOptionsMiddleware,
-		MempoolFeeMiddleware,
-		ValidateBasicMiddleware,
-		TxTimeoutHeightMiddleware,
-		ValidateMemoMiddleware(options.AccountKeeper),
-		ConsumeTxSizeGasMiddleware(options.AccountKeeper),
-		DeductFeeMiddleware(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
-		SetPubKeyMiddleware(options.AccountKeeper),
-		ValidateSigCountMiddleware(options.AccountKeeper),
-		SigGasConsumeMiddleware(options.AccountKeeper, sigGasConsumer),
-		SigVerificationMiddleware(options.AccountKeeper, options.SignModeHandler),
-		IncrementSequenceMiddleware(options.AccountKeeper),
+	return NewDefaultMiddlewareChain(options).Compose(
+		NewRunMsgsTxHandlerWithPostHandler(options.MsgServiceRouter, options.LegacyRouter, postHandler),
 	), nil
 }
+
+// NewDefaultPostHandler returns the PostHandler of NewDefaultTxHandler, which
+// pays the tips of txs.
+func NewDefaultPostHandler(options TxHandlerOptions) sdk.PostHandler {
+	return sdk.ChainPostDecorators(NewTipDecorator(options.BankKeeper))
+}
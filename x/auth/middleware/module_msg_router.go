@@ -0,0 +1,120 @@
+package middleware
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AttributeKeyInvokerModule is the attribute of the message event of the Msgs
+// executed through a ModuleMsgRouter holding the name of the invoking module.
+const AttributeKeyInvokerModule = "invoker_module"
+
+// SignerAuthorizer returns whether a module can execute Msgs on behalf of the
+// given signer.
+type SignerAuthorizer func(ctx sdk.Context, signer sdk.AccAddress) bool
+
+// ModuleMsgRouter executes the Msgs of other modules on behalf of the module
+// account of a module, e.g. for modules executing the Msgs of proposals, of
+// authorizations or of interchain accounts. Only the Msgs of the type URLs the
+// router is created with can be executed, and all their signers must be the
+// module account, or be authorized by the SignerAuthorizer of the router.
+//
+// The Msgs are executed on a branch of the state written only if they succeed,
+// consuming gas on the gas meter of the given context, and their events are
+// emitted on its event manager, along with a message event as for the Msgs of
+// a tx.
+type ModuleMsgRouter struct {
+	router      *MsgServiceRouter
+	module      string
+	address     sdk.AccAddress
+	msgTypeURLs map[string]struct{}
+	authorizer  SignerAuthorizer
+}
+
+// NewModuleMsgRouter returns a ModuleMsgRouter executing the Msgs of the given
+// type URLs with the handlers of the given router, on behalf of the module
+// account of the given module.
+func NewModuleMsgRouter(router *MsgServiceRouter, module string, msgTypeURLs []string) ModuleMsgRouter {
+	if router == nil {
+		panic("the msg service router of a module msg router must not be nil")
+	}
+
+	allowed := make(map[string]struct{}, len(msgTypeURLs))
+	for _, typeURL := range msgTypeURLs {
+		allowed[typeURL] = struct{}{}
+	}
+
+	return ModuleMsgRouter{
+		router:      router,
+		module:      module,
+		address:     authtypes.NewModuleAddress(module),
+		msgTypeURLs: allowed,
+	}
+}
+
+// WithSignerAuthorizer returns a copy of the router which also executes the
+// Msgs of the signers authorized by the given function, e.g. the accounts
+// derived from the module account.
+func (r ModuleMsgRouter) WithSignerAuthorizer(authorizer SignerAuthorizer) ModuleMsgRouter {
+	r.authorizer = authorizer
+	return r
+}
+
+// Module returns the name of the module the router executes Msgs for.
+func (r ModuleMsgRouter) Module() string {
+	return r.module
+}
+
+// CanInvoke returns whether the router executes the Msgs of the given type URL.
+func (r ModuleMsgRouter) CanInvoke(msgTypeURL string) bool {
+	_, ok := r.msgTypeURLs[msgTypeURL]
+	return ok
+}
+
+// Invoke executes the given Msg on behalf of the module. It fails if the module
+// isn't authorized to execute the Msg, if the Msg is invalid or if its handler
+// fails, in which case none of its state changes are written.
+func (r ModuleMsgRouter) Invoke(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	typeURL := sdk.MsgTypeURL(msg)
+	if !r.CanInvoke(typeURL) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module %s is not authorized to execute %s", r.module, typeURL)
+	}
+
+	signers := msg.GetSigners()
+	if len(signers) == 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNoSignatures, "message %s has no signers", typeURL)
+	}
+	for _, signer := range signers {
+		if !signer.Equals(r.address) && (r.authorizer == nil || !r.authorizer(ctx, signer)) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module %s can't execute messages on behalf of %s", r.module, signer)
+		}
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	handler := r.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", typeURL)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	res, err := handler(cacheCtx, msg)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to execute message %s on behalf of module %s", typeURL, r.module)
+	}
+	write()
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyAction, typeURL),
+			sdk.NewAttribute(AttributeKeyInvokerModule, r.module),
+		),
+	}
+	ctx.EventManager().EmitEvents(events.AppendEvents(res.GetEvents()))
+
+	return res, nil
+}
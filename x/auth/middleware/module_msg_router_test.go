@@ -0,0 +1,78 @@
+package middleware_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestModuleMsgRouterInvoke(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	msr := middleware.NewMsgServiceRouter(app.InterfaceRegistry())
+	banktypes.RegisterMsgServer(msr, bankkeeper.NewMsgServerImpl(app.BankKeeper))
+
+	moduleAddr := authtypes.NewModuleAddress("invoker")
+	_, _, derivedAddr := testdata.KeyTestPubAddr()
+	_, _, otherAddr := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, moduleAddr, coins))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, derivedAddr, coins))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, otherAddr, coins))
+
+	router := middleware.NewModuleMsgRouter(msr, "invoker", []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	send := func(from sdk.AccAddress, amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(from, otherAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", amount)))
+	}
+
+	// the Msgs of the module account are executed, with their events
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	gasBefore := ctx.GasMeter().GasConsumed()
+	_, err := router.Invoke(ctx, send(moduleAddr, 10))
+	require.NoError(t, err)
+	require.Equal(t, int64(90), app.BankKeeper.GetBalance(ctx, moduleAddr, "atom").Amount.Int64())
+	require.Greater(t, ctx.GasMeter().GasConsumed(), gasBefore)
+
+	events := ctx.EventManager().Events()
+	require.NotEmpty(t, events)
+	require.Equal(t, sdk.EventTypeMessage, events[0].Type)
+	require.Contains(t, events[0].Attributes, sdk.NewAttribute(middleware.AttributeKeyInvokerModule, "invoker").ToKVPair())
+
+	// the Msgs of other signers are rejected
+	_, err = router.Invoke(ctx, send(derivedAddr, 10))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// unless they are authorized
+	authorized := router.WithSignerAuthorizer(func(_ sdk.Context, signer sdk.AccAddress) bool {
+		return signer.Equals(derivedAddr)
+	})
+	_, err = authorized.Invoke(ctx, send(derivedAddr, 10))
+	require.NoError(t, err)
+	_, err = authorized.Invoke(ctx, send(otherAddr, 10))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the Msgs of the types not allowed are rejected
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(moduleAddr, coins)},
+		[]banktypes.Output{banktypes.NewOutput(otherAddr, coins)},
+	)
+	_, err = router.Invoke(ctx, multiSend)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the state changes of the failed Msgs are discarded
+	_, err = router.Invoke(ctx, send(moduleAddr, 1000))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	require.Equal(t, int64(90), app.BankKeeper.GetBalance(ctx, moduleAddr, "atom").Amount.Int64())
+}
@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// EventTypeGasRefund is the type of the event emitted when unused gas is
+	// refunded.
+	EventTypeGasRefund = "gas_refund"
+
+	AttributeKeyRefundReceiver = "receiver"
+	AttributeKeyRefundAmount   = "amount"
+)
+
+var _ sdk.PostDecorator = GasRefundDecorator{}
+
+// GasRefundDecorator is a PostDecorator refunding the fees paid for the unused
+// gas of a tx above a threshold, so that over-estimating the gas of a tx is less
+// punishing for users. The refund is sent from the fee collector to the
+// account which paid the fees, i.e. the fee granter if any; the allowance of a
+// fee grant is not restored.
+//
+// Given a threshold t, a gas limit L and a gas used U, the refunded share of the
+// fees is (L - U - t*L) / L, truncated, if positive.
+//
+// CONTRACT: must run after DeductFeeMiddleware, with the gas meter set by
+// GasTxMiddleware.
+type GasRefundDecorator struct {
+	bankKeeper RefundBankKeeper
+	threshold  sdk.Dec
+}
+
+// NewGasRefundDecorator returns a GasRefundDecorator refunding the unused gas
+// above the threshold share of the gas limit, which must be in [0, 1].
+func NewGasRefundDecorator(bk RefundBankKeeper, threshold sdk.Dec) GasRefundDecorator {
+	if threshold.IsNil() || threshold.IsNegative() || threshold.GT(sdk.OneDec()) {
+		panic(fmt.Sprintf("invalid gas refund threshold %s, must be in [0, 1]", threshold))
+	}
+
+	return GasRefundDecorator{
+		bankKeeper: bk,
+		threshold:  threshold,
+	}
+}
+
+// PostHandle implements sdk.PostDecorator.
+func (grd GasRefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.PostHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	refund := GasRefund(feeTx.GetFee(), feeTx.GetGas(), ctx.GasMeter().GasConsumed(), grd.threshold)
+	if !refund.IsZero() {
+		receiver := feeTx.FeePayer()
+		if granter := feeTx.FeeGranter(); granter != nil {
+			receiver = granter
+		}
+
+		if err := grd.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FeeCollectorName, receiver, refund); err != nil {
+			return ctx, sdkerrors.Wrap(err, "failed to refund unused gas")
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeGasRefund,
+			sdk.NewAttribute(AttributeKeyRefundReceiver, receiver.String()),
+			sdk.NewAttribute(AttributeKeyRefundAmount, refund.String()),
+		))
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// GasRefund returns the share of fee paid for the unused gas of a tx above the
+// threshold share of gasLimit, truncated.
+func GasRefund(fee sdk.Coins, gasLimit, gasUsed uint64, threshold sdk.Dec) sdk.Coins {
+	if fee.IsZero() || gasLimit == 0 || gasUsed >= gasLimit {
+		return nil
+	}
+
+	limit := sdk.NewIntFromUint64(gasLimit)
+	refundGas := limit.Sub(sdk.NewIntFromUint64(gasUsed)).Sub(threshold.MulInt(limit).Ceil().TruncateInt())
+	if !refundGas.IsPositive() {
+		return nil
+	}
+
+	var refund sdk.Coins
+	for _, coin := range fee {
+		amount := coin.Amount.Mul(refundGas).Quo(limit)
+		if amount.IsPositive() {
+			refund = refund.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return refund
+}
@@ -0,0 +1,89 @@
+package middleware_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+)
+
+func TestGasRefund(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 10))
+
+	testCases := []struct {
+		name      string
+		fee       sdk.Coins
+		gasUsed   uint64
+		threshold sdk.Dec
+		expected  sdk.Coins
+	}{
+		{"no unused gas", fee, 1000, sdk.ZeroDec(), nil},
+		{"no fee", nil, 100, sdk.ZeroDec(), nil},
+		{"unused gas without threshold", fee, 250, sdk.ZeroDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 750), sdk.NewInt64Coin("stake", 7))},
+		{"unused gas above threshold", fee, 250, sdk.NewDecWithPrec(5, 1), sdk.NewCoins(sdk.NewInt64Coin("atom", 250), sdk.NewInt64Coin("stake", 2))},
+		{"unused gas below threshold", fee, 600, sdk.NewDecWithPrec(5, 1), nil},
+		{"full threshold", fee, 0, sdk.OneDec(), nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			refund := middleware.GasRefund(tc.fee, 1000, tc.gasUsed, tc.threshold)
+			require.Equal(t, tc.expected.String(), refund.String())
+		})
+	}
+}
+
+func TestNewGasRefundDecoratorInvalidThreshold(t *testing.T) {
+	require.Panics(t, func() { middleware.NewGasRefundDecorator(nil, sdk.NewDec(-1)) })
+	require.Panics(t, func() { middleware.NewGasRefundDecorator(nil, sdk.NewDec(2)) })
+	require.NotPanics(t, func() { middleware.NewGasRefundDecorator(nil, sdk.NewDecWithPrec(1, 1)) })
+}
+
+func (s *MWTestSuite) TestGasRefundDecorator() {
+	ctx := s.SetupTest(false) // setup
+
+	msr := middleware.NewMsgServiceRouter(s.clientCtx.InterfaceRegistry)
+	legacyRouter := middleware.NewLegacyRouter()
+	legacyRouter.AddRoute(sdk.NewRoute((&testdata.TestMsg{}).Route(), func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) { return &sdk.Result{}, nil }))
+	postHandler := sdk.ChainPostDecorators(middleware.NewGasRefundDecorator(s.app.BankKeeper, sdk.NewDecWithPrec(1, 1)))
+	txHandler := middleware.ComposeMiddlewares(
+		middleware.NewRunMsgsTxHandlerWithPostHandler(msr, legacyRouter, postHandler),
+		middleware.GasTxMiddleware,
+		middleware.DeductFeeMiddleware(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper),
+	)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	acc := s.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	s.app.AccountKeeper.SetAccount(ctx, acc)
+	initial := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, ctx, addr1, initial))
+
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 500)))
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, txBytes, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+	s.Require().NoError(err)
+
+	res, err := txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx, abci.RequestDeliverTx{Tx: txBytes})
+	s.Require().NoError(err)
+	s.Require().Less(res.GasUsed, int64(testdata.NewTestGasLimit()/2))
+
+	// at least 90% - 50% of the fee is refunded
+	balance := s.app.BankKeeper.GetBalance(ctx, addr1, "atom")
+	s.Require().True(balance.Amount.GTE(sdk.NewInt(700)), balance)
+	s.Require().True(balance.Amount.LT(sdk.NewInt(1000)), balance)
+
+	var found bool
+	for _, event := range res.Events {
+		found = found || event.Type == middleware.EventTypeGasRefund
+	}
+	s.Require().True(found)
+}
@@ -18,12 +18,21 @@ import (
 type runMsgsTxHandler struct {
 	legacyRouter     sdk.Router        // router for redirecting legacy Msgs
 	msgServiceRouter *MsgServiceRouter // router for redirecting Msg service messages
+	postHandler      sdk.PostHandler   // run after all Msgs succeeded, may be nil
 }
 
 func NewRunMsgsTxHandler(msr *MsgServiceRouter, legacyRouter sdk.Router) tx.Handler {
+	return NewRunMsgsTxHandlerWithPostHandler(msr, legacyRouter, nil)
+}
+
+// NewRunMsgsTxHandlerWithPostHandler returns a tx.Handler running the Msgs of
+// a tx, then postHandler if not nil. The state changes of the Msgs and of the
+// postHandler are only committed if both succeed.
+func NewRunMsgsTxHandlerWithPostHandler(msr *MsgServiceRouter, legacyRouter sdk.Router, postHandler sdk.PostHandler) tx.Handler {
 	return runMsgsTxHandler{
 		legacyRouter:     legacyRouter,
 		msgServiceRouter: msr,
+		postHandler:      postHandler,
 	}
 }
 
@@ -37,7 +46,7 @@ func (txh runMsgsTxHandler) CheckTx(ctx context.Context, tx sdk.Tx, req abci.Req
 
 // DeliverTx implements tx.Handler.DeliverTx method.
 func (txh runMsgsTxHandler) DeliverTx(ctx context.Context, tx sdk.Tx, req abci.RequestDeliverTx) (abci.ResponseDeliverTx, error) {
-	res, err := txh.runMsgs(sdk.UnwrapSDKContext(ctx), tx.GetMsgs(), req.Tx)
+	res, err := txh.runMsgs(sdk.UnwrapSDKContext(ctx), tx, req.Tx, false)
 	if err != nil {
 		return abci.ResponseDeliverTx{}, err
 	}
@@ -52,7 +61,7 @@ func (txh runMsgsTxHandler) DeliverTx(ctx context.Context, tx sdk.Tx, req abci.R
 
 // SimulateTx implements tx.Handler.SimulateTx method.
 func (txh runMsgsTxHandler) SimulateTx(ctx context.Context, sdkTx sdk.Tx, req tx.RequestSimulateTx) (tx.ResponseSimulateTx, error) {
-	res, err := txh.runMsgs(sdk.UnwrapSDKContext(ctx), sdkTx.GetMsgs(), req.TxBytes)
+	res, err := txh.runMsgs(sdk.UnwrapSDKContext(ctx), sdkTx, req.TxBytes, true)
 	if err != nil {
 		return tx.ResponseSimulateTx{}, err
 	}
@@ -68,7 +77,9 @@ func (txh runMsgsTxHandler) SimulateTx(ctx context.Context, sdkTx sdk.Tx, req tx
 // and DeliverTx. An error is returned if any single message fails or if a
 // Handler does not exist for a given message route. Otherwise, a reference to a
 // Result is returned. The caller must not commit state if an error is returned.
-func (txh runMsgsTxHandler) runMsgs(sdkCtx sdk.Context, msgs []sdk.Msg, txBytes []byte) (*sdk.Result, error) {
+func (txh runMsgsTxHandler) runMsgs(sdkCtx sdk.Context, sdkTx sdk.Tx, txBytes []byte, simulate bool) (*sdk.Result, error) {
+	msgs := sdkTx.GetMsgs()
+
 	// Create a new Context based off of the existing Context with a MultiStore branch
 	// in case message processing fails. At this point, the MultiStore
 	// is a branch of a branch.
@@ -91,9 +102,19 @@ func (txh runMsgsTxHandler) runMsgs(sdkCtx sdk.Context, msgs []sdk.Msg, txBytes
 			err          error
 		)
 
+		gasBefore := sdkCtx.GasMeter().GasConsumed()
+
+		// the gas of each message is broken down on its own meter if the gas
+		// meter of the tx is a ChildGasMeter
+		msgCtx, legacyMsgCtx := runMsgCtx, sdkCtx
+		if meter, ok := sdkCtx.GasMeter().(*sdk.ChildGasMeter); ok {
+			msgMeter := meter.Child(fmt.Sprintf("msg_%d", i))
+			msgCtx, legacyMsgCtx = runMsgCtx.WithGasMeter(msgMeter), sdkCtx.WithGasMeter(msgMeter)
+		}
+
 		if handler := txh.msgServiceRouter.Handler(msg); handler != nil {
 			// ADR 031 request type routing
-			msgResult, err = handler(runMsgCtx, msg)
+			msgResult, err = handler(msgCtx, msg)
 			eventMsgName = sdk.MsgTypeURL(msg)
 		} else if legacyMsg, ok := msg.(legacytx.LegacyMsg); ok {
 			// legacy sdk.Msg routing
@@ -108,7 +129,7 @@ func (txh runMsgsTxHandler) runMsgs(sdkCtx sdk.Context, msgs []sdk.Msg, txBytes
 				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
 			}
 
-			msgResult, err = handler(sdkCtx, msg)
+			msgResult, err = handler(legacyMsgCtx, msg)
 		} else {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
 		}
@@ -117,6 +138,10 @@ func (txh runMsgsTxHandler) runMsgs(sdkCtx sdk.Context, msgs []sdk.Msg, txBytes
 			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
 
+		if tracer := sdkCtx.GasTracer(); tracer != nil {
+			tracer.AddMsg(sdk.MsgTypeURL(msg), sdkCtx.GasMeter().GasConsumed()-gasBefore)
+		}
+
 		msgEvents := sdk.Events{
 			sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, eventMsgName)),
 		}
@@ -132,6 +157,19 @@ func (txh runMsgsTxHandler) runMsgs(sdkCtx sdk.Context, msgs []sdk.Msg, txBytes
 		msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint32(i), msgResult.Log, msgEvents))
 	}
 
+	if txh.postHandler != nil {
+		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
+		newCtx, err := txh.postHandler(postCtx, sdkTx, simulate)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to execute post handler")
+		}
+		if !newCtx.IsZero() {
+			postCtx = newCtx
+		}
+
+		events = events.AppendEvents(postCtx.EventManager().Events())
+	}
+
 	msCache.Write()
 	data, err := proto.Marshal(txMsgData)
 	if err != nil {
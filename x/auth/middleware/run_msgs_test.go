@@ -1,12 +1,15 @@
 package middleware_test
 
 import (
+	"errors"
+
 	"github.com/tendermint/tendermint/abci/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func (s *MWTestSuite) TestRunMsgs() {
@@ -34,3 +37,54 @@ func (s *MWTestSuite) TestRunMsgs() {
 	s.Require().Len(txMsgData.Data, 1)
 	s.Require().Equal(sdk.MsgTypeURL(&testdata.MsgCreateDog{}), txMsgData.Data[0].MsgType)
 }
+
+func (s *MWTestSuite) TestRunMsgsPostHandler() {
+	ctx := s.SetupTest(true) // setup
+
+	msr := middleware.NewMsgServiceRouter(s.clientCtx.InterfaceRegistry)
+	testdata.RegisterMsgServer(msr, testdata.MsgServerImpl{})
+
+	priv, _, _ := testdata.KeyTestPubAddr()
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	txBuilder.SetMsgs(&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}})
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{0}
+	tx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+	s.Require().NoError(err)
+	txBytes, err := s.clientCtx.TxConfig.TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	key := []byte("post")
+	storeKey := s.app.GetKey(authtypes.StoreKey)
+	testCases := []struct {
+		name   string
+		errMsg error
+	}{
+		{"post handler succeeds", nil},
+		{"post handler fails", errors.New("post failure")},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx, _ := ctx.CacheContext()
+			postHandler := func(ctx sdk.Context, _ sdk.Tx, simulate bool) (sdk.Context, error) {
+				s.Require().False(simulate)
+				ctx.KVStore(storeKey).Set(key, []byte{1})
+				ctx.EventManager().EmitEvent(sdk.NewEvent("post"))
+				return ctx, tc.errMsg
+			}
+			txHandler := middleware.NewRunMsgsTxHandlerWithPostHandler(msr, nil, postHandler)
+
+			res, err := txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx, types.RequestDeliverTx{Tx: txBytes})
+			if tc.errMsg != nil {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg.Error())
+				s.Require().False(ctx.KVStore(storeKey).Has(key))
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().True(ctx.KVStore(storeKey).Has(key))
+			s.Require().Equal("post", res.Events[len(res.Events)-1].Type)
+		})
+	}
+}
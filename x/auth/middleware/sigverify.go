@@ -73,16 +73,20 @@ func (spkm setPubKeyTxHandler) setPubKey(ctx context.Context, tx sdk.Tx, simulat
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
-		}
-
 		acc, err := GetSignerAcc(sdkCtx, spkm.ak, signers[i])
 		if err != nil {
 			return err
 		}
+
+		// Only make check if simulate=false. The pubkey of an account rotated
+		// with MsgChangePubKey doesn't match its address, but is the pubkey
+		// set in the account.
+		if !simulate && !bytes.Equal(pk.Address(), signers[i]) &&
+			(acc.GetPubKey() == nil || !acc.GetPubKey().Equals(pk)) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
+		}
+
 		// account already has pubkey set,no need to reset
 		if acc.GetPubKey() != nil {
 			continue
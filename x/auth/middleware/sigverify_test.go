@@ -67,6 +67,54 @@ func (s *MWTestSuite) TestSetPubKey() {
 	}
 }
 
+func (s *MWTestSuite) TestSigVerificationRotatedPubKey() {
+	ctx := s.SetupTest(true) // setup
+	txHandler := middleware.ComposeMiddlewares(
+		noopTxHandler{},
+		middleware.SetPubKeyMiddleware(s.app.AccountKeeper),
+		middleware.SigVerificationMiddleware(
+			s.app.AccountKeeper,
+			s.clientCtx.TxConfig.SignModeHandler(),
+		),
+	)
+
+	priv1, pub1, addr1 := testdata.KeyTestPubAddr()
+	priv2, pub2, _ := testdata.KeyTestPubAddr()
+	priv3, _, _ := testdata.KeyTestPubAddr()
+
+	acc := s.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	s.Require().NoError(acc.SetPubKey(pub1))
+	s.app.AccountKeeper.SetAccount(ctx, acc)
+	s.Require().NoError(s.app.AccountKeeper.ChangePubKey(ctx, addr1, pub2))
+
+	testCases := []struct {
+		name      string
+		priv      cryptotypes.PrivKey
+		shouldErr bool
+	}{
+		{"signed with the new pubkey", priv2, false},
+		{"signed with the old pubkey", priv1, true},
+		{"signed with another pubkey", priv3, true},
+	}
+
+	for _, tc := range testCases {
+		txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+		txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		testTx, _, err := s.createTestTx(txBuilder, []cryptotypes.PrivKey{tc.priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, ctx.ChainID())
+		s.Require().NoError(err)
+
+		_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), testTx, abci.RequestDeliverTx{})
+		if tc.shouldErr {
+			s.Require().Error(err, tc.name)
+		} else {
+			s.Require().NoError(err, tc.name)
+		}
+	}
+}
+
 func (s *MWTestSuite) TestConsumeSignatureVerificationGas() {
 	params := types.DefaultParams()
 	msg := []byte{1, 2, 3, 4}
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// EventTypeTip is the type of the event emitted when a tip is paid.
+	EventTypeTip = "tip"
+
+	AttributeKeyTipper   = "tipper"
+	AttributeKeyTipPayee = "payee"
+	AttributeKeyTip      = "tip"
+)
+
+var _ sdk.PostDecorator = TipDecorator{}
+
+// TipDecorator is a PostDecorator transferring the tip of a tx from the tipper
+// to the fee payer. A tipper can thus sign a tx with a tip in a denom it owns,
+// and let a fee payer pay the fees of the tx in the fee denom of the chain.
+// The tip is only paid if all the messages of the tx succeeded.
+type TipDecorator struct {
+	bankKeeper types.BankKeeper
+}
+
+// NewTipDecorator returns a new TipDecorator.
+func NewTipDecorator(bk types.BankKeeper) TipDecorator {
+	return TipDecorator{
+		bankKeeper: bk,
+	}
+}
+
+// PostHandle implements sdk.PostDecorator.
+func (td TipDecorator) PostHandle(ctx sdk.Context, sdkTx sdk.Tx, simulate bool, next sdk.PostHandler) (sdk.Context, error) {
+	tipTx, ok := sdkTx.(tx.TipTx)
+	if !ok || tipTx.GetTip() == nil {
+		return next(ctx, sdkTx, simulate)
+	}
+
+	tip := tipTx.GetTip()
+	tipper, err := sdk.AccAddressFromBech32(tip.Tipper)
+	if err != nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tipper address (%s)", err)
+	}
+
+	payee, amount := tipTx.FeePayer(), sdk.Coins(tip.Amount)
+	if err := td.bankKeeper.SendCoins(ctx, tipper, payee, amount); err != nil {
+		return ctx, sdkerrors.Wrap(err, "failed to pay tip")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeTip,
+		sdk.NewAttribute(AttributeKeyTipper, tip.Tipper),
+		sdk.NewAttribute(AttributeKeyTipPayee, payee.String()),
+		sdk.NewAttribute(AttributeKeyTip, amount.String()),
+	))
+
+	return next(ctx, sdkTx, simulate)
+}
@@ -0,0 +1,60 @@
+package middleware_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+)
+
+func (s *MWTestSuite) TestTips() {
+	ctx := s.SetupTest(false) // setup
+	accounts := s.createTestAccounts(ctx, 3)
+	tipper, feePayer, other := accounts[0], accounts[1], accounts[2]
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, ctx, tipper.acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("tipcoin", 1000))))
+
+	feeAmount := testdata.NewTestFeeAmount()
+	tip := sdk.NewCoins(sdk.NewInt64Coin("tipcoin", 100))
+
+	testCases := []struct {
+		desc   string
+		tip    *txtypes.Tip
+		expErr error
+	}{
+		{"tipper is not a signer", &txtypes.Tip{Amount: tip, Tipper: other.acc.GetAddress().String()}, sdkerrors.ErrUnauthorized},
+		{"invalid tip amount", &txtypes.Tip{Tipper: tipper.acc.GetAddress().String()}, sdkerrors.ErrInvalidCoins},
+		{"insufficient tipper funds", &txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("tipcoin", 5000)), Tipper: tipper.acc.GetAddress().String()}, sdkerrors.ErrInsufficientFunds},
+		{"tip is paid to the fee payer", &txtypes.Tip{Amount: tip, Tipper: tipper.acc.GetAddress().String()}, nil},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.desc, func() {
+			ctx, _ := ctx.CacheContext()
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(tipper.acc.GetAddress())))
+			txBuilder.SetFeeAmount(feeAmount)
+			txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			txBuilder.(interface{ SetFeePayer(sdk.AccAddress) }).SetFeePayer(feePayer.acc.GetAddress())
+			txBuilder.SetTip(tc.tip)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{tipper.priv, feePayer.priv}, []uint64{0, 1}, []uint64{0, 0}
+			tx, txBytes, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+			s.Require().NoError(err)
+
+			_, err = s.txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx, abci.RequestDeliverTx{Tx: txBytes})
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewInt(900), s.app.BankKeeper.GetBalance(ctx, tipper.acc.GetAddress(), "tipcoin").Amount)
+			s.Require().Equal(sdk.NewInt(100), s.app.BankKeeper.GetBalance(ctx, feePayer.acc.GetAddress(), "tipcoin").Amount)
+			s.Require().Equal(sdk.NewInt(10000000).Sub(feeAmount.AmountOf("atom")), s.app.BankKeeper.GetBalance(ctx, feePayer.acc.GetAddress(), "atom").Amount)
+		})
+	}
+}
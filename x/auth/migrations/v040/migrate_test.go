@@ -232,13 +232,18 @@ func TestMigrate(t *testing.T) {
       "sequence": "0"
     }
   ],
+  "module_address_derivations": [],
   "params": {
     "max_memo_characters": "10",
+    "priced_fee_denoms": [],
+    "pub_key_change_cooldown": "0s",
+    "pub_key_change_cost": "0",
     "sig_verify_cost_ed25519": "40",
     "sig_verify_cost_secp256k1": "50",
     "tx_sig_limit": "20",
     "tx_size_cost_per_byte": "30"
-  }
+  },
+  "pub_key_rotations": []
 }`
 
 	bz, err := clientCtx.Codec.MarshalJSON(migrated)
@@ -0,0 +1,27 @@
+package v046
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Setting the PubKeyChangeCost, PubKeyChangeCooldown and PricedFeeDenoms
+// params to their default values, unless they were already set, e.g. by the
+// upgrade handler before running the migrations.
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	if !paramSpace.Has(ctx, types.KeyPubKeyChangeCost) {
+		paramSpace.Set(ctx, types.KeyPubKeyChangeCost, types.DefaultPubKeyChangeCost)
+	}
+	if !paramSpace.Has(ctx, types.KeyPubKeyChangeCooldown) {
+		paramSpace.Set(ctx, types.KeyPubKeyChangeCooldown, types.DefaultPubKeyChangeCooldown)
+	}
+	if !paramSpace.Has(ctx, types.KeyPricedFeeDenoms) {
+		paramSpace.Set(ctx, types.KeyPricedFeeDenoms, []string(nil))
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	require.False(t, paramSpace.Has(ctx, types.KeyPubKeyChangeCost))
+	require.False(t, paramSpace.Has(ctx, types.KeyPubKeyChangeCooldown))
+	require.False(t, paramSpace.Has(ctx, types.KeyPricedFeeDenoms))
+
+	require.NoError(t, v046.MigrateStore(ctx, paramSpace))
+
+	var (
+		cost         uint64
+		cooldown     time.Duration
+		pricedDenoms []string
+	)
+	paramSpace.Get(ctx, types.KeyPubKeyChangeCost, &cost)
+	paramSpace.Get(ctx, types.KeyPubKeyChangeCooldown, &cooldown)
+	paramSpace.Get(ctx, types.KeyPricedFeeDenoms, &pricedDenoms)
+	require.Equal(t, types.DefaultPubKeyChangeCost, cost)
+	require.Equal(t, types.DefaultPubKeyChangeCooldown, cooldown)
+	require.Empty(t, pricedDenoms)
+
+	// values set before the migration are kept
+	paramSpace.Set(ctx, types.KeyPubKeyChangeCooldown, time.Hour)
+	require.NoError(t, v046.MigrateStore(ctx, paramSpace))
+	paramSpace.Get(ctx, types.KeyPubKeyChangeCooldown, &cooldown)
+	require.Equal(t, time.Hour, cooldown)
+}
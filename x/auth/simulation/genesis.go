@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"time"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/simulation"
@@ -19,18 +21,59 @@ const (
 	TxSizeCostPerByte      = "tx_size_cost_per_byte"
 	SigVerifyCostED25519   = "sig_verify_cost_ed25519"
 	SigVerifyCostSECP256K1 = "sig_verify_cost_secp256k1"
+	PubKeyChangeCost       = "pub_key_change_cost"
+	PubKeyChangeCooldown   = "pub_key_change_cooldown"
 )
 
+// RandGenAccountsProvider is the key of the simulation params holding a
+// RandomGenesisAccountsProvider, packed in an Any, generating the genesis
+// accounts instead of the RandomGenesisAccountsFn of the app, e.g.
+//
+//	"random_genesis_accounts_provider": {
+//	  "@type": "/cosmos.auth.v1beta1.GenesisAccountsDistribution",
+//	  "vesting_percent": 90,
+//	  "continuous_vesting_percent": 50,
+//	  "module_accounts": 10
+//	}
+const RandGenAccountsProvider = "random_genesis_accounts_provider"
+
+var _ types.RandomGenesisAccountsProvider = &GenesisAccountsDistribution{}
+
+// RegisterInterfaces registers the RandomGenesisAccountsProvider
+// implementations of the auth module.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*types.RandomGenesisAccountsProvider)(nil),
+		&GenesisAccountsDistribution{},
+	)
+}
+
+// DefaultGenesisAccountsDistribution returns the distribution of the genesis
+// accounts generated by RandomGenesisAccounts.
+func DefaultGenesisAccountsDistribution() *GenesisAccountsDistribution {
+	return &GenesisAccountsDistribution{
+		VestingPercent:           50,
+		ContinuousVestingPercent: 50,
+	}
+}
+
 // RandomGenesisAccounts defines the default RandomGenesisAccountsFn used on the SDK.
 // It creates a slice of BaseAccount, ContinuousVestingAccount and DelayedVestingAccount.
 func RandomGenesisAccounts(simState *module.SimulationState) types.GenesisAccounts {
-	genesisAccs := make(types.GenesisAccounts, len(simState.Accounts))
+	return DefaultGenesisAccountsDistribution().RandomGenesisAccounts(simState)
+}
+
+// RandomGenesisAccounts implements RandomGenesisAccountsProvider. It creates a
+// BaseAccount, ContinuousVestingAccount or DelayedVestingAccount for each of
+// the simulation accounts, followed by the module accounts of the distribution.
+func (d *GenesisAccountsDistribution) RandomGenesisAccounts(simState *module.SimulationState) types.GenesisAccounts {
+	genesisAccs := make(types.GenesisAccounts, len(simState.Accounts), len(simState.Accounts)+int(d.ModuleAccounts))
 	for i, acc := range simState.Accounts {
 		bacc := types.NewBaseAccountWithAddress(acc.Address)
 
 		// Only consider making a vesting account once the initial bonded validator
 		// set is exhausted due to needing to track DelegatedVesting.
-		if !(int64(i) > simState.NumBonded && simState.Rand.Intn(100) < 50) {
+		if !(int64(i) > simState.NumBonded && simState.Rand.Intn(100) < int(d.VestingPercent)) {
 			genesisAccs[i] = bacc
 			continue
 		}
@@ -49,13 +92,25 @@ func RandomGenesisAccounts(simState *module.SimulationState) types.GenesisAccoun
 
 		bva := vestingtypes.NewBaseVestingAccount(bacc, initialVesting, endTime)
 
-		if simState.Rand.Intn(100) < 50 {
+		if simState.Rand.Intn(100) < int(d.ContinuousVestingPercent) {
 			genesisAccs[i] = vestingtypes.NewContinuousVestingAccountRaw(bva, startTime)
 		} else {
 			genesisAccs[i] = vestingtypes.NewDelayedVestingAccountRaw(bva)
 		}
 	}
 
+	permissions := []string{types.Minter, types.Burner, types.Staking}
+	for i := 0; i < int(d.ModuleAccounts); i++ {
+		var perms []string
+		for _, perm := range permissions {
+			if simState.Rand.Intn(2) == 0 {
+				perms = append(perms, perm)
+			}
+		}
+
+		genesisAccs = append(genesisAccs, types.NewEmptyModuleAccount(fmt.Sprintf("simulation-%d", i), perms...))
+	}
+
 	return genesisAccs
 }
 
@@ -87,6 +142,16 @@ func GenSigVerifyCostSECP256K1(r *rand.Rand) uint64 {
 	return uint64(simulation.RandIntBetween(r, 500, 1000))
 }
 
+// GenPubKeyChangeCost randomized PubKeyChangeCost
+func GenPubKeyChangeCost(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1000, 20000))
+}
+
+// GenPubKeyChangeCooldown randomized PubKeyChangeCooldown
+func GenPubKeyChangeCooldown(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 48)) * time.Hour
+}
+
 // RandomizedGenState generates a random GenesisState for auth
 func RandomizedGenState(simState *module.SimulationState, randGenAccountsFn types.RandomGenesisAccountsFn) {
 	var maxMemoChars uint64
@@ -119,8 +184,29 @@ func RandomizedGenState(simState *module.SimulationState, randGenAccountsFn type
 		func(r *rand.Rand) { sigVerifyCostSECP256K1 = GenSigVerifyCostSECP256K1(r) },
 	)
 
+	var pubKeyChangeCost uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PubKeyChangeCost, &pubKeyChangeCost, simState.Rand,
+		func(r *rand.Rand) { pubKeyChangeCost = GenPubKeyChangeCost(r) },
+	)
+
+	var pubKeyChangeCooldown time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PubKeyChangeCooldown, &pubKeyChangeCooldown, simState.Rand,
+		func(r *rand.Rand) { pubKeyChangeCooldown = GenPubKeyChangeCooldown(r) },
+	)
+
 	params := types.NewParams(maxMemoChars, txSigLimit, txSizeCostPerByte,
-		sigVerifyCostED25519, sigVerifyCostSECP256K1)
+		sigVerifyCostED25519, sigVerifyCostSECP256K1, pubKeyChangeCost, pubKeyChangeCooldown)
+	if bz, ok := simState.AppParams[RandGenAccountsProvider]; ok {
+		var provider types.RandomGenesisAccountsProvider
+		if err := simState.Cdc.UnmarshalInterfaceJSON(bz, &provider); err != nil {
+			panic(err)
+		}
+
+		randGenAccountsFn = provider.RandomGenesisAccounts
+	}
+
 	genesisAccs := randGenAccountsFn(simState)
 
 	authGenesis := types.NewGenesisState(params, genesisAccs)
@@ -13,6 +13,7 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 // TestRandomizedGenState tests the normal scenario of applying RandomizedGenState.
@@ -53,3 +54,55 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, uint64(0), genAccounts[2].GetAccountNumber())
 	require.Equal(t, uint64(0), genAccounts[2].GetSequence())
 }
+
+// TestRandomizedGenStateProvider tests generating the genesis accounts with the
+// RandomGenesisAccountsProvider of the simulation params.
+func TestRandomizedGenStateProvider(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	vestingtypes.RegisterInterfaces(registry)
+	simulation.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	r := rand.New(rand.NewSource(1))
+	simState := module.SimulationState{
+		AppParams: simtypes.AppParams{
+			simulation.RandGenAccountsProvider: json.RawMessage(`{
+				"@type": "/cosmos.auth.v1beta1.GenesisAccountsDistribution",
+				"vesting_percent": 100,
+				"continuous_vesting_percent": 100,
+				"module_accounts": 2
+			}`),
+		},
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    1,
+		Accounts:     simtypes.RandomAccounts(r, 5),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState, simulation.RandomGenesisAccounts)
+
+	var authGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &authGenesis)
+	genAccounts, err := types.UnpackAccounts(authGenesis.Accounts)
+	require.NoError(t, err)
+	require.NoError(t, types.ValidateGenAccounts(genAccounts))
+	require.Len(t, genAccounts, 7)
+
+	for i, acc := range genAccounts[:5] {
+		if i > 1 {
+			require.IsType(t, &vestingtypes.ContinuousVestingAccount{}, acc)
+		} else {
+			require.IsType(t, &types.BaseAccount{}, acc)
+		}
+	}
+	for _, acc := range genAccounts[5:] {
+		require.IsType(t, &types.ModuleAccount{}, acc)
+	}
+
+	// the providers must be registered
+	simState.AppParams[simulation.RandGenAccountsProvider] = json.RawMessage(`{"@type": "/cosmos.auth.v1beta1.BaseAccount"}`)
+	require.Panics(t, func() { simulation.RandomizedGenState(&simState, simulation.RandomGenesisAccounts) })
+}
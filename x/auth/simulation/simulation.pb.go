@@ -0,0 +1,385 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/auth/v1beta1/simulation.proto
+
+package simulation
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisAccountsDistribution is a RandomGenesisAccountsProvider generating the
+// genesis accounts of the simulations with the given shares of vesting accounts
+// and the given number of module accounts, e.g. to fuzz vesting-heavy or
+// module-account-heavy genesis states.
+type GenesisAccountsDistribution struct {
+	// vesting_percent is the percentage of the simulation accounts, other than
+	// the ones bonded at genesis, created as vesting accounts.
+	VestingPercent uint32 `protobuf:"varint,1,opt,name=vesting_percent,json=vestingPercent,proto3" json:"vesting_percent,omitempty"`
+	// continuous_vesting_percent is the percentage of the vesting accounts created
+	// as continuous vesting accounts, the others being delayed vesting accounts.
+	ContinuousVestingPercent uint32 `protobuf:"varint,2,opt,name=continuous_vesting_percent,json=continuousVestingPercent,proto3" json:"continuous_vesting_percent,omitempty"`
+	// module_accounts is the number of module accounts added to the genesis
+	// accounts, with random names and permissions.
+	ModuleAccounts uint32 `protobuf:"varint,3,opt,name=module_accounts,json=moduleAccounts,proto3" json:"module_accounts,omitempty"`
+}
+
+func (m *GenesisAccountsDistribution) Reset()         { *m = GenesisAccountsDistribution{} }
+func (m *GenesisAccountsDistribution) String() string { return proto.CompactTextString(m) }
+func (*GenesisAccountsDistribution) ProtoMessage()    {}
+func (*GenesisAccountsDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5693225a7e983841, []int{0}
+}
+func (m *GenesisAccountsDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisAccountsDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisAccountsDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisAccountsDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisAccountsDistribution.Merge(m, src)
+}
+func (m *GenesisAccountsDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisAccountsDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisAccountsDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisAccountsDistribution proto.InternalMessageInfo
+
+func (m *GenesisAccountsDistribution) GetVestingPercent() uint32 {
+	if m != nil {
+		return m.VestingPercent
+	}
+	return 0
+}
+
+func (m *GenesisAccountsDistribution) GetContinuousVestingPercent() uint32 {
+	if m != nil {
+		return m.ContinuousVestingPercent
+	}
+	return 0
+}
+
+func (m *GenesisAccountsDistribution) GetModuleAccounts() uint32 {
+	if m != nil {
+		return m.ModuleAccounts
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisAccountsDistribution)(nil), "cosmos.auth.v1beta1.GenesisAccountsDistribution")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/auth/v1beta1/simulation.proto", fileDescriptor_5693225a7e983841)
+}
+
+var fileDescriptor_5693225a7e983841 = []byte{
+	// 239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xce, 0x2f, 0xce,
+	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
+	0x2f, 0xce, 0xcc, 0x2d, 0xcd, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
+	0x17, 0x12, 0x86, 0xa8, 0xd2, 0x03, 0xa9, 0xd2, 0x83, 0xaa, 0x52, 0x5a, 0xcb, 0xc8, 0x25, 0xed,
+	0x9e, 0x9a, 0x97, 0x5a, 0x9c, 0x59, 0xec, 0x98, 0x9c, 0x9c, 0x5f, 0x9a, 0x57, 0x52, 0xec, 0x92,
+	0x59, 0x5c, 0x52, 0x94, 0x99, 0x54, 0x0a, 0xd2, 0x2a, 0xa4, 0xce, 0xc5, 0x5f, 0x96, 0x5a, 0x5c,
+	0x92, 0x99, 0x97, 0x1e, 0x5f, 0x90, 0x5a, 0x94, 0x9c, 0x9a, 0x57, 0x22, 0xc1, 0xa8, 0xc0, 0xa8,
+	0xc1, 0x1b, 0xc4, 0x07, 0x15, 0x0e, 0x80, 0x88, 0x0a, 0xd9, 0x70, 0x49, 0x25, 0xe7, 0xe7, 0x95,
+	0x64, 0xe6, 0x95, 0xe6, 0x97, 0x16, 0xc7, 0xa3, 0xeb, 0x61, 0x02, 0xeb, 0x91, 0x40, 0xa8, 0x08,
+	0x43, 0xd5, 0xad, 0xce, 0xc5, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93, 0x1a, 0x9f, 0x08, 0x75, 0x85,
+	0x04, 0x33, 0xc4, 0x1a, 0x88, 0x30, 0xcc, 0x6d, 0x4e, 0x1e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
+	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
+	0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x97, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab,
+	0x0f, 0x0d, 0x0f, 0x08, 0xa5, 0x5b, 0x9c, 0x92, 0xad, 0x5f, 0x01, 0x09, 0x1c, 0x44, 0xa0, 0x24,
+	0xb1, 0x81, 0x43, 0xc5, 0x18, 0x30, 0x00, 0xe6, 0x2e, 0xa2, 0x6c, 0x3d, 0x01, 0x00, 0x00,
+}
+
+func (m *GenesisAccountsDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisAccountsDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisAccountsDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ModuleAccounts != 0 {
+		i = encodeVarintSimulation(dAtA, i, uint64(m.ModuleAccounts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContinuousVestingPercent != 0 {
+		i = encodeVarintSimulation(dAtA, i, uint64(m.ContinuousVestingPercent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VestingPercent != 0 {
+		i = encodeVarintSimulation(dAtA, i, uint64(m.VestingPercent))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSimulation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSimulation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisAccountsDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VestingPercent != 0 {
+		n += 1 + sovSimulation(uint64(m.VestingPercent))
+	}
+	if m.ContinuousVestingPercent != 0 {
+		n += 1 + sovSimulation(uint64(m.ContinuousVestingPercent))
+	}
+	if m.ModuleAccounts != 0 {
+		n += 1 + sovSimulation(uint64(m.ModuleAccounts))
+	}
+	return n
+}
+
+func sovSimulation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSimulation(x uint64) (n int) {
+	return sovSimulation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisAccountsDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSimulation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisAccountsDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisAccountsDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPercent", wireType)
+			}
+			m.VestingPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSimulation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestingPercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousVestingPercent", wireType)
+			}
+			m.ContinuousVestingPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSimulation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContinuousVestingPercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccounts", wireType)
+			}
+			m.ModuleAccounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSimulation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModuleAccounts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSimulation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSimulation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSimulation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSimulation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSimulation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSimulation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSimulation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSimulation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSimulation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSimulation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSimulation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSimulation = fmt.Errorf("proto: unexpected end of group")
+)
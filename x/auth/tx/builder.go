@@ -35,6 +35,7 @@ var (
 	_ client.TxBuilder                 = &wrapper{}
 	_ middleware.HasExtensionOptionsTx = &wrapper{}
 	_ ExtensionOptionsTxBuilder        = &wrapper{}
+	_ tx.TipTx                         = &wrapper{}
 )
 
 // ExtensionOptionsTxBuilder defines a TxBuilder that can also set extensions.
@@ -144,6 +145,10 @@ func (w *wrapper) FeePayer() sdk.AccAddress {
 	return w.GetSigners()[0]
 }
 
+func (w *wrapper) GetTip() *tx.Tip {
+	return w.tx.AuthInfo.Tip
+}
+
 func (w *wrapper) FeeGranter() sdk.AccAddress {
 	feePayer := w.tx.AuthInfo.Fee.Granter
 	if feePayer != "" {
@@ -24,9 +24,19 @@ type config struct {
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
 // first enabled sign mode will become the default sign mode.
 func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode) client.TxConfig {
+	return NewTxConfigWithOptions(protoCodec, enabledSignModes, ConfigOptions{})
+}
+
+// NewTxConfigWithOptions returns a new protobuf TxConfig like NewTxConfig, with
+// the given options. It panics if the options are invalid.
+func NewTxConfigWithOptions(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode, opts ConfigOptions) client.TxConfig {
+	if err := opts.validate(); err != nil {
+		panic(err)
+	}
+
 	return &config{
 		handler:     makeSignModeHandler(enabledSignModes),
-		decoder:     DefaultTxDecoder(protoCodec),
+		decoder:     newTxDecoder(protoCodec, opts),
 		encoder:     DefaultTxEncoder(),
 		jsonDecoder: DefaultJSONTxDecoder(protoCodec),
 		jsonEncoder: DefaultJSONTxEncoder(protoCodec),
@@ -14,6 +14,12 @@ import (
 
 // DefaultTxDecoder returns a default protobuf TxDecoder using the provided Marshaler.
 func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
+	return newTxDecoder(cdc, ConfigOptions{})
+}
+
+// newTxDecoder returns a protobuf TxDecoder applying the unknown field policy
+// of the given options.
+func newTxDecoder(cdc codec.ProtoCodecMarshaler, opts ConfigOptions) sdk.TxDecoder {
 	return func(txBytes []byte) (sdk.Tx, error) {
 		// Make sure txBytes follow ADR-027.
 		err := rejectNonADR027TxRaw(txBytes)
@@ -34,10 +40,17 @@ func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 			return nil, err
 		}
 
+		policy := opts.unknownFieldPolicy()
+
 		var body tx.TxBody
 
-		// allow non-critical unknown fields in TxBody
-		txBodyHasUnknownNonCriticals, err := unknownproto.RejectUnknownFields(raw.BodyBytes, &body, true, cdc.InterfaceRegistry())
+		// allow non-critical unknown fields in TxBody, unless rejected by the
+		// policy
+		txBodyHasUnknownNonCriticals, err := unknownproto.RejectUnknownFields(raw.BodyBytes, &body, policy != UnknownFieldsReject, cdc.InterfaceRegistry())
+		if err != nil && policy == UnknownFieldsWarn && unknownproto.IsUnknownFieldError(err) {
+			opts.logger().Info("accepting tx with unknown fields in its body", "err", err)
+			txBodyHasUnknownNonCriticals, err = true, nil
+		}
 		if err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
 		}
@@ -49,8 +62,15 @@ func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 
 		var authInfo tx.AuthInfo
 
-		// reject all unknown proto fields in AuthInfo
+		// reject all unknown proto fields in AuthInfo, unless accepted by the
+		// policy
 		err = unknownproto.RejectUnknownFieldsStrict(raw.AuthInfoBytes, &authInfo, cdc.InterfaceRegistry())
+		if err != nil && policy == UnknownFieldsWarn && unknownproto.IsUnknownFieldError(err) {
+			opts.logger().Info("accepting tx with unknown fields in its auth info", "err", err)
+			// the unknown fields aren't covered by the legacy amino JSON sign
+			// bytes either
+			txBodyHasUnknownNonCriticals, err = true, nil
+		}
 		if err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
 		}
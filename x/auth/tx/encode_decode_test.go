@@ -161,6 +161,73 @@ func TestUnknownFields(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestUnknownFieldPolicy(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	encodeTx := func(body *testdata.TestUpdatedTxBody, authInfo *testdata.TestUpdatedAuthInfo) []byte {
+		bodyBz, err := body.Marshal()
+		require.NoError(t, err)
+		authInfoBz, err := authInfo.Marshal()
+		require.NoError(t, err)
+		txBz, err := (&tx.TxRaw{BodyBytes: bodyBz, AuthInfoBytes: authInfoBz}).Marshal()
+		require.NoError(t, err)
+		return txBz
+	}
+	nonCritical := encodeTx(&testdata.TestUpdatedTxBody{Memo: "foo", SomeNewFieldNonCriticalField: "blah"}, &testdata.TestUpdatedAuthInfo{})
+	critical := encodeTx(&testdata.TestUpdatedTxBody{Memo: "foo", SomeNewField: 10}, &testdata.TestUpdatedAuthInfo{})
+	authInfo := encodeTx(&testdata.TestUpdatedTxBody{Memo: "foo"}, &testdata.TestUpdatedAuthInfo{NewField_1024: []byte("xyz")})
+
+	height := int64(1)
+	opts := ConfigOptions{
+		UnknownFieldPolicy: UnknownFieldsReject,
+		UnknownFieldPolicyUpgrades: []UnknownFieldPolicyUpgrade{
+			{Height: 10, Policy: UnknownFieldsAllowNonCritical},
+			{Height: 20, Policy: UnknownFieldsWarn},
+		},
+		BlockHeight: func() int64 { return height },
+	}
+	decoder := newTxDecoder(cdc, opts)
+
+	tests := []struct {
+		height  int64
+		txBz    []byte
+		expPass bool
+	}{
+		{1, nonCritical, false},
+		{9, nonCritical, false},
+		{10, nonCritical, true},
+		{10, critical, false},
+		{10, authInfo, false},
+		{20, nonCritical, true},
+		{20, critical, true},
+		{30, authInfo, true},
+	}
+	for _, tc := range tests {
+		height = tc.height
+		theTx, err := decoder(tc.txBz)
+		if !tc.expPass {
+			require.Error(t, err, "height %d", tc.height)
+			continue
+		}
+		require.NoError(t, err, "height %d", tc.height)
+
+		// the unknown fields aren't covered by the legacy amino JSON sign bytes
+		_, err = signModeLegacyAminoJSONHandler{}.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signing.SignerData{}, theTx)
+		require.Error(t, err)
+	}
+
+	// without block height, the policy of the last upgrade applies
+	opts.BlockHeight = nil
+	_, err := newTxDecoder(cdc, opts)(critical)
+	require.NoError(t, err)
+
+	require.Panics(t, func() {
+		NewTxConfigWithOptions(cdc, DefaultSignModes, ConfigOptions{
+			UnknownFieldPolicyUpgrades: []UnknownFieldPolicyUpgrade{{Height: 10}, {Height: 10}},
+		})
+	})
+}
+
 func TestRejectNonADR027(t *testing.T) {
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
@@ -0,0 +1,58 @@
+//go:build go1.18
+// +build go1.18
+
+package tx
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/fuzz"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// newFuzzTxConfig returns the decoder and the tx config of the fuzz tests, and
+// adds a valid tx and malformed inputs to the seed corpus.
+func newFuzzTxConfig(f *testing.F) (sdk.TxDecoder, *config) {
+	registry := codectypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+	txConfig := NewTxConfig(codec.NewProtoCodec(registry), DefaultSignModes).(*config)
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	builder := txConfig.NewTxBuilder()
+	if err := builder.SetMsgs(testdata.NewTestMsg(addr)); err != nil {
+		f.Fatal(err)
+	}
+	builder.SetMemo("memo")
+	builder.SetFeeAmount(testdata.NewTestFeeAmount())
+	builder.SetGasLimit(testdata.NewTestGasLimit())
+	sig := signing.SignatureV2{
+		PubKey: pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+			Signature: []byte("signature"),
+		},
+	}
+	if err := builder.SetSignatures(sig); err != nil {
+		f.Fatal(err)
+	}
+
+	fuzz.AddTxs(f, txConfig.TxEncoder(), builder.GetTx())
+	f.Add([]byte{})
+	f.Add([]byte{0x0a, 0x02, 0x0a, 0x00})
+
+	return txConfig.TxDecoder(), txConfig
+}
+
+func FuzzTxDecoder(f *testing.F) {
+	decoder, _ := newFuzzTxConfig(f)
+	fuzz.TxDecoder(f, decoder)
+}
+
+func FuzzSignBytes(f *testing.F) {
+	decoder, txConfig := newFuzzTxConfig(f)
+	fuzz.SignBytes(f, decoder, txConfig.SignModeHandler())
+}
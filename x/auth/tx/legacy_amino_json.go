@@ -46,6 +46,12 @@ func (s signModeLegacyAminoJSONHandler) GetSignBytes(mode signingtypes.SignMode,
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not support protobuf extension options", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
 	}
 
+	// The tip is not part of the legacy sign bytes, it could be changed
+	// without invalidating the signatures.
+	if protoTx.tx.AuthInfo.Tip != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not support tips", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+
 	return legacytx.StdSignBytes(
 		data.ChainID, data.AccountNumber, data.Sequence, protoTx.GetTimeoutHeight(),
 		legacytx.StdFee{Amount: protoTx.GetFee(), Gas: protoTx.GetGas()},
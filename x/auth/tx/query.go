@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -13,8 +14,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
+// maxUnconfirmedTxs is the maximum number of mempool txs returned by a single
+// Tendermint RPC unconfirmed_txs call.
+const maxUnconfirmedTxs = 100
+
 // QueryTxsByEvents performs a search for transactions for a given set of events
 // via the Tendermint RPC. An event takes the form of:
 // "{eventAttribute}.{attributeKey} = '{attributeValue}'". Each event is
@@ -151,3 +157,75 @@ func mkTxResult(txConfig client.TxConfig, resTx *ctypes.ResultTx, resBlock *ctyp
 type intoAny interface {
 	AsAny() *codectypes.Any
 }
+
+// QueryPendingSequences returns the sequences signed by the given address in
+// the txs currently in the node's mempool, sorted in ascending order and
+// deduplicated. Only the first 100 mempool txs are inspected, as the
+// Tendermint RPC does not paginate unconfirmed txs.
+func QueryPendingSequences(clientCtx client.Context, addr sdk.AccAddress) ([]uint64, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	limit := maxUnconfirmedTxs
+	resTxs, err := node.UnconfirmedTxs(context.Background(), &limit)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint64]bool)
+	var sequences []uint64
+	for _, txBytes := range resTxs.Txs {
+		tx, err := clientCtx.TxConfig.TxDecoder()(txBytes)
+		if err != nil {
+			// skip txs that cannot be decoded by this node's codec
+			continue
+		}
+
+		sigTx, ok := tx.(signing.SigVerifiableTx)
+		if !ok {
+			continue
+		}
+
+		sigs, err := sigTx.GetSignaturesV2()
+		if err != nil {
+			continue
+		}
+
+		for i, signer := range sigTx.GetSigners() {
+			if i >= len(sigs) || !signer.Equals(addr) || seen[sigs[i].Sequence] {
+				continue
+			}
+
+			seen[sigs[i].Sequence] = true
+			sequences = append(sequences, sigs[i].Sequence)
+		}
+	}
+
+	sort.Slice(sequences, func(i, j int) bool { return sequences[i] < sequences[j] })
+
+	return sequences, nil
+}
+
+// MissingSequences returns the sequences between the given on-chain sequence
+// and the highest pending sequence for which no pending tx exists. The pending
+// sequences must be sorted in ascending order.
+func MissingSequences(sequence uint64, pending []uint64) []uint64 {
+	var missing []uint64
+	next := sequence
+	for _, seq := range pending {
+		if seq < next {
+			// stale tx, already superseded by a committed one
+			continue
+		}
+
+		for ; next < seq; next++ {
+			missing = append(missing, next)
+		}
+
+		next = seq + 1
+	}
+
+	return missing
+}
@@ -0,0 +1,29 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMissingSequences(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sequence uint64
+		pending  []uint64
+		expected []uint64
+	}{
+		{"no pending txs", 5, nil, nil},
+		{"contiguous pending txs", 5, []uint64{5, 6, 7}, nil},
+		{"gap before first pending tx", 5, []uint64{7, 8}, []uint64{5, 6}},
+		{"gap between pending txs", 5, []uint64{5, 8}, []uint64{6, 7}},
+		{"stale pending txs are ignored", 5, []uint64{3, 4, 6}, []uint64{5}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, MissingSequences(tc.sequence, tc.pending))
+		})
+	}
+}
@@ -21,19 +21,27 @@ import (
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// AccountKeeper defines the account keeper used by the tx service to read
+// on-chain account sequences.
+type AccountKeeper interface {
+	GetSequence(ctx sdk.Context, addr sdk.AccAddress) (uint64, error)
+}
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
 	clientCtx         client.Context
 	simulate          baseAppSimulateFn
 	interfaceRegistry codectypes.InterfaceRegistry
+	accountKeeper     AccountKeeper
 }
 
 // NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
+func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry, ak AccountKeeper) txtypes.ServiceServer {
 	return txServer{
 		clientCtx:         clientCtx,
 		simulate:          simulate,
 		interfaceRegistry: interfaceRegistry,
+		accountKeeper:     ak,
 	}
 }
 
@@ -153,16 +161,47 @@ func (s txServer) BroadcastTx(ctx context.Context, req *txtypes.BroadcastTxReque
 	return client.TxServiceBroadcast(ctx, s.clientCtx, req)
 }
 
+// PendingSequences implements the ServiceServer.PendingSequences RPC method.
+func (s txServer) PendingSequences(ctx context.Context, req *txtypes.PendingSequencesRequest) (*txtypes.PendingSequencesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	// The account is read from the query context state rather than through the
+	// client context, as the latter would re-enter the ABCI connection.
+	seq, err := s.accountKeeper.GetSequence(sdk.UnwrapSDKContext(ctx), addr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	pending, err := QueryPendingSequences(s.clientCtx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.PendingSequencesResponse{
+		Sequence:         seq,
+		PendingSequences: pending,
+		MissingSequences: MissingSequences(seq, pending),
+	}, nil
+}
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	ak AccountKeeper,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, interfaceRegistry, ak),
 	)
 }
 
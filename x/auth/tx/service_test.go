@@ -405,6 +405,35 @@ func (s IntegrationTestSuite) TestGetTx_GRPCGateway() {
 	}
 }
 
+func (s IntegrationTestSuite) TestPendingSequences_GRPC() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		req       *tx.PendingSequencesRequest
+		expErr    bool
+		expErrMsg string
+	}{
+		{"nil request", nil, true, "request cannot be nil"},
+		{"invalid address", &tx.PendingSequencesRequest{Address: "foo"}, true, "invalid address"},
+		{"good request", &tx.PendingSequencesRequest{Address: val.Address.String()}, false, ""},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			grpcRes, err := s.queryClient.PendingSequences(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				_, seq, err := val.ClientCtx.AccountRetriever.GetAccountNumberSequence(val.ClientCtx, val.Address)
+				s.Require().NoError(err)
+				s.Require().Equal(seq, grpcRes.Sequence)
+				s.Require().Empty(grpcRes.MissingSequences)
+			}
+		})
+	}
+}
+
 func (s IntegrationTestSuite) TestBroadcastTx_GRPC() {
 	val := s.network.Validators[0]
 	txBuilder := s.mkTxBuilder()
@@ -0,0 +1,116 @@
+package tx
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// UnknownFieldPolicy defines how the tx decoder treats the unknown proto fields
+// of the TxBody and AuthInfo of the decoded txs. The unknown fields of TxRaw
+// are always rejected.
+//
+// The policy decides which txs are valid, so all the nodes of a chain must
+// apply the same policy at a given height. Use ConfigOptions to switch policies
+// at an upgrade height, e.g. to accept the txs of clients built against newer
+// protos during a rolling upgrade of the clients.
+type UnknownFieldPolicy uint8
+
+const (
+	// UnknownFieldsAllowNonCritical accepts the unknown non-critical fields of
+	// TxBody, i.e. the ones whose field number has bit 11 set, and rejects any
+	// other unknown field. It is the default policy.
+	UnknownFieldsAllowNonCritical UnknownFieldPolicy = iota
+	// UnknownFieldsReject rejects any unknown field, critical or not.
+	UnknownFieldsReject
+	// UnknownFieldsWarn accepts any unknown field of TxBody and AuthInfo, which
+	// are ignored, and logs a warning with the first unknown field of the tx.
+	// As with non-critical fields, the txs with unknown fields can't be signed
+	// with SIGN_MODE_LEGACY_AMINO_JSON.
+	UnknownFieldsWarn
+)
+
+// String implements the fmt.Stringer interface.
+func (p UnknownFieldPolicy) String() string {
+	switch p {
+	case UnknownFieldsAllowNonCritical:
+		return "allow-non-critical"
+	case UnknownFieldsReject:
+		return "reject"
+	case UnknownFieldsWarn:
+		return "warn"
+	default:
+		return fmt.Sprintf("UnknownFieldPolicy(%d)", p)
+	}
+}
+
+// UnknownFieldPolicyUpgrade switches the unknown field policy of the txs
+// executed from a height on.
+type UnknownFieldPolicyUpgrade struct {
+	Height int64
+	Policy UnknownFieldPolicy
+}
+
+// ConfigOptions defines the options of the TxConfig returned by
+// NewTxConfigWithOptions. The zero value is the default configuration of
+// NewTxConfig.
+type ConfigOptions struct {
+	// UnknownFieldPolicy is the policy of the txs executed before the first
+	// upgrade of UnknownFieldPolicyUpgrades.
+	UnknownFieldPolicy UnknownFieldPolicy
+	// UnknownFieldPolicyUpgrades switches policies at the given heights, which
+	// must be positive and increasing.
+	UnknownFieldPolicyUpgrades []UnknownFieldPolicyUpgrade
+	// BlockHeight returns the height of the block the decoded txs are executed
+	// in, e.g. the last block height of the BaseApp plus one, to select the
+	// policy of the upgrades. If nil, e.g. for clients decoding txs, the policy
+	// of the last upgrade applies.
+	BlockHeight func() int64
+	// Logger logs the warnings of the UnknownFieldsWarn policy. If nil, the
+	// warnings are discarded.
+	Logger log.Logger
+}
+
+// validate checks that the upgrades are sorted by increasing height.
+func (o ConfigOptions) validate() error {
+	var last int64
+	for _, upgrade := range o.UnknownFieldPolicyUpgrades {
+		if upgrade.Height <= last {
+			return fmt.Errorf("unknown field policy upgrade heights must be positive and increasing, got %d after %d", upgrade.Height, last)
+		}
+		last = upgrade.Height
+	}
+
+	return nil
+}
+
+// unknownFieldPolicy returns the policy of the txs executed at the current
+// block height.
+func (o ConfigOptions) unknownFieldPolicy() UnknownFieldPolicy {
+	upgrades := o.UnknownFieldPolicyUpgrades
+	if len(upgrades) == 0 {
+		return o.UnknownFieldPolicy
+	}
+	if o.BlockHeight == nil {
+		return upgrades[len(upgrades)-1].Policy
+	}
+
+	height := o.BlockHeight()
+	policy := o.UnknownFieldPolicy
+	for _, upgrade := range upgrades {
+		if height < upgrade.Height {
+			break
+		}
+		policy = upgrade.Policy
+	}
+
+	return policy
+}
+
+func (o ConfigOptions) logger() log.Logger {
+	if o.Logger == nil {
+		return log.NewNopLogger()
+	}
+
+	return o.Logger
+}
@@ -130,7 +130,7 @@ func (acc BaseAccount) Validate() error {
 	}
 
 	if !bytes.Equal(acc.GetPubKey().Address().Bytes(), accAddr.Bytes()) {
-		return errors.New("account address and pubkey address do not match")
+		return ErrPubKeyAddressMismatch
 	}
 
 	return nil
@@ -9,15 +9,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -106,6 +111,51 @@ func (m *ModuleAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleAccount proto.InternalMessageInfo
 
+// ModuleAddressDerivation defines the derivation path of an address derived
+// from a module name and derivation keys, as registered in the module address
+// registry.
+type ModuleAddressDerivation struct {
+	// address is the derived address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// module_name is the name of the module the address is derived from.
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// derivation_keys are the keys the address is derived with, in order.
+	DerivationKeys [][]byte `protobuf:"bytes,3,rep,name=derivation_keys,json=derivationKeys,proto3" json:"derivation_keys,omitempty"`
+}
+
+func (m *ModuleAddressDerivation) Reset()         { *m = ModuleAddressDerivation{} }
+func (m *ModuleAddressDerivation) String() string { return proto.CompactTextString(m) }
+func (*ModuleAddressDerivation) ProtoMessage()    {}
+func (*ModuleAddressDerivation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{2}
+}
+func (m *ModuleAddressDerivation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleAddressDerivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleAddressDerivation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleAddressDerivation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleAddressDerivation.Merge(m, src)
+}
+func (m *ModuleAddressDerivation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleAddressDerivation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleAddressDerivation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleAddressDerivation proto.InternalMessageInfo
+
 // Params defines the parameters for the auth module.
 type Params struct {
 	MaxMemoCharacters      uint64 `protobuf:"varint,1,opt,name=max_memo_characters,json=maxMemoCharacters,proto3" json:"max_memo_characters,omitempty"`
@@ -113,12 +163,21 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// pub_key_change_cost is the gas consumed by an account public key rotation.
+	PubKeyChangeCost uint64 `protobuf:"varint,6,opt,name=pub_key_change_cost,json=pubKeyChangeCost,proto3" json:"pub_key_change_cost,omitempty"`
+	// pub_key_change_cooldown is the minimum duration between two public key
+	// rotations of an account.
+	PubKeyChangeCooldown time.Duration `protobuf:"bytes,7,opt,name=pub_key_change_cooldown,json=pubKeyChangeCooldown,proto3,stdduration" json:"pub_key_change_cooldown"`
+	// priced_fee_denoms are the non-native denoms, e.g. IBC vouchers, accepted as
+	// fees by the mempool fee check once converted into the native denoms by the
+	// fee price source of the app.
+	PricedFeeDenoms []string `protobuf:"bytes,8,rep,name=priced_fee_denoms,json=pricedFeeDenoms,proto3" json:"priced_fee_denoms,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7e1f7e915d020d2d, []int{2}
+	return fileDescriptor_7e1f7e915d020d2d, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,54 +241,143 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetPubKeyChangeCost() uint64 {
+	if m != nil {
+		return m.PubKeyChangeCost
+	}
+	return 0
+}
+
+func (m *Params) GetPubKeyChangeCooldown() time.Duration {
+	if m != nil {
+		return m.PubKeyChangeCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetPricedFeeDenoms() []string {
+	if m != nil {
+		return m.PricedFeeDenoms
+	}
+	return nil
+}
+
+// PubKeyRotation defines a public key rotation of an account. The account
+// keeps its address, account number and sequence across rotations.
+type PubKeyRotation struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// old_pub_key is the public key of the account before the rotation, if any.
+	OldPubKey *types.Any `protobuf:"bytes,2,opt,name=old_pub_key,json=oldPubKey,proto3" json:"old_pub_key,omitempty"`
+	// new_pub_key is the public key of the account after the rotation.
+	NewPubKey *types.Any `protobuf:"bytes,3,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+	// sequence is the sequence of the account at the rotation.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// height is the height of the rotation.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the rotation.
+	Time time.Time `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *PubKeyRotation) Reset()         { *m = PubKeyRotation{} }
+func (m *PubKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*PubKeyRotation) ProtoMessage()    {}
+func (*PubKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *PubKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyRotation.Merge(m, src)
+}
+func (m *PubKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyRotation proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
+	proto.RegisterType((*ModuleAddressDerivation)(nil), "cosmos.auth.v1beta1.ModuleAddressDerivation")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*PubKeyRotation)(nil), "cosmos.auth.v1beta1.PubKeyRotation")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0x8e, 0xdb, 0xfc, 0xfa, 0xe7, 0xd2, 0x56, 0xea, 0x35, 0xbf, 0xe2, 0x66, 0xb0, 0xad, 0x4a,
-	0x48, 0x41, 0x22, 0x0e, 0x09, 0x2a, 0x12, 0xdd, 0xea, 0x82, 0x50, 0x05, 0x85, 0xca, 0x11, 0x0c,
-	0x2c, 0xd6, 0xd9, 0x79, 0xeb, 0x9e, 0x9a, 0xf3, 0x19, 0xdf, 0xb9, 0x8a, 0xfb, 0x09, 0x18, 0x19,
-	0x19, 0xfb, 0x01, 0x18, 0x3b, 0x33, 0xa3, 0x4e, 0x15, 0x13, 0x53, 0x84, 0xd2, 0x01, 0xc4, 0xa7,
-	0x40, 0xb9, 0x73, 0xaa, 0x16, 0x75, 0xf2, 0xbd, 0xcf, 0xf3, 0xdc, 0xf3, 0xfe, 0xf3, 0x21, 0x2b,
-	0xe2, 0x82, 0x71, 0xd1, 0x26, 0xb9, 0x3c, 0x6a, 0x9f, 0x74, 0x42, 0x90, 0xa4, 0xa3, 0x02, 0x37,
-	0xcd, 0xb8, 0xe4, 0x78, 0x4d, 0xf3, 0xae, 0x82, 0x4a, 0xbe, 0xb1, 0xa1, 0xc1, 0x40, 0x49, 0xda,
-	0xa5, 0x42, 0x05, 0x8d, 0x7a, 0xcc, 0x63, 0xae, 0xf1, 0xc9, 0xa9, 0x44, 0x37, 0x62, 0xce, 0xe3,
-	0x01, 0xb4, 0x55, 0x14, 0xe6, 0x87, 0x6d, 0x92, 0x14, 0x9a, 0xda, 0xfc, 0x65, 0xa0, 0x9a, 0x47,
-	0x04, 0xec, 0x44, 0x11, 0xcf, 0x13, 0x89, 0xbb, 0x68, 0x9e, 0xf4, 0xfb, 0x19, 0x08, 0x61, 0x1a,
-	0x8e, 0xd1, 0x5c, 0xf4, 0xcc, 0xef, 0xe7, 0xad, 0x7a, 0x99, 0x63, 0x47, 0x33, 0x3d, 0x99, 0xd1,
-	0x24, 0xf6, 0xa7, 0x42, 0xfc, 0x02, 0xcd, 0xa7, 0x79, 0x18, 0x1c, 0x43, 0x61, 0xce, 0x38, 0x46,
-	0xb3, 0xd6, 0xad, 0xbb, 0x3a, 0xa1, 0x3b, 0x4d, 0xe8, 0xee, 0x24, 0x85, 0x67, 0xfe, 0x19, 0xd9,
-	0xf5, 0x34, 0x0f, 0x07, 0x34, 0x9a, 0x68, 0x1f, 0x72, 0x46, 0x25, 0xb0, 0x54, 0x16, 0xfe, 0x5c,
-	0x9a, 0x87, 0x2f, 0xa1, 0xc0, 0xf7, 0xd1, 0x0a, 0xd1, 0x75, 0x04, 0x49, 0xce, 0x42, 0xc8, 0xcc,
-	0x59, 0xc7, 0x68, 0x56, 0xfd, 0xe5, 0x12, 0x7d, 0xad, 0x40, 0xdc, 0x40, 0x0b, 0x02, 0x3e, 0xe4,
-	0x90, 0x44, 0x60, 0x56, 0x95, 0xe0, 0x3a, 0xde, 0x36, 0x3f, 0x9e, 0xd9, 0x95, 0xcf, 0x67, 0x76,
-	0xe5, 0xf7, 0x99, 0x5d, 0xb9, 0x38, 0x6f, 0x2d, 0x94, 0x8d, 0xed, 0x6d, 0x7e, 0x31, 0xd0, 0xf2,
-	0x3e, 0xef, 0xe7, 0x83, 0xeb, 0x5e, 0xf7, 0xd0, 0x52, 0x48, 0x04, 0x04, 0xa5, 0xbb, 0x6a, 0xb8,
-	0xd6, 0x75, 0xdc, 0x3b, 0x66, 0xee, 0xde, 0x98, 0x91, 0x57, 0xbd, 0x1c, 0xd9, 0x86, 0x5f, 0x0b,
-	0x6f, 0x8c, 0x0d, 0xa3, 0x6a, 0x42, 0x18, 0xa8, 0xfe, 0x17, 0x7d, 0x75, 0xc6, 0x0e, 0xaa, 0xa5,
-	0x90, 0x31, 0x2a, 0x04, 0xe5, 0x89, 0x30, 0x67, 0x9d, 0xd9, 0xe6, 0xa2, 0x7f, 0x13, 0xda, 0x6e,
-	0x4c, 0x8b, 0xbd, 0x38, 0x6f, 0xad, 0xdc, 0xaa, 0x6d, 0x6f, 0xf3, 0xeb, 0x0c, 0x9a, 0x3b, 0x20,
-	0x19, 0x61, 0x02, 0xbb, 0x68, 0x8d, 0x91, 0x61, 0xc0, 0x80, 0xf1, 0x20, 0x3a, 0x22, 0x19, 0x89,
-	0x24, 0x64, 0x7a, 0x3f, 0x55, 0x7f, 0x95, 0x91, 0xe1, 0x3e, 0x30, 0xbe, 0x7b, 0x4d, 0x60, 0x07,
-	0x2d, 0xc9, 0x61, 0x20, 0x68, 0x1c, 0x0c, 0x28, 0xa3, 0x52, 0x15, 0x55, 0xf5, 0x91, 0x1c, 0xf6,
-	0x68, 0xfc, 0x6a, 0x82, 0xe0, 0x47, 0xe8, 0x7f, 0xa5, 0x38, 0x85, 0x20, 0xe2, 0x42, 0x06, 0x29,
-	0x64, 0x41, 0x58, 0x48, 0x28, 0xe7, 0xbd, 0x3a, 0x91, 0x9e, 0xc2, 0x2e, 0x17, 0xf2, 0x00, 0x32,
-	0xaf, 0x90, 0x80, 0xdf, 0xa0, 0x7b, 0x13, 0xc3, 0x13, 0xc8, 0xe8, 0x61, 0xa1, 0x2f, 0x41, 0xbf,
-	0xbb, 0xb5, 0xd5, 0x79, 0xaa, 0x57, 0xe0, 0x99, 0xe3, 0x91, 0x5d, 0xef, 0xd1, 0xf8, 0x9d, 0x52,
-	0x4c, 0xae, 0x3e, 0x7f, 0xa6, 0x78, 0xbf, 0x2e, 0x6e, 0xa1, 0xfa, 0x16, 0x7e, 0x8b, 0x36, 0xfe,
-	0x35, 0x14, 0x10, 0xa5, 0xdd, 0xad, 0x27, 0xc7, 0x1d, 0xf3, 0x3f, 0x65, 0xd9, 0x18, 0x8f, 0xec,
-	0xf5, 0x5b, 0x96, 0xbd, 0xa9, 0xc2, 0x5f, 0x17, 0x77, 0xe2, 0xdb, 0x0b, 0xe5, 0xee, 0x0d, 0x6f,
-	0xf7, 0xdb, 0xd8, 0x32, 0x2e, 0xc7, 0x96, 0xf1, 0x73, 0x6c, 0x19, 0x9f, 0xae, 0xac, 0xca, 0xe5,
-	0x95, 0x55, 0xf9, 0x71, 0x65, 0x55, 0xde, 0x3f, 0x88, 0xa9, 0x3c, 0xca, 0x43, 0x37, 0xe2, 0xac,
-	0x7c, 0x3d, 0xe5, 0xa7, 0x25, 0xfa, 0xc7, 0xed, 0xa1, 0x7e, 0x8c, 0xb2, 0x48, 0x41, 0x84, 0x73,
-	0xea, 0x0f, 0x7e, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x35, 0x25, 0xef, 0x58, 0xa8, 0x03, 0x00,
+	// 865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x37, 0x21, 0x4d, 0x27, 0xdd, 0x2e, 0x75, 0x43, 0xeb, 0xe6, 0x10, 0x47, 0x91, 0x10,
+	0x05, 0x11, 0x87, 0x06, 0x15, 0x41, 0x6f, 0x75, 0x0b, 0xa8, 0x5a, 0xb6, 0x54, 0x2e, 0x70, 0xd8,
+	0x8b, 0xe5, 0x1f, 0xaf, 0xce, 0xa8, 0x19, 0x8f, 0xf1, 0x8c, 0xdb, 0x78, 0xff, 0x02, 0x8e, 0x7b,
+	0xdc, 0x0b, 0x52, 0xff, 0x00, 0x8e, 0xfd, 0x23, 0x56, 0x3d, 0x55, 0x9c, 0xf6, 0x14, 0x50, 0x7a,
+	0x00, 0x71, 0xe7, 0x8e, 0x3c, 0x33, 0xc9, 0xf6, 0x97, 0xd0, 0xaa, 0x27, 0xcf, 0x7c, 0xef, 0x9b,
+	0x6f, 0xbe, 0x37, 0xef, 0xcd, 0x18, 0xb5, 0x02, 0xca, 0x08, 0x65, 0x3d, 0x2f, 0xe3, 0x83, 0xde,
+	0xc9, 0x86, 0x0f, 0xdc, 0xdb, 0x10, 0x13, 0x2b, 0x49, 0x29, 0xa7, 0xfa, 0xb2, 0x8c, 0x5b, 0x02,
+	0x52, 0xf1, 0xe6, 0x9a, 0x04, 0x5d, 0x41, 0xe9, 0x29, 0x86, 0x98, 0x34, 0x1b, 0x11, 0x8d, 0xa8,
+	0xc4, 0x8b, 0x91, 0x42, 0xd7, 0x22, 0x4a, 0xa3, 0x21, 0xf4, 0xc4, 0xcc, 0xcf, 0x8e, 0x7a, 0x5e,
+	0x9c, 0xab, 0x50, 0xeb, 0x76, 0x28, 0xcc, 0x52, 0x8f, 0x63, 0x1a, 0xab, 0xb8, 0x79, 0x3b, 0xce,
+	0x31, 0x01, 0xc6, 0x3d, 0x92, 0x48, 0x42, 0xe7, 0x2f, 0x0d, 0xd5, 0x6d, 0x8f, 0xc1, 0x76, 0x10,
+	0xd0, 0x2c, 0xe6, 0x7a, 0x1f, 0xcd, 0x79, 0x61, 0x98, 0x02, 0x63, 0x86, 0xd6, 0xd6, 0xd6, 0xe7,
+	0x6d, 0xe3, 0xf7, 0xf3, 0x6e, 0x43, 0x99, 0xdc, 0x96, 0x91, 0x43, 0x9e, 0xe2, 0x38, 0x72, 0xa6,
+	0x44, 0xfd, 0x5b, 0x34, 0x97, 0x64, 0xbe, 0x7b, 0x0c, 0xb9, 0xf1, 0xa8, 0xad, 0xad, 0xd7, 0xfb,
+	0x0d, 0x4b, 0x6e, 0x6b, 0x4d, 0xb7, 0xb5, 0xb6, 0xe3, 0xdc, 0x36, 0xfe, 0x19, 0x9b, 0x8d, 0x24,
+	0xf3, 0x87, 0x38, 0x28, 0xb8, 0x9f, 0x52, 0x82, 0x39, 0x90, 0x84, 0xe7, 0x4e, 0x35, 0xc9, 0xfc,
+	0xa7, 0x90, 0xeb, 0x1f, 0xa2, 0x45, 0x4f, 0xfa, 0x70, 0xe3, 0x8c, 0xf8, 0x90, 0x1a, 0xe5, 0xb6,
+	0xb6, 0x5e, 0x71, 0x1e, 0x2b, 0x74, 0x5f, 0x80, 0x7a, 0x13, 0xd5, 0x18, 0xfc, 0x9c, 0x41, 0x1c,
+	0x80, 0x51, 0x11, 0x84, 0xd9, 0x7c, 0xcb, 0xf8, 0xe5, 0xcc, 0x2c, 0xbd, 0x3a, 0x33, 0x4b, 0x7f,
+	0x9f, 0x99, 0xa5, 0x8b, 0xf3, 0x6e, 0x4d, 0x25, 0xb6, 0xd7, 0xf9, 0x4d, 0x43, 0x8f, 0x9f, 0xd1,
+	0x30, 0x1b, 0xce, 0x72, 0xdd, 0x43, 0x0b, 0xbe, 0xc7, 0xc0, 0x55, 0xea, 0x22, 0xe1, 0x7a, 0xbf,
+	0x6d, 0xdd, 0x53, 0x34, 0xeb, 0xda, 0x19, 0xd9, 0x95, 0xcb, 0xb1, 0xa9, 0x39, 0x75, 0xff, 0xda,
+	0xb1, 0xe9, 0xa8, 0x12, 0x7b, 0x04, 0x44, 0xfe, 0xf3, 0x8e, 0x18, 0xeb, 0x6d, 0x54, 0x4f, 0x20,
+	0x25, 0x98, 0x31, 0x4c, 0x63, 0x66, 0x94, 0xdb, 0xe5, 0xf5, 0x79, 0xe7, 0x3a, 0xb4, 0xd5, 0x9c,
+	0x9a, 0xbd, 0x38, 0xef, 0x2e, 0xde, 0xf0, 0xb6, 0xd7, 0xf9, 0x55, 0x43, 0xab, 0x0a, 0x92, 0xc7,
+	0xbc, 0x0b, 0x29, 0x3e, 0x11, 0xb5, 0x7d, 0x50, 0x91, 0x4c, 0x54, 0x27, 0x42, 0xce, 0xbd, 0x66,
+	0x14, 0x49, 0x68, 0xbf, 0xb0, 0xfb, 0x11, 0x7a, 0x12, 0xce, 0xb6, 0x28, 0x0a, 0x24, 0x2d, 0x2f,
+	0x38, 0x8b, 0x6f, 0xe1, 0xa7, 0x90, 0xb3, 0xad, 0x4a, 0xe1, 0xba, 0xf3, 0x6f, 0x19, 0x55, 0x0f,
+	0xbc, 0xd4, 0x23, 0x4c, 0xb7, 0xd0, 0x32, 0xf1, 0x46, 0x2e, 0x01, 0x42, 0xdd, 0x60, 0xe0, 0xa5,
+	0x5e, 0xc0, 0x21, 0x95, 0xd6, 0x2a, 0xce, 0x12, 0xf1, 0x46, 0xcf, 0x80, 0xd0, 0x9d, 0x59, 0x40,
+	0x6f, 0xa3, 0x05, 0x3e, 0x72, 0x19, 0x8e, 0xdc, 0x21, 0x26, 0x98, 0x0b, 0x2f, 0x15, 0x07, 0xf1,
+	0xd1, 0x21, 0x8e, 0xbe, 0x2b, 0x10, 0xfd, 0x33, 0xf4, 0x81, 0x60, 0xbc, 0x00, 0x37, 0xa0, 0x8c,
+	0xbb, 0x09, 0xa4, 0xae, 0x9f, 0x73, 0x50, 0xfd, 0xb0, 0x54, 0x50, 0x5f, 0xc0, 0x0e, 0x65, 0xfc,
+	0x00, 0x52, 0x3b, 0xe7, 0xa0, 0x7f, 0x8f, 0x56, 0x0b, 0xc1, 0x13, 0x48, 0xf1, 0x51, 0x2e, 0x17,
+	0x41, 0xd8, 0xdf, 0xdc, 0xdc, 0xf8, 0x4a, 0xb6, 0x88, 0x6d, 0x4c, 0xc6, 0x66, 0xe3, 0x10, 0x47,
+	0x3f, 0x09, 0x46, 0xb1, 0xf4, 0xeb, 0x5d, 0x11, 0x77, 0x1a, 0xec, 0x06, 0x2a, 0x57, 0xe9, 0x3f,
+	0xa2, 0xb5, 0xdb, 0x82, 0x0c, 0x82, 0xa4, 0xbf, 0xf9, 0xc5, 0xf1, 0x86, 0xf1, 0x9e, 0x90, 0x6c,
+	0x4e, 0xc6, 0xe6, 0xca, 0x0d, 0xc9, 0xc3, 0x29, 0xc3, 0x59, 0x61, 0xf7, 0xe2, 0x7a, 0x17, 0x2d,
+	0xab, 0xbb, 0x52, 0x1c, 0x55, 0x1c, 0xc9, 0x04, 0x8d, 0xaa, 0xc8, 0xeb, 0x7d, 0x79, 0x0f, 0x76,
+	0x44, 0xa0, 0x58, 0xa7, 0x3f, 0x47, 0xab, 0x77, 0xe8, 0x74, 0x18, 0xd2, 0xd3, 0xd8, 0x98, 0x13,
+	0xdd, 0xba, 0x76, 0xe7, 0xaa, 0xed, 0xaa, 0x17, 0xc0, 0xae, 0xbd, 0x1e, 0x9b, 0xa5, 0x57, 0x7f,
+	0x98, 0x9a, 0xd3, 0xb8, 0xa9, 0x2b, 0x05, 0xf4, 0x4f, 0xd0, 0x52, 0x92, 0xe2, 0x00, 0x42, 0xf7,
+	0x08, 0xc0, 0x0d, 0x21, 0xa6, 0x84, 0x19, 0x35, 0xd1, 0xa5, 0x4f, 0x64, 0xe0, 0x1b, 0x80, 0x5d,
+	0x01, 0x6f, 0xd5, 0xd4, 0x95, 0xd2, 0x3a, 0x6f, 0x1e, 0xa1, 0xc5, 0x03, 0x21, 0xe7, 0x50, 0xfe,
+	0xf0, 0x76, 0xdc, 0x47, 0x75, 0x3a, 0x0c, 0xdd, 0x77, 0x7a, 0x37, 0x2e, 0xde, 0xaa, 0x05, 0x69,
+	0x9e, 0x70, 0x6a, 0x29, 0x03, 0xf3, 0x74, 0x18, 0xca, 0x61, 0xa1, 0x17, 0xc3, 0xe9, 0x4c, 0xaf,
+	0xfc, 0x30, 0xbd, 0x18, 0x4e, 0x95, 0xde, 0xff, 0xbc, 0x31, 0xfa, 0x0a, 0xaa, 0x0e, 0x00, 0x47,
+	0x03, 0x2e, 0xfa, 0xa0, 0xec, 0xa8, 0x99, 0xfe, 0x25, 0xaa, 0x14, 0xcf, 0xab, 0x28, 0x66, 0xbd,
+	0xdf, 0xbc, 0xb3, 0xf9, 0x0f, 0xd3, 0xb7, 0x57, 0x96, 0xe6, 0x65, 0x51, 0x1a, 0xb1, 0x42, 0x5e,
+	0x29, 0x7b, 0xe7, 0xf5, 0xa4, 0xa5, 0x5d, 0x4e, 0x5a, 0xda, 0x9f, 0x93, 0x96, 0xf6, 0xf2, 0xaa,
+	0x55, 0xba, 0xbc, 0x6a, 0x95, 0xde, 0x5c, 0xb5, 0x4a, 0xcf, 0x3f, 0x8e, 0x30, 0x1f, 0x64, 0xbe,
+	0x15, 0x50, 0xa2, 0x7e, 0x18, 0xea, 0xd3, 0x65, 0xe1, 0x71, 0x6f, 0x24, 0xff, 0x3f, 0x3c, 0x4f,
+	0x80, 0xf9, 0x55, 0xb1, 0xdd, 0xe7, 0xff, 0x0d, 0x00, 0xe9, 0x8a, 0xf7, 0x2f, 0x9b, 0x06, 0x00,
 	0x00,
 }
 
@@ -267,6 +415,20 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.PubKeyChangeCost != that1.PubKeyChangeCost {
+		return false
+	}
+	if this.PubKeyChangeCooldown != that1.PubKeyChangeCooldown {
+		return false
+	}
+	if len(this.PricedFeeDenoms) != len(that1.PricedFeeDenoms) {
+		return false
+	}
+	for i := range this.PricedFeeDenoms {
+		if this.PricedFeeDenoms[i] != that1.PricedFeeDenoms[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -372,6 +534,52 @@ func (m *ModuleAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ModuleAddressDerivation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAddressDerivation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAddressDerivation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DerivationKeys) > 0 {
+		for iNdEx := len(m.DerivationKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DerivationKeys[iNdEx])
+			copy(dAtA[i:], m.DerivationKeys[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.DerivationKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -392,6 +600,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PricedFeeDenoms) > 0 {
+		for iNdEx := len(m.PricedFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PricedFeeDenoms[iNdEx])
+			copy(dAtA[i:], m.PricedFeeDenoms[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.PricedFeeDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PubKeyChangeCooldown, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PubKeyChangeCooldown):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuth(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	if m.PubKeyChangeCost != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.PubKeyChangeCost))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -420,6 +650,78 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PubKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuth(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x32
+	if m.Height != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewPubKey != nil {
+		{
+			size, err := m.NewPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldPubKey != nil {
+		{
+			size, err := m.OldPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -477,6 +779,29 @@ func (m *ModuleAccount) Size() (n int) {
 	return n
 }
 
+func (m *ModuleAddressDerivation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.DerivationKeys) > 0 {
+		for _, b := range m.DerivationKeys {
+			l = len(b)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -498,6 +823,46 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.PubKeyChangeCost != 0 {
+		n += 1 + sovAuth(uint64(m.PubKeyChangeCost))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PubKeyChangeCooldown)
+	n += 1 + l + sovAuth(uint64(l))
+	if len(m.PricedFeeDenoms) > 0 {
+		for _, s := range m.PricedFeeDenoms {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PubKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.OldPubKey != nil {
+		l = m.OldPubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.NewPubKey != nil {
+		l = m.NewPubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovAuth(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAuth(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAuth(uint64(l))
 	return n
 }
 
@@ -813,7 +1178,7 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ModuleAddressDerivation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -836,17 +1201,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModuleAddressDerivation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModuleAddressDerivation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.MaxMemoCharacters = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -856,16 +1221,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
-			m.TxSigLimit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -875,16 +1253,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxSigLimit |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKeys", wireType)
 			}
-			m.TxSizeCostPerByte = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -894,13 +1285,133 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivationKeys = append(m.DerivationKeys, make([]byte, postIndex-iNdEx))
+			copy(m.DerivationKeys[len(m.DerivationKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			}
+			m.MaxMemoCharacters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+			}
+			m.TxSigLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSigLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			}
+			m.TxSizeCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
 			}
 			m.SigVerifyCostED25519 = 0
@@ -937,6 +1448,315 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyChangeCost", wireType)
+			}
+			m.PubKeyChangeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubKeyChangeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyChangeCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PubKeyChangeCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricedFeeDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PricedFeeDenoms = append(m.PricedFeeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldPubKey == nil {
+				m.OldPubKey = &types.Any{}
+			}
+			if err := m.OldPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubKey == nil {
+				m.NewPubKey = &types.Any{}
+			}
+			if err := m.NewPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewModuleAddressDerivation returns the derivation of the address derived
+// from the given module name and derivation keys.
+func NewModuleAddressDerivation(moduleName string, derivationKeys ...[]byte) ModuleAddressDerivation {
+	return ModuleAddressDerivation{
+		Address:        sdk.AccAddress(address.Module(moduleName, derivationKeys...)).String(),
+		ModuleName:     moduleName,
+		DerivationKeys: derivationKeys,
+	}
+}
+
+// GetAddress returns the derived address.
+func (d ModuleAddressDerivation) GetAddress() sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(d.Address)
+	return addr
+}
+
+// HasPath returns true if the derivation has the given module name and
+// derivation keys.
+func (d ModuleAddressDerivation) HasPath(moduleName string, derivationKeys [][]byte) bool {
+	if d.ModuleName != moduleName || len(d.DerivationKeys) != len(derivationKeys) {
+		return false
+	}
+
+	for i, k := range d.DerivationKeys {
+		if !bytes.Equal(k, derivationKeys[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Validate checks that the derivation has a module name and at least one
+// derivation key, and that its address is derived from them.
+func (d ModuleAddressDerivation) Validate() error {
+	if strings.TrimSpace(d.ModuleName) == "" {
+		return sdkerrors.Wrap(ErrInvalidDerivation, "module name cannot be blank")
+	}
+	if len(d.DerivationKeys) == 0 {
+		return sdkerrors.Wrap(ErrInvalidDerivation, "at least one derivation key is required")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(d.Address)
+	if err != nil {
+		return err
+	}
+	if expected := sdk.AccAddress(address.Module(d.ModuleName, d.DerivationKeys...)); !addr.Equals(expected) {
+		return sdkerrors.Wrapf(ErrInvalidDerivation, "address %s isn't derived from its path, expected %s", addr, expected)
+	}
+
+	return nil
+}
+
+// ValidateModuleAddressDerivations validates the given derivations and checks
+// for duplicated addresses.
+func ValidateModuleAddressDerivations(derivations []ModuleAddressDerivation) error {
+	seen := make(map[string]bool, len(derivations))
+	for i, d := range derivations {
+		if err := d.Validate(); err != nil {
+			return sdkerrors.WrapPath(err, i)
+		}
+		if seen[d.Address] {
+			return sdkerrors.WrapPath(fmt.Errorf("duplicate module address derivation found in genesis state; address: %s", d.Address), i)
+		}
+		seen[d.Address] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/auth module sentinel errors
+var (
+	ErrModuleAddressCollision = sdkerrors.Register(ModuleName, 2, "module address collision")
+	ErrInvalidDerivation      = sdkerrors.Register(ModuleName, 3, "invalid module address derivation")
+	ErrPubKeyAddressMismatch  = sdkerrors.Register(ModuleName, 4, "account address and pubkey address do not match")
+	ErrPubKeyChangeCooldown   = sdkerrors.Register(ModuleName, 5, "account public key rotated too recently")
+)
@@ -0,0 +1,11 @@
+package types
+
+// auth module event types
+const (
+	EventTypeChangePubKey = "change_pub_key"
+
+	AttributeKeyAddress    = "address"
+	AttributeKeyPubKey     = "pub_key"
+	AttributeKeySequence   = "sequence"
+	AttributeValueCategory = ModuleName
+)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
@@ -17,6 +19,16 @@ var _ types.UnpackInterfacesMessage = GenesisState{}
 // RandomGenesisAccountsFn defines the function required to generate custom account types
 type RandomGenesisAccountsFn func(simState *module.SimulationState) GenesisAccounts
 
+// RandomGenesisAccountsProvider is a proto message generating the genesis
+// accounts of the simulations, e.g. with a custom distribution of account
+// types. Its implementations are registered in the interface registry, so that
+// a provider can be resolved from an Any, e.g. from the simulation params.
+type RandomGenesisAccountsProvider interface {
+	proto.Message
+
+	RandomGenesisAccounts(simState *module.SimulationState) GenesisAccounts
+}
+
 // NewGenesisState - Create a new genesis state
 func NewGenesisState(params Params, accounts GenesisAccounts) *GenesisState {
 	genAccounts, err := PackAccounts(accounts)
@@ -38,6 +50,11 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, rotation := range g.PubKeyRotations {
+		if err := rotation.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -62,15 +79,28 @@ func GetGenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMe
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
-		return err
+		return sdkerrors.WrapPath(err, "params")
 	}
 
 	genAccs, err := UnpackAccounts(data.Accounts)
 	if err != nil {
-		return err
+		return sdkerrors.WrapPath(err, "accounts")
+	}
+
+	// the public keys of the rotated accounts don't match their addresses
+	rotated := make(map[string]bool, len(data.PubKeyRotations))
+	for i, rotation := range data.PubKeyRotations {
+		if err := rotation.Validate(); err != nil {
+			return sdkerrors.WrapPath(fmt.Errorf("invalid pub key rotation found in genesis state; address: %s, error: %s", rotation.Address, err.Error()), "pub_key_rotations", i)
+		}
+		rotated[rotation.Address] = true
+	}
+
+	if err := validateGenAccounts(genAccs, rotated); err != nil {
+		return sdkerrors.WrapPath(err, "accounts")
 	}
 
-	return ValidateGenAccounts(genAccs)
+	return sdkerrors.WrapPath(ValidateModuleAddressDerivations(data.ModuleAddressDerivations), "module_address_derivations")
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -82,22 +112,30 @@ func SanitizeGenesisAccounts(genAccs GenesisAccounts) GenesisAccounts {
 	return genAccs
 }
 
-// ValidateGenAccounts validates an array of GenesisAccounts and checks for duplicates
+// ValidateGenAccounts validates an array of GenesisAccounts and checks for
+// duplicates. The errors are wrapped in a PathError of the index of the account.
 func ValidateGenAccounts(accounts GenesisAccounts) error {
+	return validateGenAccounts(accounts, nil)
+}
+
+// validateGenAccounts validates an array of GenesisAccounts and checks for
+// duplicates, accepting public keys not matching the address of the given
+// rotated accounts.
+func validateGenAccounts(accounts GenesisAccounts, rotated map[string]bool) error {
 	addrMap := make(map[string]bool, len(accounts))
 
-	for _, acc := range accounts {
+	for i, acc := range accounts {
 		// check for duplicated accounts
 		addrStr := acc.GetAddress().String()
 		if _, ok := addrMap[addrStr]; ok {
-			return fmt.Errorf("duplicate account found in genesis state; address: %s", addrStr)
+			return sdkerrors.WrapPath(fmt.Errorf("duplicate account found in genesis state; address: %s", addrStr), i)
 		}
 
 		addrMap[addrStr] = true
 
 		// check account specific validation
-		if err := acc.Validate(); err != nil {
-			return fmt.Errorf("invalid account found in genesis state; address: %s, error: %s", addrStr, err.Error())
+		if err := acc.Validate(); err != nil && !(rotated[addrStr] && errors.Is(err, ErrPubKeyAddressMismatch)) {
+			return sdkerrors.WrapPath(fmt.Errorf("invalid account found in genesis state; address: %s, error: %s", addrStr, err.Error()), i)
 		}
 	}
 	return nil
@@ -147,7 +185,7 @@ func UnpackAccounts(accountsAny []*types.Any) (GenesisAccounts, error) {
 	for i, any := range accountsAny {
 		acc, ok := any.GetCachedValue().(GenesisAccount)
 		if !ok {
-			return nil, fmt.Errorf("expected genesis account")
+			return nil, sdkerrors.WrapPath(fmt.Errorf("expected genesis account"), i)
 		}
 		accounts[i] = acc
 	}
@@ -30,6 +30,10 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// module_address_derivations are the registered module address derivations.
+	ModuleAddressDerivations []ModuleAddressDerivation `protobuf:"bytes,3,rep,name=module_address_derivations,json=moduleAddressDerivations,proto3" json:"module_address_derivations"`
+	// pub_key_rotations are the public key rotations of the accounts.
+	PubKeyRotations []PubKeyRotation `protobuf:"bytes,4,rep,name=pub_key_rotations,json=pubKeyRotations,proto3" json:"pub_key_rotations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +83,20 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetModuleAddressDerivations() []ModuleAddressDerivation {
+	if m != nil {
+		return m.ModuleAddressDerivations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPubKeyRotations() []PubKeyRotation {
+	if m != nil {
+		return m.PubKeyRotations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -86,23 +104,29 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x82, 0xe5, 0x95,
-	0xaa, 0xb9, 0x78, 0xdc, 0x21, 0xd6, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x72, 0xb1, 0x15,
-	0x24, 0x16, 0x25, 0xe6, 0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x49, 0xeb, 0x61, 0x71,
-	0x86, 0x5e, 0x00, 0x58, 0x89, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x0d, 0x42, 0x06,
-	0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc,
-	0x46, 0x22, 0x7a, 0x10, 0xe7, 0xea, 0xc1, 0x9c, 0xab, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57, 0xe5,
-	0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99, 0x25,
-	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x1f, 0x40, 0x28, 0xdd, 0xe2, 0x94, 0x6c,
-	0xfd, 0x0a, 0x88, 0x77, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xac, 0xe2, 0xe7, 0xb6, 0x53, 0x01, 0x00, 0x00,
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4a, 0xfb, 0x40,
+	0x10, 0xc6, 0x93, 0xb6, 0x94, 0x3f, 0xe9, 0x1f, 0xc4, 0xd8, 0x43, 0xac, 0x10, 0xab, 0x5e, 0x2a,
+	0xe8, 0xae, 0xad, 0x27, 0x8f, 0xad, 0x82, 0x07, 0x11, 0xa4, 0xe2, 0xc5, 0x4b, 0xd8, 0x24, 0x6b,
+	0x1a, 0xda, 0x64, 0x42, 0x66, 0xb7, 0x98, 0xb7, 0xf0, 0xa1, 0x3c, 0xf4, 0xd8, 0xa3, 0x27, 0x91,
+	0xf6, 0x45, 0xa4, 0xbb, 0xb1, 0x28, 0xe4, 0x94, 0x61, 0xe6, 0xf7, 0xcd, 0xf7, 0x65, 0xc7, 0x3a,
+	0x0a, 0x00, 0x13, 0x40, 0xca, 0xa4, 0x98, 0xd0, 0x79, 0xdf, 0xe7, 0x82, 0xf5, 0x69, 0xc4, 0x53,
+	0x8e, 0x31, 0x92, 0x2c, 0x07, 0x01, 0xf6, 0x9e, 0x46, 0xc8, 0x06, 0x21, 0x25, 0xd2, 0xd9, 0x8f,
+	0x00, 0xa2, 0x19, 0xa7, 0x0a, 0xf1, 0xe5, 0x0b, 0x65, 0x69, 0xa1, 0xf9, 0x4e, 0x3b, 0x82, 0x08,
+	0x54, 0x49, 0x37, 0x55, 0xd9, 0x75, 0xab, 0x8c, 0xd4, 0x4a, 0x35, 0x3f, 0x7e, 0xaf, 0x59, 0xff,
+	0x6f, 0xb5, 0xef, 0xa3, 0x60, 0x82, 0xdb, 0x57, 0x56, 0x33, 0x63, 0x39, 0x4b, 0xd0, 0x31, 0xbb,
+	0x66, 0xaf, 0x35, 0x38, 0x20, 0x15, 0x39, 0xc8, 0x83, 0x42, 0x46, 0x8d, 0xc5, 0xe7, 0xa1, 0x31,
+	0x2e, 0x05, 0xf6, 0x85, 0xf5, 0x8f, 0x05, 0x01, 0xc8, 0x54, 0xa0, 0x53, 0xeb, 0xd6, 0x7b, 0xad,
+	0x41, 0x9b, 0xe8, 0xbc, 0xe4, 0x27, 0x2f, 0x19, 0xa6, 0xc5, 0x78, 0x4b, 0xd9, 0x99, 0xd5, 0x49,
+	0x20, 0x94, 0x33, 0xee, 0xb1, 0x30, 0xcc, 0x39, 0xa2, 0x17, 0xf2, 0x3c, 0x9e, 0x33, 0x11, 0x43,
+	0x8a, 0x4e, 0x5d, 0xed, 0x38, 0xab, 0x0c, 0x70, 0xaf, 0x64, 0x43, 0xad, 0xba, 0xd9, 0x8a, 0xca,
+	0x44, 0x4e, 0x52, 0x3d, 0x46, 0xfb, 0xc9, 0xda, 0xcd, 0xa4, 0xef, 0x4d, 0x79, 0xe1, 0xe5, 0x20,
+	0x4a, 0xa3, 0x86, 0x32, 0x3a, 0xa9, 0xfe, 0x53, 0xe9, 0xdf, 0xf1, 0x62, 0x0c, 0xe2, 0xf7, 0xfe,
+	0x9d, 0xec, 0x4f, 0x17, 0x47, 0xd7, 0x8b, 0x95, 0x6b, 0x2e, 0x57, 0xae, 0xf9, 0xb5, 0x72, 0xcd,
+	0xb7, 0xb5, 0x6b, 0x2c, 0xd7, 0xae, 0xf1, 0xb1, 0x76, 0x8d, 0xe7, 0xd3, 0x28, 0x16, 0x13, 0xe9,
+	0x93, 0x00, 0x12, 0x5a, 0xde, 0x42, 0x7f, 0xce, 0x31, 0x9c, 0xd2, 0x57, 0x7d, 0x18, 0x51, 0x64,
+	0x1c, 0xfd, 0xa6, 0x7a, 0xa5, 0xcb, 0xef, 0x01, 0x00, 0x03, 0x18, 0x97, 0x76, 0x1d, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +149,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PubKeyRotations) > 0 {
+		for iNdEx := len(m.PubKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ModuleAddressDerivations) > 0 {
+		for iNdEx := len(m.ModuleAddressDerivations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleAddressDerivations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,6 +229,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ModuleAddressDerivations) > 0 {
+		for _, e := range m.ModuleAddressDerivations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PubKeyRotations) > 0 {
+		for _, e := range m.PubKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -282,6 +346,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddressDerivations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddressDerivations = append(m.ModuleAddressDerivations, ModuleAddressDerivation{})
+			if err := m.ModuleAddressDerivations[len(m.ModuleAddressDerivations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyRotations = append(m.PubKeyRotations, PubKeyRotation{})
+			if err := m.PubKeyRotations[len(m.PubKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	proto "github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
@@ -50,6 +51,41 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateGenesisRotatedPubKey(t *testing.T) {
+	acc := types.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
+	require.NoError(t, acc.SetPubKey(pk2))
+
+	genAccs, err := types.PackAccounts(types.GenesisAccounts{acc})
+	require.NoError(t, err)
+	genState := types.GenesisState{Params: types.DefaultParams(), Accounts: genAccs}
+
+	// the pubkey of the account doesn't match its address
+	require.Error(t, types.ValidateGenesis(genState))
+
+	// unless the pubkey of the account was rotated
+	rotation, err := types.NewPubKeyRotation(sdk.AccAddress(addr1), pk1, pk2, 0, 1, time.Now())
+	require.NoError(t, err)
+	genState.PubKeyRotations = []types.PubKeyRotation{rotation}
+	require.NoError(t, types.ValidateGenesis(genState))
+}
+
+func TestValidateModuleAddressDerivations(t *testing.T) {
+	d1 := types.NewModuleAddressDerivation("escrow", []byte{1})
+	d2 := types.NewModuleAddressDerivation("escrow", []byte{1}, []byte{2})
+	require.NoError(t, types.ValidateModuleAddressDerivations([]types.ModuleAddressDerivation{d1, d2}))
+
+	// duplicated derivations
+	require.Error(t, types.ValidateModuleAddressDerivations([]types.ModuleAddressDerivation{d1, d1}))
+
+	// no derivation keys
+	require.Error(t, types.ValidateModuleAddressDerivations([]types.ModuleAddressDerivation{types.NewModuleAddressDerivation("escrow")}))
+
+	// address not derived from the path
+	invalid := d1
+	invalid.Address = d2.Address
+	require.Error(t, types.ValidateModuleAddressDerivations([]types.ModuleAddressDerivation{invalid}))
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	acc1 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
 	acc2 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr2))
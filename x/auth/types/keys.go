@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -16,12 +17,23 @@ const (
 
 	// QuerierRoute is the querier route for auth
 	QuerierRoute = ModuleName
+
+	// RouterKey is the message route for auth
+	RouterKey = ModuleName
 )
 
 var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
+	// ModuleAddressDerivationKeyPrefix prefix for the module address registry,
+	// indexing the derivations of the registered module addresses by address
+	ModuleAddressDerivationKeyPrefix = []byte{0x02}
+
+	// PubKeyRotationKeyPrefix prefix for the public key rotations of the
+	// accounts, indexed by address and rotation index
+	PubKeyRotationKeyPrefix = []byte{0x03}
+
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
 )
@@ -30,3 +42,20 @@ var (
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// ModuleAddressDerivationKey returns the key of the derivation of a registered
+// module address
+func ModuleAddressDerivationKey(addr sdk.AccAddress) []byte {
+	return append(ModuleAddressDerivationKeyPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// PubKeyRotationsPrefixKey returns the key prefix of the public key rotations
+// of an account
+func PubKeyRotationsPrefixKey(addr sdk.AccAddress) []byte {
+	return append(PubKeyRotationKeyPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// PubKeyRotationKey returns the key of a public key rotation of an account
+func PubKeyRotationKey(addr sdk.AccAddress, index uint64) []byte {
+	return append(PubKeyRotationsPrefixKey(addr), sdk.Uint64ToBigEndian(index)...)
+}
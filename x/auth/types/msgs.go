@@ -0,0 +1,150 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// auth message types
+const (
+	TypeMsgChangePubKey = "change_pub_key"
+	TypeMsgUpdateParams = "update_params"
+)
+
+var (
+	_ sdk.Msg                            = &MsgChangePubKey{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgChangePubKey)(nil)
+)
+
+// NewMsgChangePubKey creates a new MsgChangePubKey instance
+//nolint:interfacer
+func NewMsgChangePubKey(addr sdk.AccAddress, pubKey cryptotypes.PubKey) (*MsgChangePubKey, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return &MsgChangePubKey{
+		Address: addr.String(),
+		PubKey:  pkAny,
+	}, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgChangePubKey) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgChangePubKey) Type() string { return TypeMsgChangePubKey }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgChangePubKey) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgChangePubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgChangePubKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if msg.PubKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "empty public key")
+	}
+	pubKey, ok := msg.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "expecting cryptotypes.PubKey, got %T", msg.PubKey.GetCachedValue())
+	}
+
+	return validateAccountPubKey(pubKey)
+}
+
+// validateAccountPubKey rejects the public keys whose signatures aren't
+// accepted by the signature verification of the txs, e.g. ed25519 keys, as
+// the account could no longer sign any tx once rotated to such a key.
+func validateAccountPubKey(pubKey cryptotypes.PubKey) error {
+	switch pubKey := pubKey.(type) {
+	case *secp256k1.PubKey, *secp256r1.PubKey:
+		return nil
+
+	case multisig.PubKey:
+		for _, pk := range pubKey.GetPubKeys() {
+			if err := validateAccountPubKey(pk); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "unsupported public key type: %T", pubKey)
+	}
+}
+
+// GetPubKey returns the new public key of the account.
+func (msg MsgChangePubKey) GetPubKey() cryptotypes.PubKey {
+	if msg.PubKey == nil {
+		return nil
+	}
+
+	pk, _ := msg.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	return pk
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgChangePubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.PubKey, &pubKey)
+}
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid params: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMsgChangePubKey(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, pub, _ := testdata.KeyTestPubAddr()
+
+	msg, err := types.NewMsgChangePubKey(addr, pub)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	require.True(t, pub.Equals(msg.GetPubKey()))
+
+	noPubKey, err := types.NewMsgChangePubKey(addr, nil)
+	require.NoError(t, err)
+	require.Error(t, noPubKey.ValidateBasic())
+	require.Nil(t, noPubKey.GetPubKey())
+
+	invalidAddr := *msg
+	invalidAddr.Address = "invalid"
+	require.Error(t, invalidAddr.ValidateBasic())
+
+	notPubKey, err := codectypes.NewAnyWithValue(&types.Params{})
+	require.NoError(t, err)
+	invalidPubKey := *msg
+	invalidPubKey.PubKey = notPubKey
+	require.Error(t, invalidPubKey.ValidateBasic())
+
+	// the key must be able to sign txs
+	edKey, err := types.NewMsgChangePubKey(addr, ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	require.ErrorIs(t, edKey.ValidateBasic(), sdkerrors.ErrInvalidPubKey)
+
+	multisigKey, err := types.NewMsgChangePubKey(addr, kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{pub, secp256k1.GenPrivKey().PubKey()}))
+	require.NoError(t, err)
+	require.NoError(t, multisigKey.ValidateBasic())
+
+	edMultisigKey, err := types.NewMsgChangePubKey(addr, kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{pub, ed25519.GenPrivKey().PubKey()}))
+	require.NoError(t, err)
+	require.ErrorIs(t, edMultisigKey.ValidateBasic(), sdkerrors.ErrInvalidPubKey)
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	msg := types.NewMsgUpdateParams(addr.String(), types.DefaultParams())
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+
+	invalidAddr := *msg
+	invalidAddr.Authority = "invalid"
+	require.Error(t, invalidAddr.ValidateBasic())
+
+	invalidParams := *msg
+	invalidParams.Params.TxSigLimit = 0
+	require.Error(t, invalidParams.ValidateBasic())
+}
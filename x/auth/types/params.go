@@ -2,9 +2,11 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"sigs.k8s.io/yaml"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -15,8 +17,13 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultPubKeyChangeCost       uint64 = 10000
 )
 
+// DefaultPubKeyChangeCooldown is the default minimum duration between two
+// public key rotations of an account.
+var DefaultPubKeyChangeCooldown = 24 * time.Hour
+
 // Parameter keys
 var (
 	KeyMaxMemoCharacters      = []byte("MaxMemoCharacters")
@@ -24,6 +31,9 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyPubKeyChangeCost       = []byte("PubKeyChangeCost")
+	KeyPubKeyChangeCooldown   = []byte("PubKeyChangeCooldown")
+	KeyPricedFeeDenoms        = []byte("PricedFeeDenoms")
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -31,6 +41,7 @@ var _ paramtypes.ParamSet = &Params{}
 // NewParams creates a new Params object
 func NewParams(
 	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64,
+	pubKeyChangeCost uint64, pubKeyChangeCooldown time.Duration,
 ) Params {
 	return Params{
 		MaxMemoCharacters:      maxMemoCharacters,
@@ -38,6 +49,8 @@ func NewParams(
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		PubKeyChangeCost:       pubKeyChangeCost,
+		PubKeyChangeCooldown:   pubKeyChangeCooldown,
 	}
 }
 
@@ -55,6 +68,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
 		paramtypes.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
 		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
+		paramtypes.NewParamSetPair(KeyPubKeyChangeCost, &p.PubKeyChangeCost, validatePubKeyChangeCost),
+		paramtypes.NewParamSetPair(KeyPubKeyChangeCooldown, &p.PubKeyChangeCooldown, validatePubKeyChangeCooldown),
+		paramtypes.NewParamSetPair(KeyPricedFeeDenoms, &p.PricedFeeDenoms, validatePricedFeeDenoms),
 	}
 }
 
@@ -66,6 +82,8 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		PubKeyChangeCost:       DefaultPubKeyChangeCost,
+		PubKeyChangeCooldown:   DefaultPubKeyChangeCooldown,
 	}
 }
 
@@ -150,6 +168,59 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validatePubKeyChangeCost(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePubKeyChangeCooldown(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("pub key change cooldown cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validatePricedFeeDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid priced fee denom: %w", err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate priced fee denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// IsPricedFeeDenom returns whether fees in the given denom are converted into
+// the native denoms by the fee price source of the app.
+func (p Params) IsPricedFeeDenom(denom string) bool {
+	for _, d := range p.PricedFeeDenoms {
+		if d == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -167,6 +238,15 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validatePubKeyChangeCost(p.PubKeyChangeCost); err != nil {
+		return err
+	}
+	if err := validatePubKeyChangeCooldown(p.PubKeyChangeCooldown); err != nil {
+		return err
+	}
+	if err := validatePricedFeeDenoms(p.PricedFeeDenoms); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -3,6 +3,7 @@ package types_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -26,15 +27,22 @@ func TestParams_Validate(t *testing.T) {
 	}{
 		{"default params", types.DefaultParams(), nil},
 		{"invalid tx signature limit", types.NewParams(types.DefaultMaxMemoCharacters, 0, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx signature limit: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultPubKeyChangeCost, types.DefaultPubKeyChangeCooldown), fmt.Errorf("invalid tx signature limit: 0")},
 		{"invalid ED25519 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			0, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid ED25519 signature verification cost: 0")},
+			0, types.DefaultSigVerifyCostSecp256k1, types.DefaultPubKeyChangeCost, types.DefaultPubKeyChangeCooldown), fmt.Errorf("invalid ED25519 signature verification cost: 0")},
 		{"invalid SECK256k1 signature verification cost", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, 0), fmt.Errorf("invalid SECK256k1 signature verification cost: 0")},
+			types.DefaultSigVerifyCostED25519, 0, types.DefaultPubKeyChangeCost, types.DefaultPubKeyChangeCooldown), fmt.Errorf("invalid SECK256k1 signature verification cost: 0")},
 		{"invalid max memo characters", types.NewParams(0, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid max memo characters: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultPubKeyChangeCost, types.DefaultPubKeyChangeCooldown), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
-			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx size cost per byte: 0")},
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultPubKeyChangeCost, types.DefaultPubKeyChangeCooldown), fmt.Errorf("invalid tx size cost per byte: 0")},
+		{"negative pub key change cooldown", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte,
+			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultPubKeyChangeCost, -time.Hour), fmt.Errorf("pub key change cooldown cannot be negative: -1h0m0s")},
+		{"duplicate priced fee denom", types.Params{
+			MaxMemoCharacters: types.DefaultMaxMemoCharacters, TxSigLimit: types.DefaultTxSigLimit, TxSizeCostPerByte: types.DefaultTxSizeCostPerByte,
+			SigVerifyCostED25519: types.DefaultSigVerifyCostED25519, SigVerifyCostSecp256k1: types.DefaultSigVerifyCostSecp256k1,
+			PricedFeeDenoms: []string{"ibc/atom", "ibc/atom"},
+		}, fmt.Errorf("duplicate priced fee denom: ibc/atom")},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = PubKeyRotation{}
+
+// NewPubKeyRotation creates a new PubKeyRotation instance
+//nolint:interfacer
+func NewPubKeyRotation(
+	addr sdk.AccAddress, oldPubKey, newPubKey cryptotypes.PubKey, sequence uint64, height int64, rotationTime time.Time,
+) (PubKeyRotation, error) {
+	var oldAny *codectypes.Any
+	if oldPubKey != nil {
+		var err error
+		if oldAny, err = codectypes.NewAnyWithValue(oldPubKey); err != nil {
+			return PubKeyRotation{}, err
+		}
+	}
+
+	newAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return PubKeyRotation{}, err
+	}
+
+	return PubKeyRotation{
+		Address:   addr.String(),
+		OldPubKey: oldAny,
+		NewPubKey: newAny,
+		Sequence:  sequence,
+		Height:    height,
+		Time:      rotationTime,
+	}, nil
+}
+
+// GetAddress returns the address of the account.
+func (r PubKeyRotation) GetAddress() sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(r.Address)
+	return addr
+}
+
+// GetOldPubKey returns the public key of the account before the rotation.
+func (r PubKeyRotation) GetOldPubKey() cryptotypes.PubKey {
+	if r.OldPubKey == nil {
+		return nil
+	}
+
+	pk, _ := r.OldPubKey.GetCachedValue().(cryptotypes.PubKey)
+	return pk
+}
+
+// GetNewPubKey returns the public key of the account after the rotation.
+func (r PubKeyRotation) GetNewPubKey() cryptotypes.PubKey {
+	if r.NewPubKey == nil {
+		return nil
+	}
+
+	pk, _ := r.NewPubKey.GetCachedValue().(cryptotypes.PubKey)
+	return pk
+}
+
+// Validate performs a basic validation of the rotation.
+func (r PubKeyRotation) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return err
+	}
+	if r.GetNewPubKey() == nil {
+		return errors.New("new public key cannot be empty")
+	}
+	if r.Height < 0 {
+		return errors.New("height cannot be negative")
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r PubKeyRotation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var oldPubKey, newPubKey cryptotypes.PubKey
+	if err := unpacker.UnpackAny(r.OldPubKey, &oldPubKey); err != nil {
+		return err
+	}
+
+	return unpacker.UnpackAny(r.NewPubKey, &newPubKey)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryPubKeyRotationsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, r := range res.Rotations {
+		if err := r.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -645,6 +645,212 @@ func (m *AddressStringToBytesResponse) GetAddressBytes() []byte {
 	return nil
 }
 
+// QueryModuleAddressDerivationRequest is the request type for the
+// Query/ModuleAddressDerivation RPC method.
+type QueryModuleAddressDerivationRequest struct {
+	// address is the derived address to resolve.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryModuleAddressDerivationRequest) Reset()         { *m = QueryModuleAddressDerivationRequest{} }
+func (m *QueryModuleAddressDerivationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAddressDerivationRequest) ProtoMessage()    {}
+func (*QueryModuleAddressDerivationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{14}
+}
+func (m *QueryModuleAddressDerivationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAddressDerivationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAddressDerivationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleAddressDerivationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAddressDerivationRequest.Merge(m, src)
+}
+func (m *QueryModuleAddressDerivationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAddressDerivationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAddressDerivationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleAddressDerivationRequest proto.InternalMessageInfo
+
+func (m *QueryModuleAddressDerivationRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryModuleAddressDerivationResponse is the response type for the
+// Query/ModuleAddressDerivation RPC method.
+type QueryModuleAddressDerivationResponse struct {
+	// derivation is the derivation path of the address.
+	Derivation ModuleAddressDerivation `protobuf:"bytes,1,opt,name=derivation,proto3" json:"derivation"`
+}
+
+func (m *QueryModuleAddressDerivationResponse) Reset()         { *m = QueryModuleAddressDerivationResponse{} }
+func (m *QueryModuleAddressDerivationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAddressDerivationResponse) ProtoMessage()    {}
+func (*QueryModuleAddressDerivationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{15}
+}
+func (m *QueryModuleAddressDerivationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAddressDerivationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAddressDerivationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleAddressDerivationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAddressDerivationResponse.Merge(m, src)
+}
+func (m *QueryModuleAddressDerivationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAddressDerivationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAddressDerivationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleAddressDerivationResponse proto.InternalMessageInfo
+
+func (m *QueryModuleAddressDerivationResponse) GetDerivation() ModuleAddressDerivation {
+	if m != nil {
+		return m.Derivation
+	}
+	return ModuleAddressDerivation{}
+}
+
+// QueryPubKeyRotationsRequest is the request type for the Query/PubKeyRotations
+// RPC method.
+type QueryPubKeyRotationsRequest struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyRotationsRequest) Reset()         { *m = QueryPubKeyRotationsRequest{} }
+func (m *QueryPubKeyRotationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyRotationsRequest) ProtoMessage()    {}
+func (*QueryPubKeyRotationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{16}
+}
+func (m *QueryPubKeyRotationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyRotationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyRotationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyRotationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyRotationsRequest.Merge(m, src)
+}
+func (m *QueryPubKeyRotationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyRotationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyRotationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyRotationsRequest proto.InternalMessageInfo
+
+func (m *QueryPubKeyRotationsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryPubKeyRotationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPubKeyRotationsResponse is the response type for the
+// Query/PubKeyRotations RPC method.
+type QueryPubKeyRotationsResponse struct {
+	// rotations are the public key rotations of the account, oldest first.
+	Rotations []PubKeyRotation `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyRotationsResponse) Reset()         { *m = QueryPubKeyRotationsResponse{} }
+func (m *QueryPubKeyRotationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyRotationsResponse) ProtoMessage()    {}
+func (*QueryPubKeyRotationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{17}
+}
+func (m *QueryPubKeyRotationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyRotationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyRotationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyRotationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyRotationsResponse.Merge(m, src)
+}
+func (m *QueryPubKeyRotationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyRotationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyRotationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyRotationsResponse proto.InternalMessageInfo
+
+func (m *QueryPubKeyRotationsResponse) GetRotations() []PubKeyRotation {
+	if m != nil {
+		return m.Rotations
+	}
+	return nil
+}
+
+func (m *QueryPubKeyRotationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -660,63 +866,78 @@ func init() {
 	proto.RegisterType((*AddressBytesToStringResponse)(nil), "cosmos.auth.v1beta1.AddressBytesToStringResponse")
 	proto.RegisterType((*AddressStringToBytesRequest)(nil), "cosmos.auth.v1beta1.AddressStringToBytesRequest")
 	proto.RegisterType((*AddressStringToBytesResponse)(nil), "cosmos.auth.v1beta1.AddressStringToBytesResponse")
+	proto.RegisterType((*QueryModuleAddressDerivationRequest)(nil), "cosmos.auth.v1beta1.QueryModuleAddressDerivationRequest")
+	proto.RegisterType((*QueryModuleAddressDerivationResponse)(nil), "cosmos.auth.v1beta1.QueryModuleAddressDerivationResponse")
+	proto.RegisterType((*QueryPubKeyRotationsRequest)(nil), "cosmos.auth.v1beta1.QueryPubKeyRotationsRequest")
+	proto.RegisterType((*QueryPubKeyRotationsResponse)(nil), "cosmos.auth.v1beta1.QueryPubKeyRotationsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 814 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x4f, 0x13, 0x4d,
-	0x18, 0xc7, 0xbb, 0xbc, 0xef, 0x0b, 0x7d, 0x87, 0xc2, 0x61, 0x28, 0x09, 0xef, 0x16, 0x5a, 0xb2,
-	0xbc, 0x40, 0x8b, 0x74, 0x57, 0x8a, 0x17, 0x7f, 0xc4, 0x84, 0x82, 0x1a, 0x0f, 0x26, 0xb5, 0x70,
-	0xf2, 0x60, 0x33, 0xdb, 0x0e, 0x4b, 0x23, 0xdd, 0x29, 0x9d, 0xad, 0xa1, 0x31, 0x24, 0xc6, 0x13,
-	0x37, 0x4d, 0xfc, 0x07, 0xf0, 0x3f, 0xd0, 0x84, 0xab, 0x77, 0xc2, 0x89, 0xe8, 0xc5, 0x93, 0x31,
-	0xe0, 0xc1, 0x3f, 0xc3, 0x74, 0xe6, 0xd9, 0xb6, 0x4b, 0xa6, 0xed, 0x7a, 0xa2, 0x3b, 0xf3, 0x7c,
-	0xbf, 0xcf, 0x67, 0x9e, 0x67, 0x9e, 0x01, 0xa5, 0xca, 0x8c, 0xd7, 0x18, 0xb7, 0x48, 0xd3, 0xdb,
-	0xb3, 0x5e, 0xae, 0xd9, 0xd4, 0x23, 0x6b, 0xd6, 0x41, 0x93, 0x36, 0x5a, 0x66, 0xbd, 0xc1, 0x3c,
-	0x86, 0xa7, 0x64, 0x80, 0xd9, 0x0e, 0x30, 0x21, 0x40, 0x5f, 0x01, 0x95, 0x4d, 0x38, 0x95, 0xd1,
-	0x1d, 0x6d, 0x9d, 0x38, 0x55, 0x97, 0x78, 0x55, 0xe6, 0x4a, 0x03, 0x3d, 0xee, 0x30, 0x87, 0x89,
-	0x9f, 0x56, 0xfb, 0x17, 0xac, 0xfe, 0xe7, 0x30, 0xe6, 0xec, 0x53, 0x4b, 0x7c, 0xd9, 0xcd, 0x5d,
-	0x8b, 0xb8, 0x90, 0x51, 0x9f, 0x85, 0x2d, 0x52, 0xaf, 0x5a, 0xc4, 0x75, 0x99, 0x27, 0xdc, 0x38,
-	0xec, 0x26, 0x55, 0xc0, 0x02, 0x0e, 0x8c, 0xe5, 0x7e, 0x49, 0x66, 0x04, 0x78, 0xf1, 0x61, 0x3c,
-	0x47, 0xf1, 0xa7, 0x6d, 0xd6, 0x8d, 0x72, 0x99, 0x35, 0x5d, 0x8f, 0x17, 0xe9, 0x41, 0x93, 0x72,
-	0x0f, 0x3f, 0x44, 0xa8, 0x4b, 0x3d, 0xa3, 0xcd, 0x6b, 0xe9, 0xf1, 0xdc, 0x92, 0x09, 0xd2, 0xf6,
-	0x11, 0x4d, 0x59, 0x10, 0xc8, 0x66, 0x16, 0x88, 0x43, 0x41, 0x5b, 0xec, 0x51, 0x1a, 0x27, 0x1a,
-	0x9a, 0xbe, 0x96, 0x80, 0xd7, 0x99, 0xcb, 0x29, 0xbe, 0x8f, 0xa2, 0x04, 0xd6, 0x66, 0xb4, 0xf9,
-	0xbf, 0xd2, 0xe3, 0xb9, 0xb8, 0x29, 0x4f, 0x69, 0xfa, 0x05, 0x30, 0x37, 0xdc, 0x56, 0x3e, 0x76,
-	0x7e, 0x9a, 0x8d, 0x82, 0xfa, 0x71, 0xb1, 0xa3, 0xc1, 0x8f, 0x02, 0x84, 0x23, 0x82, 0x70, 0x79,
-	0x28, 0xa1, 0x4c, 0x1e, 0x40, 0xdc, 0x46, 0x53, 0xbd, 0x84, 0x7e, 0x05, 0x72, 0x68, 0x8c, 0x54,
-	0x2a, 0x0d, 0xca, 0xb9, 0x38, 0xfe, 0xbf, 0xf9, 0x99, 0x2f, 0xa7, 0xd9, 0x38, 0xf8, 0x6f, 0xc8,
-	0x9d, 0x6d, 0xaf, 0x51, 0x75, 0x9d, 0xa2, 0x1f, 0x78, 0x27, 0x7a, 0x7c, 0x92, 0x8a, 0xfc, 0x3a,
-	0x49, 0x45, 0x8c, 0x59, 0xa4, 0x0b, 0xd3, 0x27, 0xac, 0xd2, 0xdc, 0xa7, 0xd7, 0xaa, 0x6b, 0x14,
-	0x20, 0x65, 0x81, 0x34, 0x48, 0xad, 0x5b, 0x92, 0xdb, 0x68, 0xb4, 0x2e, 0x56, 0xa0, 0xe0, 0x09,
-	0x53, 0x71, 0xd1, 0x4c, 0x29, 0xca, 0xff, 0x7d, 0xf6, 0x3d, 0x15, 0x29, 0x82, 0xc0, 0xd8, 0x09,
-	0xf6, 0xb1, 0x63, 0x79, 0x0f, 0x8d, 0x41, 0xc5, 0xc0, 0x33, 0x4c, 0x91, 0x7d, 0x89, 0x11, 0x47,
-	0x38, 0xc0, 0x29, 0xe9, 0xcb, 0x28, 0xa1, 0x3c, 0x1b, 0xa4, 0xdc, 0x0a, 0xd9, 0x58, 0x7c, 0x7e,
-	0x9a, 0x9d, 0x0c, 0x78, 0xf4, 0xb4, 0xd7, 0x98, 0x46, 0x53, 0x79, 0x5a, 0xde, 0x5b, 0xcf, 0x15,
-	0x1a, 0x74, 0xb7, 0x7a, 0xe8, 0xe7, 0xbe, 0x8b, 0xe2, 0xc1, 0x65, 0x48, 0xba, 0x80, 0x26, 0x6c,
-	0xb1, 0x5e, 0xaa, 0x8b, 0x0d, 0xd9, 0xb3, 0x62, 0xcc, 0xee, 0x09, 0x36, 0xf2, 0x28, 0x01, 0x8d,
-	0xcb, 0xb7, 0x3c, 0xca, 0x77, 0x18, 0xf4, 0x0f, 0x3a, 0xbe, 0x80, 0x26, 0xa0, 0x91, 0x25, 0xbb,
-	0xbd, 0x2f, 0x3c, 0x62, 0xc5, 0x18, 0xe9, 0xd1, 0x18, 0x0f, 0xd0, 0xac, 0xda, 0x03, 0x40, 0x16,
-	0xd1, 0xa4, 0x6f, 0xc2, 0xc5, 0x0e, 0x90, 0xf8, 0xd6, 0x32, 0xdc, 0xd8, 0xea, 0xa0, 0xc8, 0x85,
-	0x1d, 0x26, 0xec, 0x7c, 0x94, 0x90, 0x2e, 0x9b, 0x1d, 0x98, 0x6b, 0x2e, 0xdd, 0xaa, 0x0c, 0x3d,
-	0x51, 0xee, 0x73, 0x14, 0xfd, 0x23, 0xfa, 0x89, 0x8f, 0x35, 0xe4, 0x5f, 0x02, 0x8e, 0x33, 0xca,
-	0xcb, 0xa7, 0x7a, 0x2c, 0xf4, 0x95, 0x30, 0xa1, 0x12, 0xc9, 0x58, 0x7c, 0xf3, 0xf5, 0xe7, 0xfb,
-	0x91, 0x14, 0x9e, 0xb3, 0x94, 0x8f, 0x96, 0x9f, 0xfd, 0xad, 0x86, 0xc6, 0x40, 0x8b, 0xd3, 0x43,
-	0xed, 0x7d, 0x90, 0x4c, 0x88, 0x48, 0xe0, 0xb0, 0x04, 0x47, 0x06, 0x2f, 0x0f, 0xe4, 0xb0, 0x5e,
-	0x41, 0xa9, 0x8e, 0xf0, 0x6b, 0x0d, 0x8d, 0xca, 0x39, 0xc0, 0xcb, 0xfd, 0xd3, 0x04, 0x26, 0x45,
-	0x4f, 0x0f, 0x0f, 0x04, 0x9c, 0x05, 0x81, 0x33, 0x87, 0x13, 0x4a, 0x1c, 0x39, 0xe4, 0xf8, 0x83,
-	0x86, 0x82, 0x03, 0xc3, 0xb1, 0xd5, 0x3f, 0x83, 0xf2, 0xe9, 0xd1, 0x6f, 0x86, 0x17, 0x00, 0xda,
-	0xaa, 0x40, 0x5b, 0xc2, 0xff, 0x2b, 0xd1, 0x6a, 0x42, 0x54, 0xea, 0x34, 0xee, 0x58, 0x43, 0xb1,
-	0xde, 0x09, 0xed, 0xd3, 0x3d, 0xc5, 0x6c, 0xf7, 0xe9, 0x9e, 0x6a, 0xdc, 0x87, 0x94, 0x4b, 0x0e,
-	0x3d, 0xfe, 0xa8, 0xa1, 0xb8, 0x6a, 0x56, 0xb1, 0xba, 0x06, 0x03, 0x9e, 0x06, 0x7d, 0xed, 0x0f,
-	0x14, 0x80, 0xb8, 0x2e, 0x10, 0xb3, 0xf8, 0xc6, 0x00, 0xc4, 0xce, 0xf5, 0x92, 0xe3, 0x79, 0x84,
-	0x3f, 0x75, 0x91, 0x03, 0x13, 0x3d, 0x18, 0x59, 0xf5, 0x84, 0x0c, 0x46, 0x56, 0x3e, 0x17, 0xc6,
-	0x2d, 0x81, 0x6c, 0xe2, 0xd5, 0x50, 0xc8, 0xf2, 0x61, 0x3a, 0xca, 0x6f, 0x9e, 0x5d, 0x26, 0xb5,
-	0x8b, 0xcb, 0xa4, 0xf6, 0xe3, 0x32, 0xa9, 0xbd, 0xbb, 0x4a, 0x46, 0x2e, 0xae, 0x92, 0x91, 0x6f,
-	0x57, 0xc9, 0xc8, 0xb3, 0x8c, 0x53, 0xf5, 0xf6, 0x9a, 0xb6, 0x59, 0x66, 0x35, 0xdf, 0x51, 0xfe,
-	0xc9, 0xf2, 0xca, 0x0b, 0xeb, 0x50, 0xda, 0x7b, 0xad, 0x3a, 0xe5, 0xf6, 0xa8, 0xf8, 0xd7, 0xb0,
-	0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x15, 0x47, 0x58, 0x91, 0x7c, 0x09, 0x00, 0x00,
+	// 982 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0x05, 0x92, 0xf4, 0xc5, 0x2d, 0xd2, 0xc4, 0x15, 0x61, 0x93, 0xda, 0xd5, 0xba,
+	0x6d, 0xe2, 0x12, 0xef, 0xd6, 0x0e, 0x87, 0x86, 0x22, 0xa4, 0xb8, 0xa1, 0x15, 0x42, 0x48, 0x66,
+	0x9b, 0x0b, 0x1c, 0xb0, 0x76, 0xed, 0xa9, 0x63, 0xb5, 0xd9, 0x71, 0x3d, 0xbb, 0x55, 0x4d, 0x15,
+	0x09, 0x71, 0xca, 0x0d, 0x24, 0x4e, 0xdc, 0xc2, 0x37, 0x08, 0x52, 0x3e, 0x02, 0x87, 0xaa, 0xa7,
+	0x02, 0x17, 0x4e, 0x08, 0x25, 0x1c, 0xf8, 0x18, 0xc8, 0x33, 0x6f, 0xed, 0xdd, 0x68, 0x6c, 0x6f,
+	0xda, 0x9e, 0xe2, 0x9d, 0x79, 0xef, 0xff, 0x7e, 0xf3, 0xde, 0xcc, 0x7b, 0x81, 0x42, 0x93, 0x8b,
+	0x5d, 0x2e, 0x6c, 0x37, 0x0c, 0x76, 0xec, 0x27, 0x15, 0x8f, 0x05, 0x6e, 0xc5, 0x7e, 0x1c, 0xb2,
+	0x5e, 0xdf, 0xea, 0xf6, 0x78, 0xc0, 0xe9, 0x82, 0x32, 0xb0, 0x06, 0x06, 0x16, 0x1a, 0x18, 0x37,
+	0xd0, 0xcb, 0x73, 0x05, 0x53, 0xd6, 0x43, 0xdf, 0xae, 0xdb, 0xee, 0xf8, 0x6e, 0xd0, 0xe1, 0xbe,
+	0x12, 0x30, 0x72, 0x6d, 0xde, 0xe6, 0xf2, 0xa7, 0x3d, 0xf8, 0x85, 0xab, 0xef, 0xb7, 0x39, 0x6f,
+	0x3f, 0x62, 0xb6, 0xfc, 0xf2, 0xc2, 0x07, 0xb6, 0xeb, 0x63, 0x44, 0x63, 0x19, 0xb7, 0xdc, 0x6e,
+	0xc7, 0x76, 0x7d, 0x9f, 0x07, 0x52, 0x4d, 0xe0, 0x6e, 0x5e, 0x07, 0x2c, 0xe1, 0x50, 0x58, 0xed,
+	0x37, 0x54, 0x44, 0x84, 0x97, 0x1f, 0xe6, 0x37, 0x90, 0xfb, 0x72, 0xc0, 0xba, 0xd9, 0x6c, 0xf2,
+	0xd0, 0x0f, 0x84, 0xc3, 0x1e, 0x87, 0x4c, 0x04, 0xf4, 0x2e, 0xc0, 0x88, 0x7a, 0x91, 0x5c, 0x21,
+	0xab, 0xf3, 0xd5, 0xeb, 0x16, 0xba, 0x0e, 0x8e, 0x68, 0xa9, 0x84, 0x60, 0x34, 0xab, 0xee, 0xb6,
+	0x19, 0xfa, 0x3a, 0x31, 0x4f, 0xf3, 0x80, 0xc0, 0xa5, 0x53, 0x01, 0x44, 0x97, 0xfb, 0x82, 0xd1,
+	0x4f, 0x60, 0xce, 0xc5, 0xb5, 0x45, 0x72, 0xe5, 0xad, 0xd5, 0xf9, 0x6a, 0xce, 0x52, 0xa7, 0xb4,
+	0xa2, 0x04, 0x58, 0x9b, 0x7e, 0xbf, 0x96, 0x7d, 0x71, 0x54, 0x9e, 0x43, 0xef, 0xcf, 0x9c, 0xa1,
+	0x0f, 0xbd, 0x97, 0x20, 0x3c, 0x27, 0x09, 0x57, 0xa6, 0x12, 0xaa, 0xe0, 0x09, 0xc4, 0xfb, 0xb0,
+	0x10, 0x27, 0x8c, 0x32, 0x50, 0x85, 0x59, 0xb7, 0xd5, 0xea, 0x31, 0x21, 0xe4, 0xf1, 0xcf, 0xd7,
+	0x16, 0xff, 0x38, 0x2a, 0xe7, 0x50, 0x7f, 0x53, 0xed, 0xdc, 0x0f, 0x7a, 0x1d, 0xbf, 0xed, 0x44,
+	0x86, 0x1f, 0xcd, 0xed, 0x1f, 0x14, 0x32, 0xff, 0x1d, 0x14, 0x32, 0xe6, 0x32, 0x18, 0x52, 0xf4,
+	0x0b, 0xde, 0x0a, 0x1f, 0xb1, 0x53, 0xd9, 0x35, 0xeb, 0x18, 0xb2, 0xee, 0xf6, 0xdc, 0xdd, 0x51,
+	0x4a, 0x36, 0x60, 0xa6, 0x2b, 0x57, 0x30, 0xe1, 0x4b, 0x96, 0xe6, 0xa2, 0x59, 0xca, 0xa9, 0xf6,
+	0xf6, 0xf3, 0xbf, 0x0b, 0x19, 0x07, 0x1d, 0xcc, 0xed, 0x64, 0x1d, 0x87, 0x92, 0x1f, 0xc3, 0x2c,
+	0x66, 0x0c, 0x35, 0xd3, 0x24, 0x39, 0x72, 0x31, 0x73, 0x40, 0x13, 0x9c, 0x8a, 0xbe, 0x09, 0x4b,
+	0xda, 0xb3, 0x61, 0xc8, 0xad, 0x94, 0x85, 0xa5, 0x2f, 0x8e, 0xca, 0x17, 0x13, 0x1a, 0xb1, 0xf2,
+	0x9a, 0x97, 0x60, 0xa1, 0xc6, 0x9a, 0x3b, 0xeb, 0xd5, 0x7a, 0x8f, 0x3d, 0xe8, 0x3c, 0x8d, 0x62,
+	0xdf, 0x86, 0x5c, 0x72, 0x19, 0x83, 0x16, 0xe1, 0x82, 0x27, 0xd7, 0x1b, 0x5d, 0xb9, 0xa1, 0x6a,
+	0xe6, 0x64, 0xbd, 0x98, 0xb1, 0x59, 0x83, 0x25, 0x2c, 0x5c, 0xad, 0x1f, 0x30, 0xb1, 0xcd, 0xb1,
+	0x7e, 0x58, 0xf1, 0x22, 0x5c, 0xc0, 0x42, 0x36, 0xbc, 0xc1, 0xbe, 0xd4, 0xc8, 0x3a, 0x59, 0x37,
+	0xe6, 0x63, 0x7e, 0x0a, 0xcb, 0x7a, 0x0d, 0x04, 0xb9, 0x06, 0x17, 0x23, 0x11, 0x21, 0x77, 0x90,
+	0x24, 0x92, 0x56, 0xe6, 0xe6, 0xd6, 0x10, 0x45, 0x2d, 0x6c, 0x73, 0x29, 0x17, 0xa1, 0xa4, 0x54,
+	0xb9, 0x33, 0x84, 0x39, 0xa5, 0x32, 0xca, 0xca, 0xf4, 0x13, 0x7d, 0x05, 0xc5, 0x78, 0x39, 0xd5,
+	0xd6, 0x16, 0xeb, 0x75, 0x9e, 0xc8, 0xf7, 0xf1, 0x1a, 0xef, 0xc1, 0xfc, 0x16, 0xae, 0x4e, 0x96,
+	0x46, 0x4e, 0x07, 0xa0, 0x35, 0x5c, 0xc5, 0x8b, 0xba, 0xa6, 0xbd, 0xfc, 0x63, 0x94, 0xf0, 0x35,
+	0xc4, 0x54, 0xcc, 0x9f, 0x09, 0x5e, 0xd3, 0x7a, 0xe8, 0x7d, 0xce, 0xfa, 0x4e, 0xd4, 0x33, 0x5f,
+	0xe3, 0x3c, 0xf4, 0xae, 0xa6, 0xe7, 0xbc, 0x4a, 0x57, 0x3c, 0x24, 0xb0, 0xac, 0x67, 0xc3, 0x84,
+	0xdc, 0x83, 0xf3, 0xbd, 0x68, 0x11, 0x1f, 0x51, 0x51, 0xdf, 0x0c, 0x12, 0x02, 0x98, 0x86, 0x91,
+	0xef, 0x1b, 0xeb, 0x92, 0xd5, 0xdf, 0xe6, 0xe1, 0x1d, 0x89, 0x4c, 0xf7, 0x09, 0x44, 0xad, 0x42,
+	0xd0, 0x92, 0x96, 0x4a, 0x37, 0x52, 0x8c, 0x1b, 0x69, 0x4c, 0x55, 0x64, 0xf3, 0xda, 0xf7, 0x7f,
+	0xfe, 0xfb, 0xd3, 0xb9, 0x02, 0xbd, 0x6c, 0x6b, 0x47, 0x5b, 0x14, 0xfd, 0x07, 0x02, 0xb3, 0xe8,
+	0x4b, 0x57, 0xa7, 0xca, 0x47, 0x20, 0xa5, 0x14, 0x96, 0xc8, 0x61, 0x4b, 0x8e, 0x12, 0x5d, 0x99,
+	0xc8, 0x61, 0x3f, 0xc3, 0x0b, 0xb2, 0x47, 0xbf, 0x23, 0x30, 0xa3, 0xba, 0x25, 0x5d, 0x19, 0x1f,
+	0x26, 0xd1, 0x4f, 0x8d, 0xd5, 0xe9, 0x86, 0x88, 0x53, 0x94, 0x38, 0x97, 0xe9, 0x92, 0x16, 0x47,
+	0x8d, 0x02, 0xfa, 0x0b, 0x81, 0x64, 0x5b, 0x15, 0xd4, 0x1e, 0x1f, 0x41, 0x3b, 0xa0, 0x8c, 0x9b,
+	0xe9, 0x1d, 0x10, 0x6d, 0x4d, 0xa2, 0x5d, 0xa7, 0x57, 0xb5, 0x68, 0xbb, 0xd2, 0xa9, 0x31, 0x2c,
+	0xdc, 0x3e, 0x81, 0x6c, 0xbc, 0x8f, 0x8f, 0xa9, 0x9e, 0x66, 0x02, 0x18, 0xa5, 0x14, 0x96, 0xa9,
+	0xd2, 0xa5, 0x46, 0x03, 0x3d, 0x24, 0x90, 0xd3, 0x75, 0x74, 0xaa, 0xcf, 0xc1, 0x84, 0x01, 0x62,
+	0x54, 0xce, 0xe0, 0x81, 0x88, 0xeb, 0x12, 0xb1, 0x4c, 0x3f, 0x98, 0x80, 0x68, 0x3f, 0x4b, 0x34,
+	0xf1, 0x3d, 0xfa, 0xeb, 0x08, 0x39, 0xd1, 0xf7, 0x27, 0x23, 0xeb, 0x06, 0x8d, 0x51, 0x39, 0x83,
+	0x07, 0x22, 0x7f, 0x28, 0x91, 0x2d, 0xba, 0x96, 0x0a, 0x59, 0x8d, 0xaf, 0x3d, 0xfa, 0x3b, 0x81,
+	0xf7, 0xc6, 0x34, 0x6f, 0x7a, 0x6b, 0xea, 0x6d, 0x1b, 0x33, 0x94, 0x8c, 0x8d, 0x57, 0xf0, 0xc4,
+	0x63, 0x6c, 0xca, 0x63, 0xdc, 0xa6, 0x1b, 0x13, 0x2f, 0x2c, 0x9e, 0x62, 0x34, 0x57, 0xe2, 0x8f,
+	0xfd, 0x90, 0xc0, 0xbb, 0xa7, 0x3a, 0x38, 0x9d, 0xf0, 0x72, 0xf4, 0x83, 0xc8, 0xa8, 0x9c, 0xc1,
+	0x03, 0xd9, 0x6f, 0x49, 0xf6, 0x2a, 0xbd, 0xa9, 0xef, 0x03, 0xa1, 0xd7, 0x78, 0xc8, 0xfa, 0x8d,
+	0xe1, 0x14, 0x18, 0x21, 0xd7, 0xee, 0x3c, 0x3f, 0xce, 0x93, 0x97, 0xc7, 0x79, 0xf2, 0xcf, 0x71,
+	0x9e, 0xfc, 0x78, 0x92, 0xcf, 0xbc, 0x3c, 0xc9, 0x67, 0xfe, 0x3a, 0xc9, 0x67, 0xbe, 0x2e, 0xb5,
+	0x3b, 0xc1, 0x4e, 0xe8, 0x59, 0x4d, 0xbe, 0x1b, 0xa9, 0xaa, 0x3f, 0x65, 0xd1, 0x7a, 0x68, 0x3f,
+	0x55, 0x21, 0x82, 0x7e, 0x97, 0x09, 0x6f, 0x46, 0xfe, 0x1f, 0xb7, 0xfe, 0xff, 0x00, 0xb4, 0x49,
+	0xc9, 0x90, 0x29, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -745,6 +966,11 @@ type QueryClient interface {
 	AddressBytesToString(ctx context.Context, in *AddressBytesToStringRequest, opts ...grpc.CallOption) (*AddressBytesToStringResponse, error)
 	// AddressStringToBytes converts Address string to bytes
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
+	// ModuleAddressDerivation resolves a registered module derived address to
+	// its module name and derivation keys.
+	ModuleAddressDerivation(ctx context.Context, in *QueryModuleAddressDerivationRequest, opts ...grpc.CallOption) (*QueryModuleAddressDerivationResponse, error)
+	// PubKeyRotations returns the public key rotations of an account.
+	PubKeyRotations(ctx context.Context, in *QueryPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPubKeyRotationsResponse, error)
 }
 
 type queryClient struct {
@@ -818,6 +1044,24 @@ func (c *queryClient) AddressStringToBytes(ctx context.Context, in *AddressStrin
 	return out, nil
 }
 
+func (c *queryClient) ModuleAddressDerivation(ctx context.Context, in *QueryModuleAddressDerivationRequest, opts ...grpc.CallOption) (*QueryModuleAddressDerivationResponse, error) {
+	out := new(QueryModuleAddressDerivationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/ModuleAddressDerivation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PubKeyRotations(ctx context.Context, in *QueryPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPubKeyRotationsResponse, error) {
+	out := new(QueryPubKeyRotationsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/PubKeyRotations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts
@@ -834,6 +1078,11 @@ type QueryServer interface {
 	AddressBytesToString(context.Context, *AddressBytesToStringRequest) (*AddressBytesToStringResponse, error)
 	// AddressStringToBytes converts Address string to bytes
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
+	// ModuleAddressDerivation resolves a registered module derived address to
+	// its module name and derivation keys.
+	ModuleAddressDerivation(context.Context, *QueryModuleAddressDerivationRequest) (*QueryModuleAddressDerivationResponse, error)
+	// PubKeyRotations returns the public key rotations of an account.
+	PubKeyRotations(context.Context, *QueryPubKeyRotationsRequest) (*QueryPubKeyRotationsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -861,6 +1110,12 @@ func (*UnimplementedQueryServer) AddressBytesToString(ctx context.Context, req *
 func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddressStringToBytes not implemented")
 }
+func (*UnimplementedQueryServer) ModuleAddressDerivation(ctx context.Context, req *QueryModuleAddressDerivationRequest) (*QueryModuleAddressDerivationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAddressDerivation not implemented")
+}
+func (*UnimplementedQueryServer) PubKeyRotations(ctx context.Context, req *QueryPubKeyRotationsRequest) (*QueryPubKeyRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyRotations not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -992,6 +1247,42 @@ func _Query_AddressStringToBytes_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ModuleAddressDerivation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAddressDerivationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAddressDerivation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/ModuleAddressDerivation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAddressDerivation(ctx, req.(*QueryModuleAddressDerivationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PubKeyRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/PubKeyRotations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyRotations(ctx, req.(*QueryPubKeyRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1024,6 +1315,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddressStringToBytes",
 			Handler:    _Query_AddressStringToBytes_Handler,
 		},
+		{
+			MethodName: "ModuleAddressDerivation",
+			Handler:    _Query_ModuleAddressDerivation_Handler,
+		},
+		{
+			MethodName: "PubKeyRotations",
+			Handler:    _Query_PubKeyRotations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -1467,86 +1766,240 @@ func (m *AddressStringToBytesResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryModuleAddressDerivationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryModuleAddressDerivationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryModuleAddressDerivationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryModuleAddressDerivationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryModuleAddressDerivationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryModuleAddressDerivationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Derivation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyRotationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyRotationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyRotationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyRotationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyRotationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyRotationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rotations) > 0 {
+		for iNdEx := len(m.Rotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.Account != nil {
@@ -1654,6 +2107,66 @@ func (m *AddressStringToBytesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryModuleAddressDerivationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleAddressDerivationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Derivation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPubKeyRotationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPubKeyRotationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rotations) > 0 {
+		for _, e := range m.Rotations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2765,6 +3278,409 @@ func (m *AddressStringToBytesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryModuleAddressDerivationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAddressDerivationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAddressDerivationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAddressDerivationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAddressDerivationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAddressDerivationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Derivation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Derivation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyRotationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyRotationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyRotationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyRotationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyRotationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyRotationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rotations = append(m.Rotations, PubKeyRotation{})
+			if err := m.Rotations[len(m.Rotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
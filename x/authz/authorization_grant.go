@@ -50,11 +50,10 @@ func (g Grant) GetAuthorization() Authorization {
 	return a
 }
 
+// ValidateBasic performs a stateless validation of the grant. Its expiration is
+// checked against the block time by the msg server, as the local time of the
+// node is not deterministic.
 func (g Grant) ValidateBasic() error {
-	if g.Expiration.Unix() < time.Now().Unix() {
-		return sdkerrors.Wrap(ErrInvalidExpirationTime, "Time can't be in the past")
-	}
-
 	av := g.Authorization.GetCachedValue()
 	a, ok := av.(Authorization)
 	if !ok {
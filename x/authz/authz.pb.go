@@ -29,6 +29,65 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ConstraintOperator is the operator of a FieldConstraint.
+type ConstraintOperator int32
+
+const (
+	// CONSTRAINT_OPERATOR_UNSPECIFIED specifies an unknown operator
+	ConstraintOperator_CONSTRAINT_OPERATOR_UNSPECIFIED ConstraintOperator = 0
+	// CONSTRAINT_OPERATOR_EQUAL requires the field to equal the value
+	ConstraintOperator_CONSTRAINT_OPERATOR_EQUAL ConstraintOperator = 1
+	// CONSTRAINT_OPERATOR_NOT_EQUAL requires the field not to equal the value
+	ConstraintOperator_CONSTRAINT_OPERATOR_NOT_EQUAL ConstraintOperator = 2
+	// CONSTRAINT_OPERATOR_LESS_THAN requires the field to be less than the value
+	ConstraintOperator_CONSTRAINT_OPERATOR_LESS_THAN ConstraintOperator = 3
+	// CONSTRAINT_OPERATOR_LESS_THAN_OR_EQUAL requires the field to be at most
+	// the value
+	ConstraintOperator_CONSTRAINT_OPERATOR_LESS_THAN_OR_EQUAL ConstraintOperator = 4
+	// CONSTRAINT_OPERATOR_GREATER_THAN requires the field to be greater than the
+	// value
+	ConstraintOperator_CONSTRAINT_OPERATOR_GREATER_THAN ConstraintOperator = 5
+	// CONSTRAINT_OPERATOR_GREATER_THAN_OR_EQUAL requires the field to be at
+	// least the value
+	ConstraintOperator_CONSTRAINT_OPERATOR_GREATER_THAN_OR_EQUAL ConstraintOperator = 6
+	// CONSTRAINT_OPERATOR_IN requires the field to be one of the values
+	ConstraintOperator_CONSTRAINT_OPERATOR_IN ConstraintOperator = 7
+	// CONSTRAINT_OPERATOR_NOT_IN requires the field to be none of the values
+	ConstraintOperator_CONSTRAINT_OPERATOR_NOT_IN ConstraintOperator = 8
+)
+
+var ConstraintOperator_name = map[int32]string{
+	0: "CONSTRAINT_OPERATOR_UNSPECIFIED",
+	1: "CONSTRAINT_OPERATOR_EQUAL",
+	2: "CONSTRAINT_OPERATOR_NOT_EQUAL",
+	3: "CONSTRAINT_OPERATOR_LESS_THAN",
+	4: "CONSTRAINT_OPERATOR_LESS_THAN_OR_EQUAL",
+	5: "CONSTRAINT_OPERATOR_GREATER_THAN",
+	6: "CONSTRAINT_OPERATOR_GREATER_THAN_OR_EQUAL",
+	7: "CONSTRAINT_OPERATOR_IN",
+	8: "CONSTRAINT_OPERATOR_NOT_IN",
+}
+
+var ConstraintOperator_value = map[string]int32{
+	"CONSTRAINT_OPERATOR_UNSPECIFIED":           0,
+	"CONSTRAINT_OPERATOR_EQUAL":                 1,
+	"CONSTRAINT_OPERATOR_NOT_EQUAL":             2,
+	"CONSTRAINT_OPERATOR_LESS_THAN":             3,
+	"CONSTRAINT_OPERATOR_LESS_THAN_OR_EQUAL":    4,
+	"CONSTRAINT_OPERATOR_GREATER_THAN":          5,
+	"CONSTRAINT_OPERATOR_GREATER_THAN_OR_EQUAL": 6,
+	"CONSTRAINT_OPERATOR_IN":                    7,
+	"CONSTRAINT_OPERATOR_NOT_IN":                8,
+}
+
+func (x ConstraintOperator) String() string {
+	return proto.EnumName(ConstraintOperator_name, int32(x))
+}
+
+func (ConstraintOperator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{0}
+}
+
 // GenericAuthorization gives the grantee unrestricted permissions to execute
 // the provided method on behalf of the granter's account.
 type GenericAuthorization struct {
@@ -69,6 +128,100 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// FilteredGenericAuthorization gives the grantee permissions to execute the
+// provided method on behalf of the granter's account, provided that the fields
+// of the executed messages satisfy all its constraints, e.g. for a MsgSend that
+// the amount is at most a limit and the recipient is in an allowlist.
+type FilteredGenericAuthorization struct {
+	// Msg, identified by it's type URL, to grant permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// constraints are the constraints on the fields of the messages, all of
+	// which must be satisfied.
+	Constraints []FieldConstraint `protobuf:"bytes,2,rep,name=constraints,proto3" json:"constraints"`
+}
+
+func (m *FilteredGenericAuthorization) Reset()         { *m = FilteredGenericAuthorization{} }
+func (m *FilteredGenericAuthorization) String() string { return proto.CompactTextString(m) }
+func (*FilteredGenericAuthorization) ProtoMessage()    {}
+func (*FilteredGenericAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *FilteredGenericAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FilteredGenericAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FilteredGenericAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FilteredGenericAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilteredGenericAuthorization.Merge(m, src)
+}
+func (m *FilteredGenericAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *FilteredGenericAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilteredGenericAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilteredGenericAuthorization proto.InternalMessageInfo
+
+// FieldConstraint constrains a field of the messages executed with a
+// FilteredGenericAuthorization, evaluated when the messages are executed.
+type FieldConstraint struct {
+	// field is the path of the field in the JSON encoding of the messages, the
+	// names of the nested fields being separated by dots, e.g. "to_address" or
+	// "outputs.address". The constraint applies to each of the values of the
+	// repeated fields along the path, except for the coins compared as a whole.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// operator is the operator comparing the field to the values.
+	Operator ConstraintOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=cosmos.authz.v1beta1.ConstraintOperator" json:"operator,omitempty"`
+	// values are the values the field is compared to: the allowed or denied
+	// values of the IN and NOT_IN operators, or a single value for the other
+	// operators. The values of the ordering operators are decimals, or coins if
+	// the field is a coin or a list of coins.
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *FieldConstraint) Reset()      { *m = FieldConstraint{} }
+func (*FieldConstraint) ProtoMessage() {}
+func (*FieldConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *FieldConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldConstraint.Merge(m, src)
+}
+func (m *FieldConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldConstraint proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
@@ -80,7 +233,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{3}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -110,33 +263,53 @@ func (m *Grant) XXX_DiscardUnknown() {
 var xxx_messageInfo_Grant proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("cosmos.authz.v1beta1.ConstraintOperator", ConstraintOperator_name, ConstraintOperator_value)
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*FilteredGenericAuthorization)(nil), "cosmos.authz.v1beta1.FilteredGenericAuthorization")
+	proto.RegisterType((*FieldConstraint)(nil), "cosmos.authz.v1beta1.FieldConstraint")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0x84, 0xf0, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0x20, 0x2a, 0xf4, 0x20, 0x62, 0x50,
-	0x15, 0x52, 0x92, 0x10, 0xd1, 0x78, 0xb0, 0x1a, 0x7d, 0xa8, 0x12, 0x30, 0x47, 0x4a, 0x3e, 0x3d,
-	0x3f, 0x3f, 0x3d, 0x27, 0x55, 0x1f, 0xcc, 0x4b, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d, 0x2d,
-	0x2e, 0x49, 0xcc, 0x2d, 0x80, 0x2a, 0x10, 0x49, 0xcf, 0x4f, 0xcf, 0x87, 0x68, 0x04, 0xb1, 0xa0,
-	0xa2, 0x92, 0xe8, 0xda, 0x12, 0xf3, 0x2a, 0x21, 0x52, 0x4a, 0xd6, 0x5c, 0x22, 0xee, 0xa9, 0x79,
-	0xa9, 0x45, 0x99, 0xc9, 0x8e, 0xa5, 0x25, 0x19, 0xf9, 0x45, 0x99, 0x55, 0x89, 0x25, 0x99, 0xf9,
-	0x79, 0x42, 0x02, 0x5c, 0xcc, 0xb9, 0xc5, 0xe9, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x20,
-	0xa6, 0x95, 0xe0, 0xa9, 0x2d, 0xba, 0xbc, 0x28, 0x8a, 0x94, 0xe6, 0x30, 0x72, 0xb1, 0xba, 0x17,
-	0x25, 0xe6, 0x95, 0x08, 0xf9, 0x72, 0xf1, 0x26, 0x22, 0x4b, 0x81, 0x35, 0x72, 0x1b, 0x89, 0xe8,
-	0x41, 0x6c, 0xd6, 0x83, 0xd9, 0xac, 0xe7, 0x98, 0x57, 0xe9, 0x84, 0x69, 0x52, 0x10, 0xaa, 0x6e,
-	0x21, 0x17, 0x2e, 0xae, 0xd4, 0x8a, 0x82, 0xcc, 0x22, 0x88, 0x59, 0x4c, 0x60, 0xb3, 0xa4, 0x30,
-	0xcc, 0x0a, 0x81, 0x79, 0xde, 0x89, 0xe3, 0xc4, 0x3d, 0x79, 0x86, 0x09, 0xf7, 0xe5, 0x19, 0x83,
-	0x90, 0xf4, 0x39, 0x39, 0x9d, 0x78, 0x28, 0xc7, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0x2a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xd0, 0x40,
-	0x86, 0x52, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x15, 0x90, 0x88, 0x4a, 0x62, 0x03, 0xdb, 0x66, 0x0c,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0x46, 0xfb, 0xa1, 0x7a, 0xcd, 0x01, 0x00, 0x00,
+	// 561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x6e, 0xda, 0x4c,
+	0x14, 0x86, 0x6d, 0xfe, 0x3e, 0x72, 0x50, 0xbe, 0xba, 0x23, 0x14, 0x01, 0x6a, 0x8c, 0x4b, 0xd3,
+	0x8a, 0x46, 0xc2, 0x56, 0xe8, 0x2e, 0x5d, 0x19, 0x30, 0x14, 0x29, 0x31, 0xa9, 0x71, 0x36, 0xdd,
+	0x20, 0x03, 0x8e, 0xb1, 0x0a, 0x1e, 0x64, 0x0f, 0x51, 0x92, 0x5b, 0xe8, 0x26, 0xea, 0xaa, 0x8b,
+	0x2e, 0x7a, 0x11, 0xbd, 0x08, 0xd4, 0x55, 0x96, 0x95, 0x2a, 0xf5, 0x07, 0x6e, 0xa4, 0xc2, 0xe3,
+	0x10, 0x12, 0x9c, 0xb6, 0x2b, 0xcf, 0x39, 0xf3, 0xbc, 0xaf, 0xcf, 0x99, 0x63, 0x0f, 0x08, 0x3d,
+	0xec, 0x8d, 0xb0, 0x27, 0x19, 0x13, 0x32, 0xb8, 0x90, 0x4e, 0xf7, 0xba, 0x26, 0x31, 0xf6, 0x68,
+	0x24, 0x8e, 0x5d, 0x4c, 0x30, 0x4a, 0x53, 0x42, 0xa4, 0xb9, 0x80, 0xc8, 0x65, 0x69, 0xb6, 0xe3,
+	0x33, 0x52, 0x80, 0xf8, 0x41, 0x2e, 0x6f, 0x61, 0x6c, 0x0d, 0x4d, 0xc9, 0x8f, 0xba, 0x93, 0x13,
+	0x89, 0xd8, 0x23, 0xd3, 0x23, 0xc6, 0x68, 0x1c, 0x00, 0x69, 0x0b, 0x5b, 0x98, 0x0a, 0x17, 0xab,
+	0x20, 0x9b, 0xbd, 0x2b, 0x33, 0x9c, 0x73, 0xba, 0x55, 0x78, 0x09, 0xe9, 0x86, 0xe9, 0x98, 0xae,
+	0xdd, 0x93, 0x27, 0x64, 0x80, 0x5d, 0xfb, 0xc2, 0x20, 0x36, 0x76, 0x10, 0x07, 0xd1, 0x91, 0x67,
+	0x65, 0x58, 0x81, 0x2d, 0x6e, 0x68, 0x8b, 0xe5, 0xfe, 0xc3, 0x2f, 0x9f, 0x4b, 0x9b, 0xb7, 0xa0,
+	0xc2, 0x7b, 0x16, 0x1e, 0xd5, 0xed, 0x21, 0x31, 0x5d, 0xb3, 0xff, 0x6f, 0x2e, 0xe8, 0x10, 0x52,
+	0x3d, 0xec, 0x78, 0xc4, 0x35, 0x6c, 0x87, 0x78, 0x99, 0x88, 0x10, 0x2d, 0xa6, 0xca, 0x4f, 0xc5,
+	0xb0, 0x83, 0x10, 0xeb, 0xb6, 0x39, 0xec, 0x57, 0x97, 0x74, 0x25, 0x36, 0xfd, 0x9e, 0x67, 0xb4,
+	0x55, 0x7d, 0x58, 0x51, 0xef, 0x58, 0x78, 0x70, 0x47, 0x89, 0xd2, 0x10, 0x3f, 0x59, 0xa4, 0x82,
+	0x4a, 0x68, 0x80, 0x6a, 0x90, 0xc4, 0x63, 0xd3, 0x35, 0x08, 0x76, 0x33, 0x11, 0x81, 0x2d, 0xfe,
+	0x5f, 0x2e, 0x86, 0x17, 0x72, 0xe3, 0xd4, 0x0a, 0x78, 0x6d, 0xa9, 0x44, 0x5b, 0x90, 0x38, 0x35,
+	0x86, 0x13, 0xd3, 0xcb, 0x44, 0x85, 0x68, 0x71, 0x43, 0x0b, 0xa2, 0xfd, 0xd8, 0x87, 0x4f, 0x79,
+	0xa6, 0xf0, 0x91, 0x85, 0x78, 0xc3, 0x35, 0x1c, 0x82, 0x0e, 0x61, 0xd3, 0x58, 0x2d, 0xd4, 0xaf,
+	0x25, 0x55, 0x4e, 0x8b, 0x74, 0x38, 0xe2, 0xf5, 0x70, 0x44, 0xd9, 0x39, 0xaf, 0xac, 0xf7, 0xa5,
+	0xdd, 0x56, 0xa3, 0x1a, 0x80, 0x79, 0x36, 0xb6, 0x5d, 0xea, 0x15, 0xf1, 0xbd, 0x72, 0x6b, 0x5e,
+	0xfa, 0xf5, 0xf7, 0x51, 0x49, 0x2e, 0x0e, 0xef, 0xf2, 0x47, 0x9e, 0xd5, 0x56, 0x74, 0xbb, 0xdf,
+	0x22, 0x80, 0xd6, 0xbb, 0x43, 0x4f, 0x20, 0x5f, 0x6d, 0xa9, 0x6d, 0x5d, 0x93, 0x9b, 0xaa, 0xde,
+	0x69, 0x1d, 0x29, 0x9a, 0xac, 0xb7, 0xb4, 0xce, 0xb1, 0xda, 0x3e, 0x52, 0xaa, 0xcd, 0x7a, 0x53,
+	0xa9, 0x71, 0x0c, 0xda, 0x86, 0x6c, 0x18, 0xa4, 0xbc, 0x3e, 0x96, 0x0f, 0x38, 0x16, 0x3d, 0x86,
+	0xed, 0xb0, 0x6d, 0xb5, 0xa5, 0x07, 0x48, 0xe4, 0x3e, 0xe4, 0x40, 0x69, 0xb7, 0x3b, 0xfa, 0x2b,
+	0x59, 0xe5, 0xa2, 0x68, 0x17, 0x9e, 0xfd, 0x11, 0xe9, 0x2c, 0xdf, 0x18, 0x43, 0x3b, 0x20, 0x84,
+	0xb1, 0x0d, 0x4d, 0x91, 0x75, 0x45, 0xa3, 0x8e, 0x71, 0x54, 0x82, 0xe7, 0x7f, 0xa3, 0x6e, 0x4c,
+	0x13, 0x28, 0x07, 0x5b, 0x61, 0x78, 0x53, 0xe5, 0xfe, 0x43, 0x3c, 0xe4, 0xee, 0x6b, 0xb1, 0xa9,
+	0x72, 0xc9, 0x4a, 0x65, 0xfa, 0x8b, 0x67, 0xa6, 0x33, 0x9e, 0xbd, 0x9a, 0xf1, 0xec, 0xcf, 0x19,
+	0xcf, 0x5e, 0xce, 0x79, 0xe6, 0x6a, 0xce, 0x33, 0x5f, 0xe7, 0x3c, 0xf3, 0x66, 0xc7, 0xb2, 0xc9,
+	0x60, 0xd2, 0x15, 0x7b, 0x78, 0x14, 0xfc, 0xe5, 0xc1, 0xa3, 0xe4, 0xf5, 0xdf, 0x4a, 0x67, 0xf4,
+	0xa6, 0xe8, 0x26, 0xfc, 0x59, 0xbe, 0xf8, 0x3d, 0x00, 0x85, 0xc5, 0x4c, 0xe8, 0x4e, 0x04, 0x00,
+	0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -169,6 +342,94 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FilteredGenericAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FilteredGenericAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FilteredGenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Constraints) > 0 {
+		for iNdEx := len(m.Constraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Constraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Operator != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Operator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -236,6 +497,47 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *FilteredGenericAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Constraints) > 0 {
+		for _, e := range m.Constraints {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Operator != 0 {
+		n += 1 + sovAuthz(uint64(m.Operator))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -339,6 +641,255 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FilteredGenericAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FilteredGenericAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FilteredGenericAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constraints = append(m.Constraints, FieldConstraint{})
+			if err := m.Constraints[len(m.Constraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldConstraint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldConstraint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= ConstraintOperator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
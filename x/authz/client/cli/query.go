@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -28,6 +29,7 @@ func GetQueryCmd() *cobra.Command {
 	authorizationQueryCmd.AddCommand(
 		GetCmdQueryGrants(),
 		GetQueryGranterGrants(),
+		GetCmdQuerySimulateExec(),
 	)
 
 	return authorizationQueryCmd
@@ -140,3 +142,48 @@ $ %s q %s granter-grants cosmos1skj..
 	flags.AddPaginationFlagsToCmd(cmd, "granter-grants")
 	return cmd
 }
+
+// GetCmdQuerySimulateExec implements the query simulating the authorization of
+// the messages of a MsgExec.
+func GetCmdQuerySimulateExec() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-exec [grantee-addr] [tx-json-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "simulate the authorization of the messages of a tx executed by a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate the authorization of the messages of a tx executed by a grantee
+on behalf of granter accounts, reporting for each message whether it would be
+authorized and why not, without executing the messages.
+Examples:
+$ %s query %s simulate-exec cosmos1skj.. tx.json
+`,
+				version.AppName, authz.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := authz.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulateExec(cmd.Context(), authz.NewQuerySimulateExecRequest(grantee, theTx.GetMsgs()))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
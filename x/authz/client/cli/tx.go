@@ -26,6 +26,7 @@ const (
 	FlagExpiration        = "expiration"
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
+	FlagConstraint        = "constraint"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -61,7 +62,9 @@ func NewCmdGrantAuthorization() *cobra.Command {
 Examples:
  $ %s tx %s grant cosmos1skjw.. send %s --spend-limit=1000stake --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1beta1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. generic --msg-type=%s --constraint="amount <= 100stake" --constraint="to_address in cosmos1a..,cosmos1b.." --from=cosmos1sk..
+	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName,
+				version.AppName, authz.ModuleName, sdk.MsgTypeURL(&bank.MsgSend{})),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -104,7 +107,24 @@ Examples:
 					return err
 				}
 
-				authorization = authz.NewGenericAuthorization(msgType)
+				constraints, err := cmd.Flags().GetStringArray(FlagConstraint)
+				if err != nil {
+					return err
+				}
+
+				if len(constraints) == 0 {
+					authorization = authz.NewGenericAuthorization(msgType)
+					break
+				}
+
+				fieldConstraints := make([]authz.FieldConstraint, len(constraints))
+				for i, c := range constraints {
+					if fieldConstraints[i], err = authz.ParseFieldConstraint(c); err != nil {
+						return err
+					}
+				}
+
+				authorization = authz.NewFilteredGenericAuthorization(msgType, fieldConstraints)
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -173,6 +193,7 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
+	cmd.Flags().StringArray(FlagConstraint, []string{}, "Constraint \"<field> <operator> <value>\" on a field of the messages of a generic authorization, e.g. \"amount <= 100stake\", which can be repeated")
 	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
 	return cmd
 }
@@ -5,7 +5,8 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
-// AccountKeeper defines the expected account keeper (noalias)
+// AccountKeeper defines the expected account keeper (noalias). It is only
+// read from, and the app may provide the read-only view of the auth keeper.
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 }
@@ -0,0 +1,402 @@
+package authz
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// gasCostPerConstraint is the gas consumed to evaluate each constraint of a
+// FilteredGenericAuthorization.
+const gasCostPerConstraint = uint64(10)
+
+var (
+	_ Authorization = &FilteredGenericAuthorization{}
+)
+
+// constraintOperators are the operators of the constraints in their text form,
+// as parsed by ParseFieldConstraint.
+var constraintOperators = map[ConstraintOperator]string{
+	ConstraintOperator_CONSTRAINT_OPERATOR_EQUAL:                 "==",
+	ConstraintOperator_CONSTRAINT_OPERATOR_NOT_EQUAL:             "!=",
+	ConstraintOperator_CONSTRAINT_OPERATOR_LESS_THAN:             "<",
+	ConstraintOperator_CONSTRAINT_OPERATOR_LESS_THAN_OR_EQUAL:    "<=",
+	ConstraintOperator_CONSTRAINT_OPERATOR_GREATER_THAN:          ">",
+	ConstraintOperator_CONSTRAINT_OPERATOR_GREATER_THAN_OR_EQUAL: ">=",
+	ConstraintOperator_CONSTRAINT_OPERATOR_IN:                    "in",
+	ConstraintOperator_CONSTRAINT_OPERATOR_NOT_IN:                "not_in",
+}
+
+// NewFilteredGenericAuthorization creates a new FilteredGenericAuthorization
+// object.
+func NewFilteredGenericAuthorization(msgTypeURL string, constraints []FieldConstraint) *FilteredGenericAuthorization {
+	return &FilteredGenericAuthorization{
+		Msg:         msgTypeURL,
+		Constraints: constraints,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a FilteredGenericAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept. The message is only accepted if its
+// fields satisfy all the constraints.
+func (a FilteredGenericAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	var fields interface{}
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	if err := dec.Decode(&fields); err != nil {
+		return AcceptResponse{}, err
+	}
+
+	for _, c := range a.Constraints {
+		ctx.GasMeter().ConsumeGas(gasCostPerConstraint, "filtered generic authorization")
+		if err := c.evaluate(fields); err != nil {
+			return AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "constraint %s not satisfied: %s", c, err)
+		}
+	}
+
+	return AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a FilteredGenericAuthorization) ValidateBasic() error {
+	if len(a.Constraints) == 0 {
+		return sdkerrors.Wrap(ErrInvalidConstraint, "no constraints, use a GenericAuthorization instead")
+	}
+
+	for _, c := range a.Constraints {
+		if err := c.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewFieldConstraint creates a new FieldConstraint object.
+func NewFieldConstraint(field string, operator ConstraintOperator, values ...string) FieldConstraint {
+	return FieldConstraint{
+		Field:    field,
+		Operator: operator,
+		Values:   values,
+	}
+}
+
+// ParseFieldConstraint parses a constraint of the form "<field> <operator>
+// <value>", where the operator is one of ==, !=, <, <=, >, >=, in and not_in,
+// e.g. "amount <= 100stake" or "to_address in cosmos1...,cosmos1...". The
+// values of the in and not_in operators are separated by commas.
+func ParseFieldConstraint(s string) (FieldConstraint, error) {
+	parts := strings.Fields(s)
+	if len(parts) != 3 {
+		return FieldConstraint{}, sdkerrors.Wrapf(ErrInvalidConstraint, "%q is not of the form \"<field> <operator> <value>\"", s)
+	}
+
+	for operator, text := range constraintOperators {
+		if parts[1] != text {
+			continue
+		}
+
+		values := []string{parts[2]}
+		if operator == ConstraintOperator_CONSTRAINT_OPERATOR_IN || operator == ConstraintOperator_CONSTRAINT_OPERATOR_NOT_IN {
+			values = strings.Split(parts[2], ",")
+		}
+
+		c := NewFieldConstraint(parts[0], operator, values...)
+		return c, c.ValidateBasic()
+	}
+
+	return FieldConstraint{}, sdkerrors.Wrapf(ErrInvalidConstraint, "unknown operator %s", parts[1])
+}
+
+// String returns the constraint in the form parsed by ParseFieldConstraint.
+func (c FieldConstraint) String() string {
+	operator, ok := constraintOperators[c.Operator]
+	if !ok {
+		operator = c.Operator.String()
+	}
+
+	return fmt.Sprintf("%s %s %s", c.Field, operator, strings.Join(c.Values, ","))
+}
+
+// ValidateBasic performs a stateless validation of the constraint.
+func (c FieldConstraint) ValidateBasic() error {
+	for _, name := range strings.Split(c.Field, ".") {
+		if name == "" {
+			return sdkerrors.Wrapf(ErrInvalidConstraint, "invalid field %q", c.Field)
+		}
+	}
+
+	switch c.Operator {
+	case ConstraintOperator_CONSTRAINT_OPERATOR_IN, ConstraintOperator_CONSTRAINT_OPERATOR_NOT_IN:
+		if len(c.Values) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidConstraint, "no values for operator %s", c.Operator)
+		}
+
+	case ConstraintOperator_CONSTRAINT_OPERATOR_EQUAL, ConstraintOperator_CONSTRAINT_OPERATOR_NOT_EQUAL:
+		if len(c.Values) != 1 {
+			return sdkerrors.Wrapf(ErrInvalidConstraint, "operator %s takes a single value, got %d", c.Operator, len(c.Values))
+		}
+
+	case ConstraintOperator_CONSTRAINT_OPERATOR_LESS_THAN, ConstraintOperator_CONSTRAINT_OPERATOR_LESS_THAN_OR_EQUAL,
+		ConstraintOperator_CONSTRAINT_OPERATOR_GREATER_THAN, ConstraintOperator_CONSTRAINT_OPERATOR_GREATER_THAN_OR_EQUAL:
+		if len(c.Values) != 1 {
+			return sdkerrors.Wrapf(ErrInvalidConstraint, "operator %s takes a single value, got %d", c.Operator, len(c.Values))
+		}
+
+		_, decErr := sdk.NewDecFromStr(c.Values[0])
+		_, coinsErr := sdk.ParseCoinsNormalized(c.Values[0])
+		if decErr != nil && coinsErr != nil {
+			return sdkerrors.Wrapf(ErrInvalidConstraint, "%s is neither a decimal nor coins", c.Values[0])
+		}
+
+	default:
+		return sdkerrors.Wrapf(ErrInvalidConstraint, "invalid operator %s", c.Operator)
+	}
+
+	return nil
+}
+
+// evaluate returns an error if the field of the JSON decoded message doesn't
+// satisfy the constraint.
+func (c FieldConstraint) evaluate(msg interface{}) error {
+	values, err := lookupField(msg, strings.Split(c.Field, "."))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range values {
+		// the coins are compared as a whole, the other lists per element
+		if list, ok := v.([]interface{}); ok && !isCoins(list) {
+			for _, elem := range list {
+				if err := c.compare(elem); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if err := c.compare(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compare returns an error if a value of the field doesn't satisfy the
+// constraint.
+func (c FieldConstraint) compare(v interface{}) error {
+	coins, isCoinsValue, err := toCoins(v)
+	if err != nil {
+		return err
+	}
+
+	switch c.Operator {
+	case ConstraintOperator_CONSTRAINT_OPERATOR_EQUAL, ConstraintOperator_CONSTRAINT_OPERATOR_NOT_EQUAL,
+		ConstraintOperator_CONSTRAINT_OPERATOR_IN, ConstraintOperator_CONSTRAINT_OPERATOR_NOT_IN:
+		s, err := toString(v)
+		if isCoinsValue {
+			s, err = coins.String(), nil
+		}
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, value := range c.Values {
+			if isCoinsValue {
+				// the values are normalized to compare the coins regardless of
+				// their order
+				if parsed, err := sdk.ParseCoinsNormalized(value); err == nil {
+					value = parsed.String()
+				}
+			}
+			if s == value {
+				found = true
+				break
+			}
+		}
+
+		negated := c.Operator == ConstraintOperator_CONSTRAINT_OPERATOR_NOT_EQUAL || c.Operator == ConstraintOperator_CONSTRAINT_OPERATOR_NOT_IN
+		if found == negated {
+			return fmt.Errorf("got %s", s)
+		}
+
+		return nil
+	}
+
+	if isCoinsValue {
+		bound, err := sdk.ParseCoinsNormalized(c.Values[0])
+		if err != nil {
+			return fmt.Errorf("%s aren't coins", c.Values[0])
+		}
+
+		var ok bool
+		switch c.Operator {
+		case ConstraintOperator_CONSTRAINT_OPERATOR_LESS_THAN:
+			ok = coins.IsAllLT(bound)
+		case ConstraintOperator_CONSTRAINT_OPERATOR_LESS_THAN_OR_EQUAL:
+			ok = coins.IsAllLTE(bound)
+		case ConstraintOperator_CONSTRAINT_OPERATOR_GREATER_THAN:
+			ok = coins.IsAllGT(bound)
+		case ConstraintOperator_CONSTRAINT_OPERATOR_GREATER_THAN_OR_EQUAL:
+			ok = coins.IsAllGTE(bound)
+		}
+		if !ok {
+			return fmt.Errorf("got %s", coins)
+		}
+
+		return nil
+	}
+
+	s, err := toString(v)
+	if err != nil {
+		return err
+	}
+	dec, err := sdk.NewDecFromStr(s)
+	if err != nil {
+		return fmt.Errorf("%s isn't a decimal", s)
+	}
+	bound, err := sdk.NewDecFromStr(c.Values[0])
+	if err != nil {
+		return fmt.Errorf("%s isn't a decimal", c.Values[0])
+	}
+
+	var ok bool
+	switch c.Operator {
+	case ConstraintOperator_CONSTRAINT_OPERATOR_LESS_THAN:
+		ok = dec.LT(bound)
+	case ConstraintOperator_CONSTRAINT_OPERATOR_LESS_THAN_OR_EQUAL:
+		ok = dec.LTE(bound)
+	case ConstraintOperator_CONSTRAINT_OPERATOR_GREATER_THAN:
+		ok = dec.GT(bound)
+	case ConstraintOperator_CONSTRAINT_OPERATOR_GREATER_THAN_OR_EQUAL:
+		ok = dec.GTE(bound)
+	}
+	if !ok {
+		return fmt.Errorf("got %s", s)
+	}
+
+	return nil
+}
+
+// lookupField returns the values of the field at the path in the JSON decoded
+// message, one per element of the lists along the path.
+func lookupField(v interface{}, path []string) ([]interface{}, error) {
+	if len(path) == 0 {
+		return []interface{}{v}, nil
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		child, ok := v[path[0]]
+		if !ok {
+			return nil, fmt.Errorf("no field %s", path[0])
+		}
+
+		return lookupField(child, path[1:])
+
+	case []interface{}:
+		var values []interface{}
+		for _, elem := range v {
+			elemValues, err := lookupField(elem, path)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, elemValues...)
+		}
+
+		return values, nil
+
+	default:
+		return nil, fmt.Errorf("no field %s", path[0])
+	}
+}
+
+// isCoin returns whether a JSON decoded value is a coin.
+func isCoin(v interface{}) bool {
+	m, ok := v.(map[string]interface{})
+	if !ok || len(m) != 2 {
+		return false
+	}
+
+	_, denomOK := m["denom"].(string)
+	_, amountOK := m["amount"].(string)
+	return denomOK && amountOK
+}
+
+// isCoins returns whether a non-empty JSON decoded list is a list of coins.
+func isCoins(list []interface{}) bool {
+	if len(list) == 0 {
+		return false
+	}
+
+	for _, elem := range list {
+		if !isCoin(elem) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// toCoins returns the coins of a JSON decoded coin or list of coins, and
+// whether the value is coins.
+func toCoins(v interface{}) (sdk.Coins, bool, error) {
+	var list []interface{}
+	switch v := v.(type) {
+	case []interface{}:
+		if !isCoins(v) {
+			return nil, false, nil
+		}
+		list = v
+
+	default:
+		if !isCoin(v) {
+			return nil, false, nil
+		}
+		list = []interface{}{v}
+	}
+
+	coins := make(sdk.Coins, len(list))
+	for i, elem := range list {
+		m := elem.(map[string]interface{})
+		amount, ok := sdk.NewIntFromString(m["amount"].(string))
+		if !ok {
+			return nil, false, fmt.Errorf("invalid coin amount %s", m["amount"])
+		}
+		coins[i] = sdk.Coin{Denom: m["denom"].(string), Amount: amount}
+	}
+
+	return coins.Sort(), true, nil
+}
+
+// toString returns the text of a JSON decoded scalar value.
+func toString(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return fmt.Sprintf("%t", v), nil
+	case nil:
+		return "", nil
+	default:
+		return "", fmt.Errorf("field isn't a scalar")
+	}
+}
@@ -0,0 +1,130 @@
+package authz_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	alice = sdk.AccAddress("alice_______________")
+	bob   = sdk.AccAddress("bob_________________")
+	carol = sdk.AccAddress("carol_______________")
+)
+
+func mustParseConstraint(t *testing.T, s string) authz.FieldConstraint {
+	c, err := authz.ParseFieldConstraint(s)
+	require.NoError(t, err)
+	return c
+}
+
+func TestParseFieldConstraint(t *testing.T) {
+	c := mustParseConstraint(t, "to_address in a,b")
+	require.Equal(t, authz.NewFieldConstraint("to_address", authz.ConstraintOperator_CONSTRAINT_OPERATOR_IN, "a", "b"), c)
+	require.Equal(t, "to_address in a,b", c.String())
+
+	c = mustParseConstraint(t, "amount <= 100stake,10atom")
+	require.Equal(t, authz.NewFieldConstraint("amount", authz.ConstraintOperator_CONSTRAINT_OPERATOR_LESS_THAN_OR_EQUAL, "100stake,10atom"), c)
+
+	for _, s := range []string{
+		"amount<=100stake",
+		"amount ~ 100stake",
+		"amount < foo",
+		".amount == 1",
+		"amount <= 1 2",
+	} {
+		_, err := authz.ParseFieldConstraint(s)
+		require.ErrorIs(t, err, authz.ErrInvalidConstraint, s)
+	}
+}
+
+func TestFilteredGenericAuthorizationValidateBasic(t *testing.T) {
+	msgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	require.NoError(t, authz.NewFilteredGenericAuthorization(msgType, []authz.FieldConstraint{mustParseConstraint(t, "amount < 10stake")}).ValidateBasic())
+
+	require.ErrorIs(t, authz.NewFilteredGenericAuthorization(msgType, nil).ValidateBasic(), authz.ErrInvalidConstraint)
+
+	for _, c := range []authz.FieldConstraint{
+		authz.NewFieldConstraint("", authz.ConstraintOperator_CONSTRAINT_OPERATOR_EQUAL, "a"),
+		authz.NewFieldConstraint("to_address", authz.ConstraintOperator_CONSTRAINT_OPERATOR_UNSPECIFIED, "a"),
+		authz.NewFieldConstraint("to_address", authz.ConstraintOperator_CONSTRAINT_OPERATOR_EQUAL, "a", "b"),
+		authz.NewFieldConstraint("to_address", authz.ConstraintOperator_CONSTRAINT_OPERATOR_IN),
+		authz.NewFieldConstraint("amount", authz.ConstraintOperator_CONSTRAINT_OPERATOR_LESS_THAN, "a"),
+	} {
+		a := authz.NewFilteredGenericAuthorization(msgType, []authz.FieldConstraint{c})
+		require.ErrorIs(t, a.ValidateBasic(), authz.ErrInvalidConstraint, c.String())
+	}
+}
+
+func TestFilteredGenericAuthorizationAccept(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	send := func(amount string, to sdk.AccAddress) sdk.Msg {
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		require.NoError(t, err)
+		return banktypes.NewMsgSend(alice, to, coins)
+	}
+	multiSend := func(to ...sdk.AccAddress) sdk.Msg {
+		coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+		msg := &banktypes.MsgMultiSend{Inputs: []banktypes.Input{banktypes.NewInput(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", int64(len(to)))))}}
+		for _, addr := range to {
+			msg.Outputs = append(msg.Outputs, banktypes.NewOutput(addr, coins))
+		}
+		return msg
+	}
+
+	testCases := []struct {
+		constraints []string
+		msg         sdk.Msg
+		accept      bool
+	}{
+		{[]string{"amount <= 100stake"}, send("100stake", bob), true},
+		{[]string{"amount <= 100stake"}, send("101stake", bob), false},
+		{[]string{"amount < 100stake"}, send("100stake", bob), false},
+		// the denoms not in the limit aren't allowed
+		{[]string{"amount <= 100stake"}, send("10stake,1atom", bob), false},
+		{[]string{"amount <= 100stake,10atom"}, send("10stake,1atom", bob), true},
+		{[]string{"amount >= 10stake"}, send("9stake", bob), false},
+		{[]string{"amount == 10atom,5stake"}, send("5stake,10atom", bob), true},
+		{[]string{"to_address in " + bob.String() + "," + carol.String()}, send("1stake", carol), true},
+		{[]string{"to_address in " + bob.String()}, send("1stake", carol), false},
+		{[]string{"to_address not_in " + bob.String()}, send("1stake", carol), true},
+		{[]string{"to_address != " + carol.String()}, send("1stake", carol), false},
+		{[]string{"amount <= 100stake", "to_address == " + bob.String()}, send("1stake", carol), false},
+		// the constraints apply to each element of the lists
+		{[]string{"outputs.address in " + bob.String() + "," + carol.String()}, multiSend(bob, carol), true},
+		{[]string{"outputs.address in " + bob.String()}, multiSend(bob, carol), false},
+		{[]string{"outputs.coins <= 1stake"}, multiSend(bob, carol), true},
+		{[]string{"proposal_id <= 2"}, govtypes.NewMsgVote(alice, 2, govtypes.OptionYes), true},
+		{[]string{"proposal_id > 2"}, govtypes.NewMsgVote(alice, 2, govtypes.OptionYes), false},
+		{[]string{"option == VOTE_OPTION_YES"}, govtypes.NewMsgVote(alice, 2, govtypes.OptionYes), true},
+		{[]string{"option == VOTE_OPTION_YES"}, govtypes.NewMsgVote(alice, 2, govtypes.OptionNo), false},
+		{[]string{"foo == bar"}, send("1stake", bob), false},
+		{[]string{"to_address < 2"}, send("1stake", bob), false},
+	}
+
+	for _, tc := range testCases {
+		constraints := make([]authz.FieldConstraint, len(tc.constraints))
+		for i, c := range tc.constraints {
+			constraints[i] = mustParseConstraint(t, c)
+		}
+		a := authz.NewFilteredGenericAuthorization(sdk.MsgTypeURL(tc.msg), constraints)
+
+		resp, err := a.Accept(ctx, tc.msg)
+		if tc.accept {
+			require.NoError(t, err, tc.constraints)
+			require.Equal(t, authz.AcceptResponse{Accept: true}, resp)
+		} else {
+			require.ErrorIs(t, err, sdkerrors.ErrUnauthorized, tc.constraints)
+		}
+	}
+}
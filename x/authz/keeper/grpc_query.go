@@ -142,3 +142,45 @@ func unmarshalAuthorization(cdc codec.BinaryCodec, value []byte) (v authz.Grant,
 	err = cdc.Unmarshal(value, &v)
 	return v, err
 }
+
+// SimulateExec implements the Query/SimulateExec gRPC method. The messages are
+// authorized in order on a branch of the state which is discarded, each of
+// them regardless of the denial of the previous ones.
+func (k Keeper) SimulateExec(c context.Context, req *authz.QuerySimulateExecRequest) (*authz.QuerySimulateExecResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Msgs) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "messages cannot be empty")
+	}
+
+	msgs, err := req.GetMessages()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+	results := make([]*authz.ExecAuthorizationResult, len(msgs))
+	for i, msg := range msgs {
+		result := &authz.ExecAuthorizationResult{MsgTypeUrl: sdk.MsgTypeURL(msg)}
+
+		granter, err := k.authorize(ctx, grantee, msg)
+		if granter != nil {
+			result.Granter = granter.String()
+		}
+		result.Authorized = err == nil
+		if err != nil {
+			result.Reason = err.Error()
+		}
+
+		results[i] = result
+	}
+
+	return &authz.QuerySimulateExecResponse{Results: results}, nil
+}
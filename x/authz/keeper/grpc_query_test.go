@@ -239,3 +239,48 @@ func (suite *TestSuite) TestGRPCQueryGranterGrants() {
 		})
 	}
 }
+
+func (suite *TestSuite) TestGRPCQuerySimulateExec() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+	granter, grantee := addrs[0], addrs[1]
+	require := suite.Require()
+
+	_, err := queryClient.SimulateExec(gocontext.Background(), &authz.QuerySimulateExecRequest{Grantee: grantee.String()})
+	require.Error(err)
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	err = app.AuthzKeeper.SaveGrant(ctx, grantee, granter, &banktypes.SendAuthorization{SpendLimit: spendLimit}, ctx.BlockTime().Add(time.Hour))
+	require.NoError(err)
+
+	send := func(from sdk.AccAddress, amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(from, addrs[2], sdk.NewCoins(sdk.NewInt64Coin("steak", amount)))
+	}
+	res, err := queryClient.SimulateExec(gocontext.Background(), authz.NewQuerySimulateExecRequest(grantee, []sdk.Msg{
+		send(granter, 60),
+		// the spend limit was decreased by the first message
+		send(granter, 60),
+		send(granter, 40),
+		// the messages of the grantee are implicitly authorized
+		send(grantee, 1000),
+		banktypes.NewMsgMultiSend(
+			[]banktypes.Input{banktypes.NewInput(granter, spendLimit)},
+			[]banktypes.Output{banktypes.NewOutput(addrs[2], spendLimit)},
+		),
+	}))
+	require.NoError(err)
+
+	sendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	require.Len(res.Results, 5)
+	require.Equal(&authz.ExecAuthorizationResult{MsgTypeUrl: sendType, Granter: granter.String(), Authorized: true}, res.Results[0])
+	require.False(res.Results[1].Authorized)
+	require.Contains(res.Results[1].Reason, "requested amount is more than spend limit")
+	require.Equal(&authz.ExecAuthorizationResult{MsgTypeUrl: sendType, Granter: granter.String(), Authorized: true}, res.Results[2])
+	require.Equal(&authz.ExecAuthorizationResult{MsgTypeUrl: sendType, Granter: grantee.String(), Authorized: true}, res.Results[3])
+	require.Equal(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), res.Results[4].MsgTypeUrl)
+	require.False(res.Results[4].Authorized)
+	require.Contains(res.Results[4].Reason, "authorization not found")
+
+	// the simulation doesn't update the grants
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, grantee, granter, sendType)
+	require.Equal(&banktypes.SendAuthorization{SpendLimit: spendLimit}, authorization)
+}
@@ -76,33 +76,8 @@ func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccA
 func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
 	var results = make([][]byte, len(msgs))
 	for i, msg := range msgs {
-		signers := msg.GetSigners()
-		if len(signers) != 1 {
-			return nil, sdkerrors.ErrInvalidRequest.Wrap("authorization can be given to msg with only one signer")
-		}
-		granter := signers[0]
-
-		// if granter != grantee then check authorization.Accept, otherwise we implicitly accept.
-		if !granter.Equals(grantee) {
-			authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, sdk.MsgTypeURL(msg))
-			if authorization == nil {
-				return nil, sdkerrors.ErrUnauthorized.Wrap("authorization not found")
-			}
-			resp, err := authorization.Accept(ctx, msg)
-			if err != nil {
-				return nil, err
-			}
-			if resp.Delete {
-				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
-			} else if resp.Updated != nil {
-				err = k.update(ctx, grantee, granter, resp.Updated)
-			}
-			if err != nil {
-				return nil, err
-			}
-			if !resp.Accept {
-				return nil, sdkerrors.ErrUnauthorized
-			}
+		if _, err := k.authorize(ctx, grantee, msg); err != nil {
+			return nil, err
 		}
 
 		handler := k.router.Handler(msg)
@@ -128,6 +103,44 @@ func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []
 	return results, nil
 }
 
+// authorize checks that the grantee is authorized to execute the message on
+// behalf of its signer, the granter, which it returns. The authorization of the
+// grant is updated or deleted as requested by its acceptance of the message.
+func (k Keeper) authorize(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) (sdk.AccAddress, error) {
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("authorization can be given to msg with only one signer")
+	}
+	granter := signers[0]
+
+	// if granter != grantee then check authorization.Accept, otherwise we implicitly accept.
+	if granter.Equals(grantee) {
+		return granter, nil
+	}
+
+	authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+	if authorization == nil {
+		return granter, sdkerrors.ErrUnauthorized.Wrap("authorization not found")
+	}
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return granter, err
+	}
+	if resp.Delete {
+		err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+	} else if resp.Updated != nil {
+		err = k.update(ctx, grantee, granter, resp.Updated)
+	}
+	if err != nil {
+		return granter, err
+	}
+	if !resp.Accept {
+		return granter, sdkerrors.ErrUnauthorized
+	}
+
+	return granter, nil
+}
+
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
 // with the provided expiration time. If there is an existing authorization grant for the
 // same `sdk.Msg` type, this grant overwrites that.
@@ -188,7 +201,7 @@ func (k Keeper) GetCleanAuthorization(ctx sdk.Context, grantee sdk.AccAddress, g
 	if !found {
 		return nil, time.Time{}
 	}
-	if grant.Expiration.Before(ctx.BlockHeader().Time) {
+	if grant.Expiration.Before(ctx.BlockClock().Now()) {
 		k.DeleteGrant(ctx, grantee, granter, msgType)
 		return nil, time.Time{}
 	}
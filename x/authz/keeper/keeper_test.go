@@ -251,3 +251,22 @@ func (s *TestSuite) TestDispatchedEvents() {
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
+
+func (s *TestSuite) TestGrantExpirationInPast() {
+	app, addrs := s.app, s.addrs
+	// the block time is far from the local time of the node, which the
+	// expiration must not be checked against
+	ctx := s.ctx.WithBlockTime(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC))
+	authorization := &banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))}
+
+	msg, err := authz.NewMsgGrant(addrs[0], addrs[1], authorization, ctx.BlockClock().Now().Add(-time.Hour))
+	s.Require().NoError(err)
+	s.Require().NoError(msg.ValidateBasic())
+	_, err = app.AuthzKeeper.Grant(sdk.WrapSDKContext(ctx), msg)
+	s.Require().ErrorIs(err, authz.ErrInvalidExpirationTime)
+
+	msg, err = authz.NewMsgGrant(addrs[0], addrs[1], authorization, ctx.BlockClock().After(time.Hour))
+	s.Require().NoError(err)
+	_, err = app.AuthzKeeper.Grant(sdk.WrapSDKContext(ctx), msg)
+	s.Require().NoError(err)
+}
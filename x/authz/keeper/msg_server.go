@@ -26,6 +26,9 @@ func (k Keeper) Grant(goCtx context.Context, msg *authz.MsgGrant) (*authz.MsgGra
 	if authorization == nil {
 		return nil, sdkerrors.ErrUnpackAny.Wrap("Authorization is not present in the msg")
 	}
+	if msg.Grant.Expiration.Before(ctx.BlockClock().Now()) {
+		return nil, sdkerrors.Wrap(authz.ErrInvalidExpirationTime, "Time can't be in the past")
+	}
 	t := authorization.MsgTypeURL()
 	if k.router.HandlerByTypeURL(t) == nil {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%s doesn't exist.", t)
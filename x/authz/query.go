@@ -0,0 +1,47 @@
+package authz
+
+import (
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ cdctypes.UnpackInterfacesMessage = &QuerySimulateExecRequest{}
+
+// NewQuerySimulateExecRequest creates a new QuerySimulateExecRequest of the
+// messages of a MsgExec of the grantee.
+//
+//nolint:interfacer
+func NewQuerySimulateExecRequest(grantee sdk.AccAddress, msgs []sdk.Msg) *QuerySimulateExecRequest {
+	return &QuerySimulateExecRequest{
+		Grantee: grantee.String(),
+		Msgs:    NewMsgExec(grantee, msgs).Msgs,
+	}
+}
+
+// GetMessages returns the cache values from the QuerySimulateExecRequest.Msgs
+// if present.
+func (req QuerySimulateExecRequest) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(req.Msgs))
+	for i, msgAny := range req.Msgs {
+		msg, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.MsgRequest", msgAny)
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (req QuerySimulateExecRequest) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, x := range req.Msgs {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(x, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
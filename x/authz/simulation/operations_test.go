@@ -50,8 +50,10 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		opMsgRoute string
 		opMsgName  string
 	}{
-		{simulation.WeightGrant, authz.ModuleName, simulation.TypeMsgGrant},
-		{simulation.WeightRevoke, authz.ModuleName, simulation.TypeMsgRevoke},
+		// the grant expires a year after the zero block time of the context,
+		// which is valid as the expiration is checked against the block time
+		{simulation.WeightGrant, simulation.TypeMsgGrant, simulation.TypeMsgGrant},
+		{simulation.WeightRevoke, simulation.TypeMsgRevoke, simulation.TypeMsgRevoke},
 		{simulation.WeightExec, authz.ModuleName, simulation.TypeMsgExec},
 	}
 
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// FlagDisplayDenoms is the flag of the commands converting the amounts they
+// accept and print from and to the display denominations of the bank denom
+// metadata.
+const FlagDisplayDenoms = "display-denoms"
+
+// DenomConverter converts amounts between the denomination units of the bank
+// denom metadata, e.g. between 12.5atom and 12500000uatom, so that users can
+// enter and read amounts in display denominations.
+type DenomConverter struct {
+	// metadata by base denom
+	metadata map[string]types.Metadata
+	// base denoms by denom unit and alias
+	bases map[string]string
+}
+
+// NewDenomConverter returns a DenomConverter of the given denom metadata.
+func NewDenomConverter(metadata []types.Metadata) DenomConverter {
+	c := DenomConverter{
+		metadata: make(map[string]types.Metadata, len(metadata)),
+		bases:    make(map[string]string),
+	}
+
+	for _, m := range metadata {
+		c.metadata[m.Base] = m
+		for _, unit := range m.DenomUnits {
+			c.bases[unit.Denom] = m.Base
+			for _, alias := range unit.Aliases {
+				c.bases[alias] = m.Base
+			}
+		}
+	}
+
+	return c
+}
+
+// QueryDenomConverter returns a DenomConverter of all the denom metadata of
+// the bank module.
+func QueryDenomConverter(ctx context.Context, queryClient types.QueryClient) (DenomConverter, error) {
+	var (
+		metadata []types.Metadata
+		pageReq  = &query.PageRequest{}
+	)
+
+	for {
+		res, err := queryClient.DenomsMetadata(ctx, &types.QueryDenomsMetadataRequest{Pagination: pageReq})
+		if err != nil {
+			return DenomConverter{}, err
+		}
+
+		metadata = append(metadata, res.Metadatas...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	return NewDenomConverter(metadata), nil
+}
+
+// BaseDenom returns the base denomination of the given denomination unit or
+// alias, or the given denom if it has no metadata.
+func (c DenomConverter) BaseDenom(denom string) string {
+	if base, ok := c.bases[denom]; ok {
+		return base
+	}
+
+	return denom
+}
+
+// ParseCoins parses coins, e.g. "12.5atom,3uosmo", converting the amounts of
+// the denomination units of the denom metadata to their base denomination. The
+// amounts of the denoms without metadata must be integers.
+func (c DenomConverter) ParseCoins(coinsStr string) (sdk.Coins, error) {
+	decCoins, err := sdk.ParseDecCoins(coinsStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ToBaseCoins(decCoins)
+}
+
+// ToBaseCoins converts coins of denomination units of the denom metadata to
+// their base denomination.
+func (c DenomConverter) ToBaseCoins(decCoins sdk.DecCoins) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	for _, decCoin := range decCoins {
+		base, ok := c.bases[decCoin.Denom]
+		if !ok {
+			if !decCoin.Amount.IsInteger() {
+				return nil, fmt.Errorf("no denom metadata to convert %s", decCoin)
+			}
+
+			coins = coins.Add(sdk.NewCoin(decCoin.Denom, decCoin.Amount.TruncateInt()))
+			continue
+		}
+
+		coin, err := c.metadata[base].ConvertToBaseDenom(decCoin)
+		if err != nil {
+			return nil, err
+		}
+		coins = coins.Add(coin)
+	}
+
+	return coins, nil
+}
+
+// ToDisplayCoins converts coins to the display denomination of their denom
+// metadata. The coins without metadata are kept in their denom.
+func (c DenomConverter) ToDisplayCoins(coins sdk.Coins) (sdk.DecCoins, error) {
+	decCoins := sdk.NewDecCoins()
+	for _, coin := range coins {
+		m, ok := c.metadata[coin.Denom]
+		if !ok {
+			decCoins = decCoins.Add(sdk.NewDecCoinFromCoin(coin))
+			continue
+		}
+
+		decCoin, err := m.ConvertToDisplayDenom(coin)
+		if err != nil {
+			return nil, err
+		}
+		decCoins = decCoins.Add(decCoin)
+	}
+
+	return decCoins, nil
+}
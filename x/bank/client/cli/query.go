@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -33,6 +35,8 @@ func GetQueryCmd() *cobra.Command {
 		GetBalancesCmd(),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
+		GetCmdQuerySendEnabled(),
+		GetCmdQueryHolds(),
 	)
 
 	return cmd
@@ -45,11 +49,15 @@ func GetBalancesCmd() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the total balance of an account or of a specific denomination.
 
+With --display-denoms, the balances are printed in the display denominations of the
+bank denom metadata, and the denomination can be any unit of the metadata.
+
 Example:
   $ %s query %s balances [address]
   $ %s query %s balances [address] --denom=[denom]
+  $ %s query %s balances [address] --display-denoms
 `,
-				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(1),
@@ -62,6 +70,10 @@ Example:
 			if err != nil {
 				return err
 			}
+			displayDenoms, err := cmd.Flags().GetBool(FlagDisplayDenoms)
+			if err != nil {
+				return err
+			}
 
 			queryClient := types.NewQueryClient(clientCtx)
 
@@ -75,6 +87,16 @@ Example:
 				return err
 			}
 			ctx := cmd.Context()
+
+			if displayDenoms {
+				converter, err := QueryDenomConverter(ctx, queryClient)
+				if err != nil {
+					return err
+				}
+
+				return printDisplayBalances(ctx, clientCtx, queryClient, converter, addr, converter.BaseDenom(denom), pageReq)
+			}
+
 			if denom == "" {
 				params := types.NewQueryAllBalancesRequest(addr, pageReq)
 				res, err := queryClient.AllBalances(ctx, params)
@@ -84,6 +106,17 @@ Example:
 				return clientCtx.PrintProto(res)
 			}
 
+			// balances are read from the store when a light client verifier is
+			// set, as gRPC query responses cannot be proven
+			if clientCtx.Verifier != nil {
+				balance, err := queryVerifiedBalance(clientCtx, addr, denom)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(&balance)
+			}
+
 			params := types.NewQueryBalanceRequest(addr, denom)
 			res, err := queryClient.Balance(ctx, params)
 			if err != nil {
@@ -95,12 +128,74 @@ Example:
 	}
 
 	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
+	cmd.Flags().Bool(FlagDisplayDenoms, false, "Print the balances in the display denominations of the bank denom metadata")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "all balances")
 
 	return cmd
 }
 
+// displayBalances are the balances of an account in display denominations.
+type displayBalances struct {
+	Balances   sdk.DecCoins        `json:"balances" yaml:"balances"`
+	Pagination *query.PageResponse `json:"pagination,omitempty" yaml:"pagination,omitempty"`
+}
+
+// printDisplayBalances prints the balances of an account, or its balance of
+// the given base denom, converted to the display denominations.
+func printDisplayBalances(
+	ctx context.Context, clientCtx client.Context, queryClient types.QueryClient, converter DenomConverter, addr sdk.AccAddress, denom string, pageReq *query.PageRequest,
+) error {
+	var out displayBalances
+	if denom == "" {
+		res, err := queryClient.AllBalances(ctx, types.NewQueryAllBalancesRequest(addr, pageReq))
+		if err != nil {
+			return err
+		}
+
+		out.Balances, err = converter.ToDisplayCoins(res.Balances)
+		if err != nil {
+			return err
+		}
+		out.Pagination = res.Pagination
+
+		return clientCtx.PrintObjectLegacy(out)
+	}
+
+	res, err := queryClient.Balance(ctx, types.NewQueryBalanceRequest(addr, denom))
+	if err != nil {
+		return err
+	}
+
+	out.Balances, err = converter.ToDisplayCoins(sdk.NewCoins(*res.Balance))
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintObjectLegacy(out)
+}
+
+// queryVerifiedBalance queries the balance of the given denom directly from
+// the bank store, so that the response is verified against the proof returned
+// by the node.
+func queryVerifiedBalance(clientCtx client.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error) {
+	key := append(types.CreateAccountBalancesPrefix(addr), []byte(denom)...)
+
+	bz, _, err := clientCtx.QueryStore(key, types.StoreKey)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amount := sdk.ZeroInt()
+	if bz != nil {
+		if err := amount.Unmarshal(bz); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return sdk.NewCoin(denom, amount), nil
+}
+
 // GetCmdDenomsMetadata defines the cobra command to query client denomination metadata.
 func GetCmdDenomsMetadata() *cobra.Command {
 	cmd := &cobra.Command{
@@ -212,3 +307,92 @@ To query for the total supply of a specific coin denomination use:
 
 	return cmd
 }
+
+// GetCmdQuerySendEnabled defines the cobra command to query the send_enabled
+// overrides of denominations.
+func GetCmdQuerySendEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-enabled [denom1 ...]",
+		Short: "Query the send_enabled overrides of coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the send_enabled overrides set for coin denominations. Denominations
+without an override use the send_enabled params.
+
+Example:
+  To query the overrides of all coin denominations use:
+  $ %s query %s send-enabled
+
+To query the overrides of specific coin denominations use:
+  $ %s query %s send-enabled [denom1] [denom2]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SendEnabled(cmd.Context(), &types.QuerySendEnabledRequest{
+				Denoms:     args,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "send enabled entries")
+
+	return cmd
+}
+
+// GetCmdQueryHolds returns a command querying the holds on the balance of an
+// account.
+func GetCmdQueryHolds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holds [address]",
+		Short: "Query the holds placed on the balance of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the holds placed by modules on the balance of an account, along with
+its held and spendable coins. The held coins stay in the balance of the account but
+can't be spent until they are released.
+
+Example:
+  $ %s query %s holds [address]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Holds(cmd.Context(), &types.QueryHoldsRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -31,6 +33,12 @@ func NewSendTxCmd() *cobra.Command {
 		Use: "send [from_key_or_address] [to_address] [amount]",
 		Short: `Send funds from one account to another. Note, the'--from' flag is
 ignored as it is implied from [from_key_or_address].`,
+		Long: `Send funds from one account to another. Note, the'--from' flag is
+ignored as it is implied from [from_key_or_address].
+
+With --display-denoms, the amount can be given in the denomination units of the
+bank denom metadata, e.g. 12.5atom, which are converted to their base denomination
+before building the message.`,
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.Flags().Set(flags.FlagFrom, args[0])
@@ -43,7 +51,7 @@ ignored as it is implied from [from_key_or_address].`,
 				return err
 			}
 
-			coins, err := sdk.ParseCoinsNormalized(args[2])
+			coins, err := parseAmount(cmd, clientCtx, args[2])
 			if err != nil {
 				return err
 			}
@@ -54,7 +62,24 @@ ignored as it is implied from [from_key_or_address].`,
 		},
 	}
 
+	cmd.Flags().Bool(FlagDisplayDenoms, false, "Convert the amount from the denomination units of the bank denom metadata, e.g. 12.5atom")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseAmount parses the amount of a command, converting it from the display
+// denominations of the bank denom metadata with the display denoms flag.
+func parseAmount(cmd *cobra.Command, clientCtx client.Context, amount string) (sdk.Coins, error) {
+	displayDenoms, _ := cmd.Flags().GetBool(FlagDisplayDenoms)
+	if !displayDenoms {
+		return sdk.ParseCoinsNormalized(amount)
+	}
+
+	converter, err := QueryDenomConverter(cmd.Context(), types.NewQueryClient(clientCtx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the denom metadata: %w", err)
+	}
+
+	return converter.ParseCoins(amount)
+}
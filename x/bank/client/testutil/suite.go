@@ -1,13 +1,16 @@
 package testutil
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -387,6 +390,39 @@ func (s *IntegrationTestSuite) TestNewSendTxCmdGenOnly() {
 	s.Require().Equal([]sdk.Msg{types.NewMsgSend(from, to, amount)}, tx.GetMsgs())
 }
 
+func (s *IntegrationTestSuite) TestNewSendTxCmdDisplayDenoms() {
+	val := s.network.Validators[0]
+
+	args := []string{
+		val.Address.String(),
+		val.Address.String(),
+		"1.5atom,2eth,10stake",
+		fmt.Sprintf("--%s=true", cli.FlagDisplayDenoms),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	}
+
+	bz, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewSendTxCmd(), args)
+	s.Require().NoError(err)
+	tx, err := s.cfg.TxConfig.TxJSONDecoder()(bz.Bytes())
+	s.Require().NoError(err)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000), sdk.NewInt64Coin("wei", 2000000), sdk.NewInt64Coin(s.cfg.BondDenom, 10))
+	s.Require().Equal([]sdk.Msg{types.NewMsgSend(val.Address, val.Address, amount)}, tx.GetMsgs())
+
+	// fractions of the base units and of the denoms without metadata are rejected
+	args[2] = "0.0000001atom"
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewSendTxCmd(), args)
+	s.Require().Error(err)
+
+	args[2] = "1.5stake"
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.NewSendTxCmd(), args)
+	s.Require().Error(err)
+
+	out, err := QueryBalancesExec(val.ClientCtx, val.Address, fmt.Sprintf("--%s=true", cli.FlagDisplayDenoms))
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), fmt.Sprintf(`"denom":"%s"`, s.cfg.BondDenom))
+}
+
 func (s *IntegrationTestSuite) TestNewSendTxCmd() {
 	val := s.network.Validators[0]
 
@@ -471,6 +507,34 @@ func (s *IntegrationTestSuite) TestNewSendTxCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewSendTxCmdFailedTx() {
+	val := s.network.Validators[0]
+
+	cmd := cli.NewSendTxCmd()
+	cmd.SetArgs([]string{
+		val.Address.String(),
+		val.Address.String(),
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String(),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1))).String()),
+	})
+	_, out := testutil.ApplyMockIO(cmd)
+	clientCtx := val.ClientCtx.WithOutput(out)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	// the response of the failed tx is printed and its code returned in the error
+	err := cmd.ExecuteContext(ctx)
+	var txErr client.TxError
+	s.Require().ErrorAs(err, &txErr)
+	s.Require().Equal(client.ExitCodeTxFailed, client.ExitCode(err))
+	s.Require().Equal(sdkerrors.ErrInsufficientFee.ABCICode(), txErr.Response.Code)
+
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(txErr.Response.TxHash, txResp.TxHash)
+}
+
 func NewCoin(denom string, amount sdk.Int) *sdk.Coin {
 	coin := sdk.NewCoin(denom, amount)
 	return &coin
@@ -39,6 +39,19 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, se := range genState.SendEnabled {
+		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
+
+	for _, hold := range genState.Holds {
+		addr, err := sdk.AccAddressFromBech32(hold.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		k.setHold(ctx, addr, hold.Holder, hold.Amount)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -48,10 +61,14 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("unable to fetch total supply %v", err))
 	}
 
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetAccountsBalances(ctx),
 		totalSupply,
 		k.GetAllDenomMetaData(ctx),
 	)
+	genState.SendEnabled = k.GetAllSendEnabledEntries(ctx)
+	genState.Holds = k.GetAllHolds(ctx)
+
+	return genState
 }
@@ -210,3 +210,59 @@ func (k BaseKeeper) DenomOwners(
 
 	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
 }
+
+// SendEnabled implements Query/SendEnabled gRPC method. It returns the
+// send_enabled overrides of the requested denoms, or all of them if no denom is
+// requested.
+func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabledRequest) (*types.QuerySendEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &types.QuerySendEnabledResponse{}
+	if len(req.Denoms) > 0 {
+		for _, denom := range req.Denoms {
+			if se, found := k.GetSendEnabledEntry(ctx, denom); found {
+				resp.SendEnabled = append(resp.SendEnabled, &se)
+			}
+		}
+
+		return resp, nil
+	}
+
+	store := k.getSendEnabledPrefixStore(ctx)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		resp.SendEnabled = append(resp.SendEnabled, types.NewSendEnabled(string(key), bytesToBool(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp.Pagination = pageRes
+
+	return resp, nil
+}
+
+// Holds implements the Query/Holds gRPC method
+func (k BaseKeeper) Holds(goCtx context.Context, req *types.QueryHoldsRequest) (*types.QueryHoldsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &types.QueryHoldsResponse{Held: sdk.NewCoins()}
+	k.IterateAccountHolds(ctx, addr, func(hold types.Hold) bool {
+		resp.Holds = append(resp.Holds, hold)
+		resp.Held = resp.Held.Add(hold.Amount...)
+		return false
+	})
+	resp.Spendable = k.SpendableCoins(ctx, addr)
+
+	return resp, nil
+}
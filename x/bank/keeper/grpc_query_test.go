@@ -312,6 +312,27 @@ func (suite *IntegrationTestSuite) QueryDenomMetadataRequest() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestQuerySendEnabled() {
+	app, ctx := suite.app, suite.ctx
+
+	app.BankKeeper.SetSendEnabled(ctx, fooDenom, false)
+	app.BankKeeper.SetSendEnabled(ctx, barDenom, true)
+
+	res, err := suite.queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{types.NewSendEnabled(barDenom, true), types.NewSendEnabled(fooDenom, false)}, res.SendEnabled)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = suite.queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{Pagination: &query.PageRequest{Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{types.NewSendEnabled(barDenom, true)}, res.SendEnabled)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = suite.queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{Denoms: []string{fooDenom, "unknown"}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{types.NewSendEnabled(fooDenom, false)}, res.SendEnabled)
+}
+
 func (suite *IntegrationTestSuite) TestGRPCDenomOwners() {
 	ctx := suite.ctx
 
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// holdHooks holds the hooks notified of the releases of the holds.
+type holdHooks struct {
+	hooks types.HoldHooks
+}
+
+// GetHold returns the coins of an account held by the given holder.
+func (k BaseViewKeeper) GetHold(ctx sdk.Context, addr sdk.AccAddress, holder string) sdk.Coins {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateHoldKey(addr, holder))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	var hold types.Hold
+	k.cdc.MustUnmarshal(bz, &hold)
+
+	return hold.Amount
+}
+
+// GetHeldCoins returns the coins of an account held by all the holders.
+func (k BaseViewKeeper) GetHeldCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	held := sdk.NewCoins()
+	k.IterateAccountHolds(ctx, addr, func(hold types.Hold) bool {
+		held = held.Add(hold.Amount...)
+		return false
+	})
+
+	return held
+}
+
+// IterateAccountHolds iterates over the holds on the balance of an account,
+// ordered by holder. If true is returned from the callback, iteration is
+// halted.
+func (k BaseViewKeeper) IterateAccountHolds(ctx sdk.Context, addr sdk.AccAddress, cb func(types.Hold) bool) {
+	holdsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateAccountHoldsPrefix(addr))
+
+	iterator := holdsStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var hold types.Hold
+		k.cdc.MustUnmarshal(iterator.Value(), &hold)
+
+		if cb(hold) {
+			break
+		}
+	}
+}
+
+// IterateAllHolds iterates over the holds on the balances of all the accounts.
+// If true is returned from the callback, iteration is halted.
+func (k BaseViewKeeper) IterateAllHolds(ctx sdk.Context, cb func(types.Hold) bool) {
+	holdsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.HoldsPrefix)
+
+	iterator := holdsStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var hold types.Hold
+		k.cdc.MustUnmarshal(iterator.Value(), &hold)
+
+		if cb(hold) {
+			break
+		}
+	}
+}
+
+// GetAllHolds returns the holds on the balances of all the accounts.
+func (k BaseViewKeeper) GetAllHolds(ctx sdk.Context) []types.Hold {
+	var holds []types.Hold
+	k.IterateAllHolds(ctx, func(hold types.Hold) bool {
+		holds = append(holds, hold)
+		return false
+	})
+
+	return holds
+}
+
+// SetHoldHooks sets the hooks notified of the releases of the holds. It panics
+// if the hooks are already set.
+func (k BaseKeeper) SetHoldHooks(hooks types.HoldHooks) {
+	if k.holdHooks.hooks != nil {
+		panic("cannot set bank hold hooks twice")
+	}
+
+	k.holdHooks.hooks = hooks
+}
+
+// AddHold places a hold of the given holder, e.g. the name of a module, on
+// coins of an account, adding to the coins it already holds. The held coins
+// stay in the balance of the account but can't be spent, sent or delegated
+// until they are released. Only spendable coins can be held.
+func (k BaseKeeper) AddHold(ctx sdk.Context, addr sdk.AccAddress, holder string, amt sdk.Coins) error {
+	if holder == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "hold holder cannot be empty")
+	}
+	if amt.Empty() || !amt.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	spendable := k.SpendableCoins(ctx, addr)
+	if !amt.IsAllLTE(spendable) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", spendable, amt)
+	}
+
+	k.setHold(ctx, addr, holder, k.GetHold(ctx, addr, holder).Add(amt...))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHold,
+			sdk.NewAttribute(types.AttributeKeyAccount, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyHolder, holder),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+	)
+
+	return nil
+}
+
+// ReleaseHold releases coins of an account held by the given holder, which
+// become spendable again unless held by another holder. The hold hooks are
+// notified of the release.
+func (k BaseKeeper) ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, holder string, amt sdk.Coins) error {
+	if amt.Empty() || !amt.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	held := k.GetHold(ctx, addr, holder)
+	remaining, err := held.SafeSub(amt)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInsufficientHold, "%s held by %s is smaller than %s", held, holder, amt)
+	}
+
+	k.setHold(ctx, addr, holder, remaining)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReleaseHold,
+			sdk.NewAttribute(types.AttributeKeyAccount, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyHolder, holder),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+	)
+
+	if k.holdHooks.hooks != nil {
+		return k.holdHooks.hooks.AfterHoldReleased(ctx, addr, holder, amt)
+	}
+
+	return nil
+}
+
+// setHold sets the coins of an account held by a holder, deleting the hold if
+// there are no coins.
+func (k BaseKeeper) setHold(ctx sdk.Context, addr sdk.AccAddress, holder string, amt sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.CreateHoldKey(addr, holder)
+
+	if amt.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	hold := types.NewHold(addr, holder, amt)
+	store.Set(key, k.cdc.MustMarshal(&hold))
+}
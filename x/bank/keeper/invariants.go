@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -12,6 +13,7 @@ import (
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding", NonnegativeBalanceInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "total-supply", TotalSupply(k))
+	ir.RegisterRoute(types.ModuleName, "module-balance-expectations", ModuleBalanceExpectationsInvariant(k))
 }
 
 // AllInvariants runs all invariants of the X/bank module.
@@ -72,3 +74,43 @@ func TotalSupply(k Keeper) sdk.Invariant {
 				expectedTotal, supply)), broken
 	}
 }
+
+// ModuleBalanceExpectationsInvariant checks that the module accounts hold the
+// balances declared with RegisterModuleBalanceExpectations.
+func ModuleBalanceExpectationsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, e := range k.GetModuleBalanceExpectations() {
+			addr := authtypes.NewModuleAddress(e.ModuleName)
+			expected := e.Expected(ctx)
+
+			var denoms []string
+			if e.Denoms != nil {
+				denoms = e.Denoms(ctx)
+			} else {
+				for _, coin := range expected.Add(k.GetAllBalances(ctx, addr)...) {
+					denoms = append(denoms, coin.Denom)
+				}
+			}
+
+			for _, denom := range denoms {
+				balance := k.GetBalance(ctx, addr, denom)
+				if expectedAmount := expected.AmountOf(denom); !balance.Amount.Equal(expectedAmount) {
+					count++
+					msg += fmt.Sprintf("\t%s (%s): balance %s, expected %s%s\n", e.ModuleName, e.Description, balance, expectedAmount, denom)
+				}
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-balance-expectations",
+			fmt.Sprintf("amount of unexpected module account balances found %d\n%s", count, msg),
+		), broken
+	}
+}
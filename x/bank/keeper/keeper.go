@@ -45,6 +45,13 @@ type Keeper interface {
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	RegisterModuleBalanceExpectations(expectations ...types.ModuleBalanceExpectation)
+	GetModuleBalanceExpectations() []types.ModuleBalanceExpectation
+
+	AddHold(ctx sdk.Context, addr sdk.AccAddress, holder string, amt sdk.Coins) error
+	ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, holder string, amt sdk.Coins) error
+	SetHoldHooks(hooks types.HoldHooks)
+
 	types.QueryServer
 }
 
@@ -56,6 +63,14 @@ type BaseKeeper struct {
 	cdc        codec.BinaryCodec
 	storeKey   storetypes.StoreKey
 	paramSpace paramtypes.Subspace
+
+	// balanceExpectations is shared by the copies of the keeper, so that
+	// expectations can be registered after the keeper is passed to modules.
+	balanceExpectations *[]types.ModuleBalanceExpectation
+
+	// holdHooks is shared by the copies of the keeper, so that the hooks can
+	// be set after the keeper is passed to modules.
+	holdHooks *holdHooks
 }
 
 // GetPaginatedTotalSupply queries for the supply, ignoring 0 coins, with a given pagination
@@ -88,13 +103,15 @@ func (k BaseKeeper) GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.P
 // store and fetch module parameters. The BaseKeeper also accepts a
 // blocklist map. This blocklist describes the set of addresses that are not allowed
 // to receive funds through direct and explicit actions, for example, by using a MsgSend or
-// by using a SendCoinsFromModuleToAccount execution.
+// by using a SendCoinsFromModuleToAccount execution. The authority is the
+// address allowed to execute MsgSetSendEnabled, typically the gov module account.
 func NewBaseKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	ak types.AccountKeeper,
 	paramSpace paramtypes.Subspace,
 	blockedAddrs map[string]bool,
+	authority string,
 ) BaseKeeper {
 
 	// set KeyTable if it has not already been set
@@ -103,14 +120,46 @@ func NewBaseKeeper(
 	}
 
 	return BaseKeeper{
-		BaseSendKeeper: NewBaseSendKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs),
+		BaseSendKeeper: NewBaseSendKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs, authority),
 		ak:             ak,
 		cdc:            cdc,
 		storeKey:       storeKey,
 		paramSpace:     paramSpace,
+
+		balanceExpectations: &[]types.ModuleBalanceExpectation{},
+		holdHooks:           &holdHooks{},
+	}
+}
+
+// RegisterModuleBalanceExpectations registers expectations on the balance of
+// module accounts, checked by the module-balance-expectations invariant. It
+// panics if an expectation of the same module account is already registered.
+func (k BaseKeeper) RegisterModuleBalanceExpectations(expectations ...types.ModuleBalanceExpectation) {
+	for _, e := range expectations {
+		if e.ModuleName == "" || e.Expected == nil {
+			panic("module balance expectation must have a module name and an expected balance")
+		}
+
+		for _, registered := range *k.balanceExpectations {
+			if registered.ModuleName == e.ModuleName {
+				panic(fmt.Sprintf("balance expectation of module account %s already registered", e.ModuleName))
+			}
+		}
+
+		*k.balanceExpectations = append(*k.balanceExpectations, e)
 	}
 }
 
+// GetModuleBalanceExpectations returns the registered module balance
+// expectations, in registration order.
+func (k BaseKeeper) GetModuleBalanceExpectations() []types.ModuleBalanceExpectation {
+	if k.balanceExpectations == nil {
+		return nil
+	}
+
+	return append([]types.ModuleBalanceExpectation{}, *k.balanceExpectations...)
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
@@ -127,10 +176,13 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 	}
 
 	balances := sdk.NewCoins()
+	held := k.GetHeldCoins(ctx, delegatorAddr)
 
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, delegatorAddr, coin.GetDenom())
-		if balance.IsLT(coin) {
+		// the held coins can't be delegated
+		unheld := balance.Amount.Sub(held.AmountOf(coin.Denom))
+		if unheld.LT(coin.Amount) {
 			return sdkerrors.Wrapf(
 				sdkerrors.ErrInsufficientFunds, "failed to delegate; %s is smaller than %s", balance, amt,
 			)
@@ -395,8 +447,10 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 	}
 
 	for _, amount := range amounts {
-		supply := k.GetSupply(ctx, amount.GetDenom())
-		supply = supply.Add(amount)
+		supply, err := k.GetSupply(ctx, amount.GetDenom()).SafeAdd(amount)
+		if err != nil {
+			return err
+		}
 		k.setSupply(ctx, supply)
 	}
 
@@ -429,8 +483,10 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 	}
 
 	for _, amount := range amounts {
-		supply := k.GetSupply(ctx, amount.GetDenom())
-		supply = supply.Sub(amount)
+		supply, err := k.GetSupply(ctx, amount.GetDenom()).SafeSub(amount)
+		if err != nil {
+			return err
+		}
 		k.setSupply(ctx, supply)
 	}
 
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	gocontext "context"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -20,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -83,11 +86,11 @@ func (suite *IntegrationTestSuite) initKeepersWithmAccPerms(blockedAddrs map[str
 	maccPerms[randomPerm] = []string{"random"}
 	authKeeper := authkeeper.NewAccountKeeper(
 		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), blockedAddrs,
+		app.GetSubspace(types.ModuleName), blockedAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	return authKeeper, keeper
@@ -458,6 +461,54 @@ func (suite *IntegrationTestSuite) TestSendCoins() {
 	suite.Require().Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *IntegrationTestSuite) TestSendRestriction() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr2, balances))
+
+	var recipients []sdk.AccAddress
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) error {
+		recipients = append(recipients, toAddr)
+		return nil
+	})
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, _ sdk.AccAddress, amt sdk.Coins) error {
+		if fromAddr.Equals(addr2) && amt.AmountOf(barDenom).IsPositive() {
+			return sdkerrors.ErrUnauthorized
+		}
+		return nil
+	})
+
+	sendAmt := sdk.NewCoins(newBarCoin(10))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr3, sendAmt))
+	suite.Require().ErrorIs(app.BankKeeper.SendCoins(ctx, addr2, addr3, sendAmt), sdkerrors.ErrUnauthorized)
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr2, addr3, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(balances.Sub(sendAmt), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(balances.Sub(sdk.NewCoins(newFooCoin(10))), app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Equal([]sdk.AccAddress{addr3, addr3, addr3}, recipients)
+
+	// the recipients of a multi-send with several inputs aren't known
+	recipients = nil
+	inputs := []types.Input{
+		{Address: addr1.String(), Coins: sendAmt},
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	outputs := []types.Output{{Address: addr3.String(), Coins: sendAmt.Add(newFooCoin(10))}}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal([]sdk.AccAddress{nil, nil}, recipients)
+
+	inputs = []types.Input{{Address: addr2.String(), Coins: sendAmt}}
+	outputs = []types.Output{{Address: addr3.String(), Coins: sendAmt}}
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), sdkerrors.ErrUnauthorized)
+
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr2, addr3, sendAmt))
+}
+
 func (suite *IntegrationTestSuite) TestValidateBalance() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -527,6 +578,154 @@ func (suite *IntegrationTestSuite) TestSendEnabled() {
 	suite.Require().Error(err)
 }
 
+func (suite *IntegrationTestSuite) TestSendEnabledOverrides() {
+	app, ctx := suite.app, suite.ctx
+	fooCoin := sdk.NewCoin(fooDenom, sdk.OneInt())
+	barCoin := sdk.NewCoin(barDenom, sdk.OneInt())
+
+	params := types.DefaultParams().SetSendEnabledParam(barDenom, false)
+	app.BankKeeper.SetParams(ctx, params)
+	suite.Require().True(app.BankKeeper.IsSendEnabledCoin(ctx, fooCoin))
+	suite.Require().False(app.BankKeeper.IsSendEnabledCoin(ctx, barCoin))
+
+	// overrides take precedence over the params
+	app.BankKeeper.SetSendEnabled(ctx, fooDenom, false)
+	app.BankKeeper.SetSendEnabled(ctx, barDenom, true)
+	suite.Require().False(app.BankKeeper.IsSendEnabledCoin(ctx, fooCoin))
+	suite.Require().True(app.BankKeeper.IsSendEnabledCoin(ctx, barCoin))
+
+	se, found := app.BankKeeper.GetSendEnabledEntry(ctx, fooDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(types.SendEnabled{Denom: fooDenom, Enabled: false}, se)
+	suite.Require().Equal([]types.SendEnabled{{Denom: barDenom, Enabled: true}, {Denom: fooDenom, Enabled: false}}, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+
+	// removing an override falls back to the params
+	app.BankKeeper.DeleteSendEnabled(ctx, barDenom)
+	_, found = app.BankKeeper.GetSendEnabledEntry(ctx, barDenom)
+	suite.Require().False(found)
+	suite.Require().False(app.BankKeeper.IsSendEnabledCoin(ctx, barCoin))
+}
+
+func (suite *IntegrationTestSuite) TestNewBaseKeeperInvalidAuthority() {
+	app := suite.app
+	suite.Require().Panics(func() {
+		keeper.NewBaseKeeper(app.AppCodec(), app.GetKey(types.StoreKey), app.AccountKeeper, app.GetSubspace(types.ModuleName), nil, "invalid")
+	})
+}
+
+func (suite *IntegrationTestSuite) TestMsgSetSendEnabled() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	authority := app.BankKeeper.GetAuthority()
+	suite.Require().Equal(authtypes.NewModuleAddress("gov").String(), authority)
+
+	app.BankKeeper.SetSendEnabled(ctx, barDenom, false)
+
+	msg := types.NewMsgSetSendEnabled(authority, []*types.SendEnabled{types.NewSendEnabled(fooDenom, false)}, []string{barDenom})
+	_, err := msgServer.SetSendEnabled(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SendEnabled{{Denom: fooDenom, Enabled: false}}, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+
+	msg = types.NewMsgSetSendEnabled(sdk.AccAddress([]byte("addr1_______________")).String(), []*types.SendEnabled{types.NewSendEnabled(barDenom, false)}, nil)
+	_, err = msgServer.SetSendEnabled(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().Error(err)
+	_, found := app.BankKeeper.GetSendEnabledEntry(ctx, barDenom)
+	suite.Require().False(found)
+
+	// the proposal handler executes the update with the gov authority
+	proposal := types.NewSetSendEnabledProposal("title", "description", []*types.SendEnabled{types.NewSendEnabled(barDenom, false)}, []string{fooDenom})
+	suite.Require().NoError(keeper.HandleSetSendEnabledProposal(ctx, app.BankKeeper, proposal))
+	suite.Require().Equal([]types.SendEnabled{{Denom: barDenom, Enabled: false}}, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+}
+
+func (suite *IntegrationTestSuite) TestMsgMultiSendV2() {
+	app, ctx := suite.app, suite.ctx.WithEventManager(sdk.NewEventManager())
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	from := sdk.AccAddress([]byte("from________________"))
+	to1 := sdk.AccAddress([]byte("to1_________________"))
+	to2 := sdk.AccAddress([]byte("to2_________________"))
+
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, from))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, from, sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	msg := types.NewMsgMultiSendV2(from, []types.LabeledOutput{
+		types.NewLabeledOutput(to1, sdk.NewCoins(newFooCoin(30)), "withdrawal-1"),
+		types.NewLabeledOutput(to2, sdk.NewCoins(newFooCoin(20), newBarCoin(50)), ""),
+		types.NewLabeledOutput(to1, sdk.NewCoins(newFooCoin(10)), "withdrawal-2"),
+	})
+	_, err := msgServer.MultiSendV2(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(40)), app.BankKeeper.GetAllBalances(ctx, from))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(40)), app.BankKeeper.GetAllBalances(ctx, to1))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, to2))
+	suite.Require().True(app.AccountKeeper.HasAccount(ctx, to2))
+
+	var references []string
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		if e.Type != types.EventTypeOutputReference {
+			continue
+		}
+		for _, attr := range e.Attributes {
+			if string(attr.Key) == types.AttributeKeyReference {
+				references = append(references, string(attr.Value))
+			}
+		}
+	}
+	suite.Require().Equal([]string{"withdrawal-1", "withdrawal-2"}, references)
+
+	// the sender must hold the sum of the outputs
+	_, err = msgServer.MultiSendV2(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// the outputs can't be blocked addresses
+	blocked := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	msg = types.NewMsgMultiSendV2(from, []types.LabeledOutput{types.NewLabeledOutput(blocked, sdk.NewCoins(newFooCoin(1)), "")})
+	_, err = msgServer.MultiSendV2(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (suite *IntegrationTestSuite) TestMsgSendToModuleAccount() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	from := sdk.AccAddress([]byte("from________________"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, from))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, from, sdk.NewCoins(newFooCoin(100))))
+
+	// a module account which isn't a blocked address
+	macc := authtypes.NewEmptyModuleAccount("deposits")
+	app.AccountKeeper.SetModuleAccount(ctx, macc)
+	suite.Require().False(app.BankKeeper.BlockedAddr(macc.GetAddress()))
+	suite.Require().True(app.BankKeeper.BlockedRecipient(ctx, macc.GetAddress()))
+
+	coins := sdk.NewCoins(newFooCoin(10))
+	_, err := msgServer.Send(sdk.WrapSDKContext(ctx), types.NewMsgSend(from, macc.GetAddress(), coins))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	multiSend := types.NewMsgMultiSend([]types.Input{types.NewInput(from, coins)}, []types.Output{types.NewOutput(macc.GetAddress(), coins)})
+	_, err = msgServer.MultiSend(sdk.WrapSDKContext(ctx), multiSend)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	multiSendV2 := types.NewMsgMultiSendV2(from, []types.LabeledOutput{types.NewLabeledOutput(macc.GetAddress(), coins, "")})
+	_, err = msgServer.MultiSendV2(sdk.WrapSDKContext(ctx), multiSendV2)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsZero())
+
+	// the modules can still send funds to their module account
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, from, macc.GetAddress(), coins))
+
+	// unless the module is allowed to receive funds from users
+	app.BankKeeper.AllowModuleAccountRecipients("deposits")
+	suite.Require().False(app.BankKeeper.BlockedRecipient(ctx, macc.GetAddress()))
+	_, err = msgServer.Send(sdk.WrapSDKContext(ctx), types.NewMsgSend(from, macc.GetAddress(), coins))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20)), app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()))
+
+	// the blocked addresses stay blocked
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	app.BankKeeper.AllowModuleAccountRecipients(authtypes.FeeCollectorName)
+	_, err = msgServer.Send(sdk.WrapSDKContext(ctx), types.NewMsgSend(from, feeCollector, coins))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
 func (suite *IntegrationTestSuite) TestHasBalance() {
 	app, ctx := suite.app, suite.ctx
 	addr := sdk.AccAddress([]byte("addr1_______________"))
@@ -716,6 +915,81 @@ func (suite *IntegrationTestSuite) TestSpendableCoins() {
 	suite.Require().Equal(origCoins.Sub(delCoins), app.BankKeeper.SpendableCoins(ctx, addr1))
 }
 
+type mockHoldHooks struct {
+	released map[string]sdk.Coins
+}
+
+func (h *mockHoldHooks) AfterHoldReleased(_ sdk.Context, addr sdk.AccAddress, holder string, released sdk.Coins) error {
+	h.released[addr.String()+"/"+holder] = h.released[addr.String()+"/"+holder].Add(released...)
+	return nil
+}
+
+func (suite *IntegrationTestSuite) TestHolds() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addrModule := sdk.AccAddress([]byte("moduleAcc___________"))
+
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addrModule))
+	require.NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+
+	hooks := &mockHoldHooks{released: make(map[string]sdk.Coins)}
+	app.BankKeeper.SetHoldHooks(hooks)
+	require.Panics(func() { app.BankKeeper.SetHoldHooks(hooks) })
+
+	// only spendable coins can be held
+	require.ErrorIs(app.BankKeeper.AddHold(ctx, addr1, "escrow", sdk.NewCoins(newFooCoin(101))), sdkerrors.ErrInsufficientFunds)
+	require.ErrorIs(app.BankKeeper.AddHold(ctx, addr1, "", sdk.NewCoins(newFooCoin(1))), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(app.BankKeeper.AddHold(ctx, addr1, "escrow", sdk.Coins{}), sdkerrors.ErrInvalidCoins)
+
+	require.NoError(app.BankKeeper.AddHold(ctx, addr1, "escrow", sdk.NewCoins(newFooCoin(30))))
+	require.NoError(app.BankKeeper.AddHold(ctx, addr1, "escrow", sdk.NewCoins(newFooCoin(10))))
+	require.NoError(app.BankKeeper.AddHold(ctx, addr1, "gov", sdk.NewCoins(newFooCoin(20), newBarCoin(50))))
+	require.ErrorIs(app.BankKeeper.AddHold(ctx, addr1, "gov", sdk.NewCoins(newFooCoin(41))), sdkerrors.ErrInsufficientFunds)
+
+	held := sdk.NewCoins(newFooCoin(60), newBarCoin(50))
+	require.Equal(sdk.NewCoins(newFooCoin(40)), app.BankKeeper.GetHold(ctx, addr1, "escrow"))
+	require.Equal(held, app.BankKeeper.GetHeldCoins(ctx, addr1))
+	require.Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+	require.Equal(sdk.NewCoins(newFooCoin(40)), app.BankKeeper.SpendableCoins(ctx, addr1))
+
+	// the held coins can be neither sent nor delegated
+	require.ErrorIs(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(41))), sdkerrors.ErrInsufficientFunds)
+	require.ErrorIs(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(1))), sdkerrors.ErrInsufficientFunds)
+	require.ErrorIs(app.BankKeeper.DelegateCoins(ctx, addr1, addrModule, sdk.NewCoins(newFooCoin(41))), sdkerrors.ErrInsufficientFunds)
+	require.NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(40))))
+
+	require.ErrorIs(app.BankKeeper.ReleaseHold(ctx, addr1, "escrow", sdk.NewCoins(newFooCoin(41))), types.ErrInsufficientHold)
+	require.ErrorIs(app.BankKeeper.ReleaseHold(ctx, addr1, "escrow", sdk.NewCoins(newBarCoin(1))), types.ErrInsufficientHold)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(app.BankKeeper.ReleaseHold(ctx, addr1, "escrow", sdk.NewCoins(newFooCoin(40))))
+	require.Equal(sdk.NewCoins(), app.BankKeeper.GetHold(ctx, addr1, "escrow"))
+	require.Equal(sdk.NewCoins(newFooCoin(40)), hooks.released[addr1.String()+"/escrow"])
+	require.Equal(sdk.NewCoins(newFooCoin(40)), app.BankKeeper.SpendableCoins(ctx, addr1))
+
+	events := ctx.EventManager().ABCIEvents()
+	require.Len(events, 1)
+	require.Equal(types.EventTypeReleaseHold, events[0].Type)
+
+	require.NoError(app.BankKeeper.ReleaseHold(ctx, addr1, "gov", sdk.NewCoins(newBarCoin(50))))
+	require.Equal(sdk.NewCoins(newFooCoin(20)), app.BankKeeper.GetHeldCoins(ctx, addr1))
+	require.Equal(sdk.NewCoins(newFooCoin(40), newBarCoin(50)), app.BankKeeper.SpendableCoins(ctx, addr1))
+
+	res, err := suite.queryClient.Holds(gocontext.Background(), &types.QueryHoldsRequest{Address: addr1.String()})
+	require.NoError(err)
+	require.Equal([]types.Hold{types.NewHold(addr1, "gov", sdk.NewCoins(newFooCoin(20)))}, res.Holds)
+	require.Equal(sdk.NewCoins(newFooCoin(20)), res.Held)
+	require.Equal(sdk.NewCoins(newFooCoin(40), newBarCoin(50)), res.Spendable)
+
+	genState := app.BankKeeper.ExportGenesis(ctx)
+	require.Equal(res.Holds, genState.Holds)
+}
+
 func (suite *IntegrationTestSuite) TestVestingAccountSend() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -1050,11 +1324,11 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 
 	suite.app.AccountKeeper = authkeeper.NewAccountKeeper(
 		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), suite.app.GetSubspace(authtypes.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil)
+		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	// set account with multiple permissions
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
@@ -1171,3 +1445,37 @@ func (suite *IntegrationTestSuite) getTestMetadata() []types.Metadata {
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+func (suite *IntegrationTestSuite) TestModuleBalanceExpectationsInvariant() {
+	ctx := suite.ctx
+	require := suite.Require()
+	_, bankKeeper := suite.initKeepersWithmAccPerms(make(map[string]bool))
+
+	expected := sdk.NewCoins(newFooCoin(100))
+	bankKeeper.RegisterModuleBalanceExpectations(types.ModuleBalanceExpectation{
+		ModuleName:  holder,
+		Description: "holder holds 100foo",
+		Expected:    func(sdk.Context) sdk.Coins { return expected },
+	})
+	require.Len(bankKeeper.GetModuleBalanceExpectations(), 1)
+	require.Panics(func() {
+		bankKeeper.RegisterModuleBalanceExpectations(types.ModuleBalanceExpectation{
+			ModuleName: holder,
+			Expected:   func(sdk.Context) sdk.Coins { return nil },
+		})
+	})
+
+	invariant := keeper.ModuleBalanceExpectationsInvariant(bankKeeper)
+	_, broken := invariant(ctx)
+	require.True(broken)
+
+	require.NoError(testutil.FundModuleAccount(bankKeeper, ctx, holder, expected))
+	_, broken = invariant(ctx)
+	require.False(broken)
+
+	// unexpected denoms break the invariant if no denoms are declared
+	require.NoError(testutil.FundModuleAccount(bankKeeper, ctx, holder, sdk.NewCoins(newBarCoin(10))))
+	msg, broken := invariant(ctx)
+	require.True(broken)
+	require.Contains(msg, barDenom)
+}
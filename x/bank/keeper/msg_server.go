@@ -39,7 +39,7 @@ func (k msgServer) Send(goCtx context.Context, msg *types.MsgSend) (*types.MsgSe
 		return nil, err
 	}
 
-	if k.BlockedAddr(to) {
+	if k.BlockedRecipient(ctx, to) {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
 	}
 
@@ -85,7 +85,7 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 		if err != nil {
 			panic(err)
 		}
-		if k.BlockedAddr(accAddr) {
+		if k.BlockedRecipient(ctx, accAddr) {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", out.Address)
 		}
 	}
@@ -104,3 +104,74 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 
 	return &types.MsgMultiSendResponse{}, nil
 }
+
+// MultiSendV2 implements Msg/MultiSendV2. The coins of all the outputs are
+// sent from the sender at once, so that its balances are read and written once.
+func (k msgServer) MultiSendV2(goCtx context.Context, msg *types.MsgMultiSendV2) (*types.MsgMultiSendV2Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	total := msg.TotalCoins()
+	if err := k.IsSendEnabledCoins(ctx, total...); err != nil {
+		return nil, err
+	}
+
+	outputs := make([]types.Output, len(msg.Outputs))
+	for i, out := range msg.Outputs {
+		accAddr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return nil, err
+		}
+		if k.BlockedRecipient(ctx, accAddr) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", out.Address)
+		}
+
+		outputs[i] = types.Output{Address: out.Address, Coins: out.Coins}
+	}
+
+	err := k.InputOutputCoins(ctx, []types.Input{{Address: msg.FromAddress, Coins: total}}, outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, out := range msg.Outputs {
+		if out.Reference == "" {
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOutputReference,
+				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
+				sdk.NewAttribute(types.AttributeKeyReference, out.Reference),
+			),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgMultiSendV2Response{}, nil
+}
+
+// SetSendEnabled implements Msg/SetSendEnabled. Only the authority of the keeper
+// can set or remove send_enabled overrides.
+func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEnabled) (*types.MsgSetSendEnabledResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, se := range msg.SendEnabled {
+		k.Keeper.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
+	for _, denom := range msg.UseDefaultFor {
+		k.DeleteSendEnabled(ctx, denom)
+	}
+
+	return &types.MsgSetSendEnabledResponse{}, nil
+}
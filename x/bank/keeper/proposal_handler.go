@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// HandleSetSendEnabledProposal is a handler for executing a passed set send
+// enabled proposal.
+func HandleSetSendEnabledProposal(ctx sdk.Context, k Keeper, p *types.SetSendEnabledProposal) error {
+	msg := types.NewMsgSetSendEnabled(k.GetAuthority(), p.SendEnabled, p.UseDefaultFor)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	_, err := NewMsgServerImpl(k).SetSendEnabled(sdk.WrapSDKContext(ctx), msg)
+	return err
+}
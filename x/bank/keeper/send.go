@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -8,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -25,8 +28,21 @@ type SendKeeper interface {
 
 	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
+	IsSendEnabledDenom(ctx sdk.Context, denom string) bool
+
+	GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool)
+	SetSendEnabled(ctx sdk.Context, denom string, value bool)
+	DeleteSendEnabled(ctx sdk.Context, denom string)
+	IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) (stop bool))
+	GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled
 
 	BlockedAddr(addr sdk.AccAddress) bool
+	BlockedRecipient(ctx sdk.Context, addr sdk.AccAddress) bool
+	AllowModuleAccountRecipients(moduleNames ...string)
+	GetAuthority() string
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -43,22 +59,83 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// the address allowed to execute MsgSetSendEnabled, typically the gov
+	// module account.
+	authority string
+
+	// sendRestriction is shared by the copies of the keeper, so that the
+	// restrictions appended after the keeper is passed to other modules apply.
+	sendRestriction *sendRestriction
+
+	// names of the modules whose module accounts can receive funds from users,
+	// shared by the copies of the keeper as sendRestriction.
+	moduleRecipients map[string]bool
+}
+
+// sendRestriction holds the restriction run before coins are sent.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
 }
 
 func NewBaseSendKeeper(
 	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool,
+	authority string,
 ) BaseSendKeeper {
 
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid bank authority address: %w", err))
+	}
+
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		blockedAddrs:   blockedAddrs,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		paramSpace:      paramSpace,
+		blockedAddrs:    blockedAddrs,
+		authority:       authority,
+		sendRestriction: &sendRestriction{},
+
+		moduleRecipients: make(map[string]bool),
 	}
 }
 
+// AppendSendRestriction adds a restriction run before coins are sent, after
+// the restrictions already added.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.fn = k.sendRestriction.fn.Then(restriction)
+}
+
+// ClearSendRestriction removes all the send restrictions.
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.fn = nil
+}
+
+// checkSendRestriction runs the send restriction, if any.
+func (k BaseSendKeeper) checkSendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.sendRestriction.fn == nil {
+		return nil
+	}
+
+	return k.sendRestriction.fn(ctx, fromAddr, toAddr, amt)
+}
+
+// AllowModuleAccountRecipients allows users to send funds to the module
+// accounts of the given modules, e.g. to the module accounts of modules
+// designed to receive user deposits. The module accounts of the other modules
+// can't receive funds through Msg/Send, Msg/MultiSend or Msg/MultiSendV2.
+func (k BaseSendKeeper) AllowModuleAccountRecipients(moduleNames ...string) {
+	for _, name := range moduleNames {
+		k.moduleRecipients[name] = true
+	}
+}
+
+// GetAuthority returns the address allowed to execute MsgSetSendEnabled.
+func (k BaseSendKeeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -80,6 +157,10 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	if err := k.checkInputOutputRestrictions(ctx, inputs, outputs); err != nil {
+		return err
+	}
+
 	for _, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
@@ -131,9 +212,56 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 	return nil
 }
 
+// checkInputOutputRestrictions runs the send restriction on the transfers of a
+// multi-send. With a single input, the restriction runs once per output.
+// Otherwise the recipients of each input aren't known, so it runs once per
+// input with a nil recipient.
+func (k BaseSendKeeper) checkInputOutputRestrictions(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
+	if k.sendRestriction.fn == nil {
+		return nil
+	}
+
+	if len(inputs) == 1 {
+		inAddress, err := sdk.AccAddressFromBech32(inputs[0].Address)
+		if err != nil {
+			return err
+		}
+
+		for _, out := range outputs {
+			outAddress, err := sdk.AccAddressFromBech32(out.Address)
+			if err != nil {
+				return err
+			}
+
+			if err := k.checkSendRestriction(ctx, inAddress, outAddress, out.Coins); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for _, in := range inputs {
+		inAddress, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.checkSendRestriction(ctx, inAddress, nil, in.Coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkSendRestriction(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	err := k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -183,16 +311,21 @@ func (k BaseSendKeeper) subUnlockedCoins(ctx sdk.Context, addr sdk.AccAddress, a
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, addr, coin.Denom)
 		locked := sdk.NewCoin(coin.Denom, lockedCoins.AmountOf(coin.Denom))
-		spendable := balance.Sub(locked)
+		spendable, err := balance.SafeSub(locked)
+		if err != nil {
+			spendable = sdk.NewCoin(coin.Denom, sdk.ZeroInt())
+		}
 
-		_, hasNeg := sdk.Coins{spendable}.SafeSub(sdk.Coins{coin})
-		if hasNeg {
+		if _, err := spendable.SafeSub(coin); err != nil {
 			return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", spendable, coin)
 		}
 
-		newBalance := balance.Sub(coin)
+		newBalance, err := balance.SafeSub(coin)
+		if err != nil {
+			return err
+		}
 
-		err := k.setBalance(ctx, addr, newBalance)
+		err = k.setBalance(ctx, addr, newBalance)
 		if err != nil {
 			return err
 		}
@@ -214,9 +347,12 @@ func (k BaseSendKeeper) addCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.C
 
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, addr, coin.Denom)
-		newBalance := balance.Add(coin)
+		newBalance, err := balance.SafeAdd(coin)
+		if err != nil {
+			return err
+		}
 
-		err := k.setBalance(ctx, addr, newBalance)
+		err = k.setBalance(ctx, addr, newBalance)
 		if err != nil {
 			return err
 		}
@@ -313,7 +449,80 @@ func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) e
 
 // IsSendEnabledCoin returns the current SendEnabled status of the provided coin's denom
 func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
-	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
+	return k.IsSendEnabledDenom(ctx, coin.Denom)
+}
+
+// IsSendEnabledDenom returns the current SendEnabled status of the provided
+// denom. The send_enabled override of the denom takes precedence over the
+// params.
+func (k BaseSendKeeper) IsSendEnabledDenom(ctx sdk.Context, denom string) bool {
+	if se, found := k.GetSendEnabledEntry(ctx, denom); found {
+		return se.Enabled
+	}
+
+	return k.GetParams(ctx).SendEnabledDenom(denom)
+}
+
+// GetSendEnabledEntry returns the send_enabled override of the provided denom,
+// if any.
+func (k BaseSendKeeper) GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool) {
+	bz := k.getSendEnabledPrefixStore(ctx).Get([]byte(denom))
+	if bz == nil {
+		return types.SendEnabled{}, false
+	}
+
+	return types.SendEnabled{Denom: denom, Enabled: bytesToBool(bz)}, true
+}
+
+// SetSendEnabled sets the send_enabled override of the provided denom.
+func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, denom string, value bool) {
+	k.getSendEnabledPrefixStore(ctx).Set([]byte(denom), boolToBytes(value))
+}
+
+// DeleteSendEnabled removes the send_enabled override of the provided denom,
+// which then falls back to the params.
+func (k BaseSendKeeper) DeleteSendEnabled(ctx sdk.Context, denom string) {
+	k.getSendEnabledPrefixStore(ctx).Delete([]byte(denom))
+}
+
+// IterateSendEnabledEntries iterates over the send_enabled overrides, in denom
+// order, until cb returns true.
+func (k BaseSendKeeper) IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) (stop bool)) {
+	iterator := k.getSendEnabledPrefixStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()), bytesToBool(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllSendEnabledEntries returns all the send_enabled overrides.
+func (k BaseSendKeeper) GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled {
+	var entries []types.SendEnabled
+	k.IterateSendEnabledEntries(ctx, func(denom string, sendEnabled bool) bool {
+		entries = append(entries, types.SendEnabled{Denom: denom, Enabled: sendEnabled})
+		return false
+	})
+
+	return entries
+}
+
+func (k BaseSendKeeper) getSendEnabledPrefixStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.SendEnabledPrefix)
+}
+
+func boolToBytes(b bool) []byte {
+	if b {
+		return []byte{1}
+	}
+
+	return []byte{0}
+}
+
+func bytesToBool(bz []byte) bool {
+	return len(bz) == 1 && bz[0] == 1
 }
 
 // BlockedAddr checks if a given address is restricted from
@@ -321,3 +530,17 @@ func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
 func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
 	return k.blockedAddrs[addr.String()]
 }
+
+// BlockedRecipient returns whether users are restricted from sending funds to
+// the given address, i.e. whether it is a blocked address, or the module
+// account of a module not allowed to receive funds from users. The module
+// accounts of most modules, e.g. the bonded tokens pool, hold funds on behalf
+// of their module only, and the funds sent to them are lost.
+func (k BaseSendKeeper) BlockedRecipient(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if k.BlockedAddr(addr) {
+		return true
+	}
+
+	macc, ok := k.ak.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return ok && !k.moduleRecipients[macc.GetName()]
+}
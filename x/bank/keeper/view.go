@@ -30,6 +30,11 @@ type ViewKeeper interface {
 
 	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+
+	GetHold(ctx sdk.Context, addr sdk.AccAddress, holder string) sdk.Coins
+	GetHeldCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	IterateAccountHolds(ctx sdk.Context, addr sdk.AccAddress, cb func(hold types.Hold) (stop bool))
+	IterateAllHolds(ctx sdk.Context, cb func(hold types.Hold) (stop bool))
 }
 
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
@@ -164,19 +169,20 @@ func (k BaseViewKeeper) IterateAllBalances(ctx sdk.Context, cb func(sdk.AccAddre
 }
 
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
-// account by address. For standard accounts, the result will always be no coins.
-// For vesting accounts, LockedCoins is delegated to the concrete vesting account
-// type.
+// account by address: the coins held by the holds on its balance, plus, for
+// vesting accounts, the coins locked by the concrete vesting account type.
 func (k BaseViewKeeper) LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	locked := k.GetHeldCoins(ctx, addr)
+
 	acc := k.ak.GetAccount(ctx, addr)
 	if acc != nil {
 		vacc, ok := acc.(vestexported.VestingAccount)
 		if ok {
-			return vacc.LockedCoins(ctx.BlockTime())
+			locked = locked.Add(vacc.LockedCoins(ctx.BlockTime())...)
 		}
 	}
 
-	return sdk.NewCoins()
+	return locked
 }
 
 // SpendableCoins returns the total balances of spendable coins for an account
@@ -193,10 +199,9 @@ func (k BaseViewKeeper) spendableCoins(ctx sdk.Context, addr sdk.AccAddress) (sp
 	total = k.GetAllBalances(ctx, addr)
 	locked := k.LockedCoins(ctx, addr)
 
-	spendable, hasNeg := total.SafeSub(locked)
-	if hasNeg {
-		spendable = sdk.NewCoins()
-		return
+	spendable, err := total.SafeSub(locked)
+	if err != nil {
+		return sdk.NewCoins(), total
 	}
 
 	return
@@ -73,10 +73,12 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"denom_metadata": [],
+	"holds": [],
 	"params": {
 		"default_send_enabled": false,
 		"send_enabled": []
 	},
+	"send_enabled": [],
 	"supply": [
 		{
 			"amount": "20",
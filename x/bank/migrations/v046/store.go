@@ -0,0 +1,31 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Move the send_enabled entries of the params to send_enabled overrides in
+// the store, and clear them from the params.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace) error {
+	var sendEnabled []*types.SendEnabled
+	paramSpace.GetIfExists(ctx, types.KeySendEnabled, &sendEnabled)
+
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.SendEnabledPrefix)
+	for _, se := range sendEnabled {
+		value := []byte{0}
+		if se.Enabled {
+			value = []byte{1}
+		}
+		store.Set([]byte(se.Denom), value)
+	}
+
+	paramSpace.Set(ctx, types.KeySendEnabled, []*types.SendEnabled{})
+	return nil
+}
@@ -0,0 +1,36 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	v046 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	bankKey := app.GetKey(types.StoreKey)
+	paramSpace := app.GetSubspace(types.ModuleName)
+
+	params := types.DefaultParams().
+		SetSendEnabledParam("foo", false).
+		SetSendEnabledParam("bar", true)
+	paramSpace.SetParamSet(ctx, &params)
+
+	require.NoError(t, v046.MigrateStore(ctx, bankKey, paramSpace))
+
+	store := prefix.NewStore(ctx.KVStore(bankKey), types.SendEnabledPrefix)
+	require.Equal(t, []byte{0}, store.Get([]byte("foo")))
+	require.Equal(t, []byte{1}, store.Get([]byte("bar")))
+
+	var migrated types.Params
+	paramSpace.GetParamSet(ctx, &migrated)
+	require.Empty(t, migrated.SendEnabled)
+	require.Equal(t, params.DefaultSendEnabled, migrated.DefaultSendEnabled)
+}
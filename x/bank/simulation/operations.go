@@ -130,8 +130,8 @@ func sendMsgSend(
 	account := ak.GetAccount(ctx, from)
 	spendable := bk.SpendableCoins(ctx, account.GetAddress())
 
-	coins, hasNeg := spendable.SafeSub(msg.Amount)
-	if !hasNeg {
+	coins, err := spendable.SafeSub(msg.Amount)
+	if err == nil {
 		fees, err = simtypes.RandomFees(r, ctx, coins)
 		if err != nil {
 			return err
@@ -351,8 +351,8 @@ func sendMsgMultiSend(
 	feePayer := ak.GetAccount(ctx, addr)
 	spendable := bk.SpendableCoins(ctx, feePayer.GetAddress())
 
-	coins, hasNeg := spendable.SafeSub(msg.Inputs[0].Coins)
-	if !hasNeg {
+	coins, err := spendable.SafeSub(msg.Inputs[0].Coins)
+	if err == nil {
 		fees, err = simtypes.RandomFees(r, ctx, coins)
 		if err != nil {
 			return err
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/exported"
 )
 
@@ -31,11 +32,11 @@ func (b Balance) GetCoins() sdk.Coins {
 // Validate checks for address and coins correctness.
 func (b Balance) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(b.Address); err != nil {
-		return err
+		return sdkerrors.WrapPath(err, "address")
 	}
 
 	if err := b.Coins.Validate(); err != nil {
-		return err
+		return sdkerrors.WrapPath(err, "coins")
 	}
 
 	return nil
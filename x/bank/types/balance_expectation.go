@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ModuleBalanceExpectation declares the expected balance of a module account,
+// e.g. "the bonded pool holds the sum of the bonded tokens". The declared
+// expectations are checked by the module-balance-expectations invariant.
+type ModuleBalanceExpectation struct {
+	// ModuleName is the name of the module account.
+	ModuleName string
+	// Description describes the expectation in the invariant message.
+	Description string
+	// Denoms returns the denoms to check. If nil, all the denoms of the
+	// balance and of the expected balance are checked.
+	Denoms func(ctx sdk.Context) []string
+	// Expected returns the expected balance of the module account.
+	Expected func(ctx sdk.Context) sdk.Coins
+}
@@ -28,6 +28,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the bank module.
 type Params struct {
+	// Deprecated: send_enabled entries are moved to the bank store, see
+	// MsgSetSendEnabled and the SendEnabled query. The list is still honored
+	// when the bank params are set, e.g. by a param change proposal.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
 }
@@ -432,6 +435,95 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// SetSendEnabledProposal is a gov Content type to set or remove the
+// send_enabled overrides of denoms.
+type SetSendEnabledProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// send_enabled is the list of send_enabled overrides to set.
+	SendEnabled []*SendEnabled `protobuf:"bytes,3,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// use_default_for is the list of denoms whose override is removed, so that
+	// they use the default_send_enabled param.
+	UseDefaultFor []string `protobuf:"bytes,4,rep,name=use_default_for,json=useDefaultFor,proto3" json:"use_default_for,omitempty"`
+}
+
+func (m *SetSendEnabledProposal) Reset()      { *m = SetSendEnabledProposal{} }
+func (*SetSendEnabledProposal) ProtoMessage() {}
+func (*SetSendEnabledProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *SetSendEnabledProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetSendEnabledProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetSendEnabledProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetSendEnabledProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSendEnabledProposal.Merge(m, src)
+}
+func (m *SetSendEnabledProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetSendEnabledProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSendEnabledProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetSendEnabledProposal proto.InternalMessageInfo
+
+// Hold defines coins of an account held by a module, e.g. as collateral or in
+// escrow. The held coins stay in the balance of the account, which can't spend
+// them until the module releases the hold.
+type Hold struct {
+	// address is the address of the account holding the coins.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// holder is the name of the module which placed the hold.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// amount is the held coins.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Hold) Reset()         { *m = Hold{} }
+func (m *Hold) String() string { return proto.CompactTextString(m) }
+func (*Hold) ProtoMessage()    {}
+func (*Hold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{8}
+}
+func (m *Hold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Hold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Hold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Hold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hold.Merge(m, src)
+}
+func (m *Hold) XXX_Size() int {
+	return m.Size()
+}
+func (m *Hold) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hold proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -440,52 +532,60 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*SetSendEnabledProposal)(nil), "cosmos.bank.v1beta1.SetSendEnabledProposal")
+	proto.RegisterType((*Hold)(nil), "cosmos.bank.v1beta1.Hold")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x34, 0x4d, 0xb2, 0x9d, 0xe8, 0x65, 0x0c, 0x32, 0xed, 0x61, 0x13, 0x72, 0x90, 0x28,
-	0x34, 0x49, 0xab, 0xa7, 0x20, 0x88, 0xad, 0xa2, 0x11, 0x44, 0xd9, 0x52, 0x04, 0x2f, 0x61, 0x92,
-	0x19, 0x93, 0xa1, 0xbb, 0x33, 0xcb, 0xce, 0x6c, 0x69, 0x7e, 0x80, 0x20, 0x9e, 0x3c, 0x7a, 0xec,
-	0x51, 0x3d, 0x17, 0xfc, 0x0b, 0xc5, 0x53, 0xf1, 0xe4, 0xa9, 0x4a, 0x7a, 0xf1, 0x67, 0xc8, 0xcc,
-	0xec, 0xa6, 0x29, 0x54, 0xf1, 0xe0, 0xc5, 0xd3, 0xbe, 0xef, 0x7d, 0xef, 0x7d, 0xef, 0xbd, 0x99,
-	0x37, 0x0b, 0xfd, 0x91, 0x54, 0x91, 0x54, 0x9d, 0x21, 0x11, 0x7b, 0x9d, 0xfd, 0x8d, 0x21, 0xd3,
-	0x64, 0xc3, 0x82, 0x76, 0x9c, 0x48, 0x2d, 0xd1, 0x35, 0xc7, 0xb7, 0xad, 0x2b, 0xe3, 0xd7, 0x6a,
-	0x63, 0x39, 0x96, 0x96, 0xef, 0x18, 0xcb, 0x85, 0xae, 0xad, 0xba, 0xd0, 0x81, 0x23, 0xb2, 0x3c,
-	0x47, 0x9d, 0x57, 0x51, 0x6c, 0x5e, 0x65, 0x24, 0xb9, 0x70, 0x7c, 0xf3, 0x35, 0x80, 0xe5, 0xe7,
-	0x24, 0x21, 0x91, 0x42, 0xdb, 0xf0, 0x8a, 0x62, 0x82, 0x0e, 0x98, 0x20, 0xc3, 0x90, 0x51, 0x0c,
-	0x1a, 0xc5, 0x56, 0x75, 0xb3, 0xd1, 0xbe, 0xa4, 0x8f, 0xf6, 0x0e, 0x13, 0xf4, 0xa1, 0x8b, 0x0b,
-	0xaa, 0xea, 0x1c, 0xa0, 0x2e, 0xac, 0x51, 0xf6, 0x8a, 0xa4, 0xa1, 0x1e, 0x5c, 0x10, 0x5b, 0x6a,
-	0x80, 0x96, 0x17, 0xa0, 0x8c, 0x5b, 0x48, 0xef, 0x2d, 0xbf, 0x3f, 0xac, 0x17, 0x9a, 0x8f, 0x60,
-	0x75, 0xc1, 0x89, 0x6a, 0xb0, 0x44, 0x99, 0x90, 0x11, 0x06, 0x0d, 0xd0, 0x5a, 0x09, 0x1c, 0x40,
-	0x18, 0x56, 0x2e, 0xea, 0xe5, 0xb0, 0xe7, 0x19, 0x91, 0x9f, 0x87, 0x75, 0xd0, 0xfc, 0x00, 0x60,
-	0xa9, 0x2f, 0xe2, 0x54, 0xa3, 0x4d, 0x58, 0x21, 0x94, 0x26, 0x4c, 0x29, 0xa7, 0xb2, 0x85, 0xbf,
-	0x1e, 0xad, 0xd7, 0xb2, 0x69, 0xee, 0x3b, 0x66, 0x47, 0x27, 0x5c, 0x8c, 0x83, 0x3c, 0x10, 0x11,
-	0x58, 0x32, 0x87, 0xa3, 0xf0, 0x92, 0x1d, 0x7e, 0xf5, 0x7c, 0x78, 0xc5, 0xe6, 0xc3, 0x6f, 0x4b,
-	0x2e, 0xb6, 0xba, 0xc7, 0xa7, 0xf5, 0xc2, 0xa7, 0xef, 0xf5, 0xd6, 0x98, 0xeb, 0x49, 0x3a, 0x6c,
-	0x8f, 0x64, 0x94, 0x9d, 0x7c, 0xf6, 0x59, 0x57, 0x74, 0xaf, 0xa3, 0xa7, 0x31, 0x53, 0x36, 0x41,
-	0x05, 0x4e, 0xb9, 0xe7, 0xbd, 0x71, 0xad, 0x16, 0x9a, 0x1f, 0x01, 0x2c, 0x3f, 0x4b, 0xf5, 0x7f,
-	0xd1, 0xeb, 0x67, 0x00, 0xcb, 0x3b, 0x69, 0x1c, 0x87, 0x53, 0x53, 0x57, 0x4b, 0x4d, 0xc2, 0x6c,
-	0x41, 0xfe, 0x6d, 0x5d, 0xab, 0xdc, 0x7b, 0x92, 0xd5, 0x05, 0x5f, 0x8e, 0xd6, 0xef, 0xde, 0xfa,
-	0x63, 0xf6, 0x81, 0x7b, 0x40, 0x11, 0x1f, 0x27, 0x44, 0x73, 0x29, 0x54, 0x67, 0xbf, 0x7b, 0xa7,
-	0xdb, 0x76, 0xbd, 0xf6, 0x31, 0x68, 0xbe, 0x80, 0x2b, 0x0f, 0xcc, 0xf6, 0xec, 0x0a, 0xae, 0x7f,
-	0xb3, 0x57, 0x6b, 0xd0, 0x63, 0x07, 0xb1, 0x14, 0x4c, 0x68, 0xbb, 0x58, 0x57, 0x83, 0x39, 0x36,
-	0x3b, 0x47, 0x42, 0x4e, 0x14, 0x53, 0xb8, 0xd8, 0x28, 0xb6, 0x56, 0x82, 0x1c, 0x36, 0xdf, 0x2e,
-	0x41, 0xef, 0x29, 0xd3, 0x84, 0x12, 0x4d, 0x50, 0x03, 0x56, 0x29, 0x53, 0xa3, 0x84, 0xc7, 0xa6,
-	0x89, 0x4c, 0x7e, 0xd1, 0x85, 0xee, 0x99, 0x08, 0x21, 0xa3, 0x41, 0x2a, 0xb8, 0xce, 0x2f, 0xcd,
-	0xbf, 0xf4, 0x75, 0xcd, 0xfb, 0x0d, 0x20, 0xcd, 0x4d, 0x85, 0x10, 0x5c, 0x36, 0x47, 0x8c, 0x8b,
-	0x56, 0xdb, 0xda, 0xa6, 0x3b, 0xca, 0x55, 0x1c, 0x92, 0x29, 0x5e, 0xb6, 0xee, 0x1c, 0x9a, 0x68,
-	0x41, 0x22, 0x86, 0x4b, 0x2e, 0xda, 0xd8, 0xe8, 0x3a, 0x2c, 0xab, 0x69, 0x34, 0x94, 0x21, 0x2e,
-	0x5b, 0x6f, 0x86, 0xd0, 0x2a, 0x2c, 0xa6, 0x09, 0xc7, 0x15, 0xbb, 0x79, 0x95, 0xd9, 0x69, 0xbd,
-	0xb8, 0x1b, 0xf4, 0x03, 0xe3, 0x43, 0x37, 0xa0, 0x97, 0x26, 0x7c, 0x30, 0x21, 0x6a, 0x82, 0x3d,
-	0xcb, 0x57, 0x67, 0xa7, 0xf5, 0xca, 0x6e, 0xd0, 0x7f, 0x4c, 0xd4, 0x24, 0xa8, 0xa4, 0x09, 0x37,
-	0xc6, 0xd6, 0xf6, 0xf1, 0xcc, 0x07, 0x27, 0x33, 0x1f, 0xfc, 0x98, 0xf9, 0xe0, 0xdd, 0x99, 0x5f,
-	0x38, 0x39, 0xf3, 0x0b, 0xdf, 0xce, 0xfc, 0xc2, 0xcb, 0x9b, 0x7f, 0x73, 0x7d, 0x76, 0x07, 0x86,
-	0x65, 0xfb, 0x4f, 0xba, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x80, 0x89, 0x32, 0x1b, 0x05,
-	0x00, 0x00,
+	// 733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xbf, 0x6b, 0x23, 0x47,
+	0x14, 0xd6, 0x68, 0xa5, 0x95, 0x3c, 0xb2, 0x09, 0x4c, 0x84, 0x19, 0xbb, 0x58, 0x09, 0x15, 0x46,
+	0x09, 0x58, 0x92, 0x9d, 0x54, 0x22, 0x10, 0x62, 0x3b, 0x89, 0x15, 0x08, 0x31, 0x2b, 0x4c, 0x20,
+	0x8d, 0x18, 0x69, 0xc7, 0xd2, 0xe2, 0xdd, 0x99, 0x65, 0x67, 0xd6, 0x58, 0x7f, 0x40, 0x20, 0xa4,
+	0x4a, 0xe9, 0xd2, 0x65, 0x92, 0xda, 0x90, 0x3a, 0x9d, 0x49, 0x1a, 0x93, 0x2a, 0x95, 0xef, 0x90,
+	0x9b, 0xfb, 0x33, 0x8e, 0x99, 0xd9, 0x95, 0x64, 0x9f, 0xef, 0x38, 0xcc, 0x5d, 0x71, 0x95, 0xe6,
+	0x7b, 0xdf, 0x9b, 0xef, 0xfd, 0xd8, 0xf7, 0x46, 0xd0, 0x19, 0x71, 0x11, 0x72, 0xd1, 0x1e, 0x12,
+	0x76, 0xda, 0x3e, 0xdb, 0x19, 0x52, 0x49, 0x76, 0x34, 0x68, 0x45, 0x31, 0x97, 0x1c, 0x7d, 0x6c,
+	0xf8, 0x96, 0x36, 0xa5, 0xfc, 0x66, 0x75, 0xcc, 0xc7, 0x5c, 0xf3, 0x6d, 0x75, 0x32, 0xae, 0x9b,
+	0x1b, 0xc6, 0x75, 0x60, 0x88, 0xf4, 0x9e, 0xa1, 0x16, 0x51, 0x04, 0x9d, 0x47, 0x19, 0x71, 0x9f,
+	0x19, 0xbe, 0xf1, 0x33, 0x80, 0xf6, 0x11, 0x89, 0x49, 0x28, 0xd0, 0x3e, 0x5c, 0x15, 0x94, 0x79,
+	0x03, 0xca, 0xc8, 0x30, 0xa0, 0x1e, 0x06, 0x75, 0xab, 0x59, 0xd9, 0xad, 0xb7, 0x1e, 0xc9, 0xa3,
+	0xd5, 0xa7, 0xcc, 0xfb, 0xda, 0xf8, 0xb9, 0x15, 0xb1, 0x00, 0xa8, 0x03, 0xab, 0x1e, 0x3d, 0x21,
+	0x49, 0x20, 0x07, 0xf7, 0xc4, 0xf2, 0x75, 0xd0, 0x2c, 0xbb, 0x28, 0xe5, 0x96, 0xae, 0x77, 0x0b,
+	0x17, 0x97, 0xb5, 0x5c, 0xe3, 0x5b, 0x58, 0x59, 0x32, 0xa2, 0x2a, 0x2c, 0x7a, 0x94, 0xf1, 0x10,
+	0x83, 0x3a, 0x68, 0xae, 0xb8, 0x06, 0x20, 0x0c, 0x4b, 0xf7, 0xf5, 0x32, 0xd8, 0x2d, 0x2b, 0x91,
+	0x17, 0x97, 0x35, 0xd0, 0xf8, 0x1d, 0xc0, 0x62, 0x8f, 0x45, 0x89, 0x44, 0xbb, 0xb0, 0x44, 0x3c,
+	0x2f, 0xa6, 0x42, 0x18, 0x95, 0x3d, 0xfc, 0xdf, 0xd5, 0x76, 0x35, 0xad, 0xe6, 0x2b, 0xc3, 0xf4,
+	0x65, 0xec, 0xb3, 0xb1, 0x9b, 0x39, 0x22, 0x02, 0x8b, 0xaa, 0x39, 0x02, 0xe7, 0x75, 0xf1, 0x1b,
+	0x8b, 0xe2, 0x05, 0x9d, 0x17, 0xbf, 0xcf, 0x7d, 0xb6, 0xd7, 0xb9, 0xbe, 0xad, 0xe5, 0xfe, 0x7c,
+	0x56, 0x6b, 0x8e, 0x7d, 0x39, 0x49, 0x86, 0xad, 0x11, 0x0f, 0xd3, 0xce, 0xa7, 0x3f, 0xdb, 0xc2,
+	0x3b, 0x6d, 0xcb, 0x69, 0x44, 0x85, 0xbe, 0x20, 0x5c, 0xa3, 0xdc, 0x2d, 0xff, 0x62, 0x52, 0xcd,
+	0x35, 0xfe, 0x00, 0xd0, 0xfe, 0x21, 0x91, 0x1f, 0x44, 0xae, 0x7f, 0x01, 0x68, 0xf7, 0x93, 0x28,
+	0x0a, 0xa6, 0x2a, 0xae, 0xe4, 0x92, 0x04, 0x18, 0xbc, 0x87, 0xb8, 0x5a, 0xb9, 0xfb, 0x5d, 0x1a,
+	0x17, 0xfc, 0x73, 0xb5, 0xfd, 0xc5, 0xa7, 0x6f, 0xbc, 0x7d, 0x6e, 0x16, 0x28, 0xf4, 0xc7, 0x31,
+	0x91, 0x3e, 0x67, 0xa2, 0x7d, 0xd6, 0xf9, 0xbc, 0xd3, 0x32, 0xb9, 0xf6, 0x30, 0x68, 0xfc, 0x08,
+	0x57, 0x0e, 0xd4, 0xf4, 0x1c, 0x33, 0x5f, 0xbe, 0x66, 0xae, 0x36, 0x61, 0x99, 0x9e, 0x47, 0x9c,
+	0x51, 0x26, 0xf5, 0x60, 0xad, 0xb9, 0x73, 0xac, 0x66, 0x8e, 0x04, 0x3e, 0x11, 0x54, 0x60, 0xab,
+	0x6e, 0x35, 0x57, 0xdc, 0x0c, 0x36, 0x7e, 0xcd, 0xc3, 0xf2, 0xf7, 0x54, 0x12, 0x8f, 0x48, 0x82,
+	0xea, 0xb0, 0xe2, 0x51, 0x31, 0x8a, 0xfd, 0x48, 0x25, 0x91, 0xca, 0x2f, 0x9b, 0xd0, 0x97, 0xca,
+	0x83, 0xf1, 0x70, 0x90, 0x30, 0x5f, 0x66, 0x1f, 0xcd, 0x79, 0x74, 0xbb, 0xe6, 0xf9, 0xba, 0xd0,
+	0xcb, 0x8e, 0x02, 0x21, 0x58, 0x50, 0x2d, 0xc6, 0x96, 0xd6, 0xd6, 0x67, 0x95, 0x9d, 0xe7, 0x8b,
+	0x28, 0x20, 0x53, 0x5c, 0xd0, 0xe6, 0x0c, 0x2a, 0x6f, 0x46, 0x42, 0x8a, 0x8b, 0xc6, 0x5b, 0x9d,
+	0xd1, 0x3a, 0xb4, 0xc5, 0x34, 0x1c, 0xf2, 0x00, 0xdb, 0xda, 0x9a, 0x22, 0xb4, 0x01, 0xad, 0x24,
+	0xf6, 0x71, 0x49, 0x4f, 0x5e, 0x69, 0x76, 0x5b, 0xb3, 0x8e, 0xdd, 0x9e, 0xab, 0x6c, 0x68, 0x0b,
+	0x96, 0x93, 0xd8, 0x1f, 0x4c, 0x88, 0x98, 0xe0, 0xb2, 0xe6, 0x2b, 0xb3, 0xdb, 0x5a, 0xe9, 0xd8,
+	0xed, 0x1d, 0x12, 0x31, 0x71, 0x4b, 0x49, 0xec, 0xab, 0x43, 0xe3, 0x5f, 0x00, 0xd7, 0xfb, 0x74,
+	0x79, 0xb1, 0x8f, 0x62, 0x1e, 0x71, 0x41, 0x02, 0xd5, 0x73, 0xe9, 0xcb, 0x80, 0x66, 0x3d, 0xd7,
+	0xe0, 0x61, 0xc3, 0xf2, 0xaf, 0x36, 0xec, 0xe1, 0x7b, 0x64, 0x3d, 0xe5, 0x3d, 0xda, 0x82, 0x1f,
+	0x25, 0x82, 0x0e, 0xb2, 0x37, 0xe9, 0x84, 0xc7, 0xb8, 0xa0, 0x3f, 0xe3, 0x5a, 0x22, 0xe8, 0x81,
+	0xb1, 0x7e, 0xc3, 0xe3, 0xee, 0xaa, 0x9a, 0xb8, 0x8b, 0x6c, 0xda, 0xff, 0x06, 0xb0, 0x70, 0xc8,
+	0x03, 0xef, 0x49, 0x7b, 0xb9, 0x0e, 0xed, 0x09, 0x0f, 0x3c, 0x1a, 0xa7, 0x45, 0xa5, 0x08, 0x8d,
+	0xa0, 0x4d, 0x42, 0x9e, 0x30, 0x89, 0xad, 0x77, 0xbf, 0x38, 0xa9, 0xf4, 0x62, 0x63, 0xf7, 0xf6,
+	0xaf, 0x67, 0x0e, 0xb8, 0x99, 0x39, 0xe0, 0xf9, 0xcc, 0x01, 0xbf, 0xdd, 0x39, 0xb9, 0x9b, 0x3b,
+	0x27, 0xf7, 0xff, 0x9d, 0x93, 0xfb, 0xe9, 0x93, 0xb7, 0x59, 0x28, 0x2d, 0x3e, 0xb4, 0xf5, 0xbf,
+	0xc4, 0x67, 0x2f, 0x07, 0x00, 0xc3, 0xd3, 0x65, 0x32, 0xad, 0x06, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -886,6 +986,117 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetSendEnabledProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetSendEnabledProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetSendEnabledProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UseDefaultFor) > 0 {
+		for iNdEx := len(m.UseDefaultFor) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UseDefaultFor[iNdEx])
+			copy(dAtA[i:], m.UseDefaultFor[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.UseDefaultFor[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SendEnabled) > 0 {
+		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Hold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -1049,6 +1260,58 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *SetSendEnabledProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if len(m.UseDefaultFor) > 0 {
+		for _, s := range m.UseDefaultFor {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Hold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2018,6 +2281,334 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetSendEnabledProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetSendEnabledProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetSendEnabledProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDefaultFor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UseDefaultFor = append(m.UseDefaultFor, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,9 +7,20 @@ import (
 // bank module event types
 const (
 	EventTypeTransfer = "transfer"
+	// EventTypeOutputReference is emitted per output of a MsgMultiSendV2 with
+	// a reference.
+	EventTypeOutputReference = "output_reference"
 
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySender    = "sender"
+	AttributeKeyReference = "reference"
+
+	// holds events
+	EventTypeHold        = "hold"
+	EventTypeReleaseHold = "release_hold"
+
+	AttributeKeyAccount = "account"
+	AttributeKeyHolder  = "holder"
 
 	AttributeValueCategory = ModuleName
 
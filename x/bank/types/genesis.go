@@ -6,13 +6,14 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Validate performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
-		return err
+		return sdkerrors.WrapPath(err, "params")
 	}
 
 	seenBalances := make(map[string]bool)
@@ -20,13 +21,13 @@ func (gs GenesisState) Validate() error {
 
 	totalSupply := sdk.Coins{}
 
-	for _, balance := range gs.Balances {
+	for i, balance := range gs.Balances {
 		if seenBalances[balance.Address] {
-			return fmt.Errorf("duplicate balance for address %s", balance.Address)
+			return sdkerrors.WrapPath(fmt.Errorf("duplicate balance for address %s", balance.Address), "balances", i)
 		}
 
 		if err := balance.Validate(); err != nil {
-			return err
+			return sdkerrors.WrapPath(err, "balances", i)
 		}
 
 		seenBalances[balance.Address] = true
@@ -34,27 +35,63 @@ func (gs GenesisState) Validate() error {
 		totalSupply = totalSupply.Add(balance.Coins...)
 	}
 
-	for _, metadata := range gs.DenomMetadata {
+	for i, metadata := range gs.DenomMetadata {
 		if seenMetadatas[metadata.Base] {
-			return fmt.Errorf("duplicate client metadata for denom %s", metadata.Base)
+			return sdkerrors.WrapPath(fmt.Errorf("duplicate client metadata for denom %s", metadata.Base), "denom_metadata", i)
 		}
 
 		if err := metadata.Validate(); err != nil {
-			return err
+			return sdkerrors.WrapPath(err, "denom_metadata", i)
 		}
 
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenSendEnabled := make(map[string]bool)
+	for i, se := range gs.SendEnabled {
+		if seenSendEnabled[se.Denom] {
+			return sdkerrors.WrapPath(fmt.Errorf("duplicate send enabled found: '%s'", se.Denom), "send_enabled", i)
+		}
+
+		if err := validateSendEnabled(se); err != nil {
+			return sdkerrors.WrapPath(err, "send_enabled", i)
+		}
+
+		seenSendEnabled[se.Denom] = true
+	}
+
+	balances := make(map[string]sdk.Coins, len(gs.Balances))
+	for _, balance := range gs.Balances {
+		balances[balance.Address] = balance.Coins
+	}
+	seenHolds := make(map[string]bool)
+	held := make(map[string]sdk.Coins)
+	for i, hold := range gs.Holds {
+		key := hold.Address + "/" + hold.Holder
+		if seenHolds[key] {
+			return sdkerrors.WrapPath(fmt.Errorf("duplicate hold of %s on %s", hold.Holder, hold.Address), "holds", i)
+		}
+
+		if err := hold.Validate(); err != nil {
+			return sdkerrors.WrapPath(err, "holds", i)
+		}
+
+		seenHolds[key] = true
+		held[hold.Address] = held[hold.Address].Add(hold.Amount...)
+		if !held[hold.Address].IsAllLTE(balances[hold.Address]) {
+			return sdkerrors.WrapPath(fmt.Errorf("held coins %s of %s exceed its balance %s", held[hold.Address], hold.Address, balances[hold.Address]), "holds", i)
+		}
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
 		if err != nil {
-			return err
+			return sdkerrors.WrapPath(err, "supply")
 		}
 
 		if !gs.Supply.IsEqual(totalSupply) {
-			return fmt.Errorf("genesis supply is incorrect, expected %v, got %v", gs.Supply, totalSupply)
+			return sdkerrors.WrapPath(fmt.Errorf("genesis supply is incorrect, expected %v, got %v", gs.Supply, totalSupply), "supply")
 		}
 	}
 
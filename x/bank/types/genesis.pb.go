@@ -37,6 +37,10 @@ type GenesisState struct {
 	Supply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=supply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply"`
 	// denom_metadata defines the metadata of the differents coins.
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
+	// send_enabled defines the send_enabled overrides of denoms.
+	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// holds defines the holds placed by modules on the balances of accounts.
+	Holds []Hold `protobuf:"bytes,6,rep,name=holds,proto3" json:"holds"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +104,20 @@ func (m *GenesisState) GetDenomMetadata() []Metadata {
 	return nil
 }
 
+func (m *GenesisState) GetSendEnabled() []SendEnabled {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHolds() []Hold {
+	if m != nil {
+		return m.Holds
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -150,32 +168,35 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x4f, 0xfa, 0x40,
-	0x18, 0xc7, 0x5b, 0xe0, 0x07, 0xfc, 0x0e, 0x75, 0xa8, 0x0c, 0x05, 0xb5, 0x45, 0x26, 0x1c, 0x68,
-	0x05, 0x27, 0x1d, 0x4c, 0x2c, 0x83, 0x89, 0x89, 0x89, 0x81, 0xcd, 0x85, 0x5c, 0xdb, 0x4b, 0x6d,
-	0xa0, 0xbd, 0xa6, 0x77, 0x18, 0x79, 0x07, 0x8e, 0xbe, 0x04, 0x66, 0x66, 0x27, 0x5f, 0x01, 0x23,
-	0x71, 0x72, 0x52, 0x03, 0x8b, 0x2f, 0xc3, 0xf4, 0xee, 0xa8, 0x26, 0x12, 0x27, 0xa7, 0xfe, 0xf9,
-	0x7e, 0x3f, 0xdf, 0xe7, 0x79, 0xee, 0x1e, 0xb0, 0xef, 0x60, 0x12, 0x60, 0x62, 0xda, 0x30, 0x1c,
-	0x98, 0xb7, 0x2d, 0x1b, 0x51, 0xd8, 0x32, 0x3d, 0x14, 0x22, 0xe2, 0x13, 0x23, 0x8a, 0x31, 0xc5,
-	0xca, 0x36, 0xb7, 0x18, 0x89, 0xc5, 0x10, 0x96, 0x6a, 0xd9, 0xc3, 0x1e, 0x66, 0xba, 0x99, 0xbc,
-	0x71, 0x6b, 0x55, 0x4b, 0xd3, 0x08, 0x4a, 0xd3, 0x1c, 0xec, 0x87, 0x3f, 0xf4, 0x6f, 0xd5, 0x58,
-	0x2e, 0xd7, 0x2b, 0x5c, 0xef, 0xf3, 0x60, 0x51, 0x97, 0x7d, 0xd4, 0x9f, 0x32, 0x60, 0xe3, 0x9c,
-	0xf7, 0xd5, 0xa3, 0x90, 0x22, 0xe5, 0x18, 0xe4, 0x23, 0x18, 0xc3, 0x80, 0xa8, 0x72, 0x4d, 0x6e,
-	0x94, 0xda, 0x3b, 0xc6, 0x9a, 0x3e, 0x8d, 0x2b, 0x66, 0xb1, 0x72, 0xb3, 0x57, 0x5d, 0xea, 0x0a,
-	0x40, 0x39, 0x05, 0x45, 0x1b, 0x0e, 0x61, 0xe8, 0x20, 0xa2, 0x66, 0x6a, 0xd9, 0x46, 0xa9, 0xbd,
-	0xbb, 0x16, 0xb6, 0xb8, 0x49, 0xd0, 0x29, 0xa3, 0x38, 0x20, 0x4f, 0x46, 0x51, 0x34, 0x1c, 0xab,
-	0x59, 0x46, 0x57, 0xbe, 0x68, 0x82, 0x52, 0xba, 0x83, 0xfd, 0xd0, 0x3a, 0x4c, 0xd0, 0xe9, 0x9b,
-	0xde, 0xf0, 0x7c, 0x7a, 0x33, 0xb2, 0x0d, 0x07, 0x07, 0x62, 0x2e, 0xf1, 0x68, 0x12, 0x77, 0x60,
-	0xd2, 0x71, 0x84, 0x08, 0x03, 0x48, 0x57, 0x44, 0x2b, 0x17, 0x60, 0xcb, 0x45, 0x21, 0x0e, 0xfa,
-	0x01, 0xa2, 0xd0, 0x85, 0x14, 0xaa, 0x39, 0x56, 0x6c, 0x6f, 0x6d, 0xab, 0x97, 0xc2, 0x24, 0x7a,
-	0xdd, 0x64, 0xe8, 0xea, 0x67, 0x7d, 0x2a, 0x83, 0x82, 0x18, 0x46, 0x69, 0x83, 0x02, 0x74, 0xdd,
-	0x18, 0x11, 0x7e, 0x70, 0xff, 0x2d, 0xf5, 0xf9, 0xb1, 0x59, 0x16, 0x99, 0x67, 0x5c, 0xe9, 0xd1,
-	0xd8, 0x0f, 0xbd, 0xee, 0xca, 0xa8, 0x40, 0xf0, 0x2f, 0xb9, 0xc5, 0xd5, 0x69, 0xfd, 0xe9, 0xbc,
-	0x3c, 0xf9, 0xa4, 0x78, 0x3f, 0xd1, 0xa5, 0x8f, 0x89, 0x2e, 0x59, 0x9d, 0xd9, 0x42, 0x93, 0xe7,
-	0x0b, 0x4d, 0x7e, 0x5f, 0x68, 0xf2, 0xc3, 0x52, 0x93, 0xe6, 0x4b, 0x4d, 0x7a, 0x59, 0x6a, 0xd2,
-	0xf5, 0xc1, 0xaf, 0xa1, 0x77, 0x7c, 0xad, 0x58, 0xb6, 0x9d, 0x67, 0x5b, 0x73, 0xf4, 0x19, 0x00,
-	0x00, 0xff, 0xff, 0x78, 0xd8, 0xf3, 0x7f, 0xe0, 0x02, 0x00, 0x00,
+	// 445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0x87, 0x6d, 0xd2, 0xb8, 0xe5, 0x52, 0x18, 0x8e, 0x0e, 0x6e, 0x01, 0x3b, 0x74, 0x0a, 0x43,
+	0x6d, 0x1a, 0xc4, 0x00, 0x03, 0x12, 0xae, 0x10, 0x7f, 0x24, 0x24, 0x94, 0x6c, 0x2c, 0xd1, 0xd9,
+	0x77, 0x72, 0xad, 0xda, 0x77, 0x96, 0xdf, 0x2b, 0xa2, 0xdf, 0x80, 0x91, 0x8f, 0xd0, 0xb9, 0x33,
+	0x23, 0x1f, 0x20, 0x63, 0xc4, 0xc4, 0x04, 0x28, 0x59, 0xf8, 0x18, 0xc8, 0x77, 0x67, 0x27, 0x02,
+	0x8b, 0x89, 0x29, 0xf1, 0xbd, 0xbf, 0xe7, 0xb9, 0xf7, 0xee, 0x5e, 0x74, 0x2f, 0x11, 0x50, 0x08,
+	0x08, 0x63, 0xc2, 0xcf, 0xc2, 0xf7, 0xc7, 0x31, 0x93, 0xe4, 0x38, 0x4c, 0x19, 0x67, 0x90, 0x41,
+	0x50, 0x56, 0x42, 0x0a, 0x7c, 0x4b, 0x47, 0x82, 0x3a, 0x12, 0x98, 0xc8, 0xc1, 0x5e, 0x2a, 0x52,
+	0xa1, 0xea, 0x61, 0xfd, 0x4f, 0x47, 0x0f, 0xbc, 0xd6, 0x06, 0xac, 0xb5, 0x25, 0x22, 0xe3, 0x7f,
+	0xd5, 0x37, 0x76, 0x53, 0x5e, 0x5d, 0xdf, 0xd7, 0xf5, 0x99, 0x16, 0x9b, 0x7d, 0xd5, 0xc7, 0xe1,
+	0x97, 0x1e, 0xda, 0x7d, 0xa1, 0xfb, 0x9a, 0x4a, 0x22, 0x19, 0x7e, 0x8c, 0x9c, 0x92, 0x54, 0xa4,
+	0x00, 0xd7, 0x1e, 0xda, 0xa3, 0xc1, 0xf8, 0x76, 0xd0, 0xd1, 0x67, 0xf0, 0x56, 0x45, 0xa2, 0xad,
+	0xf9, 0x77, 0xdf, 0x9a, 0x18, 0x00, 0x3f, 0x45, 0x3b, 0x31, 0xc9, 0x09, 0x4f, 0x18, 0xb8, 0xd7,
+	0x86, 0xbd, 0xd1, 0x60, 0x7c, 0xa7, 0x13, 0x8e, 0x74, 0xc8, 0xd0, 0x2d, 0x83, 0x13, 0xe4, 0xc0,
+	0x79, 0x59, 0xe6, 0x17, 0x6e, 0x4f, 0xd1, 0xfb, 0x6b, 0x1a, 0x58, 0x4b, 0x9f, 0x88, 0x8c, 0x47,
+	0x0f, 0x6a, 0xf4, 0xea, 0x87, 0x3f, 0x4a, 0x33, 0x79, 0x7a, 0x1e, 0x07, 0x89, 0x28, 0xcc, 0xb9,
+	0xcc, 0xcf, 0x11, 0xd0, 0xb3, 0x50, 0x5e, 0x94, 0x0c, 0x14, 0x00, 0x13, 0xa3, 0xc6, 0xaf, 0xd1,
+	0x4d, 0xca, 0xb8, 0x28, 0x66, 0x05, 0x93, 0x84, 0x12, 0x49, 0xdc, 0x2d, 0xb5, 0xd9, 0xdd, 0xce,
+	0x56, 0xdf, 0x98, 0x90, 0xe9, 0xf5, 0x86, 0x42, 0x9b, 0x45, 0xfc, 0x0a, 0xed, 0x02, 0xe3, 0x74,
+	0xc6, 0x38, 0x89, 0x73, 0x46, 0xdd, 0xbe, 0x32, 0x0d, 0x3b, 0x4d, 0x53, 0xc6, 0xe9, 0x73, 0x9d,
+	0x33, 0xb2, 0x01, 0xac, 0x97, 0xf0, 0x23, 0xd4, 0x3f, 0x15, 0x39, 0x05, 0xd7, 0xf9, 0xf3, 0xe8,
+	0x1b, 0x8e, 0x97, 0x22, 0x6f, 0x60, 0x9d, 0x3e, 0xbc, 0xb2, 0xd1, 0xb6, 0xb9, 0x4e, 0x3c, 0x46,
+	0xdb, 0x84, 0xd2, 0x8a, 0x81, 0x7e, 0xba, 0xeb, 0x91, 0xfb, 0xf5, 0xf3, 0xd1, 0x9e, 0xf1, 0x3c,
+	0xd3, 0x95, 0xa9, 0xac, 0x32, 0x9e, 0x4e, 0x9a, 0x20, 0x26, 0xa8, 0x5f, 0xcf, 0x51, 0xf3, 0x5e,
+	0xff, 0xf5, 0xc6, 0xb5, 0xf9, 0xc9, 0xce, 0xc7, 0x4b, 0xdf, 0xfa, 0x75, 0xe9, 0x5b, 0xd1, 0xc9,
+	0x7c, 0xe9, 0xd9, 0x8b, 0xa5, 0x67, 0xff, 0x5c, 0x7a, 0xf6, 0xa7, 0x95, 0x67, 0x2d, 0x56, 0x9e,
+	0xf5, 0x6d, 0xe5, 0x59, 0xef, 0xee, 0xff, 0x53, 0xfa, 0x41, 0x0f, 0xb6, 0x72, 0xc7, 0x8e, 0x9a,
+	0xdb, 0x87, 0xbf, 0x07, 0x00, 0x02, 0x1c, 0x6c, 0x4a, 0x62, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -198,6 +219,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Holds) > 0 {
+		for iNdEx := len(m.Holds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SendEnabled) > 0 {
+		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.DenomMetadata) > 0 {
 		for iNdEx := len(m.DenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -334,6 +383,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Holds) > 0 {
+		for _, e := range m.Holds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -526,6 +587,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendEnabled = append(m.SendEnabled, SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holds = append(m.Holds, Hold{})
+			if err := m.Holds[len(m.Holds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
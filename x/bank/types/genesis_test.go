@@ -141,6 +141,96 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid holds",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 2)},
+					},
+				},
+				Holds: []Hold{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Holder:  "escrow",
+						Amount:  sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+					},
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Holder:  "gov",
+						Amount:  sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"dup holds",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 2)},
+					},
+				},
+				Holds: []Hold{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Holder:  "escrow",
+						Amount:  sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+					},
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Holder:  "escrow",
+						Amount:  sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"hold without holder",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+					},
+				},
+				Holds: []Hold{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Amount:  sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"holds exceeding balance",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+					},
+				},
+				Holds: []Hold{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Holder:  "escrow",
+						Amount:  sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+					},
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Holder:  "gov",
+						Amount:  sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+					},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -157,3 +247,18 @@ func TestGenesisStateValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisStateValidatePath(t *testing.T) {
+	gs := DefaultGenesisState()
+	gs.Balances = []Balance{
+		{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Coins: sdk.Coins{sdk.NewInt64Coin("stake", 1)}},
+		{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Coins: sdk.Coins{sdk.NewInt64Coin("stake", 1)}},
+	}
+	require.EqualError(t, gs.Validate(), "balances[1]: duplicate balance for address cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t")
+
+	gs.Balances[1] = Balance{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Coins: sdk.Coins{{Denom: "stake", Amount: sdk.NewInt(-1)}}}
+	gs.Balances = gs.Balances[1:]
+	err := gs.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "balances[0].coins: ")
+}
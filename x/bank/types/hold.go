@@ -0,0 +1,43 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewHold creates a new Hold instance
+func NewHold(addr sdk.AccAddress, holder string, amount sdk.Coins) Hold {
+	return Hold{
+		Address: addr.String(),
+		Holder:  holder,
+		Amount:  amount,
+	}
+}
+
+// Validate checks that the hold has a valid address, a holder and positive
+// coins.
+func (h Hold) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(h.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid hold address: %s", err)
+	}
+
+	if h.Holder == "" {
+		return errors.New("hold holder cannot be empty")
+	}
+
+	if h.Amount.Empty() || !h.Amount.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "hold amount %s", h.Amount)
+	}
+
+	return nil
+}
+
+// HoldHooks are notified of the releases of the holds. They are set on the
+// keeper with SetHoldHooks.
+type HoldHooks interface {
+	// AfterHoldReleased is called after coins held by a holder are released,
+	// with the released coins.
+	AfterHoldReleased(ctx sdk.Context, addr sdk.AccAddress, holder string, released sdk.Coins) error
+}
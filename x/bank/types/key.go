@@ -25,6 +25,8 @@ var (
 	SupplyKey           = []byte{0x00}
 	DenomMetadataPrefix = []byte{0x1}
 	DenomAddressPrefix  = []byte{0x03}
+	SendEnabledPrefix   = []byte{0x04}
+	HoldsPrefix         = []byte{0x05}
 
 	// BalancesPrefix is the prefix for the account balances store. We use a byte
 	// (instead of `[]byte("balances")` to save some disk space).
@@ -67,3 +69,20 @@ func CreateDenomAddressPrefix(denom string) []byte {
 	copy(key[len(DenomAddressPrefix):], denom)
 	return key
 }
+
+// CreateSendEnabledKey returns the store key of the send_enabled override of a
+// denom.
+func CreateSendEnabledKey(denom string) []byte {
+	return append(append([]byte{}, SendEnabledPrefix...), []byte(denom)...)
+}
+
+// CreateAccountHoldsPrefix creates the prefix of the holds of an account.
+func CreateAccountHoldsPrefix(addr []byte) []byte {
+	return append(append([]byte{}, HoldsPrefix...), address.MustLengthPrefix(addr)...)
+}
+
+// CreateHoldKey returns the store key of the hold of a holder on the balance
+// of an account.
+func CreateHoldKey(addr []byte, holder string) []byte {
+	return append(CreateAccountHoldsPrefix(addr), []byte(holder)...)
+}
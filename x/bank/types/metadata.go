@@ -77,6 +77,63 @@ func (m Metadata) Validate() error {
 	return nil
 }
 
+// GetDenomUnit returns the denomination unit of the given denom, which is either
+// the denom of the unit or one of its aliases.
+func (m Metadata) GetDenomUnit(denom string) (*DenomUnit, bool) {
+	for _, unit := range m.DenomUnits {
+		if unit.Denom == denom {
+			return unit, true
+		}
+
+		for _, alias := range unit.Aliases {
+			if alias == denom {
+				return unit, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// ConvertToBaseDenom converts an amount of a denomination unit of the metadata,
+// e.g. 12.5atom, to the base denomination, e.g. 12500000uatom. It returns an
+// error if the denom isn't a unit of the metadata or if the amount isn't a
+// whole number of base units.
+func (m Metadata) ConvertToBaseDenom(coin sdk.DecCoin) (sdk.Coin, error) {
+	unit, ok := m.GetDenomUnit(coin.Denom)
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("%s is not a denomination unit of %s", coin.Denom, m.Base)
+	}
+	if unit.Exponent > sdk.Precision {
+		return sdk.Coin{}, fmt.Errorf("exponent %d of denomination unit %s exceeds the decimal precision", unit.Exponent, unit.Denom)
+	}
+
+	amount := coin.Amount.Mul(sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, int(unit.Exponent))))
+	if !amount.IsInteger() {
+		return sdk.Coin{}, fmt.Errorf("%s is not a whole number of %s", coin, m.Base)
+	}
+
+	return sdk.NewCoin(m.Base, amount.TruncateInt()), nil
+}
+
+// ConvertToDisplayDenom converts an amount of the base denomination of the
+// metadata, e.g. 12500000uatom, to the display denomination, e.g. 12.5atom.
+func (m Metadata) ConvertToDisplayDenom(coin sdk.Coin) (sdk.DecCoin, error) {
+	if coin.Denom != m.Base {
+		return sdk.DecCoin{}, fmt.Errorf("%s is not the base denomination %s", coin.Denom, m.Base)
+	}
+
+	unit, ok := m.GetDenomUnit(m.Display)
+	if !ok {
+		return sdk.DecCoin{}, fmt.Errorf("no denomination unit for display denom %s", m.Display)
+	}
+	if unit.Exponent > sdk.Precision {
+		return sdk.DecCoin{}, fmt.Errorf("exponent %d of denomination unit %s exceeds the decimal precision", unit.Exponent, unit.Denom)
+	}
+
+	return sdk.NewDecCoinFromDec(m.Display, sdk.NewDecFromIntWithPrec(coin.Amount, int64(unit.Exponent))), nil
+}
+
 // Validate performs a basic validation of the denomination unit fields
 func (du DenomUnit) Validate() error {
 	if err := sdk.ValidateDenom(du.Denom); err != nil {
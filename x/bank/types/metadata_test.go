@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -272,3 +273,52 @@ func TestMarshalJSONMetaData(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataConvertDenom(t *testing.T) {
+	metadata := types.Metadata{
+		DenomUnits: []*types.DenomUnit{
+			{Denom: "uatom", Aliases: []string{"microatom"}},
+			{Denom: "matom", Exponent: 3, Aliases: []string{"milliatom"}},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	}
+
+	testCases := []struct {
+		name   string
+		coin   string
+		expect sdk.Coin
+		expErr bool
+	}{
+		{"display denom", "12.5atom", sdk.NewInt64Coin("uatom", 12500000), false},
+		{"intermediate unit", "2.001matom", sdk.NewInt64Coin("uatom", 2001), false},
+		{"alias", "3milliatom", sdk.NewInt64Coin("uatom", 3000), false},
+		{"base denom", "7uatom", sdk.NewInt64Coin("uatom", 7), false},
+		{"fraction of base unit", "0.0000001atom", sdk.Coin{}, true},
+		{"unknown denom", "1eth", sdk.Coin{}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			coin, err := sdk.ParseDecCoin(tc.coin)
+			require.NoError(t, err)
+
+			base, err := metadata.ConvertToBaseDenom(coin)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, base)
+		})
+	}
+
+	display, err := metadata.ConvertToDisplayDenom(sdk.NewInt64Coin("uatom", 12500000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec("atom", sdk.MustNewDecFromStr("12.5")), display)
+
+	_, err = metadata.ConvertToDisplayDenom(sdk.NewInt64Coin("atom", 1))
+	require.Error(t, err)
+}
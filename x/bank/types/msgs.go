@@ -7,10 +7,16 @@ import (
 
 // bank message types
 const (
-	TypeMsgSend      = "send"
-	TypeMsgMultiSend = "multisend"
+	TypeMsgSend           = "send"
+	TypeMsgMultiSend      = "multisend"
+	TypeMsgMultiSendV2    = "multisend_v2"
+	TypeMsgSetSendEnabled = "set_send_enabled"
 )
 
+// MaxOutputReferenceLength is the maximum length of the reference of an output
+// of a MsgMultiSendV2.
+const MaxOutputReferenceLength = 256
+
 var _ sdk.Msg = &MsgSend{}
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -101,6 +107,85 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+var _ sdk.Msg = &MsgMultiSendV2{}
+
+// NewMsgMultiSendV2 - construct a msg to send coins from one account to many
+// accounts.
+//nolint:interfacer
+func NewMsgMultiSendV2(fromAddr sdk.AccAddress, outputs []LabeledOutput) *MsgMultiSendV2 {
+	return &MsgMultiSendV2{FromAddress: fromAddr.String(), Outputs: outputs}
+}
+
+// Route Implements Msg
+func (msg MsgMultiSendV2) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgMultiSendV2) Type() string { return TypeMsgMultiSendV2 }
+
+// ValidateBasic Implements Msg.
+func (msg MsgMultiSendV2) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if len(msg.Outputs) == 0 {
+		return ErrNoOutputs
+	}
+
+	for _, out := range msg.Outputs {
+		if err := out.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgMultiSendV2) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgMultiSendV2) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
+// TotalCoins returns the sum of the coins of the outputs, which is sent by the
+// sender.
+func (msg MsgMultiSendV2) TotalCoins() sdk.Coins {
+	var total sdk.Coins
+	for _, out := range msg.Outputs {
+		total = total.Add(out.Coins...)
+	}
+
+	return total
+}
+
+// NewLabeledOutput - create an output of a MsgMultiSendV2
+//nolint:interfacer
+func NewLabeledOutput(addr sdk.AccAddress, coins sdk.Coins, reference string) LabeledOutput {
+	return LabeledOutput{
+		Address:   addr.String(),
+		Coins:     coins,
+		Reference: reference,
+	}
+}
+
+// ValidateBasic - validate an output of a MsgMultiSendV2
+func (out LabeledOutput) ValidateBasic() error {
+	if err := (Output{Address: out.Address, Coins: out.Coins}).ValidateBasic(); err != nil {
+		return err
+	}
+
+	if len(out.Reference) > MaxOutputReferenceLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("output reference length %d exceeds %d", len(out.Reference), MaxOutputReferenceLength)
+	}
+
+	return nil
+}
+
 // ValidateBasic - validate transaction input
 func (in Input) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(in.Address); err != nil {
@@ -181,3 +266,76 @@ func ValidateInputsOutputs(inputs []Input, outputs []Output) error {
 
 	return nil
 }
+
+var _ sdk.Msg = &MsgSetSendEnabled{}
+
+// NewMsgSetSendEnabled - construct a msg to set or remove send_enabled
+// overrides.
+func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefaultFor []string) *MsgSetSendEnabled {
+	return &MsgSetSendEnabled{
+		Authority:     authority,
+		SendEnabled:   sendEnabled,
+		UseDefaultFor: useDefaultFor,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSetSendEnabled) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetSendEnabled) Type() string { return TypeMsgSetSendEnabled }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetSendEnabled) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return ValidateSendEnabledUpdate(msg.SendEnabled, msg.UseDefaultFor)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetSendEnabled) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetSendEnabled) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateSendEnabledUpdate checks that the send_enabled overrides to set and
+// the denoms whose override is removed are valid, and that each denom appears
+// only once.
+func ValidateSendEnabledUpdate(sendEnabled []*SendEnabled, useDefaultFor []string) error {
+	if len(sendEnabled) == 0 && len(useDefaultFor) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("no send_enabled overrides to set or remove")
+	}
+
+	seen := make(map[string]bool)
+	for _, se := range sendEnabled {
+		if se == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("nil send_enabled override")
+		}
+		if seen[se.Denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom %s", se.Denom)
+		}
+		if err := sdk.ValidateDenom(se.Denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		seen[se.Denom] = true
+	}
+
+	for _, denom := range useDefaultFor {
+		if seen[denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom %s", denom)
+		}
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
@@ -252,3 +252,61 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, 1, len(res))
 	require.True(t, from.Equals(res[0]))
 }
+
+func TestMsgSetSendEnabledValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority")).String()
+
+	cases := []struct {
+		msg   *MsgSetSendEnabled
+		valid bool
+	}{
+		{NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foo", false)}, []string{"bar"}), true},
+		{NewMsgSetSendEnabled("", []*SendEnabled{NewSendEnabled("foo", false)}, nil), false},
+		{NewMsgSetSendEnabled(authority, nil, nil), false},
+		{NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("", false)}, nil), false},
+		{NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foo", false), NewSendEnabled("foo", true)}, nil), false},
+		{NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foo", false)}, []string{"foo"}), false},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, "%d", i)
+		} else {
+			require.Error(t, err, "%d", i)
+		}
+	}
+	require.Equal(t, []sdk.AccAddress{sdk.AccAddress([]byte("authority"))}, cases[0].msg.GetSigners())
+}
+
+func TestMsgMultiSendV2Validation(t *testing.T) {
+	from := sdk.AccAddress([]byte("from"))
+	to1 := sdk.AccAddress([]byte("to1"))
+	to2 := sdk.AccAddress([]byte("to2"))
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	longRef := string(make([]byte, MaxOutputReferenceLength+1))
+
+	cases := []struct {
+		msg   *MsgMultiSendV2
+		valid bool
+	}{
+		{NewMsgMultiSendV2(from, []LabeledOutput{NewLabeledOutput(to1, atom, "ref-1"), NewLabeledOutput(to2, atom, "")}), true},
+		{NewMsgMultiSendV2(from, []LabeledOutput{NewLabeledOutput(to1, atom, "ref-1"), NewLabeledOutput(to1, atom, "ref-2")}), true},
+		{&MsgMultiSendV2{FromAddress: "", Outputs: []LabeledOutput{NewLabeledOutput(to1, atom, "")}}, false},
+		{NewMsgMultiSendV2(from, nil), false},
+		{NewMsgMultiSendV2(from, []LabeledOutput{{Address: "", Coins: atom}}), false},
+		{NewMsgMultiSendV2(from, []LabeledOutput{NewLabeledOutput(to1, sdk.Coins{}, "")}), false},
+		{NewMsgMultiSendV2(from, []LabeledOutput{NewLabeledOutput(to1, atom, longRef)}), false},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, "%d", i)
+		} else {
+			require.Error(t, err, "%d", i)
+		}
+	}
+	require.Equal(t, []sdk.AccAddress{from}, cases[0].msg.GetSigners())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 20)), cases[0].msg.TotalCoins())
+}
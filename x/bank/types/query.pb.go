@@ -850,6 +850,224 @@ func (m *QueryDenomOwnersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySendEnabledRequest defines the RPC request for looking up SendEnabled entries.
+type QuerySendEnabledRequest struct {
+	// denoms is the list of denoms to query the overrides of. If empty, all the
+	// overrides are returned.
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// pagination defines an optional pagination for the request. It is only used
+	// when denoms is empty.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySendEnabledRequest) Reset()         { *m = QuerySendEnabledRequest{} }
+func (m *QuerySendEnabledRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySendEnabledRequest) ProtoMessage()    {}
+func (*QuerySendEnabledRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{17}
+}
+func (m *QuerySendEnabledRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySendEnabledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySendEnabledRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySendEnabledRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySendEnabledRequest.Merge(m, src)
+}
+func (m *QuerySendEnabledRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySendEnabledRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySendEnabledRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySendEnabledRequest proto.InternalMessageInfo
+
+func (m *QuerySendEnabledRequest) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *QuerySendEnabledRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySendEnabledResponse defines the RPC response of a SendEnabled query.
+type QuerySendEnabledResponse struct {
+	SendEnabled []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// pagination defines the pagination in the response. It is only set when
+	// the request has no denoms.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySendEnabledResponse) Reset()         { *m = QuerySendEnabledResponse{} }
+func (m *QuerySendEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySendEnabledResponse) ProtoMessage()    {}
+func (*QuerySendEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{18}
+}
+func (m *QuerySendEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySendEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySendEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySendEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySendEnabledResponse.Merge(m, src)
+}
+func (m *QuerySendEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySendEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySendEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySendEnabledResponse proto.InternalMessageInfo
+
+func (m *QuerySendEnabledResponse) GetSendEnabled() []*SendEnabled {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return nil
+}
+
+func (m *QuerySendEnabledResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryHoldsRequest defines the request type for querying the holds of an
+// account.
+type QueryHoldsRequest struct {
+	// address is the address of the account to query the holds of.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryHoldsRequest) Reset()         { *m = QueryHoldsRequest{} }
+func (m *QueryHoldsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHoldsRequest) ProtoMessage()    {}
+func (*QueryHoldsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{19}
+}
+func (m *QueryHoldsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHoldsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHoldsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHoldsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHoldsRequest.Merge(m, src)
+}
+func (m *QueryHoldsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHoldsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHoldsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHoldsRequest proto.InternalMessageInfo
+
+// QueryHoldsResponse defines the response type for querying the holds of an
+// account.
+type QueryHoldsResponse struct {
+	// holds are the holds placed on the balance of the account, by holder.
+	Holds []Hold `protobuf:"bytes,1,rep,name=holds,proto3" json:"holds"`
+	// held is the sum of the held coins of the account.
+	Held github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=held,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"held"`
+	// spendable is the balance of the account which is neither held nor locked
+	// by vesting.
+	Spendable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spendable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spendable"`
+}
+
+func (m *QueryHoldsResponse) Reset()         { *m = QueryHoldsResponse{} }
+func (m *QueryHoldsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHoldsResponse) ProtoMessage()    {}
+func (*QueryHoldsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{20}
+}
+func (m *QueryHoldsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHoldsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHoldsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHoldsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHoldsResponse.Merge(m, src)
+}
+func (m *QueryHoldsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHoldsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHoldsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHoldsResponse proto.InternalMessageInfo
+
+func (m *QueryHoldsResponse) GetHolds() []Hold {
+	if m != nil {
+		return m.Holds
+	}
+	return nil
+}
+
+func (m *QueryHoldsResponse) GetHeld() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Held
+	}
+	return nil
+}
+
+func (m *QueryHoldsResponse) GetSpendable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spendable
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
@@ -868,72 +1086,87 @@ func init() {
 	proto.RegisterType((*QueryDenomOwnersRequest)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersRequest")
 	proto.RegisterType((*DenomOwner)(nil), "cosmos.bank.v1beta1.DenomOwner")
 	proto.RegisterType((*QueryDenomOwnersResponse)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersResponse")
+	proto.RegisterType((*QuerySendEnabledRequest)(nil), "cosmos.bank.v1beta1.QuerySendEnabledRequest")
+	proto.RegisterType((*QuerySendEnabledResponse)(nil), "cosmos.bank.v1beta1.QuerySendEnabledResponse")
+	proto.RegisterType((*QueryHoldsRequest)(nil), "cosmos.bank.v1beta1.QueryHoldsRequest")
+	proto.RegisterType((*QueryHoldsResponse)(nil), "cosmos.bank.v1beta1.QueryHoldsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 949 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x04, 0xea, 0x24, 0xcf, 0xc0, 0x61, 0x6a, 0x44, 0xb2, 0xa1, 0x36, 0xda, 0x42, 0x93,
-	0xb4, 0xc9, 0x6e, 0xed, 0x22, 0x55, 0xe5, 0x82, 0xe2, 0x22, 0x38, 0x20, 0x54, 0xb3, 0xe5, 0x84,
-	0x84, 0xa2, 0xb1, 0xbd, 0x2c, 0xab, 0xd8, 0x3b, 0x5b, 0xcf, 0x9a, 0x12, 0x45, 0x95, 0x10, 0x27,
-	0x4e, 0x80, 0xc4, 0x81, 0x03, 0x42, 0x94, 0x0b, 0x08, 0xce, 0xfc, 0x11, 0x39, 0x70, 0xa8, 0xca,
-	0x85, 0x13, 0xa0, 0x84, 0x03, 0x7f, 0x06, 0xf2, 0xcc, 0x9b, 0xf5, 0xae, 0xbd, 0x5e, 0xaf, 0xc0,
-	0x9c, 0xbc, 0x3b, 0xf3, 0x7e, 0x7c, 0xdf, 0x37, 0xf3, 0xde, 0x5b, 0x43, 0xbd, 0xcb, 0xc5, 0x80,
-	0x0b, 0xbb, 0xc3, 0x82, 0x23, 0xfb, 0xc3, 0x46, 0xc7, 0x8d, 0x58, 0xc3, 0xbe, 0x37, 0x72, 0x87,
-	0xc7, 0x56, 0x38, 0xe4, 0x11, 0xa7, 0x17, 0x95, 0x81, 0x35, 0x36, 0xb0, 0xd0, 0xc0, 0xb8, 0x1a,
-	0x7b, 0x09, 0x57, 0x59, 0xc7, 0xbe, 0x21, 0xf3, 0xfc, 0x80, 0x45, 0x3e, 0x0f, 0x54, 0x00, 0xa3,
-	0xea, 0x71, 0x8f, 0xcb, 0x47, 0x7b, 0xfc, 0x84, 0xab, 0xcf, 0x7b, 0x9c, 0x7b, 0x7d, 0xd7, 0x66,
-	0xa1, 0x6f, 0xb3, 0x20, 0xe0, 0x91, 0x74, 0x11, 0xb8, 0x5b, 0x4b, 0xc6, 0xd7, 0x91, 0xbb, 0xdc,
-	0x0f, 0x66, 0xf6, 0x13, 0xa8, 0x25, 0x42, 0xb5, 0xbf, 0xa9, 0xf6, 0x0f, 0x55, 0x5a, 0x64, 0x20,
-	0x5f, 0x4c, 0x1f, 0x2e, 0xbe, 0x3d, 0x06, 0xdc, 0x62, 0x7d, 0x16, 0x74, 0x5d, 0xc7, 0xbd, 0x37,
-	0x72, 0x45, 0x44, 0x9b, 0xb0, 0xca, 0x7a, 0xbd, 0xa1, 0x2b, 0xc4, 0x06, 0x79, 0x81, 0xec, 0xac,
-	0xb7, 0x36, 0x1e, 0xff, 0xbc, 0x5f, 0x45, 0xcf, 0x03, 0xb5, 0x73, 0x37, 0x1a, 0xfa, 0x81, 0xe7,
-	0x68, 0x43, 0x5a, 0x85, 0x0b, 0x3d, 0x37, 0xe0, 0x83, 0x8d, 0x95, 0xb1, 0x87, 0xa3, 0x5e, 0x5e,
-	0x59, 0xfb, 0xf4, 0x61, 0xbd, 0xf4, 0xf7, 0xc3, 0x7a, 0xc9, 0x7c, 0x13, 0xaa, 0xe9, 0x54, 0x22,
-	0xe4, 0x81, 0x70, 0xe9, 0x0d, 0x58, 0xed, 0xa8, 0x25, 0x99, 0xab, 0xd2, 0xdc, 0xb4, 0x62, 0x91,
-	0x85, 0xab, 0x45, 0xb6, 0x6e, 0x73, 0x3f, 0x70, 0xb4, 0xa5, 0xf9, 0x2d, 0x81, 0xe7, 0x64, 0xb4,
-	0x83, 0x7e, 0x1f, 0x03, 0x8a, 0xff, 0x02, 0xfe, 0x75, 0x80, 0xc9, 0x51, 0x49, 0x06, 0x95, 0xe6,
-	0x95, 0x14, 0x0e, 0x75, 0x0b, 0x34, 0x9a, 0x36, 0xf3, 0xb4, 0x58, 0x4e, 0xc2, 0x33, 0x41, 0xf7,
-	0x17, 0x02, 0x1b, 0xb3, 0x08, 0x91, 0xb3, 0x07, 0x6b, 0xc8, 0x64, 0x8c, 0xf1, 0x89, 0x5c, 0xd2,
-	0xad, 0xeb, 0xa7, 0xbf, 0xd7, 0x4b, 0x3f, 0xfd, 0x51, 0xdf, 0xf1, 0xfc, 0xe8, 0x83, 0x51, 0xc7,
-	0xea, 0xf2, 0x01, 0x1e, 0x22, 0xfe, 0xec, 0x8b, 0xde, 0x91, 0x1d, 0x1d, 0x87, 0xae, 0x90, 0x0e,
-	0xc2, 0x89, 0x83, 0xd3, 0x37, 0x32, 0x78, 0x6d, 0x2f, 0xe4, 0xa5, 0x50, 0x26, 0x89, 0x99, 0x47,
-	0xa8, 0xf7, 0x3b, 0x3c, 0x62, 0xfd, 0xbb, 0xa3, 0x30, 0xec, 0x1f, 0x6b, 0xbd, 0xd3, 0xda, 0x91,
-	0x25, 0x68, 0x77, 0xaa, 0xb5, 0x4b, 0x65, 0x43, 0xed, 0xba, 0x50, 0x16, 0x72, 0xe5, 0xff, 0x50,
-	0x0e, 0x43, 0x2f, 0x4f, 0xb7, 0x3d, 0xbc, 0xf5, 0x8a, 0xc4, 0x9d, 0xf7, 0xb5, 0x68, 0x71, 0xb5,
-	0x90, 0x44, 0xb5, 0x98, 0x6d, 0x78, 0x76, 0xca, 0x1a, 0x49, 0xdf, 0x84, 0x32, 0x1b, 0xf0, 0x51,
-	0x10, 0x2d, 0xac, 0x91, 0xd6, 0x93, 0x63, 0xd2, 0x0e, 0x9a, 0x9b, 0x55, 0xa0, 0x32, 0x62, 0x9b,
-	0x0d, 0xd9, 0x40, 0x97, 0x88, 0xd9, 0xc6, 0xb2, 0xd7, 0xab, 0x98, 0xe5, 0x16, 0x94, 0x43, 0xb9,
-	0x82, 0x59, 0xb6, 0xac, 0x8c, 0x76, 0x67, 0x29, 0x27, 0x9d, 0x47, 0x39, 0x98, 0x3d, 0x30, 0x64,
-	0xc4, 0xd7, 0xc6, 0x3c, 0xc4, 0x5b, 0x6e, 0xc4, 0x7a, 0x2c, 0x62, 0x4b, 0xbe, 0x22, 0xe6, 0x8f,
-	0x04, 0xb6, 0x32, 0xd3, 0x20, 0x81, 0x03, 0x58, 0x1f, 0xe0, 0x9a, 0x2e, 0xac, 0x4b, 0x99, 0x1c,
-	0xb4, 0x27, 0xb2, 0x98, 0x78, 0x2d, 0xef, 0xe4, 0x1b, 0xb0, 0x39, 0x81, 0x3a, 0x2d, 0x48, 0xf6,
-	0xf1, 0xbf, 0x97, 0x14, 0x71, 0x86, 0xdc, 0xab, 0xb0, 0xa6, 0x61, 0xa2, 0x84, 0x85, 0xb8, 0xc5,
-	0x4e, 0xe6, 0x7d, 0xac, 0x61, 0x19, 0xfe, 0xce, 0xfd, 0xc0, 0x1d, 0x8a, 0x5c, 0x3c, 0xcb, 0xea,
-	0x8a, 0xe6, 0x09, 0xc0, 0x24, 0xe7, 0xbf, 0xea, 0xcf, 0xb7, 0x26, 0x43, 0x62, 0xa5, 0x58, 0x01,
-	0xc4, 0xa3, 0xe2, 0x07, 0xdd, 0x4c, 0x52, 0xb4, 0x51, 0xd3, 0x16, 0x3c, 0x25, 0xa9, 0x1e, 0x72,
-	0xb9, 0x8e, 0x77, 0xa6, 0x9e, 0xa9, 0xeb, 0xc4, 0xdf, 0xa9, 0xf4, 0x26, 0xb1, 0x96, 0x76, 0x63,
-	0x9a, 0x8f, 0xd7, 0xe1, 0x82, 0x44, 0x4a, 0xbf, 0x22, 0xb0, 0x8a, 0x43, 0x83, 0xee, 0x64, 0x82,
-	0xc9, 0x98, 0xda, 0xc6, 0x6e, 0x01, 0x4b, 0x95, 0xd6, 0xbc, 0xf9, 0xc9, 0xaf, 0x7f, 0x7d, 0xb9,
-	0xd2, 0xa0, 0xb6, 0x9d, 0xfd, 0xed, 0xa0, 0xc6, 0x87, 0x7d, 0x82, 0xfa, 0x3f, 0xb0, 0x4f, 0x24,
-	0xe3, 0x07, 0xf4, 0x6b, 0x02, 0x95, 0xc4, 0x44, 0xa3, 0x7b, 0xf3, 0x73, 0xce, 0x8e, 0x66, 0x63,
-	0xbf, 0xa0, 0x35, 0xa2, 0xb4, 0x25, 0xca, 0x5d, 0xba, 0x5d, 0x10, 0x25, 0xfd, 0x9c, 0x40, 0x25,
-	0x31, 0x33, 0xf2, 0xd0, 0xcd, 0x0e, 0xb2, 0x3c, 0x74, 0x19, 0x83, 0xc8, 0xbc, 0x2c, 0xd1, 0x5d,
-	0xa2, 0x5b, 0x99, 0xe8, 0x70, 0x90, 0x7c, 0x46, 0x60, 0x4d, 0x77, 0x73, 0x9a, 0x73, 0x40, 0x53,
-	0xf3, 0xc1, 0xb8, 0x5a, 0xc4, 0x14, 0x81, 0x5c, 0x93, 0x40, 0x5e, 0xa2, 0x97, 0x73, 0x80, 0xc4,
-	0x07, 0xf8, 0x31, 0x81, 0xb2, 0xea, 0xe0, 0x74, 0x7b, 0x7e, 0x8e, 0xd4, 0xb8, 0x30, 0x76, 0x16,
-	0x1b, 0x16, 0xd2, 0x44, 0xcd, 0x0a, 0xfa, 0x3d, 0x81, 0xa7, 0x53, 0x2d, 0x8e, 0x5a, 0xf3, 0x13,
-	0x64, 0xb5, 0x4f, 0xc3, 0x2e, 0x6c, 0x8f, 0xb8, 0x5e, 0x96, 0xb8, 0x2c, 0xba, 0x97, 0x89, 0x4b,
-	0x4a, 0x23, 0x0e, 0x75, 0xa3, 0x8c, 0xb5, 0xfa, 0x8e, 0xc0, 0x33, 0xe9, 0x49, 0x43, 0x17, 0x65,
-	0x9e, 0x1e, 0x7d, 0xc6, 0xf5, 0xe2, 0x0e, 0x88, 0x75, 0x4f, 0x62, 0xbd, 0x42, 0x5f, 0x2c, 0x82,
-	0x95, 0x7e, 0x43, 0xa0, 0x92, 0xe8, 0x6c, 0x79, 0x57, 0x7e, 0xb6, 0xef, 0xe7, 0x5d, 0xf9, 0x8c,
-	0x76, 0x69, 0x36, 0x24, 0xb4, 0x6b, 0x74, 0x77, 0x3e, 0x34, 0xec, 0xa4, 0x5a, 0xc3, 0xd6, 0xed,
-	0xd3, 0xb3, 0x1a, 0x79, 0x74, 0x56, 0x23, 0x7f, 0x9e, 0xd5, 0xc8, 0x17, 0xe7, 0xb5, 0xd2, 0xa3,
-	0xf3, 0x5a, 0xe9, 0xb7, 0xf3, 0x5a, 0xe9, 0xdd, 0xdd, 0xdc, 0xaf, 0xb2, 0x8f, 0x54, 0x6c, 0xf9,
-	0x71, 0xd6, 0x29, 0xcb, 0x7f, 0x2b, 0x37, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x11, 0x1b, 0xa0,
-	0xde, 0xa0, 0x0d, 0x00, 0x00,
+	// 1130 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xb8, 0x8d, 0x93, 0x3c, 0x17, 0x24, 0x26, 0x86, 0x3a, 0x1b, 0x6a, 0x97, 0x0d, 0x24,
+	0x71, 0x1b, 0xef, 0xd6, 0x29, 0xa8, 0x2a, 0x17, 0x14, 0x07, 0x28, 0x12, 0x42, 0x4d, 0x1d, 0x4e,
+	0x48, 0xc8, 0x5a, 0x7b, 0x07, 0xc7, 0x8a, 0xbd, 0xeb, 0x7a, 0xd6, 0x94, 0x28, 0xaa, 0x84, 0x38,
+	0x20, 0x4e, 0x80, 0x84, 0x50, 0x0f, 0x08, 0x51, 0x2e, 0x7c, 0x9d, 0xf9, 0x23, 0x72, 0xe0, 0x50,
+	0xc1, 0x85, 0x13, 0xa0, 0x84, 0x03, 0x77, 0xfe, 0x01, 0xb4, 0x33, 0x6f, 0xbf, 0xec, 0xb5, 0xbd,
+	0x4a, 0xcd, 0x29, 0xde, 0x99, 0xf7, 0xf1, 0xfb, 0xfd, 0xde, 0xcc, 0xbc, 0xa7, 0x40, 0xb1, 0x69,
+	0xf3, 0xae, 0xcd, 0xf5, 0x86, 0x61, 0x1d, 0xe8, 0xef, 0x57, 0x1a, 0xcc, 0x31, 0x2a, 0xfa, 0xdd,
+	0x01, 0xeb, 0x1f, 0x6a, 0xbd, 0xbe, 0xed, 0xd8, 0x74, 0x49, 0x1a, 0x68, 0xae, 0x81, 0x86, 0x06,
+	0xca, 0x15, 0xdf, 0x8b, 0x33, 0x69, 0xed, 0xfb, 0xf6, 0x8c, 0x56, 0xdb, 0x32, 0x9c, 0xb6, 0x6d,
+	0xc9, 0x00, 0x4a, 0xae, 0x65, 0xb7, 0x6c, 0xf1, 0x53, 0x77, 0x7f, 0xe1, 0xea, 0xb3, 0x2d, 0xdb,
+	0x6e, 0x75, 0x98, 0x6e, 0xf4, 0xda, 0xba, 0x61, 0x59, 0xb6, 0x23, 0x5c, 0x38, 0xee, 0x16, 0xc2,
+	0xf1, 0xbd, 0xc8, 0x4d, 0xbb, 0x6d, 0x8d, 0xec, 0x87, 0x50, 0xbb, 0x1f, 0xb8, 0xbf, 0x2c, 0xf7,
+	0xeb, 0x32, 0xad, 0xfc, 0x90, 0x5b, 0x6a, 0x1b, 0x96, 0xee, 0xb8, 0x80, 0xab, 0x46, 0xc7, 0xb0,
+	0x9a, 0xac, 0xc6, 0xee, 0x0e, 0x18, 0x77, 0xe8, 0x16, 0xcc, 0x1b, 0xa6, 0xd9, 0x67, 0x9c, 0xe7,
+	0xc9, 0x65, 0xb2, 0xb1, 0x58, 0xcd, 0xff, 0xfa, 0x73, 0x39, 0x87, 0x9e, 0xdb, 0x72, 0x67, 0xcf,
+	0xe9, 0xb7, 0xad, 0x56, 0xcd, 0x33, 0xa4, 0x39, 0x98, 0x33, 0x99, 0x65, 0x77, 0xf3, 0x69, 0xd7,
+	0xa3, 0x26, 0x3f, 0x5e, 0x5e, 0xf8, 0xe4, 0x61, 0x31, 0xf5, 0xcf, 0xc3, 0x62, 0x4a, 0x7d, 0x13,
+	0x72, 0xd1, 0x54, 0xbc, 0x67, 0x5b, 0x9c, 0xd1, 0xeb, 0x30, 0xdf, 0x90, 0x4b, 0x22, 0x57, 0x76,
+	0x6b, 0x59, 0xf3, 0x45, 0xe6, 0xcc, 0x13, 0x59, 0xdb, 0xb1, 0xdb, 0x56, 0xcd, 0xb3, 0x54, 0xbf,
+	0x21, 0x70, 0x51, 0x44, 0xdb, 0xee, 0x74, 0x30, 0x20, 0x7f, 0x1c, 0xf0, 0xaf, 0x03, 0x04, 0xa5,
+	0x12, 0x0c, 0xb2, 0x5b, 0x6b, 0x11, 0x1c, 0xf2, 0x14, 0x78, 0x68, 0x76, 0x8d, 0x96, 0x27, 0x56,
+	0x2d, 0xe4, 0x19, 0xa2, 0xfb, 0x0b, 0x81, 0xfc, 0x28, 0x42, 0xe4, 0xdc, 0x82, 0x05, 0x64, 0xe2,
+	0x62, 0x3c, 0x37, 0x91, 0x74, 0xf5, 0xda, 0xf1, 0x1f, 0xc5, 0xd4, 0x4f, 0x7f, 0x16, 0x37, 0x5a,
+	0x6d, 0x67, 0x7f, 0xd0, 0xd0, 0x9a, 0x76, 0x17, 0x8b, 0x88, 0x7f, 0xca, 0xdc, 0x3c, 0xd0, 0x9d,
+	0xc3, 0x1e, 0xe3, 0xc2, 0x81, 0xd7, 0xfc, 0xe0, 0xf4, 0x56, 0x0c, 0xaf, 0xf5, 0xa9, 0xbc, 0x24,
+	0xca, 0x30, 0x31, 0xf5, 0x00, 0xf5, 0x7e, 0xdb, 0x76, 0x8c, 0xce, 0xde, 0xa0, 0xd7, 0xeb, 0x1c,
+	0x7a, 0x7a, 0x47, 0xb5, 0x23, 0x33, 0xd0, 0xee, 0xd8, 0xd3, 0x2e, 0x92, 0x0d, 0xb5, 0x6b, 0x42,
+	0x86, 0x8b, 0x95, 0xff, 0x43, 0x39, 0x0c, 0x3d, 0x3b, 0xdd, 0x36, 0xf1, 0xd4, 0x4b, 0x12, 0xb7,
+	0xdf, 0xf3, 0x44, 0xf3, 0x6f, 0x0b, 0x09, 0xdd, 0x16, 0x75, 0x17, 0x9e, 0x1e, 0xb2, 0x46, 0xd2,
+	0x37, 0x20, 0x63, 0x74, 0xed, 0x81, 0xe5, 0x4c, 0xbd, 0x23, 0xd5, 0xf3, 0x2e, 0xe9, 0x1a, 0x9a,
+	0xab, 0x39, 0xa0, 0x22, 0xe2, 0xae, 0xd1, 0x37, 0xba, 0xde, 0x15, 0x51, 0x77, 0x61, 0x29, 0xb2,
+	0x8a, 0x59, 0x6e, 0x42, 0xa6, 0x27, 0x56, 0x30, 0xcb, 0x8a, 0x16, 0xf3, 0xdc, 0x69, 0xd2, 0xc9,
+	0xcb, 0x23, 0x1d, 0x54, 0x13, 0x14, 0x11, 0xf1, 0x55, 0x97, 0x07, 0x7f, 0x8b, 0x39, 0x86, 0x69,
+	0x38, 0xc6, 0x8c, 0x8f, 0x88, 0xfa, 0x23, 0x81, 0x95, 0xd8, 0x34, 0x48, 0x60, 0x1b, 0x16, 0xbb,
+	0xb8, 0xe6, 0x5d, 0xac, 0x4b, 0xb1, 0x1c, 0x3c, 0x4f, 0x64, 0x11, 0x78, 0xcd, 0xae, 0xf2, 0x15,
+	0x58, 0x0e, 0xa0, 0x0e, 0x0b, 0x12, 0x5f, 0xfe, 0x77, 0x41, 0x89, 0x73, 0x41, 0x72, 0xaf, 0xc0,
+	0x82, 0x07, 0x13, 0x25, 0x4c, 0xc4, 0xcd, 0x77, 0x52, 0xef, 0xc1, 0xc5, 0x20, 0xfc, 0xed, 0x7b,
+	0x16, 0xeb, 0xf3, 0x89, 0x78, 0x66, 0xf5, 0x2a, 0xaa, 0x47, 0x00, 0x41, 0xce, 0x33, 0xbd, 0xcf,
+	0x37, 0x83, 0x26, 0x91, 0x4e, 0x76, 0x01, 0xfc, 0x56, 0xf1, 0xbd, 0xf7, 0x98, 0x44, 0x68, 0xa3,
+	0xa6, 0x55, 0xb8, 0x20, 0xa8, 0xd6, 0x6d, 0xb1, 0x8e, 0x67, 0xa6, 0x18, 0xab, 0x6b, 0xe0, 0x5f,
+	0xcb, 0x9a, 0x41, 0xac, 0xd9, 0x9d, 0x98, 0x43, 0xac, 0xcf, 0x1e, 0xb3, 0xcc, 0xd7, 0x2c, 0xa3,
+	0xd1, 0x61, 0xa6, 0x57, 0x9f, 0x67, 0x20, 0x23, 0x52, 0x4a, 0x84, 0x8b, 0x35, 0xfc, 0x1a, 0xaa,
+	0x50, 0xf3, 0xcc, 0x15, 0xfa, 0xc1, 0x13, 0x29, 0x92, 0x1b, 0x45, 0xda, 0x81, 0x0b, 0x9c, 0x59,
+	0x66, 0x9d, 0xc9, 0x75, 0x14, 0xe9, 0x72, 0xac, 0x48, 0x61, 0xff, 0x2c, 0x0f, 0x3e, 0xe8, 0xad,
+	0x18, 0xa4, 0x67, 0x52, 0xe9, 0x0e, 0x3c, 0x25, 0x90, 0xbe, 0x61, 0x77, 0xcc, 0xc7, 0xe9, 0xf9,
+	0xa1, 0x7e, 0xf3, 0x20, 0x0d, 0x34, 0x1c, 0x13, 0x79, 0xbf, 0x04, 0x73, 0xfb, 0xee, 0xc2, 0x68,
+	0xa3, 0x09, 0x11, 0x76, 0x5d, 0xf0, 0xc8, 0x49, 0x6b, 0x5a, 0x87, 0xf3, 0xfb, 0xac, 0x63, 0xe6,
+	0xd3, 0xb3, 0x6f, 0x4f, 0x22, 0x30, 0x6d, 0xc3, 0x22, 0xef, 0x31, 0xcb, 0x74, 0x85, 0xcd, 0x9f,
+	0x9b, 0x7d, 0x96, 0x20, 0xfa, 0xd6, 0xbf, 0x59, 0x98, 0x13, 0xca, 0xd0, 0x07, 0x04, 0xe6, 0x71,
+	0x8e, 0xa1, 0x1b, 0xb1, 0x4a, 0xc4, 0x0c, 0x92, 0x4a, 0x29, 0x81, 0xa5, 0x54, 0x5b, 0xbd, 0xf1,
+	0xd1, 0x6f, 0x7f, 0x7f, 0x91, 0xae, 0x50, 0x5d, 0x8f, 0x1f, 0x67, 0x85, 0x35, 0xd7, 0x8f, 0xb0,
+	0x7c, 0xf7, 0xf5, 0x23, 0x71, 0x07, 0xee, 0xd3, 0xaf, 0x08, 0x64, 0x43, 0x43, 0x16, 0xdd, 0x1c,
+	0x9f, 0x73, 0x74, 0x5a, 0x54, 0xca, 0x09, 0xad, 0x11, 0xa5, 0x2e, 0x50, 0x96, 0xe8, 0x7a, 0x42,
+	0x94, 0xf4, 0x33, 0x02, 0xd9, 0xd0, 0x18, 0x33, 0x09, 0xdd, 0xe8, 0x6c, 0xa5, 0x94, 0x13, 0x5a,
+	0x23, 0xba, 0x55, 0x81, 0xee, 0x12, 0x5d, 0x89, 0x45, 0x87, 0xb3, 0xcd, 0xa7, 0x04, 0x16, 0xbc,
+	0x01, 0x83, 0x4e, 0x28, 0xd0, 0xd0, 0xc8, 0xa2, 0x5c, 0x49, 0x62, 0x8a, 0x40, 0xae, 0x0a, 0x20,
+	0x2f, 0xd0, 0xd5, 0x09, 0x40, 0xfc, 0x02, 0x7e, 0x48, 0x20, 0x23, 0x87, 0x0a, 0xba, 0x3e, 0x3e,
+	0x47, 0x64, 0x82, 0x51, 0x36, 0xa6, 0x1b, 0x26, 0xd2, 0x44, 0x8e, 0x2f, 0xf4, 0x3b, 0x02, 0x4f,
+	0x44, 0xba, 0x2e, 0xd5, 0xc6, 0x27, 0x88, 0xeb, 0xe8, 0x8a, 0x9e, 0xd8, 0x1e, 0x71, 0xbd, 0x28,
+	0x70, 0x69, 0x74, 0x33, 0x16, 0x97, 0x7c, 0xdf, 0xeb, 0x5e, 0xef, 0xf6, 0xb5, 0xfa, 0x96, 0xc0,
+	0x93, 0xd1, 0xe1, 0x87, 0x4e, 0xcb, 0x3c, 0x3c, 0x8d, 0x29, 0xd7, 0x92, 0x3b, 0x20, 0xd6, 0x4d,
+	0x81, 0x75, 0x8d, 0x3e, 0x9f, 0x04, 0x2b, 0xfd, 0x9a, 0x40, 0x36, 0xd4, 0x6c, 0x27, 0x1d, 0xf9,
+	0xd1, 0x51, 0x44, 0x29, 0x27, 0xb4, 0x46, 0x68, 0x15, 0x01, 0xed, 0x2a, 0x2d, 0x8d, 0x87, 0x86,
+	0xcd, 0xdd, 0xd7, 0xf0, 0x4b, 0x02, 0xd9, 0x50, 0x9f, 0x9a, 0x84, 0x6f, 0xb4, 0x15, 0x2b, 0xe5,
+	0x84, 0xd6, 0x88, 0xaf, 0x24, 0xf0, 0xad, 0xd2, 0xe7, 0xe2, 0x6f, 0x42, 0xa8, 0xaf, 0xd2, 0x8f,
+	0x09, 0xcc, 0x89, 0x0e, 0x44, 0xd7, 0xc6, 0xe7, 0x08, 0xb7, 0x3d, 0x65, 0x7d, 0xaa, 0x5d, 0xa2,
+	0x02, 0x8a, 0xbe, 0x15, 0xbc, 0x59, 0xd5, 0x9d, 0xe3, 0x93, 0x02, 0x79, 0x74, 0x52, 0x20, 0x7f,
+	0x9d, 0x14, 0xc8, 0xe7, 0xa7, 0x85, 0xd4, 0xa3, 0xd3, 0x42, 0xea, 0xf7, 0xd3, 0x42, 0xea, 0x9d,
+	0xd2, 0xc4, 0x26, 0xf2, 0x81, 0x0c, 0x2b, 0x7a, 0x49, 0x23, 0x23, 0xfe, 0xc3, 0x70, 0xfd, 0xbf,
+	0x01, 0x00, 0x7a, 0x16, 0x30, 0x13, 0x54, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -966,6 +1199,12 @@ type QueryClient interface {
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	DenomOwners(ctx context.Context, in *QueryDenomOwnersRequest, opts ...grpc.CallOption) (*QueryDenomOwnersResponse, error)
+	// SendEnabled queries the send_enabled overrides of denoms. If no denom is
+	// given, all the overrides are returned.
+	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// Holds queries the holds placed on the balance of an account, along with
+	// its held and spendable coins.
+	Holds(ctx context.Context, in *QueryHoldsRequest, opts ...grpc.CallOption) (*QueryHoldsResponse, error)
 }
 
 type queryClient struct {
@@ -1048,6 +1287,24 @@ func (c *queryClient) DenomOwners(ctx context.Context, in *QueryDenomOwnersReque
 	return out, nil
 }
 
+func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error) {
+	out := new(QuerySendEnabledResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/SendEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Holds(ctx context.Context, in *QueryHoldsRequest, opts ...grpc.CallOption) (*QueryHoldsResponse, error) {
+	out := new(QueryHoldsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/Holds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single coin for a single account.
@@ -1068,6 +1325,12 @@ type QueryServer interface {
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	DenomOwners(context.Context, *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error)
+	// SendEnabled queries the send_enabled overrides of denoms. If no denom is
+	// given, all the overrides are returned.
+	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// Holds queries the holds placed on the balance of an account, along with
+	// its held and spendable coins.
+	Holds(context.Context, *QueryHoldsRequest) (*QueryHoldsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1098,6 +1361,12 @@ func (*UnimplementedQueryServer) DenomsMetadata(ctx context.Context, req *QueryD
 func (*UnimplementedQueryServer) DenomOwners(ctx context.Context, req *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomOwners not implemented")
 }
+func (*UnimplementedQueryServer) SendEnabled(ctx context.Context, req *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
+}
+func (*UnimplementedQueryServer) Holds(ctx context.Context, req *QueryHoldsRequest) (*QueryHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Holds not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1247,6 +1516,42 @@ func _Query_DenomOwners_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySendEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SendEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/SendEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SendEnabled(ctx, req.(*QuerySendEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Holds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Holds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/Holds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Holds(ctx, req.(*QueryHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1283,6 +1588,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomOwners",
 			Handler:    _Query_DenomOwners_Handler,
 		},
+		{
+			MethodName: "SendEnabled",
+			Handler:    _Query_SendEnabled_Handler,
+		},
+		{
+			MethodName: "Holds",
+			Handler:    _Query_Holds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -1932,76 +2245,268 @@ func (m *QueryDenomOwnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySendEnabledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QuerySendEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySendEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Balance != nil {
-		l = m.Balance.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySendEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QuerySendEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.SendEnabled) > 0 {
+		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHoldsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHoldsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHoldsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHoldsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHoldsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHoldsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spendable) > 0 {
+		for iNdEx := len(m.Spendable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spendable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Held) > 0 {
+		for iNdEx := len(m.Held) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Held[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Holds) > 0 {
+		for iNdEx := len(m.Holds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Balance != nil {
+		l = m.Balance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -2192,6 +2697,84 @@ func (m *QueryDenomOwnersResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySendEnabledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySendEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHoldsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHoldsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Holds) > 0 {
+		for _, e := range m.Holds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Held) > 0 {
+		for _, e := range m.Held {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Spendable) > 0 {
+		for _, e := range m.Spendable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3864,6 +4447,478 @@ func (m *QueryDenomOwnersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySendEnabledRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySendEnabledRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySendEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySendEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySendEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHoldsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHoldsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHoldsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHoldsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHoldsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHoldsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holds = append(m.Holds, Hold{})
+			if err := m.Holds[len(m.Holds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Held", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Held = append(m.Held, types.Coin{})
+			if err := m.Held[len(m.Held)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spendable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spendable = append(m.Spendable, types.Coin{})
+			if err := m.Spendable[len(m.Spendable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
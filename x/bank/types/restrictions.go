@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn is a hook run by the bank keeper before coins are sent.
+// Returning an error aborts the send. A nil toAddr means that the recipients
+// of the coins aren't known, e.g. in a multi-send with several inputs.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+
+// Then returns a SendRestrictionFn running r and then the given restriction,
+// stopping at the first error. A nil restriction is skipped.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	switch {
+	case r == nil:
+		return second
+	case second == nil:
+		return r
+	}
+
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+		if err := r(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
+		return second(ctx, fromAddr, toAddr, amt)
+	}
+}
@@ -28,8 +28,8 @@ func (a SendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRes
 	if !ok {
 		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
 	}
-	limitLeft, isNegative := a.SpendLimit.SafeSub(mSend.Amount)
-	if isNegative {
+	limitLeft, err := a.SpendLimit.SafeSub(mSend.Amount)
+	if err != nil {
 		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
 	}
 	if limitLeft.IsZero() {
@@ -198,45 +198,321 @@ func (m *MsgMultiSendResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMultiSendResponse proto.InternalMessageInfo
 
+// MsgMultiSendV2 represents a message to send coins from one account to many
+// accounts. Unlike MsgMultiSend, it has a single input, which is the sum of the
+// outputs, and each output can have a reference.
+type MsgMultiSendV2 struct {
+	FromAddress string          `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	Outputs     []LabeledOutput `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs"`
+}
+
+func (m *MsgMultiSendV2) Reset()         { *m = MsgMultiSendV2{} }
+func (m *MsgMultiSendV2) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiSendV2) ProtoMessage()    {}
+func (*MsgMultiSendV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{4}
+}
+func (m *MsgMultiSendV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiSendV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiSendV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMultiSendV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiSendV2.Merge(m, src)
+}
+func (m *MsgMultiSendV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiSendV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiSendV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiSendV2 proto.InternalMessageInfo
+
+// LabeledOutput is an output of a MsgMultiSendV2.
+type LabeledOutput struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Coins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// reference is an optional label of the output, e.g. the ID of a withdrawal
+	// of the recipient, which is emitted in the events of the message.
+	Reference string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (m *LabeledOutput) Reset()         { *m = LabeledOutput{} }
+func (m *LabeledOutput) String() string { return proto.CompactTextString(m) }
+func (*LabeledOutput) ProtoMessage()    {}
+func (*LabeledOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{5}
+}
+func (m *LabeledOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LabeledOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LabeledOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LabeledOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabeledOutput.Merge(m, src)
+}
+func (m *LabeledOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *LabeledOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabeledOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabeledOutput proto.InternalMessageInfo
+
+// MsgMultiSendV2Response defines the Msg/MultiSendV2 response type.
+type MsgMultiSendV2Response struct {
+}
+
+func (m *MsgMultiSendV2Response) Reset()         { *m = MsgMultiSendV2Response{} }
+func (m *MsgMultiSendV2Response) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiSendV2Response) ProtoMessage()    {}
+func (*MsgMultiSendV2Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{6}
+}
+func (m *MsgMultiSendV2Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiSendV2Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiSendV2Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMultiSendV2Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiSendV2Response.Merge(m, src)
+}
+func (m *MsgMultiSendV2Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiSendV2Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiSendV2Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiSendV2Response proto.InternalMessageInfo
+
+// MsgSetSendEnabled is the Msg/SetSendEnabled request type.
+type MsgSetSendEnabled struct {
+	// authority is the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// send_enabled is the list of send_enabled overrides to set.
+	SendEnabled []*SendEnabled `protobuf:"bytes,2,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// use_default_for is the list of denoms whose override is removed, so that
+	// they use the default_send_enabled param.
+	UseDefaultFor []string `protobuf:"bytes,3,rep,name=use_default_for,json=useDefaultFor,proto3" json:"use_default_for,omitempty"`
+}
+
+func (m *MsgSetSendEnabled) Reset()         { *m = MsgSetSendEnabled{} }
+func (m *MsgSetSendEnabled) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSendEnabled) ProtoMessage()    {}
+func (*MsgSetSendEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{7}
+}
+func (m *MsgSetSendEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSendEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSendEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSendEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSendEnabled.Merge(m, src)
+}
+func (m *MsgSetSendEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSendEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSendEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSendEnabled proto.InternalMessageInfo
+
+func (m *MsgSetSendEnabled) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetSendEnabled) GetSendEnabled() []*SendEnabled {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return nil
+}
+
+func (m *MsgSetSendEnabled) GetUseDefaultFor() []string {
+	if m != nil {
+		return m.UseDefaultFor
+	}
+	return nil
+}
+
+// MsgSetSendEnabledResponse defines the Msg/SetSendEnabled response type.
+type MsgSetSendEnabledResponse struct {
+}
+
+func (m *MsgSetSendEnabledResponse) Reset()         { *m = MsgSetSendEnabledResponse{} }
+func (m *MsgSetSendEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSendEnabledResponse) ProtoMessage()    {}
+func (*MsgSetSendEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{8}
+}
+func (m *MsgSetSendEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSendEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSendEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSendEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSendEnabledResponse.Merge(m, src)
+}
+func (m *MsgSetSendEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSendEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSendEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSendEnabledResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSend)(nil), "cosmos.bank.v1beta1.MsgSend")
 	proto.RegisterType((*MsgSendResponse)(nil), "cosmos.bank.v1beta1.MsgSendResponse")
 	proto.RegisterType((*MsgMultiSend)(nil), "cosmos.bank.v1beta1.MsgMultiSend")
 	proto.RegisterType((*MsgMultiSendResponse)(nil), "cosmos.bank.v1beta1.MsgMultiSendResponse")
+	proto.RegisterType((*MsgMultiSendV2)(nil), "cosmos.bank.v1beta1.MsgMultiSendV2")
+	proto.RegisterType((*LabeledOutput)(nil), "cosmos.bank.v1beta1.LabeledOutput")
+	proto.RegisterType((*MsgMultiSendV2Response)(nil), "cosmos.bank.v1beta1.MsgMultiSendV2Response")
+	proto.RegisterType((*MsgSetSendEnabled)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabled")
+	proto.RegisterType((*MsgSetSendEnabledResponse)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabledResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
 
 var fileDescriptor_1d8cb1613481f5b7 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcb, 0xae, 0xd2, 0x40,
-	0x1c, 0xc6, 0x3b, 0x07, 0xc2, 0x91, 0x39, 0x27, 0x31, 0x56, 0x62, 0x38, 0xf5, 0xa4, 0x45, 0xe2,
-	0x02, 0x16, 0x4c, 0x05, 0x17, 0x1a, 0x58, 0x59, 0x56, 0x9a, 0x34, 0x26, 0x65, 0xa5, 0x1b, 0xd2,
-	0xcb, 0x58, 0x1b, 0xec, 0x4c, 0xd3, 0x99, 0x1a, 0x7c, 0x03, 0x13, 0x37, 0x3e, 0x02, 0x6b, 0xe3,
-	0xd2, 0x87, 0x60, 0x49, 0x5c, 0xb9, 0x52, 0x03, 0x1b, 0x57, 0x3e, 0x83, 0xe9, 0x74, 0x5a, 0x49,
-	0x44, 0x58, 0xf5, 0xf2, 0x7d, 0xbf, 0x6f, 0xfe, 0x97, 0x81, 0xd7, 0x3e, 0x65, 0x31, 0x65, 0xa6,
-	0xe7, 0x92, 0x85, 0xf9, 0x76, 0xe8, 0x61, 0xee, 0x0e, 0x4d, 0xbe, 0x44, 0x49, 0x4a, 0x39, 0x55,
-	0x6f, 0x17, 0x2a, 0xca, 0x55, 0x24, 0x55, 0xad, 0x15, 0xd2, 0x90, 0x0a, 0xdd, 0xcc, 0xdf, 0x0a,
-	0xab, 0xa6, 0x57, 0x41, 0x0c, 0x57, 0x41, 0x3e, 0x8d, 0xc8, 0x3f, 0xfa, 0xde, 0x41, 0x22, 0xb7,
-	0xd0, 0xaf, 0x0a, 0x7d, 0x5e, 0x04, 0xcb, 0x73, 0xc5, 0x47, 0xf7, 0x37, 0x80, 0xe7, 0x36, 0x0b,
-	0x67, 0x98, 0x04, 0xea, 0x04, 0x5e, 0xbe, 0x4a, 0x69, 0x3c, 0x77, 0x83, 0x20, 0xc5, 0x8c, 0xb5,
-	0x41, 0x07, 0xf4, 0x9a, 0x56, 0xfb, 0xeb, 0x97, 0x41, 0x4b, 0x32, 0x4f, 0x0a, 0x65, 0xc6, 0xd3,
-	0x88, 0x84, 0xce, 0x45, 0xee, 0x96, 0xbf, 0xd4, 0x47, 0x10, 0x72, 0x5a, 0xa1, 0x67, 0x27, 0xd0,
-	0x26, 0xa7, 0x25, 0xe8, 0xc3, 0x86, 0x1b, 0xd3, 0x8c, 0xf0, 0x76, 0xad, 0x53, 0xeb, 0x5d, 0x8c,
-	0xae, 0x50, 0x35, 0x18, 0x86, 0xcb, 0xc1, 0xa0, 0x29, 0x8d, 0x88, 0xf5, 0x60, 0xfd, 0xdd, 0x50,
-	0x3e, 0xfd, 0x30, 0x7a, 0x61, 0xc4, 0x5f, 0x67, 0x1e, 0xf2, 0x69, 0x2c, 0xbb, 0x91, 0x8f, 0x01,
-	0x0b, 0x16, 0x26, 0x7f, 0x97, 0x60, 0x26, 0x00, 0xe6, 0xc8, 0xe8, 0xf1, 0x8d, 0xf7, 0x2b, 0x43,
-	0xf9, 0xb5, 0x32, 0x94, 0xee, 0x2d, 0x78, 0x53, 0xf6, 0xeb, 0x60, 0x96, 0x50, 0xc2, 0x70, 0xf7,
-	0x03, 0x80, 0x97, 0x36, 0x0b, 0xed, 0xec, 0x0d, 0x8f, 0xc4, 0x20, 0x1e, 0xc3, 0x46, 0x44, 0x92,
-	0x8c, 0xe7, 0x23, 0xc8, 0x4b, 0xd2, 0xd0, 0x81, 0x5d, 0xa1, 0xa7, 0xb9, 0xc5, 0xaa, 0xe7, 0x35,
-	0x39, 0xd2, 0xaf, 0x4e, 0xe0, 0x39, 0xcd, 0xb8, 0x40, 0xcf, 0x04, 0x7a, 0xf7, 0x20, 0xfa, 0x5c,
-	0x78, 0x24, 0x5b, 0x12, 0xe3, 0xba, 0x28, 0xf0, 0x0e, 0x6c, 0xed, 0x17, 0x53, 0x56, 0x39, 0xfa,
-	0x0c, 0x60, 0xcd, 0x66, 0xa1, 0xfa, 0x0c, 0xd6, 0x45, 0x91, 0xd7, 0x07, 0x93, 0x65, 0x6f, 0xda,
-	0xfd, 0x63, 0x6a, 0x99, 0xa9, 0xbe, 0x80, 0xcd, 0xbf, 0x5d, 0xdf, 0xfb, 0x1f, 0x52, 0x59, 0xb4,
-	0xfe, 0x49, 0x4b, 0x19, 0x6d, 0x4d, 0xd7, 0x5b, 0x1d, 0x6c, 0xb6, 0x3a, 0xf8, 0xb9, 0xd5, 0xc1,
-	0xc7, 0x9d, 0xae, 0x6c, 0x76, 0xba, 0xf2, 0x6d, 0xa7, 0x2b, 0x2f, 0xfb, 0x47, 0xb7, 0xb7, 0x2c,
-	0x6e, 0xb1, 0x58, 0xa2, 0xd7, 0x10, 0x97, 0xf4, 0xe1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe8,
-	0x6d, 0x23, 0xe3, 0x4a, 0x03, 0x00, 0x00,
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xb6, 0x9b, 0xd2, 0xe2, 0x97, 0xfe, 0x50, 0x4d, 0x55, 0xa5, 0x6e, 0xe5, 0x84, 0x80, 0xaa,
+	0x54, 0xa8, 0x0e, 0x0d, 0x12, 0xa0, 0x76, 0x22, 0x01, 0x24, 0x10, 0x11, 0x92, 0x2b, 0x55, 0x82,
+	0xc5, 0xb2, 0xe3, 0x8b, 0x63, 0x35, 0xf1, 0x45, 0xbe, 0x33, 0x6a, 0xff, 0x03, 0x24, 0x16, 0x76,
+	0x96, 0xce, 0xcc, 0xcc, 0xcc, 0x9d, 0x50, 0xc5, 0xc4, 0x04, 0x28, 0x59, 0x98, 0x58, 0x59, 0x91,
+	0xcf, 0x67, 0xc7, 0x6d, 0xdd, 0x26, 0x12, 0x4c, 0x6d, 0xee, 0xfb, 0xbe, 0xf7, 0xbe, 0xf7, 0xcb,
+	0xb0, 0xde, 0xc2, 0xa4, 0x87, 0x49, 0xd5, 0x32, 0xbd, 0x83, 0xea, 0x9b, 0x6d, 0x0b, 0x51, 0x73,
+	0xbb, 0x4a, 0x0f, 0xb5, 0xbe, 0x8f, 0x29, 0x96, 0x6f, 0x44, 0xa8, 0x16, 0xa2, 0x1a, 0x47, 0x95,
+	0x65, 0x07, 0x3b, 0x98, 0xe1, 0xd5, 0xf0, 0xbf, 0x88, 0xaa, 0xa8, 0x49, 0x20, 0x82, 0x92, 0x40,
+	0x2d, 0xec, 0x7a, 0x17, 0xf0, 0x54, 0x22, 0x16, 0x37, 0xc2, 0x57, 0x23, 0xdc, 0x88, 0x02, 0xf3,
+	0xbc, 0xec, 0x47, 0xf9, 0xb7, 0x08, 0xb3, 0x4d, 0xe2, 0xec, 0x21, 0xcf, 0x96, 0x77, 0x61, 0xae,
+	0xed, 0xe3, 0x9e, 0x61, 0xda, 0xb6, 0x8f, 0x08, 0x29, 0x88, 0x25, 0xb1, 0x22, 0xd5, 0x0b, 0x5f,
+	0x3f, 0x6d, 0x2d, 0x73, 0xcd, 0xa3, 0x08, 0xd9, 0xa3, 0xbe, 0xeb, 0x39, 0x7a, 0x3e, 0x64, 0xf3,
+	0x27, 0xf9, 0x01, 0x00, 0xc5, 0x89, 0x74, 0x6a, 0x8c, 0x54, 0xa2, 0x38, 0x16, 0xb6, 0x60, 0xc6,
+	0xec, 0xe1, 0xc0, 0xa3, 0x85, 0x5c, 0x29, 0x57, 0xc9, 0xd7, 0x56, 0xb5, 0xa4, 0x31, 0x04, 0xc5,
+	0x8d, 0xd1, 0x1a, 0xd8, 0xf5, 0xea, 0x77, 0x4f, 0xbe, 0x17, 0x85, 0x8f, 0x3f, 0x8a, 0x15, 0xc7,
+	0xa5, 0x9d, 0xc0, 0xd2, 0x5a, 0xb8, 0xc7, 0xab, 0xe1, 0x7f, 0xb6, 0x88, 0x7d, 0x50, 0xa5, 0x47,
+	0x7d, 0x44, 0x98, 0x80, 0xe8, 0x3c, 0xf4, 0xce, 0xf5, 0xb7, 0xc7, 0x45, 0xe1, 0xd7, 0x71, 0x51,
+	0x28, 0x2f, 0xc1, 0x22, 0xaf, 0x57, 0x47, 0xa4, 0x8f, 0x3d, 0x82, 0xca, 0xef, 0x44, 0x98, 0x6b,
+	0x12, 0xa7, 0x19, 0x74, 0xa9, 0xcb, 0x1a, 0xf1, 0x10, 0x66, 0x5c, 0xaf, 0x1f, 0xd0, 0xb0, 0x05,
+	0xa1, 0x25, 0x45, 0xcb, 0x98, 0x95, 0xf6, 0x2c, 0xa4, 0xd4, 0xa7, 0x43, 0x4f, 0x3a, 0xe7, 0xcb,
+	0xbb, 0x30, 0x8b, 0x03, 0xca, 0xa4, 0x53, 0x4c, 0xba, 0x96, 0x29, 0x7d, 0x19, 0xd0, 0x91, 0x36,
+	0x56, 0xec, 0x4c, 0x33, 0x83, 0x2b, 0xb0, 0x9c, 0x36, 0x93, 0xb8, 0xfc, 0x20, 0xc2, 0x42, 0x1a,
+	0xd8, 0xaf, 0xfd, 0xdb, 0xc0, 0xea, 0xe7, 0xad, 0x96, 0x33, 0xad, 0xbe, 0x30, 0x2d, 0xd4, 0x45,
+	0x76, 0xb6, 0xe3, 0x51, 0x5b, 0xbf, 0x88, 0x30, 0x7f, 0x86, 0x2a, 0xd7, 0x60, 0x76, 0x52, 0x5f,
+	0x31, 0x51, 0x36, 0xe1, 0x5a, 0xb8, 0xd6, 0xb1, 0xa3, 0xff, 0xba, 0x0a, 0x51, 0x64, 0x79, 0x1d,
+	0x24, 0x1f, 0xb5, 0x91, 0x8f, 0xbc, 0x16, 0x2a, 0xe4, 0x42, 0x63, 0xfa, 0xe8, 0x21, 0x29, 0x48,
+	0x2c, 0x17, 0x60, 0xe5, 0x6c, 0xb7, 0x93, 0x41, 0x7c, 0x16, 0x61, 0x89, 0xad, 0x10, 0x0d, 0x81,
+	0x27, 0x9e, 0x69, 0x75, 0x91, 0x2d, 0xdf, 0x07, 0xc9, 0x0c, 0x68, 0x07, 0xfb, 0x2e, 0x3d, 0x1a,
+	0x5b, 0xf0, 0x88, 0x2a, 0x37, 0x60, 0x8e, 0x20, 0xcf, 0x36, 0x50, 0x14, 0x87, 0x57, 0x5e, 0xca,
+	0x9c, 0x45, 0x2a, 0x9f, 0x9e, 0x27, 0xa9, 0xe4, 0x1b, 0xb0, 0x18, 0x10, 0x64, 0xd8, 0xa8, 0x6d,
+	0x06, 0x5d, 0x6a, 0xb4, 0xb1, 0xcf, 0x8e, 0x49, 0xd2, 0xe7, 0x03, 0x82, 0x1e, 0x47, 0xaf, 0x4f,
+	0xb1, 0xcf, 0x37, 0x6c, 0x0d, 0x56, 0x2f, 0xf8, 0x8f, 0xab, 0xab, 0xfd, 0x99, 0x82, 0x5c, 0x93,
+	0x38, 0xf2, 0x73, 0x98, 0x66, 0xb7, 0xb0, 0x9e, 0xe9, 0x84, 0x9f, 0x90, 0x72, 0xfb, 0x2a, 0x34,
+	0x8e, 0x29, 0xbf, 0x02, 0x69, 0x74, 0x5c, 0x37, 0x2f, 0x93, 0x24, 0x14, 0x65, 0x73, 0x2c, 0x25,
+	0x09, 0x6d, 0x40, 0x3e, 0x7d, 0x11, 0xb7, 0xc6, 0x2a, 0xf7, 0x6b, 0xca, 0x9d, 0x09, 0x48, 0x49,
+	0x82, 0x0e, 0x2c, 0x9c, 0x9b, 0xf4, 0xc6, 0xe5, 0x35, 0xa7, 0x79, 0x8a, 0x36, 0x19, 0x2f, 0xce,
+	0x54, 0x6f, 0x9c, 0x0c, 0x54, 0xf1, 0x74, 0xa0, 0x8a, 0x3f, 0x07, 0xaa, 0xf8, 0x7e, 0xa8, 0x0a,
+	0xa7, 0x43, 0x55, 0xf8, 0x36, 0x54, 0x85, 0xd7, 0x9b, 0x57, 0x2e, 0xf9, 0x61, 0xf4, 0xdd, 0x67,
+	0xbb, 0x6e, 0xcd, 0xb0, 0xcf, 0xfa, 0xbd, 0xbf, 0x03, 0x00, 0x3a, 0xd8, 0xe1, 0x87, 0x7c, 0x06,
+	0x00, 0x00,
+}
+
+func (this *LabeledOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LabeledOutput)
+	if !ok {
+		that2, ok := that.(LabeledOutput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Coins) != len(that1.Coins) {
+		return false
+	}
+	for i := range this.Coins {
+		if !this.Coins[i].Equal(&that1.Coins[i]) {
+			return false
+		}
+	}
+	if this.Reference != that1.Reference {
+		return false
+	}
+	return true
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -255,6 +531,12 @@ type MsgClient interface {
 	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
 	// MultiSend defines a method for sending coins from some accounts to other accounts.
 	MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error)
+	// MultiSendV2 defines a method for sending coins from one account to many
+	// accounts, e.g. for the payouts of an exchange.
+	MultiSendV2(ctx context.Context, in *MsgMultiSendV2, opts ...grpc.CallOption) (*MsgMultiSendV2Response, error)
+	// SetSendEnabled sets or removes the send_enabled overrides of denoms. It can
+	// only be executed by the governance module account.
+	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
 }
 
 type msgClient struct {
@@ -283,12 +565,36 @@ func (c *msgClient) MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) MultiSendV2(ctx context.Context, in *MsgMultiSendV2, opts ...grpc.CallOption) (*MsgMultiSendV2Response, error) {
+	out := new(MsgMultiSendV2Response)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/MultiSendV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error) {
+	out := new(MsgSetSendEnabledResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SetSendEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Send defines a method for sending coins from one account to another account.
 	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
 	// MultiSend defines a method for sending coins from some accounts to other accounts.
 	MultiSend(context.Context, *MsgMultiSend) (*MsgMultiSendResponse, error)
+	// MultiSendV2 defines a method for sending coins from one account to many
+	// accounts, e.g. for the payouts of an exchange.
+	MultiSendV2(context.Context, *MsgMultiSendV2) (*MsgMultiSendV2Response, error)
+	// SetSendEnabled sets or removes the send_enabled overrides of denoms. It can
+	// only be executed by the governance module account.
+	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -301,6 +607,12 @@ func (*UnimplementedMsgServer) Send(ctx context.Context, req *MsgSend) (*MsgSend
 func (*UnimplementedMsgServer) MultiSend(ctx context.Context, req *MsgMultiSend) (*MsgMultiSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultiSend not implemented")
 }
+func (*UnimplementedMsgServer) MultiSendV2(ctx context.Context, req *MsgMultiSendV2) (*MsgMultiSendV2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSendV2 not implemented")
+}
+func (*UnimplementedMsgServer) SetSendEnabled(ctx context.Context, req *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -342,6 +654,42 @@ func _Msg_MultiSend_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MultiSendV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiSendV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiSendV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/MultiSendV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiSendV2(ctx, req.(*MsgMultiSendV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSendEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSendEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SetSendEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSendEnabled(ctx, req.(*MsgSetSendEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -354,6 +702,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MultiSend",
 			Handler:    _Msg_MultiSend_Handler,
 		},
+		{
+			MethodName: "MultiSendV2",
+			Handler:    _Msg_MultiSendV2_Handler,
+		},
+		{
+			MethodName: "SetSendEnabled",
+			Handler:    _Msg_SetSendEnabled_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -507,86 +863,731 @@ func (m *MsgMultiSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgMultiSendV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgMultiSendV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LabeledOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LabeledOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LabeledOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiSendV2Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSendV2Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSendEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSendEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSendEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UseDefaultFor) > 0 {
+		for iNdEx := len(m.UseDefaultFor) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UseDefaultFor[iNdEx])
+			copy(dAtA[i:], m.UseDefaultFor[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.UseDefaultFor[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SendEnabled) > 0 {
+		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSendEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSendEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMultiSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMultiSendV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LabeledOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMultiSendV2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSendEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UseDefaultFor) > 0 {
+		for _, s := range m.UseDefaultFor {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetSendEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, Input{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, Output{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgMultiSend) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.Outputs) > 0 {
-		for _, e := range m.Outputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgMultiSendResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSend) Unmarshal(dAtA []byte) error {
+func (m *MsgMultiSendV2) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -609,10 +1610,10 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMultiSendV2: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMultiSendV2: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -649,7 +1650,91 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, LabeledOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabeledOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabeledOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabeledOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -677,11 +1762,11 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -708,11 +1793,43 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -734,7 +1851,7 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMultiSendV2Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -757,10 +1874,10 @@ func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMultiSendV2Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMultiSendV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -784,7 +1901,7 @@ func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -807,17 +1924,17 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSendEnabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -827,29 +1944,27 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inputs = append(m.Inputs, Input{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -876,11 +1991,43 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Outputs = append(m.Outputs, Output{})
-			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDefaultFor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UseDefaultFor = append(m.UseDefaultFor, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -902,7 +2049,7 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -925,10 +2072,10 @@ func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSendEnabledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSendEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -20,18 +21,18 @@ type (
 	// initialization, the keeper can be hooked up to modules through unique function
 	// references so that it can identify the calling module when later invoked.
 	//
-	// When the initial state is loaded from disk, the keeper allows the ability to
-	// create new capability keys for all previously allocated capability identifiers
-	// (allocated during execution of past transactions and assigned to particular modes),
-	// and keep them in a memory-only store while the chain is running.
+	// The owners of the capabilities, and the names under which they own them,
+	// are persisted in the module store. The in-memory capabilities, whose memory
+	// references authenticate them, are created on the first time they are
+	// retrieved by one of their owners after the node starts, so the ownerships
+	// survive restarts and state sync without any initialization.
 	//
 	// The keeper allows the ability to create scoped sub-keepers which are tied to
 	// a single specific module.
 	Keeper struct {
 		cdc           codec.BinaryCodec
 		storeKey      storetypes.StoreKey
-		memKey        storetypes.StoreKey
-		capMap        map[uint64]*types.Capability
+		capMap        *capabilityMap
 		scopedModules map[string]struct{}
 		sealed        bool
 	}
@@ -45,20 +46,67 @@ type (
 	ScopedKeeper struct {
 		cdc      codec.BinaryCodec
 		storeKey storetypes.StoreKey
-		memKey   storetypes.StoreKey
-		capMap   map[uint64]*types.Capability
+		capMap   *capabilityMap
 		module   string
 	}
 )
 
+// capabilityMap maps the indexes of the capabilities to their in-memory
+// references, shared by the keeper and its scoped keepers. The map isn't
+// reverted with the state of failed txs, so a capability is only genuine if it
+// is the reference mapped to its index, and its owners are in the store.
+type capabilityMap struct {
+	mtx  sync.Mutex
+	caps map[uint64]*types.Capability
+}
+
+// get returns the in-memory capability of the given index, creating it if it
+// doesn't exist yet, e.g. after a restart.
+func (m *capabilityMap) get(index uint64) *types.Capability {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cap, ok := m.caps[index]
+	if !ok {
+		cap = types.NewCapability(index)
+		m.caps[index] = cap
+	}
+
+	return cap
+}
+
+// set maps the index of the given capability to it.
+func (m *capabilityMap) set(cap *types.Capability) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.caps[cap.GetIndex()] = cap
+}
+
+// delete deletes the in-memory capability of the given index.
+func (m *capabilityMap) delete(index uint64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	delete(m.caps, index)
+}
+
+// authenticate returns whether the given capability is the in-memory
+// capability of its index, and not a copy forged by a module.
+func (m *capabilityMap) authenticate(cap *types.Capability) bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return cap != nil && m.caps[cap.GetIndex()] == cap
+}
+
 // NewKeeper constructs a new CapabilityKeeper instance and initializes maps
 // for capability map and scopedModules map.
-func NewKeeper(cdc codec.BinaryCodec, storeKey, memKey storetypes.StoreKey) *Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey) *Keeper {
 	return &Keeper{
 		cdc:           cdc,
 		storeKey:      storeKey,
-		memKey:        memKey,
-		capMap:        make(map[uint64]*types.Capability),
+		capMap:        &capabilityMap{caps: make(map[uint64]*types.Capability)},
 		scopedModules: make(map[string]struct{}),
 		sealed:        false,
 	}
@@ -84,7 +132,6 @@ func (k *Keeper) ScopeToModule(moduleName string) ScopedKeeper {
 	return ScopedKeeper{
 		cdc:      k.cdc,
 		storeKey: k.storeKey,
-		memKey:   k.memKey,
 		capMap:   k.capMap,
 		module:   moduleName,
 	}
@@ -100,51 +147,6 @@ func (k *Keeper) Seal() {
 	k.sealed = true
 }
 
-// InitMemStore will assure that the module store is a memory store (it will panic if it's not)
-// and willl initialize it. The function is safe to be called multiple times.
-// InitMemStore must be called every time the app starts before the keeper is used (so
-// `BeginBlock` or `InitChain` - whichever is first). We need access to the store so we
-// can't initialize it in a constructor.
-func (k *Keeper) InitMemStore(ctx sdk.Context) {
-	memStore := ctx.KVStore(k.memKey)
-	memStoreType := memStore.GetStoreType()
-
-	if memStoreType != storetypes.StoreTypeMemory {
-		panic(fmt.Sprintf("invalid memory store type; got %s, expected: %s", memStoreType, storetypes.StoreTypeMemory))
-	}
-
-	// create context with no block gas meter to ensure we do not consume gas during local initialization logic.
-	noGasCtx := ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter())
-
-	// check if memory store has not been initialized yet by checking if initialized flag is nil.
-	if !k.IsInitialized(noGasCtx) {
-		prefixStore := prefix.NewStore(noGasCtx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
-		iterator := sdk.KVStorePrefixIterator(prefixStore, nil)
-
-		// initialize the in-memory store for all persisted capabilities
-		defer iterator.Close()
-
-		for ; iterator.Valid(); iterator.Next() {
-			index := types.IndexFromKey(iterator.Key())
-
-			var capOwners types.CapabilityOwners
-
-			k.cdc.MustUnmarshal(iterator.Value(), &capOwners)
-			k.InitializeCapability(noGasCtx, index, capOwners)
-		}
-
-		// set the initialized flag so we don't rerun initialization logic
-		memStore := noGasCtx.KVStore(k.memKey)
-		memStore.Set(types.KeyMemInitialized, []byte{1})
-	}
-}
-
-// IsInitialized returns true if the keeper is properly initialized, and false otherwise.
-func (k *Keeper) IsInitialized(ctx sdk.Context) bool {
-	memStore := ctx.KVStore(k.memKey)
-	return memStore.Get(types.KeyMemInitialized) != nil
-}
-
 // InitializeIndex sets the index to one (or greater) in InitChain according
 // to the GenesisState. It must only be called once.
 // It will panic if the provided index is 0, or if the index is already set.
@@ -193,29 +195,24 @@ func (k Keeper) GetOwners(ctx sdk.Context, index uint64) (types.CapabilityOwners
 	return owners, true
 }
 
-// InitializeCapability takes in an index and an owners array. It creates the capability in memory
-// and sets the fwd and reverse keys for each owner in the memstore.
+// InitializeCapability sets the forward and reverse mappings between the
+// capability of the given index and the names under which its owners own it.
 // It is used during initialization from genesis.
 func (k Keeper) InitializeCapability(ctx sdk.Context, index uint64, owners types.CapabilityOwners) {
-
-	memStore := ctx.KVStore(k.memKey)
+	store := ctx.KVStore(k.storeKey)
 
 	cap := types.NewCapability(index)
 	for _, owner := range owners.Owners {
-		// Set the forward mapping between the module and capability tuple and the
-		// capability name in the memKVStore
-		memStore.Set(types.FwdCapabilityKey(owner.Module, cap), []byte(owner.Name))
-
-		// Set the reverse mapping between the module and capability name and the
-		// index in the in-memory store. Since marshalling and unmarshalling into a store
-		// will change memory address of capability, we simply store index as value here
-		// and retrieve the in-memory pointer to the capability from our map
-		memStore.Set(types.RevCapabilityKey(owner.Module, owner.Name), sdk.Uint64ToBigEndian(index))
-
-		// Set the mapping from index from index to in-memory capability in the go map
-		k.capMap[index] = cap
+		setMappings(store, owner.Module, owner.Name, cap)
 	}
+}
 
+// setMappings sets the forward mapping between the module and capability tuple
+// and the capability name, and the reverse mapping between the module and
+// capability name tuple and the capability index.
+func setMappings(store sdk.KVStore, module, name string, cap *types.Capability) {
+	store.Set(types.FwdCapabilityKey(module, cap), []byte(name))
+	store.Set(types.RevCapabilityKey(module, name), sdk.Uint64ToBigEndian(cap.GetIndex()))
 }
 
 // NewCapability attempts to create a new capability with a given name. If the
@@ -223,7 +220,7 @@ func (k Keeper) InitializeCapability(ctx sdk.Context, index uint64, owners types
 // Otherwise, a new capability is created with the current global unique index.
 // The newly created capability has the scoped module name and capability name
 // tuple set as the initial owner. Finally, the global index is incremented along
-// with forward and reverse indexes set in the in-memory store.
+// with forward and reverse indexes set in the store.
 //
 // Note, namespacing is completely local, which is safe since records are prefixed
 // with the module name and no two ScopedKeeper can have the same module name.
@@ -249,20 +246,11 @@ func (sk ScopedKeeper) NewCapability(ctx sdk.Context, name string) (*types.Capab
 	// increment global index
 	store.Set(types.KeyIndex, types.IndexToKey(index+1))
 
-	memStore := ctx.KVStore(sk.memKey)
+	setMappings(store, sk.module, name, cap)
 
-	// Set the forward mapping between the module and capability tuple and the
-	// capability name in the memKVStore
-	memStore.Set(types.FwdCapabilityKey(sk.module, cap), []byte(name))
-
-	// Set the reverse mapping between the module and capability name and the
-	// index in the in-memory store. Since marshalling and unmarshalling into a store
-	// will change memory address of capability, we simply store index as value here
-	// and retrieve the in-memory pointer to the capability from our map
-	memStore.Set(types.RevCapabilityKey(sk.module, name), sdk.Uint64ToBigEndian(index))
-
-	// Set the mapping from index from index to in-memory capability in the go map
-	sk.capMap[index] = cap
+	// Set the mapping from index to in-memory capability in the go map. The
+	// capability of a reverted tx is overwritten by the next one of the index.
+	sk.capMap.set(cap)
 
 	logger(ctx).Info("created new capability", "module", sk.module, "name", name)
 
@@ -271,12 +259,10 @@ func (sk ScopedKeeper) NewCapability(ctx sdk.Context, name string) (*types.Capab
 
 // AuthenticateCapability attempts to authenticate a given capability and name
 // from a caller. It allows for a caller to check that a capability does in fact
-// correspond to a particular name. The scoped keeper will lookup the capability
-// from the internal in-memory store and check against the provided name. It returns
-// true upon success and false upon failure.
-//
-// Note, the capability's forward mapping is indexed by a string which should
-// contain its unique memory reference.
+// correspond to a particular name. The scoped keeper checks that the capability
+// is the in-memory capability of its index, and looks up its name in the store
+// to check against the provided name. It returns true upon success and false
+// upon failure.
 func (sk ScopedKeeper) AuthenticateCapability(ctx sdk.Context, cap *types.Capability, name string) bool {
 	if strings.TrimSpace(name) == "" || cap == nil {
 		return false
@@ -287,8 +273,9 @@ func (sk ScopedKeeper) AuthenticateCapability(ctx sdk.Context, cap *types.Capabi
 // ClaimCapability attempts to claim a given Capability. The provided name and
 // the scoped module's name tuple are treated as the owner. It will attempt
 // to add the owner to the persistent set of capability owners for the capability
-// index. If the owner already exists, it will return an error. Otherwise, it will
-// also set a forward and reverse index for the capability and capability name.
+// index. If the owner already exists, or if the capability isn't genuine, it
+// will return an error. Otherwise, it will also set a forward and reverse index
+// for the capability and capability name.
 func (sk ScopedKeeper) ClaimCapability(ctx sdk.Context, cap *types.Capability, name string) error {
 	if cap == nil {
 		return sdkerrors.Wrap(types.ErrNilCapability, "cannot claim nil capability")
@@ -296,22 +283,16 @@ func (sk ScopedKeeper) ClaimCapability(ctx sdk.Context, cap *types.Capability, n
 	if strings.TrimSpace(name) == "" {
 		return sdkerrors.Wrap(types.ErrInvalidCapabilityName, "capability name cannot be empty")
 	}
+	// only the in-memory capabilities can be claimed, and not copies of them
+	if !sk.capMap.authenticate(cap) {
+		return sdkerrors.Wrapf(types.ErrCapabilityNotFound, "capability %d", cap.GetIndex())
+	}
 	// update capability owner set
 	if err := sk.addOwner(ctx, cap, name); err != nil {
 		return err
 	}
 
-	memStore := ctx.KVStore(sk.memKey)
-
-	// Set the forward mapping between the module and capability tuple and the
-	// capability name in the memKVStore
-	memStore.Set(types.FwdCapabilityKey(sk.module, cap), []byte(name))
-
-	// Set the reverse mapping between the module and capability name and the
-	// index in the in-memory store. Since marshalling and unmarshalling into a store
-	// will change memory address of capability, we simply store index as value here
-	// and retrieve the in-memory pointer to the capability from our map
-	memStore.Set(types.RevCapabilityKey(sk.module, name), sdk.Uint64ToBigEndian(cap.GetIndex()))
+	setMappings(ctx.KVStore(sk.storeKey), sk.module, name, cap)
 
 	logger(ctx).Info("claimed capability", "module", sk.module, "name", name, "capability", cap.GetIndex())
 
@@ -330,15 +311,15 @@ func (sk ScopedKeeper) ReleaseCapability(ctx sdk.Context, cap *types.Capability)
 		return sdkerrors.Wrap(types.ErrCapabilityNotOwned, sk.module)
 	}
 
-	memStore := ctx.KVStore(sk.memKey)
+	store := ctx.KVStore(sk.storeKey)
 
 	// Delete the forward mapping between the module and capability tuple and the
-	// capability name in the memKVStore
-	memStore.Delete(types.FwdCapabilityKey(sk.module, cap))
+	// capability name
+	store.Delete(types.FwdCapabilityKey(sk.module, cap))
 
 	// Delete the reverse mapping between the module and capability name and the
-	// index in the in-memory store.
-	memStore.Delete(types.RevCapabilityKey(sk.module, name))
+	// index
+	store.Delete(types.RevCapabilityKey(sk.module, name))
 
 	// remove owner
 	capOwners := sk.getOwners(ctx, cap)
@@ -351,7 +332,7 @@ func (sk ScopedKeeper) ReleaseCapability(ctx sdk.Context, cap *types.Capability)
 		// remove capability owner set
 		prefixStore.Delete(indexKey)
 		// since no one owns capability, we can delete capability from map
-		delete(sk.capMap, cap.GetIndex())
+		sk.capMap.delete(cap.GetIndex())
 	} else {
 		// update capability owner set
 		prefixStore.Set(indexKey, sk.cdc.MustMarshal(capOwners))
@@ -367,40 +348,28 @@ func (sk ScopedKeeper) GetCapability(ctx sdk.Context, name string) (*types.Capab
 	if strings.TrimSpace(name) == "" {
 		return nil, false
 	}
-	memStore := ctx.KVStore(sk.memKey)
-
-	key := types.RevCapabilityKey(sk.module, name)
-	indexBytes := memStore.Get(key)
-	index := sdk.BigEndianToUint64(indexBytes)
+	store := ctx.KVStore(sk.storeKey)
 
+	indexBytes := store.Get(types.RevCapabilityKey(sk.module, name))
 	if len(indexBytes) == 0 {
-		// If a tx failed and NewCapability got reverted, it is possible
-		// to still have the capability in the go map since changes to
-		// go map do not automatically get reverted on tx failure,
-		// so we delete here to remove unnecessary values in map
-		// TODO: Delete index correctly from capMap by storing some reverse lookup
-		// in-memory map. Issue: https://github.com/cosmos/cosmos-sdk/issues/7805
-
 		return nil, false
 	}
 
-	cap := sk.capMap[index]
-	if cap == nil {
-		panic("capability found in memstore is missing from map")
-	}
-
-	return cap, true
+	// the in-memory capability is created the first time it is retrieved after
+	// the node starts
+	return sk.capMap.get(sdk.BigEndianToUint64(indexBytes)), true
 }
 
 // GetCapabilityName allows a module to retrieve the name under which it stored a given
-// capability given the capability
+// capability given the capability. It returns an empty name if the capability
+// isn't the in-memory capability of its index.
 func (sk ScopedKeeper) GetCapabilityName(ctx sdk.Context, cap *types.Capability) string {
-	if cap == nil {
+	if !sk.capMap.authenticate(cap) {
 		return ""
 	}
-	memStore := ctx.KVStore(sk.memKey)
+	store := ctx.KVStore(sk.storeKey)
 
-	return string(memStore.Get(types.FwdCapabilityKey(sk.module, cap)))
+	return string(store.Get(types.FwdCapabilityKey(sk.module, cap)))
 }
 
 // GetOwners all the Owners that own the capability associated with the name this ScopedKeeper uses
@@ -432,7 +401,7 @@ func (sk ScopedKeeper) GetOwners(ctx sdk.Context, name string) (*types.Capabilit
 // LookupModules returns all the module owners for a given capability
 // as a string array and the capability itself.
 // The method returns an error if either the capability or the owners cannot be
-// retreived from the memstore.
+// retreived from the store.
 func (sk ScopedKeeper) LookupModules(ctx sdk.Context, name string) ([]string, *types.Capability, error) {
 	if strings.TrimSpace(name) == "" {
 		return nil, nil, sdkerrors.Wrap(types.ErrInvalidCapabilityName, "cannot lookup modules with empty capability name")
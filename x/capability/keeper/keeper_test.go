@@ -29,7 +29,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	cdc := app.AppCodec()
 
 	// create new keeper so we can define custom scoping before init and seal
-	keeper := keeper.NewKeeper(cdc, app.GetKey(types.StoreKey), app.GetMemKey(types.MemStoreKey))
+	keeper := keeper.NewKeeper(cdc, app.GetKey(types.StoreKey))
 
 	suite.app = app
 	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{Height: 1})
@@ -297,7 +297,7 @@ func (suite KeeperTestSuite) TestRevertCapability() {
 	suite.Require().False(ok, "retrieved capability from original context before write")
 	suite.Require().Nil(got, "capability not nil in original store")
 
-	// Write to underlying memKVStore
+	// Write to underlying store
 	msCache.Write()
 
 	got, ok = sk.GetCapability(suite.ctx, capName)
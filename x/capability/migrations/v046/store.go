@@ -0,0 +1,49 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Set the forward and reverse mappings between the owners of the capabilities
+// and the names under which they own them in the store, which were previously
+// only kept in the in-memory store and rebuilt on each start.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	// the owners are collected first, as no writes may happen while iterating
+	var (
+		indexes []uint64
+		owners  []types.CapabilityOwners
+	)
+	iter := sdk.KVStorePrefixIterator(prefix.NewStore(store, types.KeyPrefixIndexCapability), nil)
+	for ; iter.Valid(); iter.Next() {
+		var capOwners types.CapabilityOwners
+		if err := cdc.Unmarshal(iter.Value(), &capOwners); err != nil {
+			iter.Close()
+			return err
+		}
+
+		indexes = append(indexes, types.IndexFromKey(iter.Key()))
+		owners = append(owners, capOwners)
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for i, index := range indexes {
+		cap := types.NewCapability(index)
+		for _, owner := range owners[i].Owners {
+			store.Set(types.FwdCapabilityKey(owner.Module, cap), []byte(owner.Name))
+			store.Set(types.RevCapabilityKey(owner.Module, owner.Name), sdk.Uint64ToBigEndian(index))
+		}
+	}
+
+	return nil
+}
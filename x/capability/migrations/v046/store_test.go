@@ -0,0 +1,50 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	v046 "github.com/cosmos/cosmos-sdk/x/capability/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	cdc := app.AppCodec()
+	capKey := app.GetKey(types.StoreKey)
+
+	// the v1 state only holds the owners of the capabilities
+	k := keeper.NewKeeper(cdc, capKey)
+	k.SetOwners(ctx, 1, types.CapabilityOwners{Owners: []types.Owner{
+		types.NewOwner("bank", "transfer"),
+		types.NewOwner("staking", "transfer"),
+	}})
+	k.SetOwners(ctx, 2, types.CapabilityOwners{Owners: []types.Owner{types.NewOwner("staking", "ica")}})
+
+	require.NoError(t, v046.MigrateStore(ctx, capKey, cdc))
+
+	bankKeeper := k.ScopeToModule("bank")
+	stakingKeeper := k.ScopeToModule("staking")
+	for _, tc := range []struct {
+		sk    keeper.ScopedKeeper
+		name  string
+		index uint64
+	}{
+		{bankKeeper, "transfer", 1},
+		{stakingKeeper, "transfer", 1},
+		{stakingKeeper, "ica", 2},
+	} {
+		cap, ok := tc.sk.GetCapability(ctx, tc.name)
+		require.True(t, ok, tc.name)
+		require.Equal(t, tc.index, cap.GetIndex())
+		require.True(t, tc.sk.AuthenticateCapability(ctx, cap, tc.name))
+	}
+
+	_, ok := bankKeeper.GetCapability(ctx, "ica")
+	require.False(t, ok)
+}
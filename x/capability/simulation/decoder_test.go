@@ -31,6 +31,14 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.KeyPrefixIndexCapability,
 				Value: cdc.MustMarshal(&capOwners),
 			},
+			{
+				Key:   types.FwdCapabilityKey("transfer", types.NewCapability(10)),
+				Value: []byte("ports/transfer"),
+			},
+			{
+				Key:   types.RevCapabilityKey("transfer", "ports/transfer"),
+				Value: sdk.Uint64ToBigEndian(10),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -43,6 +51,8 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"Index", "Index A: 10\nIndex B: 10\n"},
 		{"CapabilityOwners", fmt.Sprintf("CapabilityOwners A: %v\nCapabilityOwners B: %v\n", capOwners, capOwners)},
+		{"Name", "Name A: ports/transfer\nName B: ports/transfer\n"},
+		{"Reverse index", "Index A: 10\nIndex B: 10\n"},
 		{"other", ""},
 	}
 
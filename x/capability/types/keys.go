@@ -12,9 +12,6 @@ const (
 
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
-
-	// MemStoreKey defines the in-memory store key
-	MemStoreKey = "mem_capability"
 )
 
 var (
@@ -25,9 +22,6 @@ var (
 	// KeyPrefixIndexCapability defines a key prefix that stores index to capability
 	// name mappings.
 	KeyPrefixIndexCapability = []byte("capability_index")
-
-	// KeyMemInitialized defines the key that stores the initialized flag in the memory store
-	KeyMemInitialized = []byte("mem_initialized")
 )
 
 // RevCapabilityKey returns a reverse lookup key for a given module and capability
@@ -37,9 +31,9 @@ func RevCapabilityKey(module, name string) []byte {
 }
 
 // FwdCapabilityKey returns a forward lookup key for a given module and capability
-// reference.
+// index.
 func FwdCapabilityKey(module string, cap *Capability) []byte {
-	return []byte(fmt.Sprintf("%s/fwd/%p", module, cap))
+	return append([]byte(fmt.Sprintf("%s/fwd/", module)), IndexToKey(cap.GetIndex())...)
 }
 
 // IndexToKey returns bytes to be used as a key for a given capability index.
@@ -13,6 +13,10 @@ import (
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	if period := k.AsyncInvCheckPeriod(); period != 0 && ctx.BlockHeight()%int64(period) == 0 {
+		k.CheckInvariantsAsync(ctx)
+	}
+
 	if k.InvCheckPeriod() == 0 || ctx.BlockHeight()%int64(k.InvCheckPeriod()) != 0 {
 		// skip running the invariant check
 		return
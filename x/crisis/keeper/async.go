@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// StoreVersionLoader returns a branch of the committed multi-store at the
+// given version which can be read concurrently with block execution, e.g.
+// rootmulti.Store.IsolatedCacheMultiStoreWithVersion.
+type StoreVersionLoader func(version int64) (sdk.CacheMultiStore, error)
+
+type asyncInvariantCheck struct {
+	period    uint
+	loadStore StoreVersionLoader
+	running   int32 // set while a check is in progress
+}
+
+// SetAsyncInvariantCheck enables the asynchronous invariant checks, which run
+// all registered invariants every period blocks against a branch of the last
+// committed state loaded with loadStore. Broken invariants are logged and
+// reported via telemetry instead of halting the node.
+func (k *Keeper) SetAsyncInvariantCheck(period uint, loadStore StoreVersionLoader) {
+	k.asyncCheck = &asyncInvariantCheck{
+		period:    period,
+		loadStore: loadStore,
+	}
+}
+
+// AsyncInvCheckPeriod returns the asynchronous invariant checks period, 0 if
+// they are disabled.
+func (k Keeper) AsyncInvCheckPeriod() uint {
+	if k.asyncCheck == nil {
+		return 0
+	}
+
+	return k.asyncCheck.period
+}
+
+// CheckInvariants runs all registered invariants and returns the descriptions
+// of the broken ones, without halting.
+func (k Keeper) CheckInvariants(ctx sdk.Context) []string {
+	var broken []string
+	for _, ir := range k.Routes() {
+		if res, stop := ir.Invar(ctx); stop {
+			broken = append(broken, res)
+
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, "invariant", "broken"},
+				1,
+				[]metrics.Label{telemetry.NewLabel("route", ir.FullRoute())},
+			)
+		}
+	}
+
+	return broken
+}
+
+// CheckInvariantsAsync checks all registered invariants in the background
+// against the state committed by the previous block, so that the check does
+// not delay block execution nor observe uncommitted writes. The state is loaded
+// before returning, the background check only reads the loaded branch. The
+// returned channel is closed once the check is done. It returns nil if the
+// checks are disabled or a previous check is still in progress.
+func (k Keeper) CheckInvariantsAsync(ctx sdk.Context) <-chan struct{} {
+	check := k.asyncCheck
+	version := ctx.BlockHeight() - 1
+	if check == nil || version < 1 || !atomic.CompareAndSwapInt32(&check.running, 0, 1) {
+		return nil
+	}
+
+	logger := k.Logger(ctx)
+	header := ctx.BlockHeader()
+	header.Height = version
+
+	ms, err := check.loadStore(version)
+	if err != nil {
+		atomic.StoreInt32(&check.running, 0)
+		logger.Error("failed to load state for asynchronous invariant check", "height", version, "err", err)
+		return nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer atomic.StoreInt32(&check.running, 0)
+		defer func() {
+			// invariants are not expected to be run concurrently with block
+			// execution, so a panic must not crash the node
+			if r := recover(); r != nil {
+				logger.Error("asynchronous invariant check panicked", "height", version, "err", r)
+			}
+		}()
+
+		start := time.Now()
+		checkCtx := sdk.NewContext(ms, header, false, logger)
+		for _, res := range k.CheckInvariants(checkCtx) {
+			logger.Error(fmt.Sprintf("invariant broken: %s", res), "height", version)
+		}
+
+		logger.Info("checked all invariants asynchronously", "duration", time.Since(start), "height", version)
+	}()
+
+	return done
+}
@@ -20,6 +20,8 @@ type Keeper struct {
 	supplyKeeper types.SupplyKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	asyncCheck *asyncInvariantCheck
 }
 
 // NewKeeper creates a new Keeper object
@@ -1,14 +1,22 @@
 package keeper_test
 
 import (
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 func TestLogger(t *testing.T) {
@@ -44,3 +52,81 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestCheckInvariantsAsync(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+
+	// disabled by default
+	require.Zero(t, app.CrisisKeeper.AsyncInvCheckPeriod())
+	require.Nil(t, app.CrisisKeeper.CheckInvariantsAsync(ctx))
+
+	app.CrisisKeeper.SetAsyncInvariantCheck(10, app.CommitMultiStore().(*rootmulti.Store).IsolatedCacheMultiStoreWithVersion)
+	require.Equal(t, uint(10), app.CrisisKeeper.AsyncInvCheckPeriod())
+
+	var checkedHeight int64
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(ctx sdk.Context) (string, bool) {
+		checkedHeight = ctx.BlockHeight()
+		return "testRoute broken", true
+	})
+	require.Equal(t, []string{"testRoute broken"}, app.CrisisKeeper.CheckInvariants(ctx))
+
+	// the check runs against the last committed state and does not panic
+	done := app.CrisisKeeper.CheckInvariantsAsync(ctx)
+	require.NotNil(t, done)
+	<-done
+	require.Equal(t, app.LastBlockHeight(), checkedHeight)
+}
+
+// TestCheckInvariantsAsyncDuringDeliverTx runs the asynchronous checks while
+// txs are delivered, for the race detector to catch any state shared with
+// block execution.
+func TestCheckInvariantsAsyncDuringDeliverTx(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	app := simapp.SetupWithGenesisAccounts(t, []authtypes.GenesisAccount{authtypes.NewBaseAccountWithAddress(addr)}, banktypes.Balance{
+		Address: addr.String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)),
+	})
+	app.SetCommitMultiStoreTracer(io.Discard)
+	// the period is long enough for the EndBlocker not to start checks itself
+	app.CrisisKeeper.SetAsyncInvariantCheck(100, app.CommitMultiStore().(*rootmulti.Store).IsolatedCacheMultiStoreWithVersion)
+
+	// the check also reads the stores which aren't versioned
+	tkey := app.GetTKey(paramstypes.TStoreKey)
+	memKey := app.GetMemKey("testingkey")
+	app.CrisisKeeper.RegisterRoute("testModule", "allStores", func(ctx sdk.Context) (string, bool) {
+		for _, key := range []storetypes.StoreKey{tkey, memKey} {
+			iter := ctx.KVStore(key).Iterator(nil, nil)
+			for ; iter.Valid(); iter.Next() {
+			}
+			iter.Close()
+		}
+		return "", false
+	})
+
+	txCfg := simapp.MakeTestEncodingConfig().TxConfig
+	accNum := app.AccountKeeper.GetAccount(app.NewContext(true, tmproto.Header{}), addr).GetAccountNumber()
+	for seq := uint64(0); seq < 5; seq++ {
+		header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		done := app.CrisisKeeper.CheckInvariantsAsync(app.NewContext(false, header))
+		require.NotNil(t, done)
+
+		send := banktypes.NewMsgSend(addr, sdk.AccAddress([]byte("recipient")), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+		tx, err := helpers.GenTx(txCfg, []sdk.Msg{send}, sdk.NewCoins(), helpers.DefaultGenTxGas, "", []uint64{accNum}, []uint64{seq}, priv)
+		require.NoError(t, err)
+		bz, err := txCfg.TxEncoder()(tx)
+		require.NoError(t, err)
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: bz})
+		require.True(t, res.IsOK(), res.Log)
+
+		app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+		app.Commit()
+		<-done
+	}
+}
@@ -31,6 +31,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryCommissionWithdrawAddr(),
+		GetCmdQueryCommunityPoolSpends(),
 	)
 
 	return distQueryCmd
@@ -153,6 +155,52 @@ $ %s query distribution commission %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	return cmd
 }
 
+// GetCmdQueryCommissionWithdrawAddr implements the query validator commission
+// withdraw address command.
+func GetCmdQueryCommissionWithdrawAddr() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "commission-withdraw-addr [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the address the commission of a validator is withdrawn to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the address the commission of a validator is withdrawn to.
+
+Example:
+$ %s query distribution commission-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorCommissionWithdrawAddress(
+				cmd.Context(),
+				&types.QueryValidatorCommissionWithdrawAddressRequest{ValidatorAddress: validatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryValidatorSlashes implements the query validator slashes command.
 func GetCmdQueryValidatorSlashes() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
@@ -319,3 +367,45 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryCommunityPoolSpends returns the command for fetching the history
+// of the community pool spends.
+func GetCmdQueryCommunityPoolSpends() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-spends",
+		Args:  cobra.NoArgs,
+		Short: "Query the history of the community pool spends",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the history of the spends of the community pool, ordered by id.
+
+Example:
+$ %s query distribution community-pool-spends
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CommunityPoolSpends(cmd.Context(), &types.QueryCommunityPoolSpendsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "community pool spends")
+	return cmd
+}
@@ -40,7 +40,9 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawRewardsCmd(),
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
+		NewSetCommissionWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewWithdrawTokenizeShareRecordRewardCmd(),
 	)
 
 	return distTxCmd
@@ -221,6 +223,45 @@ $ %s tx distribution set-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 	return cmd
 }
 
+func NewSetCommissionWithdrawAddrCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-commission-withdraw-addr [withdraw-addr]",
+		Short: "change the withdraw address for the commission of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the withdraw address for the commission of the validator of the sender, separately from
+the withdraw address of its self-delegation rewards.
+
+Example:
+$ %s tx distribution set-commission-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+			withdrawAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCommissionWithdrawAddress(valAddr, withdrawAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewFundCommunityPoolCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "fund-community-pool [amount]",
@@ -257,6 +298,38 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 	return cmd
 }
 
+func NewWithdrawTokenizeShareRecordRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-tokenize-share-rewards",
+		Args:  cobra.NoArgs,
+		Short: "Withdraw the rewards of the tokenize share records owned by the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of the delegations tokenized into liquid staking shares,
+for all the tokenize share records owned by the sender.
+
+Example:
+$ %s tx distribution withdraw-tokenize-share-rewards --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -74,7 +74,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true}`,
+			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true,"fee_split":{"proposer":"0.010000000000000000","proposer_bonus":"0.040000000000000000","validators":"0.930000000000000000","community_pool":"0.020000000000000000"},"dust_threshold":[]}`,
 		},
 		{
 			"text output",
@@ -82,6 +82,12 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 			`base_proposer_reward: "0.010000000000000000"
 bonus_proposer_reward: "0.040000000000000000"
 community_tax: "0.020000000000000000"
+dust_threshold: []
+fee_split:
+  community_pool: "0.020000000000000000"
+  proposer: "0.010000000000000000"
+  proposer_bonus: "0.040000000000000000"
+  validators: "0.930000000000000000"
 withdraw_addr_enabled: true`,
 		},
 	}
@@ -10,9 +10,10 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// AllocateTokens handles distribution of the collected fees
-// bondedVotes is a list of (validator address, validator voted on last block flag) for all
-// validators in the bonded set.
+// AllocateTokens handles distribution of the collected fees, split between the
+// previous proposer, the validators and the community pool by the fee split
+// param. bondedVotes is a list of (validator address, validator voted on last
+// block flag) for all validators in the bonded set.
 func (k Keeper) AllocateTokens(
 	ctx sdk.Context, sumPreviousPrecommitPower, totalPreviousPower int64,
 	previousProposer sdk.ConsAddress, bondedVotes []abci.VoteInfo,
@@ -39,19 +40,21 @@ func (k Keeper) AllocateTokens(
 	if totalPreviousPower == 0 {
 		feePool.CommunityPool = feePool.CommunityPool.Add(feesCollected...)
 		k.SetFeePool(ctx, feePool)
+		emitFeeSplitEvent(ctx, nil, nil, feesCollected)
 		return
 	}
 
 	// calculate fraction votes
 	previousFractionVotes := sdk.NewDec(sumPreviousPrecommitPower).Quo(sdk.NewDec(totalPreviousPower))
 
-	// calculate previous proposer reward
-	baseProposerReward := k.GetBaseProposerReward(ctx)
-	bonusProposerReward := k.GetBonusProposerReward(ctx)
-	proposerMultiplier := baseProposerReward.Add(bonusProposerReward.MulTruncate(previousFractionVotes))
+	// calculate previous proposer reward, the part of the bonus it doesn't earn
+	// going to the validators
+	feeSplit := k.GetFeeSplit(ctx)
+	proposerMultiplier := feeSplit.Proposer.Add(feeSplit.ProposerBonus.MulTruncate(previousFractionVotes))
 	proposerReward := feesCollected.MulDecTruncate(proposerMultiplier)
 
 	// pay previous proposer
+	var paidToProposer, paidToValidators sdk.DecCoins
 	remaining := feesCollected
 	proposerValidator := k.stakingKeeper.ValidatorByConsAddr(ctx, previousProposer)
 
@@ -66,6 +69,7 @@ func (k Keeper) AllocateTokens(
 
 		k.AllocateTokensToValidator(ctx, proposerValidator, proposerReward)
 		remaining = remaining.Sub(proposerReward)
+		paidToProposer = proposerReward
 	} else {
 		// previous proposer can be unknown if say, the unbonding period is 1 block, so
 		// e.g. a validator undelegates at block X, it's removed entirely by
@@ -80,8 +84,7 @@ func (k Keeper) AllocateTokens(
 	}
 
 	// calculate fraction allocated to validators
-	communityTax := k.GetCommunityTax(ctx)
-	voteMultiplier := sdk.OneDec().Sub(proposerMultiplier).Sub(communityTax)
+	voteMultiplier := sdk.OneDec().Sub(proposerMultiplier).Sub(feeSplit.CommunityPool)
 
 	// allocate tokens proportionally to voting power
 	// TODO consider parallelizing later, ref https://github.com/cosmos/cosmos-sdk/pull/3099#discussion_r246276376
@@ -94,11 +97,27 @@ func (k Keeper) AllocateTokens(
 		reward := feesCollected.MulDecTruncate(voteMultiplier).MulDecTruncate(powerFraction)
 		k.AllocateTokensToValidator(ctx, validator, reward)
 		remaining = remaining.Sub(reward)
+		paidToValidators = paidToValidators.Add(reward...)
 	}
 
 	// allocate community funding
 	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
 	k.SetFeePool(ctx, feePool)
+	emitFeeSplitEvent(ctx, paidToProposer, paidToValidators, remaining)
+}
+
+// emitFeeSplitEvent emits the fee_split event of the amounts of the collected
+// fees allocated to the proposer, to the validators in proportion to their
+// voting power and to the community pool.
+func emitFeeSplitEvent(ctx sdk.Context, proposer, validators, communityPool sdk.DecCoins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeSplit,
+			sdk.NewAttribute(types.AttributeKeyProposerAmount, proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyValidatorsAmount, validators.String()),
+			sdk.NewAttribute(types.AttributeKeyCommunityPoolAmount, communityPool.String()),
+		),
+	)
 }
 
 // AllocateTokensToValidator allocate tokens to a particular validator, splitting according to commission
@@ -195,3 +195,49 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[1]).Rewards.IsValid())
 	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[2]).Rewards.IsValid())
 }
+
+func TestAllocateTokensFeeSplit(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.DistrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	// 10% to the proposer, plus up to 20% of bonus, 50% to the validators and
+	// 20% to the community pool
+	params := app.DistrKeeper.GetParams(ctx)
+	params.FeeSplit = disttypes.NewFeeSplit(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(2, 1))
+	app.DistrKeeper.SetParams(ctx, params)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1234))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.CreateValidator(valAddrs[1], valConsPk2, sdk.NewInt(100), true)
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.FeeCollectorName, fees))
+
+	// the proposer only included the precommit of the first validator
+	votes := []abci.VoteInfo{
+		{Validator: abci.Validator{Address: valConsPk1.Address(), Power: 100}, SignedLastBlock: true},
+		{Validator: abci.Validator{Address: valConsPk2.Address(), Power: 100}, SignedLastBlock: false},
+	}
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.AllocateTokens(ctx, 100, 200, valConsAddr2, votes)
+
+	// the proposer earns half of the bonus: (10% + 20% * 0.5) * 100 = 20, and
+	// the validators share the unearned bonus: (50% + 10%) * 100 / 2 = 30
+	stake := func(amt int64) sdk.DecCoins { return sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, amt)} }
+	require.Equal(t, stake(30), app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[0]).Rewards)
+	require.Equal(t, stake(50), app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[1]).Rewards)
+	require.Equal(t, stake(20), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+
+	events := ctx.EventManager().Events()
+	last := events[len(events)-1]
+	require.Equal(t, disttypes.EventTypeFeeSplit, last.Type)
+	require.Equal(t, []abci.EventAttribute{
+		sdk.NewAttribute(disttypes.AttributeKeyProposerAmount, stake(20).String()).ToKVPair(),
+		sdk.NewAttribute(disttypes.AttributeKeyValidatorsAmount, stake(60).String()).ToKVPair(),
+		sdk.NewAttribute(disttypes.AttributeKeyCommunityPoolAmount, stake(20).String()).ToKVPair(),
+	}, last.Attributes)
+}
@@ -164,6 +164,9 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.Vali
 	// truncate coins, return remainder to community pool
 	coins, remainder := rewards.TruncateDecimal()
 
+	// sweep the rewards below the dust threshold into the community pool
+	coins, dust := types.SplitDust(coins, k.GetDustThreshold(ctx))
+
 	// add coins to user account
 	if !coins.IsZero() {
 		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
@@ -178,6 +181,18 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.Vali
 	k.SetValidatorOutstandingRewards(ctx, del.GetValidatorAddr(), types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(rewards)})
 	feePool := k.GetFeePool(ctx)
 	feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
+	if !dust.IsZero() {
+		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(dust...)...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSweepDust,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, dust.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, del.GetValidatorAddr().String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, del.GetDelegatorAddr().String()),
+			),
+		)
+	}
 	k.SetFeePool(ctx, feePool)
 
 	// decrement reference count of starting period
@@ -345,6 +345,54 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	)
 }
 
+func TestWithdrawDelegationRewardsDust(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	balanceTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens), sdk.NewInt64Coin("photon", 100))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// sweep the photon rewards below 2photon
+	params := app.DistrKeeper.GetParams(ctx)
+	params.DustThreshold = sdk.NewCoins(sdk.NewInt64Coin("photon", 2))
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// create validator with 50% commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+
+	// allocate some rewards, the delegator gets half of them
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(
+		sdk.NewDecCoin(sdk.DefaultBondDenom, initial),
+		sdk.NewInt64DecCoin("photon", 3),
+	))
+
+	balanceBefore := app.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(valAddrs[0]))
+	communityPoolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	rewards, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0])
+	require.NoError(t, err)
+
+	// the 1.5photon of rewards are swept into the community pool
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))), rewards)
+	require.Equal(t, balanceBefore.Add(rewards...), app.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(valAddrs[0])))
+	require.Equal(t,
+		communityPoolBefore.Add(sdk.NewDecCoinFromDec("photon", sdk.NewDecWithPrec(15, 1))),
+		app.DistrKeeper.GetFeePoolCommunityCoins(ctx),
+	)
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -702,3 +750,48 @@ func Test100PercentCommissionReward(t *testing.T) {
 	}
 	require.True(t, hasValue)
 }
+
+func TestWithdrawTokenizeShareRecordRewards(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with no commission and tokenize a delegation of the
+	// same size as its self-delegation
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.Delegate(addr[1], valAddrs[0], sdk.NewInt(100))
+
+	_, _, err := app.StakingKeeper.TokenizeShares(ctx, addr[1], valAddrs[0], sdk.NewDec(100), addr[2])
+	require.NoError(t, err)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(20))})
+
+	// the rewards of the tokenized delegation go to the owner of the record
+	balance := app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom)
+	rewards, err := app.DistrKeeper.WithdrawTokenizeShareRecordRewards(ctx, addr[2])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))), rewards)
+	require.Equal(t, balance.Add(rewards[0]), app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom))
+
+	// nothing is left to withdraw
+	rewards, err = app.DistrKeeper.WithdrawTokenizeShareRecordRewards(ctx, addr[2])
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -28,3 +29,29 @@ func (k Keeper) DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receive
 	k.SetFeePool(ctx, feePool)
 	return nil
 }
+
+// SpendCommunityPool sends coins from the community pool to a recipient and
+// records the spend in the history of the community pool spends.
+func (k Keeper) SpendCommunityPool(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins) (types.CommunityPoolSpend, error) {
+	if k.blockedAddrs[recipient.String()] {
+		return types.CommunityPoolSpend{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient)
+	}
+
+	if err := k.DistributeFromFeePool(ctx, amount, recipient); err != nil {
+		return types.CommunityPoolSpend{}, err
+	}
+
+	spend := types.CommunityPoolSpend{
+		Id:        k.GetLastCommunityPoolSpendID(ctx) + 1,
+		Recipient: recipient.String(),
+		Amount:    amount,
+		Height:    ctx.BlockHeight(),
+		Time:      ctx.BlockTime(),
+	}
+	k.SetCommunityPoolSpend(ctx, spend)
+	k.SetLastCommunityPoolSpendID(ctx, spend.Id)
+
+	k.Logger(ctx).Info("transferred from the community pool to recipient", "amount", amount.String(), "recipient", spend.Recipient)
+
+	return spend, nil
+}
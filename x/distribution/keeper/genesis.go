@@ -85,6 +85,25 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, cwi := range data.ValidatorCommissionWithdrawInfos {
+		valAddr, err := sdk.ValAddressFromBech32(cwi.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		withdrawAddress, err := sdk.AccAddressFromBech32(cwi.WithdrawAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorCommissionWithdrawAddr(ctx, valAddr, withdrawAddress)
+	}
+	var lastSpendID uint64
+	for _, spend := range data.CommunityPoolSpends {
+		k.SetCommunityPoolSpend(ctx, spend)
+		if spend.Id > lastSpendID {
+			lastSpendID = spend.Id
+		}
+	}
+	k.SetLastCommunityPoolSpendID(ctx, lastSpendID)
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -190,5 +209,22 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	spends := make([]types.CommunityPoolSpend, 0)
+	k.IterateCommunityPoolSpends(ctx,
+		func(spend types.CommunityPoolSpend) (stop bool) {
+			spends = append(spends, spend)
+			return false
+		},
+	)
+
+	cwis := make([]types.ValidatorCommissionWithdrawInfo, 0)
+	k.IterateValidatorCommissionWithdrawAddrs(ctx, func(val sdk.ValAddress, addr sdk.AccAddress) (stop bool) {
+		cwis = append(cwis, types.ValidatorCommissionWithdrawInfo{
+			ValidatorAddress: val.String(),
+			WithdrawAddress:  addr.String(),
+		})
+		return false
+	})
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, spends, cwis)
 }
@@ -241,6 +241,26 @@ func (k Keeper) DelegatorWithdrawAddress(c context.Context, req *types.QueryDele
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
 }
 
+// ValidatorCommissionWithdrawAddress queries the commission withdraw address of a validator
+func (k Keeper) ValidatorCommissionWithdrawAddress(c context.Context, req *types.QueryValidatorCommissionWithdrawAddressRequest) (*types.QueryValidatorCommissionWithdrawAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	withdrawAddr := k.GetValidatorCommissionWithdrawAddr(ctx, valAddr)
+
+	return &types.QueryValidatorCommissionWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
+}
+
 // CommunityPool queries the community pool coins
 func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -248,3 +268,29 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// CommunityPoolSpends queries the history of the community pool spends
+func (k Keeper) CommunityPoolSpends(c context.Context, req *types.QueryCommunityPoolSpendsRequest) (*types.QueryCommunityPoolSpendsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	spendsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CommunityPoolSpendPrefix)
+
+	spends := make([]types.CommunityPoolSpend, 0)
+	pageRes, err := query.Paginate(spendsStore, req.Pagination, func(_ []byte, value []byte) error {
+		var spend types.CommunityPoolSpend
+		if err := k.cdc.Unmarshal(value, &spend); err != nil {
+			return err
+		}
+
+		spends = append(spends, spend)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCommunityPoolSpendsResponse{Spends: spends, Pagination: pageRes}, nil
+}
@@ -75,6 +75,7 @@ func (suite *KeeperTestSuite) TestGRPCParams() {
 					BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
 					BonusProposerReward: sdk.NewDecWithPrec(1, 1),
 					WithdrawAddrEnabled: true,
+					FeeSplit:            types.NewFeeSplit(sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(3, 1)),
 				}
 
 				app.DistrKeeper.SetParams(ctx, params)
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -44,8 +45,7 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 
 		// add to validator account
 		if !coins.IsZero() {
-			accAddr := sdk.AccAddress(valAddr)
-			withdrawAddr := h.k.GetDelegatorWithdrawAddr(ctx, accAddr)
+			withdrawAddr := h.k.GetValidatorCommissionWithdrawAddr(ctx, valAddr)
 
 			if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins); err != nil {
 				return err
@@ -66,6 +66,9 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 	// remove commission record
 	h.k.DeleteValidatorAccumulatedCommission(ctx, valAddr)
 
+	// remove commission withdraw address
+	h.k.DeleteValidatorCommissionWithdrawAddr(ctx, valAddr)
+
 	// clear slashes
 	h.k.DeleteValidatorSlashEvents(ctx, valAddr)
 
@@ -116,6 +119,9 @@ func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValA
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
 	return nil
 }
+func (h Hooks) AfterConsensusPubKeyRotated(_ sdk.Context, _, _ cryptotypes.PubKey, _ sdk.ValAddress) error {
+	return nil
+}
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
 	return nil
 }
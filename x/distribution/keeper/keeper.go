@@ -25,13 +25,17 @@ type Keeper struct {
 	blockedAddrs map[string]bool
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// the address allowed to execute MsgCommunityPoolSpend. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a new distribution Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
-	feeCollectorName string, blockedAddrs map[string]bool,
+	feeCollectorName string, blockedAddrs map[string]bool, authority string,
 ) Keeper {
 
 	// ensure distribution module account is set
@@ -53,9 +57,15 @@ func NewKeeper(
 		stakingKeeper:    sk,
 		feeCollectorName: feeCollectorName,
 		blockedAddrs:     blockedAddrs,
+		authority:        authority,
 	}
 }
 
+// GetAuthority returns the address allowed to execute MsgCommunityPoolSpend.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -82,6 +92,35 @@ func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, w
 	return nil
 }
 
+// SetCommissionWithdrawAddr sets a new address that will receive the commission
+// of a validator upon withdrawal, separately from the withdraw address of the
+// rewards of its self-delegation. Until it is set, the commission is withdrawn
+// to the withdraw address of the operator.
+func (k Keeper) SetCommissionWithdrawAddr(ctx sdk.Context, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) error {
+	if k.blockedAddrs[withdrawAddr.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", withdrawAddr)
+	}
+
+	if !k.GetWithdrawAddrEnabled(ctx) {
+		return types.ErrSetWithdrawAddrDisabled
+	}
+
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return types.ErrNoValidatorExists
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCommissionWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddr.String()),
+		),
+	)
+
+	k.SetValidatorCommissionWithdrawAddr(ctx, valAddr, withdrawAddr)
+	return nil
+}
+
 // withdraw rewards from a delegation
 func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	val := k.stakingKeeper.Validator(ctx, valAddr)
@@ -121,6 +160,46 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawTokenizeShareRecordRewards withdraws the rewards of the delegations
+// of the tokenize share records of the given owner, and sends them along with
+// the rewards already withdrawn to the module accounts of the records to the
+// owner.
+func (k Keeper) WithdrawTokenizeShareRecordRewards(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	totalRewards := sdk.NewCoins()
+	for _, record := range k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner) {
+		moduleAddr := record.GetModuleAddress()
+		valAddr := record.GetValidatorAddress()
+
+		// the rewards are withdrawn to the module account of the record, as
+		// its withdraw address can't be changed
+		if k.stakingKeeper.Validator(ctx, valAddr) != nil && k.stakingKeeper.Delegation(ctx, moduleAddr, valAddr) != nil {
+			if _, err := k.WithdrawDelegationRewards(ctx, moduleAddr, valAddr); err != nil {
+				return nil, err
+			}
+		}
+
+		rewards := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+		if rewards.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, moduleAddr, owner, rewards); err != nil {
+			return nil, err
+		}
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareReward,
+			sdk.NewAttribute(types.AttributeKeyRecordOwner, owner.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, totalRewards.String()),
+		),
+	)
+
+	return totalRewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -137,8 +216,7 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(sdk.NewDecCoinsFromCoins(commission...))})
 
 	if !commission.IsZero() {
-		accAddr := sdk.AccAddress(valAddr)
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
+		withdrawAddr := k.GetValidatorCommissionWithdrawAddr(ctx, valAddr)
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
 		if err != nil {
 			return nil, err
@@ -182,3 +260,26 @@ func (k Keeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.
 
 	return nil
 }
+
+// FundCommunityPoolBatch funds the community fund pool with the deposits of
+// several accounts, updating the pool once. An error is returned if any of the
+// deposits cannot be sent to the module account.
+func (k Keeper) FundCommunityPoolBatch(ctx sdk.Context, deposits []types.CommunityPoolDeposit) error {
+	total := sdk.NewCoins()
+	for _, deposit := range deposits {
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, deposit.Amount); err != nil {
+			return err
+		}
+		total = total.Add(deposit.Amount...)
+	}
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(total...)...)
+	k.SetFeePool(ctx, feePool)
+
+	return nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -9,8 +10,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -129,3 +133,129 @@ func TestFundCommunityPool(t *testing.T) {
 	assert.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
 	assert.Empty(t, app.BankKeeper.GetAllBalances(ctx, addr[0]))
 }
+
+func TestFundCommunityPoolBatch(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// reset fee pool
+	app.DistrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.ZeroInt())
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, addr[0], amount))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, addr[1], amount))
+
+	deposits := []types.CommunityPoolDeposit{
+		{Depositor: addr[0].String(), Amount: amount},
+		{Depositor: addr[1].String(), Amount: amount},
+	}
+	require.NoError(t, app.DistrKeeper.FundCommunityPoolBatch(ctx, deposits))
+
+	require.Equal(t, sdk.NewDecCoinsFromCoins(amount.Add(amount...)...), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, addr[0]).IsZero())
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, addr[1]).IsZero())
+
+	// the pool isn't updated if a deposit can't be sent
+	require.Error(t, app.DistrKeeper.FundCommunityPoolBatch(ctx, deposits))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(amount.Add(amount...)...), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+}
+
+func TestCommunityPoolSpend(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Unix(1000, 0).UTC()})
+
+	// reset fee pool
+	app.DistrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.ZeroInt())
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, addr[0], amount))
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, amount, addr[0]))
+
+	msgServer := keeper.NewMsgServerImpl(app.DistrKeeper)
+	spent := sdk.NewCoins(sdk.NewInt64Coin("stake", 40))
+
+	// only the authority can spend the community pool
+	_, err := msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(addr[0].String(), addr[1], spent))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	res, err := msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(app.DistrKeeper.GetAuthority(), addr[1], spent))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Id)
+
+	require.Equal(t, spent, app.BankKeeper.GetAllBalances(ctx, addr[1]))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(amount.Sub(spent)...), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+
+	spend, found := app.DistrKeeper.GetCommunityPoolSpend(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, types.CommunityPoolSpend{
+		Id:        1,
+		Recipient: addr[1].String(),
+		Amount:    spent,
+		Height:    10,
+		Time:      time.Unix(1000, 0).UTC(),
+	}, spend)
+
+	// spending more than the community pool fails
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(app.DistrKeeper.GetAuthority(), addr[1], amount))
+	require.ErrorIs(t, err, types.ErrBadDistribution)
+	require.Equal(t, uint64(1), app.DistrKeeper.GetLastCommunityPoolSpendID(ctx))
+}
+
+func TestSetCommissionWithdrawAddr(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	// the commission withdraw address can only be set for a validator
+	require.ErrorIs(t, app.DistrKeeper.SetCommissionWithdrawAddr(ctx, valAddrs[0], addr[2]), types.ErrNoValidatorExists)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	require.Equal(t, addr[0], app.DistrKeeper.GetValidatorCommissionWithdrawAddr(ctx, valAddrs[0]))
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.WithdrawAddrEnabled = false
+	app.DistrKeeper.SetParams(ctx, params)
+	require.ErrorIs(t, app.DistrKeeper.SetCommissionWithdrawAddr(ctx, valAddrs[0], addr[2]), types.ErrSetWithdrawAddrDisabled)
+
+	params.WithdrawAddrEnabled = true
+	app.DistrKeeper.SetParams(ctx, params)
+	// the commission follows the withdraw address of the operator until the
+	// commission withdraw address is set
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], addr[1]))
+	require.Equal(t, addr[1], app.DistrKeeper.GetValidatorCommissionWithdrawAddr(ctx, valAddrs[0]))
+	require.NoError(t, app.DistrKeeper.SetCommissionWithdrawAddr(ctx, valAddrs[0], addr[2]))
+	require.Equal(t, addr[2], app.DistrKeeper.GetValidatorCommissionWithdrawAddr(ctx, valAddrs[0]))
+
+	// set module account coins, outstanding rewards and commission
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2)))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), coins))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	valCommission := sdk.NewDecCoinsFromCoins(coins...)
+	app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddrs[0], types.ValidatorOutstandingRewards{Rewards: valCommission})
+	app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valAddrs[0], types.ValidatorAccumulatedCommission{Commission: valCommission})
+
+	// the commission goes to the commission withdraw address rather than the
+	// withdraw address of the operator
+	rewardsBalance := app.BankKeeper.GetBalance(ctx, addr[1], "stake")
+	commissionBalance := app.BankKeeper.GetBalance(ctx, addr[2], "stake")
+	_, err := app.DistrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, rewardsBalance, app.BankKeeper.GetBalance(ctx, addr[1], "stake"))
+	require.Equal(t, commissionBalance.Add(coins[0]), app.BankKeeper.GetBalance(ctx, addr[2], "stake"))
+
+	// the operator address can be set explicitly, regardless of the withdraw
+	// address of the operator
+	require.NoError(t, app.DistrKeeper.SetCommissionWithdrawAddr(ctx, valAddrs[0], addr[0]))
+	require.Equal(t, addr[0], app.DistrKeeper.GetValidatorCommissionWithdrawAddr(ctx, valAddrs[0]))
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], addr[2]))
+	require.Equal(t, addr[0], app.DistrKeeper.GetValidatorCommissionWithdrawAddr(ctx, valAddrs[0]))
+}
@@ -17,12 +17,16 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 }
 
 // GetCommunityTax returns the current distribution community tax.
+//
+// Deprecated: the community pool share of the fees is set by the fee split.
 func (k Keeper) GetCommunityTax(ctx sdk.Context) (percent sdk.Dec) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyCommunityTax, &percent)
 	return percent
 }
 
 // GetBaseProposerReward returns the current distribution base proposer rate.
+//
+// Deprecated: the proposer share of the fees is set by the fee split.
 func (k Keeper) GetBaseProposerReward(ctx sdk.Context) (percent sdk.Dec) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyBaseProposerReward, &percent)
 	return percent
@@ -30,6 +34,8 @@ func (k Keeper) GetBaseProposerReward(ctx sdk.Context) (percent sdk.Dec) {
 
 // GetBonusProposerReward returns the current distribution bonus proposer reward
 // rate.
+//
+// Deprecated: the proposer bonus share of the fees is set by the fee split.
 func (k Keeper) GetBonusProposerReward(ctx sdk.Context) (percent sdk.Dec) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyBonusProposerReward, &percent)
 	return percent
@@ -41,3 +47,16 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetDustThreshold returns the amounts, per denom, below which the rewards of a
+// delegation are swept into the community pool at withdrawal.
+func (k Keeper) GetDustThreshold(ctx sdk.Context) (dustThreshold sdk.Coins) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyDustThreshold, &dustThreshold)
+	return dustThreshold
+}
+
+// GetFeeSplit returns the current split of the collected fees.
+func (k Keeper) GetFeeSplit(ctx sdk.Context) (feeSplit types.FeeSplit) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyFeeSplit, &feeSplit)
+	return feeSplit
+}
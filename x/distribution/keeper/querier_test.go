@@ -134,6 +134,7 @@ func TestQueries(t *testing.T) {
 		BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
 		BonusProposerReward: sdk.NewDecWithPrec(1, 1),
 		WithdrawAddrEnabled: true,
+		FeeSplit:            types.NewFeeSplit(sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(3, 1)),
 	}
 
 	app.DistrKeeper.SetParams(ctx, params)
@@ -143,6 +144,7 @@ func TestQueries(t *testing.T) {
 	require.Equal(t, params.BaseProposerReward, paramsRes.BaseProposerReward)
 	require.Equal(t, params.BonusProposerReward, paramsRes.BonusProposerReward)
 	require.Equal(t, params.WithdrawAddrEnabled, paramsRes.WithdrawAddrEnabled)
+	require.Equal(t, params.FeeSplit, paramsRes.FeeSplit)
 
 	// test outstanding rewards query
 	outstandingRewards := sdk.DecCoins{{Denom: "mytoken", Amount: sdk.NewDec(3)}, {Denom: "myothertoken", Amount: sdk.NewDecWithPrec(3, 7)}}
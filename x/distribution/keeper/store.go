@@ -382,3 +382,88 @@ func (k Keeper) DeleteAllValidatorSlashEvents(ctx sdk.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// get the validator commission withdraw address, defaulting to the withdraw
+// address of the validator operator
+func (k Keeper) GetValidatorCommissionWithdrawAddr(ctx sdk.Context, valAddr sdk.ValAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValidatorCommissionWithdrawAddrKey(valAddr))
+	if b == nil {
+		return k.GetDelegatorWithdrawAddr(ctx, sdk.AccAddress(valAddr))
+	}
+	return sdk.AccAddress(b)
+}
+
+// set the validator commission withdraw address
+func (k Keeper) SetValidatorCommissionWithdrawAddr(ctx sdk.Context, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorCommissionWithdrawAddrKey(valAddr), withdrawAddr.Bytes())
+}
+
+// delete a validator commission withdraw address
+func (k Keeper) DeleteValidatorCommissionWithdrawAddr(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorCommissionWithdrawAddrKey(valAddr))
+}
+
+// iterate over validator commission withdraw addrs
+func (k Keeper) IterateValidatorCommissionWithdrawAddrs(ctx sdk.Context, handler func(val sdk.ValAddress, addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorCommissionWithdrawAddrPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		addr := sdk.AccAddress(iter.Value())
+		val := types.GetValidatorCommissionWithdrawInfoAddress(iter.Key())
+		if handler(val, addr) {
+			break
+		}
+	}
+}
+
+// get the id of the last community pool spend
+func (k Keeper) GetLastCommunityPoolSpendID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.LastCommunityPoolSpendIDKey)
+	if b == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+// set the id of the last community pool spend
+func (k Keeper) SetLastCommunityPoolSpendID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastCommunityPoolSpendIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// get a community pool spend
+func (k Keeper) GetCommunityPoolSpend(ctx sdk.Context, id uint64) (spend types.CommunityPoolSpend, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetCommunityPoolSpendKey(id))
+	if b == nil {
+		return spend, false
+	}
+	k.cdc.MustUnmarshal(b, &spend)
+	return spend, true
+}
+
+// set a community pool spend
+func (k Keeper) SetCommunityPoolSpend(ctx sdk.Context, spend types.CommunityPoolSpend) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&spend)
+	store.Set(types.GetCommunityPoolSpendKey(spend.Id), b)
+}
+
+// iterate over the community pool spends, ordered by id
+func (k Keeper) IterateCommunityPoolSpends(ctx sdk.Context, handler func(spend types.CommunityPoolSpend) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.CommunityPoolSpendPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var spend types.CommunityPoolSpend
+		k.cdc.MustUnmarshal(iter.Value(), &spend)
+		if handler(spend) {
+			break
+		}
+	}
+}
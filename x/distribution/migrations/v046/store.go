@@ -0,0 +1,41 @@
+package v046
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.46. The
+// migration includes:
+//
+// - Setting the FeeSplit param from the community tax and the base and bonus
+// proposer rewards, which it replaces, unless it was already set, e.g. by the
+// upgrade handler before running the migrations.
+// - Setting the DustThreshold param to an empty threshold, sweeping no
+// rewards, unless it was already set.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace) error {
+	migrateFeeSplit(ctx, paramSpace)
+	if !paramSpace.Has(ctx, types.ParamStoreKeyDustThreshold) {
+		paramSpace.Set(ctx, types.ParamStoreKeyDustThreshold, sdk.Coins(nil))
+	}
+
+	return nil
+}
+
+// migrateFeeSplit sets the fee split matching the deprecated community tax and
+// proposer rewards, the validators getting the rest of the fees as before.
+func migrateFeeSplit(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	if paramSpace.Has(ctx, types.ParamStoreKeyFeeSplit) {
+		return
+	}
+
+	var communityTax, baseProposerReward, bonusProposerReward sdk.Dec
+	paramSpace.Get(ctx, types.ParamStoreKeyCommunityTax, &communityTax)
+	paramSpace.Get(ctx, types.ParamStoreKeyBaseProposerReward, &baseProposerReward)
+	paramSpace.Get(ctx, types.ParamStoreKeyBonusProposerReward, &bonusProposerReward)
+
+	validators := sdk.OneDec().Sub(communityTax).Sub(baseProposerReward).Sub(bonusProposerReward)
+	paramSpace.Set(ctx, types.ParamStoreKeyFeeSplit, types.NewFeeSplit(baseProposerReward, bonusProposerReward, validators, communityTax))
+}
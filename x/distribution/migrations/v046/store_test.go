@@ -0,0 +1,76 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046distribution "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestFeeSplitMigration(t *testing.T) {
+	ctx, distributionKey, paramSpace := setupStores(t)
+
+	paramSpace.Set(ctx, types.ParamStoreKeyCommunityTax, sdk.NewDecWithPrec(3, 2))
+	paramSpace.Set(ctx, types.ParamStoreKeyBaseProposerReward, sdk.NewDecWithPrec(1, 2))
+	paramSpace.Set(ctx, types.ParamStoreKeyBonusProposerReward, sdk.NewDecWithPrec(5, 2))
+	require.False(t, paramSpace.Has(ctx, types.ParamStoreKeyFeeSplit))
+	require.False(t, paramSpace.Has(ctx, types.ParamStoreKeyDustThreshold))
+
+	require.NoError(t, v046distribution.MigrateStore(ctx, distributionKey, paramSpace))
+
+	// no rewards are swept
+	var dustThreshold sdk.Coins
+	paramSpace.Get(ctx, types.ParamStoreKeyDustThreshold, &dustThreshold)
+	require.True(t, dustThreshold.IsZero())
+
+	// the fees are split as before the migration
+	var feeSplit types.FeeSplit
+	paramSpace.Get(ctx, types.ParamStoreKeyFeeSplit, &feeSplit)
+	expected := types.NewFeeSplit(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(91, 2), sdk.NewDecWithPrec(3, 2))
+	require.Equal(t, expected, feeSplit)
+
+	// a fee split set before the migration is kept
+	paramSpace.Set(ctx, types.ParamStoreKeyFeeSplit, types.DefaultFeeSplit())
+	require.NoError(t, v046distribution.MigrateStore(ctx, distributionKey, paramSpace))
+	paramSpace.Get(ctx, types.ParamStoreKeyFeeSplit, &feeSplit)
+	require.Equal(t, types.DefaultFeeSplit(), feeSplit)
+}
+
+// setupStores returns a context with the distribution store and the params
+// store mounted, along with the distribution param subspace.
+func setupStores(t *testing.T) (sdk.Context, *storetypes.KVStoreKey, paramtypes.Subspace) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	distributionKey := sdk.NewKVStoreKey("distribution")
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(distributionKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(paramsKey, storetypes.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(paramsTKey, storetypes.StoreTypeTransient, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramSpace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// the params of the chain before the migration
+	params := types.DefaultParams()
+	paramSpace.Set(ctx, types.ParamStoreKeyCommunityTax, params.CommunityTax)
+	paramSpace.Set(ctx, types.ParamStoreKeyBaseProposerReward, params.BaseProposerReward)
+	paramSpace.Set(ctx, types.ParamStoreKeyBonusProposerReward, params.BonusProposerReward)
+	paramSpace.Set(ctx, types.ParamStoreKeyWithdrawAddrEnabled, params.WithdrawAddrEnabled)
+
+	return ctx, distributionKey, paramSpace
+}
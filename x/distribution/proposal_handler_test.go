@@ -52,6 +52,12 @@ func TestProposalHandlerPassed(t *testing.T) {
 
 	balances = app.BankKeeper.GetAllBalances(ctx, recipient)
 	require.Equal(t, balances, amount)
+
+	// the spend is recorded in the history of the community pool spends
+	spend, found := app.DistrKeeper.GetCommunityPoolSpend(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, recipient.String(), spend.Recipient)
+	require.Equal(t, amount, spend.Amount)
 }
 
 func TestProposalHandlerFailed(t *testing.T) {
@@ -63,6 +63,18 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.ValidatorCommissionWithdrawAddrPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.CommunityPoolSpendPrefix):
+			var spendA, spendB types.CommunityPoolSpend
+			cdc.MustUnmarshal(kvA.Value, &spendA)
+			cdc.MustUnmarshal(kvB.Value, &spendB)
+			return fmt.Sprintf("%v\n%v", spendA, spendB)
+
+		case bytes.Equal(kvA.Key[:1], types.LastCommunityPoolSpendIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
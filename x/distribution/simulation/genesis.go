@@ -35,6 +35,13 @@ func GenBonusProposerReward(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(1, 2).Add(sdk.NewDecWithPrec(int64(r.Intn(30)), 2))
 }
 
+// GenFeeSplit returns the fee split of the given community tax and proposer
+// rewards, the validators getting the rest of the fees.
+func GenFeeSplit(communityTax, baseProposerReward, bonusProposerReward sdk.Dec) types.FeeSplit {
+	validators := sdk.OneDec().Sub(communityTax).Sub(baseProposerReward).Sub(bonusProposerReward)
+	return types.NewFeeSplit(baseProposerReward, bonusProposerReward, validators, communityTax)
+}
+
 // GenWithdrawEnabled returns a randomized WithdrawEnabled parameter.
 func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
@@ -73,6 +80,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 			BaseProposerReward:  baseProposerReward,
 			BonusProposerReward: bonusProposerReward,
 			WithdrawAddrEnabled: withdrawEnabled,
+			FeeSplit:            GenFeeSplit(communityTax, baseProposerReward, bonusProposerReward),
 		},
 	}
 
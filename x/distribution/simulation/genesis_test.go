@@ -46,6 +46,7 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, dec1, distrGenesis.Params.BaseProposerReward)
 	require.Equal(t, dec2, distrGenesis.Params.BonusProposerReward)
 	require.Equal(t, dec3, distrGenesis.Params.CommunityTax)
+	require.Equal(t, types.NewFeeSplit(dec1, dec2, sdk.MustNewDecFromStr("0.610000000000000000"), dec3), distrGenesis.Params.FeeSplit)
 	require.Equal(t, true, distrGenesis.Params.WithdrawAddrEnabled)
 	require.Len(t, distrGenesis.DelegatorStartingInfos, 0)
 	require.Len(t, distrGenesis.DelegatorWithdrawInfos, 0)
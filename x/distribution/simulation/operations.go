@@ -222,8 +222,8 @@ func SimulateMsgFundCommunityPool(ak types.AccountKeeper, bk types.BankKeeper, k
 			err  error
 		)
 
-		coins, hasNeg := spendable.SafeSub(fundAmount)
-		if !hasNeg {
+		coins, err := spendable.SafeSub(fundAmount)
+		if err == nil {
 			fees, err = simtypes.RandomFees(r, ctx, coins)
 			if err != nil {
 				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgFundCommunityPool, "unable to generate fees"), nil, err
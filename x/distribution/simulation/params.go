@@ -12,29 +12,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
-const (
-	keyCommunityTax        = "communitytax"
-	keyBaseProposerReward  = "baseproposerreward"
-	keyBonusProposerReward = "bonusproposerreward"
-)
+const keyFeeSplit = "feesplit"
 
 // ParamChanges defines the parameters that can be modified by param change proposals
 // on the simulation
 func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 	return []simtypes.ParamChange{
-		simulation.NewSimParamChange(types.ModuleName, keyCommunityTax,
-			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenCommunityTax(r))
-			},
-		),
-		simulation.NewSimParamChange(types.ModuleName, keyBaseProposerReward,
-			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenBaseProposerReward(r))
-			},
-		),
-		simulation.NewSimParamChange(types.ModuleName, keyBonusProposerReward,
+		simulation.NewSimParamChange(types.ModuleName, keyFeeSplit,
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenBonusProposerReward(r))
+				feeSplit := GenFeeSplit(GenCommunityTax(r), GenBaseProposerReward(r), GenBonusProposerReward(r))
+				return fmt.Sprintf(
+					`{"proposer":"%s","proposer_bonus":"%s","validators":"%s","community_pool":"%s"}`,
+					feeSplit.Proposer, feeSplit.ProposerBonus, feeSplit.Validators, feeSplit.CommunityPool,
+				)
 			},
 		),
 	}
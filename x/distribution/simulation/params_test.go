@@ -19,14 +19,16 @@ func TestParamChanges(t *testing.T) {
 		simValue    string
 		subspace    string
 	}{
-		{"distribution/communitytax", "communitytax", "\"0.120000000000000000\"", "distribution"},
-		{"distribution/baseproposerreward", "baseproposerreward", "\"0.280000000000000000\"", "distribution"},
-		{"distribution/bonusproposerreward", "bonusproposerreward", "\"0.180000000000000000\"", "distribution"},
+		{
+			"distribution/feesplit", "feesplit",
+			`{"proposer":"0.280000000000000000","proposer_bonus":"0.180000000000000000","validators":"0.420000000000000000","community_pool":"0.120000000000000000"}`,
+			"distribution",
+		},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
 
-	require.Len(t, paramChanges, 3)
+	require.Len(t, paramChanges, 1)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
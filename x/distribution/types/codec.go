@@ -15,7 +15,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdrawDelegatorReward{}, "cosmos-sdk/MsgWithdrawDelegationReward", nil)
 	cdc.RegisterConcrete(&MsgWithdrawValidatorCommission{}, "cosmos-sdk/MsgWithdrawValidatorCommission", nil)
 	cdc.RegisterConcrete(&MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress", nil)
+	cdc.RegisterConcrete(&MsgSetCommissionWithdrawAddress{}, "cosmos-sdk/MsgSetCommissionWithdrawAddress", nil)
 	cdc.RegisterConcrete(&MsgFundCommunityPool{}, "cosmos-sdk/MsgFundCommunityPool", nil)
+	cdc.RegisterConcrete(&MsgWithdrawTokenizeShareRecordReward{}, "cosmos-sdk/MsgWithdrawTokenizeShareRecordReward", nil)
+	cdc.RegisterConcrete(&MsgCommunityPoolSpend{}, "cosmos-sdk/MsgCommunityPoolSpend", nil)
+	cdc.RegisterConcrete(&MsgFundCommunityPoolBatch{}, "cosmos-sdk/MsgFundCommunityPoolBatch", nil)
 	cdc.RegisterConcrete(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal", nil)
 }
 
@@ -25,7 +29,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdrawDelegatorReward{},
 		&MsgWithdrawValidatorCommission{},
 		&MsgSetWithdrawAddress{},
+		&MsgSetCommissionWithdrawAddress{},
 		&MsgFundCommunityPool{},
+		&MsgWithdrawTokenizeShareRecordReward{},
+		&MsgCommunityPoolSpend{},
+		&MsgFundCommunityPoolBatch{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,10 +32,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the set of params for the distribution module.
 type Params struct {
-	CommunityTax        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_tax"`
-	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward"`
+	// Deprecated: the community pool share of the fees is set by fee_split.
+	CommunityTax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_tax"`
+	// Deprecated: the proposer share of the fees is set by fee_split.
+	BaseProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward"`
+	// Deprecated: the proposer bonus share of the fees is set by fee_split.
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// fee_split is the split of the fees collected in a block between the
+	// proposer, the validators and the community pool.
+	FeeSplit FeeSplit `protobuf:"bytes,5,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split"`
+	// dust_threshold is the amount, per denom, below which the rewards of a
+	// delegation are swept into the community pool at withdrawal instead of being
+	// sent to the delegator.
+	DustThreshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=dust_threshold,json=dustThreshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"dust_threshold"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,6 +87,71 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetFeeSplit() FeeSplit {
+	if m != nil {
+		return m.FeeSplit
+	}
+	return FeeSplit{}
+}
+
+func (m *Params) GetDustThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DustThreshold
+	}
+	return nil
+}
+
+// FeeSplit defines the shares of the fees collected in a block paid to the
+// proposer of the block, to the validators, in proportion to their voting
+// power, and to the community pool. The shares sum to one.
+type FeeSplit struct {
+	// proposer is the share of the fees always paid to the proposer.
+	Proposer github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=proposer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proposer"`
+	// proposer_bonus is the maximum share of the fees paid to the proposer on top
+	// of the proposer share, scaled by the fraction of the voting power of the
+	// precommits it included. The part of the bonus it doesn't earn is paid to
+	// the validators.
+	ProposerBonus github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=proposer_bonus,json=proposerBonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proposer_bonus"`
+	// validators is the share of the fees paid to the validators, including the
+	// proposer, in proportion to their voting power.
+	Validators github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=validators,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validators"`
+	// community_pool is the share of the fees sent to the community pool.
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool"`
+}
+
+func (m *FeeSplit) Reset()         { *m = FeeSplit{} }
+func (m *FeeSplit) String() string { return proto.CompactTextString(m) }
+func (*FeeSplit) ProtoMessage()    {}
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{1}
+}
+func (m *FeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplit.Merge(m, src)
+}
+func (m *FeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplit proto.InternalMessageInfo
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -80,11 +159,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
@@ -94,7 +174,7 @@ func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalR
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{1}
+	return fileDescriptor_cd78a31ea281a992, []int{2}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,7 +229,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{2}
+	return fileDescriptor_cd78a31ea281a992, []int{3}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -202,7 +282,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{3}
+	return fileDescriptor_cd78a31ea281a992, []int{4}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -248,7 +328,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{4}
+	return fileDescriptor_cd78a31ea281a992, []int{5}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,7 +377,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{5}
+	return fileDescriptor_cd78a31ea281a992, []int{6}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +421,7 @@ type ValidatorSlashEvents struct {
 func (m *ValidatorSlashEvents) Reset()      { *m = ValidatorSlashEvents{} }
 func (*ValidatorSlashEvents) ProtoMessage() {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{6}
+	return fileDescriptor_cd78a31ea281a992, []int{7}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +466,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{7}
+	return fileDescriptor_cd78a31ea281a992, []int{8}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -435,7 +515,7 @@ type CommunityPoolSpendProposal struct {
 func (m *CommunityPoolSpendProposal) Reset()      { *m = CommunityPoolSpendProposal{} }
 func (*CommunityPoolSpendProposal) ProtoMessage() {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{8}
+	return fileDescriptor_cd78a31ea281a992, []int{9}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,6 +544,93 @@ func (m *CommunityPoolSpendProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposal proto.InternalMessageInfo
 
+// CommunityPoolSpend records a spend of the community pool, kept as the history
+// of the community pool spends.
+type CommunityPoolSpend struct {
+	// id is the sequential id of the spend.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the address of the account the coins were sent to.
+	Recipient string                                   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// height is the block height of the spend.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the spend.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *CommunityPoolSpend) Reset()         { *m = CommunityPoolSpend{} }
+func (m *CommunityPoolSpend) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolSpend) ProtoMessage()    {}
+func (*CommunityPoolSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{10}
+}
+func (m *CommunityPoolSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolSpend.Merge(m, src)
+}
+func (m *CommunityPoolSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolSpend proto.InternalMessageInfo
+
+// CommunityPoolDeposit is a deposit of coins into the community pool by a
+// depositor.
+type CommunityPoolDeposit struct {
+	Depositor string                                   `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *CommunityPoolDeposit) Reset()         { *m = CommunityPoolDeposit{} }
+func (m *CommunityPoolDeposit) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolDeposit) ProtoMessage()    {}
+func (*CommunityPoolDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{11}
+}
+func (m *CommunityPoolDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolDeposit.Merge(m, src)
+}
+func (m *CommunityPoolDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolDeposit proto.InternalMessageInfo
+
 // DelegatorStartingInfo represents the starting info for a delegator reward
 // period. It tracks the previous validator period, the delegation's amount of
 // staking token, and the creation height (to check later on if any slashes have
@@ -480,7 +647,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{9}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,7 +701,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{13}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,7 +744,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{14}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,6 +775,7 @@ var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageI
 
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
+	proto.RegisterType((*FeeSplit)(nil), "cosmos.distribution.v1beta1.FeeSplit")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
 	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewards")
 	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission")
@@ -616,6 +784,8 @@ func init() {
 	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvents")
 	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
+	proto.RegisterType((*CommunityPoolSpend)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpend")
+	proto.RegisterType((*CommunityPoolDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolDeposit")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
@@ -626,65 +796,80 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 922 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x7c, 0xe3, 0x38, 0xe9, 0x6b, 0x9b, 0x7c, 0x99, 0x38, 0xa9, 0xe3, 0x56, 0x76, 0xb4,
-	0x12, 0x10, 0x54, 0xc5, 0x69, 0xda, 0x5b, 0xc4, 0x25, 0x76, 0x82, 0xe0, 0xd4, 0x68, 0x83, 0x00,
-	0x71, 0x59, 0x8d, 0x77, 0x27, 0xf6, 0x28, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x9e, 0x39, 0xf0,
-	0xe3, 0x84, 0xc4, 0x05, 0x71, 0x40, 0x3d, 0x22, 0xce, 0xbd, 0x70, 0xe4, 0xd6, 0x63, 0xe9, 0x05,
-	0xc4, 0x21, 0xa0, 0x44, 0x48, 0x88, 0xbf, 0x02, 0xcd, 0xce, 0x78, 0xd7, 0x81, 0x50, 0xf5, 0x10,
-	0x8b, 0x93, 0x3d, 0xef, 0xcd, 0x7e, 0x7e, 0xbc, 0x79, 0xfb, 0x66, 0xa1, 0x13, 0x0a, 0x95, 0x08,
-	0xb5, 0x19, 0x31, 0xa5, 0x25, 0xeb, 0x67, 0x9a, 0x09, 0xbe, 0x39, 0xda, 0xea, 0x53, 0x4d, 0xb6,
-	0x2e, 0x04, 0x3b, 0xa9, 0x14, 0x5a, 0xe0, 0xdb, 0x76, 0x7f, 0xe7, 0x42, 0xca, 0xed, 0x6f, 0xd6,
-	0x07, 0x62, 0x20, 0xf2, 0x7d, 0x9b, 0xe6, 0x9f, 0x7d, 0xa4, 0xd9, 0x72, 0x14, 0x7d, 0xa2, 0x68,
-	0x01, 0x1d, 0x0a, 0xe6, 0x20, 0x9b, 0xab, 0x36, 0x1f, 0xd8, 0x07, 0x1d, 0x7e, 0xbe, 0xf0, 0x3e,
-	0x99, 0x81, 0xda, 0x3e, 0x91, 0x24, 0x51, 0x98, 0xc0, 0xcd, 0x50, 0x24, 0x49, 0xc6, 0x99, 0x7e,
-	0x14, 0x68, 0x72, 0xd2, 0x40, 0x6b, 0x68, 0xfd, 0x5a, 0xf7, 0xcd, 0xa7, 0xa7, 0xed, 0xca, 0x2f,
-	0xa7, 0xed, 0xd7, 0x06, 0x4c, 0x0f, 0xb3, 0x7e, 0x27, 0x14, 0x89, 0x83, 0x70, 0x3f, 0x1b, 0x2a,
-	0x3a, 0xda, 0xd4, 0x8f, 0x52, 0xaa, 0x3a, 0xbb, 0x34, 0x7c, 0xfe, 0x64, 0x03, 0x1c, 0xc3, 0x2e,
-	0x0d, 0xfd, 0x1b, 0x05, 0xe4, 0xbb, 0xe4, 0x04, 0x73, 0xa8, 0x1b, 0x8d, 0x46, 0x48, 0x2a, 0x14,
-	0x95, 0x81, 0xa4, 0xc7, 0x44, 0x46, 0x8d, 0xff, 0x5d, 0x01, 0x13, 0x36, 0xc8, 0xfb, 0x0e, 0xd8,
-	0xcf, 0x71, 0x71, 0x0a, 0xcb, 0x7d, 0xc1, 0x33, 0xf5, 0x0f, 0xc2, 0x99, 0x2b, 0x20, 0x5c, 0xca,
-	0xa1, 0xff, 0xc6, 0x78, 0x1f, 0x96, 0x8f, 0x99, 0x1e, 0x46, 0x92, 0x1c, 0x07, 0x24, 0x8a, 0x64,
-	0x40, 0x39, 0xe9, 0xc7, 0x34, 0x6a, 0x54, 0xd7, 0xd0, 0xfa, 0xbc, 0xbf, 0x34, 0x4e, 0xee, 0x44,
-	0x91, 0xdc, 0xb3, 0xa9, 0xed, 0xea, 0x57, 0x8f, 0xdb, 0x15, 0xef, 0x47, 0x04, 0xcd, 0xf7, 0x48,
-	0xcc, 0x22, 0xa2, 0x85, 0x7c, 0x9b, 0x29, 0x2d, 0x24, 0x0b, 0x49, 0x6c, 0x71, 0x15, 0xfe, 0x0c,
-	0xc1, 0xad, 0x30, 0x4b, 0xb2, 0x98, 0x68, 0x36, 0xa2, 0xce, 0x47, 0x20, 0x89, 0x66, 0xa2, 0x81,
-	0xd6, 0x66, 0xd6, 0xaf, 0xdf, 0xbf, 0xe3, 0x3a, 0xad, 0x63, 0x0a, 0x31, 0xee, 0x18, 0xa3, 0xb4,
-	0x27, 0x18, 0xef, 0x3e, 0x30, 0x5e, 0xbf, 0xfb, 0xb5, 0x7d, 0xf7, 0xe5, 0xbc, 0x9a, 0x67, 0x94,
-	0xbf, 0x5c, 0x32, 0x5a, 0x1d, 0xbe, 0xe1, 0xc3, 0xaf, 0xc3, 0xa2, 0xa4, 0x87, 0x54, 0x52, 0x1e,
-	0xd2, 0x20, 0x14, 0x19, 0xd7, 0xf9, 0x09, 0xde, 0xf4, 0x17, 0x8a, 0x70, 0xcf, 0x44, 0xbd, 0x6f,
-	0x10, 0xdc, 0x2a, 0x3c, 0xf5, 0x32, 0x29, 0x29, 0xd7, 0x63, 0x43, 0x47, 0x30, 0x67, 0x4d, 0xa8,
-	0xe9, 0xe9, 0x1f, 0x33, 0xe0, 0x15, 0xa8, 0xa5, 0x54, 0x32, 0x61, 0x5b, 0xad, 0xea, 0xbb, 0x95,
-	0xf7, 0x25, 0x82, 0x56, 0x21, 0x70, 0x27, 0x74, 0x76, 0x69, 0xd4, 0x13, 0x49, 0xc2, 0x94, 0x62,
-	0x82, 0xe3, 0x8f, 0x00, 0xc2, 0x62, 0x35, 0x3d, 0xa9, 0x13, 0x24, 0xde, 0xe7, 0x08, 0x6e, 0x17,
-	0xaa, 0x1e, 0x66, 0x5a, 0x69, 0xc2, 0x23, 0xc6, 0x07, 0xff, 0x45, 0xe9, 0xbc, 0xaf, 0x11, 0x2c,
-	0x15, 0x62, 0x0e, 0x62, 0xa2, 0x86, 0x7b, 0x23, 0xca, 0x35, 0x7e, 0x03, 0xfe, 0x3f, 0x1a, 0x87,
-	0x03, 0x57, 0x5c, 0x94, 0x17, 0x77, 0xb1, 0x88, 0xef, 0xe7, 0x61, 0xfc, 0x01, 0xcc, 0x1f, 0x4a,
-	0x12, 0x9a, 0x49, 0x76, 0x25, 0xaf, 0x7a, 0x81, 0x66, 0x2a, 0x55, 0xbf, 0x44, 0x9c, 0xc2, 0x31,
-	0xac, 0x94, 0xea, 0x94, 0x49, 0x04, 0x34, 0xcf, 0xb8, 0x8a, 0xdd, 0xeb, 0xbc, 0x60, 0xcc, 0x76,
-	0x2e, 0x81, 0xec, 0x56, 0x8d, 0x64, 0xbf, 0x3e, 0xba, 0x84, 0xcd, 0xbd, 0xc1, 0x1f, 0x23, 0x98,
-	0x7b, 0x8b, 0xd2, 0x7d, 0x21, 0x62, 0x7c, 0x02, 0x0b, 0xe5, 0x30, 0x4d, 0x85, 0x88, 0xa7, 0x77,
-	0x52, 0xe5, 0xd4, 0x36, 0xcc, 0xde, 0xef, 0x08, 0x9a, 0xbd, 0xc9, 0xc8, 0x41, 0x4a, 0x79, 0x64,
-	0xc7, 0x14, 0x89, 0x71, 0x1d, 0x66, 0x35, 0xd3, 0x31, 0xb5, 0xd3, 0xdd, 0xb7, 0x0b, 0xbc, 0x06,
-	0xd7, 0x23, 0xaa, 0x42, 0xc9, 0xd2, 0xf2, 0x90, 0xfc, 0xc9, 0x10, 0xbe, 0x03, 0xd7, 0x24, 0x0d,
-	0x59, 0xca, 0x28, 0xd7, 0x76, 0x7c, 0xfa, 0x65, 0x00, 0x87, 0x50, 0x23, 0x49, 0x3e, 0x08, 0xaa,
-	0xb9, 0xcd, 0xd5, 0x4b, 0x6d, 0xe6, 0x1e, 0xef, 0x39, 0x8f, 0xeb, 0x2f, 0xe1, 0xd1, 0x1a, 0x74,
-	0xd0, 0xdb, 0x37, 0x3e, 0x7d, 0xdc, 0xae, 0x98, 0x4a, 0xff, 0x61, 0xaa, 0xfd, 0x03, 0x82, 0xe5,
-	0x5d, 0x1a, 0xd3, 0x41, 0x7e, 0x18, 0x9a, 0x48, 0xcd, 0xf8, 0xe0, 0x1d, 0x7e, 0x98, 0x8f, 0xa7,
-	0x54, 0xd2, 0x11, 0x13, 0x66, 0xf0, 0x4f, 0x36, 0xe6, 0xc2, 0x38, 0xec, 0xfa, 0xd2, 0x87, 0x59,
-	0xa5, 0xc9, 0x11, 0xbd, 0x92, 0xa6, 0xb4, 0x50, 0xf8, 0x2e, 0xd4, 0x86, 0x94, 0x0d, 0x86, 0xb6,
-	0x48, 0xd5, 0xee, 0xd2, 0x9f, 0xa7, 0xed, 0xc5, 0x50, 0x52, 0x33, 0x38, 0x79, 0x60, 0x53, 0xbe,
-	0xdb, 0xe2, 0xfd, 0x84, 0x60, 0xd5, 0x79, 0x60, 0x82, 0x17, 0x6e, 0xdc, 0x5d, 0xb2, 0x07, 0xaf,
-	0x94, 0x3d, 0x6c, 0x2e, 0x13, 0xaa, 0x94, 0xbb, 0x94, 0x1b, 0xcf, 0x9f, 0x6c, 0xd4, 0x1d, 0xf9,
-	0x8e, 0xcd, 0x1c, 0x68, 0x69, 0x46, 0x44, 0xf9, 0x52, 0xba, 0x38, 0x66, 0x50, 0x2b, 0xae, 0xd9,
-	0x29, 0xb5, 0xa0, 0x23, 0xd8, 0x9e, 0x77, 0x27, 0x84, 0xbc, 0xef, 0x11, 0xbc, 0xfa, 0xef, 0x5d,
-	0xf8, 0x3e, 0xd3, 0xc3, 0x5d, 0x9a, 0x0a, 0xc5, 0xf4, 0x94, 0x1a, 0x72, 0x65, 0xa2, 0x21, 0x4d,
-	0xca, 0xad, 0x70, 0x03, 0xe6, 0x22, 0x4b, 0xdc, 0x98, 0xcd, 0x13, 0xe3, 0x65, 0xa9, 0xbd, 0xfb,
-	0xf0, 0xdb, 0xb3, 0x16, 0x7a, 0x7a, 0xd6, 0x42, 0xcf, 0xce, 0x5a, 0xe8, 0xb7, 0xb3, 0x16, 0xfa,
-	0xe2, 0xbc, 0x55, 0x79, 0x76, 0xde, 0xaa, 0xfc, 0x7c, 0xde, 0xaa, 0x7c, 0xb8, 0xf5, 0xc2, 0xc2,
-	0x9c, 0x5c, 0xfc, 0xce, 0xcb, 0xeb, 0xd4, 0xaf, 0xe5, 0xdf, 0x5a, 0x0f, 0xfe, 0x0a, 0x00, 0x00,
-	0xff, 0xff, 0x68, 0x12, 0x5a, 0x76, 0x0b, 0x0a, 0x00, 0x00,
+	// 1157 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xc1, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf6, 0x24, 0xae, 0xeb, 0xbc, 0x36, 0xc9, 0xef, 0x37, 0x71, 0x52, 0xc7, 0xad, 0xec, 0xc8,
+	0x52, 0x21, 0xa8, 0x8a, 0xdd, 0xa4, 0x12, 0x42, 0x11, 0x97, 0x3a, 0x49, 0x55, 0x4e, 0x8d, 0x36,
+	0x11, 0x20, 0x84, 0xb4, 0x1a, 0xef, 0x8e, 0xed, 0x51, 0xd6, 0x3b, 0xcb, 0xcc, 0xd8, 0x49, 0xcf,
+	0x5c, 0x0a, 0xe2, 0x50, 0x89, 0x4b, 0x85, 0x04, 0xca, 0x11, 0x71, 0xee, 0x85, 0x03, 0x07, 0x0e,
+	0x48, 0x3d, 0x96, 0x5e, 0x40, 0x1c, 0x52, 0x94, 0x08, 0x09, 0xf1, 0x57, 0xa0, 0xd9, 0x99, 0x5d,
+	0x3b, 0x4d, 0x08, 0x3d, 0xd8, 0xe2, 0x14, 0xcf, 0x9b, 0xd9, 0xef, 0xbd, 0xf7, 0xbd, 0xf7, 0xbe,
+	0x99, 0x40, 0xcd, 0xe3, 0xb2, 0xcb, 0x65, 0xdd, 0x67, 0x52, 0x09, 0xd6, 0xec, 0x29, 0xc6, 0xc3,
+	0x7a, 0x7f, 0xb5, 0x49, 0x15, 0x59, 0x3d, 0x65, 0xac, 0x45, 0x82, 0x2b, 0x8e, 0xaf, 0x9b, 0xf3,
+	0xb5, 0x53, 0x5b, 0xf6, 0x7c, 0xa9, 0xd0, 0xe6, 0x6d, 0x1e, 0x9f, 0xab, 0xeb, 0x5f, 0xe6, 0x93,
+	0x52, 0xd9, 0xba, 0x68, 0x12, 0x49, 0x53, 0x68, 0x8f, 0x33, 0x0b, 0x59, 0x5a, 0x34, 0xfb, 0xae,
+	0xf9, 0xd0, 0xe2, 0x9b, 0xad, 0x4a, 0x9b, 0xf3, 0x76, 0x40, 0xeb, 0xf1, 0xaa, 0xd9, 0x6b, 0xd5,
+	0x15, 0xeb, 0x52, 0xa9, 0x48, 0x37, 0x32, 0x07, 0xaa, 0x3f, 0x65, 0x21, 0xb7, 0x4d, 0x04, 0xe9,
+	0x4a, 0x4c, 0x60, 0xda, 0xe3, 0xdd, 0x6e, 0x2f, 0x64, 0xea, 0xa1, 0xab, 0xc8, 0x41, 0x11, 0x2d,
+	0xa1, 0xe5, 0xa9, 0xc6, 0xbb, 0xcf, 0x8e, 0x2a, 0x99, 0xdf, 0x8e, 0x2a, 0x6f, 0xb4, 0x99, 0xea,
+	0xf4, 0x9a, 0x35, 0x8f, 0x77, 0xad, 0x0f, 0xfb, 0x67, 0x45, 0xfa, 0x7b, 0x75, 0xf5, 0x30, 0xa2,
+	0xb2, 0xb6, 0x49, 0xbd, 0x17, 0x4f, 0x57, 0xc0, 0x86, 0xb0, 0x49, 0x3d, 0xe7, 0x6a, 0x0a, 0xb9,
+	0x4b, 0x0e, 0x70, 0x08, 0x05, 0x9d, 0x84, 0x8e, 0x34, 0xe2, 0x92, 0x0a, 0x57, 0xd0, 0x7d, 0x22,
+	0xfc, 0xe2, 0xc4, 0x08, 0x3c, 0x61, 0x8d, 0xbc, 0x6d, 0x81, 0x9d, 0x18, 0x17, 0x47, 0x30, 0xdf,
+	0xe4, 0x61, 0x4f, 0x9e, 0x71, 0x38, 0x39, 0x02, 0x87, 0x73, 0x31, 0xf4, 0x2b, 0x1e, 0xd7, 0x60,
+	0x7e, 0x9f, 0xa9, 0x8e, 0x2f, 0xc8, 0xbe, 0x4b, 0x7c, 0x5f, 0xb8, 0x34, 0x24, 0xcd, 0x80, 0xfa,
+	0xc5, 0xec, 0x12, 0x5a, 0xce, 0x3b, 0x73, 0xc9, 0xe6, 0x5d, 0xdf, 0x17, 0x5b, 0x66, 0x0b, 0xdf,
+	0x87, 0xa9, 0x16, 0xa5, 0xae, 0x8c, 0x02, 0xa6, 0x8a, 0x97, 0x96, 0xd0, 0xf2, 0x95, 0xb5, 0x9b,
+	0xb5, 0x0b, 0xda, 0xa4, 0x76, 0x8f, 0xd2, 0x1d, 0x7d, 0xb8, 0x91, 0xd5, 0x09, 0x38, 0xf9, 0x96,
+	0x5d, 0x63, 0x01, 0x33, 0x7e, 0x4f, 0x2a, 0x57, 0x75, 0x04, 0x95, 0x1d, 0x1e, 0xf8, 0xc5, 0xdc,
+	0xd2, 0xe4, 0xf2, 0x95, 0xb5, 0xc5, 0x04, 0x4e, 0x73, 0x94, 0xc2, 0x6c, 0x70, 0x16, 0x36, 0x6e,
+	0x6b, 0x88, 0xef, 0x5e, 0x56, 0x96, 0x5f, 0x83, 0x03, 0xfd, 0x81, 0x74, 0xa6, 0xb5, 0x8b, 0xdd,
+	0xc4, 0xc3, 0x7a, 0xf6, 0xc9, 0x61, 0x25, 0x53, 0xfd, 0x62, 0x12, 0xf2, 0x49, 0x58, 0xf8, 0x43,
+	0xc8, 0x27, 0x84, 0x8f, 0xa4, 0x89, 0x52, 0x34, 0xec, 0xc1, 0x4c, 0x5a, 0xca, 0x98, 0xfe, 0x91,
+	0xb4, 0xce, 0x74, 0x82, 0xd9, 0xd0, 0x90, 0xf8, 0x63, 0x80, 0x3e, 0x09, 0x98, 0x4f, 0x14, 0x17,
+	0x72, 0x24, 0xad, 0x32, 0x84, 0xa7, 0x53, 0x18, 0x8c, 0x59, 0xc4, 0x79, 0x50, 0xcc, 0x8e, 0xc0,
+	0xc3, 0x60, 0x74, 0xb7, 0x39, 0x0f, 0xaa, 0x3f, 0x23, 0x28, 0xbd, 0x9f, 0xf8, 0xbc, 0xcf, 0xa4,
+	0xe2, 0x82, 0x79, 0x24, 0x30, 0x4d, 0x2a, 0xf1, 0x67, 0x08, 0xae, 0x79, 0xbd, 0x6e, 0x2f, 0x20,
+	0x8a, 0xf5, 0xa9, 0x1d, 0x0a, 0x57, 0x10, 0xc5, 0x78, 0x11, 0xc5, 0x1d, 0x73, 0xe3, 0xdc, 0x8e,
+	0xd9, 0xa4, 0x5e, 0xdc, 0x34, 0x77, 0x6c, 0xd3, 0xdc, 0x7a, 0xbd, 0x58, 0x4d, 0xdf, 0xcc, 0x0f,
+	0x3c, 0x9a, 0x38, 0x1c, 0xed, 0x0f, 0xbf, 0x09, 0xb3, 0x82, 0xb6, 0xa8, 0xa0, 0xa1, 0x47, 0x5d,
+	0x8f, 0xf7, 0x42, 0x15, 0xd7, 0x74, 0xda, 0x99, 0x49, 0xcd, 0x1b, 0xda, 0x5a, 0xfd, 0x06, 0xc1,
+	0xb5, 0x34, 0xa7, 0x8d, 0x9e, 0x10, 0x34, 0x54, 0x49, 0x42, 0x7b, 0x70, 0xd9, 0x24, 0x21, 0xc7,
+	0x17, 0x7f, 0xe2, 0x01, 0x2f, 0x40, 0x2e, 0xa2, 0x82, 0x71, 0xa3, 0x5b, 0x59, 0xc7, 0xae, 0xaa,
+	0x5f, 0x22, 0x28, 0xa7, 0x01, 0xde, 0xf5, 0x6c, 0xba, 0xd4, 0xdf, 0xe0, 0xdd, 0x2e, 0x93, 0x92,
+	0xf1, 0x10, 0x7f, 0x02, 0xe0, 0xa5, 0xab, 0xf1, 0x85, 0x3a, 0xe4, 0xa4, 0xfa, 0x39, 0x82, 0xeb,
+	0x69, 0x54, 0x0f, 0x7a, 0x4a, 0x2a, 0x12, 0xfa, 0x2c, 0x6c, 0xff, 0x17, 0xd4, 0x55, 0xbf, 0x42,
+	0x30, 0x97, 0x06, 0xb3, 0x13, 0x10, 0xd9, 0xd9, 0xea, 0xd3, 0x50, 0xe1, 0xb7, 0xe0, 0x7f, 0xe9,
+	0x88, 0xb8, 0x96, 0x5c, 0x14, 0x93, 0x3b, 0x9b, 0xda, 0xb7, 0x63, 0xb3, 0x16, 0x97, 0x96, 0x20,
+	0x9e, 0x16, 0xc4, 0x91, 0x0c, 0x7f, 0x8a, 0xa6, 0x99, 0x2a, 0x9c, 0x13, 0x9c, 0xc4, 0x01, 0x2c,
+	0x0c, 0xa2, 0x93, 0x7a, 0xc3, 0xa5, 0xf1, 0x8e, 0x65, 0xec, 0xf6, 0x85, 0x6a, 0x7d, 0x0e, 0xa4,
+	0x15, 0xee, 0x42, 0xff, 0x1c, 0x6f, 0x56, 0x50, 0x3f, 0x45, 0x70, 0xf9, 0x1e, 0xa5, 0x7a, 0x9a,
+	0xf1, 0xc1, 0x19, 0xc9, 0x18, 0x5b, 0xa5, 0x5e, 0xd1, 0x91, 0x3f, 0x10, 0x94, 0x36, 0x86, 0x2d,
+	0x3b, 0x11, 0x0d, 0x7d, 0x73, 0xe7, 0x91, 0x00, 0x17, 0xe0, 0x92, 0x62, 0x2a, 0xa0, 0x46, 0xe5,
+	0x1d, 0xb3, 0xc0, 0x4b, 0x70, 0xc5, 0xa7, 0xd2, 0x13, 0x2c, 0x1a, 0x14, 0xc9, 0x19, 0x36, 0xe1,
+	0x1b, 0x30, 0x25, 0xa8, 0xc7, 0x22, 0x46, 0x43, 0x65, 0x04, 0xd6, 0x19, 0x18, 0xb0, 0x07, 0x39,
+	0xd2, 0x8d, 0x85, 0x20, 0x3b, 0xfa, 0xdb, 0xcb, 0x42, 0xaf, 0x5f, 0x7d, 0x74, 0x58, 0xc9, 0x68,
+	0xa6, 0xff, 0xd4, 0x6c, 0x7f, 0x3d, 0x01, 0xf8, 0x6c, 0x9e, 0x78, 0x06, 0x26, 0x58, 0xd2, 0x88,
+	0x13, 0xcc, 0xc7, 0x6f, 0x0f, 0xc7, 0x6d, 0x9a, 0xaf, 0xf8, 0xe2, 0xe9, 0x4a, 0xc1, 0xc6, 0xa7,
+	0x2f, 0x75, 0x2a, 0xe5, 0x8e, 0x12, 0x7a, 0xbe, 0xce, 0xcd, 0x68, 0x72, 0x6c, 0x19, 0x69, 0x59,
+	0xea, 0x50, 0xd6, 0xee, 0xa8, 0xf8, 0x42, 0x99, 0x74, 0xec, 0x0a, 0xbf, 0x03, 0x59, 0xfd, 0xea,
+	0xb3, 0x2f, 0x8b, 0x52, 0xcd, 0x3c, 0x09, 0x6b, 0xc9, 0x93, 0xb0, 0xb6, 0x9b, 0x3c, 0x09, 0x1b,
+	0x79, 0xed, 0xfb, 0xf1, 0xcb, 0x0a, 0x72, 0xe2, 0x2f, 0xd6, 0xf3, 0x8f, 0x12, 0x7e, 0x7e, 0x40,
+	0x50, 0x38, 0xc5, 0xcf, 0x26, 0x8d, 0xb8, 0x64, 0x4a, 0x33, 0xe2, 0x9b, 0x9f, 0x3c, 0xb9, 0xeb,
+	0x2f, 0x60, 0x24, 0x3d, 0x3a, 0xc4, 0xc8, 0xc4, 0xf8, 0x6a, 0x3c, 0x88, 0xff, 0x47, 0x04, 0xf3,
+	0x9b, 0x34, 0xa0, 0xed, 0x78, 0xd8, 0x14, 0x11, 0x8a, 0x85, 0xed, 0xf7, 0xc2, 0x56, 0x7c, 0xfd,
+	0x44, 0x82, 0xf6, 0x19, 0xd7, 0xaf, 0xc4, 0x61, 0xe1, 0x99, 0x49, 0xcc, 0x56, 0x77, 0x1c, 0xb8,
+	0x24, 0x15, 0xd9, 0xa3, 0x23, 0x11, 0x1d, 0x03, 0x85, 0x6f, 0xa5, 0x25, 0xd3, 0x43, 0x90, 0x6d,
+	0xcc, 0xfd, 0x75, 0x54, 0x99, 0xf5, 0x04, 0xd5, 0x17, 0x63, 0xe8, 0x9a, 0xad, 0xa4, 0x8e, 0xd5,
+	0x5f, 0x10, 0x2c, 0xda, 0x1c, 0x18, 0x0f, 0xd3, 0x6c, 0xec, 0xc3, 0x73, 0x0b, 0xfe, 0x3f, 0xd0,
+	0x28, 0x62, 0x68, 0xff, 0xd7, 0x82, 0x0c, 0x44, 0xd7, 0xda, 0x31, 0x83, 0x5c, 0xfa, 0x26, 0x1f,
+	0x93, 0xc4, 0x58, 0x07, 0xa6, 0x3a, 0x4f, 0x0e, 0x2b, 0xa8, 0xfa, 0x3d, 0x82, 0x9b, 0xff, 0xac,
+	0x32, 0x1f, 0x30, 0xd5, 0x49, 0xda, 0x6d, 0x3c, 0x82, 0xb3, 0x30, 0x24, 0x38, 0x7a, 0xcb, 0xae,
+	0x70, 0x11, 0x2e, 0xdb, 0x8e, 0x8d, 0x87, 0x67, 0xca, 0x49, 0x96, 0x83, 0xd8, 0x1b, 0x0f, 0xbe,
+	0x3d, 0x2e, 0xa3, 0x67, 0xc7, 0x65, 0xf4, 0xfc, 0xb8, 0x8c, 0x7e, 0x3f, 0x2e, 0xa3, 0xc7, 0x27,
+	0xe5, 0xcc, 0xf3, 0x93, 0x72, 0xe6, 0xd7, 0x93, 0x72, 0xe6, 0xa3, 0xd5, 0x0b, 0x89, 0x39, 0x38,
+	0xfd, 0x5f, 0x63, 0xcc, 0x53, 0x33, 0x17, 0x0f, 0xe6, 0x9d, 0xbf, 0x07, 0x00, 0x2d, 0x78, 0x14,
+	0xeb, 0x59, 0x0e, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -718,6 +903,50 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if !this.FeeSplit.Equal(&that1.FeeSplit) {
+		return false
+	}
+	if len(this.DustThreshold) != len(that1.DustThreshold) {
+		return false
+	}
+	for i := range this.DustThreshold {
+		if !this.DustThreshold[i].Equal(&that1.DustThreshold[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FeeSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeSplit)
+	if !ok {
+		that2, ok := that.(FeeSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Proposer.Equal(that1.Proposer) {
+		return false
+	}
+	if !this.ProposerBonus.Equal(that1.ProposerBonus) {
+		return false
+	}
+	if !this.Validators.Equal(that1.Validators) {
+		return false
+	}
+	if !this.CommunityPool.Equal(that1.CommunityPool) {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1045,6 +1274,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DustThreshold) > 0 {
+		for iNdEx := len(m.DustThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DustThreshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1088,6 +1341,69 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Validators.Size()
+		i -= size
+		if _, err := m.Validators.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ProposerBonus.Size()
+		i -= size
+		if _, err := m.ProposerBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Proposer.Size()
+		i -= size
+		if _, err := m.Proposer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorHistoricalRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1416,7 +1732,7 @@ func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
+func (m *CommunityPoolSpend) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1426,40 +1742,59 @@ func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommunityPoolSpend) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommunityPoolSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintDistribution(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
 	if m.Height != 0 {
 		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
 	}
-	{
-		size := m.Stake.Size()
-		i -= size
-		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.PreviousPeriod != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.PreviousPeriod))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
+func (m *CommunityPoolDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1469,20 +1804,20 @@ func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommunityPoolDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommunityPoolDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reward) > 0 {
-		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1493,17 +1828,17 @@ func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Depositor)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1513,25 +1848,112 @@ func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Deposit) > 0 {
-		i -= len(m.Deposit)
-		copy(dAtA[i:], m.Deposit)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Deposit)))
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x18
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PreviousPeriod != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.PreviousPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationDelegatorReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
 		copy(dAtA[i:], m.Amount)
 		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Amount)))
 		i--
@@ -1587,6 +2009,31 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = m.FeeSplit.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if len(m.DustThreshold) > 0 {
+		for _, e := range m.DustThreshold {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposer.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.ProposerBonus.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.Validators.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovDistribution(uint64(l))
 	return n
 }
 
@@ -1727,6 +2174,52 @@ func (m *CommunityPoolSpendProposal) Size() (n int) {
 	return n
 }
 
+func (m *CommunityPoolSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *CommunityPoolDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *DelegatorStartingInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1949,59 +2442,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2028,16 +2471,15 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
-			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
 			}
-			m.ReferenceCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2047,11 +2489,26 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReferenceCount |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThreshold = append(m.DustThreshold, types.Coin{})
+			if err := m.DustThreshold[len(m.DustThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2073,7 +2530,7 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
+func (m *FeeSplit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2096,17 +2553,17 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2116,31 +2573,31 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proposer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerBonus", wireType)
 			}
-			m.Period = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2150,21 +2607,104 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposerBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validators.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -2176,7 +2716,7 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
+func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2199,15 +2739,15 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2234,11 +2774,30 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commission = append(m.Commission, types.DecCoin{})
-			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
+			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+			}
+			m.ReferenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2260,7 +2819,7 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
+func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2283,10 +2842,10 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2323,6 +2882,25 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2344,7 +2922,7 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
+func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2367,36 +2945,17 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
-			}
-			m.ValidatorPeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorPeriod |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2406,23 +2965,23 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commission = append(m.Commission, types.DecCoin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2447,7 +3006,7 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
+func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2470,15 +3029,15 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2505,8 +3064,8 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
-			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2531,7 +3090,7 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeePool) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2554,17 +3113,36 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
+			}
+			m.ValidatorPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2574,23 +3152,23 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
-			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2615,7 +3193,7 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2638,17 +3216,17 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2658,27 +3236,195 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
+			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2795,6 +3541,309 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommunityPoolSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8,9 +8,25 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeFeeSplit           = "fee_split"
+	EventTypeSweepDust          = "sweep_dust"
+
+	EventTypeWithdrawTokenizeShareReward  = "withdraw_tokenize_share_reward"
+	EventTypeSetCommissionWithdrawAddress = "set_commission_withdraw_address"
+	EventTypeCommunityPoolSpend           = "community_pool_spend"
+	EventTypeFundCommunityPool            = "fund_community_pool"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyRecordOwner     = "record_owner"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyDepositor       = "depositor"
+	AttributeKeySpendID         = "spend_id"
+
+	AttributeKeyProposerAmount      = "proposer_amount"
+	AttributeKeyValidatorsAmount    = "validators_amount"
+	AttributeKeyCommunityPoolAmount = "community_pool_amount"
 
 	AttributeValueCategory = ModuleName
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,35 +11,40 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	spends []CommunityPoolSpend, cwis []ValidatorCommissionWithdrawInfo,
 ) *GenesisState {
 
 	return &GenesisState{
-		Params:                          params,
-		FeePool:                         fp,
-		DelegatorWithdrawInfos:          dwis,
-		PreviousProposer:                pp.String(),
-		OutstandingRewards:              r,
-		ValidatorAccumulatedCommissions: acc,
-		ValidatorHistoricalRewards:      historical,
-		ValidatorCurrentRewards:         cur,
-		DelegatorStartingInfos:          dels,
-		ValidatorSlashEvents:            slashes,
+		Params:                           params,
+		FeePool:                          fp,
+		DelegatorWithdrawInfos:           dwis,
+		PreviousProposer:                 pp.String(),
+		OutstandingRewards:               r,
+		ValidatorAccumulatedCommissions:  acc,
+		ValidatorHistoricalRewards:       historical,
+		ValidatorCurrentRewards:          cur,
+		DelegatorStartingInfos:           dels,
+		ValidatorSlashEvents:             slashes,
+		CommunityPoolSpends:              spends,
+		ValidatorCommissionWithdrawInfos: cwis,
 	}
 }
 
 // get raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		FeePool:                         InitialFeePool(),
-		Params:                          DefaultParams(),
-		DelegatorWithdrawInfos:          []DelegatorWithdrawInfo{},
-		PreviousProposer:                "",
-		OutstandingRewards:              []ValidatorOutstandingRewardsRecord{},
-		ValidatorAccumulatedCommissions: []ValidatorAccumulatedCommissionRecord{},
-		ValidatorHistoricalRewards:      []ValidatorHistoricalRewardsRecord{},
-		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
-		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
-		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		FeePool:                          InitialFeePool(),
+		Params:                           DefaultParams(),
+		DelegatorWithdrawInfos:           []DelegatorWithdrawInfo{},
+		PreviousProposer:                 "",
+		OutstandingRewards:               []ValidatorOutstandingRewardsRecord{},
+		ValidatorAccumulatedCommissions:  []ValidatorAccumulatedCommissionRecord{},
+		ValidatorHistoricalRewards:       []ValidatorHistoricalRewardsRecord{},
+		ValidatorCurrentRewards:          []ValidatorCurrentRewardsRecord{},
+		DelegatorStartingInfos:           []DelegatorStartingInfoRecord{},
+		ValidatorSlashEvents:             []ValidatorSlashEventRecord{},
+		CommunityPoolSpends:              []CommunityPoolSpend{},
+		ValidatorCommissionWithdrawInfos: []ValidatorCommissionWithdrawInfo{},
 	}
 }
 
@@ -46,5 +53,30 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	if err := validateCommunityPoolSpends(gs.CommunityPoolSpends); err != nil {
+		return err
+	}
 	return gs.FeePool.ValidateGenesis()
 }
+
+func validateCommunityPoolSpends(spends []CommunityPoolSpend) error {
+	ids := make(map[uint64]bool, len(spends))
+	for _, spend := range spends {
+		if spend.Id == 0 {
+			return fmt.Errorf("community pool spend id must be positive")
+		}
+		if ids[spend.Id] {
+			return fmt.Errorf("duplicate community pool spend id %d", spend.Id)
+		}
+		ids[spend.Id] = true
+
+		if _, err := sdk.AccAddressFromBech32(spend.Recipient); err != nil {
+			return fmt.Errorf("invalid recipient of community pool spend %d: %w", spend.Id, err)
+		}
+		if !spend.Amount.IsValid() {
+			return fmt.Errorf("invalid amount of community pool spend %d: %s", spend.Id, spend.Amount)
+		}
+	}
+
+	return nil
+}
@@ -69,6 +69,49 @@ func (m *DelegatorWithdrawInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorWithdrawInfo proto.InternalMessageInfo
 
+// ValidatorCommissionWithdrawInfo is the address the commission of a validator
+// is withdrawn to, if different from its operator address. This struct is only
+// used at genesis.
+type ValidatorCommissionWithdrawInfo struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// withdraw_address is the address to withdraw the commission to.
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *ValidatorCommissionWithdrawInfo) Reset()         { *m = ValidatorCommissionWithdrawInfo{} }
+func (m *ValidatorCommissionWithdrawInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCommissionWithdrawInfo) ProtoMessage()    {}
+func (*ValidatorCommissionWithdrawInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{1}
+}
+func (m *ValidatorCommissionWithdrawInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCommissionWithdrawInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCommissionWithdrawInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorCommissionWithdrawInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCommissionWithdrawInfo.Merge(m, src)
+}
+func (m *ValidatorCommissionWithdrawInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCommissionWithdrawInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCommissionWithdrawInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorCommissionWithdrawInfo proto.InternalMessageInfo
+
 // ValidatorOutstandingRewardsRecord is used for import/export via genesis json.
 type ValidatorOutstandingRewardsRecord struct {
 	// validator_address is the address of the validator.
@@ -81,7 +124,7 @@ func (m *ValidatorOutstandingRewardsRecord) Reset()         { *m = ValidatorOuts
 func (m *ValidatorOutstandingRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewardsRecord) ProtoMessage()    {}
 func (*ValidatorOutstandingRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{1}
+	return fileDescriptor_76eed0f9489db580, []int{2}
 }
 func (m *ValidatorOutstandingRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,7 +166,7 @@ func (m *ValidatorAccumulatedCommissionRecord) Reset()         { *m = ValidatorA
 func (m *ValidatorAccumulatedCommissionRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommissionRecord) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommissionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{2}
+	return fileDescriptor_76eed0f9489db580, []int{3}
 }
 func (m *ValidatorAccumulatedCommissionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -167,7 +210,7 @@ func (m *ValidatorHistoricalRewardsRecord) Reset()         { *m = ValidatorHisto
 func (m *ValidatorHistoricalRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewardsRecord) ProtoMessage()    {}
 func (*ValidatorHistoricalRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{3}
+	return fileDescriptor_76eed0f9489db580, []int{4}
 }
 func (m *ValidatorHistoricalRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,7 +251,7 @@ func (m *ValidatorCurrentRewardsRecord) Reset()         { *m = ValidatorCurrentR
 func (m *ValidatorCurrentRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewardsRecord) ProtoMessage()    {}
 func (*ValidatorCurrentRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{4}
+	return fileDescriptor_76eed0f9489db580, []int{5}
 }
 func (m *ValidatorCurrentRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,7 +294,7 @@ func (m *DelegatorStartingInfoRecord) Reset()         { *m = DelegatorStartingIn
 func (m *DelegatorStartingInfoRecord) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfoRecord) ProtoMessage()    {}
 func (*DelegatorStartingInfoRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{5}
+	return fileDescriptor_76eed0f9489db580, []int{6}
 }
 func (m *DelegatorStartingInfoRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +339,7 @@ func (m *ValidatorSlashEventRecord) Reset()         { *m = ValidatorSlashEventRe
 func (m *ValidatorSlashEventRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEventRecord) ProtoMessage()    {}
 func (*ValidatorSlashEventRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{6}
+	return fileDescriptor_76eed0f9489db580, []int{7}
 }
 func (m *ValidatorSlashEventRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,13 +390,19 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// community_pool_spends defines the history of the community pool spends at
+	// genesis.
+	CommunityPoolSpends []CommunityPoolSpend `protobuf:"bytes,11,rep,name=community_pool_spends,json=communityPoolSpends,proto3" json:"community_pool_spends"`
+	// validator_commission_withdraw_infos defines the commission withdraw
+	// addresses of the validators at genesis.
+	ValidatorCommissionWithdrawInfos []ValidatorCommissionWithdrawInfo `protobuf:"bytes,12,rep,name=validator_commission_withdraw_infos,json=validatorCommissionWithdrawInfos,proto3" json:"validator_commission_withdraw_infos"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,6 +433,7 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "cosmos.distribution.v1beta1.DelegatorWithdrawInfo")
+	proto.RegisterType((*ValidatorCommissionWithdrawInfo)(nil), "cosmos.distribution.v1beta1.ValidatorCommissionWithdrawInfo")
 	proto.RegisterType((*ValidatorOutstandingRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewardsRecord")
 	proto.RegisterType((*ValidatorAccumulatedCommissionRecord)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommissionRecord")
 	proto.RegisterType((*ValidatorHistoricalRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewardsRecord")
@@ -398,63 +448,68 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x93, 0x92, 0x9b, 0x3b, 0xb9, 0x88, 0x8b, 0x6f, 0x6f, 0x71, 0x7b, 0x8b, 0xd3, 0x96,
-	0x2e, 0x8a, 0x50, 0x1d, 0x9a, 0x22, 0x40, 0x45, 0x20, 0x25, 0x69, 0xf9, 0x59, 0xb5, 0x4a, 0x10,
-	0x95, 0x90, 0x50, 0x34, 0xb1, 0x27, 0xce, 0x40, 0xe2, 0x89, 0x66, 0xc6, 0x6e, 0x91, 0x58, 0x21,
-	0x21, 0x75, 0x89, 0x04, 0x0f, 0xd0, 0x25, 0x42, 0x62, 0xc7, 0x33, 0xa0, 0x2e, 0x2b, 0x56, 0x2c,
-	0x50, 0x41, 0x29, 0x0b, 0x5e, 0x81, 0x1d, 0xf2, 0x78, 0xfc, 0xa7, 0xba, 0x26, 0x2d, 0xed, 0xaa,
-	0x1d, 0xcf, 0xf9, 0xf9, 0xbe, 0x73, 0xbe, 0x9c, 0x33, 0xe0, 0x55, 0x93, 0xb0, 0x31, 0x61, 0x75,
-	0x0b, 0x33, 0x4e, 0x71, 0xdf, 0xe5, 0x98, 0x38, 0x75, 0x6f, 0xab, 0x8f, 0x38, 0xdc, 0xaa, 0xdb,
-	0xc8, 0x41, 0x0c, 0x33, 0x63, 0x42, 0x09, 0x27, 0xea, 0xb3, 0xc0, 0xd4, 0x48, 0x9a, 0x1a, 0xd2,
-	0x74, 0x69, 0xde, 0x26, 0x36, 0x11, 0x76, 0x75, 0xff, 0xbf, 0xc0, 0x65, 0x49, 0x97, 0xd1, 0xfb,
-	0x90, 0xa1, 0x28, 0xaa, 0x49, 0xb0, 0x23, 0xef, 0x8d, 0xbc, 0xec, 0xa9, 0x3c, 0x81, 0xfd, 0x62,
-	0x60, 0xdf, 0x0b, 0x12, 0x49, 0x3c, 0xe2, 0xb0, 0xf6, 0x93, 0x02, 0x9e, 0xee, 0xa2, 0x11, 0xb2,
-	0x21, 0x27, 0xf4, 0x10, 0xf3, 0xa1, 0x45, 0xe1, 0xd1, 0x47, 0xce, 0x80, 0xa8, 0x7b, 0xe0, 0x45,
-	0x2b, 0xbc, 0xe8, 0x41, 0xcb, 0xa2, 0x88, 0x31, 0x4d, 0x59, 0x51, 0x36, 0x1e, 0xb6, 0xb4, 0x5f,
-	0x7f, 0xde, 0x9c, 0x97, 0x61, 0x9a, 0xc1, 0x4d, 0x97, 0x53, 0xec, 0xd8, 0x9d, 0xc7, 0x91, 0x8b,
-	0xfc, 0xae, 0xb6, 0xc1, 0xe3, 0x23, 0x19, 0x36, 0x8a, 0x52, 0xfc, 0x8f, 0x28, 0x2f, 0x84, 0x1e,
-	0xf2, 0xf3, 0x4e, 0xe5, 0xe4, 0xb4, 0x56, 0xf8, 0xfb, 0xb4, 0x56, 0x58, 0xfb, 0x47, 0x01, 0xab,
-	0x9f, 0xc0, 0x11, 0xb6, 0xfc, 0x1c, 0xfb, 0x2e, 0x67, 0x1c, 0x3a, 0x96, 0xef, 0x83, 0x8e, 0x20,
-	0xb5, 0x58, 0x07, 0x99, 0x84, 0x5a, 0x3e, 0x76, 0x2f, 0x34, 0x9a, 0x1d, 0x7b, 0xe4, 0x12, 0x62,
-	0xff, 0x5a, 0x01, 0x4f, 0x48, 0x9c, 0xa3, 0x47, 0x83, 0x24, 0x5a, 0x71, 0xa5, 0xb4, 0x51, 0x6d,
-	0x2c, 0xcb, 0x36, 0x18, 0x7e, 0x9b, 0xc2, 0x8e, 0x1a, 0xbb, 0xc8, 0x6c, 0x13, 0xec, 0xb4, 0xb6,
-	0xcf, 0x2e, 0x6a, 0x85, 0x1f, 0xff, 0xa8, 0xbd, 0x66, 0x63, 0x3e, 0x74, 0xfb, 0x86, 0x49, 0xc6,
-	0xb2, 0xf2, 0xf2, 0xcf, 0x26, 0xb3, 0xbe, 0xa8, 0xf3, 0x2f, 0x27, 0x88, 0x85, 0x3e, 0xac, 0xa3,
-	0x92, 0x2b, 0x8c, 0x12, 0xdc, 0x7f, 0x57, 0xc0, 0x7a, 0xc4, 0xbd, 0x69, 0x9a, 0xee, 0xd8, 0x1d,
-	0x41, 0x8e, 0xac, 0x36, 0x19, 0x8f, 0x31, 0x63, 0x98, 0x38, 0x77, 0x4b, 0xdf, 0x04, 0x55, 0x18,
-	0x67, 0x11, 0x5d, 0xab, 0x36, 0xde, 0x31, 0x72, 0xf4, 0x6c, 0xe4, 0xc3, 0x6b, 0xcd, 0xf9, 0x45,
-	0xe9, 0x24, 0xa3, 0x26, 0xe8, 0xfd, 0xa5, 0x80, 0x95, 0xc8, 0xff, 0x43, 0xcc, 0x38, 0xa1, 0xd8,
-	0x84, 0xa3, 0x7b, 0xe9, 0xec, 0x02, 0x28, 0x4f, 0x10, 0xc5, 0x24, 0x60, 0x35, 0xd7, 0x91, 0x27,
-	0xf5, 0x10, 0x3c, 0x08, 0x9b, 0x5c, 0x12, 0x74, 0xdf, 0x9a, 0x8d, 0xee, 0x15, 0xb8, 0x92, 0x6a,
-	0x18, 0x2d, 0x41, 0xf3, 0x17, 0x05, 0xbc, 0x1c, 0xf9, 0xb5, 0x5d, 0x4a, 0x91, 0xc3, 0xef, 0x85,
-	0xe3, 0xc7, 0x31, 0x97, 0xa0, 0x75, 0x6f, 0xcc, 0xc6, 0x25, 0x8d, 0xe9, 0x7a, 0x22, 0xdf, 0x17,
-	0xc1, 0xb3, 0x68, 0x74, 0x74, 0x39, 0xa4, 0x1c, 0x3b, 0xb6, 0x3f, 0x3a, 0x62, 0x1a, 0x77, 0x31,
-	0x40, 0x32, 0xab, 0x51, 0xbc, 0x71, 0x35, 0x3e, 0x03, 0xcf, 0x33, 0x89, 0xb1, 0x87, 0x9d, 0x01,
-	0x91, 0xfd, 0x6d, 0xe4, 0xd6, 0x24, 0x93, 0x9e, 0xac, 0xc8, 0x23, 0x96, 0xf8, 0x96, 0x28, 0xcb,
-	0x49, 0x11, 0x2c, 0x46, 0xb5, 0xec, 0x8e, 0x20, 0x1b, 0xee, 0x79, 0xa2, 0x9c, 0x77, 0xac, 0xdf,
-	0x21, 0xc2, 0xf6, 0x90, 0x87, 0xfa, 0x0d, 0x4e, 0x09, 0x5d, 0x97, 0x52, 0xba, 0xfe, 0x1c, 0x3c,
-	0x8d, 0xd3, 0x32, 0x1f, 0x54, 0x0f, 0xf9, 0xa8, 0xb4, 0x39, 0x51, 0x85, 0xd7, 0x67, 0x53, 0x46,
-	0xcc, 0x46, 0xd6, 0xe0, 0x89, 0x77, 0xf5, 0x2a, 0x51, 0x8a, 0x8b, 0x0a, 0x78, 0xf4, 0x41, 0xb0,
-	0x0c, 0xbb, 0x1c, 0x72, 0xa4, 0x36, 0x41, 0x79, 0x02, 0x29, 0x1c, 0x07, 0x94, 0xab, 0x8d, 0x57,
-	0x72, 0xf3, 0x1e, 0x08, 0x53, 0x99, 0x4a, 0x3a, 0xaa, 0x7b, 0xa0, 0x32, 0x40, 0xa8, 0x37, 0x21,
-	0x64, 0x24, 0x65, 0xbd, 0x9e, 0x1b, 0xe4, 0x7d, 0x84, 0x0e, 0x08, 0x19, 0x85, 0x32, 0x1e, 0x04,
-	0x47, 0x95, 0x02, 0x2d, 0x16, 0x67, 0xb4, 0xa0, 0x7c, 0x61, 0xf8, 0xbf, 0xfc, 0xd2, 0xec, 0xca,
-	0x48, 0xee, 0x4c, 0x99, 0x64, 0xc1, 0xca, 0xba, 0x14, 0x4a, 0x9e, 0x50, 0xe4, 0x61, 0xe2, 0x8a,
-	0x55, 0x3c, 0x21, 0x0c, 0x51, 0xd1, 0x80, 0xdc, 0xde, 0x87, 0x2e, 0x07, 0xd2, 0x43, 0x75, 0xb3,
-	0x97, 0xd2, 0x73, 0x02, 0xf5, 0x7b, 0xb3, 0x75, 0xf2, 0xba, 0xcd, 0x29, 0x19, 0x64, 0xec, 0x21,
-	0xf5, 0x3b, 0x05, 0xac, 0x26, 0xa4, 0x1b, 0x8f, 0xf0, 0x9e, 0x19, 0x0d, 0x78, 0xa6, 0x95, 0x05,
-	0x8a, 0xe6, 0xff, 0x58, 0x12, 0x29, 0x20, 0x35, 0x2f, 0xd7, 0x96, 0xa9, 0xdf, 0x28, 0x60, 0x39,
-	0x46, 0x35, 0x8c, 0xc6, 0x70, 0x54, 0x96, 0x07, 0x02, 0xd0, 0xbb, 0xb7, 0x1c, 0xe3, 0x29, 0x30,
-	0x4b, 0xde, 0xb5, 0x76, 0xea, 0x57, 0x60, 0x31, 0x86, 0x61, 0x06, 0x13, 0x34, 0xc2, 0x50, 0x11,
-	0x18, 0x76, 0x6e, 0x33, 0x7e, 0x53, 0x00, 0x5e, 0xf2, 0xb2, 0x8d, 0xd4, 0xe3, 0xa4, 0x9a, 0x53,
-	0x63, 0x8e, 0x69, 0x0f, 0x45, 0xf2, 0xb7, 0x6f, 0x3e, 0xe7, 0x52, 0xa9, 0x63, 0x4d, 0x27, 0x4d,
-	0x98, 0x4a, 0xc1, 0x42, 0xe6, 0x60, 0x61, 0x1a, 0x10, 0x79, 0xdf, 0xbc, 0xe9, 0x64, 0x49, 0x65,
-	0x9d, 0xcf, 0x98, 0x2f, 0x89, 0x15, 0xd4, 0xda, 0xff, 0x61, 0xaa, 0x2b, 0x67, 0x53, 0x5d, 0x39,
-	0x9f, 0xea, 0xca, 0x9f, 0x53, 0x5d, 0xf9, 0xf6, 0x52, 0x2f, 0x9c, 0x5f, 0xea, 0x85, 0xdf, 0x2e,
-	0xf5, 0xc2, 0xa7, 0x5b, 0xb9, 0x4f, 0xaf, 0xe3, 0xf4, 0xf3, 0x59, 0xbc, 0xc4, 0xfa, 0x65, 0xf1,
-	0x2a, 0xde, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x18, 0xd7, 0x00, 0x14, 0xe0, 0x0b, 0x00, 0x00,
+	// 973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0xde, 0xd9, 0x84, 0x34, 0x9d, 0x0d, 0xa2, 0x4c, 0x93, 0xe0, 0xa4, 0xc5, 0x9b, 0x7e, 0x1c,
+	0x8a, 0x50, 0xbd, 0x24, 0x45, 0x80, 0xca, 0x87, 0x94, 0xa4, 0xe1, 0xe3, 0xd4, 0x68, 0x17, 0x51,
+	0x09, 0x09, 0x59, 0xb3, 0xf6, 0xc4, 0x3b, 0xb0, 0xeb, 0xb1, 0x66, 0xc6, 0x4e, 0x2b, 0x71, 0x42,
+	0x42, 0xea, 0x11, 0x04, 0x3f, 0xa0, 0x47, 0x84, 0xc4, 0x0d, 0x89, 0x7f, 0x80, 0x7a, 0xac, 0x38,
+	0x71, 0xe0, 0x4b, 0x1b, 0x0e, 0xfc, 0x05, 0x6e, 0xc8, 0xe3, 0xf1, 0x57, 0xd7, 0x71, 0x37, 0x65,
+	0x73, 0x4a, 0xec, 0x79, 0xdf, 0x79, 0x9f, 0xe7, 0x7d, 0x1f, 0x3f, 0x33, 0x0b, 0x5f, 0x72, 0x98,
+	0x18, 0x31, 0xd1, 0x71, 0xa9, 0x90, 0x9c, 0xf6, 0x43, 0x49, 0x99, 0xdf, 0x89, 0x36, 0xfb, 0x44,
+	0xe2, 0xcd, 0x8e, 0x47, 0x7c, 0x22, 0xa8, 0xb0, 0x02, 0xce, 0x24, 0x43, 0x17, 0x92, 0x50, 0xab,
+	0x18, 0x6a, 0xe9, 0xd0, 0xf5, 0x65, 0x8f, 0x79, 0x4c, 0xc5, 0x75, 0xe2, 0xff, 0x92, 0x94, 0x75,
+	0x53, 0xef, 0xde, 0xc7, 0x82, 0x64, 0xbb, 0x3a, 0x8c, 0xfa, 0x7a, 0xdd, 0xaa, 0xab, 0x5e, 0xaa,
+	0x93, 0xc4, 0xaf, 0x25, 0xf1, 0x76, 0x52, 0x48, 0xe3, 0x51, 0x0f, 0x97, 0x7f, 0x00, 0x70, 0xe5,
+	0x16, 0x19, 0x12, 0x0f, 0x4b, 0xc6, 0xef, 0x50, 0x39, 0x70, 0x39, 0x3e, 0xfc, 0xc0, 0x3f, 0x60,
+	0x68, 0x0f, 0x3e, 0xef, 0xa6, 0x0b, 0x36, 0x76, 0x5d, 0x4e, 0x84, 0x30, 0xc0, 0x06, 0xb8, 0x76,
+	0x76, 0xc7, 0xf8, 0xe5, 0xc7, 0xeb, 0xcb, 0x7a, 0x9b, 0xed, 0x64, 0xa5, 0x27, 0x39, 0xf5, 0xbd,
+	0xee, 0xb9, 0x2c, 0x45, 0xbf, 0x47, 0xbb, 0xf0, 0xdc, 0xa1, 0xde, 0x36, 0xdb, 0xa5, 0xf9, 0x84,
+	0x5d, 0x9e, 0x4b, 0x33, 0xf4, 0xeb, 0x9b, 0x8b, 0xf7, 0x1f, 0xb4, 0x1b, 0xff, 0x3c, 0x68, 0x37,
+	0x2e, 0xff, 0x04, 0x60, 0xfb, 0x23, 0x3c, 0xa4, 0x6e, 0x5c, 0x63, 0x97, 0x8d, 0x46, 0x54, 0x08,
+	0xca, 0xfc, 0xc7, 0x91, 0x47, 0x69, 0xc8, 0xf4, 0xc8, 0xb3, 0x94, 0x53, 0x42, 0xfe, 0x2f, 0x80,
+	0x97, 0x32, 0xe4, 0xb7, 0x43, 0x29, 0x24, 0xf6, 0xdd, 0x38, 0x87, 0x1c, 0x62, 0xee, 0x8a, 0x2e,
+	0x71, 0x18, 0x77, 0x67, 0x85, 0xfd, 0x0b, 0x00, 0xcf, 0xb3, 0xbc, 0x86, 0xcd, 0x93, 0x22, 0x46,
+	0x73, 0x63, 0xee, 0x5a, 0x6b, 0xeb, 0xa2, 0x16, 0x90, 0x15, 0x0b, 0x2c, 0xd5, 0xa2, 0x75, 0x8b,
+	0x38, 0xbb, 0x8c, 0xfa, 0x3b, 0x37, 0x1e, 0xfe, 0xd1, 0x6e, 0x7c, 0xff, 0x67, 0xfb, 0x65, 0x8f,
+	0xca, 0x41, 0xd8, 0xb7, 0x1c, 0x36, 0xd2, 0x9a, 0xd1, 0x7f, 0xae, 0x0b, 0xf7, 0xb3, 0x8e, 0xbc,
+	0x17, 0x10, 0x91, 0xe6, 0x88, 0x2e, 0x62, 0x13, 0x8c, 0x0a, 0xdc, 0x7f, 0x03, 0xf0, 0x6a, 0xc6,
+	0x7d, 0xdb, 0x71, 0xc2, 0x51, 0x38, 0xc4, 0x92, 0xb8, 0xf9, 0x00, 0x67, 0x4b, 0xdf, 0x81, 0x2d,
+	0x9c, 0x57, 0x51, 0x53, 0x6b, 0x6d, 0xbd, 0x69, 0xd5, 0x7c, 0x89, 0x56, 0x3d, 0xbc, 0x9d, 0xf9,
+	0xb8, 0x29, 0xdd, 0xe2, 0xae, 0x05, 0x7a, 0x7f, 0x03, 0xb8, 0x91, 0xe5, 0xbf, 0x4f, 0x85, 0x64,
+	0x9c, 0x3a, 0x78, 0x78, 0x2a, 0x93, 0x5d, 0x85, 0x0b, 0x01, 0xe1, 0x94, 0x25, 0xac, 0xe6, 0xbb,
+	0xfa, 0x09, 0xdd, 0x81, 0x67, 0xd2, 0x21, 0xcf, 0x29, 0xba, 0xaf, 0x4f, 0x47, 0x77, 0x02, 0xae,
+	0xa6, 0x9a, 0xee, 0x56, 0xa0, 0xf9, 0x33, 0x80, 0x2f, 0xe6, 0xdf, 0x5e, 0xc8, 0x39, 0xf1, 0xe5,
+	0xa9, 0x70, 0xfc, 0x30, 0xe7, 0x92, 0x8c, 0xee, 0xd5, 0xe9, 0xb8, 0x94, 0x31, 0x1d, 0x4f, 0xe4,
+	0xdb, 0x26, 0xbc, 0x90, 0x99, 0x5e, 0x4f, 0x62, 0x2e, 0xa9, 0xef, 0xc5, 0xd6, 0x91, 0xd3, 0x98,
+	0x85, 0xf5, 0x55, 0x76, 0xa3, 0x79, 0xe2, 0x6e, 0x7c, 0x02, 0x9f, 0x15, 0x1a, 0xa3, 0x4d, 0xfd,
+	0x03, 0xa6, 0xe7, 0xbb, 0x55, 0xdb, 0x93, 0x4a, 0x7a, 0xba, 0x23, 0x4b, 0xa2, 0xf0, 0xae, 0xd0,
+	0x96, 0xfb, 0x4d, 0xb8, 0x96, 0xf5, 0xb2, 0x37, 0xc4, 0x62, 0xb0, 0x17, 0xa9, 0x76, 0xce, 0x58,
+	0xbf, 0x03, 0x42, 0xbd, 0x81, 0x4c, 0xf5, 0x9b, 0x3c, 0x15, 0x74, 0x3d, 0x57, 0xd2, 0xf5, 0xa7,
+	0x70, 0x25, 0x2f, 0x2b, 0x62, 0x50, 0x36, 0x89, 0x51, 0x19, 0xf3, 0xaa, 0x0b, 0xaf, 0x4c, 0xa7,
+	0x8c, 0x9c, 0x8d, 0xee, 0xc1, 0xf9, 0x68, 0x72, 0xa9, 0xd0, 0x8a, 0xdf, 0x21, 0x5c, 0x7a, 0x2f,
+	0x39, 0xc6, 0x7b, 0x12, 0x4b, 0x82, 0xb6, 0xe1, 0x42, 0x80, 0x39, 0x1e, 0x25, 0x94, 0x5b, 0x5b,
+	0x57, 0x6a, 0xeb, 0xee, 0xab, 0x50, 0x5d, 0x4a, 0x27, 0xa2, 0x3d, 0xb8, 0x78, 0x40, 0x88, 0x1d,
+	0x30, 0x36, 0xd4, 0xb2, 0xbe, 0x5a, 0xbb, 0xc9, 0xbb, 0x84, 0xec, 0x33, 0x36, 0x4c, 0x65, 0x7c,
+	0x90, 0x3c, 0x22, 0x0e, 0x8d, 0x5c, 0x9c, 0xd9, 0x01, 0x15, 0x0b, 0x23, 0xfe, 0xf2, 0xe7, 0xa6,
+	0x57, 0x46, 0xf1, 0xcc, 0xd4, 0x45, 0x56, 0xdd, 0xaa, 0x45, 0xa5, 0xe4, 0x80, 0x93, 0x88, 0xb2,
+	0x50, 0x5d, 0x22, 0x02, 0x26, 0x08, 0x37, 0xe6, 0x9f, 0x34, 0xfb, 0x34, 0x65, 0x5f, 0x67, 0xa0,
+	0xb0, 0xfa, 0x50, 0x7a, 0x46, 0xa1, 0x7e, 0x67, 0xba, 0x49, 0x1e, 0x77, 0x72, 0x6a, 0x06, 0x15,
+	0xe7, 0x10, 0xfa, 0x06, 0xc0, 0x4b, 0x05, 0xe9, 0xe6, 0x16, 0x6e, 0x3b, 0x99, 0xc1, 0x0b, 0x63,
+	0x41, 0xa1, 0xd8, 0xfe, 0x1f, 0x87, 0x44, 0x09, 0x48, 0x3b, 0xaa, 0x8d, 0x15, 0xe8, 0x4b, 0x00,
+	0x2f, 0xe6, 0xa8, 0x06, 0x99, 0x0d, 0x67, 0x6d, 0x39, 0xa3, 0x00, 0xbd, 0xfd, 0x94, 0x36, 0x5e,
+	0x02, 0xb3, 0x1e, 0x1d, 0x1b, 0x87, 0x3e, 0x87, 0x6b, 0x39, 0x0c, 0x27, 0x71, 0xd0, 0x0c, 0xc3,
+	0xa2, 0xc2, 0x70, 0xf3, 0x69, 0xec, 0xb7, 0x04, 0xe0, 0x85, 0xa8, 0x3a, 0x08, 0xdd, 0x2d, 0xaa,
+	0xb9, 0x64, 0x73, 0xc2, 0x38, 0xab, 0x8a, 0xbf, 0x71, 0x72, 0x9f, 0x2b, 0x95, 0x5e, 0x75, 0xab,
+	0x42, 0x04, 0xe2, 0x70, 0xb5, 0xd2, 0x58, 0x84, 0x01, 0x55, 0xdd, 0xd7, 0x4e, 0xea, 0x2c, 0xa5,
+	0xaa, 0xcb, 0x15, 0xfe, 0x22, 0x10, 0x85, 0x2b, 0xb1, 0xe4, 0x42, 0x9f, 0xca, 0x7b, 0xca, 0x08,
+	0x6c, 0x11, 0x10, 0xdf, 0x15, 0x46, 0x4b, 0x95, 0xec, 0xd4, 0x96, 0xdc, 0x4d, 0x33, 0x63, 0x1b,
+	0xe8, 0xc5, 0x79, 0xa9, 0x97, 0x39, 0x13, 0x2b, 0x02, 0x7d, 0x0d, 0xe0, 0x95, 0xc2, 0x5c, 0x33,
+	0xe1, 0x3d, 0x6e, 0x19, 0x4b, 0xaa, 0xf2, 0x5b, 0x53, 0x4e, 0xb8, 0xf2, 0xc2, 0xad, 0x61, 0x6c,
+	0x44, 0xf5, 0x61, 0x85, 0x13, 0x78, 0xe7, 0xf6, 0x77, 0x63, 0x13, 0x3c, 0x1c, 0x9b, 0xe0, 0xd1,
+	0xd8, 0x04, 0x7f, 0x8d, 0x4d, 0xf0, 0xd5, 0x91, 0xd9, 0x78, 0x74, 0x64, 0x36, 0x7e, 0x3d, 0x32,
+	0x1b, 0x1f, 0x6f, 0xd6, 0xde, 0x3c, 0xef, 0x96, 0x7f, 0xf7, 0xa8, 0x8b, 0x68, 0x7f, 0x41, 0xfd,
+	0x9c, 0xb9, 0xf1, 0xdf, 0x00, 0x85, 0x7f, 0xb0, 0xe8, 0x99, 0x0d, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -494,6 +549,43 @@ func (m *DelegatorWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorCommissionWithdrawInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCommissionWithdrawInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCommissionWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -780,6 +872,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorCommissionWithdrawInfos) > 0 {
+		for iNdEx := len(m.ValidatorCommissionWithdrawInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCommissionWithdrawInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.CommunityPoolSpends) > 0 {
+		for iNdEx := len(m.CommunityPoolSpends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPoolSpends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -936,6 +1056,23 @@ func (m *DelegatorWithdrawInfo) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorCommissionWithdrawInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1099,6 +1236,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CommunityPoolSpends) > 0 {
+		for _, e := range m.CommunityPoolSpends {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorCommissionWithdrawInfos) > 0 {
+		for _, e := range m.ValidatorCommissionWithdrawInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1222,6 +1371,120 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorCommissionWithdrawInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCommissionWithdrawInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCommissionWithdrawInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2367,6 +2630,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolSpends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPoolSpends = append(m.CommunityPoolSpends, CommunityPoolSpend{})
+			if err := m.CommunityPoolSpends[len(m.CommunityPoolSpends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCommissionWithdrawInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorCommissionWithdrawInfos = append(m.ValidatorCommissionWithdrawInfos, ValidatorCommissionWithdrawInfo{})
+			if err := m.ValidatorCommissionWithdrawInfos[len(m.ValidatorCommissionWithdrawInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -42,6 +42,12 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<id_Bytes>: CommunityPoolSpend
+//
+// - 0x0A: LastCommunityPoolSpendID
+//
+// - 0x0B<valAddrLen (1 Byte)><valAddr_Bytes>: sdk.AccAddress
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -53,6 +59,11 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+
+	CommunityPoolSpendPrefix    = []byte{0x09} // key for the history of the community pool spends
+	LastCommunityPoolSpendIDKey = []byte{0x0A} // key for the id of the last community pool spend
+
+	ValidatorCommissionWithdrawAddrPrefix = []byte{0x0B} // key for validator commission withdraw address
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -152,6 +163,19 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// GetValidatorCommissionWithdrawInfoAddress creates an address from a validator's commission withdraw info key.
+func GetValidatorCommissionWithdrawInfoAddress(key []byte) (valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x0B<valAddrLen (1 Byte)><valAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.ValAddress(addr)
+}
+
 // GetValidatorOutstandingRewardsKey creates the outstanding rewards key for a validator.
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
@@ -213,3 +237,13 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetValidatorCommissionWithdrawAddrKey creates the key for a validator's commission withdraw addr.
+func GetValidatorCommissionWithdrawAddrKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorCommissionWithdrawAddrPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// GetCommunityPoolSpendKey creates the key for a community pool spend.
+func GetCommunityPoolSpendKey(id uint64) []byte {
+	return append(CommunityPoolSpendPrefix, sdk.Uint64ToBigEndian(id)...)
+}
@@ -11,10 +11,17 @@ const (
 	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
 	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
 	TypeMsgFundCommunityPool           = "fund_community_pool"
+
+	TypeMsgWithdrawTokenizeShareRecordReward = "withdraw_tokenize_share_record_reward"
+	TypeMsgCommunityPoolSpend                = "community_pool_spend"
+	TypeMsgFundCommunityPoolBatch            = "fund_community_pool_batch"
+	TypeMsgSetCommissionWithdrawAddress      = "set_commission_withdraw_address"
 )
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}, &MsgWithdrawTokenizeShareRecordReward{}
+var _, _, _ sdk.Msg = &MsgCommunityPoolSpend{}, &MsgFundCommunityPool{}, &MsgFundCommunityPoolBatch{}
+var _ sdk.Msg = &MsgSetCommissionWithdrawAddress{}
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
 	return &MsgSetWithdrawAddress{
@@ -50,6 +57,42 @@ func (msg MsgSetWithdrawAddress) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgSetCommissionWithdrawAddress(valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) *MsgSetCommissionWithdrawAddress {
+	return &MsgSetCommissionWithdrawAddress{
+		ValidatorAddress: valAddr.String(),
+		WithdrawAddress:  withdrawAddr.String(),
+	}
+}
+
+func (msg MsgSetCommissionWithdrawAddress) Route() string { return ModuleName }
+func (msg MsgSetCommissionWithdrawAddress) Type() string {
+	return TypeMsgSetCommissionWithdrawAddress
+}
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetCommissionWithdrawAddress) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetCommissionWithdrawAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetCommissionWithdrawAddress) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid withdraw address: %s", err)
+	}
+
+	return nil
+}
+
 func NewMsgWithdrawDelegatorReward(delAddr sdk.AccAddress, valAddr sdk.ValAddress) *MsgWithdrawDelegatorReward {
 	return &MsgWithdrawDelegatorReward{
 		DelegatorAddress: delAddr.String(),
@@ -151,3 +194,130 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 	}
 	return nil
 }
+
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr sdk.AccAddress) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr.String(),
+	}
+}
+
+func (msg MsgWithdrawTokenizeShareRecordReward) Route() string { return ModuleName }
+func (msg MsgWithdrawTokenizeShareRecordReward) Type() string {
+	return TypeMsgWithdrawTokenizeShareRecordReward
+}
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	return []sdk.AccAddress{owner}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgWithdrawTokenizeShareRecordReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	return nil
+}
+
+// NewMsgCommunityPoolSpend returns a new MsgCommunityPoolSpend with an
+// authority, recipient and amount.
+func NewMsgCommunityPoolSpend(authority string, recipient sdk.AccAddress, amount sdk.Coins) *MsgCommunityPoolSpend {
+	return &MsgCommunityPoolSpend{
+		Authority: authority,
+		Recipient: recipient.String(),
+		Amount:    amount,
+	}
+}
+
+// Route returns the MsgCommunityPoolSpend message route.
+func (msg MsgCommunityPoolSpend) Route() string { return ModuleName }
+
+// Type returns the MsgCommunityPoolSpend message type.
+func (msg MsgCommunityPoolSpend) Type() string { return TypeMsgCommunityPoolSpend }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the authority.
+func (msg MsgCommunityPoolSpend) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCommunityPoolSpend message that
+// the expected signer needs to sign.
+func (msg MsgCommunityPoolSpend) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgCommunityPoolSpend message validation.
+func (msg MsgCommunityPoolSpend) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidProposalAmount, msg.Amount.String())
+	}
+	return nil
+}
+
+// NewMsgFundCommunityPoolBatch returns a new MsgFundCommunityPoolBatch with the
+// given deposits.
+func NewMsgFundCommunityPoolBatch(deposits []CommunityPoolDeposit) *MsgFundCommunityPoolBatch {
+	return &MsgFundCommunityPoolBatch{Deposits: deposits}
+}
+
+// Route returns the MsgFundCommunityPoolBatch message route.
+func (msg MsgFundCommunityPoolBatch) Route() string { return ModuleName }
+
+// Type returns the MsgFundCommunityPoolBatch message type.
+func (msg MsgFundCommunityPoolBatch) Type() string { return TypeMsgFundCommunityPoolBatch }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which are the distinct depositors in order.
+func (msg MsgFundCommunityPoolBatch) GetSigners() []sdk.AccAddress {
+	seen := make(map[string]bool, len(msg.Deposits))
+	var signers []sdk.AccAddress
+	for _, deposit := range msg.Deposits {
+		if seen[deposit.Depositor] {
+			continue
+		}
+		seen[deposit.Depositor] = true
+
+		depositor, _ := sdk.AccAddressFromBech32(deposit.Depositor)
+		signers = append(signers, depositor)
+	}
+	return signers
+}
+
+// GetSignBytes returns the raw bytes for a MsgFundCommunityPoolBatch message
+// that the expected signers need to sign.
+func (msg MsgFundCommunityPoolBatch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgFundCommunityPoolBatch message validation.
+func (msg MsgFundCommunityPoolBatch) ValidateBasic() error {
+	if len(msg.Deposits) == 0 {
+		return ErrEmptyDeposits
+	}
+	for _, deposit := range msg.Deposits {
+		if _, err := sdk.AccAddressFromBech32(deposit.Depositor); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid depositor address: %s", err)
+		}
+		if !deposit.Amount.IsValid() || deposit.Amount.IsZero() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, deposit.Amount.String())
+		}
+	}
+	return nil
+}
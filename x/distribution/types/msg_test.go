@@ -93,3 +93,76 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgCommunityPoolSpend(t *testing.T) {
+	tests := []struct {
+		authority  string
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		expectPass bool
+	}{
+		{"", delAddr2, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), false},
+		{delAddr1.String(), emptyDelAddr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), false},
+		{delAddr1.String(), delAddr2, sdk.NewCoins(), false},
+		{delAddr1.String(), delAddr2, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), true},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCommunityPoolSpend(tc.authority, tc.recipient, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgFundCommunityPoolBatch(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+	tests := []struct {
+		deposits   []CommunityPoolDeposit
+		expectPass bool
+	}{
+		{nil, false},
+		{[]CommunityPoolDeposit{{Depositor: "", Amount: amount}}, false},
+		{[]CommunityPoolDeposit{{Depositor: delAddr1.String(), Amount: sdk.NewCoins()}}, false},
+		{[]CommunityPoolDeposit{{Depositor: delAddr1.String(), Amount: amount}, {Depositor: delAddr2.String(), Amount: amount}}, true},
+	}
+	for i, tc := range tests {
+		msg := NewMsgFundCommunityPoolBatch(tc.deposits)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+
+	// every depositor signs the message once
+	msg := NewMsgFundCommunityPoolBatch([]CommunityPoolDeposit{
+		{Depositor: delAddr2.String(), Amount: amount},
+		{Depositor: delAddr1.String(), Amount: amount},
+		{Depositor: delAddr2.String(), Amount: amount},
+	})
+	require.Equal(t, []sdk.AccAddress{delAddr2, delAddr1}, msg.GetSigners())
+}
+
+func TestMsgSetCommissionWithdrawAddress(t *testing.T) {
+	tests := []struct {
+		validatorAddr sdk.ValAddress
+		withdrawAddr  sdk.AccAddress
+		expectPass    bool
+	}{
+		{valAddr1, delAddr1, true},
+		{emptyValAddr, delAddr1, false},
+		{valAddr1, emptyDelAddr, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSetCommissionWithdrawAddress(tc.validatorAddr, tc.withdrawAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+			require.Equal(t, []sdk.AccAddress{sdk.AccAddress(tc.validatorAddr)}, msg.GetSigners())
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -15,6 +15,8 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyFeeSplit            = []byte("feesplit")
+	ParamStoreKeyDustThreshold       = []byte("dustthreshold")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -29,9 +31,61 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+		FeeSplit:            DefaultFeeSplit(),
 	}
 }
 
+// DefaultFeeSplit returns the default split of the collected fees, matching the
+// default community tax and proposer rewards.
+func DefaultFeeSplit() FeeSplit {
+	return NewFeeSplit(
+		sdk.NewDecWithPrec(1, 2),  // 1%
+		sdk.NewDecWithPrec(4, 2),  // 4%
+		sdk.NewDecWithPrec(93, 2), // 93%
+		sdk.NewDecWithPrec(2, 2),  // 2%
+	)
+}
+
+// NewFeeSplit returns a FeeSplit of the given shares.
+func NewFeeSplit(proposer, proposerBonus, validators, communityPool sdk.Dec) FeeSplit {
+	return FeeSplit{
+		Proposer:      proposer,
+		ProposerBonus: proposerBonus,
+		Validators:    validators,
+		CommunityPool: communityPool,
+	}
+}
+
+// Validate checks that the shares of the fee split are non-negative and sum to
+// one.
+func (fs FeeSplit) Validate() error {
+	shares := []struct {
+		name  string
+		value sdk.Dec
+	}{
+		{"proposer", fs.Proposer},
+		{"proposer bonus", fs.ProposerBonus},
+		{"validators", fs.Validators},
+		{"community pool", fs.CommunityPool},
+	}
+
+	sum := sdk.ZeroDec()
+	for _, share := range shares {
+		if share.value.IsNil() {
+			return fmt.Errorf("%s share of the fee split must be not nil", share.name)
+		}
+		if share.value.IsNegative() {
+			return fmt.Errorf("%s share of the fee split must be non-negative: %s", share.name, share.value)
+		}
+		sum = sum.Add(share.value)
+	}
+	if !sum.Equal(sdk.OneDec()) {
+		return fmt.Errorf("shares of the fee split must sum to one: %s", sum)
+	}
+
+	return nil
+}
+
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
@@ -44,6 +98,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeSplit, &p.FeeSplit, validateFeeSplit),
+		paramtypes.NewParamSetPair(ParamStoreKeyDustThreshold, &p.DustThreshold, validateDustThreshold),
 	}
 }
 
@@ -70,7 +126,11 @@ func (p Params) ValidateBasic() error {
 		)
 	}
 
-	return nil
+	if err := p.FeeSplit.Validate(); err != nil {
+		return err
+	}
+
+	return validateDustThreshold(p.DustThreshold)
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -138,3 +198,39 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateDustThreshold(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid dust threshold: %w", err)
+	}
+
+	return nil
+}
+
+// SplitDust splits the given rewards into the rewards to withdraw and the dust,
+// the rewards of the denoms whose amount is below the dust threshold.
+func SplitDust(rewards, dustThreshold sdk.Coins) (withdrawn, dust sdk.Coins) {
+	for _, reward := range rewards {
+		if reward.Amount.LT(dustThreshold.AmountOf(reward.Denom)) {
+			dust = append(dust, reward)
+		} else {
+			withdrawn = append(withdrawn, reward)
+		}
+	}
+
+	return withdrawn, dust
+}
+
+func validateFeeSplit(i interface{}) error {
+	v, ok := i.(FeeSplit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
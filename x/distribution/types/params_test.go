@@ -36,6 +36,7 @@ func TestParams_ValidateBasic(t *testing.T) {
 				BaseProposerReward:  tt.fields.BaseProposerReward,
 				BonusProposerReward: tt.fields.BonusProposerReward,
 				WithdrawAddrEnabled: tt.fields.WithdrawAddrEnabled,
+				FeeSplit:            types.DefaultFeeSplit(),
 			}
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
@@ -44,6 +45,47 @@ func TestParams_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestFeeSplit_Validate(t *testing.T) {
+	toDec := sdk.MustNewDecFromStr
+
+	tests := []struct {
+		name     string
+		feeSplit types.FeeSplit
+		wantErr  bool
+	}{
+		{"success", types.NewFeeSplit(toDec("0.01"), toDec("0.04"), toDec("0.93"), toDec("0.02")), false},
+		{"all to the community pool", types.NewFeeSplit(toDec("0"), toDec("0"), toDec("0"), toDec("1")), false},
+		{"negative share", types.NewFeeSplit(toDec("-0.01"), toDec("0.04"), toDec("0.95"), toDec("0.02")), true},
+		{"sum lower than 1", types.NewFeeSplit(toDec("0.01"), toDec("0.04"), toDec("0.9"), toDec("0.02")), true},
+		{"sum greater than 1", types.NewFeeSplit(toDec("0.01"), toDec("0.04"), toDec("0.95"), toDec("0.02")), true},
+		{"nil share", types.FeeSplit{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.feeSplit.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSplitDust(t *testing.T) {
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("photon", 1), sdk.NewInt64Coin("stake", 10))
+	threshold := sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("photon", 2))
+
+	withdrawn, dust := types.SplitDust(rewards, threshold)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 10)), withdrawn)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("photon", 1)), dust)
+
+	withdrawn, dust = types.SplitDust(rewards, nil)
+	require.Equal(t, rewards, withdrawn)
+	require.True(t, dust.IsZero())
+
+	p := types.DefaultParams()
+	p.DustThreshold = sdk.Coins{sdk.NewInt64Coin("photon", 2), sdk.NewInt64Coin("atom", 5)}
+	require.Error(t, p.ValidateBasic())
+}
+
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
@@ -754,6 +754,94 @@ func (m *QueryDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryDelegatorWithdrawAddressResponse proto.InternalMessageInfo
 
+// QueryValidatorCommissionWithdrawAddressRequest is the request type for the
+// Query/ValidatorCommissionWithdrawAddress RPC method.
+type QueryValidatorCommissionWithdrawAddressRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryValidatorCommissionWithdrawAddressRequest) Reset() {
+	*m = QueryValidatorCommissionWithdrawAddressRequest{}
+}
+func (m *QueryValidatorCommissionWithdrawAddressRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorCommissionWithdrawAddressRequest) ProtoMessage() {}
+func (*QueryValidatorCommissionWithdrawAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+}
+func (m *QueryValidatorCommissionWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorCommissionWithdrawAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorCommissionWithdrawAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorCommissionWithdrawAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorCommissionWithdrawAddressRequest.Merge(m, src)
+}
+func (m *QueryValidatorCommissionWithdrawAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorCommissionWithdrawAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorCommissionWithdrawAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorCommissionWithdrawAddressRequest proto.InternalMessageInfo
+
+// QueryValidatorCommissionWithdrawAddressResponse is the response type for the
+// Query/ValidatorCommissionWithdrawAddress RPC method.
+type QueryValidatorCommissionWithdrawAddressResponse struct {
+	// withdraw_address defines the address the commission is withdrawn to.
+	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *QueryValidatorCommissionWithdrawAddressResponse) Reset() {
+	*m = QueryValidatorCommissionWithdrawAddressResponse{}
+}
+func (m *QueryValidatorCommissionWithdrawAddressResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorCommissionWithdrawAddressResponse) ProtoMessage() {}
+func (*QueryValidatorCommissionWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{17}
+}
+func (m *QueryValidatorCommissionWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorCommissionWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorCommissionWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorCommissionWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorCommissionWithdrawAddressResponse.Merge(m, src)
+}
+func (m *QueryValidatorCommissionWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorCommissionWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorCommissionWithdrawAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorCommissionWithdrawAddressResponse proto.InternalMessageInfo
+
 // QueryCommunityPoolRequest is the request type for the Query/CommunityPool RPC
 // method.
 type QueryCommunityPoolRequest struct {
@@ -763,7 +851,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +891,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{17}
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -839,6 +927,109 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryCommunityPoolSpendsRequest is the request type for the
+// Query/CommunityPoolSpends RPC method.
+type QueryCommunityPoolSpendsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCommunityPoolSpendsRequest) Reset()         { *m = QueryCommunityPoolSpendsRequest{} }
+func (m *QueryCommunityPoolSpendsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityPoolSpendsRequest) ProtoMessage()    {}
+func (*QueryCommunityPoolSpendsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryCommunityPoolSpendsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityPoolSpendsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityPoolSpendsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommunityPoolSpendsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityPoolSpendsRequest.Merge(m, src)
+}
+func (m *QueryCommunityPoolSpendsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityPoolSpendsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityPoolSpendsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommunityPoolSpendsRequest proto.InternalMessageInfo
+
+func (m *QueryCommunityPoolSpendsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCommunityPoolSpendsResponse is the response type for the
+// Query/CommunityPoolSpends RPC method.
+type QueryCommunityPoolSpendsResponse struct {
+	// spends are the community pool spends, ordered by id.
+	Spends []CommunityPoolSpend `protobuf:"bytes,1,rep,name=spends,proto3" json:"spends"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCommunityPoolSpendsResponse) Reset()         { *m = QueryCommunityPoolSpendsResponse{} }
+func (m *QueryCommunityPoolSpendsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommunityPoolSpendsResponse) ProtoMessage()    {}
+func (*QueryCommunityPoolSpendsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryCommunityPoolSpendsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommunityPoolSpendsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommunityPoolSpendsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommunityPoolSpendsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommunityPoolSpendsResponse.Merge(m, src)
+}
+func (m *QueryCommunityPoolSpendsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommunityPoolSpendsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommunityPoolSpendsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommunityPoolSpendsResponse proto.InternalMessageInfo
+
+func (m *QueryCommunityPoolSpendsResponse) GetSpends() []CommunityPoolSpend {
+	if m != nil {
+		return m.Spends
+	}
+	return nil
+}
+
+func (m *QueryCommunityPoolSpendsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -856,8 +1047,12 @@ func init() {
 	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsResponse")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryValidatorCommissionWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorCommissionWithdrawAddressRequest")
+	proto.RegisterType((*QueryValidatorCommissionWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorCommissionWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryCommunityPoolSpendsRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolSpendsRequest")
+	proto.RegisterType((*QueryCommunityPoolSpendsResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolSpendsResponse")
 }
 
 func init() {
@@ -865,78 +1060,85 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1129 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x6e, 0x9a, 0xd2, 0x57, 0x4a, 0xd2, 0x69, 0x84, 0xdc, 0x4d, 0xb0, 0xa3, 0x0d,
-	0x25, 0x11, 0x51, 0xbc, 0x4d, 0x22, 0x15, 0x68, 0x41, 0x90, 0x5f, 0xa5, 0x52, 0xab, 0x36, 0x75,
-	0xab, 0xa6, 0x70, 0xb1, 0xd6, 0xde, 0xd1, 0x7a, 0x55, 0x7b, 0xc7, 0xdd, 0x19, 0xc7, 0x44, 0x55,
-	0x2f, 0x94, 0x4a, 0x5c, 0x90, 0x90, 0xb8, 0xf4, 0x98, 0x33, 0x67, 0x10, 0x12, 0x7f, 0x41, 0x8f,
-	0x15, 0x48, 0xa8, 0x27, 0x40, 0x09, 0x42, 0xbd, 0x70, 0xe6, 0x5a, 0x79, 0x66, 0xd6, 0xde, 0xb5,
-	0xd7, 0x6b, 0x3b, 0xae, 0x4f, 0x75, 0x67, 0xe7, 0x7d, 0xdf, 0xfb, 0xbc, 0x99, 0x37, 0xef, 0x29,
-	0x30, 0x5f, 0xa4, 0xac, 0x42, 0x99, 0x61, 0x39, 0x8c, 0x7b, 0x4e, 0xa1, 0xc6, 0x1d, 0xea, 0x1a,
-	0xbb, 0xcb, 0x05, 0xc2, 0xcd, 0x65, 0xe3, 0x41, 0x8d, 0x78, 0x7b, 0xd9, 0xaa, 0x47, 0x39, 0xc5,
-	0xd3, 0x72, 0x63, 0x36, 0xb8, 0x31, 0xab, 0x36, 0x6a, 0xef, 0x2b, 0x95, 0x82, 0xc9, 0x88, 0xb4,
-	0x6a, 0x6a, 0x54, 0x4d, 0xdb, 0x71, 0x4d, 0xb1, 0x5b, 0x08, 0x69, 0x53, 0x36, 0xb5, 0xa9, 0xf8,
-	0x69, 0x34, 0x7e, 0xa9, 0xd5, 0x19, 0x9b, 0x52, 0xbb, 0x4c, 0x0c, 0xb3, 0xea, 0x18, 0xa6, 0xeb,
-	0x52, 0x2e, 0x4c, 0x98, 0xfa, 0x9a, 0x0e, 0xea, 0xfb, 0xca, 0x45, 0xea, 0xf8, 0x9a, 0xd9, 0x38,
-	0x8a, 0x50, 0xc4, 0x72, 0xff, 0x39, 0xb9, 0x3f, 0x2f, 0xc3, 0x50, 0x64, 0xe2, 0x3f, 0xfa, 0x14,
-	0xe0, 0x5b, 0x0d, 0x80, 0x6d, 0xd3, 0x33, 0x2b, 0x2c, 0x47, 0x1e, 0xd4, 0x08, 0xe3, 0xfa, 0x3d,
-	0x38, 0x1b, 0x5a, 0x65, 0x55, 0xea, 0x32, 0x82, 0xd7, 0x60, 0xbc, 0x2a, 0x56, 0x52, 0x68, 0x16,
-	0x2d, 0x9c, 0x5a, 0x99, 0xcb, 0xc6, 0x64, 0x29, 0x2b, 0x8d, 0xd7, 0xc7, 0x9e, 0xfd, 0x99, 0x49,
-	0xe4, 0x94, 0xa1, 0x5e, 0x85, 0x79, 0xa1, 0x7c, 0xd7, 0x2c, 0x3b, 0x96, 0xc9, 0xa9, 0x77, 0xb3,
-	0xc6, 0x19, 0x37, 0x5d, 0xcb, 0x71, 0xed, 0x1c, 0xa9, 0x9b, 0x9e, 0xe5, 0x07, 0x81, 0xb7, 0xe0,
-	0xcc, 0xae, 0xbf, 0x2b, 0x6f, 0x5a, 0x96, 0x47, 0x98, 0x74, 0x7c, 0x72, 0x3d, 0xf5, 0xdb, 0x4f,
-	0x4b, 0x53, 0xca, 0xf7, 0x9a, 0xfc, 0x72, 0x9b, 0x7b, 0x0d, 0x89, 0xc9, 0xa6, 0x89, 0x5a, 0xd7,
-	0xbf, 0x41, 0xb0, 0xd0, 0xdb, 0xa5, 0x22, 0xbc, 0x07, 0x27, 0x3c, 0xb9, 0xa4, 0x10, 0x3f, 0x8c,
-	0x45, 0x8c, 0x91, 0x54, 0xdc, 0xbe, 0x9c, 0x5e, 0x82, 0x4c, 0x38, 0x8a, 0x0d, 0x5a, 0xa9, 0x38,
-	0x8c, 0x39, 0xd4, 0x7d, 0xcd, 0xc0, 0x4f, 0x10, 0xcc, 0x76, 0x77, 0xa5, 0x40, 0x4d, 0x80, 0x62,
-	0x73, 0x55, 0xb1, 0x5e, 0xee, 0x8f, 0x75, 0xad, 0x58, 0xac, 0x55, 0x6a, 0x65, 0x93, 0x13, 0xab,
-	0x25, 0xac, 0x70, 0x03, 0xa2, 0xfa, 0x93, 0x24, 0xcc, 0x84, 0xe3, 0xb8, 0x5d, 0x36, 0x59, 0x89,
-	0xbc, 0xe6, 0x03, 0xc6, 0xf3, 0x30, 0xc1, 0xb8, 0xe9, 0x71, 0xc7, 0xb5, 0xf3, 0x25, 0xe2, 0xd8,
-	0x25, 0x9e, 0x4a, 0xce, 0xa2, 0x85, 0xb1, 0xdc, 0x5b, 0xfe, 0xf2, 0x55, 0xb1, 0x8a, 0xe7, 0xe0,
-	0x34, 0x11, 0x47, 0xe4, 0x6f, 0x3b, 0x26, 0xb6, 0xbd, 0x29, 0x17, 0xd5, 0xa6, 0x2b, 0x00, 0xad,
-	0x1a, 0x4e, 0x8d, 0x89, 0xc4, 0xbc, 0xe7, 0x27, 0xa6, 0x51, 0x90, 0x59, 0xf9, 0x4c, 0xb4, 0x6e,
-	0xb9, 0x4d, 0x14, 0x50, 0x2e, 0x60, 0x79, 0xe9, 0x8d, 0x6f, 0xf7, 0x33, 0x89, 0xa7, 0xfb, 0x19,
-	0xa4, 0xff, 0x8a, 0xe0, 0x9d, 0x2e, 0x79, 0x50, 0x87, 0xb1, 0x0d, 0x27, 0x98, 0x5c, 0x4a, 0xa1,
-	0xd9, 0x63, 0x0b, 0xa7, 0x56, 0x2e, 0xf4, 0x77, 0x12, 0x42, 0x67, 0x6b, 0x97, 0xb8, 0xdc, 0xbf,
-	0x6d, 0x4a, 0x06, 0x7f, 0x1e, 0xa2, 0x48, 0x0a, 0x8a, 0xf9, 0x9e, 0x14, 0x32, 0x9c, 0x20, 0x86,
-	0xfe, 0x8b, 0x1f, 0xfc, 0x26, 0x29, 0x13, 0x5b, 0xac, 0x75, 0x96, 0xa9, 0x25, 0xbf, 0x0d, 0x72,
-	0x8a, 0x4d, 0x13, 0xff, 0x14, 0x23, 0x2f, 0x43, 0x72, 0xd0, 0xcb, 0x20, 0xd3, 0xfe, 0x72, 0x3f,
-	0x93, 0xd0, 0xbf, 0x43, 0x90, 0xee, 0x16, 0xb9, 0xca, 0xfb, 0xfd, 0x60, 0xb5, 0x37, 0xf2, 0x3e,
-	0x13, 0x4a, 0x91, 0x9f, 0x9c, 0x4d, 0x52, 0xdc, 0xa0, 0x8e, 0xbb, 0xbe, 0xda, 0xc8, 0xf1, 0x8f,
-	0x7f, 0x65, 0x16, 0x6d, 0x87, 0x97, 0x6a, 0x85, 0x6c, 0x91, 0x56, 0xd4, 0x63, 0xaa, 0xfe, 0x59,
-	0x62, 0xd6, 0x7d, 0x83, 0xef, 0x55, 0x09, 0xf3, 0x6d, 0x58, 0xeb, 0x01, 0xa8, 0x81, 0xde, 0x16,
-	0xce, 0x1d, 0xca, 0xcd, 0xf2, 0x48, 0xb2, 0x19, 0x48, 0xc3, 0xbf, 0x08, 0xe6, 0x62, 0xfd, 0xaa,
-	0x5c, 0xdc, 0x6d, 0xcf, 0xc5, 0xc5, 0xd8, 0x3b, 0xd8, 0x52, 0xdb, 0xf4, 0x7d, 0x4b, 0xc5, 0xb6,
-	0x77, 0x0f, 0xdb, 0x70, 0x9c, 0x37, 0xfc, 0xa5, 0x92, 0xa3, 0xca, 0xb0, 0xd4, 0xd7, 0x3d, 0xf5,
-	0xc0, 0x36, 0xe3, 0x69, 0x96, 0xc9, 0xe8, 0x92, 0x7b, 0x5d, 0xbd, 0xb4, 0x91, 0x3e, 0x55, 0x62,
-	0xd3, 0x00, 0xcd, 0x5b, 0x2a, 0x73, 0x7b, 0x32, 0x17, 0x58, 0x09, 0xa8, 0xd5, 0xe1, 0xdd, 0xb0,
-	0xda, 0x8e, 0xc3, 0x4b, 0x96, 0x67, 0xd6, 0x95, 0xe3, 0x91, 0x61, 0xec, 0xc2, 0xf9, 0x1e, 0x8e,
-	0x15, 0xcb, 0x06, 0x4c, 0xd6, 0xd5, 0xa7, 0xbe, 0x1d, 0x4f, 0xd4, 0xc3, 0x62, 0x01, 0xbf, 0xd3,
-	0x70, 0x4e, 0xf8, 0x6d, 0xb4, 0x91, 0x9a, 0xeb, 0xf0, 0xbd, 0x6d, 0x4a, 0xcb, 0xfe, 0x0c, 0xf2,
-	0x18, 0x81, 0x16, 0xf5, 0x55, 0x85, 0x42, 0x60, 0xac, 0x4a, 0x69, 0x79, 0x74, 0x85, 0x2b, 0xe4,
-	0x57, 0x5e, 0x4c, 0xc0, 0x71, 0x11, 0x05, 0x7e, 0x8a, 0x60, 0x5c, 0x8e, 0x34, 0xd8, 0x88, 0x2d,
-	0x8d, 0xce, 0x79, 0x4a, 0xbb, 0xd0, 0xbf, 0x81, 0xc4, 0xd3, 0x17, 0xbf, 0xfe, 0xfd, 0x9f, 0x1f,
-	0x92, 0xe7, 0xf1, 0x9c, 0x11, 0x37, 0xeb, 0xc9, 0xa1, 0x0a, 0x3f, 0x4e, 0xc2, 0x74, 0xcc, 0x28,
-	0x82, 0x37, 0x7b, 0xbb, 0xef, 0x3d, 0x8f, 0x69, 0x5b, 0x43, 0xaa, 0x28, 0xb2, 0x1d, 0x41, 0x76,
-	0x0b, 0xdf, 0x8c, 0x25, 0x6b, 0x15, 0x88, 0xf1, 0xb0, 0xa3, 0x2f, 0x3c, 0x32, 0x68, 0x4b, 0x3f,
-	0xef, 0xbf, 0x34, 0x07, 0x08, 0xce, 0x46, 0x8c, 0x3c, 0xf8, 0xe3, 0x01, 0xe2, 0xee, 0x18, 0xca,
-	0xb4, 0x4f, 0x8e, 0x68, 0xad, 0x68, 0x6f, 0x08, 0xda, 0xab, 0xf8, 0xca, 0x30, 0xb4, 0xad, 0xa1,
-	0x0a, 0xff, 0x81, 0x60, 0xb2, 0x7d, 0x8e, 0xc0, 0x1f, 0x0d, 0x10, 0x63, 0x78, 0x06, 0xd3, 0x2e,
-	0x1d, 0xc5, 0x54, 0xb1, 0x5d, 0x13, 0x6c, 0x5b, 0x78, 0x63, 0x18, 0x36, 0x7f, 0x62, 0xf9, 0x0f,
-	0xc1, 0x99, 0x8e, 0x4e, 0x8d, 0xfb, 0x08, 0xaf, 0xdb, 0x60, 0xa2, 0x5d, 0x3e, 0x92, 0xad, 0x62,
-	0xcb, 0x0b, 0xb6, 0x2f, 0xf0, 0x4e, 0x2c, 0x5b, 0xf3, 0x4d, 0x65, 0xc6, 0xc3, 0x8e, 0x27, 0xf9,
-	0x91, 0xa1, 0x6e, 0x66, 0x14, 0x37, 0x7e, 0x89, 0xe0, 0xed, 0xe8, 0x96, 0x8c, 0x3f, 0x1d, 0x24,
-	0xf0, 0x88, 0x21, 0x42, 0xfb, 0xec, 0xe8, 0x02, 0x03, 0x1d, 0x6d, 0x7f, 0xf8, 0xa2, 0x30, 0x23,
-	0x3a, 0x64, 0x3f, 0x85, 0xd9, 0xbd, 0x99, 0xf7, 0x53, 0x98, 0x31, 0x6d, 0xb9, 0xcf, 0xc2, 0xec,
-	0x41, 0xd8, 0xba, 0xdb, 0xf8, 0x7f, 0x04, 0xa9, 0x6e, 0xfd, 0x13, 0xaf, 0x0d, 0x10, 0x6b, 0x74,
-	0xd3, 0xd7, 0xd6, 0x87, 0x91, 0x50, 0xcc, 0x77, 0x04, 0xf3, 0x0d, 0x7c, 0x7d, 0x18, 0xe6, 0xf6,
-	0x01, 0x00, 0xff, 0x8c, 0xe0, 0x74, 0xa8, 0x47, 0xe3, 0x8b, 0xbd, 0x63, 0x8d, 0x6a, 0xf9, 0xda,
-	0x07, 0x03, 0xdb, 0x29, 0xb0, 0x55, 0x01, 0xb6, 0x84, 0x17, 0x63, 0xc1, 0x8a, 0xbe, 0x6d, 0xbe,
-	0xd1, 0xda, 0xd7, 0xaf, 0x3d, 0x3b, 0x48, 0xa3, 0xe7, 0x07, 0x69, 0xf4, 0xf7, 0x41, 0x1a, 0x7d,
-	0x7f, 0x98, 0x4e, 0x3c, 0x3f, 0x4c, 0x27, 0x5e, 0x1c, 0xa6, 0x13, 0x5f, 0x2e, 0xc7, 0xce, 0x09,
-	0x5f, 0x85, 0xd5, 0xc5, 0xd8, 0x50, 0x18, 0x17, 0x7f, 0x4e, 0x59, 0x7d, 0x15, 0x00, 0x00, 0xff,
-	0xff, 0xc6, 0xc9, 0xca, 0xd1, 0x61, 0x12, 0x00, 0x00,
+	// 1247 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xb8, 0x69, 0xfa, 0xed, 0xeb, 0xb7, 0x34, 0x9d, 0x44, 0xc8, 0xdd, 0x04, 0xdb, 0xda,
+	0x50, 0x12, 0x11, 0xc5, 0xdb, 0x24, 0xa8, 0x40, 0x4a, 0x04, 0xf9, 0x55, 0x2a, 0x25, 0xb4, 0xa9,
+	0x53, 0x35, 0x85, 0x8b, 0xb5, 0xf1, 0xae, 0xd6, 0xab, 0xda, 0x3b, 0xee, 0xce, 0x38, 0x21, 0xaa,
+	0x2a, 0x01, 0xa5, 0x12, 0x17, 0x24, 0x24, 0x2e, 0x3d, 0xe6, 0xcc, 0x19, 0x84, 0xc4, 0x5f, 0xd0,
+	0x03, 0x42, 0x15, 0x48, 0x88, 0x13, 0xa0, 0x04, 0xa1, 0x5e, 0x38, 0x73, 0x45, 0x9e, 0x99, 0xb5,
+	0x77, 0x63, 0xef, 0xda, 0xeb, 0xc4, 0xa7, 0xba, 0x6f, 0xe7, 0x7d, 0xde, 0xe7, 0xf3, 0x66, 0xe6,
+	0xcd, 0xa7, 0x85, 0x89, 0x22, 0xa1, 0x15, 0x42, 0x35, 0xc3, 0xa6, 0xcc, 0xb5, 0xb7, 0x6b, 0xcc,
+	0x26, 0x8e, 0xb6, 0x33, 0xb3, 0x6d, 0x32, 0x7d, 0x46, 0x7b, 0x50, 0x33, 0xdd, 0xbd, 0x5c, 0xd5,
+	0x25, 0x8c, 0xe0, 0x51, 0xb1, 0x30, 0xe7, 0x5f, 0x98, 0x93, 0x0b, 0x95, 0xd7, 0x25, 0xca, 0xb6,
+	0x4e, 0x4d, 0x91, 0xd5, 0xc0, 0xa8, 0xea, 0x96, 0xed, 0xe8, 0x7c, 0x35, 0x07, 0x52, 0x46, 0x2c,
+	0x62, 0x11, 0xfe, 0x53, 0xab, 0xff, 0x92, 0xd1, 0x31, 0x8b, 0x10, 0xab, 0x6c, 0x6a, 0x7a, 0xd5,
+	0xd6, 0x74, 0xc7, 0x21, 0x8c, 0xa7, 0x50, 0xf9, 0x35, 0xed, 0xc7, 0xf7, 0x90, 0x8b, 0xc4, 0xf6,
+	0x30, 0x73, 0x51, 0x2a, 0x02, 0x8c, 0xc5, 0xfa, 0x4b, 0x62, 0x7d, 0x41, 0xd0, 0x90, 0xca, 0xf8,
+	0x5f, 0xd4, 0x11, 0xc0, 0xb7, 0xeb, 0x02, 0x36, 0x74, 0x57, 0xaf, 0xd0, 0xbc, 0xf9, 0xa0, 0x66,
+	0x52, 0xa6, 0xde, 0x83, 0xe1, 0x40, 0x94, 0x56, 0x89, 0x43, 0x4d, 0xbc, 0x08, 0x83, 0x55, 0x1e,
+	0x49, 0xa1, 0x2c, 0x9a, 0x3c, 0x37, 0x3b, 0x9e, 0x8b, 0xe8, 0x52, 0x4e, 0x24, 0x2f, 0x0d, 0x3c,
+	0xfb, 0x3d, 0x93, 0xc8, 0xcb, 0x44, 0xb5, 0x0a, 0x13, 0x1c, 0xf9, 0xae, 0x5e, 0xb6, 0x0d, 0x9d,
+	0x11, 0xf7, 0x56, 0x8d, 0x51, 0xa6, 0x3b, 0x86, 0xed, 0x58, 0x79, 0x73, 0x57, 0x77, 0x0d, 0x8f,
+	0x04, 0x5e, 0x85, 0x8b, 0x3b, 0xde, 0xaa, 0x82, 0x6e, 0x18, 0xae, 0x49, 0x45, 0xe1, 0xb3, 0x4b,
+	0xa9, 0x9f, 0xbf, 0x9d, 0x1e, 0x91, 0xb5, 0x17, 0xc5, 0x97, 0x4d, 0xe6, 0xd6, 0x21, 0x86, 0x1a,
+	0x29, 0x32, 0xae, 0x7e, 0x8e, 0x60, 0xb2, 0x73, 0x49, 0xa9, 0xf0, 0x1e, 0x9c, 0x71, 0x45, 0x48,
+	0x4a, 0x7c, 0x2b, 0x52, 0x62, 0x04, 0xa4, 0xd4, 0xed, 0xc1, 0xa9, 0x25, 0xc8, 0x04, 0x59, 0x2c,
+	0x93, 0x4a, 0xc5, 0xa6, 0xd4, 0x26, 0xce, 0x09, 0x0b, 0x7e, 0x82, 0x20, 0x1b, 0x5e, 0x4a, 0x0a,
+	0xd5, 0x01, 0x8a, 0x8d, 0xa8, 0xd4, 0x7a, 0xad, 0x3b, 0xad, 0x8b, 0xc5, 0x62, 0xad, 0x52, 0x2b,
+	0xeb, 0xcc, 0x34, 0x9a, 0xc0, 0x52, 0xae, 0x0f, 0x54, 0x7d, 0x92, 0x84, 0xb1, 0x20, 0x8f, 0xcd,
+	0xb2, 0x4e, 0x4b, 0xe6, 0x09, 0x6f, 0x30, 0x9e, 0x80, 0x0b, 0x94, 0xe9, 0x2e, 0xb3, 0x1d, 0xab,
+	0x50, 0x32, 0x6d, 0xab, 0xc4, 0x52, 0xc9, 0x2c, 0x9a, 0x1c, 0xc8, 0xbf, 0xe4, 0x85, 0x6f, 0xf0,
+	0x28, 0x1e, 0x87, 0xf3, 0xa6, 0x63, 0xf8, 0x96, 0x9d, 0xe2, 0xcb, 0xfe, 0x2f, 0x82, 0x72, 0xd1,
+	0x75, 0x80, 0xe6, 0x1d, 0x4e, 0x0d, 0xf0, 0xc6, 0xbc, 0xe6, 0x35, 0xa6, 0x7e, 0x21, 0x73, 0x62,
+	0x4c, 0x34, 0x4f, 0xb9, 0x65, 0x4a, 0x41, 0x79, 0x5f, 0xe6, 0xfc, 0xff, 0xbe, 0xd8, 0xcf, 0x24,
+	0x9e, 0xee, 0x67, 0x90, 0xfa, 0x03, 0x82, 0x57, 0x42, 0xfa, 0x20, 0x37, 0x63, 0x03, 0xce, 0x50,
+	0x11, 0x4a, 0xa1, 0xec, 0xa9, 0xc9, 0x73, 0xb3, 0x57, 0xba, 0xdb, 0x09, 0x8e, 0xb3, 0xba, 0x63,
+	0x3a, 0xcc, 0x3b, 0x6d, 0x12, 0x06, 0xbf, 0x1f, 0x50, 0x91, 0xe4, 0x2a, 0x26, 0x3a, 0xaa, 0x10,
+	0x74, 0xfc, 0x32, 0xd4, 0xef, 0x3d, 0xf2, 0x2b, 0x66, 0xd9, 0xb4, 0x78, 0xac, 0xf5, 0x9a, 0x1a,
+	0xe2, 0x5b, 0x9c, 0x5d, 0x6c, 0xa4, 0x78, 0xbb, 0xd8, 0xf6, 0x30, 0x24, 0xe3, 0x1e, 0x06, 0xd1,
+	0xf6, 0x17, 0xfb, 0x99, 0x84, 0xfa, 0x25, 0x82, 0x74, 0x18, 0x73, 0xd9, 0xf7, 0xfb, 0xfe, 0xdb,
+	0x5e, 0xef, 0xfb, 0x58, 0xa0, 0x45, 0x5e, 0x73, 0x56, 0xcc, 0xe2, 0x32, 0xb1, 0x9d, 0xa5, 0xb9,
+	0x7a, 0x8f, 0xbf, 0xf9, 0x23, 0x33, 0x65, 0xd9, 0xac, 0x54, 0xdb, 0xce, 0x15, 0x49, 0x45, 0x0e,
+	0x53, 0xf9, 0xc7, 0x34, 0x35, 0xee, 0x6b, 0x6c, 0xaf, 0x6a, 0x52, 0x2f, 0x87, 0x36, 0x07, 0x40,
+	0x0d, 0xd4, 0x23, 0x74, 0xee, 0x10, 0xa6, 0x97, 0xfb, 0xd2, 0x4d, 0x5f, 0x1b, 0xfe, 0x46, 0x30,
+	0x1e, 0x59, 0x57, 0xf6, 0xe2, 0xee, 0xd1, 0x5e, 0x5c, 0x8d, 0x3c, 0x83, 0x4d, 0xb4, 0x15, 0xaf,
+	0xb6, 0x40, 0x3c, 0x32, 0xf7, 0xb0, 0x05, 0xa7, 0x59, 0xbd, 0x5e, 0x2a, 0xd9, 0xaf, 0x0e, 0x0b,
+	0x7c, 0xd5, 0x95, 0x03, 0xb6, 0xc1, 0xa7, 0x71, 0x4d, 0xfa, 0xd7, 0xdc, 0x75, 0xc8, 0x86, 0xd7,
+	0x94, 0x8d, 0x4d, 0x03, 0x34, 0x4e, 0xa9, 0xe8, 0xed, 0xd9, 0xbc, 0x2f, 0xe2, 0x43, 0xdb, 0x85,
+	0x57, 0x83, 0x68, 0x5b, 0x36, 0x2b, 0x19, 0xae, 0xbe, 0x2b, 0x0b, 0xf7, 0x4d, 0xc6, 0x0e, 0x5c,
+	0xee, 0x50, 0x58, 0x6a, 0x59, 0x86, 0xa1, 0x5d, 0xf9, 0xa9, 0xeb, 0xc2, 0x17, 0x76, 0x83, 0x60,
+	0xbe, 0xba, 0x9f, 0x22, 0xc8, 0x85, 0xbd, 0x54, 0xe1, 0xda, 0x4f, 0xe0, 0xcd, 0xf0, 0x71, 0xf8,
+	0x04, 0x81, 0xd6, 0x35, 0x87, 0xfe, 0xb4, 0x61, 0x14, 0x2e, 0x71, 0x06, 0xf5, 0xc2, 0x35, 0xc7,
+	0x66, 0x7b, 0x1b, 0x84, 0x94, 0x3d, 0x2b, 0xf6, 0x18, 0x81, 0xd2, 0xee, 0xab, 0xa4, 0x62, 0xc2,
+	0x40, 0x95, 0x90, 0x72, 0xff, 0xe6, 0x17, 0x87, 0x57, 0x6d, 0xc8, 0xb4, 0x92, 0xd8, 0xac, 0x9a,
+	0x4e, 0x73, 0x72, 0x05, 0x1f, 0x4e, 0xd4, 0xeb, 0xc3, 0x59, 0x7f, 0x2e, 0xb3, 0xe1, 0xb5, 0xa4,
+	0xec, 0x0f, 0x60, 0x90, 0xf2, 0x88, 0x14, 0xae, 0x45, 0x0e, 0xab, 0x56, 0x24, 0xcf, 0x95, 0x0a,
+	0x90, 0x13, 0x7b, 0x2e, 0x67, 0x7f, 0x1c, 0x86, 0xd3, 0x9c, 0x3c, 0x7e, 0x8a, 0x60, 0x50, 0x38,
+	0x60, 0x1c, 0x4d, 0xae, 0xd5, 0x7e, 0x2b, 0x57, 0xba, 0x4f, 0x10, 0x1c, 0xd4, 0xa9, 0xcf, 0x7e,
+	0xf9, 0xeb, 0xeb, 0xe4, 0x65, 0x3c, 0xae, 0x45, 0xfd, 0xd3, 0x40, 0x78, 0x70, 0xfc, 0x38, 0x09,
+	0xa3, 0x11, 0xce, 0x15, 0xaf, 0x74, 0x2e, 0xdf, 0xd9, 0xbe, 0x2b, 0xab, 0xc7, 0x44, 0x91, 0xca,
+	0xb6, 0xb8, 0xb2, 0xdb, 0xf8, 0x56, 0xa4, 0xb2, 0xe6, 0x3c, 0xd5, 0x1e, 0xb6, 0xcc, 0x87, 0x47,
+	0x1a, 0x69, 0xe2, 0x17, 0xbc, 0x87, 0xe9, 0x00, 0xc1, 0x70, 0x9b, 0x3b, 0x8f, 0xdf, 0x89, 0xc1,
+	0xbb, 0xc5, 0xc3, 0x2b, 0x0b, 0x3d, 0x66, 0x4b, 0xb5, 0x37, 0xb9, 0xda, 0x1b, 0xf8, 0xfa, 0x71,
+	0xd4, 0x36, 0x3d, 0x38, 0xfe, 0x15, 0xc1, 0xd0, 0x51, 0xdb, 0x89, 0xdf, 0x8e, 0xc1, 0x31, 0x68,
+	0xd9, 0x95, 0xf9, 0x5e, 0x52, 0xa5, 0xb6, 0x35, 0xae, 0x6d, 0x15, 0x2f, 0x1f, 0x47, 0x9b, 0x67,
+	0x70, 0xff, 0x41, 0x70, 0xb1, 0xc5, 0xd8, 0xe1, 0x2e, 0xe8, 0x85, 0xf9, 0x58, 0xe5, 0x5a, 0x4f,
+	0xb9, 0x52, 0x5b, 0x81, 0x6b, 0xfb, 0x10, 0x6f, 0x45, 0x6a, 0x6b, 0x3c, 0xc1, 0x54, 0x7b, 0xd8,
+	0xf2, 0x82, 0x3f, 0xd2, 0xe4, 0xc9, 0x6c, 0xa7, 0x1b, 0xbf, 0x40, 0xf0, 0x72, 0x7b, 0x07, 0x87,
+	0xdf, 0x8d, 0x43, 0xbc, 0x8d, 0xe7, 0x54, 0xde, 0xeb, 0x1d, 0x20, 0xd6, 0xd6, 0x76, 0x27, 0x9f,
+	0x5f, 0xcc, 0x36, 0x86, 0xaa, 0x9b, 0x8b, 0x19, 0xee, 0xfd, 0x94, 0x85, 0x1e, 0xb3, 0x63, 0x5d,
+	0xcc, 0x0e, 0x0a, 0x9b, 0x67, 0x1b, 0xff, 0x8b, 0x20, 0x15, 0x66, 0xb7, 0xf0, 0x62, 0x0c, 0xae,
+	0xed, 0x7d, 0x92, 0xb2, 0x74, 0x1c, 0x08, 0xa9, 0xf9, 0x0e, 0xd7, 0x7c, 0x13, 0xaf, 0x1f, 0x47,
+	0xf3, 0x51, 0xa3, 0x84, 0xf7, 0x93, 0xa0, 0x76, 0xf6, 0x5a, 0x78, 0xad, 0xa7, 0x41, 0x1a, 0xd2,
+	0x8d, 0xf5, 0x93, 0x01, 0x8b, 0x75, 0xd9, 0xbb, 0x1e, 0xd2, 0x85, 0x96, 0x16, 0x7d, 0x87, 0xe0,
+	0x7c, 0xc0, 0xb3, 0xe0, 0xab, 0x9d, 0x05, 0xb4, 0x73, 0x8f, 0xca, 0x9b, 0xb1, 0xf3, 0xa4, 0xc6,
+	0x39, 0xae, 0x71, 0x1a, 0x4f, 0x45, 0x6a, 0x2c, 0x7a, 0xb9, 0x85, 0xba, 0x4b, 0xc4, 0x3f, 0x21,
+	0x18, 0x6e, 0xe3, 0xda, 0xba, 0xb9, 0xb9, 0xe1, 0xc6, 0x52, 0x59, 0xe8, 0x31, 0x5b, 0x2a, 0x99,
+	0xe7, 0x4a, 0xde, 0xc0, 0xb3, 0x31, 0x94, 0x68, 0xc2, 0x17, 0x2e, 0xad, 0x3d, 0x3b, 0x48, 0xa3,
+	0xe7, 0x07, 0x69, 0xf4, 0xe7, 0x41, 0x1a, 0x7d, 0x75, 0x98, 0x4e, 0x3c, 0x3f, 0x4c, 0x27, 0x7e,
+	0x3b, 0x4c, 0x27, 0x3e, 0x9a, 0x89, 0xf4, 0xd0, 0x1f, 0x07, 0x8b, 0x70, 0x4b, 0xbd, 0x3d, 0xc8,
+	0xff, 0xc7, 0x75, 0xee, 0xbf, 0x01, 0x00, 0x4e, 0xf7, 0x88, 0xe4, 0x84, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -968,8 +1170,13 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// ValidatorCommissionWithdrawAddress queries the address the commission of a
+	// validator is withdrawn to.
+	ValidatorCommissionWithdrawAddress(ctx context.Context, in *QueryValidatorCommissionWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// CommunityPoolSpends queries the history of the community pool spends.
+	CommunityPoolSpends(ctx context.Context, in *QueryCommunityPoolSpendsRequest, opts ...grpc.CallOption) (*QueryCommunityPoolSpendsResponse, error)
 }
 
 type queryClient struct {
@@ -1052,6 +1259,15 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) ValidatorCommissionWithdrawAddress(ctx context.Context, in *QueryValidatorCommissionWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionWithdrawAddressResponse, error) {
+	out := new(QueryValidatorCommissionWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/ValidatorCommissionWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/CommunityPool", in, out, opts...)
@@ -1061,6 +1277,15 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) CommunityPoolSpends(ctx context.Context, in *QueryCommunityPoolSpendsRequest, opts ...grpc.CallOption) (*QueryCommunityPoolSpendsResponse, error) {
+	out := new(QueryCommunityPoolSpendsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/CommunityPoolSpends", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1080,8 +1305,13 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// ValidatorCommissionWithdrawAddress queries the address the commission of a
+	// validator is withdrawn to.
+	ValidatorCommissionWithdrawAddress(context.Context, *QueryValidatorCommissionWithdrawAddressRequest) (*QueryValidatorCommissionWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// CommunityPoolSpends queries the history of the community pool spends.
+	CommunityPoolSpends(context.Context, *QueryCommunityPoolSpendsRequest) (*QueryCommunityPoolSpendsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1112,9 +1342,15 @@ func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorCommissionWithdrawAddress(ctx context.Context, req *QueryValidatorCommissionWithdrawAddressRequest) (*QueryValidatorCommissionWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCommissionWithdrawAddress not implemented")
+}
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) CommunityPoolSpends(ctx context.Context, req *QueryCommunityPoolSpendsRequest) (*QueryCommunityPoolSpendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolSpends not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1264,6 +1500,24 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorCommissionWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorCommissionWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorCommissionWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/ValidatorCommissionWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorCommissionWithdrawAddress(ctx, req.(*QueryValidatorCommissionWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -1282,6 +1536,24 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CommunityPoolSpends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommunityPoolSpendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityPoolSpends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/CommunityPoolSpends",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunityPoolSpends(ctx, req.(*QueryCommunityPoolSpendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1318,10 +1590,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "ValidatorCommissionWithdrawAddress",
+			Handler:    _Query_ValidatorCommissionWithdrawAddress_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "CommunityPoolSpends",
+			Handler:    _Query_CommunityPoolSpends_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -1887,6 +2167,66 @@ func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryValidatorCommissionWithdrawAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCommissionWithdrawAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCommissionWithdrawAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCommissionWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCommissionWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCommissionWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1947,46 +2287,130 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCommunityPoolSpendsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryCommunityPoolSpendsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCommunityPoolSpendsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityPoolSpendsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolSpendsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolSpendsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Spends) > 0 {
+		for iNdEx := len(m.Spends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
@@ -2188,6 +2612,32 @@ func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidatorCommissionWithdrawAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorCommissionWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryCommunityPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2212,6 +2662,38 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCommunityPoolSpendsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommunityPoolSpendsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Spends) > 0 {
+		for _, e := range m.Spends {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3683,6 +4165,170 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryValidatorCommissionWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionWithdrawAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCommissionWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3817,6 +4463,212 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCommunityPoolSpendsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityPoolSpendsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityPoolSpendsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommunityPoolSpendsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityPoolSpendsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityPoolSpendsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spends = append(m.Spends, CommunityPoolSpend{})
+			if err := m.Spends[len(m.Spends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -108,6 +108,86 @@ func (m *MsgSetWithdrawAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetWithdrawAddressResponse proto.InternalMessageInfo
 
+// MsgSetCommissionWithdrawAddress sets the address the commission of a
+// validator is withdrawn to.
+type MsgSetCommissionWithdrawAddress struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	WithdrawAddress  string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *MsgSetCommissionWithdrawAddress) Reset()         { *m = MsgSetCommissionWithdrawAddress{} }
+func (m *MsgSetCommissionWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCommissionWithdrawAddress) ProtoMessage()    {}
+func (*MsgSetCommissionWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{2}
+}
+func (m *MsgSetCommissionWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCommissionWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCommissionWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCommissionWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCommissionWithdrawAddress.Merge(m, src)
+}
+func (m *MsgSetCommissionWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCommissionWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCommissionWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCommissionWithdrawAddress proto.InternalMessageInfo
+
+// MsgSetCommissionWithdrawAddressResponse defines the
+// Msg/SetCommissionWithdrawAddress response type.
+type MsgSetCommissionWithdrawAddressResponse struct {
+}
+
+func (m *MsgSetCommissionWithdrawAddressResponse) Reset() {
+	*m = MsgSetCommissionWithdrawAddressResponse{}
+}
+func (m *MsgSetCommissionWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCommissionWithdrawAddressResponse) ProtoMessage()    {}
+func (*MsgSetCommissionWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{3}
+}
+func (m *MsgSetCommissionWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCommissionWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCommissionWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCommissionWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCommissionWithdrawAddressResponse.Merge(m, src)
+}
+func (m *MsgSetCommissionWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCommissionWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCommissionWithdrawAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCommissionWithdrawAddressResponse proto.InternalMessageInfo
+
 // MsgWithdrawDelegatorReward represents delegation withdrawal to a delegator
 // from a single validator.
 type MsgWithdrawDelegatorReward struct {
@@ -119,7 +199,7 @@ func (m *MsgWithdrawDelegatorReward) Reset()         { *m = MsgWithdrawDelegator
 func (m *MsgWithdrawDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawDelegatorReward) ProtoMessage()    {}
 func (*MsgWithdrawDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{2}
+	return fileDescriptor_ed4f433d965e58ca, []int{4}
 }
 func (m *MsgWithdrawDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -156,7 +236,7 @@ func (m *MsgWithdrawDelegatorRewardResponse) Reset()         { *m = MsgWithdrawD
 func (m *MsgWithdrawDelegatorRewardResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawDelegatorRewardResponse) ProtoMessage()    {}
 func (*MsgWithdrawDelegatorRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{3}
+	return fileDescriptor_ed4f433d965e58ca, []int{5}
 }
 func (m *MsgWithdrawDelegatorRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +275,7 @@ func (m *MsgWithdrawValidatorCommission) Reset()         { *m = MsgWithdrawValid
 func (m *MsgWithdrawValidatorCommission) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawValidatorCommission) ProtoMessage()    {}
 func (*MsgWithdrawValidatorCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{4}
+	return fileDescriptor_ed4f433d965e58ca, []int{6}
 }
 func (m *MsgWithdrawValidatorCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,7 +314,7 @@ func (m *MsgWithdrawValidatorCommissionResponse) Reset() {
 func (m *MsgWithdrawValidatorCommissionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawValidatorCommissionResponse) ProtoMessage()    {}
 func (*MsgWithdrawValidatorCommissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{5}
+	return fileDescriptor_ed4f433d965e58ca, []int{7}
 }
 func (m *MsgWithdrawValidatorCommissionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,7 +354,7 @@ func (m *MsgFundCommunityPool) Reset()         { *m = MsgFundCommunityPool{} }
 func (m *MsgFundCommunityPool) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCommunityPool) ProtoMessage()    {}
 func (*MsgFundCommunityPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{6}
+	return fileDescriptor_ed4f433d965e58ca, []int{8}
 }
 func (m *MsgFundCommunityPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,7 +391,7 @@ func (m *MsgFundCommunityPoolResponse) Reset()         { *m = MsgFundCommunityPo
 func (m *MsgFundCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCommunityPoolResponse) ProtoMessage()    {}
 func (*MsgFundCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed4f433d965e58ca, []int{7}
+	return fileDescriptor_ed4f433d965e58ca, []int{9}
 }
 func (m *MsgFundCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,15 +420,270 @@ func (m *MsgFundCommunityPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFundCommunityPoolResponse proto.InternalMessageInfo
 
+// MsgWithdrawTokenizeShareRecordReward withdraws the rewards of the delegations
+// of all the tokenize share records owned by the owner address.
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset()         { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{10}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward proto.InternalMessageInfo
+
+// MsgWithdrawTokenizeShareRecordRewardResponse defines the
+// Msg/WithdrawTokenizeShareRecordReward response type.
+type MsgWithdrawTokenizeShareRecordRewardResponse struct {
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Reset() {
+	*m = MsgWithdrawTokenizeShareRecordRewardResponse{}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) ProtoMessage() {}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{11}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse proto.InternalMessageInfo
+
+// MsgCommunityPoolSpend sends coins from the community pool to a recipient. It
+// can only be executed by the authority of the module.
+type MsgCommunityPoolSpend struct {
+	// authority is the address of the governance module account.
+	Authority string                                   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Recipient string                                   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgCommunityPoolSpend) Reset()         { *m = MsgCommunityPoolSpend{} }
+func (m *MsgCommunityPoolSpend) String() string { return proto.CompactTextString(m) }
+func (*MsgCommunityPoolSpend) ProtoMessage()    {}
+func (*MsgCommunityPoolSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{12}
+}
+func (m *MsgCommunityPoolSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommunityPoolSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommunityPoolSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommunityPoolSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommunityPoolSpend.Merge(m, src)
+}
+func (m *MsgCommunityPoolSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommunityPoolSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommunityPoolSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommunityPoolSpend proto.InternalMessageInfo
+
+// MsgCommunityPoolSpendResponse defines the Msg/CommunityPoolSpend response
+// type.
+type MsgCommunityPoolSpendResponse struct {
+	// id is the id of the community pool spend record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCommunityPoolSpendResponse) Reset()         { *m = MsgCommunityPoolSpendResponse{} }
+func (m *MsgCommunityPoolSpendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommunityPoolSpendResponse) ProtoMessage()    {}
+func (*MsgCommunityPoolSpendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{13}
+}
+func (m *MsgCommunityPoolSpendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommunityPoolSpendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommunityPoolSpendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommunityPoolSpendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommunityPoolSpendResponse.Merge(m, src)
+}
+func (m *MsgCommunityPoolSpendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommunityPoolSpendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommunityPoolSpendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommunityPoolSpendResponse proto.InternalMessageInfo
+
+func (m *MsgCommunityPoolSpendResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgFundCommunityPoolBatch funds the community pool with the deposits of
+// several accounts, which must all sign the message.
+type MsgFundCommunityPoolBatch struct {
+	Deposits []CommunityPoolDeposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits"`
+}
+
+func (m *MsgFundCommunityPoolBatch) Reset()         { *m = MsgFundCommunityPoolBatch{} }
+func (m *MsgFundCommunityPoolBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgFundCommunityPoolBatch) ProtoMessage()    {}
+func (*MsgFundCommunityPoolBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{14}
+}
+func (m *MsgFundCommunityPoolBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundCommunityPoolBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundCommunityPoolBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundCommunityPoolBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundCommunityPoolBatch.Merge(m, src)
+}
+func (m *MsgFundCommunityPoolBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundCommunityPoolBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundCommunityPoolBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundCommunityPoolBatch proto.InternalMessageInfo
+
+// MsgFundCommunityPoolBatchResponse defines the Msg/FundCommunityPoolBatch
+// response type.
+type MsgFundCommunityPoolBatchResponse struct {
+}
+
+func (m *MsgFundCommunityPoolBatchResponse) Reset()         { *m = MsgFundCommunityPoolBatchResponse{} }
+func (m *MsgFundCommunityPoolBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundCommunityPoolBatchResponse) ProtoMessage()    {}
+func (*MsgFundCommunityPoolBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{15}
+}
+func (m *MsgFundCommunityPoolBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundCommunityPoolBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundCommunityPoolBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundCommunityPoolBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundCommunityPoolBatchResponse.Merge(m, src)
+}
+func (m *MsgFundCommunityPoolBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundCommunityPoolBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundCommunityPoolBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundCommunityPoolBatchResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
+	proto.RegisterType((*MsgSetCommissionWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetCommissionWithdrawAddress")
+	proto.RegisterType((*MsgSetCommissionWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetCommissionWithdrawAddressResponse")
 	proto.RegisterType((*MsgWithdrawDelegatorReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward")
 	proto.RegisterType((*MsgWithdrawDelegatorRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawDelegatorRewardResponse")
 	proto.RegisterType((*MsgWithdrawValidatorCommission)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission")
 	proto.RegisterType((*MsgWithdrawValidatorCommissionResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommissionResponse")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPool")
 	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPoolResponse")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordReward")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordRewardResponse")
+	proto.RegisterType((*MsgCommunityPoolSpend)(nil), "cosmos.distribution.v1beta1.MsgCommunityPoolSpend")
+	proto.RegisterType((*MsgCommunityPoolSpendResponse)(nil), "cosmos.distribution.v1beta1.MsgCommunityPoolSpendResponse")
+	proto.RegisterType((*MsgFundCommunityPoolBatch)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPoolBatch")
+	proto.RegisterType((*MsgFundCommunityPoolBatchResponse)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPoolBatchResponse")
 }
 
 func init() {
@@ -356,42 +691,58 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 551 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x7d, 0x14, 0x55, 0xf4, 0x18, 0x68, 0xac, 0x20, 0x52, 0x17, 0x2e, 0x55, 0x54, 0xa1,
-	0x2c, 0xb5, 0x49, 0x90, 0x40, 0x94, 0x01, 0x91, 0x50, 0xb6, 0x08, 0x94, 0x4a, 0x20, 0xb1, 0x54,
-	0x76, 0xee, 0xe4, 0x9e, 0x88, 0xfd, 0x06, 0xdf, 0xb9, 0x69, 0x47, 0x24, 0x06, 0x46, 0x24, 0x3e,
-	0x00, 0x1d, 0x11, 0x12, 0x5b, 0x57, 0xf6, 0x8c, 0x15, 0x13, 0x13, 0xa0, 0x64, 0xe1, 0x63, 0xa0,
-	0xd8, 0x67, 0x93, 0x28, 0x4e, 0x4c, 0x29, 0x53, 0xfe, 0xbc, 0xcf, 0xf3, 0xdc, 0xef, 0x55, 0x9e,
-	0x8b, 0xf1, 0x66, 0x07, 0x84, 0x07, 0xc2, 0xa2, 0x5c, 0xc8, 0x80, 0x3b, 0xa1, 0xe4, 0xe0, 0x5b,
-	0x07, 0x35, 0x87, 0x49, 0xbb, 0x66, 0xc9, 0x43, 0xb3, 0x17, 0x80, 0x04, 0x7d, 0x3d, 0x56, 0x99,
-	0x93, 0x2a, 0x53, 0xa9, 0x8c, 0xa2, 0x0b, 0x2e, 0x44, 0x3a, 0x6b, 0xfc, 0x2e, 0xb6, 0x18, 0x44,
-	0x05, 0x3b, 0xb6, 0x60, 0x69, 0x60, 0x07, 0xb8, 0xaf, 0xe6, 0x6b, 0xf1, 0x7c, 0x2f, 0x36, 0xaa,
-	0xfc, 0xe8, 0x43, 0xe5, 0x33, 0xc2, 0x57, 0x5b, 0xc2, 0xdd, 0x65, 0xf2, 0x39, 0x97, 0xfb, 0x34,
-	0xb0, 0xfb, 0x0f, 0x29, 0x0d, 0x98, 0x10, 0xfa, 0x0e, 0x2e, 0x50, 0xd6, 0x65, 0xae, 0x2d, 0x21,
-	0xd8, 0xb3, 0xe3, 0x2f, 0x4b, 0x68, 0x03, 0x55, 0x57, 0x1a, 0xa5, 0xaf, 0x27, 0x5b, 0x45, 0x15,
-	0xa3, 0xe4, 0xbb, 0x32, 0xe0, 0xbe, 0xdb, 0x5e, 0x4d, 0x2d, 0x49, 0x4c, 0x13, 0xaf, 0xf6, 0x55,
-	0x72, 0x9a, 0x72, 0x21, 0x27, 0xe5, 0x4a, 0x7f, 0x9a, 0x65, 0xfb, 0xd2, 0xdb, 0xe3, 0xb2, 0xf6,
-	0xeb, 0xb8, 0xac, 0x55, 0xca, 0xf8, 0x46, 0x26, 0x6e, 0x9b, 0x89, 0x1e, 0xf8, 0x82, 0x55, 0x4e,
-	0x10, 0x36, 0x5a, 0xc2, 0x4d, 0xc6, 0x8f, 0x12, 0x9e, 0x36, 0xeb, 0xdb, 0x01, 0xfd, 0x5f, 0x5b,
-	0xed, 0xe0, 0xc2, 0x81, 0xdd, 0xe5, 0x74, 0x2a, 0x26, 0x6f, 0xad, 0xd5, 0xd4, 0x32, 0xbb, 0xd7,
-	0x26, 0xae, 0xcc, 0xa7, 0x4e, 0x97, 0x7b, 0x85, 0xc9, 0x84, 0xea, 0x59, 0x12, 0xd7, 0x04, 0xcf,
-	0xe3, 0x42, 0x70, 0xf0, 0xb3, 0xc1, 0xd0, 0x39, 0xc0, 0xaa, 0xf8, 0xe6, 0xe2, 0x23, 0x53, 0xb8,
-	0x2f, 0x08, 0x17, 0x5b, 0xc2, 0x7d, 0x1c, 0xfa, 0x74, 0x3c, 0x0d, 0x7d, 0x2e, 0x8f, 0x9e, 0x02,
-	0x74, 0xf5, 0x0e, 0x5e, 0xb6, 0x3d, 0x08, 0x7d, 0x59, 0x42, 0x1b, 0x4b, 0xd5, 0xcb, 0xf5, 0x35,
-	0x53, 0x51, 0x8c, 0xfb, 0x9a, 0x54, 0xdb, 0x6c, 0x02, 0xf7, 0x1b, 0xb7, 0x06, 0xdf, 0xcb, 0xda,
-	0xa7, 0x1f, 0xe5, 0xaa, 0xcb, 0xe5, 0x7e, 0xe8, 0x98, 0x1d, 0xf0, 0x54, 0x5f, 0xd5, 0xcb, 0x96,
-	0xa0, 0x2f, 0x2d, 0x79, 0xd4, 0x63, 0x22, 0x32, 0x88, 0xb6, 0x8a, 0xd6, 0xef, 0xe0, 0x15, 0xca,
-	0x7a, 0x20, 0xb8, 0x84, 0x20, 0xf7, 0x97, 0xf8, 0x23, 0x9d, 0xd8, 0x94, 0xe0, 0xeb, 0x59, 0xf8,
-	0xc9, 0x7e, 0xf5, 0xc1, 0x45, 0xbc, 0xd4, 0x12, 0xae, 0xfe, 0x06, 0x61, 0x3d, 0xe3, 0xbe, 0xd4,
-	0xcd, 0x05, 0x17, 0xd7, 0xcc, 0x2c, 0xad, 0xb1, 0x7d, 0x76, 0x4f, 0x82, 0xa3, 0xbf, 0x47, 0xf8,
-	0xda, 0xbc, 0x96, 0xdf, 0xcd, 0xcb, 0x9d, 0x63, 0x34, 0x1e, 0xfc, 0xa3, 0x31, 0xa5, 0xfa, 0x80,
-	0xf0, 0xfa, 0xa2, 0x7e, 0xde, 0xff, 0xdb, 0x03, 0x32, 0xcc, 0x46, 0xf3, 0x1c, 0xe6, 0x94, 0xf0,
-	0x35, 0xc2, 0x85, 0xd9, 0x8e, 0xd6, 0xf2, 0xa2, 0x67, 0x2c, 0xc6, 0xbd, 0x33, 0x5b, 0x12, 0x86,
-	0xc6, 0x93, 0x8f, 0x43, 0x82, 0x06, 0x43, 0x82, 0x4e, 0x87, 0x04, 0xfd, 0x1c, 0x12, 0xf4, 0x6e,
-	0x44, 0xb4, 0xd3, 0x11, 0xd1, 0xbe, 0x8d, 0x88, 0xf6, 0xa2, 0xb6, 0xb0, 0xfc, 0x87, 0xd3, 0xcf,
-	0x8f, 0xe8, 0x2e, 0x38, 0xcb, 0xd1, 0xbf, 0xf9, 0xed, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2b,
-	0xc5, 0x87, 0x39, 0x63, 0x06, 0x00, 0x00,
+	// 802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6b, 0x13, 0x4f,
+	0x18, 0xce, 0xa4, 0xa5, 0xb4, 0xef, 0xef, 0xa7, 0xb6, 0x4b, 0xd5, 0x76, 0xdb, 0x6e, 0xda, 0x58,
+	0x34, 0x82, 0xdd, 0x98, 0x0a, 0x15, 0xeb, 0x17, 0x4d, 0xaa, 0xe0, 0x21, 0x28, 0x89, 0x28, 0x78,
+	0x29, 0x9b, 0xec, 0xb0, 0x19, 0xda, 0xec, 0xc4, 0x9d, 0x49, 0xd3, 0xf6, 0x26, 0x78, 0xf0, 0x58,
+	0xf0, 0x0f, 0xb0, 0x78, 0x12, 0xc1, 0x83, 0x50, 0xf0, 0x20, 0xde, 0x7b, 0x2c, 0x9e, 0x3c, 0xa9,
+	0xa4, 0x17, 0xff, 0x02, 0xcf, 0x92, 0xfd, 0x98, 0x6e, 0xc8, 0x26, 0x9b, 0xf4, 0xe3, 0x94, 0xb0,
+	0xf3, 0x3e, 0xcf, 0x3c, 0xcf, 0xf3, 0xee, 0xbc, 0xb3, 0x30, 0x5b, 0xa4, 0xac, 0x4c, 0x59, 0x52,
+	0x27, 0x8c, 0x5b, 0xa4, 0x50, 0xe5, 0x84, 0x9a, 0xc9, 0xf5, 0x54, 0x01, 0x73, 0x2d, 0x95, 0xe4,
+	0x1b, 0x6a, 0xc5, 0xa2, 0x9c, 0x4a, 0x13, 0x4e, 0x95, 0xea, 0xaf, 0x52, 0xdd, 0x2a, 0x79, 0xd4,
+	0xa0, 0x06, 0xb5, 0xeb, 0x92, 0x8d, 0x7f, 0x0e, 0x44, 0x56, 0x5c, 0xe2, 0x82, 0xc6, 0xb0, 0x20,
+	0x2c, 0x52, 0x62, 0xba, 0xeb, 0xe3, 0xce, 0xfa, 0x8a, 0x03, 0x74, 0xf9, 0x9d, 0x25, 0xb5, 0x93,
+	0xa6, 0x26, 0x09, 0x76, 0x7d, 0xfc, 0x13, 0x82, 0xf3, 0x59, 0x66, 0xe4, 0x31, 0x7f, 0x4e, 0x78,
+	0x49, 0xb7, 0xb4, 0xda, 0x92, 0xae, 0x5b, 0x98, 0x31, 0xe9, 0x01, 0x8c, 0xe8, 0x78, 0x0d, 0x1b,
+	0x1a, 0xa7, 0xd6, 0x8a, 0xe6, 0x3c, 0x1c, 0x43, 0xd3, 0x28, 0x31, 0x94, 0x1e, 0xfb, 0xbe, 0x3b,
+	0x37, 0xea, 0x6e, 0xeb, 0x96, 0xe7, 0xb9, 0x45, 0x4c, 0x23, 0x37, 0x2c, 0x20, 0x1e, 0x4d, 0x06,
+	0x86, 0x6b, 0x2e, 0xb3, 0x60, 0x89, 0x86, 0xb0, 0x9c, 0xab, 0x35, 0x6b, 0x59, 0x1c, 0x7c, 0xb3,
+	0x13, 0x8b, 0xfc, 0xd9, 0x89, 0x45, 0xe2, 0x31, 0x98, 0x0a, 0x94, 0x9b, 0xc3, 0xac, 0x42, 0x4d,
+	0x86, 0xe3, 0x5f, 0x10, 0xc4, 0x9c, 0x8a, 0x0c, 0x2d, 0x97, 0x09, 0x63, 0x84, 0x9a, 0x01, 0xd6,
+	0xd6, 0xb5, 0x35, 0xa2, 0xf7, 0x66, 0x4d, 0x40, 0x4e, 0xc9, 0xda, 0x55, 0xb8, 0x12, 0x22, 0x5c,
+	0x98, 0xdc, 0x45, 0x20, 0x67, 0x99, 0xe1, 0x2d, 0x2f, 0x7b, 0xa1, 0xe7, 0x70, 0x4d, 0xb3, 0xf4,
+	0x93, 0x6a, 0x5d, 0x60, 0x4c, 0xd1, 0x5e, 0x63, 0xf2, 0x39, 0x9c, 0x85, 0x78, 0x7b, 0xd5, 0xc2,
+	0xdc, 0x4b, 0x50, 0x7c, 0x55, 0xcf, 0x3c, 0xba, 0xc3, 0x54, 0x4e, 0xa8, 0x7f, 0x3e, 0x61, 0x09,
+	0xb8, 0xdc, 0x79, 0x4b, 0x21, 0xee, 0x1b, 0x82, 0xd1, 0x2c, 0x33, 0x1e, 0x56, 0x4d, 0xbd, 0xb1,
+	0x5a, 0x35, 0x09, 0xdf, 0x7c, 0x42, 0xe9, 0x9a, 0x54, 0x84, 0x01, 0xad, 0x4c, 0xab, 0x26, 0x1f,
+	0x43, 0xd3, 0x7d, 0x89, 0xff, 0xe6, 0xc7, 0xdd, 0x93, 0xa8, 0x36, 0x0e, 0xb1, 0x77, 0xde, 0xd5,
+	0x0c, 0x25, 0x66, 0xfa, 0xfa, 0xde, 0xcf, 0x58, 0xe4, 0xe3, 0xaf, 0x58, 0xc2, 0x20, 0xbc, 0x54,
+	0x2d, 0xa8, 0x45, 0x5a, 0x76, 0x0f, 0xb1, 0xfb, 0x33, 0xc7, 0xf4, 0xd5, 0x24, 0xdf, 0xac, 0x60,
+	0x66, 0x03, 0x58, 0xce, 0xa5, 0x96, 0x16, 0x60, 0x48, 0xc7, 0x15, 0xca, 0x08, 0xa7, 0x56, 0x68,
+	0x27, 0x0e, 0x4b, 0x7d, 0x4e, 0x15, 0x98, 0x0c, 0x92, 0x2f, 0xfc, 0x51, 0x98, 0xf5, 0x25, 0xf1,
+	0x94, 0xae, 0x62, 0x93, 0x6c, 0xe1, 0x7c, 0x49, 0xb3, 0x70, 0x0e, 0x17, 0xa9, 0xa5, 0x3b, 0xcd,
+	0x92, 0xee, 0xc2, 0x19, 0x5a, 0x33, 0x71, 0xf7, 0xf1, 0xff, 0x6f, 0x97, 0xb7, 0x46, 0xaf, 0xc2,
+	0xb5, 0x6e, 0x36, 0x14, 0x02, 0xff, 0x3a, 0x03, 0xab, 0x49, 0x7d, 0xbe, 0x82, 0x4d, 0xbd, 0x11,
+	0x8e, 0x56, 0xe5, 0x25, 0x6a, 0x11, 0xbe, 0x19, 0x2a, 0xe7, 0xb0, 0xb4, 0x81, 0xb3, 0x70, 0x91,
+	0x54, 0x08, 0x36, 0x79, 0x78, 0xa8, 0xa2, 0xd4, 0xd7, 0xf1, 0xbe, 0x53, 0xeb, 0xb8, 0x2f, 0xa8,
+	0x24, 0x4c, 0x05, 0xfa, 0xf6, 0x92, 0x91, 0xce, 0x42, 0x94, 0xe8, 0xb6, 0xf1, 0xfe, 0x5c, 0x94,
+	0xe8, 0xf1, 0x2d, 0x18, 0x0f, 0x6a, 0x75, 0x5a, 0xe3, 0xc5, 0x92, 0x94, 0x87, 0x41, 0xf7, 0xf5,
+	0x60, 0xee, 0x0b, 0x9b, 0x52, 0x3b, 0x5c, 0x54, 0x6a, 0x13, 0xc5, 0xb2, 0x83, 0x4c, 0xf7, 0x37,
+	0x6c, 0xe5, 0x04, 0x91, 0x4f, 0xec, 0x25, 0x98, 0x69, 0xbb, 0xb7, 0x27, 0x78, 0xfe, 0xeb, 0x10,
+	0xf4, 0x65, 0x99, 0x21, 0xbd, 0x46, 0x20, 0x05, 0x5c, 0x40, 0xf3, 0x1d, 0x05, 0x05, 0xde, 0x02,
+	0xf2, 0x62, 0xef, 0x18, 0x91, 0xdf, 0x7b, 0x04, 0x93, 0x1d, 0xaf, 0x8d, 0x3b, 0x5d, 0x90, 0xb7,
+	0x45, 0xcb, 0xcb, 0xc7, 0x41, 0x0b, 0x91, 0x6f, 0x11, 0x5c, 0x6c, 0x37, 0xf6, 0x6f, 0x86, 0xed,
+	0xd0, 0x06, 0x28, 0xdf, 0x3f, 0x22, 0x50, 0xa8, 0x7a, 0x87, 0x60, 0xa2, 0xd3, 0xc0, 0xbe, 0xdd,
+	0xed, 0x06, 0x01, 0x60, 0x39, 0x73, 0x0c, 0xb0, 0x50, 0xf8, 0x0a, 0xc1, 0x48, 0xeb, 0xd0, 0x4e,
+	0x85, 0x51, 0xb7, 0x40, 0xe4, 0x5b, 0x3d, 0x43, 0x84, 0x86, 0xcf, 0x08, 0x66, 0xc2, 0x27, 0xeb,
+	0x52, 0xb7, 0x76, 0xdb, 0x52, 0xc8, 0x8f, 0x8e, 0x4d, 0x21, 0x34, 0x37, 0xce, 0x66, 0xc0, 0xac,
+	0x0d, 0x3d, 0x9b, 0xad, 0x18, 0x79, 0xb1, 0x77, 0x8c, 0x90, 0xb1, 0x8d, 0xe0, 0x42, 0x9b, 0x49,
+	0xb6, 0xd0, 0x73, 0x43, 0x6c, 0x9c, 0x7c, 0xef, 0x68, 0x38, 0x4f, 0x52, 0xfa, 0xf1, 0x87, 0xba,
+	0x82, 0xf6, 0xea, 0x0a, 0xda, 0xaf, 0x2b, 0xe8, 0x77, 0x5d, 0x41, 0xdb, 0x07, 0x4a, 0x64, 0xff,
+	0x40, 0x89, 0xfc, 0x38, 0x50, 0x22, 0x2f, 0x52, 0x1d, 0x27, 0xfd, 0x46, 0xf3, 0xf7, 0xb9, 0x3d,
+	0xf8, 0x0b, 0x03, 0xf6, 0x17, 0xf9, 0x8d, 0x7f, 0x03, 0x00, 0x5a, 0xa4, 0xd5, 0x2d, 0x57, 0x0c,
+	0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -415,6 +766,27 @@ func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgSetCommissionWithdrawAddressResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetCommissionWithdrawAddressResponse)
+	if !ok {
+		that2, ok := that.(MsgSetCommissionWithdrawAddressResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *MsgWithdrawDelegatorRewardResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -478,40 +850,120 @@ func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgWithdrawTokenizeShareRecordRewardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// SetWithdrawAddress defines a method to change the withdraw address
-	// for a delegator (or validator self-delegation).
-	SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error)
-	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
-	// from a single validator.
-	WithdrawDelegatorReward(ctx context.Context, in *MsgWithdrawDelegatorReward, opts ...grpc.CallOption) (*MsgWithdrawDelegatorRewardResponse, error)
-	// WithdrawValidatorCommission defines a method to withdraw the
-	// full commission to the validator address.
-	WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error)
-	// FundCommunityPool defines a method to allow an account to directly
-	// fund the community pool.
-	FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error)
-}
-
-type msgClient struct {
-	cc grpc1.ClientConn
+	that1, ok := that.(*MsgWithdrawTokenizeShareRecordRewardResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawTokenizeShareRecordRewardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *MsgCommunityPoolSpendResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
-}
+	that1, ok := that.(*MsgCommunityPoolSpendResponse)
+	if !ok {
+		that2, ok := that.(MsgCommunityPoolSpendResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	return true
+}
+func (this *MsgFundCommunityPoolBatchResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFundCommunityPoolBatchResponse)
+	if !ok {
+		that2, ok := that.(MsgFundCommunityPoolBatchResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetWithdrawAddress defines a method to change the withdraw address
+	// for a delegator (or validator self-delegation).
+	SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error)
+	// SetCommissionWithdrawAddress defines a method to change the address the
+	// commission of a validator is withdrawn to, separately from the withdraw
+	// address of its self-delegation rewards.
+	SetCommissionWithdrawAddress(ctx context.Context, in *MsgSetCommissionWithdrawAddress, opts ...grpc.CallOption) (*MsgSetCommissionWithdrawAddressResponse, error)
+	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
+	// from a single validator.
+	WithdrawDelegatorReward(ctx context.Context, in *MsgWithdrawDelegatorReward, opts ...grpc.CallOption) (*MsgWithdrawDelegatorRewardResponse, error)
+	// WithdrawValidatorCommission defines a method to withdraw the
+	// full commission to the validator address.
+	WithdrawValidatorCommission(ctx context.Context, in *MsgWithdrawValidatorCommission, opts ...grpc.CallOption) (*MsgWithdrawValidatorCommissionResponse, error)
+	// FundCommunityPool defines a method to allow an account to directly
+	// fund the community pool.
+	FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the delegations tokenized into liquid staking shares to the owner of
+	// their tokenize share records.
+	WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
+	// CommunityPoolSpend defines a governance operation to send coins from the
+	// community pool to an account.
+	CommunityPoolSpend(ctx context.Context, in *MsgCommunityPoolSpend, opts ...grpc.CallOption) (*MsgCommunityPoolSpendResponse, error)
+	// FundCommunityPoolBatch defines a method to fund the community pool with
+	// the deposits of several accounts at once.
+	FundCommunityPoolBatch(ctx context.Context, in *MsgFundCommunityPoolBatch, opts ...grpc.CallOption) (*MsgFundCommunityPoolBatchResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
 
 func (c *msgClient) SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAddress, opts ...grpc.CallOption) (*MsgSetWithdrawAddressResponse, error) {
 	out := new(MsgSetWithdrawAddressResponse)
@@ -522,6 +974,15 @@ func (c *msgClient) SetWithdrawAddress(ctx context.Context, in *MsgSetWithdrawAd
 	return out, nil
 }
 
+func (c *msgClient) SetCommissionWithdrawAddress(ctx context.Context, in *MsgSetCommissionWithdrawAddress, opts ...grpc.CallOption) (*MsgSetCommissionWithdrawAddressResponse, error) {
+	out := new(MsgSetCommissionWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetCommissionWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) WithdrawDelegatorReward(ctx context.Context, in *MsgWithdrawDelegatorReward, opts ...grpc.CallOption) (*MsgWithdrawDelegatorRewardResponse, error) {
 	out := new(MsgWithdrawDelegatorRewardResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward", in, out, opts...)
@@ -549,11 +1010,42 @@ func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundCommunityP
 	return out, nil
 }
 
+func (c *msgClient) WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	out := new(MsgWithdrawTokenizeShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommunityPoolSpend(ctx context.Context, in *MsgCommunityPoolSpend, opts ...grpc.CallOption) (*MsgCommunityPoolSpendResponse, error) {
+	out := new(MsgCommunityPoolSpendResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/CommunityPoolSpend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundCommunityPoolBatch(ctx context.Context, in *MsgFundCommunityPoolBatch, opts ...grpc.CallOption) (*MsgFundCommunityPoolBatchResponse, error) {
+	out := new(MsgFundCommunityPoolBatchResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/FundCommunityPoolBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
 	// for a delegator (or validator self-delegation).
 	SetWithdrawAddress(context.Context, *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error)
+	// SetCommissionWithdrawAddress defines a method to change the address the
+	// commission of a validator is withdrawn to, separately from the withdraw
+	// address of its self-delegation rewards.
+	SetCommissionWithdrawAddress(context.Context, *MsgSetCommissionWithdrawAddress) (*MsgSetCommissionWithdrawAddressResponse, error)
 	// WithdrawDelegatorReward defines a method to withdraw rewards of delegator
 	// from a single validator.
 	WithdrawDelegatorReward(context.Context, *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error)
@@ -563,6 +1055,16 @@ type MsgServer interface {
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(context.Context, *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the delegations tokenized into liquid staking shares to the owner of
+	// their tokenize share records.
+	WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
+	// CommunityPoolSpend defines a governance operation to send coins from the
+	// community pool to an account.
+	CommunityPoolSpend(context.Context, *MsgCommunityPoolSpend) (*MsgCommunityPoolSpendResponse, error)
+	// FundCommunityPoolBatch defines a method to fund the community pool with
+	// the deposits of several accounts at once.
+	FundCommunityPoolBatch(context.Context, *MsgFundCommunityPoolBatch) (*MsgFundCommunityPoolBatchResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -572,6 +1074,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SetWithdrawAddress(ctx context.Context, req *MsgSetWithdrawAddress) (*MsgSetWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawAddress not implemented")
 }
+func (*UnimplementedMsgServer) SetCommissionWithdrawAddress(ctx context.Context, req *MsgSetCommissionWithdrawAddress) (*MsgSetCommissionWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommissionWithdrawAddress not implemented")
+}
 func (*UnimplementedMsgServer) WithdrawDelegatorReward(ctx context.Context, req *MsgWithdrawDelegatorReward) (*MsgWithdrawDelegatorRewardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDelegatorReward not implemented")
 }
@@ -581,6 +1086,15 @@ func (*UnimplementedMsgServer) WithdrawValidatorCommission(ctx context.Context,
 func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPool not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, req *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTokenizeShareRecordReward not implemented")
+}
+func (*UnimplementedMsgServer) CommunityPoolSpend(ctx context.Context, req *MsgCommunityPoolSpend) (*MsgCommunityPoolSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolSpend not implemented")
+}
+func (*UnimplementedMsgServer) FundCommunityPoolBatch(ctx context.Context, req *MsgFundCommunityPoolBatch) (*MsgFundCommunityPoolBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPoolBatch not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -604,6 +1118,24 @@ func _Msg_SetWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetCommissionWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCommissionWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCommissionWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetCommissionWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCommissionWithdrawAddress(ctx, req.(*MsgSetCommissionWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_WithdrawDelegatorReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgWithdrawDelegatorReward)
 	if err := dec(in); err != nil {
@@ -658,6 +1190,60 @@ func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawTokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTokenizeShareRecordReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, req.(*MsgWithdrawTokenizeShareRecordReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommunityPoolSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommunityPoolSpend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommunityPoolSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/CommunityPoolSpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommunityPoolSpend(ctx, req.(*MsgCommunityPoolSpend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundCommunityPoolBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundCommunityPoolBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundCommunityPoolBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/FundCommunityPoolBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundCommunityPoolBatch(ctx, req.(*MsgFundCommunityPoolBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -666,6 +1252,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetWithdrawAddress",
 			Handler:    _Msg_SetWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "SetCommissionWithdrawAddress",
+			Handler:    _Msg_SetCommissionWithdrawAddress_Handler,
+		},
 		{
 			MethodName: "WithdrawDelegatorReward",
 			Handler:    _Msg_WithdrawDelegatorReward_Handler,
@@ -678,6 +1268,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FundCommunityPool",
 			Handler:    _Msg_FundCommunityPool_Handler,
 		},
+		{
+			MethodName: "WithdrawTokenizeShareRecordReward",
+			Handler:    _Msg_WithdrawTokenizeShareRecordReward_Handler,
+		},
+		{
+			MethodName: "CommunityPoolSpend",
+			Handler:    _Msg_CommunityPoolSpend_Handler,
+		},
+		{
+			MethodName: "FundCommunityPoolBatch",
+			Handler:    _Msg_FundCommunityPoolBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -743,6 +1345,66 @@ func (m *MsgSetWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetCommissionWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCommissionWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCommissionWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCommissionWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCommissionWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCommissionWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgWithdrawDelegatorReward) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -923,45 +1585,263 @@ func (m *MsgFundCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSetWithdrawAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawDelegatorReward) Size() (n int) {
-	if m == nil {
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommunityPoolSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommunityPoolSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommunityPoolSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommunityPoolSpendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommunityPoolSpendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommunityPoolSpendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundCommunityPoolBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundCommunityPoolBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundCommunityPoolBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundCommunityPoolBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundCommunityPoolBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundCommunityPoolBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCommissionWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetCommissionWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawDelegatorReward) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1020,29 +1900,734 @@ func (m *MsgFundCommunityPool) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommunityPoolSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCommunityPoolSpendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgFundCommunityPoolBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundCommunityPoolBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCommissionWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCommissionWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCommissionWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCommissionWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCommissionWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCommissionWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgFundCommunityPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1065,17 +2650,17 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1085,27 +2670,29 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1133,7 +2720,7 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1156,7 +2743,7 @@ func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1179,10 +2766,10 @@ func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1206,7 +2793,7 @@ func (m *MsgSetWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1229,47 +2816,15 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1297,7 +2852,7 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1320,7 +2875,7 @@ func (m *MsgWithdrawDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1343,10 +2898,10 @@ func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDelegatorRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1370,7 +2925,7 @@ func (m *MsgWithdrawDelegatorRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
+func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1393,15 +2948,15 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommunityPoolSpend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommunityPoolSpend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1429,7 +2984,73 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1452,7 +3073,7 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCommunityPoolSpendResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1475,12 +3096,31 @@ func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCommunityPoolSpendResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCommunityPoolSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1502,7 +3142,7 @@ func (m *MsgWithdrawValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
+func (m *MsgFundCommunityPoolBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1525,15 +3165,15 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundCommunityPoolBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundCommunityPoolBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1560,43 +3200,11 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposits = append(m.Deposits, CommunityPoolDeposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1618,7 +3226,7 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundCommunityPoolBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1641,10 +3249,10 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundCommunityPoolBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundCommunityPoolBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
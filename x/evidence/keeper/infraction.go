@@ -19,6 +19,7 @@ import (
 // - the validator is unbonded or does not exist
 // - the signing info does not exist (will panic)
 // - is already tombstoned
+// - the tombstone of the validator was lifted after the infraction
 //
 // TODO: Some of the invalid constraints listed above may need to be reconsidered
 // in the case of a lunatic attack.
@@ -39,6 +40,11 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		return
 	}
 
+	// The evidence may refer to a consensus public key the validator has
+	// rotated since, while its signing info is kept under its initial
+	// consensus address.
+	consAddr = k.stakingKeeper.ValidatorIdentifier(ctx, consAddr)
+
 	// calculate the age of the evidence
 	infractionHeight := evidence.GetHeight()
 	infractionTime := evidence.GetTime()
@@ -85,6 +91,18 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		return
 	}
 
+	// ignore if the tombstone of the validator was lifted after the infraction,
+	// as it was already punished for it
+	if k.slashingKeeper.IsInfractionAppealed(ctx, consAddr, infractionTime) {
+		logger.Info(
+			"ignored equivocation; infraction committed before tombstone appeal",
+			"validator", consAddr,
+			"infraction_height", infractionHeight,
+			"infraction_time", infractionTime,
+		)
+		return
+	}
+
 	logger.Info(
 		"confirmed equivocation",
 		"validator", consAddr,
@@ -119,5 +137,6 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 
 	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
 	k.slashingKeeper.Tombstone(ctx, consAddr)
+	k.slashingKeeper.RecordDoubleSignJail(ctx, consAddr, evidence.Hash())
 	k.SetEvidence(ctx, evidence)
 }
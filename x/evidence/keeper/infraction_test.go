@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 )
@@ -45,6 +46,12 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
 	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 
+	// the jailing should be recorded with the hash of the evidence
+	records := suite.app.SlashingKeeper.GetJailRecords(ctx, sdk.ConsAddress(val.Address()))
+	suite.Require().Len(records, 1)
+	suite.Equal(slashingtypes.JailReasonDoubleSign, records[0].Reason)
+	suite.Equal(evidence.Hash().String(), records[0].EvidenceHash)
+
 	// tokens should be decreased
 	newTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
 	suite.True(newTokens.LT(oldTokens))
@@ -13,6 +13,7 @@ type (
 	// evidence module.
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
+		ValidatorIdentifier(sdk.Context, sdk.ConsAddress) sdk.ConsAddress
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -20,11 +21,13 @@ type (
 	SlashingKeeper interface {
 		GetPubkey(sdk.Context, cryptotypes.Address) (cryptotypes.PubKey, error)
 		IsTombstoned(sdk.Context, sdk.ConsAddress) bool
+		IsInfractionAppealed(sdk.Context, sdk.ConsAddress, time.Time) bool
 		HasValidatorSigningInfo(sdk.Context, sdk.ConsAddress) bool
 		Tombstone(sdk.Context, sdk.ConsAddress)
 		Slash(sdk.Context, sdk.ConsAddress, sdk.Dec, int64, int64)
 		SlashFractionDoubleSign(sdk.Context) sdk.Dec
 		Jail(sdk.Context, sdk.ConsAddress)
 		JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
+		RecordDoubleSignJail(sdk.Context, sdk.ConsAddress, []byte)
 	}
 )
@@ -23,8 +23,8 @@ func (a *BasicAllowance) Accept(ctx sdk.Context, fee sdk.Coins, _ []sdk.Msg) (bo
 	}
 
 	if a.SpendLimit != nil {
-		left, invalid := a.SpendLimit.SafeSub(fee)
-		if invalid {
+		left, err := a.SpendLimit.SafeSub(fee)
+		if err != nil {
 			return false, sdkerrors.Wrap(ErrFeeLimitExceeded, "basic allowance")
 		}
 
@@ -59,11 +59,17 @@ $ %s query feegrant grant [granter] [grantee]
 				return err
 			}
 
+			allowanceKey, err := cmd.Flags().GetString(FlagAllowanceKey)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.Allowance(
 				cmd.Context(),
 				&feegrant.QueryAllowanceRequest{
-					Granter: granterAddr.String(),
-					Grantee: granteeAddr.String(),
+					Granter:      granterAddr.String(),
+					Grantee:      granteeAddr.String(),
+					AllowanceKey: allowanceKey,
 				},
 			)
 
@@ -76,6 +82,7 @@ $ %s query feegrant grant [granter] [grantee]
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagAllowanceKey, "", "The key of the allowance to query")
 
 	return cmd
 }
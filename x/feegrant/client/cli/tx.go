@@ -17,11 +17,12 @@ import (
 
 // flag for feegrant module
 const (
-	FlagExpiration  = "expiration"
-	FlagPeriod      = "period"
-	FlagPeriodLimit = "period-limit"
-	FlagSpendLimit  = "spend-limit"
-	FlagAllowedMsgs = "allowed-messages"
+	FlagExpiration   = "expiration"
+	FlagPeriod       = "period"
+	FlagPeriodLimit  = "period-limit"
+	FlagSpendLimit   = "spend-limit"
+	FlagAllowedMsgs  = "allowed-messages"
+	FlagAllowanceKey = "allowance-key"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -57,8 +58,13 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 36000 or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --allowance-key dapp
+
+Allowances of different '--allowance-key' are stacked: the fees of the grantee are paid
+under the first allowance, in the order of their keys, accepting them.
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -166,6 +172,11 @@ Examples:
 				return err
 			}
 
+			msg.AllowanceKey, err = cmd.Flags().GetString(FlagAllowanceKey)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -176,6 +187,7 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration in which period_spend_limit coins can be spent before that allowance is reset")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().String(FlagAllowanceKey, "", "The key of the allowance, to stack it with the other allowances of the granter for the grantee")
 
 	return cmd
 }
@@ -191,6 +203,7 @@ func NewCmdRevokeFeegrant() *cobra.Command {
 
 Example:
  $ %s tx %s revoke cosmos1skj.. cosmos1skj..
+ $ %[1]s tx %[2]s revoke cosmos1skj.. cosmos1skj.. --allowance-key=dapp
 			`, version.AppName, feegrant.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
@@ -207,12 +220,17 @@ Example:
 			}
 
 			msg := feegrant.NewMsgRevokeAllowance(clientCtx.GetFromAddress(), grantee)
+			msg.AllowanceKey, err = cmd.Flags().GetString(FlagAllowanceKey)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagAllowanceKey, "", "The key of the allowance to revoke")
 	return cmd
 }
 
@@ -22,4 +22,6 @@ var (
 	ErrNoMessages = sdkerrors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = sdkerrors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrInvalidAllowanceKey error if the key of an allowance is invalid
+	ErrInvalidAllowanceKey = sdkerrors.Register(DefaultCodespace, 8, "invalid allowance key")
 )
@@ -5,9 +5,12 @@ const (
 	EventTypeUseFeeGrant    = "use_feegrant"
 	EventTypeRevokeFeeGrant = "revoke_feegrant"
 	EventTypeSetFeeGrant    = "set_feegrant"
+	EventTypeSponsorFee     = "sponsor_fee"
 
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
+	// AttributeKeyAllowanceKey is only set for the allowances of a non-empty key
+	AttributeKeyAllowanceKey = "allowance_key"
 
 	AttributeValueCategory = ModuleName
 )
@@ -226,6 +226,10 @@ type Grant struct {
 	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// allowance can be any of basic and filtered fee allowance.
 	Allowance *types1.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// allowance_key distinguishes the allowances stacked by the granter for the
+	// grantee. The allowances are used in the order of their keys, the default
+	// allowance of the empty key first.
+	AllowanceKey string `protobuf:"bytes,4,opt,name=allowance_key,json=allowanceKey,proto3" json:"allowance_key,omitempty"`
 }
 
 func (m *Grant) Reset()         { *m = Grant{} }
@@ -282,6 +286,13 @@ func (m *Grant) GetAllowance() *types1.Any {
 	return nil
 }
 
+func (m *Grant) GetAllowanceKey() string {
+	if m != nil {
+		return m.AllowanceKey
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
@@ -294,44 +305,45 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x9b, 0xa6, 0x90, 0x0b, 0x94, 0xd6, 0x04, 0xe1, 0x64, 0x70, 0xa2, 0x0e, 0x34, 0x0c,
-	0xb1, 0x69, 0xd8, 0xca, 0x42, 0x1c, 0xa0, 0x42, 0xa2, 0x12, 0x72, 0x99, 0x58, 0xa2, 0xb3, 0xfd,
-	0x6a, 0x4e, 0xc4, 0x3e, 0xcb, 0x77, 0x81, 0xe6, 0x1b, 0x30, 0x76, 0x64, 0x42, 0xcc, 0xcc, 0x15,
-	0x9f, 0xa1, 0x62, 0xaa, 0x60, 0x61, 0xa2, 0x28, 0xf9, 0x22, 0xc8, 0x77, 0xe7, 0x24, 0x24, 0xfc,
-	0x13, 0xea, 0x14, 0xdf, 0xbb, 0xf7, 0xfb, 0xf7, 0xde, 0x29, 0xe8, 0x96, 0x4f, 0x59, 0x44, 0x99,
-	0x7d, 0x08, 0x10, 0xa6, 0x38, 0xe6, 0xf6, 0xab, 0x1d, 0x0f, 0x38, 0xde, 0x99, 0x16, 0xac, 0x24,
-	0xa5, 0x9c, 0xea, 0x37, 0x65, 0x9f, 0x35, 0x2d, 0xab, 0xbe, 0x7a, 0x35, 0xa4, 0x21, 0x15, 0x3d,
-	0x76, 0xf6, 0x25, 0xdb, 0xeb, 0xb5, 0x90, 0xd2, 0x70, 0x00, 0xb6, 0x38, 0x79, 0xc3, 0x43, 0x1b,
-	0xc7, 0xa3, 0xfc, 0x4a, 0x32, 0xf5, 0x25, 0x46, 0xd1, 0xca, 0x2b, 0x53, 0x99, 0xf1, 0x30, 0x83,
-	0xa9, 0x11, 0x9f, 0x92, 0x58, 0xdd, 0x37, 0x16, 0x59, 0x39, 0x89, 0x80, 0x71, 0x1c, 0x25, 0x39,
-	0xc1, 0x62, 0x43, 0x30, 0x4c, 0x31, 0x27, 0x54, 0x11, 0x6c, 0x7d, 0xd1, 0xd0, 0xba, 0x83, 0x19,
-	0xf1, 0xbb, 0x83, 0x01, 0x7d, 0x8d, 0x63, 0x1f, 0xf4, 0x01, 0xaa, 0xb0, 0x04, 0xe2, 0xa0, 0x3f,
-	0x20, 0x11, 0xe1, 0x86, 0xd6, 0x2c, 0xb6, 0x2a, 0x9d, 0x9a, 0xa5, 0x7c, 0x65, 0x4e, 0xf2, 0xa8,
-	0x56, 0x8f, 0x92, 0xd8, 0xb9, 0x73, 0xfa, 0xad, 0x51, 0xf8, 0x70, 0xde, 0x68, 0x85, 0x84, 0xbf,
-	0x18, 0x7a, 0x96, 0x4f, 0x23, 0x15, 0x42, 0xfd, 0xb4, 0x59, 0xf0, 0xd2, 0xe6, 0xa3, 0x04, 0x98,
-	0x00, 0x30, 0x17, 0x09, 0xfe, 0x27, 0x19, 0xbd, 0x7e, 0x1f, 0x21, 0x38, 0x4a, 0x88, 0x34, 0x65,
-	0xac, 0x34, 0xb5, 0x56, 0xa5, 0x53, 0xb7, 0xa4, 0x6b, 0x2b, 0x77, 0x6d, 0x3d, 0xcb, 0x63, 0x39,
-	0xab, 0xc7, 0xe7, 0x0d, 0xcd, 0x9d, 0xc3, 0xec, 0x6e, 0x7e, 0x3a, 0x69, 0x5f, 0x7d, 0x04, 0x30,
-	0x4d, 0xf0, 0x78, 0x6b, 0x52, 0x44, 0x9b, 0x4f, 0x21, 0x25, 0x34, 0x98, 0x0f, 0xd6, 0x43, 0x25,
-	0x2f, 0x8b, 0x6a, 0x68, 0x42, 0x65, 0xdb, 0xfa, 0xcd, 0x06, 0xad, 0x9f, 0x07, 0xe2, 0xac, 0x66,
-	0x01, 0x5d, 0x89, 0xd5, 0xef, 0xa1, 0xb5, 0x44, 0x30, 0x2b, 0xaf, 0xb5, 0x25, 0xaf, 0x0f, 0xd4,
-	0x84, 0x9d, 0xcb, 0x19, 0xee, 0x6d, 0x66, 0x57, 0x41, 0xf4, 0x11, 0xd2, 0xe5, 0x57, 0x7f, 0x7e,
-	0xc2, 0xc5, 0x8b, 0x9f, 0xf0, 0x86, 0x94, 0x39, 0x98, 0xcd, 0x79, 0x88, 0x54, 0xad, 0xef, 0xe3,
-	0x58, 0xca, 0x1b, 0xab, 0x17, 0x2f, 0xbc, 0x2e, 0x45, 0x7a, 0x38, 0x16, 0xda, 0xfa, 0x1e, 0xba,
-	0xa2, 0x64, 0x53, 0x60, 0xc0, 0x8d, 0xd2, 0x5f, 0x17, 0x2c, 0xa6, 0x26, 0x96, 0x5c, 0x91, 0x48,
-	0x37, 0x03, 0xfe, 0x6a, 0xcb, 0xef, 0x34, 0x74, 0x5d, 0x1c, 0x21, 0xd8, 0x67, 0xe1, 0x6c, 0xcf,
-	0x0f, 0x51, 0x19, 0xe7, 0x07, 0xb5, 0xeb, 0xea, 0x92, 0x60, 0x37, 0x1e, 0x39, 0xcb, 0x9c, 0xee,
-	0x0c, 0xa9, 0xdf, 0x46, 0x1b, 0x58, 0xb2, 0xf7, 0x23, 0x60, 0x0c, 0x87, 0xc0, 0x8c, 0x95, 0x66,
-	0xb1, 0x55, 0x76, 0xaf, 0xa9, 0xfa, 0xbe, 0x2a, 0xef, 0xde, 0x78, 0xf3, 0xbe, 0x51, 0x58, 0x36,
-	0xf8, 0x51, 0x43, 0xa5, 0xbd, 0xec, 0x65, 0xe9, 0x1d, 0x74, 0x49, 0x3c, 0x31, 0x48, 0x85, 0xa1,
-	0xb2, 0x63, 0x7c, 0x3e, 0x69, 0x57, 0xd5, 0xdc, 0xbb, 0x41, 0x90, 0x02, 0x63, 0x07, 0x3c, 0x25,
-	0x71, 0xe8, 0xe6, 0x8d, 0x33, 0x0c, 0x88, 0xa7, 0xf6, 0x0f, 0x98, 0x85, 0xe8, 0xc5, 0xff, 0x8d,
-	0xee, 0x74, 0x4f, 0xc7, 0xa6, 0x76, 0x36, 0x36, 0xb5, 0xef, 0x63, 0x53, 0x3b, 0x9e, 0x98, 0x85,
-	0xb3, 0x89, 0x59, 0xf8, 0x3a, 0x31, 0x0b, 0xcf, 0xb7, 0xff, 0xf8, 0x12, 0x8e, 0xa6, 0x7f, 0x92,
-	0xde, 0x9a, 0x90, 0xbb, 0xfb, 0x23, 0x00, 0x00, 0xff, 0xff, 0x41, 0xd8, 0x15, 0xc6, 0x4f, 0x05,
-	0x00, 0x00,
+	// 595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x14, 0x8f, 0x9b, 0xb4, 0xdf, 0x6f, 0x2e, 0x6d, 0x69, 0x4d, 0x10, 0x4e, 0x06, 0x27, 0x2a, 0x12,
+	0x0d, 0x43, 0x6c, 0x1a, 0xb6, 0xb2, 0x10, 0x07, 0xa8, 0x10, 0x54, 0x42, 0x2e, 0x13, 0x8b, 0x75,
+	0xb6, 0x5f, 0x8d, 0xd5, 0xd8, 0x67, 0xf9, 0x1c, 0xa8, 0xff, 0x03, 0xc6, 0x8e, 0x4c, 0x88, 0x99,
+	0xb9, 0x7f, 0x44, 0xc5, 0x54, 0xc1, 0x82, 0x84, 0x44, 0x51, 0xf2, 0x8f, 0x20, 0xdf, 0x9d, 0x9d,
+	0x90, 0xf0, 0x4b, 0xa8, 0x53, 0x7c, 0xef, 0xde, 0xe7, 0xd7, 0x7b, 0xa7, 0xa0, 0x9b, 0x0e, 0xa1,
+	0x01, 0xa1, 0xfa, 0x21, 0x80, 0x17, 0xe3, 0x30, 0xd1, 0x5f, 0xee, 0xd8, 0x90, 0xe0, 0x9d, 0xa2,
+	0xa0, 0x45, 0x31, 0x49, 0x88, 0x7c, 0x9d, 0xf7, 0x69, 0x45, 0x59, 0xf4, 0x35, 0xeb, 0x1e, 0xf1,
+	0x08, 0xeb, 0xd1, 0xb3, 0x2f, 0xde, 0xde, 0x6c, 0x78, 0x84, 0x78, 0x43, 0xd0, 0xd9, 0xc9, 0x1e,
+	0x1d, 0xea, 0x38, 0x4c, 0xf3, 0x2b, 0xce, 0x64, 0x71, 0x8c, 0xa0, 0xe5, 0x57, 0xaa, 0x30, 0x63,
+	0x63, 0x0a, 0x85, 0x11, 0x87, 0xf8, 0xa1, 0xb8, 0x6f, 0xcd, 0xb3, 0x26, 0x7e, 0x00, 0x34, 0xc1,
+	0x41, 0x94, 0x13, 0xcc, 0x37, 0xb8, 0xa3, 0x18, 0x27, 0x3e, 0x11, 0x04, 0x5b, 0x9f, 0x24, 0xb4,
+	0x6e, 0x60, 0xea, 0x3b, 0xfd, 0xe1, 0x90, 0xbc, 0xc2, 0xa1, 0x03, 0xf2, 0x10, 0xd5, 0x68, 0x04,
+	0xa1, 0x6b, 0x0d, 0xfd, 0xc0, 0x4f, 0x14, 0xa9, 0x5d, 0xee, 0xd4, 0x7a, 0x0d, 0x4d, 0xf8, 0xca,
+	0x9c, 0xe4, 0x51, 0xb5, 0x01, 0xf1, 0x43, 0xe3, 0xf6, 0xd9, 0xd7, 0x56, 0xe9, 0xfd, 0x45, 0xab,
+	0xe3, 0xf9, 0xc9, 0x8b, 0x91, 0xad, 0x39, 0x24, 0x10, 0x21, 0xc4, 0x4f, 0x97, 0xba, 0x47, 0x7a,
+	0x92, 0x46, 0x40, 0x19, 0x80, 0x9a, 0x88, 0xf1, 0x3f, 0xc9, 0xe8, 0xe5, 0x7b, 0x08, 0xc1, 0x71,
+	0xe4, 0x73, 0x53, 0xca, 0x52, 0x5b, 0xea, 0xd4, 0x7a, 0x4d, 0x8d, 0xbb, 0xd6, 0x72, 0xd7, 0xda,
+	0xb3, 0x3c, 0x96, 0x51, 0x39, 0xb9, 0x68, 0x49, 0xe6, 0x0c, 0x66, 0x77, 0xf3, 0xc3, 0x69, 0x77,
+	0xed, 0x21, 0x40, 0x91, 0xe0, 0xd1, 0xd6, 0xa4, 0x8c, 0x36, 0x9f, 0x42, 0xec, 0x13, 0x77, 0x36,
+	0xd8, 0x00, 0x2d, 0xdb, 0x59, 0x54, 0x45, 0x62, 0x2a, 0xdb, 0xda, 0x2f, 0x36, 0xa8, 0xfd, 0x38,
+	0x10, 0xa3, 0x92, 0x05, 0x34, 0x39, 0x56, 0xbe, 0x8b, 0x56, 0x22, 0xc6, 0x2c, 0xbc, 0x36, 0x16,
+	0xbc, 0xde, 0x17, 0x13, 0x36, 0xfe, 0xcf, 0x70, 0x6f, 0x32, 0xbb, 0x02, 0x22, 0xa7, 0x48, 0xe6,
+	0x5f, 0xd6, 0xec, 0x84, 0xcb, 0x97, 0x3f, 0xe1, 0x0d, 0x2e, 0x73, 0x30, 0x9d, 0xf3, 0x08, 0x89,
+	0x9a, 0xe5, 0xe0, 0x90, 0xcb, 0x2b, 0x95, 0xcb, 0x17, 0x5e, 0xe7, 0x22, 0x03, 0x1c, 0x32, 0x6d,
+	0x79, 0x0f, 0xad, 0x0a, 0xd9, 0x18, 0x28, 0x24, 0xca, 0xf2, 0x1f, 0x17, 0xcc, 0xa6, 0xc6, 0x96,
+	0x5c, 0xe3, 0x48, 0x33, 0x03, 0xfe, 0x6c, 0xcb, 0x6f, 0x25, 0x74, 0x95, 0x1d, 0xc1, 0xdd, 0xa7,
+	0xde, 0x74, 0xcf, 0x0f, 0x50, 0x15, 0xe7, 0x07, 0xb1, 0xeb, 0xfa, 0x82, 0x60, 0x3f, 0x4c, 0x8d,
+	0x45, 0x4e, 0x73, 0x8a, 0x94, 0x6f, 0xa1, 0x0d, 0xcc, 0xd9, 0xad, 0x00, 0x28, 0xc5, 0x1e, 0x50,
+	0x65, 0xa9, 0x5d, 0xee, 0x54, 0xcd, 0x2b, 0xa2, 0xbe, 0x2f, 0xca, 0xbb, 0xd7, 0x5e, 0xbf, 0x6b,
+	0x95, 0x16, 0x0d, 0x7e, 0x91, 0xd0, 0xf2, 0x5e, 0xf6, 0xb2, 0xe4, 0x1e, 0xfa, 0x8f, 0x3d, 0x31,
+	0x88, 0x99, 0xa1, 0xaa, 0xa1, 0x7c, 0x3c, 0xed, 0xd6, 0xc5, 0xdc, 0xfb, 0xae, 0x1b, 0x03, 0xa5,
+	0x07, 0x49, 0xec, 0x87, 0x9e, 0x99, 0x37, 0x4e, 0x31, 0xa0, 0x2c, 0xfd, 0x1d, 0x66, 0x2e, 0x7a,
+	0xf9, 0x9f, 0xa3, 0xdf, 0x40, 0x6b, 0xc5, 0xc1, 0x3a, 0x82, 0x54, 0xa9, 0x64, 0x06, 0xcc, 0xd5,
+	0xa2, 0xf8, 0x18, 0x52, 0xa3, 0x7f, 0x36, 0x56, 0xa5, 0xf3, 0xb1, 0x2a, 0x7d, 0x1b, 0xab, 0xd2,
+	0xc9, 0x44, 0x2d, 0x9d, 0x4f, 0xd4, 0xd2, 0xe7, 0x89, 0x5a, 0x7a, 0xbe, 0xfd, 0xdb, 0xe7, 0x72,
+	0x5c, 0xfc, 0x93, 0xda, 0x2b, 0xcc, 0xd3, 0x9d, 0xef, 0x03, 0x00, 0x10, 0x51, 0x89, 0xb4, 0x74,
+	0x05, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -522,6 +534,13 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowanceKey) > 0 {
+		i -= len(m.AllowanceKey)
+		copy(dAtA[i:], m.AllowanceKey)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowanceKey)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Allowance != nil {
 		{
 			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
@@ -645,6 +664,10 @@ func (m *Grant) Size() (n int) {
 		l = m.Allowance.Size()
 		n += 1 + l + sovFeegrant(uint64(l))
 	}
+	l = len(m.AllowanceKey)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
 	return n
 }
 
@@ -1238,6 +1261,38 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowanceKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowanceKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeegrant(dAtA[iNdEx:])
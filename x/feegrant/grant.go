@@ -15,6 +15,12 @@ var (
 // NewGrant creates a new FeeAllowanceGrant.
 //nolint:interfacer
 func NewGrant(granter, grantee sdk.AccAddress, feeAllowance FeeAllowanceI) (Grant, error) {
+	return NewKeyedGrant(granter, grantee, "", feeAllowance)
+}
+
+// NewKeyedGrant creates a new FeeAllowanceGrant of the given allowance key.
+//nolint:interfacer
+func NewKeyedGrant(granter, grantee sdk.AccAddress, allowanceKey string, feeAllowance FeeAllowanceI) (Grant, error) {
 	msg, ok := feeAllowance.(proto.Message)
 	if !ok {
 		return Grant{}, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", feeAllowance)
@@ -26,9 +32,10 @@ func NewGrant(granter, grantee sdk.AccAddress, feeAllowance FeeAllowanceI) (Gran
 	}
 
 	return Grant{
-		Granter:   granter.String(),
-		Grantee:   grantee.String(),
-		Allowance: any,
+		Granter:      granter.String(),
+		Grantee:      grantee.String(),
+		Allowance:    any,
+		AllowanceKey: allowanceKey,
 	}, nil
 }
 
@@ -44,6 +51,9 @@ func (a Grant) ValidateBasic() error {
 	if a.Grantee == a.Granter {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
 	}
+	if err := ValidateAllowanceKey(a.AllowanceKey); err != nil {
+		return err
+	}
 
 	f, err := a.GetGrant()
 	if err != nil {
@@ -34,7 +34,7 @@ func (q Keeper) Allowance(c context.Context, req *feegrant.QueryAllowanceRequest
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	feeAllowance, err := q.GetAllowance(ctx, granterAddr, granteeAddr)
+	feeAllowance, err := q.GetKeyedAllowance(ctx, granterAddr, granteeAddr, req.AllowanceKey)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
@@ -51,9 +51,10 @@ func (q Keeper) Allowance(c context.Context, req *feegrant.QueryAllowanceRequest
 
 	return &feegrant.QueryAllowanceResponse{
 		Allowance: &feegrant.Grant{
-			Granter:   granterAddr.String(),
-			Grantee:   granteeAddr.String(),
-			Allowance: feeAllowanceAny,
+			Granter:      granterAddr.String(),
+			Grantee:      granteeAddr.String(),
+			Allowance:    feeAllowanceAny,
+			AllowanceKey: req.AllowanceKey,
 		},
 	}, nil
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -11,6 +12,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Keeper manages state of all fee grants, as well as calculating approval.
@@ -18,16 +20,23 @@ import (
 type Keeper struct {
 	cdc        codec.BinaryCodec
 	storeKey   storetypes.StoreKey
+	paramSpace paramtypes.Subspace
 	authKeeper feegrant.AccountKeeper
 }
 
 var _ middleware.FeegrantKeeper = &Keeper{}
 
 // NewKeeper creates a fee grant Keeper
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak feegrant.AccountKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, ak feegrant.AccountKeeper) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(feegrant.ParamKeyTable())
+	}
+
 	return Keeper{
 		cdc:        cdc,
 		storeKey:   storeKey,
+		paramSpace: paramSpace,
 		authKeeper: ak,
 	}
 }
@@ -37,8 +46,18 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", feegrant.ModuleName))
 }
 
-// GrantAllowance creates a new grant
+// GrantAllowance creates a new grant of the default, empty, allowance key
 func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error {
+	return k.GrantKeyedAllowance(ctx, granter, grantee, "", feeAllowance)
+}
+
+// GrantKeyedAllowance creates a new grant of the given allowance key, stacked
+// with the other allowances of the granter for the grantee. An existing grant
+// of the same allowance key is overwritten.
+func (k Keeper) GrantKeyedAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, allowanceKey string, feeAllowance feegrant.FeeAllowanceI) error {
+	if err := feegrant.ValidateAllowanceKey(allowanceKey); err != nil {
+		return err
+	}
 
 	// create the account if it is not in account state
 	granteeAcc := k.authKeeper.GetAccount(ctx, grantee)
@@ -48,8 +67,8 @@ func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress,
 	}
 
 	store := ctx.KVStore(k.storeKey)
-	key := feegrant.FeeAllowanceKey(granter, grantee)
-	grant, err := feegrant.NewGrant(granter, grantee, feeAllowance)
+	key := feegrant.KeyedFeeAllowanceKey(granter, grantee, allowanceKey)
+	grant, err := feegrant.NewKeyedGrant(granter, grantee, allowanceKey, feeAllowance)
 	if err != nil {
 		return err
 	}
@@ -62,42 +81,64 @@ func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress,
 	store.Set(key, bz)
 
 	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			feegrant.EventTypeSetFeeGrant,
-			sdk.NewAttribute(feegrant.AttributeKeyGranter, grant.Granter),
-			sdk.NewAttribute(feegrant.AttributeKeyGrantee, grant.Grantee),
-		),
+		newGrantEvent(feegrant.EventTypeSetFeeGrant, grant.Granter, grant.Grantee, allowanceKey),
 	)
 
 	return nil
 }
 
-// revokeAllowance removes an existing grant
-func (k Keeper) revokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
-	_, err := k.getGrant(ctx, granter, grantee)
+// GrantModuleAllowance creates a new grant of the given allowance key from the
+// account of the given module, allowing modules (e.g. a contract runtime) to
+// sponsor the fees of the grantee programmatically.
+func (k Keeper) GrantModuleAllowance(ctx sdk.Context, moduleName string, grantee sdk.AccAddress, allowanceKey string, feeAllowance feegrant.FeeAllowanceI) error {
+	granter := k.authKeeper.GetModuleAddress(moduleName)
+	if granter == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleName)
+	}
+
+	return k.GrantKeyedAllowance(ctx, granter, grantee, allowanceKey, feeAllowance)
+}
+
+// RevokeModuleAllowance removes an existing grant of the given allowance key
+// from the account of the given module.
+func (k Keeper) RevokeModuleAllowance(ctx sdk.Context, moduleName string, grantee sdk.AccAddress, allowanceKey string) error {
+	granter := k.authKeeper.GetModuleAddress(moduleName)
+	if granter == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleName)
+	}
+
+	return k.RevokeKeyedAllowance(ctx, granter, grantee, allowanceKey)
+}
+
+// RevokeKeyedAllowance removes an existing grant of the given allowance key
+func (k Keeper) RevokeKeyedAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, allowanceKey string) error {
+	_, err := k.getGrant(ctx, granter, grantee, allowanceKey)
 	if err != nil {
 		return err
 	}
 
 	store := ctx.KVStore(k.storeKey)
-	key := feegrant.FeeAllowanceKey(granter, grantee)
+	key := feegrant.KeyedFeeAllowanceKey(granter, grantee, allowanceKey)
 	store.Delete(key)
 
 	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			feegrant.EventTypeRevokeFeeGrant,
-			sdk.NewAttribute(feegrant.AttributeKeyGranter, granter.String()),
-			sdk.NewAttribute(feegrant.AttributeKeyGrantee, grantee.String()),
-		),
+		newGrantEvent(feegrant.EventTypeRevokeFeeGrant, granter.String(), grantee.String(), allowanceKey),
 	)
 	return nil
 }
 
-// GetAllowance returns the allowance between the granter and grantee.
+// GetAllowance returns the allowance of the default, empty, allowance key
+// between the granter and grantee.
 // If there is none, it returns nil, nil.
 // Returns an error on parsing issues
 func (k Keeper) GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
-	grant, err := k.getGrant(ctx, granter, grantee)
+	return k.GetKeyedAllowance(ctx, granter, grantee, "")
+}
+
+// GetKeyedAllowance returns the allowance of the given allowance key between
+// the granter and grantee.
+func (k Keeper) GetKeyedAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, allowanceKey string) (feegrant.FeeAllowanceI, error) {
+	grant, err := k.getGrant(ctx, granter, grantee, allowanceKey)
 	if err != nil {
 		return nil, err
 	}
@@ -105,10 +146,30 @@ func (k Keeper) GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (
 	return grant.GetGrant()
 }
 
-// getGrant returns entire grant between both accounts
-func (k Keeper) getGrant(ctx sdk.Context, granter sdk.AccAddress, grantee sdk.AccAddress) (*feegrant.Grant, error) {
+// GetAllowances returns all the grants stacked by the granter for the grantee,
+// ordered by allowance key, the grant of the default, empty, key first.
+func (k Keeper) GetAllowances(ctx sdk.Context, granter, grantee sdk.AccAddress) ([]feegrant.Grant, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, feegrant.FeeAllowanceKey(granter, grantee))
+	defer iter.Close()
+
+	var grants []feegrant.Grant
+	for ; iter.Valid(); iter.Next() {
+		var grant feegrant.Grant
+		if err := k.cdc.Unmarshal(iter.Value(), &grant); err != nil {
+			return nil, err
+		}
+
+		grants = append(grants, grant)
+	}
+
+	return grants, nil
+}
+
+// getGrant returns entire grant of the allowance key between both accounts
+func (k Keeper) getGrant(ctx sdk.Context, granter sdk.AccAddress, grantee sdk.AccAddress, allowanceKey string) (*feegrant.Grant, error) {
 	store := ctx.KVStore(k.storeKey)
-	key := feegrant.FeeAllowanceKey(granter, grantee)
+	key := feegrant.KeyedFeeAllowanceKey(granter, grantee, allowanceKey)
 	bz := store.Get(key)
 	if len(bz) == 0 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "fee-grant not found")
@@ -145,12 +206,43 @@ func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(grant feegrant.
 }
 
 // UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+// The allowances stacked by the granter for the grantee are tried in the order
+// of their allowance keys, the fee is paid under the first one accepting it.
+// If no grant exists and the granter is the configured sponsor module account,
+// the fee is paid under the sponsorship params instead.
 func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
-	f, err := k.getGrant(ctx, granter, grantee)
+	grants, err := k.GetAllowances(ctx, granter, grantee)
 	if err != nil {
 		return err
 	}
 
+	if len(grants) == 0 {
+		if params := k.GetSponsorshipParams(ctx); params.Enabled() && granter.Equals(k.authKeeper.GetModuleAddress(params.ModuleName)) {
+			return k.useSponsoredFees(ctx, params, granter, grantee, fee, msgs)
+		}
+
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "fee-grant not found")
+	}
+
+	var reasons []string
+	for _, f := range grants {
+		err = k.useGrantedFees(ctx, granter, grantee, f, fee, msgs)
+		if err == nil {
+			return nil
+		}
+
+		reasons = append(reasons, fmt.Sprintf("%q: %s", f.AllowanceKey, err))
+	}
+
+	if len(grants) == 1 {
+		return err
+	}
+
+	return sdkerrors.Wrapf(err, "no fee allowance accepted the fee: %s", strings.Join(reasons, "; "))
+}
+
+// useGrantedFees tries to pay the given fee under a single grant.
+func (k Keeper) useGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, f feegrant.Grant, fee sdk.Coins, msgs []sdk.Msg) error {
 	grant, err := f.GetGrant()
 	if err != nil {
 		return err
@@ -160,12 +252,12 @@ func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress,
 
 	if remove {
 		// Ignoring the `revokeFeeAllowance` error, because the user has enough grants to perform this transaction.
-		k.revokeAllowance(ctx, granter, grantee)
+		k.RevokeKeyedAllowance(ctx, granter, grantee, f.AllowanceKey)
 		if err != nil {
 			return err
 		}
 
-		emitUseGrantEvent(ctx, granter.String(), grantee.String())
+		emitUseGrantEvent(ctx, granter.String(), grantee.String(), f.AllowanceKey)
 
 		return nil
 	}
@@ -174,20 +266,76 @@ func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress,
 		return err
 	}
 
-	emitUseGrantEvent(ctx, granter.String(), grantee.String())
+	emitUseGrantEvent(ctx, granter.String(), grantee.String(), f.AllowanceKey)
 
 	// if fee allowance is accepted, store the updated state of the allowance
-	return k.GrantAllowance(ctx, granter, grantee, grant)
+	return k.GrantKeyedAllowance(ctx, granter, grantee, f.AllowanceKey, grant)
 }
 
-func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
+// useSponsoredFees checks that the tx of the grantee is eligible for a fee paid
+// by the sponsor module account.
+func (k Keeper) useSponsoredFees(ctx sdk.Context, params feegrant.SponsorshipParams, sponsor, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	// make sure the module account exists so the fee can be deducted from it
+	if k.authKeeper.GetModuleAccount(ctx, params.ModuleName) == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "sponsor module account %s does not exist", params.ModuleName)
+	}
+
+	granteeAcc := k.authKeeper.GetAccount(ctx, grantee)
+	if granteeAcc == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", grantee)
+	}
+
+	if err := params.Accept(granteeAcc.GetSequence(), fee, msgs); err != nil {
+		return err
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			feegrant.EventTypeUseFeeGrant,
-			sdk.NewAttribute(feegrant.AttributeKeyGranter, granter),
-			sdk.NewAttribute(feegrant.AttributeKeyGrantee, grantee),
+			feegrant.EventTypeSponsorFee,
+			sdk.NewAttribute(feegrant.AttributeKeyGranter, sponsor.String()),
+			sdk.NewAttribute(feegrant.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
 		),
 	)
+
+	return nil
+}
+
+// GetSponsorshipParams returns the fee sponsorship params. Params missing from
+// the store keep their (disabled) default value.
+func (k Keeper) GetSponsorshipParams(ctx sdk.Context) feegrant.SponsorshipParams {
+	params := feegrant.DefaultSponsorshipParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return params
+}
+
+// SetSponsorshipParams sets the fee sponsorship params.
+func (k Keeper) SetSponsorshipParams(ctx sdk.Context, params feegrant.SponsorshipParams) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+func emitUseGrantEvent(ctx sdk.Context, granter, grantee, allowanceKey string) {
+	ctx.EventManager().EmitEvent(
+		newGrantEvent(feegrant.EventTypeUseFeeGrant, granter, grantee, allowanceKey),
+	)
+}
+
+// newGrantEvent returns an event of the given type for a grant, the allowance
+// key attribute is only set for a non-empty allowance key.
+func newGrantEvent(eventType, granter, grantee, allowanceKey string) sdk.Event {
+	event := sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(feegrant.AttributeKeyGranter, granter),
+		sdk.NewAttribute(feegrant.AttributeKeyGrantee, grantee),
+	)
+	if allowanceKey != "" {
+		event = event.AppendAttributes(sdk.NewAttribute(feegrant.AttributeKeyAllowanceKey, allowanceKey))
+	}
+
+	return event
 }
 
 // InitGenesis will initialize the keeper from a *previously validated* GenesisState
@@ -207,7 +355,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *feegrant.GenesisState) error
 			return err
 		}
 
-		err = k.GrantAllowance(ctx, granter, grantee, grant)
+		err = k.GrantKeyedAllowance(ctx, granter, grantee, f.AllowanceKey, grant)
 		if err != nil {
 			return err
 		}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -9,8 +10,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 type KeeperTestSuite struct {
@@ -235,6 +239,119 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 
 }
 
+func (suite *KeeperTestSuite) TestUseSponsoredFees() {
+	sponsor := suite.app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	grantee := suite.addrs[1]
+	msgs := []sdk.Msg{banktypes.NewMsgSend(grantee, suite.addrs[2], suite.atom)}
+
+	// sponsorship is disabled by default
+	err := suite.keeper.UseGrantedFees(suite.sdkCtx, sponsor, grantee, suite.atom, msgs)
+	suite.Require().Error(err)
+
+	params := feegrant.NewSponsorshipParams(minttypes.ModuleName, 1, suite.atom, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	suite.Require().NoError(params.Validate())
+	suite.keeper.SetSponsorshipParams(suite.sdkCtx, params)
+	suite.Require().Equal(params, suite.keeper.GetSponsorshipParams(suite.sdkCtx))
+
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, sponsor, grantee, suite.atom, msgs)
+	suite.Require().NoError(err)
+
+	// only the configured module account can sponsor fees
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, suite.addrs[0], grantee, suite.atom, msgs)
+	suite.Require().Error(err)
+
+	// fee above the sponsored limit
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, sponsor, grantee, suite.atom.Add(suite.atom...), msgs)
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+
+	// message not allowed
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, sponsor, grantee, suite.atom, []sdk.Msg{&feegrant.MsgRevokeAllowance{}})
+	suite.Require().ErrorIs(err, feegrant.ErrMessageNotAllowed)
+
+	// account already sent its sponsored txs
+	acc := suite.app.AccountKeeper.GetAccount(suite.sdkCtx, grantee)
+	suite.Require().NoError(acc.SetSequence(1))
+	suite.app.AccountKeeper.SetAccount(suite.sdkCtx, acc)
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, sponsor, grantee, suite.atom, msgs)
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+}
+
+func (suite *KeeperTestSuite) TestUseStackedAllowances() {
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
+
+	atomAllowance := &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &exp}
+	ethAllowance := &feegrant.BasicAllowance{SpendLimit: eth, Expiration: &exp}
+
+	suite.Require().NoError(suite.keeper.GrantKeyedAllowance(suite.sdkCtx, granter, grantee, "dapp", ethAllowance))
+	suite.Require().NoError(suite.keeper.GrantAllowance(suite.sdkCtx, granter, grantee, atomAllowance))
+
+	// the grant of the default key is stored apart from the keyed grant
+	loaded, err := suite.keeper.GetAllowance(suite.sdkCtx, granter, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(atomAllowance, loaded)
+
+	grants, err := suite.keeper.GetAllowances(suite.sdkCtx, granter, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Len(grants, 2)
+	suite.Require().Equal("", grants[0].AllowanceKey)
+	suite.Require().Equal("dapp", grants[1].AllowanceKey)
+
+	// a fee in eth is only accepted by the allowance of the "dapp" key
+	suite.Require().NoError(suite.keeper.UseGrantedFees(suite.sdkCtx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("eth", 23)), nil))
+	loaded, err = suite.keeper.GetKeyedAllowance(suite.sdkCtx, granter, grantee, "dapp")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("eth", 100)), loaded.(*feegrant.BasicAllowance).SpendLimit)
+	loaded, err = suite.keeper.GetAllowance(suite.sdkCtx, granter, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(atomAllowance, loaded)
+
+	// a fee accepted by no allowance reports the errors of all of them
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("eth", 1000)), nil)
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+	suite.Require().Contains(err.Error(), `"dapp"`)
+
+	// revoking the allowance of a key keeps the other allowances
+	_, err = suite.msgSrvr.RevokeAllowance(suite.ctx, &feegrant.MsgRevokeAllowance{Granter: granter.String(), Grantee: grantee.String(), AllowanceKey: "dapp"})
+	suite.Require().NoError(err)
+	_, err = suite.keeper.GetKeyedAllowance(suite.sdkCtx, granter, grantee, "dapp")
+	suite.Require().Error(err)
+	loaded, err = suite.keeper.GetAllowance(suite.sdkCtx, granter, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(atomAllowance, loaded)
+
+	// an allowance spent entirely is removed
+	suite.Require().NoError(suite.keeper.UseGrantedFees(suite.sdkCtx, granter, grantee, suite.atom, nil))
+	grants, err = suite.keeper.GetAllowances(suite.sdkCtx, granter, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Empty(grants)
+
+	err = suite.keeper.GrantKeyedAllowance(suite.sdkCtx, granter, grantee, strings.Repeat("a", feegrant.MaxAllowanceKeyLength+1), atomAllowance)
+	suite.Require().ErrorIs(err, feegrant.ErrInvalidAllowanceKey)
+}
+
+func (suite *KeeperTestSuite) TestModuleAllowance() {
+	grantee := suite.addrs[1]
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
+	allowance := &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &exp}
+
+	err := suite.keeper.GrantModuleAllowance(suite.sdkCtx, "unknown", grantee, "dapp", allowance)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnknownAddress)
+
+	suite.Require().NoError(suite.keeper.GrantModuleAllowance(suite.sdkCtx, minttypes.ModuleName, grantee, "dapp", allowance))
+	loaded, err := suite.keeper.GetKeyedAllowance(suite.sdkCtx, moduleAddr, grantee, "dapp")
+	suite.Require().NoError(err)
+	suite.Require().Equal(allowance, loaded)
+
+	suite.Require().NoError(suite.keeper.UseGrantedFees(suite.sdkCtx, moduleAddr, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), nil))
+
+	suite.Require().NoError(suite.keeper.RevokeModuleAllowance(suite.sdkCtx, minttypes.ModuleName, grantee, "dapp"))
+	_, err = suite.keeper.GetKeyedAllowance(suite.sdkCtx, moduleAddr, grantee, "dapp")
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestIterateGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
@@ -38,7 +38,7 @@ func (k msgServer) GrantAllowance(goCtx context.Context, msg *feegrant.MsgGrantA
 	}
 
 	// Checking for duplicate entry
-	if f, _ := k.Keeper.GetAllowance(ctx, granter, grantee); f != nil {
+	if f, _ := k.Keeper.GetKeyedAllowance(ctx, granter, grantee, msg.AllowanceKey); f != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance already exists")
 	}
 
@@ -47,7 +47,7 @@ func (k msgServer) GrantAllowance(goCtx context.Context, msg *feegrant.MsgGrantA
 		return nil, err
 	}
 
-	err = k.Keeper.GrantAllowance(ctx, granter, grantee, allowance)
+	err = k.Keeper.GrantKeyedAllowance(ctx, granter, grantee, msg.AllowanceKey, allowance)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +69,7 @@ func (k msgServer) RevokeAllowance(goCtx context.Context, msg *feegrant.MsgRevok
 		return nil, err
 	}
 
-	err = k.Keeper.revokeAllowance(ctx, granter, grantee)
+	err = k.Keeper.RevokeKeyedAllowance(ctx, granter, grantee, msg.AllowanceKey)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +77,42 @@ func (suite *KeeperTestSuite) TestGrantAllowance() {
 			true,
 			"fee allowance already exists",
 		},
+		{
+			"valid: fee allowance of another allowance key",
+			func() *feegrant.MsgGrantAllowance {
+				any, err := codectypes.NewAnyWithValue(&feegrant.BasicAllowance{
+					SpendLimit: suite.atom,
+					Expiration: &oneYear,
+				})
+				suite.Require().NoError(err)
+				return &feegrant.MsgGrantAllowance{
+					Granter:      suite.addrs[0].String(),
+					Grantee:      suite.addrs[1].String(),
+					Allowance:    any,
+					AllowanceKey: "dapp",
+				}
+			},
+			false,
+			"",
+		},
+		{
+			"fail: fee allowance of the allowance key exists",
+			func() *feegrant.MsgGrantAllowance {
+				any, err := codectypes.NewAnyWithValue(&feegrant.BasicAllowance{
+					SpendLimit: suite.atom,
+					Expiration: &oneYear,
+				})
+				suite.Require().NoError(err)
+				return &feegrant.MsgGrantAllowance{
+					Granter:      suite.addrs[0].String(),
+					Grantee:      suite.addrs[1].String(),
+					Allowance:    any,
+					AllowanceKey: "dapp",
+				}
+			},
+			true,
+			"fee allowance already exists",
+		},
 		{
 			"valid: periodic fee allowance",
 			func() *feegrant.MsgGrantAllowance {
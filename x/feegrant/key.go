@@ -3,6 +3,7 @@ package feegrant
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
@@ -17,6 +18,9 @@ const (
 
 	// QuerierRoute is the querier route for supply
 	QuerierRoute = ModuleName
+
+	// MaxAllowanceKeyLength is the maximum length of the key of an allowance
+	MaxAllowanceKeyLength = 64
 )
 
 var (
@@ -30,6 +34,23 @@ func FeeAllowanceKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
 	return append(FeeAllowancePrefixByGrantee(grantee), address.MustLengthPrefix(granter.Bytes())...)
 }
 
+// KeyedFeeAllowanceKey is the key to store a grant of the given allowance key
+// from granter to grantee. The grant of the default, empty, allowance key is
+// stored under FeeAllowanceKey, which prefixes the keys of all the allowances
+// stacked by the granter for the grantee.
+func KeyedFeeAllowanceKey(granter sdk.AccAddress, grantee sdk.AccAddress, allowanceKey string) []byte {
+	return append(FeeAllowanceKey(granter, grantee), allowanceKey...)
+}
+
+// ValidateAllowanceKey returns an error if the key of an allowance is too long.
+func ValidateAllowanceKey(allowanceKey string) error {
+	if len(allowanceKey) > MaxAllowanceKeyLength {
+		return sdkerrors.Wrapf(ErrInvalidAllowanceKey, "length %d exceeds %d", len(allowanceKey), MaxAllowanceKeyLength)
+	}
+
+	return nil
+}
+
 // FeeAllowancePrefixByGrantee returns a prefix to scan for all grants to this given address.
 func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
 	return append(FeeAllowanceKeyPrefix, address.MustLengthPrefix(grantee.Bytes())...)
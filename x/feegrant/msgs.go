@@ -47,6 +47,9 @@ func (msg MsgGrantAllowance) ValidateBasic() error {
 	if msg.Grantee == msg.Granter {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
 	}
+	if err := ValidateAllowanceKey(msg.AllowanceKey); err != nil {
+		return err
+	}
 	allowance, err := msg.GetFeeAllowanceI()
 	if err != nil {
 		return err
@@ -110,6 +113,9 @@ func (msg MsgRevokeAllowance) ValidateBasic() error {
 	if msg.Grantee == msg.Granter {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "addresses must be different")
 	}
+	if err := ValidateAllowanceKey(msg.AllowanceKey); err != nil {
+		return err
+	}
 
 	return nil
 }
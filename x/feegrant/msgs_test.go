@@ -1,6 +1,7 @@
 package feegrant_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -24,10 +25,11 @@ func TestMsgGrantAllowance(t *testing.T) {
 	}
 
 	cases := map[string]struct {
-		grantee sdk.AccAddress
-		granter sdk.AccAddress
-		grant   *feegrant.BasicAllowance
-		valid   bool
+		grantee      sdk.AccAddress
+		granter      sdk.AccAddress
+		grant        *feegrant.BasicAllowance
+		allowanceKey string
+		valid        bool
 	}{
 		"valid": {
 			grantee: addr,
@@ -35,6 +37,20 @@ func TestMsgGrantAllowance(t *testing.T) {
 			grant:   basic,
 			valid:   true,
 		},
+		"valid allowance key": {
+			grantee:      addr,
+			granter:      addr2,
+			grant:        basic,
+			allowanceKey: "dapp",
+			valid:        true,
+		},
+		"allowance key too long": {
+			grantee:      addr,
+			granter:      addr2,
+			grant:        basic,
+			allowanceKey: strings.Repeat("a", feegrant.MaxAllowanceKeyLength+1),
+			valid:        false,
+		},
 		"no grantee": {
 			granter: addr2,
 			grantee: sdk.AccAddress{},
@@ -58,6 +74,7 @@ func TestMsgGrantAllowance(t *testing.T) {
 	for _, tc := range cases {
 		msg, err := feegrant.NewMsgGrantAllowance(tc.grant, tc.granter, tc.grantee)
 		require.NoError(t, err)
+		msg.AllowanceKey = tc.allowanceKey
 		err = msg.ValidateBasic()
 
 		if tc.valid {
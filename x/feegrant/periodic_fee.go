@@ -29,17 +29,18 @@ func (a *PeriodicAllowance) Accept(ctx sdk.Context, fee sdk.Coins, _ []sdk.Msg)
 	a.tryResetPeriod(blockTime)
 
 	// deduct from both the current period and the max amount
-	var isNeg bool
-	a.PeriodCanSpend, isNeg = a.PeriodCanSpend.SafeSub(fee)
-	if isNeg {
+	periodCanSpend, err := a.PeriodCanSpend.SafeSub(fee)
+	if err != nil {
 		return false, sdkerrors.Wrap(ErrFeeLimitExceeded, "period limit")
 	}
+	a.PeriodCanSpend = periodCanSpend
 
 	if a.Basic.SpendLimit != nil {
-		a.Basic.SpendLimit, isNeg = a.Basic.SpendLimit.SafeSub(fee)
-		if isNeg {
+		spendLimit, err := a.Basic.SpendLimit.SafeSub(fee)
+		if err != nil {
 			return false, sdkerrors.Wrap(ErrFeeLimitExceeded, "absolute limit")
 		}
+		a.Basic.SpendLimit = spendLimit
 
 		return a.Basic.SpendLimit.IsZero(), nil
 	}
@@ -59,7 +60,7 @@ func (a *PeriodicAllowance) tryResetPeriod(blockTime time.Time) {
 	}
 
 	// set PeriodCanSpend to the lesser of Basic.SpendLimit and PeriodSpendLimit
-	if _, isNeg := a.Basic.SpendLimit.SafeSub(a.PeriodSpendLimit); isNeg && !a.Basic.SpendLimit.Empty() {
+	if _, err := a.Basic.SpendLimit.SafeSub(a.PeriodSpendLimit); err != nil && !a.Basic.SpendLimit.Empty() {
 		a.PeriodCanSpend = a.Basic.SpendLimit
 	} else {
 		a.PeriodCanSpend = a.PeriodSpendLimit
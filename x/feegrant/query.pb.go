@@ -36,6 +36,8 @@ type QueryAllowanceRequest struct {
 	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
 	// grantee is the address of the user being granted an allowance of another user's funds.
 	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// allowance_key is the key of the allowance, the default allowance if empty.
+	AllowanceKey string `protobuf:"bytes,3,opt,name=allowance_key,json=allowanceKey,proto3" json:"allowance_key,omitempty"`
 }
 
 func (m *QueryAllowanceRequest) Reset()         { *m = QueryAllowanceRequest{} }
@@ -85,6 +87,13 @@ func (m *QueryAllowanceRequest) GetGrantee() string {
 	return ""
 }
 
+func (m *QueryAllowanceRequest) GetAllowanceKey() string {
+	if m != nil {
+		return m.AllowanceKey
+	}
+	return ""
+}
+
 // QueryAllowanceResponse is the response type for the Query/Allowance RPC method.
 type QueryAllowanceResponse struct {
 	// allowance is a allowance granted for grantee by granter.
@@ -252,36 +261,37 @@ func init() {
 }
 
 var fileDescriptor_59efc303945de53f = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8b, 0x13, 0x31,
-	0x18, 0xc6, 0x9b, 0x8a, 0x4a, 0xb3, 0xb7, 0xa0, 0xee, 0x38, 0xc8, 0xb0, 0x8c, 0xb0, 0x2b, 0x42,
-	0x13, 0x77, 0x44, 0xf1, 0x20, 0x0b, 0xdd, 0x83, 0x7b, 0xd5, 0x11, 0x3c, 0x78, 0x91, 0x4c, 0xfb,
-	0x3a, 0x0e, 0x76, 0x93, 0xd9, 0x49, 0xea, 0x1f, 0x64, 0x11, 0xfc, 0x04, 0x82, 0x7e, 0x02, 0x0f,
-	0x9e, 0x3c, 0xfa, 0x21, 0x3c, 0x2e, 0x7a, 0xf1, 0xa6, 0xb4, 0x7e, 0x10, 0x69, 0x26, 0x99, 0x29,
-	0x6d, 0x87, 0xce, 0xa9, 0xcd, 0xe4, 0x79, 0x9e, 0xfc, 0xde, 0x37, 0x6f, 0xf0, 0xf5, 0xa1, 0x54,
-	0xc7, 0x52, 0xb1, 0xe7, 0x00, 0x69, 0xc1, 0x85, 0x66, 0xaf, 0xf6, 0x13, 0xd0, 0x7c, 0x9f, 0x9d,
-	0x4c, 0xa0, 0x78, 0x4b, 0xf3, 0x42, 0x6a, 0x49, 0xb6, 0x4b, 0x11, 0x75, 0x22, 0x6a, 0x45, 0xfe,
-	0x6e, 0x93, 0xbb, 0x52, 0x9a, 0x00, 0xff, 0xa6, 0xd5, 0x25, 0x5c, 0x41, 0x99, 0x5c, 0x29, 0x73,
-	0x9e, 0x66, 0x82, 0xeb, 0x4c, 0x0a, 0xab, 0xbd, 0x96, 0x4a, 0x99, 0x8e, 0x81, 0xf1, 0x3c, 0x63,
-	0x5c, 0x08, 0xa9, 0xcd, 0xa6, 0xb2, 0xbb, 0x57, 0xcb, 0xa4, 0x67, 0x66, 0xc5, 0x2c, 0x97, 0x59,
-	0x84, 0xef, 0xf1, 0xe5, 0x47, 0xf3, 0xe8, 0xc1, 0x78, 0x2c, 0x5f, 0x73, 0x31, 0x84, 0x18, 0x4e,
-	0x26, 0xa0, 0x34, 0x89, 0xf0, 0x45, 0x03, 0x03, 0x85, 0x87, 0x76, 0xd0, 0x8d, 0xde, 0xa1, 0xf7,
-	0xf3, 0x7b, 0xff, 0x92, 0xf5, 0x0e, 0x46, 0xa3, 0x02, 0x94, 0x7a, 0xac, 0x8b, 0x4c, 0xa4, 0xb1,
-	0x13, 0xd6, 0x1e, 0xf0, 0xba, 0xed, 0x3c, 0x10, 0x3e, 0xc1, 0x57, 0x96, 0x01, 0x54, 0x2e, 0x85,
-	0x02, 0x72, 0x1f, 0xf7, 0xb8, 0xfb, 0x68, 0x18, 0xb6, 0xa2, 0x80, 0x36, 0x34, 0x95, 0x1e, 0xcd,
-	0x57, 0x71, 0x6d, 0x08, 0x3f, 0xa3, 0xe5, 0x60, 0xb5, 0x52, 0x1a, 0xb4, 0x2d, 0x0d, 0xc8, 0x03,
-	0x8c, 0xeb, 0xa6, 0x9b, 0xea, 0xb6, 0xa2, 0x5d, 0x47, 0x33, 0xbf, 0x21, 0x5a, 0xde, 0xbd, 0xe3,
-	0x79, 0xc8, 0x53, 0xd7, 0xca, 0x78, 0xc1, 0x19, 0x7e, 0x41, 0x78, 0x7b, 0x05, 0xcb, 0x16, 0x7c,
-	0x80, 0x71, 0xc5, 0xaf, 0x3c, 0xb4, 0x73, 0xae, 0x45, 0xc5, 0x0b, 0x0e, 0x72, 0xb4, 0x86, 0x71,
-	0x6f, 0x23, 0x63, 0x79, 0xf8, 0x22, 0x64, 0xf4, 0xa7, 0x8b, 0xcf, 0x1b, 0x48, 0xf2, 0x0d, 0xe1,
-	0x5e, 0x45, 0x4a, 0x68, 0x23, 0xcc, 0xda, 0x19, 0xf2, 0x59, 0x6b, 0x7d, 0x09, 0x11, 0x1e, 0x7c,
-	0xf8, 0xf5, 0xef, 0x53, 0xf7, 0x1e, 0xb9, 0xcb, 0x9a, 0xde, 0x48, 0x55, 0x2e, 0x7b, 0x67, 0xa7,
-	0xee, 0xd4, 0xfd, 0x83, 0x53, 0xf2, 0x15, 0x61, 0x5c, 0x37, 0x96, 0xb4, 0x3d, 0xdf, 0x4d, 0x86,
-	0x7f, 0xab, 0xbd, 0xc1, 0x12, 0xdf, 0x31, 0xc4, 0x8c, 0xf4, 0x37, 0x13, 0xab, 0x1a, 0xf4, 0x70,
-	0xf0, 0x63, 0x1a, 0xa0, 0xb3, 0x69, 0x80, 0xfe, 0x4e, 0x03, 0xf4, 0x71, 0x16, 0x74, 0xce, 0x66,
-	0x41, 0xe7, 0xf7, 0x2c, 0xe8, 0x3c, 0xdd, 0x4b, 0x33, 0xfd, 0x62, 0x92, 0xd0, 0xa1, 0x3c, 0x76,
-	0x91, 0xe5, 0x4f, 0x5f, 0x8d, 0x5e, 0xb2, 0x37, 0x55, 0x7e, 0x72, 0xc1, 0x3c, 0xe0, 0xdb, 0xff,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x15, 0x17, 0x19, 0x54, 0x8d, 0x04, 0x00, 0x00,
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xb1, 0x6e, 0x13, 0x31,
+	0x18, 0xc7, 0xe3, 0x54, 0x80, 0xe2, 0xc2, 0x62, 0x01, 0x3d, 0x22, 0x74, 0xaa, 0xae, 0x52, 0x8b,
+	0x90, 0x62, 0xd3, 0x20, 0x10, 0x03, 0xaa, 0x94, 0x0e, 0x74, 0x60, 0x81, 0x43, 0x62, 0x60, 0xa9,
+	0x9c, 0xe4, 0xe3, 0x38, 0x35, 0xb5, 0xd3, 0xb3, 0x03, 0x44, 0xa8, 0x0b, 0x4f, 0x80, 0x04, 0x4f,
+	0x80, 0x10, 0x13, 0x23, 0x0f, 0xc1, 0x58, 0xc1, 0xc2, 0x06, 0x4a, 0x78, 0x10, 0x74, 0x3e, 0xdb,
+	0x17, 0xb5, 0x3d, 0xe5, 0xa6, 0xc4, 0xe7, 0xff, 0xff, 0xef, 0xdf, 0xf7, 0xf9, 0x33, 0xde, 0x18,
+	0x48, 0x75, 0x28, 0x15, 0x7b, 0x09, 0x90, 0x64, 0x5c, 0x68, 0xf6, 0x7a, 0xbb, 0x0f, 0x9a, 0x6f,
+	0xb3, 0xa3, 0x09, 0x64, 0x53, 0x3a, 0xce, 0xa4, 0x96, 0x64, 0xad, 0x10, 0x51, 0x27, 0xa2, 0x56,
+	0xd4, 0xde, 0xac, 0x72, 0x7b, 0xa5, 0x09, 0x68, 0xdf, 0xb6, 0xba, 0x3e, 0x57, 0x50, 0x24, 0x7b,
+	0xe5, 0x98, 0x27, 0xa9, 0xe0, 0x3a, 0x95, 0xc2, 0x6a, 0x6f, 0x26, 0x52, 0x26, 0x23, 0x60, 0x7c,
+	0x9c, 0x32, 0x2e, 0x84, 0xd4, 0x66, 0x53, 0xd9, 0xdd, 0x1b, 0x45, 0xd2, 0xbe, 0x59, 0x31, 0xcb,
+	0x65, 0x16, 0xd1, 0x17, 0x84, 0xaf, 0x3d, 0xcd, 0xb3, 0x7b, 0xa3, 0x91, 0x7c, 0xc3, 0xc5, 0x00,
+	0x62, 0x38, 0x9a, 0x80, 0xd2, 0xa4, 0x8b, 0x2f, 0x19, 0x1a, 0xc8, 0x02, 0xb4, 0x8e, 0x6e, 0xb5,
+	0x76, 0x83, 0x9f, 0xdf, 0x3b, 0x57, 0xad, 0xb9, 0x37, 0x1c, 0x66, 0xa0, 0xd4, 0x33, 0x9d, 0xa5,
+	0x22, 0x89, 0x9d, 0xb0, 0xf4, 0x40, 0xd0, 0xac, 0xe7, 0x01, 0xb2, 0x81, 0xaf, 0x70, 0x77, 0xf6,
+	0xfe, 0x01, 0x4c, 0x83, 0x95, 0xdc, 0x19, 0x5f, 0xf6, 0x1f, 0x1f, 0xc3, 0x34, 0x7a, 0x8e, 0xaf,
+	0x9f, 0xa6, 0x54, 0x63, 0x29, 0x14, 0x90, 0x87, 0xb8, 0xe5, 0x95, 0x06, 0x74, 0xb5, 0x1b, 0xd2,
+	0x8a, 0xd6, 0xd3, 0xbd, 0x7c, 0x15, 0x97, 0x86, 0xe8, 0x13, 0x3a, 0x1d, 0xac, 0xce, 0xd4, 0x0f,
+	0x75, 0xeb, 0x07, 0xf2, 0x08, 0xe3, 0xf2, 0x6a, 0x4c, 0x0b, 0x56, 0xbb, 0x9b, 0x8e, 0x26, 0xbf,
+	0x47, 0x5a, 0x4c, 0x88, 0xe3, 0x79, 0xc2, 0x13, 0xd7, 0xef, 0x78, 0xc1, 0x19, 0x7d, 0x46, 0x78,
+	0xed, 0x0c, 0x96, 0x2d, 0x78, 0x07, 0x63, 0xcf, 0xaf, 0x02, 0xb4, 0xbe, 0x52, 0xa3, 0xe2, 0x05,
+	0x07, 0xd9, 0x3b, 0x87, 0x71, 0x6b, 0x29, 0x63, 0x71, 0xf8, 0x22, 0x64, 0xf7, 0x4f, 0x13, 0x5f,
+	0x30, 0x90, 0xe4, 0x1b, 0xc2, 0x2d, 0x4f, 0x4a, 0x68, 0x25, 0xcc, 0xb9, 0x83, 0xd6, 0x66, 0xb5,
+	0xf5, 0x05, 0x44, 0xb4, 0xf3, 0xfe, 0xd7, 0xbf, 0x8f, 0xcd, 0x07, 0xe4, 0x3e, 0xab, 0x7a, 0x49,
+	0xbe, 0x5c, 0xf6, 0xce, 0x8e, 0xe6, 0xb1, 0xfb, 0x07, 0xc7, 0xe4, 0x2b, 0xc2, 0xb8, 0x6c, 0x2c,
+	0xa9, 0x7b, 0xbe, 0x9b, 0x8c, 0xf6, 0x9d, 0xfa, 0x06, 0x4b, 0x7c, 0xcf, 0x10, 0x33, 0xd2, 0x59,
+	0x4e, 0xac, 0x4a, 0xd0, 0xdd, 0xde, 0x8f, 0x59, 0x88, 0x4e, 0x66, 0x21, 0xfa, 0x3b, 0x0b, 0xd1,
+	0x87, 0x79, 0xd8, 0x38, 0x99, 0x87, 0x8d, 0xdf, 0xf3, 0xb0, 0xf1, 0x62, 0x2b, 0x49, 0xf5, 0xab,
+	0x49, 0x9f, 0x0e, 0xe4, 0xa1, 0x8b, 0x2c, 0x7e, 0x3a, 0x6a, 0x78, 0xc0, 0xde, 0xfa, 0xfc, 0xfe,
+	0x45, 0xf3, 0xcc, 0xef, 0xfe, 0x1f, 0x00, 0x1f, 0x5d, 0xd2, 0xf0, 0xb3, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -424,6 +434,13 @@ func (m *QueryAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowanceKey) > 0 {
+		i -= len(m.AllowanceKey)
+		copy(dAtA[i:], m.AllowanceKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowanceKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Grantee) > 0 {
 		i -= len(m.Grantee)
 		copy(dAtA[i:], m.Grantee)
@@ -592,6 +609,10 @@ func (m *QueryAllowanceRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.AllowanceKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -743,6 +764,38 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowanceKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowanceKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,175 @@
+package feegrant
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys for fee sponsorship.
+var (
+	KeySponsorModule          = []byte("SponsorModule")
+	KeySponsorMaxTxs          = []byte("SponsorMaxTxs")
+	KeySponsorSpendLimit      = []byte("SponsorSpendLimit")
+	KeySponsorAllowedMessages = []byte("SponsorAllowedMessages")
+)
+
+var _ paramtypes.ParamSet = (*SponsorshipParams)(nil)
+
+// SponsorshipParams configures a module account that pays the fees of new
+// accounts without requiring an explicit grant. A tx opts into sponsorship by
+// setting the sponsor module account address as its fee granter.
+type SponsorshipParams struct {
+	// ModuleName is the name of the module account paying the fees. An empty
+	// name disables sponsorship.
+	ModuleName string `json:"module_name" yaml:"module_name"`
+	// MaxTxs is the number of txs sponsored per account, counted by the
+	// account sequence.
+	MaxTxs uint64 `json:"max_txs" yaml:"max_txs"`
+	// SpendLimit is the maximum fee sponsored for a single tx. An empty limit
+	// means any fee is sponsored.
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+	// AllowedMessages restricts the sponsored txs to the given Msg type URLs.
+	// An empty list allows all messages.
+	AllowedMessages []string `json:"allowed_messages" yaml:"allowed_messages"`
+}
+
+// ParamKeyTable returns the parameter key table for the feegrant module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&SponsorshipParams{})
+}
+
+// NewSponsorshipParams creates a new SponsorshipParams instance.
+func NewSponsorshipParams(moduleName string, maxTxs uint64, spendLimit sdk.Coins, allowedMsgs []string) SponsorshipParams {
+	return SponsorshipParams{
+		ModuleName:      moduleName,
+		MaxTxs:          maxTxs,
+		SpendLimit:      spendLimit,
+		AllowedMessages: allowedMsgs,
+	}
+}
+
+// DefaultSponsorshipParams returns sponsorship params with sponsorship
+// disabled.
+func DefaultSponsorshipParams() SponsorshipParams {
+	return SponsorshipParams{}
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *SponsorshipParams) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySponsorModule, &p.ModuleName, validateSponsorModule),
+		paramtypes.NewParamSetPair(KeySponsorMaxTxs, &p.MaxTxs, validateSponsorMaxTxs),
+		paramtypes.NewParamSetPair(KeySponsorSpendLimit, &p.SpendLimit, validateSponsorSpendLimit),
+		paramtypes.NewParamSetPair(KeySponsorAllowedMessages, &p.AllowedMessages, validateSponsorAllowedMessages),
+	}
+}
+
+// Enabled returns true if a sponsor module account is configured.
+func (p SponsorshipParams) Enabled() bool {
+	return p.ModuleName != ""
+}
+
+// Validate performs basic validation of the sponsorship params.
+func (p SponsorshipParams) Validate() error {
+	if err := validateSponsorModule(p.ModuleName); err != nil {
+		return err
+	}
+	if err := validateSponsorMaxTxs(p.MaxTxs); err != nil {
+		return err
+	}
+	if err := validateSponsorSpendLimit(p.SpendLimit); err != nil {
+		return err
+	}
+	return validateSponsorAllowedMessages(p.AllowedMessages)
+}
+
+// Accept checks whether a tx with the given fee and msgs, sent by an account
+// with the given sequence, is eligible for sponsorship.
+func (p SponsorshipParams) Accept(sequence uint64, fee sdk.Coins, msgs []sdk.Msg) error {
+	if sequence >= p.MaxTxs {
+		return sdkerrors.Wrapf(ErrFeeLimitExceeded, "sponsorship limited to the first %d txs of an account", p.MaxTxs)
+	}
+
+	if !p.SpendLimit.Empty() && !fee.IsAllLTE(p.SpendLimit) {
+		return sdkerrors.Wrapf(ErrFeeLimitExceeded, "fee %s exceeds sponsored limit %s", fee, p.SpendLimit)
+	}
+
+	if len(p.AllowedMessages) == 0 {
+		return nil
+	}
+
+	allowed := make(map[string]bool, len(p.AllowedMessages))
+	for _, msg := range p.AllowedMessages {
+		allowed[msg] = true
+	}
+
+	for _, msg := range msgs {
+		if !allowed[sdk.MsgTypeURL(msg)] {
+			return sdkerrors.Wrap(ErrMessageNotAllowed, sdk.MsgTypeURL(msg))
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p SponsorshipParams) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateSponsorModule(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) != v {
+		return fmt.Errorf("sponsor module name cannot contain leading or trailing spaces: %q", v)
+	}
+
+	return nil
+}
+
+func validateSponsorMaxTxs(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateSponsorSpendLimit(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.Empty() {
+		return nil
+	}
+
+	return v.Validate()
+}
+
+func validateSponsorAllowedMessages(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, msg := range v {
+		if strings.TrimSpace(msg) == "" {
+			return fmt.Errorf("allowed message type URL cannot be blank")
+		}
+	}
+
+	return nil
+}
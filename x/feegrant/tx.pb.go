@@ -39,6 +39,9 @@ type MsgGrantAllowance struct {
 	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 	// allowance can be any of basic and filtered fee allowance.
 	Allowance *types.Any `protobuf:"bytes,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// allowance_key is the key of the allowance, allowing to stack several
+	// allowances for the grantee. It defaults to the empty key.
+	AllowanceKey string `protobuf:"bytes,4,opt,name=allowance_key,json=allowanceKey,proto3" json:"allowance_key,omitempty"`
 }
 
 func (m *MsgGrantAllowance) Reset()         { *m = MsgGrantAllowance{} }
@@ -95,6 +98,13 @@ func (m *MsgGrantAllowance) GetAllowance() *types.Any {
 	return nil
 }
 
+func (m *MsgGrantAllowance) GetAllowanceKey() string {
+	if m != nil {
+		return m.AllowanceKey
+	}
+	return ""
+}
+
 // MsgGrantAllowanceResponse defines the Msg/GrantAllowanceResponse response type.
 type MsgGrantAllowanceResponse struct {
 }
@@ -138,6 +148,8 @@ type MsgRevokeAllowance struct {
 	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
 	// grantee is the address of the user being granted an allowance of another user's funds.
 	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// allowance_key is the key of the revoked allowance.
+	AllowanceKey string `protobuf:"bytes,3,opt,name=allowance_key,json=allowanceKey,proto3" json:"allowance_key,omitempty"`
 }
 
 func (m *MsgRevokeAllowance) Reset()         { *m = MsgRevokeAllowance{} }
@@ -187,6 +199,13 @@ func (m *MsgRevokeAllowance) GetGrantee() string {
 	return ""
 }
 
+func (m *MsgRevokeAllowance) GetAllowanceKey() string {
+	if m != nil {
+		return m.AllowanceKey
+	}
+	return ""
+}
+
 // MsgRevokeAllowanceResponse defines the Msg/RevokeAllowanceResponse response type.
 type MsgRevokeAllowanceResponse struct {
 }
@@ -234,30 +253,32 @@ func init() {
 func init() { proto.RegisterFile("cosmos/feegrant/v1beta1/tx.proto", fileDescriptor_dd44ad7946dad783) }
 
 var fileDescriptor_dd44ad7946dad783 = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0x24, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2,
-	0x34, 0xfd, 0xc4, 0xbc, 0x4a, 0x98, 0x14, 0xc4, 0xa4, 0x78, 0x88, 0x1e, 0xa8, 0xb1, 0x60, 0x8e,
-	0xd2, 0x21, 0x46, 0x2e, 0x41, 0xdf, 0xe2, 0x74, 0x77, 0x90, 0x05, 0x8e, 0x39, 0x39, 0xf9, 0xe5,
-	0x89, 0x79, 0xc9, 0xa9, 0x42, 0x46, 0x5c, 0xec, 0x60, 0x2b, 0x53, 0x8b, 0x24, 0x18, 0x15, 0x18,
-	0x35, 0x38, 0x9d, 0x24, 0x2e, 0x6d, 0xd1, 0x15, 0x81, 0x6a, 0x74, 0x4c, 0x49, 0x29, 0x4a, 0x2d,
-	0x2e, 0x0e, 0x2e, 0x29, 0xca, 0xcc, 0x4b, 0x0f, 0x82, 0x29, 0x44, 0xe8, 0x49, 0x95, 0x60, 0x22,
-	0x4e, 0x4f, 0xaa, 0x90, 0x2b, 0x17, 0x67, 0x22, 0xcc, 0x52, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x6e,
-	0x23, 0x11, 0x3d, 0x88, 0x3f, 0xf4, 0x60, 0xfe, 0xd0, 0x73, 0xcc, 0xab, 0x74, 0x12, 0x3c, 0xb5,
-	0x45, 0x97, 0xd7, 0x2d, 0x35, 0x15, 0xee, 0x44, 0xcf, 0x20, 0x84, 0x4e, 0x25, 0x69, 0x2e, 0x49,
-	0x0c, 0x3f, 0x04, 0xa5, 0x16, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x2a, 0xd5, 0x70, 0x09, 0xf9, 0x16,
-	0xa7, 0x07, 0xa5, 0x96, 0xe5, 0x67, 0xa7, 0xd2, 0xdd, 0x87, 0x4a, 0x32, 0x5c, 0x52, 0x98, 0xb6,
-	0xc3, 0xdc, 0x66, 0xf4, 0x86, 0x91, 0x8b, 0xd9, 0xb7, 0x38, 0x5d, 0xa8, 0x80, 0x8b, 0x0f, 0x2d,
-	0x06, 0xb4, 0xf4, 0x70, 0xc4, 0xbe, 0x1e, 0x86, 0x4f, 0xa5, 0x8c, 0x88, 0x57, 0x0b, 0xb3, 0x59,
-	0xa8, 0x98, 0x8b, 0x1f, 0x3d, 0x48, 0xb4, 0xf1, 0x19, 0x83, 0xa6, 0x58, 0xca, 0x98, 0x04, 0xc5,
-	0x30, 0x4b, 0x9d, 0x1c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3d,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x17, 0x9a, 0x3e, 0xa1, 0x94, 0x6e, 0x71,
-	0x4a, 0xb6, 0x7e, 0x05, 0x3c, 0x97, 0x24, 0xb1, 0x81, 0x93, 0x85, 0x31, 0x20, 0x00, 0x00, 0xff,
-	0xff, 0xa8, 0x2c, 0xa7, 0x12, 0x3f, 0x03, 0x00, 0x00,
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x41, 0x4e, 0xfa, 0x40,
+	0x14, 0xc6, 0x99, 0x3f, 0xff, 0x68, 0x18, 0x45, 0x43, 0x43, 0x62, 0xa9, 0xa6, 0x21, 0xb8, 0x90,
+	0x68, 0x98, 0x86, 0x72, 0x82, 0x92, 0xa8, 0x31, 0x86, 0x4d, 0xdd, 0xb9, 0x21, 0x2d, 0x3c, 0x46,
+	0x02, 0x74, 0x48, 0xa7, 0x20, 0xbd, 0x85, 0x57, 0xf0, 0x0e, 0x1c, 0xc2, 0xb8, 0x22, 0xae, 0x5c,
+	0x1a, 0x58, 0xb8, 0xf1, 0x10, 0x86, 0xb6, 0x53, 0x12, 0xaa, 0x06, 0x37, 0xae, 0xda, 0x69, 0x7f,
+	0xdf, 0xfb, 0xde, 0xf7, 0xfa, 0x8a, 0x8b, 0x2d, 0xc6, 0x07, 0x8c, 0x6b, 0x1d, 0x00, 0xea, 0x5a,
+	0x8e, 0xa7, 0x8d, 0xab, 0x36, 0x78, 0x56, 0x55, 0xf3, 0x26, 0x64, 0xe8, 0x32, 0x8f, 0x49, 0x07,
+	0x21, 0x41, 0x04, 0x41, 0x22, 0x42, 0xc9, 0x53, 0x46, 0x59, 0xc0, 0x68, 0xcb, 0xbb, 0x10, 0x57,
+	0x0a, 0x94, 0x31, 0xda, 0x07, 0x2d, 0x38, 0xd9, 0xa3, 0x8e, 0x66, 0x39, 0xbe, 0x78, 0x15, 0x56,
+	0x6a, 0x86, 0x9a, 0xa8, 0x6c, 0x70, 0x28, 0xbd, 0x23, 0x9c, 0x6b, 0x70, 0x7a, 0xb9, 0x34, 0x30,
+	0xfa, 0x7d, 0x76, 0x6f, 0x39, 0x2d, 0x90, 0x74, 0xbc, 0x1d, 0x58, 0x82, 0x2b, 0xa3, 0x22, 0x2a,
+	0x67, 0xea, 0xf2, 0xcb, 0xb4, 0x92, 0x8f, 0x84, 0x46, 0xbb, 0xed, 0x02, 0xe7, 0x37, 0x9e, 0xdb,
+	0x75, 0xa8, 0x29, 0xc0, 0x95, 0x06, 0xe4, 0x7f, 0x9b, 0x69, 0x40, 0x3a, 0xc7, 0x19, 0x4b, 0x98,
+	0xca, 0xe9, 0x22, 0x2a, 0xef, 0xe8, 0x79, 0x12, 0xe6, 0x20, 0x22, 0x07, 0x31, 0x1c, 0xbf, 0x9e,
+	0x7b, 0x9e, 0x56, 0xb2, 0x17, 0x00, 0x71, 0x8b, 0x57, 0xe6, 0x4a, 0x29, 0x1d, 0xe3, 0x6c, 0x7c,
+	0x68, 0xf6, 0xc0, 0x97, 0xff, 0x2f, 0x1b, 0x30, 0x77, 0xe3, 0x87, 0xd7, 0xe0, 0x97, 0x0e, 0x71,
+	0x21, 0x11, 0xd4, 0x04, 0x3e, 0x64, 0x0e, 0x87, 0xd2, 0x23, 0xc2, 0x52, 0x83, 0x53, 0x13, 0xc6,
+	0xac, 0x07, 0x7f, 0x3f, 0x87, 0x44, 0x80, 0xf4, 0x17, 0x01, 0x8e, 0xb0, 0x92, 0x6c, 0x51, 0x24,
+	0xd0, 0x3f, 0x10, 0x4e, 0x37, 0x38, 0x95, 0x86, 0x78, 0x6f, 0xed, 0x63, 0x9e, 0x92, 0x6f, 0x16,
+	0x89, 0x24, 0xe6, 0xa1, 0xe8, 0x9b, 0xb3, 0xc2, 0x59, 0xe2, 0x78, 0x7f, 0x7d, 0x6e, 0x67, 0x3f,
+	0x95, 0x59, 0x83, 0x95, 0xda, 0x2f, 0x60, 0x61, 0x5a, 0x37, 0x9e, 0xe6, 0x2a, 0x9a, 0xcd, 0x55,
+	0xf4, 0x36, 0x57, 0xd1, 0xc3, 0x42, 0x4d, 0xcd, 0x16, 0x6a, 0xea, 0x75, 0xa1, 0xa6, 0x6e, 0x4f,
+	0x68, 0xd7, 0xbb, 0x1b, 0xd9, 0xa4, 0xc5, 0x06, 0xd1, 0xaa, 0x47, 0x97, 0x0a, 0x6f, 0xf7, 0xb4,
+	0x49, 0xfc, 0xc3, 0xd9, 0x5b, 0xc1, 0x86, 0xd5, 0x3e, 0x07, 0x00, 0xa9, 0x87, 0x6d, 0x65, 0x8a,
+	0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -404,6 +425,13 @@ func (m *MsgGrantAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowanceKey) > 0 {
+		i -= len(m.AllowanceKey)
+		copy(dAtA[i:], m.AllowanceKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AllowanceKey)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Allowance != nil {
 		{
 			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
@@ -476,6 +504,13 @@ func (m *MsgRevokeAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowanceKey) > 0 {
+		i -= len(m.AllowanceKey)
+		copy(dAtA[i:], m.AllowanceKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AllowanceKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Grantee) > 0 {
 		i -= len(m.Grantee)
 		copy(dAtA[i:], m.Grantee)
@@ -545,6 +580,10 @@ func (m *MsgGrantAllowance) Size() (n int) {
 		l = m.Allowance.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.AllowanceKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -571,6 +610,10 @@ func (m *MsgRevokeAllowance) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.AllowanceKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -718,6 +761,38 @@ func (m *MsgGrantAllowance) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowanceKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowanceKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -882,6 +957,38 @@ func (m *MsgRevokeAllowance) Unmarshal(dAtA []byte) error {
 			}
 			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowanceKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowanceKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/grpc/modulegenesis"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// QueryModuleStateCmd returns a command exporting the genesis state of a
+// single module from a node serving module genesis queries.
+func QueryModuleStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-state [module-name]",
+		Short: "Export the genesis state of a single module from a node",
+		Long: `Export the genesis state of a single module at the latest height, or at the
+height of the --height flag, from a node with module-genesis-queries enabled.
+The state is printed as JSON and can be imported into a genesis file with
+init-from-module-state.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := modulegenesis.NewQueryClient(clientCtx)
+			res, err := queryClient.ModuleGenesis(cmd.Context(), &modulegenesis.QueryModuleGenesisRequest{ModuleName: args[0]})
+			if err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("Exported module %s at height %d\n", args[0], res.Height)
+			return clientCtx.PrintBytes(res.Genesis)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// InitFromModuleStateCmd returns a command replacing the genesis state of a
+// module in the genesis file by an exported state, e.g. to fork the state of a
+// module of a live chain into a devnet.
+func InitFromModuleStateCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init-from-module-state [module-name] [state-file]",
+		Short: "Replace the genesis state of a module by an exported state",
+		Long: `Replace the genesis state of a module in the genesis file by the JSON state
+exported by the module-state query, after validating it. The states of the
+other modules aren't updated, so they must stay consistent with the imported
+state, e.g. the supply of the bank module with the balances of the accounts.
+This command is meant for development networks.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			moduleName := args[0]
+			basic, ok := mbm[moduleName]
+			if !ok {
+				return fmt.Errorf("unknown module %s", moduleName)
+			}
+
+			state, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			if err := basic.ValidateGenesis(clientCtx.Codec, clientCtx.TxConfig, state); err != nil {
+				return fmt.Errorf("invalid %s state: %w", moduleName, err)
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			appState[moduleName] = state
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
@@ -0,0 +1,81 @@
+package cli_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestInitFromModuleStateCmd(t *testing.T) {
+	home := t.TempDir()
+	logger := log.NewNopLogger()
+	cfg, err := genutiltest.CreateDefaultTendermintConfig(home)
+	require.NoError(t, err)
+
+	interfaceRegistry := types.NewInterfaceRegistry()
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+	require.NoError(t, genutiltest.ExecInitCmd(testMbm, home, marshaler))
+
+	serverCtx := server.NewContext(viper.New(), cfg, logger)
+	clientCtx := client.Context{}.
+		WithCodec(marshaler).
+		WithHomeDir(home)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+	stakingGenState := stakingtypes.DefaultGenesisState()
+	stakingGenState.Params.BondDenom = "forked"
+	stateFile := filepath.Join(home, "staking.json")
+	require.NoError(t, os.WriteFile(stateFile, marshaler.MustMarshalJSON(stakingGenState), 0o600))
+
+	invalidStateFile := filepath.Join(home, "invalid.json")
+	require.NoError(t, os.WriteFile(invalidStateFile, []byte(`{"params":{"bond_denom":""}}`), 0o600))
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr bool
+	}{
+		{"unknown module", []string{"unknown", stateFile}, true},
+		{"missing state file", []string{stakingtypes.ModuleName, filepath.Join(home, "missing.json")}, true},
+		{"invalid state", []string{stakingtypes.ModuleName, invalidStateFile}, true},
+		{"valid state", []string{stakingtypes.ModuleName, stateFile}, false},
+	}
+
+	for _, tc := range testCases {
+		cmd := genutilcli.InitFromModuleStateCmd(testMbm, home)
+		cmd.SetArgs(append(tc.args, fmt.Sprintf("--%s=%s", cli.HomeFlag, home)))
+
+		err := cmd.ExecuteContext(ctx)
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(cfg.GenesisFile())
+	require.NoError(t, err)
+
+	var imported stakingtypes.GenesisState
+	marshaler.MustUnmarshalJSON(appState[stakingtypes.ModuleName], &imported)
+	require.Equal(t, "forked", imported.Params.BondDenom)
+}
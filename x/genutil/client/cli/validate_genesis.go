@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"runtime"
 
 	"github.com/spf13/cobra"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -12,11 +13,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
-const chainUpgradeGuide = "https://docs.cosmos.network/master/migrations/chain-upgrade-guide-040.html"
+const (
+	chainUpgradeGuide = "https://docs.cosmos.network/master/migrations/chain-upgrade-guide-040.html"
+
+	flagWorkers = "workers"
+)
 
 // ValidateGenesisCmd takes a genesis file, and makes sure that it is valid.
 func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "validate-genesis [file]",
 		Args:  cobra.RangeArgs(0, 1),
 		Short: "validates the genesis file at the default location or at the location passed as an arg",
@@ -44,7 +49,12 @@ func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
 				return fmt.Errorf("error unmarshalling genesis doc %s: %s", genesis, err.Error())
 			}
 
-			if err = mbm.ValidateGenesis(cdc, clientCtx.TxConfig, genState); err != nil {
+			workers, err := cmd.Flags().GetInt(flagWorkers)
+			if err != nil {
+				return err
+			}
+
+			if err = mbm.ValidateGenesisWithWorkers(cdc, clientCtx.TxConfig, genState, workers); err != nil {
 				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
 			}
 
@@ -52,6 +62,10 @@ func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().Int(flagWorkers, runtime.NumCPU(), "Number of modules validated concurrently")
+
+	return cmd
 }
 
 // validateGenDoc reads a genesis file and validates that it is a correct
@@ -32,21 +32,31 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 			),
 		)
 
+		depositParams, _, _ := keeper.GetProposalParams(ctx, proposal.ProposalType())
 		logger.Info(
 			"proposal did not meet minimum deposit; deleted",
 			"proposal", proposal.ProposalId,
 			"title", proposal.GetTitle(),
-			"min_deposit", keeper.GetDepositParams(ctx).MinDeposit.String(),
+			"min_deposit", depositParams.MinDeposit.String(),
 			"total_deposit", proposal.TotalDeposit.String(),
 		)
 
 		return false
 	})
 
+	// commit-reveal proposals whose voting periods have ended enter their
+	// reveal period, after the iteration over the queue they are moved in
+	var revealProposals []types.Proposal
+
 	// fetch active proposals whose voting periods have ended (are passed the block time)
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		var tagValue, logMsg string
 
+		if proposal.CommitReveal && proposal.Status == types.StatusVotingPeriod {
+			revealProposals = append(revealProposals, proposal)
+			return false
+		}
+
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
 		if burnDeposits {
@@ -90,7 +100,13 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		proposal.FinalTallyResult = tallyResults
 
 		keeper.SetProposal(ctx, proposal)
-		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		if proposal.CommitReveal {
+			// the votes which were not revealed are not counted
+			keeper.DeleteVoteCommitments(ctx, proposal.ProposalId)
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, *proposal.RevealEndTime)
+		} else {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		}
 
 		// when proposal become active
 		keeper.AfterProposalVotingPeriodEnded(ctx, proposal.ProposalId)
@@ -111,4 +127,23 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		)
 		return false
 	})
+
+	for _, proposal := range revealProposals {
+		keeper.StartRevealPeriod(ctx, proposal)
+
+		logger.Info(
+			"proposal entered reveal period",
+			"proposal", proposal.ProposalId,
+			"title", proposal.GetTitle(),
+			"reveal_end_time", proposal.RevealEndTime.String(),
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRevealPeriod,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+				sdk.NewAttribute(types.AttributeKeyRevealEndTime, proposal.RevealEndTime.String()),
+			),
+		)
+	}
 }
@@ -288,7 +288,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -338,7 +338,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -379,3 +379,84 @@ func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sd
 		require.NotNil(t, res)
 	}
 }
+
+func TestCommitRevealProposal(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 10, valTokens)
+
+	SortAddresses(addrs)
+
+	govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.CommitRevealProposalTypes = []string{types.ProposalTypeText}
+	votingParams.RevealPeriod = time.Hour
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+	require.NoError(t, err)
+	require.True(t, proposal.CommitReveal)
+
+	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
+	_, err = govMsgSvr.Deposit(sdk.WrapSDKContext(ctx), types.NewMsgDeposit(addrs[0], proposal.ProposalId, proposalCoins))
+	require.NoError(t, err)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingEndTime.Add(time.Hour), *proposal.RevealEndTime)
+
+	// the votes of commit-reveal proposals can't be cast in the clear
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	require.ErrorIs(t, err, types.ErrCommitRevealProposal)
+
+	options := types.NewNonSplitVoteOption(types.OptionYes)
+	salt := []byte("salt")
+	commitment := types.VoteCommitmentHash(proposal.ProposalId, addrs[0], options, salt)
+	_, err = govMsgSvr.CommitVote(sdk.WrapSDKContext(ctx), types.NewMsgCommitVote(addrs[0], proposal.ProposalId, commitment))
+	require.NoError(t, err)
+	require.Len(t, app.GovKeeper.GetAllVoteCommitments(ctx), 1)
+
+	// the votes can't be revealed before the end of the voting period
+	_, err = govMsgSvr.RevealVote(sdk.WrapSDKContext(ctx), types.NewMsgRevealVote(addrs[0], proposal.ProposalId, options, salt))
+	require.ErrorIs(t, err, types.ErrInactiveProposal)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusRevealPeriod, proposal.Status)
+
+	// no more commitments are accepted in the reveal period
+	_, err = govMsgSvr.CommitVote(sdk.WrapSDKContext(ctx), types.NewMsgCommitVote(addrs[1], proposal.ProposalId, commitment))
+	require.ErrorIs(t, err, types.ErrInactiveProposal)
+
+	_, err = govMsgSvr.RevealVote(sdk.WrapSDKContext(ctx), types.NewMsgRevealVote(addrs[0], proposal.ProposalId, types.NewNonSplitVoteOption(types.OptionNo), salt))
+	require.ErrorIs(t, err, types.ErrVoteRevealMismatch)
+
+	_, err = govMsgSvr.RevealVote(sdk.WrapSDKContext(ctx), types.NewMsgRevealVote(addrs[0], proposal.ProposalId, options, salt))
+	require.NoError(t, err)
+	vote, found := app.GovKeeper.GetVote(ctx, proposal.ProposalId, addrs[0])
+	require.True(t, found)
+	require.Equal(t, []types.WeightedVoteOption(options), vote.Options)
+	require.Empty(t, app.GovKeeper.GetAllVoteCommitments(ctx))
+
+	newHeader.Time = *proposal.RevealEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+}
@@ -31,12 +31,15 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryProposals(),
 		GetCmdQueryVote(),
 		GetCmdQueryVotes(),
+		GetCmdQueryVoteCommitments(),
 		GetCmdQueryParam(),
 		GetCmdQueryParams(),
+		GetCmdQueryProposalTypeParams(),
 		GetCmdQueryProposer(),
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryVotingPower(),
 	)
 
 	return govQueryCmd
@@ -101,7 +104,7 @@ func GetCmdQueryProposals() *cobra.Command {
 Example:
 $ %s query gov proposals --depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ %s query gov proposals --voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
-$ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
+$ %s query gov proposals --status (DepositPeriod|VotingPeriod|RevealPeriod|Passed|Rejected)
 $ %s query gov proposals --page=2 --limit=100
 `,
 				version.AppName, version.AppName, version.AppName, version.AppName,
@@ -292,7 +295,7 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 			}
 
 			propStatus := proposalRes.GetProposal().Status
-			if !(propStatus == types.StatusVotingPeriod || propStatus == types.StatusDepositPeriod) {
+			if !(propStatus == types.StatusVotingPeriod || propStatus == types.StatusRevealPeriod || propStatus == types.StatusDepositPeriod) {
 				page, _ := cmd.Flags().GetInt(flags.FlagPage)
 				limit, _ := cmd.Flags().GetInt(flags.FlagLimit)
 
@@ -335,6 +338,59 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 	return cmd
 }
 
+// GetCmdQueryVoteCommitments implements the command to query the vote
+// commitments of a commit-reveal proposal.
+func GetCmdQueryVoteCommitments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-commitments [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the vote commitments of a commit-reveal proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the vote commitments not revealed yet of a commit-reveal proposal.
+
+Example:
+$ %[1]s query gov vote-commitments 1
+$ %[1]s query gov vote-commitments 1 --page=2 --limit=100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoteCommitments(
+				cmd.Context(),
+				&types.QueryVoteCommitmentsRequest{ProposalId: proposalID, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "vote commitments")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryDeposit implements the query proposal deposit command. Command to
 // get a specific Deposit Information
 func GetCmdQueryDeposit() *cobra.Command {
@@ -579,16 +635,17 @@ func GetCmdQueryParam() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "param [param-type]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query the parameters (voting|tallying|deposit) of the governance process",
+		Short: "Query the parameters (voting|tallying|deposit|proposal_types) of the governance process",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the all the parameters for the governance process.
 
 Example:
-$ %s query gov param voting
-$ %s query gov param tallying
-$ %s query gov param deposit
+$ %[1]s query gov param voting
+$ %[1]s query gov param tallying
+$ %[1]s query gov param deposit
+$ %[1]s query gov param proposal_types
 `,
-				version.AppName, version.AppName, version.AppName,
+				version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -607,7 +664,7 @@ $ %s query gov param deposit
 				return err
 			}
 
-			var out fmt.Stringer
+			var out interface{}
 			switch args[0] {
 			case "voting":
 				out = res.GetVotingParams()
@@ -615,8 +672,10 @@ $ %s query gov param deposit
 				out = res.GetTallyParams()
 			case "deposit":
 				out = res.GetDepositParams()
+			case "proposal_types":
+				out = res.GetProposalTypeParams()
 			default:
-				return fmt.Errorf("argument must be one of (voting|tallying|deposit), was %s", args[0])
+				return fmt.Errorf("argument must be one of (voting|tallying|deposit|proposal_types), was %s", args[0])
 			}
 
 			return clientCtx.PrintObjectLegacy(out)
@@ -628,6 +687,47 @@ $ %s query gov param deposit
 	return cmd
 }
 
+// GetCmdQueryProposalTypeParams implements the command to query the params
+// applying to the proposals of a type.
+func GetCmdQueryProposalTypeParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal-type-params [proposal-type]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the parameters of the governance process applying to the proposals of a type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the deposit, voting and tally parameters applying to the proposals
+of a type: the global parameters, overridden by the parameters set for the type.
+
+Example:
+$ %s query gov proposal-type-params SoftwareUpgrade
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProposalTypeParams(
+				cmd.Context(),
+				&types.QueryProposalTypeParamsRequest{ProposalType: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryProposer implements the query proposer command.
 func GetCmdQueryProposer() *cobra.Command {
 	cmd := &cobra.Command{
@@ -668,3 +768,57 @@ $ %s query gov proposer 1
 
 	return cmd
 }
+
+// GetCmdQueryVotingPower implements the command to query the voting power of a
+// vote of an address on a proposal.
+func GetCmdQueryVotingPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voting-power [proposal-id] [voter-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the voting power of a vote of an address on a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the voting power a vote of an address on a proposal in voting
+period would be tallied with, given the votes cast so far. It is the voting power of the
+delegations of the address, plus, for the operator of a bonded validator, the voting power
+of the delegators to the validator which haven't voted themselves.
+
+Example:
+$ %s query gov voting-power 1 cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			voterAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VotingPower(
+				cmd.Context(),
+				&types.QueryVotingPowerRequest{ProposalId: proposalID, Voter: voterAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -69,6 +70,9 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 		NewCmdDeposit(),
 		NewCmdVote(),
 		NewCmdWeightedVote(),
+		NewCmdCommitVote(),
+		NewCmdRevealVote(),
+		NewCmdCancelProposal(),
 		cmdSubmitProp,
 	)
 
@@ -188,6 +192,46 @@ $ %s tx gov deposit 1 10stake --from mykey
 	return cmd
 }
 
+// NewCmdCancelProposal implements cancelling a proposal by its proposer.
+func NewCmdCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal in its deposit or voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal in its deposit or voting period. Only the proposer of
+the proposal can cancel it. A share of the deposits, set by the proposal_cancel_ratio
+deposit param, is burned and the rest is refunded to the depositors.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgCancelProposal(clientCtx.GetFromAddress(), proposalID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdVote implements creating a new vote command.
 func NewCmdVote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -283,3 +327,104 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 
 	return cmd
 }
+
+// NewCmdCommitVote implements committing to a vote on a commit-reveal proposal.
+func NewCmdCommitVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-vote [proposal-id] [weighted-options] [salt]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Commit to a vote on a commit-reveal proposal, options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Commit to a vote on a commit-reveal proposal in voting period. Only
+the hash of the options and the hex encoded salt is submitted. The same options
+and salt must be revealed with the reveal-vote command in the reveal period of the
+proposal for the vote to be tallied. Keep the salt secret until then, and pick a
+random one, e.g. with "openssl rand -hex 32".
+
+Example:
+$ %s tx gov commit-vote 1 yes=0.6,no=0.4 5d1a...e07f --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			proposalID, options, salt, err := parseVoteReveal(args)
+			if err != nil {
+				return err
+			}
+
+			commitment := types.VoteCommitmentHash(proposalID, from, options, salt)
+			msg := types.NewMsgCommitVote(from, proposalID, commitment)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRevealVote implements revealing a vote committed on a commit-reveal
+// proposal.
+func NewCmdRevealVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-vote [proposal-id] [weighted-options] [salt]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Reveal a vote committed on a commit-reveal proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reveal a vote committed with the commit-vote command, with the same
+options and hex encoded salt, in the reveal period of the proposal.
+
+Example:
+$ %s tx gov reveal-vote 1 yes=0.6,no=0.4 5d1a...e07f --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, options, salt, err := parseVoteReveal(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealVote(clientCtx.GetFromAddress(), proposalID, options, salt)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseVoteReveal parses the proposal id, weighted options and hex encoded
+// salt arguments of the commit-vote and reveal-vote commands.
+func parseVoteReveal(args []string) (uint64, types.WeightedVoteOptions, []byte, error) {
+	proposalID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+	}
+
+	options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	salt, err := hex.DecodeString(args[2])
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("invalid hex salt: %w", err)
+	}
+
+	return proposalID, options, salt, nil
+}
@@ -58,6 +58,8 @@ func NormalizeProposalStatus(status string) string {
 		return types.StatusDepositPeriod.String()
 	case "VotingPeriod", "voting_period":
 		return types.StatusVotingPeriod.String()
+	case "RevealPeriod", "reveal_period":
+		return types.StatusRevealPeriod.String()
 	case "Passed", "passed":
 		return types.StatusPassed.String()
 	case "Rejected", "rejected":
@@ -14,6 +14,7 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	k.SetDepositParams(ctx, data.DepositParams)
 	k.SetVotingParams(ctx, data.VotingParams)
 	k.SetTallyParams(ctx, data.TallyParams)
+	k.SetProposalTypeParams(ctx, data.ProposalTypeParams)
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -31,12 +32,18 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		k.SetVote(ctx, vote)
 	}
 
+	for _, commitment := range data.VoteCommitments {
+		k.SetVoteCommitment(ctx, commitment)
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case types.StatusDepositPeriod:
 			k.InsertInactiveProposalQueue(ctx, proposal.ProposalId, proposal.DepositEndTime)
 		case types.StatusVotingPeriod:
 			k.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		case types.StatusRevealPeriod:
+			k.InsertActiveProposalQueue(ctx, proposal.ProposalId, *proposal.RevealEndTime)
 		}
 		k.SetProposal(ctx, proposal)
 	}
@@ -79,5 +86,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
 		TallyParams:        tallyParams,
+		VoteCommitments:    k.GetAllVoteCommitments(ctx),
+		ProposalTypeParams: k.GetProposalTypeParams(ctx),
 	}
 }
@@ -72,6 +72,41 @@ func (keeper Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
 	})
 }
 
+// CancelDeposits deletes the deposits on a canceled proposal, burning the part
+// of each deposit set by the ProposalCancelRatio param and refunding the rest
+// to its depositor. It returns the burned coins.
+func (keeper Keeper) CancelDeposits(ctx sdk.Context, proposalID uint64) (sdk.Coins, error) {
+	store := ctx.KVStore(keeper.storeKey)
+	depositParams := keeper.GetDepositParams(ctx)
+
+	burned := sdk.NewCoins()
+	for _, deposit := range keeper.GetDeposits(ctx, proposalID) {
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		if err != nil {
+			return nil, err
+		}
+
+		burn := depositParams.CancelBurnedDeposit(deposit.Amount)
+		if !burn.IsZero() {
+			if err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, burn); err != nil {
+				return nil, err
+			}
+		}
+
+		refund := deposit.Amount.Sub(burn)
+		if !refund.IsZero() {
+			if err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refund); err != nil {
+				return nil, err
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+		burned = burned.Add(burn...)
+	}
+
+	return burned, nil
+}
+
 // IterateAllDeposits iterates over the all the stored deposits and performs a callback function
 func (keeper Keeper) IterateAllDeposits(ctx sdk.Context, cb func(deposit types.Deposit) (stop bool)) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -135,7 +170,8 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	depositParams, _, _ := keeper.GetProposalParams(ctx, proposal.ProposalType())
+	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(depositParams.MinDeposit) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -18,7 +19,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalId
 
@@ -102,7 +103,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr1Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Test delete and burn deposits
-	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, nil)
 	require.NoError(t, err)
 	proposalID = proposal.ProposalId
 	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
@@ -112,3 +113,47 @@ func TestDeposits(t *testing.T) {
 	require.Len(t, deposits, 0)
 	require.Equal(t, addr0Initial.Sub(fourStake), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 }
+
+func TestDepositsWithProposalTypeParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(100000000))
+
+	minDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 20)))
+	app.GovKeeper.SetProposalTypeParams(ctx, []types.ProposalTypeParams{{
+		ProposalType: types.ProposalTypeText,
+		MinDeposit:   minDeposit,
+		VotingPeriod: time.Hour,
+		Threshold:    sdk.NewDecWithPrec(67, 2),
+	}})
+
+	depositParams, votingParams, tallyParams := app.GovKeeper.GetProposalParams(ctx, types.ProposalTypeText)
+	require.Equal(t, minDeposit, depositParams.MinDeposit)
+	require.Equal(t, app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod, depositParams.MaxDepositPeriod)
+	require.Equal(t, time.Hour, votingParams.VotingPeriod)
+	require.Equal(t, app.GovKeeper.GetTallyParams(ctx).Quorum, tallyParams.Quorum)
+	require.Equal(t, sdk.NewDecWithPrec(67, 2), tallyParams.Threshold)
+
+	// the params of the other proposal types are the global ones
+	depositParams, votingParams, tallyParams = app.GovKeeper.GetProposalParams(ctx, "Other")
+	require.Equal(t, app.GovKeeper.GetDepositParams(ctx), depositParams)
+	require.Equal(t, app.GovKeeper.GetVotingParams(ctx), votingParams)
+	require.Equal(t, app.GovKeeper.GetTallyParams(ctx), tallyParams)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+	require.NoError(t, err)
+
+	// the global minimum deposit doesn't activate the voting period
+	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, TestAddrs[0], app.GovKeeper.GetDepositParams(ctx).MinDeposit)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, TestAddrs[0], minDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, proposal.VotingStartTime.Add(time.Hour), proposal.VotingEndTime)
+}
@@ -176,6 +176,10 @@ func (q Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 		tallyParams := q.GetTallyParams(ctx)
 		return &types.QueryParamsResponse{TallyParams: tallyParams}, nil
 
+	case types.ParamProposalTypes:
+		proposalTypeParams := q.GetProposalTypeParams(ctx)
+		return &types.QueryParamsResponse{ProposalTypeParams: proposalTypeParams}, nil
+
 	default:
 		return nil, status.Errorf(codes.InvalidArgument,
 			"%s is not a valid parameter type", req.ParamsType)
@@ -271,9 +275,101 @@ func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultReques
 		tallyResult = proposal.FinalTallyResult
 
 	default:
-		// proposal is in voting period
+		// proposal is in voting or reveal period
 		_, _, tallyResult = q.Tally(ctx, proposal)
 	}
 
 	return &types.QueryTallyResultResponse{Tally: tallyResult}, nil
 }
+
+// VoteCommitments queries the vote commitments of a proposal
+func (q Keeper) VoteCommitments(c context.Context, req *types.QueryVoteCommitmentsRequest) (*types.QueryVoteCommitmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	var commitments []types.VoteCommitment
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	commitmentsStore := prefix.NewStore(store, types.VoteCommitmentsKey(req.ProposalId))
+
+	pageRes, err := query.Paginate(commitmentsStore, req.Pagination, func(key []byte, value []byte) error {
+		var commitment types.VoteCommitment
+		if err := q.cdc.Unmarshal(value, &commitment); err != nil {
+			return err
+		}
+
+		commitments = append(commitments, commitment)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVoteCommitmentsResponse{VoteCommitments: commitments, Pagination: pageRes}, nil
+}
+
+// VotingPower queries the voting power of a vote of an address on a proposal
+func (q Keeper) VotingPower(c context.Context, req *types.QueryVotingPowerRequest) (*types.QueryVotingPowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	if req.Voter == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty voter address")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	if proposal.Status != types.StatusVotingPeriod && proposal.Status != types.StatusRevealPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d is not in voting period", req.ProposalId)
+	}
+
+	delegated, validator := q.GetVotingPower(ctx, req.ProposalId, voter)
+
+	return &types.QueryVotingPowerResponse{
+		VotingPower:          delegated.Add(validator),
+		DelegatedVotingPower: delegated,
+		ValidatorVotingPower: validator,
+	}, nil
+}
+
+// ProposalTypeParams queries the params applying to the proposals of a type
+func (q Keeper) ProposalTypeParams(c context.Context, req *types.QueryProposalTypeParamsRequest) (*types.QueryProposalTypeParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalType == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty proposal type")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	depositParams, votingParams, tallyParams := q.GetProposalParams(ctx, req.ProposalType)
+
+	return &types.QueryProposalTypeParamsResponse{
+		VotingParams:  votingParams,
+		DepositParams: depositParams,
+		TallyParams:   tallyParams,
+	}, nil
+}
@@ -9,6 +9,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
@@ -50,7 +52,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 			func() {
 				req = &types.QueryProposalRequest{ProposalId: 1}
 				testProposal := types.NewTextProposal("Proposal", "testing proposal")
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal)
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, nil)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -106,7 +108,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 				for i := 0; i < 5; i++ {
 					num := strconv.Itoa(i + 1)
 					testProposal := types.NewTextProposal("Proposal"+num, "testing proposal "+num)
-					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal)
+					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, nil)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, proposal)
@@ -274,7 +276,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
 				suite.Require().NoError(err)
 
 				req = &types.QueryVoteRequest{
@@ -379,7 +381,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
 				suite.Require().NoError(err)
 
 				req = &types.QueryVotesRequest{
@@ -470,8 +472,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			func() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamVoting}
 				expRes = &types.QueryParamsResponse{
-					VotingParams: types.DefaultVotingParams(),
-					TallyParams:  types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					DepositParams: types.DepositParams{ProposalCancelRatio: sdk.NewDec(0)},
+					VotingParams:  types.DefaultVotingParams(),
+					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
 				}
 			},
 			true,
@@ -481,7 +484,8 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			func() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamTallying}
 				expRes = &types.QueryParamsResponse{
-					TallyParams: types.DefaultTallyParams(),
+					DepositParams: types.DepositParams{ProposalCancelRatio: sdk.NewDec(0)},
+					TallyParams:   types.DefaultTallyParams(),
 				}
 			},
 			true,
@@ -570,7 +574,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -659,7 +663,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
 				suite.Require().NoError(err)
 
 				req = &types.QueryDepositsRequest{
@@ -751,7 +755,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 			"create a proposal and get tally",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -816,3 +820,120 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryVotingPower() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addrs, valAddrs := createValidators(suite.T(), ctx, app, []int64{5, 6, 7})
+
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	suite.Require().True(found)
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[4], app.StakingKeeper.TokensFromConsensusPower(ctx, 30), stakingtypes.Unbonded, val1, true)
+	suite.Require().NoError(err)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	var (
+		req      *types.QueryVotingPowerRequest
+		expRes   *types.QueryVotingPowerResponse
+		proposal types.Proposal
+	)
+
+	newResponse := func(delegated, validator int64) *types.QueryVotingPowerResponse {
+		return &types.QueryVotingPowerResponse{
+			VotingPower:          sdk.NewDec((delegated + validator) * 1000000),
+			DelegatedVotingPower: sdk.NewDec(delegated * 1000000),
+			ValidatorVotingPower: sdk.NewDec(validator * 1000000),
+		}
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryVotingPowerRequest{}
+			},
+			false,
+		},
+		{
+			"invalid voter address",
+			func() {
+				req = &types.QueryVotingPowerRequest{ProposalId: 1, Voter: "invalid"}
+			},
+			false,
+		},
+		{
+			"query non existed proposal",
+			func() {
+				req = &types.QueryVotingPowerRequest{ProposalId: 1, Voter: addrs[0].String()}
+			},
+			false,
+		},
+		{
+			"proposal in deposit period",
+			func() {
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+				suite.Require().NoError(err)
+
+				req = &types.QueryVotingPowerRequest{ProposalId: proposal.ProposalId, Voter: addrs[0].String()}
+			},
+			false,
+		},
+		{
+			"validator inheriting the power of its delegators",
+			func() {
+				proposal.Status = types.StatusVotingPeriod
+				app.GovKeeper.SetProposal(ctx, proposal)
+
+				req = &types.QueryVotingPowerRequest{ProposalId: proposal.ProposalId, Voter: addrs[0].String()}
+				expRes = newResponse(5, 30)
+			},
+			true,
+		},
+		{
+			"delegator",
+			func() {
+				req = &types.QueryVotingPowerRequest{ProposalId: proposal.ProposalId, Voter: addrs[4].String()}
+				expRes = newResponse(30, 0)
+			},
+			true,
+		},
+		{
+			"validator overridden by the vote of its delegator",
+			func() {
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[4], types.NewNonSplitVoteOption(types.OptionNo)))
+
+				req = &types.QueryVotingPowerRequest{ProposalId: proposal.ProposalId, Voter: addrs[0].String()}
+				expRes = newResponse(5, 0)
+			},
+			true,
+		},
+		{
+			"address without delegations",
+			func() {
+				req = &types.QueryVotingPowerRequest{ProposalId: proposal.ProposalId, Voter: addrs[3].String()}
+				expRes = newResponse(0, 0)
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.VotingPower(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.String(), res.String())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -40,3 +40,34 @@ func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams types.VotingP
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams types.TallyParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
 }
+
+// GetProposalTypeParams returns the params overriding the deposit, voting and
+// tally params for the proposals of some types
+func (keeper Keeper) GetProposalTypeParams(ctx sdk.Context) []types.ProposalTypeParams {
+	var params []types.ProposalTypeParams
+	// the params may not be set on chains upgraded from a version without them
+	keeper.paramSpace.GetIfExists(ctx, types.ParamStoreKeyProposalTypeParams, &params)
+	return params
+}
+
+// SetProposalTypeParams sets the params overriding the deposit, voting and
+// tally params for the proposals of some types to the global param store
+func (keeper Keeper) SetProposalTypeParams(ctx sdk.Context, params []types.ProposalTypeParams) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyProposalTypeParams, &params)
+}
+
+// GetProposalParams returns the deposit, voting and tally params applying to
+// the proposals of the given type: the global params, overridden by the params
+// set for the type, if any
+func (keeper Keeper) GetProposalParams(ctx sdk.Context, proposalType string) (types.DepositParams, types.VotingParams, types.TallyParams) {
+	depositParams := keeper.GetDepositParams(ctx)
+	votingParams := keeper.GetVotingParams(ctx)
+	tallyParams := keeper.GetTallyParams(ctx)
+
+	typeParams, ok := types.FindProposalTypeParams(keeper.GetProposalTypeParams(ctx), proposalType)
+	if !ok {
+		return depositParams, votingParams, tallyParams
+	}
+
+	return typeParams.ResolveParams(depositParams, votingParams, tallyParams)
+}
@@ -9,8 +9,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+// SubmitProposal create new proposal given a content and its proposer
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content, proposer sdk.AccAddress) (types.Proposal, error) {
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
@@ -36,6 +36,10 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	if err != nil {
 		return types.Proposal{}, err
 	}
+	proposal.CommitReveal = keeper.GetVotingParams(ctx).IsCommitReveal(content.ProposalType())
+	if !proposer.Empty() {
+		proposal.Proposer = proposer.String()
+	}
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -97,6 +101,51 @@ func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	store.Delete(types.ProposalKey(proposalID))
 }
 
+// CancelProposal cancels a proposal in deposit or voting period on behalf of
+// its proposer. The proposal is deleted along with its votes, and its deposits
+// are partly burned, as set by the ProposalCancelRatio param, and partly
+// refunded.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Proposer == "" || proposal.Proposer != proposer.String() {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not the proposer of proposal %d", proposer, proposalID)
+	}
+
+	if proposal.Status != types.StatusDepositPeriod && proposal.Status != types.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrProposalNotCancelable, "proposal %d is in status %s", proposalID, proposal.Status)
+	}
+
+	burned, err := keeper.CancelDeposits(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	for _, vote := range keeper.GetVotes(ctx, proposalID) {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			return err
+		}
+		keeper.deleteVote(ctx, proposalID, voter)
+	}
+	keeper.DeleteVoteCommitments(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposal.Proposer),
+			sdk.NewAttribute(types.AttributeKeyBurnedDeposit, burned.String()),
+		),
+	)
+
+	return nil
+}
+
 // IterateProposals iterates over the all the proposals and performs a callback function.
 // Panics when the iterator encounters a proposal which can't be unmarshaled.
 func (keeper Keeper) IterateProposals(ctx sdk.Context, cb func(proposal types.Proposal) (stop bool)) {
@@ -193,8 +242,12 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
-	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
+	_, votingParams, _ := keeper.GetProposalParams(ctx, proposal.ProposalType())
+	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingParams.VotingPeriod)
+	if proposal.CommitReveal {
+		revealEndTime := proposal.VotingEndTime.Add(votingParams.RevealPeriod)
+		proposal.RevealEndTime = &revealEndTime
+	}
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
@@ -202,6 +255,18 @@ func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Propos
 	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
 }
 
+// StartRevealPeriod moves a commit-reveal proposal whose voting period ended
+// to its reveal period, during which the committed votes are revealed. The
+// proposal stays in the active proposal queue until the end of the reveal
+// period.
+func (keeper Keeper) StartRevealPeriod(ctx sdk.Context, proposal types.Proposal) {
+	proposal.Status = types.StatusRevealPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, *proposal.RevealEndTime)
+}
+
 func (keeper Keeper) MarshalProposal(proposal types.Proposal) ([]byte, error) {
 	bz, err := keeper.cdc.Marshal(&proposal)
 	if err != nil {
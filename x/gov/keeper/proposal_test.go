@@ -12,7 +12,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, nil)
 	suite.Require().NoError(err)
 	proposalID := proposal.ProposalId
 	suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
@@ -24,7 +24,7 @@ func (suite *KeeperTestSuite) TestGetSetProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, nil)
 	suite.Require().NoError(err)
 
 	suite.Require().True(proposal.VotingStartTime.Equal(time.Time{}))
@@ -64,11 +64,73 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}
 
 	for i, tc := range testCases {
-		_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tc.content)
+		_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tc.content, nil)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func (suite *KeeperTestSuite) TestCancelProposal() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 5)))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(addrs[0].String(), proposal.Proposer)
+	proposalID := proposal.ProposalId
+
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addrs[0], deposit)
+	suite.Require().NoError(err)
+	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposalID, addrs[1], deposit)
+	suite.Require().NoError(err)
+	suite.Require().True(votingStarted)
+	suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	suite.Require().True(ok)
+
+	err = app.GovKeeper.CancelProposal(ctx, proposalID, addrs[1])
+	suite.Require().ErrorIs(err, types.ErrInvalidProposer)
+
+	balances := []sdk.Coins{app.BankKeeper.GetAllBalances(ctx, addrs[0]), app.BankKeeper.GetAllBalances(ctx, addrs[1])}
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+	suite.Require().NoError(app.GovKeeper.CancelProposal(ctx, proposalID, addrs[0]))
+
+	// half of the deposits are burned, and the rest is refunded
+	refund := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, deposit.AmountOf(sdk.DefaultBondDenom).QuoRaw(2)))
+	suite.Require().Equal(balances[0].Add(refund...), app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+	suite.Require().Equal(balances[1].Add(refund...), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	suite.Require().Equal(supply.Sub(refund[0]).Sub(refund[0]), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+
+	_, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	suite.Require().False(ok)
+	suite.Require().Empty(app.GovKeeper.GetDeposits(ctx, proposalID))
+	suite.Require().Empty(app.GovKeeper.GetVotes(ctx, proposalID))
+
+	activeIterator := app.GovKeeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime)
+	suite.Require().False(activeIterator.Valid())
+	activeIterator.Close()
+
+	err = app.GovKeeper.CancelProposal(ctx, proposalID, addrs[0])
+	suite.Require().ErrorIs(err, types.ErrUnknownProposal)
+
+	// the proposals which passed can't be canceled
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0])
+	suite.Require().NoError(err)
+	proposal.Status = types.StatusPassed
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	err = app.GovKeeper.CancelProposal(ctx, proposal.ProposalId, addrs[0])
+	suite.Require().ErrorIs(err, types.ErrProposalNotCancelable)
+
+	// the proposals without proposer can't be canceled
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+	suite.Require().NoError(err)
+
+	err = app.GovKeeper.CancelProposal(ctx, proposal.ProposalId, addrs[0])
+	suite.Require().ErrorIs(err, types.ErrInvalidProposer)
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []types.ProposalStatus{types.StatusDepositPeriod, types.StatusVotingPeriod}
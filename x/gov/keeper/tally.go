@@ -18,20 +18,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 	results[types.OptionNoWithVeto] = sdk.ZeroDec()
 
 	totalVotingPower := sdk.ZeroDec()
-	currValidators := make(map[string]types.ValidatorGovInfo)
-
-	// fetch all the bonded validators, insert them into currValidators
-	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
-		currValidators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
-			validator.GetOperator(),
-			validator.GetBondedTokens(),
-			validator.GetDelegatorShares(),
-			sdk.ZeroDec(),
-			types.WeightedVoteOptions{},
-		)
-
-		return false
-	})
+	currValidators := keeper.bondedValidators(ctx)
 
 	keeper.IterateVotes(ctx, proposal.ProposalId, func(vote types.Vote) bool {
 		// if validator, just record it in the map
@@ -90,7 +77,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyParams := keeper.GetTallyParams(ctx)
+	_, _, tallyParams := keeper.GetProposalParams(ctx, proposal.ProposalType())
 	tallyResults = types.NewTallyResultFromMap(results)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
@@ -123,3 +110,75 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
 	return false, false, tallyResults
 }
+
+// GetVotingPower returns the voting power a vote of the voter on the proposal
+// would be tallied with, given the votes cast so far: the voting power of the
+// delegations of the voter to bonded validators, and, if the voter operates a
+// bonded validator, the voting power of the delegations to it from the
+// delegators which haven't voted, which the validator votes on behalf of. Only
+// the delegations of the voter and the delegations of the voters of the
+// proposal to the validator of the voter are read.
+func (keeper Keeper) GetVotingPower(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (delegated sdk.Dec, validator sdk.Dec) {
+	delegated = sdk.ZeroDec()
+	keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+		if val := keeper.sk.Validator(ctx, delegation.GetValidatorAddr()); val != nil && val.IsBonded() {
+			delegated = delegated.Add(delegation.GetShares().MulInt(val.GetBondedTokens()).Quo(val.GetDelegatorShares()))
+		}
+
+		return false
+	})
+
+	valAddr := sdk.ValAddress(voter.Bytes())
+	val := keeper.sk.Validator(ctx, valAddr)
+	if val == nil || !val.IsBonded() {
+		return delegated, sdk.ZeroDec()
+	}
+
+	// the delegations of the voter and of the delegators which voted are
+	// deducted from the validator
+	deductions := sdk.ZeroDec()
+	deductDelegation := func(delegator sdk.AccAddress) {
+		if delegation := keeper.sk.Delegation(ctx, delegator, valAddr); delegation != nil {
+			deductions = deductions.Add(delegation.GetShares())
+		}
+	}
+
+	deductDelegation(voter)
+	keeper.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
+		delegator, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		if !delegator.Equals(voter) {
+			deductDelegation(delegator)
+		}
+
+		return false
+	})
+
+	sharesAfterDeductions := val.GetDelegatorShares().Sub(deductions)
+	validator = sharesAfterDeductions.MulInt(val.GetBondedTokens()).Quo(val.GetDelegatorShares())
+
+	return delegated, validator
+}
+
+// bondedValidators returns the governance info of the bonded validators, by
+// operator address.
+func (keeper Keeper) bondedValidators(ctx sdk.Context) map[string]types.ValidatorGovInfo {
+	validators := make(map[string]types.ValidatorGovInfo)
+
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		validators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
+			validator.GetOperator(),
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			sdk.ZeroDec(),
+			types.WeightedVoteOptions{},
+		)
+
+		return false
+	})
+
+	return validators
+}
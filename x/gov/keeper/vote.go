@@ -17,6 +17,10 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 	if proposal.Status != types.StatusVotingPeriod {
 		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
+	// the votes on commit-reveal proposals are committed, then revealed
+	if proposal.CommitReveal {
+		return sdkerrors.Wrapf(types.ErrCommitRevealProposal, "%d", proposalID)
+	}
 
 	for _, option := range options {
 		if !types.ValidWeightedVoteOption(option) {
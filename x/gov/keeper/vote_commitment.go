@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// CommitVote commits a voter to a hidden vote on a commit-reveal proposal in
+// its voting period, replacing any previous commitment of the voter. The
+// commitment is the hash returned by types.VoteCommitmentHash.
+func (keeper Keeper) CommitVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, commitment []byte) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if !proposal.CommitReveal {
+		return sdkerrors.Wrapf(types.ErrNotCommitRevealProposal, "%d", proposalID)
+	}
+	if proposal.Status != types.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	keeper.SetVoteCommitment(ctx, types.NewVoteCommitment(proposalID, voterAddr, commitment))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommitVote,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
+
+// RevealVote reveals the vote committed by a voter on a commit-reveal proposal
+// in its reveal period. The vote is cast if it matches the commitment.
+func (keeper Keeper) RevealVote(
+	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions, salt []byte,
+) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if !proposal.CommitReveal {
+		return sdkerrors.Wrapf(types.ErrNotCommitRevealProposal, "%d", proposalID)
+	}
+	if proposal.Status != types.StatusRevealPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d is not in its reveal period", proposalID)
+	}
+
+	commitment, found := keeper.GetVoteCommitment(ctx, proposalID, voterAddr)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidVoteCommitment, "no vote commitment of %s on proposal %d", voterAddr, proposalID)
+	}
+	if !bytes.Equal(commitment.Commitment, types.VoteCommitmentHash(proposalID, voterAddr, options, salt)) {
+		return sdkerrors.Wrapf(types.ErrVoteRevealMismatch, "proposal %d", proposalID)
+	}
+
+	for _, option := range options {
+		if !types.ValidWeightedVoteOption(option) {
+			return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
+		}
+	}
+
+	keeper.SetVote(ctx, types.NewVote(proposalID, voterAddr, options))
+	keeper.deleteVoteCommitment(ctx, proposalID, voterAddr)
+
+	// called after a vote on a proposal is cast
+	keeper.AfterProposalVote(ctx, proposalID, voterAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalVote,
+			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
+
+// GetVoteCommitment gets the vote commitment of an address on a specific
+// proposal
+func (keeper Keeper) GetVoteCommitment(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (commitment types.VoteCommitment, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.VoteCommitmentKey(proposalID, voterAddr))
+	if bz == nil {
+		return commitment, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &commitment)
+
+	return commitment, true
+}
+
+// SetVoteCommitment sets a VoteCommitment to the gov store
+func (keeper Keeper) SetVoteCommitment(ctx sdk.Context, commitment types.VoteCommitment) {
+	addr, err := sdk.AccAddressFromBech32(commitment.Voter)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.VoteCommitmentKey(commitment.ProposalId, addr), keeper.cdc.MustMarshal(&commitment))
+}
+
+// GetAllVoteCommitments returns all the vote commitments from the store
+func (keeper Keeper) GetAllVoteCommitments(ctx sdk.Context) (commitments []types.VoteCommitment) {
+	keeper.iterateVoteCommitments(ctx, types.VoteCommitmentsKeyPrefix, func(commitment types.VoteCommitment) bool {
+		commitments = append(commitments, commitment)
+		return false
+	})
+	return
+}
+
+// IterateVoteCommitments iterates over the vote commitments of a proposal and
+// performs a callback function
+func (keeper Keeper) IterateVoteCommitments(ctx sdk.Context, proposalID uint64, cb func(commitment types.VoteCommitment) (stop bool)) {
+	keeper.iterateVoteCommitments(ctx, types.VoteCommitmentsKey(proposalID), cb)
+}
+
+// DeleteVoteCommitments deletes the vote commitments of a proposal which were
+// not revealed
+func (keeper Keeper) DeleteVoteCommitments(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteCommitmentsKey(proposalID))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (keeper Keeper) iterateVoteCommitments(ctx sdk.Context, prefix []byte, cb func(commitment types.VoteCommitment) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var commitment types.VoteCommitment
+		keeper.cdc.MustUnmarshal(iterator.Value(), &commitment)
+
+		if cb(commitment) {
+			break
+		}
+	}
+}
+
+// deleteVoteCommitment deletes the vote commitment of a voter on a proposal
+func (keeper Keeper) deleteVoteCommitment(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.VoteCommitmentKey(proposalID, voterAddr))
+}
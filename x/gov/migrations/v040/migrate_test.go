@@ -97,11 +97,14 @@ func TestMigrate(t *testing.T) {
 	expected := `{
 	"deposit_params": {
 		"max_deposit_period": "0s",
-		"min_deposit": []
+		"min_deposit": [],
+		"proposal_cancel_ratio": "0"
 	},
 	"deposits": [],
+	"proposal_type_params": [],
 	"proposals": [
 		{
+			"commit_reveal": false,
 			"content": {
 				"@type": "/cosmos.gov.v1beta1.TextProposal",
 				"description": "bar_text",
@@ -115,6 +118,8 @@ func TestMigrate(t *testing.T) {
 				"yes": "0"
 			},
 			"proposal_id": "0",
+			"proposer": "",
+			"reveal_end_time": null,
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
@@ -122,6 +127,7 @@ func TestMigrate(t *testing.T) {
 			"voting_start_time": "0001-01-01T00:00:00Z"
 		},
 		{
+			"commit_reveal": false,
 			"content": {
 				"@type": "/cosmos.distribution.v1beta1.CommunityPoolSpendProposal",
 				"amount": [
@@ -142,6 +148,8 @@ func TestMigrate(t *testing.T) {
 				"yes": "0"
 			},
 			"proposal_id": "0",
+			"proposer": "",
+			"reveal_end_time": null,
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
@@ -149,6 +157,7 @@ func TestMigrate(t *testing.T) {
 			"voting_start_time": "0001-01-01T00:00:00Z"
 		},
 		{
+			"commit_reveal": false,
 			"content": {
 				"@type": "/cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal",
 				"description": "bar_cancel_upgrade",
@@ -162,6 +171,8 @@ func TestMigrate(t *testing.T) {
 				"yes": "0"
 			},
 			"proposal_id": "0",
+			"proposer": "",
+			"reveal_end_time": null,
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
@@ -169,6 +180,7 @@ func TestMigrate(t *testing.T) {
 			"voting_start_time": "0001-01-01T00:00:00Z"
 		},
 		{
+			"commit_reveal": false,
 			"content": {
 				"@type": "/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal",
 				"description": "bar_software_upgrade",
@@ -189,6 +201,8 @@ func TestMigrate(t *testing.T) {
 				"yes": "0"
 			},
 			"proposal_id": "0",
+			"proposer": "",
+			"reveal_end_time": null,
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
@@ -196,6 +210,7 @@ func TestMigrate(t *testing.T) {
 			"voting_start_time": "0001-01-01T00:00:00Z"
 		},
 		{
+			"commit_reveal": false,
 			"content": {
 				"@type": "/cosmos.params.v1beta1.ParameterChangeProposal",
 				"changes": [
@@ -216,6 +231,8 @@ func TestMigrate(t *testing.T) {
 				"yes": "0"
 			},
 			"proposal_id": "0",
+			"proposer": "",
+			"reveal_end_time": null,
 			"status": "PROPOSAL_STATUS_UNSPECIFIED",
 			"submit_time": "0001-01-01T00:00:00Z",
 			"total_deposit": [],
@@ -229,8 +246,11 @@ func TestMigrate(t *testing.T) {
 		"threshold": "0",
 		"veto_threshold": "0"
 	},
+	"vote_commitments": [],
 	"votes": [],
 	"voting_params": {
+		"commit_reveal_proposal_types": [],
+		"reveal_period": "0s",
 		"voting_period": "0s"
 	}
 }`
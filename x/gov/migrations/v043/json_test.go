@@ -50,9 +50,11 @@ func TestMigrateJSON(t *testing.T) {
 	expected := `{
 	"deposit_params": {
 		"max_deposit_period": "0s",
-		"min_deposit": []
+		"min_deposit": [],
+		"proposal_cancel_ratio": "0"
 	},
 	"deposits": [],
+	"proposal_type_params": [],
 	"proposals": [],
 	"starting_proposal_id": "0",
 	"tally_params": {
@@ -60,6 +62,7 @@ func TestMigrateJSON(t *testing.T) {
 		"threshold": "0",
 		"veto_threshold": "0"
 	},
+	"vote_commitments": [],
 	"votes": [
 		{
 			"option": "VOTE_OPTION_UNSPECIFIED",
@@ -118,6 +121,8 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"voting_params": {
+		"commit_reveal_proposal_types": [],
+		"reveal_period": "0s",
 		"voting_period": "0s"
 	}
 }`
@@ -16,6 +16,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(&MsgVote{}, "cosmos-sdk/MsgVote", nil)
 	cdc.RegisterConcrete(&MsgVoteWeighted{}, "cosmos-sdk/MsgVoteWeighted", nil)
+	cdc.RegisterConcrete(&MsgCommitVote{}, "cosmos-sdk/MsgCommitVote", nil)
+	cdc.RegisterConcrete(&MsgRevealVote{}, "cosmos-sdk/MsgRevealVote", nil)
+	cdc.RegisterConcrete(&MsgCancelProposal{}, "cosmos-sdk/MsgCancelProposal", nil)
 	cdc.RegisterConcrete(&TextProposal{}, "cosmos-sdk/TextProposal", nil)
 }
 
@@ -25,6 +28,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgVote{},
 		&MsgVoteWeighted{},
 		&MsgDeposit{},
+		&MsgCommitVote{},
+		&MsgRevealVote{},
+		&MsgCancelProposal{},
 	)
 	registry.RegisterInterface(
 		"cosmos.gov.v1beta1.Content",
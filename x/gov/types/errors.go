@@ -14,4 +14,10 @@ var (
 	ErrInvalidVote             = sdkerrors.Register(ModuleName, 7, "invalid vote option")
 	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 8, "invalid genesis state")
 	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 9, "no handler exists for proposal type")
+	ErrCommitRevealProposal    = sdkerrors.Register(ModuleName, 10, "proposal uses commit-reveal voting")
+	ErrNotCommitRevealProposal = sdkerrors.Register(ModuleName, 11, "proposal does not use commit-reveal voting")
+	ErrInvalidVoteCommitment   = sdkerrors.Register(ModuleName, 12, "invalid vote commitment")
+	ErrVoteRevealMismatch      = sdkerrors.Register(ModuleName, 13, "vote reveal does not match commitment")
+	ErrInvalidProposer         = sdkerrors.Register(ModuleName, 14, "invalid proposer")
+	ErrProposalNotCancelable   = sdkerrors.Register(ModuleName, 15, "proposal cannot be canceled")
 )
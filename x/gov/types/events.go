@@ -7,11 +7,17 @@ const (
 	EventTypeProposalVote     = "proposal_vote"
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
+	EventTypeCommitVote       = "commit_vote"
+	EventTypeRevealPeriod     = "reveal_period"
+	EventTypeCancelProposal   = "cancel_proposal"
 
 	AttributeKeyProposalResult     = "proposal_result"
 	AttributeKeyOption             = "option"
 	AttributeKeyProposalID         = "proposal_id"
 	AttributeKeyVotingPeriodStart  = "voting_period_start"
+	AttributeKeyRevealEndTime      = "reveal_end_time"
+	AttributeKeyProposer           = "proposer"
+	AttributeKeyBurnedDeposit      = "burned_deposit"
 	AttributeValueCategory         = "governance"
 	AttributeValueProposalDropped  = "proposal_dropped"  // didn't meet min deposit
 	AttributeValueProposalPassed   = "proposal_passed"   // met vote quorum
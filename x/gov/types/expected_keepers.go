@@ -9,6 +9,7 @@ import (
 // ParamSubspace defines the expected Subspace interface for parameters (noalias)
 type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
 	Set(ctx sdk.Context, key []byte, param interface{})
 }
 
@@ -20,6 +21,8 @@ type StakingKeeper interface {
 	)
 
 	TotalBondedTokens(sdk.Context) sdk.Int // total bonded tokens within the validator set
+	Validator(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI
+	Delegation(sdk.Context, sdk.AccAddress, sdk.ValAddress) stakingtypes.DelegationI
 	IterateDelegations(
 		ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
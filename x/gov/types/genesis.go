@@ -61,6 +61,10 @@ func ValidateGenesis(data *GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	if err := validateProposalTypeParams(data.ProposalTypeParams); err != nil {
+		return fmt.Errorf("invalid governance proposal type params: %w", err)
+	}
+
 	return nil
 }
 
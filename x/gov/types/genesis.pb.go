@@ -39,6 +39,11 @@ type GenesisState struct {
 	VotingParams VotingParams `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params"`
 	// params defines all the paramaters of related to tally.
 	TallyParams TallyParams `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params"`
+	// vote_commitments defines all the vote commitments present at genesis.
+	VoteCommitments []VoteCommitment `protobuf:"bytes,8,rep,name=vote_commitments,json=voteCommitments,proto3" json:"vote_commitments"`
+	// proposal_type_params defines the params overriding the deposit, voting and
+	// tally params for the proposals of some types.
+	ProposalTypeParams []ProposalTypeParams `protobuf:"bytes,9,rep,name=proposal_type_params,json=proposalTypeParams,proto3" json:"proposal_type_params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,6 +128,20 @@ func (m *GenesisState) GetTallyParams() TallyParams {
 	return TallyParams{}
 }
 
+func (m *GenesisState) GetVoteCommitments() []VoteCommitment {
+	if m != nil {
+		return m.VoteCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposalTypeParams() []ProposalTypeParams {
+	if m != nil {
+		return m.ProposalTypeParams
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1beta1.GenesisState")
 }
@@ -130,32 +149,35 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/genesis.proto", fileDescriptor_43cd825e0fa7a627) }
 
 var fileDescriptor_43cd825e0fa7a627 = []byte{
-	// 389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0xda, 0x40,
-	0x14, 0x45, 0xed, 0x62, 0x28, 0x0c, 0x50, 0xb5, 0x23, 0x16, 0x16, 0x45, 0xc6, 0xed, 0xca, 0x9b,
-	0xda, 0x85, 0xae, 0xbb, 0xb1, 0x2a, 0xb5, 0xa8, 0x6a, 0x85, 0xdc, 0x2a, 0x8b, 0x6c, 0x90, 0xc1,
-	0x23, 0xc7, 0x0a, 0xf0, 0x2c, 0xbf, 0x89, 0x15, 0x76, 0xf9, 0x84, 0x7c, 0x47, 0xbe, 0x84, 0x25,
-	0xcb, 0xac, 0x92, 0x08, 0x7e, 0x24, 0xf2, 0x8c, 0x9d, 0x38, 0x8a, 0x93, 0x95, 0x3d, 0xf7, 0xde,
-	0x39, 0xba, 0xf3, 0xf4, 0x88, 0xb9, 0x00, 0x5c, 0x01, 0x3a, 0x21, 0xa4, 0x4e, 0x3a, 0x9a, 0x33,
-	0xee, 0x8f, 0x9c, 0x90, 0xad, 0x19, 0x46, 0x68, 0xc7, 0x09, 0x70, 0xa0, 0x54, 0x26, 0xec, 0x10,
-	0x52, 0x3b, 0x4f, 0xf4, 0x7b, 0x21, 0x84, 0x20, 0x6c, 0x27, 0xfb, 0x93, 0xc9, 0xfe, 0xa0, 0x8a,
-	0x05, 0xa9, 0x74, 0x3f, 0x5f, 0x68, 0xa4, 0xf3, 0x53, 0x92, 0xff, 0x71, 0x9f, 0x33, 0xfa, 0x95,
-	0xf4, 0x90, 0xfb, 0x09, 0x8f, 0xd6, 0xe1, 0x2c, 0x4e, 0x20, 0x06, 0xf4, 0x97, 0xb3, 0x28, 0xd0,
-	0x55, 0x53, 0xb5, 0x34, 0x8f, 0x16, 0xde, 0x34, 0xb7, 0x26, 0x01, 0x9d, 0x90, 0x66, 0xc0, 0x62,
-	0xc0, 0x88, 0xa3, 0xfe, 0xc6, 0xac, 0x59, 0xed, 0xf1, 0x47, 0xfb, 0x79, 0x3b, 0xfb, 0x87, 0xcc,
-	0xb8, 0xef, 0xb7, 0x37, 0x43, 0xe5, 0xea, 0x76, 0xd8, 0xcc, 0x05, 0xf4, 0x1e, 0xae, 0xd3, 0xef,
-	0xa4, 0x9e, 0x02, 0x67, 0xa8, 0xd7, 0x04, 0x47, 0xaf, 0xe2, 0x1c, 0x01, 0x67, 0x6e, 0x37, 0x87,
-	0xd4, 0xb3, 0x13, 0x7a, 0xf2, 0x16, 0xfd, 0x43, 0x5a, 0x45, 0x65, 0xd4, 0x35, 0x81, 0x18, 0x54,
-	0x21, 0x8a, 0xf2, 0xee, 0x87, 0x1c, 0xd3, 0x2a, 0x14, 0xf4, 0x1e, 0x09, 0xf4, 0x2f, 0x79, 0x97,
-	0x37, 0x9b, 0xc5, 0x7e, 0xe2, 0xaf, 0x50, 0xaf, 0x9b, 0xaa, 0xd5, 0x1e, 0x7f, 0x7a, 0xe5, 0x79,
-	0x53, 0x11, 0x74, 0xb5, 0x0c, 0xec, 0x75, 0x83, 0xb2, 0x48, 0x7f, 0x93, 0x6e, 0x0a, 0x72, 0xb0,
-	0x12, 0xd7, 0x10, 0x38, 0xf3, 0x85, 0x57, 0x66, 0x53, 0x2e, 0xd3, 0x3a, 0x69, 0x49, 0xa3, 0xbf,
-	0x48, 0x87, 0xfb, 0xcb, 0xe5, 0xa6, 0x60, 0xbd, 0x15, 0xac, 0x61, 0x15, 0xeb, 0x7f, 0x96, 0x7b,
-	0x82, 0x6a, 0xf3, 0x92, 0xe4, 0x6e, 0xf7, 0x86, 0xba, 0xdb, 0x1b, 0xea, 0xdd, 0xde, 0x50, 0x2f,
-	0x0f, 0x86, 0xb2, 0x3b, 0x18, 0xca, 0xf5, 0xc1, 0x50, 0x8e, 0xad, 0x30, 0xe2, 0x27, 0x67, 0x73,
-	0x7b, 0x01, 0x2b, 0x27, 0xdf, 0x22, 0xf9, 0xf9, 0x82, 0xc1, 0xa9, 0x73, 0x2e, 0x56, 0x8a, 0x6f,
-	0x62, 0x86, 0xf3, 0x86, 0xd8, 0xa6, 0x6f, 0xf7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x3b, 0x1a,
-	0xbf, 0xb9, 0x02, 0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0x1b, 0xd6, 0x8e, 0xd6, 0x6d, 0x61, 0x58, 0x3d, 0x44, 0x63, 0x4a, 0xc3, 0x0e, 0x28,
+	0x17, 0x12, 0x36, 0xce, 0x5c, 0x02, 0x12, 0x4c, 0x08, 0x34, 0x65, 0x13, 0x07, 0x0e, 0x44, 0x69,
+	0x63, 0x85, 0x88, 0xa6, 0x7f, 0x2b, 0x7f, 0x63, 0xd1, 0xb7, 0xe0, 0x39, 0xb8, 0xf3, 0x0e, 0x3b,
+	0xee, 0xc8, 0x09, 0x50, 0xfb, 0x22, 0x28, 0xb6, 0xd3, 0xb5, 0x5a, 0xd6, 0x53, 0x92, 0xef, 0xff,
+	0xf9, 0xa7, 0xcf, 0x5f, 0x6c, 0xe2, 0x4e, 0x01, 0x0b, 0xc0, 0x20, 0x03, 0x19, 0xc8, 0x93, 0x09,
+	0x13, 0xc9, 0x49, 0x90, 0xb1, 0x39, 0xc3, 0x1c, 0x7d, 0x5e, 0x82, 0x00, 0x4a, 0xb5, 0xc3, 0xcf,
+	0x40, 0xfa, 0xc6, 0x71, 0x38, 0xca, 0x20, 0x03, 0x35, 0x0e, 0xaa, 0x37, 0xed, 0x3c, 0x3c, 0x6a,
+	0x62, 0x81, 0xd4, 0xd3, 0xe3, 0x5f, 0x1d, 0x32, 0x78, 0xa3, 0xc9, 0x17, 0x22, 0x11, 0x8c, 0x3e,
+	0x27, 0x23, 0x14, 0x49, 0x29, 0xf2, 0x79, 0x16, 0xf3, 0x12, 0x38, 0x60, 0x32, 0x8b, 0xf3, 0xd4,
+	0xb6, 0x5c, 0xcb, 0x6b, 0x47, 0xb4, 0x9e, 0x9d, 0x9b, 0xd1, 0x59, 0x4a, 0xcf, 0x48, 0x37, 0x65,
+	0x1c, 0x30, 0x17, 0x68, 0xdf, 0x73, 0xf7, 0xbc, 0xfe, 0xe9, 0x63, 0xff, 0x76, 0x3a, 0xff, 0xb5,
+	0xf6, 0x84, 0x07, 0x57, 0x7f, 0xc6, 0xad, 0x9f, 0x7f, 0xc7, 0x5d, 0x23, 0x60, 0xb4, 0x5e, 0x4e,
+	0x5f, 0x92, 0x8e, 0x04, 0xc1, 0xd0, 0xde, 0x53, 0x1c, 0xbb, 0x89, 0xf3, 0x11, 0x04, 0x0b, 0x87,
+	0x06, 0xd2, 0xa9, 0xbe, 0x30, 0xd2, 0xab, 0xe8, 0x7b, 0xd2, 0xab, 0x23, 0xa3, 0xdd, 0x56, 0x88,
+	0xa3, 0x26, 0x44, 0x1d, 0x3e, 0x7c, 0x64, 0x30, 0xbd, 0x5a, 0xc1, 0xe8, 0x86, 0x40, 0x3f, 0x90,
+	0x07, 0x26, 0x59, 0xcc, 0x93, 0x32, 0x29, 0xd0, 0xee, 0xb8, 0x96, 0xd7, 0x3f, 0x7d, 0xb2, 0x63,
+	0x7b, 0xe7, 0xca, 0x18, 0xb6, 0x2b, 0x70, 0x34, 0x4c, 0x37, 0x45, 0xfa, 0x8e, 0x0c, 0x25, 0xe8,
+	0x62, 0x35, 0x6e, 0x5f, 0xe1, 0xdc, 0x3b, 0x76, 0x59, 0xb5, 0xbc, 0x49, 0x1b, 0xc8, 0x0d, 0x8d,
+	0xbe, 0x25, 0x03, 0x91, 0xcc, 0x66, 0x8b, 0x9a, 0x75, 0x5f, 0xb1, 0xc6, 0x4d, 0xac, 0xcb, 0xca,
+	0xb7, 0x85, 0xea, 0x8b, 0x1b, 0x89, 0x5e, 0x90, 0x83, 0xaa, 0xbe, 0x78, 0x0a, 0x45, 0x91, 0x8b,
+	0x82, 0xcd, 0x05, 0xda, 0x5d, 0x55, 0xde, 0xf1, 0x5d, 0xfd, 0xbf, 0x5a, 0x5b, 0x0d, 0xf0, 0xa1,
+	0xdc, 0x52, 0x91, 0x7e, 0x26, 0xa3, 0xf5, 0xe9, 0x11, 0x0b, 0xce, 0xea, 0x98, 0x3d, 0x05, 0x7e,
+	0xba, 0xeb, 0xaf, 0x5c, 0x2e, 0x38, 0xdb, 0x4a, 0x4b, 0xf9, 0xed, 0x49, 0x78, 0xb5, 0x74, 0xac,
+	0xeb, 0xa5, 0x63, 0xfd, 0x5b, 0x3a, 0xd6, 0x8f, 0x95, 0xd3, 0xba, 0x5e, 0x39, 0xad, 0xdf, 0x2b,
+	0xa7, 0xf5, 0xc9, 0xcb, 0x72, 0xf1, 0xe5, 0xdb, 0xc4, 0x9f, 0x42, 0x11, 0x98, 0xa3, 0xaf, 0x1f,
+	0xcf, 0x30, 0xfd, 0x1a, 0x7c, 0x57, 0xf7, 0xa0, 0x4a, 0x84, 0x93, 0x7d, 0x75, 0x05, 0x5e, 0xfc,
+	0x1f, 0x00, 0x06, 0x25, 0x5b, 0xcd, 0x6e, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -178,6 +200,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposalTypeParams) > 0 {
+		for iNdEx := len(m.ProposalTypeParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalTypeParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.VoteCommitments) > 0 {
+		for iNdEx := len(m.VoteCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -302,6 +352,18 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.TallyParams.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.VoteCommitments) > 0 {
+		for _, e := range m.VoteCommitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposalTypeParams) > 0 {
+		for _, e := range m.ProposalTypeParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -560,6 +622,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteCommitments = append(m.VoteCommitments, VoteCommitment{})
+			if err := m.VoteCommitments[len(m.VoteCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTypeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalTypeParams = append(m.ProposalTypeParams, ProposalTypeParams{})
+			if err := m.ProposalTypeParams[len(m.ProposalTypeParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -93,6 +93,9 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	StatusFailed ProposalStatus = 5
+	// PROPOSAL_STATUS_REVEAL_PERIOD defines a proposal status of a commit-reveal
+	// proposal during the reveal period following its voting period.
+	StatusRevealPeriod ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -102,6 +105,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_REVEAL_PERIOD",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -111,6 +115,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_PASSED":         3,
 	"PROPOSAL_STATUS_REJECTED":       4,
 	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_REVEAL_PERIOD":  6,
 }
 
 func (x ProposalStatus) String() string {
@@ -249,6 +254,14 @@ type Proposal struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time"`
+	// commit_reveal is set if the votes on the proposal are committed during the
+	// voting period and revealed during the reveal period which follows it.
+	CommitReveal bool `protobuf:"varint,10,opt,name=commit_reveal,json=commitReveal,proto3" json:"commit_reveal,omitempty"`
+	// reveal_end_time is the end of the reveal period of a commit-reveal
+	// proposal, set when its voting period starts.
+	RevealEndTime *time.Time `protobuf:"bytes,11,opt,name=reveal_end_time,json=revealEndTime,proto3,stdtime" json:"reveal_end_time,omitempty"`
+	// proposer is the address which submitted the proposal.
+	Proposer string `protobuf:"bytes,12,opt,name=proposer,proto3" json:"proposer,omitempty"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
@@ -367,6 +380,48 @@ func (m *Vote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Vote proto.InternalMessageInfo
 
+// VoteCommitment defines the commitment of a voter to a vote on a commit-reveal
+// proposal. The vote is hidden until the voter reveals it.
+type VoteCommitment struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Voter      string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// commitment is the SHA-256 hash of the proposal ID, the voter, the vote
+	// options and a secret salt.
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *VoteCommitment) Reset()      { *m = VoteCommitment{} }
+func (*VoteCommitment) ProtoMessage() {}
+func (*VoteCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+}
+func (m *VoteCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteCommitment.Merge(m, src)
+}
+func (m *VoteCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteCommitment proto.InternalMessageInfo
+
 // DepositParams defines the params for deposits on governance proposals.
 type DepositParams struct {
 	//  Minimum deposit for a proposal to enter voting period.
@@ -374,12 +429,15 @@ type DepositParams struct {
 	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2
 	//  months.
 	MaxDepositPeriod time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+	//  Fraction of the deposits burned when a proposal is canceled by its
+	//  proposer, the rest being refunded to the depositors.
+	ProposalCancelRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proposal_cancel_ratio,omitempty"`
 }
 
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,12 +470,17 @@ var xxx_messageInfo_DepositParams proto.InternalMessageInfo
 type VotingParams struct {
 	//  Length of the voting period.
 	VotingPeriod time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	//  Types of the proposals using commit-reveal voting, e.g. "Text".
+	CommitRevealProposalTypes []string `protobuf:"bytes,2,rep,name=commit_reveal_proposal_types,json=commitRevealProposalTypes,proto3" json:"commit_reveal_proposal_types,omitempty"`
+	//  Length of the reveal period following the voting period of commit-reveal
+	//  proposals.
+	RevealPeriod time.Duration `protobuf:"bytes,3,opt,name=reveal_period,json=revealPeriod,proto3,stdduration" json:"reveal_period,omitempty"`
 }
 
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,7 +524,7 @@ type TallyParams struct {
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{9}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,6 +553,55 @@ func (m *TallyParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TallyParams proto.InternalMessageInfo
 
+// ProposalTypeParams defines the params overriding the deposit, voting and
+// tally params for the proposals of a type. The params left empty or zero are
+// the ones of the DepositParams, VotingParams and TallyParams.
+type ProposalTypeParams struct {
+	//  Type of the proposals the params apply to, e.g. "SoftwareUpgrade".
+	ProposalType string `protobuf:"bytes,1,opt,name=proposal_type,json=proposalType,proto3" json:"proposal_type,omitempty"`
+	//  Minimum deposit for a proposal of the type to enter voting period.
+	MinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit,omitempty"`
+	//  Length of the voting period of the proposals of the type.
+	VotingPeriod time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
+	Quorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum,omitempty"`
+	//  Minimum proportion of Yes votes for the proposals of the type to pass.
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold,omitempty"`
+}
+
+func (m *ProposalTypeParams) Reset()      { *m = ProposalTypeParams{} }
+func (*ProposalTypeParams) ProtoMessage() {}
+func (*ProposalTypeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{10}
+}
+func (m *ProposalTypeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalTypeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalTypeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalTypeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalTypeParams.Merge(m, src)
+}
+func (m *ProposalTypeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalTypeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalTypeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalTypeParams proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1beta1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -499,100 +611,116 @@ func init() {
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1beta1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1beta1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1beta1.Vote")
+	proto.RegisterType((*VoteCommitment)(nil), "cosmos.gov.v1beta1.VoteCommitment")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1beta1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1beta1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1beta1.TallyParams")
+	proto.RegisterType((*ProposalTypeParams)(nil), "cosmos.gov.v1beta1.ProposalTypeParams")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/gov.proto", fileDescriptor_6e82113c1a9a4b7c) }
 
 var fileDescriptor_6e82113c1a9a4b7c = []byte{
-	// 1352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xf7, 0xda, 0x8e, 0x93, 0x3c, 0x3b, 0xc9, 0x32, 0xe4, 0x0b, 0x8e, 0xbf, 0x7c, 0xed, 0x95,
-	0xbf, 0x12, 0x8d, 0x10, 0x71, 0x20, 0x95, 0x90, 0x1a, 0x7a, 0xb1, 0xe3, 0x4d, 0x6b, 0x14, 0xd9,
-	0xd6, 0x7a, 0x71, 0x04, 0x87, 0xae, 0x36, 0xde, 0xc1, 0xd9, 0xd6, 0xbb, 0x63, 0xbc, 0xe3, 0x90,
-	0xdc, 0x7a, 0xa9, 0x84, 0x7c, 0xe2, 0xc8, 0xc5, 0x12, 0x6a, 0x6f, 0x3d, 0xf5, 0xc0, 0x3f, 0xd0,
-	0x1b, 0xaa, 0x7a, 0xa0, 0x9c, 0x50, 0x0f, 0xa1, 0x04, 0xb5, 0xa2, 0xfc, 0x15, 0xd5, 0xce, 0xcc,
-	0x26, 0x1b, 0x27, 0x6a, 0x70, 0xe9, 0x29, 0xbb, 0x33, 0x9f, 0x1f, 0xf3, 0x9e, 0xdf, 0x7b, 0xb3,
-	0x81, 0x4b, 0x2d, 0xe2, 0x39, 0xc4, 0x5b, 0x6e, 0x93, 0x9d, 0xe5, 0x9d, 0xeb, 0x5b, 0x98, 0x9a,
-	0xd7, 0xfd, 0xe7, 0x42, 0xb7, 0x47, 0x28, 0x41, 0x88, 0xef, 0x16, 0xfc, 0x15, 0xb1, 0x9b, 0xc9,
-	0x0a, 0xc6, 0x96, 0xe9, 0xe1, 0x43, 0x4a, 0x8b, 0xd8, 0x2e, 0xe7, 0x64, 0xe6, 0xdb, 0xa4, 0x4d,
-	0xd8, 0xe3, 0xb2, 0xff, 0x24, 0x56, 0x73, 0x6d, 0x42, 0xda, 0x1d, 0xbc, 0xcc, 0xde, 0xb6, 0xfa,
-	0xf7, 0x96, 0xa9, 0xed, 0x60, 0x8f, 0x9a, 0x4e, 0x57, 0x00, 0x16, 0x46, 0x01, 0xa6, 0xbb, 0x27,
-	0xb6, 0xb2, 0xa3, 0x5b, 0x56, 0xbf, 0x67, 0x52, 0x9b, 0x04, 0x8e, 0x0b, 0xfc, 0x44, 0x06, 0x37,
-	0x15, 0x47, 0x66, 0x2f, 0xf9, 0x6f, 0x25, 0x40, 0x9b, 0xd8, 0x6e, 0x6f, 0x53, 0x6c, 0x35, 0x09,
-	0xc5, 0xb5, 0xae, 0xcf, 0x43, 0x37, 0x20, 0x41, 0xd8, 0x53, 0x5a, 0x52, 0xa4, 0xc5, 0xd9, 0x95,
-	0x6c, 0xe1, 0x64, 0xa0, 0x85, 0x23, 0xbc, 0x26, 0xd0, 0x48, 0x87, 0xc4, 0x03, 0xa6, 0x96, 0x8e,
-	0x2a, 0xd2, 0xe2, 0x74, 0xe9, 0xd3, 0x67, 0xfb, 0xb9, 0xc8, 0xaf, 0xfb, 0xb9, 0xcb, 0x6d, 0x9b,
-	0x6e, 0xf7, 0xb7, 0x0a, 0x2d, 0xe2, 0x08, 0x7f, 0xf1, 0x67, 0xc9, 0xb3, 0xbe, 0x5a, 0xa6, 0x7b,
-	0x5d, 0xec, 0x15, 0xca, 0xb8, 0xf5, 0xe2, 0xe9, 0x12, 0x08, 0xa3, 0x32, 0x6e, 0x69, 0x42, 0x2b,
-	0xbf, 0x09, 0x29, 0x1d, 0xef, 0xd2, 0x7a, 0x8f, 0x74, 0x89, 0x67, 0x76, 0xd0, 0x3c, 0x4c, 0x50,
-	0x9b, 0x76, 0x30, 0x3b, 0xdc, 0xb4, 0xc6, 0x5f, 0x90, 0x02, 0x49, 0x0b, 0x7b, 0xad, 0x9e, 0xcd,
-	0x0f, 0xce, 0x0e, 0xa0, 0x85, 0x97, 0x56, 0xe7, 0xde, 0x3e, 0xc9, 0x49, 0x3f, 0x3d, 0x5d, 0x9a,
-	0x5c, 0x23, 0x2e, 0xc5, 0x2e, 0xcd, 0xff, 0x22, 0xc1, 0x64, 0x19, 0x77, 0x89, 0x67, 0x53, 0x94,
-	0x83, 0x64, 0x57, 0x18, 0x18, 0xb6, 0xc5, 0xa4, 0xe3, 0x1a, 0x04, 0x4b, 0x15, 0x0b, 0xdd, 0x80,
-	0x69, 0x8b, 0x63, 0x49, 0x4f, 0x84, 0x97, 0x7e, 0xf1, 0x74, 0x69, 0x5e, 0x1c, 0xb8, 0x68, 0x59,
-	0x3d, 0xec, 0x79, 0x0d, 0xda, 0xb3, 0xdd, 0xb6, 0x76, 0x04, 0x45, 0x2d, 0x48, 0x98, 0x0e, 0xe9,
-	0xbb, 0x34, 0x1d, 0x53, 0x62, 0x8b, 0xc9, 0x95, 0x85, 0x20, 0x97, 0x7e, 0x81, 0x1c, 0x26, 0x73,
-	0x8d, 0xd8, 0x6e, 0xe9, 0x9a, 0x9f, 0xae, 0xef, 0x5f, 0xe5, 0x16, 0xdf, 0x23, 0x5d, 0x3e, 0xc1,
-	0xd3, 0x84, 0xf4, 0xea, 0xd4, 0xc3, 0x27, 0xb9, 0xc8, 0xdb, 0x27, 0xb9, 0x48, 0xfe, 0x87, 0x09,
-	0x98, 0x3a, 0xcc, 0xd4, 0x47, 0xa7, 0x04, 0x55, 0x4a, 0xbc, 0xdb, 0xcf, 0x45, 0x6d, 0xeb, 0x58,
-	0x70, 0x37, 0x61, 0xb2, 0xc5, 0x93, 0xc2, 0x42, 0x4b, 0xae, 0xcc, 0x17, 0x78, 0x51, 0x15, 0x82,
-	0xa2, 0x2a, 0x14, 0xdd, 0xbd, 0x52, 0x32, 0x94, 0x3d, 0x2d, 0x60, 0xa0, 0x55, 0x48, 0x78, 0xd4,
-	0xa4, 0x7d, 0x2f, 0x1d, 0x63, 0xd5, 0x92, 0x3f, 0xad, 0x5a, 0x82, 0x33, 0x35, 0x18, 0x52, 0x13,
-	0x0c, 0xd4, 0x00, 0x74, 0xcf, 0x76, 0xcd, 0x8e, 0x41, 0xcd, 0x4e, 0x67, 0xcf, 0xe8, 0x61, 0xaf,
-	0xdf, 0xa1, 0xe9, 0x38, 0x3b, 0x43, 0xee, 0x34, 0x1d, 0xdd, 0xc7, 0x69, 0x0c, 0x56, 0x8a, 0xfb,
-	0xf9, 0xd2, 0x64, 0x26, 0x10, 0x5a, 0x47, 0x2a, 0x24, 0xbd, 0xfe, 0x96, 0x63, 0x53, 0xc3, 0xef,
-	0xa2, 0xf4, 0x04, 0x53, 0xcb, 0x9c, 0x88, 0x48, 0x0f, 0x5a, 0xac, 0x34, 0xe5, 0x0b, 0x3d, 0x7a,
-	0x95, 0x93, 0x34, 0xe0, 0x44, 0x7f, 0x0b, 0x55, 0x41, 0x16, 0x3f, 0xa3, 0x81, 0x5d, 0x8b, 0x6b,
-	0x25, 0xc6, 0xd0, 0x9a, 0x15, 0x6c, 0xd5, 0xb5, 0x98, 0x5e, 0x17, 0x66, 0x28, 0xa1, 0x66, 0xc7,
-	0x10, 0xeb, 0xe9, 0xc9, 0x7f, 0xbf, 0x20, 0x52, 0xcc, 0x21, 0x28, 0xea, 0x3a, 0x9c, 0xdb, 0x21,
-	0xd4, 0x76, 0xdb, 0x86, 0x47, 0xcd, 0x9e, 0x48, 0xc7, 0xd4, 0x18, 0x21, 0xcc, 0x71, 0x7a, 0xc3,
-	0x67, 0xb3, 0x18, 0x36, 0x40, 0x2c, 0x1d, 0xa5, 0x64, 0x7a, 0x0c, 0xbd, 0x19, 0x4e, 0x16, 0x19,
-	0x59, 0x8d, 0xfb, 0x1d, 0x99, 0xff, 0x33, 0x0a, 0xc9, 0xf0, 0xcf, 0x57, 0x85, 0xd8, 0x1e, 0xf6,
-	0x78, 0x77, 0x8f, 0x35, 0x42, 0x2a, 0x2e, 0x0d, 0x8d, 0x90, 0x8a, 0x4b, 0x35, 0x5f, 0x08, 0x35,
-	0x61, 0xd2, 0xdc, 0xf2, 0xa8, 0x69, 0xbb, 0xff, 0x60, 0x2c, 0x9d, 0xd4, 0x0c, 0xc4, 0xd0, 0x06,
-	0x44, 0x5d, 0xc2, 0x6a, 0xfe, 0x43, 0x25, 0xa3, 0x2e, 0x41, 0x5f, 0x40, 0xca, 0x25, 0xc6, 0x03,
-	0x9b, 0x6e, 0x1b, 0x3b, 0x98, 0x12, 0xd6, 0x03, 0x1f, 0xaa, 0x0b, 0x2e, 0xd9, 0xb4, 0xe9, 0x76,
-	0x13, 0x53, 0x22, 0x72, 0xfd, 0xbb, 0x04, 0x71, 0x7f, 0x70, 0x9f, 0x3d, 0xef, 0x0a, 0x30, 0xb1,
-	0x43, 0x28, 0x3e, 0x7b, 0xd6, 0x71, 0x98, 0x3f, 0x05, 0xc4, 0x9d, 0x11, 0x7b, 0x9f, 0x3b, 0xa3,
-	0x14, 0x4d, 0x4b, 0x87, 0xf7, 0xc6, 0x3a, 0x4c, 0xf2, 0x27, 0x2f, 0x1d, 0x67, 0x3d, 0x71, 0xf9,
-	0x34, 0xf2, 0xc9, 0x8b, 0x4a, 0x4c, 0x80, 0x80, 0xbc, 0x3a, 0xf5, 0x38, 0x18, 0x83, 0x83, 0x28,
-	0xcc, 0x88, 0x2e, 0xa8, 0x9b, 0x3d, 0xd3, 0xf1, 0xd0, 0x37, 0x12, 0x24, 0x1d, 0xdb, 0x3d, 0x6c,
-	0x3e, 0xe9, 0xac, 0xe6, 0xab, 0xf8, 0xda, 0xef, 0xf6, 0x73, 0xff, 0x09, 0xb1, 0xae, 0x12, 0xc7,
-	0xa6, 0xd8, 0xe9, 0xd2, 0xbd, 0xb1, 0xba, 0x12, 0x1c, 0xdb, 0x0d, 0x7a, 0xf2, 0x3e, 0x20, 0xc7,
-	0xdc, 0x0d, 0x04, 0x8d, 0x2e, 0xee, 0xd9, 0xc4, 0x12, 0x53, 0x77, 0xe1, 0x44, 0x13, 0x95, 0xc5,
-	0x55, 0x5e, 0x5a, 0x14, 0xa7, 0xb9, 0x74, 0x92, 0x7c, 0x74, 0xa8, 0xc7, 0x7e, 0x8f, 0xc9, 0x8e,
-	0xb9, 0x1b, 0x84, 0xce, 0xf6, 0xf3, 0x1e, 0xa4, 0x9a, 0xac, 0xef, 0x44, 0x2a, 0x5a, 0x20, 0xfa,
-	0x30, 0x70, 0x97, 0xce, 0x72, 0xff, 0xbf, 0x70, 0xbf, 0x78, 0x8c, 0x37, 0x62, 0x9c, 0xe2, 0x9b,
-	0xc2, 0xf4, 0xc7, 0xa0, 0xab, 0x85, 0xe9, 0x5d, 0x48, 0xdc, 0xef, 0x93, 0x5e, 0xdf, 0x61, 0x6e,
-	0xa9, 0x52, 0x69, 0xbc, 0x6f, 0x83, 0x77, 0xfb, 0x39, 0x99, 0xf3, 0x8f, 0x5c, 0x35, 0xa1, 0x88,
-	0x5a, 0x30, 0x4d, 0xb7, 0x7b, 0xd8, 0xdb, 0x26, 0x1d, 0x9e, 0xca, 0x54, 0x49, 0x1d, 0x5b, 0xfe,
-	0xfc, 0xa1, 0x44, 0xc8, 0xe1, 0x48, 0x17, 0xdd, 0x87, 0x59, 0xbf, 0x31, 0x8d, 0x23, 0xa7, 0x18,
-	0x73, 0xba, 0x35, 0xb6, 0x53, 0xfa, 0xb8, 0x4e, 0xc8, 0x6e, 0xc6, 0xdf, 0xd1, 0x83, 0x8d, 0x2b,
-	0x7f, 0x48, 0x00, 0xa1, 0xcf, 0xb2, 0xab, 0x70, 0xb1, 0x59, 0xd3, 0x55, 0xa3, 0x56, 0xd7, 0x2b,
-	0xb5, 0xaa, 0x71, 0xbb, 0xda, 0xa8, 0xab, 0x6b, 0x95, 0xf5, 0x8a, 0x5a, 0x96, 0x23, 0x99, 0xb9,
-	0xc1, 0x50, 0x49, 0x72, 0xa0, 0xea, 0x6b, 0xa1, 0x3c, 0xcc, 0x85, 0xd1, 0x77, 0xd4, 0x86, 0x2c,
-	0x65, 0x66, 0x06, 0x43, 0x65, 0x9a, 0xa3, 0xee, 0x60, 0x0f, 0x5d, 0x81, 0xf3, 0x61, 0x4c, 0xb1,
-	0xd4, 0xd0, 0x8b, 0x95, 0xaa, 0x1c, 0xcd, 0x9c, 0x1b, 0x0c, 0x95, 0x19, 0x8e, 0x2b, 0x8a, 0x71,
-	0xa7, 0xc0, 0x6c, 0x18, 0x5b, 0xad, 0xc9, 0xb1, 0x4c, 0x6a, 0x30, 0x54, 0xa6, 0x38, 0xac, 0x4a,
-	0xd0, 0x0a, 0xa4, 0x8f, 0x23, 0x8c, 0xcd, 0x8a, 0xfe, 0xb9, 0xd1, 0x54, 0xf5, 0x9a, 0x1c, 0xcf,
-	0xcc, 0x0f, 0x86, 0x8a, 0x1c, 0x60, 0x83, 0xb1, 0x94, 0x89, 0x3f, 0xfc, 0x2e, 0x1b, 0xb9, 0xf2,
-	0x73, 0x14, 0x66, 0x8f, 0x7f, 0x21, 0xa0, 0x02, 0xfc, 0xb7, 0xae, 0xd5, 0xea, 0xb5, 0x46, 0x71,
-	0xc3, 0x68, 0xe8, 0x45, 0xfd, 0x76, 0x63, 0x24, 0x60, 0x16, 0x0a, 0x07, 0x57, 0xed, 0x0e, 0xba,
-	0x09, 0xd9, 0x51, 0x7c, 0x59, 0xad, 0xd7, 0x1a, 0x15, 0xdd, 0xa8, 0xab, 0x5a, 0xa5, 0x56, 0x96,
-	0xa5, 0xcc, 0xc5, 0xc1, 0x50, 0x39, 0xcf, 0x29, 0xc7, 0x3a, 0x04, 0x7d, 0x02, 0xff, 0x1b, 0x25,
-	0x37, 0x6b, 0x7a, 0xa5, 0xfa, 0x59, 0xc0, 0x8d, 0x66, 0x2e, 0x0c, 0x86, 0x0a, 0xe2, 0xdc, 0x66,
-	0xa8, 0xce, 0xd1, 0x55, 0xb8, 0x30, 0x4a, 0xad, 0x17, 0x1b, 0x0d, 0xb5, 0x2c, 0xc7, 0x32, 0xf2,
-	0x60, 0xa8, 0xa4, 0x38, 0xa7, 0x6e, 0x7a, 0x1e, 0xb6, 0xd0, 0x35, 0x48, 0x8f, 0xa2, 0x35, 0xf5,
-	0x96, 0xba, 0xa6, 0xab, 0x65, 0x39, 0x9e, 0x41, 0x83, 0xa1, 0x32, 0x2b, 0xbe, 0x90, 0xf0, 0x97,
-	0xb8, 0x45, 0xf1, 0xa9, 0xfa, 0xeb, 0xc5, 0xca, 0x86, 0x5a, 0x96, 0x27, 0xc2, 0xfa, 0xeb, 0xa6,
-	0xdd, 0xc1, 0x16, 0x4f, 0x67, 0xa9, 0xfa, 0xec, 0x75, 0x36, 0xf2, 0xf2, 0x75, 0x36, 0xf2, 0xf5,
-	0x41, 0x36, 0xf2, 0xec, 0x20, 0x2b, 0x3d, 0x3f, 0xc8, 0x4a, 0xbf, 0x1d, 0x64, 0xa5, 0x47, 0x6f,
-	0xb2, 0x91, 0xe7, 0x6f, 0xb2, 0x91, 0x97, 0x6f, 0xb2, 0x91, 0xbb, 0x7f, 0x3f, 0xbf, 0x76, 0xd9,
-	0xff, 0x3c, 0xac, 0x6c, 0xb7, 0x12, 0x6c, 0x22, 0x7c, 0xfc, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x8c, 0x21, 0xa9, 0x1b, 0x0e, 0x0d, 0x00, 0x00,
+	// 1583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x41, 0x6f, 0x22, 0xc9,
+	0x15, 0xa6, 0x01, 0x63, 0xfc, 0x00, 0x9b, 0x2d, 0x7b, 0x77, 0xda, 0x64, 0x02, 0x2d, 0x46, 0x9a,
+	0x58, 0xd6, 0x18, 0xef, 0x3a, 0xd1, 0x4a, 0xf1, 0xe6, 0x02, 0xa6, 0x9d, 0x65, 0x65, 0x01, 0x69,
+	0x7a, 0xb1, 0x76, 0x0f, 0x69, 0xb5, 0xa1, 0x16, 0x77, 0x96, 0xee, 0x62, 0xe8, 0xc2, 0x6b, 0xdf,
+	0x72, 0x89, 0x76, 0xc5, 0x69, 0x95, 0xd3, 0x5e, 0x90, 0x46, 0xc9, 0x2d, 0xe7, 0xf9, 0x03, 0x39,
+	0x44, 0x1a, 0xe5, 0x34, 0x19, 0x29, 0xd2, 0x28, 0x07, 0x4f, 0xc6, 0xa3, 0x24, 0x13, 0xff, 0x8a,
+	0xa8, 0xab, 0xaa, 0xa1, 0xc1, 0xd6, 0x78, 0x90, 0x3c, 0xca, 0xc9, 0xdd, 0x55, 0xdf, 0xf7, 0xbd,
+	0xfa, 0x5e, 0xbd, 0x7a, 0xd5, 0x18, 0xee, 0xb6, 0x88, 0x6b, 0x13, 0x77, 0xbb, 0x43, 0x4e, 0xb6,
+	0x4f, 0x3e, 0x3a, 0xc2, 0xd4, 0xfc, 0xc8, 0x7b, 0x2e, 0xf4, 0xfa, 0x84, 0x12, 0x84, 0xf8, 0x6c,
+	0xc1, 0x1b, 0x11, 0xb3, 0x99, 0xac, 0x60, 0x1c, 0x99, 0x2e, 0x1e, 0x53, 0x5a, 0xc4, 0x72, 0x38,
+	0x27, 0xb3, 0xd6, 0x21, 0x1d, 0xc2, 0x1e, 0xb7, 0xbd, 0x27, 0x31, 0x9a, 0xeb, 0x10, 0xd2, 0xe9,
+	0xe2, 0x6d, 0xf6, 0x76, 0x34, 0xf8, 0x6a, 0x9b, 0x5a, 0x36, 0x76, 0xa9, 0x69, 0xf7, 0x04, 0x60,
+	0x7d, 0x16, 0x60, 0x3a, 0x67, 0x62, 0x2a, 0x3b, 0x3b, 0xd5, 0x1e, 0xf4, 0x4d, 0x6a, 0x11, 0x3f,
+	0xe2, 0x3a, 0x5f, 0x91, 0xc1, 0x83, 0x8a, 0x25, 0xb3, 0x97, 0xfc, 0x1f, 0x24, 0x40, 0x87, 0xd8,
+	0xea, 0x1c, 0x53, 0xdc, 0x6e, 0x12, 0x8a, 0x6b, 0x3d, 0x8f, 0x87, 0x3e, 0x86, 0x18, 0x61, 0x4f,
+	0xb2, 0xa4, 0x48, 0x1b, 0xcb, 0x3b, 0xd9, 0xc2, 0x55, 0xa3, 0x85, 0x09, 0x5e, 0x13, 0x68, 0xa4,
+	0x43, 0xec, 0x1b, 0xa6, 0x26, 0x87, 0x15, 0x69, 0x63, 0xa9, 0xf4, 0x8b, 0x27, 0xe7, 0xb9, 0xd0,
+	0x3f, 0xce, 0x73, 0xf7, 0x3b, 0x16, 0x3d, 0x1e, 0x1c, 0x15, 0x5a, 0xc4, 0x16, 0xf1, 0xc5, 0x9f,
+	0x2d, 0xb7, 0xfd, 0xf5, 0x36, 0x3d, 0xeb, 0x61, 0xb7, 0x50, 0xc6, 0xad, 0x67, 0x8f, 0xb7, 0x40,
+	0x04, 0x2a, 0xe3, 0x96, 0x26, 0xb4, 0xf2, 0x87, 0x90, 0xd4, 0xf1, 0x29, 0xad, 0xf7, 0x49, 0x8f,
+	0xb8, 0x66, 0x17, 0xad, 0xc1, 0x02, 0xb5, 0x68, 0x17, 0xb3, 0xc5, 0x2d, 0x69, 0xfc, 0x05, 0x29,
+	0x90, 0x68, 0x63, 0xb7, 0xd5, 0xb7, 0xf8, 0xc2, 0xd9, 0x02, 0xb4, 0xe0, 0xd0, 0xee, 0xca, 0xeb,
+	0x47, 0x39, 0xe9, 0xaf, 0x8f, 0xb7, 0x16, 0xf7, 0x88, 0x43, 0xb1, 0x43, 0xf3, 0x7f, 0x93, 0x60,
+	0xb1, 0x8c, 0x7b, 0xc4, 0xb5, 0x28, 0xca, 0x41, 0xa2, 0x27, 0x02, 0x18, 0x56, 0x9b, 0x49, 0x47,
+	0x35, 0xf0, 0x87, 0x2a, 0x6d, 0xf4, 0x31, 0x2c, 0xb5, 0x39, 0x96, 0xf4, 0x85, 0x3d, 0xf9, 0xd9,
+	0xe3, 0xad, 0x35, 0xb1, 0xe0, 0x62, 0xbb, 0xdd, 0xc7, 0xae, 0xdb, 0xa0, 0x7d, 0xcb, 0xe9, 0x68,
+	0x13, 0x28, 0x6a, 0x41, 0xcc, 0xb4, 0xc9, 0xc0, 0xa1, 0x72, 0x44, 0x89, 0x6c, 0x24, 0x76, 0xd6,
+	0xfd, 0x5c, 0x7a, 0x05, 0x32, 0x4e, 0xe6, 0x1e, 0xb1, 0x9c, 0xd2, 0x87, 0x5e, 0xba, 0xfe, 0xf4,
+	0x22, 0xb7, 0xf1, 0x16, 0xe9, 0xf2, 0x08, 0xae, 0x26, 0xa4, 0x77, 0xe3, 0xdf, 0x3d, 0xca, 0x85,
+	0x5e, 0x3f, 0xca, 0x85, 0xf2, 0x7f, 0x8f, 0x41, 0x7c, 0x9c, 0xa9, 0x9f, 0x5c, 0x63, 0xaa, 0x14,
+	0xbb, 0x3c, 0xcf, 0x85, 0xad, 0xf6, 0x94, 0xb9, 0x4f, 0x60, 0xb1, 0xc5, 0x93, 0xc2, 0xac, 0x25,
+	0x76, 0xd6, 0x0a, 0xbc, 0xa8, 0x0a, 0x7e, 0x51, 0x15, 0x8a, 0xce, 0x59, 0x29, 0x11, 0xc8, 0x9e,
+	0xe6, 0x33, 0xd0, 0x2e, 0xc4, 0x5c, 0x6a, 0xd2, 0x81, 0x2b, 0x47, 0x58, 0xb5, 0xe4, 0xaf, 0xab,
+	0x16, 0x7f, 0x4d, 0x0d, 0x86, 0xd4, 0x04, 0x03, 0x35, 0x00, 0x7d, 0x65, 0x39, 0x66, 0xd7, 0xa0,
+	0x66, 0xb7, 0x7b, 0x66, 0xf4, 0xb1, 0x3b, 0xe8, 0x52, 0x39, 0xca, 0xd6, 0x90, 0xbb, 0x4e, 0x47,
+	0xf7, 0x70, 0x1a, 0x83, 0x95, 0xa2, 0x5e, 0xbe, 0xb4, 0x34, 0x13, 0x08, 0x8c, 0x23, 0x15, 0x12,
+	0xee, 0xe0, 0xc8, 0xb6, 0xa8, 0xe1, 0x9d, 0x22, 0x79, 0x81, 0xa9, 0x65, 0xae, 0x38, 0xd2, 0xfd,
+	0x23, 0x56, 0x8a, 0x7b, 0x42, 0xdf, 0xbf, 0xc8, 0x49, 0x1a, 0x70, 0xa2, 0x37, 0x85, 0xaa, 0x90,
+	0x16, 0xdb, 0x68, 0x60, 0xa7, 0xcd, 0xb5, 0x62, 0x73, 0x68, 0x2d, 0x0b, 0xb6, 0xea, 0xb4, 0x99,
+	0x5e, 0x0f, 0x52, 0x94, 0x50, 0xb3, 0x6b, 0x88, 0x71, 0x79, 0xf1, 0xf6, 0x0b, 0x22, 0xc9, 0x22,
+	0xf8, 0x45, 0x5d, 0x87, 0xf7, 0x4e, 0x08, 0xb5, 0x9c, 0x8e, 0xe1, 0x52, 0xb3, 0x2f, 0xd2, 0x11,
+	0x9f, 0xc3, 0xc2, 0x0a, 0xa7, 0x37, 0x3c, 0x36, 0xf3, 0x70, 0x00, 0x62, 0x68, 0x92, 0x92, 0xa5,
+	0x39, 0xf4, 0x52, 0x9c, 0xec, 0x67, 0xe4, 0x1e, 0xa4, 0x5a, 0xc4, 0xf6, 0x36, 0xaa, 0x8f, 0x4f,
+	0xb0, 0xd9, 0x95, 0x41, 0x91, 0x36, 0xe2, 0x5a, 0x92, 0x0f, 0x6a, 0x6c, 0x0c, 0x7d, 0x0a, 0x2b,
+	0x7c, 0x76, 0x12, 0x32, 0x71, 0x63, 0xc8, 0x28, 0x0f, 0xc7, 0x89, 0x7e, 0xb8, 0x9f, 0x41, 0x9c,
+	0xd7, 0x3c, 0xee, 0xcb, 0xc9, 0x1b, 0x4e, 0xf0, 0x18, 0xb9, 0x1b, 0xf5, 0xda, 0x46, 0xfe, 0xbf,
+	0x61, 0x48, 0x04, 0x6b, 0xac, 0x0a, 0x91, 0x33, 0xec, 0xca, 0xd2, 0xdc, 0x7d, 0xae, 0xe2, 0xd0,
+	0x40, 0x9f, 0xab, 0x38, 0x54, 0xf3, 0x84, 0x50, 0x13, 0x16, 0xcd, 0x23, 0x97, 0x9a, 0x96, 0x23,
+	0x87, 0x6f, 0x41, 0xd3, 0x17, 0x43, 0x07, 0x10, 0x76, 0x88, 0x1c, 0xb9, 0x05, 0xc9, 0xb0, 0x43,
+	0xd0, 0xaf, 0x21, 0xe9, 0x10, 0xe3, 0x1b, 0x8b, 0x1e, 0x1b, 0x27, 0x98, 0x12, 0x39, 0x7a, 0x0b,
+	0xba, 0xe0, 0x90, 0x43, 0x8b, 0x1e, 0x37, 0x31, 0x25, 0x22, 0xd7, 0xff, 0x92, 0x20, 0xea, 0xdd,
+	0x2e, 0x37, 0x37, 0xe5, 0x02, 0x2c, 0x9c, 0x10, 0x8a, 0x6f, 0x6e, 0xc8, 0x1c, 0xe6, 0xb5, 0x2a,
+	0x71, 0xb1, 0x45, 0xde, 0xe6, 0x62, 0x2b, 0x85, 0x65, 0x69, 0x7c, 0xb9, 0xed, 0xc3, 0x22, 0x7f,
+	0x72, 0xe5, 0x28, 0x3b, 0xb8, 0xf7, 0xaf, 0x23, 0x5f, 0xbd, 0x4d, 0x45, 0x9b, 0xf2, 0xc9, 0xbb,
+	0xf1, 0x1f, 0xfc, 0x5e, 0xfd, 0xad, 0x04, 0xcb, 0x1e, 0x6e, 0x8f, 0x95, 0xbb, 0xed, 0xf5, 0xd2,
+	0x5b, 0x77, 0x9c, 0x05, 0x68, 0x8d, 0xe5, 0x99, 0xeb, 0xa4, 0x16, 0x18, 0x61, 0x19, 0x0f, 0xe5,
+	0x7f, 0x1f, 0x81, 0x94, 0x68, 0x1a, 0x75, 0xb3, 0x6f, 0xda, 0x2e, 0xfa, 0x9d, 0x04, 0x09, 0xdb,
+	0x72, 0xc6, 0xbd, 0x4a, 0xba, 0xa9, 0x57, 0x55, 0x3c, 0x97, 0x97, 0xe7, 0xb9, 0xf7, 0x03, 0xac,
+	0x07, 0xc4, 0xb6, 0x28, 0xb6, 0x7b, 0xf4, 0x6c, 0xae, 0x26, 0x06, 0xb6, 0xe5, 0xf8, 0x2d, 0xec,
+	0x21, 0x20, 0xdb, 0x3c, 0xf5, 0x05, 0x8d, 0x1e, 0xee, 0x5b, 0xa4, 0x2d, 0x2e, 0xa9, 0xf5, 0x2b,
+	0x0d, 0xa0, 0x2c, 0xbe, 0x7c, 0x4a, 0x1b, 0x62, 0x35, 0x77, 0xaf, 0x92, 0x27, 0x8b, 0xfa, 0xc1,
+	0xeb, 0x11, 0x69, 0xdb, 0x3c, 0xf5, 0xad, 0xb3, 0x79, 0xcf, 0xfa, 0xfb, 0xe3, 0x4d, 0x68, 0x99,
+	0x4e, 0x0b, 0x77, 0x0d, 0x26, 0xcb, 0xd3, 0x57, 0xfa, 0xd5, 0x7c, 0x5f, 0x35, 0x97, 0xe7, 0xb9,
+	0xdc, 0xb5, 0x72, 0x93, 0x85, 0x68, 0xab, 0x3e, 0x60, 0x8f, 0xcd, 0x6b, 0xde, 0x74, 0xfe, 0x2f,
+	0x61, 0x48, 0x36, 0x59, 0xbf, 0x14, 0x7b, 0xd2, 0x02, 0xd1, 0x3f, 0xfd, 0x34, 0x48, 0x37, 0xa5,
+	0xe1, 0x9e, 0x48, 0xc3, 0x9d, 0x29, 0xde, 0x4c, 0x06, 0x92, 0x7c, 0x52, 0xb8, 0xff, 0x1a, 0xee,
+	0xf2, 0xf2, 0x10, 0x3d, 0xd9, 0x18, 0xaf, 0x9d, 0x39, 0x92, 0xc3, 0x4a, 0x64, 0x63, 0xa9, 0xb4,
+	0x79, 0x79, 0x9e, 0xbb, 0xff, 0x26, 0x5c, 0xc0, 0xdc, 0x7a, 0xb0, 0x9d, 0xfb, 0xdf, 0x01, 0xba,
+	0x07, 0xf2, 0x1c, 0xf9, 0x6c, 0xee, 0x28, 0xf2, 0xd6, 0x8e, 0xa6, 0x78, 0xb3, 0x8e, 0xf8, 0x24,
+	0x77, 0x94, 0xff, 0xb3, 0xdf, 0xba, 0x45, 0x1a, 0xbf, 0x84, 0xd8, 0xc3, 0x01, 0xe9, 0x0f, 0x6c,
+	0x96, 0xbf, 0x64, 0xa9, 0x34, 0xf7, 0x7e, 0xa6, 0x39, 0x3f, 0xe0, 0x51, 0x28, 0xa2, 0x16, 0x2c,
+	0xd1, 0xe3, 0x3e, 0x76, 0x8f, 0x49, 0x97, 0x57, 0x69, 0xb2, 0xa4, 0xce, 0x2d, 0xbf, 0x3a, 0x96,
+	0x08, 0x44, 0x98, 0xe8, 0xa2, 0x87, 0xb0, 0xec, 0x75, 0x5f, 0x63, 0x12, 0x89, 0x17, 0xe6, 0x67,
+	0x73, 0x47, 0x92, 0xa7, 0x75, 0x02, 0xe1, 0x52, 0xde, 0x8c, 0xee, 0x4f, 0xe4, 0xff, 0x13, 0x01,
+	0x14, 0xdc, 0x3a, 0x91, 0xca, 0x7b, 0x90, 0x9a, 0xda, 0x76, 0xf1, 0x49, 0x9e, 0xec, 0x05, 0xa0,
+	0x57, 0x5a, 0x49, 0xf8, 0xff, 0xd4, 0x4a, 0xae, 0x1c, 0x9f, 0xc8, 0x3b, 0x38, 0x3e, 0x93, 0xe2,
+	0x8a, 0xbe, 0xdb, 0xe2, 0x5a, 0x78, 0x37, 0xc5, 0xb5, 0xf9, 0x6f, 0x09, 0x20, 0xf0, 0x53, 0xf0,
+	0x01, 0xdc, 0x69, 0xd6, 0x74, 0xd5, 0xa8, 0xd5, 0xf5, 0x4a, 0xad, 0x6a, 0x7c, 0x5e, 0x6d, 0xd4,
+	0xd5, 0xbd, 0xca, 0x7e, 0x45, 0x2d, 0xa7, 0x43, 0x99, 0x95, 0xe1, 0x48, 0x49, 0x70, 0xa0, 0xea,
+	0xe9, 0xa0, 0x3c, 0xac, 0x04, 0xd1, 0x5f, 0xa8, 0x8d, 0xb4, 0x94, 0x49, 0x0d, 0x47, 0xca, 0x12,
+	0x47, 0x7d, 0x81, 0x5d, 0xb4, 0x09, 0xab, 0x41, 0x4c, 0xb1, 0xd4, 0xd0, 0x8b, 0x95, 0x6a, 0x3a,
+	0x9c, 0x79, 0x6f, 0x38, 0x52, 0x52, 0x1c, 0x57, 0x14, 0x5f, 0x2f, 0x0a, 0x2c, 0x07, 0xb1, 0xd5,
+	0x5a, 0x3a, 0x92, 0x49, 0x0e, 0x47, 0x4a, 0x9c, 0xc3, 0xaa, 0x04, 0xed, 0x80, 0x3c, 0x8d, 0x30,
+	0x0e, 0x2b, 0xfa, 0xa7, 0x46, 0x53, 0xd5, 0x6b, 0xe9, 0x68, 0x66, 0x6d, 0x38, 0x52, 0xd2, 0x3e,
+	0xd6, 0xff, 0xca, 0xc8, 0x44, 0xbf, 0xfb, 0x63, 0x36, 0xb4, 0xf9, 0x6d, 0x04, 0x96, 0xa7, 0x7f,
+	0x95, 0xa0, 0x02, 0xfc, 0xa8, 0xae, 0xd5, 0xea, 0xb5, 0x46, 0xf1, 0xc0, 0x68, 0xe8, 0x45, 0xfd,
+	0xf3, 0xc6, 0x8c, 0x61, 0x66, 0x85, 0x83, 0xab, 0x56, 0x17, 0x7d, 0x02, 0xd9, 0x59, 0x7c, 0x59,
+	0xad, 0xd7, 0x1a, 0x15, 0xdd, 0xa8, 0xab, 0x5a, 0xa5, 0x56, 0x4e, 0x4b, 0x99, 0x3b, 0xc3, 0x91,
+	0xb2, 0xca, 0x29, 0xd3, 0xd7, 0xcc, 0xcf, 0xe1, 0xc7, 0xb3, 0xe4, 0x66, 0x4d, 0xaf, 0x54, 0x7f,
+	0xe9, 0x73, 0xc3, 0x99, 0x0f, 0x86, 0x23, 0x05, 0x71, 0x6e, 0x33, 0x58, 0x64, 0x0f, 0xe0, 0x83,
+	0x59, 0x6a, 0xbd, 0xd8, 0x68, 0xa8, 0xe5, 0x74, 0x24, 0x93, 0x1e, 0x8e, 0x94, 0x24, 0xe7, 0xd4,
+	0x4d, 0xd7, 0xc5, 0x6d, 0xf4, 0x21, 0xc8, 0xb3, 0x68, 0x4d, 0xfd, 0x4c, 0xdd, 0xd3, 0xd5, 0x72,
+	0x3a, 0x9a, 0x41, 0xc3, 0x91, 0xb2, 0xcc, 0xf1, 0x1a, 0xfe, 0x0d, 0x6e, 0x51, 0x7c, 0xad, 0xfe,
+	0x7e, 0xb1, 0x72, 0xa0, 0x96, 0xd3, 0x0b, 0x41, 0xfd, 0x7d, 0xd3, 0xea, 0xe2, 0x6b, 0x8d, 0x68,
+	0x6a, 0x53, 0x2d, 0x1e, 0xf8, 0x46, 0x62, 0x41, 0x23, 0x5a, 0xa0, 0x35, 0xf3, 0x9d, 0x28, 0x55,
+	0x9f, 0xbc, 0xcc, 0x86, 0x9e, 0xbf, 0xcc, 0x86, 0x7e, 0x7b, 0x91, 0x0d, 0x3d, 0xb9, 0xc8, 0x4a,
+	0x4f, 0x2f, 0xb2, 0xd2, 0x3f, 0x2f, 0xb2, 0xd2, 0xf7, 0xaf, 0xb2, 0xa1, 0xa7, 0xaf, 0xb2, 0xa1,
+	0xe7, 0xaf, 0xb2, 0xa1, 0x2f, 0xdf, 0x7c, 0xe8, 0x4f, 0xd9, 0xbf, 0x68, 0x58, 0xa1, 0x1f, 0xc5,
+	0xd8, 0x49, 0xfe, 0xe9, 0xff, 0x06, 0x00, 0x2d, 0x5a, 0x75, 0x01, 0xbd, 0x11, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -673,6 +801,19 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if this.CommitReveal != that1.CommitReveal {
+		return false
+	}
+	if that1.RevealEndTime == nil {
+		if this.RevealEndTime != nil {
+			return false
+		}
+	} else if !this.RevealEndTime.Equal(*that1.RevealEndTime) {
+		return false
+	}
+	if this.Proposer != that1.Proposer {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -852,21 +993,48 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
-	if err1 != nil {
-		return 0, err1
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x62
 	}
-	i -= n1
-	i = encodeVarintGov(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x4a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingStartTime):])
+	if m.RevealEndTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RevealEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RevealEndTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.CommitReveal {
+		i--
+		if m.CommitReveal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintGov(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x4a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingStartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGov(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x42
 	if len(m.TotalDeposit) > 0 {
 		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
@@ -882,21 +1050,21 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x3a
 		}
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositEndTime):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintGov(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x32
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositEndTime):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintGov(dAtA, i, uint64(n4))
 	i--
+	dAtA[i] = 0x32
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGov(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x2a
 	{
 		size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
@@ -1050,6 +1218,48 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DepositParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1070,12 +1280,22 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
-	if err7 != nil {
-		return 0, err7
+	{
+		size := m.ProposalCancelRatio.Size()
+		i -= size
+		if _, err := m.ProposalCancelRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n7
-	i = encodeVarintGov(dAtA, i, uint64(n7))
+	i -= n8
+	i = encodeVarintGov(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x12
 	if len(m.MinDeposit) > 0 {
@@ -1115,12 +1335,29 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RevealPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RevealPeriod):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintGov(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintGov(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CommitRevealProposalTypes) > 0 {
+		for iNdEx := len(m.CommitRevealProposalTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CommitRevealProposalTypes[iNdEx])
+			copy(dAtA[i:], m.CommitRevealProposalTypes[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.CommitRevealProposalTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintGov(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1179,6 +1416,78 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalTypeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalTypeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalTypeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Quorum.Size()
+		i -= size
+		if _, err := m.Quorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintGov(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ProposalType) > 0 {
+		i -= len(m.ProposalType)
+		copy(dAtA[i:], m.ProposalType)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1275,6 +1584,17 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	if m.CommitReveal {
+		n += 2
+	}
+	if m.RevealEndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.RevealEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1320,6 +1640,26 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *VoteCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func (m *DepositParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1334,6 +1674,8 @@ func (m *DepositParams) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	l = m.ProposalCancelRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1345,6 +1687,14 @@ func (m *VotingParams) Size() (n int) {
 	_ = l
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	if len(m.CommitRevealProposalTypes) > 0 {
+		for _, s := range m.CommitRevealProposalTypes {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RevealPeriod)
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1363,6 +1713,31 @@ func (m *TallyParams) Size() (n int) {
 	return n
 }
 
+func (m *ProposalTypeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProposalType)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
+	n += 1 + l + sovGov(uint64(l))
+	l = m.Quorum.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2023,7 +2398,95 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitReveal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitReveal = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RevealEndTime == nil {
+				m.RevealEndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.RevealEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
 			if err != nil {
@@ -2384,6 +2847,141 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoteCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DepositParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2480,6 +3078,39 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposalCancelRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2563,6 +3194,71 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealProposalTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitRevealProposalTypes = append(m.CommitRevealProposalTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RevealPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2733,6 +3429,221 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProposalTypeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalTypeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalTypeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37,6 +37,8 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
+//
+// - 0x21<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: VoteCommitment
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -45,7 +47,8 @@ var (
 
 	DepositsKeyPrefix = []byte{0x10}
 
-	VotesKeyPrefix = []byte{0x20}
+	VotesKeyPrefix           = []byte{0x20}
+	VoteCommitmentsKeyPrefix = []byte{0x21}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -107,6 +110,17 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// VoteCommitmentsKey gets the first part of the vote commitments key based on
+// the proposalID
+func VoteCommitmentsKey(proposalID uint64) []byte {
+	return append(VoteCommitmentsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// VoteCommitmentKey key of a specific vote commitment from the store
+func VoteCommitmentKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
+	return append(VoteCommitmentsKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
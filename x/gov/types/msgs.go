@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"fmt"
 
 	"sigs.k8s.io/yaml"
@@ -18,10 +19,14 @@ const (
 	TypeMsgVote           = "vote"
 	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgCommitVote     = "commit_vote"
+	TypeMsgRevealVote     = "reveal_vote"
+	TypeMsgCancelProposal = "cancel_proposal"
 )
 
 var (
 	_, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}
+	_, _, _    sdk.Msg                       = &MsgCommitVote{}, &MsgRevealVote{}, &MsgCancelProposal{}
 	_          types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
@@ -235,13 +240,20 @@ func (msg MsgVoteWeighted) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
 	}
-	if len(msg.Options) == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, WeightedVoteOptions(msg.Options).String())
+
+	return validateWeightedVoteOptions(msg.Options)
+}
+
+// validateWeightedVoteOptions checks that the options of a weighted vote are
+// valid, not duplicated and that their weights sum up to 1.
+func validateWeightedVoteOptions(options WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, options.String())
 	}
 
 	totalWeight := sdk.NewDec(0)
 	usedOptions := make(map[VoteOption]bool)
-	for _, option := range msg.Options {
+	for _, option := range options {
 		if !ValidWeightedVoteOption(option) {
 			return sdkerrors.Wrap(ErrInvalidVote, option.String())
 		}
@@ -280,3 +292,131 @@ func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
 	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
 	return []sdk.AccAddress{voter}
 }
+
+// NewMsgCommitVote creates a message to commit to a vote on a commit-reveal
+// proposal
+//nolint:interfacer
+func NewMsgCommitVote(voter sdk.AccAddress, proposalID uint64, commitment []byte) *MsgCommitVote {
+	return &MsgCommitVote{proposalID, voter.String(), commitment}
+}
+
+// Route implements Msg
+func (msg MsgCommitVote) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCommitVote) Type() string { return TypeMsgCommitVote }
+
+// ValidateBasic implements Msg
+func (msg MsgCommitVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+	if len(msg.Commitment) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidVoteCommitment, "commitment must be a %d bytes hash, got %d bytes", sha256.Size, len(msg.Commitment))
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgCommitVote) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCommitVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCommitVote) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// NewMsgRevealVote creates a message to reveal a committed vote on a
+// commit-reveal proposal
+//nolint:interfacer
+func NewMsgRevealVote(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions, salt []byte) *MsgRevealVote {
+	return &MsgRevealVote{proposalID, voter.String(), options, salt}
+}
+
+// Route implements Msg
+func (msg MsgRevealVote) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgRevealVote) Type() string { return TypeMsgRevealVote }
+
+// ValidateBasic implements Msg
+func (msg MsgRevealVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+	if len(msg.Salt) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVoteCommitment, "salt cannot be empty")
+	}
+
+	return validateWeightedVoteOptions(msg.Options)
+}
+
+// String implements the Stringer interface
+func (msg MsgRevealVote) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgRevealVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgRevealVote) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// NewMsgCancelProposal creates a message to cancel a proposal
+//nolint:interfacer
+func NewMsgCancelProposal(proposer sdk.AccAddress, proposalID uint64) *MsgCancelProposal {
+	return &MsgCancelProposal{proposalID, proposer.String()}
+}
+
+// Route implements Msg
+func (msg MsgCancelProposal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCancelProposal) Type() string { return TypeMsgCancelProposal }
+
+// ValidateBasic implements Msg
+func (msg MsgCancelProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+	if msg.ProposalId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("proposal id cannot be zero")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgCancelProposal) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{proposer}
+}
@@ -174,3 +174,75 @@ func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 		`{"type":"cosmos-sdk/MsgSubmitProposal","value":{"content":{"type":"cosmos-sdk/TextProposal","value":{"description":"abcd","title":"test"}},"initial_deposit":[]}}`,
 		string(bz))
 }
+
+// test ValidateBasic for MsgCommitVote
+func TestMsgCommitVote(t *testing.T) {
+	commitment := VoteCommitmentHash(1, addrs[0], NewNonSplitVoteOption(OptionYes), []byte("salt"))
+
+	tests := []struct {
+		voterAddr  sdk.AccAddress
+		commitment []byte
+		expectPass bool
+	}{
+		{addrs[0], commitment, true},
+		{sdk.AccAddress{}, commitment, false},
+		{addrs[0], nil, false},
+		{addrs[0], commitment[:16], false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCommitVote(tc.voterAddr, 1, tc.commitment)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgRevealVote
+func TestMsgRevealVote(t *testing.T) {
+	tests := []struct {
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		salt       []byte
+		expectPass bool
+	}{
+		{addrs[0], NewNonSplitVoteOption(OptionYes), []byte("salt"), true},
+		{sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), []byte("salt"), false},
+		{addrs[0], NewNonSplitVoteOption(OptionYes), nil, false},
+		{addrs[0], WeightedVoteOptions{}, []byte("salt"), false},
+		{addrs[0], NewNonSplitVoteOption(VoteOption(0x13)), []byte("salt"), false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgRevealVote(tc.voterAddr, 1, tc.options, tc.salt)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposerAddr sdk.AccAddress
+		proposalID   uint64
+		expectPass   bool
+	}{
+		{addrs[0], 1, true},
+		{sdk.AccAddress{}, 1, false},
+		{addrs[0], 0, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCancelProposal(tc.proposerAddr, tc.proposalID)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -17,10 +17,11 @@ const (
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.NewInt(10000000)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens    = sdk.NewInt(10000000)
+	DefaultQuorum              = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold           = sdk.NewDecWithPrec(5, 1)
+	DefaultVetoThreshold       = sdk.NewDecWithPrec(334, 3)
+	DefaultProposalCancelRatio = sdk.NewDecWithPrec(5, 1)
 )
 
 // Parameter store key
@@ -28,6 +29,8 @@ var (
 	ParamStoreKeyDepositParams = []byte("depositparams")
 	ParamStoreKeyVotingParams  = []byte("votingparams")
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
+
+	ParamStoreKeyProposalTypeParams = []byte("proposaltypeparams")
 )
 
 // ParamKeyTable - Key declaration for parameters
@@ -36,6 +39,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 		paramtypes.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyProposalTypeParams, []ProposalTypeParams{}, validateProposalTypeParams),
 	)
 }
 
@@ -49,10 +53,13 @@ func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) Depo
 
 // DefaultDepositParams default parameters for deposits
 func DefaultDepositParams() DepositParams {
-	return NewDepositParams(
+	dp := NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
 	)
+	dp.ProposalCancelRatio = DefaultProposalCancelRatio
+
+	return dp
 }
 
 // String implements stringer insterface
@@ -63,7 +70,26 @@ func (dp DepositParams) String() string {
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		decEqual(dp.ProposalCancelRatio, dp2.ProposalCancelRatio)
+}
+
+// CancelBurnedDeposit returns the part of the given deposit burned when its
+// proposal is canceled.
+func (dp DepositParams) CancelBurnedDeposit(deposit sdk.Coins) sdk.Coins {
+	if dp.ProposalCancelRatio.IsNil() || dp.ProposalCancelRatio.IsZero() {
+		return sdk.NewCoins()
+	}
+
+	burned := sdk.NewCoins()
+	for _, coin := range deposit {
+		amount := coin.Amount.ToDec().Mul(dp.ProposalCancelRatio).TruncateInt()
+		if amount.IsPositive() {
+			burned = burned.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return burned
 }
 
 func validateDepositParams(i interface{}) error {
@@ -78,6 +104,9 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if !v.ProposalCancelRatio.IsNil() && (v.ProposalCancelRatio.IsNegative() || v.ProposalCancelRatio.GT(sdk.OneDec())) {
+		return fmt.Errorf("proposal cancel ratio must be between 0 and 1: %s", v.ProposalCancelRatio)
+	}
 
 	return nil
 }
@@ -149,7 +178,28 @@ func DefaultVotingParams() VotingParams {
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	if len(vp.CommitRevealProposalTypes) != len(other.CommitRevealProposalTypes) {
+		return false
+	}
+	for i, ty := range vp.CommitRevealProposalTypes {
+		if ty != other.CommitRevealProposalTypes[i] {
+			return false
+		}
+	}
+
+	return vp.VotingPeriod == other.VotingPeriod && vp.RevealPeriod == other.RevealPeriod
+}
+
+// IsCommitReveal returns true if the proposals of the given type use
+// commit-reveal voting.
+func (vp VotingParams) IsCommitReveal(proposalType string) bool {
+	for _, ty := range vp.CommitRevealProposalTypes {
+		if ty == proposalType {
+			return true
+		}
+	}
+
+	return false
 }
 
 // String implements stringer interface
@@ -168,9 +218,116 @@ func validateVotingParams(i interface{}) error {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
 
+	seenTypes := make(map[string]bool)
+	for _, ty := range v.CommitRevealProposalTypes {
+		if ty == "" {
+			return fmt.Errorf("commit-reveal proposal type cannot be empty")
+		}
+		if seenTypes[ty] {
+			return fmt.Errorf("duplicate commit-reveal proposal type %s", ty)
+		}
+		seenTypes[ty] = true
+	}
+
+	if v.RevealPeriod < 0 {
+		return fmt.Errorf("reveal period cannot be negative: %s", v.RevealPeriod)
+	}
+	if len(v.CommitRevealProposalTypes) > 0 && v.RevealPeriod == 0 {
+		return fmt.Errorf("reveal period must be positive if proposals use commit-reveal voting")
+	}
+
+	return nil
+}
+
+// String implements stringer interface
+func (p ProposalTypeParams) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Equal checks equality of ProposalTypeParams
+func (p ProposalTypeParams) Equal(other ProposalTypeParams) bool {
+	return p.ProposalType == other.ProposalType && p.MinDeposit.IsEqual(other.MinDeposit) &&
+		p.VotingPeriod == other.VotingPeriod && decEqual(p.Quorum, other.Quorum) &&
+		decEqual(p.Threshold, other.Threshold)
+}
+
+// ResolveParams returns the deposit, voting and tally params of the proposals
+// of the type of p: the given global params, overridden by the params set in p.
+func (p ProposalTypeParams) ResolveParams(dp DepositParams, vp VotingParams, tp TallyParams) (DepositParams, VotingParams, TallyParams) {
+	if !p.MinDeposit.Empty() {
+		dp.MinDeposit = p.MinDeposit
+	}
+	if p.VotingPeriod != 0 {
+		vp.VotingPeriod = p.VotingPeriod
+	}
+	if !p.Quorum.IsNil() && !p.Quorum.IsZero() {
+		tp.Quorum = p.Quorum
+	}
+	if !p.Threshold.IsNil() && !p.Threshold.IsZero() {
+		tp.Threshold = p.Threshold
+	}
+
+	return dp, vp, tp
+}
+
+// FindProposalTypeParams returns the params of the given proposal type among
+// the given ones, if any.
+func FindProposalTypeParams(params []ProposalTypeParams, proposalType string) (ProposalTypeParams, bool) {
+	for _, p := range params {
+		if p.ProposalType == proposalType {
+			return p, true
+		}
+	}
+
+	return ProposalTypeParams{}, false
+}
+
+func validateProposalTypeParams(i interface{}) error {
+	v, ok := i.([]ProposalTypeParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenTypes := make(map[string]bool)
+	for _, p := range v {
+		if p.ProposalType == "" {
+			return fmt.Errorf("proposal type cannot be empty")
+		}
+		if seenTypes[p.ProposalType] {
+			return fmt.Errorf("duplicate params of proposal type %s", p.ProposalType)
+		}
+		seenTypes[p.ProposalType] = true
+
+		if !p.MinDeposit.IsValid() {
+			return fmt.Errorf("invalid minimum deposit of proposal type %s: %s", p.ProposalType, p.MinDeposit)
+		}
+		if p.VotingPeriod < 0 {
+			return fmt.Errorf("voting period of proposal type %s cannot be negative: %s", p.ProposalType, p.VotingPeriod)
+		}
+		if !p.Quorum.IsNil() && (p.Quorum.IsNegative() || p.Quorum.GT(sdk.OneDec())) {
+			return fmt.Errorf("quorum of proposal type %s must be between 0 and 1: %s", p.ProposalType, p.Quorum)
+		}
+		if !p.Threshold.IsNil() && (p.Threshold.IsNegative() || p.Threshold.GT(sdk.OneDec())) {
+			return fmt.Errorf("vote threshold of proposal type %s must be between 0 and 1: %s", p.ProposalType, p.Threshold)
+		}
+	}
+
 	return nil
 }
 
+// decEqual checks equality of optional decimals, the nil decimal being zero.
+func decEqual(a, b sdk.Dec) bool {
+	if a.IsNil() {
+		a = sdk.ZeroDec()
+	}
+	if b.IsNil() {
+		b = sdk.ZeroDec()
+	}
+
+	return a.Equal(b)
+}
+
 // Params returns all of the governance params
 type Params struct {
 	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
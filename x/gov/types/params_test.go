@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateProposalTypeParams(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	tests := []struct {
+		params     []ProposalTypeParams
+		expectPass bool
+	}{
+		{nil, true},
+		{[]ProposalTypeParams{{ProposalType: ProposalTypeText}}, true},
+		{[]ProposalTypeParams{{ProposalType: ProposalTypeText, MinDeposit: coins, VotingPeriod: time.Hour, Quorum: sdk.NewDecWithPrec(5, 1), Threshold: sdk.OneDec()}}, true},
+		{[]ProposalTypeParams{{ProposalType: ""}}, false},
+		{[]ProposalTypeParams{{ProposalType: ProposalTypeText}, {ProposalType: ProposalTypeText}}, false},
+		{[]ProposalTypeParams{{ProposalType: ProposalTypeText, MinDeposit: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}}}, false},
+		{[]ProposalTypeParams{{ProposalType: ProposalTypeText, VotingPeriod: -time.Hour}}, false},
+		{[]ProposalTypeParams{{ProposalType: ProposalTypeText, Quorum: sdk.NewDec(2)}}, false},
+		{[]ProposalTypeParams{{ProposalType: ProposalTypeText, Threshold: sdk.NewDec(-1)}}, false},
+	}
+
+	for i, tc := range tests {
+		err := validateProposalTypeParams(tc.params)
+		if tc.expectPass {
+			require.NoError(t, err, "test: %v", i)
+		} else {
+			require.Error(t, err, "test: %v", i)
+		}
+	}
+}
+
+func TestProposalTypeParamsResolveParams(t *testing.T) {
+	dp, vp, tp := DefaultDepositParams(), DefaultVotingParams(), DefaultTallyParams()
+
+	// the params left empty are the global ones
+	rdp, rvp, rtp := ProposalTypeParams{ProposalType: ProposalTypeText}.ResolveParams(dp, vp, tp)
+	require.Equal(t, dp, rdp)
+	require.Equal(t, vp, rvp)
+	require.Equal(t, tp, rtp)
+
+	p := ProposalTypeParams{
+		ProposalType: ProposalTypeText,
+		MinDeposit:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+		VotingPeriod: time.Hour,
+		Quorum:       sdk.NewDecWithPrec(5, 1),
+		Threshold:    sdk.NewDecWithPrec(67, 2),
+	}
+	rdp, rvp, rtp = p.ResolveParams(dp, vp, tp)
+	expDeposit := dp
+	expDeposit.MinDeposit = p.MinDeposit
+	require.Equal(t, expDeposit, rdp)
+	require.Equal(t, NewVotingParams(time.Hour), rvp)
+	require.Equal(t, NewTallyParams(p.Quorum, p.Threshold, tp.VetoThreshold), rtp)
+}
@@ -20,6 +20,8 @@ const (
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
+
+	ParamProposalTypes = "proposal_types"
 )
 
 // QueryProposalParams Params for queries:
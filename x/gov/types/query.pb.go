@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -422,7 +423,7 @@ func (m *QueryVotesResponse) GetPagination() *query.PageResponse {
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 	// params_type defines which parameters to query for, can be one of "voting",
-	// "tallying" or "deposit".
+	// "tallying", "deposit" or "proposal_types".
 	ParamsType string `protobuf:"bytes,1,opt,name=params_type,json=paramsType,proto3" json:"params_type,omitempty"`
 }
 
@@ -474,6 +475,9 @@ type QueryParamsResponse struct {
 	DepositParams DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params"`
 	// tally_params defines the parameters related to tally.
 	TallyParams TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params"`
+	// proposal_type_params defines the parameters overriding the deposit, voting
+	// and tally parameters for the proposals of some types.
+	ProposalTypeParams []ProposalTypeParams `protobuf:"bytes,4,rep,name=proposal_type_params,json=proposalTypeParams,proto3" json:"proposal_type_params"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -530,6 +534,13 @@ func (m *QueryParamsResponse) GetTallyParams() TallyParams {
 	return TallyParams{}
 }
 
+func (m *QueryParamsResponse) GetProposalTypeParams() []ProposalTypeParams {
+	if m != nil {
+		return m.ProposalTypeParams
+	}
+	return nil
+}
+
 // QueryDepositRequest is the request type for the Query/Deposit RPC method.
 type QueryDepositRequest struct {
 	// proposal_id defines the unique id of the proposal.
@@ -818,6 +829,320 @@ func (m *QueryTallyResultResponse) GetTally() TallyResult {
 	return TallyResult{}
 }
 
+// QueryVoteCommitmentsRequest is the request type for the Query/VoteCommitments
+// RPC method.
+type QueryVoteCommitmentsRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteCommitmentsRequest) Reset()         { *m = QueryVoteCommitmentsRequest{} }
+func (m *QueryVoteCommitmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteCommitmentsRequest) ProtoMessage()    {}
+func (*QueryVoteCommitmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{16}
+}
+func (m *QueryVoteCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteCommitmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteCommitmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteCommitmentsRequest.Merge(m, src)
+}
+func (m *QueryVoteCommitmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteCommitmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteCommitmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteCommitmentsRequest proto.InternalMessageInfo
+
+func (m *QueryVoteCommitmentsRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *QueryVoteCommitmentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVoteCommitmentsResponse is the response type for the
+// Query/VoteCommitments RPC method.
+type QueryVoteCommitmentsResponse struct {
+	// vote_commitments defines the queried vote commitments.
+	VoteCommitments []VoteCommitment `protobuf:"bytes,1,rep,name=vote_commitments,json=voteCommitments,proto3" json:"vote_commitments"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVoteCommitmentsResponse) Reset()         { *m = QueryVoteCommitmentsResponse{} }
+func (m *QueryVoteCommitmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteCommitmentsResponse) ProtoMessage()    {}
+func (*QueryVoteCommitmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{17}
+}
+func (m *QueryVoteCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteCommitmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVoteCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteCommitmentsResponse.Merge(m, src)
+}
+func (m *QueryVoteCommitmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteCommitmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVoteCommitmentsResponse proto.InternalMessageInfo
+
+func (m *QueryVoteCommitmentsResponse) GetVoteCommitments() []VoteCommitment {
+	if m != nil {
+		return m.VoteCommitments
+	}
+	return nil
+}
+
+func (m *QueryVoteCommitmentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVotingPowerRequest is the request type for the Query/VotingPower RPC
+// method.
+type QueryVotingPowerRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter defines the voter address.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (m *QueryVotingPowerRequest) Reset()         { *m = QueryVotingPowerRequest{} }
+func (m *QueryVotingPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerRequest) ProtoMessage()    {}
+func (*QueryVotingPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{18}
+}
+func (m *QueryVotingPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerRequest.Merge(m, src)
+}
+func (m *QueryVotingPowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowerRequest proto.InternalMessageInfo
+
+// QueryVotingPowerResponse is the response type for the Query/VotingPower RPC
+// method.
+type QueryVotingPowerResponse struct {
+	// voting_power is the total voting power of the voter, the sum of the
+	// delegated and validator voting powers.
+	VotingPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"voting_power"`
+	// delegated_voting_power is the voting power of the delegations of the voter
+	// to bonded validators.
+	DelegatedVotingPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=delegated_voting_power,json=delegatedVotingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegated_voting_power"`
+	// validator_voting_power is the voting power the voter inherits as the
+	// operator of a bonded validator, from the delegations of the delegators
+	// which haven't overridden its vote with their own.
+	ValidatorVotingPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=validator_voting_power,json=validatorVotingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_voting_power"`
+}
+
+func (m *QueryVotingPowerResponse) Reset()         { *m = QueryVotingPowerResponse{} }
+func (m *QueryVotingPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerResponse) ProtoMessage()    {}
+func (*QueryVotingPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{19}
+}
+func (m *QueryVotingPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerResponse.Merge(m, src)
+}
+func (m *QueryVotingPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowerResponse proto.InternalMessageInfo
+
+// QueryProposalTypeParamsRequest is the request type for the
+// Query/ProposalTypeParams RPC method.
+type QueryProposalTypeParamsRequest struct {
+	// proposal_type defines the type of the proposals, e.g. "Text".
+	ProposalType string `protobuf:"bytes,1,opt,name=proposal_type,json=proposalType,proto3" json:"proposal_type,omitempty"`
+}
+
+func (m *QueryProposalTypeParamsRequest) Reset()         { *m = QueryProposalTypeParamsRequest{} }
+func (m *QueryProposalTypeParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalTypeParamsRequest) ProtoMessage()    {}
+func (*QueryProposalTypeParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{20}
+}
+func (m *QueryProposalTypeParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalTypeParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalTypeParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalTypeParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalTypeParamsRequest.Merge(m, src)
+}
+func (m *QueryProposalTypeParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalTypeParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalTypeParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalTypeParamsRequest proto.InternalMessageInfo
+
+func (m *QueryProposalTypeParamsRequest) GetProposalType() string {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ""
+}
+
+// QueryProposalTypeParamsResponse is the response type for the
+// Query/ProposalTypeParams RPC method.
+type QueryProposalTypeParamsResponse struct {
+	// voting_params defines the parameters related to voting.
+	VotingParams VotingParams `protobuf:"bytes,1,opt,name=voting_params,json=votingParams,proto3" json:"voting_params"`
+	// deposit_params defines the parameters related to deposit.
+	DepositParams DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params"`
+	// tally_params defines the parameters related to tally.
+	TallyParams TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params"`
+}
+
+func (m *QueryProposalTypeParamsResponse) Reset()         { *m = QueryProposalTypeParamsResponse{} }
+func (m *QueryProposalTypeParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalTypeParamsResponse) ProtoMessage()    {}
+func (*QueryProposalTypeParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{21}
+}
+func (m *QueryProposalTypeParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalTypeParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalTypeParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalTypeParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalTypeParamsResponse.Merge(m, src)
+}
+func (m *QueryProposalTypeParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalTypeParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalTypeParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalTypeParamsResponse proto.InternalMessageInfo
+
+func (m *QueryProposalTypeParamsResponse) GetVotingParams() VotingParams {
+	if m != nil {
+		return m.VotingParams
+	}
+	return VotingParams{}
+}
+
+func (m *QueryProposalTypeParamsResponse) GetDepositParams() DepositParams {
+	if m != nil {
+		return m.DepositParams
+	}
+	return DepositParams{}
+}
+
+func (m *QueryProposalTypeParamsResponse) GetTallyParams() TallyParams {
+	if m != nil {
+		return m.TallyParams
+	}
+	return TallyParams{}
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1beta1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1beta1.QueryProposalResponse")
@@ -835,75 +1160,99 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1beta1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1beta1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1beta1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryVoteCommitmentsRequest)(nil), "cosmos.gov.v1beta1.QueryVoteCommitmentsRequest")
+	proto.RegisterType((*QueryVoteCommitmentsResponse)(nil), "cosmos.gov.v1beta1.QueryVoteCommitmentsResponse")
+	proto.RegisterType((*QueryVotingPowerRequest)(nil), "cosmos.gov.v1beta1.QueryVotingPowerRequest")
+	proto.RegisterType((*QueryVotingPowerResponse)(nil), "cosmos.gov.v1beta1.QueryVotingPowerResponse")
+	proto.RegisterType((*QueryProposalTypeParamsRequest)(nil), "cosmos.gov.v1beta1.QueryProposalTypeParamsRequest")
+	proto.RegisterType((*QueryProposalTypeParamsResponse)(nil), "cosmos.gov.v1beta1.QueryProposalTypeParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/query.proto", fileDescriptor_e35c0d133e91c0a2) }
 
 var fileDescriptor_e35c0d133e91c0a2 = []byte{
-	// 994 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x24, 0x4e, 0x6b, 0xbf, 0xb4, 0x01, 0x1e, 0x01, 0x8c, 0x29, 0x76, 0x58, 0xd1, 0xd6,
-	0xa4, 0xc4, 0x4b, 0x92, 0x52, 0xd4, 0x16, 0x50, 0x6b, 0xa1, 0xb6, 0xa8, 0x12, 0x2a, 0x4e, 0x05,
-	0x12, 0x97, 0x68, 0x53, 0xaf, 0x96, 0x15, 0x8e, 0x67, 0xeb, 0x19, 0x5b, 0x8d, 0x42, 0x84, 0xc4,
-	0x09, 0xc4, 0x05, 0x54, 0xc4, 0x0d, 0xa8, 0x54, 0x89, 0x5f, 0xc0, 0x8f, 0xe8, 0xb1, 0x02, 0x0e,
-	0x9c, 0x10, 0x4a, 0x38, 0xf0, 0x23, 0x38, 0xa0, 0x9d, 0x79, 0xb3, 0xde, 0x4d, 0xd6, 0xd9, 0x75,
-	0xa9, 0x38, 0xc5, 0x9e, 0xf9, 0xbe, 0xf7, 0xbe, 0xf7, 0xcd, 0x9b, 0x37, 0x0e, 0xd4, 0x6e, 0x71,
-	0xb1, 0xc9, 0x85, 0xed, 0xf1, 0xa1, 0x3d, 0x5c, 0xde, 0x70, 0xa5, 0xb3, 0x6c, 0xdf, 0x1e, 0xb8,
-	0xfd, 0xad, 0x66, 0xd0, 0xe7, 0x92, 0x23, 0xea, 0xfd, 0xa6, 0xc7, 0x87, 0x4d, 0xda, 0xaf, 0x2e,
-	0x12, 0x67, 0xc3, 0x11, 0xae, 0x06, 0x47, 0xd4, 0xc0, 0xf1, 0xfc, 0x9e, 0x23, 0x7d, 0xde, 0xd3,
-	0xfc, 0xea, 0xbc, 0xc7, 0x3d, 0xae, 0x3e, 0xda, 0xe1, 0x27, 0x5a, 0x3d, 0xe1, 0x71, 0xee, 0x75,
-	0x5d, 0xdb, 0x09, 0x7c, 0xdb, 0xe9, 0xf5, 0xb8, 0x54, 0x14, 0x61, 0x76, 0x53, 0x34, 0x85, 0xf9,
-	0xf5, 0xee, 0xf3, 0x7a, 0x77, 0x5d, 0x07, 0x25, 0x79, 0xea, 0x8b, 0xf5, 0x06, 0xcc, 0xbf, 0x1f,
-	0xca, 0xb9, 0xd1, 0xe7, 0x01, 0x17, 0x4e, 0xb7, 0xed, 0xde, 0x1e, 0xb8, 0x42, 0x62, 0x1d, 0x66,
-	0x03, 0x5a, 0x5a, 0xf7, 0x3b, 0x15, 0xb6, 0xc0, 0x1a, 0xc5, 0x36, 0x98, 0xa5, 0x77, 0x3b, 0xd6,
-	0x87, 0xf0, 0xcc, 0x3e, 0xa2, 0x08, 0x78, 0x4f, 0xb8, 0xf8, 0x36, 0x94, 0x0c, 0x4c, 0xd1, 0x66,
-	0x57, 0x4e, 0x34, 0x0f, 0x3a, 0xd2, 0x34, 0xbc, 0x56, 0xf1, 0xc1, 0x1f, 0xf5, 0x42, 0x3b, 0xe2,
-	0x58, 0x3f, 0x4c, 0xed, 0x8b, 0x2c, 0x8c, 0xa6, 0xeb, 0xf0, 0x44, 0xa4, 0x49, 0x48, 0x47, 0x0e,
-	0x84, 0x4a, 0x30, 0xb7, 0x62, 0x1d, 0x96, 0x60, 0x4d, 0x21, 0xdb, 0x73, 0x41, 0xe2, 0x3b, 0x36,
-	0x61, 0x66, 0xc8, 0xa5, 0xdb, 0xaf, 0x4c, 0x2d, 0xb0, 0x46, 0xb9, 0x55, 0xf9, 0xe5, 0xe7, 0xa5,
-	0x79, 0x8a, 0x72, 0xb9, 0xd3, 0xe9, 0xbb, 0x42, 0xac, 0xc9, 0xbe, 0xdf, 0xf3, 0xda, 0x1a, 0x86,
-	0xe7, 0xa0, 0xdc, 0x71, 0x03, 0x2e, 0x7c, 0xc9, 0xfb, 0x95, 0xe9, 0x0c, 0xce, 0x08, 0x8a, 0x57,
-	0x00, 0x46, 0x27, 0x5c, 0x29, 0x2a, 0x43, 0x4e, 0x19, 0xbd, 0x61, 0x3b, 0x34, 0x75, 0xef, 0x44,
-	0xb2, 0x1d, 0xcf, 0xa5, 0x82, 0xdb, 0x31, 0xe6, 0x85, 0xd2, 0x17, 0xf7, 0xea, 0x85, 0xbf, 0xef,
-	0xd5, 0x0b, 0xd6, 0x7d, 0x06, 0xcf, 0xee, 0x37, 0x88, 0xbc, 0xbf, 0x04, 0x65, 0x53, 0x66, 0xe8,
-	0xcd, 0x74, 0x4e, 0xf3, 0x47, 0x24, 0xbc, 0x9a, 0x90, 0x3b, 0xa5, 0xe4, 0x9e, 0xce, 0x94, 0xab,
-	0xd3, 0xc7, 0xf5, 0x5a, 0x9b, 0xf0, 0xa4, 0x12, 0xf9, 0x01, 0x97, 0x6e, 0xde, 0xa6, 0x9a, 0xf4,
-	0x50, 0x62, 0xa6, 0x5c, 0x85, 0xa7, 0x62, 0xe9, 0xc8, 0x8e, 0x15, 0x28, 0x86, 0x38, 0x6a, 0xc3,
-	0x4a, 0x9a, 0x13, 0x21, 0x9e, 0x5c, 0x50, 0x58, 0xeb, 0xd3, 0x58, 0x20, 0x91, 0x5b, 0xf8, 0x95,
-	0x14, 0xdb, 0x1e, 0xe1, 0x94, 0xad, 0xbb, 0x0c, 0x30, 0x9e, 0x9e, 0x0a, 0x39, 0xab, 0x7d, 0x31,
-	0x67, 0x9a, 0x55, 0x89, 0x06, 0x3f, 0xbe, 0xb3, 0x7c, 0x9d, 0x44, 0xdd, 0x70, 0xfa, 0xce, 0x66,
-	0xc2, 0x14, 0xb5, 0xb0, 0x2e, 0xb7, 0x02, 0x6d, 0x72, 0x39, 0xa4, 0x85, 0x4b, 0x37, 0xb7, 0x02,
-	0xd7, 0xfa, 0x87, 0xc1, 0xd3, 0x09, 0x1e, 0x55, 0x73, 0x1d, 0x8e, 0x0f, 0xb9, 0xf4, 0x7b, 0xde,
-	0xba, 0x06, 0xd3, 0xf9, 0x2c, 0x8c, 0xa9, 0xca, 0xef, 0x79, 0x3a, 0x00, 0x55, 0x77, 0x6c, 0x18,
-	0x5b, 0xc3, 0xf7, 0x60, 0x8e, 0x2e, 0x9b, 0x89, 0xa6, 0x0b, 0x7d, 0x29, 0x2d, 0xda, 0x3b, 0x1a,
-	0x99, 0x08, 0x77, 0xbc, 0x13, 0x5f, 0xc4, 0x6b, 0x70, 0x4c, 0x3a, 0xdd, 0xee, 0x96, 0x89, 0x36,
-	0xad, 0xa2, 0xd5, 0xd3, 0xa2, 0xdd, 0x0c, 0x71, 0x89, 0x58, 0xb3, 0x72, 0xb4, 0x64, 0xdd, 0xa1,
-	0xea, 0x29, 0x69, 0xee, 0x5e, 0x4a, 0x4c, 0x9a, 0xa9, 0xdc, 0x93, 0x26, 0x76, 0x19, 0xd6, 0x68,
-	0xa8, 0x47, 0x99, 0xc9, 0xf8, 0x8b, 0x70, 0x94, 0xe0, 0x64, 0xf9, 0x0b, 0x87, 0x98, 0x44, 0x25,
-	0x19, 0x86, 0xf5, 0x59, 0x32, 0xe8, 0xff, 0x7f, 0x37, 0x7e, 0x64, 0xf4, 0x30, 0x8c, 0x14, 0x50,
-	0x5d, 0x6f, 0x41, 0x89, 0x54, 0x9a, 0x1b, 0x92, 0xa3, 0xb0, 0x88, 0xf2, 0xf8, 0xee, 0xc9, 0x05,
-	0x78, 0x4e, 0x09, 0x54, 0x8d, 0xd1, 0x76, 0xc5, 0xa0, 0x2b, 0x27, 0x78, 0x4f, 0x2b, 0x07, 0xb9,
-	0xd1, 0xb9, 0xcd, 0xa8, 0xc6, 0xa2, 0x53, 0x1b, 0xdf, 0x8c, 0x9a, 0x67, 0xa6, 0x80, 0xe2, 0xac,
-	0xfc, 0x56, 0x86, 0x19, 0x15, 0x19, 0xbf, 0x65, 0x50, 0x32, 0x93, 0x1f, 0x1b, 0x69, 0x41, 0xd2,
-	0x7e, 0x0a, 0x54, 0x5f, 0xc9, 0x81, 0xd4, 0x42, 0xad, 0xd5, 0xcf, 0x7f, 0xfd, 0xeb, 0xee, 0xd4,
-	0x12, 0x9e, 0xb1, 0x53, 0x7e, 0x8f, 0x44, 0x8f, 0x8c, 0xbd, 0x1d, 0xb3, 0x62, 0x07, 0xbf, 0x64,
-	0x50, 0x8e, 0x9e, 0x32, 0xcc, 0xce, 0x66, 0x3a, 0xaf, 0xba, 0x98, 0x07, 0x4a, 0xca, 0x4e, 0x2a,
-	0x65, 0x75, 0x7c, 0xf1, 0x50, 0x65, 0xf8, 0x1d, 0x83, 0x62, 0x38, 0x48, 0xf1, 0xe5, 0xb1, 0xb1,
-	0x63, 0x0f, 0x5a, 0xf5, 0x64, 0x06, 0x8a, 0x92, 0x5f, 0x56, 0xc9, 0x2f, 0xe2, 0xf9, 0x09, 0x6c,
-	0xb1, 0xd5, 0x0c, 0xb7, 0xb7, 0xd5, 0x43, 0xb7, 0x83, 0xdf, 0x30, 0x98, 0x51, 0x6f, 0x02, 0x1e,
-	0x9e, 0x33, 0x32, 0xe7, 0x54, 0x16, 0x8c, 0xb4, 0x9d, 0x57, 0xda, 0x56, 0x71, 0x79, 0x62, 0x6d,
-	0xf8, 0x15, 0x83, 0x23, 0x34, 0x35, 0xc7, 0x67, 0x4b, 0xbc, 0x19, 0xd5, 0xd3, 0x99, 0x38, 0x92,
-	0xf5, 0x9a, 0x92, 0xb5, 0x88, 0x8d, 0x54, 0x59, 0x0a, 0x6b, 0x6f, 0xc7, 0x9e, 0x9f, 0x1d, 0xfc,
-	0x89, 0xc1, 0x51, 0xba, 0xe1, 0x38, 0x3e, 0x4d, 0x72, 0x18, 0x57, 0x1b, 0xd9, 0x40, 0x12, 0x74,
-	0x4d, 0x09, 0x6a, 0xe1, 0xa5, 0x49, 0x7c, 0x32, 0x23, 0xc6, 0xde, 0x8e, 0xc6, 0xf4, 0x0e, 0x7e,
-	0xcf, 0xa0, 0x64, 0x46, 0x18, 0x66, 0x0a, 0x10, 0xd9, 0xd7, 0x70, 0xff, 0x3c, 0xb4, 0xde, 0x54,
-	0x5a, 0xcf, 0xe1, 0xd9, 0x47, 0xd1, 0x8a, 0xf7, 0x19, 0xcc, 0xc6, 0xa6, 0x09, 0x9e, 0x19, 0x9b,
-	0xf8, 0xe0, 0x9c, 0xab, 0xbe, 0x9a, 0x0f, 0xfc, 0x5f, 0x9a, 0x4f, 0x8d, 0xb5, 0x56, 0xeb, 0xc1,
-	0x6e, 0x8d, 0x3d, 0xdc, 0xad, 0xb1, 0x3f, 0x77, 0x6b, 0xec, 0xeb, 0xbd, 0x5a, 0xe1, 0xe1, 0x5e,
-	0xad, 0xf0, 0xfb, 0x5e, 0xad, 0xf0, 0x51, 0xc3, 0xf3, 0xe5, 0xc7, 0x83, 0x8d, 0xe6, 0x2d, 0xbe,
-	0x69, 0xc2, 0xea, 0x3f, 0x4b, 0xa2, 0xf3, 0x89, 0x7d, 0x47, 0xe5, 0x08, 0x5b, 0x46, 0x6c, 0x1c,
-	0x51, 0xff, 0x03, 0xad, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x12, 0x1c, 0x27, 0xd2, 0x0d,
-	0x00, 0x00,
+	// 1287 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x38, 0x4e, 0x9b, 0x3c, 0xa7, 0x69, 0x19, 0xdc, 0x62, 0xdc, 0x62, 0x87, 0x85, 0xa6,
+	0x26, 0x69, 0xbc, 0x4d, 0x52, 0x8a, 0xda, 0x86, 0xaa, 0x35, 0xa1, 0x2d, 0xaa, 0x84, 0x8a, 0x53,
+	0x15, 0x89, 0x03, 0xd6, 0x26, 0x1e, 0x2d, 0x16, 0xb6, 0xc7, 0xdd, 0x99, 0x98, 0x46, 0x21, 0x42,
+	0xe2, 0x80, 0x5a, 0x71, 0x01, 0x15, 0x71, 0x03, 0x2a, 0x55, 0xe2, 0x03, 0xa0, 0x9e, 0x10, 0x07,
+	0x8e, 0x3d, 0x56, 0xe5, 0x82, 0x10, 0xaa, 0x50, 0xc2, 0x81, 0x8f, 0x81, 0x76, 0xf6, 0xed, 0x7a,
+	0xd7, 0xff, 0x76, 0xdd, 0x46, 0xe5, 0xc0, 0x29, 0xf6, 0xcc, 0x7b, 0xbf, 0xf7, 0x7b, 0x7f, 0xe7,
+	0x39, 0x90, 0x5d, 0xe3, 0xa2, 0xce, 0x85, 0x6e, 0xf2, 0x96, 0xde, 0x9a, 0x5f, 0x65, 0xd2, 0x98,
+	0xd7, 0x6f, 0xac, 0x33, 0x6b, 0xa3, 0xd0, 0xb4, 0xb8, 0xe4, 0x94, 0x3a, 0xf7, 0x05, 0x93, 0xb7,
+	0x0a, 0x78, 0x9f, 0x99, 0x41, 0x9d, 0x55, 0x43, 0x30, 0x47, 0xd8, 0x53, 0x6d, 0x1a, 0x66, 0xb5,
+	0x61, 0xc8, 0x2a, 0x6f, 0x38, 0xfa, 0x99, 0x94, 0xc9, 0x4d, 0xae, 0x3e, 0xea, 0xf6, 0x27, 0x3c,
+	0x3d, 0x62, 0x72, 0x6e, 0xd6, 0x98, 0x6e, 0x34, 0xab, 0xba, 0xd1, 0x68, 0x70, 0xa9, 0x54, 0x84,
+	0x7b, 0xdb, 0x83, 0x93, 0x6d, 0xdf, 0xb9, 0x7d, 0xd1, 0xb9, 0x2d, 0x3b, 0xa0, 0x48, 0x4f, 0x7d,
+	0xd1, 0xde, 0x80, 0xd4, 0x7b, 0x36, 0x9d, 0xab, 0x16, 0x6f, 0x72, 0x61, 0xd4, 0x4a, 0xec, 0xc6,
+	0x3a, 0x13, 0x92, 0xe6, 0x20, 0xd9, 0xc4, 0xa3, 0x72, 0xb5, 0x92, 0x26, 0x53, 0x24, 0x9f, 0x28,
+	0x81, 0x7b, 0xf4, 0x4e, 0x45, 0x7b, 0x1f, 0x0e, 0x76, 0x28, 0x8a, 0x26, 0x6f, 0x08, 0x46, 0xcf,
+	0xc1, 0x98, 0x2b, 0xa6, 0xd4, 0x92, 0x0b, 0x47, 0x0a, 0xdd, 0x11, 0x29, 0xb8, 0x7a, 0xc5, 0xc4,
+	0x83, 0xc7, 0xb9, 0x58, 0xc9, 0xd3, 0xd1, 0xbe, 0x8f, 0x77, 0x20, 0x0b, 0x97, 0xd3, 0x15, 0xd8,
+	0xef, 0x71, 0x12, 0xd2, 0x90, 0xeb, 0x42, 0x19, 0x98, 0x5c, 0xd0, 0x06, 0x19, 0x58, 0x51, 0x92,
+	0xa5, 0xc9, 0x66, 0xe0, 0x3b, 0x2d, 0xc0, 0x68, 0x8b, 0x4b, 0x66, 0xa5, 0xe3, 0x53, 0x24, 0x3f,
+	0x5e, 0x4c, 0x3f, 0xba, 0x3f, 0x97, 0x42, 0x94, 0x0b, 0x95, 0x8a, 0xc5, 0x84, 0x58, 0x91, 0x56,
+	0xb5, 0x61, 0x96, 0x1c, 0x31, 0x7a, 0x0a, 0xc6, 0x2b, 0xac, 0xc9, 0x45, 0x55, 0x72, 0x2b, 0x3d,
+	0x12, 0xa2, 0xd3, 0x16, 0xa5, 0x17, 0x01, 0xda, 0x19, 0x4e, 0x27, 0x54, 0x40, 0xa6, 0x5d, 0xbe,
+	0x76, 0x39, 0x14, 0x9c, 0xda, 0xf1, 0x68, 0x1b, 0x26, 0x43, 0x87, 0x4b, 0x3e, 0xcd, 0x33, 0x63,
+	0xb7, 0xee, 0xe6, 0x62, 0xff, 0xdc, 0xcd, 0xc5, 0xb4, 0x7b, 0x04, 0x0e, 0x75, 0x06, 0x08, 0x63,
+	0x7f, 0x1e, 0xc6, 0x5d, 0x37, 0xed, 0xd8, 0x8c, 0x44, 0x0c, 0x7e, 0x5b, 0x89, 0x5e, 0x0a, 0xd0,
+	0x8d, 0x2b, 0xba, 0xc7, 0x42, 0xe9, 0x3a, 0xe6, 0xfd, 0x7c, 0xb5, 0x3a, 0x1c, 0x50, 0x24, 0xaf,
+	0x73, 0xc9, 0xa2, 0x16, 0xd5, 0xb0, 0x49, 0xf1, 0x05, 0xe5, 0x12, 0x3c, 0xe7, 0x33, 0x87, 0xe1,
+	0x58, 0x80, 0x84, 0x2d, 0x87, 0x65, 0x98, 0xee, 0x15, 0x09, 0x5b, 0x1e, 0xa3, 0xa0, 0x64, 0xb5,
+	0x4f, 0x7d, 0x40, 0x22, 0x32, 0xf1, 0x8b, 0x3d, 0xc2, 0xf6, 0x04, 0x59, 0xd6, 0xee, 0x10, 0xa0,
+	0x7e, 0xf3, 0xe8, 0xc8, 0x49, 0x27, 0x2e, 0x6e, 0x4e, 0xc3, 0x3c, 0x71, 0x84, 0x77, 0x2f, 0x97,
+	0xaf, 0x23, 0xa9, 0xab, 0x86, 0x65, 0xd4, 0x03, 0x41, 0x51, 0x07, 0x65, 0xb9, 0xd1, 0x74, 0x82,
+	0x3c, 0x5e, 0x02, 0xe7, 0xe8, 0xda, 0x46, 0x93, 0x69, 0x7f, 0xc6, 0xe1, 0xf9, 0x80, 0x1e, 0x7a,
+	0x73, 0x05, 0xf6, 0xb5, 0xb8, 0xac, 0x36, 0xcc, 0xb2, 0x23, 0x8c, 0xf9, 0x99, 0xea, 0xe3, 0x55,
+	0xb5, 0x61, 0x3a, 0x00, 0xe8, 0xdd, 0x44, 0xcb, 0x77, 0x46, 0xdf, 0x85, 0x49, 0x6c, 0x36, 0x17,
+	0xcd, 0x71, 0xf4, 0xe5, 0x5e, 0x68, 0xcb, 0x8e, 0x64, 0x00, 0x6e, 0x5f, 0xc5, 0x7f, 0x48, 0x2f,
+	0xc3, 0x84, 0x34, 0x6a, 0xb5, 0x0d, 0x17, 0x6d, 0x44, 0xa1, 0xe5, 0x7a, 0xa1, 0x5d, 0xb3, 0xe5,
+	0x02, 0x58, 0x49, 0xd9, 0x3e, 0xa2, 0x1f, 0x42, 0xca, 0x2b, 0x1a, 0x3b, 0x42, 0x2e, 0x62, 0x62,
+	0x6a, 0xc4, 0x5f, 0x1d, 0xbd, 0xfa, 0xd2, 0x0e, 0x5f, 0x00, 0x98, 0x36, 0xbb, 0x6e, 0xb4, 0x9b,
+	0x18, 0x5d, 0x74, 0x2a, 0x72, 0xad, 0x06, 0x26, 0x59, 0x3c, 0xf2, 0x24, 0xf3, 0x35, 0xdb, 0x0a,
+	0xa4, 0x82, 0x96, 0x31, 0xb1, 0x67, 0x61, 0x2f, 0x8a, 0x63, 0x4a, 0x0f, 0x0f, 0x48, 0x02, 0x7a,
+	0xe6, 0x6a, 0x68, 0x9f, 0x05, 0x41, 0x9f, 0x7d, 0xef, 0xfd, 0x40, 0xe0, 0x60, 0x07, 0x03, 0xf4,
+	0xeb, 0x4d, 0x18, 0x43, 0x96, 0x6e, 0x07, 0x46, 0x70, 0xcc, 0x53, 0xd9, 0xbd, 0x3e, 0x3c, 0x03,
+	0x2f, 0x28, 0x82, 0xaa, 0xf0, 0x4a, 0x4c, 0xac, 0xd7, 0xe4, 0x10, 0xef, 0x75, 0xba, 0x5b, 0xd7,
+	0xcb, 0xdb, 0xa8, 0x2a, 0xdc, 0x34, 0x09, 0x29, 0x76, 0x47, 0xcf, 0x9d, 0x32, 0x4a, 0x47, 0xfb,
+	0x82, 0xc0, 0x61, 0x6f, 0x64, 0xbd, 0xc5, 0xeb, 0xf5, 0xaa, 0xac, 0xb3, 0xc6, 0x7f, 0x90, 0xbf,
+	0x5f, 0x08, 0x1c, 0xe9, 0x4d, 0x04, 0xdd, 0x5c, 0x81, 0x03, 0x2d, 0x2e, 0x59, 0x79, 0xad, 0x7d,
+	0x87, 0xe9, 0xd4, 0xfa, 0x0d, 0xd4, 0x36, 0x0c, 0x3a, 0xbd, 0xbf, 0x15, 0x04, 0xdf, 0xbd, 0xe4,
+	0x4a, 0x4c, 0x2e, 0x4e, 0x3c, 0xfe, 0x09, 0xb3, 0x9e, 0xc1, 0xbb, 0xb9, 0x13, 0x87, 0x74, 0xb7,
+	0x59, 0x0c, 0x58, 0x19, 0x26, 0xdc, 0x41, 0x6d, 0x9f, 0x3b, 0x23, 0xbe, 0xb8, 0x64, 0x07, 0xe2,
+	0x8f, 0xc7, 0xb9, 0x69, 0xb3, 0x2a, 0x3f, 0x5a, 0x5f, 0x2d, 0xac, 0xf1, 0x3a, 0xee, 0x94, 0xf8,
+	0x67, 0x4e, 0x54, 0x3e, 0xd6, 0xed, 0x89, 0x27, 0x0a, 0xcb, 0x6c, 0xed, 0xd1, 0xfd, 0x39, 0x40,
+	0x2e, 0xcb, 0x6c, 0xad, 0x94, 0x6c, 0xb5, 0x0d, 0x51, 0x0b, 0x0e, 0x55, 0x58, 0x8d, 0x99, 0x86,
+	0x64, 0x95, 0x72, 0xc0, 0x54, 0x7c, 0x17, 0x4c, 0xa5, 0x3c, 0xec, 0xeb, 0x41, 0x9b, 0x2d, 0xa3,
+	0x56, 0xad, 0x18, 0x92, 0x5b, 0x41, 0x9b, 0x23, 0xbb, 0x61, 0xd3, 0xc3, 0xf6, 0xd9, 0xd4, 0xde,
+	0x86, 0x6c, 0x60, 0x63, 0x6b, 0x4f, 0x71, 0x37, 0xc5, 0xaf, 0xc0, 0xbe, 0xc0, 0x63, 0x81, 0xcf,
+	0xe9, 0x84, 0x7f, 0xee, 0x6b, 0xb7, 0xe2, 0x90, 0xeb, 0x8b, 0xf3, 0xbf, 0x7a, 0x5c, 0x17, 0x6e,
+	0x4f, 0xc2, 0xa8, 0x0a, 0x05, 0xfd, 0x86, 0xc0, 0x98, 0x1b, 0x0f, 0x9a, 0xef, 0x05, 0xd5, 0xeb,
+	0x07, 0x4e, 0xe6, 0xb5, 0x08, 0x92, 0x4e, 0x48, 0xb5, 0xc5, 0xcf, 0x7f, 0xfb, 0xfb, 0x4e, 0x7c,
+	0x8e, 0xce, 0xea, 0x3d, 0x7e, 0x65, 0x79, 0xab, 0xb3, 0xbe, 0xe9, 0xeb, 0xd1, 0x2d, 0x7a, 0x9b,
+	0xc0, 0xb8, 0x8b, 0x24, 0x68, 0xb8, 0x35, 0xb7, 0x12, 0x32, 0x33, 0x51, 0x44, 0x91, 0xd9, 0x51,
+	0xc5, 0x2c, 0x47, 0x5f, 0x1a, 0xc8, 0x8c, 0x7e, 0x4b, 0x20, 0x61, 0x4f, 0x33, 0xfa, 0x6a, 0x5f,
+	0x6c, 0xdf, 0x9a, 0x9e, 0x39, 0x1a, 0x22, 0x85, 0xc6, 0x2f, 0x28, 0xe3, 0x67, 0xe9, 0xe9, 0x21,
+	0xc2, 0xa2, 0xab, 0xcd, 0x54, 0xdf, 0xb4, 0xff, 0x58, 0x5b, 0xf4, 0x6b, 0x02, 0xa3, 0x36, 0xa6,
+	0xa0, 0x83, 0x6d, 0x7a, 0xc1, 0x99, 0x0e, 0x13, 0x43, 0x6e, 0xa7, 0x15, 0xb7, 0x45, 0x3a, 0x3f,
+	0x34, 0x37, 0xfa, 0x25, 0x81, 0x3d, 0x58, 0xae, 0xfd, 0xad, 0x05, 0x9a, 0x37, 0x73, 0x2c, 0x54,
+	0x0e, 0x69, 0x9d, 0x50, 0xb4, 0x66, 0x68, 0xbe, 0x27, 0x2d, 0x25, 0xab, 0x6f, 0xfa, 0x96, 0xea,
+	0x2d, 0xfa, 0x23, 0x81, 0xbd, 0xd8, 0x58, 0xb4, 0xbf, 0x99, 0xe0, 0x0a, 0x98, 0xc9, 0x87, 0x0b,
+	0x22, 0xa1, 0xcb, 0x8a, 0x50, 0x91, 0x9e, 0x1f, 0x26, 0x4e, 0xee, 0x62, 0xa3, 0x6f, 0xe2, 0x27,
+	0x6e, 0x6d, 0xd1, 0xef, 0x08, 0x8c, 0x21, 0xba, 0xa0, 0xa1, 0x04, 0x44, 0x78, 0x1b, 0x76, 0x6e,
+	0x61, 0xda, 0x92, 0xe2, 0x7a, 0x8a, 0x9e, 0x7c, 0x12, 0xae, 0xf4, 0x1e, 0x81, 0xa4, 0x6f, 0x87,
+	0xa1, 0xb3, 0x7d, 0x0d, 0x77, 0x6f, 0x57, 0x99, 0xe3, 0xd1, 0x84, 0x9f, 0xa6, 0xf8, 0xd4, 0x70,
+	0xa3, 0x3f, 0x13, 0xd8, 0xdf, 0xb1, 0xbe, 0x50, 0x7d, 0x60, 0xcd, 0x77, 0x6f, 0x5c, 0x99, 0x13,
+	0xd1, 0x15, 0x90, 0xf1, 0xb2, 0x62, 0x7c, 0x8e, 0x2e, 0x0d, 0xdb, 0x2e, 0xfe, 0x5d, 0x8a, 0xfe,
+	0x44, 0x20, 0xe9, 0x7f, 0x69, 0x67, 0x07, 0xf1, 0xe8, 0xd8, 0x71, 0x32, 0xc7, 0xa3, 0x09, 0x3f,
+	0x4d, 0xdd, 0xfa, 0x1f, 0x7b, 0x6f, 0x04, 0xfd, 0x4a, 0x80, 0x76, 0x3f, 0xa7, 0x74, 0x21, 0x74,
+	0x0a, 0x77, 0xbd, 0xe1, 0x99, 0xc5, 0xa1, 0x74, 0x22, 0x4d, 0x51, 0x67, 0x24, 0x04, 0x36, 0x03,
+	0xbf, 0x57, 0x6a, 0x46, 0x14, 0x8b, 0x0f, 0xb6, 0xb3, 0xe4, 0xe1, 0x76, 0x96, 0xfc, 0xb5, 0x9d,
+	0x25, 0x5f, 0xed, 0x64, 0x63, 0x0f, 0x77, 0xb2, 0xb1, 0xdf, 0x77, 0xb2, 0xb1, 0x0f, 0xf2, 0x03,
+	0x77, 0x98, 0x9b, 0xca, 0x96, 0xc2, 0x5c, 0xdd, 0xa3, 0xfe, 0x1d, 0xb8, 0xf8, 0xef, 0x00, 0x55,
+	0xc9, 0xcb, 0xa3, 0xdd, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -934,6 +1283,16 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// VoteCommitments queries the vote commitments of a commit-reveal proposal
+	// which are not revealed yet.
+	VoteCommitments(ctx context.Context, in *QueryVoteCommitmentsRequest, opts ...grpc.CallOption) (*QueryVoteCommitmentsResponse, error)
+	// VotingPower queries the voting power a vote of an address on a proposal in
+	// voting period would be tallied with, given the votes cast so far.
+	VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error)
+	// ProposalTypeParams queries the deposit, voting and tally parameters
+	// applying to the proposals of a type, with the parameters overridden for
+	// the type.
+	ProposalTypeParams(ctx context.Context, in *QueryProposalTypeParamsRequest, opts ...grpc.CallOption) (*QueryProposalTypeParamsResponse, error)
 }
 
 type queryClient struct {
@@ -1016,6 +1375,33 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) VoteCommitments(ctx context.Context, in *QueryVoteCommitmentsRequest, opts ...grpc.CallOption) (*QueryVoteCommitmentsResponse, error) {
+	out := new(QueryVoteCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Query/VoteCommitments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error) {
+	out := new(QueryVotingPowerResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Query/VotingPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalTypeParams(ctx context.Context, in *QueryProposalTypeParamsRequest, opts ...grpc.CallOption) (*QueryProposalTypeParamsResponse, error) {
+	out := new(QueryProposalTypeParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Query/ProposalTypeParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1034,6 +1420,16 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// VoteCommitments queries the vote commitments of a commit-reveal proposal
+	// which are not revealed yet.
+	VoteCommitments(context.Context, *QueryVoteCommitmentsRequest) (*QueryVoteCommitmentsResponse, error)
+	// VotingPower queries the voting power a vote of an address on a proposal in
+	// voting period would be tallied with, given the votes cast so far.
+	VotingPower(context.Context, *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error)
+	// ProposalTypeParams queries the deposit, voting and tally parameters
+	// applying to the proposals of a type, with the parameters overridden for
+	// the type.
+	ProposalTypeParams(context.Context, *QueryProposalTypeParamsRequest) (*QueryProposalTypeParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1064,6 +1460,15 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) VoteCommitments(ctx context.Context, req *QueryVoteCommitmentsRequest) (*QueryVoteCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteCommitments not implemented")
+}
+func (*UnimplementedQueryServer) VotingPower(ctx context.Context, req *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPower not implemented")
+}
+func (*UnimplementedQueryServer) ProposalTypeParams(ctx context.Context, req *QueryProposalTypeParamsRequest) (*QueryProposalTypeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalTypeParams not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1213,6 +1618,60 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VoteCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteCommitmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteCommitments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta1.Query/VoteCommitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteCommitments(ctx, req.(*QueryVoteCommitmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta1.Query/VotingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPower(ctx, req.(*QueryVotingPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalTypeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalTypeParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalTypeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta1.Query/ProposalTypeParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalTypeParams(ctx, req.(*QueryProposalTypeParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1249,11 +1708,23 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/gov/v1beta1/query.proto",
-}
-
+		{
+			MethodName: "VoteCommitments",
+			Handler:    _Query_VoteCommitments_Handler,
+		},
+		{
+			MethodName: "VotingPower",
+			Handler:    _Query_VotingPower_Handler,
+		},
+		{
+			MethodName: "ProposalTypeParams",
+			Handler:    _Query_ProposalTypeParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/gov/v1beta1/query.proto",
+}
+
 func (m *QueryProposalRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1625,6 +2096,20 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposalTypeParams) > 0 {
+		for iNdEx := len(m.ProposalTypeParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalTypeParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1876,6 +2361,266 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryVoteCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoteCommitments) > 0 {
+		for iNdEx := len(m.VoteCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValidatorVotingPower.Size()
+		i -= size
+		if _, err := m.ValidatorVotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DelegatedVotingPower.Size()
+		i -= size
+		if _, err := m.DelegatedVotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalTypeParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalTypeParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalTypeParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProposalType) > 0 {
+		i -= len(m.ProposalType)
+		copy(dAtA[i:], m.ProposalType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposalType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalTypeParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalTypeParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalTypeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.DepositParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.VotingParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2040,6 +2785,12 @@ func (m *QueryParamsResponse) Size() (n int) {
 	n += 1 + l + sovQuery(uint64(l))
 	l = m.TallyParams.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ProposalTypeParams) > 0 {
+		for _, e := range m.ProposalTypeParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2128,8 +2879,102 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryVoteCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoteCommitments) > 0 {
+		for _, e := range m.VoteCommitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DelegatedVotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ValidatorVotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProposalTypeParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProposalType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalTypeParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VotingParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DepositParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TallyParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -3194,6 +4039,40 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTypeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalTypeParams = append(m.ProposalTypeParams, ProposalTypeParams{})
+			if err := m.ProposalTypeParams[len(m.ProposalTypeParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3776,6 +4655,715 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVoteCommitmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteCommitmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteCommitmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteCommitmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteCommitments = append(m.VoteCommitments, VoteCommitment{})
+			if err := m.VoteCommitments[len(m.VoteCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedVotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorVotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalTypeParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalTypeParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalTypeParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalTypeParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalTypeParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalTypeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TallyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
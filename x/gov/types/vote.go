@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -8,6 +10,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 // NewVote creates a new Vote instance
@@ -21,6 +24,36 @@ func (v Vote) String() string {
 	return string(out)
 }
 
+// NewVoteCommitment creates a new VoteCommitment instance
+//nolint:interfacer
+func NewVoteCommitment(proposalID uint64, voter sdk.AccAddress, commitment []byte) VoteCommitment {
+	return VoteCommitment{ProposalId: proposalID, Voter: voter.String(), Commitment: commitment}
+}
+
+func (c VoteCommitment) String() string {
+	out, _ := yaml.Marshal(c)
+	return string(out)
+}
+
+// VoteCommitmentHash returns the commitment of a voter to a vote on a
+// commit-reveal proposal: the SHA-256 hash of the proposal ID, the voter, the
+// vote options and the salt, which must be kept secret until the vote is
+// revealed and be long enough not to be guessed, e.g. 32 random bytes.
+func VoteCommitmentHash(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions, salt []byte) []byte {
+	h := sha256.New()
+	h.Write(GetProposalIDBytes(proposalID))
+	h.Write(address.MustLengthPrefix(voter))
+	for _, option := range options {
+		bz := make([]byte, 4)
+		binary.BigEndian.PutUint32(bz, uint32(option.Option))
+		h.Write(bz)
+		h.Write(address.MustLengthPrefix([]byte(option.Weight.String())))
+	}
+	h.Write(salt)
+
+	return h.Sum(nil)
+}
+
 // Votes is a collection of Vote objects
 type Votes []Vote
 
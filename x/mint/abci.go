@@ -1,6 +1,7 @@
 package mint
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -9,7 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
-// BeginBlocker mints new tokens for the previous block.
+// BeginBlocker mints new tokens for the previous block, or for the minting
+// epochs ended since the previous block if the EpochDuration param is set.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -17,6 +19,15 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	minter := k.GetMinter(ctx)
 	params := k.GetParams(ctx)
 
+	if params.EpochDuration > 0 {
+		mintEpochs(ctx, k, minter, params)
+		return
+	}
+
+	// epoch-based minting starts a new epoch if it is enabled again
+	minter.EpochStartTime = time.Time{}
+	minter.EpochNumber = 0
+
 	// recalculate inflation rate
 	totalStakingSupply := k.StakingTokenSupply(ctx)
 	bondedRatio := k.BondedRatio(ctx)
@@ -26,6 +37,50 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 	// mint coins, update supply
 	mintedCoin := minter.BlockProvision(params)
+	mintProvision(ctx, k, minter, bondedRatio, mintedCoin)
+}
+
+// mintEpochs mints the provisions of the minting epochs ended since the start
+// of the current epoch, if any. The provisions of the epochs which ended while
+// the chain was halted are minted at once, based on the current supply, so
+// that the emission only depends on time. The minter is only written at the
+// start and end of the epochs.
+func mintEpochs(ctx sdk.Context, k keeper.Keeper, minter types.Minter, params types.Params) {
+	blockTime := ctx.BlockHeader().Time
+
+	if minter.EpochStartTime.IsZero() {
+		minter.EpochStartTime = blockTime
+		k.SetMinter(ctx, minter)
+		return
+	}
+
+	elapsed := blockTime.Sub(minter.EpochStartTime)
+	if elapsed < params.EpochDuration {
+		return
+	}
+	epochs := uint64(elapsed / params.EpochDuration)
+
+	// recalculate inflation rate over the ended epochs
+	totalStakingSupply := k.StakingTokenSupply(ctx)
+	bondedRatio := k.BondedRatio(ctx)
+	minter.Inflation = minter.NextEpochInflationRate(params, bondedRatio, epochs)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+	minter.EpochStartTime = minter.EpochStartTime.Add(time.Duration(epochs) * params.EpochDuration)
+	minter.EpochNumber += epochs
+	k.SetMinter(ctx, minter)
+
+	// mint coins, update supply
+	mintedCoin := minter.EpochProvision(params, epochs)
+	mintProvision(ctx, k, minter, bondedRatio, mintedCoin,
+		sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatUint(minter.EpochNumber, 10)),
+		sdk.NewAttribute(types.AttributeKeyEpochs, strconv.FormatUint(epochs, 10)),
+	)
+}
+
+// mintProvision mints the provision and sends it to the fee collector account.
+func mintProvision(
+	ctx sdk.Context, k keeper.Keeper, minter types.Minter, bondedRatio sdk.Dec, mintedCoin sdk.Coin, attrs ...sdk.Attribute,
+) {
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err := k.MintCoins(ctx, mintedCoins)
@@ -46,10 +101,12 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeMint,
-			sdk.NewAttribute(types.AttributeKeyBondedRatio, bondedRatio.String()),
-			sdk.NewAttribute(types.AttributeKeyInflation, minter.Inflation.String()),
-			sdk.NewAttribute(types.AttributeKeyAnnualProvisions, minter.AnnualProvisions.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+			append([]sdk.Attribute{
+				sdk.NewAttribute(types.AttributeKeyBondedRatio, bondedRatio.String()),
+				sdk.NewAttribute(types.AttributeKeyInflation, minter.Inflation.String()),
+				sdk.NewAttribute(types.AttributeKeyAnnualProvisions, minter.AnnualProvisions.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+			}, attrs...)...,
 		),
 	)
 }
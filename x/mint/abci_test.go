@@ -0,0 +1,65 @@
+package mint_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+)
+
+func TestEpochMinting(t *testing.T) {
+	app := simapp.Setup(t, false)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: start})
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.EpochDuration = time.Hour
+	app.MintKeeper.SetParams(ctx, params)
+
+	supply := func(ctx sdk.Context) sdk.Int {
+		return app.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	}
+
+	// the first epoch starts at the first block, nothing is minted until it ends
+	initialSupply := supply(ctx)
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	require.Equal(t, start, app.MintKeeper.GetMinter(ctx).EpochStartTime)
+
+	ctx = ctx.WithBlockTime(start.Add(30 * time.Minute))
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	require.Equal(t, initialSupply, supply(ctx))
+
+	// the provisions of an epoch are minted once it ends
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	minter := app.MintKeeper.GetMinter(ctx)
+	require.Equal(t, start.Add(time.Hour), minter.EpochStartTime)
+	require.Equal(t, uint64(1), minter.EpochNumber)
+	provision := minter.EpochProvision(params, 1)
+	require.True(t, provision.IsPositive())
+	require.Equal(t, initialSupply.Add(provision.Amount), supply(ctx))
+
+	// the provisions of the epochs ended while the chain was halted are minted
+	// at once
+	previousSupply := supply(ctx)
+	ctx = ctx.WithBlockTime(start.Add(4*time.Hour + 30*time.Minute))
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	minter = app.MintKeeper.GetMinter(ctx)
+	require.Equal(t, start.Add(4*time.Hour), minter.EpochStartTime)
+	require.Equal(t, uint64(4), minter.EpochNumber)
+	require.Equal(t, previousSupply.Add(minter.EpochProvision(params, 3).Amount), supply(ctx))
+
+	// the provisions are minted per block once epochs are disabled
+	params.EpochDuration = 0
+	app.MintKeeper.SetParams(ctx, params)
+	previousSupply = supply(ctx)
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	minter = app.MintKeeper.GetMinter(ctx)
+	require.True(t, minter.EpochStartTime.IsZero())
+	require.Equal(t, previousSupply.Add(minter.BlockProvision(params).Amount), supply(ctx))
+}
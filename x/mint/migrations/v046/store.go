@@ -0,0 +1,20 @@
+package v046
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Setting the EpochDuration param to its default value, unless it was
+// already set, e.g. by the upgrade handler before running the migrations.
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	if !paramSpace.Has(ctx, types.KeyEpochDuration) {
+		paramSpace.Set(ctx, types.KeyEpochDuration, types.DefaultEpochDuration)
+	}
+
+	return nil
+}
@@ -7,4 +7,6 @@ const (
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyEpochNumber      = "epoch_number"
+	AttributeKeyEpochs           = "epochs"
 )
@@ -9,15 +9,20 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +36,11 @@ type Minter struct {
 	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
 	// current annual expected provisions
 	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+	// start time of the current minting epoch, zero if the provisions are
+	// minted per block
+	EpochStartTime time.Time `protobuf:"bytes,3,opt,name=epoch_start_time,json=epochStartTime,proto3,stdtime" json:"epoch_start_time" yaml:"epoch_start_time"`
+	// number of the minting epochs ended since epoch-based minting started
+	EpochNumber uint64 `protobuf:"varint,4,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -66,6 +76,20 @@ func (m *Minter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Minter proto.InternalMessageInfo
 
+func (m *Minter) GetEpochStartTime() time.Time {
+	if m != nil {
+		return m.EpochStartTime
+	}
+	return time.Time{}
+}
+
+func (m *Minter) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
 // Params holds parameters for the mint module.
 type Params struct {
 	// type of coin to mint
@@ -80,6 +104,10 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// duration of the minting epochs. The provisions are minted at the end of
+	// each epoch rather than at each block, including the provisions of the
+	// epochs which ended while the chain was halted. Zero mints per block.
+	EpochDuration time.Duration `protobuf:"bytes,7,opt,name=epoch_duration,json=epochDuration,proto3,stdduration" json:"epoch_duration" yaml:"epoch_duration"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -128,6 +156,13 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetEpochDuration() time.Duration {
+	if m != nil {
+		return m.EpochDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
@@ -136,32 +171,42 @@ func init() {
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0xaa, 0xda, 0x40,
-	0x18, 0x85, 0x93, 0x6a, 0x03, 0x4e, 0x2b, 0x6d, 0xc7, 0x16, 0x52, 0xa1, 0x51, 0x5c, 0x88, 0x5d,
-	0x98, 0x20, 0xdd, 0x95, 0xae, 0xd4, 0xad, 0x20, 0xd9, 0x55, 0x28, 0x61, 0x92, 0x4c, 0xe3, 0x60,
-	0x32, 0x13, 0x66, 0x46, 0xd1, 0xb7, 0xe8, 0xb2, 0xcb, 0x3e, 0x44, 0x1f, 0xc2, 0x5d, 0xa5, 0xab,
-	0xd2, 0x85, 0x5c, 0x74, 0x7b, 0x1f, 0xe2, 0x92, 0x99, 0x10, 0xe1, 0x2e, 0xee, 0x2a, 0xab, 0x64,
-	0xce, 0x99, 0xff, 0x3b, 0x27, 0x81, 0x1f, 0x38, 0x11, 0x13, 0x19, 0x13, 0x5e, 0x46, 0xa8, 0xf4,
-	0x76, 0x93, 0x10, 0x4b, 0x34, 0x51, 0x07, 0x37, 0xe7, 0x4c, 0x32, 0xd8, 0xd1, 0xbe, 0xab, 0xa4,
-	0xd2, 0xef, 0xbe, 0x4d, 0x58, 0xc2, 0x94, 0xef, 0x15, 0x6f, 0xfa, 0x6a, 0xf7, 0xbd, 0xbe, 0x1a,
-	0x68, 0xa3, 0x9c, 0x53, 0x87, 0xc1, 0x1f, 0x13, 0x58, 0x0b, 0x42, 0x25, 0xe6, 0x70, 0x05, 0x5a,
-	0x84, 0x7e, 0x4f, 0x91, 0x24, 0x8c, 0xda, 0x66, 0xdf, 0x1c, 0xb5, 0xa6, 0x5f, 0x8e, 0xe7, 0x9e,
-	0xf1, 0xff, 0xdc, 0x1b, 0x26, 0x44, 0xae, 0xb7, 0xa1, 0x1b, 0xb1, 0xac, 0x1c, 0x2f, 0x1f, 0x63,
-	0x11, 0x6f, 0x3c, 0x79, 0xc8, 0xb1, 0x70, 0xe7, 0x38, 0xfa, 0xfb, 0x7b, 0x0c, 0x4a, 0xfa, 0x1c,
-	0x47, 0xfe, 0x0d, 0x07, 0x09, 0x78, 0x83, 0x28, 0xdd, 0xa2, 0xb4, 0xe8, 0xb0, 0x23, 0x82, 0x30,
-	0x2a, 0xec, 0x67, 0x35, 0x64, 0xbc, 0xd6, 0xd8, 0x65, 0x45, 0x1d, 0xdc, 0x37, 0x80, 0xb5, 0x44,
-	0x1c, 0x65, 0x02, 0x7e, 0x00, 0xa0, 0xf8, 0x3b, 0x41, 0x8c, 0x29, 0xcb, 0xf4, 0x27, 0xf9, 0xad,
-	0x42, 0x99, 0x17, 0x02, 0xcc, 0xc1, 0xbb, 0xaa, 0x61, 0xc0, 0x91, 0xc4, 0x41, 0xb4, 0x46, 0x34,
-	0xc1, 0xb5, 0x14, 0xeb, 0x54, 0x68, 0x1f, 0x49, 0x3c, 0x53, 0x60, 0x88, 0x40, 0xfb, 0x96, 0x98,
-	0xa1, 0xbd, 0xdd, 0xa8, 0x21, 0xe9, 0x65, 0x85, 0x5c, 0xa0, 0xfd, 0xa3, 0x08, 0x42, 0xed, 0x66,
-	0xbd, 0x11, 0x84, 0xc2, 0x6f, 0xe0, 0x45, 0xc2, 0x50, 0x1a, 0x84, 0x8c, 0xc6, 0x38, 0xb6, 0x9f,
-	0xd7, 0x10, 0x00, 0x0a, 0xe0, 0x54, 0xf1, 0xe0, 0x10, 0xbc, 0x0a, 0x53, 0x16, 0x6d, 0x44, 0x90,
-	0x63, 0x1e, 0x1c, 0x30, 0xe2, 0xb6, 0xd5, 0x37, 0x47, 0x4d, 0xbf, 0xad, 0xe5, 0x25, 0xe6, 0x5f,
-	0x31, 0xe2, 0x9f, 0x9b, 0x3f, 0x7f, 0xf5, 0x8c, 0xe9, 0xec, 0x78, 0x71, 0xcc, 0xd3, 0xc5, 0x31,
-	0xef, 0x2e, 0x8e, 0xf9, 0xe3, 0xea, 0x18, 0xa7, 0xab, 0x63, 0xfc, 0xbb, 0x3a, 0xc6, 0xea, 0xe3,
-	0x93, 0x4d, 0xf6, 0x7a, 0xb1, 0x54, 0xa1, 0xd0, 0x52, 0xcb, 0xf0, 0xe9, 0x21, 0x00, 0x00, 0xff,
-	0xff, 0x83, 0xde, 0x9f, 0x75, 0x74, 0x03, 0x00, 0x00,
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0x8d, 0xdb, 0x10, 0x94, 0x4b, 0x03, 0xe5, 0x42, 0x55, 0x37, 0x12, 0x76, 0x08, 0x52, 0x15,
+	0x86, 0xda, 0x2a, 0x6c, 0x11, 0x93, 0x9b, 0xb5, 0x28, 0x32, 0x2c, 0x54, 0x42, 0xd6, 0xd9, 0xb9,
+	0x3a, 0xa7, 0xda, 0x77, 0xd6, 0xf9, 0x52, 0x25, 0xff, 0xa2, 0x63, 0x47, 0x7e, 0x04, 0x3f, 0xa2,
+	0x63, 0x61, 0x42, 0x0c, 0x01, 0x25, 0xff, 0xa0, 0x03, 0x33, 0xba, 0x3b, 0x27, 0xad, 0x82, 0xc4,
+	0xe4, 0x29, 0xb9, 0xf7, 0xbe, 0xef, 0xbd, 0xfb, 0x3e, 0x3f, 0x1b, 0x58, 0x11, 0xcb, 0x53, 0x96,
+	0xbb, 0x29, 0xa1, 0xc2, 0xbd, 0x3c, 0x0e, 0xb1, 0x40, 0xc7, 0xea, 0xe0, 0x64, 0x9c, 0x09, 0x06,
+	0x5b, 0x9a, 0x77, 0x14, 0x54, 0xf0, 0xed, 0xe7, 0x31, 0x8b, 0x99, 0xe2, 0x5d, 0xf9, 0x4f, 0x97,
+	0xb6, 0x0f, 0x74, 0x69, 0xa0, 0x89, 0xa2, 0x4f, 0x53, 0x56, 0xcc, 0x58, 0x9c, 0x60, 0x57, 0x9d,
+	0xc2, 0xc9, 0xb9, 0x3b, 0x9a, 0x70, 0x24, 0x08, 0xa3, 0x05, 0x6f, 0x6f, 0xf2, 0x82, 0xa4, 0x38,
+	0x17, 0x28, 0xcd, 0x74, 0x41, 0xf7, 0xcf, 0x16, 0xa8, 0x9d, 0x12, 0x2a, 0x30, 0x87, 0x67, 0xa0,
+	0x4e, 0xe8, 0x79, 0xa2, 0xda, 0x4d, 0xa3, 0x63, 0xf4, 0xea, 0xde, 0xbb, 0x9b, 0xb9, 0x5d, 0xf9,
+	0x39, 0xb7, 0x0f, 0x63, 0x22, 0xc6, 0x93, 0xd0, 0x89, 0x58, 0x5a, 0xf8, 0x17, 0x3f, 0x47, 0xf9,
+	0xe8, 0xc2, 0x15, 0xb3, 0x0c, 0xe7, 0xce, 0x00, 0x47, 0xdf, 0xbf, 0x1e, 0x81, 0xe2, 0x7a, 0x03,
+	0x1c, 0xf9, 0xf7, 0x72, 0x90, 0x80, 0x67, 0x88, 0xd2, 0x09, 0x4a, 0xe4, 0x10, 0x97, 0x24, 0x27,
+	0x8c, 0xe6, 0xe6, 0x56, 0x09, 0x1e, 0xbb, 0x5a, 0x76, 0xb8, 0x56, 0x85, 0x04, 0xec, 0xe2, 0x8c,
+	0x45, 0xe3, 0x20, 0x17, 0x88, 0x8b, 0x40, 0x0e, 0x6c, 0x6e, 0x77, 0x8c, 0x5e, 0xe3, 0x4d, 0xdb,
+	0xd1, 0xdb, 0x70, 0x56, 0xdb, 0x70, 0x3e, 0xae, 0xb6, 0xe1, 0xbd, 0x92, 0xb7, 0xb8, 0x9b, 0xdb,
+	0xfb, 0x33, 0x94, 0x26, 0xfd, 0xee, 0xa6, 0x42, 0xf7, 0xea, 0x97, 0x6d, 0xf8, 0x4f, 0x14, 0xfc,
+	0x41, 0xa2, 0xb2, 0x13, 0xf6, 0xc1, 0x8e, 0x2e, 0xa4, 0x93, 0x34, 0xc4, 0xdc, 0xac, 0x76, 0x8c,
+	0x5e, 0xd5, 0xdb, 0xbf, 0x9b, 0xdb, 0xad, 0x87, 0x32, 0x9a, 0xed, 0xfa, 0x0d, 0x75, 0x7c, 0xaf,
+	0x4f, 0xdf, 0xaa, 0xa0, 0x36, 0x44, 0x1c, 0xa5, 0x39, 0x7c, 0x01, 0x80, 0x4c, 0x41, 0x30, 0xc2,
+	0x94, 0xa5, 0x7a, 0xf3, 0x7e, 0x5d, 0x22, 0x03, 0x09, 0xc0, 0x0c, 0xec, 0xad, 0x17, 0x19, 0x70,
+	0x24, 0x70, 0x10, 0x8d, 0x11, 0x8d, 0x71, 0x29, 0xfb, 0x6b, 0xad, 0xa5, 0x7d, 0x24, 0xf0, 0x89,
+	0x12, 0x86, 0x08, 0x34, 0xef, 0x1d, 0x53, 0x34, 0x35, 0xb7, 0x4b, 0x70, 0xda, 0x59, 0x4b, 0x9e,
+	0xa2, 0xe9, 0x86, 0x05, 0xa1, 0x66, 0xb5, 0x5c, 0x0b, 0x42, 0xe1, 0x67, 0xd0, 0x88, 0x19, 0x4a,
+	0x82, 0x90, 0xd1, 0x11, 0x1e, 0x99, 0x8f, 0x4a, 0x30, 0x00, 0x52, 0xd0, 0x53, 0x7a, 0xf0, 0x10,
+	0x3c, 0x0d, 0x13, 0x16, 0x5d, 0xe4, 0x41, 0x86, 0x79, 0x30, 0xc3, 0x88, 0x9b, 0x35, 0xf9, 0xfc,
+	0xfd, 0xa6, 0x86, 0x87, 0x98, 0x7f, 0xc2, 0x88, 0xc3, 0x08, 0xe8, 0xd8, 0x04, 0xab, 0x57, 0xd3,
+	0x7c, 0xac, 0xd2, 0x78, 0xf0, 0x4f, 0x1a, 0x07, 0x45, 0x81, 0xf7, 0xb2, 0x08, 0xe3, 0xde, 0xc3,
+	0x14, 0xad, 0xda, 0xbb, 0xd7, 0x32, 0x8a, 0x4d, 0x05, 0xae, 0x3a, 0xfa, 0xd5, 0xeb, 0x2f, 0x76,
+	0xc5, 0x3b, 0xb9, 0x59, 0x58, 0xc6, 0xed, 0xc2, 0x32, 0x7e, 0x2f, 0x2c, 0xe3, 0x6a, 0x69, 0x55,
+	0x6e, 0x97, 0x56, 0xe5, 0xc7, 0xd2, 0xaa, 0x9c, 0xbd, 0xfe, 0xef, 0xb8, 0x53, 0xfd, 0x95, 0x52,
+	0x53, 0x87, 0x35, 0x75, 0x9f, 0xb7, 0x7f, 0x07, 0x00, 0xbf, 0x11, 0x97, 0x81, 0xc1, 0x04, 0x00,
+	0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -184,6 +229,19 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EpochStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EpochStartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.AnnualProvisions.Size()
 		i -= size
@@ -227,6 +285,14 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMint(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -303,6 +369,11 @@ func (m *Minter) Size() (n int) {
 	n += 1 + l + sovMint(uint64(l))
 	l = m.AnnualProvisions.Size()
 	n += 1 + l + sovMint(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EpochStartTime)
+	n += 1 + l + sovMint(uint64(l))
+	if m.EpochNumber != 0 {
+		n += 1 + sovMint(uint64(m.EpochNumber))
+	}
 	return n
 }
 
@@ -327,6 +398,8 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochDuration)
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -433,6 +506,58 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -670,6 +795,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.EpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -2,10 +2,15 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Year is the duration of a year used to compute the provisions of the minting
+// epochs, consistent with the default BlocksPerYear param.
+const Year = 8766 * time.Hour
+
 // NewMinter returns a new Minter object with the given inflation and annual
 // provisions values.
 func NewMinter(inflation, annualProvisions sdk.Dec) Minter {
@@ -40,8 +45,20 @@ func ValidateMinter(minter Minter) error {
 	return nil
 }
 
-// NextInflationRate returns the new inflation rate for the next hour.
+// NextInflationRate returns the new inflation rate for the next block.
 func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec) sdk.Dec {
+	return m.nextInflationRate(params, bondedRatio, sdk.OneDec(), sdk.NewDec(int64(params.BlocksPerYear)))
+}
+
+// NextEpochInflationRate returns the new inflation rate for the given number
+// of minting epochs.
+func (m Minter) NextEpochInflationRate(params Params, bondedRatio sdk.Dec, epochs uint64) sdk.Dec {
+	return m.nextInflationRate(params, bondedRatio, epochsDuration(params, epochs), sdk.NewDec(int64(Year)))
+}
+
+// nextInflationRate returns the new inflation rate for the given fraction of
+// a year.
+func (m Minter) nextInflationRate(params Params, bondedRatio, yearNum, yearDenom sdk.Dec) sdk.Dec {
 	// The target annual inflation rate is recalculated for each previsions cycle. The
 	// inflation is also subject to a rate change (positive or negative) depending on
 	// the distance from the desired ratio (67%). The maximum rate change possible is
@@ -52,7 +69,7 @@ func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec) sdk.Dec {
 	inflationRateChangePerYear := sdk.OneDec().
 		Sub(bondedRatio.Quo(params.GoalBonded)).
 		Mul(params.InflationRateChange)
-	inflationRateChange := inflationRateChangePerYear.Quo(sdk.NewDec(int64(params.BlocksPerYear)))
+	inflationRateChange := inflationRateChangePerYear.Mul(yearNum).Quo(yearDenom)
 
 	// adjust the new annual inflation for this next cycle
 	inflation := m.Inflation.Add(inflationRateChange) // note inflationRateChange may be negative
@@ -78,3 +95,16 @@ func (m Minter) BlockProvision(params Params) sdk.Coin {
 	provisionAmt := m.AnnualProvisions.QuoInt(sdk.NewInt(int64(params.BlocksPerYear)))
 	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
 }
+
+// EpochProvision returns the provisions for the given number of minting epochs
+// based on the annual provisions rate.
+func (m Minter) EpochProvision(params Params, epochs uint64) sdk.Coin {
+	provisionAmt := m.AnnualProvisions.Mul(epochsDuration(params, epochs)).QuoInt64(int64(Year))
+	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
+}
+
+// epochsDuration returns the duration of the given number of minting epochs,
+// in nanoseconds.
+func epochsDuration(params Params, epochs uint64) sdk.Dec {
+	return sdk.NewDec(int64(params.EpochDuration)).Mul(sdk.NewDecFromInt(sdk.NewIntFromUint64(epochs)))
+}
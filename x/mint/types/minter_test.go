@@ -3,6 +3,7 @@ package types
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -82,6 +83,39 @@ func TestBlockProvision(t *testing.T) {
 	}
 }
 
+func TestEpochProvision(t *testing.T) {
+	minter := InitialMinter(sdk.NewDecWithPrec(1, 1))
+	params := DefaultParams()
+	params.EpochDuration = time.Hour
+
+	hoursPerYear := int64(8766)
+
+	tests := []struct {
+		annualProvisions int64
+		epochs           uint64
+		expProvisions    int64
+	}{
+		{hoursPerYear, 1, 1},
+		{hoursPerYear + 1, 1, 1},
+		{hoursPerYear * 2, 1, 2},
+		{hoursPerYear / 2, 1, 0},
+		{hoursPerYear / 2, 2, 1},
+		{hoursPerYear, 3, 3},
+		{hoursPerYear, 0, 0},
+	}
+	for i, tc := range tests {
+		minter.AnnualProvisions = sdk.NewDec(tc.annualProvisions)
+		provisions := minter.EpochProvision(params, tc.epochs)
+
+		expProvisions := sdk.NewCoin(params.MintDenom,
+			sdk.NewInt(tc.expProvisions))
+
+		require.True(t, expProvisions.IsEqual(provisions),
+			"test: %v\n\tExp: %v\n\tGot: %v\n",
+			i, tc.expProvisions, provisions)
+	}
+}
+
 // Benchmarking :)
 // previously using sdk.Int operations:
 // BenchmarkBlockProvision-4 5000000 220 ns/op
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,19 +18,31 @@ type Keeper struct {
 	key         storetypes.StoreKey
 	tkey        storetypes.StoreKey
 	spaces      map[string]*types.Subspace
+	validators  map[string]types.SubspaceValidator
+
+	// the address capable of executing a MsgUpdateSubspaceParams message.
+	// Typically, this should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper constructs a params keeper
-func NewKeeper(cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey storetypes.StoreKey) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey storetypes.StoreKey, authority string) Keeper {
 	return Keeper{
 		cdc:         cdc,
 		legacyAmino: legacyAmino,
 		key:         key,
 		tkey:        tkey,
 		spaces:      make(map[string]*types.Subspace),
+		validators:  make(map[string]types.SubspaceValidator),
+		authority:   authority,
 	}
 }
 
+// GetAuthority returns the address allowed to execute MsgUpdateSubspaceParams.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+proposal.ModuleName)
@@ -71,3 +85,27 @@ func (k Keeper) GetSubspaces() []types.Subspace {
 
 	return spaces
 }
+
+// RegisterSubspaceValidator registers the validator of the parameters of a
+// subspace, allowing the subspace to be updated by MsgUpdateSubspaceParams, e.g.
+// for a module still storing its parameters in x/params to be governed by
+// messages until it migrates them to its own store.
+func (k Keeper) RegisterSubspaceValidator(s string, validator types.SubspaceValidator) {
+	if _, ok := k.spaces[s]; !ok {
+		panic(fmt.Sprintf("subspace %s not allocated", s))
+	}
+	if _, ok := k.validators[s]; ok {
+		panic(fmt.Sprintf("validator of subspace %s already registered", s))
+	}
+	if validator.ParamSet == nil || validator.Validate == nil {
+		panic(fmt.Sprintf("incomplete validator of subspace %s", s))
+	}
+
+	k.validators[s] = validator
+}
+
+// GetSubspaceValidator returns the validator registered for a subspace.
+func (k Keeper) GetSubspaceValidator(s string) (types.SubspaceValidator, bool) {
+	validator, ok := k.validators[s]
+	return validator, ok
+}
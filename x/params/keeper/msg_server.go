@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the params MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) proposal.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ proposal.MsgServer = msgServer{}
+
+// UpdateSubspaceParams implements the Msg/UpdateSubspaceParams method. Only the
+// authority of the keeper can update the parameters, of the subspaces whose
+// validator is registered. The changes are applied together, only if each of
+// the updated parameters and the resulting parameter set are valid.
+func (ms msgServer) UpdateSubspaceParams(goCtx context.Context, msg *proposal.MsgUpdateSubspaceParams) (*proposal.MsgUpdateSubspaceParamsResponse, error) {
+	if ms.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", ms.GetAuthority(), msg.Authority)
+	}
+
+	ss, ok := ms.GetSubspace(msg.Subspace)
+	if !ok {
+		return nil, sdkerrors.Wrap(proposal.ErrUnknownSubspace, msg.Subspace)
+	}
+	validator, ok := ms.GetSubspaceValidator(msg.Subspace)
+	if !ok {
+		return nil, sdkerrors.Wrap(proposal.ErrNoValidator, msg.Subspace)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, write := ctx.CacheContext()
+	for _, c := range msg.Changes {
+		if c.Subspace != msg.Subspace {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "change of key %s in subspace %s instead of %s", c.Key, c.Subspace, msg.Subspace)
+		}
+		if !ss.HasKey([]byte(c.Key)) {
+			return nil, sdkerrors.Wrapf(proposal.ErrUnknownKey, "subspace: %s, key: %s", msg.Subspace, c.Key)
+		}
+
+		ms.Logger(ctx).Info(
+			fmt.Sprintf("attempt to set new parameter value; key: %s, value: %s", c.Key, c.Value),
+		)
+
+		if err := ss.Update(cacheCtx, []byte(c.Key), []byte(c.Value)); err != nil {
+			return nil, sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
+		}
+	}
+
+	ps := validator.ParamSet()
+	ss.GetParamSet(cacheCtx, ps)
+	if err := validator.Validate(cacheCtx, ps); err != nil {
+		return nil, sdkerrors.Wrapf(proposal.ErrInvalidParamSet, "subspace: %s, err: %s", msg.Subspace, err.Error())
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return &proposal.MsgUpdateSubspaceParamsResponse{}, nil
+}
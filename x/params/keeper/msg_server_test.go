@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgUpdateSubspaceParams() {
+	ctx := suite.ctx
+	pk := suite.app.ParamsKeeper
+	msgServer := keeper.NewMsgServerImpl(pk)
+	update := func(authority, subspace string, changes ...proposal.ParamChange) error {
+		_, err := msgServer.UpdateSubspaceParams(sdk.WrapSDKContext(ctx), proposal.NewMsgUpdateSubspaceParams(authority, subspace, changes))
+		return err
+	}
+
+	maxValidators := proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "7")
+
+	// only the authority can update the params
+	err := update(authtypes.NewModuleAddress("foo").String(), stakingtypes.ModuleName, maxValidators)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = update(pk.GetAuthority(), "foo", proposal.NewParamChange("foo", "bar", "1"))
+	suite.Require().ErrorIs(err, proposal.ErrUnknownSubspace)
+
+	// only the subspaces whose validator is registered can be updated
+	err = update(pk.GetAuthority(), crisistypes.ModuleName, proposal.NewParamChange(crisistypes.ModuleName, string(crisistypes.ParamStoreKeyConstantFee), `{"denom":"stake","amount":"1"}`))
+	suite.Require().ErrorIs(err, proposal.ErrNoValidator)
+
+	err = update(pk.GetAuthority(), stakingtypes.ModuleName, proposal.NewParamChange(stakingtypes.ModuleName, "foo", "1"))
+	suite.Require().ErrorIs(err, proposal.ErrUnknownKey)
+
+	err = update(pk.GetAuthority(), stakingtypes.ModuleName, proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "0"))
+	suite.Require().ErrorIs(err, proposal.ErrSettingParameter)
+
+	suite.Require().NoError(update(pk.GetAuthority(), stakingtypes.ModuleName, maxValidators))
+	suite.Require().Equal(uint32(7), suite.app.StakingKeeper.MaxValidators(ctx))
+
+	// each parameter is valid but the parameter set isn't, so none is updated
+	params := suite.app.MintKeeper.GetParams(ctx)
+	err = update(pk.GetAuthority(), minttypes.ModuleName,
+		proposal.NewParamChange(minttypes.ModuleName, string(minttypes.KeyGoalBonded), `"0.500000000000000000"`),
+		proposal.NewParamChange(minttypes.ModuleName, string(minttypes.KeyInflationMin), `"0.500000000000000000"`),
+	)
+	suite.Require().ErrorIs(err, proposal.ErrInvalidParamSet)
+	suite.Require().Equal(params, suite.app.MintKeeper.GetParams(ctx))
+
+	suite.Require().NoError(update(pk.GetAuthority(), minttypes.ModuleName,
+		proposal.NewParamChange(minttypes.ModuleName, string(minttypes.KeyGoalBonded), `"0.500000000000000000"`),
+		proposal.NewParamChange(minttypes.ModuleName, string(minttypes.KeyInflationMin), `"0.100000000000000000"`),
+	))
+	params.GoalBonded = sdk.NewDecWithPrec(5, 1)
+	params.InflationMin = sdk.NewDecWithPrec(1, 1)
+	suite.Require().Equal(params, suite.app.MintKeeper.GetParams(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateSubspaceParamsProposal() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	pk := suite.app.ParamsKeeper
+	msg := proposal.NewMsgUpdateSubspaceParams(pk.GetAuthority(), stakingtypes.ModuleName, []proposal.ParamChange{
+		proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "7"),
+	})
+
+	// governance schedules the Msg, which is executed by the scheduler
+	content, err := scheduler.NewScheduleMsgProposal("title", "description", msg, 12, nil, 1, 0, 0)
+	suite.Require().NoError(err)
+	suite.Require().NoError(content.ValidateBasic())
+
+	handler := suite.app.GovKeeper.Router().GetRoute(content.ProposalRoute())
+	suite.Require().NoError(handler(ctx, content))
+	suite.Require().NotEqual(uint32(7), suite.app.StakingKeeper.MaxValidators(ctx))
+
+	ctx = ctx.WithBlockHeight(12)
+	suite.app.SchedulerKeeper.ExecuteDueSchedules(ctx)
+	suite.Require().Equal(uint32(7), suite.app.StakingKeeper.MaxValidators(ctx))
+}
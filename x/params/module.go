@@ -114,8 +114,8 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 // RegisterServices registers a gRPC query service to respond to the
 // module-specific gRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	proposal.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	proposal.RegisterQueryServer(cfg.QueryServer(), am.keeper)
-
 }
 
 // ProposalContents returns all the params content functions used to
@@ -12,4 +12,7 @@ var (
 	ErrEmptySubspace    = sdkerrors.Register(ModuleName, 5, "parameter subspace is empty")
 	ErrEmptyKey         = sdkerrors.Register(ModuleName, 6, "parameter key is empty")
 	ErrEmptyValue       = sdkerrors.Register(ModuleName, 7, "parameter value is empty")
+	ErrNoValidator      = sdkerrors.Register(ModuleName, 8, "no validator registered for subspace")
+	ErrUnknownKey       = sdkerrors.Register(ModuleName, 9, "unknown parameter key")
+	ErrInvalidParamSet  = sdkerrors.Register(ModuleName, 10, "invalid parameter set")
 )
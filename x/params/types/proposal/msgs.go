@@ -0,0 +1,62 @@
+package proposal
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// params message types
+const (
+	TypeMsgUpdateSubspaceParams = "update_subspace_params"
+)
+
+var _ sdk.Msg = &MsgUpdateSubspaceParams{}
+
+// NewMsgUpdateSubspaceParams creates a new MsgUpdateSubspaceParams instance
+func NewMsgUpdateSubspaceParams(authority, subspace string, changes []ParamChange) *MsgUpdateSubspaceParams {
+	return &MsgUpdateSubspaceParams{
+		Authority: authority,
+		Subspace:  subspace,
+		Changes:   changes,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateSubspaceParams) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateSubspaceParams) Type() string { return TypeMsgUpdateSubspaceParams }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgUpdateSubspaceParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgUpdateSubspaceParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateSubspaceParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if len(msg.Subspace) == 0 {
+		return ErrEmptySubspace
+	}
+
+	if err := ValidateChanges(msg.Changes); err != nil {
+		return err
+	}
+
+	for _, pc := range msg.Changes {
+		if pc.Subspace != msg.Subspace {
+			return sdkerrors.ErrInvalidRequest.Wrapf("change of key %s in subspace %s instead of %s", pc.Key, pc.Subspace, msg.Subspace)
+		}
+	}
+
+	return nil
+}
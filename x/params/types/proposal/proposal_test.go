@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParameterChangeProposal(t *testing.T) {
@@ -25,3 +27,26 @@ func TestParameterChangeProposal(t *testing.T) {
 	pcp = NewParameterChangeProposal("test title", "test description", []ParamChange{pc4})
 	require.Error(t, pcp.ValidateBasic())
 }
+
+func TestMsgUpdateSubspaceParams(t *testing.T) {
+	authority := sdk.AccAddress("authority___________")
+	msg := NewMsgUpdateSubspaceParams(authority.String(), "sub", []ParamChange{NewParamChange("sub", "foo", "baz")})
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{authority}, msg.GetSigners())
+
+	invalidAddr := *msg
+	invalidAddr.Authority = "invalid"
+	require.Error(t, invalidAddr.ValidateBasic())
+
+	noSubspace := *msg
+	noSubspace.Subspace = ""
+	require.ErrorIs(t, noSubspace.ValidateBasic(), ErrEmptySubspace)
+
+	noChanges := *msg
+	noChanges.Changes = nil
+	require.ErrorIs(t, noChanges.ValidateBasic(), ErrEmptyChanges)
+
+	otherSubspace := *msg
+	otherSubspace.Changes = []ParamChange{NewParamChange("other", "foo", "baz")}
+	require.Error(t, otherSubspace.ValidateBasic())
+}
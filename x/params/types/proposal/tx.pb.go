@@ -0,0 +1,639 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/params/v1beta1/tx.proto
+
+package proposal
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateSubspaceParams updates parameters of a subspace whose validator is
+// registered in the params keeper. It can only be executed by the authority of
+// the module.
+type MsgUpdateSubspaceParams struct {
+	// authority is the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// subspace is the name of the subspace of the parameters.
+	Subspace string `protobuf:"bytes,2,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	// changes are the changes of the parameters, whose subspace must be the
+	// subspace of the message.
+	Changes []ParamChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *MsgUpdateSubspaceParams) Reset()         { *m = MsgUpdateSubspaceParams{} }
+func (m *MsgUpdateSubspaceParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSubspaceParams) ProtoMessage()    {}
+func (*MsgUpdateSubspaceParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38e41d26b26ee208, []int{0}
+}
+func (m *MsgUpdateSubspaceParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSubspaceParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSubspaceParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSubspaceParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSubspaceParams.Merge(m, src)
+}
+func (m *MsgUpdateSubspaceParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSubspaceParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSubspaceParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSubspaceParams proto.InternalMessageInfo
+
+// MsgUpdateSubspaceParamsResponse defines the Msg/UpdateSubspaceParams
+// response type.
+type MsgUpdateSubspaceParamsResponse struct {
+}
+
+func (m *MsgUpdateSubspaceParamsResponse) Reset()         { *m = MsgUpdateSubspaceParamsResponse{} }
+func (m *MsgUpdateSubspaceParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSubspaceParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateSubspaceParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38e41d26b26ee208, []int{1}
+}
+func (m *MsgUpdateSubspaceParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSubspaceParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSubspaceParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSubspaceParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSubspaceParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateSubspaceParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSubspaceParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSubspaceParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSubspaceParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateSubspaceParams)(nil), "cosmos.params.v1beta1.MsgUpdateSubspaceParams")
+	proto.RegisterType((*MsgUpdateSubspaceParamsResponse)(nil), "cosmos.params.v1beta1.MsgUpdateSubspaceParamsResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/params/v1beta1/tx.proto", fileDescriptor_38e41d26b26ee208) }
+
+var fileDescriptor_38e41d26b26ee208 = []byte{
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0xe3, 0xdb, 0xab, 0x7b, 0x5b, 0xdf, 0x2d, 0xea, 0x15, 0x21, 0x83, 0x53, 0x32, 0x75,
+	0xa9, 0xa3, 0x16, 0xd4, 0x81, 0x8d, 0x30, 0x17, 0xa1, 0x54, 0x2c, 0x2c, 0xc8, 0x49, 0x2c, 0xb7,
+	0x82, 0xd6, 0x56, 0x8e, 0x8b, 0xda, 0x85, 0x0d, 0x89, 0x91, 0x47, 0xe8, 0x43, 0xb0, 0xf1, 0x02,
+	0x1d, 0x2b, 0x26, 0x26, 0x84, 0xda, 0x85, 0xc7, 0x40, 0x75, 0x12, 0x58, 0xda, 0x81, 0x29, 0xb1,
+	0xfe, 0xef, 0x9c, 0xff, 0x3f, 0xfa, 0x31, 0x49, 0x24, 0x8c, 0x24, 0x04, 0x8a, 0x65, 0x6c, 0x04,
+	0xc1, 0x6d, 0x3b, 0xe6, 0x9a, 0xb5, 0x03, 0x3d, 0xa5, 0x2a, 0x93, 0x5a, 0xda, 0xff, 0x73, 0x9d,
+	0xe6, 0x3a, 0x2d, 0x74, 0xb7, 0x2e, 0xa4, 0x90, 0x86, 0x08, 0x36, 0x7f, 0x39, 0xec, 0xee, 0xe7,
+	0xf0, 0x55, 0x2e, 0x94, 0x93, 0x46, 0xf2, 0xb7, 0xfb, 0x14, 0x6b, 0x0d, 0xe3, 0x3f, 0x23, 0xbc,
+	0xd7, 0x03, 0x71, 0xa1, 0x52, 0xa6, 0x79, 0x7f, 0x12, 0x83, 0x62, 0x09, 0x3f, 0x37, 0x84, 0xdd,
+	0xc5, 0x35, 0x36, 0xd1, 0x03, 0x99, 0x0d, 0xf5, 0xcc, 0x41, 0x0d, 0xd4, 0xac, 0x85, 0xce, 0xcb,
+	0x53, 0xab, 0x5e, 0x98, 0x9c, 0xa4, 0x69, 0xc6, 0x01, 0xfa, 0x3a, 0x1b, 0x8e, 0x45, 0xf4, 0x8d,
+	0xda, 0x2e, 0xae, 0x42, 0xb1, 0xc9, 0xf9, 0xb5, 0x19, 0x8b, 0xbe, 0xde, 0x76, 0x88, 0xff, 0x26,
+	0x03, 0x36, 0x16, 0x1c, 0x9c, 0x4a, 0xa3, 0xd2, 0xfc, 0xd7, 0xf1, 0xe9, 0xd6, 0x6b, 0xa9, 0xc9,
+	0x70, 0x6a, 0xd0, 0xf0, 0xf7, 0xe2, 0xcd, 0xb3, 0xa2, 0x72, 0xf0, 0xb8, 0xfa, 0x30, 0xf7, 0xac,
+	0x8f, 0xb9, 0x67, 0xf9, 0x07, 0xd8, 0xdb, 0x11, 0x3e, 0xe2, 0xa0, 0xe4, 0x18, 0x78, 0xe7, 0x1e,
+	0xe1, 0x4a, 0x0f, 0x84, 0x7d, 0x87, 0xeb, 0x5b, 0x8f, 0xa4, 0x3b, 0xfc, 0x77, 0xec, 0x75, 0xbb,
+	0x3f, 0xe3, 0xcb, 0x1c, 0xe1, 0xd9, 0x62, 0x45, 0xd0, 0x72, 0x45, 0xd0, 0xfb, 0x8a, 0xa0, 0xc7,
+	0x35, 0xb1, 0x96, 0x6b, 0x62, 0xbd, 0xae, 0x89, 0x75, 0x79, 0x24, 0x86, 0x7a, 0x30, 0x89, 0x69,
+	0x22, 0x47, 0x45, 0x7f, 0xc5, 0xa7, 0x05, 0xe9, 0x75, 0x30, 0x2d, 0xeb, 0xd3, 0x33, 0xc5, 0x21,
+	0x50, 0x99, 0x54, 0x12, 0xd8, 0x4d, 0xfc, 0xc7, 0xf4, 0x77, 0xf8, 0x39, 0x00, 0xbe, 0x14, 0xea,
+	0xb4, 0x4d, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateSubspaceParams defines a governance operation for updating the
+	// parameters of a subspace, for the modules still storing their parameters
+	// in x/params.
+	UpdateSubspaceParams(ctx context.Context, in *MsgUpdateSubspaceParams, opts ...grpc.CallOption) (*MsgUpdateSubspaceParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateSubspaceParams(ctx context.Context, in *MsgUpdateSubspaceParams, opts ...grpc.CallOption) (*MsgUpdateSubspaceParamsResponse, error) {
+	out := new(MsgUpdateSubspaceParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.params.v1beta1.Msg/UpdateSubspaceParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateSubspaceParams defines a governance operation for updating the
+	// parameters of a subspace, for the modules still storing their parameters
+	// in x/params.
+	UpdateSubspaceParams(context.Context, *MsgUpdateSubspaceParams) (*MsgUpdateSubspaceParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateSubspaceParams(ctx context.Context, req *MsgUpdateSubspaceParams) (*MsgUpdateSubspaceParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubspaceParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateSubspaceParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSubspaceParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSubspaceParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.params.v1beta1.Msg/UpdateSubspaceParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSubspaceParams(ctx, req.(*MsgUpdateSubspaceParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.params.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateSubspaceParams",
+			Handler:    _Msg_UpdateSubspaceParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/params/v1beta1/tx.proto",
+}
+
+func (m *MsgUpdateSubspaceParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSubspaceParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSubspaceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSubspaceParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSubspaceParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSubspaceParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateSubspaceParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateSubspaceParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateSubspaceParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSubspaceParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSubspaceParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, ParamChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSubspaceParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSubspaceParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSubspaceParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
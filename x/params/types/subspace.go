@@ -47,6 +47,13 @@ func (s Subspace) HasKeyTable() bool {
 	return len(s.table.m) > 0
 }
 
+// HasKey returns whether the parameter key is registered in the KeyTable of the
+// Subspace.
+func (s Subspace) HasKey(key []byte) bool {
+	_, ok := s.table.m[string(key)]
+	return ok
+}
+
 // WithKeyTable initializes KeyTable and returns modified Subspace
 func (s Subspace) WithKeyTable(table KeyTable) Subspace {
 	if table.m == nil {
@@ -69,18 +76,21 @@ func (s Subspace) WithKeyTable(table KeyTable) Subspace {
 	return s
 }
 
+// prefix returns the store prefix of the Subspace. The name is capped before
+// appending so that concurrent readers, e.g. the asynchronous invariant checks,
+// never write into the spare capacity of the shared name.
+func (s Subspace) prefix() []byte {
+	return append(s.name[:len(s.name):len(s.name)], '/')
+}
+
 // Returns a KVStore identical with ctx.KVStore(s.key).Prefix()
 func (s Subspace) kvStore(ctx sdk.Context) sdk.KVStore {
-	// append here is safe, appends within a function won't cause
-	// weird side effects when its singlethreaded
-	return prefix.NewStore(ctx.KVStore(s.key), append(s.name, '/'))
+	return prefix.NewStore(ctx.KVStore(s.key), s.prefix())
 }
 
 // Returns a transient store for modification
 func (s Subspace) transientStore(ctx sdk.Context) sdk.KVStore {
-	// append here is safe, appends within a function won't cause
-	// weird side effects when its singlethreaded
-	return prefix.NewStore(ctx.TransientStore(s.tkey), append(s.name, '/'))
+	return prefix.NewStore(ctx.TransientStore(s.tkey), s.prefix())
 }
 
 // Validate attempts to validate a parameter value by its key. If the key is not
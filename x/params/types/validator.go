@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SubspaceValidator validates the parameters of a subspace updated by a
+// MsgUpdateSubspaceParams as a whole, e.g. the constraints between them which
+// the validation functions of the individual parameters can't check. A
+// subspace can only be updated by the message once its validator is registered
+// in the params keeper.
+type SubspaceValidator struct {
+	// ParamSet returns an empty parameter set of the subspace, into which the
+	// updated parameters are loaded.
+	ParamSet func() ParamSet
+	// Validate returns an error if the updated parameter set is invalid. The
+	// parameter set is the one returned by ParamSet, so that it can be asserted
+	// to the parameters type of the module.
+	Validate func(ctx sdk.Context, ps ParamSet) error
+}
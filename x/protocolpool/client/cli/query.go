@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        protocolpool.ModuleName,
+		Short:                      "Querying commands for the protocolpool module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryPool(),
+		GetCmdQueryContinuousFund(),
+		GetCmdQueryContinuousFunds(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryParams returns the command to query the protocolpool params.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current protocolpool parameters",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := protocolpool.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &protocolpool.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPool returns the command to query the balance of the protocol
+// pool.
+func GetCmdQueryPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool",
+		Args:  cobra.NoArgs,
+		Short: "Query the balance of the protocol pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := protocolpool.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Pool(cmd.Context(), &protocolpool.QueryPoolRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryContinuousFund returns the command to query the continuous fund
+// of a recipient.
+func GetCmdQueryContinuousFund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "continuous-fund [recipient]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the continuous fund of a recipient",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the continuous fund of a recipient.
+
+Example:
+$ %s query %s continuous-fund cosmos1..
+`, version.AppName, protocolpool.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := protocolpool.NewQueryClient(clientCtx)
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContinuousFund(cmd.Context(), &protocolpool.QueryContinuousFundRequest{Recipient: recipient.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.ContinuousFund)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryContinuousFunds returns the command to query all the continuous
+// funds.
+func GetCmdQueryContinuousFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "continuous-funds",
+		Args:  cobra.NoArgs,
+		Short: "Query all the continuous funds",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := protocolpool.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContinuousFunds(cmd.Context(), &protocolpool.QueryContinuousFundsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "continuous funds")
+
+	return cmd
+}
@@ -0,0 +1,190 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+// Flags of the continuous fund proposals.
+const (
+	FlagCap       = "cap"
+	FlagStartTime = "start-time"
+	FlagExpiry    = "expiry"
+)
+
+// NewCmdSubmitCreateContinuousFundProposal implements the command to submit a
+// proposal creating a continuous fund.
+func NewCmdSubmitCreateContinuousFundProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-continuous-fund [recipient] [amount-per-block] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to pay a recipient from the protocol pool at each block",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to create a continuous fund, paying the given amount per block from the
+protocol pool to a recipient along with an initial deposit. The payments start at the given start time, or at the
+execution of the proposal, and stop at the optional expiry or once the total amount paid reaches the optional cap.
+
+Example:
+$ %s tx gov submit-proposal create-continuous-fund cosmos1.. 100stake --cap=1000000stake --expiry=2023-01-01T00:00:00Z --title="Developer grant" --description="Funds the core developers" --deposit=1000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amountPerBlock, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			capStr, err := cmd.Flags().GetString(FlagCap)
+			if err != nil {
+				return err
+			}
+
+			fundCap, err := sdk.ParseCoinsNormalized(capStr)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := parseTimeFlag(cmd, FlagStartTime)
+			if err != nil {
+				return err
+			}
+
+			expiry, err := parseTimeFlag(cmd, FlagExpiry)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := protocolpool.NewCreateContinuousFundProposal(title, description, recipient, amountPerBlock, fundCap, startTime, expiry)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagCap, "", "The maximum total amount paid to the recipient")
+	cmd.Flags().String(FlagStartTime, "", "The block time from which the payments start in RFC3339 format")
+	cmd.Flags().String(FlagExpiry, "", "The block time from which the payments stop in RFC3339 format")
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitCancelContinuousFundProposal implements the command to submit a
+// proposal cancelling the continuous fund of a recipient.
+func NewCmdSubmitCancelContinuousFundProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-continuous-fund [recipient] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel the continuous fund of a recipient",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cancel the continuous fund of a recipient along with an initial deposit.
+
+Example:
+$ %s tx gov submit-proposal cancel-continuous-fund cosmos1.. --title="Cancel developer grant" --description="The grant is no longer needed" --deposit=1000stake --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := protocolpool.NewCancelContinuousFundProposal(title, description, recipient)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+}
+
+func parseProposalFlags(cmd *cobra.Command) (title, description string, deposit sdk.Coins, err error) {
+	if title, err = cmd.Flags().GetString(govcli.FlagTitle); err != nil {
+		return
+	}
+	if description, err = cmd.Flags().GetString(govcli.FlagDescription); err != nil {
+		return
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return
+	}
+	deposit, err = sdk.ParseCoinsNormalized(depositStr)
+	return
+}
+
+// parseTimeFlag parses an optional RFC3339 time flag.
+func parseTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	timeStr, err := cmd.Flags().GetString(flag)
+	if err != nil || timeStr == "" {
+		return nil, err
+	}
+
+	t, err := time.Parse(time.RFC3339, timeStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %s: %w", flag, timeStr, err)
+	}
+	t = t.UTC()
+
+	return &t, nil
+}
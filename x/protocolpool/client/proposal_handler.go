@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/client/cli"
+)
+
+// CreateContinuousFundProposalHandler is the create continuous fund proposal
+// handler, and CancelContinuousFundProposalHandler the cancel continuous fund
+// proposal handler.
+var (
+	CreateContinuousFundProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitCreateContinuousFundProposal)
+	CancelContinuousFundProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitCancelContinuousFundProposal)
+)
@@ -0,0 +1,17 @@
+/*
+Package protocolpool routes a share of the collected fees and minted
+provisions to a protocol pool, and pays continuous funding streams from it to
+recipients approved by governance, e.g. to fund the developers of the chain.
+
+At the beginning of each block, the FeeShare param of the balance of the fee
+collector, i.e. of the fees collected in the previous block and of the tokens
+minted in the current one, is sent to the protocol pool module account, before
+the distribution module allocates the rest.
+
+Governance creates a continuous fund with a CreateContinuousFundProposal or a
+MsgCreateContinuousFund of its authority, paying a fixed amount per block to a
+recipient from a start time, until an optional expiry or until the total amount
+paid reaches an optional cap. It stops a fund with a CancelContinuousFundProposal
+or a MsgCancelContinuousFund.
+*/
+package protocolpool
@@ -0,0 +1,12 @@
+package protocolpool
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/protocolpool module sentinel errors
+var (
+	ErrInvalidContinuousFund  = sdkerrors.Register(ModuleName, 2, "invalid continuous fund")
+	ErrContinuousFundNotFound = sdkerrors.Register(ModuleName, 3, "continuous fund not found")
+	ErrContinuousFundExists   = sdkerrors.Register(ModuleName, 4, "continuous fund already exists")
+)
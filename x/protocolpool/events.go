@@ -0,0 +1,20 @@
+package protocolpool
+
+// protocolpool module event types
+const (
+	EventTypeFundPool               = "fund_protocol_pool"
+	EventTypeCreateContinuousFund   = "create_continuous_fund"
+	EventTypeCancelContinuousFund   = "cancel_continuous_fund"
+	EventTypeCompleteContinuousFund = "complete_continuous_fund"
+	EventTypeContinuousFundPayout   = "continuous_fund_payout"
+
+	AttributeKeyRecipient      = "recipient"
+	AttributeKeyAmountPerBlock = "amount_per_block"
+	AttributeKeyCap            = "cap"
+	AttributeKeyPaid           = "paid"
+	AttributeKeyReason         = "reason"
+
+	AttributeValueExpired    = "expired"
+	AttributeValueCapReached = "cap_reached"
+	AttributeValueCategory   = ModuleName
+)
@@ -0,0 +1,104 @@
+package protocolpool
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewContinuousFund creates a new ContinuousFund paying the given amount per
+// block to the recipient from the start time, until the optional expiry or cap.
+//nolint:interfacer
+func NewContinuousFund(
+	recipient sdk.AccAddress, amountPerBlock, fundCap sdk.Coins, startTime time.Time, expiry *time.Time,
+) (ContinuousFund, error) {
+	fund := ContinuousFund{
+		Recipient:      recipient.String(),
+		AmountPerBlock: amountPerBlock,
+		Cap:            fundCap,
+		StartTime:      startTime,
+		Expiry:         expiry,
+	}
+	if err := fund.Validate(); err != nil {
+		return ContinuousFund{}, err
+	}
+
+	return fund, nil
+}
+
+// GetRecipient returns the address of the recipient of the fund.
+func (f ContinuousFund) GetRecipient() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(f.Recipient)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// Validate performs a stateless validation of the fund.
+func (f ContinuousFund) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(f.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if err := ValidateFundTerms(f.AmountPerBlock, f.Cap, &f.StartTime, f.Expiry); err != nil {
+		return err
+	}
+	if err := f.Paid.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidContinuousFund, "invalid paid amount: %s", err)
+	}
+
+	return nil
+}
+
+// IsStarted returns true if the payments of the fund started at the given
+// block time.
+func (f ContinuousFund) IsStarted(blockTime time.Time) bool {
+	return !blockTime.Before(f.StartTime)
+}
+
+// IsExpired returns true if the fund expired at the given block time.
+func (f ContinuousFund) IsExpired(blockTime time.Time) bool {
+	return f.Expiry != nil && !blockTime.Before(*f.Expiry)
+}
+
+// NextPayment returns the amount paid to the recipient at the next block,
+// limited to the amount remaining until the cap. It is empty once the cap is
+// reached.
+func (f ContinuousFund) NextPayment() sdk.Coins {
+	if f.Cap.Empty() {
+		return f.AmountPerBlock
+	}
+
+	payment := sdk.NewCoins()
+	for _, coin := range f.AmountPerBlock {
+		remaining := f.Cap.AmountOf(coin.Denom).Sub(f.Paid.AmountOf(coin.Denom))
+		if remaining.LT(coin.Amount) {
+			coin.Amount = remaining
+		}
+		if coin.IsPositive() {
+			payment = append(payment, coin)
+		}
+	}
+
+	return payment
+}
+
+// ValidateFundTerms validates the terms of a continuous fund. The start time
+// is optional.
+func ValidateFundTerms(amountPerBlock, fundCap sdk.Coins, startTime, expiry *time.Time) error {
+	if !amountPerBlock.IsValid() || amountPerBlock.Empty() {
+		return sdkerrors.Wrapf(ErrInvalidContinuousFund, "invalid amount per block %s", amountPerBlock)
+	}
+	if err := fundCap.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidContinuousFund, "invalid cap: %s", err)
+	}
+	if !fundCap.Empty() && !amountPerBlock.DenomsSubsetOf(fundCap) {
+		return sdkerrors.Wrapf(ErrInvalidContinuousFund, "cap %s doesn't cap every denom of the amount per block %s", fundCap, amountPerBlock)
+	}
+	if startTime != nil && expiry != nil && !expiry.After(*startTime) {
+		return sdkerrors.Wrapf(ErrInvalidContinuousFund, "expiry %s must be after the start time %s", expiry, startTime)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package protocolpool
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, continuousFunds []ContinuousFund) *GenesisState {
+	return &GenesisState{
+		Params:          params,
+		ContinuousFunds: continuousFunds,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the protocolpool
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// ValidateGenesis validates the protocolpool genesis state.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	recipients := make(map[string]bool, len(data.ContinuousFunds))
+	for _, fund := range data.ContinuousFunds {
+		if err := fund.Validate(); err != nil {
+			return err
+		}
+		if recipients[fund.Recipient] {
+			return fmt.Errorf("duplicate continuous fund of recipient %s", fund.Recipient)
+		}
+		recipients[fund.Recipient] = true
+	}
+
+	return nil
+}
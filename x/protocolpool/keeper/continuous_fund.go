@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+// GetContinuousFund returns the continuous fund of a recipient.
+func (k Keeper) GetContinuousFund(ctx sdk.Context, recipient sdk.AccAddress) (protocolpool.ContinuousFund, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(protocolpool.ContinuousFundKey(recipient))
+	if bz == nil {
+		return protocolpool.ContinuousFund{}, false
+	}
+
+	var fund protocolpool.ContinuousFund
+	k.cdc.MustUnmarshal(bz, &fund)
+	return fund, true
+}
+
+// SetContinuousFund sets the continuous fund of a recipient.
+func (k Keeper) SetContinuousFund(ctx sdk.Context, fund protocolpool.ContinuousFund) {
+	ctx.KVStore(k.storeKey).Set(protocolpool.ContinuousFundKey(fund.GetRecipient()), k.cdc.MustMarshal(&fund))
+}
+
+// DeleteContinuousFund deletes the continuous fund of a recipient.
+func (k Keeper) DeleteContinuousFund(ctx sdk.Context, recipient sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(protocolpool.ContinuousFundKey(recipient))
+}
+
+// IterateContinuousFunds iterates over the continuous funds, ordered by
+// recipient. If the cb returns true, the iterator will close and stop.
+func (k Keeper) IterateContinuousFunds(ctx sdk.Context, cb func(fund protocolpool.ContinuousFund) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), protocolpool.ContinuousFundKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fund protocolpool.ContinuousFund
+		k.cdc.MustUnmarshal(iterator.Value(), &fund)
+		if cb(fund) {
+			break
+		}
+	}
+}
+
+// GetContinuousFunds returns all the continuous funds, ordered by recipient.
+func (k Keeper) GetContinuousFunds(ctx sdk.Context) (funds []protocolpool.ContinuousFund) {
+	k.IterateContinuousFunds(ctx, func(fund protocolpool.ContinuousFund) bool {
+		funds = append(funds, fund)
+		return false
+	})
+	return funds
+}
+
+func (k Keeper) continuousFundsStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), protocolpool.ContinuousFundKeyPrefix)
+}
+
+// CreateContinuousFund creates a continuous fund paid from the protocol pool.
+// The payments start at the block time if no start time is given. A recipient
+// can only have a single continuous fund.
+func (k Keeper) CreateContinuousFund(
+	ctx sdk.Context, recipient sdk.AccAddress, amountPerBlock, fundCap sdk.Coins, startTime, expiry *time.Time,
+) error {
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient)
+	}
+	if _, found := k.GetContinuousFund(ctx, recipient); found {
+		return sdkerrors.Wrapf(protocolpool.ErrContinuousFundExists, "recipient %s", recipient)
+	}
+
+	blockTime := ctx.BlockTime()
+	if expiry != nil && !expiry.After(blockTime) {
+		return sdkerrors.Wrapf(protocolpool.ErrInvalidContinuousFund, "expiry %s must be after the block time %s", expiry, blockTime)
+	}
+
+	start := blockTime
+	if startTime != nil {
+		start = *startTime
+	}
+
+	fund, err := protocolpool.NewContinuousFund(recipient, amountPerBlock, fundCap, start, expiry)
+	if err != nil {
+		return err
+	}
+	k.SetContinuousFund(ctx, fund)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			protocolpool.EventTypeCreateContinuousFund,
+			sdk.NewAttribute(protocolpool.AttributeKeyRecipient, fund.Recipient),
+			sdk.NewAttribute(protocolpool.AttributeKeyAmountPerBlock, fund.AmountPerBlock.String()),
+			sdk.NewAttribute(protocolpool.AttributeKeyCap, fund.Cap.String()),
+		),
+	)
+
+	return nil
+}
+
+// CancelContinuousFund cancels the continuous fund of a recipient, and
+// returns the total amount paid by the fund.
+func (k Keeper) CancelContinuousFund(ctx sdk.Context, recipient sdk.AccAddress) (sdk.Coins, error) {
+	fund, found := k.GetContinuousFund(ctx, recipient)
+	if !found {
+		return nil, sdkerrors.Wrapf(protocolpool.ErrContinuousFundNotFound, "recipient %s", recipient)
+	}
+	k.DeleteContinuousFund(ctx, recipient)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			protocolpool.EventTypeCancelContinuousFund,
+			sdk.NewAttribute(protocolpool.AttributeKeyRecipient, fund.Recipient),
+			sdk.NewAttribute(protocolpool.AttributeKeyPaid, fund.Paid.String()),
+		),
+	)
+
+	return fund.Paid, nil
+}
+
+// FundPool sends the FeeShare param of the balance of the fee collector to the
+// protocol pool. It is called in BeginBlock, before the distribution module
+// allocates the collected fees.
+func (k Keeper) FundPool(ctx sdk.Context) {
+	feeShare := k.GetParams(ctx).FeeShare
+	if !feeShare.IsPositive() {
+		return
+	}
+
+	feeCollector := k.authKeeper.GetModuleAddress(k.feeCollectorName)
+	fees := k.bankKeeper.GetAllBalances(ctx, feeCollector)
+	share, _ := sdk.NewDecCoinsFromCoins(fees...).MulDecTruncate(feeShare).TruncateDecimal()
+	if share.IsZero() {
+		return
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, protocolpool.ModuleName, share); err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			protocolpool.EventTypeFundPool,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, share.String()),
+		),
+	)
+}
+
+// PayContinuousFunds pays the started continuous funds from the protocol
+// pool, in the order of their recipients. The payment of a fund is skipped for
+// the block if the pool can't pay it in full. The expired funds, and the funds
+// whose cap is reached, are completed and deleted.
+func (k Keeper) PayContinuousFunds(ctx sdk.Context) {
+	blockTime := ctx.BlockTime()
+	pool := k.GetPool(ctx)
+
+	for _, fund := range k.GetContinuousFunds(ctx) {
+		if fund.IsExpired(blockTime) {
+			k.completeContinuousFund(ctx, fund, protocolpool.AttributeValueExpired)
+			continue
+		}
+		if !fund.IsStarted(blockTime) {
+			continue
+		}
+
+		payment := fund.NextPayment()
+		if !pool.IsAllGTE(payment) {
+			continue
+		}
+
+		recipient := fund.GetRecipient()
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, protocolpool.ModuleName, recipient, payment); err != nil {
+			panic(err)
+		}
+		pool = pool.Sub(payment)
+		fund.Paid = fund.Paid.Add(payment...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				protocolpool.EventTypeContinuousFundPayout,
+				sdk.NewAttribute(protocolpool.AttributeKeyRecipient, fund.Recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, payment.String()),
+			),
+		)
+
+		if !fund.Cap.Empty() && fund.NextPayment().Empty() {
+			k.completeContinuousFund(ctx, fund, protocolpool.AttributeValueCapReached)
+			continue
+		}
+		k.SetContinuousFund(ctx, fund)
+	}
+}
+
+// completeContinuousFund deletes a completed continuous fund.
+func (k Keeper) completeContinuousFund(ctx sdk.Context, fund protocolpool.ContinuousFund, reason string) {
+	k.DeleteContinuousFund(ctx, fund.GetRecipient())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			protocolpool.EventTypeCompleteContinuousFund,
+			sdk.NewAttribute(protocolpool.AttributeKeyRecipient, fund.Recipient),
+			sdk.NewAttribute(protocolpool.AttributeKeyPaid, fund.Paid.String()),
+			sdk.NewAttribute(protocolpool.AttributeKeyReason, reason),
+		),
+	)
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+// InitGenesis initializes the protocolpool module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *protocolpool.GenesisState) {
+	// ensure the module account is set, as it holds the pool
+	k.authKeeper.GetModuleAccount(ctx, protocolpool.ModuleName)
+
+	k.SetParams(ctx, data.Params)
+
+	for _, fund := range data.ContinuousFunds {
+		k.SetContinuousFund(ctx, fund)
+	}
+}
+
+// ExportGenesis returns the protocolpool module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *protocolpool.GenesisState {
+	return protocolpool.NewGenesisState(k.GetParams(ctx), k.GetContinuousFunds(ctx))
+}
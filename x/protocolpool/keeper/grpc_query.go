@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+var _ protocolpool.QueryServer = Keeper{}
+
+// Params returns the protocolpool params.
+func (k Keeper) Params(c context.Context, req *protocolpool.QueryParamsRequest) (*protocolpool.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &protocolpool.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Pool returns the balance of the protocol pool.
+func (k Keeper) Pool(c context.Context, req *protocolpool.QueryPoolRequest) (*protocolpool.QueryPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &protocolpool.QueryPoolResponse{Pool: k.GetPool(ctx)}, nil
+}
+
+// ContinuousFund returns the continuous fund of a recipient.
+func (k Keeper) ContinuousFund(c context.Context, req *protocolpool.QueryContinuousFundRequest) (*protocolpool.QueryContinuousFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	fund, found := k.GetContinuousFund(ctx, recipient)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "continuous fund of %s not found", req.Recipient)
+	}
+
+	return &protocolpool.QueryContinuousFundResponse{ContinuousFund: fund}, nil
+}
+
+// ContinuousFunds returns all the continuous funds.
+func (k Keeper) ContinuousFunds(c context.Context, req *protocolpool.QueryContinuousFundsRequest) (*protocolpool.QueryContinuousFundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var funds []protocolpool.ContinuousFund
+	pageRes, err := query.Paginate(k.continuousFundsStore(ctx), req.Pagination, func(_ []byte, value []byte) error {
+		var fund protocolpool.ContinuousFund
+		if err := k.cdc.Unmarshal(value, &fund); err != nil {
+			return err
+		}
+		funds = append(funds, fund)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &protocolpool.QueryContinuousFundsResponse{ContinuousFunds: funds, Pagination: pageRes}, nil
+}
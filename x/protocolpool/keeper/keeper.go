@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+// Keeper manages the protocol pool and the continuous funds paid from it.
+type Keeper struct {
+	cdc              codec.BinaryCodec
+	storeKey         storetypes.StoreKey
+	paramSpace       paramtypes.Subspace
+	authKeeper       protocolpool.AccountKeeper
+	bankKeeper       protocolpool.BankKeeper
+	feeCollectorName string
+
+	// the address capable of executing the continuous fund Msgs. Typically,
+	// this should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a protocolpool Keeper. A share of the balance of the given
+// fee collector module account is routed to the protocol pool at each block.
+func NewKeeper(
+	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	ak protocolpool.AccountKeeper, bk protocolpool.BankKeeper, feeCollectorName string, authority string,
+) Keeper {
+	// ensure the protocolpool module account is set
+	if addr := ak.GetModuleAddress(protocolpool.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", protocolpool.ModuleName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(protocolpool.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         storeKey,
+		paramSpace:       paramSpace,
+		authKeeper:       ak,
+		bankKeeper:       bk,
+		feeCollectorName: feeCollectorName,
+		authority:        authority,
+	}
+}
+
+// GetAuthority returns the address capable of executing the continuous fund
+// Msgs.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", protocolpool.ModuleName))
+}
+
+// GetParams returns the protocolpool params.
+func (k Keeper) GetParams(ctx sdk.Context) (params protocolpool.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the protocolpool params.
+func (k Keeper) SetParams(ctx sdk.Context, params protocolpool.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetPool returns the balance of the protocol pool.
+func (k Keeper) GetPool(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.authKeeper.GetModuleAddress(protocolpool.ModuleName))
+}
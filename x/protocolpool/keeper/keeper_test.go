@@ -0,0 +1,243 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool/keeper"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app     *simapp.SimApp
+	ctx     sdk.Context
+	addrs   []sdk.AccAddress
+	keeper  keeper.Keeper
+	msgSrvr protocolpool.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Unix(1_000_000_000, 0).UTC()})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	suite.keeper = app.ProtocolPoolKeeper
+	suite.msgSrvr = keeper.NewMsgServerImpl(suite.keeper)
+}
+
+func stake(amt int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amt))
+}
+
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) int64 {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, addr, sdk.DefaultBondDenom).Amount.Int64()
+}
+
+func (suite *KeeperTestSuite) fundPool(amt int64) {
+	suite.Require().NoError(banktestutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, protocolpool.ModuleName, stake(amt)))
+}
+
+func (suite *KeeperTestSuite) createFund(
+	recipient sdk.AccAddress, amountPerBlock, fundCap sdk.Coins, startTime, expiry *time.Time,
+) error {
+	msg := protocolpool.NewMsgCreateContinuousFund(suite.keeper.GetAuthority(), recipient, amountPerBlock, fundCap, startTime, expiry)
+	_, err := suite.msgSrvr.CreateContinuousFund(sdk.WrapSDKContext(suite.ctx), msg)
+	return err
+}
+
+// beginBlock moves to the given block time and pays the continuous funds.
+func (suite *KeeperTestSuite) beginBlock(t time.Time) {
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1).WithBlockTime(t).WithEventManager(sdk.NewEventManager())
+	suite.keeper.FundPool(suite.ctx)
+	suite.keeper.PayContinuousFunds(suite.ctx)
+}
+
+func (suite *KeeperTestSuite) TestFundPool() {
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().NoError(banktestutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, authtypes.FeeCollectorName, stake(1001)))
+
+	// nothing is routed to the pool by default
+	suite.beginBlock(suite.ctx.BlockTime())
+	suite.Require().True(suite.keeper.GetPool(suite.ctx).IsZero())
+
+	params := protocolpool.DefaultParams()
+	params.FeeShare = sdk.NewDecWithPrec(1, 1)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// the share is truncated
+	suite.beginBlock(suite.ctx.BlockTime())
+	suite.Require().Equal(stake(100), suite.keeper.GetPool(suite.ctx))
+	suite.Require().Equal(int64(901), suite.balance(feeCollector))
+}
+
+func (suite *KeeperTestSuite) TestContinuousFund() {
+	recipient := suite.addrs[0]
+	recipientBalance := suite.balance(recipient)
+	start := suite.ctx.BlockTime().Add(time.Minute)
+	suite.fundPool(1000)
+
+	// only the authority can create a fund
+	msg := protocolpool.NewMsgCreateContinuousFund(recipient.String(), recipient, stake(100), stake(250), &start, nil)
+	_, err := suite.msgSrvr.CreateContinuousFund(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	suite.Require().NoError(suite.createFund(recipient, stake(100), stake(250), &start, nil))
+	suite.Require().ErrorIs(suite.createFund(recipient, stake(10), nil, nil, nil), protocolpool.ErrContinuousFundExists)
+
+	// the payments start at the start time
+	suite.beginBlock(start.Add(-time.Second))
+	suite.Require().Equal(recipientBalance, suite.balance(recipient))
+
+	suite.beginBlock(start)
+	suite.beginBlock(start.Add(time.Second))
+	suite.Require().Equal(recipientBalance+200, suite.balance(recipient))
+	fund, found := suite.keeper.GetContinuousFund(suite.ctx, recipient)
+	suite.Require().True(found)
+	suite.Require().Equal(stake(200), fund.Paid)
+
+	// the last payment is limited by the cap, which completes the fund
+	suite.beginBlock(start.Add(2 * time.Second))
+	suite.Require().Equal(recipientBalance+250, suite.balance(recipient))
+	suite.Require().Equal(stake(750), suite.keeper.GetPool(suite.ctx))
+	_, found = suite.keeper.GetContinuousFund(suite.ctx, recipient)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestContinuousFundExpiry() {
+	recipient := suite.addrs[0]
+	recipientBalance := suite.balance(recipient)
+	expiry := suite.ctx.BlockTime().Add(time.Minute)
+	suite.fundPool(1000)
+
+	blockTime := suite.ctx.BlockTime()
+	suite.Require().ErrorIs(suite.createFund(recipient, stake(100), nil, nil, &blockTime), protocolpool.ErrInvalidContinuousFund)
+	suite.Require().NoError(suite.createFund(recipient, stake(100), nil, nil, &expiry))
+
+	suite.beginBlock(expiry.Add(-time.Second))
+	suite.Require().Equal(recipientBalance+100, suite.balance(recipient))
+
+	suite.beginBlock(expiry)
+	suite.Require().Equal(recipientBalance+100, suite.balance(recipient))
+	_, found := suite.keeper.GetContinuousFund(suite.ctx, recipient)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestInsufficientPool() {
+	first, second := suite.addrs[0], suite.addrs[1]
+	if bytes.Compare(first, second) > 0 {
+		first, second = second, first
+	}
+	firstBalance, secondBalance := suite.balance(first), suite.balance(second)
+	suite.fundPool(150)
+
+	suite.Require().NoError(suite.createFund(first, stake(100), nil, nil, nil))
+	suite.Require().NoError(suite.createFund(second, stake(100), nil, nil, nil))
+
+	// the funds are paid in the order of their recipients, and the payments
+	// the pool can't make in full are skipped
+	suite.beginBlock(suite.ctx.BlockTime())
+	suite.Require().Equal(firstBalance+100, suite.balance(first))
+	suite.Require().Equal(secondBalance, suite.balance(second))
+	suite.Require().Equal(stake(50), suite.keeper.GetPool(suite.ctx))
+
+	suite.fundPool(50)
+	suite.beginBlock(suite.ctx.BlockTime())
+	suite.Require().Equal(firstBalance+200, suite.balance(first))
+	suite.Require().Equal(secondBalance, suite.balance(second))
+}
+
+func (suite *KeeperTestSuite) TestCancelContinuousFund() {
+	recipient := suite.addrs[0]
+	suite.fundPool(1000)
+	suite.Require().NoError(suite.createFund(recipient, stake(100), nil, nil, nil))
+	suite.beginBlock(suite.ctx.BlockTime())
+
+	msg := protocolpool.NewMsgCancelContinuousFund(recipient.String(), recipient)
+	_, err := suite.msgSrvr.CancelContinuousFund(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	msg = protocolpool.NewMsgCancelContinuousFund(suite.keeper.GetAuthority(), recipient)
+	res, err := suite.msgSrvr.CancelContinuousFund(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(stake(100), res.Paid)
+
+	_, err = suite.msgSrvr.CancelContinuousFund(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, protocolpool.ErrContinuousFundNotFound)
+}
+
+func (suite *KeeperTestSuite) TestBlockedRecipient() {
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().ErrorIs(suite.createFund(feeCollector, stake(100), nil, nil, nil), sdkerrors.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestContinuousFundProposalHandler() {
+	recipient := suite.addrs[0]
+	handler := keeper.NewContinuousFundProposalHandler(suite.keeper)
+
+	create := protocolpool.NewCreateContinuousFundProposal("title", "description", recipient, stake(100), nil, nil, nil)
+	suite.Require().NoError(create.ValidateBasic())
+	suite.Require().NoError(handler(suite.ctx, create))
+	fund, found := suite.keeper.GetContinuousFund(suite.ctx, recipient)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.ctx.BlockTime(), fund.StartTime)
+
+	cancel := protocolpool.NewCancelContinuousFundProposal("title", "description", recipient)
+	suite.Require().NoError(cancel.ValidateBasic())
+	suite.Require().NoError(handler(suite.ctx, cancel))
+	_, found = suite.keeper.GetContinuousFund(suite.ctx, recipient)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestScheduleContinuousFundMsg() {
+	recipient := suite.addrs[0]
+
+	// governance schedules the Msg, which is executed by the scheduler
+	msg := protocolpool.NewMsgCreateContinuousFund(suite.keeper.GetAuthority(), recipient, stake(100), nil, nil, nil)
+	content, err := scheduler.NewScheduleMsgProposal("title", "description", msg, 12, nil, 1, 0, 0)
+	suite.Require().NoError(err)
+	suite.Require().NoError(content.ValidateBasic())
+
+	handler := suite.app.GovKeeper.Router().GetRoute(content.ProposalRoute())
+	suite.Require().NoError(handler(suite.ctx, content))
+	_, found := suite.keeper.GetContinuousFund(suite.ctx, recipient)
+	suite.Require().False(found)
+
+	suite.ctx = suite.ctx.WithBlockHeight(12)
+	suite.app.SchedulerKeeper.ExecuteDueSchedules(suite.ctx)
+	_, found = suite.keeper.GetContinuousFund(suite.ctx, recipient)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	params := protocolpool.NewParams(sdk.NewDecWithPrec(2, 2))
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(suite.createFund(suite.addrs[0], stake(100), stake(1000), nil, nil))
+	suite.Require().NoError(suite.createFund(suite.addrs[1], stake(10), nil, nil, nil))
+
+	genesis := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(protocolpool.ValidateGenesis(*genesis))
+	suite.Require().Len(genesis.ContinuousFunds, 2)
+
+	suite.SetupTest()
+	suite.keeper.InitGenesis(suite.ctx, genesis)
+	suite.Require().Equal(params, suite.keeper.GetParams(suite.ctx))
+	suite.Require().Equal(genesis.ContinuousFunds, suite.keeper.GetContinuousFunds(suite.ctx))
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the protocolpool MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(k Keeper) protocolpool.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ protocolpool.MsgServer = msgServer{}
+
+// CreateContinuousFund implements Msg/CreateContinuousFund. Only the authority
+// of the keeper can create continuous funds.
+func (k msgServer) CreateContinuousFund(goCtx context.Context, msg *protocolpool.MsgCreateContinuousFund) (*protocolpool.MsgCreateContinuousFundResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CreateContinuousFund(ctx, recipient, msg.AmountPerBlock, msg.Cap, msg.StartTime, msg.Expiry); err != nil {
+		return nil, err
+	}
+
+	return &protocolpool.MsgCreateContinuousFundResponse{}, nil
+}
+
+// CancelContinuousFund implements Msg/CancelContinuousFund. Only the authority
+// of the keeper can cancel continuous funds.
+func (k msgServer) CancelContinuousFund(goCtx context.Context, msg *protocolpool.MsgCancelContinuousFund) (*protocolpool.MsgCancelContinuousFundResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	paid, err := k.Keeper.CancelContinuousFund(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protocolpool.MsgCancelContinuousFundResponse{Paid: paid}, nil
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+// NewContinuousFundProposalHandler returns the governance handler of the
+// proposals creating and cancelling continuous funds.
+func NewContinuousFundProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *protocolpool.CreateContinuousFundProposal:
+			recipient, err := sdk.AccAddressFromBech32(c.Recipient)
+			if err != nil {
+				return err
+			}
+
+			msg := protocolpool.NewMsgCreateContinuousFund(k.GetAuthority(), recipient, c.AmountPerBlock, c.Cap, c.StartTime, c.Expiry)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			_, err = NewMsgServerImpl(k).CreateContinuousFund(sdk.WrapSDKContext(ctx), msg)
+			return err
+
+		case *protocolpool.CancelContinuousFundProposal:
+			recipient, err := sdk.AccAddressFromBech32(c.Recipient)
+			if err != nil {
+				return err
+			}
+
+			msg := protocolpool.NewMsgCancelContinuousFund(k.GetAuthority(), recipient)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			_, err = NewMsgServerImpl(k).CancelContinuousFund(sdk.WrapSDKContext(ctx), msg)
+			return err
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized protocolpool proposal content type: %T", c)
+		}
+	}
+}
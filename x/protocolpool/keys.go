@@ -0,0 +1,31 @@
+package protocolpool
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "protocolpool"
+
+	// StoreKey is the store key string for protocolpool
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for protocolpool
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for protocolpool
+	QuerierRoute = ModuleName
+)
+
+var (
+	// ContinuousFundKeyPrefix is the prefix of the continuous funds by
+	// recipient
+	ContinuousFundKeyPrefix = []byte{0x01}
+)
+
+// ContinuousFundKey returns the key of the continuous fund of a recipient.
+func ContinuousFundKey(recipient sdk.AccAddress) []byte {
+	return append(ContinuousFundKeyPrefix, address.MustLengthPrefix(recipient)...)
+}
@@ -0,0 +1,102 @@
+package protocolpool
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_, _ sdk.Msg            = &MsgCreateContinuousFund{}, &MsgCancelContinuousFund{}
+	_, _ legacytx.LegacyMsg = &MsgCreateContinuousFund{}, &MsgCancelContinuousFund{} // For amino support.
+)
+
+// NewMsgCreateContinuousFund creates a new MsgCreateContinuousFund.
+//nolint:interfacer
+func NewMsgCreateContinuousFund(
+	authority string, recipient sdk.AccAddress, amountPerBlock, fundCap sdk.Coins, startTime, expiry *time.Time,
+) *MsgCreateContinuousFund {
+	return &MsgCreateContinuousFund{
+		Authority:      authority,
+		Recipient:      recipient.String(),
+		AmountPerBlock: amountPerBlock,
+		Cap:            fundCap,
+		StartTime:      startTime,
+		Expiry:         expiry,
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateContinuousFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return ValidateFundTerms(msg.AmountPerBlock, msg.Cap, msg.StartTime, msg.Expiry)
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateContinuousFund) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgCreateContinuousFund) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgCreateContinuousFund) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgCreateContinuousFund) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgCancelContinuousFund creates a new MsgCancelContinuousFund.
+//nolint:interfacer
+func NewMsgCancelContinuousFund(authority string, recipient sdk.AccAddress) *MsgCancelContinuousFund {
+	return &MsgCancelContinuousFund{Authority: authority, Recipient: recipient.String()}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelContinuousFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCancelContinuousFund) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgCancelContinuousFund) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgCancelContinuousFund) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgCancelContinuousFund) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
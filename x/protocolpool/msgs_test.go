@@ -0,0 +1,73 @@
+package protocolpool_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+func TestMsgCreateContinuousFund(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	recipient := sdk.AccAddress("addr1_______________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	start := time.Unix(1_000_000_000, 0).UTC()
+	expiry := start.Add(time.Hour)
+
+	cases := map[string]struct {
+		authority      string
+		amountPerBlock sdk.Coins
+		cap            sdk.Coins
+		startTime      *time.Time
+		expiry         *time.Time
+		valid          bool
+	}{
+		"no cap nor expiry":      {authority, amount, nil, nil, nil, true},
+		"capped":                 {authority, amount, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), nil, nil, true},
+		"with start and expiry":  {authority, amount, nil, &start, &expiry, true},
+		"expiry before start":    {authority, amount, nil, &expiry, &start, false},
+		"no amount":              {authority, sdk.Coins{}, nil, nil, nil, false},
+		"cap of another denom":   {authority, amount, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), nil, nil, false},
+		"invalid authority":      {"foo", amount, nil, nil, nil, false},
+		"invalid amount":         {authority, sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}}, nil, nil, nil, false},
+		"cap of a smaller value": {authority, amount, sdk.NewCoins(sdk.NewInt64Coin("atom", 5)), nil, nil, true},
+	}
+
+	for name, tc := range cases {
+		msg := protocolpool.NewMsgCreateContinuousFund(tc.authority, recipient, tc.amountPerBlock, tc.cap, tc.startTime, tc.expiry)
+
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, name)
+		} else {
+			require.Error(t, err, name)
+		}
+	}
+}
+
+func TestMsgCancelContinuousFund(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	recipient := sdk.AccAddress("addr1_______________")
+
+	require.NoError(t, protocolpool.NewMsgCancelContinuousFund(authority, recipient).ValidateBasic())
+	require.Error(t, protocolpool.NewMsgCancelContinuousFund("foo", recipient).ValidateBasic())
+	require.Error(t, (&protocolpool.MsgCancelContinuousFund{Authority: authority, Recipient: "foo"}).ValidateBasic())
+}
+
+func TestContinuousFundNextPayment(t *testing.T) {
+	fund := protocolpool.ContinuousFund{
+		AmountPerBlock: sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 10)),
+	}
+	require.Equal(t, fund.AmountPerBlock, fund.NextPayment())
+
+	// the payment is limited to the amount remaining until the cap
+	fund.Cap = sdk.NewCoins(sdk.NewInt64Coin("atom", 25), sdk.NewInt64Coin("stake", 100))
+	fund.Paid = sdk.NewCoins(sdk.NewInt64Coin("atom", 20), sdk.NewInt64Coin("stake", 20))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 10)), fund.NextPayment())
+
+	fund.Paid = sdk.NewCoins(sdk.NewInt64Coin("atom", 25), sdk.NewInt64Coin("stake", 100))
+	require.True(t, fund.NextPayment().Empty())
+}
@@ -0,0 +1,72 @@
+package protocolpool
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys of the protocolpool params.
+var (
+	KeyFeeShare = []byte("FeeShare")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table for the protocolpool module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(feeShare sdk.Dec) Params {
+	return Params{
+		FeeShare: feeShare,
+	}
+}
+
+// DefaultParams returns the default protocolpool params, routing nothing to
+// the protocol pool.
+func DefaultParams() Params {
+	return NewParams(sdk.ZeroDec())
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeShare, &p.FeeShare, validateFeeShare),
+	}
+}
+
+// Validate performs basic validation of the protocolpool params.
+func (p Params) Validate() error {
+	return validateFeeShare(p.FeeShare)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateFeeShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("fee share must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("fee share must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee share too large: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package protocolpool
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeCreateContinuousFund defines the type for a
+	// CreateContinuousFundProposal
+	ProposalTypeCreateContinuousFund = "CreateContinuousFund"
+	// ProposalTypeCancelContinuousFund defines the type for a
+	// CancelContinuousFundProposal
+	ProposalTypeCancelContinuousFund = "CancelContinuousFund"
+)
+
+var (
+	_ govtypes.Content = &CreateContinuousFundProposal{}
+	_ govtypes.Content = &CancelContinuousFundProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCreateContinuousFund)
+	govtypes.RegisterProposalTypeCodec(&CreateContinuousFundProposal{}, "cosmos-sdk/CreateContinuousFundProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelContinuousFund)
+	govtypes.RegisterProposalTypeCodec(&CancelContinuousFundProposal{}, "cosmos-sdk/CancelContinuousFundProposal")
+}
+
+// NewCreateContinuousFundProposal creates a new proposal creating a
+// continuous fund.
+//nolint:interfacer
+func NewCreateContinuousFundProposal(
+	title, description string, recipient sdk.AccAddress, amountPerBlock, fundCap sdk.Coins, startTime, expiry *time.Time,
+) *CreateContinuousFundProposal {
+	return &CreateContinuousFundProposal{
+		Title:          title,
+		Description:    description,
+		Recipient:      recipient.String(),
+		AmountPerBlock: amountPerBlock,
+		Cap:            fundCap,
+		StartTime:      startTime,
+		Expiry:         expiry,
+	}
+}
+
+// GetTitle returns the title of a create continuous fund proposal.
+func (p *CreateContinuousFundProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a create continuous fund proposal.
+func (p *CreateContinuousFundProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a create continuous fund proposal.
+func (p *CreateContinuousFundProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a create continuous fund proposal.
+func (p *CreateContinuousFundProposal) ProposalType() string {
+	return ProposalTypeCreateContinuousFund
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *CreateContinuousFundProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return ValidateFundTerms(p.AmountPerBlock, p.Cap, p.StartTime, p.Expiry)
+}
+
+// String implements the Stringer interface.
+func (p CreateContinuousFundProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Create Continuous Fund Proposal:
+  Title:            %s
+  Description:      %s
+  Recipient:        %s
+  Amount Per Block: %s
+  Cap:              %s
+  Start Time:       %v
+  Expiry:           %v
+`, p.Title, p.Description, p.Recipient, p.AmountPerBlock, p.Cap, p.StartTime, p.Expiry))
+	return b.String()
+}
+
+// NewCancelContinuousFundProposal creates a new proposal cancelling the
+// continuous fund of a recipient.
+//nolint:interfacer
+func NewCancelContinuousFundProposal(title, description string, recipient sdk.AccAddress) *CancelContinuousFundProposal {
+	return &CancelContinuousFundProposal{
+		Title:       title,
+		Description: description,
+		Recipient:   recipient.String(),
+	}
+}
+
+// GetTitle returns the title of a cancel continuous fund proposal.
+func (p *CancelContinuousFundProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a cancel continuous fund proposal.
+func (p *CancelContinuousFundProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a cancel continuous fund proposal.
+func (p *CancelContinuousFundProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel continuous fund proposal.
+func (p *CancelContinuousFundProposal) ProposalType() string {
+	return ProposalTypeCancelContinuousFund
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *CancelContinuousFundProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p CancelContinuousFundProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Continuous Fund Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+`, p.Title, p.Description, p.Recipient))
+	return b.String()
+}
@@ -0,0 +1,26 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding protocolpool type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], protocolpool.ContinuousFundKeyPrefix):
+			var fundA, fundB protocolpool.ContinuousFund
+			cdc.MustUnmarshal(kvA.Value, &fundA)
+			cdc.MustUnmarshal(kvB.Value, &fundB)
+			return fmt.Sprintf("%v\n%v", fundA, fundB)
+		default:
+			panic(fmt.Sprintf("invalid protocolpool key %X", kvA.Key))
+		}
+	}
+}
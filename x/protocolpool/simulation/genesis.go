@@ -0,0 +1,40 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/protocolpool"
+)
+
+// Simulation parameter constants
+const (
+	feeShare = "fee_share"
+)
+
+// GenFeeShare randomized FeeShare
+func GenFeeShare(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(11)), 2)
+}
+
+// RandomizedGenState generates a random GenesisState for protocolpool, without
+// continuous funds.
+func RandomizedGenState(simState *module.SimulationState) {
+	var share sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, feeShare, &share, simState.Rand,
+		func(r *rand.Rand) { share = GenFeeShare(r) },
+	)
+
+	genesis := protocolpool.NewGenesisState(protocolpool.NewParams(share), nil)
+
+	bz, err := json.MarshalIndent(&genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated protocolpool parameters:\n%s\n", bz)
+	simState.GenState[protocolpool.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
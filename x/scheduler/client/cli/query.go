@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        scheduler.ModuleName,
+		Short:                      "Querying commands for the scheduler module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQuerySchedule(),
+		GetCmdQuerySchedules(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryParams returns the command to query the scheduler params.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current scheduler parameters",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := scheduler.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &scheduler.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySchedule returns the command to query a schedule by id.
+func GetCmdQuerySchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [schedule-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a schedule by its id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a schedule by its id.
+
+Example:
+$ %s query %s schedule 1
+`, version.AppName, scheduler.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := scheduler.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("schedule-id %s not a valid uint", args[0])
+			}
+
+			res, err := queryClient.Schedule(cmd.Context(), &scheduler.QueryScheduleRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Schedule)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySchedules returns the command to query the schedules of an owner.
+func GetCmdQuerySchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedules [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the schedules of an owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the schedules of an owner.
+
+Example:
+$ %s query %s schedules cosmos1..
+`, version.AppName, scheduler.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := scheduler.NewQueryClient(clientCtx)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Schedules(cmd.Context(), &scheduler.QuerySchedulesRequest{Owner: owner.String(), Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "schedules")
+
+	return cmd
+}
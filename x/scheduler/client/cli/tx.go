@@ -0,0 +1,283 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+// flags for the scheduler module
+const (
+	FlagExecuteHeight  = "execute-height"
+	FlagExecuteTime    = "execute-time"
+	FlagExecutions     = "executions"
+	FlagIntervalBlocks = "interval-blocks"
+	FlagInterval       = "interval"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        scheduler.ModuleName,
+		Short:                      "Scheduler transactions subcommands",
+		Long:                       "Schedule Msgs for execution at a future height or time and cancel schedules",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCmdCreateSchedule(),
+		NewCmdCancelSchedule(),
+	)
+
+	return txCmd
+}
+
+// NewCmdCreateSchedule returns a CLI command handler for creating a
+// MsgCreateSchedule transaction.
+func NewCmdCreateSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [msg-json-file]",
+		Short: "Schedule a Msg of the sender for execution at a future height or time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Schedule a Msg of the sender, read from a JSON file, for execution at a future
+height or time, once or repeatedly. The execution fee of each execution is prepaid.
+
+Example:
+$ %s tx %s create msg.json --execute-height=1000 --executions=12 --interval-blocks=100000 --from=mykey
+
+Where msg.json contains:
+
+{
+  "@type": "/cosmos.bank.v1beta1.MsgSend",
+  "from_address": "cosmos1..",
+  "to_address": "cosmos1..",
+  "amount": [{"denom": "stake", "amount": "10"}]
+}
+`, version.AppName, scheduler.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scheduled, err := readMsg(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			timing, err := parseTiming(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := scheduler.NewMsgCreateSchedule(
+				clientCtx.GetFromAddress(), scheduled, timing.executeHeight, timing.executeTime,
+				timing.executions, timing.intervalBlocks, timing.interval,
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addTimingFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCancelSchedule returns a CLI command handler for creating a
+// MsgCancelSchedule transaction.
+func NewCmdCancelSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [schedule-id]",
+		Short: "Cancel a schedule of the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a schedule of the sender, refunding the fees of its remaining executions.
+
+Example:
+$ %s tx %s cancel 1 --from=mykey
+`, version.AppName, scheduler.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("schedule-id %s not a valid uint", args[0])
+			}
+
+			msg := scheduler.NewMsgCancelSchedule(clientCtx.GetFromAddress(), id)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitScheduleMsgProposal implements the command to submit a
+// schedule-msg proposal.
+func NewCmdSubmitScheduleMsgProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-msg [msg-json-file] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal scheduling a Msg of the governance module account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal scheduling a Msg of the governance module account, read
+from a JSON file, for execution at a future height or time, along with an initial deposit.
+
+Example:
+$ %s tx gov submit-proposal schedule-msg msg.json --execute-time=2022-01-01T00:00:00Z --title="..." --description="..." --deposit=1000stake --from=mykey
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scheduled, err := readMsg(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			timing, err := parseTiming(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := scheduler.NewScheduleMsgProposal(
+				title, description, scheduled, timing.executeHeight, timing.executeTime,
+				timing.executions, timing.intervalBlocks, timing.interval,
+			)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addTimingFlags(cmd)
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
+
+// timing holds the first execution and the interval of the executions of a
+// schedule.
+type timing struct {
+	executeHeight  int64
+	executeTime    *time.Time
+	executions     uint32
+	intervalBlocks int64
+	interval       time.Duration
+}
+
+func addTimingFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64(FlagExecuteHeight, 0, "The height of the first execution, for a schedule by height")
+	cmd.Flags().String(FlagExecuteTime, "", "The block time of the first execution in RFC3339 format, for a schedule by time")
+	cmd.Flags().Uint32(FlagExecutions, 1, "The number of executions")
+	cmd.Flags().Int64(FlagIntervalBlocks, 0, "The number of blocks between the executions of a schedule by height")
+	cmd.Flags().Duration(FlagInterval, 0, "The duration between the executions of a schedule by time")
+}
+
+func parseTiming(cmd *cobra.Command) (t timing, err error) {
+	if t.executeHeight, err = cmd.Flags().GetInt64(FlagExecuteHeight); err != nil {
+		return t, err
+	}
+	if t.executions, err = cmd.Flags().GetUint32(FlagExecutions); err != nil {
+		return t, err
+	}
+	if t.intervalBlocks, err = cmd.Flags().GetInt64(FlagIntervalBlocks); err != nil {
+		return t, err
+	}
+	if t.interval, err = cmd.Flags().GetDuration(FlagInterval); err != nil {
+		return t, err
+	}
+
+	timeStr, err := cmd.Flags().GetString(FlagExecuteTime)
+	if err != nil {
+		return t, err
+	}
+	if timeStr != "" {
+		executeTime, err := time.Parse(time.RFC3339, timeStr)
+		if err != nil {
+			return t, fmt.Errorf("invalid execute time %s: %w", timeStr, err)
+		}
+		executeTime = executeTime.UTC()
+		t.executeTime = &executeTime
+	}
+
+	return t, nil
+}
+
+// readMsg reads a Msg from a JSON file.
+func readMsg(clientCtx client.Context, path string) (sdk.Msg, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg sdk.Msg
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &msg); err != nil {
+		return nil, fmt.Errorf("failed to parse the msg of %s: %w", path, err)
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/client/cli"
+)
+
+// ProposalHandler is the schedule msg proposal handler.
+var (
+	ProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitScheduleMsgProposal)
+)
@@ -0,0 +1,17 @@
+/*
+Package scheduler lets accounts and governance schedule a Msg for execution at
+a future height or time, once or repeatedly, e.g. for vesting claims, recurring
+payments or automated governance actions.
+
+An account schedules one of its Msgs with MsgCreateSchedule, prepaying the
+execution fee of each execution, and can cancel it with MsgCancelSchedule to get
+the fees of the remaining executions back. Governance schedules the Msgs of its
+module account with a ScheduleMsgProposal, without fees.
+
+The due Msgs are executed in EndBlock, on behalf of their owner, through a
+ModuleMsgRouter of the auth middleware, which restricts the types of the Msgs
+which can be scheduled. Each execution has a gas limit, and the number of
+executions per block is capped, the due executions above the cap being deferred
+to the next blocks. A failed execution doesn't cancel the next ones.
+*/
+package scheduler
@@ -0,0 +1,16 @@
+package scheduler
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/scheduler module sentinel errors
+var (
+	ErrInvalidSchedule    = sdkerrors.Register(ModuleName, 2, "invalid schedule")
+	ErrScheduleNotFound   = sdkerrors.Register(ModuleName, 3, "schedule not found")
+	ErrMsgNotSchedulable  = sdkerrors.Register(ModuleName, 4, "msg type can't be scheduled")
+	ErrTooManyExecutions  = sdkerrors.Register(ModuleName, 5, "too many executions")
+	ErrScheduleInThePast  = sdkerrors.Register(ModuleName, 6, "schedule in the past")
+	ErrNotScheduleOwner   = sdkerrors.Register(ModuleName, 7, "not the owner of the schedule")
+	ErrInvalidScheduleMsg = sdkerrors.Register(ModuleName, 8, "invalid scheduled msg")
+)
@@ -0,0 +1,17 @@
+package scheduler
+
+// scheduler module event types
+const (
+	EventTypeCreateSchedule  = "create_schedule"
+	EventTypeCancelSchedule  = "cancel_schedule"
+	EventTypeExecuteSchedule = "execute_schedule"
+
+	AttributeKeyScheduleID = "schedule_id"
+	AttributeKeyOwner      = "owner"
+	AttributeKeyMsgType    = "msg_type"
+	AttributeKeySuccess    = "success"
+	AttributeKeyError      = "error"
+	AttributeKeyRefund     = "refund"
+
+	AttributeValueCategory = ModuleName
+)
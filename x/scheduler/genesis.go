@@ -0,0 +1,62 @@
+package scheduler
+
+import (
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ cdctypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, schedules []Schedule, nextScheduleID uint64) *GenesisState {
+	return &GenesisState{
+		Params:         params,
+		Schedules:      schedules,
+		NextScheduleId: nextScheduleID,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the scheduler
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, 1)
+}
+
+// ValidateGenesis validates the scheduler genesis state.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.NextScheduleId == 0 {
+		return fmt.Errorf("next schedule id must be positive")
+	}
+
+	ids := make(map[uint64]bool, len(data.Schedules))
+	for _, schedule := range data.Schedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+		if ids[schedule.Id] {
+			return fmt.Errorf("duplicate schedule id %d", schedule.Id)
+		}
+		if schedule.Id >= data.NextScheduleId {
+			return fmt.Errorf("schedule id %d must be lower than the next schedule id %d", schedule.Id, data.NextScheduleId)
+		}
+		ids[schedule.Id] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, schedule := range data.Schedules {
+		if err := schedule.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+// InitGenesis initializes the scheduler module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *scheduler.GenesisState) {
+	// ensure the module account is set, as it holds the prepaid fees
+	k.authKeeper.GetModuleAccount(ctx, scheduler.ModuleName)
+
+	k.SetParams(ctx, data.Params)
+	k.SetNextScheduleID(ctx, data.NextScheduleId)
+
+	for _, schedule := range data.Schedules {
+		k.SetSchedule(ctx, schedule)
+	}
+}
+
+// ExportGenesis returns the scheduler module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *scheduler.GenesisState {
+	var schedules []scheduler.Schedule
+	k.IterateSchedules(ctx, func(schedule scheduler.Schedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+
+	return scheduler.NewGenesisState(k.GetParams(ctx), schedules, k.GetNextScheduleID(ctx))
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+var _ scheduler.QueryServer = Keeper{}
+
+// Params returns the scheduler params.
+func (k Keeper) Params(c context.Context, req *scheduler.QueryParamsRequest) (*scheduler.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &scheduler.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Schedule returns a schedule by its id.
+func (k Keeper) Schedule(c context.Context, req *scheduler.QueryScheduleRequest) (*scheduler.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	schedule, found := k.GetSchedule(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "schedule %d not found", req.Id)
+	}
+
+	return &scheduler.QueryScheduleResponse{Schedule: schedule}, nil
+}
+
+// Schedules returns the schedules of an owner.
+func (k Keeper) Schedules(c context.Context, req *scheduler.QuerySchedulesRequest) (*scheduler.QuerySchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var schedules []scheduler.Schedule
+	pageRes, err := query.Paginate(k.ownerSchedulesStore(ctx, owner), req.Pagination, func(key []byte, _ []byte) error {
+		schedule, found := k.GetSchedule(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return status.Errorf(codes.Internal, "indexed schedule %d not found", sdk.BigEndianToUint64(key))
+		}
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduler.QuerySchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+// Keeper manages the scheduled Msgs and executes them when they are due.
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	paramSpace paramtypes.Subspace
+	authKeeper scheduler.AccountKeeper
+	bankKeeper scheduler.BankKeeper
+	router     middleware.ModuleMsgRouter
+
+	// the address whose Msgs are scheduled by the ScheduleMsgProposals,
+	// typically the gov module account.
+	authority string
+}
+
+// NewKeeper creates a scheduler Keeper. The scheduled Msgs are executed
+// through the given router, whose Msg type URLs are the ones which can be
+// scheduled. The ScheduleMsgProposals schedule Msgs of the given authority.
+func NewKeeper(
+	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	ak scheduler.AccountKeeper, bk scheduler.BankKeeper, router middleware.ModuleMsgRouter, authority string,
+) Keeper {
+	// ensure the scheduler module account is set
+	if addr := ak.GetModuleAddress(scheduler.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", scheduler.ModuleName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(scheduler.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
+		authKeeper: ak,
+		bankKeeper: bk,
+		router:     router,
+		authority:  authority,
+	}
+}
+
+// GetAuthority returns the address whose Msgs are scheduled by the
+// ScheduleMsgProposals.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", scheduler.ModuleName))
+}
+
+// GetParams returns the scheduler params.
+func (k Keeper) GetParams(ctx sdk.Context) (params scheduler.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the scheduler params.
+func (k Keeper) SetParams(ctx sdk.Context, params scheduler.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetNextScheduleID returns the id of the next schedule.
+func (k Keeper) GetNextScheduleID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(scheduler.NextScheduleIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextScheduleID sets the id of the next schedule.
+func (k Keeper) SetNextScheduleID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(scheduler.NextScheduleIDKey, sdk.Uint64ToBigEndian(id))
+}
@@ -0,0 +1,354 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/keeper"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app     *simapp.SimApp
+	ctx     sdk.Context
+	addrs   []sdk.AccAddress
+	keeper  keeper.Keeper
+	msgSrvr scheduler.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Unix(1_000_000_000, 0).UTC()})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	suite.keeper = app.SchedulerKeeper
+	suite.msgSrvr = keeper.NewMsgServerImpl(suite.keeper)
+
+	params := scheduler.DefaultParams()
+	params.ExecutionFee = stake(10)
+	suite.keeper.SetParams(ctx, params)
+}
+
+func stake(amt int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amt))
+}
+
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) int64 {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, addr, sdk.DefaultBondDenom).Amount.Int64()
+}
+
+func (suite *KeeperTestSuite) createSchedule(
+	owner sdk.AccAddress, msg sdk.Msg, executeHeight int64, executeTime *time.Time,
+	executions uint32, intervalBlocks int64, interval time.Duration,
+) (uint64, error) {
+	msgCreate, err := scheduler.NewMsgCreateSchedule(owner, msg, executeHeight, executeTime, executions, intervalBlocks, interval)
+	suite.Require().NoError(err)
+
+	res, err := suite.msgSrvr.CreateSchedule(sdk.WrapSDKContext(suite.ctx), msgCreate)
+	if err != nil {
+		return 0, err
+	}
+	return res.Id, nil
+}
+
+// endBlock moves to the given height and time and executes the due schedules.
+func (suite *KeeperTestSuite) endBlock(height int64, t time.Time) {
+	suite.ctx = suite.ctx.WithBlockHeight(height).WithBlockTime(t).WithEventManager(sdk.NewEventManager())
+	suite.keeper.ExecuteDueSchedules(suite.ctx)
+}
+
+func (suite *KeeperTestSuite) TestScheduleByHeight() {
+	owner, recipient := suite.addrs[0], suite.addrs[1]
+	ownerBalance, recipientBalance := suite.balance(owner), suite.balance(recipient)
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	collected := suite.balance(feeCollector)
+
+	send := banktypes.NewMsgSend(owner, recipient, stake(100))
+	id, err := suite.createSchedule(owner, send, 12, nil, 2, 5, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), id)
+
+	// the fees of both executions are prepaid
+	suite.Require().Equal(ownerBalance-20, suite.balance(owner))
+
+	// nothing is executed before the due height
+	suite.endBlock(11, suite.ctx.BlockTime())
+	suite.Require().Equal(recipientBalance, suite.balance(recipient))
+
+	suite.endBlock(12, suite.ctx.BlockTime())
+	suite.Require().Equal(recipientBalance+100, suite.balance(recipient))
+	suite.Require().Equal(collected+10, suite.balance(feeCollector))
+
+	schedule, found := suite.keeper.GetSchedule(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(17), schedule.NextHeight)
+	suite.Require().Equal(uint32(1), schedule.RemainingExecutions)
+
+	// the schedule is deleted after its last execution
+	suite.endBlock(17, suite.ctx.BlockTime())
+	suite.Require().Equal(recipientBalance+200, suite.balance(recipient))
+	suite.Require().Equal(collected+20, suite.balance(feeCollector))
+	_, found = suite.keeper.GetSchedule(suite.ctx, id)
+	suite.Require().False(found)
+
+	res, err := suite.keeper.Schedules(sdk.WrapSDKContext(suite.ctx), &scheduler.QuerySchedulesRequest{Owner: owner.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Schedules)
+}
+
+func (suite *KeeperTestSuite) TestScheduleByTime() {
+	owner, recipient := suite.addrs[0], suite.addrs[1]
+	recipientBalance := suite.balance(recipient)
+	start := suite.ctx.BlockTime()
+	at := start.Add(time.Hour)
+
+	send := banktypes.NewMsgSend(owner, recipient, stake(100))
+	id, err := suite.createSchedule(owner, send, 0, &at, 2, 0, 24*time.Hour)
+	suite.Require().NoError(err)
+
+	suite.endBlock(11, at.Add(-time.Second))
+	suite.Require().Equal(recipientBalance, suite.balance(recipient))
+
+	// a block after the due time executes the schedule
+	suite.endBlock(12, at.Add(time.Minute))
+	suite.Require().Equal(recipientBalance+100, suite.balance(recipient))
+
+	schedule, found := suite.keeper.GetSchedule(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(at.Add(24*time.Hour), *schedule.NextTime)
+
+	suite.endBlock(13, at.Add(24*time.Hour))
+	suite.Require().Equal(recipientBalance+200, suite.balance(recipient))
+	_, found = suite.keeper.GetSchedule(suite.ctx, id)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestCreateScheduleErrors() {
+	owner, recipient := suite.addrs[0], suite.addrs[1]
+	send := banktypes.NewMsgSend(owner, recipient, stake(100))
+
+	// the Msgs not allowed by the router can't be scheduled
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(owner, stake(1))},
+		[]banktypes.Output{banktypes.NewOutput(recipient, stake(1))},
+	)
+	_, err := suite.createSchedule(owner, multiSend, 12, nil, 1, 0, 0)
+	suite.Require().ErrorIs(err, scheduler.ErrMsgNotSchedulable)
+
+	// the first execution must be after the current block
+	_, err = suite.createSchedule(owner, send, 10, nil, 1, 0, 0)
+	suite.Require().ErrorIs(err, scheduler.ErrScheduleInThePast)
+
+	// the executions are limited
+	params := suite.keeper.GetParams(suite.ctx)
+	_, err = suite.createSchedule(owner, send, 12, nil, params.MaxExecutions+1, 1, 0)
+	suite.Require().ErrorIs(err, scheduler.ErrTooManyExecutions)
+
+	// the fees of all the executions must be prepaid
+	poor := sdk.AccAddress("poor________________")
+	_, err = suite.createSchedule(poor, banktypes.NewMsgSend(poor, recipient, stake(1)), 12, nil, 1, 0, 0)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
+
+func (suite *KeeperTestSuite) TestCancelSchedule() {
+	owner, other := suite.addrs[0], suite.addrs[1]
+	ownerBalance := suite.balance(owner)
+
+	send := banktypes.NewMsgSend(owner, other, stake(100))
+	id, err := suite.createSchedule(owner, send, 12, nil, 3, 5, 0)
+	suite.Require().NoError(err)
+
+	// a single execution is left after two of them
+	suite.endBlock(12, suite.ctx.BlockTime())
+	suite.endBlock(17, suite.ctx.BlockTime())
+	suite.Require().Equal(ownerBalance-30-200, suite.balance(owner))
+
+	_, err = suite.msgSrvr.CancelSchedule(sdk.WrapSDKContext(suite.ctx), scheduler.NewMsgCancelSchedule(other, id))
+	suite.Require().ErrorIs(err, scheduler.ErrNotScheduleOwner)
+
+	// the fee of the remaining execution is refunded
+	_, err = suite.msgSrvr.CancelSchedule(sdk.WrapSDKContext(suite.ctx), scheduler.NewMsgCancelSchedule(owner, id))
+	suite.Require().NoError(err)
+	suite.Require().Equal(ownerBalance-20-200, suite.balance(owner))
+
+	_, found := suite.keeper.GetSchedule(suite.ctx, id)
+	suite.Require().False(found)
+	suite.endBlock(22, suite.ctx.BlockTime())
+	suite.Require().Equal(ownerBalance-20-200, suite.balance(owner))
+
+	_, err = suite.msgSrvr.CancelSchedule(sdk.WrapSDKContext(suite.ctx), scheduler.NewMsgCancelSchedule(owner, id))
+	suite.Require().ErrorIs(err, scheduler.ErrScheduleNotFound)
+}
+
+func (suite *KeeperTestSuite) TestFailedExecution() {
+	owner, recipient := suite.addrs[0], suite.addrs[1]
+	recipientBalance := suite.balance(recipient)
+
+	// the owner can't afford the first execution
+	balance := suite.balance(owner)
+	send := banktypes.NewMsgSend(owner, recipient, stake(balance-30))
+	id, err := suite.createSchedule(owner, send, 12, nil, 2, 5, 0)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, owner, suite.addrs[2], stake(100)))
+
+	suite.endBlock(12, suite.ctx.BlockTime())
+	suite.Require().Equal(recipientBalance, suite.balance(recipient))
+
+	events := suite.ctx.EventManager().Events()
+	last := events[len(events)-1]
+	suite.Require().Equal(scheduler.EventTypeExecuteSchedule, last.Type)
+	suite.Require().Contains(last.Attributes, sdk.NewAttribute(scheduler.AttributeKeySuccess, "false").ToKVPair())
+
+	// the failure doesn't cancel the next execution
+	suite.Require().NoError(banktestutil.FundAccount(suite.app.BankKeeper, suite.ctx, owner, stake(100)))
+	suite.endBlock(17, suite.ctx.BlockTime())
+	suite.Require().Equal(recipientBalance+balance-30, suite.balance(recipient))
+	_, found := suite.keeper.GetSchedule(suite.ctx, id)
+	suite.Require().False(found)
+}
+
+// panickingMsgServer is a bank MsgServer whose sends of the given amount
+// panic.
+type panickingMsgServer struct {
+	banktypes.MsgServer
+	amount sdk.Coins
+}
+
+func (s panickingMsgServer) Send(goCtx context.Context, msg *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	if msg.Amount.IsEqual(s.amount) {
+		panic("send handler panic")
+	}
+	return s.MsgServer.Send(goCtx, msg)
+}
+
+func (suite *KeeperTestSuite) TestPanickingExecution() {
+	app := suite.app
+	msr := middleware.NewMsgServiceRouter(app.InterfaceRegistry())
+	banktypes.RegisterMsgServer(msr, panickingMsgServer{bankkeeper.NewMsgServerImpl(app.BankKeeper), stake(13)})
+	suite.keeper = keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(scheduler.StoreKey), app.GetSubspace(scheduler.ModuleName), app.AccountKeeper, app.BankKeeper,
+		middleware.NewModuleMsgRouter(msr, scheduler.ModuleName, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	suite.msgSrvr = keeper.NewMsgServerImpl(suite.keeper)
+
+	owner, recipient := suite.addrs[0], suite.addrs[1]
+	recipientBalance := suite.balance(recipient)
+
+	panicID, err := suite.createSchedule(owner, banktypes.NewMsgSend(owner, recipient, stake(13)), 12, nil, 2, 5, 0)
+	suite.Require().NoError(err)
+	sendID, err := suite.createSchedule(owner, banktypes.NewMsgSend(owner, recipient, stake(100)), 12, nil, 1, 0, 0)
+	suite.Require().NoError(err)
+
+	// the panic fails only the execution of its schedule
+	suite.Require().NotPanics(func() { suite.endBlock(12, suite.ctx.BlockTime()) })
+	suite.Require().Equal(recipientBalance+100, suite.balance(recipient))
+
+	var executions []sdk.Event
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == scheduler.EventTypeExecuteSchedule {
+			executions = append(executions, event)
+		}
+	}
+	suite.Require().Len(executions, 2)
+	suite.Require().Contains(executions[0].Attributes, sdk.NewAttribute(scheduler.AttributeKeyScheduleID, "1").ToKVPair())
+	suite.Require().Contains(executions[0].Attributes, sdk.NewAttribute(scheduler.AttributeKeySuccess, "false").ToKVPair())
+	suite.Require().Contains(executions[0].Attributes, sdk.NewAttribute(scheduler.AttributeKeyError, "send handler panic: panic").ToKVPair())
+	suite.Require().Contains(executions[1].Attributes, sdk.NewAttribute(scheduler.AttributeKeySuccess, "true").ToKVPair())
+
+	// the failure doesn't cancel the next execution
+	schedule, found := suite.keeper.GetSchedule(suite.ctx, panicID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(1), schedule.RemainingExecutions)
+	_, found = suite.keeper.GetSchedule(suite.ctx, sendID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestMaxExecutionsPerBlock() {
+	owner, recipient := suite.addrs[0], suite.addrs[1]
+	recipientBalance := suite.balance(recipient)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MaxExecutionsPerBlock = 1
+	suite.keeper.SetParams(suite.ctx, params)
+
+	send := banktypes.NewMsgSend(owner, recipient, stake(100))
+	first, err := suite.createSchedule(owner, send, 12, nil, 1, 0, 0)
+	suite.Require().NoError(err)
+	second, err := suite.createSchedule(owner, send, 12, nil, 1, 0, 0)
+	suite.Require().NoError(err)
+
+	// the second execution is deferred to the next block
+	suite.endBlock(12, suite.ctx.BlockTime())
+	suite.Require().Equal(recipientBalance+100, suite.balance(recipient))
+	_, found := suite.keeper.GetSchedule(suite.ctx, first)
+	suite.Require().False(found)
+	_, found = suite.keeper.GetSchedule(suite.ctx, second)
+	suite.Require().True(found)
+
+	suite.endBlock(13, suite.ctx.BlockTime())
+	suite.Require().Equal(recipientBalance+200, suite.balance(recipient))
+	_, found = suite.keeper.GetSchedule(suite.ctx, second)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestScheduleMsgProposalHandler() {
+	recipient := suite.addrs[1]
+	recipientBalance := suite.balance(recipient)
+	govAddr := suite.app.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+	suite.Require().NoError(banktestutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, govtypes.ModuleName, stake(100)))
+
+	send := banktypes.NewMsgSend(govAddr, recipient, stake(100))
+	proposal, err := scheduler.NewScheduleMsgProposal("title", "description", send, 12, nil, 1, 0, 0)
+	suite.Require().NoError(err)
+	suite.Require().NoError(proposal.ValidateBasic())
+
+	// the Msg of another signer than the authority can't be scheduled
+	other, err := scheduler.NewScheduleMsgProposal("title", "description", banktypes.NewMsgSend(recipient, govAddr, stake(1)), 12, nil, 1, 0, 0)
+	suite.Require().NoError(err)
+	suite.Require().NoError(other.ValidateBasic())
+
+	handler := keeper.NewScheduleMsgProposalHandler(suite.keeper)
+	suite.Require().Error(handler(suite.ctx, other))
+	suite.Require().NoError(handler(suite.ctx, proposal))
+
+	// the schedules of the proposals are free
+	suite.endBlock(12, suite.ctx.BlockTime())
+	suite.Require().Equal(recipientBalance+100, suite.balance(recipient))
+	suite.Require().Zero(suite.balance(govAddr))
+
+	// a keeper with another authority schedules the Msgs of that authority
+	app := suite.app
+	msr := middleware.NewMsgServiceRouter(app.InterfaceRegistry())
+	banktypes.RegisterMsgServer(msr, bankkeeper.NewMsgServerImpl(app.BankKeeper))
+	custom := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(scheduler.StoreKey), app.GetSubspace(scheduler.ModuleName), app.AccountKeeper, app.BankKeeper,
+		middleware.NewModuleMsgRouter(msr, scheduler.ModuleName, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}),
+		recipient.String(),
+	)
+	other.ExecuteHeight, proposal.ExecuteHeight = 20, 20
+	customHandler := keeper.NewScheduleMsgProposalHandler(custom)
+	suite.Require().NoError(customHandler(suite.ctx, other))
+	suite.Require().Error(customHandler(suite.ctx, proposal))
+}
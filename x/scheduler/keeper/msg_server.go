@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the scheduler MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(k Keeper) scheduler.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ scheduler.MsgServer = msgServer{}
+
+// CreateSchedule schedules a Msg of the signer, who prepays the current
+// execution fee of each execution.
+func (k msgServer) CreateSchedule(goCtx context.Context, msg *scheduler.MsgCreateSchedule) (*scheduler.MsgCreateScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled, err := msg.GetScheduledMsg()
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := scheduler.NewSchedule(
+		0, owner, scheduled, msg.ExecuteHeight, msg.ExecuteTime,
+		msg.Executions, msg.IntervalBlocks, msg.Interval, k.GetParams(ctx).ExecutionFee,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.CreateSchedule(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduler.MsgCreateScheduleResponse{Id: id}, nil
+}
+
+// CancelSchedule cancels a schedule of the signer.
+func (k msgServer) CancelSchedule(goCtx context.Context, msg *scheduler.MsgCancelSchedule) (*scheduler.MsgCancelScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelSchedule(ctx, msg.Id, owner); err != nil {
+		return nil, err
+	}
+
+	return &scheduler.MsgCancelScheduleResponse{}, nil
+}
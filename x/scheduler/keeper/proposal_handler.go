@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+// NewScheduleMsgProposalHandler returns the governance handler of the
+// ScheduleMsgProposals, which schedule Msgs of the authority of the keeper
+// without execution fee.
+func NewScheduleMsgProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *scheduler.ScheduleMsgProposal:
+			msg, err := c.GetScheduledMsg()
+			if err != nil {
+				return err
+			}
+
+			authority, err := sdk.AccAddressFromBech32(k.GetAuthority())
+			if err != nil {
+				return err
+			}
+
+			schedule, err := scheduler.NewSchedule(
+				0, authority, msg, c.ExecuteHeight, c.ExecuteTime,
+				c.Executions, c.IntervalBlocks, c.Interval, nil,
+			)
+			if err != nil {
+				return err
+			}
+
+			_, err = k.CreateSchedule(ctx, schedule)
+			return err
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized scheduler proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+// GetSchedule returns the schedule of the given id.
+func (k Keeper) GetSchedule(ctx sdk.Context, id uint64) (schedule scheduler.Schedule, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(scheduler.ScheduleKey(id))
+	if bz == nil {
+		return schedule, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+// SetSchedule stores a schedule, indexes it by owner and queues its next
+// execution.
+func (k Keeper) SetSchedule(ctx sdk.Context, schedule scheduler.Schedule) {
+	store := ctx.KVStore(k.storeKey)
+	owner, err := sdk.AccAddressFromBech32(schedule.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(scheduler.ScheduleKey(schedule.Id), k.cdc.MustMarshal(&schedule))
+	store.Set(scheduler.OwnerScheduleKey(owner, schedule.Id), []byte{})
+	store.Set(queueKey(schedule), []byte{})
+}
+
+// deleteSchedule deletes a schedule along with its index and queue entries.
+func (k Keeper) deleteSchedule(ctx sdk.Context, schedule scheduler.Schedule) {
+	store := ctx.KVStore(k.storeKey)
+	owner, err := sdk.AccAddressFromBech32(schedule.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	store.Delete(scheduler.ScheduleKey(schedule.Id))
+	store.Delete(scheduler.OwnerScheduleKey(owner, schedule.Id))
+	store.Delete(queueKey(schedule))
+}
+
+// IterateSchedules iterates over all the schedules, by id, and performs a
+// callback function.
+func (k Keeper) IterateSchedules(ctx sdk.Context, cb func(schedule scheduler.Schedule) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), scheduler.ScheduleKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule scheduler.Schedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+
+		if cb(schedule) {
+			break
+		}
+	}
+}
+
+// ownerSchedulesStore returns the index of the schedules of an owner, keyed
+// by schedule id.
+func (k Keeper) ownerSchedulesStore(ctx sdk.Context, owner sdk.AccAddress) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), scheduler.OwnerSchedulesPrefix(owner))
+}
+
+// CreateSchedule validates and stores a new schedule, assigning it the next
+// schedule id, which is returned. The execution fees of all its executions are
+// prepaid by the owner to the scheduler module account.
+func (k Keeper) CreateSchedule(ctx sdk.Context, schedule scheduler.Schedule) (uint64, error) {
+	schedule.Id = k.GetNextScheduleID(ctx)
+	if err := schedule.Validate(); err != nil {
+		return 0, err
+	}
+
+	msg, err := schedule.GetMsg()
+	if err != nil {
+		return 0, err
+	}
+	if typeURL := sdk.MsgTypeURL(msg); !k.router.CanInvoke(typeURL) {
+		return 0, sdkerrors.Wrapf(scheduler.ErrMsgNotSchedulable, "%s can't be scheduled", typeURL)
+	}
+
+	params := k.GetParams(ctx)
+	if schedule.RemainingExecutions > params.MaxExecutions {
+		return 0, sdkerrors.Wrapf(scheduler.ErrTooManyExecutions, "%d executions, the maximum is %d", schedule.RemainingExecutions, params.MaxExecutions)
+	}
+	if schedule.IsDue(ctx) {
+		return 0, sdkerrors.Wrap(scheduler.ErrScheduleInThePast, "the first execution must be after the current block")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(schedule.Owner)
+	if err != nil {
+		return 0, err
+	}
+	if fees := schedule.PrepaidFees(); !fees.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, scheduler.ModuleName, fees); err != nil {
+			return 0, err
+		}
+	}
+
+	k.SetSchedule(ctx, schedule)
+	k.SetNextScheduleID(ctx, schedule.Id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			scheduler.EventTypeCreateSchedule,
+			sdk.NewAttribute(scheduler.AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.Id)),
+			sdk.NewAttribute(scheduler.AttributeKeyOwner, schedule.Owner),
+			sdk.NewAttribute(scheduler.AttributeKeyMsgType, sdk.MsgTypeURL(msg)),
+		),
+	)
+
+	return schedule.Id, nil
+}
+
+// CancelSchedule deletes a schedule of the given owner, refunding the prepaid
+// fees of its remaining executions.
+func (k Keeper) CancelSchedule(ctx sdk.Context, id uint64, owner sdk.AccAddress) error {
+	schedule, found := k.GetSchedule(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(scheduler.ErrScheduleNotFound, "schedule %d", id)
+	}
+	if schedule.Owner != owner.String() {
+		return sdkerrors.Wrapf(scheduler.ErrNotScheduleOwner, "schedule %d is owned by %s", id, schedule.Owner)
+	}
+
+	refund := schedule.PrepaidFees()
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, scheduler.ModuleName, owner, refund); err != nil {
+			return err
+		}
+	}
+
+	k.deleteSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			scheduler.EventTypeCancelSchedule,
+			sdk.NewAttribute(scheduler.AttributeKeyScheduleID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(scheduler.AttributeKeyOwner, schedule.Owner),
+			sdk.NewAttribute(scheduler.AttributeKeyRefund, refund.String()),
+		),
+	)
+
+	return nil
+}
+
+// ExecuteDueSchedules executes the Msgs of the schedules due at the current
+// block, up to the max executions per block. The schedules by height are
+// executed first, in the order of their due height, then the schedules by
+// time, in the order of their due time. The due executions above the maximum
+// are deferred to the next blocks.
+func (k Keeper) ExecuteDueSchedules(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	for _, id := range k.dueScheduleIDs(ctx, params.MaxExecutionsPerBlock) {
+		schedule, found := k.GetSchedule(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("queued schedule %d not found", id))
+		}
+
+		k.executeSchedule(ctx, schedule, params.MaxExecutionGas)
+	}
+}
+
+// dueScheduleIDs returns the ids of the schedules due at the current block, up
+// to the given limit.
+func (k Keeper) dueScheduleIDs(ctx sdk.Context, limit uint32) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	var ids []uint64
+
+	queues := [][2][]byte{
+		{scheduler.HeightQueueKeyPrefix, sdk.PrefixEndBytes(scheduler.HeightQueuePrefix(ctx.BlockHeight()))},
+		{scheduler.TimeQueueKeyPrefix, sdk.PrefixEndBytes(scheduler.TimeQueuePrefix(ctx.BlockTime()))},
+	}
+	for _, queue := range queues {
+		iterator := store.Iterator(queue[0], queue[1])
+		for ; iterator.Valid() && uint32(len(ids)) < limit; iterator.Next() {
+			ids = append(ids, scheduler.QueueKeyID(iterator.Key()))
+		}
+		iterator.Close()
+	}
+
+	return ids
+}
+
+// executeSchedule pays the execution fee of a schedule to the fee collector,
+// executes its Msg and moves it to its next execution, or deletes it after its
+// last one. A failed execution is logged and reported in the execute_schedule
+// event, without cancelling the next executions.
+func (k Keeper) executeSchedule(ctx sdk.Context, schedule scheduler.Schedule, gasLimit uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(queueKey(schedule))
+
+	if !schedule.ExecutionFee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, scheduler.ModuleName, authtypes.FeeCollectorName, schedule.ExecutionFee); err != nil {
+			panic(err)
+		}
+	}
+
+	msg, err := schedule.GetMsg()
+	if err == nil {
+		err = k.invoke(ctx, schedule.Owner, msg, gasLimit)
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(scheduler.AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.Id)),
+		sdk.NewAttribute(scheduler.AttributeKeyOwner, schedule.Owner),
+		sdk.NewAttribute(scheduler.AttributeKeyMsgType, schedule.Msg.GetTypeUrl()),
+		sdk.NewAttribute(scheduler.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+	if err != nil {
+		attrs = append(attrs, sdk.NewAttribute(scheduler.AttributeKeyError, err.Error()))
+		k.Logger(ctx).Info("scheduled msg failed", "schedule", schedule.Id, "msg", schedule.Msg.GetTypeUrl(), "err", err)
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(scheduler.EventTypeExecuteSchedule, attrs...))
+
+	schedule.Advance()
+	if schedule.RemainingExecutions == 0 {
+		k.deleteSchedule(ctx, schedule)
+		return
+	}
+	k.SetSchedule(ctx, schedule)
+}
+
+// invoke executes a Msg on behalf of its owner with the given gas limit. The
+// state changes of a failed Msg are discarded. A panic of the Msg, e.g. when
+// running out of gas, is recovered into an error so that it fails only its
+// execution rather than the block.
+func (k Keeper) invoke(ctx sdk.Context, owner string, msg sdk.Msg, gasLimit uint64) (err error) {
+	router := k.router.WithSignerAuthorizer(func(_ sdk.Context, signer sdk.AccAddress) bool {
+		return signer.String() == owner
+	})
+
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+				return
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+		}
+	}()
+
+	_, err = router.Invoke(ctx.WithGasMeter(sdk.NewGasMeter(gasLimit)), msg)
+	return err
+}
+
+// queueKey returns the key of the next execution of a schedule in the height
+// or time queue.
+func queueKey(schedule scheduler.Schedule) []byte {
+	if schedule.ByHeight() {
+		return scheduler.HeightQueueKey(schedule.NextHeight, schedule.Id)
+	}
+	return scheduler.TimeQueueKey(*schedule.NextTime, schedule.Id)
+}
@@ -0,0 +1,83 @@
+package scheduler
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "scheduler"
+
+	// StoreKey is the store key string for scheduler
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for scheduler
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for scheduler
+	QuerierRoute = ModuleName
+)
+
+var (
+	// ScheduleKeyPrefix is the prefix of the schedules by id
+	ScheduleKeyPrefix = []byte{0x01}
+	// OwnerScheduleKeyPrefix is the prefix of the index of the schedules by
+	// owner
+	OwnerScheduleKeyPrefix = []byte{0x02}
+	// HeightQueueKeyPrefix is the prefix of the queue of the schedules by
+	// height, ordered by the height of their next execution
+	HeightQueueKeyPrefix = []byte{0x03}
+	// TimeQueueKeyPrefix is the prefix of the queue of the schedules by time,
+	// ordered by the time of their next execution
+	TimeQueueKeyPrefix = []byte{0x04}
+	// NextScheduleIDKey is the key of the id of the next schedule
+	NextScheduleIDKey = []byte{0x05}
+)
+
+// ScheduleKey returns the key of a schedule.
+func ScheduleKey(id uint64) []byte {
+	return append(ScheduleKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// OwnerSchedulesPrefix returns the prefix of the index of the schedules of an
+// owner.
+func OwnerSchedulesPrefix(owner sdk.AccAddress) []byte {
+	return append(OwnerScheduleKeyPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// OwnerScheduleKey returns the key of a schedule in the index of the
+// schedules of its owner.
+func OwnerScheduleKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(OwnerSchedulesPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// HeightQueueKey returns the key of a schedule in the height queue.
+func HeightQueueKey(height int64, id uint64) []byte {
+	return append(HeightQueuePrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// HeightQueuePrefix returns the prefix of the schedules due at a height in the
+// height queue.
+func HeightQueuePrefix(height int64) []byte {
+	return append(HeightQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// TimeQueueKey returns the key of a schedule in the time queue.
+func TimeQueueKey(t time.Time, id uint64) []byte {
+	return append(TimeQueuePrefix(t), sdk.Uint64ToBigEndian(id)...)
+}
+
+// TimeQueuePrefix returns the prefix of the schedules due at a time in the
+// time queue.
+func TimeQueuePrefix(t time.Time) []byte {
+	return append(TimeQueueKeyPrefix, sdk.FormatTimeBytes(t)...)
+}
+
+// QueueKeyID returns the id of the schedule of a key of the height or time
+// queue, which ends with the id.
+func QueueKeyID(key []byte) uint64 {
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
@@ -0,0 +1,129 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_, _ sdk.Msg                          = &MsgCreateSchedule{}, &MsgCancelSchedule{}
+	_, _ legacytx.LegacyMsg               = &MsgCreateSchedule{}, &MsgCancelSchedule{} // For amino support.
+	_    cdctypes.UnpackInterfacesMessage = MsgCreateSchedule{}
+)
+
+// NewMsgCreateSchedule creates a new MsgCreateSchedule. The first execution is
+// due at the given height, or at the given time for a schedule by time.
+//nolint:interfacer
+func NewMsgCreateSchedule(
+	owner sdk.AccAddress, msg sdk.Msg, executeHeight int64, executeTime *time.Time,
+	executions uint32, intervalBlocks int64, interval time.Duration,
+) (*MsgCreateSchedule, error) {
+	anyMsg, err := cdctypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateSchedule{
+		Owner:          owner.String(),
+		Msg:            anyMsg,
+		ExecuteHeight:  executeHeight,
+		ExecuteTime:    executeTime,
+		Executions:     executions,
+		IntervalBlocks: intervalBlocks,
+		Interval:       interval,
+	}, nil
+}
+
+// GetScheduledMsg returns the scheduled Msg.
+func (msg MsgCreateSchedule) GetScheduledMsg() (sdk.Msg, error) {
+	return unpackMsg(msg.Msg)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateSchedule) ValidateBasic() error {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	scheduled, err := msg.GetScheduledMsg()
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidScheduleMsg, err.Error())
+	}
+	if err := ValidateScheduledMsg(scheduled, owner); err != nil {
+		return err
+	}
+
+	return ValidateTiming(msg.ExecuteHeight, msg.ExecuteTime, msg.Executions, msg.IntervalBlocks, msg.Interval)
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCreateSchedule) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgCreateSchedule) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgCreateSchedule) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgCreateSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateSchedule) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var scheduled sdk.Msg
+	return unpacker.UnpackAny(msg.Msg, &scheduled)
+}
+
+// NewMsgCancelSchedule creates a new MsgCancelSchedule.
+//nolint:interfacer
+func NewMsgCancelSchedule(owner sdk.AccAddress, id uint64) *MsgCancelSchedule {
+	return &MsgCancelSchedule{Owner: owner.String(), Id: id}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	if msg.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "schedule id must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCancelSchedule) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgCancelSchedule) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgCancelSchedule) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgCancelSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
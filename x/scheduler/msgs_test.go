@@ -0,0 +1,65 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+func TestMsgCreateSchedule(t *testing.T) {
+	owner := sdk.AccAddress("addr1_______________")
+	other := sdk.AccAddress("addr2_______________")
+	send := banktypes.NewMsgSend(owner, other, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	at := time.Unix(1_000_000_000, 0).UTC()
+
+	cases := map[string]struct {
+		signer         sdk.AccAddress
+		msg            sdk.Msg
+		executeHeight  int64
+		executeTime    *time.Time
+		executions     uint32
+		intervalBlocks int64
+		interval       time.Duration
+		valid          bool
+	}{
+		"by height":               {owner, send, 10, nil, 1, 0, 0, true},
+		"by time":                 {owner, send, 0, &at, 1, 0, 0, true},
+		"repeated by height":      {owner, send, 10, nil, 3, 5, 0, true},
+		"repeated by time":        {owner, send, 0, &at, 3, 0, time.Hour, true},
+		"no execution":            {owner, send, 10, nil, 0, 0, 0, false},
+		"height and time":         {owner, send, 10, &at, 1, 0, 0, false},
+		"neither height nor time": {owner, send, 0, nil, 1, 0, 0, false},
+		"missing interval":        {owner, send, 10, nil, 3, 0, 0, false},
+		"interval of time":        {owner, send, 10, nil, 3, 0, time.Hour, false},
+		"msg of another signer":   {other, send, 10, nil, 1, 0, 0, false},
+		"invalid msg": {
+			owner, banktypes.NewMsgSend(owner, other, sdk.Coins{}), 10, nil, 1, 0, 0, false,
+		},
+	}
+
+	for name, tc := range cases {
+		msg, err := scheduler.NewMsgCreateSchedule(tc.signer, tc.msg, tc.executeHeight, tc.executeTime, tc.executions, tc.intervalBlocks, tc.interval)
+		require.NoError(t, err, name)
+
+		err = msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, name)
+			require.Equal(t, []sdk.AccAddress{owner}, msg.GetSigners(), name)
+		} else {
+			require.Error(t, err, name)
+		}
+	}
+}
+
+func TestMsgCancelSchedule(t *testing.T) {
+	owner := sdk.AccAddress("addr1_______________")
+
+	require.NoError(t, scheduler.NewMsgCancelSchedule(owner, 1).ValidateBasic())
+	require.Error(t, scheduler.NewMsgCancelSchedule(owner, 0).ValidateBasic())
+	require.Error(t, (&scheduler.MsgCancelSchedule{Owner: "foo", Id: 1}).ValidateBasic())
+}
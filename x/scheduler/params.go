@@ -0,0 +1,120 @@
+package scheduler
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default values of the scheduler params.
+const (
+	DefaultMaxExecutionsPerBlock uint32 = 100
+	DefaultMaxExecutionGas       uint64 = 1_000_000
+	DefaultMaxExecutions         uint32 = 1000
+)
+
+// Parameter store keys of the scheduler params.
+var (
+	KeyExecutionFee          = []byte("ExecutionFee")
+	KeyMaxExecutionsPerBlock = []byte("MaxExecutionsPerBlock")
+	KeyMaxExecutionGas       = []byte("MaxExecutionGas")
+	KeyMaxExecutions         = []byte("MaxExecutions")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table for the scheduler module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(executionFee sdk.Coins, maxExecutionsPerBlock uint32, maxExecutionGas uint64, maxExecutions uint32) Params {
+	return Params{
+		ExecutionFee:          executionFee,
+		MaxExecutionsPerBlock: maxExecutionsPerBlock,
+		MaxExecutionGas:       maxExecutionGas,
+		MaxExecutions:         maxExecutions,
+	}
+}
+
+// DefaultParams returns the default scheduler params, without execution fee.
+func DefaultParams() Params {
+	return NewParams(sdk.NewCoins(), DefaultMaxExecutionsPerBlock, DefaultMaxExecutionGas, DefaultMaxExecutions)
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyExecutionFee, &p.ExecutionFee, validateExecutionFee),
+		paramtypes.NewParamSetPair(KeyMaxExecutionsPerBlock, &p.MaxExecutionsPerBlock, validatePositiveUint32),
+		paramtypes.NewParamSetPair(KeyMaxExecutionGas, &p.MaxExecutionGas, validateMaxExecutionGas),
+		paramtypes.NewParamSetPair(KeyMaxExecutions, &p.MaxExecutions, validatePositiveUint32),
+	}
+}
+
+// Validate performs basic validation of the scheduler params.
+func (p Params) Validate() error {
+	if err := validateExecutionFee(p.ExecutionFee); err != nil {
+		return err
+	}
+	if err := validatePositiveUint32(p.MaxExecutionsPerBlock); err != nil {
+		return fmt.Errorf("max executions per block: %w", err)
+	}
+	if err := validateMaxExecutionGas(p.MaxExecutionGas); err != nil {
+		return err
+	}
+	if err := validatePositiveUint32(p.MaxExecutions); err != nil {
+		return fmt.Errorf("max executions: %w", err)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateExecutionFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid execution fee: %w", err)
+	}
+
+	return nil
+}
+
+func validatePositiveUint32(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("value must be positive")
+	}
+
+	return nil
+}
+
+func validateMaxExecutionGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max execution gas must be positive")
+	}
+
+	return nil
+}
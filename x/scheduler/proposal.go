@@ -0,0 +1,107 @@
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeScheduleMsg defines the type for a ScheduleMsgProposal
+	ProposalTypeScheduleMsg = "ScheduleMsg"
+)
+
+var (
+	_ govtypes.Content                 = &ScheduleMsgProposal{}
+	_ cdctypes.UnpackInterfacesMessage = ScheduleMsgProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeScheduleMsg)
+	govtypes.RegisterProposalTypeCodec(&ScheduleMsgProposal{}, "cosmos-sdk/ScheduleMsgProposal")
+}
+
+// NewScheduleMsgProposal creates a new proposal scheduling a Msg of the
+// authority of the scheduler keeper, typically the governance module account.
+func NewScheduleMsgProposal(
+	title, description string, msg sdk.Msg, executeHeight int64, executeTime *time.Time,
+	executions uint32, intervalBlocks int64, interval time.Duration,
+) (*ScheduleMsgProposal, error) {
+	anyMsg, err := cdctypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScheduleMsgProposal{
+		Title:          title,
+		Description:    description,
+		Msg:            anyMsg,
+		ExecuteHeight:  executeHeight,
+		ExecuteTime:    executeTime,
+		Executions:     executions,
+		IntervalBlocks: intervalBlocks,
+		Interval:       interval,
+	}, nil
+}
+
+// GetTitle returns the title of a schedule msg proposal.
+func (p *ScheduleMsgProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a schedule msg proposal.
+func (p *ScheduleMsgProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a schedule msg proposal.
+func (p *ScheduleMsgProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a schedule msg proposal.
+func (p *ScheduleMsgProposal) ProposalType() string { return ProposalTypeScheduleMsg }
+
+// GetScheduledMsg returns the scheduled Msg.
+func (p ScheduleMsgProposal) GetScheduledMsg() (sdk.Msg, error) {
+	return unpackMsg(p.Msg)
+}
+
+// ValidateBasic runs basic stateless validity checks. The signer of the Msg is
+// checked against the authority of the scheduler keeper by the proposal handler.
+func (p *ScheduleMsgProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	msg, err := p.GetScheduledMsg()
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidScheduleMsg, err.Error())
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidScheduleMsg, "%s: %s", sdk.MsgTypeURL(msg), err)
+	}
+
+	return ValidateTiming(p.ExecuteHeight, p.ExecuteTime, p.Executions, p.IntervalBlocks, p.Interval)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p ScheduleMsgProposal) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(p.Msg, &msg)
+}
+
+// String implements the Stringer interface.
+func (p ScheduleMsgProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Schedule Msg Proposal:
+  Title:           %s
+  Description:     %s
+  Msg:             %s
+  Execute Height:  %d
+  Execute Time:    %v
+  Executions:      %d
+  Interval Blocks: %d
+  Interval:        %s
+`, p.Title, p.Description, p.Msg.GetTypeUrl(), p.ExecuteHeight, p.ExecuteTime, p.Executions, p.IntervalBlocks, p.Interval))
+	return b.String()
+}
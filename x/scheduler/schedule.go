@@ -0,0 +1,202 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ cdctypes.UnpackInterfacesMessage = Schedule{}
+
+// NewSchedule returns a schedule of the given Msg of the owner. The first
+// execution is due at the given height, or at the given time for a schedule by
+// time, and the next ones every interval blocks or every interval.
+//nolint:interfacer
+func NewSchedule(
+	id uint64, owner sdk.AccAddress, msg sdk.Msg, executeHeight int64, executeTime *time.Time,
+	executions uint32, intervalBlocks int64, interval time.Duration, executionFee sdk.Coins,
+) (Schedule, error) {
+	anyMsg, err := cdctypes.NewAnyWithValue(msg)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	return Schedule{
+		Id:                  id,
+		Owner:               owner.String(),
+		Msg:                 anyMsg,
+		NextHeight:          executeHeight,
+		NextTime:            executeTime,
+		RemainingExecutions: executions,
+		IntervalBlocks:      intervalBlocks,
+		Interval:            interval,
+		ExecutionFee:        executionFee,
+	}, nil
+}
+
+// GetMsg returns the scheduled Msg.
+func (s Schedule) GetMsg() (sdk.Msg, error) {
+	return unpackMsg(s.Msg)
+}
+
+// ByHeight returns whether the executions of the schedule are due at heights,
+// rather than at block times.
+func (s Schedule) ByHeight() bool {
+	return s.NextTime == nil
+}
+
+// IsDue returns whether the next execution of the schedule is due at the height
+// and block time of the given context.
+func (s Schedule) IsDue(ctx sdk.Context) bool {
+	if s.ByHeight() {
+		return s.NextHeight <= ctx.BlockHeight()
+	}
+	return !s.NextTime.After(ctx.BlockTime())
+}
+
+// PrepaidFees returns the execution fees of the remaining executions.
+func (s Schedule) PrepaidFees() sdk.Coins {
+	return ExecutionFees(s.ExecutionFee, s.RemainingExecutions)
+}
+
+// Advance records an execution of the schedule, moving its next execution one
+// interval later.
+func (s *Schedule) Advance() {
+	s.RemainingExecutions--
+	if s.ByHeight() {
+		s.NextHeight += s.IntervalBlocks
+		return
+	}
+
+	next := s.NextTime.Add(s.Interval)
+	s.NextTime = &next
+}
+
+// Validate performs a basic validation of the schedule.
+func (s Schedule) Validate() error {
+	if s.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "schedule id must be positive")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(s.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidSchedule, "invalid owner of schedule %d: %s", s.Id, err)
+	}
+
+	msg, err := s.GetMsg()
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidSchedule, "schedule %d: %s", s.Id, err)
+	}
+	if err := ValidateScheduledMsg(msg, owner); err != nil {
+		return sdkerrors.Wrapf(err, "schedule %d", s.Id)
+	}
+
+	if err := ValidateTiming(s.NextHeight, s.NextTime, s.RemainingExecutions, s.IntervalBlocks, s.Interval); err != nil {
+		return sdkerrors.Wrapf(err, "schedule %d", s.Id)
+	}
+
+	if err := s.ExecutionFee.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidSchedule, "invalid execution fee of schedule %d: %s", s.Id, err)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s Schedule) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(s.Msg, &msg)
+}
+
+// ValidateTiming validates the first execution and the interval of the
+// executions of a schedule, which is either by height or by time: exactly one
+// of executeHeight and executeTime must be set, and the interval of its kind
+// must be positive if there is more than one execution.
+func ValidateTiming(executeHeight int64, executeTime *time.Time, executions uint32, intervalBlocks int64, interval time.Duration) error {
+	if executions == 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "executions must be positive")
+	}
+	if intervalBlocks < 0 || interval < 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "interval must not be negative")
+	}
+
+	switch {
+	case executeHeight > 0 && executeTime != nil:
+		return sdkerrors.Wrap(ErrInvalidSchedule, "only one of execute height and execute time can be set")
+	case executeHeight > 0:
+		if interval != 0 {
+			return sdkerrors.Wrap(ErrInvalidSchedule, "a schedule by height must have an interval in blocks")
+		}
+		if executions > 1 && intervalBlocks == 0 {
+			return sdkerrors.Wrap(ErrInvalidSchedule, "interval blocks must be positive for more than one execution")
+		}
+	case executeTime != nil:
+		if intervalBlocks != 0 {
+			return sdkerrors.Wrap(ErrInvalidSchedule, "a schedule by time must have an interval duration")
+		}
+		if executions > 1 && interval == 0 {
+			return sdkerrors.Wrap(ErrInvalidSchedule, "interval must be positive for more than one execution")
+		}
+	default:
+		return sdkerrors.Wrap(ErrInvalidSchedule, "execute height or execute time must be set")
+	}
+
+	return nil
+}
+
+// ValidateScheduledMsg validates a Msg scheduled by the given owner, which
+// must be its only signer.
+func ValidateScheduledMsg(msg sdk.Msg, owner sdk.AccAddress) error {
+	signers := msg.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(owner) {
+		return sdkerrors.Wrapf(ErrInvalidScheduleMsg, "the only signer of %s must be %s", sdk.MsgTypeURL(msg), owner)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidScheduleMsg, "%s: %s", sdk.MsgTypeURL(msg), err)
+	}
+
+	return nil
+}
+
+// ExecutionFees returns the fees of the given number of executions.
+func ExecutionFees(executionFee sdk.Coins, executions uint32) sdk.Coins {
+	fees := sdk.NewCoins()
+	for _, coin := range executionFee {
+		fees = fees.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(executions))))
+	}
+
+	return fees
+}
+
+func unpackMsg(anyMsg *cdctypes.Any) (sdk.Msg, error) {
+	if anyMsg == nil {
+		return nil, fmt.Errorf("missing msg")
+	}
+
+	msg, ok := anyMsg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("expected sdk.Msg, got %T", anyMsg.GetCachedValue())
+	}
+
+	return msg, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryScheduleResponse) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return res.Schedule.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QuerySchedulesResponse) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, schedule := range res.Schedules {
+		if err := schedule.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
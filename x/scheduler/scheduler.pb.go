@@ -0,0 +1,1426 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/scheduler/v1beta1/scheduler.proto
+
+package scheduler
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Schedule is a Msg scheduled for execution at a future height or time, once
+// or repeatedly.
+type Schedule struct {
+	// id is the unique id of the schedule.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address on behalf of which the Msg is executed.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// msg is the scheduled Msg, whose only signer is the owner.
+	Msg *types.Any `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// next_height is the height from which the next execution is due, for the
+	// schedules by height.
+	NextHeight int64 `protobuf:"varint,4,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// next_time is the block time from which the next execution is due, for the
+	// schedules by time.
+	NextTime *time.Time `protobuf:"bytes,5,opt,name=next_time,json=nextTime,proto3,stdtime" json:"next_time,omitempty"`
+	// remaining_executions is the number of executions left, including the next
+	// one.
+	RemainingExecutions uint32 `protobuf:"varint,6,opt,name=remaining_executions,json=remainingExecutions,proto3" json:"remaining_executions,omitempty"`
+	// interval_blocks is the number of blocks between the executions of a
+	// repeated schedule by height.
+	IntervalBlocks int64 `protobuf:"varint,7,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// interval is the duration between the executions of a repeated schedule by
+	// time.
+	Interval time.Duration `protobuf:"bytes,8,opt,name=interval,proto3,stdduration" json:"interval"`
+	// execution_fee is the fee prepaid by the owner for each execution, paid to
+	// the fee collector when the execution happens.
+	ExecutionFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=execution_fee,json=executionFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"execution_fee"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_646a3496f8aa19ec, []int{0}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+// Params defines the parameters of the scheduler module.
+type Params struct {
+	// execution_fee is the fee prepaid by the accounts scheduling a Msg for each
+	// of its executions.
+	ExecutionFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=execution_fee,json=executionFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"execution_fee"`
+	// max_executions_per_block is the maximum number of scheduled Msgs executed
+	// per block. The due executions above it are deferred to the next blocks.
+	MaxExecutionsPerBlock uint32 `protobuf:"varint,2,opt,name=max_executions_per_block,json=maxExecutionsPerBlock,proto3" json:"max_executions_per_block,omitempty"`
+	// max_execution_gas is the gas limit of each execution.
+	MaxExecutionGas uint64 `protobuf:"varint,3,opt,name=max_execution_gas,json=maxExecutionGas,proto3" json:"max_execution_gas,omitempty"`
+	// max_executions is the maximum number of executions of a schedule.
+	MaxExecutions uint32 `protobuf:"varint,4,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_646a3496f8aa19ec, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetExecutionFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExecutionFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxExecutionsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxExecutionsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxExecutionGas() uint64 {
+	if m != nil {
+		return m.MaxExecutionGas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxExecutions() uint32 {
+	if m != nil {
+		return m.MaxExecutions
+	}
+	return 0
+}
+
+// ScheduleMsgProposal is a governance proposal scheduling a Msg on behalf of
+// the governance module account.
+type ScheduleMsgProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// msg is the scheduled Msg, whose only signer is the governance module
+	// account.
+	Msg *types.Any `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// execute_height is the height of the first execution, for a schedule by
+	// height.
+	ExecuteHeight int64 `protobuf:"varint,4,opt,name=execute_height,json=executeHeight,proto3" json:"execute_height,omitempty"`
+	// execute_time is the block time of the first execution, for a schedule by
+	// time.
+	ExecuteTime *time.Time `protobuf:"bytes,5,opt,name=execute_time,json=executeTime,proto3,stdtime" json:"execute_time,omitempty"`
+	// executions is the number of executions of the Msg.
+	Executions uint32 `protobuf:"varint,6,opt,name=executions,proto3" json:"executions,omitempty"`
+	// interval_blocks is the number of blocks between the executions of a
+	// schedule by height.
+	IntervalBlocks int64 `protobuf:"varint,7,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// interval is the duration between the executions of a schedule by time.
+	Interval time.Duration `protobuf:"bytes,8,opt,name=interval,proto3,stdduration" json:"interval"`
+}
+
+func (m *ScheduleMsgProposal) Reset()      { *m = ScheduleMsgProposal{} }
+func (*ScheduleMsgProposal) ProtoMessage() {}
+func (*ScheduleMsgProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_646a3496f8aa19ec, []int{2}
+}
+func (m *ScheduleMsgProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleMsgProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleMsgProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleMsgProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleMsgProposal.Merge(m, src)
+}
+func (m *ScheduleMsgProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleMsgProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleMsgProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleMsgProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Schedule)(nil), "cosmos.scheduler.v1beta1.Schedule")
+	proto.RegisterType((*Params)(nil), "cosmos.scheduler.v1beta1.Params")
+	proto.RegisterType((*ScheduleMsgProposal)(nil), "cosmos.scheduler.v1beta1.ScheduleMsgProposal")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/scheduler/v1beta1/scheduler.proto", fileDescriptor_646a3496f8aa19ec)
+}
+
+var fileDescriptor_646a3496f8aa19ec = []byte{
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xcd, 0x6a, 0xdb, 0x40,
+	0x10, 0xb6, 0x6c, 0x27, 0xb1, 0xd7, 0xb1, 0x43, 0x37, 0x2e, 0x28, 0x39, 0x48, 0x22, 0x10, 0x2a,
+	0x0a, 0x91, 0x9b, 0xe6, 0x50, 0x08, 0x94, 0x12, 0xa5, 0x7f, 0x97, 0x40, 0x50, 0x7a, 0xea, 0x45,
+	0xac, 0xa5, 0x89, 0xbc, 0xc4, 0xd2, 0x0a, 0xad, 0x9c, 0x3a, 0x2f, 0x50, 0x7a, 0xe8, 0x21, 0xc7,
+	0x1c, 0x73, 0xee, 0x39, 0x0f, 0x11, 0x7a, 0x0a, 0x3d, 0xf5, 0xd4, 0x94, 0xe4, 0xd2, 0x07, 0xe8,
+	0x03, 0x14, 0xad, 0x56, 0xfe, 0x89, 0xa1, 0xd0, 0x42, 0xe9, 0xc9, 0xde, 0x6f, 0x66, 0xbe, 0xfd,
+	0x76, 0x66, 0x3e, 0x21, 0xd3, 0x63, 0x3c, 0x64, 0xbc, 0xc3, 0xbd, 0x1e, 0xf8, 0x83, 0x3e, 0x24,
+	0x9d, 0xe3, 0xcd, 0x2e, 0xa4, 0x64, 0x73, 0x8c, 0x58, 0x71, 0xc2, 0x52, 0x86, 0xd5, 0x3c, 0xd3,
+	0x1a, 0xe3, 0x32, 0x73, 0xb5, 0x1d, 0xb0, 0x80, 0x89, 0xa4, 0x4e, 0xf6, 0x2f, 0xcf, 0x5f, 0x5d,
+	0x09, 0x18, 0x0b, 0xfa, 0xd0, 0x11, 0xa7, 0xee, 0xe0, 0xb0, 0x43, 0xa2, 0x13, 0x19, 0xd2, 0xee,
+	0x86, 0xfc, 0x41, 0x42, 0x52, 0xca, 0x22, 0x19, 0xd7, 0xef, 0xc6, 0x53, 0x1a, 0x02, 0x4f, 0x49,
+	0x18, 0x17, 0x04, 0x52, 0x75, 0x97, 0x70, 0x18, 0x09, 0xf6, 0x18, 0x2d, 0x08, 0x56, 0xf2, 0xb8,
+	0x9b, 0x8b, 0x92, 0xc2, 0xc5, 0x61, 0xed, 0x67, 0x05, 0xd5, 0x0e, 0xe4, 0x13, 0x70, 0x0b, 0x95,
+	0xa9, 0xaf, 0x2a, 0x86, 0x62, 0x56, 0x9d, 0x32, 0xf5, 0xb1, 0x85, 0xe6, 0xd8, 0xbb, 0x08, 0x12,
+	0xb5, 0x6c, 0x28, 0x66, 0xdd, 0x56, 0xbf, 0x5c, 0x6c, 0xb4, 0x65, 0xf5, 0x8e, 0xef, 0x27, 0xc0,
+	0xf9, 0x41, 0x9a, 0xd0, 0x28, 0x70, 0xf2, 0x34, 0xbc, 0x85, 0x2a, 0x21, 0x0f, 0xd4, 0x8a, 0xa1,
+	0x98, 0x8d, 0xc7, 0x6d, 0x2b, 0x97, 0x6d, 0x15, 0xb2, 0xad, 0x9d, 0xe8, 0xc4, 0x6e, 0x7c, 0xbe,
+	0xd8, 0x58, 0xe0, 0xfe, 0x91, 0xb5, 0xc7, 0x03, 0x27, 0xcb, 0xc6, 0x3a, 0x6a, 0x44, 0x30, 0x4c,
+	0xdd, 0x1e, 0xd0, 0xa0, 0x97, 0xaa, 0x55, 0x43, 0x31, 0x2b, 0x0e, 0xca, 0xa0, 0xd7, 0x02, 0xc1,
+	0x4f, 0x51, 0x5d, 0x24, 0x64, 0xaf, 0x56, 0xe7, 0x04, 0xf7, 0xea, 0x0c, 0xf7, 0x9b, 0xa2, 0x25,
+	0x76, 0xf5, 0xf4, 0x5a, 0x57, 0x9c, 0x5a, 0x56, 0x92, 0x81, 0x78, 0x13, 0xb5, 0x13, 0x08, 0x09,
+	0x8d, 0x68, 0x14, 0xb8, 0x30, 0x04, 0x6f, 0x90, 0xb5, 0x96, 0xab, 0xf3, 0x86, 0x62, 0x36, 0x9d,
+	0xe5, 0x51, 0xec, 0xc5, 0x28, 0x84, 0x1f, 0xa0, 0x25, 0x1a, 0xa5, 0x90, 0x1c, 0x93, 0xbe, 0xdb,
+	0xed, 0x33, 0xef, 0x88, 0xab, 0x0b, 0x42, 0x56, 0xab, 0x80, 0x6d, 0x81, 0xe2, 0x67, 0xa8, 0x56,
+	0x20, 0x6a, 0x4d, 0x28, 0x5b, 0x99, 0x51, 0xf6, 0x5c, 0x0e, 0xd3, 0xae, 0x5d, 0x7e, 0xd3, 0x4b,
+	0x67, 0x42, 0x5c, 0x51, 0x84, 0x63, 0xd4, 0x1c, 0x49, 0x72, 0x0f, 0x01, 0xd4, 0xba, 0x51, 0x11,
+	0x2c, 0xb2, 0xcd, 0xd9, 0x44, 0x8b, 0xc5, 0xb2, 0x76, 0x19, 0x8d, 0xec, 0x47, 0x19, 0xcb, 0xa7,
+	0x6b, 0xdd, 0x0c, 0x68, 0xda, 0x1b, 0x74, 0x2d, 0x8f, 0x85, 0x72, 0xa2, 0xf2, 0x67, 0x83, 0xfb,
+	0x47, 0x9d, 0xf4, 0x24, 0x06, 0x2e, 0x0a, 0xb8, 0xb3, 0x38, 0xba, 0xe1, 0x25, 0xc0, 0x76, 0xf5,
+	0xc3, 0xb9, 0x5e, 0x5a, 0xfb, 0x58, 0x46, 0xf3, 0xfb, 0x24, 0x21, 0x21, 0x9f, 0x95, 0xa0, 0xfc,
+	0x63, 0x09, 0xf8, 0x09, 0x52, 0x43, 0x32, 0x9c, 0x98, 0x85, 0x1b, 0x43, 0x92, 0x37, 0x5a, 0x6c,
+	0x5a, 0xd3, 0xb9, 0x1f, 0x92, 0xe1, 0x78, 0x1e, 0xfb, 0x90, 0x88, 0x7e, 0xe3, 0x87, 0xe8, 0xde,
+	0x54, 0xa1, 0x1b, 0x10, 0x2e, 0xb6, 0xad, 0xea, 0x2c, 0x4d, 0x56, 0xbc, 0x22, 0x1c, 0xaf, 0xa3,
+	0xd6, 0xf4, 0x25, 0x62, 0xb3, 0x9a, 0x4e, 0x73, 0x8a, 0x7a, 0xbb, 0x7a, 0x96, 0xb5, 0xe3, 0x7d,
+	0x05, 0x2d, 0x17, 0x2e, 0xd8, 0xe3, 0xc1, 0x7e, 0xc2, 0x62, 0xc6, 0x49, 0x1f, 0xb7, 0xd1, 0x5c,
+	0x4a, 0xd3, 0x3e, 0x08, 0x4f, 0xd4, 0x9d, 0xfc, 0x80, 0x0d, 0xd4, 0xf0, 0x81, 0x7b, 0x09, 0x8d,
+	0x33, 0x8e, 0xdc, 0x1c, 0xce, 0x24, 0xf4, 0x77, 0x46, 0x58, 0x47, 0xad, 0x5c, 0x2d, 0x4c, 0x7b,
+	0x41, 0x8e, 0x07, 0xa4, 0x1d, 0x76, 0xd1, 0x62, 0x91, 0xf6, 0x47, 0x8e, 0x68, 0xc8, 0x2a, 0x61,
+	0x0a, 0x0d, 0xa1, 0x19, 0x2b, 0x20, 0xf8, 0x0f, 0x0e, 0xd8, 0x5e, 0xcc, 0xf6, 0x31, 0x1b, 0xc2,
+	0x8f, 0x73, 0xbd, 0x64, 0xdb, 0x97, 0x37, 0x9a, 0x72, 0x75, 0xa3, 0x29, 0xdf, 0x6f, 0x34, 0xe5,
+	0xf4, 0x56, 0x2b, 0x5d, 0xdd, 0x6a, 0xa5, 0xaf, 0xb7, 0x5a, 0xe9, 0xed, 0xef, 0x97, 0x6d, 0x38,
+	0xfe, 0x3e, 0x77, 0xe7, 0xc5, 0xc5, 0x5b, 0xbf, 0x06, 0x00, 0xc4, 0x0a, 0xd0, 0xa2, 0xcc, 0x05,
+	0x00, 0x00,
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExecutionFee) > 0 {
+		for iNdEx := len(m.ExecutionFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScheduler(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintScheduler(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RemainingExecutions != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.RemainingExecutions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NextTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintScheduler(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduler(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxExecutions != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.MaxExecutions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxExecutionGas != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.MaxExecutionGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxExecutionsPerBlock != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.MaxExecutionsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ExecutionFee) > 0 {
+		for iNdEx := len(m.ExecutionFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScheduler(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleMsgProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleMsgProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleMsgProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintScheduler(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Executions != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExecuteTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecuteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecuteTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintScheduler(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExecuteHeight != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.ExecuteHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduler(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScheduler(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScheduler(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovScheduler(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovScheduler(uint64(m.NextHeight))
+	}
+	if m.NextTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextTime)
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if m.RemainingExecutions != 0 {
+		n += 1 + sovScheduler(uint64(m.RemainingExecutions))
+	}
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovScheduler(uint64(m.IntervalBlocks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovScheduler(uint64(l))
+	if len(m.ExecutionFee) > 0 {
+		for _, e := range m.ExecutionFee {
+			l = e.Size()
+			n += 1 + l + sovScheduler(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExecutionFee) > 0 {
+		for _, e := range m.ExecutionFee {
+			l = e.Size()
+			n += 1 + l + sovScheduler(uint64(l))
+		}
+	}
+	if m.MaxExecutionsPerBlock != 0 {
+		n += 1 + sovScheduler(uint64(m.MaxExecutionsPerBlock))
+	}
+	if m.MaxExecutionGas != 0 {
+		n += 1 + sovScheduler(uint64(m.MaxExecutionGas))
+	}
+	if m.MaxExecutions != 0 {
+		n += 1 + sovScheduler(uint64(m.MaxExecutions))
+	}
+	return n
+}
+
+func (m *ScheduleMsgProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if m.ExecuteHeight != 0 {
+		n += 1 + sovScheduler(uint64(m.ExecuteHeight))
+	}
+	if m.ExecuteTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecuteTime)
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovScheduler(uint64(m.Executions))
+	}
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovScheduler(uint64(m.IntervalBlocks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovScheduler(uint64(l))
+	return n
+}
+
+func sovScheduler(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScheduler(x uint64) (n int) {
+	return sovScheduler(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextTime == nil {
+				m.NextTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NextTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingExecutions", wireType)
+			}
+			m.RemainingExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingExecutions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionFee = append(m.ExecutionFee, types1.Coin{})
+			if err := m.ExecutionFee[len(m.ExecutionFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionFee = append(m.ExecutionFee, types1.Coin{})
+			if err := m.ExecutionFee[len(m.ExecutionFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionsPerBlock", wireType)
+			}
+			m.MaxExecutionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionGas", wireType)
+			}
+			m.MaxExecutionGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutions", wireType)
+			}
+			m.MaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleMsgProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleMsgProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleMsgProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHeight", wireType)
+			}
+			m.ExecuteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecuteTime == nil {
+				m.ExecuteTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExecuteTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScheduler(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScheduler
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScheduler
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScheduler
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScheduler        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScheduler          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScheduler = fmt.Errorf("proto: unexpected end of group")
+)
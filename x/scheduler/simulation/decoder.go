@@ -0,0 +1,33 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding scheduler type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], scheduler.ScheduleKeyPrefix):
+			var scheduleA, scheduleB scheduler.Schedule
+			cdc.MustUnmarshal(kvA.Value, &scheduleA)
+			cdc.MustUnmarshal(kvB.Value, &scheduleB)
+			return fmt.Sprintf("%v\n%v", scheduleA, scheduleB)
+		case bytes.Equal(kvA.Key[:1], scheduler.OwnerScheduleKeyPrefix),
+			bytes.Equal(kvA.Key[:1], scheduler.HeightQueueKeyPrefix),
+			bytes.Equal(kvA.Key[:1], scheduler.TimeQueueKeyPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], scheduler.NextScheduleIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		default:
+			panic(fmt.Sprintf("invalid scheduler key %X", kvA.Key))
+		}
+	}
+}
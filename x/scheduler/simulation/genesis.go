@@ -0,0 +1,65 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+)
+
+// Simulation parameter constants
+const (
+	maxExecutionsPerBlock = "max_executions_per_block"
+	maxExecutionGas       = "max_execution_gas"
+	maxExecutions         = "max_executions"
+)
+
+// GenMaxExecutionsPerBlock randomized MaxExecutionsPerBlock
+func GenMaxExecutionsPerBlock(r *rand.Rand) uint32 {
+	return uint32(r.Intn(200) + 1)
+}
+
+// GenMaxExecutionGas randomized MaxExecutionGas
+func GenMaxExecutionGas(r *rand.Rand) uint64 {
+	return uint64(r.Intn(2_000_000) + 100_000)
+}
+
+// GenMaxExecutions randomized MaxExecutions
+func GenMaxExecutions(r *rand.Rand) uint32 {
+	return uint32(r.Intn(1000) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for scheduler, without
+// schedules.
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		perBlock   uint32
+		gas        uint64
+		executions uint32
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxExecutionsPerBlock, &perBlock, simState.Rand,
+		func(r *rand.Rand) { perBlock = GenMaxExecutionsPerBlock(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxExecutionGas, &gas, simState.Rand,
+		func(r *rand.Rand) { gas = GenMaxExecutionGas(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxExecutions, &executions, simState.Rand,
+		func(r *rand.Rand) { executions = GenMaxExecutions(r) },
+	)
+
+	params := scheduler.NewParams(sdk.NewCoins(), perBlock, gas, executions)
+	genesis := scheduler.NewGenesisState(params, nil, 1)
+
+	bz, err := json.MarshalIndent(&genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated scheduler parameters:\n%s\n", bz)
+	simState.GenState[scheduler.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
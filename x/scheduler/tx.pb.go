@@ -0,0 +1,1242 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/scheduler/v1beta1/tx.proto
+
+package scheduler
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgCreateSchedule schedules a Msg for execution at a future height or time.
+type MsgCreateSchedule struct {
+	// owner is the address on behalf of which the Msg is executed.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// msg is the scheduled Msg, whose only signer must be the owner.
+	Msg *types.Any `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// execute_height is the height of the first execution, for a schedule by
+	// height.
+	ExecuteHeight int64 `protobuf:"varint,3,opt,name=execute_height,json=executeHeight,proto3" json:"execute_height,omitempty"`
+	// execute_time is the block time of the first execution, for a schedule by
+	// time.
+	ExecuteTime *time.Time `protobuf:"bytes,4,opt,name=execute_time,json=executeTime,proto3,stdtime" json:"execute_time,omitempty"`
+	// executions is the number of executions of the Msg.
+	Executions uint32 `protobuf:"varint,5,opt,name=executions,proto3" json:"executions,omitempty"`
+	// interval_blocks is the number of blocks between the executions of a
+	// schedule by height.
+	IntervalBlocks int64 `protobuf:"varint,6,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// interval is the duration between the executions of a schedule by time.
+	Interval time.Duration `protobuf:"bytes,7,opt,name=interval,proto3,stdduration" json:"interval"`
+}
+
+func (m *MsgCreateSchedule) Reset()         { *m = MsgCreateSchedule{} }
+func (m *MsgCreateSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSchedule) ProtoMessage()    {}
+func (*MsgCreateSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69f72846871cdd0c, []int{0}
+}
+func (m *MsgCreateSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSchedule.Merge(m, src)
+}
+func (m *MsgCreateSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSchedule proto.InternalMessageInfo
+
+func (m *MsgCreateSchedule) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgCreateSchedule) GetMsg() *types.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *MsgCreateSchedule) GetExecuteHeight() int64 {
+	if m != nil {
+		return m.ExecuteHeight
+	}
+	return 0
+}
+
+func (m *MsgCreateSchedule) GetExecuteTime() *time.Time {
+	if m != nil {
+		return m.ExecuteTime
+	}
+	return nil
+}
+
+func (m *MsgCreateSchedule) GetExecutions() uint32 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
+func (m *MsgCreateSchedule) GetIntervalBlocks() int64 {
+	if m != nil {
+		return m.IntervalBlocks
+	}
+	return 0
+}
+
+func (m *MsgCreateSchedule) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+// MsgCreateScheduleResponse defines the Msg/CreateSchedule response type.
+type MsgCreateScheduleResponse struct {
+	// id is the id of the created schedule.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCreateScheduleResponse) Reset()         { *m = MsgCreateScheduleResponse{} }
+func (m *MsgCreateScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateScheduleResponse) ProtoMessage()    {}
+func (*MsgCreateScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69f72846871cdd0c, []int{1}
+}
+func (m *MsgCreateScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateScheduleResponse.Merge(m, src)
+}
+func (m *MsgCreateScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgCreateScheduleResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelSchedule cancels a schedule.
+type MsgCancelSchedule struct {
+	// owner is the address of the owner of the schedule.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// id is the id of the schedule.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelSchedule) Reset()         { *m = MsgCancelSchedule{} }
+func (m *MsgCancelSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSchedule) ProtoMessage()    {}
+func (*MsgCancelSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69f72846871cdd0c, []int{2}
+}
+func (m *MsgCancelSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSchedule.Merge(m, src)
+}
+func (m *MsgCancelSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelSchedule proto.InternalMessageInfo
+
+func (m *MsgCancelSchedule) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgCancelSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelScheduleResponse defines the Msg/CancelSchedule response type.
+type MsgCancelScheduleResponse struct {
+}
+
+func (m *MsgCancelScheduleResponse) Reset()         { *m = MsgCancelScheduleResponse{} }
+func (m *MsgCancelScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduleResponse) ProtoMessage()    {}
+func (*MsgCancelScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69f72846871cdd0c, []int{3}
+}
+func (m *MsgCancelScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduleResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduleResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateSchedule)(nil), "cosmos.scheduler.v1beta1.MsgCreateSchedule")
+	proto.RegisterType((*MsgCreateScheduleResponse)(nil), "cosmos.scheduler.v1beta1.MsgCreateScheduleResponse")
+	proto.RegisterType((*MsgCancelSchedule)(nil), "cosmos.scheduler.v1beta1.MsgCancelSchedule")
+	proto.RegisterType((*MsgCancelScheduleResponse)(nil), "cosmos.scheduler.v1beta1.MsgCancelScheduleResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/scheduler/v1beta1/tx.proto", fileDescriptor_69f72846871cdd0c) }
+
+var fileDescriptor_69f72846871cdd0c = []byte{
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0x3a, 0xe9, 0x0f, 0x1b, 0x1a, 0xc4, 0x2a, 0x07, 0xc7, 0x48, 0x4e, 0x88, 0x84, 0xb0,
+	0x54, 0x65, 0xad, 0x36, 0x0f, 0x80, 0xea, 0x72, 0xe0, 0x92, 0x8b, 0xc3, 0x89, 0x4b, 0xe4, 0x9f,
+	0x65, 0x63, 0xc5, 0xf6, 0x46, 0xde, 0x75, 0x49, 0xdf, 0xa2, 0x47, 0x1e, 0xa4, 0x0f, 0x51, 0x71,
+	0xaa, 0x38, 0x71, 0x02, 0x94, 0x9c, 0xe1, 0x19, 0x90, 0xd7, 0xeb, 0x40, 0x63, 0x21, 0x14, 0x4e,
+	0xf6, 0x7c, 0xf3, 0xcd, 0x7c, 0xdf, 0xec, 0xec, 0xc2, 0xe7, 0x01, 0xe3, 0x09, 0xe3, 0x36, 0x0f,
+	0xe6, 0x24, 0xcc, 0x63, 0x92, 0xd9, 0x57, 0x67, 0x3e, 0x11, 0xde, 0x99, 0x2d, 0x56, 0x78, 0x99,
+	0x31, 0xc1, 0x90, 0x5e, 0x52, 0xf0, 0x96, 0x82, 0x15, 0xc5, 0xe8, 0x52, 0x46, 0x99, 0x24, 0xd9,
+	0xc5, 0x5f, 0xc9, 0x37, 0x7a, 0x94, 0x31, 0x1a, 0x13, 0x5b, 0x46, 0x7e, 0xfe, 0xde, 0xf6, 0xd2,
+	0x6b, 0x95, 0x32, 0x77, 0x53, 0x61, 0x9e, 0x79, 0x22, 0x62, 0xa9, 0xca, 0xf7, 0x77, 0xf3, 0x22,
+	0x4a, 0x08, 0x17, 0x5e, 0xb2, 0xac, 0x7a, 0x97, 0x5e, 0x66, 0xa5, 0xa8, 0x32, 0x26, 0x83, 0xe1,
+	0x4f, 0x0d, 0x3e, 0x9d, 0x70, 0x7a, 0x99, 0x11, 0x4f, 0x90, 0xa9, 0xf2, 0x8a, 0x30, 0x3c, 0x60,
+	0x1f, 0x52, 0x92, 0xe9, 0x60, 0x00, 0xac, 0x47, 0x8e, 0xfe, 0xf9, 0x76, 0xd4, 0x55, 0x65, 0x17,
+	0x61, 0x98, 0x11, 0xce, 0xa7, 0x22, 0x8b, 0x52, 0xea, 0x96, 0x34, 0x34, 0x86, 0xcd, 0x84, 0x53,
+	0x5d, 0x1b, 0x00, 0xab, 0x7d, 0xde, 0xc5, 0xa5, 0x1f, 0x5c, 0xf9, 0xc1, 0x17, 0xe9, 0xb5, 0xd3,
+	0xfe, 0x74, 0x3b, 0x3a, 0xe2, 0xe1, 0x02, 0x4f, 0x38, 0x75, 0x0b, 0x36, 0x7a, 0x01, 0x3b, 0x64,
+	0x45, 0x82, 0x5c, 0x90, 0xd9, 0x9c, 0x44, 0x74, 0x2e, 0xf4, 0xe6, 0x00, 0x58, 0x4d, 0xf7, 0x44,
+	0xa1, 0x6f, 0x24, 0x88, 0x2e, 0xe1, 0xe3, 0x8a, 0x56, 0xcc, 0xa5, 0xb7, 0xa4, 0x88, 0x51, 0x13,
+	0x79, 0x5b, 0x0d, 0xed, 0xb4, 0x6e, 0xbe, 0xf5, 0x81, 0xdb, 0x56, 0x55, 0x05, 0x8e, 0x4c, 0x08,
+	0xcb, 0x30, 0x62, 0x29, 0xd7, 0x0f, 0x06, 0xc0, 0x3a, 0x71, 0xff, 0x40, 0xd0, 0x4b, 0xf8, 0x24,
+	0x4a, 0x05, 0xc9, 0xae, 0xbc, 0x78, 0xe6, 0xc7, 0x2c, 0x58, 0x70, 0xfd, 0x50, 0x9a, 0xe9, 0x54,
+	0xb0, 0x23, 0x51, 0xf4, 0x0a, 0x1e, 0x57, 0x88, 0x7e, 0x24, 0x9d, 0xf4, 0x6a, 0x4e, 0x5e, 0xab,
+	0xf5, 0x38, 0xc7, 0x77, 0x5f, 0xfb, 0x8d, 0x8f, 0x85, 0x99, 0x6d, 0xd1, 0xf0, 0x14, 0xf6, 0x6a,
+	0xe7, 0xed, 0x12, 0xbe, 0x64, 0x29, 0x27, 0xa8, 0x03, 0xb5, 0x28, 0x94, 0x87, 0xde, 0x72, 0xb5,
+	0x28, 0x1c, 0x4e, 0xcb, 0xe5, 0x78, 0x69, 0x40, 0xe2, 0xff, 0x5e, 0x4e, 0xd9, 0x54, 0xdb, 0x36,
+	0x7d, 0x06, 0x7b, 0xb5, 0xa6, 0x95, 0x83, 0xf3, 0x1f, 0x00, 0x36, 0x27, 0x9c, 0xa2, 0x0c, 0x76,
+	0x76, 0xee, 0xc4, 0x29, 0xfe, 0xdb, 0x8d, 0xc6, 0xb5, 0x81, 0x8c, 0xf1, 0x1e, 0xe4, 0xed, 0xf4,
+	0x85, 0xe6, 0xc3, 0x51, 0xff, 0xa1, 0xf9, 0x80, 0x6c, 0x8c, 0xf7, 0x20, 0x57, 0x9a, 0x8e, 0x73,
+	0xb7, 0x36, 0xc1, 0xfd, 0xda, 0x04, 0xdf, 0xd7, 0x26, 0xb8, 0xd9, 0x98, 0x8d, 0xfb, 0x8d, 0xd9,
+	0xf8, 0xb2, 0x31, 0x1b, 0xef, 0x2c, 0x1a, 0x89, 0x79, 0xee, 0xe3, 0x80, 0x25, 0xea, 0xc9, 0xa8,
+	0xcf, 0x88, 0x87, 0x0b, 0x7b, 0xf5, 0xfb, 0xed, 0xfb, 0x87, 0x72, 0xf3, 0xe3, 0x5f, 0x03, 0x00,
+	0x8e, 0x26, 0x90, 0xb9, 0x16, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateSchedule schedules a Msg of the signer for execution at a future
+	// height or time, prepaying the execution fee of each execution.
+	CreateSchedule(ctx context.Context, in *MsgCreateSchedule, opts ...grpc.CallOption) (*MsgCreateScheduleResponse, error)
+	// CancelSchedule cancels a schedule of the signer, refunding the fees of the
+	// remaining executions.
+	CancelSchedule(ctx context.Context, in *MsgCancelSchedule, opts ...grpc.CallOption) (*MsgCancelScheduleResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateSchedule(ctx context.Context, in *MsgCreateSchedule, opts ...grpc.CallOption) (*MsgCreateScheduleResponse, error) {
+	out := new(MsgCreateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.scheduler.v1beta1.Msg/CreateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelSchedule(ctx context.Context, in *MsgCancelSchedule, opts ...grpc.CallOption) (*MsgCancelScheduleResponse, error) {
+	out := new(MsgCancelScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.scheduler.v1beta1.Msg/CancelSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateSchedule schedules a Msg of the signer for execution at a future
+	// height or time, prepaying the execution fee of each execution.
+	CreateSchedule(context.Context, *MsgCreateSchedule) (*MsgCreateScheduleResponse, error)
+	// CancelSchedule cancels a schedule of the signer, refunding the fees of the
+	// remaining executions.
+	CancelSchedule(context.Context, *MsgCancelSchedule) (*MsgCancelScheduleResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateSchedule(ctx context.Context, req *MsgCreateSchedule) (*MsgCreateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (*UnimplementedMsgServer) CancelSchedule(ctx context.Context, req *MsgCancelSchedule) (*MsgCancelScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSchedule not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.scheduler.v1beta1.Msg/CreateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSchedule(ctx, req.(*MsgCreateSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.scheduler.v1beta1.Msg/CancelSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSchedule(ctx, req.(*MsgCancelSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.scheduler.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _Msg_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "CancelSchedule",
+			Handler:    _Msg_CancelSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/scheduler/v1beta1/tx.proto",
+}
+
+func (m *MsgCreateSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Executions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExecuteTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecuteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecuteTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExecuteHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecuteHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecuteHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExecuteHeight))
+	}
+	if m.ExecuteTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecuteTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovTx(uint64(m.Executions))
+	}
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovTx(uint64(m.IntervalBlocks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHeight", wireType)
+			}
+			m.ExecuteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecuteTime == nil {
+				m.ExecuteTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExecuteTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
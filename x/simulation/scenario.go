@@ -0,0 +1,192 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// Scenario is a recorded sequence of the blocks of a simulation. Replaying a
+// scenario delivers the same blocks and txs to the application without
+// generating them from a seed, so that a simulation failure can be reproduced
+// on any machine, regardless of the Go version used to record it.
+type Scenario struct {
+	InitChain abci.RequestInitChain
+	Blocks    []ScenarioBlock
+}
+
+// ScenarioBlock is a block of a Scenario, holding the txs delivered between
+// BeginBlock and EndBlock.
+type ScenarioBlock struct {
+	BeginBlock abci.RequestBeginBlock
+	Txs        [][]byte
+	EndBlock   abci.RequestEndBlock
+}
+
+type scenarioJSON struct {
+	InitChain json.RawMessage     `json:"init_chain"`
+	Blocks    []scenarioBlockJSON `json:"blocks"`
+}
+
+type scenarioBlockJSON struct {
+	BeginBlock json.RawMessage `json:"begin_block"`
+	Txs        [][]byte        `json:"txs"`
+	EndBlock   json.RawMessage `json:"end_block"`
+}
+
+// MarshalJSON implements the json.Marshaler interface. The ABCI requests are
+// encoded with the protobuf JSON mapping.
+func (s Scenario) MarshalJSON() ([]byte, error) {
+	initChain, err := marshalABCIRequest(&s.InitChain)
+	if err != nil {
+		return nil, err
+	}
+
+	out := scenarioJSON{
+		InitChain: initChain,
+		Blocks:    make([]scenarioBlockJSON, len(s.Blocks)),
+	}
+
+	for i := range s.Blocks {
+		block := &s.Blocks[i]
+		if out.Blocks[i].BeginBlock, err = marshalABCIRequest(&block.BeginBlock); err != nil {
+			return nil, err
+		}
+		if out.Blocks[i].EndBlock, err = marshalABCIRequest(&block.EndBlock); err != nil {
+			return nil, err
+		}
+		out.Blocks[i].Txs = block.Txs
+	}
+
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (s *Scenario) UnmarshalJSON(bz []byte) error {
+	var in scenarioJSON
+	if err := json.Unmarshal(bz, &in); err != nil {
+		return err
+	}
+
+	if err := unmarshalABCIRequest(in.InitChain, &s.InitChain); err != nil {
+		return err
+	}
+
+	s.Blocks = make([]ScenarioBlock, len(in.Blocks))
+	for i, block := range in.Blocks {
+		if err := unmarshalABCIRequest(block.BeginBlock, &s.Blocks[i].BeginBlock); err != nil {
+			return err
+		}
+		if err := unmarshalABCIRequest(block.EndBlock, &s.Blocks[i].EndBlock); err != nil {
+			return err
+		}
+		s.Blocks[i].Txs = block.Txs
+	}
+
+	return nil
+}
+
+// Export writes the scenario as JSON to the given file path.
+func (s Scenario) Export(path string) error {
+	bz, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bz, 0600)
+}
+
+// LoadScenario reads a scenario written by Scenario.Export.
+func LoadScenario(path string) (Scenario, error) {
+	var s Scenario
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return s, err
+	}
+
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return s, fmt.Errorf("failed to decode scenario %s: %w", path, err)
+	}
+
+	return s, nil
+}
+
+// ReplayScenario initializes the application with the genesis of the scenario
+// and delivers all of its blocks, committing each of them if commit is set.
+// Txs are delivered as recorded, so txs which failed during the recorded
+// simulation fail again.
+func ReplayScenario(w io.Writer, app *baseapp.BaseApp, scenario Scenario, commit bool) {
+	app.InitChain(scenario.InitChain)
+
+	for i, block := range scenario.Blocks {
+		fmt.Fprintf(w, "\rReplaying... block %d/%d.", i+1, len(scenario.Blocks))
+
+		app.BeginBlock(block.BeginBlock)
+		for _, tx := range block.Txs {
+			app.DeliverTx(abci.RequestDeliverTx{Tx: tx})
+		}
+		app.EndBlock(block.EndBlock)
+
+		if commit {
+			app.Commit()
+		}
+	}
+
+	fmt.Fprintf(w, "\nScenario replay complete; blocks: %d\n", len(scenario.Blocks))
+}
+
+// scenarioRecorder records the blocks and txs of a simulation into a Scenario.
+type scenarioRecorder struct {
+	scenario Scenario
+}
+
+// newScenarioRecorder returns a recorder of the txs delivered to the given
+// application.
+func newScenarioRecorder(app *baseapp.BaseApp) *scenarioRecorder {
+	rec := &scenarioRecorder{}
+	app.SetSimDeliverListener(func(txBytes []byte) {
+		if n := len(rec.scenario.Blocks); n > 0 {
+			rec.scenario.Blocks[n-1].Txs = append(rec.scenario.Blocks[n-1].Txs, txBytes)
+		}
+	})
+
+	return rec
+}
+
+func (rec *scenarioRecorder) initChain(req abci.RequestInitChain) {
+	rec.scenario.InitChain = req
+}
+
+func (rec *scenarioRecorder) beginBlock(req abci.RequestBeginBlock) {
+	rec.scenario.Blocks = append(rec.scenario.Blocks, ScenarioBlock{BeginBlock: req})
+}
+
+func (rec *scenarioRecorder) endBlock(req abci.RequestEndBlock) {
+	rec.scenario.Blocks[len(rec.scenario.Blocks)-1].EndBlock = req
+}
+
+func marshalABCIRequest(msg proto.Message) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, msg); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func unmarshalABCIRequest(bz json.RawMessage, msg proto.Message) error {
+	if len(bz) == 0 {
+		return nil
+	}
+
+	return jsonpb.Unmarshal(bytes.NewReader(bz), msg)
+}
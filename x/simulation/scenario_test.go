@@ -0,0 +1,46 @@
+package simulation
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestScenarioExportLoad(t *testing.T) {
+	scenario := Scenario{
+		InitChain: abci.RequestInitChain{
+			Time:          time.Unix(1000, 0).UTC(),
+			ChainId:       "test-chain",
+			AppStateBytes: []byte(`{"bank":{}}`),
+		},
+		Blocks: []ScenarioBlock{
+			{
+				BeginBlock: abci.RequestBeginBlock{
+					Hash:   []byte{1, 2, 3},
+					Header: tmproto.Header{ChainID: "test-chain", Height: 1, Time: time.Unix(1006, 0).UTC()},
+				},
+				Txs:      [][]byte{[]byte("tx1"), []byte("tx2")},
+				EndBlock: abci.RequestEndBlock{Height: 1},
+			},
+			{
+				BeginBlock: abci.RequestBeginBlock{
+					Header: tmproto.Header{ChainID: "test-chain", Height: 2, Time: time.Unix(1012, 0).UTC()},
+				},
+			},
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "scenario.json")
+	require.NoError(t, scenario.Export(path))
+
+	loaded, err := LoadScenario(path)
+	require.NoError(t, err)
+	require.Equal(t, scenario, loaded)
+
+	_, err = LoadScenario(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
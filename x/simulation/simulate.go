@@ -30,7 +30,7 @@ func initChain(
 	appStateFn simulation.AppStateFn,
 	config simulation.Config,
 	cdc codec.JSONCodec,
-) (mockValidators, time.Time, []simulation.Account, string) {
+) (mockValidators, time.Time, []simulation.Account, string, abci.RequestInitChain) {
 
 	appState, accounts, chainID, genesisTimestamp := appStateFn(r, accounts, config)
 	consensusParams := randomConsensusParams(r, appState, cdc)
@@ -43,7 +43,7 @@ func initChain(
 	res := app.InitChain(req)
 	validators := newMockValidators(r, res.Validators, params)
 
-	return validators, genesisTimestamp, accounts, chainID
+	return validators, genesisTimestamp, accounts, chainID, req
 }
 
 // SimulateFromSeed tests an application by running the provided
@@ -72,13 +72,30 @@ func SimulateFromSeed(
 	accs := randAccFn(r, params.NumKeys())
 	eventStats := NewEventStats()
 
+	// record the blocks and txs of the simulation, even if it halts on a panic
+	var recorder *scenarioRecorder
+	if config.ExportScenarioPath != "" {
+		recorder = newScenarioRecorder(app)
+		defer func() {
+			app.SetSimDeliverListener(nil)
+			fmt.Fprintf(w, "Exporting simulation scenario to %s\n", config.ExportScenarioPath)
+			if err := recorder.scenario.Export(config.ExportScenarioPath); err != nil {
+				fmt.Fprintf(w, "failed to export simulation scenario: %v\n", err)
+			}
+		}()
+	}
+
 	// Second variable to keep pending validator set (delayed one block since
 	// TM 0.24) Initially this is the same as the initial validator set
-	validators, genesisTimestamp, accs, chainID := initChain(r, params, accs, app, appStateFn, config, cdc)
+	validators, genesisTimestamp, accs, chainID, initReq := initChain(r, params, accs, app, appStateFn, config, cdc)
 	if len(accs) == 0 {
 		return true, params, fmt.Errorf("must have greater than zero genesis accounts")
 	}
 
+	if recorder != nil {
+		recorder.initChain(initReq)
+	}
+
 	config.ChainID = chainID
 
 	fmt.Printf(
@@ -163,6 +180,9 @@ func SimulateFromSeed(
 
 		// Run the BeginBlock handler
 		logWriter.AddEntry(BeginBlockEntry(int64(height)))
+		if recorder != nil {
+			recorder.beginBlock(request)
+		}
 		app.BeginBlock(request)
 
 		ctx := app.NewContext(false, header)
@@ -183,12 +203,16 @@ func SimulateFromSeed(
 		operations := blockSimulator(r, app, ctx, accs, header)
 		opCount += operations + numQueuedOpsRan + numQueuedTimeOpsRan
 
+		if recorder != nil {
+			recorder.endBlock(abci.RequestEndBlock{})
+		}
 		res := app.EndBlock(abci.RequestEndBlock{})
 		header.Height++
 		header.Time = header.Time.Add(
 			time.Duration(minTimePerBlock) * time.Second)
 		header.Time = header.Time.Add(
 			time.Duration(int64(r.Intn(int(timeDiff)))) * time.Second)
+		header.Time = header.Time.Add(clockSkew(r, config.ClockSkew))
 		header.ProposerAddress = validators.randomProposer(r)
 
 		logWriter.AddEntry(EndBlockEntry(int64(height)))
@@ -381,3 +405,18 @@ func runQueuedTimeOperations(queueOps []simulation.FutureOperation,
 
 	return numOpsRan
 }
+
+// clockSkewFrequency is the inverse of the probability that the block time
+// jumps forward when the clock skew is enabled.
+const clockSkewFrequency = 20
+
+// clockSkew returns the random forward jump of the time of the next block, up
+// to the given maximum. The random source is only used if the skew is enabled,
+// so that the simulations of a seed are unchanged otherwise.
+func clockSkew(r *rand.Rand, max time.Duration) time.Duration {
+	if max <= 0 || r.Intn(clockSkewFrequency) != 0 {
+		return 0
+	}
+
+	return time.Duration(r.Int63n(int64(max)) + 1)
+}
@@ -0,0 +1,27 @@
+package simulation
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockSkew(t *testing.T) {
+	// the random source is not used if the skew is disabled
+	r1, r2 := rand.New(rand.NewSource(1)), rand.New(rand.NewSource(1))
+	require.Zero(t, clockSkew(r1, 0))
+	require.Equal(t, r2.Int63(), r1.Int63())
+
+	r := rand.New(rand.NewSource(1))
+	var skewed int
+	for i := 0; i < 1000; i++ {
+		skew := clockSkew(r, time.Hour)
+		require.True(t, skew >= 0 && skew <= time.Hour)
+		if skew > 0 {
+			skewed++
+		}
+	}
+	require.InDelta(t, 1000/clockSkewFrequency, skewed, 25)
+}
@@ -27,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryJailHistory(),
 	)
 
 	return slashingQueryCmd
@@ -109,6 +110,50 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQueryJailHistory implements the command to query the jail history of a
+// validator.
+func GetCmdQueryJailHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jail-history [validator-cons-addr]",
+		Short: "Query the jail history of a validator",
+		Long: strings.TrimSpace(`Use a validator's consensus address to find the jail records of that validator, from oldest to newest:
+
+$ <appd> query slashing jail-history cosmosvalcons1nrqslkwd3pz096lh6t082frdqc84uwxn0t958c
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			consAddr, err := sdk.ConsAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryJailHistoryRequest{ConsAddress: consAddr.String(), Pagination: pageReq}
+			res, err := queryClient.JailHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "jail history")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,12 +1,18 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
@@ -50,3 +56,71 @@ $ <appd> tx slashing unjail --from mykey
 
 	return cmd
 }
+
+// NewCmdSubmitTombstoneAppealProposal implements the command to submit a
+// tombstone appeal proposal.
+func NewCmdSubmitTombstoneAppealProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tombstone-appeal [validator-addr] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to lift the tombstone of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to lift the tombstone of a validator along with an initial deposit.
+The validator remains jailed for the tombstone appeal cooldown. A validator can only be granted a single tombstone appeal.
+
+Example:
+$ %s tx gov submit-proposal tombstone-appeal %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --title="Tombstone Appeal" --description="Accidental double-sign" --deposit=1000stake --from mykey
+`,
+				version.AppName, sdk.GetConfig().GetBech32ValidatorAddrPrefix(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewTombstoneAppealProposal(title, description, valAddr)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
@@ -0,0 +1,9 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
+)
+
+// ProposalHandler is the tombstone appeal proposal handler.
+var ProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitTombstoneAppealProposal)
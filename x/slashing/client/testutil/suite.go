@@ -115,7 +115,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000"}`,
+			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000","tombstone_appeal_cooldown":"604800s"}`,
 		},
 		{
 			"text output",
@@ -124,7 +124,8 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 min_signed_per_window: "0.500000000000000000"
 signed_blocks_window: "100"
 slash_fraction_double_sign: "0.050000000000000000"
-slash_fraction_downtime: "0.010000000000000000"`,
+slash_fraction_downtime: "0.010000000000000000"
+tombstone_appeal_cooldown: 604800s`,
 		},
 	}
 
@@ -39,6 +39,14 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, stakingKeeper types.Stak
 		}
 	}
 
+	for _, appeal := range data.TombstoneAppeals {
+		keeper.SetTombstoneAppeal(ctx, appeal)
+	}
+
+	for _, record := range data.JailRecords {
+		keeper.AppendJailRecord(ctx, record)
+	}
+
 	keeper.SetParams(ctx, data.Params)
 }
 
@@ -66,5 +74,17 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	tombstoneAppeals := make([]types.TombstoneAppeal, 0)
+	keeper.IterateTombstoneAppeals(ctx, func(appeal types.TombstoneAppeal) (stop bool) {
+		tombstoneAppeals = append(tombstoneAppeals, appeal)
+		return false
+	})
+
+	jailRecords := make([]types.JailRecord, 0)
+	keeper.IterateJailRecords(ctx, func(record types.JailRecord) (stop bool) {
+		jailRecords = append(jailRecords, record)
+		return false
+	})
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, tombstoneAppeals, jailRecords)
 }
@@ -72,3 +72,36 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+func (k Keeper) JailHistory(c context.Context, req *types.QueryJailHistoryRequest) (*types.QueryJailHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	var records []types.JailRecord
+
+	recordStore := prefix.NewStore(store, types.JailRecordPrefixKey(consAddr))
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key []byte, value []byte) error {
+		var record types.JailRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryJailHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -80,6 +80,39 @@ func (suite *SlashingTestSuite) TestGRPCSigningInfo() {
 	suite.Equal(info, infoResp.ValSigningInfo)
 }
 
+func (suite *SlashingTestSuite) TestGRPCJailHistory() {
+	queryClient := suite.queryClient
+
+	historyResp, err := queryClient.JailHistory(gocontext.Background(), &types.QueryJailHistoryRequest{ConsAddress: ""})
+	suite.Error(err)
+	suite.Nil(historyResp)
+
+	consAddr := sdk.ConsAddress(suite.addrDels[0])
+	records := []types.JailRecord{
+		types.NewJailRecord(consAddr, types.JailReasonDowntime, 10, time.Unix(10, 0).UTC(), ""),
+		types.NewJailRecord(consAddr, types.JailReasonDoubleSign, 20, time.Unix(20, 0).UTC(), "ABCD"),
+		types.NewJailRecord(consAddr, types.JailReasonDowntime, 30, time.Unix(30, 0).UTC(), ""),
+	}
+	for _, record := range records {
+		suite.app.SlashingKeeper.AppendJailRecord(suite.ctx, record)
+	}
+	// the records of other validators aren't returned
+	suite.app.SlashingKeeper.AppendJailRecord(suite.ctx,
+		types.NewJailRecord(sdk.ConsAddress(suite.addrDels[1]), types.JailReasonDowntime, 10, time.Unix(10, 0).UTC(), ""))
+
+	historyResp, err = queryClient.JailHistory(gocontext.Background(),
+		&types.QueryJailHistoryRequest{ConsAddress: consAddr.String()})
+	suite.NoError(err)
+	suite.Equal(records, historyResp.Records)
+
+	historyResp, err = queryClient.JailHistory(gocontext.Background(),
+		&types.QueryJailHistoryRequest{ConsAddress: consAddr.String(), Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	suite.NoError(err)
+	suite.Equal(records[:2], historyResp.Records)
+	suite.Equal(uint64(3), historyResp.Pagination.Total)
+	suite.NotNil(historyResp.Pagination.NextKey)
+}
+
 func (suite *SlashingTestSuite) TestGRPCSigningInfos() {
 	queryClient := suite.queryClient
 
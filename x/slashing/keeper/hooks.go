@@ -5,11 +5,16 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
 func (k Keeper) AfterValidatorBonded(ctx sdk.Context, address sdk.ConsAddress, _ sdk.ValAddress) error {
+	// The signing info of a validator which rotated its consensus public key
+	// is kept under its initial consensus address
+	address = k.sk.ValidatorIdentifier(ctx, address)
+
 	// Update the signing info start height or create a new signing info
 	_, found := k.GetValidatorSigningInfo(ctx, address)
 	if !found {
@@ -38,6 +43,14 @@ func (k Keeper) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) e
 	return k.AddPubkey(ctx, consPk)
 }
 
+// AfterConsensusPubKeyRotated adds the address-pubkey relation of the new
+// consensus public key of a validator. The relation of the old key is kept, so
+// that the evidence of infractions committed before the rotation can be
+// handled.
+func (k Keeper) AfterConsensusPubKeyRotated(ctx sdk.Context, newPubKey cryptotypes.PubKey) error {
+	return k.AddPubkey(ctx, newPubKey)
+}
+
 // AfterValidatorRemoved deletes the address-pubkey relation when a validator is removed,
 func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress) error {
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
@@ -88,3 +101,8 @@ func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.Va
 	return nil
 }
 func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error { return nil }
+
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterConsensusPubKeyRotated(ctx sdk.Context, _, newPubKey cryptotypes.PubKey, _ sdk.ValAddress) error {
+	return h.k.AfterConsensusPubKeyRotated(ctx, newPubKey)
+}
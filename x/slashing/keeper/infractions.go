@@ -19,6 +19,10 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 		panic(fmt.Sprintf("Validator consensus-address %s not found", consAddr))
 	}
 
+	// the signing info of a validator which rotated its consensus public key
+	// is kept under its initial consensus address
+	consAddr = k.sk.ValidatorIdentifier(ctx, consAddr)
+
 	// fetch signing info
 	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
 	if !found {
@@ -96,6 +100,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				),
 			)
 			k.sk.Jail(ctx, consAddr)
+			k.AppendJailRecord(ctx, types.NewJailRecord(consAddr, types.JailReasonDowntime, height, ctx.BlockHeader().Time, ""))
 
 			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
 
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// AppendJailRecord appends a jail record to the jail history of a validator.
+func (k Keeper) AppendJailRecord(ctx sdk.Context, record types.JailRecord) {
+	consAddr, err := sdk.ConsAddressFromBech32(record.Address)
+	if err != nil {
+		panic(fmt.Sprintf("invalid jail record address %s: %s", record.Address, err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.JailRecordPrefixKey(consAddr)
+
+	// the records are indexed sequentially per validator, so the next index
+	// follows the one of the last record
+	index := uint64(0)
+	iter := sdk.KVStoreReversePrefixIterator(store, prefix)
+	if iter.Valid() {
+		index = sdk.BigEndianToUint64(iter.Key()[len(prefix):]) + 1
+	}
+	iter.Close()
+
+	store.Set(types.JailRecordKey(consAddr, index), k.cdc.MustMarshal(&record))
+}
+
+// RecordDoubleSignJail records the jailing of a validator for the double-sign
+// of the evidence of the given hash.
+func (k Keeper) RecordDoubleSignJail(ctx sdk.Context, consAddr sdk.ConsAddress, evidenceHash []byte) {
+	k.AppendJailRecord(ctx, types.NewJailRecord(
+		consAddr, types.JailReasonDoubleSign, ctx.BlockHeight(), ctx.BlockHeader().Time, fmt.Sprintf("%X", evidenceHash),
+	))
+}
+
+// closeJailRecords sets the unjail height of the jail records of a validator
+// which are still open, i.e. the latest records without an unjail height.
+func (k Keeper) closeJailRecords(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStoreReversePrefixIterator(store, types.JailRecordPrefixKey(consAddr))
+	defer iter.Close()
+
+	// the store can't be written to while being iterated
+	var (
+		keys    [][]byte
+		records []types.JailRecord
+	)
+	for ; iter.Valid(); iter.Next() {
+		var record types.JailRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if record.UnjailHeight != 0 {
+			break
+		}
+
+		record.UnjailHeight = ctx.BlockHeight()
+		keys = append(keys, iter.Key())
+		records = append(records, record)
+	}
+
+	for i, key := range keys {
+		store.Set(key, k.cdc.MustMarshal(&records[i]))
+	}
+}
+
+// GetJailRecords returns the jail records of a validator, from oldest to newest.
+func (k Keeper) GetJailRecords(ctx sdk.Context, consAddr sdk.ConsAddress) []types.JailRecord {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.JailRecordPrefixKey(consAddr))
+	defer iter.Close()
+
+	records := []types.JailRecord{}
+	for ; iter.Valid(); iter.Next() {
+		var record types.JailRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// IterateJailRecords iterates over the stored jail records, by validator and
+// from oldest to newest.
+func (k Keeper) IterateJailRecords(ctx sdk.Context, handler func(record types.JailRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.JailRecordKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.JailRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if handler(record) {
+			break
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func TestJailHistory(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(0, 0)})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(100)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Empty(t, app.SlashingKeeper.GetJailRecords(ctx, consAddr))
+
+	// miss blocks until the validator is jailed for downtime
+	window := app.SlashingKeeper.SignedBlocksWindow(ctx)
+	height := int64(0)
+	for ; height < window; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+	for ; height < window+(window-app.SlashingKeeper.MinSignedPerWindow(ctx))+1; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	jailHeight := height - 1
+	records := app.SlashingKeeper.GetJailRecords(ctx, consAddr)
+	require.Equal(t, []types.JailRecord{
+		types.NewJailRecord(consAddr, types.JailReasonDowntime, jailHeight, ctx.BlockHeader().Time, ""),
+	}, records)
+
+	// missing blocks while jailed doesn't jail the validator again
+	ctx = ctx.WithBlockHeight(height)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	require.Len(t, app.SlashingKeeper.GetJailRecords(ctx, consAddr), 1)
+
+	// unjailing closes the record
+	ctx = ctx.WithBlockHeight(height + 1).WithBlockTime(ctx.BlockHeader().Time.Add(app.SlashingKeeper.DowntimeJailDuration(ctx)))
+	require.NoError(t, app.SlashingKeeper.Unjail(ctx, addr))
+	records = app.SlashingKeeper.GetJailRecords(ctx, consAddr)
+	require.Len(t, records, 1)
+	require.Equal(t, height+1, records[0].UnjailHeight)
+
+	// a double-sign opens a new record with the hash of the evidence
+	ctx = ctx.WithBlockHeight(height + 2)
+	app.SlashingKeeper.RecordDoubleSignJail(ctx, consAddr, []byte{0xab, 0xcd})
+	records = app.SlashingKeeper.GetJailRecords(ctx, consAddr)
+	require.Len(t, records, 2)
+	require.Equal(t, types.NewJailRecord(consAddr, types.JailReasonDoubleSign, height+2, ctx.BlockHeader().Time, "ABCD"), records[1])
+}
@@ -280,3 +280,33 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	tstaking.CheckValidator(valAddr, stakingtypes.Unbonding, true)
 }
+
+// Test a validator signing blocks after rotating its consensus public key
+// Ensure that its signing info is still the one of its initial consensus address
+func TestHandleRotatedValidator(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(2)
+	addr, oldPk, newPk := valAddrs[0], pks[0], pks[1]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, oldPk, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), 100, true)
+
+	validator := tstaking.CheckValidator(addr, stakingtypes.Bonded, false)
+	require.NoError(t, app.StakingKeeper.RotateValidatorConsPubKey(ctx, validator, newPk))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, newPk.Address(), 100, false)
+
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(oldPk.Address()))
+	require.True(t, found)
+	require.Equal(t, int64(2), info.IndexOffset)
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+	_, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(newPk.Address()))
+	require.False(t, found)
+}
@@ -42,6 +42,13 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// TombstoneAppealCooldown - duration a validator remains jailed after a
+// tombstone appeal
+func (k Keeper) TombstoneAppealCooldown(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyTombstoneAppealCooldown, &res)
+	return
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
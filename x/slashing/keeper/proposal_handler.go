@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// HandleTombstoneAppealProposal is a handler for executing a passed tombstone
+// appeal proposal.
+func HandleTombstoneAppealProposal(ctx sdk.Context, k Keeper, p *types.TombstoneAppealProposal) error {
+	valAddr, err := sdk.ValAddressFromBech32(p.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	msg := types.NewMsgAppealTombstone(k.GetAuthority(), valAddr)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	_, err = NewMsgServerImpl(k).AppealTombstone(sdk.WrapSDKContext(ctx), msg)
+	return err
+}
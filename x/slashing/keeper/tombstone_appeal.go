@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// AppealTombstone lifts the tombstone of a validator, e.g. after an accidental
+// double-sign. The validator remains jailed until the tombstone appeal cooldown
+// has passed, after which it must unjail itself with MsgUnjail. A validator can
+// only be granted a single tombstone appeal, so that a second tombstone is
+// final.
+func (k Keeper) AppealTombstone(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
+	validator := k.sk.Validator(ctx, validatorAddr)
+	if validator == nil {
+		return types.ErrNoValidatorForAddress
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	consAddr = k.sk.ValidatorIdentifier(ctx, consAddr)
+
+	info, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return types.ErrNoSigningInfoFound
+	}
+	if !info.Tombstoned {
+		return types.ErrValidatorNotTombstoned
+	}
+	if _, found := k.GetTombstoneAppeal(ctx, consAddr); found {
+		return types.ErrTombstoneAlreadyAppealed
+	}
+
+	// the validator starts over with a clean liveness record once it unjails
+	info.Tombstoned = false
+	info.JailedUntil = ctx.BlockHeader().Time.Add(k.TombstoneAppealCooldown(ctx))
+	info.MissedBlocksCounter = 0
+	info.IndexOffset = 0
+	k.clearValidatorMissedBlockBitArray(ctx, consAddr)
+	k.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	k.SetTombstoneAppeal(ctx, types.NewTombstoneAppeal(consAddr, ctx.BlockHeight(), ctx.BlockHeader().Time))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTombstoneAppeal,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyJailedUntil, info.JailedUntil.String()),
+		),
+	)
+
+	k.Logger(ctx).Info(
+		"lifted validator tombstone",
+		"validator", consAddr.String(),
+		"jailed_until", info.JailedUntil,
+	)
+
+	return nil
+}
+
+// GetTombstoneAppeal returns the tombstone appeal granted to a validator by
+// consensus address.
+func (k Keeper) GetTombstoneAppeal(ctx sdk.Context, consAddr sdk.ConsAddress) (appeal types.TombstoneAppeal, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TombstoneAppealKey(consAddr))
+	if bz == nil {
+		return appeal, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &appeal)
+	return appeal, true
+}
+
+// SetTombstoneAppeal sets the tombstone appeal granted to a validator.
+func (k Keeper) SetTombstoneAppeal(ctx sdk.Context, appeal types.TombstoneAppeal) {
+	consAddr, err := sdk.ConsAddressFromBech32(appeal.Address)
+	if err != nil {
+		panic(fmt.Sprintf("invalid tombstone appeal address %s: %s", appeal.Address, err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TombstoneAppealKey(consAddr), k.cdc.MustMarshal(&appeal))
+}
+
+// IterateTombstoneAppeals iterates over the stored tombstone appeals.
+func (k Keeper) IterateTombstoneAppeals(ctx sdk.Context, handler func(appeal types.TombstoneAppeal) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TombstoneAppealKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var appeal types.TombstoneAppeal
+		k.cdc.MustUnmarshal(iter.Value(), &appeal)
+		if handler(appeal) {
+			break
+		}
+	}
+}
+
+// IsInfractionAppealed returns if the tombstone of a validator was lifted after
+// the given infraction time, in which case the infraction must be ignored.
+func (k Keeper) IsInfractionAppealed(ctx sdk.Context, consAddr sdk.ConsAddress, infractionTime time.Time) bool {
+	appeal, found := k.GetTombstoneAppeal(ctx, consAddr)
+	if !found {
+		return false
+	}
+
+	return infractionTime.Before(appeal.Time)
+}
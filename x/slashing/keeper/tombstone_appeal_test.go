@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func TestAppealTombstone(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(0, 0)})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	consAddr := sdk.ConsAddress(pks[0].Address())
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], pks[0], 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// only tombstoned validators can appeal
+	require.ErrorIs(t, app.SlashingKeeper.AppealTombstone(ctx, valAddrs[0]), types.ErrValidatorNotTombstoned)
+
+	infractionTime := ctx.BlockHeader().Time
+	app.SlashingKeeper.Jail(ctx, consAddr)
+	app.SlashingKeeper.JailUntil(ctx, consAddr, evidencetypes.DoubleSignJailEndTime)
+	app.SlashingKeeper.Tombstone(ctx, consAddr)
+
+	// only the authority can lift a tombstone
+	msgServer := keeper.NewMsgServerImpl(app.SlashingKeeper)
+	_, err := msgServer.AppealTombstone(sdk.WrapSDKContext(ctx), types.NewMsgAppealTombstone(addrDels[0].String(), valAddrs[0]))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(infractionTime.Add(time.Hour))
+	proposal := types.NewTombstoneAppealProposal("title", "description", valAddrs[0])
+	require.NoError(t, keeper.HandleTombstoneAppealProposal(ctx, app.SlashingKeeper, proposal))
+	require.False(t, app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+
+	appeal, found := app.SlashingKeeper.GetTombstoneAppeal(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, types.NewTombstoneAppeal(consAddr, 10, ctx.BlockHeader().Time), appeal)
+
+	// the infractions committed before the appeal are ignored
+	require.True(t, app.SlashingKeeper.IsInfractionAppealed(ctx, consAddr, infractionTime))
+	require.False(t, app.SlashingKeeper.IsInfractionAppealed(ctx, consAddr, ctx.BlockHeader().Time.Add(time.Second)))
+
+	// the validator remains jailed for the cooldown
+	cooldown := app.SlashingKeeper.TombstoneAppealCooldown(ctx)
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeader().Time.Add(cooldown), info.JailedUntil)
+	require.ErrorIs(t, app.SlashingKeeper.Unjail(ctx, valAddrs[0]), types.ErrValidatorJailed)
+
+	ctx = ctx.WithBlockTime(info.JailedUntil)
+	require.NoError(t, app.SlashingKeeper.Unjail(ctx, valAddrs[0]))
+	tstaking.CheckValidator(valAddrs[0], -1, false)
+
+	// a second tombstone is final
+	app.SlashingKeeper.Jail(ctx, consAddr)
+	app.SlashingKeeper.Tombstone(ctx, consAddr)
+	require.ErrorIs(t, app.SlashingKeeper.AppealTombstone(ctx, valAddrs[0]), types.ErrTombstoneAlreadyAppealed)
+	require.True(t, app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+}
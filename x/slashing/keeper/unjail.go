@@ -37,6 +37,7 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
 	if err != nil {
 		return err
 	}
+	consAddr = k.sk.ValidatorIdentifier(ctx, consAddr)
 	// If the validator has a ValidatorSigningInfo object that signals that the
 	// validator was bonded and so we must check that the validator is not tombstoned
 	// and can be unjailed at the current block.
@@ -59,5 +60,6 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
 	}
 
 	k.sk.Unjail(ctx, consAddr)
+	k.closeJailRecords(ctx, consAddr)
 	return nil
 }
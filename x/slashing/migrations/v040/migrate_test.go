@@ -69,6 +69,7 @@ func TestMigrate(t *testing.T) {
 	// cosmosvalcons10e4c5p6qk0sycy9u6u43t7csmlx9fyadr9yxph
 	// (in alphabetic order, basically).
 	expected := `{
+  "jail_records": [],
   "missed_blocks": [
     {
       "address": "cosmosvalcons104cjmxkrg8y8lmrp25de02e4zf00zle4mzs685",
@@ -98,7 +99,8 @@ func TestMigrate(t *testing.T) {
     "min_signed_per_window": "0.500000000000000000",
     "signed_blocks_window": "100",
     "slash_fraction_double_sign": "0.050000000000000000",
-    "slash_fraction_downtime": "0.010000000000000000"
+    "slash_fraction_downtime": "0.010000000000000000",
+    "tombstone_appeal_cooldown": "0s"
   },
   "signing_infos": [
     {
@@ -123,7 +125,8 @@ func TestMigrate(t *testing.T) {
         "tombstoned": false
       }
     }
-  ]
+  ],
+  "tombstone_appeals": []
 }`
 
 	bz, err := clientCtx.Codec.MarshalJSON(migrated)
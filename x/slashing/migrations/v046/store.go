@@ -0,0 +1,19 @@
+package v046
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Setting the TombstoneAppealCooldown param to its default value, unless it
+// was already set, e.g. by the upgrade handler before running the migrations.
+func MigrateStore(ctx sdk.Context, paramSpace types.ParamSubspace) error {
+	if !paramSpace.Has(ctx, types.KeyTombstoneAppealCooldown) {
+		paramSpace.Set(ctx, types.KeyTombstoneAppealCooldown, types.DefaultTombstoneAppealCooldown)
+	}
+
+	return nil
+}
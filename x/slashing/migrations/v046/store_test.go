@@ -0,0 +1,38 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	require.False(t, paramSpace.Has(ctx, types.KeyTombstoneAppealCooldown))
+
+	require.NoError(t, v046.MigrateStore(ctx, paramSpace))
+
+	var cooldown time.Duration
+	paramSpace.Get(ctx, types.KeyTombstoneAppealCooldown, &cooldown)
+	require.Equal(t, types.DefaultTombstoneAppealCooldown, cooldown)
+
+	// a value set before the migration is kept
+	paramSpace.Set(ctx, types.KeyTombstoneAppealCooldown, time.Hour)
+	require.NoError(t, v046.MigrateStore(ctx, paramSpace))
+	paramSpace.Get(ctx, types.KeyTombstoneAppealCooldown, &cooldown)
+	require.Equal(t, time.Hour, cooldown)
+}
@@ -39,6 +39,18 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			}
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA, pubKeyB)
 
+		case bytes.Equal(kvA.Key[:1], types.TombstoneAppealKeyPrefix):
+			var appealA, appealB types.TombstoneAppeal
+			cdc.MustUnmarshal(kvA.Value, &appealA)
+			cdc.MustUnmarshal(kvB.Value, &appealB)
+			return fmt.Sprintf("%v\n%v", appealA, appealB)
+
+		case bytes.Equal(kvA.Key[:1], types.JailRecordKeyPrefix):
+			var recordA, recordB types.JailRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
@@ -30,6 +30,8 @@ func TestDecodeStore(t *testing.T) {
 
 	info := types.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0)
 	missed := gogotypes.BoolValue{Value: true}
+	appeal := types.NewTombstoneAppeal(consAddr1, 10, time.Now().UTC())
+	record := types.NewJailRecord(consAddr1, types.JailReasonDowntime, 10, time.Now().UTC(), "")
 	bz, err := cdc.MarshalInterface(delPk1)
 	require.NoError(t, err)
 
@@ -38,6 +40,8 @@ func TestDecodeStore(t *testing.T) {
 			{Key: types.ValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshal(&info)},
 			{Key: types.ValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshal(&missed)},
 			{Key: types.AddrPubkeyRelationKey(delAddr1), Value: bz},
+			{Key: types.TombstoneAppealKey(consAddr1), Value: cdc.MustMarshal(&appeal)},
+			{Key: types.JailRecordKey(consAddr1, 0), Value: cdc.MustMarshal(&record)},
 			{Key: []byte{0x99}, Value: []byte{0x99}}, // This test should panic
 		},
 	}
@@ -50,6 +54,8 @@ func TestDecodeStore(t *testing.T) {
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info), false},
 		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v", missed.Value, missed.Value), false},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", delPk1, delPk1), false},
+		{"TombstoneAppeal", fmt.Sprintf("%v\n%v", appeal, appeal), false},
+		{"JailRecord", fmt.Sprintf("%v\n%v", record, record), false},
 		{"other", "", true},
 	}
 	for i, tt := range tests {
@@ -21,6 +21,7 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+	TombstoneAppealCooldown = "tombstone_appeal_cooldown"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -48,6 +49,11 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenTombstoneAppealCooldown randomized TombstoneAppealCooldown
+func GenTombstoneAppealCooldown(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60, 60*60*24*14)) * time.Second
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -80,12 +86,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var tombstoneAppealCooldown time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TombstoneAppealCooldown, &tombstoneAppealCooldown, simState.Rand,
+		func(r *rand.Rand) { tombstoneAppealCooldown = GenTombstoneAppealCooldown(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, tombstoneAppealCooldown,
 	)
 
-	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
+	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{}, []types.TombstoneAppeal{}, []types.JailRecord{})
 
 	bz, err := json.MarshalIndent(&slashingGenesis, "", " ")
 	if err != nil {
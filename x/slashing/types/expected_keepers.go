@@ -3,13 +3,15 @@
 package types
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// AccountKeeper expected account keeper
+// AccountKeeper expected account keeper. It is only read from, and the app
+// may provide the read-only view of the auth keeper.
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) auth.AccountI
 	IterateAccounts(ctx sdk.Context, process func(auth.AccountI) (stop bool))
@@ -27,7 +29,9 @@ type BankKeeper interface {
 type ParamSubspace interface {
 	HasKeyTable() bool
 	WithKeyTable(table paramtypes.KeyTable) paramtypes.Subspace
+	Has(ctx sdk.Context, key []byte) bool
 	Get(ctx sdk.Context, key []byte, ptr interface{})
+	Set(ctx sdk.Context, key []byte, value interface{})
 	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
 	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
 }
@@ -41,6 +45,11 @@ type StakingKeeper interface {
 	Validator(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI            // get a particular validator by operator address
 	ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI // get a particular validator by consensus address
 
+	// ValidatorIdentifier returns the initial consensus address of the
+	// validator with the given consensus address, before any rotation of its
+	// consensus public key.
+	ValidatorIdentifier(sdk.Context, sdk.ConsAddress) sdk.ConsAddress
+
 	// slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
 	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec) sdk.Int
 	Jail(sdk.Context, sdk.ConsAddress)   // jail a validator
@@ -60,4 +69,6 @@ type StakingHooks interface {
 	AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error // Must be called when a validator is deleted
 
 	AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error // Must be called when a validator is bonded
+
+	AfterConsensusPubKeyRotated(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey, valAddr sdk.ValAddress) error // Must be called when a validator's consensus public key is rotated
 }
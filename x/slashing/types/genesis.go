@@ -10,12 +10,15 @@ import (
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	tombstoneAppeals []TombstoneAppeal, jailRecords []JailRecord,
 ) *GenesisState {
 
 	return &GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:           params,
+		SigningInfos:     signingInfos,
+		MissedBlocks:     missedBlocks,
+		TombstoneAppeals: tombstoneAppeals,
+		JailRecords:      jailRecords,
 	}
 }
 
@@ -30,9 +33,11 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:           DefaultParams(),
+		SigningInfos:     []SigningInfo{},
+		MissedBlocks:     []ValidatorMissedBlocks{},
+		TombstoneAppeals: []TombstoneAppeal{},
+		JailRecords:      []JailRecord{},
 	}
 }
 
@@ -63,5 +68,29 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if cooldown := data.Params.TombstoneAppealCooldown; cooldown < 0 {
+		return fmt.Errorf("tombstone appeal cooldown cannot be negative, is %s", cooldown.String())
+	}
+
+	seenAppeals := make(map[string]bool, len(data.TombstoneAppeals))
+	for _, appeal := range data.TombstoneAppeals {
+		if _, err := sdk.ConsAddressFromBech32(appeal.Address); err != nil {
+			return fmt.Errorf("invalid tombstone appeal address %s: %w", appeal.Address, err)
+		}
+		if seenAppeals[appeal.Address] {
+			return fmt.Errorf("duplicate tombstone appeal for %s", appeal.Address)
+		}
+		seenAppeals[appeal.Address] = true
+	}
+
+	for _, record := range data.JailRecords {
+		if _, err := sdk.ConsAddressFromBech32(record.Address); err != nil {
+			return fmt.Errorf("invalid jail record address %s: %w", record.Address, err)
+		}
+		if record.UnjailHeight != 0 && record.UnjailHeight < record.Height {
+			return fmt.Errorf("jail record of %s unjailed at %d before being jailed at %d", record.Address, record.UnjailHeight, record.Height)
+		}
+	}
+
 	return nil
 }
@@ -30,10 +30,16 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes>: TombstoneAppeal
+//
+// - 0x05<consAddrLen (1 Byte)><consAddress_Bytes><index_Bytes>: JailRecord
 var (
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	TombstoneAppealKeyPrefix              = []byte{0x04} // Prefix for tombstone appeals
+	JailRecordKeyPrefix                   = []byte{0x05} // Prefix for jail records
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -67,3 +73,19 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// TombstoneAppealKey - stored by *Consensus* address (not operator address)
+func TombstoneAppealKey(v sdk.ConsAddress) []byte {
+	return append(TombstoneAppealKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// JailRecordPrefixKey - stored by *Consensus* address (not operator address)
+func JailRecordPrefixKey(v sdk.ConsAddress) []byte {
+	return append(JailRecordKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// JailRecordKey - stored by *Consensus* address (not operator address), then by
+// the index of the record
+func JailRecordKey(v sdk.ConsAddress, index uint64) []byte {
+	return append(JailRecordPrefixKey(v), sdk.Uint64ToBigEndian(index)...)
+}
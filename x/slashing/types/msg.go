@@ -7,11 +7,15 @@ import (
 
 // slashing message types
 const (
-	TypeMsgUnjail = "unjail"
+	TypeMsgUnjail          = "unjail"
+	TypeMsgAppealTombstone = "appeal_tombstone"
 )
 
 // verify interface at compile time
-var _ sdk.Msg = &MsgUnjail{}
+var (
+	_ sdk.Msg = &MsgUnjail{}
+	_ sdk.Msg = &MsgAppealTombstone{}
+)
 
 // NewMsgUnjail creates a new MsgUnjail instance
 //nolint:interfacer
@@ -41,3 +45,36 @@ func (msg MsgUnjail) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgAppealTombstone creates a new MsgAppealTombstone instance
+//nolint:interfacer
+func NewMsgAppealTombstone(authority string, validatorAddr sdk.ValAddress) *MsgAppealTombstone {
+	return &MsgAppealTombstone{
+		Authority:        authority,
+		ValidatorAddress: validatorAddr.String(),
+	}
+}
+
+func (msg MsgAppealTombstone) Route() string { return RouterKey }
+func (msg MsgAppealTombstone) Type() string  { return TypeMsgAppealTombstone }
+func (msg MsgAppealTombstone) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgAppealTombstone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgAppealTombstone) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("authority address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+	return nil
+}
@@ -18,3 +18,13 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgAppealTombstoneValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority")
+	valAddr := sdk.ValAddress("abcd")
+
+	require.NoError(t, NewMsgAppealTombstone(authority.String(), valAddr).ValidateBasic())
+	require.Error(t, NewMsgAppealTombstone("", valAddr).ValidateBasic())
+	require.Error(t, NewMsgAppealTombstone(authority.String(), sdk.ValAddress{}).ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{authority}, NewMsgAppealTombstone(authority.String(), valAddr).GetSigners())
+}
@@ -10,8 +10,9 @@ import (
 
 // Default parameter namespace
 const (
-	DefaultSignedBlocksWindow   = int64(100)
-	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultSignedBlocksWindow      = int64(100)
+	DefaultDowntimeJailDuration    = 60 * 10 * time.Second
+	DefaultTombstoneAppealCooldown = 60 * 60 * 24 * 7 * time.Second
 )
 
 var (
@@ -27,6 +28,7 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeyTombstoneAppealCooldown = []byte("TombstoneAppealCooldown")
 )
 
 // ParamKeyTable for slashing module
@@ -37,7 +39,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	slashFractionDoubleSign, slashFractionDowntime sdk.Dec, tombstoneAppealCooldown time.Duration,
 ) Params {
 
 	return Params{
@@ -46,6 +48,7 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+		TombstoneAppealCooldown: tombstoneAppealCooldown,
 	}
 }
 
@@ -57,6 +60,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyTombstoneAppealCooldown, &p.TombstoneAppealCooldown, validateTombstoneAppealCooldown),
 	}
 }
 
@@ -64,7 +68,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
-		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime, DefaultTombstoneAppealCooldown,
 	)
 }
 
@@ -141,3 +145,16 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateTombstoneAppealCooldown(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("tombstone appeal cooldown cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeTombstoneAppeal defines the type for a TombstoneAppealProposal
+	ProposalTypeTombstoneAppeal = "TombstoneAppeal"
+)
+
+// Assert TombstoneAppealProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &TombstoneAppealProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeTombstoneAppeal)
+	govtypes.RegisterProposalTypeCodec(&TombstoneAppealProposal{}, "cosmos-sdk/TombstoneAppealProposal")
+}
+
+// NewTombstoneAppealProposal creates a new tombstone appeal proposal.
+//nolint:interfacer
+func NewTombstoneAppealProposal(title, description string, validatorAddr sdk.ValAddress) *TombstoneAppealProposal {
+	return &TombstoneAppealProposal{title, description, validatorAddr.String()}
+}
+
+// GetTitle returns the title of a tombstone appeal proposal.
+func (p *TombstoneAppealProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a tombstone appeal proposal.
+func (p *TombstoneAppealProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a tombstone appeal proposal.
+func (p *TombstoneAppealProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a tombstone appeal proposal.
+func (p *TombstoneAppealProposal) ProposalType() string { return ProposalTypeTombstoneAppeal }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *TombstoneAppealProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if _, err := sdk.ValAddressFromBech32(p.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p TombstoneAppealProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Tombstone Appeal Proposal:
+  Title:       %s
+  Description: %s
+  Validator:   %s
+`, p.Title, p.Description, p.ValidatorAddress))
+	return b.String()
+}
@@ -43,3 +43,26 @@ func UnmarshalValSigningInfo(cdc codec.Codec, value []byte) (signingInfo Validat
 	err = cdc.Unmarshal(value, &signingInfo)
 	return signingInfo, err
 }
+
+// NewTombstoneAppeal creates a new TombstoneAppeal instance
+//nolint:interfacer
+func NewTombstoneAppeal(consAddr sdk.ConsAddress, height int64, appealTime time.Time) TombstoneAppeal {
+	return TombstoneAppeal{
+		Address: consAddr.String(),
+		Height:  height,
+		Time:    appealTime,
+	}
+}
+
+// NewJailRecord creates a new JailRecord instance of a validator jailed at the
+// given height and time
+//nolint:interfacer
+func NewJailRecord(consAddr sdk.ConsAddress, reason JailReason, height int64, jailTime time.Time, evidenceHash string) JailRecord {
+	return JailRecord{
+		Address:      consAddr.String(),
+		Reason:       reason,
+		Height:       height,
+		Time:         jailTime,
+		EvidenceHash: evidenceHash,
+	}
+}
@@ -30,6 +30,38 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// JailReason defines the reason a validator was jailed for.
+type JailReason int32
+
+const (
+	// UNSPECIFIED defines an unknown jail reason.
+	JailReasonUnspecified JailReason = 0
+	// DOWNTIME defines a validator jailed for missing too many blocks.
+	JailReasonDowntime JailReason = 1
+	// DOUBLE_SIGN defines a validator jailed and tombstoned for double-signing.
+	JailReasonDoubleSign JailReason = 2
+)
+
+var JailReason_name = map[int32]string{
+	0: "JAIL_REASON_UNSPECIFIED",
+	1: "JAIL_REASON_DOWNTIME",
+	2: "JAIL_REASON_DOUBLE_SIGN",
+}
+
+var JailReason_value = map[string]int32{
+	"JAIL_REASON_UNSPECIFIED": 0,
+	"JAIL_REASON_DOWNTIME":    1,
+	"JAIL_REASON_DOUBLE_SIGN": 2,
+}
+
+func (x JailReason) String() string {
+	return proto.EnumName(JailReason_name, int32(x))
+}
+
+func (JailReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -131,6 +163,9 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// tombstone_appeal_cooldown is the duration a validator remains jailed after
+	// its tombstone is lifted by a tombstone appeal.
+	TombstoneAppealCooldown time.Duration `protobuf:"bytes,6,opt,name=tombstone_appeal_cooldown,json=tombstoneAppealCooldown,proto3,stdduration" json:"tombstone_appeal_cooldown"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -180,9 +215,218 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetTombstoneAppealCooldown() time.Duration {
+	if m != nil {
+		return m.TombstoneAppealCooldown
+	}
+	return 0
+}
+
+// TombstoneAppeal records the lifting of the tombstone of a validator by
+// governance. A validator can only be granted a single tombstone appeal.
+type TombstoneAppeal struct {
+	// address is the consensus address of the validator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height is the block height at which the tombstone was lifted.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the tombstone was lifted.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *TombstoneAppeal) Reset()         { *m = TombstoneAppeal{} }
+func (m *TombstoneAppeal) String() string { return proto.CompactTextString(m) }
+func (*TombstoneAppeal) ProtoMessage()    {}
+func (*TombstoneAppeal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *TombstoneAppeal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TombstoneAppeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TombstoneAppeal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TombstoneAppeal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TombstoneAppeal.Merge(m, src)
+}
+func (m *TombstoneAppeal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TombstoneAppeal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TombstoneAppeal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TombstoneAppeal proto.InternalMessageInfo
+
+func (m *TombstoneAppeal) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TombstoneAppeal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TombstoneAppeal) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// TombstoneAppealProposal is a gov Content type to lift the tombstone of a
+// validator, e.g. after an accidental double-sign.
+type TombstoneAppealProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// validator_address is the operator address of the tombstoned validator.
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *TombstoneAppealProposal) Reset()      { *m = TombstoneAppealProposal{} }
+func (*TombstoneAppealProposal) ProtoMessage() {}
+func (*TombstoneAppealProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{3}
+}
+func (m *TombstoneAppealProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TombstoneAppealProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TombstoneAppealProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TombstoneAppealProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TombstoneAppealProposal.Merge(m, src)
+}
+func (m *TombstoneAppealProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TombstoneAppealProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TombstoneAppealProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TombstoneAppealProposal proto.InternalMessageInfo
+
+// JailRecord records a jailing of a validator by the slashing module.
+type JailRecord struct {
+	// address is the consensus address of the validator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// reason is the reason the validator was jailed for.
+	Reason JailReason `protobuf:"varint,2,opt,name=reason,proto3,enum=cosmos.slashing.v1beta1.JailReason" json:"reason,omitempty"`
+	// height is the block height at which the validator was jailed.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the validator was jailed.
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// evidence_hash is the hex-encoded hash of the evidence of a double-sign.
+	EvidenceHash string `protobuf:"bytes,5,opt,name=evidence_hash,json=evidenceHash,proto3" json:"evidence_hash,omitempty"`
+	// unjail_height is the block height at which the validator was unjailed, or
+	// zero if it is still jailed.
+	UnjailHeight int64 `protobuf:"varint,6,opt,name=unjail_height,json=unjailHeight,proto3" json:"unjail_height,omitempty"`
+}
+
+func (m *JailRecord) Reset()         { *m = JailRecord{} }
+func (m *JailRecord) String() string { return proto.CompactTextString(m) }
+func (*JailRecord) ProtoMessage()    {}
+func (*JailRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{4}
+}
+func (m *JailRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JailRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JailRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JailRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailRecord.Merge(m, src)
+}
+func (m *JailRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *JailRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailRecord proto.InternalMessageInfo
+
+func (m *JailRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *JailRecord) GetReason() JailReason {
+	if m != nil {
+		return m.Reason
+	}
+	return JailReasonUnspecified
+}
+
+func (m *JailRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *JailRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *JailRecord) GetEvidenceHash() string {
+	if m != nil {
+		return m.EvidenceHash
+	}
+	return ""
+}
+
+func (m *JailRecord) GetUnjailHeight() int64 {
+	if m != nil {
+		return m.UnjailHeight
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.slashing.v1beta1.JailReason", JailReason_name, JailReason_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*TombstoneAppeal)(nil), "cosmos.slashing.v1beta1.TombstoneAppeal")
+	proto.RegisterType((*TombstoneAppealProposal)(nil), "cosmos.slashing.v1beta1.TombstoneAppealProposal")
+	proto.RegisterType((*JailRecord)(nil), "cosmos.slashing.v1beta1.JailRecord")
 }
 
 func init() {
@@ -190,43 +434,64 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0x1b, 0x08, 0xe1, 0x92, 0xe9, 0x48, 0x89, 0x9b, 0xc1, 0x0e, 0x1d, 0xaa, 0x2c, 0xb1,
-	0x69, 0xd8, 0xd8, 0x08, 0x11, 0x7f, 0x07, 0x2a, 0x87, 0x3f, 0x82, 0xc5, 0x3a, 0xfb, 0xce, 0xce,
-	0x11, 0xfb, 0x2e, 0xf2, 0x9d, 0x49, 0xf9, 0x16, 0x1d, 0x3b, 0x76, 0xe4, 0x03, 0x20, 0xf1, 0x15,
-	0xba, 0x20, 0x55, 0x4c, 0x88, 0xa1, 0xa0, 0x64, 0xe1, 0x63, 0x20, 0xdf, 0xd9, 0x2d, 0x6a, 0xa5,
-	0x4a, 0x9d, 0x12, 0xbf, 0xf7, 0x7e, 0xef, 0xf9, 0xf7, 0xee, 0x0c, 0x76, 0x42, 0x2e, 0x52, 0x2e,
-	0x5c, 0x91, 0x20, 0x31, 0xa3, 0x2c, 0x76, 0x3f, 0xed, 0x06, 0x44, 0xa2, 0xdd, 0x33, 0xc0, 0x59,
-	0x64, 0x5c, 0x72, 0xd8, 0xd5, 0x3a, 0xe7, 0x0c, 0x2e, 0x75, 0xbd, 0x4e, 0xcc, 0x63, 0xae, 0x34,
-	0x6e, 0xf1, 0x4f, 0xcb, 0x7b, 0x56, 0xcc, 0x79, 0x9c, 0x10, 0x57, 0x3d, 0x05, 0x79, 0xe4, 0xe2,
-	0x3c, 0x43, 0x92, 0x72, 0x56, 0xf2, 0xf6, 0x45, 0x5e, 0xd2, 0x94, 0x08, 0x89, 0xd2, 0x45, 0x29,
-	0xd8, 0xd2, 0x79, 0xbe, 0x76, 0x2e, 0xc3, 0xd5, 0xc3, 0xf6, 0xb7, 0x0d, 0xd0, 0x79, 0x8b, 0x12,
-	0x8a, 0x91, 0xe4, 0xd9, 0x94, 0xc6, 0x8c, 0xb2, 0xf8, 0x39, 0x8b, 0x38, 0x1c, 0x81, 0x5b, 0x08,
-	0xe3, 0x8c, 0x08, 0x61, 0x1a, 0x7d, 0x63, 0x70, 0x7b, 0x6c, 0xfe, 0xf8, 0x3a, 0xec, 0x94, 0xb3,
-	0x8f, 0x34, 0x33, 0x95, 0x19, 0x65, 0xb1, 0x57, 0x09, 0xe1, 0x3d, 0xd0, 0x16, 0x12, 0x65, 0xd2,
-	0x9f, 0x11, 0x1a, 0xcf, 0xa4, 0xb9, 0xd1, 0x37, 0x06, 0x75, 0xaf, 0xa5, 0xb0, 0x67, 0x0a, 0x2a,
-	0x24, 0x94, 0x61, 0xb2, 0xef, 0xf3, 0x28, 0x12, 0x44, 0x9a, 0x75, 0x2d, 0x51, 0xd8, 0x2b, 0x05,
-	0xc1, 0xa7, 0xa0, 0xfd, 0x11, 0xd1, 0x84, 0x60, 0x3f, 0x67, 0x92, 0x26, 0xe6, 0x8d, 0xbe, 0x31,
-	0x68, 0x8d, 0x7a, 0x8e, 0xde, 0xd2, 0xa9, 0xb6, 0x74, 0x5e, 0x57, 0x5b, 0x8e, 0x9b, 0xc7, 0xa7,
-	0x76, 0xed, 0xe0, 0xb7, 0x6d, 0x78, 0x2d, 0x3d, 0xf9, 0xa6, 0x18, 0x84, 0x16, 0x00, 0x92, 0xa7,
-	0x81, 0x90, 0x9c, 0x11, 0x6c, 0xde, 0xec, 0x1b, 0x83, 0xa6, 0xf7, 0x1f, 0x02, 0x47, 0x60, 0x33,
-	0xa5, 0x42, 0x10, 0xec, 0x07, 0x09, 0x0f, 0xe7, 0xc2, 0x0f, 0x79, 0xce, 0x24, 0xc9, 0xcc, 0x86,
-	0x7a, 0xa9, 0x3b, 0x9a, 0x1c, 0x2b, 0xee, 0xb1, 0xa6, 0x1e, 0x36, 0x0f, 0x8f, 0xec, 0xda, 0xdf,
-	0x23, 0xdb, 0xd8, 0xfe, 0x5e, 0x07, 0x8d, 0x3d, 0x94, 0xa1, 0x54, 0xc0, 0xfb, 0xa0, 0x23, 0x68,
-	0xcc, 0xce, 0x8d, 0x96, 0x94, 0x61, 0xbe, 0x54, 0xc5, 0xd5, 0x3d, 0xa8, 0x39, 0xed, 0xf3, 0x4e,
-	0x31, 0x10, 0x15, 0xd1, 0xcc, 0x2f, 0xa7, 0x16, 0x24, 0xab, 0x46, 0x8a, 0xca, 0xda, 0x63, 0xa7,
-	0x58, 0xe8, 0xd7, 0xa9, 0xbd, 0x13, 0x53, 0x39, 0xcb, 0x03, 0x27, 0xe4, 0x69, 0x79, 0x6c, 0xe5,
-	0xcf, 0x50, 0xe0, 0xb9, 0x2b, 0x3f, 0x2f, 0x88, 0x70, 0x26, 0x24, 0xf4, 0x60, 0x4a, 0xd9, 0x54,
-	0x79, 0xed, 0x91, 0xac, 0x8c, 0x78, 0x0f, 0xee, 0x62, 0xbe, 0x64, 0xc5, 0x5d, 0xf0, 0x8b, 0x56,
-	0xfc, 0xea, 0xd6, 0xa8, 0xce, 0x5b, 0xa3, 0xad, 0x4b, 0x85, 0x4e, 0x4a, 0x81, 0xee, 0xf3, 0xb0,
-	0xe8, 0xb3, 0x53, 0x59, 0xbc, 0x40, 0x34, 0xa9, 0x78, 0x38, 0x07, 0x3d, 0x75, 0x75, 0xfd, 0x28,
-	0x43, 0x61, 0x81, 0xf8, 0x98, 0xe7, 0x41, 0x42, 0xd4, 0x3e, 0xea, 0xbc, 0xae, 0xbf, 0x42, 0x57,
-	0x39, 0x3e, 0x29, 0x0d, 0x27, 0xca, 0xaf, 0x58, 0x09, 0x46, 0xa0, 0x7b, 0x29, 0x4c, 0xbf, 0x93,
-	0x3a, 0xd2, 0xeb, 0x27, 0x6d, 0x5e, 0x48, 0xd2, 0x66, 0xe3, 0x97, 0x5f, 0x56, 0x96, 0x71, 0xbc,
-	0xb2, 0x8c, 0x93, 0x95, 0x65, 0xfc, 0x59, 0x59, 0xc6, 0xc1, 0xda, 0xaa, 0x9d, 0xac, 0xad, 0xda,
-	0xcf, 0xb5, 0x55, 0xfb, 0x30, 0xbc, 0xd2, 0x7c, 0xff, 0xfc, 0x93, 0x57, 0x39, 0x41, 0x43, 0x95,
-	0xfa, 0xe0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xbe, 0xf2, 0xba, 0x12, 0x04, 0x00, 0x00,
+	// 902 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x8e, 0x49, 0xc7, 0x06, 0xc2, 0xe0, 0xc4, 0x1b, 0x1f, 0xd6, 0xc6, 0x95, 0xaa,
+	0x08, 0x29, 0x6b, 0x6a, 0x04, 0x42, 0xe5, 0x14, 0xc7, 0x6e, 0xeb, 0x52, 0x92, 0x68, 0x9d, 0x50,
+	0xc1, 0x65, 0x34, 0xde, 0x1d, 0xaf, 0x87, 0xac, 0x67, 0xac, 0x9d, 0x71, 0x52, 0xfe, 0x83, 0x2a,
+	0xa7, 0xde, 0x28, 0x87, 0x48, 0x91, 0x90, 0x10, 0x27, 0x2e, 0x20, 0xf1, 0x2f, 0xf4, 0x58, 0x71,
+	0x42, 0x1c, 0x0a, 0x4a, 0x2e, 0xfc, 0x19, 0x68, 0x67, 0x66, 0x1d, 0x27, 0xe5, 0x57, 0x72, 0xb2,
+	0xe7, 0x7b, 0xdf, 0xfb, 0xde, 0xbc, 0xcf, 0xef, 0x8d, 0xc1, 0x2d, 0x9f, 0x8b, 0x31, 0x17, 0x4d,
+	0x11, 0x61, 0x31, 0xa2, 0x2c, 0x6c, 0x1e, 0xdc, 0x1e, 0x10, 0x89, 0x6f, 0xcf, 0x00, 0x77, 0x12,
+	0x73, 0xc9, 0x61, 0x45, 0xf3, 0xdc, 0x19, 0x6c, 0x78, 0xd5, 0x72, 0xc8, 0x43, 0xae, 0x38, 0xcd,
+	0xe4, 0x9b, 0xa6, 0x57, 0x9d, 0x90, 0xf3, 0x30, 0x22, 0x4d, 0x75, 0x1a, 0x4c, 0x87, 0xcd, 0x60,
+	0x1a, 0x63, 0x49, 0x39, 0x33, 0xf1, 0xda, 0xe5, 0xb8, 0xa4, 0x63, 0x22, 0x24, 0x1e, 0x4f, 0x0c,
+	0x61, 0x55, 0xd7, 0x43, 0x5a, 0xd9, 0x14, 0x57, 0x87, 0xc6, 0xcf, 0x59, 0x50, 0xfe, 0x0c, 0x47,
+	0x34, 0xc0, 0x92, 0xc7, 0x7d, 0x1a, 0x32, 0xca, 0xc2, 0x1e, 0x1b, 0x72, 0xd8, 0x02, 0xaf, 0xe1,
+	0x20, 0x88, 0x89, 0x10, 0xb6, 0x55, 0xb7, 0xd6, 0x6e, 0xb4, 0xed, 0x5f, 0x7e, 0x5a, 0x2f, 0x9b,
+	0xdc, 0x0d, 0x1d, 0xe9, 0xcb, 0x98, 0xb2, 0xd0, 0x4b, 0x89, 0xf0, 0x1d, 0x50, 0x12, 0x12, 0xc7,
+	0x12, 0x8d, 0x08, 0x0d, 0x47, 0xd2, 0xce, 0xd6, 0xad, 0xb5, 0x9c, 0x57, 0x54, 0xd8, 0x7d, 0x05,
+	0x25, 0x14, 0xca, 0x02, 0xf2, 0x18, 0xf1, 0xe1, 0x50, 0x10, 0x69, 0xe7, 0x34, 0x45, 0x61, 0xdb,
+	0x0a, 0x82, 0xf7, 0x40, 0xe9, 0x4b, 0x4c, 0x23, 0x12, 0xa0, 0x29, 0x93, 0x34, 0xb2, 0xf3, 0x75,
+	0x6b, 0xad, 0xd8, 0xaa, 0xba, 0xba, 0x4b, 0x37, 0xed, 0xd2, 0xdd, 0x4d, 0xbb, 0x6c, 0x2f, 0x3e,
+	0x7f, 0x59, 0xcb, 0x3c, 0xfd, 0xbd, 0x66, 0x79, 0x45, 0x9d, 0xb9, 0x97, 0x24, 0x42, 0x07, 0x00,
+	0xc9, 0xc7, 0x03, 0x21, 0x39, 0x23, 0x81, 0xbd, 0x50, 0xb7, 0xd6, 0x16, 0xbd, 0x39, 0x04, 0xb6,
+	0xc0, 0xf2, 0x98, 0x0a, 0x41, 0x02, 0x34, 0x88, 0xb8, 0xbf, 0x2f, 0x90, 0xcf, 0xa7, 0x4c, 0x92,
+	0xd8, 0x2e, 0xa8, 0x4b, 0xbd, 0xad, 0x83, 0x6d, 0x15, 0xdb, 0xd4, 0xa1, 0x3b, 0x8b, 0xcf, 0x4e,
+	0x6a, 0x99, 0x3f, 0x4f, 0x6a, 0x56, 0xe3, 0x87, 0x3c, 0x28, 0xec, 0xe0, 0x18, 0x8f, 0x05, 0x7c,
+	0x0f, 0x94, 0x05, 0x0d, 0xd9, 0xb9, 0xd0, 0x21, 0x65, 0x01, 0x3f, 0x54, 0xc6, 0xe5, 0x3c, 0xa8,
+	0x63, 0x5a, 0xe7, 0x91, 0x8a, 0x40, 0x9c, 0x94, 0x66, 0xc8, 0x64, 0x4d, 0x48, 0x9c, 0xa6, 0x24,
+	0x96, 0x95, 0xda, 0x6e, 0xd2, 0xd0, 0x6f, 0x2f, 0x6b, 0xb7, 0x42, 0x2a, 0x47, 0xd3, 0x81, 0xeb,
+	0xf3, 0xb1, 0xf9, 0xd9, 0xcc, 0xc7, 0xba, 0x08, 0xf6, 0x9b, 0xf2, 0xab, 0x09, 0x11, 0x6e, 0x87,
+	0xf8, 0x1e, 0x1c, 0x53, 0xd6, 0x57, 0x5a, 0x3b, 0x24, 0x36, 0x25, 0x3e, 0x07, 0x2b, 0x01, 0x3f,
+	0x64, 0xc9, 0x2c, 0xa0, 0xc4, 0x15, 0x94, 0x4e, 0x8d, 0xf2, 0xbc, 0xd8, 0x5a, 0x7d, 0xc5, 0xd0,
+	0x8e, 0x21, 0x68, 0x3f, 0x9f, 0x25, 0x7e, 0x96, 0x53, 0x89, 0x07, 0x98, 0x46, 0x69, 0x1c, 0xee,
+	0x83, 0xaa, 0x1a, 0x5d, 0x34, 0x8c, 0xb1, 0x9f, 0x20, 0x28, 0xe0, 0xd3, 0x41, 0x44, 0x54, 0x3f,
+	0x76, 0xfe, 0x5a, 0x2d, 0x54, 0x94, 0xe2, 0x5d, 0x23, 0xd8, 0x51, 0x7a, 0x49, 0x4b, 0x70, 0x08,
+	0x2a, 0xaf, 0x14, 0xd3, 0x77, 0xb2, 0x17, 0xae, 0x55, 0x69, 0xf9, 0x52, 0x25, 0x2d, 0x06, 0x11,
+	0x58, 0x9d, 0xcd, 0x06, 0xc2, 0x93, 0x09, 0xc1, 0x11, 0xf2, 0x39, 0x8f, 0x92, 0x6a, 0x76, 0xe1,
+	0xff, 0x5b, 0x56, 0x99, 0xa9, 0x6c, 0x28, 0x91, 0x4d, 0xa3, 0xd1, 0xf8, 0xda, 0x02, 0x6f, 0xee,
+	0x5e, 0x8c, 0x5d, 0x6b, 0xcb, 0x56, 0x40, 0xe1, 0xc2, 0x7e, 0x99, 0x13, 0xfc, 0x08, 0xe4, 0x95,
+	0x2b, 0xb9, 0x2b, 0xec, 0x8b, 0xca, 0x68, 0x7c, 0x67, 0x81, 0xca, 0xa5, 0x9b, 0xed, 0xc4, 0x7c,
+	0xc2, 0x05, 0x8e, 0x60, 0x19, 0x2c, 0x48, 0x2a, 0x23, 0xa2, 0xef, 0xe7, 0xe9, 0x03, 0xac, 0x83,
+	0x62, 0x40, 0x84, 0x1f, 0xd3, 0x89, 0x9a, 0xa8, 0xac, 0x8a, 0xcd, 0x43, 0xb0, 0x0b, 0xde, 0x3a,
+	0x48, 0xdf, 0x15, 0x94, 0xf6, 0x98, 0xfb, 0x8f, 0x1e, 0x97, 0x66, 0x29, 0x06, 0xbf, 0x53, 0x7a,
+	0x72, 0x52, 0xcb, 0x98, 0x9d, 0xcb, 0x34, 0xbe, 0xc9, 0x02, 0x90, 0x4c, 0xa2, 0x47, 0x7c, 0x1e,
+	0x07, 0xd7, 0x72, 0xef, 0x63, 0x50, 0x88, 0x09, 0x16, 0xe6, 0xd2, 0x6f, 0xb4, 0x6e, 0xba, 0xff,
+	0xf0, 0x18, 0xbb, 0xba, 0x50, 0x42, 0xf5, 0x4c, 0xca, 0x9c, 0xf5, 0xb9, 0xbf, 0xb5, 0x3e, 0x7f,
+	0x55, 0xeb, 0xe1, 0x4d, 0xf0, 0x3a, 0x39, 0xa0, 0x01, 0x61, 0x3e, 0x41, 0x23, 0x2c, 0x46, 0x6a,
+	0xa6, 0x6f, 0x78, 0xa5, 0x14, 0xbc, 0x8f, 0xc5, 0x28, 0x21, 0x4d, 0x99, 0xda, 0x61, 0x53, 0x5d,
+	0x3f, 0x50, 0x25, 0x0d, 0xea, 0x97, 0xf5, 0xdd, 0x1f, 0xad, 0xd4, 0x1b, 0x75, 0xd5, 0x0f, 0x41,
+	0xe5, 0xc1, 0x46, 0xef, 0x21, 0xf2, 0xba, 0x1b, 0xfd, 0xed, 0x2d, 0xb4, 0xb7, 0xd5, 0xdf, 0xe9,
+	0x6e, 0xf6, 0xee, 0xf6, 0xba, 0x9d, 0xa5, 0x4c, 0x75, 0xf5, 0xe8, 0xb8, 0xbe, 0x7c, 0x4e, 0xde,
+	0x63, 0x62, 0x42, 0x7c, 0x3a, 0xa4, 0x24, 0x48, 0xde, 0xb2, 0xf9, 0xbc, 0xce, 0xf6, 0xa3, 0xad,
+	0xdd, 0xde, 0xa7, 0xdd, 0x25, 0xab, 0xba, 0x72, 0x74, 0x5c, 0x87, 0xe7, 0x49, 0xb3, 0xc5, 0xf9,
+	0xe0, 0x62, 0xa5, 0xce, 0xf6, 0x5e, 0xfb, 0x61, 0x17, 0xf5, 0x7b, 0xf7, 0xb6, 0x96, 0xb2, 0x55,
+	0xfb, 0xe8, 0xb8, 0x5e, 0x9e, 0x4f, 0x4a, 0xf7, 0xba, 0x9a, 0x7f, 0xf2, 0xad, 0x93, 0x69, 0x7f,
+	0xf2, 0xfd, 0xa9, 0x63, 0x3d, 0x3f, 0x75, 0xac, 0x17, 0xa7, 0x8e, 0xf5, 0xc7, 0xa9, 0x63, 0x3d,
+	0x3d, 0x73, 0x32, 0x2f, 0xce, 0x9c, 0xcc, 0xaf, 0x67, 0x4e, 0xe6, 0x8b, 0xf5, 0x7f, 0x5d, 0xe9,
+	0xc7, 0xe7, 0x7f, 0xb4, 0x6a, 0xbb, 0x07, 0x05, 0x65, 0xf8, 0xfb, 0x7f, 0x0d, 0x00, 0x4e, 0xb0,
+	0xdb, 0xb8, 0x88, 0x07, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -302,6 +567,78 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.TombstoneAppealCooldown != that1.TombstoneAppealCooldown {
+		return false
+	}
+	return true
+}
+func (this *TombstoneAppeal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TombstoneAppeal)
+	if !ok {
+		that2, ok := that.(TombstoneAppeal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	return true
+}
+func (this *JailRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JailRecord)
+	if !ok {
+		that2, ok := that.(JailRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.EvidenceHash != that1.EvidenceHash {
+		return false
+	}
+	if this.UnjailHeight != that1.UnjailHeight {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -387,6 +724,14 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TombstoneAppealCooldown, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TombstoneAppealCooldown):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -407,12 +752,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -433,42 +778,189 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSlashing(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TombstoneAppeal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ValidatorSigningInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TombstoneAppeal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TombstoneAppeal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovSlashing(uint64(l))
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovSlashing(uint64(m.StartHeight))
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
 	}
-	if m.IndexOffset != 0 {
-		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil)
-	n += 1 + l + sovSlashing(uint64(l))
-	if m.Tombstoned {
-		n += 2
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.MissedBlocksCounter != 0 {
-		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	return len(dAtA) - i, nil
+}
+
+func (m *TombstoneAppealProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *TombstoneAppealProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TombstoneAppealProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JailRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JailRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JailRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnjailHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.UnjailHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EvidenceHash) > 0 {
+		i -= len(m.EvidenceHash)
+		copy(dAtA[i:], m.EvidenceHash)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.EvidenceHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSlashing(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Reason != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	}
+	return n
 }
 
 func (m *Params) Size() (n int) {
@@ -488,6 +980,75 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TombstoneAppealCooldown)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *TombstoneAppeal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *TombstoneAppealProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	return n
+}
+
+func (m *JailRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovSlashing(uint64(m.Reason))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = len(m.EvidenceHash)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.UnjailHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.UnjailHeight))
+	}
 	return n
 }
 
@@ -869,6 +1430,523 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TombstoneAppealCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TombstoneAppealCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TombstoneAppeal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TombstoneAppeal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TombstoneAppeal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TombstoneAppealProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TombstoneAppealProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TombstoneAppealProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JailRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JailRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JailRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= JailReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailHeight", wireType)
+			}
+			m.UnjailHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnjailHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -104,33 +104,121 @@ func (m *MsgUnjailResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnjailResponse proto.InternalMessageInfo
 
+// MsgAppealTombstone lifts the tombstone of a validator. The validator remains
+// jailed for the tombstone appeal cooldown. It can only be executed by the
+// authority of the module.
+type MsgAppealTombstone struct {
+	// authority is the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// validator_address is the operator address of the tombstoned validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *MsgAppealTombstone) Reset()         { *m = MsgAppealTombstone{} }
+func (m *MsgAppealTombstone) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealTombstone) ProtoMessage()    {}
+func (*MsgAppealTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{2}
+}
+func (m *MsgAppealTombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealTombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAppealTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealTombstone.Merge(m, src)
+}
+func (m *MsgAppealTombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealTombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAppealTombstone proto.InternalMessageInfo
+
+// MsgAppealTombstoneResponse defines the Msg/AppealTombstone response type.
+type MsgAppealTombstoneResponse struct {
+}
+
+func (m *MsgAppealTombstoneResponse) Reset()         { *m = MsgAppealTombstoneResponse{} }
+func (m *MsgAppealTombstoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAppealTombstoneResponse) ProtoMessage()    {}
+func (*MsgAppealTombstoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{3}
+}
+func (m *MsgAppealTombstoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppealTombstoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppealTombstoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAppealTombstoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppealTombstoneResponse.Merge(m, src)
+}
+func (m *MsgAppealTombstoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppealTombstoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppealTombstoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAppealTombstoneResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgUnjailResponse")
+	proto.RegisterType((*MsgAppealTombstone)(nil), "cosmos.slashing.v1beta1.MsgAppealTombstone")
+	proto.RegisterType((*MsgAppealTombstoneResponse)(nil), "cosmos.slashing.v1beta1.MsgAppealTombstoneResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
 
 var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0x24, 0x21, 0xca, 0xe3, 0x21, 0x12, 0x50, 0xbd, 0x60, 0x8e, 0x52, 0x22,
-	0x17, 0xa7, 0x6f, 0x71, 0x7a, 0x68, 0x5e, 0x56, 0x62, 0x66, 0x8e, 0x90, 0x17, 0x17, 0x5f, 0x59,
-	0x62, 0x4e, 0x66, 0x4a, 0x62, 0x49, 0x7e, 0x51, 0x7c, 0x62, 0x4a, 0x4a, 0x91, 0x04, 0xa3, 0x02,
-	0xa3, 0x06, 0xa7, 0x93, 0xf2, 0xab, 0x7b, 0xf2, 0xec, 0x20, 0x7e, 0x6a, 0x71, 0xf1, 0xa5, 0x2d,
-	0xba, 0x22, 0x50, 0x13, 0x1c, 0x21, 0x22, 0xc1, 0x25, 0x45, 0x99, 0x79, 0xe9, 0x41, 0xbc, 0x70,
-	0xad, 0x20, 0x71, 0x2b, 0x8e, 0x8e, 0x05, 0xf2, 0x0c, 0x33, 0x16, 0xc8, 0x33, 0x2a, 0x09, 0x73,
-	0x09, 0xc2, 0xad, 0x08, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x35, 0x8a, 0xe7, 0x62, 0xf6,
-	0x2d, 0x4e, 0x17, 0x8a, 0xe0, 0x62, 0x83, 0xda, 0xad, 0xa4, 0x87, 0xc3, 0x4f, 0x7a, 0x70, 0xcd,
-	0x52, 0x5a, 0x84, 0xd5, 0xc0, 0x2c, 0x70, 0xf2, 0x5e, 0xf1, 0x48, 0x8e, 0xf1, 0xc4, 0x23, 0x39,
-	0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63,
-	0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x74, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92,
-	0xf3, 0x73, 0xa1, 0xe1, 0x01, 0xa5, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x2b, 0x10, 0x41, 0x5f, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x2c, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9f,
-	0x32, 0xcd, 0x3e, 0x9a, 0x01, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x33, 0xf7, 0x42, 0xef, 0xed, 0xc0, 0xbd, 0xda, 0x58, 0xb0, 0x06, 0x49, 0x4a, 0xdc,
+	0x88, 0xd2, 0x84, 0x5a, 0x70, 0xe1, 0xae, 0x05, 0x37, 0x4a, 0x37, 0x55, 0x41, 0xdc, 0x94, 0x49,
+	0x33, 0x4c, 0xa3, 0x69, 0x26, 0xe4, 0x4c, 0x4b, 0xfb, 0x06, 0x2e, 0x5d, 0xba, 0x11, 0xba, 0xf4,
+	0x01, 0x7c, 0x08, 0x97, 0x45, 0x5c, 0xb8, 0x12, 0x49, 0x37, 0xe2, 0x53, 0x48, 0x9b, 0xa4, 0xa5,
+	0x95, 0x5a, 0x5c, 0x25, 0xe7, 0xe4, 0xfb, 0xf3, 0xff, 0x73, 0xe6, 0xe0, 0x7c, 0x83, 0x43, 0x8b,
+	0x83, 0x09, 0x2e, 0x81, 0xa6, 0xe3, 0x31, 0xb3, 0x53, 0xb4, 0xa8, 0x20, 0x45, 0x53, 0x74, 0x0d,
+	0x3f, 0xe0, 0x82, 0xcb, 0xeb, 0x11, 0x61, 0x24, 0x84, 0x11, 0x13, 0x4a, 0x96, 0x71, 0xc6, 0xc7,
+	0x8c, 0x39, 0x7a, 0x8b, 0x70, 0x65, 0x23, 0xc2, 0xeb, 0xd1, 0x87, 0x58, 0x3b, 0x2e, 0x74, 0x82,
+	0xd3, 0x55, 0x60, 0x67, 0xde, 0x25, 0x71, 0x5c, 0xf9, 0x08, 0xff, 0xef, 0x10, 0xd7, 0xb1, 0x89,
+	0xe0, 0x41, 0x9d, 0xd8, 0x76, 0x90, 0x43, 0x79, 0xb4, 0x9d, 0xae, 0x6c, 0x7d, 0xbc, 0x6a, 0x7f,
+	0x46, 0x35, 0x05, 0x78, 0x7a, 0x28, 0x64, 0xe3, 0x3f, 0x94, 0xa3, 0xce, 0x89, 0x08, 0x1c, 0x8f,
+	0xd5, 0xfe, 0x4d, 0xa4, 0xa3, 0xfe, 0xc1, 0xdf, 0xeb, 0xbe, 0x26, 0xdd, 0xf6, 0x35, 0xa4, 0xaf,
+	0xe1, 0xcc, 0xc4, 0xa2, 0x46, 0xc1, 0xe7, 0x1e, 0x50, 0xfd, 0x0e, 0x61, 0xb9, 0x0a, 0xac, 0xec,
+	0xfb, 0x94, 0xb8, 0xa7, 0xbc, 0x65, 0x81, 0xe0, 0x1e, 0x95, 0xf7, 0x71, 0x9a, 0xb4, 0x45, 0x93,
+	0x07, 0x8e, 0xe8, 0xc5, 0xe6, 0xb9, 0x85, 0x8e, 0x53, 0x54, 0x3e, 0xc4, 0x99, 0xd9, 0xe4, 0x14,
+	0x20, 0xf7, 0x6b, 0x89, 0x7e, 0x75, 0x26, 0x31, 0x05, 0x88, 0x42, 0xbf, 0xf7, 0x35, 0x49, 0xdf,
+	0xc4, 0xca, 0xd7, 0x78, 0x49, 0xfa, 0xbd, 0x67, 0x84, 0x7f, 0x57, 0x81, 0xc9, 0xe7, 0x38, 0x15,
+	0x8f, 0x4e, 0x37, 0x16, 0x5c, 0x89, 0x31, 0x39, 0xbb, 0xb2, 0xb3, 0x9c, 0x49, 0x1c, 0x64, 0xc0,
+	0x2b, 0xf3, 0xb3, 0xd9, 0xfd, 0x4e, 0x3e, 0x07, 0x2b, 0xa5, 0x1f, 0xc0, 0x89, 0x69, 0xe5, 0xf8,
+	0x3e, 0x54, 0xd1, 0x63, 0xa8, 0xa2, 0x41, 0xa8, 0xa2, 0xb7, 0x50, 0x45, 0x37, 0x43, 0x55, 0x1a,
+	0x0c, 0x55, 0xe9, 0x65, 0xa8, 0x4a, 0x17, 0x05, 0xe6, 0x88, 0x66, 0xdb, 0x32, 0x1a, 0xbc, 0x15,
+	0xef, 0x50, 0xfc, 0x28, 0x80, 0x7d, 0x65, 0x76, 0xa7, 0xeb, 0x2a, 0x7a, 0x3e, 0x05, 0x2b, 0x35,
+	0x5e, 0xb0, 0xd2, 0xe7, 0x00, 0x81, 0x22, 0xdf, 0xbf, 0xce, 0x02, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -178,6 +266,27 @@ func (this *MsgUnjailResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgAppealTombstoneResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgAppealTombstoneResponse)
+	if !ok {
+		that2, ok := that.(MsgAppealTombstoneResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -195,6 +304,9 @@ type MsgClient interface {
 	// them into the bonded validator set, so they can begin receiving provisions
 	// and rewards again.
 	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
+	// AppealTombstone defines a governance operation for lifting the tombstone
+	// of a validator.
+	AppealTombstone(ctx context.Context, in *MsgAppealTombstone, opts ...grpc.CallOption) (*MsgAppealTombstoneResponse, error)
 }
 
 type msgClient struct {
@@ -214,12 +326,24 @@ func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) AppealTombstone(ctx context.Context, in *MsgAppealTombstone, opts ...grpc.CallOption) (*MsgAppealTombstoneResponse, error) {
+	out := new(MsgAppealTombstoneResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/AppealTombstone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
 	// them into the bonded validator set, so they can begin receiving provisions
 	// and rewards again.
 	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
+	// AppealTombstone defines a governance operation for lifting the tombstone
+	// of a validator.
+	AppealTombstone(context.Context, *MsgAppealTombstone) (*MsgAppealTombstoneResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -229,6 +353,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*MsgUnjailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
 }
+func (*UnimplementedMsgServer) AppealTombstone(ctx context.Context, req *MsgAppealTombstone) (*MsgAppealTombstoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealTombstone not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -252,6 +379,24 @@ func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AppealTombstone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppealTombstone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppealTombstone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/AppealTombstone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppealTombstone(ctx, req.(*MsgAppealTombstone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -260,6 +405,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Unjail",
 			Handler:    _Msg_Unjail_Handler,
 		},
+		{
+			MethodName: "AppealTombstone",
+			Handler:    _Msg_AppealTombstone_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -318,6 +467,66 @@ func (m *MsgUnjailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAppealTombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealTombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealTombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppealTombstoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppealTombstoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppealTombstoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -351,6 +560,32 @@ func (m *MsgUnjailResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAppealTombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAppealTombstoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -489,6 +724,170 @@ func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAppealTombstone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppealTombstone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppealTombstone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAppealTombstoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppealTombstoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppealTombstoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19,9 +19,14 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	k.TrackHistoricalInfo(ctx)
 }
 
-// Called every block, update validator set
+// Called every block, prune the historical entries older than the
+// HistoricalRetentionTime parameter, jail the validators below their minimum
+// self-delegation and update validator set
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	k.PruneHistoricalInfoByTime(ctx)
+	k.EnforceMinSelfDelegation(ctx)
+
 	return k.BlockValidatorUpdates(ctx)
 }
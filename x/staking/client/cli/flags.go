@@ -27,6 +27,8 @@ const (
 
 	FlagMinSelfDelegation = "min-self-delegation"
 
+	FlagVerifyTimeout = "verify-timeout"
+
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
@@ -1,11 +1,15 @@
 package cli
 
 import (
+	"bytes"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -33,17 +37,184 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryRedelegations(),
 		GetCmdQueryValidator(),
 		GetCmdQueryValidators(),
+		GetCmdQueryValidatorMetadataVerification(),
+		GetCmdVerifyValidatorMetadata(),
+		GetCmdQueryValidatorConsPubKeyRotations(),
 		GetCmdQueryValidatorDelegations(),
 		GetCmdQueryValidatorUnbondingDelegations(),
 		GetCmdQueryValidatorRedelegations(),
 		GetCmdQueryHistoricalInfo(),
+		GetCmdQueryHistoricalInfoByTime(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryTokenizeShareRecordByID(),
+		GetCmdQueryTokenizeShareRecordsOwned(),
+		GetCmdQueryTotalLiquidStaked(),
 	)
 
 	return stakingQueryCmd
 }
 
+// GetCmdQueryValidatorMetadataVerification implements the command to query the
+// attested verification of the metadata of a validator.
+func GetCmdQueryValidatorMetadataVerification() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "metadata-verification [validator-addr]",
+		Short: "Query the attested verification of the metadata of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the verification of the website and security contact of a validator, as attested by the validator operator.
+
+Example:
+$ %s query staking metadata-verification %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorMetadataVerification(cmd.Context(), &types.QueryValidatorMetadataVerificationRequest{ValidatorAddr: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Verification)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorConsPubKeyRotations implements the command to query the
+// rotations of the consensus public key of a validator.
+func GetCmdQueryValidatorConsPubKeyRotations() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "cons-pubkey-rotations [validator-addr]",
+		Short: "Query the rotations of the consensus public key of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rotations of the consensus public key of a validator, ordered by height.
+
+Example:
+$ %s query staking cons-pubkey-rotations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorConsPubKeyRotations(cmd.Context(), &types.QueryValidatorConsPubKeyRotationsRequest{
+				ValidatorAddr: addr.String(),
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "cons pubkey rotations")
+
+	return cmd
+}
+
+// GetCmdVerifyValidatorMetadata implements the command to verify off-chain the
+// metadata of a validator.
+func GetCmdVerifyValidatorMetadata() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "verify-metadata [validator-addr]",
+		Short: "Verify the website and security contact of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Verify off-chain the website and security contact of a validator, and compare
+the results with the verification attested on-chain by the validator operator.
+
+The website is verified if its domain has a "%s<validator-addr>" DNS TXT record,
+or if its %s file lists the validator address. The security contact is
+verified if it is a Contact of the %s file of the website.
+
+Example:
+$ %s query staking verify-metadata %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				ValidatorTXTRecordPrefix, ValidatorWellKnownPath, SecurityTxtPath, version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valRes, err := queryClient.Validator(cmd.Context(), &types.QueryValidatorRequest{ValidatorAddr: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			timeout, _ := cmd.Flags().GetDuration(FlagVerifyTimeout)
+			description := valRes.Validator.Description
+			res := NewMetadataVerifier(timeout).Verify(cmd.Context(), addr, description)
+
+			verificationRes, err := queryClient.ValidatorMetadataVerification(cmd.Context(), &types.QueryValidatorMetadataVerificationRequest{ValidatorAddr: addr.String()})
+			if err != nil && status.Code(err) != codes.NotFound {
+				return err
+			}
+			if err == nil {
+				res.Attested = &verificationRes.Verification
+				res.AttestedWebsiteMatches = bytes.Equal(res.Attested.WebsiteHash, types.HashWebsite(description.Website))
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	cmd.Flags().Duration(FlagVerifyTimeout, defaultVerifyTimeout, "Timeout of the requests to the website")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryValidator implements the validator query command.
 func GetCmdQueryValidator() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
@@ -673,6 +844,50 @@ $ %s query staking historical-info 5
 	return cmd
 }
 
+// GetCmdQueryHistoricalInfoByTime implements the command to query the
+// historical info closest to a given time
+func GetCmdQueryHistoricalInfoByTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-info-by-time [time]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the historical info closest to a given time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the height and the historical info whose header time is the closest to a
+given RFC3339 time.
+
+Example:
+$ %s query staking historical-info-by-time 2021-10-01T12:00:00Z
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			t, err := time.Parse(time.RFC3339, args[0])
+			if err != nil {
+				return fmt.Errorf("time argument provided must be a RFC3339 time: %w", err)
+			}
+
+			params := &types.QueryHistoricalInfoByTimeRequest{Time: t}
+			res, err := queryClient.HistoricalInfoByTime(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryPool implements the pool query command.
 func GetCmdQueryPool() *cobra.Command {
 	cmd := &cobra.Command{
@@ -744,3 +959,126 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryTokenizeShareRecordByID implements the query command of a
+// tokenize share record by id.
+func GetCmdQueryTokenizeShareRecordByID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record-by-id [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a tokenize share record by id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a tokenize share record by id.
+
+Example:
+$ %s query staking tokenize-share-record-by-id 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id argument provided must be a non-negative-integer: %v", err)
+			}
+
+			res, err := queryClient.TokenizeShareRecordById(cmd.Context(), &types.QueryTokenizeShareRecordByIdRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordsOwned implements the query command of the
+// tokenize share records owned by an address.
+func GetCmdQueryTokenizeShareRecordsOwned() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-records-owned [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tokenize share records owned by an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokenize share records owned by an address.
+
+Example:
+$ %s query staking tokenize-share-records-owned %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecordsOwned(cmd.Context(), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: owner.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTotalLiquidStaked implements the query command of the total
+// amount of tokens tokenized into liquid staking shares.
+func GetCmdQueryTotalLiquidStaked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-liquid-staked",
+		Args:  cobra.NoArgs,
+		Short: "Query the total amount of tokens tokenized into liquid staking shares",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total amount of tokens tokenized into liquid staking shares.
+
+Example:
+$ %s query staking total-liquid-staked
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidStaked(cmd.Context(), &types.QueryTotalLiquidStakedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
@@ -26,6 +27,7 @@ var (
 	defaultCommissionMaxRate       = "0.2"
 	defaultCommissionMaxChangeRate = "0.01"
 	defaultMinSelfDelegation       = "1"
+	defaultVerifyTimeout           = 10 * time.Second
 )
 
 // NewTxCmd returns a root CLI command handler for all x/staking transaction commands.
@@ -41,9 +43,13 @@ func NewTxCmd() *cobra.Command {
 	stakingTxCmd.AddCommand(
 		NewCreateValidatorCmd(),
 		NewEditValidatorCmd(),
+		NewAttestMetadataCmd(),
 		NewDelegateCmd(),
 		NewRedelegateCmd(),
 		NewUnbondCmd(),
+		NewTokenizeSharesCmd(),
+		NewRedeemTokensCmd(),
+		NewRotateConsPubKeyCmd(),
 	)
 
 	return stakingTxCmd
@@ -143,6 +149,57 @@ func NewEditValidatorCmd() *cobra.Command {
 	return cmd
 }
 
+// NewAttestMetadataCmd returns a CLI command handler for verifying off-chain the
+// metadata of the validator of the sender and attesting the results on-chain.
+func NewAttestMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-metadata",
+		Short: "Verify the website and security contact of your validator and attest the results",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Verify off-chain the website and security contact of your validator, as done by
+the "query staking verify-metadata" command, and attest the results on-chain.
+
+Example:
+$ %s tx staking attest-metadata --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+			queryClient := types.NewQueryClient(clientCtx)
+			valRes, err := queryClient.Validator(cmd.Context(), &types.QueryValidatorRequest{ValidatorAddr: valAddr.String()})
+			if err != nil {
+				return err
+			}
+
+			timeout, _ := cmd.Flags().GetDuration(FlagVerifyTimeout)
+			res := NewMetadataVerifier(timeout).Verify(cmd.Context(), valAddr, valRes.Validator.Description)
+			if res.WebsiteError != "" {
+				cmd.PrintErrf("website not verified: %s\n", res.WebsiteError)
+			}
+			if res.SecurityContactError != "" {
+				cmd.PrintErrf("security contact not verified: %s\n", res.SecurityContactError)
+			}
+
+			msg := types.NewMsgSetMetadataVerification(valAddr, res.WebsiteVerified, res.SecurityContactVerified)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(FlagVerifyTimeout, defaultVerifyTimeout, "Timeout of the requests to the website")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewDelegateCmd() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
 
@@ -277,6 +334,139 @@ $ %s tx staking unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake --from
 	return cmd
 }
 
+func NewTokenizeSharesCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share [validator-addr] [amount] [reward-owner]",
+		Short: "Tokenize a delegation into liquid staking shares",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Tokenize an amount of a delegation to a validator into transferable liquid
+staking shares, minted to the delegator. The rewards of the tokenized delegation
+are withdrawn by the reward owner.
+
+Example:
+$ %s tx staking tokenize-share %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9 --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenizeShares(delAddr, valAddr, amount, owner)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRedeemTokensCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "redeem-tokens [amount]",
+		Short: "Redeem liquid staking shares into a delegation",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redeem an amount of liquid staking shares into a delegation to the validator of
+the shares.
+
+Example:
+$ %s tx staking redeem-tokens 100%s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1 --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemTokensForShares(delAddr, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRotateConsPubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-cons-pubkey [pubkey]",
+		Short: "Rotate the consensus public key of your validator without unbonding it",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Rotate the consensus public key of your validator to the given Protobuf JSON
+encoded public key, without unbonding it. The rotation fee of the staking params
+is paid by the operator, and a validator can rotate its key only once per
+unbonding period.
+
+Example:
+$ %s tx staking rotate-cons-pubkey '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"oWg2ISpLF405Jcm2vXV+2v4fnjodh6aafuIdeoW+rUw="}' --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgRotateConsPubKey(sdk.ValAddress(clientCtx.GetFromAddress()), pk)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, *types.MsgCreateValidator, error) {
 	fAmount, _ := fs.GetString(FlagAmount)
 	amount, err := sdk.ParseCoinNormalized(fAmount)
@@ -0,0 +1,206 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	// ValidatorTXTRecordPrefix is the prefix of the DNS TXT record of a
+	// website domain proving it is controlled by a validator operator, followed
+	// by the validator operator address.
+	ValidatorTXTRecordPrefix = "cosmos-validator="
+	// ValidatorWellKnownPath is the path of the file of a website listing the
+	// addresses of the validator operators controlling it, one per line.
+	ValidatorWellKnownPath = "/.well-known/cosmos-validator.txt"
+	// SecurityTxtPath is the path of the RFC 9116 security.txt file of a
+	// website, which lists the security contact of a validator.
+	SecurityTxtPath = "/.well-known/security.txt"
+
+	maxWellKnownSize = 64 * 1024
+)
+
+// MetadataVerifier verifies off-chain the metadata of a validator's
+// description:
+//
+// - the website is verified if its domain has a "cosmos-validator=<valoper>"
+// DNS TXT record, or if its /.well-known/cosmos-validator.txt file lists the
+// validator operator address.
+// - the security contact is verified if it is listed as a Contact of the
+// /.well-known/security.txt file of the website.
+type MetadataVerifier struct {
+	// LookupTXT returns the DNS TXT records of a domain name.
+	LookupTXT func(ctx context.Context, name string) ([]string, error)
+	// Client fetches the well-known files of the website.
+	Client *http.Client
+}
+
+// NewMetadataVerifier returns a MetadataVerifier using the default DNS resolver
+// and an HTTP client with the given timeout.
+func NewMetadataVerifier(timeout time.Duration) MetadataVerifier {
+	return MetadataVerifier{
+		LookupTXT: net.DefaultResolver.LookupTXT,
+		Client:    &http.Client{Timeout: timeout},
+	}
+}
+
+// MetadataVerificationResult is the result of the off-chain verification of
+// the metadata of a validator's description.
+type MetadataVerificationResult struct {
+	Validator               string `json:"validator" yaml:"validator"`
+	WebsiteVerified         bool   `json:"website_verified" yaml:"website_verified"`
+	WebsiteError            string `json:"website_error,omitempty" yaml:"website_error,omitempty"`
+	SecurityContactVerified bool   `json:"security_contact_verified" yaml:"security_contact_verified"`
+	SecurityContactError    string `json:"security_contact_error,omitempty" yaml:"security_contact_error,omitempty"`
+
+	// Attested is the verification attested on-chain by the validator
+	// operator, if any.
+	Attested *types.MetadataVerification `json:"attested,omitempty" yaml:"attested,omitempty"`
+	// AttestedWebsiteMatches is true if the attested verification is of the
+	// current website of the validator.
+	AttestedWebsiteMatches bool `json:"attested_website_matches,omitempty" yaml:"attested_website_matches,omitempty"`
+}
+
+// Verify verifies the website and the security contact of the description of
+// the given validator.
+func (v MetadataVerifier) Verify(ctx context.Context, valAddr sdk.ValAddress, description types.Description) MetadataVerificationResult {
+	res := MetadataVerificationResult{Validator: valAddr.String()}
+
+	if err := v.VerifyWebsite(ctx, valAddr, description.Website); err != nil {
+		res.WebsiteError = err.Error()
+	} else {
+		res.WebsiteVerified = true
+	}
+
+	if err := v.VerifySecurityContact(ctx, description.Website, description.SecurityContact); err != nil {
+		res.SecurityContactError = err.Error()
+	} else {
+		res.SecurityContactVerified = true
+	}
+
+	return res
+}
+
+// VerifyWebsite verifies that the given website is controlled by the given
+// validator operator.
+func (v MetadataVerifier) VerifyWebsite(ctx context.Context, valAddr sdk.ValAddress, website string) error {
+	base, err := parseWebsite(website)
+	if err != nil {
+		return err
+	}
+
+	records, err := v.LookupTXT(ctx, base.Hostname())
+	if err == nil {
+		for _, record := range records {
+			if strings.TrimSpace(record) == ValidatorTXTRecordPrefix+valAddr.String() {
+				return nil
+			}
+		}
+	}
+
+	lines, err := v.fetchLines(ctx, base, ValidatorWellKnownPath)
+	if err != nil {
+		return fmt.Errorf("no %s DNS TXT record and %s", ValidatorTXTRecordPrefix+valAddr.String(), err)
+	}
+
+	for _, line := range lines {
+		if line == valAddr.String() {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("validator %s is neither in a DNS TXT record nor in %s", valAddr, ValidatorWellKnownPath)
+}
+
+// VerifySecurityContact verifies that the given security contact is listed in
+// the security.txt file of the given website.
+func (v MetadataVerifier) VerifySecurityContact(ctx context.Context, website, contact string) error {
+	if contact == "" {
+		return fmt.Errorf("empty security contact")
+	}
+
+	base, err := parseWebsite(website)
+	if err != nil {
+		return err
+	}
+
+	lines, err := v.fetchLines(ctx, base, SecurityTxtPath)
+	if err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		field := strings.SplitN(line, ":", 2)
+		if len(field) != 2 || !strings.EqualFold(strings.TrimSpace(field[0]), "Contact") {
+			continue
+		}
+
+		value := strings.TrimSpace(field[1])
+		if strings.EqualFold(value, contact) || strings.EqualFold(value, "mailto:"+contact) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("security contact %s is not listed in %s", contact, SecurityTxtPath)
+}
+
+// fetchLines fetches a file of the website and returns its trimmed lines.
+func (v MetadataVerifier) fetchLines(ctx context.Context, base *url.URL, path string) ([]string, error) {
+	u := *base
+	u.Path = path
+	u.RawQuery, u.Fragment = "", ""
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := v.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", u.String(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: %s", u.String(), resp.Status)
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(io.LimitReader(resp.Body, maxWellKnownSize))
+	for scanner.Scan() {
+		lines = append(lines, strings.TrimSpace(scanner.Text()))
+	}
+
+	return lines, scanner.Err()
+}
+
+// parseWebsite parses the website of a validator's description, which
+// defaults to the https scheme.
+func parseWebsite(website string) (*url.URL, error) {
+	if website == "" {
+		return nil, fmt.Errorf("empty website")
+	}
+	if !strings.Contains(website, "://") {
+		website = "https://" + website
+	}
+
+	u, err := url.Parse(website)
+	if err != nil {
+		return nil, fmt.Errorf("invalid website %s: %w", website, err)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid website %s: no host", website)
+	}
+
+	return u, nil
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMetadataVerifier(t *testing.T) {
+	valAddr := sdk.ValAddress("validator")
+	otherAddr := sdk.ValAddress("other")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(ValidatorWellKnownPath, func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(w, "%s\n", valAddr)
+	})
+	mux.HandleFunc(SecurityTxtPath, func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, "# security.txt\nContact: mailto:security@example.com\nExpires: 2030-01-01T00:00:00.000Z\n")
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	verifier := MetadataVerifier{
+		LookupTXT: func(_ context.Context, name string) ([]string, error) {
+			if name == "dns.example.com" {
+				return []string{"v=spf1 -all", ValidatorTXTRecordPrefix + otherAddr.String()}, nil
+			}
+			return nil, errors.New("no such host")
+		},
+		Client: srv.Client(),
+	}
+	ctx := context.Background()
+
+	// the website is verified with the well-known file
+	res := verifier.Verify(ctx, valAddr, types.Description{Website: srv.URL, SecurityContact: "security@example.com"})
+	require.True(t, res.WebsiteVerified, res.WebsiteError)
+	require.True(t, res.SecurityContactVerified, res.SecurityContactError)
+
+	res = verifier.Verify(ctx, otherAddr, types.Description{Website: srv.URL + "/about", SecurityContact: "admin@example.com"})
+	require.False(t, res.WebsiteVerified)
+	require.NotEmpty(t, res.WebsiteError)
+	require.False(t, res.SecurityContactVerified)
+	require.NotEmpty(t, res.SecurityContactError)
+
+	// the website is verified with the DNS TXT record
+	require.NoError(t, verifier.VerifyWebsite(ctx, otherAddr, "dns.example.com"))
+	require.Error(t, verifier.VerifyWebsite(ctx, otherAddr, ""))
+
+	require.Error(t, verifier.VerifySecurityContact(ctx, srv.URL, ""))
+}
@@ -882,15 +882,22 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 			"with text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`bond_denom: stake
+global_liquid_staking_cap: "1.000000000000000000"
 historical_entries: 10000
+historical_retention_time: 0s
+key_rotation_fee: []
 max_entries: 7
+max_maturities_per_block: 0
 max_validators: 100
-unbonding_time: 1814400s`,
+min_commission_rate: "0.000000000000000000"
+min_self_delegation_grace_period: 0s
+unbonding_time: 1814400s
+validator_liquid_staking_cap: "1.000000000000000000"`,
 		},
 		{
 			"with json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake"}`,
+			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake","min_commission_rate":"0.000000000000000000","historical_retention_time":"0s","global_liquid_staking_cap":"1.000000000000000000","validator_liquid_staking_cap":"1.000000000000000000","min_self_delegation_grace_period":"0s","key_rotation_fee":[],"max_maturities_per_block":0}`,
 		},
 	}
 	for _, tc := range testCases {
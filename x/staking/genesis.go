@@ -63,6 +63,10 @@ func InitGenesis(
 		}
 	}
 
+	if err := keeper.InitConsPubKeyRotationHistory(ctx, data.ConsPubKeyRotationHistory); err != nil {
+		panic(err)
+	}
+
 	for _, delegation := range data.Delegations {
 		delegatorAddress, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
 		if err != nil {
@@ -96,13 +100,49 @@ func InitGenesis(
 	}
 
 	for _, red := range data.Redelegations {
+		red.AggregateEntries()
 		keeper.SetRedelegation(ctx, red)
 
-		for _, entry := range red.Entries {
+		for i, entry := range red.Entries {
+			// the entries are ordered by completion time, the redelegation is
+			// queued once per completion time
+			if i > 0 && red.Entries[i-1].CompletionTime.Equal(entry.CompletionTime) {
+				continue
+			}
 			keeper.InsertRedelegationQueue(ctx, red, entry.CompletionTime)
 		}
 	}
 
+	for _, mv := range data.MetadataVerifications {
+		valAddr, err := sdk.ValAddressFromBech32(mv.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetMetadataVerification(ctx, valAddr, mv.Verification)
+	}
+
+	// the liquid shares of the validators are rebuilt from the delegations of
+	// the tokenize share records
+	for _, record := range data.TokenizeShareRecords {
+		keeper.SetTokenizeShareRecord(ctx, record)
+
+		valAddr := record.GetValidatorAddress()
+		delegation, found := keeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+		if !found {
+			continue
+		}
+
+		if _, found := keeper.GetValidator(ctx, valAddr); !found {
+			panic(fmt.Sprintf("validator %s of tokenize share record %d not found", record.Validator, record.Id))
+		}
+
+		keeper.SetValidatorLiquidShares(ctx, valAddr, keeper.GetValidatorLiquidShares(ctx, valAddr).Add(delegation.Shares))
+	}
+	if data.LastTokenizeShareRecordId > 0 {
+		keeper.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -195,15 +235,33 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	var metadataVerifications []types.ValidatorMetadataVerification
+
+	keeper.IterateMetadataVerifications(ctx, func(valAddr sdk.ValAddress, verification types.MetadataVerification) (stop bool) {
+		metadataVerifications = append(metadataVerifications, types.NewValidatorMetadataVerification(valAddr, verification))
+		return false
+	})
+
+	var consPubKeyRotationHistory []types.ConsPubKeyRotationHistory
+
+	keeper.IterateConsPubKeyRotationHistory(ctx, func(history types.ConsPubKeyRotationHistory) (stop bool) {
+		consPubKeyRotationHistory = append(consPubKeyRotationHistory, history)
+		return false
+	})
+
 	return &types.GenesisState{
-		Params:               keeper.GetParams(ctx),
-		LastTotalPower:       keeper.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           keeper.GetAllValidators(ctx),
-		Delegations:          keeper.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    keeper.GetParams(ctx),
+		LastTotalPower:            keeper.GetLastTotalPower(ctx),
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                keeper.GetAllValidators(ctx),
+		Delegations:               keeper.GetAllDelegations(ctx),
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		MetadataVerifications:     metadataVerifications,
+		TokenizeShareRecords:      keeper.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: keeper.GetLastTokenizeShareRecordID(ctx),
+		ConsPubKeyRotationHistory: consPubKeyRotationHistory,
 	}
 }
 
@@ -239,6 +297,18 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateMetadataVerifications(data.MetadataVerifications, data.Validators); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateConsPubKeyRotationHistory(data.ConsPubKeyRotationHistory, data.Validators); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -279,3 +349,67 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStateMetadataVerifications(verifications []types.ValidatorMetadataVerification, validators []types.Validator) error {
+	valAddrs := make(map[string]bool, len(validators))
+	for _, val := range validators {
+		valAddrs[val.OperatorAddress] = true
+	}
+
+	seen := make(map[string]bool, len(verifications))
+	for _, mv := range verifications {
+		if _, err := sdk.ValAddressFromBech32(mv.ValidatorAddress); err != nil {
+			return err
+		}
+		if !valAddrs[mv.ValidatorAddress] {
+			return fmt.Errorf("metadata verification of unknown validator %s", mv.ValidatorAddress)
+		}
+		if seen[mv.ValidatorAddress] {
+			return fmt.Errorf("duplicate metadata verification of validator %s", mv.ValidatorAddress)
+		}
+		seen[mv.ValidatorAddress] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	seen := make(map[uint64]bool, len(records))
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if record.Id > lastID {
+			return fmt.Errorf("tokenize share record id %d is greater than the last id %d", record.Id, lastID)
+		}
+		if seen[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record %d", record.Id)
+		}
+		seen[record.Id] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateConsPubKeyRotationHistory(history []types.ConsPubKeyRotationHistory, validators []types.Validator) error {
+	valAddrs := make(map[string]bool, len(validators))
+	for _, val := range validators {
+		valAddrs[val.OperatorAddress] = true
+	}
+
+	lastHeights := make(map[string]int64, len(history))
+	for _, h := range history {
+		if err := h.Validate(); err != nil {
+			return err
+		}
+		if !valAddrs[h.OperatorAddress] {
+			return fmt.Errorf("consensus public key rotation of unknown validator %s", h.OperatorAddress)
+		}
+		if last, ok := lastHeights[h.OperatorAddress]; ok && h.Height <= last {
+			return fmt.Errorf("consensus public key rotations of validator %s not ordered by height", h.OperatorAddress)
+		}
+		lastHeights[h.OperatorAddress] = h.Height
+	}
+
+	return nil
+}
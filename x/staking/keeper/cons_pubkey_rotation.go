@@ -0,0 +1,286 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RotateValidatorConsPubKey rotates the consensus public key of a validator without
+// unbonding it. The rotation fee is paid by the operator and burned. The
+// previous consensus addresses of the validator remain indexed, so that the
+// validator is still found by the evidence and slashing of infractions
+// committed before the rotation. A validator can rotate its key only once per
+// unbonding period.
+func (k Keeper) RotateValidatorConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey cryptotypes.PubKey) error {
+	valAddr := validator.GetOperator()
+
+	newConsAddr := sdk.GetConsAddress(newPubKey)
+	if _, found := k.GetValidatorByConsAddr(ctx, newConsAddr); found {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	if last, found := k.GetLastConsPubKeyRotation(ctx, valAddr); found {
+		if next := last.Time.Add(k.UnbondingTime(ctx)); ctx.BlockHeader().Time.Before(next) {
+			return sdkerrors.Wrapf(types.ErrConsPubKeyRotationLimit, "next rotation allowed at %s", next)
+		}
+	}
+
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	oldConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	fee := k.KeyRotationFee(ctx)
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.NotBondedPoolName, fee); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, fee); err != nil {
+			return err
+		}
+	}
+
+	history, err := types.NewConsPubKeyRotationHistory(valAddr, oldPubKey, newPubKey, ctx.BlockHeight(), ctx.BlockHeader().Time, fee)
+	if err != nil {
+		return err
+	}
+	k.SetConsPubKeyRotationHistory(ctx, history)
+
+	// Tendermint only knows the key the validator had at the beginning of the
+	// block, which is the one removed from the validator set
+	if _, found := k.GetPendingConsPubKeyRotation(ctx, valAddr); !found {
+		k.SetPendingConsPubKeyRotation(ctx, history)
+	}
+
+	k.SetInitialConsAddr(ctx, newConsAddr, k.ValidatorIdentifier(ctx, oldConsAddr))
+
+	validator.ConsensusPubkey = history.NewConsPubkey
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+
+	if err := k.AfterConsensusPubKeyRotated(ctx, oldPubKey, newPubKey, valAddr); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotateConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyNewConsAddress, newConsAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+
+	return nil
+}
+
+// ValidatorIdentifier returns the initial consensus address of the validator
+// with the given consensus address, i.e. the address it had before rotating
+// its consensus public key, which identifies the validator in the slashing
+// and evidence records. The given address is returned if it was never rotated.
+func (k Keeper) ValidatorIdentifier(ctx sdk.Context, consAddr sdk.ConsAddress) sdk.ConsAddress {
+	store := ctx.KVStore(k.storeKey)
+
+	initial := store.Get(types.GetInitialConsAddrKey(consAddr))
+	if initial == nil {
+		return consAddr
+	}
+
+	return initial
+}
+
+// SetInitialConsAddr sets the initial consensus address of the validator
+// rotated to the given consensus address
+func (k Keeper) SetInitialConsAddr(ctx sdk.Context, consAddr, initial sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetInitialConsAddrKey(consAddr), initial)
+}
+
+// GetConsPubKeyRotationHistory gets the rotation of the consensus public key
+// of a validator at the given height
+func (k Keeper) GetConsPubKeyRotationHistory(ctx sdk.Context, valAddr sdk.ValAddress, height int64) (types.ConsPubKeyRotationHistory, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetConsPubKeyRotationHistoryKey(valAddr, height))
+	if value == nil {
+		return types.ConsPubKeyRotationHistory{}, false
+	}
+
+	return types.MustUnmarshalConsPubKeyRotationHistory(k.cdc, value), true
+}
+
+// SetConsPubKeyRotationHistory sets the rotation of the consensus public key
+// of a validator
+func (k Keeper) SetConsPubKeyRotationHistory(ctx sdk.Context, history types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	value := types.MustMarshalConsPubKeyRotationHistory(k.cdc, &history)
+	store.Set(types.GetConsPubKeyRotationHistoryKey(history.GetOperator(), history.Height), value)
+}
+
+// GetLastConsPubKeyRotation gets the last rotation of the consensus public key
+// of a validator
+func (k Keeper) GetLastConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) (types.ConsPubKeyRotationHistory, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetConsPubKeyRotationHistoryPrefix(valAddr))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.ConsPubKeyRotationHistory{}, false
+	}
+
+	return types.MustUnmarshalConsPubKeyRotationHistory(k.cdc, iterator.Value()), true
+}
+
+// GetValidatorConsPubKeyRotations gets the rotations of the consensus public
+// key of a validator, ordered by height
+func (k Keeper) GetValidatorConsPubKeyRotations(ctx sdk.Context, valAddr sdk.ValAddress) (rotations []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetConsPubKeyRotationHistoryPrefix(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		rotations = append(rotations, types.MustUnmarshalConsPubKeyRotationHistory(k.cdc, iterator.Value()))
+	}
+
+	return rotations
+}
+
+// IterateConsPubKeyRotationHistory iterates over the rotations of the
+// consensus public keys of the validators, ordered by validator and height. If
+// the cb returns true, the iterator will close and stop.
+func (k Keeper) IterateConsPubKeyRotationHistory(ctx sdk.Context, cb func(history types.ConsPubKeyRotationHistory) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationHistoryKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.MustUnmarshalConsPubKeyRotationHistory(k.cdc, iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetPendingConsPubKeyRotation gets the rotation of the consensus public key
+// of a validator in the current block
+func (k Keeper) GetPendingConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) (types.ConsPubKeyRotationHistory, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetPendingConsPubKeyRotationKey(valAddr))
+	if value == nil {
+		return types.ConsPubKeyRotationHistory{}, false
+	}
+
+	return types.MustUnmarshalConsPubKeyRotationHistory(k.cdc, value), true
+}
+
+// SetPendingConsPubKeyRotation sets the rotation of the consensus public key
+// of a validator in the current block
+func (k Keeper) SetPendingConsPubKeyRotation(ctx sdk.Context, history types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	value := types.MustMarshalConsPubKeyRotationHistory(k.cdc, &history)
+	store.Set(types.GetPendingConsPubKeyRotationKey(history.GetOperator()), value)
+}
+
+// getPendingConsPubKeyRotations returns the consensus public keys of the
+// validators before their rotations of the current block, by operator
+// address, and deletes the pending rotations.
+func (k Keeper) getPendingConsPubKeyRotations(ctx sdk.Context) (map[string]cryptotypes.PubKey, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	var keys [][]byte
+	oldPubKeys := make(map[string]cryptotypes.PubKey)
+	for ; iterator.Valid(); iterator.Next() {
+		history := types.MustUnmarshalConsPubKeyRotationHistory(k.cdc, iterator.Value())
+		oldPubKey, err := history.OldConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		oldPubKeys[history.OperatorAddress] = oldPubKey
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return oldPubKeys, nil
+}
+
+// deleteConsPubKeyRotations deletes the rotations of the consensus public key
+// of a removed validator, along with the indexes of its previous consensus
+// addresses.
+func (k Keeper) deleteConsPubKeyRotations(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, history := range k.GetValidatorConsPubKeyRotations(ctx, valAddr) {
+		oldPubKey, err := history.OldConsPubKey()
+		if err != nil {
+			panic(err)
+		}
+		newPubKey, err := history.NewConsPubKey()
+		if err != nil {
+			panic(err)
+		}
+
+		store.Delete(types.GetValidatorByConsAddrKey(sdk.GetConsAddress(oldPubKey)))
+		store.Delete(types.GetInitialConsAddrKey(sdk.GetConsAddress(newPubKey)))
+		store.Delete(types.GetConsPubKeyRotationHistoryKey(valAddr, history.Height))
+	}
+
+	store.Delete(types.GetPendingConsPubKeyRotationKey(valAddr))
+}
+
+// InitConsPubKeyRotationHistory sets the rotations of the consensus public keys
+// of the validators from genesis, along with the indexes of their previous
+// consensus addresses. The rotations of each validator must be ordered by
+// height.
+func (k Keeper) InitConsPubKeyRotationHistory(ctx sdk.Context, history []types.ConsPubKeyRotationHistory) error {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, h := range history {
+		oldPubKey, err := h.OldConsPubKey()
+		if err != nil {
+			return err
+		}
+		newPubKey, err := h.NewConsPubKey()
+		if err != nil {
+			return err
+		}
+
+		oldConsAddr := sdk.GetConsAddress(oldPubKey)
+		store.Set(types.GetValidatorByConsAddrKey(oldConsAddr), h.GetOperator())
+		k.SetInitialConsAddr(ctx, sdk.GetConsAddress(newPubKey), k.ValidatorIdentifier(ctx, oldConsAddr))
+		k.SetConsPubKeyRotationHistory(ctx, h)
+	}
+
+	return nil
+}
+
+// abciValidatorUpdateZero returns the validator update removing the given
+// consensus public key from the Tendermint validator set.
+func abciValidatorUpdateZero(pubKey cryptotypes.PubKey) (abci.ValidatorUpdate, error) {
+	tmPk, err := cryptocodec.ToTmProtoPublicKey(pubKey)
+	if err != nil {
+		return abci.ValidatorUpdate{}, err
+	}
+
+	return abci.ValidatorUpdate{PubKey: tmPk, Power: 0}, nil
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func zeroPowerUpdate(t *testing.T, pk cryptotypes.PubKey) abci.ValidatorUpdate {
+	tmPk, err := cryptocodec.ToTmProtoPublicKey(pk)
+	require.NoError(t, err)
+	return abci.ValidatorUpdate{PubKey: tmPk, Power: 0}
+}
+
+func TestRotateConsPubKey(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	params := app.StakingKeeper.GetParams(ctx)
+	params.KeyRotationFee = fee
+	app.StakingKeeper.SetParams(ctx, params)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], PKs[0], 10, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], PKs[1], 10, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	rotate := func(valAddr sdk.ValAddress, pk cryptotypes.PubKey) error {
+		msg, err := types.NewMsgRotateConsPubKey(valAddr, pk)
+		require.NoError(t, err)
+		_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
+
+	// a validator can't rotate to the key of another validator
+	require.ErrorIs(t, rotate(valAddrs[0], PKs[1]), types.ErrValidatorPubKeyExists)
+
+	// the rotation fee is burned
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+	require.NoError(t, rotate(valAddrs[0], PKs[2]))
+	require.Equal(t, supply.Sub(fee[0]), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+
+	oldConsAddr, newConsAddr := sdk.GetConsAddress(PKs[0]), sdk.GetConsAddress(PKs[2])
+	validator := tstaking.CheckValidator(valAddrs[0], types.Bonded, false)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	require.Equal(t, newConsAddr, consAddr)
+
+	// the validator is found by both its old and new consensus addresses,
+	// and identified by its initial one
+	for _, addr := range []sdk.ConsAddress{oldConsAddr, newConsAddr} {
+		found, ok := app.StakingKeeper.GetValidatorByConsAddr(ctx, addr)
+		require.True(t, ok)
+		require.Equal(t, valAddrs[0], found.GetOperator())
+		require.Equal(t, oldConsAddr, app.StakingKeeper.ValidatorIdentifier(ctx, addr))
+	}
+
+	res, err := keeper.Querier{Keeper: app.StakingKeeper}.ValidatorConsPubKeyRotations(
+		sdk.WrapSDKContext(ctx), &types.QueryValidatorConsPubKeyRotationsRequest{ValidatorAddr: valAddrs[0].String()},
+	)
+	require.NoError(t, err)
+	require.Len(t, res.Rotations, 1)
+	require.Equal(t, ctx.BlockHeight(), res.Rotations[0].Height)
+	require.Equal(t, fee, res.Rotations[0].Fee)
+
+	// the key can be rotated only once per unbonding period
+	require.ErrorIs(t, rotate(valAddrs[0], PKs[3]), types.ErrConsPubKeyRotationLimit)
+
+	// the second validator rotates its key and is jailed in the same block
+	require.NoError(t, rotate(valAddrs[1], PKs[4]))
+	app.StakingKeeper.Jail(ctx, sdk.GetConsAddress(PKs[4]))
+
+	// Tendermint replaces the key of the first validator, and removes the
+	// key it knows of the second one
+	updates := staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Len(t, updates, 3)
+	require.Contains(t, updates, validator.ABCIValidatorUpdate(app.StakingKeeper.PowerReduction(ctx)))
+	require.Contains(t, updates, zeroPowerUpdate(t, PKs[0]))
+	require.Contains(t, updates, zeroPowerUpdate(t, PKs[1]))
+	require.Empty(t, staking.EndBlocker(ctx, app.StakingKeeper))
+
+	// the key can be rotated again after the unbonding period
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockHeader().Time.Add(params.UnbondingTime))
+	require.NoError(t, rotate(valAddrs[0], PKs[3]))
+	require.Equal(t, oldConsAddr, app.StakingKeeper.ValidatorIdentifier(ctx, sdk.GetConsAddress(PKs[3])))
+	require.Len(t, app.StakingKeeper.GetValidatorConsPubKeyRotations(ctx, valAddrs[0]), 2)
+}
@@ -253,71 +253,137 @@ func (k Keeper) SetUnbondingDelegationEntry(
 // unbonding delegation queue timeslice operations
 
 // gets a specific unbonding queue timeslice. A timeslice is a slice of DVPairs
-// corresponding to unbonding delegations that expire at a certain time.
+// corresponding to unbonding delegations that expire at a certain time, stored
+// in shards of at most QueueShardSize pairs.
 func (k Keeper) GetUBDQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (dvPairs []types.DVPair) {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(types.GetUnbondingDelegationTimeKey(timestamp))
-	if bz == nil {
-		return []types.DVPair{}
-	}
+	iterator := sdk.KVStorePrefixIterator(store, types.GetUnbondingDelegationTimeKey(timestamp))
+	defer iterator.Close()
 
-	pairs := types.DVPairs{}
-	k.cdc.MustUnmarshal(bz, &pairs)
+	dvPairs = []types.DVPair{}
+	for ; iterator.Valid(); iterator.Next() {
+		pairs := types.DVPairs{}
+		k.cdc.MustUnmarshal(iterator.Value(), &pairs)
+		dvPairs = append(dvPairs, pairs.Pairs...)
+	}
 
-	return pairs.Pairs
+	return dvPairs
 }
 
-// Sets a specific unbonding queue timeslice.
+// Sets a specific unbonding queue timeslice, replacing its shards.
 func (k Keeper) SetUBDQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []types.DVPair) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&types.DVPairs{Pairs: keys})
-	store.Set(types.GetUnbondingDelegationTimeKey(timestamp), bz)
+	deleteQueueShards(store, types.GetUnbondingDelegationTimeKey(timestamp))
+
+	for shard := 0; shard*types.QueueShardSize < len(keys); shard++ {
+		end := (shard + 1) * types.QueueShardSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		bz := k.cdc.MustMarshal(&types.DVPairs{Pairs: keys[shard*types.QueueShardSize : end]})
+		store.Set(types.GetUnbondingDelegationQueueShardKey(timestamp, uint32(shard)), bz)
+	}
 }
 
-// Insert an unbonding delegation to the appropriate timeslice in the unbonding queue
+// Insert an unbonding delegation to the last shard of the appropriate timeslice
+// in the unbonding queue, or to a new shard if the last one is full
 func (k Keeper) InsertUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
 	completionTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
 	dvPair := types.DVPair{DelegatorAddress: ubd.DelegatorAddress, ValidatorAddress: ubd.ValidatorAddress}
 
-	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
-	if len(timeSlice) == 0 {
-		k.SetUBDQueueTimeSlice(ctx, completionTime, []types.DVPair{dvPair})
-	} else {
-		timeSlice = append(timeSlice, dvPair)
-		k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+	shard, bz := lastQueueShard(store, types.GetUnbondingDelegationTimeKey(completionTime))
+	pairs := types.DVPairs{}
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &pairs)
+		if len(pairs.Pairs) >= types.QueueShardSize {
+			shard, pairs = shard+1, types.DVPairs{}
+		}
 	}
+
+	pairs.Pairs = append(pairs.Pairs, dvPair)
+	store.Set(types.GetUnbondingDelegationQueueShardKey(completionTime, shard), k.cdc.MustMarshal(&pairs))
 }
 
-// Returns all the unbonding queue timeslices from time 0 until endTime
+// Returns all the unbonding queue shards from time 0 until endTime
 func (k Keeper) UBDQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
 	return store.Iterator(types.UnbondingQueueKey,
-		sdk.InclusiveEndBytes(types.GetUnbondingDelegationTimeKey(endTime)))
+		sdk.PrefixEndBytes(types.GetUnbondingDelegationTimeKey(endTime)))
 }
 
 // Returns a concatenated list of all the timeslices inclusively previous to
 // currTime, and deletes the timeslices from the queue
 func (k Keeper) DequeueAllMatureUBDQueue(ctx sdk.Context, currTime time.Time) (matureUnbonds []types.DVPair) {
+	return k.DequeueMatureUBDQueue(ctx, currTime, 0)
+}
+
+// DequeueMatureUBDQueue returns, in order, at most limit pairs of the
+// timeslices inclusively previous to currTime, and deletes them from the
+// queue. The remaining pairs are dequeued by the next calls. A limit of 0
+// dequeues all the mature pairs.
+func (k Keeper) DequeueMatureUBDQueue(ctx sdk.Context, currTime time.Time, limit uint32) (matureUnbonds []types.DVPair) {
 	store := ctx.KVStore(k.storeKey)
 
-	// gets an iterator for all timeslices from time 0 until the current Blockheader time
-	unbondingTimesliceIterator := k.UBDQueueIterator(ctx, ctx.BlockHeader().Time)
-	defer unbondingTimesliceIterator.Close()
+	// gets an iterator for all shards from time 0 until currTime
+	unbondingShardIterator := k.UBDQueueIterator(ctx, currTime)
+	defer unbondingShardIterator.Close()
 
-	for ; unbondingTimesliceIterator.Valid(); unbondingTimesliceIterator.Next() {
-		timeslice := types.DVPairs{}
-		value := unbondingTimesliceIterator.Value()
-		k.cdc.MustUnmarshal(value, &timeslice)
+	for ; unbondingShardIterator.Valid(); unbondingShardIterator.Next() {
+		shard := types.DVPairs{}
+		k.cdc.MustUnmarshal(unbondingShardIterator.Value(), &shard)
 
-		matureUnbonds = append(matureUnbonds, timeslice.Pairs...)
+		if limit > 0 && len(matureUnbonds)+len(shard.Pairs) > int(limit) {
+			n := int(limit) - len(matureUnbonds)
+			matureUnbonds = append(matureUnbonds, shard.Pairs[:n]...)
 
-		store.Delete(unbondingTimesliceIterator.Key())
+			shard.Pairs = shard.Pairs[n:]
+			store.Set(unbondingShardIterator.Key(), k.cdc.MustMarshal(&shard))
+			break
+		}
+
+		matureUnbonds = append(matureUnbonds, shard.Pairs...)
+		store.Delete(unbondingShardIterator.Key())
+
+		if limit > 0 && len(matureUnbonds) == int(limit) {
+			break
+		}
 	}
 
 	return matureUnbonds
 }
 
+// lastQueueShard returns the index and value of the last shard of the queue
+// timeslice with the given prefix, or a nil value if it has no shards.
+func lastQueueShard(store sdk.KVStore, timeKey []byte) (uint32, []byte) {
+	iterator := sdk.KVStoreReversePrefixIterator(store, timeKey)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, nil
+	}
+
+	return types.QueueShardFromKey(iterator.Key()), iterator.Value()
+}
+
+// deleteQueueShards deletes the shards of the queue timeslice with the given
+// prefix.
+func deleteQueueShards(store sdk.KVStore, timeKey []byte) {
+	iterator := sdk.KVStorePrefixIterator(store, timeKey)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // return a given amount of all the delegator redelegations
 func (k Keeper) GetRedelegations(ctx sdk.Context, delegator sdk.AccAddress,
 	maxRetrieve uint16) (redelegations []types.Redelegation) {
@@ -479,68 +545,104 @@ func (k Keeper) RemoveRedelegation(ctx sdk.Context, red types.Redelegation) {
 // redelegation queue timeslice operations
 
 // Gets a specific redelegation queue timeslice. A timeslice is a slice of DVVTriplets corresponding to redelegations
-// that expire at a certain time.
+// that expire at a certain time, stored in shards of at most QueueShardSize triplets.
 func (k Keeper) GetRedelegationQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (dvvTriplets []types.DVVTriplet) {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(types.GetRedelegationTimeKey(timestamp))
-	if bz == nil {
-		return []types.DVVTriplet{}
-	}
+	iterator := sdk.KVStorePrefixIterator(store, types.GetRedelegationTimeKey(timestamp))
+	defer iterator.Close()
 
-	triplets := types.DVVTriplets{}
-	k.cdc.MustUnmarshal(bz, &triplets)
+	dvvTriplets = []types.DVVTriplet{}
+	for ; iterator.Valid(); iterator.Next() {
+		triplets := types.DVVTriplets{}
+		k.cdc.MustUnmarshal(iterator.Value(), &triplets)
+		dvvTriplets = append(dvvTriplets, triplets.Triplets...)
+	}
 
-	return triplets.Triplets
+	return dvvTriplets
 }
 
-// Sets a specific redelegation queue timeslice.
+// Sets a specific redelegation queue timeslice, replacing its shards.
 func (k Keeper) SetRedelegationQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []types.DVVTriplet) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&types.DVVTriplets{Triplets: keys})
-	store.Set(types.GetRedelegationTimeKey(timestamp), bz)
+	deleteQueueShards(store, types.GetRedelegationTimeKey(timestamp))
+
+	for shard := 0; shard*types.QueueShardSize < len(keys); shard++ {
+		end := (shard + 1) * types.QueueShardSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		bz := k.cdc.MustMarshal(&types.DVVTriplets{Triplets: keys[shard*types.QueueShardSize : end]})
+		store.Set(types.GetRedelegationQueueShardKey(timestamp, uint32(shard)), bz)
+	}
 }
 
-// Insert an redelegation delegation to the appropriate timeslice in the redelegation queue
+// Insert an redelegation delegation to the last shard of the appropriate timeslice
+// in the redelegation queue, or to a new shard if the last one is full
 func (k Keeper) InsertRedelegationQueue(ctx sdk.Context, red types.Redelegation,
 	completionTime time.Time) {
-	timeSlice := k.GetRedelegationQueueTimeSlice(ctx, completionTime)
+	store := ctx.KVStore(k.storeKey)
 	dvvTriplet := types.DVVTriplet{
 		DelegatorAddress:    red.DelegatorAddress,
 		ValidatorSrcAddress: red.ValidatorSrcAddress,
 		ValidatorDstAddress: red.ValidatorDstAddress}
 
-	if len(timeSlice) == 0 {
-		k.SetRedelegationQueueTimeSlice(ctx, completionTime, []types.DVVTriplet{dvvTriplet})
-	} else {
-		timeSlice = append(timeSlice, dvvTriplet)
-		k.SetRedelegationQueueTimeSlice(ctx, completionTime, timeSlice)
+	shard, bz := lastQueueShard(store, types.GetRedelegationTimeKey(completionTime))
+	triplets := types.DVVTriplets{}
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &triplets)
+		if len(triplets.Triplets) >= types.QueueShardSize {
+			shard, triplets = shard+1, types.DVVTriplets{}
+		}
 	}
+
+	triplets.Triplets = append(triplets.Triplets, dvvTriplet)
+	store.Set(types.GetRedelegationQueueShardKey(completionTime, shard), k.cdc.MustMarshal(&triplets))
 }
 
-// Returns all the redelegation queue timeslices from time 0 until endTime
+// Returns all the redelegation queue shards from time 0 until endTime
 func (k Keeper) RedelegationQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
-	return store.Iterator(types.RedelegationQueueKey, sdk.InclusiveEndBytes(types.GetRedelegationTimeKey(endTime)))
+	return store.Iterator(types.RedelegationQueueKey, sdk.PrefixEndBytes(types.GetRedelegationTimeKey(endTime)))
 }
 
 // Returns a concatenated list of all the timeslices inclusively previous to
 // currTime, and deletes the timeslices from the queue
 func (k Keeper) DequeueAllMatureRedelegationQueue(ctx sdk.Context, currTime time.Time) (matureRedelegations []types.DVVTriplet) {
+	return k.DequeueMatureRedelegationQueue(ctx, currTime, 0)
+}
+
+// DequeueMatureRedelegationQueue returns, in order, at most limit triplets of
+// the timeslices inclusively previous to currTime, and deletes them from the
+// queue. The remaining triplets are dequeued by the next calls. A limit of 0
+// dequeues all the mature triplets.
+func (k Keeper) DequeueMatureRedelegationQueue(ctx sdk.Context, currTime time.Time, limit uint32) (matureRedelegations []types.DVVTriplet) {
 	store := ctx.KVStore(k.storeKey)
 
-	// gets an iterator for all timeslices from time 0 until the current Blockheader time
-	redelegationTimesliceIterator := k.RedelegationQueueIterator(ctx, ctx.BlockHeader().Time)
-	defer redelegationTimesliceIterator.Close()
+	// gets an iterator for all shards from time 0 until currTime
+	redelegationShardIterator := k.RedelegationQueueIterator(ctx, currTime)
+	defer redelegationShardIterator.Close()
 
-	for ; redelegationTimesliceIterator.Valid(); redelegationTimesliceIterator.Next() {
-		timeslice := types.DVVTriplets{}
-		value := redelegationTimesliceIterator.Value()
-		k.cdc.MustUnmarshal(value, &timeslice)
+	for ; redelegationShardIterator.Valid(); redelegationShardIterator.Next() {
+		shard := types.DVVTriplets{}
+		k.cdc.MustUnmarshal(redelegationShardIterator.Value(), &shard)
 
-		matureRedelegations = append(matureRedelegations, timeslice.Triplets...)
+		if limit > 0 && len(matureRedelegations)+len(shard.Triplets) > int(limit) {
+			n := int(limit) - len(matureRedelegations)
+			matureRedelegations = append(matureRedelegations, shard.Triplets[:n]...)
 
-		store.Delete(redelegationTimesliceIterator.Key())
+			shard.Triplets = shard.Triplets[n:]
+			store.Set(redelegationShardIterator.Key(), k.cdc.MustMarshal(&shard))
+			break
+		}
+
+		matureRedelegations = append(matureRedelegations, shard.Triplets...)
+		store.Delete(redelegationShardIterator.Key())
+
+		if limit > 0 && len(matureRedelegations) == int(limit) {
+			break
+		}
 	}
 
 	return matureRedelegations
@@ -701,7 +803,9 @@ func (k Keeper) Unbond(
 	validator, amount = k.RemoveValidatorTokensAndShares(ctx, validator, shares)
 	if validator.DelegatorShares.IsZero() && validator.IsUnbonded() {
 		// if not unbonded, we must instead remove validator in EndBlocker once it finishes its unbonding period
-		k.RemoveValidator(ctx, validator.GetOperator())
+		if err := k.RemoveValidator(ctx, validator.GetOperator()); err != nil {
+			return amount, err
+		}
 	}
 
 	return amount, nil
@@ -841,7 +945,13 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrTransitiveRedelegation
 	}
 
-	if k.HasMaxRedelegationEntries(ctx, delAddr, valSrcAddr, valDstAddr) {
+	completionTime, height, completeNow := k.getBeginInfo(ctx, valSrcAddr)
+
+	// an entry aggregated into an existing one doesn't count towards the
+	// maximum number of entries, nor is it queued again
+	red, found := k.GetRedelegation(ctx, delAddr, valSrcAddr, valDstAddr)
+	aggregated := found && red.CanAggregateEntry(height, completionTime)
+	if !aggregated && k.HasMaxRedelegationEntries(ctx, delAddr, valSrcAddr, valDstAddr) {
 		return time.Time{}, types.ErrMaxRedelegationEntries
 	}
 
@@ -859,25 +969,25 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, err
 	}
 
-	// create the unbonding delegation
-	completionTime, height, completeNow := k.getBeginInfo(ctx, valSrcAddr)
-
 	if completeNow { // no need to create the redelegation object
 		return completionTime, nil
 	}
 
-	red := k.SetRedelegationEntry(
+	red = k.SetRedelegationEntry(
 		ctx, delAddr, valSrcAddr, valDstAddr,
 		height, completionTime, returnAmount, sharesAmount, sharesCreated,
 	)
-	k.InsertRedelegationQueue(ctx, red, completionTime)
+	if !aggregated {
+		k.InsertRedelegationQueue(ctx, red, completionTime)
+	}
 
 	return completionTime, nil
 }
 
 // CompleteRedelegation completes the redelegations of all mature entries in the
 // retrieved redelegation object and returns the total redelegation (initial)
-// balance or an error upon failure.
+// balance or an error upon failure. As the entries are ordered by completion
+// time, only the mature ones are visited.
 func (k Keeper) CompleteRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress,
 ) (sdk.Coins, error) {
@@ -890,18 +1000,14 @@ func (k Keeper) CompleteRedelegation(
 	balances := sdk.NewCoins()
 	ctxTime := ctx.BlockHeader().Time
 
-	// loop through all the entries and complete mature redelegation entries
-	for i := 0; i < len(red.Entries); i++ {
-		entry := red.Entries[i]
-		if entry.IsMature(ctxTime) {
-			red.RemoveEntry(int64(i))
-			i--
-
-			if !entry.InitialBalance.IsZero() {
-				balances = balances.Add(sdk.NewCoin(bondDenom, entry.InitialBalance))
-			}
+	// complete the mature redelegation entries at the front of the entries
+	mature := 0
+	for ; mature < len(red.Entries) && red.Entries[mature].IsMature(ctxTime); mature++ {
+		if entry := red.Entries[mature]; !entry.InitialBalance.IsZero() {
+			balances = balances.Add(sdk.NewCoin(bondDenom, entry.InitialBalance))
 		}
 	}
+	red.Entries = red.Entries[mature:]
 
 	// set the redelegation or remove it if there are no more entries
 	if len(red.Entries) == 0 {
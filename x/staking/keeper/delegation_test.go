@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -761,17 +762,27 @@ func TestRedelegationMaxEntries(t *testing.T) {
 
 	maxEntries := app.StakingKeeper.MaxEntries(ctx)
 
-	// redelegations should pass
+	// redelegations in different blocks should pass
 	var completionTime time.Time
 	for i := uint32(0); i < maxEntries; i++ {
 		var err error
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Second))
 		completionTime, err = app.StakingKeeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], sdk.NewDec(1))
 		require.NoError(t, err)
 	}
 
-	// an additional redelegation should fail due to max entries
+	// an additional redelegation in the same block is aggregated into the last entry
 	_, err := app.StakingKeeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], sdk.NewDec(1))
-	require.Error(t, err)
+	require.NoError(t, err)
+	red, found := app.StakingKeeper.GetRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1])
+	require.True(t, found)
+	require.Len(t, red.Entries, int(maxEntries))
+	require.Equal(t, sdk.NewInt(2), red.Entries[maxEntries-1].InitialBalance)
+
+	// an additional redelegation in the next block should fail due to max entries
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Second))
+	_, err = app.StakingKeeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], sdk.NewDec(1))
+	require.ErrorIs(t, err, types.ErrMaxRedelegationEntries)
 
 	// mature redelegations
 	ctx = ctx.WithBlockTime(completionTime)
@@ -991,3 +1002,91 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestUBDQueueShards(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(0))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 0, time.Unix(0, 0), sdk.NewInt(5))
+
+	// the unbonding delegations maturing at the same time are split into shards
+	completionTime := time.Unix(100, 0)
+	for i := 0; i < types.QueueShardSize+5; i++ {
+		app.StakingKeeper.InsertUBDQueue(ctx, ubd, completionTime)
+	}
+	app.StakingKeeper.InsertUBDQueue(ctx, ubd, completionTime.Add(time.Second))
+	require.Len(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime), types.QueueShardSize+5)
+
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	require.True(t, store.Has(types.GetUnbondingDelegationQueueShardKey(completionTime, 0)))
+	require.True(t, store.Has(types.GetUnbondingDelegationQueueShardKey(completionTime, 1)))
+	require.False(t, store.Has(types.GetUnbondingDelegationQueueShardKey(completionTime, 2)))
+
+	// the mature pairs are dequeued up to the limit, across shards
+	require.Empty(t, app.StakingKeeper.DequeueMatureUBDQueue(ctx, completionTime.Add(-time.Second), 10))
+	require.Len(t, app.StakingKeeper.DequeueMatureUBDQueue(ctx, completionTime, 10), 10)
+	require.Len(t, app.StakingKeeper.DequeueMatureUBDQueue(ctx, completionTime, types.QueueShardSize), types.QueueShardSize-5)
+	require.Len(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime), 0)
+	require.False(t, store.Has(types.GetUnbondingDelegationQueueShardKey(completionTime, 0)))
+
+	// without a limit, all the mature pairs are dequeued
+	require.Len(t, app.StakingKeeper.DequeueAllMatureUBDQueue(ctx, completionTime.Add(time.Second)), 1)
+	require.Empty(t, app.StakingKeeper.DequeueAllMatureUBDQueue(ctx, completionTime.Add(time.Hour)))
+}
+
+func TestMaxMaturitiesPerBlock(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxMaturitiesPerBlock = 2
+	app.StakingKeeper.SetParams(ctx, params)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], PKs[0], 10, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], PKs[1], 10, true)
+	tstaking.TurnBlock(ctx.BlockHeader().Time)
+
+	// three delegators unbond, and two redelegate, in the same block
+	amount := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	for _, addr := range addrs {
+		tstaking.Delegate(addr, valAddrs[0], amount.MulRaw(2))
+		tstaking.Undelegate(addr, valAddrs[0], amount, true)
+	}
+	for _, addr := range addrs[:2] {
+		_, err := app.StakingKeeper.BeginRedelegation(ctx, addr, valAddrs[0], valAddrs[1], amount.ToDec())
+		require.NoError(t, err)
+	}
+
+	countUBDs := func(ctx sdk.Context) (n int) {
+		for _, addr := range addrs {
+			if _, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addr, valAddrs[0]); found {
+				n++
+			}
+		}
+		return n
+	}
+	countREDs := func(ctx sdk.Context) (n int) {
+		for _, addr := range addrs {
+			if _, found := app.StakingKeeper.GetRedelegation(ctx, addr, valAddrs[0], valAddrs[1]); found {
+				n++
+			}
+		}
+		return n
+	}
+	require.Equal(t, 3, countUBDs(ctx))
+	require.Equal(t, 2, countREDs(ctx))
+
+	// at most two unbonding delegations and two redelegations are completed
+	// per block once mature
+	ctx = tstaking.TurnBlockTimeDiff(params.UnbondingTime)
+	require.Equal(t, 1, countUBDs(ctx))
+	require.Equal(t, 0, countREDs(ctx))
+
+	ctx = tstaking.TurnBlockTimeDiff(time.Second)
+	require.Equal(t, 0, countUBDs(ctx))
+}
@@ -85,6 +85,62 @@ func (k Querier) Validator(c context.Context, req *types.QueryValidatorRequest)
 	return &types.QueryValidatorResponse{Validator: validator}, nil
 }
 
+// ValidatorMetadataVerification queries the attested verification of the
+// metadata of the given validator
+func (k Querier) ValidatorMetadataVerification(c context.Context, req *types.QueryValidatorMetadataVerificationRequest) (*types.QueryValidatorMetadataVerificationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	verification, found := k.GetMetadataVerification(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no metadata verification found for validator %s", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorMetadataVerificationResponse{Verification: verification}, nil
+}
+
+// ValidatorConsPubKeyRotations queries the rotations of the consensus public
+// key of a validator
+func (k Querier) ValidatorConsPubKeyRotations(c context.Context, req *types.QueryValidatorConsPubKeyRotationsRequest) (*types.QueryValidatorConsPubKeyRotationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetConsPubKeyRotationHistoryPrefix(valAddr))
+
+	var rotations []types.ConsPubKeyRotationHistory
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		rotations = append(rotations, types.MustUnmarshalConsPubKeyRotationHistory(k.cdc, value))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorConsPubKeyRotationsResponse{Rotations: rotations, Pagination: pageRes}, nil
+}
+
 // ValidatorDelegations queries delegate info for given validator
 func (k Querier) ValidatorDelegations(c context.Context, req *types.QueryValidatorDelegationsRequest) (*types.QueryValidatorDelegationsResponse, error) {
 	if req == nil {
@@ -375,6 +431,21 @@ func (k Querier) HistoricalInfo(c context.Context, req *types.QueryHistoricalInf
 	return &types.QueryHistoricalInfoResponse{Hist: &hi}, nil
 }
 
+// HistoricalInfoByTime queries the historical info closest to the given time
+func (k Querier) HistoricalInfoByTime(c context.Context, req *types.QueryHistoricalInfoByTimeRequest) (*types.QueryHistoricalInfoByTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	height, hi, found := k.GetHistoricalInfoByTime(ctx, req.Time)
+	if !found {
+		return nil, status.Error(codes.NotFound, "no historical info found")
+	}
+
+	return &types.QueryHistoricalInfoByTimeResponse{Height: height, Hist: &hi}, nil
+}
+
 // Redelegations queries redelegations of given address
 func (k Querier) Redelegations(c context.Context, req *types.QueryRedelegationsRequest) (*types.QueryRedelegationsResponse, error) {
 	if req == nil {
@@ -539,3 +610,42 @@ func queryAllRedelegations(store sdk.KVStore, k Querier, req *types.QueryRedeleg
 
 	return redels, res, err
 }
+
+// TokenizeShareRecordById queries the tokenize share record of the given id
+func (k Querier) TokenizeShareRecordById(c context.Context, req *types.QueryTokenizeShareRecordByIdRequest) (*types.QueryTokenizeShareRecordByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, found := k.GetTokenizeShareRecord(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "tokenize share record %d not found", req.Id)
+	}
+
+	return &types.QueryTokenizeShareRecordByIdResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsOwned queries the tokenize share records of the given owner
+func (k Querier) TokenizeShareRecordsOwned(c context.Context, req *types.QueryTokenizeShareRecordsOwnedRequest) (*types.QueryTokenizeShareRecordsOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	records := k.GetTokenizeShareRecordsByOwner(ctx, owner)
+
+	return &types.QueryTokenizeShareRecordsOwnedResponse{Records: records}, nil
+}
+
+// TotalLiquidStaked queries the total amount of tokens tokenized into liquid staking shares
+func (k Querier) TotalLiquidStaked(c context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: k.GetTotalLiquidStakedTokens(ctx)}, nil
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -18,12 +20,20 @@ func (k Keeper) GetHistoricalInfo(ctx sdk.Context, height int64) (types.Historic
 	return types.MustUnmarshalHistoricalInfo(k.cdc, value), true
 }
 
-// SetHistoricalInfo sets the historical info at a given height
+// SetHistoricalInfo sets the historical info at a given height, indexed by the
+// time of its header
 func (k Keeper) SetHistoricalInfo(ctx sdk.Context, height int64, hi *types.HistoricalInfo) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetHistoricalInfoKey(height)
+
+	// remove the time index of the entry being overwritten, if any
+	if prev, found := k.GetHistoricalInfo(ctx, height); found {
+		store.Delete(types.GetHistoricalInfoByTimeKey(prev.Header.Time, height))
+	}
+
 	value := k.cdc.MustMarshal(hi)
 	store.Set(key, value)
+	store.Set(types.GetHistoricalInfoByTimeKey(hi.Header.Time, height), []byte{})
 }
 
 // DeleteHistoricalInfo deletes the historical info at a given height
@@ -31,9 +41,62 @@ func (k Keeper) DeleteHistoricalInfo(ctx sdk.Context, height int64) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetHistoricalInfoKey(height)
 
+	if hi, found := k.GetHistoricalInfo(ctx, height); found {
+		store.Delete(types.GetHistoricalInfoByTimeKey(hi.Header.Time, height))
+	}
+
 	store.Delete(key)
 }
 
+// GetHistoricalInfoByTime returns the height and the historical info whose
+// header time is the closest to the given time. If two entries are equally
+// close, the earlier one is returned.
+func (k Keeper) GetHistoricalInfoByTime(ctx sdk.Context, t time.Time) (int64, types.HistoricalInfo, bool) {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.GetHistoricalInfoByTimePrefix(t))
+
+	// latest entry at or before t
+	before, beforeFound := firstHistoricalInfoHeight(store.ReverseIterator(types.HistoricalInfoByTimeKey, end))
+	// earliest entry after t
+	after, afterFound := firstHistoricalInfoHeight(store.Iterator(end, sdk.PrefixEndBytes(types.HistoricalInfoByTimeKey)))
+
+	switch {
+	case !beforeFound && !afterFound:
+		return 0, types.HistoricalInfo{}, false
+	case !afterFound:
+		hi, found := k.GetHistoricalInfo(ctx, before)
+		return before, hi, found
+	case !beforeFound:
+		hi, found := k.GetHistoricalInfo(ctx, after)
+		return after, hi, found
+	}
+
+	beforeInfo, _ := k.GetHistoricalInfo(ctx, before)
+	afterInfo, _ := k.GetHistoricalInfo(ctx, after)
+	if afterInfo.Header.Time.Sub(t) < t.Sub(beforeInfo.Header.Time) {
+		return after, afterInfo, true
+	}
+
+	return before, beforeInfo, true
+}
+
+// firstHistoricalInfoHeight returns the height of the first entry of the given
+// iterator over the time index, and closes it.
+func firstHistoricalInfoHeight(iterator sdk.Iterator) (int64, bool) {
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false
+	}
+
+	height, err := types.ParseHistoricalInfoByTimeKey(iterator.Key())
+	if err != nil {
+		panic(err)
+	}
+
+	return height, true
+}
+
 // IterateHistoricalInfo provides an interator over all stored HistoricalInfo
 //  objects. For each HistoricalInfo object, cb will be called. If the cb returns
 // true, the iterator will close and stop.
@@ -96,3 +159,34 @@ func (k Keeper) TrackHistoricalInfo(ctx sdk.Context) {
 	// Set latest HistoricalInfo at current height
 	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), &historicalEntry)
 }
+
+// PruneHistoricalInfoByTime deletes the historical entries whose header time is
+// older than the HistoricalRetentionTime parameter. Nothing is pruned if the
+// parameter is zero.
+func (k Keeper) PruneHistoricalInfoByTime(ctx sdk.Context) {
+	retention := k.HistoricalRetentionTime(ctx)
+	if retention == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	cutoff := ctx.BlockTime().Add(-retention)
+
+	// the entries are deleted after iterating, as the store can't be written to
+	// while being iterated
+	var heights []int64
+	iterator := store.Iterator(types.HistoricalInfoByTimeKey, types.GetHistoricalInfoByTimePrefix(cutoff))
+	for ; iterator.Valid(); iterator.Next() {
+		height, err := types.ParseHistoricalInfoByTimeKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		heights = append(heights, height)
+	}
+	iterator.Close()
+
+	for _, height := range heights {
+		k.DeleteHistoricalInfo(ctx, height)
+	}
+}
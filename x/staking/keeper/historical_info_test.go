@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -164,3 +165,84 @@ func TestGetAllHistoricalInfo(t *testing.T) {
 	infos = app.StakingKeeper.GetAllHistoricalInfo(ctx)
 	require.Equal(t, expHistInfos, infos)
 }
+
+func TestHistoricalInfoByTime(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	deleteAllHistoricalInfo(app, ctx)
+
+	genTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, _, found := app.StakingKeeper.GetHistoricalInfoByTime(ctx, genTime)
+	require.False(t, found)
+
+	// entries at 1, 2 and 4 minutes after genTime
+	for _, height := range []int64{1, 2, 4} {
+		header := tmproto.Header{ChainID: "HelloChain", Height: height, Time: genTime.Add(time.Duration(height) * time.Minute)}
+		hi := types.NewHistoricalInfo(header, types.Validators{}, app.StakingKeeper.PowerReduction(ctx))
+		app.StakingKeeper.SetHistoricalInfo(ctx, height, &hi)
+	}
+
+	testCases := []struct {
+		time   time.Time
+		height int64
+	}{
+		{genTime, 1},
+		{genTime.Add(time.Minute), 1},
+		{genTime.Add(100 * time.Second), 2},
+		// equally close entries resolve to the earlier one
+		{genTime.Add(3 * time.Minute), 2},
+		{genTime.Add(200 * time.Second), 4},
+		{genTime.Add(time.Hour), 4},
+	}
+	for _, tc := range testCases {
+		height, hi, found := app.StakingKeeper.GetHistoricalInfoByTime(ctx, tc.time)
+		require.True(t, found, tc.time)
+		require.Equal(t, tc.height, height, tc.time)
+		require.Equal(t, tc.height, hi.Header.Height, tc.time)
+	}
+
+	// deleted entries are removed from the time index
+	app.StakingKeeper.DeleteHistoricalInfo(ctx, 4)
+	height, _, found := app.StakingKeeper.GetHistoricalInfoByTime(ctx, genTime.Add(time.Hour))
+	require.True(t, found)
+	require.Equal(t, int64(2), height)
+}
+
+func TestPruneHistoricalInfoByTime(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	deleteAllHistoricalInfo(app, ctx)
+
+	genTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	for height := int64(1); height <= 5; height++ {
+		header := tmproto.Header{ChainID: "HelloChain", Height: height, Time: genTime.Add(time.Duration(height) * time.Minute)}
+		hi := types.NewHistoricalInfo(header, types.Validators{}, app.StakingKeeper.PowerReduction(ctx))
+		app.StakingKeeper.SetHistoricalInfo(ctx, height, &hi)
+	}
+	ctx = ctx.WithBlockTime(genTime.Add(5 * time.Minute))
+
+	// nothing is pruned without retention time
+	app.StakingKeeper.PruneHistoricalInfoByTime(ctx)
+	require.Len(t, app.StakingKeeper.GetAllHistoricalInfo(ctx), 5)
+
+	params := types.DefaultParams()
+	params.HistoricalRetentionTime = 2 * time.Minute
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// entries older than 3 minutes after genTime are pruned
+	app.StakingKeeper.PruneHistoricalInfoByTime(ctx)
+	for height := int64(1); height <= 5; height++ {
+		_, found := app.StakingKeeper.GetHistoricalInfo(ctx, height)
+		require.Equal(t, height >= 3, found, height)
+	}
+
+	height, _, found := app.StakingKeeper.GetHistoricalInfoByTime(ctx, genTime)
+	require.True(t, found)
+	require.Equal(t, int64(3), height)
+}
+
+// deleteAllHistoricalInfo deletes the historical entries stored at genesis.
+func deleteAllHistoricalInfo(app *simapp.SimApp, ctx sdk.Context) {
+	for _, hi := range app.StakingKeeper.GetAllHistoricalInfo(ctx) {
+		app.StakingKeeper.DeleteHistoricalInfo(ctx, hi.Header.Height)
+	}
+}
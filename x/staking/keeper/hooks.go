@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -67,7 +68,7 @@ func (k Keeper) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccA
 // BeforeDelegationRemoved - call hook if registered
 func (k Keeper) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	if k.hooks != nil {
-		k.hooks.BeforeDelegationRemoved(ctx, delAddr, valAddr)
+		return k.hooks.BeforeDelegationRemoved(ctx, delAddr, valAddr)
 	}
 	return nil
 }
@@ -87,3 +88,11 @@ func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress,
 	}
 	return nil
 }
+
+// AfterConsensusPubKeyRotated - call hook if registered
+func (k Keeper) AfterConsensusPubKeyRotated(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey, valAddr sdk.ValAddress) error {
+	if k.hooks != nil {
+		return k.hooks.AfterConsensusPubKeyRotated(ctx, oldPubKey, newPubKey, valAddr)
+	}
+	return nil
+}
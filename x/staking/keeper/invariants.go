@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -46,30 +47,10 @@ func AllInvariants(k Keeper) sdk.Invariant {
 // reflects the tokens actively bonded and not bonded
 func ModuleAccountInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		bonded := sdk.ZeroInt()
-		notBonded := sdk.ZeroInt()
 		bondedPool := k.GetBondedPool(ctx)
 		notBondedPool := k.GetNotBondedPool(ctx)
 		bondDenom := k.BondDenom(ctx)
-
-		k.IterateValidators(ctx, func(_ int64, validator types.ValidatorI) bool {
-			switch validator.GetStatus() {
-			case types.Bonded:
-				bonded = bonded.Add(validator.GetTokens())
-			case types.Unbonding, types.Unbonded:
-				notBonded = notBonded.Add(validator.GetTokens())
-			default:
-				panic("invalid validator status")
-			}
-			return false
-		})
-
-		k.IterateUnbondingDelegations(ctx, func(_ int64, ubd types.UnbondingDelegation) bool {
-			for _, entry := range ubd.Entries {
-				notBonded = notBonded.Add(entry.Balance)
-			}
-			return false
-		})
+		bonded, notBonded := poolTokens(ctx, k)
 
 		poolBonded := k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom)
 		poolNotBonded := k.bankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom)
@@ -90,6 +71,61 @@ func ModuleAccountInvariants(k Keeper) sdk.Invariant {
 	}
 }
 
+// poolTokens returns the sum of the tokens of bonded validators, and the sum of
+// the tokens of unbonding and unbonded validators and unbonding delegations.
+func poolTokens(ctx sdk.Context, k Keeper) (bonded, notBonded sdk.Int) {
+	bonded = sdk.ZeroInt()
+	notBonded = sdk.ZeroInt()
+
+	k.IterateValidators(ctx, func(_ int64, validator types.ValidatorI) bool {
+		switch validator.GetStatus() {
+		case types.Bonded:
+			bonded = bonded.Add(validator.GetTokens())
+		case types.Unbonding, types.Unbonded:
+			notBonded = notBonded.Add(validator.GetTokens())
+		default:
+			panic("invalid validator status")
+		}
+		return false
+	})
+
+	k.IterateUnbondingDelegations(ctx, func(_ int64, ubd types.UnbondingDelegation) bool {
+		for _, entry := range ubd.Entries {
+			notBonded = notBonded.Add(entry.Balance)
+		}
+		return false
+	})
+
+	return bonded, notBonded
+}
+
+// ModuleBalanceExpectations returns the expected bond denom balances of the
+// bonded and not bonded pools, to register in the bank keeper.
+func ModuleBalanceExpectations(k Keeper) []banktypes.ModuleBalanceExpectation {
+	bondDenom := func(ctx sdk.Context) []string { return []string{k.BondDenom(ctx)} }
+
+	return []banktypes.ModuleBalanceExpectation{
+		{
+			ModuleName:  types.BondedPoolName,
+			Description: "bonded pool equals sum of bonded tokens",
+			Denoms:      bondDenom,
+			Expected: func(ctx sdk.Context) sdk.Coins {
+				bonded, _ := poolTokens(ctx, k)
+				return sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), bonded))
+			},
+		},
+		{
+			ModuleName:  types.NotBondedPoolName,
+			Description: "not bonded pool equals sum of not bonded tokens",
+			Denoms:      bondDenom,
+			Expected: func(ctx sdk.Context) sdk.Coins {
+				_, notBonded := poolTokens(ctx, k)
+				return sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), notBonded))
+			},
+		},
+	}
+}
+
 // NonNegativePowerInvariant checks that all stored validators have >= 0 power.
 func NonNegativePowerInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TokenizeShares tokenizes the given shares of a delegation into liquid
+// staking shares. The delegation is moved to the module account of a new
+// tokenize share record owned by the given owner, and the liquid staking shares
+// are minted to the delegator.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec, owner sdk.AccAddress,
+) (types.TokenizeShareRecord, sdk.Coin, error) {
+	// the delegations of vesting accounts can't be tokenized, as the minted
+	// shares would escape the vesting schedule
+	if acc := k.authKeeper.GetAccount(ctx, delAddr); acc != nil {
+		if _, ok := acc.(vestexported.VestingAccount); ok {
+			return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrTokenizeSharesVestingAccount
+		}
+	}
+
+	// the redelegations to the validator must complete first, as they would
+	// otherwise not be slashed from the tokenized delegation
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+	if err := k.checkLiquidStakingCaps(ctx, validator, shares, tokens); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	record := types.NewTokenizeShareRecord(k.GetLastTokenizeShareRecordID(ctx)+1, owner, valAddr)
+
+	// move the delegation to the module account of the record, the tokens stay
+	// in the pool of the validator
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	newShares, err := k.Delegate(ctx, record.GetModuleAddress(), returnAmount, validator.GetStatus(), validator, false)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	shareTokens := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if !shareTokens.IsPositive() {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "too few shares to tokenize")
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareTokens)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareTokens)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	k.SetTokenizeShareRecord(ctx, record)
+	k.SetLastTokenizeShareRecordID(ctx, record.Id)
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(newShares))
+
+	return record, shareTokens, nil
+}
+
+// RedeemTokensForShares burns the given liquid staking shares of a delegator
+// and moves the delegation backing them from the module account of their
+// tokenize share record to the delegator. Once all the shares of a record are
+// redeemed, its remaining rewards are sent to its owner and it is deleted.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, shareTokens sdk.Coin) (sdk.Coin, error) {
+	valAddr, id, err := types.ParseShareTokenDenom(shareTokens.Denom)
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found || !record.GetValidatorAddress().Equals(valAddr) {
+		return sdk.Coin{}, types.ErrNoTokenizeShareRecord
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, shareTokens.Denom)
+	if balance.Amount.LT(shareTokens.Amount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, shareTokens)
+	}
+
+	moduleAddr := record.GetModuleAddress()
+	delegation, found := k.GetDelegation(ctx, moduleAddr, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegation
+	}
+
+	shares := shareTokens.Amount.ToDec()
+	if shares.GT(delegation.Shares) {
+		return sdk.Coin{}, types.ErrNotEnoughDelegationShares
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the last redeemer also gets the fractional shares left by the truncation
+	// of the minted shares
+	lastRedemption := k.bankKeeper.GetSupply(ctx, shareTokens.Denom).IsZero()
+	if lastRedemption {
+		shares = delegation.Shares
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+	if validator.TokensFromShares(shares).TruncateInt().IsZero() {
+		return sdk.Coin{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "too few shares to redeem")
+	}
+
+	returnAmount, err := k.Unbond(ctx, moduleAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	if _, err := k.Delegate(ctx, delAddr, returnAmount, validator.GetStatus(), validator, false); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr).Sub(shares)
+	if liquidShares.IsNegative() {
+		liquidShares = sdk.ZeroDec()
+	}
+	k.SetValidatorLiquidShares(ctx, valAddr, liquidShares)
+
+	if _, found := k.GetDelegation(ctx, moduleAddr, valAddr); !found {
+		// the rewards withdrawn to the module account when unbonding the
+		// delegation belong to the owner of the record
+		rewards := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+		if !rewards.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, moduleAddr, record.GetOwnerAddress(), rewards); err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+
+		k.DeleteTokenizeShareRecord(ctx, record.Id)
+	}
+
+	return sdk.NewCoin(k.BondDenom(ctx), returnAmount), nil
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing the given shares and
+// tokens of a validator would exceed the global or validator liquid staking
+// caps. A cap of 100% isn't checked.
+func (k Keeper) checkLiquidStakingCaps(ctx sdk.Context, validator types.Validator, shares sdk.Dec, tokens sdk.Int) error {
+	if globalCap := k.GlobalLiquidStakingCap(ctx); globalCap.LT(sdk.OneDec()) {
+		totalLiquidStaked := k.GetTotalLiquidStakedTokens(ctx).Add(tokens).ToDec()
+		if totalLiquidStaked.GT(k.TotalBondedTokens(ctx).ToDec().Mul(globalCap)) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	if validatorCap := k.ValidatorLiquidStakingCap(ctx); validatorCap.LT(sdk.OneDec()) {
+		liquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator()).Add(shares)
+		if liquidShares.GT(validator.DelegatorShares.Mul(validatorCap)) {
+			return types.ErrValidatorLiquidStakingCapExceeded
+		}
+	}
+
+	return nil
+}
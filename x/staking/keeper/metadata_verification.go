@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetMetadataVerification gets the verification of the metadata of a validator
+func (k Keeper) GetMetadataVerification(ctx sdk.Context, valAddr sdk.ValAddress) (types.MetadataVerification, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetMetadataVerificationKey(valAddr))
+	if value == nil {
+		return types.MetadataVerification{}, false
+	}
+
+	return types.MustUnmarshalMetadataVerification(k.cdc, value), true
+}
+
+// SetMetadataVerification sets the verification of the metadata of a validator
+func (k Keeper) SetMetadataVerification(ctx sdk.Context, valAddr sdk.ValAddress, verification types.MetadataVerification) {
+	store := ctx.KVStore(k.storeKey)
+	value := types.MustMarshalMetadataVerification(k.cdc, &verification)
+	store.Set(types.GetMetadataVerificationKey(valAddr), value)
+}
+
+// DeleteMetadataVerification deletes the verification of the metadata of a
+// validator
+func (k Keeper) DeleteMetadataVerification(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMetadataVerificationKey(valAddr))
+}
+
+// IterateMetadataVerifications iterates over the verifications of the
+// validators' metadata. If the cb returns true, the iterator will close and
+// stop.
+func (k Keeper) IterateMetadataVerifications(ctx sdk.Context, cb func(valAddr sdk.ValAddress, verification types.MetadataVerification) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.MetadataVerificationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(types.AddressFromMetadataVerificationKey(iterator.Key()))
+		verification := types.MustUnmarshalMetadataVerification(k.cdc, iterator.Value())
+		if cb(valAddr, verification) {
+			break
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetMinSelfDelegationBreach gets the time since which a validator is below
+// its minimum self-delegation
+func (k Keeper) GetMinSelfDelegationBreach(ctx sdk.Context, valAddr sdk.ValAddress) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetMinSelfDelegationBreachKey(valAddr))
+	if value == nil {
+		return time.Time{}, false
+	}
+
+	since, err := sdk.ParseTimeBytes(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return since, true
+}
+
+// SetMinSelfDelegationBreach sets the time since which a validator is below
+// its minimum self-delegation
+func (k Keeper) SetMinSelfDelegationBreach(ctx sdk.Context, valAddr sdk.ValAddress, since time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMinSelfDelegationBreachKey(valAddr), sdk.FormatTimeBytes(since))
+}
+
+// DeleteMinSelfDelegationBreach deletes the time since which a validator is
+// below its minimum self-delegation
+func (k Keeper) DeleteMinSelfDelegationBreach(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMinSelfDelegationBreachKey(valAddr))
+}
+
+// IterateMinSelfDelegationBreaches iterates over the validators below their
+// minimum self-delegation. If the cb returns true, the iterator will close and
+// stop.
+func (k Keeper) IterateMinSelfDelegationBreaches(ctx sdk.Context, cb func(valAddr sdk.ValAddress, since time.Time) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.MinSelfDelegationBreachKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(types.AddressFromMinSelfDelegationBreachKey(iterator.Key()))
+		since, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(valAddr, since) {
+			break
+		}
+	}
+}
+
+// GetValidatorSelfDelegation returns the amount of tokens self-delegated by
+// the operator of a validator
+func (k Keeper) GetValidatorSelfDelegation(ctx sdk.Context, validator types.Validator) sdk.Int {
+	delegation, found := k.GetDelegation(ctx, sdk.AccAddress(validator.GetOperator()), validator.GetOperator())
+	if !found {
+		return sdk.ZeroInt()
+	}
+
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// EnforceMinSelfDelegation jails the bonded validators whose self-delegation
+// has been below their minimum self-delegation for longer than the
+// MinSelfDelegationGracePeriod param. The time since which each validator is
+// below its minimum is recorded when the breach is first seen and cleared once
+// the self-delegation is restored or the validator is jailed or unbonded.
+func (k Keeper) EnforceMinSelfDelegation(ctx sdk.Context) {
+	// drop the breaches of the validators which are no longer bonded, they
+	// start a new grace period if they get bonded again
+	var stale []sdk.ValAddress
+	k.IterateMinSelfDelegationBreaches(ctx, func(valAddr sdk.ValAddress, _ time.Time) bool {
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found || validator.IsJailed() || !validator.IsBonded() {
+			stale = append(stale, valAddr)
+		}
+		return false
+	})
+	for _, valAddr := range stale {
+		k.DeleteMinSelfDelegationBreach(ctx, valAddr)
+	}
+
+	gracePeriod := k.MinSelfDelegationGracePeriod(ctx)
+	blockTime := ctx.BlockHeader().Time
+
+	for _, validator := range k.GetLastValidators(ctx) {
+		if validator.IsJailed() {
+			continue
+		}
+
+		valAddr := validator.GetOperator()
+		selfDelegation := k.GetValidatorSelfDelegation(ctx, validator)
+		if selfDelegation.GTE(validator.MinSelfDelegation) {
+			k.DeleteMinSelfDelegationBreach(ctx, valAddr)
+			continue
+		}
+
+		since, found := k.GetMinSelfDelegationBreach(ctx, valAddr)
+		if !found {
+			since = blockTime
+			k.SetMinSelfDelegationBreach(ctx, valAddr, since)
+		}
+
+		jailTime := since.Add(gracePeriod)
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, validator.MinSelfDelegation.String()),
+			sdk.NewAttribute(types.AttributeKeySelfDelegation, selfDelegation.String()),
+			sdk.NewAttribute(types.AttributeKeyJailTime, jailTime.Format(time.RFC3339)),
+		}
+
+		if blockTime.Before(jailTime) {
+			if !found {
+				ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeMinSelfDelegationBreach, attributes...))
+			}
+			continue
+		}
+
+		k.jailValidator(ctx, validator)
+		k.DeleteMinSelfDelegationBreach(ctx, valAddr)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeMinSelfDelegationJail, attributes...))
+	}
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestEnforceMinSelfDelegation(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinSelfDelegationGracePeriod = time.Hour
+	app.StakingKeeper.SetParams(ctx, params)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	pks := simapp.CreateTestPubKeys(2)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	selfBond := app.StakingKeeper.TokensFromConsensusPower(ctx, 100)
+	for i := range valAddrs {
+		msg := tstaking.CreateValidatorMsg(valAddrs[i], pks[i], selfBond)
+		msg.MinSelfDelegation = selfBond
+		_, err := tstaking.CreateValidatorWithMsg(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+	}
+	tstaking.TurnBlock(ctx.BlockHeader().Time)
+	tstaking.CheckValidator(valAddrs[0], types.Bonded, false)
+	tstaking.CheckValidator(valAddrs[1], types.Bonded, false)
+
+	// slash both validators below their minimum self-delegation
+	for i := range valAddrs {
+		app.StakingKeeper.Slash(tstaking.Ctx, sdk.ConsAddress(pks[i].Address()), tstaking.Ctx.BlockHeight(), 100, sdk.NewDecWithPrec(1, 1))
+	}
+	ctx = tstaking.TurnBlockTimeDiff(time.Minute)
+	since := ctx.BlockHeader().Time
+	for i := range valAddrs {
+		tstaking.CheckValidator(valAddrs[i], types.Bonded, false)
+		breach, found := app.StakingKeeper.GetMinSelfDelegationBreach(ctx, valAddrs[i])
+		require.True(t, found)
+		require.Equal(t, since, breach)
+	}
+
+	// the first validator restores its self-delegation within the grace period
+	tstaking.Delegate(addrs[0], valAddrs[0], selfBond)
+	ctx = tstaking.TurnBlockTimeDiff(30 * time.Minute)
+	_, found := app.StakingKeeper.GetMinSelfDelegationBreach(ctx, valAddrs[0])
+	require.False(t, found)
+	breach, found := app.StakingKeeper.GetMinSelfDelegationBreach(ctx, valAddrs[1])
+	require.True(t, found)
+	require.Equal(t, since, breach)
+	tstaking.CheckValidator(valAddrs[1], types.Bonded, false)
+
+	// the second validator is jailed and leaves the bonded validator set once
+	// the grace period is over
+	ctx = tstaking.TurnBlockTimeDiff(30 * time.Minute)
+	tstaking.CheckValidator(valAddrs[0], types.Bonded, false)
+	tstaking.CheckValidator(valAddrs[1], types.Unbonding, true)
+	_, found = app.StakingKeeper.GetMinSelfDelegationBreach(ctx, valAddrs[1])
+	require.False(t, found)
+
+	var jailed bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMinSelfDelegationJail {
+			jailed = true
+		}
+	}
+	require.True(t, jailed)
+}
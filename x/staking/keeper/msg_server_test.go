@@ -0,0 +1,243 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMinCommissionRate(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	_, valAddrs := generateAddresses(app, ctx, 2)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	amount := sdk.NewInt(1000)
+
+	// a validator can't be created with a commission rate below the minimum
+	tstaking.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(1, 2))
+	_, err := tstaking.CreateValidatorWithMsg(sdk.WrapSDKContext(ctx), tstaking.CreateValidatorMsg(valAddrs[0], PKs[0], amount))
+	require.ErrorIs(t, err, types.ErrCommissionLTMinRate)
+
+	tstaking.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(1, 2))
+	tstaking.CreateValidator(valAddrs[1], PKs[1], amount, true)
+
+	// nor can its commission rate be edited below the minimum
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(48 * time.Hour))
+	newRate := sdk.NewDecWithPrec(4, 2)
+	_, err = msgServer.EditValidator(sdk.WrapSDKContext(ctx), types.NewMsgEditValidator(valAddrs[1], types.Description{}, &newRate, nil))
+	require.ErrorIs(t, err, types.ErrCommissionLTMinRate)
+
+	newRate = sdk.NewDecWithPrec(6, 2)
+	_, err = msgServer.EditValidator(sdk.WrapSDKContext(ctx), types.NewMsgEditValidator(valAddrs[1], types.Description{}, &newRate, nil))
+	require.NoError(t, err)
+}
+
+func TestSetMetadataVerification(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	_, valAddrs := generateAddresses(app, ctx, 1)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddrs[0], PKs[0], sdk.NewInt(1000), true)
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// the metadata to verify must be set
+	_, err := msgServer.SetMetadataVerification(goCtx, types.NewMsgSetMetadataVerification(valAddrs[0], true, false))
+	require.ErrorIs(t, err, types.ErrEmptyMetadata)
+
+	description := types.NewDescription("moniker", "", "example.com", "security@example.com", "")
+	_, err = msgServer.EditValidator(goCtx, types.NewMsgEditValidator(valAddrs[0], description, nil, nil))
+	require.NoError(t, err)
+
+	_, err = msgServer.SetMetadataVerification(goCtx, types.NewMsgSetMetadataVerification(valAddrs[0], true, true))
+	require.NoError(t, err)
+
+	verification, found := app.StakingKeeper.GetMetadataVerification(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, types.NewMetadataVerification(description, true, true, ctx.BlockTime()), verification)
+
+	// editing other fields keeps the verification
+	description.Details = "details"
+	_, err = msgServer.EditValidator(goCtx, types.NewMsgEditValidator(valAddrs[0], description, nil, nil))
+	require.NoError(t, err)
+	_, found = app.StakingKeeper.GetMetadataVerification(ctx, valAddrs[0])
+	require.True(t, found)
+
+	// editing the website clears the verification
+	description.Website = "example.org"
+	_, err = msgServer.EditValidator(goCtx, types.NewMsgEditValidator(valAddrs[0], description, nil, nil))
+	require.NoError(t, err)
+	_, found = app.StakingKeeper.GetMetadataVerification(ctx, valAddrs[0])
+	require.False(t, found)
+}
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	addrs, valAddrs := generateAddresses(app, ctx, 3)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddrs[0], PKs[0], sdk.NewInt(1000), true)
+	tstaking.Delegate(addrs[1], valAddrs[0], sdk.NewInt(1000))
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	// tokenize part of the delegation, with the rewards owned by another account
+	res, err := msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(addrs[1], valAddrs[0], sdk.NewInt64Coin(bondDenom, 400), addrs[2]))
+	require.NoError(t, err)
+
+	shareDenom := types.ShareTokenDenom(valAddrs[0].String(), 1)
+	require.Equal(t, sdk.NewInt64Coin(shareDenom, 400), res.Amount)
+	require.Equal(t, res.Amount, app.BankKeeper.GetBalance(ctx, addrs[1], shareDenom))
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, addrs[2].String(), record.Owner)
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[2]))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(600), delegation.Shares)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(400), delegation.Shares)
+
+	require.Equal(t, sdk.NewInt(400), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(t, sdk.NewDec(400), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]))
+
+	// the shares are transferable and can be redeemed by any holder
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, addrs[1], addrs[2], sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 100))))
+	_, err = msgServer.RedeemTokensForShares(goCtx, types.NewMsgRedeemTokensForShares(addrs[2], sdk.NewInt64Coin(shareDenom, 200)))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	redeemRes, err := msgServer.RedeemTokensForShares(goCtx, types.NewMsgRedeemTokensForShares(addrs[2], sdk.NewInt64Coin(shareDenom, 100)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 100), redeemRes.Amount)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[2], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), delegation.Shares)
+	require.Equal(t, sdk.NewInt(300), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// redeeming the last shares deletes the record
+	_, err = msgServer.RedeemTokensForShares(goCtx, types.NewMsgRedeemTokensForShares(addrs[1], sdk.NewInt64Coin(shareDenom, 300)))
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(900), delegation.Shares)
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, addrs[2]))
+	require.True(t, app.BankKeeper.GetSupply(ctx, shareDenom).IsZero())
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]).IsZero())
+	require.Equal(t, uint64(1), app.StakingKeeper.GetLastTokenizeShareRecordID(ctx))
+}
+
+func TestTotalLiquidStakedTokensSlashed(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	valTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, valTokens)
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddrs[0], PKs[0], valTokens, true)
+	tstaking.Delegate(addrs[1], valAddrs[0], valTokens)
+	_, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	_, shareTokens, err := app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddrs[0], valTokens.ToDec(), addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, valTokens, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// the total follows the slashes of the validators
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 20, sdk.NewDecWithPrec(1, 1))
+	require.Equal(t, valTokens.ToDec().Mul(sdk.NewDecWithPrec(9, 1)).TruncateInt(), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[1], shareTokens)
+	require.NoError(t, err)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+}
+
+func TestTokenizeSharesCaps(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	addrs, valAddrs := generateAddresses(app, ctx, 2)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddrs[0], PKs[0], sdk.NewInt(1000), true)
+	tstaking.Delegate(addrs[1], valAddrs[0], sdk.NewInt(1000))
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	amount := sdk.NewInt64Coin(app.StakingKeeper.BondDenom(ctx), 500)
+
+	// the validator cap is checked against the shares of the validator
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(20, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err := msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(addrs[1], valAddrs[0], amount, addrs[1]))
+	require.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(addrs[1], valAddrs[0], amount, addrs[1]))
+	require.NoError(t, err)
+
+	// the global cap is checked against the total bonded tokens
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	params.GlobalLiquidStakingCap = sdk.ZeroDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = msgServer.TokenizeShares(goCtx, types.NewMsgTokenizeShares(addrs[1], valAddrs[0], amount, addrs[1]))
+	require.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+}
+
+func TestTokenizeSharesVestingAccount(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	addrs, valAddrs := generateAddresses(app, ctx, 2)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddrs[0], PKs[0], sdk.NewInt(1000), true)
+
+	acc := app.AccountKeeper.GetAccount(ctx, addrs[1]).(*authtypes.BaseAccount)
+	vestingAcc := vestingtypes.NewContinuousVestingAccount(acc, app.BankKeeper.GetAllBalances(ctx, addrs[1]), ctx.BlockTime().Unix(), ctx.BlockTime().Add(time.Hour).Unix())
+	app.AccountKeeper.SetAccount(ctx, vestingAcc)
+	tstaking.Delegate(addrs[1], valAddrs[0], sdk.NewInt(1000))
+
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	amount := sdk.NewInt64Coin(app.StakingKeeper.BondDenom(ctx), 500)
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(addrs[1], valAddrs[0], amount, addrs[1]))
+	require.ErrorIs(t, err, types.ErrTokenizeSharesVestingAccount)
+}
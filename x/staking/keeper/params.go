@@ -39,6 +39,12 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// MinCommissionRate - Minimum validator commission rate
+func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinCommissionRate, &res)
+	return
+}
+
 // PowerReduction - is the amount of staking tokens required for 1 unit of consensus-engine power.
 // Currently, this returns a global variable that the app developer can tweak.
 // TODO: we might turn this into an on-chain param:
@@ -48,6 +54,46 @@ func (k Keeper) PowerReduction(ctx sdk.Context) sdk.Int {
 }
 
 // Get all parameteras as types.Params
+// HistoricalRetentionTime - maximum age of the historical entries
+func (k Keeper) HistoricalRetentionTime(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyHistoricalRetentionTime, &res)
+	return
+}
+
+// GlobalLiquidStakingCap - maximum fraction of the bonded tokens which can be
+// tokenized
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyGlobalLiquidStakingCap, &res)
+	return
+}
+
+// ValidatorLiquidStakingCap - maximum fraction of the delegator shares of a
+// validator which can be tokenized
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValidatorLiquidStakingCap, &res)
+	return
+}
+
+// MinSelfDelegationGracePeriod - duration a bonded validator can stay below
+// its minimum self-delegation before being jailed
+func (k Keeper) MinSelfDelegationGracePeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyMinSelfDelegationGracePeriod, &res)
+	return
+}
+
+// KeyRotationFee - fee paid to rotate the consensus public key of a validator
+func (k Keeper) KeyRotationFee(ctx sdk.Context) (res sdk.Coins) {
+	k.paramstore.Get(ctx, types.KeyKeyRotationFee, &res)
+	return
+}
+
+// MaxMaturitiesPerBlock - maximum number of mature unbonding delegations, and
+// of mature redelegations, completed per block
+func (k Keeper) MaxMaturitiesPerBlock(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyMaxMaturitiesPerBlock, &res)
+	return
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
 		k.UnbondingTime(ctx),
@@ -55,6 +101,13 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxEntries(ctx),
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
+		k.MinCommissionRate(ctx),
+		k.HistoricalRetentionTime(ctx),
+		k.GlobalLiquidStakingCap(ctx),
+		k.ValidatorLiquidStakingCap(ctx),
+		k.MinSelfDelegationGracePeriod(ctx),
+		k.KeyRotationFee(ctx),
+		k.MaxMaturitiesPerBlock(ctx),
 	)
 }
 
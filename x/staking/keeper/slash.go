@@ -56,7 +56,9 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	operatorAddress := validator.GetOperator()
 
 	// call the before-modification hook
-	k.BeforeValidatorModified(ctx, operatorAddress)
+	if err := k.BeforeValidatorModified(ctx, operatorAddress); err != nil {
+		panic(err)
+	}
 
 	// Track remaining slash amount for the validator
 	// This will decrease when we slash unbondings and
@@ -114,7 +116,9 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 			effectiveFraction = sdk.OneDec()
 		}
 		// call the before-slashed hook
-		k.BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction)
+		if err := k.BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction); err != nil {
+			panic(err)
+		}
 	}
 
 	// Deduct from validator's bonded tokens and update the validator.
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last created tokenize
+// share record
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last created tokenize share
+// record
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord gets the tokenize share record of the given id
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (types.TokenizeShareRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetTokenizeShareRecordKey(id))
+	if value == nil {
+		return types.TokenizeShareRecord{}, false
+	}
+
+	return types.MustUnmarshalTokenizeShareRecord(k.cdc, value), true
+}
+
+// SetTokenizeShareRecord sets a tokenize share record, indexed by its owner
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	// remove the owner index of the record being overwritten, if any
+	if prev, found := k.GetTokenizeShareRecord(ctx, record.Id); found {
+		store.Delete(types.GetTokenizeShareRecordIDByOwnerKey(prev.GetOwnerAddress(), prev.Id))
+	}
+
+	store.Set(types.GetTokenizeShareRecordKey(record.Id), types.MustMarshalTokenizeShareRecord(k.cdc, &record))
+	store.Set(types.GetTokenizeShareRecordIDByOwnerKey(record.GetOwnerAddress(), record.Id), []byte{})
+}
+
+// DeleteTokenizeShareRecord deletes the tokenize share record of the given id
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, id uint64) {
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerKey(record.GetOwnerAddress(), id))
+	store.Delete(types.GetTokenizeShareRecordKey(id))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records of the given
+// owner
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) []types.TokenizeShareRecord {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetTokenizeShareRecordIDsByOwnerPrefix(owner)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var records []types.TokenizeShareRecord
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(prefix):])
+		if record, found := k.GetTokenizeShareRecord(ctx, id); found {
+			records = append(records, record)
+		}
+	}
+
+	return records
+}
+
+// IterateTokenizeShareRecords iterates over the tokenize share records by id.
+// If the cb returns true, the iterator will close and stop.
+func (k Keeper) IterateTokenizeShareRecords(ctx sdk.Context, cb func(types.TokenizeShareRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.MustUnmarshalTokenizeShareRecord(k.cdc, iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) []types.TokenizeShareRecord {
+	var records []types.TokenizeShareRecord
+
+	k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
+
+// GetTotalLiquidStakedTokens returns the total amount of tokens tokenized into
+// liquid staking shares, i.e. the tokens of the tokenized shares of all the
+// validators, so that it follows their slashes
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorLiquidSharesKey)
+	defer iterator.Close()
+
+	tokens := sdk.ZeroDec()
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(types.AddressFromValidatorLiquidSharesKey(iterator.Key()))
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		var shares sdk.DecProto
+		k.cdc.MustUnmarshal(iterator.Value(), &shares)
+		tokens = tokens.Add(validator.TokensFromShares(shares.Dec))
+	}
+
+	return tokens.TruncateInt()
+}
+
+// GetValidatorLiquidShares returns the amount of delegator shares of a
+// validator tokenized into liquid staking shares
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshal(bz, &dp)
+
+	return dp.Dec
+}
+
+// SetValidatorLiquidShares sets the amount of delegator shares of a validator
+// tokenized into liquid staking shares, deleting it if zero
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if shares.IsZero() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: shares})
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
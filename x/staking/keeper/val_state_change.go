@@ -32,8 +32,11 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// unbond all mature validators from the unbonding queue
 	k.UnbondAllMatureValidators(ctx)
 
-	// Remove all mature unbonding delegations from the ubd queue.
-	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockHeader().Time)
+	// Remove the mature unbonding delegations from the ubd queue, up to the
+	// MaxMaturitiesPerBlock param. The remaining ones are completed in the
+	// next blocks.
+	maxMaturities := k.MaxMaturitiesPerBlock(ctx)
+	matureUnbonds := k.DequeueMatureUBDQueue(ctx, ctx.BlockHeader().Time, maxMaturities)
 	for _, dvPair := range matureUnbonds {
 		addr, err := sdk.ValAddressFromBech32(dvPair.ValidatorAddress)
 		if err != nil {
@@ -58,8 +61,9 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 		)
 	}
 
-	// Remove all mature redelegations from the red queue.
-	matureRedelegations := k.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockHeader().Time)
+	// Remove the mature redelegations from the red queue, up to the
+	// MaxMaturitiesPerBlock param.
+	matureRedelegations := k.DequeueMatureRedelegationQueue(ctx, ctx.BlockHeader().Time, maxMaturities)
 	for _, dvvTriplet := range matureRedelegations {
 		valSrcAddr, err := sdk.ValAddressFromBech32(dvvTriplet.ValidatorSrcAddress)
 		if err != nil {
@@ -124,6 +128,13 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		return nil, err
 	}
 
+	// Retrieve the consensus public keys known by Tendermint of the validators
+	// which rotated their key in this block.
+	rotatedPubKeys, err := k.getPendingConsPubKeyRotations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -173,12 +184,21 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		newPower := validator.ConsensusPower(powerReduction)
 		newPowerBytes := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: newPower})
 
-		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+		// update the validator set if power has changed, or replace the
+		// rotated consensus public key of a validator of the last set
+		oldPubKey, rotated := rotatedPubKeys[valAddrStr]
+		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) || rotated {
 			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
 
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
 		}
+		if found && rotated {
+			update, err := abciValidatorUpdateZero(oldPubKey)
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, update)
+		}
 
 		delete(last, valAddrStr)
 		count++
@@ -199,7 +219,17 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		}
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+
+		// remove the key known by Tendermint if it was rotated in this block
+		if oldPubKey, rotated := rotatedPubKeys[validator.OperatorAddress]; rotated {
+			update, err := abciValidatorUpdateZero(oldPubKey)
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, update)
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
 	}
 
 	// Update the pools based on the recent updates in the validator set:
@@ -301,9 +331,11 @@ func (k Keeper) bondValidator(ctx sdk.Context, validator types.Validator) (types
 	if err != nil {
 		return validator, err
 	}
-	k.AfterValidatorBonded(ctx, consAddr, validator.GetOperator())
+	if err := k.AfterValidatorBonded(ctx, consAddr, validator.GetOperator()); err != nil {
+		return validator, err
+	}
 
-	return validator, err
+	return validator, nil
 }
 
 // perform all the store operations for when a validator begins unbonding
@@ -336,7 +368,9 @@ func (k Keeper) beginUnbondingValidator(ctx sdk.Context, validator types.Validat
 	if err != nil {
 		return validator, err
 	}
-	k.AfterValidatorBeginUnbonding(ctx, consAddr, validator.GetOperator())
+	if err := k.AfterValidatorBeginUnbonding(ctx, consAddr, validator.GetOperator()); err != nil {
+		return validator, err
+	}
 
 	return validator, nil
 }
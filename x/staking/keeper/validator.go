@@ -146,12 +146,13 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
+// An error is returned if the AfterValidatorRemoved hook fails.
 // TODO, this function panics, and it's not good.
-func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
+func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) error {
 	// first retrieve the old validator record
 	validator, found := k.GetValidator(ctx, address)
 	if !found {
-		return
+		return nil
 	}
 
 	if !validator.IsUnbonded() {
@@ -172,9 +173,11 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorKey(address))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	store.Delete(types.GetMetadataVerificationKey(address))
+	k.deleteConsPubKeyRotations(ctx, address)
 
 	// call hooks
-	k.AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator())
+	return k.AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator())
 }
 
 // get groups of validators
@@ -437,7 +440,9 @@ func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
 
 				val = k.UnbondingToUnbonded(ctx, val)
 				if val.GetDelegatorShares().IsZero() {
-					k.RemoveValidator(ctx, val.GetOperator())
+					if err := k.RemoveValidator(ctx, val.GetOperator()); err != nil {
+						panic(err)
+					}
 				}
 			}
 
@@ -342,7 +342,7 @@ func TestValidatorBasics(t *testing.T) {
 
 	validators[1].Tokens = sdk.ZeroInt()                                // ...remove all tokens
 	app.StakingKeeper.SetValidator(ctx, validators[1])                  // ...set the validator
-	app.StakingKeeper.RemoveValidator(ctx, validators[1].GetOperator()) // Now it can be removed.
+	require.NoError(t, app.StakingKeeper.RemoveValidator(ctx, validators[1].GetOperator())) // Now it can be removed.
 	_, found = app.StakingKeeper.GetValidator(ctx, addrVals[1])
 	require.False(t, found)
 }
@@ -0,0 +1,263 @@
+package v046
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Setting the MinCommissionRate param to its default value, unless it was
+// already set, e.g. by the upgrade handler before running the migrations.
+// - Bumping the commission rates of the validators below the
+// MinCommissionRate up to it.
+// - Setting the HistoricalRetentionTime param to its default value, unless it
+// was already set.
+// - Indexing the stored HistoricalInfo entries by header time.
+// - Setting the GlobalLiquidStakingCap and ValidatorLiquidStakingCap params to
+// their default values, unless they were already set.
+// - Setting the MinSelfDelegationGracePeriod, KeyRotationFee and
+// MaxMaturitiesPerBlock params to their default values, unless they were
+// already set.
+// - Ordering the entries of the redelegations by completion time and
+// aggregating the entries created at the same height and maturing at the same
+// time.
+// - Splitting the timeslices of the unbonding and redelegation queues into
+// shards of at most QueueShardSize entries.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramSpace paramtypes.Subspace) error {
+	minRate := types.DefaultMinCommissionRate
+	if paramSpace.Has(ctx, types.KeyMinCommissionRate) {
+		paramSpace.Get(ctx, types.KeyMinCommissionRate, &minRate)
+	} else {
+		paramSpace.Set(ctx, types.KeyMinCommissionRate, minRate)
+	}
+
+	if !paramSpace.Has(ctx, types.KeyHistoricalRetentionTime) {
+		paramSpace.Set(ctx, types.KeyHistoricalRetentionTime, types.DefaultHistoricalRetentionTime)
+	}
+
+	if !paramSpace.Has(ctx, types.KeyGlobalLiquidStakingCap) {
+		paramSpace.Set(ctx, types.KeyGlobalLiquidStakingCap, types.DefaultGlobalLiquidStakingCap)
+	}
+
+	if !paramSpace.Has(ctx, types.KeyValidatorLiquidStakingCap) {
+		paramSpace.Set(ctx, types.KeyValidatorLiquidStakingCap, types.DefaultValidatorLiquidStakingCap)
+	}
+
+	if !paramSpace.Has(ctx, types.KeyMinSelfDelegationGracePeriod) {
+		paramSpace.Set(ctx, types.KeyMinSelfDelegationGracePeriod, types.DefaultMinSelfDelegationGracePeriod)
+	}
+
+	if !paramSpace.Has(ctx, types.KeyKeyRotationFee) {
+		paramSpace.Set(ctx, types.KeyKeyRotationFee, types.DefaultKeyRotationFee)
+	}
+
+	if !paramSpace.Has(ctx, types.KeyMaxMaturitiesPerBlock) {
+		paramSpace.Set(ctx, types.KeyMaxMaturitiesPerBlock, types.DefaultMaxMaturitiesPerBlock)
+	}
+
+	store := ctx.KVStore(storeKey)
+	if err := bumpCommissionRates(store, cdc, minRate); err != nil {
+		return err
+	}
+
+	if err := indexHistoricalInfoByTime(store, cdc); err != nil {
+		return err
+	}
+
+	if err := aggregateRedelegationEntries(store, cdc); err != nil {
+		return err
+	}
+
+	if err := shardUBDQueue(store, cdc); err != nil {
+		return err
+	}
+
+	return shardRedelegationQueue(store, cdc)
+}
+
+// shardUBDQueue moves the timeslices of the unbonding queue, stored under their
+// time key, to shards of at most QueueShardSize pairs.
+func shardUBDQueue(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iter := sdk.KVStorePrefixIterator(store, types.UnbondingQueueKey)
+	defer iter.Close()
+
+	var keys [][]byte
+	var timeslices []types.DVPairs
+	for ; iter.Valid(); iter.Next() {
+		var timeslice types.DVPairs
+		if err := cdc.Unmarshal(iter.Value(), &timeslice); err != nil {
+			return err
+		}
+
+		keys = append(keys, iter.Key())
+		timeslices = append(timeslices, timeslice)
+	}
+
+	for i, key := range keys {
+		completionTime, err := sdk.ParseTimeBytes(key[len(types.UnbondingQueueKey):])
+		if err != nil {
+			return err
+		}
+
+		store.Delete(key)
+		pairs := timeslices[i].Pairs
+		for shard := 0; shard*types.QueueShardSize < len(pairs); shard++ {
+			end := (shard + 1) * types.QueueShardSize
+			if end > len(pairs) {
+				end = len(pairs)
+			}
+
+			bz, err := cdc.Marshal(&types.DVPairs{Pairs: pairs[shard*types.QueueShardSize : end]})
+			if err != nil {
+				return err
+			}
+			store.Set(types.GetUnbondingDelegationQueueShardKey(completionTime, uint32(shard)), bz)
+		}
+	}
+
+	return nil
+}
+
+// shardRedelegationQueue moves the timeslices of the redelegation queue,
+// stored under their time key, to shards of at most QueueShardSize triplets.
+func shardRedelegationQueue(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iter := sdk.KVStorePrefixIterator(store, types.RedelegationQueueKey)
+	defer iter.Close()
+
+	var keys [][]byte
+	var timeslices []types.DVVTriplets
+	for ; iter.Valid(); iter.Next() {
+		var timeslice types.DVVTriplets
+		if err := cdc.Unmarshal(iter.Value(), &timeslice); err != nil {
+			return err
+		}
+
+		keys = append(keys, iter.Key())
+		timeslices = append(timeslices, timeslice)
+	}
+
+	for i, key := range keys {
+		completionTime, err := sdk.ParseTimeBytes(key[len(types.RedelegationQueueKey):])
+		if err != nil {
+			return err
+		}
+
+		store.Delete(key)
+		triplets := timeslices[i].Triplets
+		for shard := 0; shard*types.QueueShardSize < len(triplets); shard++ {
+			end := (shard + 1) * types.QueueShardSize
+			if end > len(triplets) {
+				end = len(triplets)
+			}
+
+			bz, err := cdc.Marshal(&types.DVVTriplets{Triplets: triplets[shard*types.QueueShardSize : end]})
+			if err != nil {
+				return err
+			}
+			store.Set(types.GetRedelegationQueueShardKey(completionTime, uint32(shard)), bz)
+		}
+	}
+
+	return nil
+}
+
+func aggregateRedelegationEntries(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iter := sdk.KVStorePrefixIterator(store, types.RedelegationKey)
+	defer iter.Close()
+
+	// redelegations are updated after iterating, as the store can't be
+	// written to while being iterated
+	var keys, values [][]byte
+	for ; iter.Valid(); iter.Next() {
+		var red types.Redelegation
+		if err := cdc.Unmarshal(iter.Value(), &red); err != nil {
+			return err
+		}
+
+		red.AggregateEntries()
+		bz, err := cdc.Marshal(&red)
+		if err != nil {
+			return err
+		}
+
+		keys = append(keys, iter.Key())
+		values = append(values, bz)
+	}
+
+	for i, key := range keys {
+		store.Set(key, values[i])
+	}
+
+	return nil
+}
+
+func indexHistoricalInfoByTime(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iter := sdk.KVStorePrefixIterator(store, types.HistoricalInfoKey)
+	defer iter.Close()
+
+	// the index is written after iterating, as the store can't be written to
+	// while being iterated
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		height, err := strconv.ParseInt(string(iter.Key()[len(types.HistoricalInfoKey):]), 10, 64)
+		if err != nil {
+			return err
+		}
+
+		var hi types.HistoricalInfo
+		if err := cdc.Unmarshal(iter.Value(), &hi); err != nil {
+			return err
+		}
+
+		keys = append(keys, types.GetHistoricalInfoByTimeKey(hi.Header.Time, height))
+	}
+
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+
+	return nil
+}
+
+func bumpCommissionRates(store sdk.KVStore, cdc codec.BinaryCodec, minRate sdk.Dec) error {
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+	defer iter.Close()
+
+	// validators are updated after iterating, as the store can't be written
+	// to while being iterated
+	var bumped []types.Validator
+	for ; iter.Valid(); iter.Next() {
+		var validator types.Validator
+		if err := cdc.Unmarshal(iter.Value(), &validator); err != nil {
+			return err
+		}
+
+		if validator.Commission.Rate.GTE(minRate) {
+			continue
+		}
+
+		validator.Commission.Rate = minRate
+		if validator.Commission.MaxRate.LT(minRate) {
+			validator.Commission.MaxRate = minRate
+		}
+		bumped = append(bumped, validator)
+	}
+
+	for _, validator := range bumped {
+		bz, err := cdc.Marshal(&validator)
+		if err != nil {
+			return err
+		}
+
+		store.Set(types.GetValidatorKey(validator.GetOperator()), bz)
+	}
+
+	return nil
+}
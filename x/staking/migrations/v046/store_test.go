@@ -0,0 +1,104 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	stakingKey := app.GetKey(types.StoreKey)
+	paramSpace := app.GetSubspace(types.ModuleName)
+
+	pks := simapp.CreateTestPubKeys(3)
+	addrs := simapp.ConvertAddrsToValAddrs(simapp.AddTestAddrs(app, ctx, 3, sdk.ZeroInt()))
+	rates := []types.CommissionRates{
+		types.NewCommissionRates(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 2)),
+		types.NewCommissionRates(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+		types.NewCommissionRates(sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+	}
+	for i, rate := range rates {
+		validator := teststaking.NewValidator(t, addrs[i], pks[i])
+		validator.Commission = types.NewCommission(rate.Rate, rate.MaxRate, rate.MaxChangeRate)
+		app.StakingKeeper.SetValidator(ctx, validator)
+	}
+
+	// historical entries stored before the migration aren't indexed by time
+	store := ctx.KVStore(stakingKey)
+	genTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	for height := int64(1); height <= 3; height++ {
+		header := tmproto.Header{Height: height, Time: genTime.Add(time.Duration(height) * time.Minute)}
+		hi := types.NewHistoricalInfo(header, types.Validators{}, sdk.DefaultPowerReduction)
+		store.Set(types.GetHistoricalInfoKey(height), app.AppCodec().MustMarshal(&hi))
+	}
+	// redelegation entries stored before the migration aren't ordered nor
+	// aggregated
+	delAddr := sdk.AccAddress(addrs[0])
+	red := types.NewRedelegation(delAddr, addrs[0], addrs[1], 2, genTime.Add(2*time.Hour), sdk.NewInt(10), sdk.NewDec(10))
+	red.Entries = append(red.Entries,
+		types.NewRedelegationEntry(1, genTime.Add(time.Hour), sdk.NewInt(5), sdk.NewDec(5)),
+		types.NewRedelegationEntry(2, genTime.Add(2*time.Hour), sdk.NewInt(20), sdk.NewDec(20)),
+	)
+	app.StakingKeeper.SetRedelegation(ctx, red)
+
+	// queue timeslices stored before the migration aren't sharded
+	pairs := make([]types.DVPair, types.QueueShardSize+1)
+	for i := range pairs {
+		pairs[i] = types.DVPair{DelegatorAddress: delAddr.String(), ValidatorAddress: addrs[0].String()}
+	}
+	store.Set(types.GetUnbondingDelegationTimeKey(genTime), app.AppCodec().MustMarshal(&types.DVPairs{Pairs: pairs}))
+	triplets := []types.DVVTriplet{{DelegatorAddress: delAddr.String(), ValidatorSrcAddress: addrs[0].String(), ValidatorDstAddress: addrs[1].String()}}
+	store.Set(types.GetRedelegationTimeKey(genTime), app.AppCodec().MustMarshal(&types.DVVTriplets{Triplets: triplets}))
+
+	// the min commission rate set by an upgrade handler is kept
+	minRate := sdk.NewDecWithPrec(5, 2)
+	paramSpace.Set(ctx, types.KeyMinCommissionRate, minRate)
+
+	require.NoError(t, v046.MigrateStore(ctx, stakingKey, app.AppCodec(), paramSpace))
+	require.Equal(t, minRate, app.StakingKeeper.MinCommissionRate(ctx))
+	require.Equal(t, types.DefaultHistoricalRetentionTime, app.StakingKeeper.HistoricalRetentionTime(ctx))
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, app.StakingKeeper.GlobalLiquidStakingCap(ctx))
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, app.StakingKeeper.ValidatorLiquidStakingCap(ctx))
+	require.Equal(t, types.DefaultMinSelfDelegationGracePeriod, app.StakingKeeper.MinSelfDelegationGracePeriod(ctx))
+	require.Equal(t, types.DefaultKeyRotationFee, app.StakingKeeper.KeyRotationFee(ctx))
+	require.Equal(t, types.DefaultMaxMaturitiesPerBlock, app.StakingKeeper.MaxMaturitiesPerBlock(ctx))
+
+	height, hi, found := app.StakingKeeper.GetHistoricalInfoByTime(ctx, genTime.Add(2*time.Minute))
+	require.True(t, found)
+	require.Equal(t, int64(2), height)
+	require.Equal(t, int64(2), hi.Header.Height)
+
+	red, found = app.StakingKeeper.GetRedelegation(ctx, delAddr, addrs[0], addrs[1])
+	require.True(t, found)
+	require.Equal(t, []types.RedelegationEntry{
+		types.NewRedelegationEntry(1, genTime.Add(time.Hour), sdk.NewInt(5), sdk.NewDec(5)),
+		types.NewRedelegationEntry(2, genTime.Add(2*time.Hour), sdk.NewInt(30), sdk.NewDec(30)),
+	}, red.Entries)
+
+	require.False(t, store.Has(types.GetUnbondingDelegationTimeKey(genTime)))
+	require.True(t, store.Has(types.GetUnbondingDelegationQueueShardKey(genTime, 1)))
+	require.Equal(t, pairs, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, genTime))
+	require.False(t, store.Has(types.GetRedelegationTimeKey(genTime)))
+	require.Equal(t, triplets, app.StakingKeeper.GetRedelegationQueueTimeSlice(ctx, genTime))
+
+	expected := []types.CommissionRates{
+		types.NewCommissionRates(minRate, minRate, sdk.NewDecWithPrec(1, 2)),
+		types.NewCommissionRates(minRate, sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+		rates[2],
+	}
+	for i, rate := range expected {
+		validator, found := app.StakingKeeper.GetValidator(ctx, addrs[i])
+		require.True(t, found)
+		require.Equal(t, rate, validator.Commission.CommissionRates)
+	}
+}
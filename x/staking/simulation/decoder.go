@@ -57,6 +57,20 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &redB)
 
 			return fmt.Sprintf("%v\n%v", redA, redB)
+		case bytes.Equal(kvA.Key[:1], types.MetadataVerificationKey):
+			var verificationA, verificationB types.MetadataVerification
+
+			cdc.MustUnmarshal(kvA.Value, &verificationA)
+			cdc.MustUnmarshal(kvB.Value, &verificationB)
+
+			return fmt.Sprintf("%v\n%v", verificationA, verificationB)
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordKey):
+			var recordA, recordB types.TokenizeShareRecord
+
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
@@ -63,7 +63,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom)
+	params := types.NewParams(
+		simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, types.DefaultMinCommissionRate,
+		types.DefaultHistoricalRetentionTime, types.DefaultGlobalLiquidStakingCap, types.DefaultValidatorLiquidStakingCap,
+		types.DefaultMinSelfDelegationGracePeriod, types.DefaultKeyRotationFee, types.DefaultMaxMaturitiesPerBlock,
+	)
 
 	// validators & delegations
 	var (
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = ConsPubKeyRotationHistory{}
+
+// NewConsPubKeyRotationHistory creates a new ConsPubKeyRotationHistory
+// instance recording the rotation of the consensus public key of a validator.
+//nolint:interfacer
+func NewConsPubKeyRotationHistory(
+	valAddr sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey, height int64, time time.Time, fee sdk.Coins,
+) (ConsPubKeyRotationHistory, error) {
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+
+	return ConsPubKeyRotationHistory{
+		OperatorAddress: valAddr.String(),
+		OldConsPubkey:   oldPkAny,
+		NewConsPubkey:   newPkAny,
+		Height:          height,
+		Time:            time,
+		Fee:             fee,
+	}, nil
+}
+
+// GetOperator returns the operator address of the rotated validator.
+func (h ConsPubKeyRotationHistory) GetOperator() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(h.OperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// OldConsPubKey returns the consensus public key of the validator before the
+// rotation.
+func (h ConsPubKeyRotationHistory) OldConsPubKey() (cryptotypes.PubKey, error) {
+	return unpackConsPubKey(h.OldConsPubkey)
+}
+
+// NewConsPubKey returns the consensus public key of the validator after the
+// rotation.
+func (h ConsPubKeyRotationHistory) NewConsPubKey() (cryptotypes.PubKey, error) {
+	return unpackConsPubKey(h.NewConsPubkey)
+}
+
+// Validate performs a stateless validation of the rotation.
+func (h ConsPubKeyRotationHistory) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(h.OperatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid operator address: %s", err)
+	}
+
+	oldPubKey, err := h.OldConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	newPubKey, err := h.NewConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	if oldPubKey.Equals(newPubKey) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "consensus public key rotated to itself")
+	}
+
+	if h.Height < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "negative rotation height %d", h.Height)
+	}
+
+	return h.Fee.Validate()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (h ConsPubKeyRotationHistory) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(h.OldConsPubkey, &pk); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(h.NewConsPubkey, &pk)
+}
+
+func unpackConsPubKey(pkAny *codectypes.Any) (cryptotypes.PubKey, error) {
+	if pkAny == nil {
+		return nil, ErrEmptyValidatorPubKey
+	}
+
+	pk, ok := pkAny.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pkAny.GetCachedValue())
+	}
+
+	return pk, nil
+}
+
+// MustMarshalConsPubKeyRotationHistory returns the binary encoding of a
+// consensus public key rotation or panics
+func MustMarshalConsPubKeyRotationHistory(cdc codec.BinaryCodec, history *ConsPubKeyRotationHistory) []byte {
+	return cdc.MustMarshal(history)
+}
+
+// MustUnmarshalConsPubKeyRotationHistory unmarshals a consensus public key
+// rotation from a store value or panics
+func MustUnmarshalConsPubKeyRotationHistory(cdc codec.BinaryCodec, value []byte) ConsPubKeyRotationHistory {
+	var history ConsPubKeyRotationHistory
+	cdc.MustUnmarshal(value, &history)
+	return history
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -222,10 +223,51 @@ func NewRedelegation(
 	}
 }
 
-// AddEntry - append entry to the unbonding delegation
+// AddEntry - add entry to the redelegation, keeping the entries ordered by
+// completion time. An entry created at the same height and maturing at the
+// same time as an existing entry is aggregated into it.
 func (red *Redelegation) AddEntry(creationHeight int64, minTime time.Time, balance sdk.Int, sharesDst sdk.Dec) {
+	if i, found := red.entryIndex(creationHeight, minTime); found {
+		red.Entries[i].InitialBalance = red.Entries[i].InitialBalance.Add(balance)
+		red.Entries[i].SharesDst = red.Entries[i].SharesDst.Add(sharesDst)
+		return
+	}
+
+	i := sort.Search(len(red.Entries), func(i int) bool {
+		return red.Entries[i].CompletionTime.After(minTime)
+	})
 	entry := NewRedelegationEntry(creationHeight, minTime, balance, sharesDst)
-	red.Entries = append(red.Entries, entry)
+	red.Entries = append(red.Entries, RedelegationEntry{})
+	copy(red.Entries[i+1:], red.Entries[i:])
+	red.Entries[i] = entry
+}
+
+// CanAggregateEntry returns true if an entry created at the given height and
+// maturing at the given time would be aggregated into an existing entry of the
+// redelegation, rather than added to its entries.
+func (red Redelegation) CanAggregateEntry(creationHeight int64, minTime time.Time) bool {
+	_, found := red.entryIndex(creationHeight, minTime)
+	return found
+}
+
+// AggregateEntries orders the entries of the redelegation by completion time
+// and aggregates the entries created at the same height and maturing at the
+// same time.
+func (red *Redelegation) AggregateEntries() {
+	entries := red.Entries
+	red.Entries = make([]RedelegationEntry, 0, len(entries))
+	for _, entry := range entries {
+		red.AddEntry(entry.CreationHeight, entry.CompletionTime, entry.InitialBalance, entry.SharesDst)
+	}
+}
+
+func (red Redelegation) entryIndex(creationHeight int64, minTime time.Time) (int, bool) {
+	for i, entry := range red.Entries {
+		if entry.CreationHeight == creationHeight && entry.CompletionTime.Equal(minTime) {
+			return i, true
+		}
+	}
+	return 0, false
 }
 
 // RemoveEntry - remove entry at index i to the unbonding delegation
@@ -79,6 +79,37 @@ func TestRedelegationString(t *testing.T) {
 	require.NotEmpty(t, r.String())
 }
 
+func TestRedelegationAddEntry(t *testing.T) {
+	r := types.NewRedelegation(sdk.AccAddress(valAddr1), valAddr2, valAddr3, 2,
+		time.Unix(20, 0), sdk.NewInt(10), sdk.NewDec(10))
+
+	// entries are ordered by completion time
+	r.AddEntry(1, time.Unix(10, 0), sdk.NewInt(5), sdk.NewDec(5))
+	r.AddEntry(3, time.Unix(30, 0), sdk.NewInt(5), sdk.NewDec(5))
+	require.Len(t, r.Entries, 3)
+	require.Equal(t, int64(1), r.Entries[0].CreationHeight)
+	require.Equal(t, int64(2), r.Entries[1].CreationHeight)
+	require.Equal(t, int64(3), r.Entries[2].CreationHeight)
+
+	// an entry of the same height and completion time is aggregated
+	require.True(t, r.CanAggregateEntry(2, time.Unix(20, 0)))
+	require.False(t, r.CanAggregateEntry(2, time.Unix(30, 0)))
+	r.AddEntry(2, time.Unix(20, 0), sdk.NewInt(15), sdk.NewDec(20))
+	require.Len(t, r.Entries, 3)
+	require.Equal(t, sdk.NewInt(25), r.Entries[1].InitialBalance)
+	require.Equal(t, sdk.NewDec(30), r.Entries[1].SharesDst)
+
+	r2 := types.NewRedelegation(sdk.AccAddress(valAddr1), valAddr2, valAddr3, 3,
+		time.Unix(30, 0), sdk.NewInt(5), sdk.NewDec(5))
+	r2.Entries = append(r2.Entries,
+		types.NewRedelegationEntry(2, time.Unix(20, 0), sdk.NewInt(10), sdk.NewDec(10)),
+		types.NewRedelegationEntry(1, time.Unix(10, 0), sdk.NewInt(5), sdk.NewDec(5)),
+		types.NewRedelegationEntry(2, time.Unix(20, 0), sdk.NewInt(15), sdk.NewDec(20)),
+	)
+	r2.AggregateEntries()
+	require.Equal(t, r.Entries, r2.Entries)
+}
+
 func TestDelegationResponses(t *testing.T) {
 	cdc := codec.NewLegacyAmino()
 	dr1 := types.NewDelegationResp(sdk.AccAddress(valAddr1), valAddr2, sdk.NewDec(5),
@@ -2,21 +2,36 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding    = "complete_unbonding"
-	EventTypeCompleteRedelegation = "complete_redelegation"
-	EventTypeCreateValidator      = "create_validator"
-	EventTypeEditValidator        = "edit_validator"
-	EventTypeDelegate             = "delegate"
-	EventTypeUnbond               = "unbond"
-	EventTypeRedelegate           = "redelegate"
+	EventTypeCompleteUnbonding       = "complete_unbonding"
+	EventTypeCompleteRedelegation    = "complete_redelegation"
+	EventTypeCreateValidator         = "create_validator"
+	EventTypeEditValidator           = "edit_validator"
+	EventTypeSetMetadataVerification = "set_metadata_verification"
+	EventTypeDelegate                = "delegate"
+	EventTypeUnbond                  = "unbond"
+	EventTypeRedelegate              = "redelegate"
+	EventTypeTokenizeShares          = "tokenize_shares"
+	EventTypeRedeemShares            = "redeem_shares"
+	EventTypeMinSelfDelegationBreach = "min_self_delegation_breach"
+	EventTypeMinSelfDelegationJail   = "min_self_delegation_jail"
+	EventTypeRotateConsPubKey        = "rotate_cons_pubkey"
 
-	AttributeKeyValidator         = "validator"
-	AttributeKeyCommissionRate    = "commission_rate"
-	AttributeKeyMinSelfDelegation = "min_self_delegation"
-	AttributeKeySrcValidator      = "source_validator"
-	AttributeKeyDstValidator      = "destination_validator"
-	AttributeKeyDelegator         = "delegator"
-	AttributeKeyCompletionTime    = "completion_time"
-	AttributeKeyNewShares         = "new_shares"
-	AttributeValueCategory        = ModuleName
+	AttributeKeyValidator               = "validator"
+	AttributeKeyCommissionRate          = "commission_rate"
+	AttributeKeyMinSelfDelegation       = "min_self_delegation"
+	AttributeKeySrcValidator            = "source_validator"
+	AttributeKeyDstValidator            = "destination_validator"
+	AttributeKeyDelegator               = "delegator"
+	AttributeKeyCompletionTime          = "completion_time"
+	AttributeKeyNewShares               = "new_shares"
+	AttributeKeyWebsiteVerified         = "website_verified"
+	AttributeKeySecurityContactVerified = "security_contact_verified"
+	AttributeKeyShareOwner              = "share_owner"
+	AttributeKeyShareRecordID           = "share_record_id"
+	AttributeKeySelfDelegation          = "self_delegation"
+	AttributeKeyJailTime                = "jail_time"
+	AttributeKeyOldConsAddress          = "old_cons_address"
+	AttributeKeyNewConsAddress          = "new_cons_address"
+	AttributeKeyFee                     = "fee"
+	AttributeValueCategory              = ModuleName
 )
@@ -44,6 +44,17 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// metadata_verifications defines the attested verifications of the
+	// validators' metadata at genesis.
+	MetadataVerifications []ValidatorMetadataVerification `protobuf:"bytes,9,rep,name=metadata_verifications,json=metadataVerifications,proto3" json:"metadata_verifications"`
+	// tokenize_share_records defines the tokenize share records at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,10,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last created tokenize
+	// share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,11,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// cons_pub_key_rotation_history defines the rotations of the validators'
+	// consensus public keys at genesis.
+	ConsPubKeyRotationHistory []ConsPubKeyRotationHistory `protobuf:"bytes,12,rep,name=cons_pub_key_rotation_history,json=consPubKeyRotationHistory,proto3" json:"cons_pub_key_rotation_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -128,6 +139,75 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetMetadataVerifications() []ValidatorMetadataVerification {
+	if m != nil {
+		return m.MetadataVerifications
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetConsPubKeyRotationHistory() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.ConsPubKeyRotationHistory
+	}
+	return nil
+}
+
+// ValidatorMetadataVerification is the verification of the metadata of a
+// validator, used in genesis state.
+type ValidatorMetadataVerification struct {
+	// validator_address is the address of the validator operator.
+	ValidatorAddress string               `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Verification     MetadataVerification `protobuf:"bytes,2,opt,name=verification,proto3" json:"verification"`
+}
+
+func (m *ValidatorMetadataVerification) Reset()         { *m = ValidatorMetadataVerification{} }
+func (m *ValidatorMetadataVerification) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMetadataVerification) ProtoMessage()    {}
+func (*ValidatorMetadataVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b3dec8894f2831b, []int{1}
+}
+func (m *ValidatorMetadataVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMetadataVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMetadataVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorMetadataVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMetadataVerification.Merge(m, src)
+}
+func (m *ValidatorMetadataVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMetadataVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMetadataVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMetadataVerification proto.InternalMessageInfo
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -140,7 +220,7 @@ func (m *LastValidatorPower) Reset()         { *m = LastValidatorPower{} }
 func (m *LastValidatorPower) String() string { return proto.CompactTextString(m) }
 func (*LastValidatorPower) ProtoMessage()    {}
 func (*LastValidatorPower) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b3dec8894f2831b, []int{1}
+	return fileDescriptor_9b3dec8894f2831b, []int{2}
 }
 func (m *LastValidatorPower) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,6 +251,7 @@ var xxx_messageInfo_LastValidatorPower proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.staking.v1beta1.GenesisState")
+	proto.RegisterType((*ValidatorMetadataVerification)(nil), "cosmos.staking.v1beta1.ValidatorMetadataVerification")
 	proto.RegisterType((*LastValidatorPower)(nil), "cosmos.staking.v1beta1.LastValidatorPower")
 }
 
@@ -179,38 +260,49 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0x6d, 0x92, 0xa6, 0x61, 0x52, 0x10, 0x1a, 0x52, 0x64, 0xb2, 0x70, 0x42, 0x54, 0xa1,
-	0x08, 0xa8, 0xad, 0x86, 0x1d, 0x62, 0x43, 0x84, 0xa8, 0x40, 0x2c, 0x22, 0x17, 0x10, 0x62, 0x13,
-	0x4d, 0xea, 0xc1, 0xb5, 0xea, 0x78, 0xac, 0x79, 0x2f, 0xa5, 0xdc, 0x80, 0x25, 0x47, 0xe8, 0x21,
-	0x38, 0x44, 0x97, 0x15, 0x2b, 0xc4, 0xa2, 0x42, 0xc9, 0x86, 0x1b, 0xb0, 0x45, 0x9e, 0x99, 0x18,
-	0xa3, 0x60, 0x56, 0xc9, 0xe8, 0x7d, 0xff, 0x37, 0xbf, 0xe4, 0x37, 0x64, 0xe7, 0x50, 0xc0, 0x4c,
-	0x80, 0x0f, 0xc8, 0x8e, 0xe3, 0x34, 0xf2, 0x4f, 0xf6, 0xa6, 0x1c, 0xd9, 0x9e, 0x1f, 0xf1, 0x94,
-	0x43, 0x0c, 0x5e, 0x26, 0x05, 0x0a, 0x7a, 0x4b, 0x53, 0x9e, 0xa1, 0x3c, 0x43, 0x75, 0xda, 0x91,
-	0x88, 0x84, 0x42, 0xfc, 0xfc, 0x9f, 0xa6, 0x3b, 0x55, 0xce, 0x55, 0x5a, 0x53, 0xb7, 0x35, 0x35,
-	0xd1, 0x71, 0x73, 0x81, 0x3a, 0xf4, 0x7f, 0xd5, 0xc9, 0xd6, 0xbe, 0x2e, 0x70, 0x80, 0x0c, 0x39,
-	0x7d, 0x4c, 0x1a, 0x19, 0x93, 0x6c, 0x06, 0x8e, 0xdd, 0xb3, 0x07, 0xad, 0xa1, 0xeb, 0xfd, 0xbb,
-	0x90, 0x37, 0x56, 0xd4, 0xa8, 0x7e, 0x7e, 0xd9, 0xb5, 0x02, 0x93, 0xa1, 0x6f, 0xc9, 0x8d, 0x84,
-	0x01, 0x4e, 0x50, 0x20, 0x4b, 0x26, 0x99, 0xf8, 0xc0, 0xa5, 0x73, 0xa5, 0x67, 0x0f, 0xb6, 0x46,
-	0x5e, 0xce, 0x7d, 0xbf, 0xec, 0xde, 0x8d, 0x62, 0x3c, 0x9a, 0x4f, 0xbd, 0x43, 0x31, 0x33, 0x4d,
-	0xcc, 0xcf, 0x2e, 0x84, 0xc7, 0x3e, 0x7e, 0xcc, 0x38, 0x78, 0xcf, 0x53, 0x0c, 0xae, 0xe7, 0x9e,
-	0x57, 0xb9, 0x66, 0x9c, 0x5b, 0x68, 0x48, 0xb6, 0x95, 0xf9, 0x84, 0x25, 0x71, 0xc8, 0x50, 0x48,
-	0x6d, 0x07, 0xa7, 0xd6, 0xab, 0x0d, 0x5a, 0xc3, 0x7b, 0x55, 0x35, 0x5f, 0x32, 0xc0, 0x37, 0xab,
-	0x8c, 0x52, 0x99, 0xca, 0x37, 0x93, 0xb5, 0x09, 0xd0, 0x7d, 0x42, 0x8a, 0x0b, 0xc0, 0xa9, 0x2b,
-	0xf5, 0x9d, 0x2a, 0x75, 0x11, 0x36, 0xc6, 0x52, 0x94, 0xbe, 0x20, 0xad, 0x90, 0x27, 0x3c, 0x62,
-	0x18, 0x8b, 0x14, 0x9c, 0x0d, 0x65, 0xea, 0x57, 0x99, 0x9e, 0x16, 0xa8, 0x51, 0x95, 0xc3, 0xf4,
-	0x3d, 0xd9, 0x9e, 0xa7, 0x53, 0x91, 0x86, 0x71, 0x1a, 0x4d, 0xca, 0xd6, 0x86, 0xb2, 0xde, 0xaf,
-	0xb2, 0xbe, 0x5e, 0x85, 0xd6, 0xf4, 0xed, 0xf9, 0xfa, 0x08, 0xe8, 0x98, 0x5c, 0x93, 0xbc, 0xec,
-	0xdf, 0x54, 0xfe, 0x9d, 0x2a, 0x7f, 0x50, 0x82, 0x8d, 0xf8, 0x6f, 0x01, 0xed, 0x90, 0x26, 0x3f,
-	0xcd, 0x84, 0x44, 0x1e, 0x3a, 0xcd, 0x9e, 0x3d, 0x68, 0x06, 0xc5, 0xb9, 0x7f, 0x44, 0xe8, 0xfa,
-	0xb7, 0xa1, 0x43, 0xb2, 0xc9, 0xc2, 0x50, 0x72, 0xd0, 0xfb, 0x77, 0x75, 0xe4, 0x7c, 0xfd, 0xb2,
-	0xdb, 0x36, 0x05, 0x9e, 0xe8, 0xc9, 0x01, 0xca, 0x38, 0x8d, 0x82, 0x15, 0x48, 0xdb, 0x64, 0xe3,
-	0xcf, 0xa6, 0xd5, 0x02, 0x7d, 0x78, 0xd4, 0xfc, 0x74, 0xd6, 0xb5, 0x7e, 0x9e, 0x75, 0xad, 0xd1,
-	0xb3, 0xf3, 0x85, 0x6b, 0x5f, 0x2c, 0x5c, 0xfb, 0xc7, 0xc2, 0xb5, 0x3f, 0x2f, 0x5d, 0xeb, 0x62,
-	0xe9, 0x5a, 0xdf, 0x96, 0xae, 0xf5, 0xee, 0xc1, 0x7f, 0x97, 0xf1, 0xb4, 0x78, 0x56, 0x6a, 0x2d,
-	0xa7, 0x0d, 0xf5, 0x64, 0x1e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x73, 0xbd, 0x93, 0xb3, 0xc9,
-	0x03, 0x00, 0x00,
+	// 668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcb, 0x6e, 0x13, 0x31,
+	0x14, 0x86, 0x33, 0xf4, 0x96, 0x3a, 0x01, 0x15, 0x93, 0x56, 0xd3, 0x4a, 0x9d, 0x84, 0xa8, 0x42,
+	0x11, 0xb4, 0x13, 0x35, 0x88, 0x0d, 0x62, 0x01, 0xe1, 0x52, 0xca, 0x45, 0x8a, 0xa6, 0xa5, 0x42,
+	0x6c, 0x2c, 0x27, 0x76, 0x27, 0x56, 0x92, 0x71, 0x64, 0x3b, 0xa5, 0xe1, 0x09, 0x58, 0xc2, 0x1b,
+	0xf4, 0x21, 0x78, 0x88, 0x2e, 0x2b, 0x56, 0x88, 0x45, 0x85, 0xda, 0x0d, 0x8f, 0x81, 0x62, 0x3b,
+	0x69, 0xca, 0x74, 0x02, 0xab, 0xc4, 0x39, 0xff, 0xff, 0x9d, 0x7f, 0xce, 0xc4, 0x07, 0xac, 0x35,
+	0xb8, 0xec, 0x70, 0x59, 0x96, 0x0a, 0xb7, 0x58, 0x14, 0x96, 0x0f, 0x36, 0xeb, 0x54, 0xe1, 0xcd,
+	0x72, 0x48, 0x23, 0x2a, 0x99, 0xf4, 0xbb, 0x82, 0x2b, 0x0e, 0x97, 0x8c, 0xca, 0xb7, 0x2a, 0xdf,
+	0xaa, 0x56, 0x72, 0x21, 0x0f, 0xb9, 0x96, 0x94, 0x07, 0xdf, 0x8c, 0x7a, 0x25, 0x89, 0x39, 0x74,
+	0x1b, 0xd5, 0xb2, 0x51, 0x21, 0x63, 0xb7, 0x0d, 0xf4, 0xa1, 0xf8, 0x35, 0x0d, 0xb2, 0x5b, 0x26,
+	0xc0, 0x8e, 0xc2, 0x8a, 0xc2, 0x47, 0x60, 0xb6, 0x8b, 0x05, 0xee, 0x48, 0xd7, 0x29, 0x38, 0xa5,
+	0x4c, 0xc5, 0xf3, 0xaf, 0x0e, 0xe4, 0xd7, 0xb4, 0xaa, 0x3a, 0x7d, 0x7c, 0x9a, 0x4f, 0x05, 0xd6,
+	0x03, 0xdf, 0x83, 0x85, 0x36, 0x96, 0x0a, 0x29, 0xae, 0x70, 0x1b, 0x75, 0xf9, 0x47, 0x2a, 0xdc,
+	0x6b, 0x05, 0xa7, 0x94, 0xad, 0xfa, 0x03, 0xdd, 0xcf, 0xd3, 0xfc, 0x9d, 0x90, 0xa9, 0x66, 0xaf,
+	0xee, 0x37, 0x78, 0xc7, 0x26, 0xb1, 0x1f, 0x1b, 0x92, 0xb4, 0xca, 0xaa, 0xdf, 0xa5, 0xd2, 0xdf,
+	0x8e, 0x54, 0x70, 0x63, 0xc0, 0xd9, 0x1d, 0x60, 0x6a, 0x03, 0x0a, 0x24, 0x60, 0x51, 0x93, 0x0f,
+	0x70, 0x9b, 0x11, 0xac, 0xb8, 0x30, 0x74, 0xe9, 0x4e, 0x15, 0xa6, 0x4a, 0x99, 0xca, 0xdd, 0xa4,
+	0x98, 0x6f, 0xb0, 0x54, 0x7b, 0x43, 0x8f, 0x46, 0xd9, 0xc8, 0xb7, 0xda, 0xb1, 0x8a, 0x84, 0x5b,
+	0x00, 0x8c, 0x1a, 0x48, 0x77, 0x5a, 0xa3, 0x6f, 0x27, 0xa1, 0x47, 0x66, 0x4b, 0x1c, 0xb3, 0xc2,
+	0x57, 0x20, 0x43, 0x68, 0x9b, 0x86, 0x58, 0x31, 0x1e, 0x49, 0x77, 0x46, 0x93, 0x8a, 0x49, 0xa4,
+	0x67, 0x23, 0xa9, 0x45, 0x8d, 0x9b, 0xe1, 0x3e, 0x58, 0xec, 0x45, 0x75, 0x1e, 0x11, 0x16, 0x85,
+	0x68, 0x9c, 0x3a, 0xab, 0xa9, 0xf7, 0x92, 0xa8, 0xef, 0x86, 0xa6, 0x18, 0x3e, 0xd7, 0x8b, 0x97,
+	0x24, 0xac, 0x81, 0xeb, 0x82, 0x8e, 0xf3, 0xe7, 0x34, 0x7f, 0x2d, 0x89, 0x1f, 0x50, 0xf2, 0x37,
+	0xf8, 0x32, 0x00, 0xae, 0x80, 0x34, 0x3d, 0xec, 0x72, 0xa1, 0x28, 0x71, 0xd3, 0x05, 0xa7, 0x94,
+	0x0e, 0x46, 0x67, 0x28, 0xc0, 0x52, 0x87, 0x2a, 0x4c, 0xb0, 0xc2, 0xe8, 0x80, 0x0a, 0xb6, 0xcf,
+	0x1a, 0xb6, 0xed, 0xbc, 0x6e, 0xfb, 0xe0, 0x9f, 0x63, 0x7f, 0x6b, 0xed, 0x7b, 0x63, 0x6e, 0x9b,
+	0x63, 0xb1, 0x73, 0x45, 0x4d, 0xc2, 0x10, 0x2c, 0x29, 0xde, 0xa2, 0x11, 0xfb, 0x44, 0x91, 0x6c,
+	0x62, 0x41, 0x91, 0xa0, 0x0d, 0x2e, 0x88, 0x74, 0xc1, 0xe4, 0x51, 0xee, 0x5a, 0xd7, 0xce, 0xc0,
+	0x14, 0x68, 0xcf, 0x70, 0x94, 0x2a, 0x5e, 0x92, 0xf0, 0x31, 0x58, 0xb5, 0xf7, 0xe0, 0x8a, 0x6e,
+	0x88, 0x11, 0x37, 0x53, 0x70, 0x4a, 0xd3, 0xc1, 0xb2, 0xf9, 0x93, 0xc7, 0x00, 0xdb, 0x04, 0xf6,
+	0xc1, 0x6a, 0x83, 0x47, 0x12, 0x75, 0x7b, 0x75, 0xd4, 0xa2, 0x7d, 0x24, 0xb8, 0xd2, 0x0f, 0x81,
+	0x9a, 0x4c, 0x2a, 0x2e, 0xfa, 0x6e, 0x56, 0x27, 0xde, 0x4c, 0x4a, 0xfc, 0x94, 0x47, 0xb2, 0xd6,
+	0xab, 0xbf, 0xa6, 0xfd, 0xc0, 0x3a, 0x5f, 0x1a, 0xa3, 0xcd, 0xbd, 0xdc, 0x48, 0x12, 0x14, 0x8f,
+	0x1d, 0xb0, 0x3a, 0x71, 0xc8, 0xf0, 0x39, 0xb8, 0x79, 0x71, 0x0f, 0x31, 0x21, 0x82, 0x4a, 0xb3,
+	0x2f, 0xe6, 0xab, 0xee, 0xf7, 0x6f, 0x1b, 0x39, 0x9b, 0xe9, 0x89, 0xa9, 0xec, 0x28, 0xc1, 0xa2,
+	0x30, 0x58, 0x18, 0x59, 0xec, 0xef, 0x70, 0x0f, 0x64, 0xc7, 0xdf, 0xbc, 0xde, 0x14, 0x99, 0xca,
+	0x7a, 0xd2, 0x23, 0x4d, 0x78, 0xdf, 0x97, 0x38, 0x0f, 0xd3, 0x9f, 0x8f, 0xf2, 0xa9, 0xdf, 0x47,
+	0xf9, 0x54, 0xb1, 0x09, 0x60, 0x7c, 0x01, 0xc0, 0x0a, 0x98, 0xfb, 0xdf, 0xd0, 0x43, 0x21, 0xcc,
+	0x81, 0x99, 0x8b, 0x75, 0x36, 0x15, 0x98, 0xc3, 0x45, 0xa7, 0xea, 0x8b, 0xe3, 0x33, 0xcf, 0x39,
+	0x39, 0xf3, 0x9c, 0x5f, 0x67, 0x9e, 0xf3, 0xe5, 0xdc, 0x4b, 0x9d, 0x9c, 0x7b, 0xa9, 0x1f, 0xe7,
+	0x5e, 0xea, 0xc3, 0xfa, 0xc4, 0x8d, 0x77, 0x38, 0xda, 0xdd, 0x7a, 0xf7, 0xd5, 0x67, 0xf5, 0x5e,
+	0xbe, 0xff, 0x67, 0x00, 0x76, 0xa0, 0x5b, 0xef, 0x2e, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -233,6 +325,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsPubKeyRotationHistory) > 0 {
+		for iNdEx := len(m.ConsPubKeyRotationHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubKeyRotationHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.MetadataVerifications) > 0 {
+		for iNdEx := len(m.MetadataVerifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MetadataVerifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -336,6 +475,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorMetadataVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMetadataVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMetadataVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LastValidatorPower) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -425,6 +604,42 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.MetadataVerifications) > 0 {
+		for _, e := range m.MetadataVerifications {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	if len(m.ConsPubKeyRotationHistory) > 0 {
+		for _, e := range m.ConsPubKeyRotationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorMetadataVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Verification.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -735,6 +950,242 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataVerifications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataVerifications = append(m.MetadataVerifications, ValidatorMetadataVerification{})
+			if err := m.MetadataVerifications[len(m.MetadataVerifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubKeyRotationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubKeyRotationHistory = append(m.ConsPubKeyRotationHistory, ConsPubKeyRotationHistory{})
+			if err := m.ConsPubKeyRotationHistory[len(m.ConsPubKeyRotationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorMetadataVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorMetadataVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorMetadataVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,10 +1,17 @@
 package types
 
 import (
+	"fmt"
+	"sort"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// combine multiple staking hooks, all hook functions are run in array sequence
+// combine multiple staking hooks, all hook functions are run in array sequence.
+// The first error returned by a hook is returned without running the next
+// hooks, which aborts the triggering state change.
 var _ StakingHooks = &MultiStakingHooks{}
 
 type MultiStakingHooks []StakingHooks
@@ -13,6 +20,38 @@ func NewMultiStakingHooks(hooks ...StakingHooks) MultiStakingHooks {
 	return hooks
 }
 
+// NewOrderedStakingHooks combines the given named staking hooks, which are run
+// in the given order. Each hook is wrapped in a NamedStakingHooks, isolating
+// its panics. It panics if the order doesn't list each hook exactly once.
+func NewOrderedStakingHooks(order []string, hooks map[string]StakingHooks) MultiStakingHooks {
+	if len(order) != len(hooks) {
+		names := make([]string, 0, len(hooks))
+		for name := range hooks {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		panic(fmt.Sprintf("staking hooks order %v must list each of the hooks %v", order, names))
+	}
+
+	multi := make(MultiStakingHooks, 0, len(order))
+	seen := make(map[string]bool, len(order))
+	for _, name := range order {
+		h, ok := hooks[name]
+		if !ok {
+			panic(fmt.Sprintf("staking hooks order lists unknown hooks %s", name))
+		}
+		if seen[name] {
+			panic(fmt.Sprintf("staking hooks order lists hooks %s twice", name))
+		}
+		seen[name] = true
+
+		multi = append(multi, NewNamedStakingHooks(name, h))
+	}
+
+	return multi
+}
+
 func (h MultiStakingHooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error {
 	for i := range h {
 		if err := h[i].AfterValidatorCreated(ctx, valAddr); err != nil {
@@ -94,3 +133,102 @@ func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.V
 	}
 	return nil
 }
+func (h MultiStakingHooks) AfterConsensusPubKeyRotated(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey, valAddr sdk.ValAddress) error {
+	for i := range h {
+		if err := h[i].AfterConsensusPubKeyRotated(ctx, oldPubKey, newPubKey, valAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ StakingHooks = NamedStakingHooks{}
+
+// NamedStakingHooks wraps staking hooks with a name, which prefixes the errors
+// they return. The panics of the hooks are recovered and returned as
+// ErrHookPanic errors, except for out of gas panics, which are propagated so
+// that the gas consumption is accounted for.
+type NamedStakingHooks struct {
+	Name  string
+	Hooks StakingHooks
+}
+
+// NewNamedStakingHooks returns the given staking hooks wrapped with a name.
+func NewNamedStakingHooks(name string, hooks StakingHooks) NamedStakingHooks {
+	return NamedStakingHooks{Name: name, Hooks: hooks}
+}
+
+func (h NamedStakingHooks) call(hook string, fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			switch r.(type) {
+			case sdk.ErrorOutOfGas, sdk.ErrorGasOverflow:
+				panic(r)
+			}
+
+			err = sdkerrors.Wrapf(ErrHookPanic, "%s %s: %v", h.Name, hook, r)
+		}
+	}()
+
+	if err := fn(); err != nil {
+		return sdkerrors.Wrapf(err, "%s %s", h.Name, hook)
+	}
+
+	return nil
+}
+
+func (h NamedStakingHooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return h.call("AfterValidatorCreated", func() error {
+		return h.Hooks.AfterValidatorCreated(ctx, valAddr)
+	})
+}
+func (h NamedStakingHooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return h.call("BeforeValidatorModified", func() error {
+		return h.Hooks.BeforeValidatorModified(ctx, valAddr)
+	})
+}
+func (h NamedStakingHooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return h.call("AfterValidatorRemoved", func() error {
+		return h.Hooks.AfterValidatorRemoved(ctx, consAddr, valAddr)
+	})
+}
+func (h NamedStakingHooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return h.call("AfterValidatorBonded", func() error {
+		return h.Hooks.AfterValidatorBonded(ctx, consAddr, valAddr)
+	})
+}
+func (h NamedStakingHooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return h.call("AfterValidatorBeginUnbonding", func() error {
+		return h.Hooks.AfterValidatorBeginUnbonding(ctx, consAddr, valAddr)
+	})
+}
+func (h NamedStakingHooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.call("BeforeDelegationCreated", func() error {
+		return h.Hooks.BeforeDelegationCreated(ctx, delAddr, valAddr)
+	})
+}
+func (h NamedStakingHooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.call("BeforeDelegationSharesModified", func() error {
+		return h.Hooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+	})
+}
+func (h NamedStakingHooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.call("BeforeDelegationRemoved", func() error {
+		return h.Hooks.BeforeDelegationRemoved(ctx, delAddr, valAddr)
+	})
+}
+func (h NamedStakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.call("AfterDelegationModified", func() error {
+		return h.Hooks.AfterDelegationModified(ctx, delAddr, valAddr)
+	})
+}
+func (h NamedStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	return h.call("BeforeValidatorSlashed", func() error {
+		return h.Hooks.BeforeValidatorSlashed(ctx, valAddr, fraction)
+	})
+}
+func (h NamedStakingHooks) AfterConsensusPubKeyRotated(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey, valAddr sdk.ValAddress) error {
+	return h.call("AfterConsensusPubKeyRotated", func() error {
+		return h.Hooks.AfterConsensusPubKeyRotated(ctx, oldPubKey, newPubKey, valAddr)
+	})
+}
@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// recordingHooks records the names of the hooks called, and fails the
+// AfterValidatorCreated hook with the given error or panic.
+type recordingHooks struct {
+	types.StakingHooks
+
+	name   string
+	calls  *[]string
+	err    error
+	panicV interface{}
+}
+
+func (h recordingHooks) AfterValidatorCreated(sdk.Context, sdk.ValAddress) error {
+	*h.calls = append(*h.calls, h.name)
+	if h.panicV != nil {
+		panic(h.panicV)
+	}
+	return h.err
+}
+
+func TestOrderedStakingHooks(t *testing.T) {
+	var calls []string
+	newHooks := func(failing recordingHooks) types.MultiStakingHooks {
+		calls = nil
+		hooks := map[string]types.StakingHooks{
+			"a": recordingHooks{name: "a", calls: &calls},
+			"b": recordingHooks{name: "b", calls: &calls},
+			"c": recordingHooks{name: "c", calls: &calls},
+		}
+		if failing.name != "" {
+			failing.calls = &calls
+			hooks[failing.name] = failing
+		}
+		return types.NewOrderedStakingHooks([]string{"c", "a", "b"}, hooks)
+	}
+
+	// hooks are run in the given order
+	require.NoError(t, newHooks(recordingHooks{}).AfterValidatorCreated(sdk.Context{}, nil))
+	require.Equal(t, []string{"c", "a", "b"}, calls)
+
+	// an error aborts the next hooks
+	errHook := errors.New("hook failed")
+	err := newHooks(recordingHooks{name: "a", err: errHook}).AfterValidatorCreated(sdk.Context{}, nil)
+	require.ErrorIs(t, err, errHook)
+	require.Contains(t, err.Error(), "a AfterValidatorCreated")
+	require.Equal(t, []string{"c", "a"}, calls)
+
+	// panics are isolated and returned as errors
+	err = newHooks(recordingHooks{name: "c", panicV: "boom"}).AfterValidatorCreated(sdk.Context{}, nil)
+	require.ErrorIs(t, err, types.ErrHookPanic)
+	require.Contains(t, err.Error(), "boom")
+	require.Equal(t, []string{"c"}, calls)
+
+	// except for out of gas panics
+	outOfGas := sdk.ErrorOutOfGas{Descriptor: "hook"}
+	require.PanicsWithValue(t, outOfGas, func() {
+		newHooks(recordingHooks{name: "b", panicV: outOfGas}).AfterValidatorCreated(sdk.Context{}, nil) //nolint:errcheck
+	})
+
+	// the order must list each hook exactly once
+	hooks := map[string]types.StakingHooks{"a": recordingHooks{}, "b": recordingHooks{}}
+	require.Panics(t, func() { types.NewOrderedStakingHooks([]string{"a"}, hooks) })
+	require.Panics(t, func() { types.NewOrderedStakingHooks([]string{"a", "c"}, hooks) })
+	require.Panics(t, func() { types.NewOrderedStakingHooks([]string{"a", "a"}, hooks) })
+}
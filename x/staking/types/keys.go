@@ -24,6 +24,12 @@ const (
 
 	// RouterKey is the msg router key for the staking module
 	RouterKey = ModuleName
+
+	// QueueShardSize is the maximum number of entries stored in a shard of the
+	// unbonding and redelegation queues. The entries of the queues maturing at
+	// the same time are split into shards, so that adding or completing an
+	// entry only reads and writes a bounded amount of data.
+	QueueShardSize = 100
 )
 
 var (
@@ -47,7 +53,21 @@ var (
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
-	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+	HistoricalInfoKey       = []byte{0x50} // prefix for the historical info
+	HistoricalInfoByTimeKey = []byte{0x51} // prefix for each key to a historical info height, by header time
+
+	MetadataVerificationKey = []byte{0x60} // prefix for the verification of the validators' metadata
+
+	TokenizeShareRecordKey          = []byte{0x61} // prefix for the tokenize share records
+	TokenizeShareRecordIDByOwnerKey = []byte{0x62} // prefix for each key to a tokenize share record id, by owner
+	LastTokenizeShareRecordIDKey    = []byte{0x63} // key for the id of the last tokenize share record
+	ValidatorLiquidSharesKey        = []byte{0x65} // prefix for the amount of tokenized shares of each validator
+
+	MinSelfDelegationBreachKey = []byte{0x66} // prefix for the time since which each validator is below its minimum self-delegation
+
+	ConsPubKeyRotationHistoryKey = []byte{0x67} // prefix for the rotations of the consensus public key of each validator, by height
+	InitialConsAddrKey           = []byte{0x68} // prefix for the initial consensus address of each rotated consensus address
+	PendingConsPubKeyRotationKey = []byte{0x69} // prefix for the rotations of the current block, applied to the validator set updates
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -62,12 +82,40 @@ func GetValidatorByConsAddrKey(addr sdk.ConsAddress) []byte {
 	return append(ValidatorsByConsAddrKey, address.MustLengthPrefix(addr)...)
 }
 
+// GetMetadataVerificationKey creates the key for the verification of the
+// metadata of the validator with address
+// VALUE: staking/MetadataVerification
+func GetMetadataVerificationKey(operatorAddr sdk.ValAddress) []byte {
+	return append(MetadataVerificationKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
 // AddressFromValidatorsKey creates the validator operator address from ValidatorsKey
 func AddressFromValidatorsKey(key []byte) []byte {
 	kv.AssertKeyAtLeastLength(key, 3)
 	return key[2:] // remove prefix bytes and address length
 }
 
+// AddressFromMetadataVerificationKey creates the validator operator address
+// from MetadataVerificationKey
+func AddressFromMetadataVerificationKey(key []byte) []byte {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
+
+// GetMinSelfDelegationBreachKey creates the key for the time since which the
+// validator with address is below its minimum self-delegation
+// VALUE: time ([]byte)
+func GetMinSelfDelegationBreachKey(operatorAddr sdk.ValAddress) []byte {
+	return append(MinSelfDelegationBreachKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// AddressFromMinSelfDelegationBreachKey creates the validator operator address
+// from MinSelfDelegationBreachKey
+func AddressFromMinSelfDelegationBreachKey(key []byte) []byte {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
+
 // AddressFromLastValidatorPowerKey creates the validator operator address from LastValidatorPowerKey
 func AddressFromLastValidatorPowerKey(key []byte) []byte {
 	kv.AssertKeyAtLeastLength(key, 3)
@@ -227,6 +275,12 @@ func GetUnbondingDelegationTimeKey(timestamp time.Time) []byte {
 	return append(UnbondingQueueKey, bz...)
 }
 
+// GetUnbondingDelegationQueueShardKey returns the key of a shard of the
+// unbonding delegations maturing at the given time.
+func GetUnbondingDelegationQueueShardKey(timestamp time.Time, shard uint32) []byte {
+	return append(GetUnbondingDelegationTimeKey(timestamp), queueShardBytes(shard)...)
+}
+
 // GetREDKey returns a key prefix for indexing a redelegation from a delegator
 // and source validator to a destination validator.
 func GetREDKey(delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) []byte {
@@ -319,6 +373,25 @@ func GetRedelegationTimeKey(timestamp time.Time) []byte {
 	return append(RedelegationQueueKey, bz...)
 }
 
+// GetRedelegationQueueShardKey returns the key of a shard of the redelegations
+// maturing at the given time.
+func GetRedelegationQueueShardKey(timestamp time.Time, shard uint32) []byte {
+	return append(GetRedelegationTimeKey(timestamp), queueShardBytes(shard)...)
+}
+
+// QueueShardFromKey returns the index of the shard of the unbonding or
+// redelegation queue key.
+func QueueShardFromKey(key []byte) uint32 {
+	kv.AssertKeyAtLeastLength(key, 4)
+	return binary.BigEndian.Uint32(key[len(key)-4:])
+}
+
+func queueShardBytes(shard uint32) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, shard)
+	return bz
+}
+
 // GetREDsKey returns a key prefix for indexing a redelegation from a delegator
 // address.
 func GetREDsKey(delAddr sdk.AccAddress) []byte {
@@ -347,3 +420,87 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetHistoricalInfoByTimeKey returns the key indexing the height of the
+// HistoricalInfo of the given header time.
+// VALUE: none
+func GetHistoricalInfoByTimeKey(timestamp time.Time, height int64) []byte {
+	return append(GetHistoricalInfoByTimePrefix(timestamp), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetHistoricalInfoByTimePrefix returns the prefix of the keys indexing the
+// heights of the HistoricalInfo of the given header time.
+func GetHistoricalInfoByTimePrefix(timestamp time.Time) []byte {
+	return append(HistoricalInfoByTimeKey, sdk.FormatTimeBytes(timestamp)...)
+}
+
+// ParseHistoricalInfoByTimeKey returns the height from a key created from
+// GetHistoricalInfoByTimeKey.
+func ParseHistoricalInfoByTimeKey(bz []byte) (int64, error) {
+	if len(bz) < len(HistoricalInfoByTimeKey)+8 || !bytes.Equal(bz[:len(HistoricalInfoByTimeKey)], HistoricalInfoByTimeKey) {
+		return 0, fmt.Errorf("invalid historical info by time key: %X", bz)
+	}
+
+	return int64(sdk.BigEndianToUint64(bz[len(bz)-8:])), nil
+}
+
+// GetTokenizeShareRecordKey returns the key of the tokenize share record of
+// the given id.
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix returns the prefix of the keys
+// indexing the ids of the tokenize share records of the given owner.
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerKey, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerKey returns the key indexing the id of a
+// tokenize share record of the given owner.
+// VALUE: none
+func GetTokenizeShareRecordIDByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetValidatorLiquidSharesKey returns the key of the amount of tokenized
+// shares of the given validator.
+// VALUE: sdk.Dec
+func GetValidatorLiquidSharesKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// AddressFromValidatorLiquidSharesKey creates the validator operator address
+// from ValidatorLiquidSharesKey
+func AddressFromValidatorLiquidSharesKey(key []byte) []byte {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
+
+// GetConsPubKeyRotationHistoryPrefix returns the prefix of the keys of the
+// rotations of the consensus public key of the given validator.
+func GetConsPubKeyRotationHistoryPrefix(operatorAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationHistoryKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetConsPubKeyRotationHistoryKey returns the key of the rotation of the
+// consensus public key of the given validator at the given height.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetConsPubKeyRotationHistoryKey(operatorAddr sdk.ValAddress, height int64) []byte {
+	return append(GetConsPubKeyRotationHistoryPrefix(operatorAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetInitialConsAddrKey returns the key of the initial consensus address of
+// the validator rotated to the given consensus address.
+// VALUE: sdk.ConsAddress
+func GetInitialConsAddrKey(consAddr sdk.ConsAddress) []byte {
+	return append(InitialConsAddrKey, address.MustLengthPrefix(consAddr)...)
+}
+
+// GetPendingConsPubKeyRotationKey returns the key of the rotation of the
+// consensus public key of the given validator in the current block.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetPendingConsPubKeyRotationKey(operatorAddr sdk.ValAddress) []byte {
+	return append(PendingConsPubKeyRotationKey, address.MustLengthPrefix(operatorAddr)...)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"crypto/sha256"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMetadataVerification creates a new MetadataVerification instance for the
+// given description.
+func NewMetadataVerification(description Description, websiteVerified, securityContactVerified bool, verifiedAt time.Time) MetadataVerification {
+	return MetadataVerification{
+		WebsiteVerified:         websiteVerified,
+		SecurityContactVerified: securityContactVerified,
+		WebsiteHash:             HashWebsite(description.Website),
+		VerifiedAt:              verifiedAt,
+	}
+}
+
+// HashWebsite returns the SHA-256 hash of a validator's website, as recorded by
+// a MetadataVerification.
+func HashWebsite(website string) []byte {
+	hash := sha256.Sum256([]byte(website))
+	return hash[:]
+}
+
+// MustMarshalMetadataVerification returns the binary encoding of a metadata
+// verification or panics
+func MustMarshalMetadataVerification(cdc codec.BinaryCodec, verification *MetadataVerification) []byte {
+	return cdc.MustMarshal(verification)
+}
+
+// MustUnmarshalMetadataVerification unmarshals a metadata verification from a
+// store value or panics
+func MustUnmarshalMetadataVerification(cdc codec.BinaryCodec, value []byte) MetadataVerification {
+	var verification MetadataVerification
+	cdc.MustUnmarshal(value, &verification)
+
+	return verification
+}
+
+// NewValidatorMetadataVerification creates a new ValidatorMetadataVerification
+// instance
+//nolint:interfacer
+func NewValidatorMetadataVerification(valAddr sdk.ValAddress, verification MetadataVerification) ValidatorMetadataVerification {
+	return ValidatorMetadataVerification{
+		ValidatorAddress: valAddr.String(),
+		Verification:     verification,
+	}
+}
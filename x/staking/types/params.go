@@ -30,14 +30,48 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultHistoricalRetentionTime is 0, i.e. the historical entries are
+	// only pruned based on the HistoricalEntries parameter.
+	DefaultHistoricalRetentionTime time.Duration = 0
+
+	// DefaultMinSelfDelegationGracePeriod is 0, i.e. the validators below their
+	// minimum self-delegation are jailed at the end of the block.
+	DefaultMinSelfDelegationGracePeriod time.Duration = 0
+
+	// DefaultMaxMaturitiesPerBlock is 0, i.e. all the mature unbonding
+	// delegations and redelegations are completed at the end of the block.
+	DefaultMaxMaturitiesPerBlock uint32 = 0
 )
 
+// DefaultMinCommissionRate is set to 0%, i.e. there is no minimum commission
+// rate by default.
+var DefaultMinCommissionRate = sdk.ZeroDec()
+
+// DefaultGlobalLiquidStakingCap and DefaultValidatorLiquidStakingCap are set to
+// 100%, i.e. the tokenization of delegations isn't capped by default.
 var (
-	KeyUnbondingTime     = []byte("UnbondingTime")
-	KeyMaxValidators     = []byte("MaxValidators")
-	KeyMaxEntries        = []byte("MaxEntries")
-	KeyBondDenom         = []byte("BondDenom")
-	KeyHistoricalEntries = []byte("HistoricalEntries")
+	DefaultGlobalLiquidStakingCap    = sdk.OneDec()
+	DefaultValidatorLiquidStakingCap = sdk.OneDec()
+)
+
+// DefaultKeyRotationFee is empty, i.e. the rotation of the consensus public
+// keys of the validators is free by default.
+var DefaultKeyRotationFee = sdk.Coins(nil)
+
+var (
+	KeyUnbondingTime                = []byte("UnbondingTime")
+	KeyMaxValidators                = []byte("MaxValidators")
+	KeyMaxEntries                   = []byte("MaxEntries")
+	KeyBondDenom                    = []byte("BondDenom")
+	KeyHistoricalEntries            = []byte("HistoricalEntries")
+	KeyMinCommissionRate            = []byte("MinCommissionRate")
+	KeyHistoricalRetentionTime      = []byte("HistoricalRetentionTime")
+	KeyGlobalLiquidStakingCap       = []byte("GlobalLiquidStakingCap")
+	KeyValidatorLiquidStakingCap    = []byte("ValidatorLiquidStakingCap")
+	KeyMinSelfDelegationGracePeriod = []byte("MinSelfDelegationGracePeriod")
+	KeyKeyRotationFee               = []byte("KeyRotationFee")
+	KeyMaxMaturitiesPerBlock        = []byte("MaxMaturitiesPerBlock")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -48,13 +82,24 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	minCommissionRate sdk.Dec, historicalRetentionTime time.Duration, globalLiquidStakingCap, validatorLiquidStakingCap sdk.Dec,
+	minSelfDelegationGracePeriod time.Duration, keyRotationFee sdk.Coins, maxMaturitiesPerBlock uint32,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
+		UnbondingTime:                unbondingTime,
+		MaxValidators:                maxValidators,
+		MaxEntries:                   maxEntries,
+		HistoricalEntries:            historicalEntries,
+		BondDenom:                    bondDenom,
+		MinCommissionRate:            minCommissionRate,
+		HistoricalRetentionTime:      historicalRetentionTime,
+		GlobalLiquidStakingCap:       globalLiquidStakingCap,
+		ValidatorLiquidStakingCap:    validatorLiquidStakingCap,
+		MinSelfDelegationGracePeriod: minSelfDelegationGracePeriod,
+		KeyRotationFee:               keyRotationFee,
+		MaxMaturitiesPerBlock:        maxMaturitiesPerBlock,
 	}
 }
 
@@ -66,6 +111,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
+		paramtypes.NewParamSetPair(KeyHistoricalRetentionTime, &p.HistoricalRetentionTime, validateHistoricalRetentionTime),
+		paramtypes.NewParamSetPair(KeyGlobalLiquidStakingCap, &p.GlobalLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyValidatorLiquidStakingCap, &p.ValidatorLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyMinSelfDelegationGracePeriod, &p.MinSelfDelegationGracePeriod, validateMinSelfDelegationGracePeriod),
+		paramtypes.NewParamSetPair(KeyKeyRotationFee, &p.KeyRotationFee, validateKeyRotationFee),
+		paramtypes.NewParamSetPair(KeyMaxMaturitiesPerBlock, &p.MaxMaturitiesPerBlock, validateMaxMaturitiesPerBlock),
 	}
 }
 
@@ -77,6 +129,13 @@ func DefaultParams() Params {
 		DefaultMaxEntries,
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
+		DefaultMinCommissionRate,
+		DefaultHistoricalRetentionTime,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultMinSelfDelegationGracePeriod,
+		DefaultKeyRotationFee,
+		DefaultMaxMaturitiesPerBlock,
 	)
 }
 
@@ -124,6 +183,34 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMinCommissionRate(p.MinCommissionRate); err != nil {
+		return err
+	}
+
+	if err := validateHistoricalRetentionTime(p.HistoricalRetentionTime); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateMinSelfDelegationGracePeriod(p.MinSelfDelegationGracePeriod); err != nil {
+		return err
+	}
+
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
+	if err := validateMaxMaturitiesPerBlock(p.MaxMaturitiesPerBlock); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -192,6 +279,91 @@ func validateBondDenom(i interface{}) error {
 	return nil
 }
 
+func validateMinCommissionRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("minimum commission rate cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("minimum commission rate cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("minimum commission rate cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateHistoricalRetentionTime(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("historical retention time cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateMinSelfDelegationGracePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("min self-delegation grace period cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateKeyRotationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid key rotation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateMaxMaturitiesPerBlock(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
 func ValidatePowerReduction(i interface{}) error {
 	v, ok := i.(sdk.Int)
 	if !ok {
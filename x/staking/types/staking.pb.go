@@ -303,6 +303,83 @@ func (m *Description) GetDetails() string {
 	return ""
 }
 
+// MetadataVerification defines the verification of the metadata of a
+// validator's description, which is checked off-chain and attested on-chain by
+// the validator operator. It is cleared when the verified metadata is edited.
+type MetadataVerification struct {
+	// website_verified is true if the website proves it is controlled by the
+	// validator operator, with a DNS TXT record or a well-known URL.
+	WebsiteVerified bool `protobuf:"varint,1,opt,name=website_verified,json=websiteVerified,proto3" json:"website_verified,omitempty"`
+	// security_contact_verified is true if the security contact is listed in the
+	// security.txt file of the website.
+	SecurityContactVerified bool `protobuf:"varint,2,opt,name=security_contact_verified,json=securityContactVerified,proto3" json:"security_contact_verified,omitempty"`
+	// website_hash is the SHA-256 hash of the website which was verified.
+	WebsiteHash []byte `protobuf:"bytes,3,opt,name=website_hash,json=websiteHash,proto3" json:"website_hash,omitempty"`
+	// verified_at is the block time at which the verification was attested.
+	VerifiedAt time.Time `protobuf:"bytes,4,opt,name=verified_at,json=verifiedAt,proto3,stdtime" json:"verified_at"`
+}
+
+func (m *MetadataVerification) Reset()         { *m = MetadataVerification{} }
+func (m *MetadataVerification) String() string { return proto.CompactTextString(m) }
+func (*MetadataVerification) ProtoMessage()    {}
+func (*MetadataVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{4}
+}
+func (m *MetadataVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetadataVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataVerification.Merge(m, src)
+}
+func (m *MetadataVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataVerification proto.InternalMessageInfo
+
+func (m *MetadataVerification) GetWebsiteVerified() bool {
+	if m != nil {
+		return m.WebsiteVerified
+	}
+	return false
+}
+
+func (m *MetadataVerification) GetSecurityContactVerified() bool {
+	if m != nil {
+		return m.SecurityContactVerified
+	}
+	return false
+}
+
+func (m *MetadataVerification) GetWebsiteHash() []byte {
+	if m != nil {
+		return m.WebsiteHash
+	}
+	return nil
+}
+
+func (m *MetadataVerification) GetVerifiedAt() time.Time {
+	if m != nil {
+		return m.VerifiedAt
+	}
+	return time.Time{}
+}
+
 // Validator defines a validator, together with the total amount of the
 // Validator's bond shares and their exchange rate to coins. Slashing results in
 // a decrease in the exchange rate, allowing correct calculation of future
@@ -339,7 +416,7 @@ type Validator struct {
 func (m *Validator) Reset()      { *m = Validator{} }
 func (*Validator) ProtoMessage() {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{4}
+	return fileDescriptor_64c30c6cf92913c9, []int{5}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,7 +453,7 @@ type ValAddresses struct {
 func (m *ValAddresses) Reset()      { *m = ValAddresses{} }
 func (*ValAddresses) ProtoMessage() {}
 func (*ValAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{5}
+	return fileDescriptor_64c30c6cf92913c9, []int{6}
 }
 func (m *ValAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -423,7 +500,7 @@ type DVPair struct {
 func (m *DVPair) Reset()      { *m = DVPair{} }
 func (*DVPair) ProtoMessage() {}
 func (*DVPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{6}
+	return fileDescriptor_64c30c6cf92913c9, []int{7}
 }
 func (m *DVPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,7 +538,7 @@ func (m *DVPairs) Reset()         { *m = DVPairs{} }
 func (m *DVPairs) String() string { return proto.CompactTextString(m) }
 func (*DVPairs) ProtoMessage()    {}
 func (*DVPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{7}
+	return fileDescriptor_64c30c6cf92913c9, []int{8}
 }
 func (m *DVPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,7 +587,7 @@ type DVVTriplet struct {
 func (m *DVVTriplet) Reset()      { *m = DVVTriplet{} }
 func (*DVVTriplet) ProtoMessage() {}
 func (*DVVTriplet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{8}
+	return fileDescriptor_64c30c6cf92913c9, []int{9}
 }
 func (m *DVVTriplet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,7 +625,7 @@ func (m *DVVTriplets) Reset()         { *m = DVVTriplets{} }
 func (m *DVVTriplets) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplets) ProtoMessage()    {}
 func (*DVVTriplets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{9}
+	return fileDescriptor_64c30c6cf92913c9, []int{10}
 }
 func (m *DVVTriplets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +676,7 @@ type Delegation struct {
 func (m *Delegation) Reset()      { *m = Delegation{} }
 func (*Delegation) ProtoMessage() {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{10}
+	return fileDescriptor_64c30c6cf92913c9, []int{11}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -642,7 +719,7 @@ type UnbondingDelegation struct {
 func (m *UnbondingDelegation) Reset()      { *m = UnbondingDelegation{} }
 func (*UnbondingDelegation) ProtoMessage() {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{11}
+	return fileDescriptor_64c30c6cf92913c9, []int{12}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,7 +763,7 @@ type UnbondingDelegationEntry struct {
 func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
 func (*UnbondingDelegationEntry) ProtoMessage() {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{12}
+	return fileDescriptor_64c30c6cf92913c9, []int{13}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +821,7 @@ type RedelegationEntry struct {
 func (m *RedelegationEntry) Reset()      { *m = RedelegationEntry{} }
 func (*RedelegationEntry) ProtoMessage() {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{13}
+	return fileDescriptor_64c30c6cf92913c9, []int{14}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +880,7 @@ type Redelegation struct {
 func (m *Redelegation) Reset()      { *m = Redelegation{} }
 func (*Redelegation) ProtoMessage() {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{14}
+	return fileDescriptor_64c30c6cf92913c9, []int{15}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -844,12 +921,37 @@ type Params struct {
 	HistoricalEntries uint32 `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
 	// bond_denom defines the bondable coin denomination.
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// min_commission_rate is the chain-wide minimum commission rate that a
+	// validator can charge their delegators.
+	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// historical_retention_time is the maximum age of the historical entries,
+	// which are pruned in EndBlock. Zero disables the time based pruning.
+	HistoricalRetentionTime time.Duration `protobuf:"bytes,7,opt,name=historical_retention_time,json=historicalRetentionTime,proto3,stdduration" json:"historical_retention_time" yaml:"historical_retention_time"`
+	// global_liquid_staking_cap is the maximum fraction of the bonded tokens
+	// which can be tokenized into liquid staking shares. A cap of 1 disables
+	// the check.
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap" yaml:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegator
+	// shares of a validator which can be tokenized into liquid staking shares.
+	// A cap of 1 disables the check.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"`
+	// min_self_delegation_grace_period is the duration a bonded validator can stay
+	// below its minimum self-delegation, e.g. after being slashed, before being
+	// jailed in EndBlock. Zero jails the validator at the end of the block.
+	MinSelfDelegationGracePeriod time.Duration `protobuf:"bytes,10,opt,name=min_self_delegation_grace_period,json=minSelfDelegationGracePeriod,proto3,stdduration" json:"min_self_delegation_grace_period" yaml:"min_self_delegation_grace_period"`
+	// key_rotation_fee is the fee paid by the operator of a validator to rotate
+	// its consensus public key, burned by the module.
+	KeyRotationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=key_rotation_fee,json=keyRotationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"key_rotation_fee" yaml:"key_rotation_fee"`
+	// max_maturities_per_block is the maximum number of mature unbonding
+	// delegations, and of mature redelegations, completed in EndBlock. The
+	// remaining ones are completed in the next blocks. Zero means no limit.
+	MaxMaturitiesPerBlock uint32 `protobuf:"varint,12,opt,name=max_maturities_per_block,json=maxMaturitiesPerBlock,proto3" json:"max_maturities_per_block,omitempty" yaml:"max_maturities_per_block"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{15}
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -913,6 +1015,34 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetHistoricalRetentionTime() time.Duration {
+	if m != nil {
+		return m.HistoricalRetentionTime
+	}
+	return 0
+}
+
+func (m *Params) GetMinSelfDelegationGracePeriod() time.Duration {
+	if m != nil {
+		return m.MinSelfDelegationGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetKeyRotationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxMaturitiesPerBlock() uint32 {
+	if m != nil {
+		return m.MaxMaturitiesPerBlock
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -923,7 +1053,7 @@ type DelegationResponse struct {
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -978,7 +1108,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1026,7 +1156,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1210,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1109,12 +1239,143 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// ConsPubKeyRotationHistory records the rotation of the consensus public key
+// of a validator.
+type ConsPubKeyRotationHistory struct {
+	// operator_address is the operator address of the validator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// old_cons_pubkey is the consensus public key of the validator before the
+	// rotation.
+	OldConsPubkey *types1.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the consensus public key of the validator after the
+	// rotation.
+	NewConsPubkey *types1.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height is the height of the block of the rotation.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the block of the rotation.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// fee is the rotation fee paid by the operator.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHistory{} }
+func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
+func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotationHistory.Merge(m, src)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotationHistory proto.InternalMessageInfo
+
+// TokenizeShareRecord represents a delegation tokenized into liquid staking
+// shares. The delegation is held by the module account of the record, and its
+// rewards are withdrawn by the owner of the record.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the owner of the record, receiving the rewards of
+	// the tokenized delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the tokenized
+	// delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator of the tokenized
+	// delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
 	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
 	proto.RegisterType((*Commission)(nil), "cosmos.staking.v1beta1.Commission")
 	proto.RegisterType((*Description)(nil), "cosmos.staking.v1beta1.Description")
+	proto.RegisterType((*MetadataVerification)(nil), "cosmos.staking.v1beta1.MetadataVerification")
 	proto.RegisterType((*Validator)(nil), "cosmos.staking.v1beta1.Validator")
 	proto.RegisterType((*ValAddresses)(nil), "cosmos.staking.v1beta1.ValAddresses")
 	proto.RegisterType((*DVPair)(nil), "cosmos.staking.v1beta1.DVPair")
@@ -1131,6 +1392,8 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 }
 
 func init() {
@@ -1138,110 +1401,143 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6c, 0x63, 0x47,
-	0x19, 0xf7, 0x73, 0x5c, 0xc7, 0xfe, 0x9c, 0xc4, 0xc9, 0x6c, 0x5a, 0xbc, 0x16, 0xd8, 0xc6, 0x94,
-	0x76, 0x8b, 0xba, 0x0e, 0x1b, 0xa4, 0x4a, 0x44, 0x48, 0x68, 0x1d, 0xbb, 0x6c, 0x58, 0xba, 0xb8,
-	0x76, 0x36, 0x88, 0x3f, 0xe2, 0x69, 0xfc, 0xde, 0xc4, 0x19, 0xe2, 0x37, 0xcf, 0x7a, 0x33, 0x5e,
-	0xe2, 0x03, 0x12, 0x12, 0x97, 0x92, 0x53, 0x8f, 0xbd, 0x44, 0x8a, 0x54, 0x8e, 0x3d, 0x56, 0x5c,
-	0x38, 0x70, 0x2d, 0x3d, 0xad, 0x7a, 0xa2, 0x80, 0x02, 0xda, 0xbd, 0x20, 0x4e, 0x1c, 0xb8, 0x82,
-	0xd0, 0xfc, 0x79, 0x7f, 0xd6, 0x8e, 0xd3, 0xa4, 0x4a, 0xa5, 0x4a, 0xbd, 0xec, 0xbe, 0x99, 0xf9,
-	0xbe, 0xdf, 0x7c, 0xdf, 0xef, 0xfb, 0x93, 0x6f, 0x0c, 0x2f, 0x3a, 0x3e, 0xf7, 0x7c, 0xbe, 0xc1,
-	0x05, 0x3e, 0xa4, 0x6c, 0xb0, 0xf1, 0xe8, 0x4e, 0x9f, 0x08, 0x7c, 0x27, 0x5c, 0x37, 0x46, 0x81,
-	0x2f, 0x7c, 0xf4, 0x82, 0x96, 0x6a, 0x84, 0xbb, 0x46, 0xaa, 0xbc, 0x3e, 0xf0, 0x07, 0xbe, 0x12,
-	0xd9, 0x90, 0x5f, 0x5a, 0xba, 0x7c, 0x73, 0xe0, 0xfb, 0x83, 0x21, 0xd9, 0x50, 0xab, 0xfe, 0x78,
-	0x7f, 0x03, 0xb3, 0x89, 0x39, 0xaa, 0x4c, 0x1f, 0xb9, 0xe3, 0x00, 0x0b, 0xea, 0x33, 0x73, 0x5e,
-	0x9d, 0x3e, 0x17, 0xd4, 0x23, 0x5c, 0x60, 0x6f, 0x14, 0x62, 0x6b, 0x4b, 0x6c, 0x7d, 0xa9, 0x31,
-	0xcb, 0x60, 0x1b, 0x57, 0xfa, 0x98, 0x93, 0xc8, 0x0f, 0xc7, 0xa7, 0x21, 0xf6, 0x97, 0x05, 0x61,
-	0x2e, 0x09, 0x3c, 0xca, 0xc4, 0x86, 0x98, 0x8c, 0x08, 0xd7, 0xff, 0xea, 0xd3, 0xfa, 0x6f, 0x2d,
-	0x58, 0xb9, 0x47, 0xb9, 0xf0, 0x03, 0xea, 0xe0, 0xe1, 0x0e, 0xdb, 0xf7, 0xd1, 0x6b, 0x90, 0x3d,
-	0x20, 0xd8, 0x25, 0x41, 0xc9, 0xaa, 0x59, 0xb7, 0x0a, 0x9b, 0xa5, 0x46, 0x8c, 0xd0, 0xd0, 0xba,
-	0xf7, 0xd4, 0x79, 0x33, 0xf3, 0xc1, 0x59, 0x35, 0xd5, 0x35, 0xd2, 0xe8, 0xbb, 0x90, 0x7d, 0x84,
-	0x87, 0x9c, 0x88, 0x52, 0xba, 0xb6, 0x70, 0xab, 0xb0, 0xf9, 0xd5, 0xc6, 0xf9, 0xf4, 0x35, 0xf6,
-	0xf0, 0x90, 0xba, 0x58, 0xf8, 0x11, 0x80, 0x56, 0xab, 0xbf, 0x97, 0x86, 0xe2, 0xb6, 0xef, 0x79,
-	0x94, 0x73, 0xea, 0xb3, 0x2e, 0x16, 0x84, 0xa3, 0x0e, 0x64, 0x02, 0x2c, 0x88, 0x32, 0x25, 0xdf,
-	0xfc, 0x8e, 0x94, 0xff, 0xcb, 0x59, 0xf5, 0xa5, 0x01, 0x15, 0x07, 0xe3, 0x7e, 0xc3, 0xf1, 0x3d,
-	0x43, 0x86, 0xf9, 0xef, 0x36, 0x77, 0x0f, 0x8d, 0x7f, 0x2d, 0xe2, 0x7c, 0xf4, 0xfe, 0x6d, 0x30,
-	0x36, 0xb4, 0x88, 0xd3, 0x55, 0x48, 0xe8, 0x47, 0x90, 0xf3, 0xf0, 0x91, 0xad, 0x50, 0xd3, 0xd7,
-	0x80, 0xba, 0xe8, 0xe1, 0x23, 0x69, 0x2b, 0x72, 0xa1, 0x28, 0x81, 0x9d, 0x03, 0xcc, 0x06, 0x44,
-	0xe3, 0x2f, 0x5c, 0x03, 0xfe, 0xb2, 0x87, 0x8f, 0xb6, 0x15, 0xa6, 0xbc, 0x65, 0x2b, 0xf7, 0xce,
-	0x69, 0x35, 0xf5, 0xcf, 0xd3, 0xaa, 0x55, 0xff, 0x83, 0x05, 0x10, 0xd3, 0x85, 0x7e, 0x06, 0xab,
-	0x4e, 0xb4, 0x52, 0xd7, 0x73, 0x13, 0xc0, 0x97, 0xe7, 0x05, 0x62, 0x8a, 0xec, 0x66, 0x4e, 0x1a,
-	0xfa, 0xf8, 0xac, 0x6a, 0x75, 0x8b, 0xce, 0x54, 0x1c, 0xda, 0x50, 0x18, 0x8f, 0x5c, 0x2c, 0x88,
-	0x2d, 0x53, 0x53, 0x11, 0x57, 0xd8, 0x2c, 0x37, 0x74, 0xde, 0x36, 0xc2, 0xbc, 0x6d, 0xec, 0x86,
-	0x79, 0xab, 0xb1, 0xde, 0xfe, 0x7b, 0xd5, 0xea, 0x82, 0x56, 0x94, 0x47, 0x09, 0xeb, 0xdf, 0xb3,
-	0xa0, 0xd0, 0x22, 0xdc, 0x09, 0xe8, 0x48, 0x16, 0x02, 0x2a, 0xc1, 0xa2, 0xe7, 0x33, 0x7a, 0x68,
-	0xd2, 0x2e, 0xdf, 0x0d, 0x97, 0xa8, 0x0c, 0x39, 0xea, 0x12, 0x26, 0xa8, 0x98, 0xe8, 0x80, 0x75,
-	0xa3, 0xb5, 0xd4, 0xfa, 0x25, 0xe9, 0x73, 0x1a, 0x72, 0xdd, 0x0d, 0x97, 0xe8, 0x15, 0x58, 0xe5,
-	0xc4, 0x19, 0x07, 0x54, 0x4c, 0x6c, 0xc7, 0x67, 0x02, 0x3b, 0xa2, 0x94, 0x51, 0x22, 0xc5, 0x70,
-	0x7f, 0x5b, 0x6f, 0x4b, 0x10, 0x97, 0x08, 0x4c, 0x87, 0xbc, 0xf4, 0x9c, 0x06, 0x31, 0xcb, 0x84,
-	0xb9, 0x7f, 0xca, 0x42, 0x3e, 0xca, 0x5b, 0xb4, 0x0d, 0xab, 0xfe, 0x88, 0x04, 0xf2, 0xdb, 0xc6,
-	0xae, 0x1b, 0x10, 0xce, 0x4d, 0x86, 0x96, 0x3e, 0x7a, 0xff, 0xf6, 0xba, 0xa1, 0xfb, 0xae, 0x3e,
-	0xe9, 0x89, 0x80, 0xb2, 0x41, 0xb7, 0x18, 0x6a, 0x98, 0x6d, 0xf4, 0x63, 0x19, 0x30, 0xc6, 0x09,
-	0xe3, 0x63, 0x6e, 0x8f, 0xc6, 0xfd, 0x43, 0x32, 0x31, 0xbc, 0xae, 0xcf, 0xf0, 0x7a, 0x97, 0x4d,
-	0x9a, 0xa5, 0x0f, 0x63, 0x68, 0x27, 0x98, 0x8c, 0x84, 0xdf, 0xe8, 0x8c, 0xfb, 0xf7, 0xc9, 0x44,
-	0x46, 0xcb, 0xe0, 0x74, 0x14, 0x0c, 0x7a, 0x01, 0xb2, 0xbf, 0xc0, 0x74, 0x48, 0x5c, 0xc5, 0x4a,
-	0xae, 0x6b, 0x56, 0x68, 0x0b, 0xb2, 0x5c, 0x60, 0x31, 0xe6, 0x8a, 0x8a, 0x95, 0xcd, 0xfa, 0xbc,
-	0xcc, 0x68, 0xfa, 0xcc, 0xed, 0x29, 0xc9, 0xae, 0xd1, 0x40, 0xbb, 0x90, 0x15, 0xfe, 0x21, 0x61,
-	0x86, 0xa4, 0x2b, 0x65, 0xf5, 0x0e, 0x13, 0x89, 0xac, 0xde, 0x61, 0xa2, 0x6b, 0xb0, 0xd0, 0x00,
-	0x56, 0x5d, 0x32, 0x24, 0x03, 0x45, 0x25, 0x3f, 0xc0, 0x01, 0xe1, 0xa5, 0xec, 0x35, 0x54, 0x4d,
-	0x31, 0x42, 0xed, 0x29, 0x50, 0x74, 0x1f, 0x0a, 0x6e, 0x9c, 0x6e, 0xa5, 0x45, 0x45, 0xf4, 0xd7,
-	0xe6, 0xf9, 0x9f, 0xc8, 0x4c, 0xd3, 0xa4, 0x92, 0xda, 0x32, 0xb9, 0xc6, 0xac, 0xef, 0x33, 0x97,
-	0xb2, 0x81, 0x7d, 0x40, 0xe8, 0xe0, 0x40, 0x94, 0x72, 0x35, 0xeb, 0xd6, 0x42, 0xb7, 0x18, 0xed,
-	0xdf, 0x53, 0xdb, 0xe8, 0x3e, 0xac, 0xc4, 0xa2, 0xaa, 0x76, 0xf2, 0x57, 0xa8, 0x9d, 0xe5, 0x48,
-	0x57, 0x9e, 0xa2, 0x7b, 0x00, 0x71, 0x61, 0x96, 0x40, 0x01, 0xd5, 0x3f, 0xb9, 0xba, 0x8d, 0x0b,
-	0x09, 0x5d, 0x34, 0x84, 0x1b, 0x1e, 0x65, 0x36, 0x27, 0xc3, 0x7d, 0xdb, 0x50, 0x25, 0x21, 0x0b,
-	0xd7, 0x10, 0xda, 0x35, 0x8f, 0xb2, 0x1e, 0x19, 0xee, 0xb7, 0x22, 0xd8, 0xad, 0xa5, 0xb7, 0x4e,
-	0xab, 0x29, 0x53, 0x4b, 0xa9, 0x7a, 0x07, 0x96, 0xf6, 0xf0, 0xd0, 0x94, 0x01, 0xe1, 0xe8, 0x35,
-	0xc8, 0xe3, 0x70, 0x51, 0xb2, 0x6a, 0x0b, 0x17, 0x96, 0x51, 0x2c, 0xaa, 0xab, 0xf3, 0xd7, 0x7f,
-	0xab, 0x59, 0xf5, 0xdf, 0x59, 0x90, 0x6d, 0xed, 0x75, 0x30, 0x0d, 0x50, 0x1b, 0xd6, 0xe2, 0x84,
-	0xba, 0x6c, 0x6d, 0xc6, 0x39, 0x18, 0x16, 0x67, 0x1b, 0xd6, 0x1e, 0x85, 0xe5, 0x1e, 0xc1, 0xa4,
-	0x3f, 0x09, 0x26, 0x52, 0x31, 0xfb, 0x53, 0x8e, 0xb7, 0x61, 0x51, 0x5b, 0xc9, 0xd1, 0x16, 0x3c,
-	0x37, 0x92, 0x1f, 0xca, 0xdf, 0xc2, 0x66, 0x65, 0x6e, 0x22, 0x2a, 0x79, 0x13, 0x40, 0xad, 0x52,
-	0xff, 0xaf, 0x05, 0xd0, 0xda, 0xdb, 0xdb, 0x0d, 0xe8, 0x68, 0x48, 0xc4, 0x75, 0x79, 0xfc, 0x03,
-	0x78, 0x3e, 0xf6, 0x98, 0x07, 0xce, 0xa5, 0xbd, 0xbe, 0x11, 0xa9, 0xf5, 0x02, 0xe7, 0x5c, 0x34,
-	0x97, 0x8b, 0x08, 0x6d, 0xe1, 0xd2, 0x68, 0x2d, 0x2e, 0xce, 0xa7, 0xb1, 0x07, 0x85, 0xd8, 0x7d,
-	0x8e, 0x5a, 0x90, 0x13, 0xe6, 0xdb, 0xb0, 0x59, 0x9f, 0xcf, 0x66, 0xa8, 0x66, 0x18, 0x8d, 0x34,
-	0xeb, 0xff, 0x93, 0xa4, 0x46, 0x19, 0xfb, 0xf9, 0x4a, 0x23, 0xd9, 0x7b, 0x4d, 0x6f, 0xbc, 0x8e,
-	0x89, 0xc2, 0x60, 0x4d, 0xb1, 0xfa, 0x9b, 0x34, 0xdc, 0x78, 0x18, 0x76, 0x9b, 0xcf, 0x2d, 0x13,
-	0x1d, 0x58, 0x24, 0x4c, 0x04, 0x54, 0x51, 0x21, 0x63, 0xfd, 0xcd, 0x79, 0xb1, 0x3e, 0xc7, 0x97,
-	0x36, 0x13, 0xc1, 0xc4, 0x44, 0x3e, 0x84, 0x99, 0x62, 0xe1, 0xaf, 0x69, 0x28, 0xcd, 0xd3, 0x44,
-	0x2f, 0x43, 0xd1, 0x09, 0x88, 0xda, 0x08, 0xbb, 0xbe, 0xa5, 0xba, 0xfe, 0x4a, 0xb8, 0x6d, 0x9a,
-	0xfe, 0x1b, 0x20, 0x07, 0x28, 0x99, 0x58, 0x52, 0xf4, 0xca, 0x13, 0xd3, 0x4a, 0xac, 0xac, 0xda,
-	0x3e, 0x81, 0x22, 0x65, 0x54, 0x50, 0x3c, 0xb4, 0xfb, 0x78, 0x88, 0x99, 0xf3, 0x69, 0x26, 0xcb,
-	0xd9, 0x46, 0xbd, 0x62, 0x40, 0x9b, 0x1a, 0x13, 0xed, 0xc1, 0x62, 0x08, 0x9f, 0xb9, 0x06, 0xf8,
-	0x10, 0x2c, 0x31, 0x45, 0x7d, 0x9c, 0x86, 0xb5, 0x2e, 0x71, 0xbf, 0x58, 0xb4, 0xfe, 0x14, 0x40,
-	0x17, 0x9c, 0xec, 0x83, 0x9f, 0x82, 0xd9, 0xd9, 0x02, 0xce, 0x6b, 0xbc, 0x16, 0x17, 0x09, 0x6e,
-	0x3f, 0x4c, 0xc3, 0x52, 0x92, 0xdb, 0x2f, 0xc0, 0xdf, 0x05, 0xb4, 0x13, 0x77, 0x83, 0x8c, 0xea,
-	0x06, 0xaf, 0xcc, 0xeb, 0x06, 0x33, 0x59, 0x77, 0x71, 0x1b, 0xf8, 0x8f, 0x05, 0xd9, 0x0e, 0x0e,
-	0xb0, 0xc7, 0xd1, 0xf7, 0x67, 0x06, 0x38, 0xfd, 0xaa, 0xba, 0x39, 0x93, 0x73, 0x2d, 0xf3, 0xa8,
-	0xd7, 0x29, 0xf7, 0xce, 0x39, 0xf3, 0xdb, 0xd7, 0x61, 0x45, 0x3e, 0x11, 0x23, 0x57, 0x34, 0x89,
-	0xcb, 0xea, 0x8d, 0x17, 0xbd, 0x2e, 0x38, 0xaa, 0x42, 0x41, 0x8a, 0xc5, 0x8d, 0x4e, 0xca, 0x80,
-	0x87, 0x8f, 0xda, 0x7a, 0x07, 0xdd, 0x06, 0x74, 0x10, 0x3d, 0xda, 0xed, 0x98, 0x02, 0x29, 0xb7,
-	0x16, 0x9f, 0x84, 0xe2, 0x5f, 0x01, 0x90, 0x56, 0xd8, 0x2e, 0x61, 0xbe, 0x67, 0xde, 0x38, 0x79,
-	0xb9, 0xd3, 0x92, 0x1b, 0x89, 0x1c, 0x7a, 0xd7, 0x02, 0x14, 0x37, 0xbd, 0x2e, 0xe1, 0x23, 0xf9,
-	0xb0, 0x90, 0x63, 0x67, 0x62, 0x46, 0xb4, 0x2e, 0x1e, 0x3b, 0x63, 0xfd, 0x70, 0xec, 0x4c, 0xe4,
-	0xe4, 0xb7, 0xe3, 0x16, 0x93, 0x36, 0x2c, 0x1a, 0x98, 0x3e, 0xe6, 0x24, 0x31, 0xba, 0xd2, 0x50,
-	0x7b, 0xa6, 0x8b, 0xa4, 0xea, 0x1f, 0x5b, 0x70, 0x73, 0x26, 0x9e, 0x91, 0xb1, 0x3f, 0x07, 0x14,
-	0x24, 0x0e, 0x15, 0x3b, 0x13, 0x63, 0xf4, 0x95, 0xd3, 0x63, 0x2d, 0x98, 0xe9, 0x56, 0x9f, 0x55,
-	0x97, 0xcc, 0xa8, 0x08, 0xfc, 0xd1, 0x82, 0xf5, 0xa4, 0x31, 0x91, 0x5b, 0x0f, 0x60, 0x29, 0x69,
-	0x8b, 0x71, 0xe8, 0xc5, 0xcb, 0x38, 0x64, 0x7c, 0x79, 0x46, 0x1f, 0xbd, 0x19, 0x97, 0x8e, 0xfe,
-	0xb9, 0xe6, 0xce, 0xa5, 0xb9, 0x09, 0x6d, 0x9a, 0x2e, 0xa1, 0x4c, 0x38, 0x47, 0x64, 0x3a, 0xbe,
-	0x3f, 0x44, 0xbf, 0x82, 0x35, 0xe6, 0x0b, 0x5b, 0xe6, 0x19, 0x71, 0x6d, 0xf3, 0x76, 0xd4, 0xfd,
-	0xe7, 0xcd, 0xab, 0x51, 0xf6, 0xaf, 0xb3, 0xea, 0x2c, 0xd4, 0x14, 0x8f, 0x45, 0xe6, 0x8b, 0xa6,
-	0x3a, 0xdf, 0xd5, 0x2f, 0xcb, 0x00, 0x96, 0x9f, 0xbd, 0x5a, 0xf7, 0xab, 0x37, 0xae, 0x7c, 0xf5,
-	0xf2, 0x45, 0xd7, 0x2e, 0xf5, 0x13, 0x77, 0x6e, 0xe5, 0x64, 0x0c, 0xff, 0x7d, 0x5a, 0xb5, 0xbe,
-	0xf1, 0x7b, 0x0b, 0x20, 0x7e, 0x44, 0xa3, 0x57, 0xe1, 0x4b, 0xcd, 0x1f, 0x3e, 0x68, 0xd9, 0xbd,
-	0xdd, 0xbb, 0xbb, 0x0f, 0x7b, 0xf6, 0xc3, 0x07, 0xbd, 0x4e, 0x7b, 0x7b, 0xe7, 0xf5, 0x9d, 0x76,
-	0x6b, 0x35, 0x55, 0x2e, 0x1e, 0x9f, 0xd4, 0x0a, 0x0f, 0x19, 0x1f, 0x11, 0x87, 0xee, 0x53, 0xe2,
-	0xa2, 0x97, 0x60, 0xfd, 0x59, 0x69, 0xb9, 0x6a, 0xb7, 0x56, 0xad, 0xf2, 0xd2, 0xf1, 0x49, 0x2d,
-	0xa7, 0xe7, 0x13, 0xe2, 0xa2, 0x5b, 0xf0, 0xfc, 0xac, 0xdc, 0xce, 0x83, 0xef, 0xad, 0xa6, 0xcb,
-	0xcb, 0xc7, 0x27, 0xb5, 0x7c, 0x34, 0xc8, 0xa0, 0x3a, 0xa0, 0xa4, 0xa4, 0xc1, 0x5b, 0x28, 0xc3,
-	0xf1, 0x49, 0x2d, 0xab, 0x69, 0x2b, 0x67, 0xde, 0x7a, 0xb7, 0x92, 0x6a, 0xbe, 0xfe, 0xc1, 0x93,
-	0x8a, 0xf5, 0xf8, 0x49, 0xc5, 0xfa, 0xc7, 0x93, 0x8a, 0xf5, 0xf6, 0xd3, 0x4a, 0xea, 0xf1, 0xd3,
-	0x4a, 0xea, 0xcf, 0x4f, 0x2b, 0xa9, 0x9f, 0xbc, 0x7a, 0x21, 0x63, 0x47, 0xd1, 0x6f, 0xa9, 0x8a,
-	0xbb, 0x7e, 0x56, 0xb5, 0xc5, 0x6f, 0xfd, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x6e, 0xff, 0x65, 0x73,
-	0x6a, 0x15, 0x00, 0x00,
+	// 2175 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x52, 0x34, 0x45, 0x3e, 0x4a, 0xa2, 0x34, 0x96, 0xed, 0x95, 0xe0, 0xbf, 0xc8, 0xd0,
+	0xf9, 0xc7, 0x4e, 0x61, 0x53, 0xb1, 0x03, 0x04, 0xad, 0x50, 0xa0, 0x30, 0x45, 0x39, 0x56, 0x1d,
+	0xbb, 0xcc, 0x4a, 0x56, 0xd1, 0x34, 0xed, 0x76, 0xb8, 0x3b, 0xa2, 0xb6, 0x5a, 0xee, 0xb0, 0x3b,
+	0x43, 0x5b, 0x2c, 0x50, 0xa0, 0x40, 0x2e, 0xae, 0x4f, 0x3e, 0x15, 0x01, 0x0a, 0x03, 0x06, 0x92,
+	0x53, 0x91, 0x63, 0xd0, 0x43, 0x7b, 0xe8, 0x35, 0x4d, 0x2f, 0x46, 0x0e, 0x45, 0xd3, 0x16, 0x4a,
+	0x61, 0x1f, 0x5a, 0xf4, 0x54, 0xf8, 0xde, 0xa2, 0x98, 0x8f, 0xdd, 0xa5, 0x49, 0x51, 0x12, 0x53,
+	0x15, 0x08, 0x90, 0x8b, 0xcd, 0x79, 0xf3, 0xde, 0x6f, 0xde, 0xf7, 0xbe, 0x19, 0xc1, 0x8b, 0x0e,
+	0x65, 0x2d, 0xca, 0x96, 0x18, 0xc7, 0x3b, 0x5e, 0xd0, 0x5c, 0xba, 0x73, 0xb9, 0x41, 0x38, 0xbe,
+	0x1c, 0xad, 0x2b, 0xed, 0x90, 0x72, 0x8a, 0x4e, 0x2b, 0xae, 0x4a, 0x44, 0xd5, 0x5c, 0x0b, 0x73,
+	0x4d, 0xda, 0xa4, 0x92, 0x65, 0x49, 0xfc, 0x52, 0xdc, 0x0b, 0xf3, 0x4d, 0x4a, 0x9b, 0x3e, 0x59,
+	0x92, 0xab, 0x46, 0x67, 0x6b, 0x09, 0x07, 0x5d, 0xbd, 0xb5, 0xd8, 0xbf, 0xe5, 0x76, 0x42, 0xcc,
+	0x3d, 0x1a, 0xe8, 0xfd, 0x62, 0xff, 0x3e, 0xf7, 0x5a, 0x84, 0x71, 0xdc, 0x6a, 0x47, 0xd8, 0x4a,
+	0x13, 0x5b, 0x1d, 0xaa, 0xd5, 0xd2, 0xd8, 0xda, 0x94, 0x06, 0x66, 0x24, 0xb6, 0xc3, 0xa1, 0x5e,
+	0x84, 0x7d, 0x96, 0x93, 0xc0, 0x25, 0x61, 0xcb, 0x0b, 0xf8, 0x12, 0xef, 0xb6, 0x09, 0x53, 0xff,
+	0xaa, 0xdd, 0xf2, 0xcf, 0x0c, 0x98, 0xbe, 0xee, 0x31, 0x4e, 0x43, 0xcf, 0xc1, 0xfe, 0x5a, 0xb0,
+	0x45, 0xd1, 0x6b, 0x90, 0xd9, 0x26, 0xd8, 0x25, 0xa1, 0x69, 0x94, 0x8c, 0x0b, 0xf9, 0x2b, 0x66,
+	0x25, 0x41, 0xa8, 0x28, 0xd9, 0xeb, 0x72, 0xbf, 0x9a, 0xfe, 0x68, 0xaf, 0x38, 0x66, 0x69, 0x6e,
+	0xf4, 0x0d, 0xc8, 0xdc, 0xc1, 0x3e, 0x23, 0xdc, 0x4c, 0x95, 0xc6, 0x2f, 0xe4, 0xaf, 0xbc, 0x50,
+	0xd9, 0xdf, 0x7d, 0x95, 0x4d, 0xec, 0x7b, 0x2e, 0xe6, 0x34, 0x06, 0x50, 0x62, 0xe5, 0x0f, 0x52,
+	0x50, 0x58, 0xa1, 0xad, 0x96, 0xc7, 0x98, 0x47, 0x03, 0x0b, 0x73, 0xc2, 0x50, 0x1d, 0xd2, 0x21,
+	0xe6, 0x44, 0xaa, 0x92, 0xab, 0x7e, 0x5d, 0xf0, 0xff, 0x69, 0xaf, 0xf8, 0x52, 0xd3, 0xe3, 0xdb,
+	0x9d, 0x46, 0xc5, 0xa1, 0x2d, 0xed, 0x0c, 0xfd, 0xdf, 0x25, 0xe6, 0xee, 0x68, 0xfb, 0x6a, 0xc4,
+	0xf9, 0xe4, 0xc3, 0x4b, 0xa0, 0x75, 0xa8, 0x11, 0xc7, 0x92, 0x48, 0xe8, 0xdb, 0x90, 0x6d, 0xe1,
+	0x5d, 0x5b, 0xa2, 0xa6, 0x8e, 0x01, 0x75, 0xa2, 0x85, 0x77, 0x85, 0xae, 0xc8, 0x85, 0x82, 0x00,
+	0x76, 0xb6, 0x71, 0xd0, 0x24, 0x0a, 0x7f, 0xfc, 0x18, 0xf0, 0xa7, 0x5a, 0x78, 0x77, 0x45, 0x62,
+	0x8a, 0x53, 0x96, 0xb3, 0xef, 0x3e, 0x2a, 0x8e, 0xfd, 0xfd, 0x51, 0xd1, 0x28, 0xff, 0xc6, 0x00,
+	0x48, 0xdc, 0x85, 0xde, 0x86, 0x19, 0x27, 0x5e, 0xc9, 0xe3, 0x99, 0x0e, 0xe0, 0xf9, 0x61, 0x81,
+	0xe8, 0x73, 0x76, 0x35, 0x2b, 0x14, 0x7d, 0xbc, 0x57, 0x34, 0xac, 0x82, 0xd3, 0x17, 0x87, 0x55,
+	0xc8, 0x77, 0xda, 0x2e, 0xe6, 0xc4, 0x16, 0xa9, 0x29, 0x1d, 0x97, 0xbf, 0xb2, 0x50, 0x51, 0x79,
+	0x5b, 0x89, 0xf2, 0xb6, 0xb2, 0x11, 0xe5, 0xad, 0xc2, 0x7a, 0xf0, 0x59, 0xd1, 0xb0, 0x40, 0x09,
+	0x8a, 0xad, 0x1e, 0xed, 0x3f, 0x30, 0x20, 0x5f, 0x23, 0xcc, 0x09, 0xbd, 0xb6, 0x28, 0x04, 0x64,
+	0xc2, 0x44, 0x8b, 0x06, 0xde, 0x8e, 0x4e, 0xbb, 0x9c, 0x15, 0x2d, 0xd1, 0x02, 0x64, 0x3d, 0x97,
+	0x04, 0xdc, 0xe3, 0x5d, 0x15, 0x30, 0x2b, 0x5e, 0x0b, 0xa9, 0xbb, 0xa4, 0xc1, 0xbc, 0xc8, 0xd7,
+	0x56, 0xb4, 0x44, 0x2f, 0xc3, 0x0c, 0x23, 0x4e, 0x27, 0xf4, 0x78, 0xd7, 0x76, 0x68, 0xc0, 0xb1,
+	0xc3, 0xcd, 0xb4, 0x64, 0x29, 0x44, 0xf4, 0x15, 0x45, 0x16, 0x20, 0x2e, 0xe1, 0xd8, 0xf3, 0x99,
+	0x79, 0x42, 0x81, 0xe8, 0x65, 0x8f, 0xba, 0x7f, 0x33, 0x60, 0xee, 0x26, 0xe1, 0xd8, 0xc5, 0x1c,
+	0x6f, 0x92, 0xd0, 0xdb, 0xf2, 0x1c, 0x59, 0xc0, 0xe2, 0x1c, 0x7d, 0xa4, 0x7d, 0x47, 0xd2, 0x89,
+	0x2b, 0x0d, 0xc8, 0x5a, 0x05, 0x4d, 0xdf, 0xd4, 0x64, 0xb4, 0x0c, 0xf3, 0xfd, 0x2a, 0x25, 0x32,
+	0x29, 0x29, 0x73, 0xa6, 0x4f, 0xb7, 0x58, 0xf6, 0x05, 0x98, 0x8c, 0x8e, 0xd9, 0xc6, 0x6c, 0x5b,
+	0x5a, 0x3b, 0x69, 0xe5, 0x35, 0xed, 0x3a, 0x66, 0xdb, 0x22, 0x44, 0x11, 0x9a, 0x8d, 0x95, 0xb1,
+	0x47, 0x0e, 0x51, 0x24, 0x78, 0x95, 0x97, 0x7f, 0x97, 0x81, 0x5c, 0x5c, 0xa1, 0x68, 0x05, 0x66,
+	0x68, 0x9b, 0x84, 0xe2, 0xb7, 0x8d, 0x5d, 0x37, 0x24, 0x8c, 0xe9, 0x5a, 0x34, 0x3f, 0xf9, 0xf0,
+	0xd2, 0x9c, 0x4e, 0xac, 0xab, 0x6a, 0x67, 0x9d, 0x87, 0x5e, 0xd0, 0xb4, 0x0a, 0x91, 0x84, 0x26,
+	0xa3, 0xef, 0x88, 0xd4, 0x0c, 0x18, 0x09, 0x58, 0x87, 0xd9, 0xed, 0x4e, 0x63, 0x87, 0x74, 0x75,
+	0x06, 0xcd, 0x0d, 0xa8, 0x77, 0x35, 0xe8, 0x56, 0xcd, 0x8f, 0x13, 0x68, 0x27, 0xec, 0xb6, 0x39,
+	0xad, 0xd4, 0x3b, 0x8d, 0x1b, 0xa4, 0x6b, 0x15, 0x62, 0x9c, 0xba, 0x84, 0x41, 0xa7, 0x21, 0xf3,
+	0x43, 0xec, 0xf9, 0xc4, 0x95, 0x1e, 0xc9, 0x5a, 0x7a, 0x85, 0x96, 0x21, 0xc3, 0x38, 0xe6, 0x1d,
+	0x26, 0xfd, 0x30, 0x7d, 0xa5, 0x3c, 0xac, 0x06, 0xaa, 0x34, 0x70, 0xd7, 0x25, 0xa7, 0xa5, 0x25,
+	0xd0, 0x06, 0x64, 0x38, 0xdd, 0x21, 0x81, 0x4e, 0x87, 0x91, 0xea, 0x77, 0x2d, 0xe0, 0x3d, 0xf5,
+	0xbb, 0x16, 0x70, 0x4b, 0x63, 0xa1, 0x26, 0xcc, 0xb8, 0xc4, 0x27, 0x4d, 0xe9, 0x4a, 0xb6, 0x8d,
+	0x43, 0xc2, 0xcc, 0xcc, 0x31, 0xf4, 0x87, 0x42, 0x8c, 0xba, 0x2e, 0x41, 0xd1, 0x0d, 0xc8, 0xbb,
+	0x49, 0x61, 0x99, 0x13, 0xd2, 0xd1, 0xe7, 0x86, 0xd9, 0xdf, 0x53, 0x83, 0xba, 0x1d, 0xf7, 0x4a,
+	0x8b, 0xf4, 0xee, 0x04, 0x0d, 0x1a, 0xb8, 0x5e, 0xd0, 0xb4, 0xb7, 0x89, 0xd7, 0xdc, 0xe6, 0x66,
+	0xb6, 0x64, 0x5c, 0x18, 0xb7, 0x0a, 0x31, 0xfd, 0xba, 0x24, 0xa3, 0x1b, 0x30, 0x9d, 0xb0, 0xca,
+	0x2e, 0x91, 0x1b, 0x21, 0x05, 0xa7, 0x62, 0x59, 0xb1, 0x8b, 0xae, 0x03, 0x24, 0x2d, 0xc8, 0x04,
+	0x09, 0x54, 0x3e, 0xbc, 0x8f, 0x69, 0x13, 0x7a, 0x64, 0x91, 0x0f, 0x27, 0x5b, 0x5e, 0x60, 0x33,
+	0xe2, 0x6f, 0xd9, 0xda, 0x55, 0x02, 0x32, 0x7f, 0x0c, 0xa1, 0x9d, 0x6d, 0x79, 0xc1, 0x3a, 0xf1,
+	0xb7, 0x6a, 0x31, 0xec, 0xf2, 0xe4, 0xbd, 0x47, 0xc5, 0x31, 0xdd, 0x35, 0xc6, 0xca, 0x75, 0x98,
+	0xdc, 0xc4, 0xbe, 0x2e, 0x03, 0xc2, 0xd0, 0x6b, 0x90, 0xc3, 0xd1, 0xc2, 0x34, 0x4a, 0xe3, 0x07,
+	0x96, 0x51, 0xc2, 0xaa, 0xfa, 0xd0, 0x4f, 0xff, 0x52, 0x32, 0xca, 0xef, 0x1b, 0x90, 0xa9, 0x6d,
+	0xd6, 0xb1, 0x17, 0xa2, 0x55, 0x98, 0x4d, 0x12, 0xea, 0xa8, 0xb5, 0x99, 0xe4, 0x60, 0x54, 0x9c,
+	0xab, 0x30, 0x7b, 0x27, 0x2a, 0xf7, 0x18, 0x26, 0x75, 0x18, 0x4c, 0x2c, 0xa2, 0xe9, 0x7d, 0x86,
+	0xaf, 0xc2, 0x84, 0xd2, 0x92, 0xa1, 0x65, 0x38, 0xd1, 0x16, 0x3f, 0xa4, 0xbd, 0xf9, 0x2b, 0x8b,
+	0x43, 0x13, 0x51, 0xf2, 0xeb, 0x00, 0x2a, 0x91, 0xf2, 0xbf, 0x0c, 0x80, 0xda, 0xe6, 0xe6, 0x46,
+	0xe8, 0xb5, 0x7d, 0xc2, 0x8f, 0xcb, 0xe2, 0x37, 0xe0, 0x54, 0x62, 0x31, 0x0b, 0x9d, 0x23, 0x5b,
+	0x7d, 0x32, 0x16, 0x5b, 0x0f, 0x9d, 0x7d, 0xd1, 0x5c, 0xc6, 0x63, 0xb4, 0xf1, 0x23, 0xa3, 0xd5,
+	0x18, 0xdf, 0xdf, 0x8d, 0xeb, 0x90, 0x4f, 0xcc, 0x67, 0xa8, 0x06, 0x59, 0xae, 0x7f, 0x6b, 0x6f,
+	0x96, 0x87, 0x7b, 0x33, 0x12, 0xd3, 0x1e, 0x8d, 0x25, 0xcb, 0xff, 0x16, 0x4e, 0x8d, 0x33, 0xf6,
+	0x8b, 0x95, 0x46, 0xa2, 0xf7, 0xea, 0xde, 0x78, 0x1c, 0xb3, 0x93, 0xc6, 0xea, 0xf3, 0xea, 0x3b,
+	0x29, 0x38, 0x79, 0x3b, 0xea, 0x36, 0x5f, 0x58, 0x4f, 0xd4, 0x61, 0x82, 0x04, 0x3c, 0xf4, 0xa4,
+	0x2b, 0x44, 0xac, 0x5f, 0x19, 0x16, 0xeb, 0x7d, 0x6c, 0x59, 0x0d, 0x78, 0xd8, 0xd5, 0x91, 0x8f,
+	0x60, 0xfa, 0xbc, 0xf0, 0xe7, 0x14, 0x98, 0xc3, 0x24, 0xd1, 0x79, 0x28, 0x38, 0x21, 0x91, 0x84,
+	0xa8, 0xeb, 0x1b, 0xb2, 0xeb, 0x4f, 0x47, 0x64, 0xdd, 0xf4, 0x6f, 0x82, 0x18, 0x15, 0x45, 0x62,
+	0x09, 0xd6, 0x91, 0x67, 0xc3, 0xe9, 0x44, 0x58, 0x6c, 0x23, 0x02, 0x05, 0x2f, 0xf0, 0xb8, 0x87,
+	0x7d, 0xbb, 0x81, 0x7d, 0x1c, 0x38, 0x9f, 0x67, 0x86, 0x1e, 0x6c, 0xd4, 0xd3, 0x1a, 0xb4, 0xaa,
+	0x30, 0xd1, 0x26, 0x4c, 0x44, 0xf0, 0xe9, 0x63, 0x80, 0x8f, 0xc0, 0x7a, 0xe6, 0xc5, 0x4f, 0x53,
+	0x30, 0x6b, 0x11, 0xf7, 0xcb, 0xe5, 0xd6, 0xef, 0x02, 0xa8, 0x82, 0x13, 0x7d, 0xd0, 0x4c, 0x1f,
+	0x43, 0x01, 0xe7, 0x14, 0x5e, 0x8d, 0xf1, 0x1e, 0xdf, 0x7e, 0x9c, 0x82, 0xc9, 0x5e, 0xdf, 0x7e,
+	0x09, 0xbe, 0x0b, 0x68, 0x2d, 0xe9, 0x06, 0x69, 0xd9, 0x0d, 0x5e, 0x1e, 0xd6, 0x0d, 0x06, 0xb2,
+	0xee, 0xe0, 0x36, 0xf0, 0x87, 0x1c, 0x64, 0xea, 0x38, 0xc4, 0x2d, 0x86, 0xbe, 0x39, 0x30, 0xc0,
+	0xa9, 0xfb, 0xe3, 0xfc, 0x40, 0xce, 0xd5, 0xf4, 0xf3, 0x85, 0x4a, 0xb9, 0x77, 0xf7, 0x99, 0xdf,
+	0xfe, 0x1f, 0xa6, 0xc5, 0x65, 0x38, 0x36, 0x45, 0x39, 0x71, 0x4a, 0xde, 0x66, 0xe3, 0xdb, 0x05,
+	0x43, 0x45, 0xc8, 0x0b, 0xb6, 0xa4, 0xd1, 0x09, 0x1e, 0x68, 0xe1, 0xdd, 0x55, 0x45, 0x41, 0x97,
+	0x00, 0x6d, 0xc7, 0xcf, 0x13, 0x76, 0xe2, 0x02, 0xc1, 0x37, 0x9b, 0xec, 0x44, 0xec, 0xff, 0x07,
+	0x20, 0xb4, 0xb0, 0x5d, 0x12, 0xd0, 0x96, 0xbe, 0xcd, 0xe5, 0x04, 0xa5, 0x26, 0x08, 0xe8, 0xbe,
+	0xa1, 0x86, 0xc1, 0xbe, 0x8b, 0xb2, 0x9e, 0xc3, 0xdf, 0x1a, 0x2d, 0x55, 0x9f, 0xed, 0x15, 0x17,
+	0xba, 0xb8, 0xe5, 0x2f, 0x97, 0xf7, 0x81, 0x2c, 0xf7, 0x25, 0xb2, 0x18, 0x15, 0x9f, 0xbf, 0x6e,
+	0xa3, 0x77, 0x0c, 0x98, 0xef, 0xb1, 0x2d, 0x24, 0x9c, 0x04, 0x49, 0xb9, 0x4f, 0x1c, 0xe6, 0xfa,
+	0x8b, 0x42, 0xdb, 0x67, 0x7b, 0xc5, 0x92, 0xd2, 0x61, 0x28, 0x52, 0x59, 0x86, 0xe7, 0x4c, 0xb2,
+	0x6f, 0x45, 0xdb, 0x32, 0x50, 0xbf, 0x30, 0x60, 0xbe, 0xe9, 0xd3, 0x06, 0xf6, 0x6d, 0xdf, 0xfb,
+	0x51, 0xc7, 0x73, 0x6d, 0x9d, 0x50, 0xb6, 0x83, 0xdb, 0x72, 0xd4, 0xcf, 0x55, 0x7f, 0x30, 0xb2,
+	0x63, 0xb4, 0x52, 0x43, 0x81, 0xfb, 0xdd, 0x73, 0x5a, 0x71, 0xbe, 0x21, 0x19, 0xd7, 0x15, 0xdf,
+	0x0a, 0x6e, 0xa3, 0xf7, 0x0d, 0x38, 0x9b, 0x54, 0xd1, 0x3e, 0x0a, 0xe6, 0xa4, 0x82, 0xce, 0xc8,
+	0x0a, 0x9e, 0x53, 0x0a, 0x1e, 0x84, 0xdd, 0xaf, 0xe3, 0x7c, 0xcc, 0x3c, 0xa0, 0xe6, 0xcf, 0x0d,
+	0x28, 0xed, 0x73, 0xc9, 0xb0, 0x9b, 0x21, 0x76, 0x88, 0xdd, 0x26, 0xa1, 0x47, 0x5d, 0x13, 0x0e,
+	0x8b, 0xe8, 0xab, 0x3a, 0xa2, 0xe7, 0x93, 0xac, 0x3a, 0x08, 0x50, 0x05, 0xf6, 0xec, 0xc0, 0x1d,
+	0xe4, 0x75, 0xc1, 0x53, 0x97, 0x2c, 0xe8, 0x81, 0x01, 0x33, 0x3b, 0xa4, 0x6b, 0x87, 0x94, 0x2b,
+	0x80, 0x2d, 0x42, 0xcc, 0xbc, 0x6c, 0x20, 0xf3, 0x51, 0x03, 0x11, 0x0f, 0x87, 0x3d, 0x57, 0x29,
+	0x2f, 0xa8, 0xde, 0xd0, 0x8a, 0x9c, 0x51, 0x8a, 0xf4, 0x03, 0x94, 0x7f, 0xf9, 0x59, 0xf1, 0xc2,
+	0x11, 0x3c, 0x2d, 0xb0, 0x98, 0x35, 0xbd, 0x43, 0xba, 0x96, 0x96, 0xbe, 0x46, 0x08, 0x7a, 0x1b,
+	0x4c, 0x51, 0xf2, 0x2d, 0xcc, 0xc5, 0x43, 0x87, 0x47, 0x98, 0x30, 0xc7, 0x6e, 0xf8, 0xd4, 0xd9,
+	0x31, 0x27, 0x45, 0x5d, 0x57, 0xcf, 0x3d, 0xdb, 0x2b, 0x16, 0xb5, 0x0f, 0x86, 0x70, 0x96, 0xad,
+	0x53, 0x2d, 0xbc, 0x7b, 0x33, 0xde, 0xa9, 0x93, 0xb0, 0x2a, 0xe8, 0x3d, 0x5f, 0x89, 0xf7, 0x0c,
+	0x40, 0x89, 0x53, 0x2c, 0xc2, 0xda, 0x34, 0x60, 0xf2, 0x62, 0xd9, 0x73, 0x0b, 0x34, 0x0e, 0xbe,
+	0x58, 0x26, 0xf2, 0xd1, 0xc5, 0x32, 0x91, 0x45, 0x5f, 0x4b, 0x86, 0x88, 0x94, 0x0e, 0xed, 0x50,
+	0x8f, 0xea, 0x16, 0xdc, 0x3f, 0x27, 0x8c, 0x95, 0x3f, 0x35, 0x60, 0x7e, 0xa0, 0x63, 0xc7, 0xca,
+	0x7e, 0x1f, 0x50, 0xd8, 0xb3, 0x29, 0xfb, 0x5f, 0x57, 0x2b, 0x3d, 0xf2, 0x07, 0x60, 0x36, 0xec,
+	0xdf, 0xf8, 0x9f, 0xcd, 0x41, 0x69, 0x19, 0x81, 0xdf, 0x1a, 0x30, 0xd7, 0xab, 0x4c, 0x6c, 0xd6,
+	0x2d, 0x98, 0xec, 0xd5, 0x45, 0x1b, 0xf4, 0xe2, 0x51, 0x0c, 0xd2, 0xb6, 0x3c, 0x27, 0x8f, 0xde,
+	0x4c, 0x3e, 0x8e, 0xea, 0xe9, 0xf9, 0xf2, 0x91, 0x7d, 0x13, 0xe9, 0xd4, 0xff, 0x91, 0x4c, 0x47,
+	0x37, 0x85, 0x74, 0x9d, 0x52, 0x1f, 0xfd, 0x04, 0x66, 0x03, 0xca, 0x6d, 0xf1, 0x25, 0x21, 0xae,
+	0xad, 0x5f, 0x87, 0xd4, 0x84, 0xf1, 0xe6, 0x68, 0x2e, 0xfb, 0xc7, 0x5e, 0x71, 0x10, 0xaa, 0xcf,
+	0x8f, 0x85, 0x80, 0xf2, 0xaa, 0xdc, 0xdf, 0x90, 0xdb, 0x28, 0x84, 0xa9, 0xe7, 0x8f, 0x56, 0x13,
+	0xc9, 0xcd, 0x91, 0x8f, 0x9e, 0x3a, 0xe8, 0xd8, 0xc9, 0x46, 0xcf, 0x99, 0xcb, 0x59, 0x11, 0xc3,
+	0x7f, 0x8a, 0x38, 0xfe, 0x7e, 0x1c, 0xe6, 0x57, 0x68, 0xc0, 0xf4, 0x1b, 0x9c, 0xae, 0x65, 0xf5,
+	0x57, 0x83, 0xee, 0xf1, 0xbc, 0x10, 0x6e, 0x42, 0x81, 0xfa, 0xae, 0x78, 0x15, 0xfd, 0x2f, 0x1f,
+	0x08, 0xa7, 0xa8, 0xef, 0x6a, 0x5d, 0xc5, 0xf3, 0xe0, 0x26, 0x14, 0x02, 0x72, 0xf7, 0x39, 0xdc,
+	0xf1, 0xcf, 0x87, 0x1b, 0x90, 0xbb, 0x3d, 0xb8, 0xa7, 0xc5, 0xdf, 0x48, 0xe4, 0xfc, 0x9e, 0x96,
+	0xf3, 0xbb, 0x5e, 0xa1, 0xaf, 0x42, 0x5a, 0x7e, 0xbd, 0x4f, 0x8c, 0x30, 0xac, 0x4b, 0x09, 0xf4,
+	0x3d, 0x18, 0x17, 0xbd, 0x39, 0x73, 0x58, 0x6f, 0x7e, 0x45, 0xc8, 0x8d, 0xd4, 0x80, 0x05, 0xee,
+	0x72, 0xf6, 0x5e, 0xd4, 0x71, 0x7e, 0x6d, 0xc0, 0x49, 0x19, 0x62, 0xef, 0xc7, 0x44, 0xbe, 0x18,
+	0x5a, 0xc4, 0xa1, 0xa1, 0x8b, 0xa6, 0x21, 0xe5, 0xa9, 0xa7, 0xeb, 0xb4, 0x95, 0xf2, 0x5c, 0x54,
+	0x81, 0x13, 0xf4, 0x6e, 0x40, 0xc2, 0x43, 0xa7, 0x5f, 0xc5, 0x26, 0x27, 0x3e, 0xea, 0x76, 0x7c,
+	0x62, 0x63, 0xc7, 0xa1, 0x9d, 0x80, 0xeb, 0x17, 0xf9, 0x29, 0x45, 0xbd, 0xaa, 0x88, 0xe2, 0x09,
+	0x2c, 0xfe, 0x8e, 0x9a, 0xe9, 0x43, 0xa0, 0x13, 0x56, 0xd5, 0x52, 0xbe, 0xf2, 0x2b, 0x03, 0x20,
+	0x79, 0xb1, 0x45, 0x17, 0xe1, 0x4c, 0xf5, 0x5b, 0xb7, 0x6a, 0xf6, 0xfa, 0xc6, 0xd5, 0x8d, 0xdb,
+	0xeb, 0xf6, 0xed, 0x5b, 0xeb, 0xf5, 0xd5, 0x95, 0xb5, 0x6b, 0x6b, 0xab, 0xb5, 0x99, 0xb1, 0x85,
+	0xc2, 0xfd, 0x87, 0xa5, 0xfc, 0xed, 0x80, 0xb5, 0x89, 0xa3, 0xde, 0xd0, 0x5f, 0x82, 0xb9, 0xe7,
+	0xb9, 0xc5, 0x6a, 0xb5, 0x36, 0x63, 0x2c, 0x4c, 0xde, 0x7f, 0x58, 0xca, 0xaa, 0xcb, 0x30, 0x71,
+	0xd1, 0x05, 0x38, 0x35, 0xc8, 0xb7, 0x76, 0xeb, 0xf5, 0x99, 0xd4, 0xc2, 0xd4, 0xfd, 0x87, 0xa5,
+	0x5c, 0x7c, 0x6b, 0x46, 0x65, 0x40, 0xbd, 0x9c, 0x1a, 0x6f, 0x7c, 0x01, 0xee, 0x3f, 0x2c, 0x65,
+	0x54, 0x05, 0x2f, 0xa4, 0xef, 0xbd, 0xb7, 0x38, 0x56, 0xbd, 0xf6, 0xd1, 0x93, 0x45, 0xe3, 0xf1,
+	0x93, 0x45, 0xe3, 0xaf, 0x4f, 0x16, 0x8d, 0x07, 0x4f, 0x17, 0xc7, 0x1e, 0x3f, 0x5d, 0x1c, 0xfb,
+	0xe3, 0xd3, 0xc5, 0xb1, 0xb7, 0x2e, 0x1e, 0x18, 0xc8, 0xdd, 0xf8, 0x4f, 0x94, 0x32, 0xa4, 0x8d,
+	0x8c, 0x4c, 0xa5, 0x57, 0xff, 0x33, 0x00, 0x7d, 0xa5, 0xe8, 0x68, 0xc1, 0x1c, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1250,608 +1546,496 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 9615 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x7b, 0x70, 0x24, 0xc7,
-		0x79, 0x1f, 0x66, 0x5f, 0xd8, 0xfd, 0xb0, 0x00, 0x16, 0x0d, 0xdc, 0x71, 0x6f, 0x49, 0x02, 0xe0,
-		0xf2, 0x71, 0xc7, 0x23, 0x89, 0x23, 0x8f, 0xbc, 0xd7, 0x9e, 0x24, 0x0a, 0x0b, 0xec, 0xe1, 0xc0,
-		0xc3, 0x8b, 0x03, 0xe0, 0xf8, 0x90, 0x9d, 0xad, 0xc1, 0x6c, 0x63, 0x31, 0xc4, 0xec, 0xcc, 0x68,
-		0x66, 0xf6, 0x78, 0x38, 0x45, 0x29, 0xca, 0x4a, 0x1c, 0x8a, 0xa9, 0x24, 0x72, 0x94, 0x8a, 0x25,
-		0x59, 0xa7, 0x50, 0x96, 0x12, 0x39, 0xb2, 0x9c, 0x48, 0x16, 0xa5, 0xf8, 0x91, 0x72, 0xa4, 0x54,
-		0x39, 0x96, 0xf4, 0x47, 0x4a, 0x72, 0x52, 0xb1, 0xe5, 0x38, 0x94, 0x42, 0xa9, 0x6c, 0x85, 0x51,
-		0x62, 0x25, 0x61, 0xca, 0x49, 0xa9, 0x94, 0x4a, 0xf5, 0x6b, 0x1e, 0xfb, 0x9a, 0x05, 0x88, 0x93,
-		0xe9, 0xe8, 0x2f, 0x6c, 0x77, 0x7f, 0xdf, 0xaf, 0xbf, 0xfe, 0xfa, 0xeb, 0xaf, 0xbf, 0x7e, 0x0d,
-		0xe0, 0xf3, 0x17, 0x61, 0xba, 0x6e, 0x9a, 0x75, 0x1d, 0x9f, 0xb2, 0x6c, 0xd3, 0x35, 0xb7, 0x9a,
-		0xdb, 0xa7, 0x6a, 0xd8, 0x51, 0x6d, 0xcd, 0x72, 0x4d, 0x7b, 0x86, 0xe6, 0xa1, 0x51, 0x46, 0x31,
-		0x23, 0x28, 0x8a, 0xcb, 0x30, 0x76, 0x49, 0xd3, 0xf1, 0xbc, 0x47, 0xb8, 0x8e, 0x5d, 0x74, 0x1e,
-		0x12, 0xdb, 0x9a, 0x8e, 0xf3, 0xd2, 0x74, 0xfc, 0xc4, 0xd0, 0xe9, 0x7b, 0x66, 0x5a, 0x98, 0x66,
-		0xc2, 0x1c, 0x6b, 0x24, 0x5b, 0xa6, 0x1c, 0xc5, 0xef, 0x25, 0x60, 0xbc, 0x43, 0x29, 0x42, 0x90,
-		0x30, 0x94, 0x06, 0x41, 0x94, 0x4e, 0x64, 0x64, 0xfa, 0x1b, 0xe5, 0x61, 0xd0, 0x52, 0xd4, 0x5d,
-		0xa5, 0x8e, 0xf3, 0x31, 0x9a, 0x2d, 0x92, 0x68, 0x12, 0xa0, 0x86, 0x2d, 0x6c, 0xd4, 0xb0, 0xa1,
-		0xee, 0xe5, 0xe3, 0xd3, 0xf1, 0x13, 0x19, 0x39, 0x90, 0x83, 0x1e, 0x80, 0x31, 0xab, 0xb9, 0xa5,
-		0x6b, 0x6a, 0x35, 0x40, 0x06, 0xd3, 0xf1, 0x13, 0x49, 0x39, 0xc7, 0x0a, 0xe6, 0x7d, 0xe2, 0xe3,
-		0x30, 0xfa, 0x3c, 0x56, 0x76, 0x83, 0xa4, 0x43, 0x94, 0x74, 0x84, 0x64, 0x07, 0x08, 0xe7, 0x20,
-		0xdb, 0xc0, 0x8e, 0xa3, 0xd4, 0x71, 0xd5, 0xdd, 0xb3, 0x70, 0x3e, 0x41, 0x5b, 0x3f, 0xdd, 0xd6,
-		0xfa, 0xd6, 0x96, 0x0f, 0x71, 0xae, 0x8d, 0x3d, 0x0b, 0xa3, 0x59, 0xc8, 0x60, 0xa3, 0xd9, 0x60,
-		0x08, 0xc9, 0x2e, 0xfa, 0xab, 0x18, 0xcd, 0x46, 0x2b, 0x4a, 0x9a, 0xb0, 0x71, 0x88, 0x41, 0x07,
-		0xdb, 0xd7, 0x34, 0x15, 0xe7, 0x53, 0x14, 0xe0, 0x78, 0x1b, 0xc0, 0x3a, 0x2b, 0x6f, 0xc5, 0x10,
-		0x7c, 0x68, 0x0e, 0x32, 0xf8, 0xba, 0x8b, 0x0d, 0x47, 0x33, 0x8d, 0xfc, 0x20, 0x05, 0xb9, 0xb7,
-		0x43, 0x2f, 0x62, 0xbd, 0xd6, 0x0a, 0xe1, 0xf3, 0xa1, 0xb3, 0x30, 0x68, 0x5a, 0xae, 0x66, 0x1a,
-		0x4e, 0x3e, 0x3d, 0x2d, 0x9d, 0x18, 0x3a, 0x7d, 0x47, 0x47, 0x43, 0x58, 0x65, 0x34, 0xb2, 0x20,
-		0x46, 0x8b, 0x90, 0x73, 0xcc, 0xa6, 0xad, 0xe2, 0xaa, 0x6a, 0xd6, 0x70, 0x55, 0x33, 0xb6, 0xcd,
-		0x7c, 0x86, 0x02, 0x4c, 0xb5, 0x37, 0x84, 0x12, 0xce, 0x99, 0x35, 0xbc, 0x68, 0x6c, 0x9b, 0xf2,
-		0x88, 0x13, 0x4a, 0xa3, 0xa3, 0x90, 0x72, 0xf6, 0x0c, 0x57, 0xb9, 0x9e, 0xcf, 0x52, 0x0b, 0xe1,
-		0xa9, 0xe2, 0x6f, 0xa6, 0x60, 0xb4, 0x1f, 0x13, 0xbb, 0x08, 0xc9, 0x6d, 0xd2, 0xca, 0x7c, 0x6c,
-		0x3f, 0x3a, 0x60, 0x3c, 0x61, 0x25, 0xa6, 0x0e, 0xa8, 0xc4, 0x59, 0x18, 0x32, 0xb0, 0xe3, 0xe2,
-		0x1a, 0xb3, 0x88, 0x78, 0x9f, 0x36, 0x05, 0x8c, 0xa9, 0xdd, 0xa4, 0x12, 0x07, 0x32, 0xa9, 0xa7,
-		0x61, 0xd4, 0x13, 0xa9, 0x6a, 0x2b, 0x46, 0x5d, 0xd8, 0xe6, 0xa9, 0x28, 0x49, 0x66, 0x2a, 0x82,
-		0x4f, 0x26, 0x6c, 0xf2, 0x08, 0x0e, 0xa5, 0xd1, 0x3c, 0x80, 0x69, 0x60, 0x73, 0xbb, 0x5a, 0xc3,
-		0xaa, 0x9e, 0x4f, 0x77, 0xd1, 0xd2, 0x2a, 0x21, 0x69, 0xd3, 0x92, 0xc9, 0x72, 0x55, 0x1d, 0x5d,
-		0xf0, 0x4d, 0x6d, 0xb0, 0x8b, 0xa5, 0x2c, 0xb3, 0x41, 0xd6, 0x66, 0x6d, 0x9b, 0x30, 0x62, 0x63,
-		0x62, 0xf7, 0xb8, 0xc6, 0x5b, 0x96, 0xa1, 0x42, 0xcc, 0x44, 0xb6, 0x4c, 0xe6, 0x6c, 0xac, 0x61,
-		0xc3, 0x76, 0x30, 0x89, 0xee, 0x06, 0x2f, 0xa3, 0x4a, 0xcd, 0x0a, 0xa8, 0x17, 0xca, 0x8a, 0xcc,
-		0x15, 0xa5, 0x81, 0x0b, 0x37, 0x60, 0x24, 0xac, 0x1e, 0x34, 0x01, 0x49, 0xc7, 0x55, 0x6c, 0x97,
-		0x5a, 0x61, 0x52, 0x66, 0x09, 0x94, 0x83, 0x38, 0x36, 0x6a, 0xd4, 0xcb, 0x25, 0x65, 0xf2, 0x13,
-		0xbd, 0xd3, 0x6f, 0x70, 0x9c, 0x36, 0xf8, 0xbe, 0xf6, 0x1e, 0x0d, 0x21, 0xb7, 0xb6, 0xbb, 0x70,
-		0x0e, 0x86, 0x43, 0x0d, 0xe8, 0xb7, 0xea, 0xe2, 0x5f, 0x85, 0x23, 0x1d, 0xa1, 0xd1, 0xd3, 0x30,
-		0xd1, 0x34, 0x34, 0xc3, 0xc5, 0xb6, 0x65, 0x63, 0x62, 0xb1, 0xac, 0xaa, 0xfc, 0x9f, 0x0e, 0x76,
-		0xb1, 0xb9, 0xcd, 0x20, 0x35, 0x43, 0x91, 0xc7, 0x9b, 0xed, 0x99, 0x27, 0x33, 0xe9, 0xef, 0x0f,
-		0xe6, 0x5e, 0x78, 0xe1, 0x85, 0x17, 0x62, 0xc5, 0xaf, 0xa4, 0x60, 0xa2, 0xd3, 0x98, 0xe9, 0x38,
-		0x7c, 0x8f, 0x42, 0xca, 0x68, 0x36, 0xb6, 0xb0, 0x4d, 0x95, 0x94, 0x94, 0x79, 0x0a, 0xcd, 0x42,
-		0x52, 0x57, 0xb6, 0xb0, 0x9e, 0x4f, 0x4c, 0x4b, 0x27, 0x46, 0x4e, 0x3f, 0xd0, 0xd7, 0xa8, 0x9c,
-		0x59, 0x22, 0x2c, 0x32, 0xe3, 0x44, 0xef, 0x80, 0x04, 0x77, 0xd1, 0x04, 0xe1, 0x64, 0x7f, 0x08,
-		0x64, 0x2c, 0xc9, 0x94, 0x0f, 0xdd, 0x0e, 0x19, 0xf2, 0x97, 0xd9, 0x46, 0x8a, 0xca, 0x9c, 0x26,
-		0x19, 0xc4, 0x2e, 0x50, 0x01, 0xd2, 0x74, 0x98, 0xd4, 0xb0, 0x98, 0xda, 0xbc, 0x34, 0x31, 0xac,
-		0x1a, 0xde, 0x56, 0x9a, 0xba, 0x5b, 0xbd, 0xa6, 0xe8, 0x4d, 0x4c, 0x0d, 0x3e, 0x23, 0x67, 0x79,
-		0xe6, 0x55, 0x92, 0x87, 0xa6, 0x60, 0x88, 0x8d, 0x2a, 0xcd, 0xa8, 0xe1, 0xeb, 0xd4, 0x7b, 0x26,
-		0x65, 0x36, 0xd0, 0x16, 0x49, 0x0e, 0xa9, 0xfe, 0x39, 0xc7, 0x34, 0x84, 0x69, 0xd2, 0x2a, 0x48,
-		0x06, 0xad, 0xfe, 0x5c, 0xab, 0xe3, 0xbe, 0xb3, 0x73, 0xf3, 0xda, 0xc6, 0xd2, 0x71, 0x18, 0xa5,
-		0x14, 0x8f, 0xf2, 0xae, 0x57, 0xf4, 0xfc, 0xd8, 0xb4, 0x74, 0x22, 0x2d, 0x8f, 0xb0, 0xec, 0x55,
-		0x9e, 0x5b, 0xfc, 0x52, 0x0c, 0x12, 0xd4, 0xb1, 0x8c, 0xc2, 0xd0, 0xc6, 0x33, 0x6b, 0x95, 0xea,
-		0xfc, 0xea, 0x66, 0x79, 0xa9, 0x92, 0x93, 0xd0, 0x08, 0x00, 0xcd, 0xb8, 0xb4, 0xb4, 0x3a, 0xbb,
-		0x91, 0x8b, 0x79, 0xe9, 0xc5, 0x95, 0x8d, 0xb3, 0x8f, 0xe5, 0xe2, 0x1e, 0xc3, 0x26, 0xcb, 0x48,
-		0x04, 0x09, 0x1e, 0x3d, 0x9d, 0x4b, 0xa2, 0x1c, 0x64, 0x19, 0xc0, 0xe2, 0xd3, 0x95, 0xf9, 0xb3,
-		0x8f, 0xe5, 0x52, 0xe1, 0x9c, 0x47, 0x4f, 0xe7, 0x06, 0xd1, 0x30, 0x64, 0x68, 0x4e, 0x79, 0x75,
-		0x75, 0x29, 0x97, 0xf6, 0x30, 0xd7, 0x37, 0xe4, 0xc5, 0x95, 0x85, 0x5c, 0xc6, 0xc3, 0x5c, 0x90,
-		0x57, 0x37, 0xd7, 0x72, 0xe0, 0x21, 0x2c, 0x57, 0xd6, 0xd7, 0x67, 0x17, 0x2a, 0xb9, 0x21, 0x8f,
-		0xa2, 0xfc, 0xcc, 0x46, 0x65, 0x3d, 0x97, 0x0d, 0x89, 0xf5, 0xe8, 0xe9, 0xdc, 0xb0, 0x57, 0x45,
-		0x65, 0x65, 0x73, 0x39, 0x37, 0x82, 0xc6, 0x60, 0x98, 0x55, 0x21, 0x84, 0x18, 0x6d, 0xc9, 0x3a,
-		0xfb, 0x58, 0x2e, 0xe7, 0x0b, 0xc2, 0x50, 0xc6, 0x42, 0x19, 0x67, 0x1f, 0xcb, 0xa1, 0xe2, 0x1c,
-		0x24, 0xa9, 0x19, 0x22, 0x04, 0x23, 0x4b, 0xb3, 0xe5, 0xca, 0x52, 0x75, 0x75, 0x6d, 0x63, 0x71,
-		0x75, 0x65, 0x76, 0x29, 0x27, 0xf9, 0x79, 0x72, 0xe5, 0xc9, 0xcd, 0x45, 0xb9, 0x32, 0x9f, 0x8b,
-		0x05, 0xf3, 0xd6, 0x2a, 0xb3, 0x1b, 0x95, 0xf9, 0x5c, 0xbc, 0xa8, 0xc2, 0x44, 0x27, 0x87, 0xda,
-		0x71, 0x08, 0x05, 0x6c, 0x21, 0xd6, 0xc5, 0x16, 0x28, 0x56, 0xab, 0x2d, 0x14, 0xbf, 0x1b, 0x83,
-		0xf1, 0x0e, 0x93, 0x4a, 0xc7, 0x4a, 0x1e, 0x87, 0x24, 0xb3, 0x65, 0x36, 0xcd, 0xde, 0xdf, 0x71,
-		0x76, 0xa2, 0x96, 0xdd, 0x36, 0xd5, 0x52, 0xbe, 0x60, 0xa8, 0x11, 0xef, 0x12, 0x6a, 0x10, 0x88,
-		0x36, 0x83, 0xfd, 0xd9, 0x36, 0xe7, 0xcf, 0xe6, 0xc7, 0xb3, 0xfd, 0xcc, 0x8f, 0x34, 0x6f, 0x7f,
-		0x93, 0x40, 0xb2, 0xc3, 0x24, 0x70, 0x11, 0xc6, 0xda, 0x80, 0xfa, 0x76, 0xc6, 0xef, 0x97, 0x20,
-		0xdf, 0x4d, 0x39, 0x11, 0x2e, 0x31, 0x16, 0x72, 0x89, 0x17, 0x5b, 0x35, 0x78, 0x57, 0xf7, 0x4e,
-		0x68, 0xeb, 0xeb, 0x4f, 0x4b, 0x70, 0xb4, 0x73, 0x48, 0xd9, 0x51, 0x86, 0x77, 0x40, 0xaa, 0x81,
-		0xdd, 0x1d, 0x53, 0x84, 0x55, 0xf7, 0x75, 0x98, 0xac, 0x49, 0x71, 0x6b, 0x67, 0x73, 0xae, 0xe0,
-		0x6c, 0x1f, 0xef, 0x16, 0x17, 0x32, 0x69, 0xda, 0x24, 0xfd, 0x40, 0x0c, 0x8e, 0x74, 0x04, 0xef,
-		0x28, 0xe8, 0x9d, 0x00, 0x9a, 0x61, 0x35, 0x5d, 0x16, 0x3a, 0x31, 0x4f, 0x9c, 0xa1, 0x39, 0xd4,
-		0x79, 0x11, 0x2f, 0xdb, 0x74, 0xbd, 0xf2, 0x38, 0x2d, 0x07, 0x96, 0x45, 0x09, 0xce, 0xfb, 0x82,
-		0x26, 0xa8, 0xa0, 0x93, 0x5d, 0x5a, 0xda, 0x66, 0x98, 0x0f, 0x43, 0x4e, 0xd5, 0x35, 0x6c, 0xb8,
-		0x55, 0xc7, 0xb5, 0xb1, 0xd2, 0xd0, 0x8c, 0x3a, 0x9d, 0x6a, 0xd2, 0xa5, 0xe4, 0xb6, 0xa2, 0x3b,
-		0x58, 0x1e, 0x65, 0xc5, 0xeb, 0xa2, 0x94, 0x70, 0x50, 0x03, 0xb2, 0x03, 0x1c, 0xa9, 0x10, 0x07,
-		0x2b, 0xf6, 0x38, 0x8a, 0xbf, 0x90, 0x81, 0xa1, 0x40, 0x00, 0x8e, 0xee, 0x82, 0xec, 0x73, 0xca,
-		0x35, 0xa5, 0x2a, 0x16, 0x55, 0x4c, 0x13, 0x43, 0x24, 0x6f, 0x8d, 0x2f, 0xac, 0x1e, 0x86, 0x09,
-		0x4a, 0x62, 0x36, 0x5d, 0x6c, 0x57, 0x55, 0x5d, 0x71, 0x1c, 0xaa, 0xb4, 0x34, 0x25, 0x45, 0xa4,
-		0x6c, 0x95, 0x14, 0xcd, 0x89, 0x12, 0x74, 0x06, 0xc6, 0x29, 0x47, 0xa3, 0xa9, 0xbb, 0x9a, 0xa5,
-		0xe3, 0x2a, 0x59, 0xe6, 0x39, 0x74, 0xca, 0xf1, 0x24, 0x1b, 0x23, 0x14, 0xcb, 0x9c, 0x80, 0x48,
-		0xe4, 0xa0, 0x79, 0xb8, 0x93, 0xb2, 0xd5, 0xb1, 0x81, 0x6d, 0xc5, 0xc5, 0x55, 0xfc, 0xee, 0xa6,
-		0xa2, 0x3b, 0x55, 0xc5, 0xa8, 0x55, 0x77, 0x14, 0x67, 0x27, 0x3f, 0x41, 0x00, 0xca, 0xb1, 0xbc,
-		0x24, 0x1f, 0x23, 0x84, 0x0b, 0x9c, 0xae, 0x42, 0xc9, 0x66, 0x8d, 0xda, 0x65, 0xc5, 0xd9, 0x41,
-		0x25, 0x38, 0x4a, 0x51, 0x1c, 0xd7, 0xd6, 0x8c, 0x7a, 0x55, 0xdd, 0xc1, 0xea, 0x6e, 0xb5, 0xe9,
-		0x6e, 0x9f, 0xcf, 0xdf, 0x1e, 0xac, 0x9f, 0x4a, 0xb8, 0x4e, 0x69, 0xe6, 0x08, 0xc9, 0xa6, 0xbb,
-		0x7d, 0x1e, 0xad, 0x43, 0x96, 0x74, 0x46, 0x43, 0xbb, 0x81, 0xab, 0xdb, 0xa6, 0x4d, 0xe7, 0xd0,
-		0x91, 0x0e, 0xae, 0x29, 0xa0, 0xc1, 0x99, 0x55, 0xce, 0xb0, 0x6c, 0xd6, 0x70, 0x29, 0xb9, 0xbe,
-		0x56, 0xa9, 0xcc, 0xcb, 0x43, 0x02, 0xe5, 0x92, 0x69, 0x13, 0x83, 0xaa, 0x9b, 0x9e, 0x82, 0x87,
-		0x98, 0x41, 0xd5, 0x4d, 0xa1, 0xde, 0x33, 0x30, 0xae, 0xaa, 0xac, 0xcd, 0x9a, 0x5a, 0xe5, 0x8b,
-		0x31, 0x27, 0x9f, 0x0b, 0x29, 0x4b, 0x55, 0x17, 0x18, 0x01, 0xb7, 0x71, 0x07, 0x5d, 0x80, 0x23,
-		0xbe, 0xb2, 0x82, 0x8c, 0x63, 0x6d, 0xad, 0x6c, 0x65, 0x3d, 0x03, 0xe3, 0xd6, 0x5e, 0x3b, 0x23,
-		0x0a, 0xd5, 0x68, 0xed, 0xb5, 0xb2, 0x9d, 0x83, 0x09, 0x6b, 0xc7, 0x6a, 0xe7, 0x3b, 0x19, 0xe4,
-		0x43, 0xd6, 0x8e, 0xd5, 0xca, 0x78, 0x2f, 0x5d, 0x99, 0xdb, 0x58, 0x55, 0x5c, 0x5c, 0xcb, 0xdf,
-		0x16, 0x24, 0x0f, 0x14, 0xa0, 0x19, 0xc8, 0xa9, 0x6a, 0x15, 0x1b, 0xca, 0x96, 0x8e, 0xab, 0x8a,
-		0x8d, 0x0d, 0xc5, 0xc9, 0x4f, 0x51, 0xe2, 0x84, 0x6b, 0x37, 0xb1, 0x3c, 0xa2, 0xaa, 0x15, 0x5a,
-		0x38, 0x4b, 0xcb, 0xd0, 0x49, 0x18, 0x33, 0xb7, 0x9e, 0x53, 0x99, 0x45, 0x56, 0x2d, 0x1b, 0x6f,
-		0x6b, 0xd7, 0xf3, 0xf7, 0x50, 0xf5, 0x8e, 0x92, 0x02, 0x6a, 0x8f, 0x6b, 0x34, 0x1b, 0xdd, 0x0f,
-		0x39, 0xd5, 0xd9, 0x51, 0x6c, 0x8b, 0xba, 0x64, 0xc7, 0x52, 0x54, 0x9c, 0xbf, 0x97, 0x91, 0xb2,
-		0xfc, 0x15, 0x91, 0x4d, 0x46, 0x84, 0xf3, 0xbc, 0xb6, 0xed, 0x0a, 0xc4, 0xe3, 0x6c, 0x44, 0xd0,
-		0x3c, 0x8e, 0x76, 0x02, 0x72, 0x44, 0x13, 0xa1, 0x8a, 0x4f, 0x50, 0xb2, 0x11, 0x6b, 0xc7, 0x0a,
-		0xd6, 0x7b, 0x37, 0x0c, 0x13, 0x4a, 0xbf, 0xd2, 0xfb, 0x59, 0xe0, 0x66, 0xed, 0x04, 0x6a, 0x7c,
-		0x0c, 0x8e, 0x12, 0xa2, 0x06, 0x76, 0x95, 0x9a, 0xe2, 0x2a, 0x01, 0xea, 0x07, 0x29, 0x35, 0x51,
-		0xfb, 0x32, 0x2f, 0x0c, 0xc9, 0x69, 0x37, 0xb7, 0xf6, 0x3c, 0xc3, 0x7a, 0x88, 0xc9, 0x49, 0xf2,
-		0x84, 0x69, 0xdd, 0xb2, 0xe0, 0xbc, 0x58, 0x82, 0x6c, 0xd0, 0xee, 0x51, 0x06, 0x98, 0xe5, 0xe7,
-		0x24, 0x12, 0x04, 0xcd, 0xad, 0xce, 0x93, 0xf0, 0xe5, 0xd9, 0x4a, 0x2e, 0x46, 0xc2, 0xa8, 0xa5,
-		0xc5, 0x8d, 0x4a, 0x55, 0xde, 0x5c, 0xd9, 0x58, 0x5c, 0xae, 0xe4, 0xe2, 0x81, 0xc0, 0xfe, 0x89,
-		0x44, 0xfa, 0xbe, 0xdc, 0xf1, 0xe2, 0x37, 0x63, 0x30, 0x12, 0x5e, 0xa9, 0xa1, 0xb7, 0xc1, 0x6d,
-		0x62, 0x5b, 0xc5, 0xc1, 0x6e, 0xf5, 0x79, 0xcd, 0xa6, 0x03, 0xb2, 0xa1, 0xb0, 0xc9, 0xd1, 0xb3,
-		0x9f, 0x09, 0x4e, 0xb5, 0x8e, 0xdd, 0xa7, 0x34, 0x9b, 0x0c, 0xb7, 0x86, 0xe2, 0xa2, 0x25, 0x98,
-		0x32, 0xcc, 0xaa, 0xe3, 0x2a, 0x46, 0x4d, 0xb1, 0x6b, 0x55, 0x7f, 0x43, 0xab, 0xaa, 0xa8, 0x2a,
-		0x76, 0x1c, 0x93, 0x4d, 0x84, 0x1e, 0xca, 0x1d, 0x86, 0xb9, 0xce, 0x89, 0xfd, 0x19, 0x62, 0x96,
-		0x93, 0xb6, 0x98, 0x6f, 0xbc, 0x9b, 0xf9, 0xde, 0x0e, 0x99, 0x86, 0x62, 0x55, 0xb1, 0xe1, 0xda,
-		0x7b, 0x34, 0x3e, 0x4f, 0xcb, 0xe9, 0x86, 0x62, 0x55, 0x48, 0xfa, 0x27, 0xb2, 0x4c, 0x7a, 0x22,
-		0x91, 0x4e, 0xe7, 0x32, 0x4f, 0x24, 0xd2, 0x99, 0x1c, 0x14, 0x5f, 0x8b, 0x43, 0x36, 0x18, 0xaf,
-		0x93, 0xe5, 0x8f, 0x4a, 0x67, 0x2c, 0x89, 0xfa, 0xb4, 0xbb, 0x7b, 0x46, 0xf7, 0x33, 0x73, 0x64,
-		0x2a, 0x2b, 0xa5, 0x58, 0x70, 0x2c, 0x33, 0x4e, 0x12, 0x46, 0x10, 0x63, 0xc3, 0x2c, 0x18, 0x49,
-		0xcb, 0x3c, 0x85, 0x16, 0x20, 0xf5, 0x9c, 0x43, 0xb1, 0x53, 0x14, 0xfb, 0x9e, 0xde, 0xd8, 0x4f,
-		0xac, 0x53, 0xf0, 0xcc, 0x13, 0xeb, 0xd5, 0x95, 0x55, 0x79, 0x79, 0x76, 0x49, 0xe6, 0xec, 0xe8,
-		0x18, 0x24, 0x74, 0xe5, 0xc6, 0x5e, 0x78, 0xd2, 0xa3, 0x59, 0xfd, 0x76, 0xc2, 0x31, 0x48, 0x3c,
-		0x8f, 0x95, 0xdd, 0xf0, 0x54, 0x43, 0xb3, 0x6e, 0xe1, 0x60, 0x38, 0x05, 0x49, 0xaa, 0x2f, 0x04,
-		0xc0, 0x35, 0x96, 0x1b, 0x40, 0x69, 0x48, 0xcc, 0xad, 0xca, 0x64, 0x40, 0xe4, 0x20, 0xcb, 0x72,
-		0xab, 0x6b, 0x8b, 0x95, 0xb9, 0x4a, 0x2e, 0x56, 0x3c, 0x03, 0x29, 0xa6, 0x04, 0x32, 0x58, 0x3c,
-		0x35, 0xe4, 0x06, 0x78, 0x92, 0x63, 0x48, 0xa2, 0x74, 0x73, 0xb9, 0x5c, 0x91, 0x73, 0xb1, 0x70,
-		0x57, 0x27, 0x72, 0xc9, 0xa2, 0x03, 0xd9, 0x60, 0x1c, 0xfe, 0x93, 0x59, 0x8c, 0x7f, 0x59, 0x82,
-		0xa1, 0x40, 0x5c, 0x4d, 0x02, 0x22, 0x45, 0xd7, 0xcd, 0xe7, 0xab, 0x8a, 0xae, 0x29, 0x0e, 0x37,
-		0x0d, 0xa0, 0x59, 0xb3, 0x24, 0xa7, 0xdf, 0xae, 0xfb, 0x09, 0x0d, 0x91, 0x64, 0x2e, 0x55, 0xfc,
-		0xb8, 0x04, 0xb9, 0xd6, 0xc0, 0xb6, 0x45, 0x4c, 0xe9, 0x2f, 0x52, 0xcc, 0xe2, 0xc7, 0x24, 0x18,
-		0x09, 0x47, 0xb3, 0x2d, 0xe2, 0xdd, 0xf5, 0x17, 0x2a, 0xde, 0x77, 0x62, 0x30, 0x1c, 0x8a, 0x61,
-		0xfb, 0x95, 0xee, 0xdd, 0x30, 0xa6, 0xd5, 0x70, 0xc3, 0x32, 0x5d, 0x6c, 0xa8, 0x7b, 0x55, 0x1d,
-		0x5f, 0xc3, 0x7a, 0xbe, 0x48, 0x9d, 0xc6, 0xa9, 0xde, 0x51, 0xf2, 0xcc, 0xa2, 0xcf, 0xb7, 0x44,
-		0xd8, 0x4a, 0xe3, 0x8b, 0xf3, 0x95, 0xe5, 0xb5, 0xd5, 0x8d, 0xca, 0xca, 0xdc, 0x33, 0xd5, 0xcd,
-		0x95, 0x2b, 0x2b, 0xab, 0x4f, 0xad, 0xc8, 0x39, 0xad, 0x85, 0xec, 0x16, 0x0e, 0xfb, 0x35, 0xc8,
-		0xb5, 0x0a, 0x85, 0x6e, 0x83, 0x4e, 0x62, 0xe5, 0x06, 0xd0, 0x38, 0x8c, 0xae, 0xac, 0x56, 0xd7,
-		0x17, 0xe7, 0x2b, 0xd5, 0xca, 0xa5, 0x4b, 0x95, 0xb9, 0x8d, 0x75, 0xb6, 0xef, 0xe1, 0x51, 0x6f,
-		0x84, 0x06, 0x78, 0xf1, 0xa3, 0x71, 0x18, 0xef, 0x20, 0x09, 0x9a, 0xe5, 0x2b, 0x16, 0xb6, 0x88,
-		0x7a, 0xa8, 0x1f, 0xe9, 0x67, 0x48, 0xcc, 0xb0, 0xa6, 0xd8, 0x2e, 0x5f, 0xe0, 0xdc, 0x0f, 0x44,
-		0x4b, 0x86, 0xab, 0x6d, 0x6b, 0xd8, 0xe6, 0xfb, 0x49, 0x6c, 0x19, 0x33, 0xea, 0xe7, 0xb3, 0x2d,
-		0xa5, 0x07, 0x01, 0x59, 0xa6, 0xa3, 0xb9, 0xda, 0x35, 0x5c, 0xd5, 0x0c, 0xb1, 0xf9, 0x44, 0x96,
-		0x35, 0x09, 0x39, 0x27, 0x4a, 0x16, 0x0d, 0xd7, 0xa3, 0x36, 0x70, 0x5d, 0x69, 0xa1, 0x26, 0xce,
-		0x3c, 0x2e, 0xe7, 0x44, 0x89, 0x47, 0x7d, 0x17, 0x64, 0x6b, 0x66, 0x93, 0xc4, 0x7a, 0x8c, 0x8e,
-		0xcc, 0x1d, 0x92, 0x3c, 0xc4, 0xf2, 0x3c, 0x12, 0x1e, 0xc5, 0xfb, 0xbb, 0x5e, 0x59, 0x79, 0x88,
-		0xe5, 0x31, 0x92, 0xe3, 0x30, 0xaa, 0xd4, 0xeb, 0x36, 0x01, 0x17, 0x40, 0x6c, 0x5d, 0x32, 0xe2,
-		0x65, 0x53, 0xc2, 0xc2, 0x13, 0x90, 0x16, 0x7a, 0x20, 0x53, 0x35, 0xd1, 0x44, 0xd5, 0x62, 0x8b,
-		0xed, 0xd8, 0x89, 0x8c, 0x9c, 0x36, 0x44, 0xe1, 0x5d, 0x90, 0xd5, 0x9c, 0xaa, 0xbf, 0x89, 0x1f,
-		0x9b, 0x8e, 0x9d, 0x48, 0xcb, 0x43, 0x9a, 0xe3, 0x6d, 0x80, 0x16, 0x3f, 0x1d, 0x83, 0x91, 0xf0,
-		0x21, 0x04, 0x9a, 0x87, 0xb4, 0x6e, 0xaa, 0x0a, 0x35, 0x2d, 0x76, 0x02, 0x76, 0x22, 0xe2, 0xdc,
-		0x62, 0x66, 0x89, 0xd3, 0xcb, 0x1e, 0x67, 0xe1, 0xdf, 0x48, 0x90, 0x16, 0xd9, 0xe8, 0x28, 0x24,
-		0x2c, 0xc5, 0xdd, 0xa1, 0x70, 0xc9, 0x72, 0x2c, 0x27, 0xc9, 0x34, 0x4d, 0xf2, 0x1d, 0x4b, 0x31,
-		0xa8, 0x09, 0xf0, 0x7c, 0x92, 0x26, 0xfd, 0xaa, 0x63, 0xa5, 0x46, 0x17, 0x3d, 0x66, 0xa3, 0x81,
-		0x0d, 0xd7, 0x11, 0xfd, 0xca, 0xf3, 0xe7, 0x78, 0x36, 0x7a, 0x00, 0xc6, 0x5c, 0x5b, 0xd1, 0xf4,
-		0x10, 0x6d, 0x82, 0xd2, 0xe6, 0x44, 0x81, 0x47, 0x5c, 0x82, 0x63, 0x02, 0xb7, 0x86, 0x5d, 0x45,
-		0xdd, 0xc1, 0x35, 0x9f, 0x29, 0x45, 0x37, 0x37, 0x6e, 0xe3, 0x04, 0xf3, 0xbc, 0x5c, 0xf0, 0x16,
-		0xbf, 0x29, 0xc1, 0x98, 0x58, 0xa6, 0xd5, 0x3c, 0x65, 0x2d, 0x03, 0x28, 0x86, 0x61, 0xba, 0x41,
-		0x75, 0xb5, 0x9b, 0x72, 0x1b, 0xdf, 0xcc, 0xac, 0xc7, 0x24, 0x07, 0x00, 0x0a, 0x0d, 0x00, 0xbf,
-		0xa4, 0xab, 0xda, 0xa6, 0x60, 0x88, 0x9f, 0x30, 0xd1, 0x63, 0x4a, 0xb6, 0xb0, 0x07, 0x96, 0x45,
-		0xd6, 0x73, 0x68, 0x02, 0x92, 0x5b, 0xb8, 0xae, 0x19, 0x7c, 0xdf, 0x98, 0x25, 0xc4, 0xf6, 0x4b,
-		0xc2, 0xdb, 0x7e, 0x29, 0xff, 0x35, 0x18, 0x57, 0xcd, 0x46, 0xab, 0xb8, 0xe5, 0x5c, 0xcb, 0xe6,
-		0x82, 0x73, 0x59, 0x7a, 0xf6, 0x21, 0x4e, 0x54, 0x37, 0x75, 0xc5, 0xa8, 0xcf, 0x98, 0x76, 0xdd,
-		0x3f, 0x66, 0x25, 0x11, 0x8f, 0x13, 0x38, 0x6c, 0xb5, 0xb6, 0xfe, 0xb7, 0x24, 0xfd, 0x72, 0x2c,
-		0xbe, 0xb0, 0x56, 0xfe, 0x4c, 0xac, 0xb0, 0xc0, 0x18, 0xd7, 0x84, 0x32, 0x64, 0xbc, 0xad, 0x63,
-		0x95, 0x34, 0x10, 0x5e, 0x7f, 0x00, 0x26, 0xea, 0x66, 0xdd, 0xa4, 0x48, 0xa7, 0xc8, 0x2f, 0x7e,
-		0x4e, 0x9b, 0xf1, 0x72, 0x0b, 0x91, 0x87, 0xba, 0xa5, 0x15, 0x18, 0xe7, 0xc4, 0x55, 0x7a, 0x50,
-		0xc4, 0x96, 0x31, 0xa8, 0xe7, 0x1e, 0x5a, 0xfe, 0xf3, 0xdf, 0xa3, 0xd3, 0xb7, 0x3c, 0xc6, 0x59,
-		0x49, 0x19, 0x5b, 0xe9, 0x94, 0x64, 0x38, 0x12, 0xc2, 0x63, 0x83, 0x14, 0xdb, 0x11, 0x88, 0xbf,
-		0xcb, 0x11, 0xc7, 0x03, 0x88, 0xeb, 0x9c, 0xb5, 0x34, 0x07, 0xc3, 0xfb, 0xc1, 0xfa, 0xd7, 0x1c,
-		0x2b, 0x8b, 0x83, 0x20, 0x0b, 0x30, 0x4a, 0x41, 0xd4, 0xa6, 0xe3, 0x9a, 0x0d, 0xea, 0x01, 0x7b,
-		0xc3, 0xfc, 0xde, 0xf7, 0xd8, 0xa8, 0x19, 0x21, 0x6c, 0x73, 0x1e, 0x57, 0xa9, 0x04, 0xf4, 0x6c,
-		0xac, 0x86, 0x55, 0x3d, 0x02, 0xe1, 0xab, 0x5c, 0x10, 0x8f, 0xbe, 0x74, 0x15, 0x26, 0xc8, 0x6f,
-		0xea, 0xa0, 0x82, 0x92, 0x44, 0x6f, 0xb8, 0xe5, 0xbf, 0xf9, 0x7e, 0x36, 0x30, 0xc7, 0x3d, 0x80,
-		0x80, 0x4c, 0x81, 0x5e, 0xac, 0x63, 0xd7, 0xc5, 0xb6, 0x53, 0x55, 0xf4, 0x4e, 0xe2, 0x05, 0x76,
-		0x2c, 0xf2, 0x1f, 0xf9, 0x41, 0xb8, 0x17, 0x17, 0x18, 0xe7, 0xac, 0xae, 0x97, 0x36, 0xe1, 0xb6,
-		0x0e, 0x56, 0xd1, 0x07, 0xe6, 0x47, 0x39, 0xe6, 0x44, 0x9b, 0x65, 0x10, 0xd8, 0x35, 0x10, 0xf9,
-		0x5e, 0x5f, 0xf6, 0x81, 0xf9, 0x4b, 0x1c, 0x13, 0x71, 0x5e, 0xd1, 0xa5, 0x04, 0xf1, 0x09, 0x18,
-		0xbb, 0x86, 0xed, 0x2d, 0xd3, 0xe1, 0xbb, 0x44, 0x7d, 0xc0, 0x7d, 0x8c, 0xc3, 0x8d, 0x72, 0x46,
-		0xba, 0x6d, 0x44, 0xb0, 0x2e, 0x40, 0x7a, 0x5b, 0x51, 0x71, 0x1f, 0x10, 0x37, 0x39, 0xc4, 0x20,
-		0xa1, 0x27, 0xac, 0xb3, 0x90, 0xad, 0x9b, 0x7c, 0x8e, 0x8a, 0x66, 0xff, 0x38, 0x67, 0x1f, 0x12,
-		0x3c, 0x1c, 0xc2, 0x32, 0xad, 0xa6, 0x4e, 0x26, 0xb0, 0x68, 0x88, 0x7f, 0x28, 0x20, 0x04, 0x0f,
-		0x87, 0xd8, 0x87, 0x5a, 0x5f, 0x16, 0x10, 0x4e, 0x40, 0x9f, 0x8f, 0xc3, 0x90, 0x69, 0xe8, 0x7b,
-		0xa6, 0xd1, 0x8f, 0x10, 0x9f, 0xe0, 0x08, 0xc0, 0x59, 0x08, 0xc0, 0x45, 0xc8, 0xf4, 0xdb, 0x11,
-		0xff, 0xe8, 0x07, 0x62, 0x78, 0x88, 0x1e, 0x58, 0x80, 0x51, 0xe1, 0xa0, 0x34, 0xd3, 0xe8, 0x03,
-		0xe2, 0x1f, 0x73, 0x88, 0x91, 0x00, 0x1b, 0x6f, 0x86, 0x8b, 0x1d, 0xb7, 0x8e, 0xfb, 0x01, 0xf9,
-		0xb4, 0x68, 0x06, 0x67, 0xe1, 0xaa, 0xdc, 0xc2, 0x86, 0xba, 0xd3, 0x1f, 0xc2, 0xaf, 0x08, 0x55,
-		0x0a, 0x1e, 0x02, 0x31, 0x07, 0xc3, 0x0d, 0xc5, 0x76, 0x76, 0x14, 0xbd, 0xaf, 0xee, 0xf8, 0x27,
-		0x1c, 0x23, 0xeb, 0x31, 0x71, 0x8d, 0x34, 0x8d, 0xfd, 0xc0, 0x7c, 0x46, 0x68, 0x24, 0xc0, 0xc6,
-		0x87, 0x9e, 0xe3, 0xd2, 0x2d, 0xb5, 0xfd, 0xa0, 0xfd, 0xaa, 0x18, 0x7a, 0x8c, 0x77, 0x39, 0x88,
-		0x78, 0x11, 0x32, 0x8e, 0x76, 0xa3, 0x2f, 0x98, 0xcf, 0x8a, 0x9e, 0xa6, 0x0c, 0x84, 0xf9, 0x19,
-		0x38, 0xd6, 0x71, 0x9a, 0xe8, 0x03, 0xec, 0xd7, 0x38, 0xd8, 0xd1, 0x0e, 0x53, 0x05, 0x77, 0x09,
-		0xfb, 0x85, 0xfc, 0xa7, 0xc2, 0x25, 0xe0, 0x16, 0xac, 0x35, 0xb2, 0x6a, 0x70, 0x94, 0xed, 0xfd,
-		0x69, 0xed, 0x9f, 0x09, 0xad, 0x31, 0xde, 0x90, 0xd6, 0x36, 0xe0, 0x28, 0x47, 0xdc, 0x5f, 0xbf,
-		0x7e, 0x4e, 0x38, 0x56, 0xc6, 0xbd, 0x19, 0xee, 0xdd, 0x77, 0x41, 0xc1, 0x53, 0xa7, 0x08, 0x4f,
-		0x9d, 0x6a, 0x43, 0xb1, 0xfa, 0x40, 0xfe, 0x3c, 0x47, 0x16, 0x1e, 0xdf, 0x8b, 0x6f, 0x9d, 0x65,
-		0xc5, 0x22, 0xe0, 0x4f, 0x43, 0x5e, 0x80, 0x37, 0x0d, 0x1b, 0xab, 0x66, 0xdd, 0xd0, 0x6e, 0xe0,
-		0x5a, 0x1f, 0xd0, 0xbf, 0xde, 0xd2, 0x55, 0x9b, 0x01, 0x76, 0x82, 0xbc, 0x08, 0x39, 0x2f, 0x56,
-		0xa9, 0x6a, 0x0d, 0xcb, 0xb4, 0xdd, 0x08, 0xc4, 0x2f, 0x88, 0x9e, 0xf2, 0xf8, 0x16, 0x29, 0x5b,
-		0xa9, 0x02, 0xec, 0x9c, 0xb9, 0x5f, 0x93, 0x7c, 0x85, 0x03, 0x0d, 0xfb, 0x5c, 0xdc, 0x71, 0xa8,
-		0x66, 0xc3, 0x52, 0xec, 0x7e, 0xfc, 0xdf, 0x17, 0x85, 0xe3, 0xe0, 0x2c, 0xdc, 0x71, 0x90, 0x88,
-		0x8e, 0xcc, 0xf6, 0x7d, 0x20, 0x7c, 0x49, 0x38, 0x0e, 0xc1, 0xc3, 0x21, 0x44, 0xc0, 0xd0, 0x07,
-		0xc4, 0x3f, 0x17, 0x10, 0x82, 0x87, 0x40, 0x3c, 0xe9, 0x4f, 0xb4, 0x36, 0xae, 0x6b, 0x8e, 0x6b,
-		0xb3, 0xa0, 0xb8, 0x37, 0xd4, 0x6f, 0xfc, 0x20, 0x1c, 0x84, 0xc9, 0x01, 0x56, 0xe2, 0x89, 0xf8,
-		0x26, 0x2b, 0x5d, 0x33, 0x45, 0x0b, 0xf6, 0x9b, 0xc2, 0x13, 0x05, 0xd8, 0x88, 0x6c, 0x81, 0x08,
-		0x91, 0xa8, 0x5d, 0x25, 0x2b, 0x85, 0x3e, 0xe0, 0x7e, 0xab, 0x45, 0xb8, 0x75, 0xc1, 0x4b, 0x30,
-		0x03, 0xf1, 0x4f, 0xd3, 0xd8, 0xc5, 0x7b, 0x7d, 0x59, 0xe7, 0x6f, 0xb7, 0xc4, 0x3f, 0x9b, 0x8c,
-		0x93, 0xf9, 0x90, 0xd1, 0x96, 0x78, 0x0a, 0x45, 0xdd, 0x2a, 0xca, 0xbf, 0xef, 0x0d, 0xde, 0xde,
-		0x70, 0x38, 0x55, 0x5a, 0x22, 0x46, 0x1e, 0x0e, 0x7a, 0xa2, 0xc1, 0xde, 0xff, 0x86, 0x67, 0xe7,
-		0xa1, 0x98, 0xa7, 0x74, 0x09, 0x86, 0x43, 0x01, 0x4f, 0x34, 0xd4, 0x5f, 0xe7, 0x50, 0xd9, 0x60,
-		0xbc, 0x53, 0x3a, 0x03, 0x09, 0x12, 0xbc, 0x44, 0xb3, 0xff, 0x0d, 0xce, 0x4e, 0xc9, 0x4b, 0x6f,
-		0x87, 0xb4, 0x08, 0x5a, 0xa2, 0x59, 0x7f, 0x9e, 0xb3, 0x7a, 0x2c, 0x84, 0x5d, 0x04, 0x2c, 0xd1,
-		0xec, 0x7f, 0x53, 0xb0, 0x0b, 0x16, 0xc2, 0xde, 0xbf, 0x0a, 0xbf, 0xfc, 0xb7, 0x12, 0x7c, 0xd2,
-		0x11, 0xba, 0xbb, 0x08, 0x83, 0x3c, 0x52, 0x89, 0xe6, 0xfe, 0x00, 0xaf, 0x5c, 0x70, 0x94, 0xce,
-		0x41, 0xb2, 0x4f, 0x85, 0xff, 0x6d, 0xce, 0xca, 0xe8, 0x4b, 0x73, 0x30, 0x14, 0x88, 0x4e, 0xa2,
-		0xd9, 0xff, 0x0e, 0x67, 0x0f, 0x72, 0x11, 0xd1, 0x79, 0x74, 0x12, 0x0d, 0xf0, 0x77, 0x85, 0xe8,
-		0x9c, 0x83, 0xa8, 0x4d, 0x04, 0x26, 0xd1, 0xdc, 0x1f, 0x14, 0x5a, 0x17, 0x2c, 0xa5, 0xc7, 0x21,
-		0xe3, 0x4d, 0x36, 0xd1, 0xfc, 0xbf, 0xc0, 0xf9, 0x7d, 0x1e, 0xa2, 0x81, 0xc0, 0x64, 0x17, 0x0d,
-		0xf1, 0xf7, 0x84, 0x06, 0x02, 0x5c, 0x64, 0x18, 0xb5, 0x06, 0x30, 0xd1, 0x48, 0x1f, 0x12, 0xc3,
-		0xa8, 0x25, 0x7e, 0x21, 0xbd, 0x49, 0x7d, 0x7e, 0x34, 0xc4, 0xdf, 0x17, 0xbd, 0x49, 0xe9, 0x89,
-		0x18, 0xad, 0x11, 0x41, 0x34, 0xc6, 0x2f, 0x0a, 0x31, 0x5a, 0x02, 0x82, 0xd2, 0x1a, 0xa0, 0xf6,
-		0x68, 0x20, 0x1a, 0xef, 0xc3, 0x1c, 0x6f, 0xac, 0x2d, 0x18, 0x28, 0x3d, 0x05, 0x47, 0x3b, 0x47,
-		0x02, 0xd1, 0xa8, 0x1f, 0x79, 0xa3, 0x65, 0xed, 0x16, 0x0c, 0x04, 0x4a, 0x1b, 0xfe, 0x94, 0x12,
-		0x8c, 0x02, 0xa2, 0x61, 0x3f, 0xfa, 0x46, 0xd8, 0x71, 0x07, 0x83, 0x80, 0xd2, 0x2c, 0x80, 0x3f,
-		0x01, 0x47, 0x63, 0x7d, 0x8c, 0x63, 0x05, 0x98, 0xc8, 0xd0, 0xe0, 0xf3, 0x6f, 0x34, 0xff, 0x4d,
-		0x31, 0x34, 0x38, 0x07, 0x19, 0x1a, 0x62, 0xea, 0x8d, 0xe6, 0xfe, 0xb8, 0x18, 0x1a, 0x82, 0x85,
-		0x58, 0x76, 0x60, 0x76, 0x8b, 0x46, 0xf8, 0x84, 0xb0, 0xec, 0x00, 0x57, 0x69, 0x05, 0xc6, 0xda,
-		0x26, 0xc4, 0x68, 0xa8, 0x5f, 0xe6, 0x50, 0xb9, 0xd6, 0xf9, 0x30, 0x38, 0x79, 0xf1, 0xc9, 0x30,
-		0x1a, 0xed, 0x93, 0x2d, 0x93, 0x17, 0x9f, 0x0b, 0x4b, 0x17, 0x21, 0x6d, 0x34, 0x75, 0x9d, 0x0c,
-		0x1e, 0xd4, 0xfb, 0x26, 0x60, 0xfe, 0x3f, 0xff, 0x88, 0x6b, 0x47, 0x30, 0x94, 0xce, 0x40, 0x12,
-		0x37, 0xb6, 0x70, 0x2d, 0x8a, 0xf3, 0xf5, 0x1f, 0x09, 0x87, 0x49, 0xa8, 0x4b, 0x8f, 0x03, 0xb0,
-		0xad, 0x11, 0x7a, 0x18, 0x18, 0xc1, 0xfb, 0x5f, 0x7e, 0xc4, 0xaf, 0xde, 0xf8, 0x2c, 0x3e, 0x00,
-		0xbb, 0xc8, 0xd3, 0x1b, 0xe0, 0x07, 0x61, 0x00, 0xda, 0x23, 0x17, 0x60, 0xf0, 0x39, 0xc7, 0x34,
-		0x5c, 0xa5, 0x1e, 0xc5, 0xfd, 0x5f, 0x39, 0xb7, 0xa0, 0x27, 0x0a, 0x6b, 0x98, 0x36, 0x76, 0x95,
-		0xba, 0x13, 0xc5, 0xfb, 0xdf, 0x38, 0xaf, 0xc7, 0x40, 0x98, 0x55, 0xc5, 0x71, 0xfb, 0x69, 0xf7,
-		0x9f, 0x09, 0x66, 0xc1, 0x40, 0x84, 0x26, 0xbf, 0x77, 0xf1, 0x5e, 0x14, 0xef, 0x0f, 0x85, 0xd0,
-		0x9c, 0xbe, 0xf4, 0x76, 0xc8, 0x90, 0x9f, 0xec, 0x3e, 0x5d, 0x04, 0xf3, 0x7f, 0xe7, 0xcc, 0x3e,
-		0x07, 0xa9, 0xd9, 0x71, 0x6b, 0xae, 0x16, 0xad, 0xec, 0xff, 0xc1, 0x7b, 0x5a, 0xd0, 0x97, 0x66,
-		0x61, 0xc8, 0x71, 0x6b, 0xb5, 0x26, 0x8f, 0x4f, 0x23, 0xd8, 0xff, 0xe7, 0x8f, 0xbc, 0x2d, 0x0b,
-		0x8f, 0x87, 0xf4, 0xf6, 0xf3, 0xbb, 0xae, 0x65, 0xd2, 0x03, 0x8f, 0x28, 0x84, 0x37, 0x38, 0x42,
-		0x80, 0xa5, 0x34, 0x07, 0x59, 0xd2, 0x16, 0x1b, 0x5b, 0x98, 0x9e, 0x4e, 0x45, 0x40, 0xfc, 0x2f,
-		0xae, 0x80, 0x10, 0x53, 0xf9, 0x67, 0xbf, 0xfa, 0xda, 0xa4, 0xf4, 0x8d, 0xd7, 0x26, 0xa5, 0xef,
-		0xbc, 0x36, 0x29, 0x7d, 0xf0, 0xbb, 0x93, 0x03, 0xdf, 0xf8, 0xee, 0xe4, 0xc0, 0x1f, 0x7e, 0x77,
-		0x72, 0xa0, 0xf3, 0x2e, 0x31, 0x2c, 0x98, 0x0b, 0x26, 0xdb, 0x1f, 0x7e, 0xb6, 0x58, 0xd7, 0xdc,
-		0x9d, 0xe6, 0xd6, 0x8c, 0x6a, 0x36, 0xe8, 0x36, 0xae, 0xbf, 0x5b, 0xeb, 0x2d, 0x72, 0xe0, 0xf5,
-		0x18, 0x1c, 0x53, 0x4d, 0xa7, 0x61, 0x3a, 0x55, 0xb6, 0xdf, 0xcb, 0x12, 0x7c, 0xc7, 0x37, 0x1b,
-		0x2c, 0xea, 0x63, 0xd3, 0x77, 0x03, 0x26, 0xb4, 0x86, 0xa5, 0x63, 0xba, 0x39, 0x5f, 0xa5, 0x5a,
-		0xe8, 0x2f, 0x18, 0xfc, 0xda, 0xbf, 0x4f, 0xb2, 0x4d, 0x48, 0x9f, 0x7d, 0x51, 0x70, 0x97, 0x96,
-		0x60, 0x4c, 0x51, 0x55, 0x6c, 0x85, 0x20, 0x23, 0x94, 0x29, 0x00, 0x73, 0x9c, 0xd3, 0x47, 0x3b,
-		0x07, 0x29, 0x47, 0x55, 0x74, 0x25, 0xb2, 0x4b, 0xbf, 0xce, 0x21, 0x38, 0x79, 0xf9, 0x7c, 0xb7,
-		0x9e, 0x78, 0x76, 0x32, 0xa0, 0x68, 0xa6, 0x31, 0xfe, 0xe7, 0x21, 0x86, 0x9c, 0x62, 0x37, 0x95,
-		0xe1, 0x0f, 0xe2, 0x30, 0xc9, 0xcb, 0xb7, 0x14, 0x07, 0x9f, 0xba, 0xf6, 0xc8, 0x16, 0x76, 0x95,
-		0x47, 0x4e, 0xa9, 0xa6, 0x66, 0x70, 0x8d, 0x8f, 0x73, 0xfd, 0x93, 0xf2, 0x19, 0x5e, 0x5e, 0xe8,
-		0xb8, 0x1d, 0x5f, 0xe8, 0xde, 0x6f, 0xc5, 0x4d, 0x48, 0xcc, 0x99, 0x9a, 0x81, 0x26, 0x20, 0x59,
-		0xc3, 0x86, 0xd9, 0xe0, 0xd7, 0xee, 0x58, 0x02, 0x3d, 0x02, 0x29, 0xa5, 0x61, 0x36, 0x0d, 0x97,
-		0x1d, 0x52, 0x94, 0x8f, 0x7d, 0xf5, 0xd5, 0xa9, 0x81, 0x3f, 0x7a, 0x75, 0x2a, 0xbe, 0x68, 0xb8,
-		0xbf, 0xff, 0xca, 0x43, 0xc0, 0xa1, 0x16, 0x0d, 0x57, 0xe6, 0x84, 0xa5, 0xc4, 0xf7, 0x5f, 0x9e,
-		0x92, 0x8a, 0x4f, 0xc3, 0xe0, 0x3c, 0x56, 0x0f, 0x82, 0x3c, 0x8f, 0xd5, 0x00, 0xf2, 0x3c, 0x56,
-		0x5b, 0x90, 0xcf, 0x41, 0x7a, 0xd1, 0x70, 0xd9, 0xa5, 0xc9, 0x07, 0x20, 0xae, 0x19, 0xec, 0x1e,
-		0x4e, 0x4f, 0xd9, 0x08, 0x15, 0x61, 0x9c, 0xc7, 0xaa, 0xc7, 0x58, 0xc3, 0x6a, 0x2b, 0x63, 0x7b,
-		0xd5, 0x84, 0xaa, 0x3c, 0xff, 0x87, 0xff, 0x69, 0x72, 0xe0, 0x85, 0xd7, 0x26, 0x07, 0xba, 0xf6,
-		0x6a, 0xb1, 0x6b, 0xaf, 0x3a, 0xb5, 0x5d, 0x76, 0xbc, 0xe2, 0xf5, 0xec, 0x9f, 0xa6, 0xa0, 0xc8,
-		0x69, 0x1c, 0x57, 0xd9, 0xd5, 0x8c, 0xba, 0xd7, 0xb9, 0x4a, 0xd3, 0xdd, 0xb9, 0xc1, 0x7b, 0xf7,
-		0x28, 0x97, 0x82, 0xd3, 0x1c, 0xb8, 0x83, 0x0b, 0x11, 0x66, 0x54, 0xfc, 0x93, 0x38, 0xa0, 0x75,
-		0x57, 0xd9, 0xc5, 0xb3, 0x4d, 0x77, 0xc7, 0xb4, 0xb5, 0x1b, 0xcc, 0x0d, 0x62, 0x80, 0x86, 0x72,
-		0xbd, 0xea, 0x9a, 0xbb, 0xd8, 0x70, 0xa8, 0xa2, 0x86, 0x4e, 0x1f, 0x9b, 0xe9, 0x60, 0x72, 0x33,
-		0xa4, 0x93, 0xcb, 0x0f, 0x7c, 0xe6, 0xdb, 0x53, 0xc7, 0xa3, 0xb5, 0x40, 0x89, 0x49, 0x5c, 0x7e,
-		0x7d, 0x83, 0x02, 0xa3, 0xab, 0xc0, 0xee, 0x67, 0x54, 0x75, 0xcd, 0x71, 0xf9, 0x15, 0xef, 0x33,
-		0x33, 0x9d, 0xdb, 0x3e, 0xd3, 0x2e, 0xe6, 0xcc, 0x55, 0x45, 0xd7, 0x6a, 0x8a, 0x6b, 0xda, 0xce,
-		0xe5, 0x01, 0x39, 0x43, 0xa1, 0x96, 0x34, 0xc7, 0x45, 0x1b, 0x90, 0xa9, 0x61, 0x63, 0x8f, 0xc1,
-		0xc6, 0xdf, 0x1c, 0x6c, 0x9a, 0x20, 0x51, 0xd4, 0xa7, 0x01, 0x29, 0x41, 0x3a, 0xf1, 0xa6, 0x89,
-		0x5d, 0xcd, 0xec, 0x02, 0x1f, 0x42, 0xa6, 0x4f, 0x30, 0xc6, 0x94, 0xd6, 0xac, 0xc2, 0x3b, 0x01,
-		0xfc, 0x3a, 0xd1, 0x69, 0x18, 0x54, 0x6a, 0x35, 0x1b, 0x3b, 0x0e, 0x3d, 0x3b, 0xcc, 0x94, 0xf3,
-		0xbf, 0xff, 0xca, 0x43, 0x13, 0x1c, 0x7f, 0x96, 0x95, 0xb0, 0xe5, 0xb8, 0x2c, 0x08, 0x4b, 0x63,
-		0x5f, 0x7f, 0xe5, 0xa1, 0xe1, 0x50, 0x5d, 0xe5, 0x2c, 0xc0, 0x35, 0x0f, 0xf4, 0xe4, 0xc7, 0x25,
-		0x18, 0x6b, 0x93, 0x05, 0x15, 0x61, 0x72, 0x76, 0x73, 0xe3, 0xf2, 0xaa, 0xbc, 0xf8, 0xec, 0xec,
-		0xc6, 0xe2, 0xea, 0x4a, 0x95, 0xbd, 0x23, 0x58, 0x59, 0x5f, 0xab, 0xcc, 0x2d, 0x5e, 0x5a, 0xac,
-		0xcc, 0xe7, 0x06, 0xd0, 0x14, 0xdc, 0xde, 0x81, 0x66, 0xbe, 0xb2, 0x54, 0x59, 0x98, 0xdd, 0xa8,
-		0xe4, 0x24, 0x74, 0x17, 0xdc, 0xd9, 0x11, 0xc4, 0x23, 0x89, 0x75, 0x21, 0x91, 0x2b, 0x1e, 0x49,
-		0xbc, 0x7c, 0xa9, 0xeb, 0xf8, 0x7a, 0xb0, 0xa7, 0x65, 0x5d, 0xf7, 0x06, 0x52, 0x78, 0xa4, 0xbd,
-		0x2f, 0x06, 0xc7, 0x5a, 0xe7, 0x21, 0xc5, 0xd8, 0xeb, 0xf2, 0x94, 0xb4, 0xf3, 0xc8, 0x2a, 0x5e,
-		0x86, 0xf8, 0xac, 0xb1, 0x87, 0x8e, 0xb1, 0x20, 0xbd, 0xda, 0xb4, 0x75, 0xee, 0xc7, 0x06, 0x49,
-		0x7a, 0xd3, 0xd6, 0x89, 0x7f, 0x13, 0xaf, 0x07, 0xa4, 0x13, 0x59, 0xfe, 0x24, 0xa0, 0x94, 0xfb,
-		0xf0, 0xcb, 0x53, 0x03, 0x9f, 0x7b, 0x79, 0x6a, 0xe0, 0x87, 0x9f, 0x98, 0x1a, 0x78, 0xe1, 0x8f,
-		0xa7, 0x07, 0xca, 0xbb, 0xad, 0xcd, 0xfb, 0x72, 0xe4, 0x14, 0x9d, 0x9e, 0x35, 0xf6, 0xa8, 0xc3,
-		0x5a, 0x93, 0x9e, 0x4d, 0xd2, 0xc6, 0x89, 0x53, 0xd9, 0xc9, 0xd6, 0x53, 0xd9, 0xa7, 0xb0, 0xae,
-		0x5f, 0x31, 0xcc, 0xe7, 0x69, 0xaf, 0xfa, 0x3a, 0xf8, 0x50, 0x0c, 0x26, 0xdb, 0xe6, 0x62, 0x1e,
-		0xb6, 0x74, 0x7b, 0x53, 0x5b, 0x82, 0xf4, 0xbc, 0x88, 0x86, 0xf2, 0x30, 0xe8, 0x60, 0xd5, 0x34,
-		0x6a, 0xcc, 0x07, 0xc4, 0x65, 0x91, 0x24, 0xcd, 0x36, 0x14, 0xc3, 0x74, 0xf8, 0x45, 0x7e, 0x96,
-		0x28, 0xff, 0x92, 0xb4, 0xbf, 0x20, 0x64, 0x58, 0xd4, 0x24, 0x9a, 0xf9, 0x48, 0xe4, 0x39, 0xf5,
-		0x2e, 0x69, 0xa5, 0xd7, 0x88, 0xd0, 0x59, 0x75, 0xbf, 0x5a, 0xf9, 0xc5, 0x18, 0x4c, 0xb5, 0x6a,
-		0x85, 0xc4, 0x82, 0x8e, 0xab, 0x34, 0xac, 0x6e, 0x6a, 0xb9, 0x08, 0x99, 0x0d, 0x41, 0xb3, 0x6f,
-		0xbd, 0xdc, 0xdc, 0xa7, 0x5e, 0x46, 0xbc, 0xaa, 0x84, 0x62, 0x4e, 0xf7, 0xa9, 0x18, 0xaf, 0x1d,
-		0x07, 0xd2, 0xcc, 0x67, 0x12, 0x70, 0x27, 0x7d, 0xe9, 0x65, 0x37, 0x34, 0xc3, 0x3d, 0xa5, 0xda,
-		0x7b, 0x96, 0x4b, 0xa3, 0x41, 0x73, 0x9b, 0xeb, 0x65, 0xcc, 0x2f, 0x9e, 0x61, 0xc5, 0x5d, 0x46,
-		0xce, 0x36, 0x24, 0xd7, 0x08, 0x1f, 0xd1, 0x88, 0x6b, 0xba, 0x8a, 0xce, 0x35, 0xc5, 0x12, 0x24,
-		0x97, 0xbd, 0x0e, 0x8b, 0xb1, 0x5c, 0x4d, 0x3c, 0x0c, 0xd3, 0xb1, 0xb2, 0xcd, 0x2e, 0xd9, 0xc7,
-		0xe9, 0x80, 0x4a, 0x93, 0x0c, 0x7a, 0x9f, 0x7e, 0x02, 0x92, 0x4a, 0x93, 0xdd, 0x0f, 0x89, 0x93,
-		0x91, 0x46, 0x13, 0xc5, 0x2b, 0x30, 0xc8, 0x4f, 0xa9, 0x51, 0x0e, 0xe2, 0xbb, 0x78, 0x8f, 0xd6,
-		0x93, 0x95, 0xc9, 0x4f, 0x34, 0x03, 0x49, 0x2a, 0x3c, 0x9f, 0x5a, 0xf2, 0x33, 0x6d, 0xd2, 0xcf,
-		0x50, 0x21, 0x65, 0x46, 0x56, 0x7c, 0x02, 0xd2, 0xf3, 0x66, 0x43, 0x33, 0xcc, 0x30, 0x5a, 0x86,
-		0xa1, 0x51, 0x99, 0xad, 0x26, 0x8f, 0x59, 0x64, 0x96, 0x40, 0x47, 0x21, 0xc5, 0x1e, 0x5d, 0xf0,
-		0x3b, 0x2e, 0x3c, 0x55, 0x9c, 0x83, 0x41, 0x8a, 0xbd, 0x6a, 0x21, 0xc4, 0x9f, 0xeb, 0xf1, 0xd7,
-		0x1d, 0x74, 0x75, 0xc5, 0xe1, 0x63, 0xbe, 0xb0, 0x08, 0x12, 0x35, 0xc5, 0x55, 0x78, 0xbb, 0xe9,
-		0xef, 0xe2, 0x3b, 0x20, 0xcd, 0x41, 0xc8, 0xb4, 0x10, 0x37, 0x2d, 0x87, 0xdf, 0x52, 0x29, 0x74,
-		0x6b, 0xca, 0xaa, 0x55, 0x4e, 0x90, 0x88, 0x46, 0x26, 0xc4, 0x65, 0xb9, 0xab, 0x53, 0x3d, 0x1f,
-		0x70, 0xaa, 0x81, 0x2e, 0x0f, 0xfc, 0x64, 0x5d, 0xda, 0x66, 0x0e, 0x9e, 0xb1, 0x7c, 0x22, 0x06,
-		0x93, 0x81, 0xd2, 0x6b, 0xd8, 0x76, 0x34, 0xd3, 0xe0, 0x33, 0x3d, 0xb3, 0x16, 0x14, 0x10, 0x92,
-		0x97, 0x77, 0x31, 0x97, 0xb7, 0x43, 0x7c, 0xd6, 0xb2, 0x50, 0x01, 0xd2, 0x34, 0xad, 0x9a, 0xcc,
-		0x5e, 0x12, 0xb2, 0x97, 0x26, 0x65, 0x8e, 0xb9, 0xed, 0x3e, 0xaf, 0xd8, 0xde, 0xbb, 0x44, 0x91,
-		0x2e, 0x5e, 0x80, 0xcc, 0x9c, 0x69, 0x38, 0xd8, 0x70, 0x9a, 0x74, 0x0c, 0x6e, 0xe9, 0xa6, 0xba,
-		0xcb, 0x11, 0x58, 0x82, 0x28, 0x5c, 0xb1, 0x2c, 0xca, 0x99, 0x90, 0xc9, 0x4f, 0x16, 0x51, 0x96,
-		0xd7, 0xbb, 0xaa, 0xe8, 0xc2, 0xfe, 0x55, 0xc4, 0x1b, 0xe9, 0xe9, 0xe8, 0xc7, 0x12, 0xdc, 0xd1,
-		0x3e, 0xa0, 0x76, 0xf1, 0x9e, 0xb3, 0xdf, 0xf1, 0xf4, 0x34, 0x64, 0xd6, 0xe8, 0xc7, 0x01, 0xae,
-		0xe0, 0x3d, 0x54, 0x80, 0x41, 0x5c, 0x3b, 0x7d, 0xe6, 0xcc, 0x23, 0x17, 0x98, 0xb5, 0x5f, 0x1e,
-		0x90, 0x45, 0x06, 0x9a, 0x84, 0x8c, 0x83, 0x55, 0xeb, 0xf4, 0x99, 0xb3, 0xbb, 0x8f, 0x30, 0xf3,
-		0x22, 0xb1, 0x91, 0x97, 0x55, 0x4a, 0x93, 0x56, 0x7f, 0xff, 0x13, 0x53, 0x52, 0x39, 0x09, 0x71,
-		0xa7, 0xd9, 0xb8, 0xa5, 0x36, 0xf2, 0xd1, 0x24, 0x4c, 0x07, 0x39, 0xa9, 0xa7, 0xf2, 0xa2, 0x12,
-		0xae, 0x83, 0x5c, 0x40, 0x07, 0x94, 0xa2, 0x4b, 0x98, 0xdb, 0x53, 0x93, 0xc5, 0x5f, 0x97, 0x20,
-		0xeb, 0x05, 0x51, 0xeb, 0xd8, 0x45, 0x17, 0x83, 0xf1, 0x0f, 0x1f, 0x36, 0xb7, 0xcf, 0xb4, 0xd6,
-		0xe5, 0x07, 0x7b, 0x72, 0x80, 0x1c, 0x9d, 0xa3, 0x86, 0x68, 0x99, 0x0e, 0x7f, 0xab, 0x16, 0xc1,
-		0xea, 0x11, 0xa3, 0x07, 0x01, 0x51, 0x0f, 0x57, 0xbd, 0x66, 0xba, 0x9a, 0x51, 0xaf, 0x5a, 0xe6,
-		0xf3, 0xfc, 0x05, 0x70, 0x5c, 0xce, 0xd1, 0x92, 0xab, 0xb4, 0x60, 0x8d, 0xe4, 0x13, 0xa1, 0x33,
-		0x1e, 0x0a, 0x99, 0x56, 0xfc, 0xc0, 0x8f, 0x38, 0x01, 0x91, 0x44, 0x17, 0x61, 0xd0, 0x6a, 0x6e,
-		0x55, 0x85, 0xc7, 0x18, 0x3a, 0x7d, 0x47, 0xa7, 0xf1, 0x2f, 0xec, 0x83, 0x7b, 0x80, 0x94, 0xd5,
-		0xdc, 0x22, 0xd6, 0x72, 0x17, 0x64, 0x3b, 0x08, 0x33, 0x74, 0xcd, 0x97, 0x83, 0x7e, 0x93, 0x82,
-		0xb7, 0xa0, 0x6a, 0xd9, 0x9a, 0x69, 0x6b, 0xee, 0x1e, 0x8d, 0x6c, 0xe3, 0x72, 0x4e, 0x14, 0xac,
-		0xf1, 0xfc, 0xe2, 0x2e, 0x8c, 0xae, 0xd3, 0xe5, 0xb7, 0x2f, 0xf9, 0x19, 0x5f, 0x3e, 0x29, 0x5a,
-		0xbe, 0xae, 0x92, 0xc5, 0xda, 0x24, 0x2b, 0x3f, 0xd9, 0xd5, 0x3a, 0xcf, 0xed, 0xdf, 0x3a, 0xc3,
-		0x11, 0xe2, 0x9f, 0x1d, 0x0b, 0x0d, 0x4e, 0x66, 0x9c, 0x41, 0xf7, 0xd5, 0xaf, 0x61, 0x46, 0x45,
-		0x13, 0x85, 0xde, 0x93, 0x6a, 0x21, 0xc2, 0x8d, 0x16, 0x22, 0x87, 0x50, 0xf1, 0x02, 0x0c, 0xaf,
-		0x29, 0xb6, 0xbb, 0x8e, 0xdd, 0xcb, 0x58, 0xa9, 0x61, 0x3b, 0x3c, 0xeb, 0x0e, 0x8b, 0x59, 0x17,
-		0x41, 0x82, 0x4e, 0xad, 0x6c, 0xd6, 0xa1, 0xbf, 0x8b, 0x3b, 0x90, 0xa0, 0xd7, 0x4d, 0xbd, 0x19,
-		0x99, 0x73, 0xb0, 0x19, 0x99, 0xf8, 0xd2, 0x3d, 0x17, 0x3b, 0x22, 0xbc, 0xa5, 0x09, 0xf4, 0x98,
-		0x98, 0x57, 0xe3, 0xbd, 0xe7, 0x55, 0x6e, 0x88, 0x7c, 0x76, 0xd5, 0x61, 0xb0, 0x4c, 0x5c, 0xf1,
-		0xe2, 0xbc, 0x27, 0x88, 0xe4, 0x0b, 0x82, 0x96, 0x61, 0xd4, 0x52, 0x6c, 0x97, 0xbe, 0xb3, 0xd9,
-		0xa1, 0xad, 0xe0, 0xb6, 0x3e, 0xd5, 0x3e, 0xf2, 0x42, 0x8d, 0xe5, 0xb5, 0x0c, 0x5b, 0xc1, 0xcc,
-		0xe2, 0x9f, 0x24, 0x20, 0xc5, 0x95, 0xf1, 0x76, 0x18, 0xe4, 0x6a, 0xe5, 0xd6, 0x79, 0xe7, 0x4c,
-		0xfb, 0xc4, 0x34, 0xe3, 0x4d, 0x20, 0x1c, 0x4f, 0xf0, 0xa0, 0xfb, 0x20, 0xad, 0xee, 0x28, 0x9a,
-		0x51, 0xd5, 0x6a, 0x7c, 0xbb, 0x62, 0xe8, 0xb5, 0x57, 0xa7, 0x06, 0xe7, 0x48, 0xde, 0xe2, 0xbc,
-		0x3c, 0x48, 0x0b, 0x17, 0x6b, 0x24, 0x12, 0xd8, 0xc1, 0x5a, 0x7d, 0xc7, 0xe5, 0x23, 0x8c, 0xa7,
-		0xd0, 0x79, 0x48, 0x10, 0x83, 0xe0, 0xaf, 0x30, 0x0b, 0x6d, 0x9b, 0x49, 0x5e, 0xb0, 0x57, 0x4e,
-		0x93, 0x8a, 0x3f, 0xf8, 0xed, 0x29, 0x49, 0xa6, 0x1c, 0x68, 0x0e, 0x86, 0x75, 0xc5, 0x71, 0xab,
-		0x74, 0x06, 0x23, 0xd5, 0x27, 0xf9, 0x4a, 0xbc, 0x4d, 0x21, 0x5c, 0xb1, 0x5c, 0xf4, 0x21, 0xc2,
-		0xc5, 0xb2, 0x6a, 0xe8, 0x04, 0xe4, 0x28, 0x88, 0x6a, 0x36, 0x1a, 0x9a, 0xcb, 0x62, 0xab, 0x14,
-		0xd5, 0xfb, 0x08, 0xc9, 0x9f, 0xa3, 0xd9, 0x34, 0xc2, 0xba, 0x1d, 0x32, 0xf4, 0xdd, 0x17, 0x25,
-		0x61, 0x77, 0x9c, 0xd3, 0x24, 0x83, 0x16, 0x1e, 0x87, 0x51, 0xdf, 0x3f, 0x32, 0x92, 0x34, 0x43,
-		0xf1, 0xb3, 0x29, 0xe1, 0xc3, 0x30, 0x61, 0xe0, 0xeb, 0xf4, 0xd6, 0x75, 0x88, 0x3a, 0x43, 0xa9,
-		0x11, 0x29, 0xbb, 0x1a, 0xe6, 0xb8, 0x17, 0x46, 0x54, 0xa1, 0x7c, 0x46, 0x0b, 0x94, 0x76, 0xd8,
-		0xcb, 0xa5, 0x64, 0xc7, 0x20, 0xad, 0x58, 0x16, 0x23, 0x18, 0xe2, 0xfe, 0xd1, 0xb2, 0x68, 0xd1,
-		0x49, 0x18, 0xa3, 0x6d, 0xb4, 0xb1, 0xd3, 0xd4, 0x5d, 0x0e, 0x92, 0xa5, 0x34, 0xa3, 0xa4, 0x40,
-		0x66, 0xf9, 0x94, 0xf6, 0x6e, 0x18, 0xc6, 0xd7, 0xb4, 0x1a, 0x36, 0x54, 0xcc, 0xe8, 0x86, 0x29,
-		0x5d, 0x56, 0x64, 0x52, 0xa2, 0xfb, 0xc1, 0xf3, 0x7b, 0x55, 0xe1, 0x93, 0x47, 0x18, 0x9e, 0xc8,
-		0xe7, 0x2b, 0xf1, 0x62, 0x1e, 0x12, 0xf3, 0x8a, 0xab, 0x90, 0x00, 0xc3, 0xbd, 0xce, 0x26, 0x9a,
-		0xac, 0x4c, 0x7e, 0x16, 0xbf, 0x1f, 0x83, 0xc4, 0x55, 0xd3, 0xc5, 0xe8, 0xd1, 0x40, 0x00, 0x38,
-		0xd2, 0xc9, 0x9e, 0xd7, 0xb5, 0xba, 0x81, 0x6b, 0xcb, 0x4e, 0x3d, 0xf0, 0x91, 0x06, 0xdf, 0x9c,
-		0x62, 0x21, 0x73, 0x9a, 0x80, 0xa4, 0x6d, 0x36, 0x8d, 0x9a, 0xb8, 0x1e, 0x4c, 0x13, 0xa8, 0x02,
-		0x69, 0xcf, 0x4a, 0x12, 0x51, 0x56, 0x32, 0x4a, 0xac, 0x84, 0xd8, 0x30, 0xcf, 0x90, 0x07, 0xb7,
-		0xb8, 0xb1, 0x94, 0x21, 0xe3, 0x39, 0x2f, 0x6e, 0x6d, 0xfd, 0x19, 0xac, 0xcf, 0x46, 0x26, 0x13,
-		0xaf, 0xef, 0x3d, 0xe5, 0x31, 0x8b, 0xcb, 0x79, 0x05, 0x5c, 0x7b, 0x21, 0xb3, 0xe2, 0x1f, 0x8c,
-		0x18, 0xa4, 0xed, 0xf2, 0xcd, 0x8a, 0x7d, 0x34, 0xe2, 0x0e, 0xc8, 0x38, 0x5a, 0xdd, 0x50, 0xdc,
-		0xa6, 0x8d, 0xb9, 0xe5, 0xf9, 0x19, 0xc5, 0x2f, 0x4b, 0x90, 0x62, 0x96, 0x1c, 0xd0, 0x9b, 0xd4,
-		0x59, 0x6f, 0xb1, 0x6e, 0x7a, 0x8b, 0x1f, 0x5c, 0x6f, 0xb3, 0x00, 0x9e, 0x30, 0x0e, 0x7f, 0xc7,
-		0xdf, 0x21, 0x62, 0x60, 0x22, 0xae, 0x6b, 0x75, 0x3e, 0x50, 0x03, 0x4c, 0xc5, 0xff, 0x28, 0x91,
-		0x20, 0x96, 0x97, 0xa3, 0x59, 0x18, 0x16, 0x72, 0x55, 0xb7, 0x75, 0xa5, 0xce, 0x6d, 0xe7, 0xce,
-		0xae, 0xc2, 0x5d, 0xd2, 0x95, 0xba, 0x3c, 0xc4, 0xe5, 0x21, 0x89, 0xce, 0xfd, 0x10, 0xeb, 0xd2,
-		0x0f, 0xa1, 0x8e, 0x8f, 0x1f, 0xac, 0xe3, 0x43, 0x5d, 0x94, 0x68, 0xed, 0xa2, 0x2f, 0xc4, 0xe8,
-		0x62, 0xc6, 0x32, 0x1d, 0x45, 0xff, 0x49, 0x8c, 0x88, 0xdb, 0x21, 0x63, 0x99, 0x7a, 0x95, 0x95,
-		0xb0, 0x6b, 0xf3, 0x69, 0xcb, 0xd4, 0xe5, 0xb6, 0x6e, 0x4f, 0x1e, 0xd2, 0x70, 0x49, 0x1d, 0x82,
-		0xd6, 0x06, 0x5b, 0xb5, 0x66, 0x43, 0x96, 0xa9, 0x82, 0xcf, 0x65, 0x0f, 0x13, 0x1d, 0xd0, 0xc9,
-		0x51, 0x6a, 0x9f, 0x7b, 0x99, 0xd8, 0x8c, 0x52, 0xe6, 0x74, 0x84, 0x83, 0xb9, 0xfe, 0x4e, 0xab,
-		0xe0, 0xa0, 0x59, 0xca, 0x9c, 0xae, 0xf8, 0x0f, 0x24, 0x80, 0x25, 0xa2, 0x59, 0xda, 0x5e, 0x32,
-		0x0b, 0x39, 0x54, 0x84, 0x6a, 0xa8, 0xe6, 0xc9, 0x6e, 0x9d, 0xc6, 0xeb, 0xcf, 0x3a, 0x41, 0xb9,
-		0xe7, 0x60, 0xd8, 0x37, 0x46, 0x07, 0x0b, 0x61, 0x26, 0x7b, 0x44, 0xd5, 0xeb, 0xd8, 0x95, 0xb3,
-		0xd7, 0x02, 0xa9, 0xe2, 0xbf, 0x92, 0x20, 0x43, 0x65, 0x5a, 0xc6, 0xae, 0x12, 0xea, 0x43, 0xe9,
-		0xe0, 0x7d, 0x78, 0x27, 0x00, 0x83, 0x71, 0xb4, 0x1b, 0x98, 0x5b, 0x56, 0x86, 0xe6, 0xac, 0x6b,
-		0x37, 0x30, 0x3a, 0xeb, 0x29, 0x3c, 0xde, 0x5b, 0xe1, 0x22, 0xea, 0xe6, 0x6a, 0xbf, 0x0d, 0x06,
-		0xe9, 0x77, 0xaf, 0xae, 0x3b, 0x3c, 0x90, 0x4e, 0x19, 0xcd, 0xc6, 0xc6, 0x75, 0xa7, 0xf8, 0x1c,
-		0x0c, 0x6e, 0x5c, 0x67, 0x7b, 0x23, 0xb7, 0x43, 0xc6, 0x36, 0x4d, 0x3e, 0x27, 0xb3, 0x58, 0x28,
-		0x4d, 0x32, 0xe8, 0x14, 0x24, 0xf6, 0x03, 0x62, 0xfe, 0x7e, 0x80, 0xbf, 0xa1, 0x11, 0xef, 0x6b,
-		0x43, 0xe3, 0xe4, 0x1f, 0x48, 0x30, 0x14, 0xf0, 0x0f, 0xe8, 0x11, 0x38, 0x52, 0x5e, 0x5a, 0x9d,
-		0xbb, 0x52, 0x5d, 0x9c, 0xaf, 0x5e, 0x5a, 0x9a, 0x5d, 0xf0, 0x1f, 0x86, 0x15, 0x8e, 0xbe, 0x74,
-		0x73, 0x1a, 0x05, 0x68, 0x37, 0x0d, 0xba, 0xa3, 0x84, 0x4e, 0xc1, 0x44, 0x98, 0x65, 0xb6, 0xbc,
-		0x5e, 0x59, 0xd9, 0xc8, 0x49, 0x85, 0x23, 0x2f, 0xdd, 0x9c, 0x1e, 0x0b, 0x70, 0xcc, 0x6e, 0x39,
-		0xd8, 0x70, 0xdb, 0x19, 0xe6, 0x56, 0x97, 0x97, 0x17, 0x37, 0x72, 0xb1, 0x36, 0x06, 0xee, 0xb0,
-		0xef, 0x87, 0xb1, 0x30, 0xc3, 0xca, 0xe2, 0x52, 0x2e, 0x5e, 0x40, 0x2f, 0xdd, 0x9c, 0x1e, 0x09,
-		0x50, 0xaf, 0x68, 0x7a, 0x21, 0xfd, 0xe2, 0x27, 0x27, 0x07, 0x7e, 0xe5, 0x53, 0x93, 0x12, 0x69,
-		0xd9, 0x70, 0xc8, 0x47, 0xa0, 0x07, 0xe1, 0xb6, 0xf5, 0xc5, 0x85, 0x95, 0xca, 0x7c, 0x75, 0x79,
-		0x7d, 0x41, 0xec, 0x41, 0x8b, 0xd6, 0x8d, 0xbe, 0x74, 0x73, 0x7a, 0x88, 0x37, 0xa9, 0x1b, 0xf5,
-		0x9a, 0x5c, 0xb9, 0xba, 0xba, 0x51, 0xc9, 0x49, 0x8c, 0x7a, 0xcd, 0xc6, 0xd7, 0x4c, 0x97, 0x7d,
-		0x18, 0xef, 0x61, 0x38, 0xd6, 0x81, 0xda, 0x6b, 0xd8, 0xd8, 0x4b, 0x37, 0xa7, 0x87, 0xd7, 0x6c,
-		0xcc, 0xc6, 0x0f, 0xe5, 0x98, 0x81, 0x7c, 0x3b, 0xc7, 0xea, 0xda, 0xea, 0xfa, 0xec, 0x52, 0x6e,
-		0xba, 0x90, 0x7b, 0xe9, 0xe6, 0x74, 0x56, 0x38, 0x43, 0x7a, 0x04, 0xe0, 0xb5, 0xec, 0x56, 0xae,
-		0x78, 0x5e, 0x3f, 0x09, 0xf7, 0x74, 0x39, 0x7d, 0x12, 0xe7, 0x16, 0x07, 0x3a, 0x7f, 0xea, 0xba,
-		0xcf, 0x5e, 0x88, 0xd8, 0x7e, 0x8e, 0x5e, 0x3a, 0x1d, 0xfc, 0x6c, 0xab, 0xd0, 0x73, 0x71, 0x57,
-		0xfc, 0x80, 0x04, 0x23, 0x97, 0x35, 0xc7, 0x35, 0x6d, 0x4d, 0x55, 0x74, 0xfa, 0x1c, 0xec, 0x6c,
-		0xbf, 0xbe, 0xb5, 0x65, 0xa8, 0x3f, 0x0e, 0xa9, 0x6b, 0x8a, 0xce, 0x9c, 0x5a, 0x9c, 0x7e, 0xbd,
-		0xa6, 0xcb, 0x61, 0x90, 0xe7, 0xda, 0x04, 0x00, 0x63, 0x2b, 0x7e, 0x36, 0x06, 0xa3, 0x74, 0x30,
-		0x38, 0xec, 0xbb, 0x66, 0x64, 0x8d, 0xb5, 0x06, 0x09, 0x5b, 0x71, 0xf9, 0xa6, 0x61, 0xf9, 0x6d,
-		0xfc, 0x94, 0xf2, 0xbe, 0x3e, 0x4e, 0xd9, 0xda, 0x0f, 0x32, 0x29, 0x12, 0x7a, 0x0a, 0xd2, 0x0d,
-		0xe5, 0x7a, 0x95, 0xa2, 0xc6, 0x0e, 0x01, 0x75, 0xb0, 0xa1, 0x5c, 0x27, 0xb2, 0xa2, 0x1a, 0x8c,
-		0x12, 0x60, 0x75, 0x47, 0x31, 0xea, 0x98, 0xe1, 0xc7, 0x0f, 0x01, 0x7f, 0xb8, 0xa1, 0x5c, 0x9f,
-		0xa3, 0x98, 0xa4, 0x96, 0x52, 0xfa, 0xc3, 0x2f, 0x4f, 0x0d, 0xd0, 0x43, 0xe0, 0xdf, 0x96, 0x00,
-		0x7c, 0x75, 0xa1, 0x9f, 0x81, 0x9c, 0xea, 0xa5, 0x68, 0xf5, 0xe2, 0xc8, 0xf2, 0x78, 0xb7, 0x8e,
-		0x68, 0x51, 0x36, 0x9b, 0x98, 0xbf, 0xf1, 0xea, 0x94, 0x24, 0x8f, 0xaa, 0x2d, 0xfd, 0x50, 0x81,
-		0xa1, 0xa6, 0x55, 0x53, 0x5c, 0x5c, 0xa5, 0x8b, 0xb8, 0xd8, 0x3e, 0x26, 0x79, 0x60, 0x8c, 0xa4,
-		0x28, 0x20, 0xfd, 0x67, 0x25, 0x18, 0x9a, 0x0f, 0xdc, 0xc7, 0xcc, 0xc3, 0x60, 0xc3, 0x34, 0xb4,
-		0x5d, 0x6e, 0x76, 0x19, 0x59, 0x24, 0x51, 0x01, 0xd2, 0xec, 0x21, 0xac, 0xbb, 0x27, 0x76, 0x3c,
-		0x45, 0x9a, 0x70, 0x3d, 0x8f, 0xb7, 0x1c, 0x4d, 0xe8, 0x5a, 0x16, 0x49, 0xb2, 0x74, 0x71, 0xb0,
-		0xda, 0xb4, 0x35, 0x77, 0xaf, 0xaa, 0x9a, 0x86, 0xab, 0xa8, 0x2e, 0x7f, 0x52, 0x39, 0x2a, 0xf2,
-		0xe7, 0x58, 0x36, 0x01, 0xa9, 0x61, 0x57, 0xd1, 0x74, 0x27, 0xcf, 0xae, 0x30, 0x88, 0x64, 0x40,
-		0xdc, 0xaf, 0xa5, 0x82, 0x5b, 0x54, 0x73, 0x90, 0x33, 0x2d, 0x6c, 0x87, 0x42, 0x4a, 0x66, 0xa1,
-		0xdd, 0x0f, 0x29, 0x47, 0x05, 0x87, 0x88, 0x35, 0x9f, 0x21, 0x1d, 0x26, 0xd6, 0x7b, 0x56, 0x73,
-		0xcb, 0xdf, 0xd6, 0x9a, 0x68, 0xd3, 0xeb, 0xac, 0xb1, 0x57, 0xce, 0x7f, 0xdd, 0x87, 0xf6, 0xf7,
-		0x92, 0xae, 0xe0, 0x3d, 0xd2, 0x5b, 0x1c, 0x67, 0x8d, 0xc2, 0x90, 0x10, 0xf1, 0x39, 0x45, 0xd3,
-		0xc5, 0xfb, 0x7e, 0x99, 0xa7, 0x50, 0x09, 0x52, 0x8e, 0xab, 0xb8, 0x4d, 0x87, 0x9f, 0xd7, 0x16,
-		0xbb, 0x59, 0x46, 0xd9, 0x34, 0x6a, 0xeb, 0x94, 0x52, 0xe6, 0x1c, 0x68, 0x03, 0x52, 0xfc, 0x20,
-		0x3c, 0xb9, 0x6f, 0xab, 0xee, 0x70, 0x53, 0x82, 0x61, 0xa1, 0x3a, 0xe4, 0x6a, 0x58, 0xc7, 0x75,
-		0x16, 0x10, 0xed, 0x28, 0x64, 0xdd, 0x90, 0x3a, 0x84, 0x51, 0x33, 0xea, 0xa1, 0xae, 0x53, 0x50,
-		0x74, 0x25, 0x74, 0xfd, 0x97, 0x7f, 0xa2, 0xf2, 0xee, 0x6e, 0xed, 0x0f, 0x58, 0xa6, 0xd8, 0x4c,
-		0x08, 0x5e, 0x1e, 0xbe, 0x1f, 0x72, 0x4d, 0x63, 0xcb, 0x34, 0xe8, 0x2b, 0x5c, 0x1e, 0x8c, 0xa7,
-		0x69, 0x78, 0x33, 0xea, 0xe5, 0x5f, 0x66, 0x51, 0xf9, 0x15, 0x18, 0xf1, 0x49, 0xe9, 0xd8, 0xc9,
-		0xec, 0x63, 0xec, 0x0c, 0x7b, 0xbc, 0xa4, 0x14, 0x5d, 0x06, 0xf0, 0x07, 0x26, 0xdd, 0x1e, 0x18,
-		0xea, 0xde, 0x87, 0xfe, 0xe8, 0x16, 0xcb, 0x2c, 0x9f, 0x17, 0xe9, 0x30, 0xde, 0xd0, 0x8c, 0xaa,
-		0x83, 0xf5, 0xed, 0x2a, 0x57, 0x15, 0x81, 0x1c, 0x3a, 0x84, 0xae, 0x1d, 0x6b, 0x68, 0xc6, 0x3a,
-		0xd6, 0xb7, 0xe7, 0x3d, 0xd8, 0x52, 0xf6, 0xc5, 0x97, 0xa7, 0x06, 0xf8, 0x58, 0x1a, 0x28, 0xae,
-		0xd1, 0x2d, 0x6a, 0x3e, 0x0c, 0xb0, 0x83, 0xce, 0x42, 0x46, 0x11, 0x89, 0xc8, 0xb3, 0x7e, 0x9f,
-		0x94, 0x8d, 0xce, 0x17, 0xfe, 0x78, 0x5a, 0x2a, 0x7e, 0x4a, 0x82, 0xd4, 0xfc, 0xd5, 0x35, 0x45,
-		0xb3, 0x51, 0x05, 0xc6, 0x7c, 0x83, 0xea, 0x77, 0x6c, 0xfa, 0x36, 0x28, 0x06, 0x67, 0xa5, 0xdb,
-		0xaa, 0xb1, 0x27, 0x4c, 0xeb, 0x7a, 0xb2, 0xa5, 0xe1, 0x15, 0x18, 0x64, 0x52, 0x3a, 0xa8, 0x04,
-		0x49, 0x8b, 0xfc, 0xe0, 0x3b, 0xf2, 0x93, 0x5d, 0x0d, 0x91, 0xd2, 0x7b, 0x3b, 0x88, 0x84, 0xa5,
-		0xf8, 0x63, 0x09, 0x60, 0xfe, 0xea, 0xd5, 0x0d, 0x5b, 0xb3, 0x74, 0xec, 0x1e, 0x56, 0x8b, 0x97,
-		0xe0, 0x48, 0x60, 0x69, 0x62, 0xab, 0x7d, 0xb7, 0x7a, 0xdc, 0x5f, 0x9c, 0xd8, 0x6a, 0x47, 0xb4,
-		0x9a, 0xe3, 0x7a, 0x68, 0xf1, 0xbe, 0xd1, 0xe6, 0x1d, 0xb7, 0xb3, 0x1a, 0xd7, 0x61, 0xc8, 0x6f,
-		0xbe, 0x83, 0xe6, 0x21, 0xed, 0xf2, 0xdf, 0x5c, 0x9b, 0xc5, 0xee, 0xda, 0x14, 0x6c, 0x5c, 0xa3,
-		0x1e, 0x67, 0xf1, 0xff, 0x12, 0xa5, 0x7a, 0x16, 0xfb, 0xd6, 0x32, 0x23, 0xe2, 0x7b, 0xb9, 0x6f,
-		0x3c, 0x8c, 0x88, 0x82, 0x63, 0xb5, 0x68, 0xf5, 0xfd, 0x31, 0x18, 0xdf, 0x14, 0xde, 0xe6, 0x2d,
-		0xab, 0x89, 0x35, 0x18, 0xc4, 0x86, 0x6b, 0x6b, 0x54, 0x15, 0xa4, 0xaf, 0x1f, 0xee, 0xd6, 0xd7,
-		0x1d, 0xda, 0x42, 0x3f, 0xfe, 0x24, 0xf6, 0xb5, 0x39, 0x4c, 0x8b, 0x16, 0xfe, 0x43, 0x0c, 0xf2,
-		0xdd, 0x38, 0xd1, 0x71, 0x18, 0x55, 0x6d, 0xcc, 0x6e, 0x3d, 0x85, 0x36, 0xd7, 0x46, 0x44, 0x36,
-		0x77, 0xfa, 0xcb, 0x40, 0x02, 0x28, 0x62, 0x58, 0xf4, 0x82, 0xd4, 0x7e, 0x23, 0xa6, 0x11, 0x9f,
-		0x99, 0xba, 0x7d, 0x0c, 0xa3, 0x9a, 0xa1, 0xb9, 0x9a, 0xa2, 0x57, 0xb7, 0x14, 0x5d, 0x31, 0xd4,
-		0x83, 0x44, 0x96, 0xed, 0x8e, 0x7a, 0x84, 0x83, 0x96, 0x19, 0x26, 0xba, 0x0a, 0x83, 0x02, 0x3e,
-		0x71, 0x08, 0xf0, 0x02, 0x2c, 0x10, 0x45, 0x7d, 0x2b, 0x06, 0x63, 0x32, 0xae, 0xfd, 0x74, 0xa9,
-		0xf5, 0x5d, 0x00, 0x6c, 0xc0, 0x11, 0x3f, 0x78, 0x00, 0xcd, 0xb6, 0x0f, 0xe0, 0x0c, 0xc3, 0x9b,
-		0x77, 0xdc, 0x80, 0x6e, 0xbf, 0x1e, 0x83, 0x6c, 0x50, 0xb7, 0x3f, 0x05, 0xf3, 0x02, 0x5a, 0xf4,
-		0xbd, 0x41, 0x82, 0x7f, 0xb6, 0xb6, 0x8b, 0x37, 0x68, 0xb3, 0xba, 0xde, 0x6e, 0xe0, 0x0d, 0x09,
-		0x52, 0x6b, 0x8a, 0xad, 0x34, 0x1c, 0xf4, 0x44, 0x5b, 0x00, 0x27, 0x76, 0xd9, 0xda, 0x3e, 0x4e,
-		0xce, 0x17, 0xf5, 0xcc, 0xe4, 0x3e, 0xdc, 0x21, 0x7e, 0xbb, 0x17, 0x46, 0xc8, 0x12, 0x31, 0x70,
-		0x20, 0x1f, 0xa3, 0xc7, 0x8c, 0x64, 0x8d, 0x17, 0xb8, 0xfa, 0x38, 0x05, 0x43, 0x84, 0xcc, 0x77,
-		0x74, 0x84, 0x06, 0x1a, 0xca, 0xf5, 0x0a, 0xcb, 0x41, 0x0f, 0x01, 0xda, 0xf1, 0x16, 0xed, 0x55,
-		0x5f, 0x05, 0x84, 0x6e, 0xcc, 0x2f, 0x11, 0xe4, 0x77, 0x02, 0x10, 0x29, 0xaa, 0xec, 0x0a, 0x32,
-		0x5b, 0xe3, 0x64, 0x48, 0xce, 0x3c, 0xc9, 0x08, 0xd8, 0xd0, 0x27, 0x25, 0x40, 0xbe, 0xd3, 0x93,
-		0xb1, 0x63, 0x91, 0x85, 0x05, 0x09, 0x3b, 0x03, 0x31, 0xa2, 0xd4, 0x3b, 0xec, 0xf4, 0xf9, 0x45,
-		0xd8, 0x19, 0xb0, 0xc9, 0x0b, 0xbe, 0x8b, 0x89, 0x45, 0x5d, 0xa7, 0xe5, 0x1d, 0xd4, 0xea, 0x45,
-		0x06, 0x8a, 0xdf, 0x92, 0xe0, 0x58, 0x5b, 0x7f, 0x7a, 0xc2, 0xfe, 0x15, 0x40, 0x76, 0xa0, 0x90,
-		0x7f, 0x01, 0x90, 0x09, 0xbd, 0x6f, 0xf3, 0x18, 0xb3, 0xdb, 0xbc, 0xd5, 0xad, 0xf2, 0x92, 0xec,
-		0x66, 0xf7, 0xbf, 0x94, 0x60, 0x22, 0x28, 0x8c, 0xd7, 0xac, 0x15, 0xc8, 0x06, 0x65, 0xe1, 0x0d,
-		0xba, 0xa7, 0x9f, 0x06, 0xf1, 0xb6, 0x84, 0xf8, 0xd1, 0x93, 0xfe, 0xd0, 0x61, 0xdb, 0x35, 0x8f,
-		0xf4, 0xad, 0x1b, 0x21, 0x53, 0xeb, 0x10, 0x4a, 0x88, 0x38, 0x22, 0xb1, 0x66, 0x9a, 0x3a, 0x7a,
-		0x2f, 0x8c, 0x19, 0xa6, 0x5b, 0x25, 0x76, 0x86, 0x6b, 0xc1, 0x4b, 0xd4, 0x99, 0xf2, 0x93, 0xfb,
-		0x53, 0xd9, 0xeb, 0xaf, 0x4e, 0xb5, 0x43, 0xb5, 0xe8, 0x71, 0xd4, 0x30, 0xdd, 0x32, 0x2d, 0xe7,
-		0xb7, 0xaa, 0x6d, 0x18, 0x0e, 0x57, 0xcd, 0xfc, 0xd5, 0xf2, 0xbe, 0xab, 0x1e, 0xee, 0x55, 0x6d,
-		0x76, 0x2b, 0x50, 0x27, 0xbb, 0x55, 0xf4, 0xc3, 0x97, 0xa7, 0xa4, 0x93, 0x5f, 0x92, 0x00, 0xfc,
-		0x45, 0x34, 0x7a, 0x10, 0x6e, 0x2b, 0xaf, 0xae, 0xcc, 0x57, 0xd7, 0x37, 0x66, 0x37, 0x36, 0xd7,
-		0xc3, 0x57, 0x8b, 0xc5, 0xae, 0xac, 0x63, 0x61, 0x55, 0xdb, 0xd6, 0x70, 0x0d, 0xdd, 0x07, 0x13,
-		0x61, 0x6a, 0x92, 0xaa, 0xcc, 0xe7, 0xa4, 0x42, 0xf6, 0xa5, 0x9b, 0xd3, 0x69, 0x16, 0x9f, 0xe0,
-		0x1a, 0x3a, 0x01, 0x47, 0xda, 0xe9, 0x16, 0x57, 0x16, 0x72, 0xb1, 0xc2, 0xf0, 0x4b, 0x37, 0xa7,
-		0x33, 0x5e, 0x20, 0x83, 0x8a, 0x80, 0x82, 0x94, 0x1c, 0x2f, 0x5e, 0x80, 0x97, 0x6e, 0x4e, 0xa7,
-		0x98, 0xda, 0x0a, 0x89, 0x17, 0x3f, 0x39, 0x39, 0x70, 0xe8, 0x17, 0x90, 0xbf, 0x36, 0xd8, 0x75,
-		0xb3, 0xb5, 0x8e, 0x0d, 0xec, 0x68, 0xce, 0x81, 0x36, 0x5b, 0xfb, 0xda, 0xc0, 0xed, 0xf5, 0xe6,
-		0xe3, 0xcf, 0x13, 0x90, 0x5d, 0x60, 0x02, 0x90, 0x3e, 0xc2, 0xe8, 0x6d, 0x90, 0xb2, 0xa8, 0xc7,
-		0xf7, 0x0e, 0x76, 0xba, 0x8c, 0x07, 0x36, 0x2f, 0x78, 0xb7, 0x8b, 0xd8, 0x2c, 0xf1, 0x34, 0xbf,
-		0x5e, 0xc0, 0x6e, 0x3d, 0xf9, 0xf7, 0x78, 0xb2, 0xe5, 0x99, 0xfd, 0x19, 0x1c, 0xbb, 0x8e, 0xb0,
-		0x41, 0x60, 0xd8, 0xa5, 0xa4, 0x1a, 0x1c, 0xa1, 0xc8, 0xfe, 0xb4, 0x49, 0xd1, 0x45, 0xfc, 0x7b,
-		0xb2, 0x9b, 0x98, 0x4b, 0x8a, 0xe3, 0xdf, 0x30, 0x60, 0xb7, 0x88, 0x98, 0xc8, 0xe3, 0x7a, 0x5b,
-		0x89, 0x83, 0x16, 0x42, 0xd7, 0xc4, 0x12, 0xfb, 0xdb, 0xc0, 0x0d, 0x5e, 0x19, 0x7b, 0x02, 0x86,
-		0x7c, 0x77, 0xe1, 0xf0, 0x7f, 0x51, 0xd2, 0xff, 0x64, 0x11, 0x64, 0x46, 0xdb, 0x70, 0xc4, 0x9f,
-		0x7a, 0x83, 0xa8, 0xec, 0x3f, 0xb9, 0x3c, 0xb0, 0x8f, 0xd0, 0x9f, 0xc3, 0x4f, 0x34, 0xdb, 0x8b,
-		0xc8, 0xa2, 0x62, 0x38, 0xe8, 0x1b, 0x9d, 0xbc, 0xf8, 0x18, 0x61, 0xff, 0xce, 0x35, 0x0c, 0xc0,
-		0xfe, 0x7b, 0x84, 0x65, 0xda, 0x2e, 0xae, 0xd1, 0x8d, 0xa1, 0xb4, 0xec, 0xa5, 0x8b, 0x3b, 0x80,
-		0xda, 0xfb, 0x26, 0xfc, 0xdc, 0x41, 0xea, 0xeb, 0xb9, 0x03, 0x9a, 0x80, 0x64, 0xf0, 0xc6, 0x18,
-		0x4b, 0x94, 0xd2, 0x2f, 0xf2, 0x89, 0xf2, 0xd0, 0xc7, 0xf2, 0xb7, 0x63, 0x70, 0x32, 0x78, 0xda,
-		0xf0, 0xee, 0x26, 0xb6, 0xf7, 0xbc, 0xa1, 0x67, 0x29, 0x75, 0xcd, 0x08, 0x5e, 0xaa, 0x3f, 0x16,
-		0x9c, 0xda, 0x29, 0xad, 0xd0, 0x60, 0xf1, 0x45, 0x09, 0x86, 0xd6, 0x94, 0x3a, 0x96, 0xf1, 0xbb,
-		0x9b, 0xd8, 0x71, 0x3b, 0x5c, 0x5a, 0x3e, 0x0a, 0x29, 0x73, 0x7b, 0x5b, 0x1c, 0x91, 0x26, 0x64,
-		0x9e, 0x22, 0x6d, 0xd6, 0xb5, 0x86, 0xc6, 0x6e, 0x17, 0x25, 0x64, 0x96, 0x20, 0x11, 0x93, 0x6a,
-		0x36, 0x0d, 0x3e, 0xfe, 0xf2, 0x09, 0xf1, 0x3d, 0x90, 0xa6, 0xc1, 0x86, 0x12, 0xca, 0xc3, 0xa0,
-		0x8d, 0xaf, 0x61, 0xdb, 0x61, 0x5f, 0x40, 0x4c, 0xcb, 0x22, 0x59, 0x7c, 0x1c, 0xb2, 0x4c, 0x12,
-		0x3e, 0xd1, 0x1e, 0x83, 0x34, 0xbd, 0xb8, 0xe3, 0xcb, 0x33, 0x48, 0xd2, 0x57, 0xd8, 0xd5, 0x67,
-		0x86, 0xcf, 0x44, 0x62, 0x89, 0x72, 0xb9, 0xab, 0x96, 0x4f, 0x44, 0x0f, 0x79, 0xa6, 0x43, 0x4f,
-		0xc3, 0xbf, 0x9b, 0x84, 0x23, 0xfc, 0x2c, 0x48, 0xb1, 0xb4, 0x53, 0x3b, 0xae, 0x2b, 0xae, 0xe2,
-		0x03, 0x8f, 0x36, 0x15, 0x4b, 0x2b, 0xee, 0x41, 0xe2, 0xb2, 0xeb, 0x5a, 0xe8, 0x24, 0x24, 0xed,
-		0xa6, 0x8e, 0xc5, 0xb6, 0x87, 0xb7, 0x6d, 0xac, 0x58, 0xda, 0x0c, 0x21, 0x90, 0x9b, 0x3a, 0x96,
-		0x19, 0x09, 0xaa, 0xc0, 0xd4, 0x76, 0x53, 0xd7, 0xf7, 0xaa, 0x35, 0x4c, 0xff, 0xa5, 0x93, 0xf7,
-		0x4f, 0x11, 0xf0, 0x75, 0x4b, 0x11, 0x9f, 0x56, 0x24, 0x8a, 0xb9, 0x83, 0x92, 0xcd, 0x53, 0x2a,
-		0xf1, 0x0f, 0x11, 0x2a, 0x82, 0xa6, 0xf8, 0x47, 0x31, 0x48, 0x0b, 0x68, 0x7a, 0x17, 0x19, 0xeb,
-		0x58, 0x75, 0x4d, 0xb1, 0x69, 0xef, 0xa5, 0x11, 0x82, 0x78, 0x9d, 0x77, 0x5e, 0xe6, 0xf2, 0x80,
-		0x4c, 0x12, 0x24, 0xcf, 0xbb, 0x21, 0x4e, 0xf2, 0xac, 0x26, 0xe9, 0xcf, 0x84, 0x65, 0x8a, 0x75,
-		0xd1, 0xe5, 0x01, 0x99, 0xa6, 0x50, 0x1e, 0x52, 0x64, 0x38, 0xb9, 0xac, 0xb7, 0x48, 0x3e, 0x4f,
-		0xa3, 0xa3, 0x90, 0xb4, 0x14, 0x57, 0x65, 0x97, 0xb7, 0x48, 0x01, 0x4b, 0xa2, 0x73, 0x90, 0x62,
-		0x2f, 0x87, 0x5b, 0xff, 0x5f, 0x0a, 0x51, 0x06, 0xfb, 0x44, 0x1b, 0x91, 0x7b, 0x4d, 0x71, 0x5d,
-		0x6c, 0x1b, 0x04, 0x90, 0x91, 0x23, 0x04, 0x89, 0x2d, 0xb3, 0xb6, 0xc7, 0xff, 0x87, 0x0b, 0xfd,
-		0xcd, 0xff, 0x69, 0x04, 0xb5, 0x87, 0x2a, 0x2d, 0x64, 0xff, 0xba, 0x2a, 0x2b, 0x32, 0xcb, 0x84,
-		0xa8, 0x02, 0xe3, 0x4a, 0xad, 0xa6, 0xb1, 0x7f, 0xa7, 0x52, 0xdd, 0xd2, 0xa8, 0x5b, 0x71, 0xe8,
-		0x3f, 0x26, 0xeb, 0xd6, 0x17, 0xc8, 0x67, 0x28, 0x73, 0xfa, 0x72, 0x06, 0x06, 0x2d, 0x26, 0x54,
-		0xf1, 0x22, 0x8c, 0xb5, 0x49, 0x4a, 0xe4, 0xdb, 0xd5, 0x8c, 0x9a, 0xb8, 0x36, 0x4f, 0x7e, 0x93,
-		0x3c, 0xfa, 0x51, 0x45, 0x76, 0x1c, 0x42, 0x7f, 0x97, 0x7f, 0xae, 0xfb, 0xeb, 0x8a, 0x91, 0xc0,
-		0xeb, 0x0a, 0xc5, 0xd2, 0xca, 0x19, 0x8a, 0xcf, 0xdf, 0x54, 0xcc, 0xb6, 0xbf, 0xa9, 0xa8, 0x63,
-		0x43, 0x4c, 0xb8, 0xa4, 0x48, 0xb1, 0x34, 0x87, 0x9a, 0xa3, 0xff, 0x91, 0x47, 0xe7, 0x62, 0xe0,
-		0x37, 0x7d, 0x62, 0x91, 0x58, 0x98, 0x5d, 0x5b, 0xf4, 0xec, 0xf8, 0x2b, 0x31, 0xb8, 0x23, 0x60,
-		0xc7, 0x01, 0xe2, 0x76, 0x73, 0x2e, 0x74, 0xb6, 0xf8, 0x3e, 0xde, 0xcf, 0x5e, 0x81, 0x04, 0xa1,
-		0x47, 0x11, 0xff, 0xd2, 0x21, 0xff, 0xb9, 0xaf, 0xff, 0x8b, 0x62, 0xf8, 0x60, 0x25, 0xd4, 0x2b,
-		0x14, 0xa4, 0xfc, 0xf3, 0xfd, 0xeb, 0x2f, 0xe7, 0x7f, 0xdf, 0xd2, 0x39, 0x3c, 0x35, 0xb6, 0xea,
-		0xf0, 0xc5, 0x0b, 0x5d, 0x1f, 0x49, 0x32, 0x67, 0xda, 0x3b, 0x6e, 0xda, 0x87, 0xa7, 0xee, 0x76,
-		0xd3, 0xbc, 0x57, 0x0f, 0xbe, 0xf9, 0x08, 0xec, 0x3a, 0x1c, 0x7d, 0x92, 0x88, 0xe5, 0x2f, 0x7c,
-		0xc5, 0x6c, 0x70, 0xd4, 0x3b, 0xa6, 0x92, 0xf8, 0x93, 0x62, 0x16, 0x45, 0x5f, 0x02, 0xf0, 0x45,
-		0xe7, 0x0b, 0xc8, 0xfb, 0x66, 0xba, 0xce, 0x32, 0x33, 0x81, 0x19, 0x46, 0x0e, 0x70, 0x16, 0x7f,
-		0x55, 0x82, 0xdb, 0xda, 0xaa, 0xe6, 0xee, 0x7f, 0xa1, 0xc3, 0x7d, 0xf9, 0x03, 0x05, 0x42, 0x0b,
-		0x1d, 0x84, 0x3d, 0x1e, 0x29, 0x2c, 0x93, 0x22, 0x24, 0xed, 0xd3, 0x70, 0x24, 0x2c, 0xac, 0x50,
-		0xd3, 0xe3, 0x30, 0x12, 0xde, 0x52, 0x8d, 0x8c, 0x1c, 0x86, 0x43, 0xfb, 0xa9, 0xc5, 0x6a, 0x6b,
-		0x0f, 0x78, 0x5a, 0xa8, 0x40, 0xc6, 0x23, 0xe5, 0xf1, 0x70, 0xdf, 0x4a, 0xf0, 0x39, 0x89, 0xa2,
-		0xa7, 0xc3, 0x35, 0x04, 0xc2, 0xae, 0xc3, 0x6a, 0xc6, 0xa1, 0x99, 0xc5, 0xf7, 0x25, 0xb8, 0xab,
-		0x87, 0xb4, 0x5c, 0x35, 0x37, 0x60, 0x22, 0xb0, 0xbb, 0x20, 0x66, 0x04, 0x61, 0x2a, 0x27, 0xa3,
-		0x23, 0x5d, 0x6f, 0xf9, 0x7c, 0x3b, 0x51, 0xd7, 0x67, 0xbe, 0x3d, 0x35, 0xde, 0x5e, 0xe6, 0xc8,
-		0xe3, 0xed, 0x7b, 0x00, 0x87, 0x68, 0x53, 0xaf, 0x48, 0x70, 0x7f, 0xb8, 0xa9, 0x1d, 0x62, 0xe6,
-		0xb7, 0x5e, 0x0f, 0x7d, 0x4b, 0x82, 0x93, 0xfd, 0x88, 0xcd, 0xbb, 0x6a, 0x0b, 0xc6, 0xfd, 0xf5,
-		0x43, 0x6b, 0x4f, 0x1d, 0x60, 0xf5, 0x80, 0x3c, 0xb4, 0x5b, 0xd0, 0x25, 0x9f, 0x92, 0xf8, 0x68,
-		0x0c, 0x5a, 0x83, 0xa7, 0xff, 0xf0, 0x4e, 0x6e, 0xb4, 0xfe, 0x43, 0xdb, 0xb8, 0x1d, 0x3a, 0x30,
-		0xb6, 0xaf, 0x0e, 0xf4, 0xd7, 0x14, 0xc5, 0x6b, 0xdc, 0x75, 0x76, 0xd8, 0x26, 0x7c, 0x17, 0x8c,
-		0x77, 0x18, 0x19, 0xdc, 0x7d, 0xec, 0x63, 0x60, 0xc8, 0xa8, 0xdd, 0xf6, 0x8b, 0xbf, 0x26, 0xc1,
-		0x14, 0xad, 0xb8, 0x43, 0xf7, 0xbc, 0x15, 0xf5, 0xd4, 0xe0, 0x9e, 0xaf, 0xa3, 0xb8, 0x5c, 0x61,
-		0x8b, 0x90, 0x62, 0x16, 0xc5, 0x75, 0x74, 0x00, 0x93, 0xe4, 0x00, 0xc5, 0x2f, 0x0a, 0x4f, 0x3b,
-		0x2f, 0x1a, 0xd4, 0x79, 0x1c, 0xbf, 0x39, 0xfd, 0x1c, 0xd2, 0x38, 0x0e, 0xa8, 0xe9, 0x9b, 0xc2,
-		0xe7, 0x76, 0x96, 0x9b, 0x2b, 0x4a, 0x3d, 0x34, 0x9f, 0xcb, 0xb7, 0x40, 0x6e, 0xa9, 0x73, 0xfd,
-		0x1d, 0xe1, 0x5c, 0xbd, 0x36, 0x45, 0x38, 0xd7, 0xb7, 0x5a, 0xa7, 0x78, 0x6e, 0x36, 0xa2, 0x01,
-		0x7f, 0x19, 0xdd, 0xec, 0xef, 0xc4, 0xe0, 0x18, 0x6d, 0x5b, 0x70, 0x13, 0xe7, 0x30, 0x3b, 0x03,
-		0x39, 0xb6, 0x5a, 0xdd, 0xa7, 0x17, 0xc9, 0x39, 0xb6, 0x7a, 0xb5, 0x65, 0xc6, 0x44, 0xb5, 0xd0,
-		0xa6, 0x1f, 0xc5, 0x89, 0x47, 0x9e, 0xde, 0x05, 0xf6, 0x95, 0x3a, 0x18, 0x47, 0xe2, 0x10, 0x8c,
-		0xe3, 0x1b, 0x12, 0x14, 0x3a, 0x29, 0x90, 0x1b, 0x83, 0x06, 0x47, 0x43, 0xc7, 0x2f, 0xad, 0xf6,
-		0xf0, 0x60, 0x3f, 0x9b, 0x6a, 0x2d, 0xc3, 0xf5, 0x88, 0x8d, 0x6f, 0x75, 0x34, 0x34, 0x15, 0xb6,
-		0xf7, 0xf6, 0x35, 0xc9, 0x5b, 0x70, 0x98, 0xbe, 0xd2, 0xe6, 0xf3, 0xff, 0x52, 0xac, 0x67, 0x3e,
-		0x2b, 0xc1, 0x64, 0x17, 0xb1, 0xdf, 0x8a, 0x13, 0xf9, 0x4e, 0x57, 0xdb, 0x38, 0xec, 0xd5, 0xd2,
-		0x63, 0x7c, 0x60, 0x85, 0xef, 0x63, 0x07, 0x16, 0xc5, 0x9d, 0x1e, 0x74, 0x15, 0x9f, 0x81, 0xdb,
-		0x3b, 0x72, 0x71, 0xd9, 0x4a, 0x90, 0xd8, 0xd1, 0x1c, 0x97, 0x8b, 0x75, 0x5f, 0x37, 0xb1, 0x5a,
-		0xb8, 0x29, 0x4f, 0x11, 0x41, 0x8e, 0x42, 0xaf, 0x99, 0xa6, 0xce, 0xc5, 0x28, 0x5e, 0x81, 0xb1,
-		0x40, 0x1e, 0xaf, 0xe4, 0x2c, 0x24, 0x2c, 0x93, 0x7f, 0xac, 0x60, 0xe8, 0xf4, 0x1d, 0x5d, 0x4f,
-		0x4e, 0x4c, 0x53, 0xe7, 0xcd, 0xa6, 0xf4, 0xc5, 0x09, 0x40, 0x0c, 0x8c, 0x1e, 0xa2, 0x88, 0x2a,
-		0xd6, 0x61, 0x3c, 0x94, 0xcb, 0x2b, 0x79, 0x53, 0x07, 0x34, 0xa7, 0x5f, 0x3f, 0x02, 0x49, 0x8a,
-		0x8a, 0x3e, 0x22, 0x85, 0xbe, 0x33, 0x34, 0xd3, 0x0d, 0xa6, 0xf3, 0xe6, 0x44, 0xe1, 0x54, 0xdf,
-		0xf4, 0x3c, 0x72, 0x3d, 0xf9, 0x73, 0xff, 0xf6, 0x7b, 0x1f, 0x8a, 0xdd, 0x83, 0x8a, 0xa7, 0xba,
-		0xec, 0x98, 0x04, 0x06, 0xd9, 0xa7, 0x43, 0x2f, 0xe1, 0x1f, 0xea, 0xaf, 0x2a, 0x21, 0xd9, 0x4c,
-		0xbf, 0xe4, 0x5c, 0xb0, 0x8b, 0x54, 0xb0, 0x33, 0xe8, 0xd1, 0x68, 0xc1, 0x4e, 0xbd, 0x27, 0x3c,
-		0x9c, 0xde, 0x8b, 0xfe, 0x9d, 0x04, 0x13, 0x9d, 0xd6, 0xc9, 0xe8, 0x7c, 0x7f, 0x52, 0xb4, 0x47,
-		0x42, 0x85, 0x0b, 0x07, 0xe0, 0xe4, 0x4d, 0x59, 0xa0, 0x4d, 0x99, 0x45, 0x8f, 0x1f, 0xa0, 0x29,
-		0xa7, 0x82, 0x07, 0x37, 0xff, 0x47, 0x82, 0x3b, 0x7b, 0x2e, 0x2e, 0xd1, 0x6c, 0x7f, 0x52, 0xf6,
-		0x08, 0xf9, 0x0a, 0xe5, 0x37, 0x03, 0xc1, 0x5b, 0xfc, 0x24, 0x6d, 0xf1, 0x15, 0xb4, 0x78, 0x90,
-		0x16, 0x77, 0x3c, 0x55, 0x43, 0xbf, 0x17, 0xbe, 0x02, 0xd9, 0xdb, 0x9c, 0xda, 0x56, 0x5f, 0x11,
-		0x03, 0xa3, 0x3d, 0x16, 0x2f, 0x3e, 0x4d, 0x9b, 0x20, 0xa3, 0xb5, 0x37, 0xd9, 0x69, 0xa7, 0xde,
-		0x13, 0x9e, 0x2c, 0xde, 0x8b, 0xfe, 0x5c, 0xea, 0x7c, 0x97, 0xf1, 0x5c, 0x4f, 0x11, 0xbb, 0xaf,
-		0x2c, 0x0b, 0xe7, 0xf7, 0xcf, 0xc8, 0x1b, 0xd9, 0xa0, 0x8d, 0xac, 0x23, 0x7c, 0xd8, 0x8d, 0xec,
-		0xd8, 0x89, 0xe8, 0x6b, 0x12, 0x4c, 0x74, 0x5a, 0x4a, 0x45, 0x0c, 0xcb, 0x1e, 0xab, 0xc6, 0x88,
-		0x61, 0xd9, 0x6b, 0xdd, 0x56, 0x7c, 0x1b, 0x6d, 0xfc, 0x59, 0xf4, 0x58, 0xb7, 0xc6, 0xf7, 0xec,
-		0x45, 0x32, 0x16, 0x7b, 0xae, 0x40, 0x22, 0xc6, 0x62, 0x3f, 0xcb, 0xaf, 0x88, 0xb1, 0xd8, 0xd7,
-		0x02, 0x28, 0x7a, 0x2c, 0x7a, 0x2d, 0xeb, 0xb3, 0x1b, 0x1d, 0xf4, 0x15, 0x09, 0x86, 0x43, 0x01,
-		0x36, 0x7a, 0xa4, 0xa7, 0xa0, 0x9d, 0x56, 0x33, 0x85, 0xd3, 0xfb, 0x61, 0xe1, 0x6d, 0x59, 0xa4,
-		0x6d, 0x99, 0x43, 0xb3, 0x07, 0x69, 0x4b, 0xf8, 0x10, 0xfc, 0x1b, 0x12, 0x8c, 0x77, 0x08, 0x4d,
-		0x23, 0x46, 0x61, 0xf7, 0x18, 0xbc, 0x70, 0x7e, 0xff, 0x8c, 0xbc, 0x55, 0x97, 0x68, 0xab, 0xde,
-		0x89, 0xde, 0x71, 0x90, 0x56, 0x05, 0xe6, 0xe7, 0x57, 0xfd, 0x0b, 0x72, 0x81, 0x7a, 0xd0, 0xd9,
-		0x7d, 0x0a, 0x26, 0x1a, 0x74, 0x6e, 0xdf, 0x7c, 0xbc, 0x3d, 0x4f, 0xd1, 0xf6, 0x3c, 0x89, 0x56,
-		0xdf, 0x5c, 0x7b, 0xda, 0xa7, 0xf5, 0x2f, 0xb4, 0xbf, 0x07, 0xec, 0x6d, 0x45, 0x1d, 0x83, 0xd5,
-		0xc2, 0xa3, 0xfb, 0xe2, 0xe1, 0x8d, 0x3a, 0x4f, 0x1b, 0x75, 0x1a, 0x3d, 0xdc, 0xad, 0x51, 0x81,
-		0x3b, 0x8f, 0x9a, 0xb1, 0x6d, 0x9e, 0x7a, 0x0f, 0x0b, 0x81, 0xdf, 0x8b, 0xde, 0x27, 0xf1, 0x3b,
-		0x67, 0x27, 0x7a, 0xd6, 0x1b, 0x88, 0x63, 0x0b, 0xf7, 0xf7, 0x41, 0xc9, 0xe5, 0xba, 0x87, 0xca,
-		0x35, 0x89, 0xee, 0xe8, 0x26, 0x17, 0x89, 0x65, 0xd1, 0x07, 0xfc, 0x2b, 0xa3, 0x27, 0x7b, 0x63,
-		0x07, 0x83, 0xdd, 0xc2, 0x03, 0x7d, 0xd1, 0x72, 0x49, 0xee, 0xa3, 0x92, 0x4c, 0xa3, 0xc9, 0xae,
-		0x92, 0xb0, 0xd0, 0xf7, 0xb0, 0x2f, 0x7e, 0xfc, 0xc6, 0x51, 0x98, 0xea, 0x52, 0xa3, 0x7b, 0x3d,
-		0xe2, 0x1c, 0xb2, 0xc7, 0xb3, 0xd8, 0xc8, 0x67, 0xaf, 0x87, 0xfd, 0xa1, 0xd7, 0xfe, 0x0e, 0x2d,
-		0x8b, 0x5f, 0x4c, 0x00, 0x5a, 0x76, 0xea, 0x73, 0x36, 0x66, 0xff, 0xaf, 0x92, 0x8f, 0xf2, 0x96,
-		0x27, 0x64, 0xd2, 0x9b, 0x7a, 0x42, 0xb6, 0x1c, 0x7a, 0xca, 0x15, 0xdb, 0xdf, 0x43, 0xcd, 0xbe,
-		0xdf, 0x73, 0xc5, 0x6f, 0xc9, 0x7b, 0xae, 0xce, 0x57, 0xcb, 0x13, 0x87, 0xf3, 0x26, 0x24, 0xb9,
-		0xef, 0x37, 0x21, 0x97, 0x20, 0xc5, 0x9f, 0x4f, 0xa6, 0x0e, 0xf4, 0x7c, 0x92, 0x73, 0xa3, 0x33,
-		0xe2, 0x23, 0xa6, 0x83, 0xfd, 0x5d, 0x4d, 0xe6, 0x5f, 0x39, 0xf5, 0xb7, 0x0a, 0xee, 0x80, 0x42,
-		0xbb, 0xd9, 0x78, 0x83, 0xf7, 0xc7, 0x31, 0xc8, 0x2d, 0x3b, 0xf5, 0x4a, 0x4d, 0x73, 0x6f, 0x91,
-		0x4d, 0x1d, 0xd2, 0x1b, 0x1b, 0x05, 0x46, 0x5b, 0x5e, 0x12, 0x73, 0x3b, 0x3a, 0x7f, 0xe0, 0x17,
-		0x0b, 0x23, 0xe1, 0xf7, 0xc4, 0x68, 0xa7, 0xb3, 0xb9, 0x26, 0xf6, 0x55, 0x4d, 0x5f, 0x4f, 0x0f,
-		0xfd, 0xde, 0x29, 0x40, 0xbe, 0x55, 0xfd, 0x5e, 0xdf, 0xbc, 0x2a, 0xc1, 0xd0, 0xb2, 0x23, 0x82,
-		0x3b, 0xfc, 0x16, 0x7b, 0xf4, 0x74, 0xce, 0xfb, 0x4e, 0x78, 0xbc, 0x3f, 0xcb, 0x14, 0x5f, 0x0b,
-		0xf7, 0x1b, 0x7f, 0x04, 0xc6, 0x03, 0xed, 0xf3, 0xda, 0xfd, 0x5b, 0x31, 0xea, 0xe9, 0xca, 0xb8,
-		0xae, 0x19, 0x5e, 0x3c, 0x88, 0x7f, 0x1a, 0x9e, 0x8e, 0xf8, 0x3a, 0x4d, 0x1c, 0x54, 0xa7, 0xbb,
-		0x74, 0xb8, 0xb7, 0xe8, 0xce, 0xdb, 0xae, 0xea, 0xf0, 0x58, 0x49, 0x3a, 0xf8, 0x63, 0xa5, 0xe2,
-		0x77, 0x24, 0x18, 0x5e, 0x76, 0xea, 0x9b, 0x46, 0xed, 0xff, 0x5b, 0x1b, 0xdd, 0x86, 0x23, 0xa1,
-		0x16, 0xde, 0x22, 0x55, 0x9e, 0xfe, 0x68, 0x02, 0xe2, 0xcb, 0x4e, 0x1d, 0xbd, 0x1b, 0x46, 0x5b,
-		0xa7, 0xf8, 0xae, 0x91, 0x5b, 0xbb, 0x5f, 0xef, 0xbe, 0xba, 0xea, 0x3e, 0x07, 0xa0, 0x5d, 0x18,
-		0x0e, 0xfb, 0xff, 0x13, 0x3d, 0x40, 0x42, 0x94, 0x85, 0x87, 0xfb, 0xa5, 0xf4, 0x2a, 0xfb, 0x19,
-		0x48, 0x7b, 0x0e, 0xed, 0xee, 0x1e, 0xdc, 0x82, 0xa8, 0x7b, 0x2c, 0xda, 0xc1, 0x75, 0x10, 0xed,
-		0xb5, 0xba, 0x8d, 0x5e, 0xda, 0x6b, 0xa1, 0xed, 0xa9, 0xbd, 0x6e, 0x43, 0x6a, 0x0b, 0x20, 0x60,
-		0xff, 0xf7, 0xf6, 0x40, 0xf0, 0xc9, 0x0a, 0x0f, 0xf5, 0x45, 0xe6, 0x9d, 0x38, 0x1d, 0x72, 0xe8,
-		0xfc, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xd7, 0x37, 0x9d, 0xd3, 0x97, 0x00, 0x00,
+		// 7811 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x6b, 0x70, 0x24, 0xd7,
+		0x75, 0x1e, 0xe6, 0x05, 0xcc, 0x9c, 0x19, 0x60, 0x1a, 0xbd, 0xe0, 0xee, 0x00, 0x24, 0x01, 0x70,
+		0xf8, 0xd8, 0x25, 0x45, 0x62, 0xc9, 0x25, 0x77, 0xc9, 0x9d, 0xb5, 0xc4, 0x60, 0x30, 0xb3, 0x58,
+		0x2c, 0xf1, 0x18, 0xf6, 0x00, 0xcb, 0x87, 0xad, 0x74, 0x1a, 0x3d, 0x17, 0x83, 0x26, 0x7a, 0xba,
+		0x5b, 0xdd, 0x3d, 0xbb, 0x0b, 0x56, 0x92, 0xa2, 0x8b, 0x79, 0x48, 0x9b, 0x4a, 0x22, 0xc7, 0x29,
+		0x5b, 0x96, 0xb4, 0x0a, 0x69, 0x29, 0x91, 0xa3, 0x28, 0x89, 0x65, 0x2b, 0x4a, 0x6c, 0xff, 0x88,
+		0x9c, 0xaa, 0x24, 0xb2, 0x52, 0x95, 0x92, 0xfc, 0xc3, 0x8f, 0xc4, 0xa1, 0x1d, 0x4a, 0x15, 0x2b,
+		0x8a, 0x12, 0x2b, 0x0a, 0x53, 0x95, 0x2a, 0x95, 0x53, 0xa9, 0x73, 0x1f, 0xdd, 0x3d, 0x2f, 0xcc,
+		0x60, 0xb3, 0x94, 0x5d, 0xe5, 0x5f, 0x8b, 0x7b, 0xee, 0x39, 0x5f, 0x9f, 0x7b, 0xee, 0xb9, 0xe7,
+		0x9e, 0x7b, 0x6e, 0xf7, 0x2c, 0x7c, 0xe9, 0x12, 0x2c, 0x36, 0x6d, 0xbb, 0x69, 0x92, 0xb3, 0x8e,
+		0x6b, 0xfb, 0xf6, 0x6e, 0x7b, 0xef, 0x6c, 0x83, 0x78, 0xba, 0x6b, 0x38, 0xbe, 0xed, 0x2e, 0x51,
+		0x9a, 0x9c, 0x67, 0x1c, 0x4b, 0x82, 0xa3, 0xb8, 0x01, 0xd3, 0x97, 0x0d, 0x93, 0x54, 0x02, 0xc6,
+		0x3a, 0xf1, 0xe5, 0xe7, 0x20, 0xb9, 0x67, 0x98, 0xa4, 0x10, 0x5b, 0x4c, 0x9c, 0xc9, 0x9e, 0x7b,
+		0x68, 0xa9, 0x4b, 0x68, 0xa9, 0x53, 0xa2, 0x86, 0x64, 0x85, 0x4a, 0x14, 0xbf, 0x9d, 0x84, 0x13,
+		0x7d, 0x7a, 0x65, 0x19, 0x92, 0x96, 0xd6, 0x42, 0xc4, 0xd8, 0x99, 0x8c, 0x42, 0xff, 0x96, 0x0b,
+		0x30, 0xe1, 0x68, 0xfa, 0x81, 0xd6, 0x24, 0x85, 0x38, 0x25, 0x8b, 0xa6, 0x3c, 0x0f, 0xd0, 0x20,
+		0x0e, 0xb1, 0x1a, 0xc4, 0xd2, 0x0f, 0x0b, 0x89, 0xc5, 0xc4, 0x99, 0x8c, 0x12, 0xa1, 0xc8, 0x1f,
+		0x80, 0x69, 0xa7, 0xbd, 0x6b, 0x1a, 0xba, 0x1a, 0x61, 0x83, 0xc5, 0xc4, 0x99, 0x94, 0x22, 0xb1,
+		0x8e, 0x4a, 0xc8, 0x7c, 0x1a, 0xf2, 0x37, 0x88, 0x76, 0x10, 0x65, 0xcd, 0x52, 0xd6, 0x29, 0x24,
+		0x47, 0x18, 0x57, 0x20, 0xd7, 0x22, 0x9e, 0xa7, 0x35, 0x89, 0xea, 0x1f, 0x3a, 0xa4, 0x90, 0xa4,
+		0xa3, 0x5f, 0xec, 0x19, 0x7d, 0xf7, 0xc8, 0xb3, 0x5c, 0x6a, 0xfb, 0xd0, 0x21, 0xf2, 0x32, 0x64,
+		0x88, 0xd5, 0x6e, 0x31, 0x84, 0xd4, 0x00, 0xfb, 0x55, 0xad, 0x76, 0xab, 0x1b, 0x25, 0x8d, 0x62,
+		0x1c, 0x62, 0xc2, 0x23, 0xee, 0x75, 0x43, 0x27, 0x85, 0x71, 0x0a, 0x70, 0xba, 0x07, 0xa0, 0xce,
+		0xfa, 0xbb, 0x31, 0x84, 0x9c, 0xbc, 0x02, 0x19, 0x72, 0xd3, 0x27, 0x96, 0x67, 0xd8, 0x56, 0x61,
+		0x82, 0x82, 0x3c, 0xdc, 0x67, 0x16, 0x89, 0xd9, 0xe8, 0x86, 0x08, 0xe5, 0xe4, 0x0b, 0x30, 0x61,
+		0x3b, 0xbe, 0x61, 0x5b, 0x5e, 0x21, 0xbd, 0x18, 0x3b, 0x93, 0x3d, 0x77, 0x5f, 0x5f, 0x47, 0xd8,
+		0x62, 0x3c, 0x8a, 0x60, 0x96, 0xd7, 0x40, 0xf2, 0xec, 0xb6, 0xab, 0x13, 0x55, 0xb7, 0x1b, 0x44,
+		0x35, 0xac, 0x3d, 0xbb, 0x90, 0xa1, 0x00, 0x0b, 0xbd, 0x03, 0xa1, 0x8c, 0x2b, 0x76, 0x83, 0xac,
+		0x59, 0x7b, 0xb6, 0x32, 0xe5, 0x75, 0xb4, 0xe5, 0x93, 0x30, 0xee, 0x1d, 0x5a, 0xbe, 0x76, 0xb3,
+		0x90, 0xa3, 0x1e, 0xc2, 0x5b, 0xc5, 0x5f, 0x19, 0x87, 0xfc, 0x28, 0x2e, 0x76, 0x09, 0x52, 0x7b,
+		0x38, 0xca, 0x42, 0xfc, 0x38, 0x36, 0x60, 0x32, 0x9d, 0x46, 0x1c, 0xbf, 0x43, 0x23, 0x2e, 0x43,
+		0xd6, 0x22, 0x9e, 0x4f, 0x1a, 0xcc, 0x23, 0x12, 0x23, 0xfa, 0x14, 0x30, 0xa1, 0x5e, 0x97, 0x4a,
+		0xde, 0x91, 0x4b, 0xbd, 0x0c, 0xf9, 0x40, 0x25, 0xd5, 0xd5, 0xac, 0xa6, 0xf0, 0xcd, 0xb3, 0xc3,
+		0x34, 0x59, 0xaa, 0x0a, 0x39, 0x05, 0xc5, 0x94, 0x29, 0xd2, 0xd1, 0x96, 0x2b, 0x00, 0xb6, 0x45,
+		0xec, 0x3d, 0xb5, 0x41, 0x74, 0xb3, 0x90, 0x1e, 0x60, 0xa5, 0x2d, 0x64, 0xe9, 0xb1, 0x92, 0xcd,
+		0xa8, 0xba, 0x29, 0x5f, 0x0c, 0x5d, 0x6d, 0x62, 0x80, 0xa7, 0x6c, 0xb0, 0x45, 0xd6, 0xe3, 0x6d,
+		0x3b, 0x30, 0xe5, 0x12, 0xf4, 0x7b, 0xd2, 0xe0, 0x23, 0xcb, 0x50, 0x25, 0x96, 0x86, 0x8e, 0x4c,
+		0xe1, 0x62, 0x6c, 0x60, 0x93, 0x6e, 0xb4, 0x29, 0x3f, 0x08, 0x01, 0x41, 0xa5, 0x6e, 0x05, 0x34,
+		0x0a, 0xe5, 0x04, 0x71, 0x53, 0x6b, 0x91, 0xb9, 0xd7, 0x61, 0xaa, 0xd3, 0x3c, 0xf2, 0x0c, 0xa4,
+		0x3c, 0x5f, 0x73, 0x7d, 0xea, 0x85, 0x29, 0x85, 0x35, 0x64, 0x09, 0x12, 0xc4, 0x6a, 0xd0, 0x28,
+		0x97, 0x52, 0xf0, 0x4f, 0xf9, 0xcf, 0x85, 0x03, 0x4e, 0xd0, 0x01, 0x3f, 0xd2, 0x3b, 0xa3, 0x1d,
+		0xc8, 0xdd, 0xe3, 0x9e, 0x7b, 0x16, 0x26, 0x3b, 0x06, 0x30, 0xea, 0xa3, 0x8b, 0x7f, 0x11, 0xee,
+		0xe9, 0x0b, 0x2d, 0xbf, 0x0c, 0x33, 0x6d, 0xcb, 0xb0, 0x7c, 0xe2, 0x3a, 0x2e, 0x41, 0x8f, 0x65,
+		0x8f, 0x2a, 0xfc, 0xe1, 0xc4, 0x00, 0x9f, 0xdb, 0x89, 0x72, 0x33, 0x14, 0xe5, 0x44, 0xbb, 0x97,
+		0xf8, 0x58, 0x26, 0xfd, 0x9d, 0x09, 0xe9, 0x8d, 0x37, 0xde, 0x78, 0x23, 0x5e, 0xfc, 0xf5, 0x71,
+		0x98, 0xe9, 0xb7, 0x66, 0xfa, 0x2e, 0xdf, 0x93, 0x30, 0x6e, 0xb5, 0x5b, 0xbb, 0xc4, 0xa5, 0x46,
+		0x4a, 0x29, 0xbc, 0x25, 0x2f, 0x43, 0xca, 0xd4, 0x76, 0x89, 0x59, 0x48, 0x2e, 0xc6, 0xce, 0x4c,
+		0x9d, 0xfb, 0xc0, 0x48, 0xab, 0x72, 0x69, 0x1d, 0x45, 0x14, 0x26, 0x29, 0x7f, 0x08, 0x92, 0x3c,
+		0x44, 0x23, 0xc2, 0x63, 0xa3, 0x21, 0xe0, 0x5a, 0x52, 0xa8, 0x9c, 0x7c, 0x2f, 0x64, 0xf0, 0x5f,
+		0xe6, 0x1b, 0xe3, 0x54, 0xe7, 0x34, 0x12, 0xd0, 0x2f, 0xe4, 0x39, 0x48, 0xd3, 0x65, 0xd2, 0x20,
+		0x62, 0x6b, 0x0b, 0xda, 0xe8, 0x58, 0x0d, 0xb2, 0xa7, 0xb5, 0x4d, 0x5f, 0xbd, 0xae, 0x99, 0x6d,
+		0x42, 0x1d, 0x3e, 0xa3, 0xe4, 0x38, 0xf1, 0x1a, 0xd2, 0xe4, 0x05, 0xc8, 0xb2, 0x55, 0x65, 0x58,
+		0x0d, 0x72, 0x93, 0x46, 0xcf, 0x94, 0xc2, 0x16, 0xda, 0x1a, 0x52, 0xf0, 0xf1, 0xaf, 0x79, 0xb6,
+		0x25, 0x5c, 0x93, 0x3e, 0x02, 0x09, 0xf4, 0xf1, 0xcf, 0x76, 0x07, 0xee, 0xfb, 0xfb, 0x0f, 0xaf,
+		0x67, 0x2d, 0x9d, 0x86, 0x3c, 0xe5, 0x78, 0x9a, 0x4f, 0xbd, 0x66, 0x16, 0xa6, 0x17, 0x63, 0x67,
+		0xd2, 0xca, 0x14, 0x23, 0x6f, 0x71, 0x6a, 0xf1, 0x2b, 0x71, 0x48, 0xd2, 0xc0, 0x92, 0x87, 0xec,
+		0xf6, 0x2b, 0xb5, 0xaa, 0x5a, 0xd9, 0xda, 0x29, 0xaf, 0x57, 0xa5, 0x98, 0x3c, 0x05, 0x40, 0x09,
+		0x97, 0xd7, 0xb7, 0x96, 0xb7, 0xa5, 0x78, 0xd0, 0x5e, 0xdb, 0xdc, 0xbe, 0xf0, 0x8c, 0x94, 0x08,
+		0x04, 0x76, 0x18, 0x21, 0x19, 0x65, 0x78, 0xfa, 0x9c, 0x94, 0x92, 0x25, 0xc8, 0x31, 0x80, 0xb5,
+		0x97, 0xab, 0x95, 0x0b, 0xcf, 0x48, 0xe3, 0x9d, 0x94, 0xa7, 0xcf, 0x49, 0x13, 0xf2, 0x24, 0x64,
+		0x28, 0xa5, 0xbc, 0xb5, 0xb5, 0x2e, 0xa5, 0x03, 0xcc, 0xfa, 0xb6, 0xb2, 0xb6, 0xb9, 0x2a, 0x65,
+		0x02, 0xcc, 0x55, 0x65, 0x6b, 0xa7, 0x26, 0x41, 0x80, 0xb0, 0x51, 0xad, 0xd7, 0x97, 0x57, 0xab,
+		0x52, 0x36, 0xe0, 0x28, 0xbf, 0xb2, 0x5d, 0xad, 0x4b, 0xb9, 0x0e, 0xb5, 0x9e, 0x3e, 0x27, 0x4d,
+		0x06, 0x8f, 0xa8, 0x6e, 0xee, 0x6c, 0x48, 0x53, 0xf2, 0x34, 0x4c, 0xb2, 0x47, 0x08, 0x25, 0xf2,
+		0x5d, 0xa4, 0x0b, 0xcf, 0x48, 0x52, 0xa8, 0x08, 0x43, 0x99, 0xee, 0x20, 0x5c, 0x78, 0x46, 0x92,
+		0x8b, 0x2b, 0x90, 0xa2, 0x6e, 0x28, 0xcb, 0x30, 0xb5, 0xbe, 0x5c, 0xae, 0xae, 0xab, 0x5b, 0xb5,
+		0xed, 0xb5, 0xad, 0xcd, 0xe5, 0x75, 0x29, 0x16, 0xd2, 0x94, 0xea, 0x8b, 0x3b, 0x6b, 0x4a, 0xb5,
+		0x22, 0xc5, 0xa3, 0xb4, 0x5a, 0x75, 0x79, 0xbb, 0x5a, 0x91, 0x12, 0x45, 0x1d, 0x66, 0xfa, 0x05,
+		0xd4, 0xbe, 0x4b, 0x28, 0xe2, 0x0b, 0xf1, 0x01, 0xbe, 0x40, 0xb1, 0xba, 0x7d, 0xa1, 0xf8, 0xad,
+		0x38, 0x9c, 0xe8, 0xb3, 0xa9, 0xf4, 0x7d, 0xc8, 0xf3, 0x90, 0x62, 0xbe, 0xcc, 0xb6, 0xd9, 0x47,
+		0xfb, 0xee, 0x4e, 0xd4, 0xb3, 0x7b, 0xb6, 0x5a, 0x2a, 0x17, 0x4d, 0x35, 0x12, 0x03, 0x52, 0x0d,
+		0x84, 0xe8, 0x71, 0xd8, 0x0f, 0xf7, 0x04, 0x7f, 0xb6, 0x3f, 0x5e, 0x18, 0x65, 0x7f, 0xa4, 0xb4,
+		0xe3, 0x6d, 0x02, 0xa9, 0x3e, 0x9b, 0xc0, 0x25, 0x98, 0xee, 0x01, 0x1a, 0x39, 0x18, 0xbf, 0x19,
+		0x83, 0xc2, 0x20, 0xe3, 0x0c, 0x09, 0x89, 0xf1, 0x8e, 0x90, 0x78, 0xa9, 0xdb, 0x82, 0x0f, 0x0c,
+		0x9e, 0x84, 0x9e, 0xb9, 0xfe, 0x7c, 0x0c, 0x4e, 0xf6, 0x4f, 0x29, 0xfb, 0xea, 0xf0, 0x21, 0x18,
+		0x6f, 0x11, 0x7f, 0xdf, 0x16, 0x69, 0xd5, 0x23, 0x7d, 0x36, 0x6b, 0xec, 0xee, 0x9e, 0x6c, 0x2e,
+		0x25, 0x5f, 0xec, 0xd6, 0x75, 0x61, 0x50, 0x82, 0xdb, 0xa3, 0xe9, 0xc7, 0xe2, 0x70, 0x4f, 0x5f,
+		0xf0, 0xbe, 0x8a, 0xde, 0x0f, 0x60, 0x58, 0x4e, 0xdb, 0x67, 0xa9, 0x13, 0x8b, 0xc4, 0x19, 0x4a,
+		0xa1, 0xc1, 0x0b, 0xa3, 0x6c, 0xdb, 0x0f, 0xfa, 0x13, 0xb4, 0x1f, 0x18, 0x89, 0x32, 0x3c, 0x17,
+		0x2a, 0x9a, 0xa4, 0x8a, 0xce, 0x0f, 0x18, 0x69, 0x8f, 0x63, 0x3e, 0x09, 0x92, 0x6e, 0x1a, 0xc4,
+		0xf2, 0x55, 0xcf, 0x77, 0x89, 0xd6, 0x32, 0xac, 0x26, 0xdd, 0x6a, 0xd2, 0xa5, 0xd4, 0x9e, 0x66,
+		0x7a, 0x44, 0xc9, 0xb3, 0xee, 0xba, 0xe8, 0x45, 0x09, 0xea, 0x40, 0x6e, 0x44, 0x62, 0xbc, 0x43,
+		0x82, 0x75, 0x07, 0x12, 0xc5, 0x9f, 0xca, 0x40, 0x36, 0x92, 0x80, 0xcb, 0x0f, 0x40, 0xee, 0x35,
+		0xed, 0xba, 0xa6, 0x8a, 0x43, 0x15, 0xb3, 0x44, 0x16, 0x69, 0x35, 0x46, 0x92, 0x9f, 0x84, 0x19,
+		0xca, 0x62, 0xb7, 0x7d, 0xe2, 0xaa, 0xba, 0xa9, 0x79, 0x1e, 0x35, 0x5a, 0x9a, 0xb2, 0xca, 0xd8,
+		0xb7, 0x85, 0x5d, 0x2b, 0xa2, 0x47, 0x3e, 0x0f, 0x27, 0xa8, 0x44, 0xab, 0x6d, 0xfa, 0x86, 0x63,
+		0x12, 0x15, 0x8f, 0x79, 0x5e, 0x01, 0xa2, 0x9a, 0x4d, 0x23, 0xc7, 0x06, 0x67, 0x40, 0x8d, 0x3c,
+		0xb9, 0x02, 0xf7, 0x53, 0xb1, 0x26, 0xb1, 0x88, 0xab, 0xf9, 0x44, 0x25, 0x1f, 0x69, 0x6b, 0xa6,
+		0xa7, 0x6a, 0x56, 0x43, 0xdd, 0xd7, 0xbc, 0xfd, 0xc2, 0x0c, 0x02, 0x94, 0xe3, 0x85, 0x98, 0x32,
+		0x8b, 0x8c, 0xab, 0x9c, 0xaf, 0x4a, 0xd9, 0x96, 0xad, 0xc6, 0x15, 0xcd, 0xdb, 0x97, 0x4b, 0x70,
+		0x92, 0xa2, 0x78, 0xbe, 0x6b, 0x58, 0x4d, 0x55, 0xdf, 0x27, 0xfa, 0x81, 0xda, 0xf6, 0xf7, 0x9e,
+		0x2b, 0xdc, 0x1b, 0x7d, 0x3e, 0xd5, 0xb0, 0x4e, 0x79, 0x56, 0x90, 0x65, 0xc7, 0xdf, 0x7b, 0x4e,
+		0xae, 0x43, 0x0e, 0x27, 0xa3, 0x65, 0xbc, 0x4e, 0xd4, 0x3d, 0xdb, 0xa5, 0x7b, 0xe8, 0x54, 0x9f,
+		0xd0, 0x14, 0xb1, 0xe0, 0xd2, 0x16, 0x17, 0xd8, 0xb0, 0x1b, 0xa4, 0x94, 0xaa, 0xd7, 0xaa, 0xd5,
+		0x8a, 0x92, 0x15, 0x28, 0x97, 0x6d, 0x17, 0x1d, 0xaa, 0x69, 0x07, 0x06, 0xce, 0x32, 0x87, 0x6a,
+		0xda, 0xc2, 0xbc, 0xe7, 0xe1, 0x84, 0xae, 0xb3, 0x31, 0x1b, 0xba, 0xca, 0x0f, 0x63, 0x5e, 0x41,
+		0xea, 0x30, 0x96, 0xae, 0xaf, 0x32, 0x06, 0xee, 0xe3, 0x9e, 0x7c, 0x11, 0xee, 0x09, 0x8d, 0x15,
+		0x15, 0x9c, 0xee, 0x19, 0x65, 0xb7, 0xe8, 0x79, 0x38, 0xe1, 0x1c, 0xf6, 0x0a, 0xca, 0x1d, 0x4f,
+		0x74, 0x0e, 0xbb, 0xc5, 0x9e, 0x85, 0x19, 0x67, 0xdf, 0xe9, 0x95, 0x7b, 0x2c, 0x2a, 0x27, 0x3b,
+		0xfb, 0x4e, 0xb7, 0xe0, 0xc3, 0xf4, 0x64, 0xee, 0x12, 0x5d, 0xf3, 0x49, 0xa3, 0x70, 0x2a, 0xca,
+		0x1e, 0xe9, 0x90, 0x97, 0x40, 0xd2, 0x75, 0x95, 0x58, 0xda, 0xae, 0x49, 0x54, 0xcd, 0x25, 0x96,
+		0xe6, 0x15, 0x16, 0x28, 0x73, 0xd2, 0x77, 0xdb, 0x44, 0x99, 0xd2, 0xf5, 0x2a, 0xed, 0x5c, 0xa6,
+		0x7d, 0xf2, 0x63, 0x30, 0x6d, 0xef, 0xbe, 0xa6, 0x33, 0x8f, 0x54, 0x1d, 0x97, 0xec, 0x19, 0x37,
+		0x0b, 0x0f, 0x51, 0xf3, 0xe6, 0xb1, 0x83, 0xfa, 0x63, 0x8d, 0x92, 0xe5, 0x47, 0x41, 0xd2, 0xbd,
+		0x7d, 0xcd, 0x75, 0x68, 0x48, 0xf6, 0x1c, 0x4d, 0x27, 0x85, 0x87, 0x19, 0x2b, 0xa3, 0x6f, 0x0a,
+		0x32, 0xae, 0x08, 0xef, 0x86, 0xb1, 0xe7, 0x0b, 0xc4, 0xd3, 0x6c, 0x45, 0x50, 0x1a, 0x47, 0x3b,
+		0x03, 0x12, 0x5a, 0xa2, 0xe3, 0xc1, 0x67, 0x28, 0xdb, 0x94, 0xb3, 0xef, 0x44, 0x9f, 0xfb, 0x20,
+		0x4c, 0x3a, 0xfb, 0xd1, 0x87, 0x3e, 0xca, 0x12, 0x37, 0x67, 0x3f, 0xf2, 0xc4, 0x67, 0xe0, 0x24,
+		0x32, 0xb5, 0x88, 0xaf, 0x35, 0x34, 0x5f, 0x8b, 0x70, 0x3f, 0x4e, 0xb9, 0xd1, 0xec, 0x1b, 0xbc,
+		0xb3, 0x43, 0x4f, 0xb7, 0xbd, 0x7b, 0x18, 0x38, 0xd6, 0x13, 0x4c, 0x4f, 0xa4, 0x09, 0xd7, 0x7a,
+		0xdf, 0x92, 0xf3, 0x62, 0x09, 0x72, 0x51, 0xbf, 0x97, 0x33, 0xc0, 0x3c, 0x5f, 0x8a, 0x61, 0x12,
+		0xb4, 0xb2, 0x55, 0xc1, 0xf4, 0xe5, 0xd5, 0xaa, 0x14, 0xc7, 0x34, 0x6a, 0x7d, 0x6d, 0xbb, 0xaa,
+		0x2a, 0x3b, 0x9b, 0xdb, 0x6b, 0x1b, 0x55, 0x29, 0x11, 0x49, 0xec, 0xaf, 0x26, 0xd3, 0x8f, 0x48,
+		0xa7, 0x8b, 0xdf, 0x8c, 0xc3, 0x54, 0xe7, 0x49, 0x4d, 0xfe, 0x31, 0x38, 0x25, 0xca, 0x2a, 0x1e,
+		0xf1, 0xd5, 0x1b, 0x86, 0x4b, 0x17, 0x64, 0x4b, 0x63, 0x9b, 0x63, 0xe0, 0x3f, 0x33, 0x9c, 0xab,
+		0x4e, 0xfc, 0x97, 0x0c, 0x17, 0x97, 0x5b, 0x4b, 0xf3, 0xe5, 0x75, 0x58, 0xb0, 0x6c, 0xd5, 0xf3,
+		0x35, 0xab, 0xa1, 0xb9, 0x0d, 0x35, 0x2c, 0x68, 0xa9, 0x9a, 0xae, 0x13, 0xcf, 0xb3, 0xd9, 0x46,
+		0x18, 0xa0, 0xdc, 0x67, 0xd9, 0x75, 0xce, 0x1c, 0xee, 0x10, 0xcb, 0x9c, 0xb5, 0xcb, 0x7d, 0x13,
+		0x83, 0xdc, 0xf7, 0x5e, 0xc8, 0xb4, 0x34, 0x47, 0x25, 0x96, 0xef, 0x1e, 0xd2, 0xfc, 0x3c, 0xad,
+		0xa4, 0x5b, 0x9a, 0x53, 0xc5, 0xf6, 0x8f, 0xe4, 0x98, 0x74, 0x35, 0x99, 0x4e, 0x4b, 0x99, 0xab,
+		0xc9, 0x74, 0x46, 0x82, 0xe2, 0xbb, 0x09, 0xc8, 0x45, 0xf3, 0x75, 0x3c, 0xfe, 0xe8, 0x74, 0xc7,
+		0x8a, 0xd1, 0x98, 0xf6, 0xe0, 0x91, 0xd9, 0xfd, 0xd2, 0x0a, 0x6e, 0x65, 0xa5, 0x71, 0x96, 0x1c,
+		0x2b, 0x4c, 0x12, 0xd3, 0x08, 0x74, 0x36, 0xc2, 0x92, 0x91, 0xb4, 0xc2, 0x5b, 0xf2, 0x2a, 0x8c,
+		0xbf, 0xe6, 0x51, 0xec, 0x71, 0x8a, 0xfd, 0xd0, 0xd1, 0xd8, 0x57, 0xeb, 0x14, 0x3c, 0x73, 0xb5,
+		0xae, 0x6e, 0x6e, 0x29, 0x1b, 0xcb, 0xeb, 0x0a, 0x17, 0x97, 0x67, 0x21, 0x69, 0x6a, 0xaf, 0x1f,
+		0x76, 0x6e, 0x7a, 0x94, 0x34, 0xea, 0x24, 0xcc, 0x42, 0x12, 0x0b, 0x74, 0x9d, 0x5b, 0x0d, 0x25,
+		0xbd, 0x8f, 0x8b, 0xe1, 0x2c, 0xa4, 0xa8, 0xbd, 0x64, 0x00, 0x6e, 0x31, 0x69, 0x4c, 0x4e, 0x43,
+		0x72, 0x65, 0x4b, 0xc1, 0x05, 0x21, 0x41, 0x8e, 0x51, 0xd5, 0xda, 0x5a, 0x75, 0xa5, 0x2a, 0xc5,
+		0x8b, 0xe7, 0x61, 0x9c, 0x19, 0x01, 0x17, 0x4b, 0x60, 0x06, 0x69, 0x8c, 0x37, 0x39, 0x46, 0x4c,
+		0xf4, 0xee, 0x6c, 0x94, 0xab, 0x8a, 0x14, 0xef, 0x9c, 0xea, 0xa4, 0x94, 0x2a, 0x7a, 0x90, 0x8b,
+		0xe6, 0xe1, 0x3f, 0x9a, 0xc3, 0xf8, 0x57, 0x63, 0x90, 0x8d, 0xe4, 0xd5, 0x98, 0x10, 0x69, 0xa6,
+		0x69, 0xdf, 0x50, 0x35, 0xd3, 0xd0, 0x3c, 0xee, 0x1a, 0x40, 0x49, 0xcb, 0x48, 0x19, 0x75, 0xea,
+		0x7e, 0x44, 0x4b, 0x24, 0x25, 0x8d, 0x17, 0x3f, 0x13, 0x03, 0xa9, 0x3b, 0xb1, 0xed, 0x52, 0x33,
+		0xf6, 0x27, 0xa9, 0x66, 0xf1, 0xd3, 0x31, 0x98, 0xea, 0xcc, 0x66, 0xbb, 0xd4, 0x7b, 0xe0, 0x4f,
+		0x54, 0xbd, 0x3f, 0x88, 0xc3, 0x64, 0x47, 0x0e, 0x3b, 0xaa, 0x76, 0x1f, 0x81, 0x69, 0xa3, 0x41,
+		0x5a, 0x8e, 0xed, 0x63, 0xf1, 0x5c, 0x35, 0xc9, 0x75, 0x62, 0x16, 0x8a, 0x34, 0x68, 0x9c, 0x3d,
+		0x3a, 0x4b, 0x5e, 0x5a, 0x0b, 0xe5, 0xd6, 0x51, 0xac, 0x74, 0x62, 0xad, 0x52, 0xdd, 0xa8, 0x6d,
+		0x6d, 0x57, 0x37, 0x57, 0x5e, 0x51, 0x77, 0x36, 0x5f, 0xd8, 0xdc, 0x7a, 0x69, 0x53, 0x91, 0x8c,
+		0x2e, 0xb6, 0xf7, 0x71, 0xd9, 0xd7, 0x40, 0xea, 0x56, 0x4a, 0x3e, 0x05, 0xfd, 0xd4, 0x92, 0xc6,
+		0xe4, 0x13, 0x90, 0xdf, 0xdc, 0x52, 0xeb, 0x6b, 0x95, 0xaa, 0x5a, 0xbd, 0x7c, 0xb9, 0xba, 0xb2,
+		0x5d, 0x67, 0x75, 0x8f, 0x80, 0x7b, 0xbb, 0x63, 0x81, 0x17, 0x3f, 0x99, 0x80, 0x13, 0x7d, 0x34,
+		0x91, 0x97, 0xf9, 0x89, 0x85, 0x1d, 0xa2, 0x9e, 0x18, 0x45, 0xfb, 0x25, 0xcc, 0x19, 0x6a, 0x9a,
+		0xeb, 0xf3, 0x03, 0xce, 0xa3, 0x80, 0x56, 0xb2, 0x7c, 0x63, 0xcf, 0x20, 0x2e, 0xaf, 0x27, 0xb1,
+		0x63, 0x4c, 0x3e, 0xa4, 0xb3, 0x92, 0xd2, 0xe3, 0x20, 0x3b, 0xb6, 0x67, 0xf8, 0xc6, 0x75, 0x2c,
+		0xc9, 0x8b, 0xe2, 0x13, 0x1e, 0x6b, 0x92, 0x8a, 0x24, 0x7a, 0xd6, 0x2c, 0x3f, 0xe0, 0xb6, 0x48,
+		0x53, 0xeb, 0xe2, 0xc6, 0x60, 0x9e, 0x50, 0x24, 0xd1, 0x13, 0x70, 0x3f, 0x00, 0xb9, 0x86, 0xdd,
+		0xc6, 0x5c, 0x8f, 0xf1, 0xe1, 0xde, 0x11, 0x53, 0xb2, 0x8c, 0x16, 0xb0, 0xf0, 0x2c, 0x3e, 0xac,
+		0x7a, 0xe5, 0x94, 0x2c, 0xa3, 0x31, 0x96, 0xd3, 0x90, 0xd7, 0x9a, 0x4d, 0x17, 0xc1, 0x05, 0x10,
+		0x3b, 0x97, 0x4c, 0x05, 0x64, 0xca, 0x38, 0x77, 0x15, 0xd2, 0xc2, 0x0e, 0xb8, 0x55, 0xa3, 0x25,
+		0x54, 0x87, 0x1d, 0xb6, 0xe3, 0x58, 0x08, 0xb3, 0x44, 0xe7, 0x03, 0x90, 0x33, 0x3c, 0x35, 0x2c,
+		0xe2, 0xc7, 0x17, 0xe3, 0x67, 0xd2, 0x4a, 0xd6, 0xf0, 0x82, 0x02, 0x68, 0xf1, 0xf3, 0x71, 0x98,
+		0xea, 0xbc, 0x84, 0x90, 0x2b, 0x90, 0x36, 0x6d, 0x5d, 0xa3, 0xae, 0xc5, 0x6e, 0xc0, 0xce, 0x0c,
+		0xb9, 0xb7, 0x58, 0x5a, 0xe7, 0xfc, 0x4a, 0x20, 0x39, 0xf7, 0xef, 0x63, 0x90, 0x16, 0x64, 0xf9,
+		0x24, 0x24, 0x1d, 0xcd, 0xdf, 0xa7, 0x70, 0xa9, 0x72, 0x5c, 0x8a, 0x29, 0xb4, 0x8d, 0x74, 0xcf,
+		0xd1, 0xac, 0x42, 0x3c, 0xa4, 0x63, 0x1b, 0xe7, 0xd5, 0x24, 0x5a, 0x83, 0x1e, 0x7a, 0xec, 0x56,
+		0x8b, 0x58, 0xbe, 0x27, 0xe6, 0x95, 0xd3, 0x57, 0x38, 0x19, 0xef, 0xc2, 0x7c, 0x57, 0x33, 0xcc,
+		0x0e, 0xde, 0x24, 0xe5, 0x95, 0x44, 0x47, 0xc0, 0x5c, 0x82, 0x59, 0x81, 0xdb, 0x20, 0xbe, 0xa6,
+		0xef, 0x93, 0x46, 0x28, 0x34, 0x4e, 0x8b, 0x1b, 0xa7, 0x38, 0x43, 0x85, 0xf7, 0x0b, 0xd9, 0xe2,
+		0x37, 0x63, 0x30, 0x2d, 0x8e, 0x69, 0x8d, 0xc0, 0x58, 0x1b, 0x00, 0x9a, 0x65, 0xd9, 0x7e, 0xd4,
+		0x5c, 0xbd, 0xae, 0xdc, 0x23, 0xb7, 0xb4, 0x1c, 0x08, 0x29, 0x11, 0x80, 0xb9, 0x16, 0x40, 0xd8,
+		0x33, 0xd0, 0x6c, 0x0b, 0x90, 0xe5, 0x37, 0x4c, 0xf4, 0x9a, 0x92, 0x1d, 0xec, 0x81, 0x91, 0xf0,
+		0x3c, 0x87, 0xe5, 0x97, 0x5d, 0xd2, 0x34, 0x2c, 0x5e, 0x37, 0x66, 0x0d, 0x51, 0x7e, 0x49, 0x06,
+		0xe5, 0x97, 0xf2, 0x5f, 0x86, 0x13, 0xba, 0xdd, 0xea, 0x56, 0xb7, 0x2c, 0x75, 0x15, 0x17, 0xbc,
+		0x2b, 0xb1, 0x57, 0x9f, 0xe0, 0x4c, 0x4d, 0xdb, 0xd4, 0xac, 0xe6, 0x92, 0xed, 0x36, 0xc3, 0x6b,
+		0x56, 0xcc, 0x78, 0xbc, 0xc8, 0x65, 0xab, 0xb3, 0xfb, 0x7f, 0x62, 0xb1, 0x9f, 0x8f, 0x27, 0x56,
+		0x6b, 0xe5, 0x2f, 0xc4, 0xe7, 0x56, 0x99, 0x60, 0x4d, 0x18, 0x43, 0x21, 0x7b, 0x26, 0xd1, 0x71,
+		0x80, 0xf0, 0xdd, 0x0f, 0xc0, 0x4c, 0xd3, 0x6e, 0xda, 0x14, 0xe9, 0x2c, 0xfe, 0xc5, 0xef, 0x69,
+		0x33, 0x01, 0x75, 0x6e, 0xe8, 0xa5, 0x6e, 0x69, 0x13, 0x4e, 0x70, 0x66, 0x95, 0x5e, 0x14, 0xb1,
+		0x63, 0x8c, 0x7c, 0x64, 0x0d, 0xad, 0xf0, 0xa5, 0x6f, 0xd3, 0xed, 0x5b, 0x99, 0xe6, 0xa2, 0xd8,
+		0xc7, 0x4e, 0x3a, 0x25, 0x05, 0xee, 0xe9, 0xc0, 0x63, 0x8b, 0x94, 0xb8, 0x43, 0x10, 0xff, 0x35,
+		0x47, 0x3c, 0x11, 0x41, 0xac, 0x73, 0xd1, 0xd2, 0x0a, 0x4c, 0x1e, 0x07, 0xeb, 0xdf, 0x70, 0xac,
+		0x1c, 0x89, 0x82, 0xac, 0x42, 0x9e, 0x82, 0xe8, 0x6d, 0xcf, 0xb7, 0x5b, 0x34, 0x02, 0x1e, 0x0d,
+		0xf3, 0x6f, 0xbf, 0xcd, 0x56, 0xcd, 0x14, 0x8a, 0xad, 0x04, 0x52, 0xa5, 0x12, 0xd0, 0xbb, 0x31,
+		0xbc, 0xb3, 0x1a, 0x82, 0xf0, 0x35, 0xae, 0x48, 0xc0, 0x5f, 0xba, 0x06, 0x33, 0xf8, 0x37, 0x0d,
+		0x50, 0x51, 0x4d, 0x86, 0x17, 0xdc, 0x0a, 0xdf, 0x7c, 0x93, 0x2d, 0xcc, 0x13, 0x01, 0x40, 0x44,
+		0xa7, 0xc8, 0x2c, 0x36, 0x89, 0xef, 0x13, 0xd7, 0x53, 0x35, 0xb3, 0x9f, 0x7a, 0x91, 0x8a, 0x45,
+		0xe1, 0xe7, 0xbe, 0xd7, 0x39, 0x8b, 0xab, 0x4c, 0x72, 0xd9, 0x34, 0x4b, 0x3b, 0x70, 0xaa, 0x8f,
+		0x57, 0x8c, 0x80, 0xf9, 0x49, 0x8e, 0x39, 0xd3, 0xe3, 0x19, 0x08, 0x5b, 0x03, 0x41, 0x0f, 0xe6,
+		0x72, 0x04, 0xcc, 0x4f, 0x71, 0x4c, 0x99, 0xcb, 0x8a, 0x29, 0x45, 0xc4, 0xab, 0x30, 0x7d, 0x9d,
+		0xb8, 0xbb, 0xb6, 0xc7, 0xab, 0x44, 0x23, 0xc0, 0x7d, 0x9a, 0xc3, 0xe5, 0xb9, 0x20, 0x2d, 0x1b,
+		0x21, 0xd6, 0x45, 0x48, 0xef, 0x69, 0x3a, 0x19, 0x01, 0xe2, 0x36, 0x87, 0x98, 0x40, 0x7e, 0x14,
+		0x5d, 0x86, 0x5c, 0xd3, 0xe6, 0x7b, 0xd4, 0x70, 0xf1, 0xcf, 0x70, 0xf1, 0xac, 0x90, 0xe1, 0x10,
+		0x8e, 0xed, 0xb4, 0x4d, 0xdc, 0xc0, 0x86, 0x43, 0xfc, 0x3d, 0x01, 0x21, 0x64, 0x38, 0xc4, 0x31,
+		0xcc, 0xfa, 0x96, 0x80, 0xf0, 0x22, 0xf6, 0x7c, 0x1e, 0x2f, 0x8f, 0xcc, 0x43, 0xdb, 0x1a, 0x45,
+		0x89, 0xb7, 0x39, 0x02, 0x70, 0x11, 0x04, 0xb8, 0x04, 0x99, 0x51, 0x27, 0xe2, 0xef, 0x7f, 0x4f,
+		0x2c, 0x0f, 0x31, 0x03, 0xab, 0x90, 0x17, 0x01, 0x0a, 0x2f, 0x9b, 0x87, 0x43, 0xfc, 0x03, 0x0e,
+		0x31, 0x15, 0x11, 0xe3, 0xc3, 0xf0, 0x89, 0xe7, 0x37, 0xc9, 0x28, 0x20, 0x9f, 0x17, 0xc3, 0xe0,
+		0x22, 0xdc, 0x94, 0xbb, 0xc4, 0xd2, 0xf7, 0x47, 0x43, 0xf8, 0x05, 0x61, 0x4a, 0x21, 0x83, 0x10,
+		0x2b, 0x30, 0xd9, 0xd2, 0x5c, 0x6f, 0x5f, 0x33, 0x47, 0x9a, 0x8e, 0x7f, 0xc8, 0x31, 0x72, 0x81,
+		0x10, 0xb7, 0x48, 0xdb, 0x3a, 0x0e, 0xcc, 0x17, 0x84, 0x45, 0xda, 0x56, 0x07, 0x50, 0x0d, 0x66,
+		0x3c, 0x9f, 0x96, 0xd4, 0x8e, 0x83, 0xf6, 0x8f, 0xc4, 0xd2, 0x63, 0xb2, 0x1b, 0x51, 0xc4, 0x4b,
+		0x90, 0xf1, 0x8c, 0xd7, 0x47, 0x82, 0xf9, 0xa2, 0x98, 0x69, 0x2a, 0x80, 0xc2, 0xaf, 0xc0, 0x6c,
+		0xdf, 0x6d, 0x62, 0x04, 0xb0, 0x7f, 0xcc, 0xc1, 0x4e, 0xf6, 0xd9, 0x2a, 0x78, 0x48, 0x38, 0x2e,
+		0xe4, 0x3f, 0x11, 0x21, 0x81, 0x74, 0x61, 0xd5, 0xf0, 0xd4, 0xe0, 0x69, 0x7b, 0xc7, 0xb3, 0xda,
+		0x3f, 0x15, 0x56, 0x63, 0xb2, 0x1d, 0x56, 0xdb, 0x86, 0x93, 0x1c, 0xf1, 0x78, 0xf3, 0xfa, 0x8b,
+		0x22, 0xb0, 0x32, 0xe9, 0x9d, 0xce, 0xd9, 0xfd, 0x71, 0x98, 0x0b, 0xcc, 0x29, 0xd2, 0x53, 0x4f,
+		0xc5, 0x3a, 0xd4, 0x70, 0xe4, 0x2f, 0x71, 0x64, 0x11, 0xf1, 0x83, 0xfc, 0xd6, 0xdb, 0xd0, 0x1c,
+		0x04, 0x7f, 0x19, 0x0a, 0x02, 0xbc, 0x6d, 0xb9, 0x44, 0xb7, 0x9b, 0x96, 0xf1, 0x3a, 0x69, 0x8c,
+		0x00, 0xfd, 0x4b, 0x5d, 0x53, 0xb5, 0x13, 0x11, 0x47, 0xe4, 0x35, 0x90, 0x82, 0x5c, 0x45, 0x35,
+		0x5a, 0x8e, 0xed, 0xfa, 0x43, 0x10, 0x7f, 0x59, 0xcc, 0x54, 0x20, 0xb7, 0x46, 0xc5, 0x4a, 0x55,
+		0x60, 0xf7, 0xcc, 0xa3, 0xba, 0xe4, 0x97, 0x39, 0xd0, 0x64, 0x28, 0xc5, 0x03, 0x87, 0x6e, 0xb7,
+		0x1c, 0xcd, 0x1d, 0x25, 0xfe, 0xfd, 0x33, 0x11, 0x38, 0xb8, 0x08, 0x0f, 0x1c, 0x98, 0xd1, 0xe1,
+		0x6e, 0x3f, 0x02, 0xc2, 0x57, 0x44, 0xe0, 0x10, 0x32, 0x1c, 0x42, 0x24, 0x0c, 0x23, 0x40, 0xfc,
+		0x73, 0x01, 0x21, 0x64, 0x10, 0xe2, 0xc5, 0x70, 0xa3, 0x75, 0x49, 0xd3, 0xf0, 0x7c, 0x97, 0x25,
+		0xc5, 0x47, 0x43, 0xfd, 0x8b, 0xef, 0x75, 0x26, 0x61, 0x4a, 0x44, 0x14, 0x23, 0x11, 0x2f, 0xb2,
+		0xd2, 0x33, 0xd3, 0x70, 0xc5, 0x7e, 0x45, 0x44, 0xa2, 0x88, 0x18, 0xea, 0x16, 0xc9, 0x10, 0xd1,
+		0xec, 0x3a, 0x9e, 0x14, 0x46, 0x80, 0xfb, 0xd5, 0x2e, 0xe5, 0xea, 0x42, 0x16, 0x31, 0x23, 0xf9,
+		0x4f, 0xdb, 0x3a, 0x20, 0x87, 0x23, 0x79, 0xe7, 0xaf, 0x75, 0xe5, 0x3f, 0x3b, 0x4c, 0x92, 0xc5,
+		0x90, 0x7c, 0x57, 0x3e, 0x25, 0x0f, 0x7b, 0xab, 0xa8, 0xf0, 0x93, 0xef, 0xf1, 0xf1, 0x76, 0xa6,
+		0x53, 0xa5, 0x75, 0x90, 0x38, 0x25, 0x4c, 0x60, 0x87, 0x82, 0xbd, 0xf9, 0x5e, 0xe0, 0xe7, 0x1d,
+		0x39, 0x4f, 0xe9, 0x32, 0x4c, 0x76, 0x24, 0x3c, 0xc3, 0xa1, 0xfe, 0x0a, 0x87, 0xca, 0x45, 0xf3,
+		0x9d, 0xd2, 0x79, 0x48, 0x62, 0xf2, 0x32, 0x5c, 0xfc, 0xaf, 0x72, 0x71, 0xca, 0x5e, 0xfa, 0x20,
+		0xa4, 0x45, 0xd2, 0x32, 0x5c, 0xf4, 0xaf, 0x71, 0xd1, 0x40, 0x04, 0xc5, 0x45, 0xc2, 0x32, 0x5c,
+		0xfc, 0xaf, 0x0b, 0x71, 0x21, 0x82, 0xe2, 0xa3, 0x9b, 0xf0, 0xab, 0x7f, 0x23, 0xc9, 0xc4, 0x85,
+		0x48, 0x09, 0xef, 0xb9, 0x59, 0xa6, 0x32, 0x5c, 0xfa, 0x63, 0xfc, 0xe1, 0x42, 0xa2, 0xf4, 0x2c,
+		0xa4, 0x46, 0x34, 0xf8, 0xdf, 0xe4, 0xa2, 0x8c, 0xbf, 0xb4, 0x02, 0xd9, 0x48, 0x76, 0x32, 0x5c,
+		0xfc, 0x6f, 0x71, 0xf1, 0xa8, 0x14, 0xaa, 0xce, 0xb3, 0x93, 0xe1, 0x00, 0x7f, 0x5b, 0xa8, 0xce,
+		0x25, 0xd0, 0x6c, 0x22, 0x31, 0x19, 0x2e, 0xfd, 0x71, 0x61, 0x75, 0x21, 0x52, 0x7a, 0x1e, 0x32,
+		0xc1, 0x66, 0x33, 0x5c, 0xfe, 0xa7, 0xb8, 0x7c, 0x28, 0x83, 0x16, 0x68, 0x5b, 0xc7, 0x80, 0xf8,
+		0x3b, 0xc2, 0x02, 0x11, 0x29, 0x5c, 0x46, 0xdd, 0x09, 0xcc, 0x70, 0xa4, 0x9f, 0x16, 0xcb, 0xa8,
+		0x2b, 0x7f, 0xc1, 0xd9, 0xa4, 0x31, 0x7f, 0x38, 0xc4, 0xdf, 0x15, 0xb3, 0x49, 0xf9, 0x51, 0x8d,
+		0xee, 0x8c, 0x60, 0x38, 0xc6, 0xcf, 0x0a, 0x35, 0xba, 0x12, 0x82, 0x52, 0x0d, 0xe4, 0xde, 0x6c,
+		0x60, 0x38, 0xde, 0x27, 0x38, 0xde, 0x74, 0x4f, 0x32, 0x50, 0x7a, 0x09, 0x4e, 0xf6, 0xcf, 0x04,
+		0x86, 0xa3, 0xfe, 0xdc, 0x7b, 0x5d, 0x67, 0xb7, 0x68, 0x22, 0x50, 0xda, 0x86, 0x99, 0x7e, 0x59,
+		0xc0, 0x70, 0xd8, 0x4f, 0xbe, 0xd7, 0x19, 0xb8, 0xa3, 0x49, 0x40, 0x69, 0x19, 0x20, 0xdc, 0x80,
+		0x87, 0x63, 0x7d, 0x9a, 0x63, 0x45, 0x84, 0x70, 0x69, 0xf0, 0xfd, 0x77, 0xb8, 0xfc, 0x6d, 0xb1,
+		0x34, 0xb8, 0x04, 0x2e, 0x0d, 0xb1, 0xf5, 0x0e, 0x97, 0xfe, 0x8c, 0x58, 0x1a, 0x42, 0x04, 0x3d,
+		0x3b, 0xb2, 0xbb, 0x0d, 0x47, 0x78, 0x5b, 0x78, 0x76, 0x44, 0xaa, 0xb4, 0x09, 0xd3, 0x3d, 0x1b,
+		0xe2, 0x70, 0xa8, 0x9f, 0xe7, 0x50, 0x52, 0xf7, 0x7e, 0x18, 0xdd, 0xbc, 0xf8, 0x66, 0x38, 0x1c,
+		0xed, 0xb3, 0x5d, 0x9b, 0x17, 0xdf, 0x0b, 0x4b, 0x97, 0x20, 0x6d, 0xb5, 0x4d, 0x13, 0x17, 0x8f,
+		0x7c, 0xf4, 0x9b, 0x80, 0x85, 0xff, 0xfa, 0x43, 0x6e, 0x1d, 0x21, 0x50, 0x3a, 0x0f, 0x29, 0xd2,
+		0xda, 0x25, 0x8d, 0x61, 0x92, 0xdf, 0xfd, 0xa1, 0x08, 0x98, 0xc8, 0x5d, 0x7a, 0x1e, 0x80, 0x95,
+		0x46, 0xe8, 0x65, 0xe0, 0x10, 0xd9, 0xff, 0xf6, 0x43, 0xfe, 0xea, 0x4d, 0x28, 0x12, 0x02, 0xb0,
+		0x17, 0x79, 0x8e, 0x06, 0xf8, 0x5e, 0x27, 0x00, 0x9d, 0x91, 0x8b, 0x30, 0x81, 0x2f, 0x44, 0xfa,
+		0x5a, 0x73, 0x98, 0xf4, 0x7f, 0xe7, 0xd2, 0x82, 0x1f, 0x0d, 0xd6, 0xb2, 0x5d, 0xe2, 0x6b, 0x4d,
+		0x6f, 0x98, 0xec, 0xff, 0xe0, 0xb2, 0x81, 0x00, 0x0a, 0xeb, 0x9a, 0xe7, 0x8f, 0x32, 0xee, 0x3f,
+		0x12, 0xc2, 0x42, 0x00, 0x95, 0xc6, 0xbf, 0x0f, 0xc8, 0xe1, 0x30, 0xd9, 0xef, 0x0b, 0xa5, 0x39,
+		0x7f, 0xe9, 0x83, 0x90, 0xc1, 0x3f, 0xd9, 0xfb, 0x74, 0x43, 0x84, 0xff, 0x27, 0x17, 0x0e, 0x25,
+		0xf0, 0xc9, 0x9e, 0xdf, 0xf0, 0x8d, 0xe1, 0xc6, 0xfe, 0x01, 0x9f, 0x69, 0xc1, 0x5f, 0x5a, 0x86,
+		0xac, 0xe7, 0x37, 0x1a, 0x6d, 0x9e, 0x9f, 0x0e, 0x11, 0xff, 0x5f, 0x3f, 0x0c, 0x4a, 0x16, 0x81,
+		0x0c, 0xce, 0xf6, 0x8d, 0x03, 0xdf, 0xb1, 0xe9, 0x85, 0xc7, 0x30, 0x84, 0xf7, 0x38, 0x42, 0x44,
+		0xa4, 0xb4, 0x02, 0x39, 0x1c, 0x8b, 0x4b, 0x1c, 0x42, 0x6f, 0xa7, 0x86, 0x40, 0xfc, 0x6f, 0x6e,
+		0x80, 0x0e, 0xa1, 0xf2, 0x87, 0xbf, 0xf6, 0xee, 0x7c, 0xec, 0x1b, 0xef, 0xce, 0xc7, 0xfe, 0xe0,
+		0xdd, 0xf9, 0xd8, 0xc7, 0xbf, 0x35, 0x3f, 0xf6, 0x8d, 0x6f, 0xcd, 0x8f, 0xfd, 0xce, 0xb7, 0xe6,
+		0xc7, 0xfa, 0x57, 0x89, 0x61, 0xd5, 0x5e, 0xb5, 0x59, 0x7d, 0xf8, 0xd5, 0x62, 0xd3, 0xf0, 0xf7,
+		0xdb, 0xbb, 0x4b, 0xba, 0xdd, 0xa2, 0x65, 0xdc, 0xb0, 0x5a, 0x1b, 0x1c, 0x72, 0xe0, 0x27, 0xe3,
+		0x30, 0xcb, 0x30, 0xc2, 0x5e, 0xcd, 0x3a, 0x1c, 0xf0, 0x65, 0xce, 0x5c, 0xdf, 0xc2, 0x70, 0xf1,
+		0x0a, 0x24, 0x96, 0xad, 0x43, 0x79, 0x96, 0xc5, 0x3c, 0xb5, 0xed, 0x9a, 0xfc, 0x3d, 0xaf, 0x09,
+		0x6c, 0xef, 0xb8, 0x26, 0xd6, 0xbe, 0xc5, 0xcb, 0x98, 0x78, 0xc5, 0xc2, 0x1a, 0x25, 0xe9, 0x13,
+		0x6f, 0x2d, 0x8c, 0xfd, 0xe2, 0x5b, 0x0b, 0x63, 0xdf, 0x7f, 0x7b, 0x61, 0xec, 0x8d, 0xdf, 0x5b,
+		0x1c, 0x2b, 0x1f, 0x74, 0x8f, 0xf6, 0xab, 0x43, 0x47, 0x9c, 0x5e, 0xb6, 0x0e, 0xe9, 0x80, 0x6b,
+		0xb1, 0x57, 0x53, 0xf8, 0x3c, 0x4f, 0x14, 0xb9, 0xe7, 0xbb, 0x8b, 0xdc, 0x2f, 0x11, 0xd3, 0x7c,
+		0xc1, 0xb2, 0x6f, 0x58, 0x78, 0x37, 0xee, 0xed, 0x8e, 0xb3, 0x17, 0x88, 0xe1, 0xa7, 0xe3, 0x30,
+		0xdf, 0x53, 0xcf, 0xe6, 0x5e, 0x30, 0xe8, 0x13, 0xa5, 0x12, 0xa4, 0x2b, 0xc2, 0xb9, 0x0a, 0xf8,
+		0x6d, 0x8c, 0x6e, 0x5b, 0x0d, 0x8f, 0x0e, 0x3b, 0xa1, 0x88, 0x26, 0x0e, 0xdb, 0xd2, 0x2c, 0xdb,
+		0xe3, 0xef, 0x45, 0xb2, 0x46, 0xf9, 0x53, 0xb1, 0xe3, 0xcd, 0xe9, 0xa4, 0x78, 0x92, 0x18, 0xe6,
+		0x53, 0x43, 0xcb, 0xfe, 0x07, 0x38, 0xca, 0x60, 0x10, 0x1d, 0xa5, 0xff, 0x51, 0xad, 0xf2, 0xb3,
+		0x71, 0x58, 0xe8, 0xb6, 0x0a, 0x2e, 0x2d, 0xcf, 0xd7, 0x5a, 0xce, 0x20, 0xb3, 0x5c, 0x82, 0xcc,
+		0xb6, 0xe0, 0x39, 0xb6, 0x5d, 0x6e, 0x1f, 0xd3, 0x2e, 0x53, 0xc1, 0xa3, 0x84, 0x61, 0xce, 0x8d,
+		0x68, 0x98, 0x60, 0x1c, 0x77, 0x64, 0x99, 0xef, 0xc6, 0x61, 0x56, 0xb7, 0xbd, 0x96, 0xed, 0xa9,
+		0x6c, 0x29, 0xb0, 0x06, 0xb7, 0x49, 0x2e, 0xda, 0x35, 0xc2, 0x45, 0xc9, 0x36, 0xcc, 0x18, 0x2d,
+		0xc7, 0x24, 0xf4, 0x42, 0x4b, 0xa5, 0x91, 0x63, 0xb4, 0x03, 0xd4, 0x6f, 0xfc, 0x56, 0x8a, 0x15,
+		0xee, 0x43, 0xf1, 0x35, 0x21, 0x5d, 0x5a, 0x87, 0x69, 0x7c, 0x17, 0xc9, 0xe9, 0x80, 0x1c, 0x12,
+		0x80, 0x04, 0xa0, 0xc4, 0x25, 0x43, 0xb4, 0x67, 0x61, 0xdc, 0xd3, 0x35, 0x53, 0x1b, 0x1a, 0x06,
+		0xbf, 0xce, 0x21, 0x38, 0x7b, 0xf9, 0xb9, 0x41, 0x33, 0xfa, 0xea, 0x7c, 0x24, 0x38, 0x31, 0x8b,
+		0xf1, 0x7f, 0x9e, 0x60, 0xc8, 0xc2, 0xd8, 0xbf, 0x9d, 0x80, 0x79, 0xde, 0xbf, 0xab, 0x79, 0xe4,
+		0xec, 0xf5, 0xa7, 0x76, 0x89, 0xaf, 0x3d, 0x75, 0x56, 0xb7, 0x0d, 0xb1, 0x38, 0x4f, 0x70, 0xfb,
+		0x63, 0xff, 0x12, 0xef, 0xef, 0x1f, 0xa9, 0xe6, 0x06, 0xcf, 0x5b, 0x71, 0x07, 0x92, 0x2b, 0xb6,
+		0x61, 0xa1, 0x6f, 0x36, 0x88, 0x65, 0xb7, 0x78, 0x08, 0x63, 0x0d, 0xf9, 0x29, 0x18, 0xd7, 0x5a,
+		0x76, 0xdb, 0xf2, 0xd9, 0xc5, 0x5e, 0x79, 0xf6, 0x6b, 0xef, 0x2c, 0x8c, 0xfd, 0x87, 0x77, 0x16,
+		0x12, 0x6b, 0x96, 0xff, 0x9b, 0x5f, 0x7e, 0x02, 0x38, 0xd4, 0x9a, 0xe5, 0x2b, 0x9c, 0xb1, 0x94,
+		0xfc, 0xce, 0x5b, 0x0b, 0xb1, 0xe2, 0xcb, 0x30, 0x51, 0x21, 0xfa, 0x9d, 0x20, 0x57, 0x88, 0x1e,
+		0x41, 0xae, 0x10, 0xbd, 0x0b, 0xf9, 0x59, 0x48, 0xaf, 0x59, 0x3e, 0x7b, 0xd1, 0xf8, 0x03, 0x90,
+		0x30, 0x2c, 0xf6, 0xee, 0xda, 0x91, 0xba, 0x21, 0x17, 0x0a, 0x56, 0x88, 0x1e, 0x08, 0x36, 0x88,
+		0x5e, 0x88, 0x0d, 0x7b, 0x34, 0x72, 0x95, 0x2b, 0xbf, 0xf3, 0x9f, 0xe7, 0xc7, 0xde, 0x78, 0x77,
+		0x7e, 0x6c, 0xe0, 0xac, 0x16, 0x07, 0xce, 0xaa, 0xd7, 0x38, 0x60, 0x4b, 0x30, 0x98, 0xd9, 0x2f,
+		0x24, 0xe1, 0x7e, 0xfa, 0xfd, 0x89, 0xdb, 0x32, 0x2c, 0xff, 0xac, 0xee, 0x1e, 0x3a, 0x3e, 0xdd,
+		0xa3, 0xec, 0x3d, 0x3e, 0xb1, 0xd3, 0x61, 0xf7, 0x12, 0xeb, 0x1e, 0xb0, 0x01, 0xed, 0x41, 0xaa,
+		0x86, 0x72, 0x68, 0x62, 0xdf, 0xf6, 0x35, 0x93, 0x07, 0x1c, 0xd6, 0x40, 0x2a, 0xfb, 0x66, 0x25,
+		0xce, 0xa8, 0x86, 0xf8, 0x5c, 0xc5, 0x24, 0xda, 0x1e, 0x7b, 0xf5, 0x37, 0x41, 0xf7, 0xa5, 0x34,
+		0x12, 0xe8, 0x5b, 0xbe, 0x33, 0x90, 0xd2, 0xda, 0xec, 0xd6, 0x3a, 0x81, 0x1b, 0x16, 0x6d, 0x14,
+		0x5f, 0x80, 0x09, 0x7e, 0x77, 0x86, 0xf7, 0xb6, 0x07, 0xe4, 0x90, 0x3e, 0x27, 0xa7, 0xe0, 0x9f,
+		0xf2, 0x12, 0xa4, 0xa8, 0xf2, 0xfc, 0x9b, 0x86, 0xc2, 0x52, 0x8f, 0xf6, 0x4b, 0x54, 0x49, 0x85,
+		0xb1, 0x15, 0xaf, 0x42, 0xba, 0x62, 0xb7, 0x0c, 0xcb, 0xee, 0x44, 0xcb, 0x30, 0x34, 0xaa, 0xb3,
+		0xd3, 0xe6, 0x5e, 0xa1, 0xb0, 0x06, 0xbe, 0x22, 0xc7, 0x5e, 0x05, 0xe7, 0x37, 0xef, 0xbc, 0x55,
+		0x5c, 0x81, 0x09, 0x8a, 0xbd, 0xe5, 0xe0, 0x3b, 0xe7, 0xc1, 0x7b, 0x78, 0x19, 0xfe, 0x61, 0x10,
+		0x87, 0x8f, 0x87, 0xca, 0xca, 0x90, 0x6c, 0x68, 0xbe, 0xc6, 0xc7, 0x4d, 0xff, 0x2e, 0x7e, 0x08,
+		0xd2, 0x1c, 0xc4, 0x93, 0xcf, 0x41, 0xc2, 0x76, 0x3c, 0x7e, 0x77, 0x3e, 0x37, 0x68, 0x28, 0x5b,
+		0x4e, 0x39, 0x89, 0x3e, 0xa3, 0x20, 0x73, 0x59, 0x19, 0xe8, 0x16, 0xcf, 0x45, 0xdc, 0x22, 0x32,
+		0xe5, 0x91, 0x3f, 0xd9, 0x94, 0xf6, 0xb8, 0x43, 0xe0, 0x2c, 0x6f, 0xc7, 0x61, 0x3e, 0xd2, 0x7b,
+		0x9d, 0xb8, 0x78, 0x80, 0x64, 0x1e, 0xc5, 0xbd, 0x45, 0x8e, 0x28, 0xc9, 0xfb, 0x07, 0xb8, 0xcb,
+		0x07, 0x21, 0xb1, 0xec, 0x38, 0xf8, 0x45, 0x14, 0x6d, 0xeb, 0x36, 0xf3, 0x97, 0xa4, 0x12, 0xb4,
+		0xb1, 0xcf, 0xb3, 0xf7, 0xfc, 0x1b, 0x9a, 0x1b, 0x7c, 0x2d, 0x25, 0xda, 0xc5, 0x8b, 0x90, 0x59,
+		0xb1, 0x2d, 0x8f, 0x58, 0x5e, 0x9b, 0x6e, 0x65, 0xbb, 0xa6, 0xad, 0x1f, 0x70, 0x04, 0xd6, 0x40,
+		0x83, 0x6b, 0x8e, 0x43, 0x25, 0x93, 0x0a, 0xfe, 0xc9, 0xd6, 0x6c, 0xb9, 0x3e, 0xd0, 0x44, 0x17,
+		0x8f, 0x6f, 0x22, 0x3e, 0xc8, 0xc0, 0x46, 0x7f, 0x1c, 0x83, 0xfb, 0x7a, 0x17, 0xd4, 0x01, 0x39,
+		0xf4, 0x8e, 0xbb, 0x9e, 0x5e, 0x86, 0x4c, 0x8d, 0x7e, 0xb2, 0xfc, 0x02, 0x39, 0x94, 0xe7, 0x60,
+		0x82, 0x34, 0xce, 0x9d, 0x3f, 0xff, 0xd4, 0x45, 0xe6, 0xed, 0x57, 0xc6, 0x14, 0x41, 0x90, 0xe7,
+		0x21, 0xe3, 0x11, 0xdd, 0x39, 0x77, 0xfe, 0xc2, 0xc1, 0x53, 0xcc, 0xbd, 0xae, 0x8c, 0x29, 0x21,
+		0xa9, 0x94, 0xc6, 0x51, 0x7f, 0xe7, 0xed, 0x85, 0x58, 0x39, 0x05, 0x09, 0xaf, 0xdd, 0x7a, 0x5f,
+		0x7d, 0xe4, 0x93, 0x29, 0x58, 0x8c, 0x4a, 0xd2, 0x0d, 0xff, 0xba, 0x66, 0x1a, 0x0d, 0x2d, 0xfc,
+		0xd8, 0x5c, 0x8a, 0xd8, 0x80, 0x72, 0x0c, 0xd8, 0x29, 0x8e, 0xb4, 0x64, 0xf1, 0x97, 0x62, 0x90,
+		0xbb, 0x26, 0x90, 0xf1, 0xeb, 0xf4, 0x4b, 0x00, 0xc1, 0x93, 0xc4, 0xb2, 0xb9, 0x77, 0xa9, 0xfb,
+		0x59, 0x4b, 0x81, 0x8c, 0x12, 0x61, 0x97, 0x9f, 0xa5, 0x8e, 0xe8, 0xd8, 0x1e, 0xff, 0x82, 0x66,
+		0x88, 0x68, 0xc0, 0x8c, 0x6f, 0x44, 0xd1, 0x08, 0xa7, 0x5e, 0xb7, 0x7d, 0xbc, 0x22, 0x76, 0xec,
+		0x1b, 0xfc, 0xbb, 0xc4, 0x84, 0x22, 0xd1, 0x9e, 0x6b, 0xb4, 0xa3, 0x86, 0x74, 0x54, 0x3a, 0x13,
+		0xa0, 0x60, 0x76, 0xa6, 0x35, 0x1a, 0x2e, 0xf1, 0x3c, 0x1e, 0xc4, 0x44, 0x13, 0x3f, 0xdb, 0x71,
+		0xda, 0xbb, 0xaa, 0x88, 0x18, 0xf8, 0xe1, 0x53, 0x9f, 0xf5, 0x2f, 0xfc, 0x83, 0x47, 0x80, 0x71,
+		0xa7, 0xbd, 0x8b, 0xde, 0xf2, 0x00, 0xe4, 0xfa, 0x28, 0x93, 0xbd, 0x1e, 0xea, 0x41, 0xbf, 0x94,
+		0xe7, 0x23, 0x50, 0x1d, 0xd7, 0xb0, 0x5d, 0xc3, 0x3f, 0xa4, 0x2f, 0xc0, 0x24, 0x14, 0x49, 0x74,
+		0xd4, 0x38, 0xbd, 0x78, 0x00, 0xf9, 0x3a, 0x4d, 0x70, 0x42, 0xcd, 0xcf, 0x87, 0xfa, 0xc5, 0x86,
+		0xeb, 0x37, 0x50, 0xb3, 0x78, 0x8f, 0x66, 0xe5, 0x17, 0x07, 0x7a, 0xe7, 0xb3, 0xc7, 0xf7, 0xce,
+		0xce, 0xdd, 0xee, 0x8f, 0x66, 0xe1, 0xbe, 0xee, 0xce, 0x8e, 0xf0, 0x35, 0xaa, 0x63, 0x0e, 0x4b,
+		0xca, 0xe7, 0x8e, 0xde, 0x54, 0xe7, 0x86, 0x84, 0xd1, 0xb9, 0xa1, 0x4b, 0xa8, 0x78, 0x11, 0x26,
+		0xf1, 0x4d, 0xb6, 0x3a, 0xf1, 0xaf, 0x10, 0xad, 0x41, 0xdc, 0xce, 0x5d, 0x77, 0x52, 0xec, 0xba,
+		0x32, 0x24, 0xe9, 0xd6, 0xca, 0x76, 0x1d, 0xfa, 0x77, 0x71, 0x1f, 0x92, 0x28, 0x1a, 0xee, 0xc8,
+		0x5c, 0x82, 0x36, 0x90, 0xba, 0x7b, 0xe8, 0x13, 0x4f, 0x9c, 0x12, 0x69, 0x43, 0x7e, 0x46, 0xec,
+		0xab, 0x89, 0xa3, 0xf7, 0x55, 0xee, 0x88, 0x7c, 0x77, 0x35, 0x61, 0xa2, 0x8c, 0xa1, 0x78, 0xad,
+		0x12, 0x28, 0x12, 0x0b, 0x15, 0x91, 0x37, 0x20, 0xef, 0x68, 0xae, 0x4f, 0xdf, 0xfe, 0xdf, 0xa7,
+		0xa3, 0xe0, 0xbe, 0xbe, 0xd0, 0xbb, 0xf2, 0x3a, 0x06, 0xcb, 0x9f, 0x32, 0xe9, 0x44, 0x89, 0xc5,
+		0xff, 0x92, 0x84, 0x71, 0x6e, 0x8c, 0x0f, 0xc2, 0x04, 0x37, 0x2b, 0xf7, 0xce, 0xfb, 0x97, 0x7a,
+		0x37, 0xa6, 0xa5, 0x60, 0x03, 0xe1, 0x78, 0x42, 0x46, 0x7e, 0x04, 0xd2, 0xfa, 0xbe, 0x66, 0x58,
+		0xaa, 0xd1, 0xe0, 0x09, 0x61, 0xf6, 0xdd, 0x77, 0x16, 0x26, 0x56, 0x90, 0xb6, 0x56, 0x51, 0x26,
+		0x68, 0xe7, 0x5a, 0x03, 0x33, 0x81, 0x7d, 0x62, 0x34, 0xf7, 0x7d, 0xbe, 0xc2, 0x78, 0x0b, 0x7f,
+		0x26, 0x03, 0x1d, 0x82, 0x7f, 0x1b, 0x36, 0xd7, 0x93, 0xae, 0x07, 0x67, 0xa6, 0x72, 0x1a, 0x1f,
+		0xfc, 0xf1, 0xdf, 0x5f, 0x88, 0x29, 0x54, 0x42, 0x5e, 0x81, 0x49, 0x53, 0xf3, 0x7c, 0x95, 0xee,
+		0x60, 0xf8, 0xf8, 0x14, 0x85, 0x98, 0xed, 0x35, 0x08, 0x37, 0x2c, 0x57, 0x3d, 0x8b, 0x52, 0x8c,
+		0xd4, 0xc0, 0x4f, 0x57, 0x28, 0x08, 0xbe, 0xc0, 0x67, 0xf8, 0x2c, 0xb7, 0x1a, 0xa7, 0x76, 0x9f,
+		0x42, 0xfa, 0x0a, 0x25, 0xd3, 0x0c, 0xeb, 0x5e, 0xc8, 0xd0, 0xaf, 0x51, 0x28, 0x0b, 0x7b, 0xf3,
+		0x32, 0x8d, 0x04, 0xda, 0x79, 0x1a, 0xf2, 0x61, 0x7c, 0x64, 0x2c, 0x69, 0x86, 0x12, 0x92, 0x29,
+		0xe3, 0x93, 0x30, 0x63, 0x91, 0x9b, 0xbe, 0x1a, 0x92, 0x19, 0x77, 0x86, 0x72, 0xcb, 0xd8, 0x77,
+		0xad, 0x53, 0xe2, 0x61, 0x98, 0xd2, 0x85, 0xf1, 0x19, 0x2f, 0x50, 0xde, 0xc9, 0x80, 0x4a, 0xd9,
+		0x66, 0x21, 0xad, 0x39, 0x0e, 0x63, 0xc8, 0xf2, 0xf8, 0xe8, 0x38, 0xb4, 0xeb, 0x31, 0x98, 0xa6,
+		0x63, 0x74, 0x89, 0xd7, 0x36, 0x7d, 0x0e, 0x92, 0xa3, 0x3c, 0x79, 0xec, 0x50, 0x18, 0x9d, 0xf2,
+		0x3e, 0x08, 0x93, 0xe4, 0xba, 0xd1, 0x20, 0x96, 0x4e, 0x18, 0xdf, 0x24, 0xe5, 0xcb, 0x09, 0x22,
+		0x65, 0x7a, 0x14, 0x82, 0xb8, 0xa7, 0x8a, 0x98, 0x3c, 0xc5, 0xf0, 0x04, 0x7d, 0x99, 0x91, 0x8b,
+		0x05, 0x48, 0x56, 0x34, 0x5f, 0xc3, 0x04, 0xc3, 0xbf, 0xc9, 0x36, 0x9a, 0x9c, 0x82, 0x7f, 0x16,
+		0xbf, 0x13, 0x87, 0xe4, 0x35, 0xdb, 0x27, 0xf2, 0xd3, 0x91, 0x04, 0x70, 0xaa, 0x9f, 0x3f, 0xd7,
+		0x8d, 0xa6, 0x45, 0x1a, 0x1b, 0x5e, 0x33, 0xf2, 0xe9, 0x78, 0xe8, 0x4e, 0xf1, 0x0e, 0x77, 0x9a,
+		0x81, 0x94, 0x6b, 0xb7, 0xad, 0x86, 0x78, 0x69, 0x91, 0x36, 0xe4, 0x2a, 0xa4, 0x03, 0x2f, 0x49,
+		0x0e, 0xf3, 0x92, 0x3c, 0x7a, 0x09, 0xfa, 0x30, 0x27, 0x28, 0x13, 0xbb, 0xdc, 0x59, 0xca, 0x90,
+		0x09, 0x82, 0x57, 0x21, 0x75, 0x0c, 0x87, 0x0d, 0xc5, 0x70, 0x33, 0x09, 0xe6, 0x3e, 0x30, 0x1e,
+		0xf3, 0x38, 0x29, 0xe8, 0xe0, 0xd6, 0xeb, 0x70, 0x2b, 0xfe, 0x19, 0xfb, 0x04, 0x1d, 0x57, 0xe8,
+		0x56, 0xec, 0x53, 0xf6, 0xfb, 0xf0, 0x1d, 0x94, 0xa6, 0xa5, 0xf9, 0x6d, 0x97, 0x70, 0xcf, 0x0b,
+		0x09, 0xf8, 0x89, 0xc2, 0x38, 0xf3, 0xe4, 0x88, 0xdd, 0x62, 0xfd, 0xed, 0x16, 0x1f, 0x64, 0xb7,
+		0xc4, 0x9d, 0xdb, 0x6d, 0x19, 0x20, 0x50, 0xc6, 0xe3, 0x5f, 0x17, 0xf7, 0xc9, 0x18, 0x98, 0x8a,
+		0x75, 0xa3, 0xc9, 0x17, 0x6a, 0x44, 0xa8, 0xf8, 0x9f, 0x62, 0x90, 0x09, 0xfa, 0xe5, 0x65, 0x98,
+		0x14, 0x7a, 0xa9, 0x7b, 0xa6, 0xd6, 0xe4, 0xbe, 0x73, 0xff, 0x40, 0xe5, 0x2e, 0x9b, 0x5a, 0x53,
+		0xc9, 0x72, 0x7d, 0xb0, 0xd1, 0x7f, 0x1e, 0xe2, 0x03, 0xe6, 0xa1, 0x63, 0xe2, 0x13, 0x77, 0x36,
+		0xf1, 0x1d, 0x53, 0x94, 0xec, 0x9e, 0xa2, 0x5f, 0x8e, 0xd3, 0xc3, 0x8c, 0x63, 0x7b, 0x9a, 0xf9,
+		0xa3, 0x58, 0x11, 0xf7, 0x42, 0xc6, 0xb1, 0x4d, 0x95, 0xf5, 0xb0, 0x97, 0x79, 0xd3, 0x8e, 0x6d,
+		0x2a, 0x3d, 0xd3, 0x9e, 0xba, 0x4b, 0xcb, 0x65, 0xfc, 0x2e, 0x58, 0x6d, 0xa2, 0xdb, 0x6a, 0x2e,
+		0xe4, 0x98, 0x29, 0xf8, 0x5e, 0xf6, 0x24, 0xda, 0x00, 0xff, 0x2a, 0xc4, 0x7a, 0xf7, 0x5e, 0xa6,
+		0x36, 0xe3, 0x54, 0xc6, 0xf7, 0x03, 0x09, 0x16, 0xfa, 0x0b, 0xf1, 0x41, 0x12, 0xcc, 0xed, 0x14,
+		0xce, 0x57, 0xfc, 0x99, 0x18, 0xc0, 0x3a, 0x5a, 0x96, 0x8e, 0x17, 0x77, 0x21, 0x8f, 0xaa, 0xa0,
+		0x76, 0x3c, 0x79, 0x7e, 0xd0, 0xa4, 0xf1, 0xe7, 0xe7, 0xbc, 0xa8, 0xde, 0x2b, 0x30, 0x19, 0x3a,
+		0xa3, 0x47, 0x84, 0x32, 0xf3, 0x47, 0x64, 0xd5, 0x75, 0xe2, 0x2b, 0xb9, 0xeb, 0x91, 0x56, 0xf1,
+		0x5f, 0xc5, 0x20, 0x43, 0x75, 0xc2, 0x6f, 0x23, 0x3b, 0xe6, 0x30, 0x76, 0xe7, 0x73, 0x78, 0x3f,
+		0x00, 0x83, 0xc1, 0x1b, 0x39, 0xee, 0x59, 0x19, 0x4a, 0xc1, 0x7b, 0x36, 0xf9, 0x42, 0x60, 0xf0,
+		0xc4, 0xd1, 0x06, 0x17, 0x59, 0x37, 0x37, 0xfb, 0x29, 0x98, 0xa0, 0xbf, 0xc6, 0x73, 0xd3, 0xe3,
+		0x89, 0x34, 0x7e, 0x82, 0xbf, 0x7d, 0xd3, 0x2b, 0xbe, 0x06, 0x13, 0xdb, 0x37, 0x59, 0x6d, 0xe4,
+		0x5e, 0xc8, 0xb8, 0xb6, 0xcd, 0xf7, 0x64, 0x96, 0x0b, 0xa5, 0x91, 0x40, 0xb7, 0x20, 0x51, 0x0f,
+		0x88, 0x87, 0xf5, 0x80, 0xb0, 0xa0, 0x91, 0x18, 0xa9, 0xa0, 0xf1, 0xd8, 0x6f, 0xc7, 0x20, 0x1b,
+		0x89, 0x0f, 0xf2, 0x53, 0x70, 0x4f, 0x79, 0x7d, 0x6b, 0xe5, 0x05, 0x75, 0xad, 0xa2, 0x5e, 0x5e,
+		0x5f, 0x5e, 0x0d, 0x3f, 0x57, 0x99, 0x3b, 0x79, 0xeb, 0xf6, 0xa2, 0x1c, 0xe1, 0xdd, 0xb1, 0x68,
+		0x61, 0x56, 0x3e, 0x0b, 0x33, 0x9d, 0x22, 0xcb, 0xe5, 0x3a, 0x7e, 0xbb, 0x12, 0x9b, 0xbb, 0xe7,
+		0xd6, 0xed, 0xc5, 0xe9, 0x88, 0xc4, 0xf2, 0xae, 0x47, 0x2c, 0xbf, 0x57, 0x60, 0x65, 0x6b, 0x63,
+		0x63, 0x6d, 0x5b, 0x8a, 0xf7, 0x08, 0xf0, 0x80, 0xfd, 0x28, 0x4c, 0x77, 0x0a, 0x6c, 0xae, 0xad,
+		0x4b, 0x89, 0x39, 0xf9, 0xd6, 0xed, 0xc5, 0xa9, 0x08, 0xf7, 0xa6, 0x61, 0xce, 0xa5, 0x3f, 0xfa,
+		0xd9, 0xf9, 0xb1, 0x5f, 0xf8, 0xdc, 0x7c, 0x0c, 0x47, 0x36, 0xd9, 0x11, 0x23, 0xe4, 0xc7, 0xe1,
+		0x54, 0x7d, 0x6d, 0x75, 0xb3, 0x5a, 0x51, 0x37, 0xea, 0xab, 0x2a, 0xfb, 0x99, 0x8e, 0x60, 0x74,
+		0xf9, 0x5b, 0xb7, 0x17, 0xb3, 0x7c, 0x48, 0x83, 0xb8, 0x6b, 0x4a, 0xf5, 0xda, 0xd6, 0x76, 0x55,
+		0x8a, 0x31, 0xee, 0x9a, 0x4b, 0xae, 0xdb, 0x3e, 0xfb, 0xb9, 0xae, 0x27, 0x61, 0xb6, 0x0f, 0x77,
+		0x30, 0xb0, 0xe9, 0x5b, 0xb7, 0x17, 0x27, 0x6b, 0x78, 0xd7, 0x8d, 0x03, 0xa2, 0x12, 0x4b, 0x50,
+		0xe8, 0x95, 0xd8, 0xaa, 0x6d, 0xd5, 0x97, 0xd7, 0xa5, 0xc5, 0x39, 0xe9, 0xd6, 0xed, 0xc5, 0x9c,
+		0x08, 0x86, 0xc8, 0x1f, 0x8e, 0xec, 0xfd, 0x3c, 0xf1, 0xfc, 0xfa, 0x45, 0x78, 0x88, 0xd7, 0x00,
+		0x3d, 0x5f, 0x3b, 0x30, 0xac, 0x66, 0x50, 0xbc, 0xe5, 0x6d, 0x7e, 0xf2, 0x39, 0xc9, 0xb8, 0x96,
+		0x04, 0x75, 0x48, 0x09, 0x77, 0xe0, 0x75, 0xd5, 0xdc, 0x90, 0x5b, 0x9c, 0xe1, 0x47, 0xa7, 0xc1,
+		0xe5, 0xe1, 0xb9, 0x21, 0x45, 0xe8, 0xb9, 0x23, 0x0f, 0x77, 0xc5, 0x8f, 0xc5, 0x60, 0xea, 0x8a,
+		0xe1, 0xf9, 0xb6, 0x6b, 0xe8, 0x9a, 0x49, 0x3f, 0x52, 0xb9, 0x30, 0x6a, 0x6c, 0xed, 0x5a, 0xea,
+		0xcf, 0xc3, 0xf8, 0x75, 0xcd, 0x64, 0x41, 0x2d, 0x41, 0x7f, 0x53, 0xa3, 0xbf, 0xf9, 0xc2, 0xd0,
+		0x26, 0x00, 0x98, 0x58, 0xf1, 0x8b, 0x71, 0xc8, 0xd3, 0xc5, 0xe0, 0xb1, 0x5f, 0x5b, 0xc2, 0x33,
+		0x56, 0x0d, 0x92, 0xae, 0xe6, 0xf3, 0xa2, 0x61, 0xf9, 0xc7, 0x78, 0x1d, 0xf8, 0x91, 0xe1, 0xd5,
+		0xdc, 0xa5, 0xde, 0x52, 0x31, 0x45, 0x92, 0x5f, 0x82, 0x74, 0x4b, 0xbb, 0xa9, 0x52, 0xd4, 0xf8,
+		0x5d, 0x40, 0x9d, 0x68, 0x69, 0x37, 0x51, 0x57, 0xb9, 0x01, 0x79, 0x04, 0xd6, 0xf7, 0x35, 0xab,
+		0x49, 0x18, 0x7e, 0xe2, 0x2e, 0xe0, 0x4f, 0xb6, 0xb4, 0x9b, 0x2b, 0x14, 0x13, 0x9f, 0x52, 0x4a,
+		0xe3, 0xd5, 0x24, 0x2d, 0xb3, 0xff, 0x5a, 0x0c, 0x20, 0x34, 0x97, 0xfc, 0x13, 0x20, 0xe9, 0x41,
+		0x8b, 0x3e, 0xde, 0xe3, 0x13, 0x78, 0x7a, 0xd0, 0x44, 0x74, 0x19, 0x9b, 0x6d, 0xcc, 0xdf, 0x78,
+		0x67, 0x21, 0xa6, 0xe4, 0xf5, 0xae, 0x79, 0xa8, 0x42, 0xb6, 0xed, 0x34, 0x34, 0x9f, 0xa8, 0xf4,
+		0x10, 0x17, 0x3f, 0xc6, 0x26, 0x0f, 0x4c, 0x10, 0xbb, 0x22, 0xda, 0x7f, 0x31, 0x06, 0xd9, 0x4a,
+		0xe4, 0x2d, 0xb1, 0x02, 0x4c, 0xb4, 0x6c, 0xcb, 0x38, 0xe0, 0x6e, 0x97, 0x51, 0x44, 0x13, 0x2b,
+		0x9e, 0xec, 0xf3, 0x3c, 0xff, 0x50, 0x54, 0x3c, 0x45, 0x1b, 0xa5, 0x6e, 0x90, 0x5d, 0xcf, 0x10,
+		0xb6, 0x56, 0x44, 0x13, 0x8f, 0x2e, 0x1e, 0xd1, 0xdb, 0x58, 0xaa, 0x51, 0x75, 0xdb, 0xf2, 0x35,
+		0xdd, 0xe7, 0x1f, 0x7a, 0xe5, 0x05, 0x7d, 0x85, 0x91, 0x11, 0xa4, 0x41, 0x7c, 0xcd, 0x30, 0xbd,
+		0x02, 0xbb, 0x24, 0x12, 0xcd, 0x88, 0xba, 0x7f, 0x18, 0x83, 0x19, 0xf1, 0x53, 0x04, 0xd7, 0x88,
+		0x6b, 0xec, 0x19, 0xfc, 0x63, 0xb5, 0x47, 0x41, 0xe2, 0x8f, 0x54, 0xaf, 0x53, 0xba, 0xf8, 0x86,
+		0x56, 0xc9, 0x73, 0xfa, 0x35, 0x4e, 0xc6, 0xef, 0xc9, 0xba, 0x55, 0x0a, 0x65, 0xd8, 0xe7, 0xc3,
+		0xa7, 0xba, 0x74, 0x0b, 0x64, 0x1f, 0x80, 0x9c, 0x78, 0x4c, 0xe4, 0x5a, 0x20, 0xcb, 0x69, 0x74,
+		0xa7, 0xac, 0x42, 0x56, 0xa0, 0xa9, 0x9a, 0x7f, 0xac, 0x73, 0x36, 0x08, 0xc1, 0x65, 0xbf, 0xf8,
+		0x1b, 0xe3, 0xd1, 0x62, 0xdc, 0x0a, 0x48, 0xb6, 0x43, 0xdc, 0x8e, 0xe4, 0x99, 0xad, 0xc5, 0xc2,
+		0x6f, 0x7e, 0xf9, 0x89, 0x19, 0xee, 0x58, 0x3c, 0x7d, 0x66, 0xef, 0x6c, 0x2a, 0x79, 0x21, 0xc1,
+		0xc9, 0xf2, 0x2b, 0x20, 0x05, 0x67, 0x58, 0xd5, 0x69, 0xef, 0x86, 0x05, 0xbc, 0x99, 0x1e, 0xf5,
+		0x96, 0xad, 0xc3, 0x72, 0xe1, 0xeb, 0x21, 0x74, 0x58, 0x35, 0xc3, 0x92, 0x59, 0x3e, 0xc0, 0xa9,
+		0x51, 0x18, 0x4c, 0x86, 0x5f, 0xd3, 0x0c, 0x53, 0x7c, 0x5f, 0xad, 0xf0, 0x96, 0x5c, 0x82, 0x71,
+		0xcf, 0xd7, 0xfc, 0xb6, 0xc7, 0x7f, 0xf5, 0xac, 0x38, 0x68, 0x0d, 0x94, 0x6d, 0xab, 0x51, 0xa7,
+		0x9c, 0x0a, 0x97, 0x90, 0xb7, 0x61, 0xdc, 0xb7, 0x0f, 0x88, 0xc5, 0xdd, 0xe1, 0x58, 0xeb, 0xb7,
+		0xcf, 0xad, 0x1b, 0xc3, 0x92, 0x9b, 0x20, 0x35, 0x88, 0x49, 0x9a, 0x2c, 0xf5, 0xdb, 0xd7, 0xf0,
+		0x84, 0x34, 0x7e, 0x17, 0xe2, 0x43, 0x3e, 0x40, 0xad, 0x53, 0x50, 0xf9, 0x85, 0x8e, 0xd7, 0x2f,
+		0xf9, 0x4f, 0x04, 0x3e, 0x38, 0x68, 0xfc, 0x91, 0x35, 0x28, 0xca, 0x26, 0x11, 0x69, 0x74, 0xef,
+		0xb6, 0xb5, 0x6b, 0x5b, 0xf4, 0x2b, 0x48, 0x7e, 0xec, 0x48, 0xd3, 0x44, 0x2e, 0x1f, 0xd0, 0xaf,
+		0x50, 0xb2, 0xfc, 0x02, 0x4c, 0x85, 0xac, 0x34, 0x4a, 0x64, 0x8e, 0xe1, 0x82, 0x93, 0x81, 0x2c,
+		0xf6, 0xca, 0x57, 0x00, 0xc2, 0x10, 0x44, 0x0b, 0x21, 0xd9, 0x73, 0xc5, 0xe1, 0x71, 0x4c, 0x1c,
+		0x28, 0x43, 0x59, 0xd9, 0x84, 0x13, 0x2d, 0xc3, 0x52, 0x3d, 0x62, 0xee, 0xa9, 0xdc, 0x54, 0x08,
+		0x99, 0xbd, 0x0b, 0x53, 0x3b, 0xdd, 0x32, 0xac, 0x3a, 0x31, 0xf7, 0x2a, 0x01, 0x6c, 0x29, 0xf7,
+		0xd1, 0xb7, 0x16, 0xc6, 0x78, 0xd4, 0x18, 0x2b, 0xd6, 0x68, 0x31, 0x9e, 0x2f, 0x03, 0xe2, 0xc9,
+		0x17, 0x20, 0xa3, 0x89, 0x06, 0x2d, 0x91, 0x1c, 0xb5, 0x8c, 0x42, 0x56, 0x16, 0x87, 0xde, 0xf8,
+		0xbd, 0xc5, 0x58, 0xf1, 0x73, 0x31, 0x18, 0xaf, 0x5c, 0xab, 0x69, 0x86, 0x2b, 0x57, 0x61, 0x3a,
+		0x74, 0xa8, 0x51, 0xd7, 0x66, 0xe8, 0x83, 0x62, 0x71, 0x56, 0x07, 0x9d, 0x8f, 0x8f, 0x84, 0xe9,
+		0x3e, 0x39, 0x77, 0x0d, 0xbc, 0x0a, 0x13, 0x4c, 0x4b, 0xfc, 0x8a, 0x36, 0xe5, 0xe0, 0x1f, 0xfc,
+		0xee, 0x61, 0x7e, 0xa0, 0x23, 0x52, 0xfe, 0xa0, 0x56, 0x8a, 0x22, 0xc5, 0x3f, 0x8e, 0x01, 0x54,
+		0xae, 0x5d, 0xdb, 0x76, 0x0d, 0xc7, 0x24, 0xfe, 0xdd, 0x1a, 0xf1, 0x3a, 0xdc, 0x13, 0x8e, 0xd8,
+		0x73, 0xf5, 0x91, 0x47, 0x7d, 0x22, 0x3c, 0x86, 0xb9, 0x7a, 0x5f, 0xb4, 0x86, 0xe7, 0x07, 0x68,
+		0x89, 0x91, 0xd1, 0x2a, 0x9e, 0xdf, 0xdf, 0x8c, 0x75, 0xc8, 0x86, 0xc3, 0xc7, 0xdf, 0x89, 0x4a,
+		0xfb, 0xfc, 0x6f, 0x6e, 0xcd, 0xe2, 0x60, 0x6b, 0x0a, 0x31, 0x6e, 0xd1, 0x40, 0xb2, 0xf8, 0x7f,
+		0xd1, 0xa8, 0x81, 0xc7, 0xfe, 0xe9, 0x72, 0x23, 0x8c, 0xbd, 0x3c, 0x36, 0xde, 0x8d, 0xdc, 0x89,
+		0x63, 0x75, 0x59, 0xf5, 0xcd, 0x38, 0xfe, 0xc4, 0x00, 0x8f, 0x36, 0x7f, 0x6a, 0x2d, 0x51, 0x83,
+		0x09, 0x62, 0xf9, 0xae, 0x41, 0x4d, 0x81, 0x73, 0xfd, 0xe4, 0xa0, 0xb9, 0xee, 0x33, 0x16, 0xfa,
+		0xe3, 0x3b, 0xa2, 0x82, 0xcf, 0x61, 0xba, 0xac, 0xf0, 0x1f, 0xe3, 0x50, 0x18, 0x24, 0x89, 0xf5,
+		0x48, 0xdd, 0x25, 0x94, 0xa0, 0x76, 0x94, 0x11, 0xa7, 0x04, 0x99, 0x07, 0xfd, 0x0d, 0xc0, 0x54,
+		0x11, 0x1d, 0x0b, 0x59, 0x8f, 0x9d, 0x1b, 0x4e, 0x85, 0xc2, 0xd8, 0x2d, 0x13, 0xc8, 0x1b, 0x96,
+		0xe1, 0x1b, 0x9a, 0xa9, 0xee, 0x6a, 0xa6, 0x66, 0xe9, 0x77, 0x92, 0x43, 0xf7, 0x06, 0xea, 0x29,
+		0x0e, 0x5a, 0x66, 0x98, 0xf2, 0x35, 0x98, 0x10, 0xf0, 0xc9, 0xbb, 0x00, 0x2f, 0xc0, 0x22, 0xf9,
+		0xe2, 0xef, 0xc6, 0x61, 0x5a, 0x21, 0x8d, 0x3f, 0x5b, 0x66, 0xfd, 0x71, 0x00, 0xb6, 0xe0, 0x30,
+		0x0e, 0x16, 0x92, 0x77, 0x61, 0x01, 0x67, 0x18, 0x5e, 0xc5, 0xf3, 0x23, 0xb6, 0xfd, 0x7a, 0x1c,
+		0x72, 0x51, 0xdb, 0xfe, 0x19, 0xd8, 0x17, 0xe4, 0xb5, 0x30, 0x1a, 0x24, 0xf9, 0xcf, 0x86, 0x0e,
+		0x88, 0x06, 0x3d, 0x5e, 0x77, 0x74, 0x18, 0xf8, 0xad, 0x0c, 0x8c, 0xd7, 0x34, 0x57, 0x6b, 0x79,
+		0xf2, 0xd5, 0x9e, 0x04, 0x4e, 0xd4, 0x13, 0x7b, 0x7e, 0x1c, 0x9a, 0x97, 0x2f, 0x98, 0xcb, 0x7d,
+		0xa2, 0x4f, 0xfe, 0xf6, 0x30, 0x4c, 0xe1, 0x61, 0x38, 0xf2, 0xea, 0x41, 0x9c, 0x5e, 0xa8, 0xe2,
+		0x69, 0x36, 0xbc, 0xf7, 0xc2, 0xdf, 0xa6, 0x40, 0xb6, 0x30, 0xd0, 0x21, 0x0f, 0xb4, 0xb4, 0x9b,
+		0x55, 0x46, 0x91, 0x9f, 0x00, 0x79, 0x3f, 0x28, 0x4f, 0xa8, 0xa1, 0x09, 0x90, 0x6f, 0x3a, 0xec,
+		0x11, 0xec, 0x58, 0xc5, 0xb4, 0xad, 0x86, 0xca, 0x5e, 0x67, 0x63, 0xa7, 0xb9, 0x0c, 0x52, 0x2a,
+		0x48, 0x90, 0x6f, 0xc5, 0x58, 0x32, 0xd8, 0x75, 0x50, 0xe6, 0x79, 0xf8, 0xab, 0xc7, 0x73, 0xd5,
+		0x1f, 0xbc, 0xb3, 0x30, 0x77, 0xa8, 0xb5, 0xcc, 0x52, 0xb1, 0x0f, 0x64, 0xb1, 0xcb, 0x91, 0x31,
+		0x55, 0xec, 0x3c, 0x6e, 0xcb, 0x6f, 0xc6, 0x60, 0x36, 0x32, 0x36, 0x97, 0xf8, 0xc4, 0x0a, 0x97,
+		0xfb, 0xc4, 0x30, 0xd3, 0x3f, 0x8e, 0xda, 0xfe, 0xe0, 0x9d, 0x85, 0x45, 0xa6, 0xc3, 0x40, 0xa4,
+		0x22, 0x9d, 0x9e, 0x53, 0x61, 0xbf, 0x22, 0xba, 0xe9, 0x44, 0x7d, 0x2a, 0x06, 0xb3, 0x4d, 0xd3,
+		0xde, 0xd5, 0x4c, 0xd5, 0x34, 0x3e, 0xd2, 0x36, 0x1a, 0x2a, 0x77, 0x28, 0x55, 0xd7, 0x1c, 0xf6,
+		0x93, 0x32, 0xe5, 0xbf, 0x70, 0x6c, 0xc3, 0x70, 0xa5, 0x06, 0x02, 0x77, 0x9b, 0xe7, 0x24, 0xe3,
+		0x5c, 0xa7, 0x8c, 0x75, 0xc6, 0xb7, 0xa2, 0x39, 0xf2, 0xe7, 0x62, 0x70, 0x5f, 0xb8, 0x8a, 0xfa,
+		0x28, 0x98, 0xa1, 0x0a, 0xea, 0xc7, 0x56, 0xf0, 0x41, 0xa6, 0xe0, 0x51, 0xd8, 0xdd, 0x3a, 0xce,
+		0x06, 0xcc, 0x3d, 0x6a, 0xfe, 0x4c, 0x0c, 0x16, 0xfb, 0x1c, 0x32, 0xd4, 0xa6, 0x8b, 0x3f, 0xaf,
+		0xe0, 0x10, 0xd7, 0xb0, 0x1b, 0x05, 0x18, 0x36, 0xa3, 0x4f, 0xf3, 0x19, 0x3d, 0x1d, 0x7a, 0xd5,
+		0x51, 0x80, 0x6c, 0x62, 0xef, 0xeb, 0x39, 0x83, 0xac, 0x22, 0x4f, 0x8d, 0xb2, 0xc8, 0x1f, 0x8f,
+		0x81, 0x74, 0x40, 0x0e, 0x55, 0x97, 0xff, 0x48, 0x8c, 0xba, 0x47, 0x08, 0xfd, 0x0f, 0x1d, 0x50,
+		0x91, 0x3e, 0x6f, 0xa7, 0x2e, 0xe1, 0xfb, 0xa0, 0xe5, 0x17, 0xb8, 0x22, 0xa7, 0x98, 0x22, 0xdd,
+		0x00, 0xc5, 0x2f, 0xfc, 0xfe, 0xc2, 0x99, 0x11, 0x2c, 0x8d, 0x58, 0x9e, 0x32, 0x75, 0x40, 0x0e,
+		0x15, 0x2e, 0x7d, 0x99, 0x10, 0xf9, 0x27, 0xa0, 0x80, 0x4b, 0xbe, 0x85, 0x17, 0x3b, 0x86, 0x6f,
+		0x10, 0x0f, 0x87, 0xc3, 0xee, 0xf6, 0xe9, 0x5d, 0xf5, 0x64, 0xf9, 0xc1, 0x1f, 0xbc, 0xb3, 0xb0,
+		0xc0, 0x6d, 0x30, 0x80, 0xb3, 0xa8, 0xdc, 0xd3, 0xd2, 0x6e, 0x6e, 0x04, 0x3d, 0x35, 0xe2, 0xd2,
+		0x1a, 0x79, 0x64, 0x97, 0xf8, 0x6c, 0x0c, 0xe4, 0xd0, 0x28, 0x0a, 0xf1, 0x1c, 0xdb, 0xf2, 0xe8,
+		0xc1, 0x32, 0x72, 0x0a, 0x8c, 0x1d, 0x7d, 0xb0, 0x0c, 0xe5, 0xc5, 0xc1, 0x32, 0x94, 0xc5, 0x1f,
+		0xe6, 0x15, 0x9b, 0x69, 0x9c, 0x4f, 0xed, 0x40, 0x8b, 0xf2, 0x10, 0xdc, 0x9d, 0x27, 0x8c, 0x15,
+		0x7f, 0x37, 0x06, 0xb3, 0x3d, 0x11, 0x3b, 0x50, 0xf6, 0xcf, 0x83, 0xec, 0x46, 0x3a, 0xf9, 0x6f,
+		0x2c, 0x32, 0xa5, 0x8f, 0xbd, 0x01, 0x4c, 0xbb, 0xdd, 0x1d, 0xef, 0x5b, 0x1e, 0xc4, 0xde, 0x03,
+		0xfe, 0x97, 0x31, 0x98, 0x89, 0x2a, 0x13, 0x0c, 0x6b, 0x13, 0x72, 0x51, 0x5d, 0xf8, 0x80, 0x1e,
+		0x1a, 0x65, 0x40, 0x7c, 0x2c, 0x1d, 0xf2, 0xf2, 0x8b, 0xe1, 0xe6, 0xc8, 0x4a, 0xcf, 0x4f, 0x8d,
+		0x6c, 0x1b, 0xa1, 0x53, 0xf7, 0x26, 0x99, 0x14, 0x27, 0x85, 0x64, 0xcd, 0xb6, 0x4d, 0xf9, 0x2f,
+		0xc1, 0xb4, 0x65, 0xfb, 0x2a, 0xee, 0x24, 0xa4, 0xa1, 0xf2, 0xea, 0x10, 0xcb, 0x30, 0x5e, 0x3c,
+		0x9e, 0xc9, 0xbe, 0xfb, 0xce, 0x42, 0x2f, 0x54, 0x97, 0x1d, 0xf3, 0x96, 0xed, 0x97, 0x69, 0xff,
+		0x36, 0xed, 0x96, 0x5d, 0x98, 0xec, 0x7c, 0x34, 0xcb, 0x48, 0x36, 0x8e, 0xfd, 0xe8, 0xc9, 0xa3,
+		0x1e, 0x9b, 0xdb, 0x8d, 0x3c, 0x93, 0xbd, 0x21, 0xf9, 0x7d, 0x9c, 0xc7, 0x7f, 0x97, 0x80, 0x59,
+		0x7c, 0x2d, 0x88, 0xd7, 0xe0, 0xf8, 0x5a, 0x66, 0xb7, 0x06, 0x87, 0x77, 0xa7, 0x42, 0x78, 0x0d,
+		0xf2, 0xb6, 0x89, 0xbf, 0xae, 0x65, 0xfd, 0x7f, 0x16, 0x08, 0x27, 0x6d, 0xb3, 0xc1, 0x75, 0xc5,
+		0xf2, 0xe0, 0x35, 0xc8, 0x5b, 0xe4, 0x46, 0x07, 0x6e, 0xe2, 0xce, 0x70, 0x2d, 0x72, 0x23, 0x82,
+		0x1b, 0xde, 0xc1, 0x27, 0xfb, 0xbe, 0xe4, 0x94, 0x3a, 0xf6, 0x4b, 0x4e, 0x1f, 0x86, 0x04, 0xc6,
+		0xe6, 0xf1, 0x61, 0xb1, 0xf9, 0x49, 0x94, 0x3b, 0x56, 0x00, 0x46, 0xdc, 0x52, 0xfa, 0xa3, 0x22,
+		0xe2, 0xfc, 0x6a, 0x0c, 0x4e, 0xd0, 0x29, 0x36, 0x5e, 0x27, 0xb4, 0x62, 0xa8, 0x10, 0xdd, 0x76,
+		0x1b, 0xf2, 0x14, 0xc4, 0xf9, 0x0d, 0x72, 0x52, 0x89, 0x1b, 0xf8, 0xab, 0xc4, 0x29, 0xfb, 0x86,
+		0xc5, 0x5f, 0x3f, 0x3b, 0x6a, 0x32, 0x19, 0x1b, 0xcd, 0xf8, 0xec, 0x46, 0xdb, 0x24, 0xf8, 0x5b,
+		0xb3, 0xf4, 0xb3, 0x01, 0x56, 0x91, 0x9f, 0x64, 0xd4, 0x65, 0x46, 0xc4, 0x12, 0x58, 0xb0, 0x8f,
+		0x16, 0x92, 0x43, 0xa0, 0x43, 0x56, 0x16, 0x52, 0x1e, 0xfb, 0x4a, 0x0c, 0x20, 0xac, 0xd8, 0xe2,
+		0xf5, 0x65, 0x79, 0x6b, 0xb3, 0xa2, 0xd6, 0xb7, 0x97, 0xb7, 0x77, 0xea, 0xea, 0xce, 0x66, 0xbd,
+		0x56, 0x5d, 0x59, 0xbb, 0xbc, 0x56, 0xad, 0x84, 0x97, 0x9d, 0x9e, 0x43, 0x74, 0x56, 0x43, 0x7f,
+		0x04, 0x66, 0x3a, 0xb9, 0xb1, 0x85, 0x3f, 0xdd, 0x3b, 0x97, 0xbb, 0x75, 0x7b, 0x31, 0xcd, 0x0e,
+		0xc3, 0x04, 0x5f, 0x15, 0xbb, 0xa7, 0x97, 0x0f, 0x7f, 0x98, 0x34, 0x3e, 0x37, 0x79, 0xeb, 0xf6,
+		0x62, 0x26, 0x38, 0x35, 0xcb, 0x45, 0x90, 0xa3, 0x9c, 0x1c, 0x2f, 0x31, 0x07, 0xb7, 0x6e, 0x2f,
+		0x8e, 0xb3, 0x15, 0x3c, 0x97, 0xc4, 0x2b, 0xcd, 0xf2, 0xe5, 0x81, 0xd7, 0x99, 0x8f, 0x1f, 0x39,
+		0x91, 0x37, 0x83, 0x2b, 0xca, 0x8e, 0x3b, 0xcc, 0xff, 0x37, 0x00, 0x91, 0x56, 0x2f, 0xb2, 0xc0,
+		0x6a, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2060,6 +2244,32 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
+	if this.HistoricalRetentionTime != that1.HistoricalRetentionTime {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if this.MinSelfDelegationGracePeriod != that1.MinSelfDelegationGracePeriod {
+		return false
+	}
+	if len(this.KeyRotationFee) != len(that1.KeyRotationFee) {
+		return false
+	}
+	for i := range this.KeyRotationFee {
+		if !this.KeyRotationFee[i].Equal(&that1.KeyRotationFee[i]) {
+			return false
+		}
+	}
+	if this.MaxMaturitiesPerBlock != that1.MaxMaturitiesPerBlock {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2116,6 +2326,39 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2315,6 +2558,64 @@ func (m *Description) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MetadataVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VerifiedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VerifiedAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintStaking(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.WebsiteHash) > 0 {
+		i -= len(m.WebsiteHash)
+		copy(dAtA[i:], m.WebsiteHash)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.WebsiteHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SecurityContactVerified {
+		i--
+		if m.SecurityContactVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WebsiteVerified {
+		i--
+		if m.WebsiteVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Validator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2355,12 +2656,12 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x52
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
-	if err5 != nil {
-		return 0, err5
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n5
-	i = encodeVarintStaking(dAtA, i, uint64(n5))
+	i -= n6
+	i = encodeVarintStaking(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x4a
 	if m.UnbondingHeight != 0 {
@@ -2760,12 +3061,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintStaking(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintStaking(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -2816,12 +3117,12 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err9 != nil {
-		return 0, err9
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n9
-	i = encodeVarintStaking(dAtA, i, uint64(n9))
+	i -= n10
+	i = encodeVarintStaking(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -2910,16 +3211,81 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.BondDenom) > 0 {
-		i -= len(m.BondDenom)
-		copy(dAtA[i:], m.BondDenom)
-		i = encodeVarintStaking(dAtA, i, uint64(len(m.BondDenom)))
+	if m.MaxMaturitiesPerBlock != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxMaturitiesPerBlock))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x60
 	}
-	if m.HistoricalEntries != 0 {
-		i = encodeVarintStaking(dAtA, i, uint64(m.HistoricalEntries))
-		i--
+	if len(m.KeyRotationFee) > 0 {
+		for iNdEx := len(m.KeyRotationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinSelfDelegationGracePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinSelfDelegationGracePeriod):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.HistoricalRetentionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.HistoricalRetentionTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.HistoricalEntries != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.HistoricalEntries))
+		i--
 		dAtA[i] = 0x20
 	}
 	if m.MaxEntries != 0 {
@@ -2932,12 +3298,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err10 != nil {
-		return 0, err10
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n10
-	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3119,6 +3485,136 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintStaking(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -3204,6 +3700,27 @@ func (m *Description) Size() (n int) {
 	return n
 }
 
+func (m *MetadataVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WebsiteVerified {
+		n += 2
+	}
+	if m.SecurityContactVerified {
+		n += 2
+	}
+	l = len(m.WebsiteHash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VerifiedAt)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func (m *Validator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3451,6 +3968,25 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.HistoricalRetentionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinSelfDelegationGracePeriod)
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.KeyRotationFee) > 0 {
+		for _, e := range m.KeyRotationFee {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	if m.MaxMaturitiesPerBlock != 0 {
+		n += 1 + sovStaking(uint64(m.MaxMaturitiesPerBlock))
+	}
 	return n
 }
 
@@ -3510,6 +4046,62 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *ConsPubKeyRotationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4129,7 +4721,7 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *MetadataVerification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4152,17 +4744,17 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetadataVerification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetadataVerification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebsiteVerified", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4172,29 +4764,37 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
+			m.WebsiteVerified = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContactVerified", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.SecurityContactVerified = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebsiteHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4204,7 +4804,156 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebsiteHash = append(m.WebsiteHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.WebsiteHash == nil {
+				m.WebsiteHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VerifiedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5983,59 +6732,43 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRetentionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6062,15 +6795,15 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.HistoricalRetentionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6080,38 +6813,275 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			iNdEx += skippy
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegationGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinSelfDelegationGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRotationFee = append(m.KeyRotationFee, types2.Coin{})
+			if err := m.KeyRotationFee[len(m.KeyRotationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMaturitiesPerBlock", wireType)
+			}
+			m.MaxMaturitiesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMaturitiesPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -6472,6 +7442,411 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types1.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types1.Any{}
+			}
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types2.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
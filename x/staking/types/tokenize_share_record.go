@@ -0,0 +1,119 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the module
+// accounts holding the tokenized delegations.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance
+//nolint:interfacer
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, valAddr sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     valAddr.String(),
+	}
+}
+
+// GetOwnerAddress returns the address of the owner of the record.
+func (r TokenizeShareRecord) GetOwnerAddress() sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(r.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return owner
+}
+
+// GetValidatorAddress returns the operator address of the validator of the
+// tokenized delegation.
+func (r TokenizeShareRecord) GetValidatorAddress() sdk.ValAddress {
+	valAddr, err := sdk.ValAddressFromBech32(r.Validator)
+	if err != nil {
+		panic(err)
+	}
+
+	return valAddr
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// tokenized delegation.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the liquid staking shares of the
+// record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return ShareTokenDenom(r.Validator, r.Id)
+}
+
+// ShareTokenDenom returns the denom of the liquid staking shares of the
+// tokenize share record of the given validator and id.
+func ShareTokenDenom(validator string, id uint64) string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(validator), id)
+}
+
+// ParseShareTokenDenom returns the validator operator address and the record
+// id of a liquid staking share denom.
+func ParseShareTokenDenom(denom string) (sdk.ValAddress, uint64, error) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 2 {
+		return nil, 0, fmt.Errorf("invalid share token denom: %s", denom)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(parts[0])
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid share token denom %s: %w", denom, err)
+	}
+
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid share token denom %s: %w", denom, err)
+	}
+
+	return valAddr, id, nil
+}
+
+// Validate performs a basic validation of the record.
+func (r TokenizeShareRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("tokenize share record id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return fmt.Errorf("invalid owner of tokenize share record %d: %w", r.Id, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(r.Validator); err != nil {
+		return fmt.Errorf("invalid validator of tokenize share record %d: %w", r.Id, err)
+	}
+	if expected := fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, r.Id); r.ModuleAccount != expected {
+		return fmt.Errorf("invalid module account of tokenize share record %d: expected %s, got %s", r.Id, expected, r.ModuleAccount)
+	}
+
+	return nil
+}
+
+// MustMarshalTokenizeShareRecord returns the binary encoding of a tokenize share
+// record or panics
+func MustMarshalTokenizeShareRecord(cdc codec.BinaryCodec, record *TokenizeShareRecord) []byte {
+	return cdc.MustMarshal(record)
+}
+
+// MustUnmarshalTokenizeShareRecord unmarshals a tokenize share record from a
+// store value or panics
+func MustUnmarshalTokenizeShareRecord(cdc codec.BinaryCodec, value []byte) TokenizeShareRecord {
+	var record TokenizeShareRecord
+	cdc.MustUnmarshal(value, &record)
+
+	return record
+}
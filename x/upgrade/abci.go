@@ -35,8 +35,8 @@ func BeginBlocker(k keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 			skipUpgradeMsg := fmt.Sprintf("UPGRADE \"%s\" SKIPPED at %d: %s", plan.Name, plan.Height, plan.Info)
 			logger.Info(skipUpgradeMsg)
 
-			// Clear the upgrade plan at current height
-			k.ClearUpgradePlan(ctx)
+			// Record the skipped upgrade and clear the upgrade plan at current height
+			k.SkipUpgrade(ctx, plan)
 			return
 		}
 
@@ -49,6 +49,16 @@ func BeginBlocker(k keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 				panic(fmt.Errorf("unable to write upgrade info to filesystem: %s", err.Error()))
 			}
 
+			// The verification is local to the node, it only warns the operator.
+			if err := k.VerifyPlanInfo(plan); err != nil {
+				logger.Error("upgrade binaries are not verified, check them before running them", "upgrade", plan.Name, "err", err)
+			}
+
+			// The node halts below, so the export does not delay block execution.
+			if err := k.ExportPreUpgradeState(ctx, plan); err != nil {
+				logger.Error("failed to export state before upgrade", "upgrade", plan.Name, "err", err)
+			}
+
 			upgradeMsg := BuildUpgradeNeededMsg(plan)
 			logger.Error(upgradeMsg)
 			panic(upgradeMsg)
@@ -61,6 +71,8 @@ func BeginBlocker(k keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 		return
 	}
 
+	k.SchedulePreUpgradeSnapshot(ctx, plan)
+
 	// if we have a pending upgrade, but it is not yet time, make sure we did not
 	// set the handler already
 	if k.HasHandler(plan.Name) {
@@ -1,6 +1,7 @@
 package upgrade_test
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -285,6 +286,12 @@ func TestSkipUpgradeSkippingAll(t *testing.T) {
 	VerifyCleared(t, s.ctx)
 	VerifyNotDone(t, s.ctx, "test")
 	VerifyNotDone(t, s.ctx, "test2")
+
+	t.Log("Verify both skipped upgrades are recorded")
+	require.Equal(t, []*types.SkippedUpgrade{
+		{Name: "test", Height: skipOne},
+		{Name: "test2", Height: skipTwo},
+	}, s.keeper.GetSkippedUpgrades(s.ctx))
 }
 
 func TestUpgradeSkippingOne(t *testing.T) {
@@ -410,3 +417,49 @@ func TestDumpUpgradeInfoToFile(t *testing.T) {
 	err = os.Remove(upgradeInfoFilePath)
 	require.Nil(err)
 }
+
+type mockSnapshotScheduler struct {
+	heights []int64
+}
+
+func (m *mockSnapshotScheduler) ScheduleSnapshot(height int64) {
+	m.heights = append(m.heights, height)
+}
+
+func TestPreUpgradeSnapshotAndExport(t *testing.T) {
+	app := simapp.Setup(t, false)
+	homeDir := t.TempDir()
+	k := keeper.NewKeeper(map[int64]bool{}, app.GetKey(types.StoreKey), app.AppCodec(), homeDir, app.BaseApp)
+
+	scheduler := &mockSnapshotScheduler{}
+	k.SetPreUpgradeSnapshotScheduler(scheduler)
+	exports := 0
+	k.SetPreUpgradeExporter(func(ctx sdk.Context) (json.RawMessage, error) {
+		exports++
+		return json.RawMessage(`{"height":"9"}`), nil
+	})
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 8, Time: time.Now()})
+	require.NoError(t, k.ScheduleUpgrade(ctx, types.Plan{Name: "test", Height: 10}))
+
+	// only the last height before the upgrade is snapshotted
+	upgrade.BeginBlocker(k, ctx, abci.RequestBeginBlock{})
+	require.Empty(t, scheduler.heights)
+	upgrade.BeginBlocker(k, ctx.WithBlockHeight(9), abci.RequestBeginBlock{})
+	require.Equal(t, []int64{9}, scheduler.heights)
+
+	// the state is exported by the binary halting for the upgrade
+	require.Panics(t, func() {
+		upgrade.BeginBlocker(k, ctx.WithBlockHeight(10), abci.RequestBeginBlock{})
+	})
+	require.Equal(t, 1, exports)
+
+	exportPath, err := k.GetPreUpgradeExportPath(9)
+	require.NoError(t, err)
+	bz, err := os.ReadFile(exportPath)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"height":"9"}`, string(bz))
+
+	// an existing export is kept
+	require.NoError(t, k.ExportPreUpgradeState(ctx.WithBlockHeight(10), types.Plan{Name: "test", Height: 10}))
+	require.Equal(t, 1, exports)
+}
@@ -0,0 +1,200 @@
+package cli
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const (
+	FlagBinary = "binary"
+	FlagUpload = "upload"
+)
+
+// Uploader uploads a binary artifact so that it can be downloaded by the nodes
+// running cosmovisor, e.g. to an object storage bucket or a release page.
+type Uploader interface {
+	// Upload stores the content of r under the given name and returns the URL
+	// it can be downloaded from.
+	Upload(ctx context.Context, name string, r io.Reader) (string, error)
+}
+
+// NewCmdUpgrade returns the upgrade tooling commands, which do not query nor
+// send txs to a node. The uploader is used by gen-plan-info with --upload and
+// may be nil.
+func NewCmdUpgrade(uploader Uploader) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "Upgrade tooling subcommands",
+	}
+
+	cmd.AddCommand(NewCmdGenPlanInfo(uploader))
+
+	return cmd
+}
+
+// NewCmdGenPlanInfo returns a command generating the plan info of a software
+// upgrade proposal in the format understood by cosmovisor.
+func NewCmdGenPlanInfo(uploader Uploader) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gen-plan-info --binary [os/arch]=[path-or-url]...",
+		Args:  cobra.NoArgs,
+		Short: "Generate the plan info of a software upgrade with the binaries checksums",
+		Long: `Generate the plan info of a software upgrade, to be passed to --upgrade-info, listing
+the download URL of the upgraded binary for each platform along with its sha256 checksum,
+so that cosmovisor can download and verify the binaries.
+
+Binaries can be given as URLs, which are downloaded to compute their checksum, or as local
+paths. Local binaries are referenced by their file:// URL, unless --upload is set, in which
+case they are uploaded and referenced by their uploaded URL.`,
+		Example: fmt.Sprintf(`$ %s upgrade gen-plan-info --binary linux/amd64=https://example.com/%[1]s-linux-amd64 --binary darwin/arm64=./build/%[1]s-darwin-arm64 --upload`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			binaryFlags, err := cmd.Flags().GetStringArray(FlagBinary)
+			if err != nil {
+				return err
+			}
+
+			binaries := make(map[string]string, len(binaryFlags))
+			for _, b := range binaryFlags {
+				kv := strings.SplitN(b, "=", 2)
+				if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+					return fmt.Errorf("invalid binary %q, expected os/arch=path-or-url", b)
+				}
+				if _, ok := binaries[kv[0]]; ok {
+					return fmt.Errorf("duplicate binary for platform %s", kv[0])
+				}
+				binaries[kv[0]] = kv[1]
+			}
+
+			upload, _ := cmd.Flags().GetBool(FlagUpload)
+			if !upload {
+				uploader = nil
+			} else if uploader == nil {
+				return fmt.Errorf("--%s is not supported: no uploader is configured", FlagUpload)
+			}
+
+			info, err := GeneratePlanInfo(cmd.Context(), binaries, uploader)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(info.String())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArray(FlagBinary, nil, "Binary of a platform as os/arch=path-or-url, where os/arch may be \"any\" (repeatable)")
+	cmd.Flags().Bool(FlagUpload, false, "Upload the local binaries with the configured uploader")
+	_ = cmd.MarkFlagRequired(FlagBinary)
+
+	return cmd
+}
+
+// GeneratePlanInfo computes the checksum of the binaries, given by platform as
+// URLs or local paths, and returns the plan info referencing them. Local
+// binaries are uploaded if uploader is not nil.
+func GeneratePlanInfo(ctx context.Context, binaries map[string]string, uploader Uploader) (types.PlanInfo, error) {
+	info := types.PlanInfo{Binaries: make(map[string]string, len(binaries))}
+
+	for platform, source := range binaries {
+		var (
+			binaryURL string
+			sum       []byte
+			err       error
+		)
+
+		if isRemoteBinary(source) {
+			binaryURL = source
+			sum, err = remoteChecksum(ctx, source)
+		} else {
+			binaryURL, sum, err = localBinary(ctx, platform, source, uploader)
+		}
+		if err != nil {
+			return info, fmt.Errorf("binary of %s: %w", platform, err)
+		}
+
+		if info.Binaries[platform], err = types.BinaryURLWithChecksum(binaryURL, sum); err != nil {
+			return info, fmt.Errorf("binary of %s: %w", platform, err)
+		}
+	}
+
+	return info, info.ValidateBasic()
+}
+
+func isRemoteBinary(source string) bool {
+	return strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+}
+
+func remoteChecksum(ctx context.Context, binaryURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, binaryURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s: %s", binaryURL, resp.Status)
+	}
+
+	return checksum(resp.Body)
+}
+
+// localBinary returns the URL and the checksum of a local binary, uploading it
+// if uploader is not nil.
+func localBinary(ctx context.Context, platform, path string, uploader Uploader) (string, []byte, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	f, err := os.Open(absPath)
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+
+	sum, err := checksum(f)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if uploader == nil {
+		return "file://" + filepath.ToSlash(absPath), sum, nil
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", nil, err
+	}
+
+	name := fmt.Sprintf("%s-%s", filepath.Base(absPath), strings.ReplaceAll(platform, "/", "-"))
+	binaryURL, err := uploader.Upload(ctx, name, f)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to upload %s: %w", path, err)
+	}
+
+	return binaryURL, sum, nil
+}
+
+func checksum(r io.Reader) ([]byte, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
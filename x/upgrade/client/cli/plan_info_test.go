@@ -0,0 +1,72 @@
+package cli_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/upgrade/client/cli"
+)
+
+type mockUploader struct {
+	uploaded map[string][]byte
+}
+
+func (u *mockUploader) Upload(_ context.Context, name string, r io.Reader) (string, error) {
+	bz, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	u.uploaded[name] = bz
+
+	return "https://example.com/releases/" + name, nil
+}
+
+func TestGeneratePlanInfo(t *testing.T) {
+	localBinary := []byte("local binary")
+	localPath := filepath.Join(t.TempDir(), "simd")
+	require.NoError(t, os.WriteFile(localPath, localBinary, 0o755))
+
+	remoteBinary := []byte("remote binary")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/simd" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(remoteBinary)
+	}))
+	defer srv.Close()
+
+	localSum := fmt.Sprintf("%x", sha256.Sum256(localBinary))
+	remoteSum := fmt.Sprintf("%x", sha256.Sum256(remoteBinary))
+
+	info, err := cli.GeneratePlanInfo(context.Background(), map[string]string{
+		"linux/amd64":  localPath,
+		"darwin/arm64": srv.URL + "/simd",
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"linux/amd64":  "file://" + filepath.ToSlash(localPath) + "?checksum=sha256:" + localSum,
+		"darwin/arm64": srv.URL + "/simd?checksum=sha256:" + remoteSum,
+	}, info.Binaries)
+
+	uploader := &mockUploader{uploaded: make(map[string][]byte)}
+	info, err = cli.GeneratePlanInfo(context.Background(), map[string]string{"linux/amd64": localPath}, uploader)
+	require.NoError(t, err)
+	require.Equal(t, `{"binaries":{"linux/amd64":"https://example.com/releases/simd-linux-amd64?checksum=sha256:`+localSum+`"}}`, info.String())
+	require.Equal(t, localBinary, uploader.uploaded["simd-linux-amd64"])
+
+	_, err = cli.GeneratePlanInfo(context.Background(), map[string]string{"linux/amd64": srv.URL + "/missing"}, nil)
+	require.Error(t, err)
+
+	_, err = cli.GeneratePlanInfo(context.Background(), map[string]string{"linux": localPath}, nil)
+	require.Error(t, err)
+}
@@ -22,6 +22,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
 		GetModuleVersionsCmd(),
+		GetSkippedUpgradesCmd(),
+		GetVersionDiffCmd(),
 	)
 
 	return cmd
@@ -154,3 +156,70 @@ func GetModuleVersionsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetSkippedUpgradesCmd returns the list of upgrade plans skipped with
+// --unsafe-skip-upgrades
+func GetSkippedUpgradesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "skipped_upgrades [optional name]",
+		Short: "get the list of skipped upgrade plans",
+		Long: "Gets the list of upgrade plans which were skipped with --unsafe-skip-upgrades,\n" +
+			"whose migrations were not applied. Following the command with a specific\n" +
+			"upgrade name will return only that upgrade's information.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.QuerySkippedUpgradesRequest{}
+			if len(args) == 1 {
+				params.Name = args[0]
+			}
+
+			res, err := queryClient.SkippedUpgrades(cmd.Context(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetVersionDiffCmd returns the modules whose consensus version on chain differs
+// from the running binary of the queried node
+func GetVersionDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "version_diff",
+		Short: "compare the module versions on chain to the node binary",
+		Long: "Gets the list of modules whose consensus version stored on chain differs from\n" +
+			"the consensus version of the binary run by the queried node. An empty list means\n" +
+			"the binary matches the chain state.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.VersionDiff(cmd.Context(), &types.QueryVersionDiffRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -5,3 +5,9 @@ package exported
 type ProtocolVersionSetter interface {
 	SetProtocolVersion(uint64)
 }
+
+// SnapshotScheduler defines the interface fulfilled by BaseApp which allows
+// requesting a state sync snapshot at a given height.
+type SnapshotScheduler interface {
+	ScheduleSnapshot(height int64)
+}
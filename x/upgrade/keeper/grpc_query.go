@@ -70,3 +70,29 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 		ModuleVersions: mv,
 	}, nil
 }
+
+// SkippedUpgrades implements the Query/SkippedUpgrades gRPC method
+func (k Keeper) SkippedUpgrades(c context.Context, req *types.QuerySkippedUpgradesRequest) (*types.QuerySkippedUpgradesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if len(req.Name) > 0 {
+		skipped, ok := k.GetSkippedUpgrade(ctx, req.Name)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrNotFound, "x/upgrade: QuerySkippedUpgrades upgrade %s not found", req.Name)
+		}
+		return &types.QuerySkippedUpgradesResponse{SkippedUpgrades: []*types.SkippedUpgrade{&skipped}}, nil
+	}
+
+	return &types.QuerySkippedUpgradesResponse{
+		SkippedUpgrades: k.GetSkippedUpgrades(ctx),
+	}, nil
+}
+
+// VersionDiff implements the Query/VersionDiff gRPC method
+func (k Keeper) VersionDiff(c context.Context, req *types.QueryVersionDiffRequest) (*types.QueryVersionDiffResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryVersionDiffResponse{
+		Diffs: k.GetModuleVersionDiffs(ctx),
+	}, nil
+}
@@ -203,6 +203,56 @@ func (suite *UpgradeTestSuite) TestModuleVersions() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestSkippedUpgrades() {
+	res, err := suite.queryClient.SkippedUpgrades(gocontext.Background(), &types.QuerySkippedUpgradesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.SkippedUpgrades)
+
+	plans := []types.Plan{
+		{Name: "skipped-b", Height: 20, Info: "info-b"},
+		{Name: "skipped-a", Height: 10},
+	}
+	for _, plan := range plans {
+		suite.app.UpgradeKeeper.SkipUpgrade(suite.ctx, plan)
+	}
+
+	res, err = suite.queryClient.SkippedUpgrades(gocontext.Background(), &types.QuerySkippedUpgradesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SkippedUpgrade{
+		{Name: "skipped-a", Height: 10},
+		{Name: "skipped-b", Height: 20, Info: "info-b"},
+	}, res.SkippedUpgrades)
+
+	res, err = suite.queryClient.SkippedUpgrades(gocontext.Background(), &types.QuerySkippedUpgradesRequest{Name: "skipped-b"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SkippedUpgrade{{Name: "skipped-b", Height: 20, Info: "info-b"}}, res.SkippedUpgrades)
+
+	_, err = suite.queryClient.SkippedUpgrades(gocontext.Background(), &types.QuerySkippedUpgradesRequest{Name: "unknown"})
+	suite.Require().Error(err)
+}
+
+func (suite *UpgradeTestSuite) TestVersionDiff() {
+	// the stored versions match the binary after InitChain
+	res, err := suite.queryClient.VersionDiff(gocontext.Background(), &types.QueryVersionDiffRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Diffs)
+
+	binaryVersions := suite.app.UpgradeKeeper.GetModuleVersionMap(suite.ctx)
+	suite.app.UpgradeKeeper.SetModuleVersionMap(suite.ctx, module.VersionMap{
+		"bank":    binaryVersions["bank"] + 1,
+		"removed": 1,
+	})
+	suite.app.UpgradeKeeper.SetBinaryModuleVersions(module.VersionMap{"added": 2})
+
+	res, err = suite.queryClient.VersionDiff(gocontext.Background(), &types.QueryVersionDiffRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.ModuleVersionDiff{
+		{Name: "added", OnChainVersion: 0, BinaryVersion: 2},
+		{Name: "bank", OnChainVersion: binaryVersions["bank"] + 1, BinaryVersion: binaryVersions["bank"]},
+		{Name: "removed", OnChainVersion: 1, BinaryVersion: 0},
+	}, res.Diffs)
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
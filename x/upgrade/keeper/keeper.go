@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/binary"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path"
 	"path/filepath"
@@ -13,6 +14,7 @@ import (
 	tmos "github.com/tendermint/tendermint/libs/os"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -32,6 +34,11 @@ type Keeper struct {
 	cdc                codec.BinaryCodec               // App-wide binary codec
 	upgradeHandlers    map[string]types.UpgradeHandler // map of plan name to upgrade handler
 	versionSetter      xp.ProtocolVersionSetter        // implements setting the protocol version field on BaseApp
+	snapshotScheduler  xp.SnapshotScheduler            // requests state sync snapshots from BaseApp before an upgrade, if set
+	stateExporter      types.StateExporter             // exports the application state before an upgrade, if set
+	binaryVersions     module.VersionMap               // module consensus versions of the running binary
+	planInfoKeys       []cryptotypes.PubKey            // keys trusted to sign the binaries of the plan infos, if set
+	planInfoThreshold  int                             // number of trusted keys required to sign the binaries of a plan info
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -48,9 +55,57 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey storetypes.StoreKey,
 		cdc:                cdc,
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
 		versionSetter:      vs,
+		binaryVersions:     module.VersionMap{},
 	}
 }
 
+// SetBinaryModuleVersions sets the module consensus versions of the running
+// binary, usually module.Manager.GetVersionMap, which are compared to the
+// versions stored on chain by the VersionDiff query.
+func (k Keeper) SetBinaryModuleVersions(vm module.VersionMap) {
+	for name, version := range vm {
+		k.binaryVersions[name] = version
+	}
+}
+
+// SetPreUpgradeSnapshotScheduler enables taking a state sync snapshot of the
+// last height before a scheduled upgrade, so that the node can be restored to
+// that height if the upgrade fails.
+func (k *Keeper) SetPreUpgradeSnapshotScheduler(s xp.SnapshotScheduler) {
+	k.snapshotScheduler = s
+}
+
+// SetPlanInfoTrustedKeys sets the keys VerifyPlanInfo requires at least
+// threshold of to sign the binaries of the inline plan infos, see
+// PlanInfo.ValidateFull. The keys are local to the node and never affect the
+// validity of the upgrade plans.
+func (k *Keeper) SetPlanInfoTrustedKeys(keys []cryptotypes.PubKey, threshold int) {
+	k.planInfoKeys = keys
+	k.planInfoThreshold = threshold
+}
+
+// VerifyPlanInfo verifies that the binaries of the inline plan info of the given
+// plan have checksums and are signed by the keys set with SetPlanInfoTrustedKeys.
+// It returns nil if no key is set or if the plan info is not inline.
+func (k Keeper) VerifyPlanInfo(plan types.Plan) error {
+	info, ok := types.ParsePlanInfo(plan.Info)
+	if !ok || len(k.planInfoKeys) == 0 {
+		return nil
+	}
+
+	if err := info.ValidateFull(k.planInfoKeys, k.planInfoThreshold); err != nil {
+		return sdkerrors.Wrap(err, "invalid plan info")
+	}
+
+	return nil
+}
+
+// SetPreUpgradeExporter enables exporting the application state of the last
+// height before a scheduled upgrade to the data directory of the node.
+func (k *Keeper) SetPreUpgradeExporter(exporter types.StateExporter) {
+	k.stateExporter = exporter
+}
+
 // SetUpgradeHandler sets an UpgradeHandler for the upgrade specified by name. This handler will be called when the upgrade
 // with this name is applied. In order for an upgrade with the given name to proceed, a handler for this upgrade
 // must be set even if it is a no-op function.
@@ -143,6 +198,37 @@ func (k Keeper) GetModuleVersions(ctx sdk.Context) []*types.ModuleVersion {
 	return mv
 }
 
+// GetModuleVersionDiffs returns the modules whose consensus version stored on
+// chain differs from the consensus version of the running binary, ordered by
+// module name.
+func (k Keeper) GetModuleVersionDiffs(ctx sdk.Context) []*types.ModuleVersionDiff {
+	onChain := k.GetModuleVersionMap(ctx)
+
+	names := make([]string, 0, len(onChain)+len(k.binaryVersions))
+	for name := range onChain {
+		names = append(names, name)
+	}
+	for name := range k.binaryVersions {
+		if _, ok := onChain[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var diffs []*types.ModuleVersionDiff
+	for _, name := range names {
+		if onChain[name] != k.binaryVersions[name] {
+			diffs = append(diffs, &types.ModuleVersionDiff{
+				Name:           name,
+				OnChainVersion: onChain[name],
+				BinaryVersion:  k.binaryVersions[name],
+			})
+		}
+	}
+
+	return diffs
+}
+
 // gets the version for a given module, and returns true if it exists, false otherwise
 func (k Keeper) getModuleVersion(ctx sdk.Context, name string) (uint64, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -238,6 +324,45 @@ func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
 	return int64(binary.BigEndian.Uint64(bz))
 }
 
+// setSkipped records that the given upgrade plan was skipped with
+// --unsafe-skip-upgrades, so that later upgrades can reconcile the migrations
+// it bypassed.
+func (k Keeper) setSkipped(ctx sdk.Context, plan types.Plan) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.SkippedByte})
+	skipped := types.SkippedUpgrade{Name: plan.Name, Height: plan.Height, Info: plan.Info}
+	store.Set([]byte(plan.Name), k.cdc.MustMarshal(&skipped))
+}
+
+// GetSkippedUpgrade returns the skipped upgrade plan of the given name, if any.
+func (k Keeper) GetSkippedUpgrade(ctx sdk.Context, name string) (types.SkippedUpgrade, bool) {
+	var skipped types.SkippedUpgrade
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.SkippedByte})
+	bz := store.Get([]byte(name))
+	if bz == nil {
+		return skipped, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &skipped)
+	return skipped, true
+}
+
+// GetSkippedUpgrades returns all the skipped upgrade plans, ordered by name.
+func (k Keeper) GetSkippedUpgrades(ctx sdk.Context) []*types.SkippedUpgrade {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.SkippedByte})
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	var skipped []*types.SkippedUpgrade
+	for ; it.Valid(); it.Next() {
+		var s types.SkippedUpgrade
+		k.cdc.MustUnmarshal(it.Value(), &s)
+		skipped = append(skipped, &s)
+	}
+
+	return skipped
+}
+
 // ClearIBCState clears any planned IBC state
 func (k Keeper) ClearIBCState(ctx sdk.Context, lastHeight int64) {
 	// delete IBC client and consensus state from store if this is IBC plan
@@ -319,6 +444,13 @@ func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 	k.setDone(ctx, plan.Name)
 }
 
+// SkipUpgrade clears the Plan without executing its handler and records it as
+// skipped, so that the migrations it bypassed can be queried.
+func (k Keeper) SkipUpgrade(ctx sdk.Context, plan types.Plan) {
+	k.ClearUpgradePlan(ctx)
+	k.setSkipped(ctx, plan)
+}
+
 // IsSkipHeight checks if the given height is part of skipUpgradeHeights
 func (k Keeper) IsSkipHeight(height int64) bool {
 	return k.skipUpgradeHeights[height]
@@ -344,6 +476,63 @@ func (k Keeper) DumpUpgradeInfoToDisk(height int64, p types.Plan) error {
 	return os.WriteFile(upgradeInfoFilePath, info, 0600)
 }
 
+// SchedulePreUpgradeSnapshot requests a state sync snapshot of the current
+// height if it is the last height before the given plan is executed and a
+// snapshot scheduler is set.
+func (k Keeper) SchedulePreUpgradeSnapshot(ctx sdk.Context, plan types.Plan) {
+	if k.snapshotScheduler == nil || ctx.BlockHeight() != plan.Height-1 || k.IsSkipHeight(plan.Height) {
+		return
+	}
+
+	k.Logger(ctx).Info("scheduling state sync snapshot before upgrade", "upgrade", plan.Name, "height", ctx.BlockHeight())
+	k.snapshotScheduler.ScheduleSnapshot(ctx.BlockHeight())
+}
+
+// ExportPreUpgradeState writes the application state to the data directory if
+// a state exporter is set. It is called by the binary halting for the upgrade,
+// at the upgrade height, so that the exported state is the one committed at the
+// last height before the upgrade and the export does not delay block execution.
+// An existing export is not overwritten.
+func (k Keeper) ExportPreUpgradeState(ctx sdk.Context, plan types.Plan) error {
+	if k.stateExporter == nil {
+		return nil
+	}
+
+	exportPath, err := k.GetPreUpgradeExportPath(plan.Height - 1)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(exportPath); err == nil {
+		return nil
+	}
+
+	// the export must not alter the state of the upgrade block
+	cacheCtx, _ := ctx.CacheContext()
+	state, err := k.stateExporter(cacheCtx)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(exportPath, state, 0600); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("exported state before upgrade", "upgrade", plan.Name, "path", exportPath)
+	return nil
+}
+
+// GetPreUpgradeExportPath returns the path of the application state exported
+// at the given height before an upgrade.
+func (k Keeper) GetPreUpgradeExportPath(height int64) (string, error) {
+	exportDir := path.Join(k.getHomeDir(), "data")
+	if err := tmos.EnsureDir(exportDir, os.ModePerm); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(exportDir, fmt.Sprintf(types.PreUpgradeExportFilenameFmt, height)), nil
+}
+
 // GetUpgradeInfoPath returns the upgrade info file path
 func (k Keeper) GetUpgradeInfoPath() (string, error) {
 	upgradeInfoFileDir := path.Join(k.getHomeDir(), "data")
@@ -5,9 +5,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -57,7 +60,14 @@ func (s *KeeperTestSuite) TestReadUpgradeInfoFromDisk() {
 	s.Require().Equal(expected, ui)
 }
 
+func signedPlanInfo(t *testing.T, key cryptotypes.PrivKey) string {
+	info := types.PlanInfo{Binaries: map[string]string{"linux/amd64": "https://example.com/simd?checksum=sha256:abcd"}}
+	require.NoError(t, info.Sign(key))
+	return info.String()
+}
+
 func (s *KeeperTestSuite) TestScheduleUpgrade() {
+	trustedKey := secp256k1.GenPrivKey()
 	cases := []struct {
 		name    string
 		plan    types.Plan
@@ -108,6 +118,18 @@ func (s *KeeperTestSuite) TestScheduleUpgrade() {
 			setup:   func() {},
 			expPass: false,
 		},
+		{
+			name: "successful schedule: plan info not signed by a trusted key",
+			plan: types.Plan{
+				Name:   "all-good",
+				Info:   signedPlanInfo(s.T(), secp256k1.GenPrivKey()),
+				Height: 123450000,
+			},
+			setup: func() {
+				s.app.UpgradeKeeper.SetPlanInfoTrustedKeys([]cryptotypes.PubKey{trustedKey.PubKey()}, 1)
+			},
+			expPass: true,
+		},
 		{
 			name: "unsuccessful schedule: schedule already executed",
 			plan: types.Plan{
@@ -150,6 +172,24 @@ func (s *KeeperTestSuite) TestScheduleUpgrade() {
 	}
 }
 
+func (s *KeeperTestSuite) TestVerifyPlanInfo() {
+	trustedKey := secp256k1.GenPrivKey()
+	signed := types.Plan{Name: "signed", Info: signedPlanInfo(s.T(), trustedKey), Height: 123450000}
+	untrusted := types.Plan{Name: "untrusted", Info: signedPlanInfo(s.T(), secp256k1.GenPrivKey()), Height: 123450000}
+	noChecksum := types.Plan{Name: "no-checksum", Info: `{"binaries":{"linux/amd64":"https://example.com/simd"}}`, Height: 123450000}
+	text := types.Plan{Name: "text", Info: "free text", Height: 123450000}
+
+	// nothing is verified without trusted keys
+	s.Require().NoError(s.app.UpgradeKeeper.VerifyPlanInfo(untrusted))
+	s.Require().NoError(s.app.UpgradeKeeper.VerifyPlanInfo(noChecksum))
+
+	s.app.UpgradeKeeper.SetPlanInfoTrustedKeys([]cryptotypes.PubKey{trustedKey.PubKey()}, 1)
+	s.Require().NoError(s.app.UpgradeKeeper.VerifyPlanInfo(signed))
+	s.Require().NoError(s.app.UpgradeKeeper.VerifyPlanInfo(text))
+	s.Require().Error(s.app.UpgradeKeeper.VerifyPlanInfo(untrusted))
+	s.Require().Error(s.app.UpgradeKeeper.VerifyPlanInfo(noChecksum))
+}
+
 func (s *KeeperTestSuite) TestSetUpgradedClient() {
 	cs := []byte("IBC client state")
 
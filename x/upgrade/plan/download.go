@@ -0,0 +1,276 @@
+// Package plan implements the download of the binaries referenced by the info
+// of an upgrade plan.
+package plan
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// partSuffix is appended to the destination path of a download in progress.
+const partSuffix = ".part"
+
+// PartialPath returns the path of the partial download of dstPath, which is
+// resumed by the next download to dstPath.
+func PartialPath(dstPath string) string {
+	return dstPath + partSuffix
+}
+
+// DownloadConfig configures the download of upgrade binaries.
+type DownloadConfig struct {
+	// Timeout of each download attempt, 0 for no timeout.
+	Timeout time.Duration
+	// Retries is the number of attempts per URL before falling back to the
+	// next mirror. Each attempt resumes the download of the previous one.
+	Retries int
+	// ProxyURL is the proxy of the HTTP requests. If empty, the proxy is read
+	// from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+	ProxyURL string
+	// TrustedKeys are the keys expected to sign the plan info binaries. If not
+	// empty, DownloadUpgrade requires SignatureThreshold valid signatures.
+	TrustedKeys        []cryptotypes.PubKey
+	SignatureThreshold int
+}
+
+// DefaultDownloadConfig returns the default DownloadConfig.
+func DefaultDownloadConfig() DownloadConfig {
+	return DownloadConfig{
+		Timeout: 10 * time.Minute,
+		Retries: 3,
+	}
+}
+
+// DownloadUpgrade downloads the binary of the current platform referenced by
+// the plan info to dstPath, trying its mirrors in order. If cfg has trusted
+// keys, the plan info is checked with ValidateFull first.
+func DownloadUpgrade(ctx context.Context, info types.PlanInfo, dstPath string, cfg DownloadConfig) error {
+	validate := info.ValidateBasic
+	if len(cfg.TrustedKeys) > 0 {
+		validate = func() error { return info.ValidateFull(cfg.TrustedKeys, cfg.SignatureThreshold) }
+	}
+	if err := validate(); err != nil {
+		return err
+	}
+
+	urls := info.URLs(types.CurrentPlatform())
+	if len(urls) == 0 {
+		return fmt.Errorf("no binary found for %s nor %s", types.CurrentPlatform(), types.PlanInfoAnyPlatform)
+	}
+
+	return DownloadURLs(ctx, urls, dstPath, cfg)
+}
+
+// DownloadURLs downloads a binary to dstPath from the first of urls which
+// succeeds, and makes it executable. The http, https and file schemes are
+// supported. The sha256 or sha512 checksum given by the checksum query
+// parameter of a URL, e.g. ?checksum=sha256:<hex>, is verified.
+func DownloadURLs(ctx context.Context, urls []string, dstPath string, cfg DownloadConfig) error {
+	client, err := newHTTPClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	retries := cfg.Retries
+	if retries < 1 {
+		retries = 1
+	}
+
+	var errs []string
+	for _, rawURL := range urls {
+		for attempt := 1; attempt <= retries; attempt++ {
+			err = downloadAttempt(ctx, client, rawURL, dstPath, cfg.Timeout)
+			if err == nil {
+				return os.Chmod(dstPath, 0o755)
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			errs = append(errs, fmt.Sprintf("%s (attempt %d): %s", rawURL, attempt, err))
+		}
+
+		// a partial download of a mirror cannot be resumed from another one
+		_ = os.Remove(PartialPath(dstPath))
+	}
+
+	return fmt.Errorf("failed to download binary:\n%s", strings.Join(errs, "\n"))
+}
+
+func newHTTPClient(cfg DownloadConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.ProxyURL != "" {
+		proxy, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	return &http.Client{Transport: transport}, nil
+}
+
+// downloadAttempt downloads rawURL to dstPath, resuming a previous partial
+// download, and verifies its checksum.
+func downloadAttempt(ctx context.Context, client *http.Client, rawURL, dstPath string, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	q := u.Query()
+	checksum := q.Get("checksum")
+	q.Del("checksum")
+	u.RawQuery = q.Encode()
+
+	partPath := PartialPath(dstPath)
+	switch u.Scheme {
+	case "http", "https":
+		err = downloadHTTP(ctx, client, u.String(), partPath)
+	case "file":
+		err = copyFile(u.Path, partPath)
+	default:
+		err = fmt.Errorf("unsupported url scheme %q", u.Scheme)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := verifyChecksum(partPath, checksum); err != nil {
+		// the partial download is corrupted, restart it on the next attempt
+		_ = os.Remove(partPath)
+		return err
+	}
+
+	return os.Rename(partPath, dstPath)
+}
+
+// downloadHTTP appends the content of rawURL to the file at path, requesting
+// only the missing bytes if the file already exists.
+func downloadHTTP(ctx context.Context, client *http.Client, rawURL, path string) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		// resume the download at the end of the file
+	case http.StatusOK:
+		// the server ignored the range, restart the download
+		if err := f.Truncate(0); err != nil {
+			return err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		// the file was already fully downloaded
+		return nil
+	default:
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+
+	_, err = io.Copy(f, resp.Body)
+	return err
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
+
+// verifyChecksum checks the file at path against a checksum of the form
+// <sha256|sha512>:<hex>. An empty checksum is not verified.
+func verifyChecksum(path, checksum string) error {
+	if checksum == "" {
+		return nil
+	}
+
+	parts := strings.SplitN(checksum, ":", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid checksum %q", checksum)
+	}
+
+	var h hash.Hash
+	switch parts[0] {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return fmt.Errorf("unsupported checksum type %q", parts[0])
+	}
+
+	expected, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return fmt.Errorf("invalid checksum %q: %w", checksum, err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+
+	if actual := h.Sum(nil); !bytes.Equal(actual, expected) {
+		return fmt.Errorf("checksum mismatch: expected %s, got %x", parts[1], actual)
+	}
+
+	return nil
+}
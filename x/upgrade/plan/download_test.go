@@ -0,0 +1,109 @@
+package plan
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func TestDownloadURLs(t *testing.T) {
+	binary := bytes.Repeat([]byte("upgraded binary "), 1024)
+	checksum := fmt.Sprintf("checksum=sha256:%x", sha256.Sum256(binary))
+
+	var ranges []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/binary":
+			ranges = append(ranges, r.Header.Get("Range"))
+			http.ServeContent(w, r, "binary", time.Time{}, bytes.NewReader(binary))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	cfg := DownloadConfig{Timeout: time.Minute, Retries: 2}
+
+	t.Run("mirror fallback", func(t *testing.T) {
+		dst := filepath.Join(t.TempDir(), "simd")
+		err := DownloadURLs(context.Background(), []string{
+			srv.URL + "/missing?" + checksum,
+			srv.URL + "/binary?" + checksum,
+		}, dst, cfg)
+		require.NoError(t, err)
+
+		bz, err := os.ReadFile(dst)
+		require.NoError(t, err)
+		require.Equal(t, binary, bz)
+
+		fi, err := os.Stat(dst)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o755), fi.Mode().Perm())
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		ranges = nil
+		dst := filepath.Join(t.TempDir(), "simd")
+		require.NoError(t, os.WriteFile(dst+partSuffix, binary[:100], 0o644))
+
+		require.NoError(t, DownloadURLs(context.Background(), []string{srv.URL + "/binary?" + checksum}, dst, cfg))
+		require.Equal(t, []string{"bytes=100-"}, ranges)
+
+		bz, err := os.ReadFile(dst)
+		require.NoError(t, err)
+		require.Equal(t, binary, bz)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		dst := filepath.Join(t.TempDir(), "simd")
+		err := DownloadURLs(context.Background(), []string{srv.URL + "/binary?checksum=sha256:0000"}, dst, cfg)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "checksum mismatch")
+		require.NoFileExists(t, dst)
+		require.NoFileExists(t, dst+partSuffix)
+	})
+
+	t.Run("file url", func(t *testing.T) {
+		src := filepath.Join(t.TempDir(), "src")
+		require.NoError(t, os.WriteFile(src, binary, 0o644))
+
+		dst := filepath.Join(t.TempDir(), "simd")
+		require.NoError(t, DownloadURLs(context.Background(), []string{"file://" + src + "?" + checksum}, dst, cfg))
+	})
+
+	t.Run("plan info", func(t *testing.T) {
+		info := types.PlanInfo{
+			Binaries: map[string]string{types.PlanInfoAnyPlatform: srv.URL + "/missing"},
+			Mirrors:  map[string][]string{types.PlanInfoAnyPlatform: {srv.URL + "/binary?" + checksum}},
+		}
+		require.NoError(t, info.ValidateBasic())
+
+		dst := filepath.Join(t.TempDir(), "simd")
+		require.NoError(t, DownloadUpgrade(context.Background(), info, dst, cfg))
+
+		// unsigned plan info are rejected if trusted keys are configured
+		priv := secp256k1.GenPrivKey()
+		signedCfg := cfg
+		signedCfg.TrustedKeys = []cryptotypes.PubKey{priv.PubKey()}
+		signedCfg.SignatureThreshold = 1
+
+		info.Binaries[types.PlanInfoAnyPlatform] = srv.URL + "/missing?" + checksum
+		require.Error(t, DownloadUpgrade(context.Background(), info, filepath.Join(t.TempDir(), "simd"), signedCfg))
+
+		require.NoError(t, info.Sign(priv))
+		require.NoError(t, DownloadUpgrade(context.Background(), info, filepath.Join(t.TempDir(), "simd"), signedCfg))
+	})
+}
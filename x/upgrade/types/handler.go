@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
@@ -24,3 +26,8 @@ import (
 //
 // Please also refer to docs/core/upgrade.md for more information.
 type UpgradeHandler func(ctx sdk.Context, plan Plan, fromVM module.VersionMap) (module.VersionMap, error)
+
+// StateExporter specifies the type of function that is called to export the
+// application state before an upgrade is applied, returning the JSON encoded
+// genesis state of the application.
+type StateExporter func(ctx sdk.Context) (json.RawMessage, error)
@@ -28,6 +28,9 @@ const (
 	// ProtocolVersionByte is a prefix to look up Protocol Version
 	ProtocolVersionByte = 0x3
 
+	// SkippedByte is a prefix to look up skipped upgrade plans by name
+	SkippedByte = 0x4
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -10,6 +10,10 @@ import (
 // UpgradeInfoFileName file to store upgrade information
 const UpgradeInfoFilename = "upgrade-info.json"
 
+// PreUpgradeExportFilenameFmt is the format of the name of the file storing the
+// application state exported at the last height before an upgrade.
+const PreUpgradeExportFilenameFmt = "upgrade-export-%d.json"
+
 func (p Plan) String() string {
 	due := p.DueAt()
 	return fmt.Sprintf(`Upgrade Plan
@@ -0,0 +1,284 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"runtime"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PlanInfoAnyPlatform is the PlanInfo binaries key of a binary which runs on
+// any platform.
+const PlanInfoAnyPlatform = "any"
+
+// PlanInfo is the content of the Plan info field understood by cosmovisor to
+// download the upgraded binary automatically.
+type PlanInfo struct {
+	// Binaries maps an os/arch platform, e.g. linux/amd64, or "any" to the
+	// download URL of the binary, which includes its checksum.
+	Binaries map[string]string `json:"binaries"`
+
+	// Mirrors maps a platform of Binaries to alternative download URLs of the
+	// same binary, tried in order when the download from Binaries fails.
+	Mirrors map[string][]string `json:"mirrors,omitempty"`
+
+	// Signature holds detached signatures over the canonical encoding of
+	// Binaries, see BinariesSignBytes. It is verified by ValidateFull.
+	Signature []PlanInfoSignature `json:"signature,omitempty"`
+}
+
+// PlanInfoSignature is a signature of the binaries of a PlanInfo.
+type PlanInfoSignature struct {
+	// PubKeyType is the type of PubKey, secp256k1 or ed25519.
+	PubKeyType string `json:"pub_key_type"`
+	PubKey     []byte `json:"pub_key"`
+	Signature  []byte `json:"signature"`
+}
+
+// GetPubKey returns the public key of the signature.
+func (s PlanInfoSignature) GetPubKey() (cryptotypes.PubKey, error) {
+	switch s.PubKeyType {
+	case (&secp256k1.PubKey{}).Type():
+		if len(s.PubKey) != secp256k1.PubKeySize {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid secp256k1 public key length %d", len(s.PubKey))
+		}
+		return &secp256k1.PubKey{Key: s.PubKey}, nil
+	case (&ed25519.PubKey{}).Type():
+		if len(s.PubKey) != ed25519.PubKeySize {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid ed25519 public key length %d", len(s.PubKey))
+		}
+		return &ed25519.PubKey{Key: s.PubKey}, nil
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "unsupported public key type %q", s.PubKeyType)
+	}
+}
+
+// ParsePlanInfoKey parses a trusted key of the plan info signatures given as
+// <type>:<base64 key>, e.g. secp256k1:A0b1..., as configured in cosmovisor.
+func ParsePlanInfoKey(key string) (cryptotypes.PubKey, error) {
+	parts := strings.SplitN(key, ":", 2)
+	if len(parts) != 2 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid key %q, expected <type>:<base64 key>", key)
+	}
+
+	bz, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid key %q: %s", key, err)
+	}
+
+	return PlanInfoSignature{PubKeyType: parts[0], PubKey: bz}.GetPubKey()
+}
+
+// ParsePlanInfo parses the info of a Plan holding a PlanInfo inline, i.e. a
+// JSON object with binaries. It returns false for the other infos, e.g. a link
+// to a PlanInfo or free text.
+func ParsePlanInfo(info string) (PlanInfo, bool) {
+	info = strings.TrimSpace(info)
+	if !strings.HasPrefix(info, "{") {
+		return PlanInfo{}, false
+	}
+
+	var pi PlanInfo
+	if err := json.Unmarshal([]byte(info), &pi); err != nil || pi.Binaries == nil {
+		return PlanInfo{}, false
+	}
+
+	return pi, true
+}
+
+// CurrentPlatform returns the os/arch platform of the running binary.
+func CurrentPlatform() string {
+	return fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH)
+}
+
+// URLs returns the download URL and the mirrors of the binary of the given
+// platform, falling back to the binary of any platform.
+func (pi PlanInfo) URLs(platform string) []string {
+	binaryURL, ok := pi.Binaries[platform]
+	if !ok {
+		platform = PlanInfoAnyPlatform
+		if binaryURL, ok = pi.Binaries[platform]; !ok {
+			return nil
+		}
+	}
+
+	return append([]string{binaryURL}, pi.Mirrors[platform]...)
+}
+
+// ValidateBasic checks the platforms and URLs of the binaries.
+func (pi PlanInfo) ValidateBasic() error {
+	if len(pi.Binaries) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "plan info must contain at least one binary")
+	}
+
+	for platform, binaryURL := range pi.Binaries {
+		if platform != PlanInfoAnyPlatform && len(strings.Split(platform, "/")) != 2 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid platform %q, expected os/arch or %s", platform, PlanInfoAnyPlatform)
+		}
+		if _, err := url.Parse(binaryURL); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid url of %s binary: %s", platform, err)
+		}
+	}
+
+	for platform, mirrors := range pi.Mirrors {
+		if _, ok := pi.Binaries[platform]; !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "mirrors of %s have no binary", platform)
+		}
+		for _, mirror := range mirrors {
+			if _, err := url.Parse(mirror); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid mirror url of %s binary: %s", platform, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// BinariesSignBytes returns the canonical encoding of the binaries signed by
+// the plan info signatures: their JSON encoding, ordered by platform and
+// without whitespace.
+func (pi PlanInfo) BinariesSignBytes() []byte {
+	// encoding/json sorts map keys
+	bz, err := json.Marshal(pi.Binaries)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// Sign adds a signature of the binaries by the given key.
+func (pi *PlanInfo) Sign(priv cryptotypes.PrivKey) error {
+	sig, err := priv.Sign(pi.BinariesSignBytes())
+	if err != nil {
+		return err
+	}
+
+	pubKey := priv.PubKey()
+	pi.Signature = append(pi.Signature, PlanInfoSignature{
+		PubKeyType: pubKey.Type(),
+		PubKey:     pubKey.Bytes(),
+		Signature:  sig,
+	})
+
+	return nil
+}
+
+// ValidateFull performs ValidateBasic and checks that every binary and mirror
+// URL includes a checksum, and that mirrors have the checksum of their binary.
+// If trustedKeys is not empty, the binaries must also be signed by at least
+// threshold distinct trusted keys, e.g. keys designated by governance, which
+// protects against a compromised proposal submitter.
+func (pi PlanInfo) ValidateFull(trustedKeys []cryptotypes.PubKey, threshold int) error {
+	if err := pi.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for platform, binaryURL := range pi.Binaries {
+		checksum, err := urlChecksum(binaryURL)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "binary of %s", platform)
+		}
+		for _, mirror := range pi.Mirrors[platform] {
+			mirrorChecksum, err := urlChecksum(mirror)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "mirror of %s", platform)
+			}
+			if mirrorChecksum != checksum {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "mirror %s of %s has checksum %s, expected %s", mirror, platform, mirrorChecksum, checksum)
+			}
+		}
+	}
+
+	if len(trustedKeys) == 0 {
+		return nil
+	}
+	if threshold < 1 || threshold > len(trustedKeys) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid signature threshold %d of %d trusted keys", threshold, len(trustedKeys))
+	}
+
+	signBytes := pi.BinariesSignBytes()
+	signers := make(map[string]bool)
+	for _, sig := range pi.Signature {
+		pubKey, err := sig.GetPubKey()
+		if err != nil {
+			return err
+		}
+		if !isTrustedKey(pubKey, trustedKeys) || !pubKey.VerifySignature(signBytes, sig.Signature) {
+			continue
+		}
+		signers[pubKey.Address().String()] = true
+	}
+
+	if len(signers) < threshold {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "plan info has %d valid signatures of trusted keys, expected at least %d", len(signers), threshold)
+	}
+
+	return nil
+}
+
+func isTrustedKey(pubKey cryptotypes.PubKey, trustedKeys []cryptotypes.PubKey) bool {
+	for _, trusted := range trustedKeys {
+		if pubKey.Equals(trusted) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// urlChecksum returns the checksum query parameter of a binary URL.
+func urlChecksum(binaryURL string) (string, error) {
+	u, err := url.Parse(binaryURL)
+	if err != nil {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid url %s: %s", binaryURL, err)
+	}
+
+	checksum := u.Query().Get("checksum")
+	if checksum == "" {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "url %s has no checksum", binaryURL)
+	}
+
+	return checksum, nil
+}
+
+// String returns the JSON encoding of the plan info, to be used as the Plan
+// info field.
+func (pi PlanInfo) String() string {
+	bz, err := json.Marshal(pi)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bz)
+}
+
+// BinaryURLWithChecksum appends the sha256 checksum of a binary to its download
+// URL, in the format verified by cosmovisor.
+func BinaryURLWithChecksum(binaryURL string, sha256Sum []byte) (string, error) {
+	u, err := url.Parse(binaryURL)
+	if err != nil {
+		return "", err
+	}
+
+	if u.Query().Get("checksum") != "" {
+		return "", fmt.Errorf("url %s already contains a checksum", binaryURL)
+	}
+
+	// the checksum is appended verbatim, as url.Values would escape the colon
+	checksum := fmt.Sprintf("checksum=sha256:%x", sha256Sum)
+	if u.RawQuery == "" {
+		u.RawQuery = checksum
+	} else {
+		u.RawQuery += "&" + checksum
+	}
+
+	return u.String(), nil
+}
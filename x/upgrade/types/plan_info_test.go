@@ -0,0 +1,135 @@
+package types_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func TestBinaryURLWithChecksum(t *testing.T) {
+	sum := []byte{0xab, 0xcd}
+
+	cases := map[string]struct {
+		url      string
+		expected string
+		expErr   bool
+	}{
+		"no query":       {url: "https://example.com/simd", expected: "https://example.com/simd?checksum=sha256:abcd"},
+		"existing query": {url: "https://example.com/simd?archive=zip", expected: "https://example.com/simd?archive=zip&checksum=sha256:abcd"},
+		"file url":       {url: "file:///tmp/simd", expected: "file:///tmp/simd?checksum=sha256:abcd"},
+		"has checksum":   {url: "https://example.com/simd?checksum=sha256:0000", expErr: true},
+		"invalid url":    {url: "://", expErr: true},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			res, err := types.BinaryURLWithChecksum(tc.url, sum)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
+
+func TestPlanInfoValidateBasic(t *testing.T) {
+	require.NoError(t, types.PlanInfo{Binaries: map[string]string{"linux/amd64": "https://example.com/simd"}}.ValidateBasic())
+	require.NoError(t, types.PlanInfo{Binaries: map[string]string{types.PlanInfoAnyPlatform: "https://example.com/simd"}}.ValidateBasic())
+	require.Error(t, types.PlanInfo{}.ValidateBasic())
+	require.Error(t, types.PlanInfo{Binaries: map[string]string{"linux": "https://example.com/simd"}}.ValidateBasic())
+}
+
+func TestPlanInfoURLs(t *testing.T) {
+	info := types.PlanInfo{
+		Binaries: map[string]string{
+			"linux/amd64":             "https://example.com/simd-linux",
+			types.PlanInfoAnyPlatform: "https://example.com/simd",
+		},
+		Mirrors: map[string][]string{
+			"linux/amd64": {"https://mirror.example.com/simd-linux"},
+		},
+	}
+	require.NoError(t, info.ValidateBasic())
+
+	require.Equal(t, []string{"https://example.com/simd-linux", "https://mirror.example.com/simd-linux"}, info.URLs("linux/amd64"))
+	require.Equal(t, []string{"https://example.com/simd"}, info.URLs("darwin/arm64"))
+	require.Empty(t, types.PlanInfo{}.URLs("linux/amd64"))
+
+	info.Mirrors["darwin/arm64"] = []string{"https://mirror.example.com/simd-darwin"}
+	require.Error(t, info.ValidateBasic())
+}
+
+func TestPlanInfoValidateFull(t *testing.T) {
+	newInfo := func() types.PlanInfo {
+		return types.PlanInfo{
+			Binaries: map[string]string{
+				"linux/amd64":  "https://example.com/simd-linux?checksum=sha256:aa",
+				"darwin/arm64": "https://example.com/simd-darwin?checksum=sha256:bb",
+			},
+			Mirrors: map[string][]string{
+				"linux/amd64": {"https://mirror.example.com/simd-linux?checksum=sha256:aa"},
+			},
+		}
+	}
+
+	// checksums are required and must match between binaries and mirrors
+	info := newInfo()
+	require.NoError(t, info.ValidateFull(nil, 0))
+	info.Binaries["darwin/arm64"] = "https://example.com/simd-darwin"
+	require.Error(t, info.ValidateFull(nil, 0))
+	info = newInfo()
+	info.Mirrors["linux/amd64"] = []string{"https://mirror.example.com/simd-linux?checksum=sha256:bb"}
+	require.Error(t, info.ValidateFull(nil, 0))
+
+	key1, key2, key3 := secp256k1.GenPrivKey(), ed25519.GenPrivKey(), secp256k1.GenPrivKey()
+	trusted := []cryptotypes.PubKey{key1.PubKey(), key2.PubKey()}
+
+	info = newInfo()
+	require.Error(t, info.ValidateFull(trusted, 1))
+	require.Error(t, info.ValidateFull(trusted, 3))
+
+	// untrusted and duplicate signatures do not count towards the threshold
+	require.NoError(t, info.Sign(key3))
+	require.NoError(t, info.Sign(key1))
+	require.NoError(t, info.Sign(key1))
+	require.NoError(t, info.ValidateFull(trusted, 1))
+	require.Error(t, info.ValidateFull(trusted, 2))
+
+	require.NoError(t, info.Sign(key2))
+	require.NoError(t, info.ValidateFull(trusted, 2))
+
+	// signatures survive the JSON encoding of the plan info
+	var decoded types.PlanInfo
+	require.NoError(t, json.Unmarshal([]byte(info.String()), &decoded))
+	require.NoError(t, decoded.ValidateFull(trusted, 2))
+
+	// changing the binaries invalidates the signatures
+	decoded.Binaries["linux/amd64"] = "https://evil.example.com/simd-linux?checksum=sha256:aa"
+	decoded.Mirrors = nil
+	require.Error(t, decoded.ValidateFull(trusted, 1))
+}
+
+func TestParsePlanInfoKey(t *testing.T) {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+
+	parsed, err := types.ParsePlanInfoKey("secp256k1:" + base64.StdEncoding.EncodeToString(pubKey.Bytes()))
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(parsed))
+
+	_, err = types.ParsePlanInfoKey(base64.StdEncoding.EncodeToString(pubKey.Bytes()))
+	require.Error(t, err)
+	_, err = types.ParsePlanInfoKey("secp256k1:not base64")
+	require.Error(t, err)
+	_, err = types.ParsePlanInfoKey("ed25519:" + base64.StdEncoding.EncodeToString(pubKey.Bytes()))
+	require.Error(t, err)
+}
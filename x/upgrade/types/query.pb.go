@@ -402,6 +402,252 @@ func (m *QueryModuleVersionsResponse) GetModuleVersions() []*ModuleVersion {
 	return nil
 }
 
+// QuerySkippedUpgradesRequest is the request type for the Query/SkippedUpgrades
+// RPC method.
+type QuerySkippedUpgradesRequest struct {
+	// name is a field to query a specific skipped upgrade. Leaving this empty
+	// will fetch all the skipped upgrades.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QuerySkippedUpgradesRequest) Reset()         { *m = QuerySkippedUpgradesRequest{} }
+func (m *QuerySkippedUpgradesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySkippedUpgradesRequest) ProtoMessage()    {}
+func (*QuerySkippedUpgradesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{8}
+}
+func (m *QuerySkippedUpgradesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySkippedUpgradesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySkippedUpgradesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySkippedUpgradesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySkippedUpgradesRequest.Merge(m, src)
+}
+func (m *QuerySkippedUpgradesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySkippedUpgradesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySkippedUpgradesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySkippedUpgradesRequest proto.InternalMessageInfo
+
+func (m *QuerySkippedUpgradesRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QuerySkippedUpgradesResponse is the response type for the Query/SkippedUpgrades
+// RPC method.
+type QuerySkippedUpgradesResponse struct {
+	// skipped_upgrades is the list of skipped upgrade plans, ordered by name.
+	SkippedUpgrades []*SkippedUpgrade `protobuf:"bytes,1,rep,name=skipped_upgrades,json=skippedUpgrades,proto3" json:"skipped_upgrades,omitempty"`
+}
+
+func (m *QuerySkippedUpgradesResponse) Reset()         { *m = QuerySkippedUpgradesResponse{} }
+func (m *QuerySkippedUpgradesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySkippedUpgradesResponse) ProtoMessage()    {}
+func (*QuerySkippedUpgradesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{9}
+}
+func (m *QuerySkippedUpgradesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySkippedUpgradesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySkippedUpgradesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySkippedUpgradesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySkippedUpgradesResponse.Merge(m, src)
+}
+func (m *QuerySkippedUpgradesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySkippedUpgradesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySkippedUpgradesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySkippedUpgradesResponse proto.InternalMessageInfo
+
+func (m *QuerySkippedUpgradesResponse) GetSkippedUpgrades() []*SkippedUpgrade {
+	if m != nil {
+		return m.SkippedUpgrades
+	}
+	return nil
+}
+
+// QueryVersionDiffRequest is the request type for the Query/VersionDiff RPC
+// method.
+type QueryVersionDiffRequest struct {
+}
+
+func (m *QueryVersionDiffRequest) Reset()         { *m = QueryVersionDiffRequest{} }
+func (m *QueryVersionDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVersionDiffRequest) ProtoMessage()    {}
+func (*QueryVersionDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryVersionDiffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVersionDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVersionDiffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVersionDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVersionDiffRequest.Merge(m, src)
+}
+func (m *QueryVersionDiffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVersionDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVersionDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVersionDiffRequest proto.InternalMessageInfo
+
+// QueryVersionDiffResponse is the response type for the Query/VersionDiff RPC
+// method.
+type QueryVersionDiffResponse struct {
+	// diffs is the list of modules whose on chain consensus version differs from
+	// the consensus version of the running binary, ordered by module name.
+	Diffs []*ModuleVersionDiff `protobuf:"bytes,1,rep,name=diffs,proto3" json:"diffs,omitempty"`
+}
+
+func (m *QueryVersionDiffResponse) Reset()         { *m = QueryVersionDiffResponse{} }
+func (m *QueryVersionDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVersionDiffResponse) ProtoMessage()    {}
+func (*QueryVersionDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryVersionDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVersionDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVersionDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVersionDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVersionDiffResponse.Merge(m, src)
+}
+func (m *QueryVersionDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVersionDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVersionDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVersionDiffResponse proto.InternalMessageInfo
+
+func (m *QueryVersionDiffResponse) GetDiffs() []*ModuleVersionDiff {
+	if m != nil {
+		return m.Diffs
+	}
+	return nil
+}
+
+// ModuleVersionDiff is the consensus version of a module on chain and in the
+// running binary. A version of 0 means the module is missing.
+type ModuleVersionDiff struct {
+	// name of the app module
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// on_chain_version is the consensus version stored in the upgrade module
+	OnChainVersion uint64 `protobuf:"varint,2,opt,name=on_chain_version,json=onChainVersion,proto3" json:"on_chain_version,omitempty"`
+	// binary_version is the consensus version of the running binary
+	BinaryVersion uint64 `protobuf:"varint,3,opt,name=binary_version,json=binaryVersion,proto3" json:"binary_version,omitempty"`
+}
+
+func (m *ModuleVersionDiff) Reset()         { *m = ModuleVersionDiff{} }
+func (m *ModuleVersionDiff) String() string { return proto.CompactTextString(m) }
+func (*ModuleVersionDiff) ProtoMessage()    {}
+func (*ModuleVersionDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{12}
+}
+func (m *ModuleVersionDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleVersionDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleVersionDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleVersionDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleVersionDiff.Merge(m, src)
+}
+func (m *ModuleVersionDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleVersionDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleVersionDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleVersionDiff proto.InternalMessageInfo
+
+func (m *ModuleVersionDiff) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ModuleVersionDiff) GetOnChainVersion() uint64 {
+	if m != nil {
+		return m.OnChainVersion
+	}
+	return 0
+}
+
+func (m *ModuleVersionDiff) GetBinaryVersion() uint64 {
+	if m != nil {
+		return m.BinaryVersion
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -411,6 +657,11 @@ func init() {
 	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradedConsensusStateResponse")
 	proto.RegisterType((*QueryModuleVersionsRequest)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsRequest")
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
+	proto.RegisterType((*QuerySkippedUpgradesRequest)(nil), "cosmos.upgrade.v1beta1.QuerySkippedUpgradesRequest")
+	proto.RegisterType((*QuerySkippedUpgradesResponse)(nil), "cosmos.upgrade.v1beta1.QuerySkippedUpgradesResponse")
+	proto.RegisterType((*QueryVersionDiffRequest)(nil), "cosmos.upgrade.v1beta1.QueryVersionDiffRequest")
+	proto.RegisterType((*QueryVersionDiffResponse)(nil), "cosmos.upgrade.v1beta1.QueryVersionDiffResponse")
+	proto.RegisterType((*ModuleVersionDiff)(nil), "cosmos.upgrade.v1beta1.ModuleVersionDiff")
 }
 
 func init() {
@@ -418,44 +669,55 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0xd3, 0x3c,
-	0x1c, 0xae, 0xbb, 0x6e, 0x7a, 0x5f, 0x17, 0x0d, 0xe4, 0x43, 0xc9, 0xc2, 0x14, 0x2a, 0x33, 0xa0,
-	0x88, 0x35, 0xde, 0xda, 0x0b, 0x1a, 0x02, 0x01, 0x93, 0x10, 0x43, 0x30, 0x41, 0x11, 0x1c, 0xb8,
-	0x54, 0x6e, 0x63, 0xda, 0x88, 0x24, 0xce, 0x62, 0x67, 0x62, 0x9a, 0x76, 0xe1, 0xc4, 0x11, 0x89,
-	0x3b, 0x37, 0x2e, 0x7c, 0x12, 0x8e, 0x93, 0xb8, 0x70, 0xd8, 0x01, 0xb5, 0x7c, 0x10, 0x14, 0xc7,
-	0x45, 0x29, 0x4d, 0x3a, 0xe0, 0xd4, 0xc6, 0x7e, 0xfe, 0xfd, 0xe2, 0xc7, 0x81, 0xb8, 0xcf, 0x85,
-	0xcf, 0x05, 0x89, 0xc3, 0x41, 0x44, 0x1d, 0x46, 0xf6, 0x37, 0x7b, 0x4c, 0xd2, 0x4d, 0xb2, 0x17,
-	0xb3, 0xe8, 0xc0, 0x0e, 0x23, 0x2e, 0x39, 0xaa, 0xa5, 0x18, 0x5b, 0x63, 0x6c, 0x8d, 0x31, 0x57,
-	0x06, 0x9c, 0x0f, 0x3c, 0x46, 0x14, 0xaa, 0x17, 0xbf, 0x22, 0x34, 0xd0, 0x14, 0x73, 0x55, 0x6f,
-	0xd1, 0xd0, 0x25, 0x34, 0x08, 0xb8, 0xa4, 0xd2, 0xe5, 0x81, 0xd0, 0xbb, 0x6b, 0x05, 0xa6, 0x13,
-	0x03, 0x85, 0xc2, 0x2b, 0xf0, 0xfc, 0xd3, 0x24, 0xc5, 0x76, 0x1c, 0x45, 0x2c, 0x90, 0x4f, 0x3c,
-	0x1a, 0x74, 0xd8, 0x5e, 0xcc, 0x84, 0xc4, 0x8f, 0xa0, 0x31, 0xbb, 0x25, 0x42, 0x1e, 0x08, 0x86,
-	0x36, 0x60, 0x25, 0xf4, 0x68, 0x60, 0x80, 0x3a, 0x68, 0x54, 0x5b, 0xab, 0x76, 0x7e, 0x78, 0x5b,
-	0x71, 0x14, 0x12, 0x37, 0xb5, 0xd1, 0xdd, 0x30, 0xf4, 0x5c, 0xe6, 0x64, 0x8c, 0x10, 0x82, 0x95,
-	0x80, 0xfa, 0x4c, 0x89, 0xfd, 0xdf, 0x51, 0xff, 0x71, 0x4b, 0x9b, 0x4f, 0xc1, 0xb5, 0x79, 0x0d,
-	0x2e, 0x0d, 0x99, 0x3b, 0x18, 0x4a, 0xc5, 0x58, 0xe8, 0xe8, 0x27, 0xbc, 0x03, 0xb1, 0xe2, 0x3c,
-	0x4f, 0x53, 0x38, 0xdb, 0x09, 0x3a, 0x10, 0xb1, 0x78, 0x26, 0xa9, 0x64, 0x13, 0xb7, 0x8b, 0xb0,
-	0xea, 0x51, 0x21, 0xbb, 0x53, 0x12, 0x30, 0x59, 0x7a, 0xa0, 0x56, 0xb6, 0xca, 0x06, 0xc0, 0x2e,
-	0xbc, 0x34, 0x57, 0x4a, 0x27, 0xb9, 0x01, 0x0d, 0x3d, 0xb2, 0xd3, 0xed, 0x4f, 0x20, 0x5d, 0x91,
-	0x60, 0x8c, 0x72, 0x1d, 0x34, 0xce, 0x74, 0x6a, 0x71, 0xae, 0x42, 0x62, 0xf2, 0xb0, 0xf2, 0x1f,
-	0x38, 0x57, 0xc6, 0xb7, 0xa0, 0xa9, 0xac, 0x1e, 0x73, 0x27, 0xf6, 0xd8, 0x0b, 0x16, 0x89, 0xe4,
-	0x10, 0x33, 0x69, 0x7d, 0xb5, 0xd1, 0xcd, 0xbc, 0x22, 0x98, 0x2e, 0xed, 0x26, 0x2f, 0xca, 0x87,
-	0x17, 0x72, 0xe9, 0x3a, 0xe1, 0x2e, 0x3c, 0xab, 0xf9, 0xfb, 0x7a, 0xcb, 0x00, 0xf5, 0x85, 0x46,
-	0xb5, 0x75, 0xb9, 0xe8, 0xcc, 0xa6, 0x84, 0x3a, 0xcb, 0xfe, 0x94, 0x6e, 0xeb, 0x64, 0x11, 0x2e,
-	0x2a, 0x3f, 0xf4, 0x11, 0xc0, 0x6a, 0xa6, 0x1a, 0x88, 0x14, 0x09, 0x16, 0xf4, 0xcb, 0xdc, 0xf8,
-	0x73, 0x42, 0x3a, 0x0c, 0x5e, 0x7f, 0xfb, 0xf5, 0xc7, 0x87, 0xf2, 0x15, 0xb4, 0x46, 0x0a, 0xba,
-	0xdd, 0x4f, 0x49, 0xdd, 0xa4, 0x71, 0xe8, 0x13, 0x80, 0xd5, 0x4c, 0x7d, 0x4e, 0x09, 0x38, 0xdb,
-	0xcb, 0x53, 0x02, 0xe6, 0x34, 0x13, 0xb7, 0x55, 0xc0, 0x26, 0xba, 0x5e, 0x14, 0x90, 0xa6, 0x24,
-	0x15, 0x90, 0x1c, 0x26, 0x47, 0x7a, 0x84, 0x4e, 0x00, 0xac, 0xe5, 0xf7, 0x0c, 0x6d, 0xcd, 0x4d,
-	0x30, 0xb7, 0xe7, 0xe6, 0xcd, 0x7f, 0xe2, 0xea, 0x41, 0x76, 0xd4, 0x20, 0x77, 0xd0, 0x6d, 0x32,
-	0xff, 0x2b, 0x32, 0x53, 0x7b, 0x72, 0x98, 0xb9, 0x5c, 0x47, 0xef, 0xca, 0x00, 0x7d, 0x06, 0x70,
-	0x79, 0xba, 0x9c, 0xa8, 0x35, 0x37, 0x5a, 0xee, 0x45, 0x30, 0xdb, 0x7f, 0xc5, 0xd1, 0x63, 0x10,
-	0x35, 0xc6, 0x35, 0x74, 0xb5, 0x68, 0x8c, 0xdf, 0xee, 0xc6, 0xbd, 0xfb, 0x5f, 0x46, 0x16, 0x38,
-	0x1e, 0x59, 0xe0, 0xfb, 0xc8, 0x02, 0xef, 0xc7, 0x56, 0xe9, 0x78, 0x6c, 0x95, 0xbe, 0x8d, 0xad,
-	0xd2, 0xcb, 0xf5, 0x81, 0x2b, 0x87, 0x71, 0xcf, 0xee, 0x73, 0x7f, 0x22, 0x96, 0xfe, 0x34, 0x85,
-	0xf3, 0x9a, 0xbc, 0xf9, 0xa5, 0x2c, 0x0f, 0x42, 0x26, 0x7a, 0x4b, 0xea, 0xeb, 0xda, 0xfe, 0x19,
-	0x00, 0x00, 0xff, 0xff, 0x80, 0xcd, 0x5d, 0xc6, 0xfa, 0x05, 0x00, 0x00,
+	// 764 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x4f, 0x13, 0x5b,
+	0x14, 0xe6, 0x96, 0x42, 0x78, 0xa7, 0xef, 0x15, 0xde, 0x5d, 0xf4, 0x0d, 0xf3, 0x48, 0x25, 0x23,
+	0x60, 0x51, 0xe8, 0x94, 0xe2, 0xc2, 0x60, 0xfc, 0x89, 0x31, 0x62, 0x94, 0x48, 0x89, 0x2e, 0x74,
+	0x31, 0xb9, 0xed, 0xdc, 0xb6, 0x13, 0xda, 0x3b, 0x43, 0xef, 0x0c, 0x81, 0x10, 0x36, 0xae, 0x5c,
+	0x9a, 0xb8, 0x77, 0xe7, 0xc6, 0x05, 0x7f, 0x87, 0x4b, 0x12, 0x37, 0x2e, 0x5c, 0x18, 0xf0, 0x0f,
+	0x31, 0x73, 0xe7, 0x0e, 0x69, 0x99, 0x1f, 0x16, 0x57, 0x6d, 0xef, 0xf9, 0xbe, 0x73, 0xbe, 0x73,
+	0xee, 0x3d, 0x5f, 0x0a, 0x5a, 0xc3, 0xe6, 0x5d, 0x9b, 0xeb, 0x9e, 0xd3, 0xea, 0x11, 0x93, 0xea,
+	0x7b, 0x2b, 0x75, 0xea, 0x92, 0x15, 0x7d, 0xd7, 0xa3, 0xbd, 0x83, 0xb2, 0xd3, 0xb3, 0x5d, 0x1b,
+	0x17, 0x02, 0x4c, 0x59, 0x62, 0xca, 0x12, 0xa3, 0x4e, 0xb7, 0x6c, 0xbb, 0xd5, 0xa1, 0xba, 0x40,
+	0xd5, 0xbd, 0xa6, 0x4e, 0x98, 0xa4, 0xa8, 0x33, 0x32, 0x44, 0x1c, 0x4b, 0x27, 0x8c, 0xd9, 0x2e,
+	0x71, 0x2d, 0x9b, 0x71, 0x19, 0x9d, 0x4b, 0x28, 0x1a, 0x16, 0x10, 0x28, 0x6d, 0x1a, 0xfe, 0xdb,
+	0xf2, 0x55, 0xac, 0x7b, 0xbd, 0x1e, 0x65, 0xee, 0x8b, 0x0e, 0x61, 0x35, 0xba, 0xeb, 0x51, 0xee,
+	0x6a, 0xcf, 0x40, 0x89, 0x86, 0xb8, 0x63, 0x33, 0x4e, 0x71, 0x05, 0xb2, 0x4e, 0x87, 0x30, 0x05,
+	0xcd, 0xa2, 0x52, 0xae, 0x3a, 0x53, 0x8e, 0x17, 0x5f, 0x16, 0x1c, 0x81, 0xd4, 0x96, 0x65, 0xa1,
+	0x07, 0x8e, 0xd3, 0xb1, 0xa8, 0xd9, 0x57, 0x08, 0x63, 0xc8, 0x32, 0xd2, 0xa5, 0x22, 0xd9, 0x5f,
+	0x35, 0xf1, 0x5d, 0xab, 0x82, 0x12, 0x85, 0xcb, 0xe2, 0x05, 0x18, 0x6f, 0x53, 0xab, 0xd5, 0x76,
+	0x05, 0x63, 0xb4, 0x26, 0x7f, 0x69, 0x1b, 0xa0, 0x09, 0xce, 0xcb, 0x40, 0x85, 0xb9, 0xee, 0xa3,
+	0x19, 0xf7, 0xf8, 0xb6, 0x4b, 0x5c, 0x1a, 0x56, 0xbb, 0x02, 0xb9, 0x0e, 0xe1, 0xae, 0x31, 0x90,
+	0x02, 0xfc, 0xa3, 0x27, 0xe2, 0x64, 0x2d, 0xa3, 0x20, 0xcd, 0x82, 0xab, 0xa9, 0xa9, 0xa4, 0x92,
+	0x5b, 0xa0, 0xc8, 0x96, 0x4d, 0xa3, 0x11, 0x42, 0x0c, 0xee, 0x63, 0x94, 0xcc, 0x2c, 0x2a, 0xfd,
+	0x5d, 0x2b, 0x78, 0xb1, 0x19, 0xfc, 0x22, 0x4f, 0xb3, 0x13, 0x68, 0x2a, 0xa3, 0xdd, 0x01, 0x55,
+	0x94, 0x7a, 0x6e, 0x9b, 0x5e, 0x87, 0xbe, 0xa2, 0x3d, 0xee, 0x5f, 0x62, 0x9f, 0xda, 0xae, 0x08,
+	0x18, 0x7d, 0x23, 0x82, 0xe0, 0x68, 0xd3, 0x1f, 0x54, 0x17, 0xfe, 0x8f, 0xa5, 0x4b, 0x85, 0x9b,
+	0x30, 0x29, 0xf9, 0x7b, 0x32, 0xa4, 0xa0, 0xd9, 0xd1, 0x52, 0xae, 0x3a, 0x9f, 0x74, 0x67, 0x03,
+	0x89, 0x6a, 0xf9, 0xee, 0x40, 0x5e, 0x6d, 0x45, 0x96, 0xdb, 0xde, 0xb1, 0x1c, 0x87, 0x9a, 0x72,
+	0x3e, 0x3c, 0xed, 0x2a, 0x77, 0x61, 0x26, 0x9e, 0x22, 0x25, 0x6e, 0xc1, 0x14, 0x0f, 0x42, 0x86,
+	0xd4, 0x12, 0x6a, 0x5c, 0x48, 0xd2, 0x38, 0x98, 0xaa, 0x36, 0xc9, 0x07, 0x53, 0x9f, 0xbf, 0x6a,
+	0x29, 0xfb, 0x91, 0xd5, 0x6c, 0x86, 0xaf, 0xfa, 0x0d, 0x28, 0xd1, 0x90, 0x54, 0x72, 0x0f, 0xc6,
+	0x4c, 0xab, 0xd9, 0x0c, 0xcb, 0x2f, 0x0e, 0x35, 0x22, 0x91, 0x21, 0xe0, 0x69, 0xfb, 0xf0, 0x6f,
+	0x24, 0x16, 0x37, 0x13, 0x5c, 0x82, 0x29, 0x9b, 0x19, 0x8d, 0x36, 0xb1, 0x58, 0x78, 0x31, 0xe2,
+	0xc1, 0x64, 0x6b, 0x79, 0x9b, 0xad, 0xfb, 0xc7, 0x32, 0x03, 0x9e, 0x87, 0x7c, 0xdd, 0x62, 0xa4,
+	0x77, 0x70, 0x8e, 0x1b, 0x15, 0xb8, 0x7f, 0x82, 0x53, 0x09, 0xab, 0x1e, 0x4f, 0xc0, 0x98, 0xe8,
+	0x0b, 0x7f, 0x44, 0x90, 0xeb, 0x5b, 0x59, 0xac, 0x27, 0x75, 0x91, 0xb0, 0xf7, 0x6a, 0x65, 0x78,
+	0x42, 0x30, 0x37, 0x6d, 0xe9, 0xed, 0xd7, 0x9f, 0x1f, 0x32, 0x0b, 0x78, 0x4e, 0x4f, 0xf0, 0x9c,
+	0x46, 0x40, 0x32, 0x7c, 0x27, 0xc0, 0x9f, 0x10, 0xe4, 0xfa, 0xd6, 0xfa, 0x37, 0x02, 0xa3, 0x7e,
+	0xa1, 0x56, 0x86, 0x27, 0x48, 0x81, 0xab, 0x42, 0xe0, 0x32, 0xbe, 0x91, 0x24, 0x90, 0x04, 0x24,
+	0x21, 0x50, 0x3f, 0xf4, 0xaf, 0xe8, 0x08, 0x7f, 0x47, 0x50, 0x88, 0xdf, 0x7f, 0xbc, 0x96, 0xaa,
+	0x20, 0xd5, 0x7f, 0xd4, 0xdb, 0x7f, 0xc4, 0x95, 0x8d, 0x6c, 0x88, 0x46, 0xee, 0xe3, 0xbb, 0x7a,
+	0xba, 0xbb, 0x47, 0xec, 0x48, 0x3f, 0xec, 0x33, 0xbd, 0xa3, 0x77, 0x19, 0x84, 0x3f, 0x23, 0xc8,
+	0x0f, 0x9a, 0x06, 0xae, 0xa6, 0x4a, 0x8b, 0x35, 0x28, 0x75, 0xf5, 0x52, 0x1c, 0xd9, 0x86, 0x2e,
+	0xda, 0x58, 0xc4, 0xd7, 0x92, 0xda, 0xb8, 0xe0, 0x59, 0xf8, 0x18, 0xc1, 0xe4, 0x05, 0xff, 0xc0,
+	0xe9, 0x95, 0xe3, 0x0d, 0x4a, 0xbd, 0x79, 0x39, 0x92, 0xd4, 0x5b, 0x11, 0x7a, 0xaf, 0xe3, 0x52,
+	0x92, 0xde, 0x8b, 0x06, 0x26, 0xb6, 0xb0, 0xdf, 0x04, 0xd2, 0x1f, 0x79, 0xd4, 0xa7, 0xd4, 0xca,
+	0xf0, 0x84, 0x61, 0xb7, 0x50, 0x4e, 0xd3, 0xf0, 0xbd, 0xea, 0xe1, 0xe3, 0x2f, 0xa7, 0x45, 0x74,
+	0x72, 0x5a, 0x44, 0x3f, 0x4e, 0x8b, 0xe8, 0xfd, 0x59, 0x71, 0xe4, 0xe4, 0xac, 0x38, 0xf2, 0xed,
+	0xac, 0x38, 0xf2, 0x7a, 0xa9, 0x65, 0xb9, 0x6d, 0xaf, 0x5e, 0x6e, 0xd8, 0xdd, 0x30, 0x53, 0xf0,
+	0xb1, 0xcc, 0xcd, 0x1d, 0x7d, 0xff, 0x3c, 0xad, 0x7b, 0xe0, 0x50, 0x5e, 0x1f, 0x17, 0xff, 0x23,
+	0x56, 0x7f, 0x0d, 0x00, 0x8e, 0xa4, 0x5b, 0x8d, 0xe4, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -483,6 +745,11 @@ type QueryClient interface {
 	UpgradedConsensusState(ctx context.Context, in *QueryUpgradedConsensusStateRequest, opts ...grpc.CallOption) (*QueryUpgradedConsensusStateResponse, error)
 	// ModuleVersions queries the list of module versions from state.
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
+	// SkippedUpgrades queries the upgrade plans skipped with --unsafe-skip-upgrades.
+	SkippedUpgrades(ctx context.Context, in *QuerySkippedUpgradesRequest, opts ...grpc.CallOption) (*QuerySkippedUpgradesResponse, error)
+	// VersionDiff compares the module consensus versions stored on chain to the
+	// module consensus versions of the running binary.
+	VersionDiff(ctx context.Context, in *QueryVersionDiffRequest, opts ...grpc.CallOption) (*QueryVersionDiffResponse, error)
 }
 
 type queryClient struct {
@@ -530,6 +797,24 @@ func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersion
 	return out, nil
 }
 
+func (c *queryClient) SkippedUpgrades(ctx context.Context, in *QuerySkippedUpgradesRequest, opts ...grpc.CallOption) (*QuerySkippedUpgradesResponse, error) {
+	out := new(QuerySkippedUpgradesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/SkippedUpgrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VersionDiff(ctx context.Context, in *QueryVersionDiffRequest, opts ...grpc.CallOption) (*QueryVersionDiffResponse, error) {
+	out := new(QueryVersionDiffResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/VersionDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -545,6 +830,11 @@ type QueryServer interface {
 	UpgradedConsensusState(context.Context, *QueryUpgradedConsensusStateRequest) (*QueryUpgradedConsensusStateResponse, error)
 	// ModuleVersions queries the list of module versions from state.
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
+	// SkippedUpgrades queries the upgrade plans skipped with --unsafe-skip-upgrades.
+	SkippedUpgrades(context.Context, *QuerySkippedUpgradesRequest) (*QuerySkippedUpgradesResponse, error)
+	// VersionDiff compares the module consensus versions stored on chain to the
+	// module consensus versions of the running binary.
+	VersionDiff(context.Context, *QueryVersionDiffRequest) (*QueryVersionDiffResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -563,6 +853,12 @@ func (*UnimplementedQueryServer) UpgradedConsensusState(ctx context.Context, req
 func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
 }
+func (*UnimplementedQueryServer) SkippedUpgrades(ctx context.Context, req *QuerySkippedUpgradesRequest) (*QuerySkippedUpgradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkippedUpgrades not implemented")
+}
+func (*UnimplementedQueryServer) VersionDiff(ctx context.Context, req *QueryVersionDiffRequest) (*QueryVersionDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VersionDiff not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -640,6 +936,42 @@ func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SkippedUpgrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySkippedUpgradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SkippedUpgrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/SkippedUpgrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SkippedUpgrades(ctx, req.(*QuerySkippedUpgradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VersionDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVersionDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VersionDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/VersionDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VersionDiff(ctx, req.(*QueryVersionDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -660,6 +992,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleVersions",
 			Handler:    _Query_ModuleVersions_Handler,
 		},
+		{
+			MethodName: "SkippedUpgrades",
+			Handler:    _Query_SkippedUpgrades_Handler,
+		},
+		{
+			MethodName: "VersionDiff",
+			Handler:    _Query_VersionDiff_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -906,59 +1246,226 @@ func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySkippedUpgradesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCurrentPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QuerySkippedUpgradesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySkippedUpgradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Plan != nil {
-		l = m.Plan.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAppliedPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySkippedUpgradesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAppliedPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySkippedUpgradesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySkippedUpgradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
+	if len(m.SkippedUpgrades) > 0 {
+		for iNdEx := len(m.SkippedUpgrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SkippedUpgrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVersionDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVersionDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVersionDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVersionDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVersionDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVersionDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Diffs) > 0 {
+		for iNdEx := len(m.Diffs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Diffs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleVersionDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleVersionDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleVersionDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BinaryVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BinaryVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OnChainVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OnChainVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCurrentPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppliedPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppliedPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
 		n += 1 + sovQuery(uint64(m.Height))
 	}
 	return n
@@ -1017,13 +1524,371 @@ func (m *QueryModuleVersionsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QuerySkippedUpgradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySkippedUpgradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SkippedUpgrades) > 0 {
+		for _, e := range m.SkippedUpgrades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVersionDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVersionDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Diffs) > 0 {
+		for _, e := range m.Diffs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleVersionDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OnChainVersion != 0 {
+		n += 1 + sovQuery(uint64(m.OnChainVersion))
+	}
+	if m.BinaryVersion != 0 {
+		n += 1 + sovQuery(uint64(m.BinaryVersion))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryCurrentPlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentPlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plan == nil {
+				m.Plan = &Plan{}
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAppliedPlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppliedPlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppliedPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAppliedPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAppliedPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAppliedPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryCurrentPlanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradedConsensusStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1046,12 +1911,31 @@ func (m *QueryCurrentPlanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentPlanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradedConsensusStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradedConsensusStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1073,7 +1957,7 @@ func (m *QueryCurrentPlanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentPlanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1096,17 +1980,17 @@ func (m *QueryCurrentPlanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentPlanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradedConsensusStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradedConsensusStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedConsensusState", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1116,26 +2000,24 @@ func (m *QueryCurrentPlanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plan == nil {
-				m.Plan = &Plan{}
-			}
-			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UpgradedConsensusState = append(m.UpgradedConsensusState[:0], dAtA[iNdEx:postIndex]...)
+			if m.UpgradedConsensusState == nil {
+				m.UpgradedConsensusState = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1159,7 +2041,7 @@ func (m *QueryCurrentPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAppliedPlanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1182,15 +2064,15 @@ func (m *QueryAppliedPlanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAppliedPlanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAppliedPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1218,7 +2100,7 @@ func (m *QueryAppliedPlanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1241,7 +2123,7 @@ func (m *QueryAppliedPlanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAppliedPlanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1264,17 +2146,17 @@ func (m *QueryAppliedPlanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAppliedPlanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAppliedPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleVersions", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1284,11 +2166,26 @@ func (m *QueryAppliedPlanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleVersions = append(m.ModuleVersions, &ModuleVersion{})
+			if err := m.ModuleVersions[len(m.ModuleVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1310,7 +2207,7 @@ func (m *QueryAppliedPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUpgradedConsensusStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySkippedUpgradesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1333,17 +2230,17 @@ func (m *QueryUpgradedConsensusStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradedConsensusStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySkippedUpgradesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradedConsensusStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySkippedUpgradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.LastHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1353,11 +2250,24 @@ func (m *QueryUpgradedConsensusStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1379,7 +2289,7 @@ func (m *QueryUpgradedConsensusStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySkippedUpgradesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1402,17 +2312,17 @@ func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradedConsensusStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySkippedUpgradesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradedConsensusStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySkippedUpgradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedUpgrades", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1422,24 +2332,24 @@ func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpgradedConsensusState = append(m.UpgradedConsensusState[:0], dAtA[iNdEx:postIndex]...)
-			if m.UpgradedConsensusState == nil {
-				m.UpgradedConsensusState = []byte{}
+			m.SkippedUpgrades = append(m.SkippedUpgrades, &SkippedUpgrade{})
+			if err := m.SkippedUpgrades[len(m.SkippedUpgrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1463,7 +2373,7 @@ func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVersionDiffRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1486,17 +2396,67 @@ func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleVersionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVersionDiffRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVersionDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVersionDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVersionDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVersionDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diffs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1506,23 +2466,25 @@ func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			m.Diffs = append(m.Diffs, &ModuleVersionDiff{})
+			if err := m.Diffs[len(m.Diffs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1545,7 +2507,7 @@ func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
+func (m *ModuleVersionDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1568,17 +2530,17 @@ func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleVersionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModuleVersionDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModuleVersionDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1588,26 +2550,62 @@ func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleVersions = append(m.ModuleVersions, &ModuleVersion{})
-			if err := m.ModuleVersions[len(m.ModuleVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnChainVersion", wireType)
+			}
+			m.OnChainVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OnChainVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryVersion", wireType)
+			}
+			m.BinaryVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BinaryVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
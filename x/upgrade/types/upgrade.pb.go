@@ -206,11 +206,56 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// SkippedUpgrade records an upgrade plan which was skipped by the validators
+// with --unsafe-skip-upgrades, bypassing its migrations.
+type SkippedUpgrade struct {
+	// name of the skipped upgrade plan
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// height at which the upgrade plan was due
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// info of the skipped upgrade plan
+	Info string `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (m *SkippedUpgrade) Reset()         { *m = SkippedUpgrade{} }
+func (m *SkippedUpgrade) String() string { return proto.CompactTextString(m) }
+func (*SkippedUpgrade) ProtoMessage()    {}
+func (*SkippedUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *SkippedUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SkippedUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SkippedUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SkippedUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkippedUpgrade.Merge(m, src)
+}
+func (m *SkippedUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *SkippedUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkippedUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SkippedUpgrade proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*SkippedUpgrade)(nil), "cosmos.upgrade.v1beta1.SkippedUpgrade")
 }
 
 func init() {
@@ -218,36 +263,37 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 450 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x3f, 0x6f, 0xd4, 0x30,
-	0x14, 0x8f, 0xdb, 0xb4, 0x50, 0x9f, 0x58, 0xcc, 0x51, 0xcc, 0x09, 0x92, 0x53, 0xc5, 0x70, 0x03,
-	0x38, 0x6a, 0x91, 0x18, 0xba, 0x71, 0x1d, 0x90, 0x10, 0x48, 0x55, 0x0a, 0x0c, 0x2c, 0x95, 0x2f,
-	0xf1, 0xe5, 0x2c, 0x1c, 0x3b, 0x8a, 0x9d, 0xc2, 0x7d, 0x8b, 0x4a, 0x2c, 0x8c, 0xfd, 0x38, 0x37,
-	0x76, 0x44, 0x0c, 0xfc, 0xb9, 0x5b, 0xf8, 0x18, 0xc8, 0x76, 0x82, 0x4e, 0x70, 0x23, 0x53, 0xde,
-	0x7b, 0xf9, 0xfd, 0x79, 0xcf, 0xef, 0xc1, 0x87, 0x99, 0xd2, 0xa5, 0xd2, 0x49, 0x53, 0x15, 0x35,
-	0xcd, 0x59, 0x72, 0x71, 0x38, 0x61, 0x86, 0x1e, 0x76, 0x39, 0xa9, 0x6a, 0x65, 0x14, 0xda, 0xf7,
-	0x28, 0xd2, 0x55, 0x5b, 0xd4, 0xe0, 0x5e, 0xa1, 0x54, 0x21, 0x58, 0xe2, 0x50, 0x93, 0x66, 0x9a,
-	0x50, 0x39, 0xf7, 0x94, 0x41, 0xbf, 0x50, 0x85, 0x72, 0x61, 0x62, 0xa3, 0xb6, 0x1a, 0xff, 0x4d,
-	0x30, 0xbc, 0x64, 0xda, 0xd0, 0xb2, 0xf2, 0x80, 0x83, 0xaf, 0x00, 0x86, 0xa7, 0x82, 0x4a, 0x84,
-	0x60, 0x28, 0x69, 0xc9, 0x30, 0x18, 0x82, 0xd1, 0x5e, 0xea, 0x62, 0x74, 0x0c, 0x43, 0x8b, 0xc7,
-	0x5b, 0x43, 0x30, 0xea, 0x1d, 0x0d, 0x88, 0x17, 0x23, 0x9d, 0x18, 0x79, 0xdd, 0x89, 0x8d, 0xe1,
-	0xe2, 0x5b, 0x1c, 0x5c, 0x7e, 0x8f, 0x01, 0x06, 0xa9, 0xe3, 0xa0, 0x7d, 0xb8, 0x3b, 0x63, 0xbc,
-	0x98, 0x19, 0xbc, 0x3d, 0x04, 0xa3, 0xed, 0xb4, 0xcd, 0xac, 0x0f, 0x97, 0x53, 0x85, 0x43, 0xef,
-	0x63, 0x63, 0xf4, 0x12, 0xde, 0x69, 0x27, 0xcd, 0xcf, 0x33, 0xc1, 0x99, 0x34, 0xe7, 0xda, 0x50,
-	0xc3, 0xf0, 0x8e, 0x33, 0xee, 0xff, 0x63, 0xfc, 0x4c, 0xce, 0xc7, 0x5b, 0x18, 0xa4, 0xb7, 0x3b,
-	0xda, 0x89, 0x63, 0x9d, 0x59, 0xd2, 0xf1, 0xcd, 0xcf, 0x57, 0x71, 0xf0, 0xeb, 0x2a, 0x06, 0x07,
-	0x9f, 0x00, 0xbc, 0x7b, 0xa6, 0xa6, 0xe6, 0x03, 0xad, 0xd9, 0x1b, 0x8f, 0x3c, 0xad, 0x55, 0xa5,
-	0x34, 0x15, 0xa8, 0x0f, 0x77, 0x0c, 0x37, 0xa2, 0x1b, 0xd8, 0x27, 0x68, 0x08, 0x7b, 0x39, 0xd3,
-	0x59, 0xcd, 0x2b, 0xc3, 0x95, 0x74, 0x83, 0xef, 0xa5, 0xeb, 0x25, 0xf4, 0x14, 0x86, 0x95, 0xa0,
-	0xd2, 0x4d, 0xd5, 0x3b, 0xba, 0x4f, 0x36, 0x6f, 0x8a, 0xd8, 0x37, 0x1d, 0x87, 0xf6, 0x55, 0x52,
-	0x87, 0x5f, 0xeb, 0x8a, 0xc2, 0x07, 0x27, 0x54, 0x66, 0x4c, 0xfc, 0xe7, 0xd6, 0xd6, 0x2c, 0x9e,
-	0xc3, 0x5b, 0xaf, 0x54, 0xde, 0x08, 0xf6, 0x96, 0xd5, 0xda, 0x76, 0xbd, 0x69, 0xbb, 0x18, 0xde,
-	0xb8, 0xf0, 0xbf, 0x9d, 0x58, 0x98, 0x76, 0xa9, 0x13, 0x02, 0x56, 0x68, 0xfc, 0x62, 0xf1, 0x33,
-	0x0a, 0x16, 0xcb, 0x08, 0x5c, 0x2f, 0x23, 0xf0, 0x63, 0x19, 0x81, 0xcb, 0x55, 0x14, 0x5c, 0xaf,
-	0xa2, 0xe0, 0xcb, 0x2a, 0x0a, 0xde, 0x3d, 0x2a, 0xb8, 0x99, 0x35, 0x13, 0x92, 0xa9, 0x32, 0x69,
-	0xef, 0xda, 0x7f, 0x1e, 0xeb, 0xfc, 0x7d, 0xf2, 0xf1, 0xcf, 0x91, 0x9b, 0x79, 0xc5, 0xf4, 0x64,
-	0xd7, 0xad, 0xef, 0xc9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x2b, 0xd1, 0x8c, 0x03, 0x03,
-	0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0x8e, 0xdb, 0x6c, 0x30, 0x57, 0x70, 0x30, 0x65, 0x84, 0x0a, 0x92, 0x6a, 0xe2, 0xd0, 0x03,
+	0x24, 0xda, 0x90, 0x38, 0xf4, 0x46, 0x77, 0x40, 0x42, 0x20, 0x4d, 0x29, 0xec, 0xc0, 0x65, 0x72,
+	0x13, 0x37, 0xb5, 0x96, 0xd8, 0x56, 0xec, 0x0e, 0xfa, 0x5f, 0x4c, 0xe2, 0xc2, 0x71, 0x7f, 0x4e,
+	0x8f, 0x3b, 0x22, 0x0e, 0xfc, 0x68, 0x2f, 0xfc, 0x19, 0xc8, 0x76, 0x42, 0x2b, 0xc8, 0x71, 0xa7,
+	0xbc, 0xf7, 0xf2, 0x7d, 0xdf, 0x7b, 0xcf, 0x9f, 0x0d, 0x9f, 0x24, 0x5c, 0x16, 0x5c, 0x46, 0x73,
+	0x91, 0x95, 0x38, 0x25, 0xd1, 0xc5, 0xe1, 0x84, 0x28, 0x7c, 0x58, 0xe7, 0xa1, 0x28, 0xb9, 0xe2,
+	0x68, 0xdf, 0xa2, 0xc2, 0xba, 0x5a, 0xa1, 0x7a, 0x0f, 0x33, 0xce, 0xb3, 0x9c, 0x44, 0x06, 0x35,
+	0x99, 0x4f, 0x23, 0xcc, 0x16, 0x96, 0xd2, 0xeb, 0x66, 0x3c, 0xe3, 0x26, 0x8c, 0x74, 0x54, 0x55,
+	0x83, 0x7f, 0x09, 0x8a, 0x16, 0x44, 0x2a, 0x5c, 0x08, 0x0b, 0x38, 0xf8, 0x06, 0xa0, 0x7b, 0x92,
+	0x63, 0x86, 0x10, 0x74, 0x19, 0x2e, 0x88, 0x07, 0xfa, 0x60, 0xb0, 0x17, 0x9b, 0x18, 0x0d, 0xa1,
+	0xab, 0xf1, 0x5e, 0xab, 0x0f, 0x06, 0x9d, 0xa3, 0x5e, 0x68, 0xc5, 0xc2, 0x5a, 0x2c, 0x7c, 0x57,
+	0x8b, 0x8d, 0xe0, 0xf2, 0x7b, 0xe0, 0x5c, 0xfe, 0x08, 0x80, 0x07, 0x62, 0xc3, 0x41, 0xfb, 0x70,
+	0x77, 0x46, 0x68, 0x36, 0x53, 0x5e, 0xbb, 0x0f, 0x06, 0xed, 0xb8, 0xca, 0x74, 0x1f, 0xca, 0xa6,
+	0xdc, 0x73, 0x6d, 0x1f, 0x1d, 0xa3, 0x37, 0xf0, 0x7e, 0xb5, 0x69, 0x7a, 0x96, 0xe4, 0x94, 0x30,
+	0x75, 0x26, 0x15, 0x56, 0xc4, 0xdb, 0x31, 0x8d, 0xbb, 0xff, 0x35, 0x7e, 0xc9, 0x16, 0xa3, 0x96,
+	0x07, 0xe2, 0x7b, 0x35, 0xed, 0xd8, 0xb0, 0xc6, 0x9a, 0x34, 0xbc, 0xfd, 0xe5, 0x2a, 0x70, 0x7e,
+	0x5f, 0x05, 0xe0, 0xe0, 0x33, 0x80, 0x0f, 0xc6, 0x7c, 0xaa, 0x3e, 0xe2, 0x92, 0xbc, 0xb7, 0xc8,
+	0x93, 0x92, 0x0b, 0x2e, 0x71, 0x8e, 0xba, 0x70, 0x47, 0x51, 0x95, 0xd7, 0x0b, 0xdb, 0x04, 0xf5,
+	0x61, 0x27, 0x25, 0x32, 0x29, 0xa9, 0x50, 0x94, 0x33, 0xb3, 0xf8, 0x5e, 0xbc, 0x5d, 0x42, 0x2f,
+	0xa0, 0x2b, 0x72, 0xcc, 0xcc, 0x56, 0x9d, 0xa3, 0x47, 0x61, 0xb3, 0x53, 0xa1, 0x3e, 0xd3, 0x91,
+	0xab, 0x4f, 0x25, 0x36, 0xf8, 0xad, 0xa9, 0x30, 0x7c, 0x7c, 0x8c, 0x59, 0x42, 0xf2, 0x1b, 0x1e,
+	0x6d, 0xab, 0xc5, 0x2b, 0x78, 0xe7, 0x2d, 0x4f, 0xe7, 0x39, 0x39, 0x25, 0xa5, 0xa4, 0xbc, 0xd9,
+	0x5d, 0x0f, 0xde, 0xba, 0xb0, 0xbf, 0x8d, 0x98, 0x1b, 0xd7, 0xa9, 0x11, 0x02, 0x46, 0xe8, 0x14,
+	0xde, 0x1d, 0x9f, 0x53, 0x21, 0x48, 0x5a, 0x0d, 0xd9, 0xa8, 0xb4, 0xf1, 0xba, 0xd5, 0xe8, 0x75,
+	0x7b, 0xe3, 0xf5, 0xd0, 0xd5, 0xba, 0xa3, 0xd7, 0xcb, 0x5f, 0xbe, 0xb3, 0x5c, 0xf9, 0xe0, 0x7a,
+	0xe5, 0x83, 0x9f, 0x2b, 0x1f, 0x5c, 0xae, 0x7d, 0xe7, 0x7a, 0xed, 0x3b, 0x5f, 0xd7, 0xbe, 0xf3,
+	0xe1, 0x69, 0x46, 0xd5, 0x6c, 0x3e, 0x09, 0x13, 0x5e, 0x44, 0xd5, 0x7b, 0xb1, 0x9f, 0x67, 0x32,
+	0x3d, 0x8f, 0x3e, 0xfd, 0x7d, 0x3c, 0x6a, 0x21, 0x88, 0x9c, 0xec, 0x9a, 0x6b, 0xf1, 0xfc, 0xcf,
+	0x00, 0x81, 0xf0, 0x43, 0x9d, 0x5b, 0x03, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -370,6 +416,36 @@ func (this *ModuleVersion) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SkippedUpgrade) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SkippedUpgrade)
+	if !ok {
+		that2, ok := that.(SkippedUpgrade)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Info != that1.Info {
+		return false
+	}
+	return true
+}
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -551,6 +627,48 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SkippedUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SkippedUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SkippedUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		i -= len(m.Info)
+		copy(dAtA[i:], m.Info)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Info)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -640,6 +758,26 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *SkippedUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovUpgrade(uint64(m.Height))
+	}
+	l = len(m.Info)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1210,6 +1348,139 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SkippedUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SkippedUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SkippedUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0